@@ -33,10 +33,12 @@ type Config struct {
 
 	// LogGroupName is the name of CloudWatch log group which defines group of log streams
 	// that share the same retention, monitoring, and access control settings.
+	// It may reference resource attributes as `{<attribute name>}`.
 	LogGroupName string `mapstructure:"log_group_name"`
 
 	// LogStreamName is the name of CloudWatch log stream which is a sequence of log events
 	// that share the same source.
+	// It may reference resource attributes as `{<attribute name>}`.
 	LogStreamName string `mapstructure:"log_stream_name"`
 
 	// Endpoint is the CloudWatch Logs service endpoint which the requests
@@ -49,6 +51,10 @@ type Config struct {
 	// Possible values are 1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1827, 2192, 2557, 2922, 3288, or 3653
 	LogRetention int64 `mapstructure:"log_retention"`
 
+	// KMSKeyID is the ARN of the KMS key used to encrypt the log groups created by the exporter.
+	// Optional.
+	KMSKeyID string `mapstructure:"kms_key_id"`
+
 	// QueueSettings is a subset of exporterhelper.QueueSettings,
 	// because only QueueSize is user-settable due to how AWS CloudWatch API works
 	QueueSettings QueueSettings `mapstructure:"sending_queue"`
@@ -74,6 +74,21 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(typeStr, "e3-templated-kms"),
+			expected: &Config{
+				ExporterSettings:   config.NewExporterSettings(component.NewID(typeStr)),
+				RetrySettings:      defaultRetrySettings,
+				LogGroupName:       "/aws/{k8s.cluster.name}/logs",
+				LogStreamName:      "{k8s.namespace.name}/{k8s.pod.name}",
+				LogRetention:       30,
+				KMSKeyID:           "arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+				AWSSessionSettings: awsutil.CreateDefaultSessionConfig(),
+				QueueSettings: QueueSettings{
+					QueueSize: exporterhelper.NewDefaultQueueSettings().QueueSize,
+				},
+			},
+		},
 		{
 			id:           component.NewIDWithName(typeStr, "invalid_queue_size"),
 			errorMessage: "'sending_queue.queue_size' must be 1 or greater",
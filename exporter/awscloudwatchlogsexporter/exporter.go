@@ -19,6 +19,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -29,6 +30,7 @@ import (
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/awsutil"
@@ -41,7 +43,9 @@ type exporter struct {
 	retryCount       int
 	collectorID      string
 	svcStructuredLog *cwlogs.Client
-	pusher           cwlogs.Pusher
+
+	pusherMapLock          sync.Mutex
+	groupStreamToPusherMap map[string]map[string]cwlogs.Pusher
 }
 
 func newCwLogsPusher(expConfig *Config, params component.ExporterCreateSettings) (component.LogsExporter, error) {
@@ -58,22 +62,24 @@ func newCwLogsPusher(expConfig *Config, params component.ExporterCreateSettings)
 	}
 
 	// create CWLogs client with aws session config
-	svcStructuredLog := cwlogs.NewClient(params.Logger, awsConfig, params.BuildInfo, expConfig.LogGroupName, expConfig.LogRetention, session)
+	var clientOpts []cwlogs.ClientOption
+	if expConfig.KMSKeyID != "" {
+		clientOpts = append(clientOpts, cwlogs.WithKMSKeyID(expConfig.KMSKeyID))
+	}
+	svcStructuredLog := cwlogs.NewClient(params.Logger, awsConfig, params.BuildInfo, expConfig.LogGroupName, expConfig.LogRetention, session, clientOpts...)
 	collectorIdentifier, err := uuid.NewRandom()
 
 	if err != nil {
 		return nil, err
 	}
 
-	pusher := cwlogs.NewPusher(aws.String(expConfig.LogGroupName), aws.String(expConfig.LogStreamName), *awsConfig.MaxRetries, *svcStructuredLog, params.Logger)
-
 	logsExporter := &exporter{
-		svcStructuredLog: svcStructuredLog,
-		Config:           expConfig,
-		logger:           params.Logger,
-		retryCount:       *awsConfig.MaxRetries,
-		collectorID:      collectorIdentifier.String(),
-		pusher:           pusher,
+		svcStructuredLog:       svcStructuredLog,
+		Config:                 expConfig,
+		logger:                 params.Logger,
+		retryCount:             *awsConfig.MaxRetries,
+		collectorID:            collectorIdentifier.String(),
+		groupStreamToPusherMap: map[string]map[string]cwlogs.Pusher{},
 	}
 	return logsExporter, nil
 }
@@ -96,30 +102,71 @@ func newCwLogsExporter(config component.ExporterConfig, params component.Exporte
 }
 
 func (e *exporter) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
-	cwLogsPusher := e.pusher
-	logEvents, _ := logsToCWLogs(e.logger, ld)
-	if len(logEvents) == 0 {
-		return nil
-	}
-
-	for _, logEvent := range logEvents {
-		logEvent := &cwlogs.Event{
-			InputLogEvent: logEvent,
-			GeneratedTime: time.Now(),
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		logEvents, _ := resourceLogsToCWLogs(e.logger, rl)
+		if len(logEvents) == 0 {
+			continue
 		}
-		e.logger.Debug("Adding log event", zap.Any("event", logEvent))
-		err := cwLogsPusher.AddLogEntry(logEvent)
-		if err != nil {
-			e.logger.Error("Failed ", zap.Int("num_of_events", len(logEvents)))
+
+		logGroup, logStream := getLogInfo(rl.Resource(), e.Config)
+		cwLogsPusher := e.getPusher(logGroup, logStream)
+		for _, logEvent := range logEvents {
+			logEvent := &cwlogs.Event{
+				InputLogEvent: logEvent,
+				GeneratedTime: time.Now(),
+			}
+			e.logger.Debug("Adding log event", zap.Any("event", logEvent))
+			err := cwLogsPusher.AddLogEntry(logEvent)
+			if err != nil {
+				e.logger.Error("Failed ", zap.Int("num_of_events", len(logEvents)))
+			}
 		}
 	}
 	e.logger.Debug("Log events are successfully put")
-	flushErr := cwLogsPusher.ForceFlush()
-	if flushErr != nil {
-		e.logger.Error("Error force flushing logs. Skipping to next logPusher.", zap.Error(flushErr))
-		return flushErr
+
+	var errs error
+	for _, cwLogsPusher := range e.listPushers() {
+		flushErr := cwLogsPusher.ForceFlush()
+		if flushErr != nil {
+			e.logger.Error("Error force flushing logs. Skipping to next logPusher.", zap.Error(flushErr))
+			errs = multierr.Append(errs, flushErr)
+		}
 	}
-	return nil
+	return errs
+}
+
+// getPusher returns the pusher of the given log group and log stream, creating it on first use.
+func (e *exporter) getPusher(logGroup, logStream string) cwlogs.Pusher {
+	e.pusherMapLock.Lock()
+	defer e.pusherMapLock.Unlock()
+
+	streamToPusherMap, ok := e.groupStreamToPusherMap[logGroup]
+	if !ok {
+		streamToPusherMap = map[string]cwlogs.Pusher{}
+		e.groupStreamToPusherMap[logGroup] = streamToPusherMap
+	}
+
+	pusher, ok := streamToPusherMap[logStream]
+	if !ok {
+		pusher = cwlogs.NewPusher(aws.String(logGroup), aws.String(logStream), e.retryCount, *e.svcStructuredLog, e.logger)
+		streamToPusherMap[logStream] = pusher
+	}
+	return pusher
+}
+
+func (e *exporter) listPushers() []cwlogs.Pusher {
+	e.pusherMapLock.Lock()
+	defer e.pusherMapLock.Unlock()
+
+	var pushers []cwlogs.Pusher
+	for _, streamToPusherMap := range e.groupStreamToPusherMap {
+		for _, pusher := range streamToPusherMap {
+			pushers = append(pushers, pusher)
+		}
+	}
+	return pushers
 }
 
 func (e *exporter) Capabilities() consumer.Capabilities {
@@ -127,8 +174,8 @@ func (e *exporter) Capabilities() consumer.Capabilities {
 }
 
 func (e *exporter) Shutdown(ctx context.Context) error {
-	if e.pusher != nil {
-		e.pusher.ForceFlush()
+	for _, pusher := range e.listPushers() {
+		pusher.ForceFlush()
 	}
 	return nil
 }
@@ -137,33 +184,24 @@ func (e *exporter) Start(ctx context.Context, host component.Host) error {
 	return nil
 }
 
-func logsToCWLogs(logger *zap.Logger, ld plog.Logs) ([]*cloudwatchlogs.InputLogEvent, int) {
-	n := ld.ResourceLogs().Len()
-	if n == 0 {
-		return []*cloudwatchlogs.InputLogEvent{}, 0
-	}
-
+func resourceLogsToCWLogs(logger *zap.Logger, rl plog.ResourceLogs) ([]*cloudwatchlogs.InputLogEvent, int) {
 	var dropped int
 	var out []*cloudwatchlogs.InputLogEvent
 
-	rls := ld.ResourceLogs()
-	for i := 0; i < rls.Len(); i++ {
-		rl := rls.At(i)
-		resourceAttrs := attrsValue(rl.Resource().Attributes())
-
-		sls := rl.ScopeLogs()
-		for j := 0; j < sls.Len(); j++ {
-			sl := sls.At(j)
-			logs := sl.LogRecords()
-			for k := 0; k < logs.Len(); k++ {
-				log := logs.At(k)
-				event, err := logToCWLog(resourceAttrs, log)
-				if err != nil {
-					logger.Debug("Failed to convert to CloudWatch Log", zap.Error(err))
-					dropped++
-				} else {
-					out = append(out, event)
-				}
+	resourceAttrs := attrsValue(rl.Resource().Attributes())
+
+	sls := rl.ScopeLogs()
+	for j := 0; j < sls.Len(); j++ {
+		sl := sls.At(j)
+		logs := sl.LogRecords()
+		for k := 0; k < logs.Len(); k++ {
+			log := logs.At(k)
+			event, err := logToCWLog(resourceAttrs, log)
+			if err != nil {
+				logger.Debug("Failed to convert to CloudWatch Log", zap.Error(err))
+				dropped++
+			} else {
+				out = append(out, event)
 			}
 		}
 	}
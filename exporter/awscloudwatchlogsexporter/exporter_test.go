@@ -248,11 +248,67 @@ func TestConsumeLogs(t *testing.T) {
 	logPusher := new(mockPusher)
 	logPusher.On("AddLogEntry", nil).Return("").Once()
 	logPusher.On("ForceFlush", nil).Return("").Twice()
-	exp.(*exporter).pusher = logPusher
+	exp.(*exporter).groupStreamToPusherMap["testGroup"] = map[string]cwlogs.Pusher{"testStream": logPusher}
 	require.NoError(t, exp.(*exporter).ConsumeLogs(ctx, ld))
 	require.NoError(t, exp.Shutdown(ctx))
 }
 
+func TestConsumeLogsTemplated(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	factory := NewFactory()
+	expCfg := factory.CreateDefaultConfig().(*Config)
+	expCfg.Region = "us-west-2"
+	expCfg.LogGroupName = "/aws/{k8s.cluster.name}"
+	expCfg.LogStreamName = "{k8s.pod.name}"
+	expCfg.MaxRetries = 0
+	exp, err := newCwLogsPusher(expCfg, componenttest.NewNopExporterCreateSettings())
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	for _, pod := range []string{"pod-a", "pod-b", "pod-a"} {
+		r := ld.ResourceLogs().AppendEmpty()
+		r.Resource().Attributes().PutStr("k8s.cluster.name", "cluster")
+		r.Resource().Attributes().PutStr("k8s.pod.name", pod)
+		r.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	}
+
+	podA := new(mockPusher)
+	podA.On("AddLogEntry", nil).Return("").Twice()
+	podA.On("ForceFlush", nil).Return("").Twice()
+	podB := new(mockPusher)
+	podB.On("AddLogEntry", nil).Return("").Once()
+	podB.On("ForceFlush", nil).Return("").Twice()
+	exp.(*exporter).groupStreamToPusherMap["/aws/cluster"] = map[string]cwlogs.Pusher{"pod-a": podA, "pod-b": podB}
+
+	require.NoError(t, exp.(*exporter).ConsumeLogs(ctx, ld))
+	require.NoError(t, exp.Shutdown(ctx))
+	podA.AssertExpectations(t)
+	podB.AssertExpectations(t)
+}
+
+func TestConsumeLogsFlushError(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	factory := NewFactory()
+	expCfg := factory.CreateDefaultConfig().(*Config)
+	expCfg.Region = "us-west-2"
+	expCfg.LogGroupName = "testGroup"
+	expCfg.LogStreamName = "testStream"
+	expCfg.MaxRetries = 0
+	exp, err := newCwLogsPusher(expCfg, componenttest.NewNopExporterCreateSettings())
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+
+	logPusher := new(mockPusher)
+	logPusher.On("AddLogEntry", nil).Return("").Once()
+	logPusher.On("ForceFlush", nil).Return("error").Once()
+	exp.(*exporter).groupStreamToPusherMap["testGroup"] = map[string]cwlogs.Pusher{"testStream": logPusher}
+	assert.Error(t, exp.(*exporter).ConsumeLogs(ctx, ld))
+}
+
 func TestNewExporterWithoutRegionErr(t *testing.T) {
 	factory := NewFactory()
 	expCfg := factory.CreateDefaultConfig().(*Config)
@@ -0,0 +1,51 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awscloudwatchlogsexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awscloudwatchlogsexporter"
+
+import (
+	"regexp"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+)
+
+// undefinedPatternValue replaces the patterns whose resource attribute is missing or empty.
+const undefinedPatternValue = "undefined"
+
+// attributePattern matches the `{<resource attribute>}` placeholders of log group and stream names.
+var attributePattern = regexp.MustCompile(`\{([^{}]+)\}`)
+
+// getLogInfo returns the log group and log stream names of the logs of the given resource.
+func getLogInfo(resource pcommon.Resource, config *Config) (string, string) {
+	logGroup := replacePatterns(config.LogGroupName, resource.Attributes(), config.logger)
+	logStream := replacePatterns(config.LogStreamName, resource.Attributes(), config.logger)
+	return logGroup, logStream
+}
+
+// replacePatterns replaces the `{<resource attribute>}` placeholders of s with the
+// value of the resource attribute, or with "undefined" if the resource does not have it.
+func replacePatterns(s string, attrs pcommon.Map, logger *zap.Logger) string {
+	return attributePattern.ReplaceAllStringFunc(s, func(pattern string) string {
+		key := pattern[1 : len(pattern)-1]
+		value, ok := attrs.Get(key)
+		if !ok || value.AsString() == "" {
+			if logger != nil {
+				logger.Debug("No resource attribute found for pattern " + pattern)
+			}
+			return undefinedPatternValue
+		}
+		return value.AsString()
+	})
+}
@@ -0,0 +1,78 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awscloudwatchlogsexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+)
+
+func TestReplacePatterns(t *testing.T) {
+	attrs := pcommon.NewMap()
+	attrs.PutStr("k8s.namespace.name", "default")
+	attrs.PutStr("k8s.pod.name", "my-pod")
+	attrs.PutInt("port", 8080)
+	attrs.PutStr("empty", "")
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "no pattern",
+			input:    "/aws/logs",
+			expected: "/aws/logs",
+		},
+		{
+			name:     "single pattern",
+			input:    "/aws/{k8s.namespace.name}/logs",
+			expected: "/aws/default/logs",
+		},
+		{
+			name:     "multiple patterns",
+			input:    "{k8s.namespace.name}/{k8s.pod.name}",
+			expected: "default/my-pod",
+		},
+		{
+			name:     "non string attribute",
+			input:    "port-{port}",
+			expected: "port-8080",
+		},
+		{
+			name:     "missing attribute",
+			input:    "{k8s.container.name}",
+			expected: "undefined",
+		},
+		{
+			name:     "empty attribute",
+			input:    "/aws/{empty}",
+			expected: "/aws/undefined",
+		},
+		{
+			name:     "unterminated pattern",
+			input:    "/aws/{k8s.pod.name",
+			expected: "/aws/{k8s.pod.name",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, replacePatterns(tt.input, attrs, zap.NewNop()))
+		})
+	}
+}
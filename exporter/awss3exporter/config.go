@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awss3exporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awss3exporter"
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/partitioner"
+)
+
+// Config defines the configuration of the AWS S3 exporter.
+type Config struct {
+	config.ExporterSettings        `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct.
+	exporterhelper.TimeoutSettings `mapstructure:",squash"`
+	exporterhelper.QueueSettings   `mapstructure:"sending_queue"`
+	exporterhelper.RetrySettings   `mapstructure:"retry_on_failure"`
+
+	// S3Uploader defines the bucket objects are uploaded to.
+	S3Uploader S3UploaderConfig `mapstructure:"s3uploader"`
+
+	// MarshalerName is the encoding of the objects: `otlp_json`, `otlp_proto` or `parquet`.
+	MarshalerName string `mapstructure:"marshaler"`
+
+	// Compression is the algorithm objects are compressed with, `gzip` or `zstd`. Parquet
+	// objects have their column chunks compressed instead. Objects are not compressed by default.
+	Compression string `mapstructure:"compression"`
+
+	// Partition lays out the objects below S3Prefix by time and resource attributes. Each
+	// batch of telemetry is uploaded to an object of its own, so MaxSize is not supported.
+	Partition partitioner.Config `mapstructure:"partition"`
+}
+
+// S3UploaderConfig defines where and how objects are uploaded.
+type S3UploaderConfig struct {
+	// Region is the AWS region of the bucket.
+	Region string `mapstructure:"region"`
+	// S3Bucket is the name of the bucket.
+	S3Bucket string `mapstructure:"s3_bucket"`
+	// S3Prefix is the key prefix of the uploaded objects.
+	S3Prefix string `mapstructure:"s3_prefix"`
+	// Endpoint overrides the S3 endpoint, e.g. for S3 compatible object stores.
+	Endpoint string `mapstructure:"endpoint"`
+	// S3ForcePathStyle addresses the bucket in the path of the URL instead of the host name.
+	S3ForcePathStyle bool `mapstructure:"s3_force_path_style"`
+	// RoleARN is the IAM role assumed to upload the objects.
+	RoleARN string `mapstructure:"role_arn"`
+	// SSEKMSKeyID is the ID of the KMS key objects are encrypted with server side (SSE-KMS).
+	// Objects are encrypted with the default encryption of the bucket when empty.
+	SSEKMSKeyID string `mapstructure:"sse_kms_key_id"`
+}
+
+var _ component.ExporterConfig = (*Config)(nil)
+
+// Validate checks if the exporter configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.S3Uploader.S3Bucket == "" {
+		return errors.New("s3uploader.s3_bucket must be non-empty")
+	}
+	if cfg.S3Uploader.Region == "" {
+		return errors.New("s3uploader.region must be non-empty")
+	}
+	if _, ok := marshalers[cfg.MarshalerName]; !ok {
+		return errors.New("marshaler must be one of otlp_json, otlp_proto or parquet")
+	}
+	if _, err := partitioner.NewCompressor(cfg.Compression); err != nil {
+		return err
+	}
+	if cfg.Partition.MaxSize != 0 {
+		return errors.New("partition.max_size is not supported, each batch is uploaded to an object of its own")
+	}
+	if err := cfg.Partition.Validate(); err != nil {
+		return err
+	}
+	return cfg.QueueSettings.Validate()
+}
@@ -0,0 +1,127 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awss3exporter
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/partitioner"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id           component.ID
+		expected     component.ExporterConfig
+		errorMessage string
+	}{
+		{
+			id: component.NewIDWithName(typeStr, "minimal"),
+			expected: &Config{
+				ExporterSettings: config.NewExporterSettings(component.NewID(typeStr)),
+				TimeoutSettings:  exporterhelper.NewDefaultTimeoutSettings(),
+				QueueSettings:    exporterhelper.NewDefaultQueueSettings(),
+				RetrySettings:    exporterhelper.NewDefaultRetrySettings(),
+				S3Uploader: S3UploaderConfig{
+					Region:   defaultRegion,
+					S3Bucket: "telemetry",
+				},
+				MarshalerName: marshalerOTLPJSON,
+				Partition: partitioner.Config{
+					TimeGranularity: partitioner.GranularityHour,
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(typeStr, "full"),
+			expected: &Config{
+				ExporterSettings: config.NewExporterSettings(component.NewID(typeStr)),
+				TimeoutSettings:  exporterhelper.TimeoutSettings{Timeout: 30 * time.Second},
+				QueueSettings:    exporterhelper.NewDefaultQueueSettings(),
+				RetrySettings:    exporterhelper.NewDefaultRetrySettings(),
+				S3Uploader: S3UploaderConfig{
+					Region:           "eu-west-1",
+					S3Bucket:         "telemetry",
+					S3Prefix:         "otel",
+					Endpoint:         "http://localhost:9000",
+					S3ForcePathStyle: true,
+					RoleARN:          "arn:aws:iam::123456789012:role/otel-s3-writer",
+					SSEKMSKeyID:      "arn:aws:kms:eu-west-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+				},
+				MarshalerName: marshalerParquet,
+				Compression:   partitioner.CompressionZstd,
+				Partition: partitioner.Config{
+					TimeGranularity:    partitioner.GranularityDay,
+					ResourceAttributes: []string{"service.name"},
+				},
+			},
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "marshaler_error"),
+			errorMessage: "marshaler must be one of otlp_json, otlp_proto or parquet",
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "compression_error"),
+			errorMessage: `unsupported compression "snappy"`,
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "max_size_error"),
+			errorMessage: "partition.max_size is not supported, each batch is uploaded to an object of its own",
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "partition_error"),
+			errorMessage: `unsupported time_granularity "week"`,
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "region_error"),
+			errorMessage: "s3uploader.region must be non-empty",
+		},
+		{
+			id:           component.NewIDWithName(typeStr, ""),
+			errorMessage: "s3uploader.s3_bucket must be non-empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalExporterConfig(sub, cfg))
+
+			if tt.expected == nil {
+				assert.EqualError(t, cfg.Validate(), tt.errorMessage)
+				return
+			}
+			assert.NoError(t, cfg.Validate())
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awss3exporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awss3exporter"
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager/s3manageriface"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/partitioner"
+)
+
+// s3Exporter uploads each batch of telemetry to objects, one per partition.
+type s3Exporter struct {
+	config      *Config
+	logger      *zap.Logger
+	uploader    s3manageriface.UploaderAPI
+	partitioner *partitioner.Partitioner
+
+	marshaler   marshaler
+	compressor  partitioner.Compressor
+	extension   string
+	contentType string
+}
+
+func newS3Exporter(cfg *Config, logger *zap.Logger) (*s3Exporter, error) {
+	m, compressor, extension, err := newMarshaler(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &s3Exporter{
+		config:      cfg,
+		logger:      logger,
+		partitioner: partitioner.New(cfg.Partition),
+		marshaler:   m,
+		compressor:  compressor,
+		extension:   extension,
+		contentType: marshalers[cfg.MarshalerName].contentType,
+	}, nil
+}
+
+func (e *s3Exporter) start(context.Context, component.Host) error {
+	awsConfig := &aws.Config{
+		Region:           aws.String(e.config.S3Uploader.Region),
+		S3ForcePathStyle: aws.Bool(e.config.S3Uploader.S3ForcePathStyle),
+	}
+	if e.config.S3Uploader.Endpoint != "" {
+		awsConfig.Endpoint = aws.String(e.config.S3Uploader.Endpoint)
+	}
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return fmt.Errorf("failed to create AWS session: %w", err)
+	}
+	if e.config.S3Uploader.RoleARN != "" {
+		sess.Config.Credentials = stscreds.NewCredentials(sess, e.config.S3Uploader.RoleARN)
+	}
+	e.uploader = s3manager.NewUploader(sess)
+	return nil
+}
+
+func (e *s3Exporter) consumeTraces(ctx context.Context, td ptrace.Traces) error {
+	now := time.Now()
+	for prefix, part := range e.partitioner.SplitTraces(td, now) {
+		buf, err := e.marshaler.MarshalTraces(part)
+		if err != nil {
+			return consumererror.NewPermanent(err)
+		}
+		if err = e.upload(ctx, prefix, "traces", buf, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *s3Exporter) consumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	now := time.Now()
+	for prefix, part := range e.partitioner.SplitMetrics(md, now) {
+		buf, err := e.marshaler.MarshalMetrics(part)
+		if err != nil {
+			return consumererror.NewPermanent(err)
+		}
+		if err = e.upload(ctx, prefix, "metrics", buf, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *s3Exporter) consumeLogs(ctx context.Context, ld plog.Logs) error {
+	now := time.Now()
+	for prefix, part := range e.partitioner.SplitLogs(ld, now) {
+		buf, err := e.marshaler.MarshalLogs(part)
+		if err != nil {
+			return consumererror.NewPermanent(err)
+		}
+		if err = e.upload(ctx, prefix, "logs", buf, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// upload compresses buf and uploads it to a new object of the given signal below the
+// partition prefix, e.g. `<s3_prefix>/year=2022/month=11/day=15/hour=09/traces-<time>-<uuid>.json.gz`.
+func (e *s3Exporter) upload(ctx context.Context, prefix, signal string, buf []byte, now time.Time) error {
+	if len(buf) == 0 {
+		return nil
+	}
+	key := path.Join(e.config.S3Uploader.S3Prefix, prefix, fmt.Sprintf("%s-%d-%s%s", signal, now.UnixNano(), uuid.NewString(), e.extension))
+	input := &s3manager.UploadInput{
+		Bucket:      aws.String(e.config.S3Uploader.S3Bucket),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(e.compressor(buf)),
+		ContentType: aws.String(e.contentType),
+	}
+	if e.config.S3Uploader.SSEKMSKeyID != "" {
+		input.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
+		input.SSEKMSKeyId = aws.String(e.config.S3Uploader.SSEKMSKeyID)
+	}
+	if _, err := e.uploader.UploadWithContext(ctx, input); err != nil {
+		return fmt.Errorf("failed to upload %q: %w", key, err)
+	}
+	e.logger.Debug("Uploaded object", zap.String("key", key), zap.Int("size", len(buf)))
+	return nil
+}
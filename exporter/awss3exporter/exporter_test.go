@@ -0,0 +1,191 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awss3exporter
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"regexp"
+	"sort"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/partitioner"
+)
+
+// fakeUploader records the uploaded objects instead of sending them to S3.
+type fakeUploader struct {
+	inputs []*s3manager.UploadInput
+	bodies [][]byte
+	err    error
+}
+
+func (u *fakeUploader) Upload(input *s3manager.UploadInput, opts ...func(*s3manager.Uploader)) (*s3manager.UploadOutput, error) {
+	return u.UploadWithContext(context.Background(), input, opts...)
+}
+
+func (u *fakeUploader) UploadWithContext(_ aws.Context, input *s3manager.UploadInput, _ ...func(*s3manager.Uploader)) (*s3manager.UploadOutput, error) {
+	if u.err != nil {
+		return nil, u.err
+	}
+	body, err := io.ReadAll(input.Body)
+	if err != nil {
+		return nil, err
+	}
+	u.inputs = append(u.inputs, input)
+	u.bodies = append(u.bodies, body)
+	return &s3manager.UploadOutput{}, nil
+}
+
+func newTestExporter(t *testing.T, cfg *Config) (*s3Exporter, *fakeUploader) {
+	exp, err := newS3Exporter(cfg, zap.NewNop())
+	require.NoError(t, err)
+	uploader := &fakeUploader{}
+	exp.uploader = uploader
+	return exp, uploader
+}
+
+func testConfig() *Config {
+	cfg := createDefaultConfig().(*Config)
+	cfg.S3Uploader.S3Bucket = "telemetry"
+	cfg.S3Uploader.S3Prefix = "otel"
+	return cfg
+}
+
+func testTraces() ptrace.Traces {
+	td := ptrace.NewTraces()
+	for _, service := range []string{"checkout", "cart"} {
+		rs := td.ResourceSpans().AppendEmpty()
+		rs.Resource().Attributes().PutStr("service.name", service)
+		rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("GET /" + service)
+	}
+	return td
+}
+
+func TestConsumeTraces(t *testing.T) {
+	cfg := testConfig()
+	cfg.Partition.ResourceAttributes = []string{"service.name"}
+	exp, uploader := newTestExporter(t, cfg)
+
+	require.NoError(t, exp.consumeTraces(context.Background(), testTraces()))
+	require.Len(t, uploader.inputs, 2)
+
+	keys := make([]string, 0, len(uploader.inputs))
+	for i, input := range uploader.inputs {
+		assert.Equal(t, "telemetry", aws.StringValue(input.Bucket))
+		assert.Equal(t, "application/json", aws.StringValue(input.ContentType))
+		assert.Nil(t, input.ServerSideEncryption)
+		keys = append(keys, aws.StringValue(input.Key))
+
+		td, err := (&ptrace.JSONUnmarshaler{}).UnmarshalTraces(uploader.bodies[i])
+		require.NoError(t, err)
+		assert.Equal(t, 1, td.SpanCount())
+	}
+	sort.Strings(keys)
+	assert.Regexp(t, regexp.MustCompile(`^otel/service.name=cart/year=\d{4}/month=\d{2}/day=\d{2}/hour=\d{2}/traces-\d+-[0-9a-f-]{36}\.json$`), keys[0])
+	assert.Regexp(t, regexp.MustCompile(`^otel/service.name=checkout/year=\d{4}/month=\d{2}/day=\d{2}/hour=\d{2}/traces-\d+-[0-9a-f-]{36}\.json$`), keys[1])
+}
+
+func TestConsumeMetricsCompressed(t *testing.T) {
+	cfg := testConfig()
+	cfg.MarshalerName = marshalerOTLPProto
+	cfg.Compression = partitioner.CompressionGzip
+	cfg.Partition = partitioner.Config{}
+	exp, uploader := newTestExporter(t, cfg)
+
+	md := pmetric.NewMetrics()
+	md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName("requests")
+	require.NoError(t, exp.consumeMetrics(context.Background(), md))
+	require.Len(t, uploader.inputs, 1)
+
+	assert.Regexp(t, regexp.MustCompile(`^otel/metrics-\d+-[0-9a-f-]{36}\.pb\.gz$`), aws.StringValue(uploader.inputs[0].Key))
+	assert.Equal(t, "application/x-protobuf", aws.StringValue(uploader.inputs[0].ContentType))
+
+	r, err := gzip.NewReader(bytes.NewReader(uploader.bodies[0]))
+	require.NoError(t, err)
+	buf, err := io.ReadAll(r)
+	require.NoError(t, err)
+	got, err := (&pmetric.ProtoUnmarshaler{}).UnmarshalMetrics(buf)
+	require.NoError(t, err)
+	assert.Equal(t, md, got)
+}
+
+func TestConsumeLogsParquet(t *testing.T) {
+	cfg := testConfig()
+	cfg.MarshalerName = marshalerParquet
+	cfg.Compression = partitioner.CompressionGzip
+	cfg.S3Uploader.SSEKMSKeyID = "alias/otel"
+	exp, uploader := newTestExporter(t, cfg)
+
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("checkout failed")
+	require.NoError(t, exp.consumeLogs(context.Background(), ld))
+	require.Len(t, uploader.inputs, 1)
+
+	input := uploader.inputs[0]
+	assert.Regexp(t, regexp.MustCompile(`^otel/year=\d{4}/month=\d{2}/day=\d{2}/hour=\d{2}/logs-\d+-[0-9a-f-]{36}\.parquet$`), aws.StringValue(input.Key))
+	assert.Equal(t, "application/vnd.apache.parquet", aws.StringValue(input.ContentType))
+	assert.Equal(t, "aws:kms", aws.StringValue(input.ServerSideEncryption))
+	assert.Equal(t, "alias/otel", aws.StringValue(input.SSEKMSKeyId))
+
+	// column chunks are compressed, the file itself is not
+	body := uploader.bodies[0]
+	assert.True(t, bytes.HasPrefix(body, []byte("PAR1")))
+	assert.True(t, bytes.HasSuffix(body, []byte("PAR1")))
+}
+
+func TestConsumeEmpty(t *testing.T) {
+	exp, uploader := newTestExporter(t, testConfig())
+	require.NoError(t, exp.consumeTraces(context.Background(), ptrace.NewTraces()))
+	require.NoError(t, exp.consumeLogs(context.Background(), plog.NewLogs()))
+	assert.Empty(t, uploader.inputs)
+}
+
+func TestUploadError(t *testing.T) {
+	exp, uploader := newTestExporter(t, testConfig())
+	uploader.err = errors.New("access denied")
+
+	err := exp.consumeTraces(context.Background(), testTraces())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "access denied")
+	// failed uploads are retried
+	assert.False(t, consumererror.IsPermanent(err))
+}
+
+func TestStart(t *testing.T) {
+	cfg := testConfig()
+	cfg.S3Uploader.Endpoint = "http://localhost:9000"
+	cfg.S3Uploader.S3ForcePathStyle = true
+	cfg.S3Uploader.RoleARN = "arn:aws:iam::123456789012:role/otel-s3-writer"
+	exp, err := newS3Exporter(cfg, zap.NewNop())
+	require.NoError(t, err)
+
+	require.NoError(t, exp.start(context.Background(), nil))
+	uploader, ok := exp.uploader.(*s3manager.Uploader)
+	require.True(t, ok)
+	assert.NotNil(t, uploader.S3)
+}
@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awss3exporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awss3exporter"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/partitioner"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/partitioner/parquet"
+)
+
+const (
+	marshalerOTLPJSON  = "otlp_json"
+	marshalerOTLPProto = "otlp_proto"
+	marshalerParquet   = "parquet"
+)
+
+// marshaler encodes telemetry of all signals into objects.
+type marshaler interface {
+	ptrace.Marshaler
+	pmetric.Marshaler
+	plog.Marshaler
+}
+
+// otlpMarshaler combines the marshalers of an OTLP encoding.
+type otlpMarshaler struct {
+	traces  ptrace.Marshaler
+	metrics pmetric.Marshaler
+	logs    plog.Marshaler
+}
+
+func (m otlpMarshaler) MarshalTraces(td ptrace.Traces) ([]byte, error) {
+	return m.traces.MarshalTraces(td)
+}
+
+func (m otlpMarshaler) MarshalMetrics(md pmetric.Metrics) ([]byte, error) {
+	return m.metrics.MarshalMetrics(md)
+}
+
+func (m otlpMarshaler) MarshalLogs(ld plog.Logs) ([]byte, error) {
+	return m.logs.MarshalLogs(ld)
+}
+
+// objectFormat describes the objects of a marshaler.
+type objectFormat struct {
+	extension   string
+	contentType string
+}
+
+var marshalers = map[string]objectFormat{
+	marshalerOTLPJSON:  {extension: ".json", contentType: "application/json"},
+	marshalerOTLPProto: {extension: ".pb", contentType: "application/x-protobuf"},
+	marshalerParquet:   {extension: ".parquet", contentType: "application/vnd.apache.parquet"},
+}
+
+// newMarshaler returns the marshaler of a valid config, along with the compressor applied to
+// the objects and their extension, e.g. `.json.gz`.
+func newMarshaler(cfg *Config) (marshaler, partitioner.Compressor, string, error) {
+	format := marshalers[cfg.MarshalerName]
+	compressor, err := partitioner.NewCompressor(cfg.Compression)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	switch cfg.MarshalerName {
+	case marshalerOTLPJSON:
+		return otlpMarshaler{&ptrace.JSONMarshaler{}, &pmetric.JSONMarshaler{}, &plog.JSONMarshaler{}},
+			compressor, format.extension + partitioner.Extension(cfg.Compression), nil
+	case marshalerOTLPProto:
+		return otlpMarshaler{&ptrace.ProtoMarshaler{}, &pmetric.ProtoMarshaler{}, &plog.ProtoMarshaler{}},
+			compressor, format.extension + partitioner.Extension(cfg.Compression), nil
+	case marshalerParquet:
+		// Parquet files compress their column chunks, and remain uncompressed as a whole
+		m, err := parquet.NewMarshaler(cfg.Compression)
+		if err != nil {
+			return nil, nil, "", err
+		}
+		return m, func(src []byte) []byte { return src }, format.extension, nil
+	}
+	return nil, nil, "", fmt.Errorf("unsupported marshaler %q", cfg.MarshalerName)
+}
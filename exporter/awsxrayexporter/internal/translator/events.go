@@ -0,0 +1,144 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package translator // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awsxrayexporter/internal/translator"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+)
+
+// Metadata keys of the span events and links, stored in the default metadata namespace.
+const (
+	spanEventsMetadataKey        = "otel.span.events"
+	droppedSpanEventsMetadataKey = "otel.span.events.dropped"
+	spanLinksMetadataKey         = "otel.span.links"
+	droppedSpanLinksMetadataKey  = "otel.span.links.dropped"
+)
+
+const (
+	// maxSpanEvents is the maximum number of events of a span stored in the segment metadata.
+	maxSpanEvents = 32
+	// maxSpanLinks is the maximum number of links of a span stored in the segment metadata.
+	maxSpanLinks = 32
+	// maxMetadataStringLength is the length string attribute values of events and links are truncated to,
+	// keeping segment documents well below the X-Ray size limit.
+	maxMetadataStringLength = 1024
+)
+
+// addSpanEventsAndLinks stores the events and links of span in the default namespace of metadata,
+// along with the number of events and links which were dropped, either by the SDK or because of the
+// size limits. The exception events are stored without their stack trace, which is part of the cause.
+func addSpanEventsAndLinks(span ptrace.Span, metadata map[string]map[string]interface{}) map[string]map[string]interface{} {
+	events, droppedEvents := makeSpanEvents(span)
+	links, droppedLinks := makeSpanLinks(span)
+	if len(events) == 0 && droppedEvents == 0 && len(links) == 0 && droppedLinks == 0 {
+		return metadata
+	}
+
+	if metadata == nil {
+		metadata = map[string]map[string]interface{}{}
+	}
+	defaultMetadata, ok := metadata["default"]
+	if !ok {
+		defaultMetadata = map[string]interface{}{}
+		metadata["default"] = defaultMetadata
+	}
+	if len(events) > 0 {
+		defaultMetadata[spanEventsMetadataKey] = events
+	}
+	if droppedEvents > 0 {
+		defaultMetadata[droppedSpanEventsMetadataKey] = droppedEvents
+	}
+	if len(links) > 0 {
+		defaultMetadata[spanLinksMetadataKey] = links
+	}
+	if droppedLinks > 0 {
+		defaultMetadata[droppedSpanLinksMetadataKey] = droppedLinks
+	}
+	return metadata
+}
+
+func makeSpanEvents(span ptrace.Span) ([]interface{}, int64) {
+	spanEvents := span.Events()
+	dropped := int64(span.DroppedEventsCount())
+	var events []interface{}
+	for i := 0; i < spanEvents.Len(); i++ {
+		if len(events) == maxSpanEvents {
+			dropped += int64(spanEvents.Len() - i)
+			break
+		}
+		event := spanEvents.At(i)
+		converted := map[string]interface{}{
+			"name":      event.Name(),
+			"timestamp": timestampToFloatSeconds(event.Timestamp()),
+		}
+		attributes := makeMetadataAttributes(event.Attributes(), func(key string) bool {
+			return event.Name() == ExceptionEventName && key == conventions.AttributeExceptionStacktrace
+		})
+		if len(attributes) > 0 {
+			converted["attributes"] = attributes
+		}
+		events = append(events, converted)
+	}
+	return events, dropped
+}
+
+func makeSpanLinks(span ptrace.Span) ([]interface{}, int64) {
+	spanLinks := span.Links()
+	dropped := int64(span.DroppedLinksCount())
+	var links []interface{}
+	for i := 0; i < spanLinks.Len(); i++ {
+		if len(links) == maxSpanLinks {
+			dropped += int64(spanLinks.Len() - i)
+			break
+		}
+		link := spanLinks.At(i)
+		// Links to traces started outside the X-Ray time range keep their OpenTelemetry trace ID.
+		traceID, err := convertToAmazonTraceID(link.TraceID())
+		if err != nil {
+			traceID = link.TraceID().HexString()
+		}
+		converted := map[string]interface{}{
+			"trace_id": traceID,
+			"id":       link.SpanID().HexString(),
+		}
+		if attributes := makeMetadataAttributes(link.Attributes(), nil); len(attributes) > 0 {
+			converted["attributes"] = attributes
+		}
+		links = append(links, converted)
+	}
+	return links, dropped
+}
+
+// makeMetadataAttributes converts attrs to metadata values, truncating long strings. The attributes
+// matching skip are left out.
+func makeMetadataAttributes(attrs pcommon.Map, skip func(key string) bool) map[string]interface{} {
+	converted := make(map[string]interface{}, attrs.Len())
+	attrs.Range(func(key string, value pcommon.Value) bool {
+		if skip != nil && skip(key) {
+			return true
+		}
+		if value.Type() == pcommon.ValueTypeStr && len(value.Str()) > maxMetadataStringLength {
+			converted[key] = value.Str()[:maxMetadataStringLength]
+			return true
+		}
+		if metaVal := metadataValue(value); metaVal != nil {
+			converted[key] = metaVal
+		}
+		return true
+	})
+	return converted
+}
@@ -0,0 +1,130 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package translator
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+)
+
+func TestSpanEventsMetadata(t *testing.T) {
+	span := constructServerSpan(newSegmentID(), "/api/locations", ptrace.StatusCodeError, "", nil)
+	span.SetDroppedEventsCount(2)
+	timestamp := pcommon.NewTimestampFromTime(time.Unix(1668500000, 0))
+
+	event := span.Events().AppendEmpty()
+	event.SetName("cache.miss")
+	event.SetTimestamp(timestamp)
+	event.Attributes().PutStr("cache.key", "locations")
+	event.Attributes().PutStr("cache.value", strings.Repeat("x", 2*maxMetadataStringLength))
+	event.Attributes().PutInt("cache.size", 12)
+
+	exception := span.Events().AppendEmpty()
+	exception.SetName(ExceptionEventName)
+	exception.SetTimestamp(timestamp)
+	exception.Attributes().PutStr(conventions.AttributeExceptionType, "java.lang.IllegalStateException")
+	exception.Attributes().PutStr(conventions.AttributeExceptionStacktrace, "java.lang.IllegalStateException: state is not legal")
+	exception.Attributes().PutBool(conventions.AttributeExceptionEscaped, true)
+
+	segment, err := MakeSegment(span, pcommon.NewResource(), nil, false)
+	require.NoError(t, err)
+
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{
+			"name":      "cache.miss",
+			"timestamp": float64(1668500000),
+			"attributes": map[string]interface{}{
+				"cache.key":   "locations",
+				"cache.value": strings.Repeat("x", maxMetadataStringLength),
+				"cache.size":  int64(12),
+			},
+		},
+		map[string]interface{}{
+			"name":      ExceptionEventName,
+			"timestamp": float64(1668500000),
+			"attributes": map[string]interface{}{
+				conventions.AttributeExceptionType:    "java.lang.IllegalStateException",
+				conventions.AttributeExceptionEscaped: true,
+			},
+		},
+	}, segment.Metadata["default"][spanEventsMetadataKey])
+	assert.Equal(t, int64(2), segment.Metadata["default"][droppedSpanEventsMetadataKey])
+	assert.Len(t, segment.Cause.Exceptions, 1)
+}
+
+func TestSpanEventsMetadataLimit(t *testing.T) {
+	span := constructServerSpan(newSegmentID(), "/api/locations", ptrace.StatusCodeOk, "", nil)
+	for i := 0; i < maxSpanEvents+3; i++ {
+		span.Events().AppendEmpty().SetName("retry")
+	}
+
+	segment, err := MakeSegment(span, pcommon.NewResource(), nil, false)
+	require.NoError(t, err)
+	assert.Len(t, segment.Metadata["default"][spanEventsMetadataKey], maxSpanEvents)
+	assert.Equal(t, int64(3), segment.Metadata["default"][droppedSpanEventsMetadataKey])
+}
+
+func TestSpanLinksMetadata(t *testing.T) {
+	span := constructServerSpan(newSegmentID(), "/api/locations", ptrace.StatusCodeOk, "", nil)
+	span.SetDroppedLinksCount(1)
+
+	xrayTraceID := newTraceID()
+	link := span.Links().AppendEmpty()
+	link.SetTraceID(xrayTraceID)
+	link.SetSpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8})
+	link.Attributes().PutStr("messaging.operation", "process")
+
+	otelTraceID := pcommon.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	otelLink := span.Links().AppendEmpty()
+	otelLink.SetTraceID(otelTraceID)
+	otelLink.SetSpanID([8]byte{8, 7, 6, 5, 4, 3, 2, 1})
+
+	for i := 0; i < maxSpanLinks; i++ {
+		span.Links().AppendEmpty().SetTraceID(otelTraceID)
+	}
+
+	segment, err := MakeSegment(span, pcommon.NewResource(), nil, false)
+	require.NoError(t, err)
+
+	expectedTraceID, err := convertToAmazonTraceID(xrayTraceID)
+	require.NoError(t, err)
+	links := segment.Metadata["default"][spanLinksMetadataKey].([]interface{})
+	require.Len(t, links, maxSpanLinks)
+	assert.Equal(t, map[string]interface{}{
+		"trace_id":   expectedTraceID,
+		"id":         "0102030405060708",
+		"attributes": map[string]interface{}{"messaging.operation": "process"},
+	}, links[0])
+	assert.Equal(t, map[string]interface{}{
+		"trace_id": "0102030405060708090a0b0c0d0e0f10",
+		"id":       "0807060504030201",
+	}, links[1])
+	assert.Equal(t, int64(3), segment.Metadata["default"][droppedSpanLinksMetadataKey])
+}
+
+func TestSpanWithoutEventsOrLinks(t *testing.T) {
+	span := constructServerSpan(newSegmentID(), "/api/locations", ptrace.StatusCodeOk, "", nil)
+
+	segment, err := MakeSegment(span, pcommon.NewResource(), nil, false)
+	require.NoError(t, err)
+	assert.Nil(t, segment.Metadata)
+}
@@ -112,6 +112,8 @@ func MakeSegment(span ptrace.Span, resource pcommon.Resource, indexedAttrs []str
 		namespace                                          string
 	)
 
+	metadata = addSpanEventsAndLinks(span, metadata)
+
 	// X-Ray segment names are service names, unlike span names which are methods. Try to find a service name.
 
 	attributes := span.Attributes()
@@ -0,0 +1,150 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azureblobexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/azureblobexporter"
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/streaming"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/go-autorest/autorest/adal"
+)
+
+const (
+	// maxAppendBlockSize is the largest block the service accepts in an append.
+	maxAppendBlockSize = 4 * 1024 * 1024
+
+	storageResource = "https://storage.azure.com/"
+)
+
+type blobClient interface {
+	// uploadBlockBlob uploads data to a new block blob, replacing an existing one.
+	uploadBlockBlob(ctx context.Context, container, name, contentType string, data []byte) error
+	// appendBlob appends data to an append blob, creating it if it does not exist.
+	appendBlob(ctx context.Context, container, name, contentType string, data []byte) error
+}
+
+type azureBlobClient struct {
+	serviceClient azblob.ServiceClient
+}
+
+var _ blobClient = (*azureBlobClient)(nil)
+
+func newBlobClient(cfg *Config) (*azureBlobClient, error) {
+	// retries are handled by the retry_on_failure settings of the exporter
+	options := &azblob.ClientOptions{Retry: policy.RetryOptions{MaxRetries: -1}}
+
+	var serviceClient azblob.ServiceClient
+	var err error
+	switch cfg.Auth.Type {
+	case authManagedIdentity:
+		serviceClient, err = azblob.NewServiceClient(cfg.URL, &managedIdentityCredential{clientID: cfg.Auth.ClientID}, options)
+	default:
+		serviceClient, err = azblob.NewServiceClientFromConnectionString(cfg.Auth.ConnectionString, options)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &azureBlobClient{serviceClient: serviceClient}, nil
+}
+
+func (bc *azureBlobClient) uploadBlockBlob(ctx context.Context, container, name, contentType string, data []byte) error {
+	blockBlob := bc.serviceClient.NewContainerClient(container).NewBlockBlobClient(name)
+	_, err := blockBlob.Upload(ctx, streaming.NopCloser(bytes.NewReader(data)), &azblob.UploadBlockBlobOptions{
+		HTTPHeaders: &azblob.BlobHTTPHeaders{BlobContentType: &contentType},
+	})
+	return err
+}
+
+func (bc *azureBlobClient) appendBlob(ctx context.Context, container, name, contentType string, data []byte) error {
+	appendBlob := bc.serviceClient.NewContainerClient(container).NewAppendBlobClient(name)
+	for len(data) > 0 {
+		block := data
+		if len(block) > maxAppendBlockSize {
+			block = block[:maxAppendBlockSize]
+		}
+		_, err := appendBlob.AppendBlock(ctx, streaming.NopCloser(bytes.NewReader(block)), nil)
+		if isStorageError(err, azblob.StorageErrorCodeBlobNotFound) {
+			if err = bc.createAppendBlob(ctx, appendBlob, contentType); err != nil {
+				return err
+			}
+			_, err = appendBlob.AppendBlock(ctx, streaming.NopCloser(bytes.NewReader(block)), nil)
+		}
+		if err != nil {
+			return err
+		}
+		data = data[len(block):]
+	}
+	return nil
+}
+
+// createAppendBlob creates an empty append blob, unless another writer created it first.
+func (bc *azureBlobClient) createAppendBlob(ctx context.Context, appendBlob azblob.AppendBlobClient, contentType string) error {
+	ifNoneMatch := "*"
+	_, err := appendBlob.Create(ctx, &azblob.CreateAppendBlobOptions{
+		HTTPHeaders: &azblob.BlobHTTPHeaders{BlobContentType: &contentType},
+		BlobAccessConditions: &azblob.BlobAccessConditions{
+			ModifiedAccessConditions: &azblob.ModifiedAccessConditions{IfNoneMatch: &ifNoneMatch},
+		},
+	})
+	if isStorageError(err, azblob.StorageErrorCodeBlobAlreadyExists) {
+		return nil
+	}
+	return err
+}
+
+func isStorageError(err error, code azblob.StorageErrorCode) bool {
+	var storageErr *azblob.StorageError
+	return errors.As(err, &storageErr) && storageErr.ErrorCode == code
+}
+
+// managedIdentityCredential authenticates with the managed identity of the Azure
+// resource the collector runs on.
+type managedIdentityCredential struct {
+	clientID string
+
+	mu    sync.Mutex
+	token *adal.ServicePrincipalToken
+}
+
+var _ azcore.TokenCredential = (*managedIdentityCredential)(nil)
+
+func (c *managedIdentityCredential) GetToken(ctx context.Context, options policy.TokenRequestOptions) (*azcore.AccessToken, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.token == nil {
+		resource := storageResource
+		if len(options.Scopes) > 0 {
+			resource = strings.TrimSuffix(options.Scopes[0], ".default")
+		}
+		token, err := adal.NewServicePrincipalTokenFromManagedIdentity(resource, &adal.ManagedIdentityOptions{ClientID: c.clientID})
+		if err != nil {
+			return nil, err
+		}
+		c.token = token
+	}
+	if err := c.token.EnsureFreshWithContext(ctx); err != nil {
+		return nil, err
+	}
+	token := c.token.Token()
+	return &azcore.AccessToken{Token: token.AccessToken, ExpiresOn: token.Expires()}, nil
+}
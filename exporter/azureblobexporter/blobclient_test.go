@@ -0,0 +1,127 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azureblobexporter
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testConnectionString = "DefaultEndpointsProtocol=https;AccountName=accountName;AccountKey=+idLkHYcL0MUWIKYHm2j4Q==;EndpointSuffix=core.windows.net"
+
+// fakeBlobService implements the blob service operations used by the exporter.
+type fakeBlobService struct {
+	mu           sync.Mutex
+	blobs        map[string][]byte
+	contentTypes map[string]string
+	appendBlocks int
+}
+
+func (s *fakeBlobService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r.Method != http.MethodPut {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	// the connection string addresses the account in the path
+	name := strings.TrimPrefix(r.URL.Path, "/devstoreaccount1/")
+	body, _ := io.ReadAll(r.Body)
+
+	switch {
+	case r.URL.Query().Get("comp") == "appendblock":
+		if _, ok := s.blobs[name]; !ok {
+			w.Header().Set("x-ms-error-code", "BlobNotFound")
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		s.appendBlocks++
+		s.blobs[name] = append(s.blobs[name], body...)
+	case r.Header.Get("x-ms-blob-type") == "AppendBlob":
+		if _, ok := s.blobs[name]; ok && r.Header.Get("If-None-Match") == "*" {
+			w.Header().Set("x-ms-error-code", "BlobAlreadyExists")
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		s.blobs[name] = []byte{}
+		s.contentTypes[name] = r.Header.Get("x-ms-blob-content-type")
+	case r.Header.Get("x-ms-blob-type") == "BlockBlob":
+		s.blobs[name] = body
+		s.contentTypes[name] = r.Header.Get("x-ms-blob-content-type")
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+}
+
+func newTestBlobClient(t *testing.T) (*azureBlobClient, *fakeBlobService) {
+	service := &fakeBlobService{blobs: map[string][]byte{}, contentTypes: map[string]string{}}
+	server := httptest.NewServer(service)
+	t.Cleanup(server.Close)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Auth.ConnectionString = "DefaultEndpointsProtocol=http;AccountName=devstoreaccount1;" +
+		"AccountKey=Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw==;" +
+		"BlobEndpoint=" + server.URL + "/devstoreaccount1;"
+	client, err := newBlobClient(cfg)
+	require.NoError(t, err)
+	return client, service
+}
+
+func TestUploadBlockBlob(t *testing.T) {
+	client, service := newTestBlobClient(t)
+
+	require.NoError(t, client.uploadBlockBlob(context.Background(), "traces", "year=2022/traces-1.json", "application/json", []byte(`{"resourceSpans":[]}`)))
+	assert.Equal(t, []byte(`{"resourceSpans":[]}`), service.blobs["traces/year=2022/traces-1.json"])
+	assert.Equal(t, "application/json", service.contentTypes["traces/year=2022/traces-1.json"])
+}
+
+func TestAppendBlob(t *testing.T) {
+	client, service := newTestBlobClient(t)
+
+	// the blob is created by the first append
+	require.NoError(t, client.appendBlob(context.Background(), "logs", "logs-0.json", "application/json", []byte("first\n")))
+	require.NoError(t, client.appendBlob(context.Background(), "logs", "logs-0.json", "application/json", []byte("second\n")))
+	assert.Equal(t, "first\nsecond\n", string(service.blobs["logs/logs-0.json"]))
+	assert.Equal(t, "application/json", service.contentTypes["logs/logs-0.json"])
+	assert.Equal(t, 2, service.appendBlocks)
+
+	// data larger than a block is appended in several blocks
+	large := []byte(strings.Repeat("a", maxAppendBlockSize+1))
+	require.NoError(t, client.appendBlob(context.Background(), "logs", "logs-1.json", "application/json", large))
+	assert.Equal(t, large, service.blobs["logs/logs-1.json"])
+	assert.Equal(t, 4, service.appendBlocks)
+}
+
+func TestAppendBlobCreatedConcurrently(t *testing.T) {
+	client, service := newTestBlobClient(t)
+	appendBlob := client.serviceClient.NewContainerClient("logs").NewAppendBlobClient("logs-0.json")
+
+	require.NoError(t, client.createAppendBlob(context.Background(), appendBlob, "application/json"))
+	service.blobs["logs/logs-0.json"] = []byte("first\n")
+	// creating a blob created by another writer keeps its content
+	require.NoError(t, client.createAppendBlob(context.Background(), appendBlob, "application/json"))
+	assert.Equal(t, "first\n", string(service.blobs["logs/logs-0.json"]))
+}
@@ -0,0 +1,135 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azureblobexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/azureblobexporter"
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/partitioner"
+)
+
+const (
+	authConnectionString = "connection_string"
+	authManagedIdentity  = "managed_identity"
+
+	blobTypeBlock  = "block"
+	blobTypeAppend = "append"
+
+	formatTypeJSON  = "json"
+	formatTypeProto = "proto"
+)
+
+// Config defines the configuration of the Azure Blob Storage exporter.
+type Config struct {
+	config.ExporterSettings        `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct.
+	exporterhelper.TimeoutSettings `mapstructure:",squash"`
+	exporterhelper.QueueSettings   `mapstructure:"sending_queue"`
+	exporterhelper.RetrySettings   `mapstructure:"retry_on_failure"`
+
+	// URL is the blob service endpoint of the storage account, e.g.
+	// `https://<account>.blob.core.windows.net`. Required with managed identity authentication.
+	URL string `mapstructure:"url"`
+
+	// Auth defines how the exporter authenticates to the storage account.
+	Auth Authentication `mapstructure:"auth"`
+
+	// Container defines the containers each signal is written to.
+	Container ContainerConfig `mapstructure:"container"`
+
+	// BlobType is `block` to upload each batch to a block blob of its own, or `append`
+	// to append the batches to append blobs.
+	BlobType string `mapstructure:"blob_type"`
+
+	// FormatType is the encoding of the batches, `json` or `proto`. Append blobs only
+	// support `json`, as a blob holds one batch per line.
+	FormatType string `mapstructure:"format"`
+
+	// Partition lays out the blobs in virtual folders by time and resource attributes.
+	// MaxSize rolls append blobs, and is not supported with block blobs.
+	Partition partitioner.Config `mapstructure:"partition"`
+}
+
+// Authentication defines how the exporter authenticates to the storage account.
+type Authentication struct {
+	// Type is `connection_string` or `managed_identity`.
+	Type string `mapstructure:"type"`
+	// ConnectionString is the connection string of the storage account, which can be
+	// found in the Azure Portal.
+	ConnectionString string `mapstructure:"connection_string"`
+	// ClientID is the client ID of a user-assigned managed identity. The system-assigned
+	// managed identity is used when empty.
+	ClientID string `mapstructure:"client_id"`
+}
+
+// ContainerConfig defines the containers each signal is written to.
+type ContainerConfig struct {
+	// Logs is the name of the container logs are written to (default = "logs").
+	Logs string `mapstructure:"logs"`
+	// Metrics is the name of the container metrics are written to (default = "metrics").
+	Metrics string `mapstructure:"metrics"`
+	// Traces is the name of the container traces are written to (default = "traces").
+	Traces string `mapstructure:"traces"`
+}
+
+var _ component.ExporterConfig = (*Config)(nil)
+
+// Validate checks if the exporter configuration is valid.
+func (cfg *Config) Validate() error {
+	switch cfg.Auth.Type {
+	case authConnectionString:
+		if cfg.Auth.ConnectionString == "" {
+			return errors.New("auth.connection_string must be non-empty")
+		}
+	case authManagedIdentity:
+		if cfg.URL == "" {
+			return errors.New("url must be non-empty with managed identity authentication")
+		}
+	default:
+		return fmt.Errorf("unsupported auth.type %q", cfg.Auth.Type)
+	}
+
+	if cfg.Container.Logs == "" || cfg.Container.Metrics == "" || cfg.Container.Traces == "" {
+		return errors.New("container names must be non-empty")
+	}
+
+	switch cfg.FormatType {
+	case formatTypeJSON, formatTypeProto:
+	default:
+		return fmt.Errorf("unsupported format %q", cfg.FormatType)
+	}
+
+	switch cfg.BlobType {
+	case blobTypeBlock:
+		if cfg.Partition.MaxSize != 0 {
+			return errors.New("partition.max_size is only supported with append blobs")
+		}
+	case blobTypeAppend:
+		if cfg.FormatType != formatTypeJSON {
+			return errors.New("append blobs only support the json format")
+		}
+	default:
+		return fmt.Errorf("unsupported blob_type %q", cfg.BlobType)
+	}
+
+	if err := cfg.Partition.Validate(); err != nil {
+		return err
+	}
+	return cfg.QueueSettings.Validate()
+}
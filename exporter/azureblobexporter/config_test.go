@@ -0,0 +1,145 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azureblobexporter
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/partitioner"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	defaultContainers := ContainerConfig{Logs: "logs", Metrics: "metrics", Traces: "traces"}
+
+	tests := []struct {
+		id           component.ID
+		expected     component.ExporterConfig
+		errorMessage string
+	}{
+		{
+			id: component.NewIDWithName(typeStr, "connection_string"),
+			expected: &Config{
+				ExporterSettings: config.NewExporterSettings(component.NewID(typeStr)),
+				TimeoutSettings:  exporterhelper.NewDefaultTimeoutSettings(),
+				QueueSettings:    exporterhelper.NewDefaultQueueSettings(),
+				RetrySettings:    exporterhelper.NewDefaultRetrySettings(),
+				Auth: Authentication{
+					Type:             authConnectionString,
+					ConnectionString: "DefaultEndpointsProtocol=https;AccountName=accountName;AccountKey=+idLkHYcL0MUWIKYHm2j4Q==;EndpointSuffix=core.windows.net",
+				},
+				Container:  defaultContainers,
+				BlobType:   blobTypeBlock,
+				FormatType: formatTypeJSON,
+				Partition: partitioner.Config{
+					TimeGranularity: partitioner.GranularityHour,
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(typeStr, "managed_identity"),
+			expected: &Config{
+				ExporterSettings: config.NewExporterSettings(component.NewID(typeStr)),
+				TimeoutSettings:  exporterhelper.TimeoutSettings{Timeout: 30 * time.Second},
+				QueueSettings:    exporterhelper.NewDefaultQueueSettings(),
+				RetrySettings:    exporterhelper.NewDefaultRetrySettings(),
+				URL:              "https://accountname.blob.core.windows.net",
+				Auth: Authentication{
+					Type:     authManagedIdentity,
+					ClientID: "00000000-0000-0000-0000-000000000000",
+				},
+				Container: ContainerConfig{
+					Logs:    "otel-logs",
+					Metrics: "otel-metrics",
+					Traces:  "otel-traces",
+				},
+				BlobType:   blobTypeAppend,
+				FormatType: formatTypeJSON,
+				Partition: partitioner.Config{
+					TimeGranularity:    partitioner.GranularityDay,
+					ResourceAttributes: []string{"service.name"},
+					MaxSize:            104857600,
+				},
+			},
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "managed_identity_error"),
+			errorMessage: "url must be non-empty with managed identity authentication",
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "auth_error"),
+			errorMessage: `unsupported auth.type "sas_token"`,
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "container_error"),
+			errorMessage: "container names must be non-empty",
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "format_error"),
+			errorMessage: `unsupported format "avro"`,
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "blob_type_error"),
+			errorMessage: `unsupported blob_type "page"`,
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "append_proto_error"),
+			errorMessage: "append blobs only support the json format",
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "block_max_size_error"),
+			errorMessage: "partition.max_size is only supported with append blobs",
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "partition_error"),
+			errorMessage: `unsupported time_granularity "week"`,
+		},
+		{
+			id:           component.NewIDWithName(typeStr, ""),
+			errorMessage: "auth.connection_string must be non-empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalExporterConfig(sub, cfg))
+
+			if tt.expected == nil {
+				assert.EqualError(t, cfg.Validate(), tt.errorMessage)
+				return
+			}
+			assert.NoError(t, cfg.Validate())
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
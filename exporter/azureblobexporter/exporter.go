@@ -0,0 +1,147 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azureblobexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/azureblobexporter"
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/partitioner"
+)
+
+var contentTypes = map[string]string{
+	formatTypeJSON:  "application/json",
+	formatTypeProto: "application/x-protobuf",
+}
+
+// blobExporter writes each batch of telemetry to blobs, one per partition.
+type blobExporter struct {
+	config      *Config
+	logger      *zap.Logger
+	client      blobClient
+	partitioner *partitioner.Partitioner
+
+	tracesMarshaler  ptrace.Marshaler
+	metricsMarshaler pmetric.Marshaler
+	logsMarshaler    plog.Marshaler
+}
+
+func newBlobExporter(cfg *Config, logger *zap.Logger) *blobExporter {
+	exp := &blobExporter{
+		config:      cfg,
+		logger:      logger,
+		partitioner: partitioner.New(cfg.Partition),
+	}
+	if cfg.FormatType == formatTypeProto {
+		exp.tracesMarshaler = &ptrace.ProtoMarshaler{}
+		exp.metricsMarshaler = &pmetric.ProtoMarshaler{}
+		exp.logsMarshaler = &plog.ProtoMarshaler{}
+	} else {
+		exp.tracesMarshaler = &ptrace.JSONMarshaler{}
+		exp.metricsMarshaler = &pmetric.JSONMarshaler{}
+		exp.logsMarshaler = &plog.JSONMarshaler{}
+	}
+	return exp
+}
+
+func (e *blobExporter) start(context.Context, component.Host) error {
+	client, err := newBlobClient(e.config)
+	if err != nil {
+		return fmt.Errorf("failed to create blob client: %w", err)
+	}
+	e.client = client
+	return nil
+}
+
+func (e *blobExporter) consumeTraces(ctx context.Context, td ptrace.Traces) error {
+	now := time.Now()
+	for prefix, part := range e.partitioner.SplitTraces(td, now) {
+		buf, err := e.tracesMarshaler.MarshalTraces(part)
+		if err != nil {
+			return consumererror.NewPermanent(err)
+		}
+		if err = e.write(ctx, e.config.Container.Traces, prefix, "traces", buf, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *blobExporter) consumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	now := time.Now()
+	for prefix, part := range e.partitioner.SplitMetrics(md, now) {
+		buf, err := e.metricsMarshaler.MarshalMetrics(part)
+		if err != nil {
+			return consumererror.NewPermanent(err)
+		}
+		if err = e.write(ctx, e.config.Container.Metrics, prefix, "metrics", buf, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *blobExporter) consumeLogs(ctx context.Context, ld plog.Logs) error {
+	now := time.Now()
+	for prefix, part := range e.partitioner.SplitLogs(ld, now) {
+		buf, err := e.logsMarshaler.MarshalLogs(part)
+		if err != nil {
+			return consumererror.NewPermanent(err)
+		}
+		if err = e.write(ctx, e.config.Container.Logs, prefix, "logs", buf, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// write writes buf to a blob of the given signal in the partition's virtual folder. Block
+// blobs are named after the time of the batch and a random ID, e.g.
+// `year=2022/month=11/day=15/hour=09/traces-<time>-<uuid>.json`, while append blobs are
+// numbered as they reach the maximum size of the partition, e.g.
+// `year=2022/month=11/day=15/hour=09/traces-0.json`.
+func (e *blobExporter) write(ctx context.Context, container, prefix, signal string, buf []byte, now time.Time) error {
+	if len(buf) == 0 {
+		return nil
+	}
+	ext := "." + e.config.FormatType
+	contentType := contentTypes[e.config.FormatType]
+
+	if e.config.BlobType == blobTypeAppend {
+		// each batch is a line of the append blob
+		buf = append(buf, '\n')
+		name := e.partitioner.Object(prefix, signal, ext, len(buf), now)
+		if err := e.client.appendBlob(ctx, container, name, contentType, buf); err != nil {
+			return fmt.Errorf("failed to append to blob %q: %w", name, err)
+		}
+		return nil
+	}
+
+	name := path.Join(prefix, fmt.Sprintf("%s-%d-%s%s", signal, now.UnixNano(), uuid.NewString(), ext))
+	if err := e.client.uploadBlockBlob(ctx, container, name, contentType, buf); err != nil {
+		return fmt.Errorf("failed to upload blob %q: %w", name, err)
+	}
+	return nil
+}
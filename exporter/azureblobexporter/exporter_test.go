@@ -0,0 +1,181 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azureblobexporter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"regexp"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/partitioner"
+)
+
+type blobWrite struct {
+	container   string
+	name        string
+	contentType string
+	data        []byte
+}
+
+// fakeBlobClient records the writes instead of sending them to Azure.
+type fakeBlobClient struct {
+	uploads []blobWrite
+	appends []blobWrite
+	err     error
+}
+
+func (c *fakeBlobClient) uploadBlockBlob(_ context.Context, container, name, contentType string, data []byte) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.uploads = append(c.uploads, blobWrite{container, name, contentType, data})
+	return nil
+}
+
+func (c *fakeBlobClient) appendBlob(_ context.Context, container, name, contentType string, data []byte) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.appends = append(c.appends, blobWrite{container, name, contentType, data})
+	return nil
+}
+
+func newTestExporter(cfg *Config) (*blobExporter, *fakeBlobClient) {
+	exp := newBlobExporter(cfg, zap.NewNop())
+	client := &fakeBlobClient{}
+	exp.client = client
+	return exp, client
+}
+
+func testConfig() *Config {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Auth.ConnectionString = testConnectionString
+	return cfg
+}
+
+func testTraces() ptrace.Traces {
+	td := ptrace.NewTraces()
+	for _, service := range []string{"checkout", "cart"} {
+		rs := td.ResourceSpans().AppendEmpty()
+		rs.Resource().Attributes().PutStr("service.name", service)
+		rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("GET /" + service)
+	}
+	return td
+}
+
+func TestConsumeTracesBlockBlobs(t *testing.T) {
+	cfg := testConfig()
+	cfg.Partition.ResourceAttributes = []string{"service.name"}
+	exp, client := newTestExporter(cfg)
+
+	require.NoError(t, exp.consumeTraces(context.Background(), testTraces()))
+	require.Len(t, client.uploads, 2)
+	assert.Empty(t, client.appends)
+
+	names := make([]string, 0, len(client.uploads))
+	for _, upload := range client.uploads {
+		assert.Equal(t, "traces", upload.container)
+		assert.Equal(t, "application/json", upload.contentType)
+		names = append(names, upload.name)
+
+		td, err := (&ptrace.JSONUnmarshaler{}).UnmarshalTraces(upload.data)
+		require.NoError(t, err)
+		assert.Equal(t, 1, td.SpanCount())
+	}
+	sort.Strings(names)
+	assert.Regexp(t, regexp.MustCompile(`^service.name=cart/year=\d{4}/month=\d{2}/day=\d{2}/hour=\d{2}/traces-\d+-[0-9a-f-]{36}\.json$`), names[0])
+	assert.Regexp(t, regexp.MustCompile(`^service.name=checkout/year=\d{4}/month=\d{2}/day=\d{2}/hour=\d{2}/traces-\d+-[0-9a-f-]{36}\.json$`), names[1])
+}
+
+func TestConsumeMetricsProto(t *testing.T) {
+	cfg := testConfig()
+	cfg.FormatType = formatTypeProto
+	cfg.Partition = partitioner.Config{}
+	cfg.Container.Metrics = "otel-metrics"
+	exp, client := newTestExporter(cfg)
+
+	md := pmetric.NewMetrics()
+	md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName("requests")
+	require.NoError(t, exp.consumeMetrics(context.Background(), md))
+	require.Len(t, client.uploads, 1)
+
+	upload := client.uploads[0]
+	assert.Equal(t, "otel-metrics", upload.container)
+	assert.Regexp(t, regexp.MustCompile(`^metrics-\d+-[0-9a-f-]{36}\.proto$`), upload.name)
+	assert.Equal(t, "application/x-protobuf", upload.contentType)
+	got, err := (&pmetric.ProtoUnmarshaler{}).UnmarshalMetrics(upload.data)
+	require.NoError(t, err)
+	assert.Equal(t, md, got)
+}
+
+func TestConsumeLogsAppendBlobs(t *testing.T) {
+	cfg := testConfig()
+	cfg.BlobType = blobTypeAppend
+	cfg.Partition = partitioner.Config{MaxSize: 1024}
+	exp, client := newTestExporter(cfg)
+
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("checkout failed")
+	for i := 0; i < 20; i++ {
+		require.NoError(t, exp.consumeLogs(context.Background(), ld))
+	}
+	require.Len(t, client.appends, 20)
+	assert.Empty(t, client.uploads)
+
+	sizes := map[string]int{}
+	for _, a := range client.appends {
+		assert.Equal(t, "logs", a.container)
+		assert.True(t, bytes.HasSuffix(a.data, []byte("\n")), "batches are written as lines")
+		got, err := (&plog.JSONUnmarshaler{}).UnmarshalLogs(bytes.TrimSuffix(a.data, []byte("\n")))
+		require.NoError(t, err)
+		assert.Equal(t, 1, got.LogRecordCount())
+		sizes[a.name] += len(a.data)
+	}
+	// blobs are rolled as they reach the maximum size
+	assert.Equal(t, "logs-0.json", client.appends[0].name)
+	assert.Greater(t, len(sizes), 1)
+	for _, size := range sizes {
+		assert.LessOrEqual(t, size, 1024)
+	}
+}
+
+func TestConsumeEmpty(t *testing.T) {
+	exp, client := newTestExporter(testConfig())
+	require.NoError(t, exp.consumeTraces(context.Background(), ptrace.NewTraces()))
+	require.NoError(t, exp.consumeLogs(context.Background(), plog.NewLogs()))
+	assert.Empty(t, client.uploads)
+}
+
+func TestWriteError(t *testing.T) {
+	exp, client := newTestExporter(testConfig())
+	client.err = errors.New("authorization failure")
+
+	err := exp.consumeTraces(context.Background(), testTraces())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "authorization failure")
+	// failed writes are retried
+	assert.False(t, consumererror.IsPermanent(err))
+}
@@ -0,0 +1,99 @@
+// Copyright 2019, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package carbonexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/carbonexporter"
+
+import (
+	"strconv"
+	"strings"
+)
+
+// seriesAggregator is a lineWriter merging the lines of the same series, i.e.
+// with the same path and timestamp, since Graphite keeps only the last value it
+// receives for a timestamp of a series. The merged lines keep the order in which
+// their series first appeared.
+type seriesAggregator struct {
+	// sum adds the values of a series up, otherwise the last value is kept.
+	sum    bool
+	index  map[seriesKey]int
+	series []aggregatedSeries
+}
+
+type seriesKey struct {
+	path      string
+	timestamp string
+}
+
+type aggregatedSeries struct {
+	seriesKey
+	// last is the last value written for the series.
+	last string
+	// isInt is true while all the values are integers, which are added up as
+	// integers to keep their precision.
+	isInt    bool
+	intSum   int64
+	floatSum float64
+}
+
+func newSeriesAggregator(sum bool) *seriesAggregator {
+	return &seriesAggregator{
+		sum:   sum,
+		index: map[seriesKey]int{},
+	}
+}
+
+func (a *seriesAggregator) writeLine(path, value, timestamp string) {
+	key := seriesKey{path: path, timestamp: timestamp}
+	idx, ok := a.index[key]
+	if !ok {
+		idx = len(a.series)
+		a.index[key] = idx
+		a.series = append(a.series, aggregatedSeries{seriesKey: key, isInt: true})
+	}
+	s := &a.series[idx]
+	s.last = value
+	if a.sum {
+		s.add(value)
+	}
+}
+
+func (s *aggregatedSeries) add(value string) {
+	if s.isInt {
+		if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+			s.intSum += i
+			return
+		}
+		s.isInt = false
+		s.floatSum = float64(s.intSum)
+	}
+	f, _ := strconv.ParseFloat(value, 64)
+	s.floatSum += f
+}
+
+// String returns the merged lines in the plaintext format.
+func (a *seriesAggregator) String() string {
+	var sb strings.Builder
+	for _, s := range a.series {
+		value := s.last
+		switch {
+		case !a.sum:
+		case s.isInt:
+			value = formatInt64(s.intSum)
+		default:
+			value = formatFloatForValue(s.floatSum)
+		}
+		sb.WriteString(buildLine(s.path, value, s.timestamp))
+	}
+	return sb.String()
+}
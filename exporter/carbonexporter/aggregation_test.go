@@ -0,0 +1,96 @@
+// Copyright 2019 OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package carbonexporter
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestSeriesAggregator(t *testing.T) {
+	lines := [][3]string{
+		{"requests;host=a", "1", "1668074400"},
+		{"latency", "0.5", "1668074400"},
+		{"requests;host=a", "2", "1668074400"},
+		{"requests;host=a", "4", "1668074410"},
+		{"latency", "2", "1668074400"},
+		{"requests;host=a", "3", "1668074400"},
+	}
+	tests := []struct {
+		name string
+		sum  bool
+		want []string
+	}{
+		{
+			name: "sum",
+			sum:  true,
+			want: []string{
+				"requests;host=a 6 1668074400",
+				"latency 2.5 1668074400",
+				"requests;host=a 4 1668074410",
+			},
+		},
+		{
+			name: "last",
+			want: []string{
+				"requests;host=a 3 1668074400",
+				"latency 2 1668074400",
+				"requests;host=a 4 1668074410",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			aggregator := newSeriesAggregator(tt.sum)
+			for _, line := range lines {
+				aggregator.writeLine(line[0], line[1], line[2])
+			}
+			got := strings.Split(aggregator.String(), "\n")
+			assert.Equal(t, tt.want, got[:len(got)-1])
+		})
+	}
+}
+
+func TestSeriesAggregatorMetricData(t *testing.T) {
+	// two instances report the same series, which only differ by their resource
+	md := pmetric.NewMetrics()
+	ts := pcommon.NewTimestampFromTime(time.Unix(1668074405, 0))
+	for _, value := range []int64{3, 4} {
+		rm := md.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().PutStr("host.name", "host")
+		m := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+		m.SetName("requests")
+		dp := m.SetEmptySum().DataPoints().AppendEmpty()
+		dp.Attributes().PutStr("route", "/")
+		dp.SetTimestamp(ts)
+		dp.SetIntValue(value)
+	}
+
+	assert.Equal(t, "requests;route=/ 3 1668074405\nrequests;route=/ 4 1668074405\n", metricDataToPlaintext(md, timestampFormatter{}))
+
+	aggregator := newSeriesAggregator(true)
+	writeMetricData(aggregator, md, timestampFormatter{})
+	assert.Equal(t, "requests;route=/ 7 1668074405\n", aggregator.String())
+
+	// series are merged after their timestamps are aligned
+	aggregator = newSeriesAggregator(false)
+	writeMetricData(aggregator, md, timestampFormatter{resolution: 10})
+	assert.Equal(t, "requests;route=/ 4 1668074400\n", aggregator.String())
+}
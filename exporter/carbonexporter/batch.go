@@ -0,0 +1,160 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package carbonexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/carbonexporter"
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// splitLines splits the plaintext lines into chunks of at most maxBytes bytes,
+// never splitting a line. A line longer than maxBytes gets a chunk of its own.
+// A maxBytes of 0 leaves the lines in a single chunk.
+func splitLines(lines string, maxBytes int) []string {
+	if maxBytes <= 0 || len(lines) <= maxBytes {
+		return []string{lines}
+	}
+
+	var chunks []string
+	for len(lines) > maxBytes {
+		end := strings.LastIndexByte(lines[:maxBytes], '\n') + 1
+		if end == 0 {
+			// the first line doesn't fit, send it on its own
+			end = strings.IndexByte(lines, '\n') + 1
+			if end == 0 {
+				end = len(lines)
+			}
+		}
+		chunks = append(chunks, lines[:end])
+		lines = lines[end:]
+	}
+	if len(lines) > 0 {
+		chunks = append(chunks, lines)
+	}
+	return chunks
+}
+
+// lineBatcher coalesces the plaintext lines of the batches of metrics pushed
+// concurrently into fewer, larger writes. Lines are written once they add up to
+// maxBytes, and every flush interval. Each add waits for the write of its lines,
+// so that write errors are reported to the exporterhelper and retried.
+type lineBatcher struct {
+	mtx      sync.Mutex
+	buf      bytes.Buffer
+	maxBytes int
+	write    func([]byte) error
+	// pending is notified of the result of the write of the lines in buf.
+	pending *writeResult
+
+	interval time.Duration
+	started  bool
+	stopCh   chan struct{}
+	doneCh   chan struct{}
+}
+
+// writeResult is the result of the write of a batch of lines, err is set once
+// done is closed.
+type writeResult struct {
+	done chan struct{}
+	err  error
+}
+
+func newLineBatcher(maxBytes int, interval time.Duration, write func([]byte) error) *lineBatcher {
+	return &lineBatcher{
+		maxBytes: maxBytes,
+		write:    write,
+		interval: interval,
+		stopCh:   make(chan struct{}),
+		doneCh:   make(chan struct{}),
+	}
+}
+
+// add appends the lines to the batch, writes the batch if it reached maxBytes,
+// and waits until the lines are written.
+func (b *lineBatcher) add(ctx context.Context, lines string) error {
+	b.mtx.Lock()
+	if b.maxBytes > 0 && b.buf.Len() > 0 && b.buf.Len()+len(lines) > b.maxBytes {
+		// the result of this write is reported to the adds of the pending lines
+		b.flushLocked()
+	}
+	b.buf.WriteString(lines)
+	if b.pending == nil {
+		b.pending = &writeResult{done: make(chan struct{})}
+	}
+	result := b.pending
+	if b.maxBytes > 0 && b.buf.Len() >= b.maxBytes {
+		b.flushLocked()
+	}
+	b.mtx.Unlock()
+
+	select {
+	case <-result.done:
+		return result.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *lineBatcher) flush() {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	b.flushLocked()
+}
+
+func (b *lineBatcher) flushLocked() {
+	if b.pending == nil {
+		return
+	}
+	result := b.pending
+	b.pending = nil
+	// The lines are dropped if the write fails, they are retried by the
+	// exporterhelper of the adds they came from.
+	defer b.buf.Reset()
+	for _, chunk := range splitLines(b.buf.String(), b.maxBytes) {
+		if result.err = b.write([]byte(chunk)); result.err != nil {
+			break
+		}
+	}
+	close(result.done)
+}
+
+func (b *lineBatcher) start(context.Context) {
+	b.started = true
+	go func() {
+		defer close(b.doneCh)
+		ticker := time.NewTicker(b.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				b.flush()
+			case <-b.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+// shutdown stops the periodic flushes and writes the remaining lines.
+func (b *lineBatcher) shutdown() {
+	if b.started {
+		close(b.stopCh)
+		<-b.doneCh
+	}
+	b.flush()
+}
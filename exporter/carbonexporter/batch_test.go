@@ -0,0 +1,218 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package carbonexporter
+
+import (
+	"bytes"
+	"compress/zlib"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
+)
+
+func TestSplitLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		lines    string
+		maxBytes int
+		want     []string
+	}{
+		{
+			name:     "unbounded",
+			lines:    "a 1 1\nb 2 1\n",
+			maxBytes: 0,
+			want:     []string{"a 1 1\nb 2 1\n"},
+		},
+		{
+			name:     "fits",
+			lines:    "a 1 1\nb 2 1\n",
+			maxBytes: 12,
+			want:     []string{"a 1 1\nb 2 1\n"},
+		},
+		{
+			name:     "split at line boundaries",
+			lines:    "a 1 1\nb 2 1\nc 3 1\n",
+			maxBytes: 13,
+			want:     []string{"a 1 1\nb 2 1\n", "c 3 1\n"},
+		},
+		{
+			name:     "line longer than max",
+			lines:    "long.path 1 1\nb 2 1\n",
+			maxBytes: 8,
+			want:     []string{"long.path 1 1\n", "b 2 1\n"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, splitLines(tt.lines, tt.maxBytes))
+		})
+	}
+}
+
+// addAsync adds the lines to the batcher in the background, and waits until they
+// are pending or written.
+func addAsync(t *testing.T, batcher *lineBatcher, ctx context.Context, lines string) <-chan error {
+	batcher.mtx.Lock()
+	before := batcher.buf.Len()
+	batcher.mtx.Unlock()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- batcher.add(ctx, lines)
+	}()
+	require.Eventually(t, func() bool {
+		batcher.mtx.Lock()
+		defer batcher.mtx.Unlock()
+		return batcher.buf.Len() != before
+	}, 5*time.Second, time.Millisecond)
+	return errCh
+}
+
+func waitForResult(t *testing.T, errCh <-chan error) error {
+	select {
+	case err := <-errCh:
+		return err
+	case <-time.After(5 * time.Second):
+		t.Fatal("the lines were not written")
+		return nil
+	}
+}
+
+func TestLineBatcher(t *testing.T) {
+	var writes []string
+	batcher := newLineBatcher(12, time.Hour, func(b []byte) error {
+		writes = append(writes, string(b))
+		return nil
+	})
+	ctx := context.Background()
+
+	errA := addAsync(t, batcher, ctx, "a 1 1\n")
+	// reaches max batch bytes, the lines of both adds are written at once
+	require.NoError(t, batcher.add(ctx, "b 2 1\n"))
+	require.NoError(t, waitForResult(t, errA))
+	assert.Equal(t, []string{"a 1 1\nb 2 1\n"}, writes)
+
+	errC := addAsync(t, batcher, ctx, "c 3 1\n")
+	// would exceed max batch bytes, the pending lines are written first
+	errD := make(chan error, 1)
+	go func() {
+		errD <- batcher.add(ctx, "dd 4 1\n")
+	}()
+	require.NoError(t, waitForResult(t, errC))
+	assert.Equal(t, []string{"a 1 1\nb 2 1\n", "c 3 1\n"}, writes)
+
+	batcher.shutdown()
+	require.NoError(t, waitForResult(t, errD))
+	assert.Equal(t, []string{"a 1 1\nb 2 1\n", "c 3 1\n", "dd 4 1\n"}, writes)
+}
+
+func TestLineBatcherFlushInterval(t *testing.T) {
+	var writes []string
+	batcher := newLineBatcher(0, 10*time.Millisecond, func(b []byte) error {
+		writes = append(writes, string(b))
+		return nil
+	})
+	batcher.start(context.Background())
+	defer batcher.shutdown()
+
+	errA := addAsync(t, batcher, context.Background(), "a 1 1\n")
+	errB := addAsync(t, batcher, context.Background(), "b 2 1\n")
+	require.NoError(t, waitForResult(t, errA))
+	require.NoError(t, waitForResult(t, errB))
+	batcher.mtx.Lock()
+	defer batcher.mtx.Unlock()
+	assert.Equal(t, "a 1 1\nb 2 1\n", strings.Join(writes, ""))
+}
+
+func TestLineBatcherWriteError(t *testing.T) {
+	errWrite := errors.New("write failed")
+	var writes int
+	batcher := newLineBatcher(12, time.Hour, func([]byte) error {
+		writes++
+		return errWrite
+	})
+
+	errA := addAsync(t, batcher, context.Background(), "a 1 1\n")
+	// the error is reported to every add of the failed write
+	assert.ErrorIs(t, batcher.add(context.Background(), "b 2 1\n"), errWrite)
+	assert.ErrorIs(t, waitForResult(t, errA), errWrite)
+
+	// the lines of the failed write are dropped, they are retried by the exporterhelper
+	batcher.shutdown()
+	assert.Equal(t, 1, writes)
+}
+
+func TestLineBatcherContextCanceled(t *testing.T) {
+	var writes []string
+	batcher := newLineBatcher(0, time.Hour, func(b []byte) error {
+		writes = append(writes, string(b))
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errA := addAsync(t, batcher, ctx, "a 1 1\n")
+	cancel()
+	assert.ErrorIs(t, waitForResult(t, errA), context.Canceled)
+
+	batcher.shutdown()
+	assert.Equal(t, []string{"a 1 1\n"}, writes)
+}
+
+func TestConnPoolZlib(t *testing.T) {
+	addr := testutil.GetAvailableLocalAddress(t)
+	ln, err := net.Listen("tcp", addr)
+	require.NoError(t, err)
+	defer ln.Close()
+
+	received := make(chan []byte, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		data, _ := io.ReadAll(conn)
+		received <- data
+	}()
+
+	cp := newTCPConnPool(addr, 5*time.Second)
+	cp.zlib = true
+	_, err = cp.Write([]byte("a 1 1\n"))
+	require.NoError(t, err)
+	_, err = cp.Write([]byte("b 2 1\n"))
+	require.NoError(t, err)
+	cp.Close()
+
+	var data []byte
+	select {
+	case data = <-received:
+	case <-time.After(5 * time.Second):
+		t.Fatal("nothing received")
+	}
+	zr, err := zlib.NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	lines, err := io.ReadAll(zr)
+	require.NoError(t, err)
+	assert.Equal(t, "a 1 1\nb 2 1\n", string(lines))
+}
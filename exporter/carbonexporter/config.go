@@ -15,20 +15,56 @@
 package carbonexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/carbonexporter"
 
 import (
+	"fmt"
 	"time"
 
 	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
 )
 
 // Defaults for not specified configuration settings.
 const (
-	DefaultEndpoint    = "localhost:2003"
-	DefaultSendTimeout = 5 * time.Second
+	DefaultEndpoint           = "localhost:2003"
+	DefaultSendTimeout        = 5 * time.Second
+	DefaultTimestampAlignment = TimestampAlignmentTruncate
+	DefaultAggregation        = AggregationNone
+	DefaultMaxBatchBytes      = 64 * 1024
+	DefaultCompression        = CompressionNone
+)
+
+// Supported values of the timestamp alignment.
+const (
+	// TimestampAlignmentTruncate aligns timestamps to the start of the step they fall in.
+	TimestampAlignmentTruncate = "truncate"
+	// TimestampAlignmentRound aligns timestamps to the nearest step.
+	TimestampAlignmentRound = "round"
+)
+
+// Supported values of the aggregation of duplicate series.
+const (
+	// AggregationNone sends every data point, even if several have the same path
+	// and timestamp.
+	AggregationNone = "none"
+	// AggregationSum sends the sum of the data points with the same path and timestamp.
+	AggregationSum = "sum"
+	// AggregationLast sends the last of the data points with the same path and timestamp.
+	AggregationLast = "last"
+)
+
+// Supported values of the compression.
+const (
+	// CompressionNone sends the plaintext lines as is.
+	CompressionNone = "none"
+	// CompressionZlib sends the plaintext lines in a zlib stream, which is supported
+	// by relays such as carbon-c-relay.
+	CompressionZlib = "zlib"
 )
 
 // Config defines configuration for Carbon exporter.
 type Config struct {
-	config.ExporterSettings `mapstructure:",squash"`
+	config.ExporterSettings      `mapstructure:",squash"`
+	exporterhelper.QueueSettings `mapstructure:"sending_queue"`
+	exporterhelper.RetrySettings `mapstructure:"retry_on_failure"`
 
 	// Endpoint specifies host and port to send metrics in the Carbon plaintext
 	// format. The default value is defined by the DefaultEndpoint constant.
@@ -38,4 +74,47 @@ type Config struct {
 	// data to the Carbon/Graphite backend.
 	// The default value is defined by the DefaultSendTimeout constant.
 	Timeout time.Duration `mapstructure:"timeout"`
+
+	// TimestampResolution is the step to which the timestamps are aligned before
+	// sending, which should match the precision of the Whisper storage schema,
+	// e.g. 10s or 60s. It must be a whole number of seconds. The default value
+	// of 0 sends timestamps with a resolution of 1 second.
+	TimestampResolution time.Duration `mapstructure:"timestamp_resolution"`
+
+	// TimestampAlignment defines how timestamps are aligned to the resolution,
+	// either "truncate" or "round". The default value is defined by the
+	// DefaultTimestampAlignment constant.
+	TimestampAlignment string `mapstructure:"timestamp_alignment"`
+
+	// Aggregation defines how the data points of a batch with the same path and
+	// aligned timestamp are merged before sending, since Graphite keeps only the
+	// last value it receives for a timestamp, e.g. when several instances report
+	// the same series. Either "none", "sum" or "last". The default value is defined
+	// by the DefaultAggregation constant.
+	Aggregation string `mapstructure:"aggregation"`
+
+	// MaxBatchBytes is the maximum size of a single write to the Carbon/Graphite
+	// backend. Lines are never split, so a line longer than it is written on its
+	// own. 0 means unbounded. The default value is defined by the
+	// DefaultMaxBatchBytes constant.
+	MaxBatchBytes int `mapstructure:"max_batch_bytes"`
+
+	// FlushInterval, when set, makes the exporter coalesce the lines of the
+	// batches of metrics sent concurrently by the consumers of the sending queue,
+	// and write them once they add up to MaxBatchBytes or when the interval
+	// elapses. Each batch is only reported as sent once its lines are written.
+	// By default, each batch is written as soon as it is received.
+	FlushInterval time.Duration `mapstructure:"flush_interval"`
+
+	// Compression of the connections, either "none" or "zlib". The default value
+	// is defined by the DefaultCompression constant.
+	Compression string `mapstructure:"compression"`
+}
+
+// Validate checks if the exporter configuration is valid.
+func (cfg *Config) Validate() error {
+	if err := cfg.QueueSettings.Validate(); err != nil {
+		return fmt.Errorf("queue settings has invalid configuration: %w", err)
+	}
+	return nil
 }
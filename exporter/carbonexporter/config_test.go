@@ -24,6 +24,7 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
 )
 
 func TestLoadConfig(t *testing.T) {
@@ -46,8 +47,25 @@ func TestLoadConfig(t *testing.T) {
 			id: component.NewIDWithName(typeStr, "allsettings"),
 			expected: &Config{
 				ExporterSettings: config.NewExporterSettings(component.NewID(typeStr)),
-				Endpoint:         "localhost:8080",
-				Timeout:          10 * time.Second,
+				QueueSettings: exporterhelper.QueueSettings{
+					Enabled:      true,
+					NumConsumers: 2,
+					QueueSize:    10,
+				},
+				RetrySettings: exporterhelper.RetrySettings{
+					Enabled:         true,
+					InitialInterval: 10 * time.Second,
+					MaxInterval:     1 * time.Minute,
+					MaxElapsedTime:  10 * time.Minute,
+				},
+				Endpoint:            "localhost:8080",
+				Timeout:             10 * time.Second,
+				TimestampResolution: time.Minute,
+				TimestampAlignment:  TimestampAlignmentRound,
+				Aggregation:         AggregationSum,
+				MaxBatchBytes:       32768,
+				FlushInterval:       10 * time.Second,
+				Compression:         CompressionZlib,
 			},
 		},
 	}
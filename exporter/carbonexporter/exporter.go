@@ -15,6 +15,7 @@
 package carbonexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/carbonexporter"
 
 import (
+	"compress/zlib"
 	"context"
 	"fmt"
 	"net"
@@ -39,8 +40,53 @@ func newCarbonExporter(cfg *Config, set component.ExporterCreateSettings) (compo
 		return nil, fmt.Errorf("%v exporter requires a positive timeout", cfg.ID())
 	}
 
-	sender := carbonSender{
-		connPool: newTCPConnPool(cfg.Endpoint, cfg.Timeout),
+	// Carbon timestamps are in seconds, so finer resolutions can't be honored.
+	if cfg.TimestampResolution < 0 || cfg.TimestampResolution%time.Second != 0 {
+		return nil, fmt.Errorf("%v exporter requires a timestamp resolution of a whole number of seconds", cfg.ID())
+	}
+
+	tsFormatter := timestampFormatter{resolution: uint64(cfg.TimestampResolution / time.Second)}
+	switch cfg.TimestampAlignment {
+	case "", TimestampAlignmentTruncate:
+	case TimestampAlignmentRound:
+		tsFormatter.round = true
+	default:
+		return nil, fmt.Errorf("%v exporter has an invalid timestamp alignment %q, must be one of %q or %q",
+			cfg.ID(), cfg.TimestampAlignment, TimestampAlignmentTruncate, TimestampAlignmentRound)
+	}
+
+	if cfg.MaxBatchBytes < 0 {
+		return nil, fmt.Errorf("%v exporter requires a non-negative max batch bytes", cfg.ID())
+	}
+	if cfg.FlushInterval < 0 {
+		return nil, fmt.Errorf("%v exporter requires a non-negative flush interval", cfg.ID())
+	}
+
+	connPool := newTCPConnPool(cfg.Endpoint, cfg.Timeout)
+	switch cfg.Compression {
+	case "", CompressionNone:
+	case CompressionZlib:
+		connPool.zlib = true
+	default:
+		return nil, fmt.Errorf("%v exporter has an invalid compression %q, must be one of %q or %q",
+			cfg.ID(), cfg.Compression, CompressionNone, CompressionZlib)
+	}
+
+	sender := &carbonSender{
+		connPool:      connPool,
+		tsFormatter:   tsFormatter,
+		maxBatchBytes: cfg.MaxBatchBytes,
+	}
+	if cfg.FlushInterval > 0 {
+		sender.batcher = newLineBatcher(cfg.MaxBatchBytes, cfg.FlushInterval, sender.write)
+	}
+	switch cfg.Aggregation {
+	case "", AggregationNone:
+	case AggregationSum, AggregationLast:
+		sender.aggregation = cfg.Aggregation
+	default:
+		return nil, fmt.Errorf("%v exporter has an invalid aggregation %q, must be one of %q, %q or %q",
+			cfg.ID(), cfg.Aggregation, AggregationNone, AggregationSum, AggregationLast)
 	}
 
 	return exporterhelper.NewMetricsExporter(
@@ -48,6 +94,9 @@ func newCarbonExporter(cfg *Config, set component.ExporterCreateSettings) (compo
 		set,
 		cfg,
 		sender.pushMetricsData,
+		exporterhelper.WithQueue(cfg.QueueSettings),
+		exporterhelper.WithRetry(cfg.RetrySettings),
+		exporterhelper.WithStart(sender.Start),
 		exporterhelper.WithShutdown(sender.Shutdown))
 }
 
@@ -55,21 +104,56 @@ func newCarbonExporter(cfg *Config, set component.ExporterCreateSettings) (compo
 // connections into an implementations of exporterhelper.PushMetricsData so
 // the exporter can leverage the helper and get consistent observability.
 type carbonSender struct {
-	connPool *connPool
+	connPool    *connPool
+	tsFormatter timestampFormatter
+	// aggregation merges the duplicate series of a batch when it is set.
+	aggregation string
+	// maxBatchBytes bounds the size of each write, 0 means unbounded.
+	maxBatchBytes int
+	// batcher coalesces the lines of several batches when it is set, otherwise
+	// each batch is written as soon as it is pushed.
+	batcher *lineBatcher
 }
 
-func (cs *carbonSender) pushMetricsData(_ context.Context, md pmetric.Metrics) error {
-	lines := metricDataToPlaintext(md)
+func (cs *carbonSender) pushMetricsData(ctx context.Context, md pmetric.Metrics) error {
+	var lines string
+	if cs.aggregation == "" {
+		lines = metricDataToPlaintext(md, cs.tsFormatter)
+	} else {
+		aggregator := newSeriesAggregator(cs.aggregation == AggregationSum)
+		writeMetricData(aggregator, md, cs.tsFormatter)
+		lines = aggregator.String()
+	}
+
+	if cs.batcher != nil {
+		return cs.batcher.add(ctx, lines)
+	}
 
-	if _, err := cs.connPool.Write([]byte(lines)); err != nil {
-		// Use the sum of converted and dropped since the write failed for all.
-		return err
+	for _, chunk := range splitLines(lines, cs.maxBatchBytes) {
+		if err := cs.write([]byte(chunk)); err != nil {
+			// Use the sum of converted and dropped since the write failed for all.
+			return err
+		}
 	}
+	return nil
+}
+
+func (cs *carbonSender) write(lines []byte) error {
+	_, err := cs.connPool.Write(lines)
+	return err
+}
 
+func (cs *carbonSender) Start(ctx context.Context, _ component.Host) error {
+	if cs.batcher != nil {
+		cs.batcher.start(ctx)
+	}
 	return nil
 }
 
 func (cs *carbonSender) Shutdown(context.Context) error {
+	if cs.batcher != nil {
+		cs.batcher.shutdown()
+	}
 	cs.connPool.Close()
 	return nil
 }
@@ -85,9 +169,39 @@ func (cs *carbonSender) Shutdown(context.Context) error {
 // unused connections as that was the case on the prior art mentioned above.
 type connPool struct {
 	mtx      sync.Mutex
-	conns    []*net.TCPConn
+	conns    []*carbonConn
 	endpoint string
 	timeout  time.Duration
+	// zlib compresses the stream of each connection, for the relays supporting it.
+	zlib bool
+}
+
+// carbonConn is a pooled connection, along with the zlib stream written to it
+// when compression is enabled.
+type carbonConn struct {
+	*net.TCPConn
+	zw *zlib.Writer
+}
+
+func (c *carbonConn) Write(bytes []byte) (int, error) {
+	if c.zw == nil {
+		return c.TCPConn.Write(bytes)
+	}
+	n, err := c.zw.Write(bytes)
+	if err != nil {
+		return n, err
+	}
+	// Flush so that the relay receives the lines of this write right away, while
+	// keeping a single zlib stream on the connection.
+	return n, c.zw.Flush()
+}
+
+func (c *carbonConn) Close() error {
+	if c.zw != nil {
+		// Terminate the zlib stream, its errors are irrelevant since the connection is closed.
+		_ = c.zw.Close()
+	}
+	return c.TCPConn.Close()
 }
 
 func newTCPConnPool(
@@ -101,7 +215,7 @@ func newTCPConnPool(
 }
 
 func (cp *connPool) Write(bytes []byte) (int, error) {
-	var conn *net.TCPConn
+	var conn *carbonConn
 	var err error
 
 	// The deferred function below is what puts back connections on the pool.
@@ -167,10 +281,14 @@ func (cp *connPool) Close() {
 	cp.conns = nil
 }
 
-func (cp *connPool) createTCPConn() (*net.TCPConn, error) {
+func (cp *connPool) createTCPConn() (*carbonConn, error) {
 	c, err := net.DialTimeout("tcp", cp.endpoint, cp.timeout)
 	if err != nil {
 		return nil, err
 	}
-	return c.(*net.TCPConn), err
+	conn := &carbonConn{TCPConn: c.(*net.TCPConn)}
+	if cp.zlib {
+		conn.zw = zlib.NewWriter(conn.TCPConn)
+	}
+	return conn, nil
 }
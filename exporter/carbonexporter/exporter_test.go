@@ -66,6 +66,54 @@ func TestNew(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "invalid_timestamp_resolution",
+			config: &Config{
+				ExporterSettings:    config.NewExporterSettings(component.NewID(typeStr)),
+				TimestampResolution: 1500 * time.Millisecond,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid_timestamp_alignment",
+			config: &Config{
+				ExporterSettings:   config.NewExporterSettings(component.NewID(typeStr)),
+				TimestampAlignment: "ceil",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid_compression",
+			config: &Config{
+				ExporterSettings: config.NewExporterSettings(component.NewID(typeStr)),
+				Compression:      "gzip",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid_max_batch_bytes",
+			config: &Config{
+				ExporterSettings: config.NewExporterSettings(component.NewID(typeStr)),
+				MaxBatchBytes:    -1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid_flush_interval",
+			config: &Config{
+				ExporterSettings: config.NewExporterSettings(component.NewID(typeStr)),
+				FlushInterval:    -time.Second,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid_aggregation",
+			config: &Config{
+				ExporterSettings: config.NewExporterSettings(component.NewID(typeStr)),
+				Aggregation:      "max",
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
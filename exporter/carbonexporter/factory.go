@@ -19,6 +19,7 @@ import (
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
 )
 
 const (
@@ -38,9 +39,15 @@ func NewFactory() component.ExporterFactory {
 
 func createDefaultConfig() component.ExporterConfig {
 	return &Config{
-		ExporterSettings: config.NewExporterSettings(component.NewID(typeStr)),
-		Endpoint:         DefaultEndpoint,
-		Timeout:          DefaultSendTimeout,
+		ExporterSettings:   config.NewExporterSettings(component.NewID(typeStr)),
+		QueueSettings:      exporterhelper.NewDefaultQueueSettings(),
+		RetrySettings:      exporterhelper.NewDefaultRetrySettings(),
+		Endpoint:           DefaultEndpoint,
+		Timeout:            DefaultSendTimeout,
+		TimestampAlignment: DefaultTimestampAlignment,
+		Aggregation:        DefaultAggregation,
+		MaxBatchBytes:      DefaultMaxBatchBytes,
+		Compression:        DefaultCompression,
 	}
 }
 
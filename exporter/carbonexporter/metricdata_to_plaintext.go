@@ -73,20 +73,23 @@ const (
 //
 // The <value> is the textual representation of the metric value.
 //
-// The <timestamp> is the Unix time text of when the measurement was made.
+// The <timestamp> is the Unix time text of when the measurement was made,
+// aligned by the given timestampFormatter.
 //
 // The returned values are:
 //   - a string concatenating all generated "lines" (each single one representing
 //     a single Carbon metric.
 //   - number of time series successfully converted to carbon.
 //   - number of time series that could not be converted to Carbon.
-func metricDataToPlaintext(md pmetric.Metrics) string {
-	if md.DataPointCount() == 0 {
-		return ""
-	}
-
-	var sb strings.Builder
+func metricDataToPlaintext(md pmetric.Metrics, tsFormatter timestampFormatter) string {
+	var w plaintextWriter
+	writeMetricData(&w, md, tsFormatter)
+	return w.String()
+}
 
+// writeMetricData converts the metrics data like metricDataToPlaintext, passing
+// every line to the given lineWriter.
+func writeMetricData(w lineWriter, md pmetric.Metrics, tsFormatter timestampFormatter) {
 	for i := 0; i < md.ResourceMetrics().Len(); i++ {
 		rm := md.ResourceMetrics().At(i)
 		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
@@ -99,22 +102,34 @@ func metricDataToPlaintext(md pmetric.Metrics) string {
 				}
 				switch metric.Type() {
 				case pmetric.MetricTypeGauge:
-					formatNumberDataPoints(&sb, metric.Name(), metric.Gauge().DataPoints())
+					formatNumberDataPoints(w, metric.Name(), metric.Gauge().DataPoints(), tsFormatter)
 				case pmetric.MetricTypeSum:
-					formatNumberDataPoints(&sb, metric.Name(), metric.Sum().DataPoints())
+					formatNumberDataPoints(w, metric.Name(), metric.Sum().DataPoints(), tsFormatter)
 				case pmetric.MetricTypeHistogram:
-					formatHistogramDataPoints(&sb, metric.Name(), metric.Histogram().DataPoints())
+					formatHistogramDataPoints(w, metric.Name(), metric.Histogram().DataPoints(), tsFormatter)
 				case pmetric.MetricTypeSummary:
-					formatSummaryDataPoints(&sb, metric.Name(), metric.Summary().DataPoints())
+					formatSummaryDataPoints(w, metric.Name(), metric.Summary().DataPoints(), tsFormatter)
 				}
 			}
 		}
 	}
+}
 
-	return sb.String()
+// lineWriter receives the Carbon lines converted from the metrics data.
+type lineWriter interface {
+	writeLine(path, value, timestamp string)
+}
+
+// plaintextWriter concatenates the lines in the plaintext format.
+type plaintextWriter struct {
+	strings.Builder
+}
+
+func (w *plaintextWriter) writeLine(path, value, timestamp string) {
+	w.WriteString(buildLine(path, value, timestamp))
 }
 
-func formatNumberDataPoints(sb *strings.Builder, metricName string, dps pmetric.NumberDataPointSlice) {
+func formatNumberDataPoints(w lineWriter, metricName string, dps pmetric.NumberDataPointSlice, tsFormatter timestampFormatter) {
 	for i := 0; i < dps.Len(); i++ {
 		dp := dps.At(i)
 		var valueStr string
@@ -124,7 +139,7 @@ func formatNumberDataPoints(sb *strings.Builder, metricName string, dps pmetric.
 		case pmetric.NumberDataPointValueTypeDouble:
 			valueStr = formatFloatForValue(dp.DoubleValue())
 		}
-		sb.WriteString(buildLine(buildPath(metricName, dp.Attributes()), valueStr, formatTimestamp(dp.Timestamp())))
+		w.writeLine(buildPath(metricName, dp.Attributes()), valueStr, tsFormatter.format(dp.Timestamp()))
 	}
 }
 
@@ -143,15 +158,16 @@ func formatNumberDataPoints(sb *strings.Builder, metricName string, dps pmetric.
 // that bucket. This metric specifies the number of events with a value that is
 // less than or equal to the upper bound.
 func formatHistogramDataPoints(
-	sb *strings.Builder,
+	w lineWriter,
 	metricName string,
 	dps pmetric.HistogramDataPointSlice,
+	tsFormatter timestampFormatter,
 ) {
 	for i := 0; i < dps.Len(); i++ {
 		dp := dps.At(i)
 
-		timestampStr := formatTimestamp(dp.Timestamp())
-		formatCountAndSum(sb, metricName, dp.Attributes(), dp.Count(), dp.Sum(), timestampStr)
+		timestampStr := tsFormatter.format(dp.Timestamp())
+		formatCountAndSum(w, metricName, dp.Attributes(), dp.Count(), dp.Sum(), timestampStr)
 		if dp.ExplicitBounds().Len() == 0 {
 			continue
 		}
@@ -165,7 +181,7 @@ func formatHistogramDataPoints(
 
 		bucketPath := buildPath(metricName+distributionBucketSuffix, dp.Attributes())
 		for j := 0; j < dp.BucketCounts().Len(); j++ {
-			sb.WriteString(buildLine(bucketPath+distributionUpperBoundTagBeforeValue+carbonBounds[j], formatUint64(dp.BucketCounts().At(j)), timestampStr))
+			w.writeLine(bucketPath+distributionUpperBoundTagBeforeValue+carbonBounds[j], formatUint64(dp.BucketCounts().At(j)), timestampStr)
 		}
 	}
 }
@@ -183,15 +199,16 @@ func formatHistogramDataPoints(
 // 3. Each quantile is represented by a metric named "<metricName>.quantile"
 // and will include a tag key "quantile" that specifies the quantile value.
 func formatSummaryDataPoints(
-	sb *strings.Builder,
+	w lineWriter,
 	metricName string,
 	dps pmetric.SummaryDataPointSlice,
+	tsFormatter timestampFormatter,
 ) {
 	for i := 0; i < dps.Len(); i++ {
 		dp := dps.At(i)
 
-		timestampStr := formatTimestamp(dp.Timestamp())
-		formatCountAndSum(sb, metricName, dp.Attributes(), dp.Count(), dp.Sum(), timestampStr)
+		timestampStr := tsFormatter.format(dp.Timestamp())
+		formatCountAndSum(w, metricName, dp.Attributes(), dp.Count(), dp.Sum(), timestampStr)
 
 		if dp.QuantileValues().Len() == 0 {
 			continue
@@ -199,10 +216,10 @@ func formatSummaryDataPoints(
 
 		quantilePath := buildPath(metricName+summaryQuantileSuffix, dp.Attributes())
 		for j := 0; j < dp.QuantileValues().Len(); j++ {
-			sb.WriteString(buildLine(
+			w.writeLine(
 				quantilePath+summaryQuantileTagBeforeValue+formatFloatForLabel(dp.QuantileValues().At(j).Quantile()*100),
 				formatFloatForValue(dp.QuantileValues().At(j).Value()),
-				timestampStr))
+				timestampStr)
 		}
 	}
 }
@@ -215,7 +232,7 @@ func formatSummaryDataPoints(
 //
 // 2. The total sum will be represented by a metruc with the original "<metricName>".
 func formatCountAndSum(
-	sb *strings.Builder,
+	w lineWriter,
 	metricName string,
 	attributes pcommon.Map,
 	count uint64,
@@ -225,11 +242,11 @@ func formatCountAndSum(
 	// Build count and sum metrics.
 	countPath := buildPath(metricName+countSuffix, attributes)
 	valueStr := formatUint64(count)
-	sb.WriteString(buildLine(countPath, valueStr, timestampStr))
+	w.writeLine(countPath, valueStr, timestampStr)
 
 	sumPath := buildPath(metricName, attributes)
 	valueStr = formatFloatForValue(sum)
-	sb.WriteString(buildLine(sumPath, valueStr, timestampStr))
+	w.writeLine(sumPath, valueStr, timestampStr)
 }
 
 // buildPath is used to build the <metric_path> per description above.
@@ -308,6 +325,21 @@ func formatInt64(i int64) string {
 	return strconv.FormatInt(i, 10)
 }
 
-func formatTimestamp(timestamp pcommon.Timestamp) string {
-	return formatUint64(uint64(timestamp) / 1e9)
+// timestampFormatter formats timestamps as Unix seconds, aligned to a resolution.
+type timestampFormatter struct {
+	// resolution in seconds, timestamps are not aligned when it is 0 or 1.
+	resolution uint64
+	// round aligns timestamps to the nearest step instead of truncating them.
+	round bool
+}
+
+func (f timestampFormatter) format(timestamp pcommon.Timestamp) string {
+	secs := uint64(timestamp) / 1e9
+	if f.resolution > 1 {
+		if f.round {
+			secs += f.resolution / 2
+		}
+		secs -= secs % f.resolution
+	}
+	return formatUint64(secs)
 }
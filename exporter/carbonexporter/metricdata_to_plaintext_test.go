@@ -284,7 +284,7 @@ func TestToPlaintext(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotLines := metricDataToPlaintext(tt.metricsDataFn())
+			gotLines := metricDataToPlaintext(tt.metricsDataFn(), timestampFormatter{})
 			got := strings.Split(gotLines, "\n")
 			got = got[:len(got)-1]
 			assert.Equal(t, tt.wantLines, got)
@@ -333,3 +333,50 @@ func expectedSummaryLines(
 
 	return lines
 }
+
+func TestTimestampFormatter(t *testing.T) {
+	// 2022-11-10 10:00:00 UTC
+	const base = 1668074400
+	tests := []struct {
+		name      string
+		formatter timestampFormatter
+		secs      []int64
+		want      []string
+	}{
+		{
+			name:      "default",
+			formatter: timestampFormatter{},
+			secs:      []int64{0, 9, 10, 59},
+			want:      []string{"1668074400", "1668074409", "1668074410", "1668074459"},
+		},
+		{
+			name:      "truncate_10s",
+			formatter: timestampFormatter{resolution: 10},
+			secs:      []int64{0, 4, 5, 9, 10, 19},
+			want:      []string{"1668074400", "1668074400", "1668074400", "1668074400", "1668074410", "1668074410"},
+		},
+		{
+			name:      "round_10s",
+			formatter: timestampFormatter{resolution: 10, round: true},
+			secs:      []int64{0, 4, 5, 9, 10, 19},
+			want:      []string{"1668074400", "1668074400", "1668074410", "1668074410", "1668074410", "1668074420"},
+		},
+		{
+			name:      "truncate_60s",
+			formatter: timestampFormatter{resolution: 60},
+			secs:      []int64{0, 29, 30, 59, 61},
+			want:      []string{"1668074400", "1668074400", "1668074400", "1668074400", "1668074460"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, s := range tt.secs {
+				// sub-second precision is always truncated
+				ts := pcommon.NewTimestampFromTime(time.Unix(base+s, 999_000_000))
+				got = append(got, tt.formatter.format(ts))
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -18,7 +18,9 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
@@ -44,6 +46,34 @@ type Config struct {
 	TracesTableName string `mapstructure:"traces_table_name"`
 	// TTLDays is The data time-to-live in days, 0 means no ttl.
 	TTLDays uint `mapstructure:"ttl_days"`
+	// CreateSchema creates the database and the tables when the exporter starts. default is true.
+	CreateSchema bool `mapstructure:"create_schema"`
+	// LogsSchema overrides the schema of the logs table.
+	LogsSchema TableSchema `mapstructure:"logs_schema"`
+	// TracesSchema overrides the schema of the traces table.
+	TracesSchema TableSchema `mapstructure:"traces_schema"`
+	// AsyncInsert configures the ClickHouse asynchronous inserts.
+	AsyncInsert AsyncInsertSettings `mapstructure:"async_insert"`
+}
+
+// AsyncInsertSettings configures the ClickHouse asynchronous inserts, which buffer the
+// inserted rows on the server and write them in batches. Settings explicitly set in the
+// DSN take precedence.
+type AsyncInsertSettings struct {
+	// Enabled enables asynchronous inserts. default is false.
+	Enabled bool `mapstructure:"enabled"`
+	// Wait makes inserts wait until the buffered rows are written to the table, so that
+	// failed writes are reported and retried. default is true.
+	Wait bool `mapstructure:"wait"`
+	// WaitTimeout is how long inserts wait for the buffered rows to be written, rounded up
+	// to seconds. 0 means the server default.
+	WaitTimeout time.Duration `mapstructure:"wait_timeout"`
+	// BusyTimeout is the maximum time the rows are buffered before they are written.
+	// 0 means the server default.
+	BusyTimeout time.Duration `mapstructure:"busy_timeout"`
+	// MaxDataSize is the maximum size of the buffered rows in bytes before they are
+	// written. 0 means the server default.
+	MaxDataSize int `mapstructure:"max_data_size"`
 }
 
 // QueueSettings is a subset of exporterhelper.QueueSettings.
@@ -53,7 +83,8 @@ type QueueSettings struct {
 }
 
 var (
-	errConfigNoDSN = errors.New("dsn must be specified")
+	errConfigNoDSN              = errors.New("dsn must be specified")
+	errConfigInvalidAsyncInsert = errors.New("async_insert timeouts and max_data_size must not be negative")
 )
 
 // Validate validates the clickhouse server configuration.
@@ -65,6 +96,11 @@ func (cfg *Config) Validate() (err error) {
 	if e != nil {
 		err = multierr.Append(err, fmt.Errorf("invalid dsn format:%w", err))
 	}
+	err = multierr.Append(err, cfg.LogsSchema.validate("logs_schema", logsColumns, cfg.CreateSchema))
+	err = multierr.Append(err, cfg.TracesSchema.validate("traces_schema", tracesColumns, cfg.CreateSchema))
+	if cfg.AsyncInsert.WaitTimeout < 0 || cfg.AsyncInsert.BusyTimeout < 0 || cfg.AsyncInsert.MaxDataSize < 0 {
+		err = multierr.Append(err, errConfigInvalidAsyncInsert)
+	}
 	return err
 }
 
@@ -78,6 +114,41 @@ func parseDSNDatabase(dsn string) (string, error) {
 	return strings.TrimPrefix(u.Path, "/"), nil
 }
 
+// buildDSN returns the DSN the exporter connects with, adding the asynchronous insert
+// settings to it.
+func (cfg *Config) buildDSN() (string, error) {
+	if !cfg.AsyncInsert.Enabled {
+		return cfg.DSN, nil
+	}
+	u, err := url.Parse(cfg.DSN)
+	if err != nil {
+		return "", fmt.Errorf("invalid dsn format:%w", err)
+	}
+	params := u.Query()
+	setDefault := func(key, value string) {
+		if !params.Has(key) {
+			params.Set(key, value)
+		}
+	}
+	setDefault("async_insert", "1")
+	if cfg.AsyncInsert.Wait {
+		setDefault("wait_for_async_insert", "1")
+	} else {
+		setDefault("wait_for_async_insert", "0")
+	}
+	if timeout := cfg.AsyncInsert.WaitTimeout; timeout > 0 {
+		setDefault("wait_for_async_insert_timeout", strconv.FormatInt(int64((timeout+time.Second-1)/time.Second), 10))
+	}
+	if timeout := cfg.AsyncInsert.BusyTimeout; timeout > 0 {
+		setDefault("async_insert_busy_timeout_ms", strconv.FormatInt(timeout.Milliseconds(), 10))
+	}
+	if size := cfg.AsyncInsert.MaxDataSize; size > 0 {
+		setDefault("async_insert_max_data_size", strconv.Itoa(size))
+	}
+	u.RawQuery = params.Encode()
+	return u.String(), nil
+}
+
 func (cfg *Config) enforcedQueueSettings() exporterhelper.QueueSettings {
 	return exporterhelper.QueueSettings{
 		Enabled:      true,
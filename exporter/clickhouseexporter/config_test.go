@@ -67,8 +67,29 @@ func TestLoadConfig(t *testing.T) {
 				QueueSettings: QueueSettings{
 					QueueSize: 100,
 				},
+				CreateSchema: false,
+				LogsSchema: TableSchema{
+					Columns: map[string]string{"Body": "Message"},
+				},
+				AsyncInsert: AsyncInsertSettings{
+					Enabled:     true,
+					Wait:        false,
+					WaitTimeout: 10 * time.Second,
+					BusyTimeout: 200 * time.Millisecond,
+					MaxDataSize: 1000000,
+				},
 			},
 		},
+		{
+			id: component.NewIDWithName(typeStr, "schema"),
+			expected: withDefaultConfig(func(cfg *Config) {
+				cfg.DSN = defaultDSN
+				cfg.TracesSchema = TableSchema{
+					CreateSQL: "CREATE TABLE IF NOT EXISTS {table} (Timestamp DateTime64(9), SpanName String) ENGINE MergeTree() {ttl} ORDER BY Timestamp",
+					Columns:   map[string]string{"SpanName": "Name"},
+				}
+			}),
+		},
 	}
 
 	for _, tt := range tests {
@@ -86,6 +107,99 @@ func TestLoadConfig(t *testing.T) {
 	}
 }
 
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  *Config
+		err  string
+	}{
+		{
+			name: "unknown column",
+			cfg: withDefaultConfig(func(cfg *Config) {
+				cfg.LogsSchema.Columns = map[string]string{"Message": "Body"}
+			}),
+			err: `logs_schema::columns: unknown column "Message"`,
+		},
+		{
+			name: "empty column",
+			cfg: withDefaultConfig(func(cfg *Config) {
+				cfg.TracesSchema.Columns = map[string]string{"Events.Name": ""}
+			}),
+			err: `traces_schema::columns: column "Events.Name" must not be renamed to an empty name`,
+		},
+		{
+			name: "renamed column without create_sql",
+			cfg: withDefaultConfig(func(cfg *Config) {
+				cfg.LogsSchema.Columns = map[string]string{"Body": "Message"}
+			}),
+			err: "logs_schema::create_sql must be set to rename columns when create_schema is enabled",
+		},
+		{
+			name: "negative async insert timeout",
+			cfg: withDefaultConfig(func(cfg *Config) {
+				cfg.AsyncInsert.BusyTimeout = -time.Second
+			}),
+			err: errConfigInvalidAsyncInsert.Error(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.cfg.DSN = defaultDSN
+			assert.EqualError(t, tt.cfg.Validate(), tt.err)
+		})
+	}
+}
+
+func TestConfig_buildDSN(t *testing.T) {
+	tests := []struct {
+		name        string
+		dsn         string
+		asyncInsert AsyncInsertSettings
+		want        string
+	}{
+		{
+			name: "disabled",
+			dsn:  defaultDSN,
+			want: defaultDSN,
+		},
+		{
+			name:        "enabled",
+			dsn:         defaultDSN,
+			asyncInsert: AsyncInsertSettings{Enabled: true, Wait: true},
+			want:        defaultDSN + "?async_insert=1&wait_for_async_insert=1",
+		},
+		{
+			name: "all settings",
+			dsn:  defaultDSN + "?dial_timeout=200ms",
+			asyncInsert: AsyncInsertSettings{
+				Enabled:     true,
+				WaitTimeout: 1500 * time.Millisecond,
+				BusyTimeout: 200 * time.Millisecond,
+				MaxDataSize: 1000000,
+			},
+			want: defaultDSN + "?async_insert=1&async_insert_busy_timeout_ms=200&async_insert_max_data_size=1000000" +
+				"&dial_timeout=200ms&wait_for_async_insert=0&wait_for_async_insert_timeout=2",
+		},
+		{
+			name:        "dsn settings take precedence",
+			dsn:         defaultDSN + "?wait_for_async_insert=0",
+			asyncInsert: AsyncInsertSettings{Enabled: true, Wait: true},
+			want:        defaultDSN + "?async_insert=1&wait_for_async_insert=0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := withDefaultConfig(func(cfg *Config) {
+				cfg.DSN = tt.dsn
+				cfg.AsyncInsert = tt.asyncInsert
+			})
+			dsn, err := cfg.buildDSN()
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, dsn)
+		})
+	}
+}
+
 func withDefaultConfig(fns ...func(*Config)) *Config {
 	cfg := createDefaultConfig().(*Config)
 	for _, fn := range fns {
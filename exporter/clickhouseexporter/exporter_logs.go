@@ -151,42 +151,22 @@ PARTITION BY toDate(Timestamp)
 ORDER BY (ServiceName, SeverityText, toUnixTimestamp(Timestamp), TraceId)
 SETTINGS index_granularity=8192, ttl_only_drop_parts = 1;
 `
-	// language=ClickHouse SQL
-	insertLogsSQLTemplate = `INSERT INTO %s (
-                        Timestamp,
-                        TraceId,
-                        SpanId,
-                        TraceFlags,
-                        SeverityText,
-                        SeverityNumber,
-                        ServiceName,
-                        Body,
-                        ResourceAttributes,
-                        LogAttributes
-                        ) VALUES (
-                                  ?,
-                                  ?,
-                                  ?,
-                                  ?,
-                                  ?,
-                                  ?,
-                                  ?,
-                                  ?,
-                                  ?,
-                                  ?
-                                  )`
 )
 
 var driverName = "clickhouse" // for testing
 
 // newClickhouseClient create a clickhouse client.
 func newClickhouseClient(cfg *Config) (*sql.DB, error) {
-	return sql.Open(driverName, cfg.DSN)
+	dsn, err := cfg.buildDSN()
+	if err != nil {
+		return nil, err
+	}
+	return sql.Open(driverName, dsn)
 }
 
 func createDatabase(cfg *Config) error {
 	database, _ := parseDSNDatabase(cfg.DSN)
-	if database == defaultDatabase {
+	if !cfg.CreateSchema || database == defaultDatabase {
 		return nil
 	}
 	// use default database to create new database
@@ -207,6 +187,9 @@ func createDatabase(cfg *Config) error {
 }
 
 func createLogsTable(cfg *Config, db *sql.DB) error {
+	if !cfg.CreateSchema {
+		return nil
+	}
 	if _, err := db.Exec(renderCreateLogsTableSQL(cfg)); err != nil {
 		return fmt.Errorf("exec create logs table sql: %w", err)
 	}
@@ -214,15 +197,12 @@ func createLogsTable(cfg *Config, db *sql.DB) error {
 }
 
 func renderCreateLogsTableSQL(cfg *Config) string {
-	var ttlExpr string
-	if cfg.TTLDays > 0 {
-		ttlExpr = fmt.Sprintf(`TTL toDateTime(Timestamp) + toIntervalDay(%d)`, cfg.TTLDays)
-	}
-	return fmt.Sprintf(createLogsTableSQL, cfg.LogsTableName, ttlExpr)
+	ttlExpr := renderTTLExpr(cfg.TTLDays, cfg.LogsSchema.column("Timestamp"))
+	return cfg.LogsSchema.renderCreateTableSQL(createLogsTableSQL, cfg.LogsTableName, ttlExpr)
 }
 
 func renderInsertLogsSQL(cfg *Config) string {
-	return fmt.Sprintf(insertLogsSQLTemplate, cfg.LogsTableName)
+	return cfg.LogsSchema.renderInsertSQL(cfg.LogsTableName, logsColumns)
 }
 
 func doWithTx(_ context.Context, db *sql.DB, fn func(tx *sql.Tx) error) error {
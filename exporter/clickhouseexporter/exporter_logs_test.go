@@ -99,6 +99,43 @@ func TestExporter_pushLogsData(t *testing.T) {
 
 		require.Equal(t, 3, items)
 	})
+	t.Run("custom schema", func(t *testing.T) {
+		var queries []string
+		initClickhouseTestServer(t, func(query string, values []driver.Value) error {
+			queries = append(queries, query)
+			return nil
+		})
+
+		exporter := newTestLogsExporter(t, defaultDSN, func(cfg *Config) {
+			cfg.TTLDays = 3
+			cfg.LogsSchema = TableSchema{
+				CreateSQL: "CREATE TABLE {table} (ts DateTime64(9), Message String) ENGINE MergeTree() {ttl} ORDER BY ts",
+				Columns:   map[string]string{"Timestamp": "ts", "Body": "Message"},
+			}
+		})
+		mustPushLogsData(t, exporter, simpleLogs(1))
+
+		require.Len(t, queries, 3)
+		require.Equal(t, "CREATE DATABASE IF NOT EXISTS otel", queries[0])
+		require.Equal(t, "CREATE TABLE otel_logs (ts DateTime64(9), Message String) ENGINE MergeTree() TTL toDateTime(ts) + toIntervalDay(3) ORDER BY ts", queries[1])
+		require.Equal(t, "INSERT INTO otel_logs (ts, TraceId, SpanId, TraceFlags, SeverityText, SeverityNumber, ServiceName, Message, ResourceAttributes, LogAttributes) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", queries[2])
+	})
+	t.Run("without creating the schema", func(t *testing.T) {
+		var queries []string
+		initClickhouseTestServer(t, func(query string, values []driver.Value) error {
+			queries = append(queries, query)
+			return nil
+		})
+
+		exporter := newTestLogsExporter(t, defaultDSN, func(cfg *Config) {
+			cfg.CreateSchema = false
+		})
+		mustPushLogsData(t, exporter, simpleLogs(1))
+
+		require.Len(t, queries, 1)
+		require.True(t, strings.HasPrefix(queries[0], "INSERT INTO otel_logs"))
+	})
 }
 
 func newTestLogsExporter(t *testing.T, dsn string, fns ...func(*Config)) *logsExporter {
@@ -18,7 +18,6 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"strings"
 	"time"
 
 	_ "github.com/ClickHouse/clickhouse-go/v2" // For register database driver.
@@ -202,50 +201,6 @@ PARTITION BY toDate(Timestamp)
 ORDER BY (ServiceName, SpanName, toUnixTimestamp(Timestamp), TraceId)
 SETTINGS index_granularity=8192, ttl_only_drop_parts = 1;
 `
-	// language=ClickHouse SQL
-	insertTracesSQLTemplate = `INSERT INTO %s (
-                        Timestamp,
-                        TraceId,
-                        SpanId,
-                        ParentSpanId,
-                        TraceState,
-                        SpanName,
-                        SpanKind,
-                        ServiceName,
-                        ResourceAttributes,
-                        SpanAttributes,
-                        Duration,
-                        StatusCode,
-                        StatusMessage,
-                        Events.Timestamp,
-                        Events.Name,
-                        Events.Attributes,
-                        Links.TraceId,
-                        Links.SpanId,
-                        Links.TraceState,
-                        Links.Attributes
-                        ) VALUES (
-                                  ?,
-                                  ?,
-                                  ?,
-                                  ?,
-                                  ?,
-                                  ?,
-                                  ?,
-                                  ?,
-                                  ?,
-                                  ?,
-                                  ?,
-                                  ?,
-                                  ?,
-                                  ?,
-                                  ?,
-                                  ?,
-                                  ?,
-                                  ?,
-                                  ?,
-                                  ?
-                                  )`
 )
 
 const (
@@ -264,17 +219,20 @@ SETTINGS index_granularity=8192;
 CREATE MATERIALIZED VIEW IF NOT EXISTS %s_trace_id_ts_mv
 TO %s.%s_trace_id_ts
 AS SELECT
-TraceId,
-min(Timestamp) as Start,
-max(Timestamp) as End
+%s AS TraceId,
+min(%s) as Start,
+max(%s) as End
 FROM
 %s.%s
-WHERE TraceId!=''
-GROUP BY TraceId;
+WHERE %s!=''
+GROUP BY %s;
 `
 )
 
 func createTracesTable(cfg *Config, db *sql.DB) error {
+	if !cfg.CreateSchema {
+		return nil
+	}
 	if _, err := db.Exec(renderCreateTracesTableSQL(cfg)); err != nil {
 		return fmt.Errorf("exec create traces table sql: %w", err)
 	}
@@ -288,27 +246,22 @@ func createTracesTable(cfg *Config, db *sql.DB) error {
 }
 
 func renderInsertTracesSQL(cfg *Config) string {
-	return fmt.Sprintf(strings.ReplaceAll(insertTracesSQLTemplate, "'", "`"), cfg.TracesTableName)
+	return cfg.TracesSchema.renderInsertSQL(cfg.TracesTableName, tracesColumns)
 }
 
 func renderCreateTracesTableSQL(cfg *Config) string {
-	var ttlExpr string
-	if cfg.TTLDays > 0 {
-		ttlExpr = fmt.Sprintf(`TTL toDateTime(Timestamp) + toIntervalDay(%d)`, cfg.TTLDays)
-	}
-	return fmt.Sprintf(createTracesTableSQL, cfg.TracesTableName, ttlExpr)
+	ttlExpr := renderTTLExpr(cfg.TTLDays, cfg.TracesSchema.column("Timestamp"))
+	return cfg.TracesSchema.renderCreateTableSQL(createTracesTableSQL, cfg.TracesTableName, ttlExpr)
 }
 
 func renderCreateTraceIDTsTableSQL(cfg *Config) string {
-	var ttlExpr string
-	if cfg.TTLDays > 0 {
-		ttlExpr = fmt.Sprintf(`TTL toDateTime(Start) + toIntervalDay(%d)`, cfg.TTLDays)
-	}
-	return fmt.Sprintf(createTraceIDTsTableSQL, cfg.TracesTableName, ttlExpr)
+	return fmt.Sprintf(createTraceIDTsTableSQL, cfg.TracesTableName, renderTTLExpr(cfg.TTLDays, "Start"))
 }
 
 func renderTraceIDTsMaterializedViewSQL(cfg *Config) string {
 	database, _ := parseDSNDatabase(cfg.DSN)
 	return fmt.Sprintf(createTraceIDTsMaterializedViewSQL, cfg.TracesTableName,
-		database, cfg.TracesTableName, database, cfg.TracesTableName)
+		database, cfg.TracesTableName,
+		cfg.TracesSchema.column("TraceId"), cfg.TracesSchema.column("Timestamp"), cfg.TracesSchema.column("Timestamp"),
+		database, cfg.TracesTableName, cfg.TracesSchema.column("TraceId"), cfg.TracesSchema.column("TraceId"))
 }
@@ -45,6 +45,27 @@ func TestExporter_pushTracesData(t *testing.T) {
 
 		require.Equal(t, 3, items)
 	})
+	t.Run("custom schema", func(t *testing.T) {
+		var queries []string
+		initClickhouseTestServer(t, func(query string, values []driver.Value) error {
+			queries = append(queries, query)
+			return nil
+		})
+
+		exporter := newTestTracesExporter(t, defaultDSN, func(cfg *Config) {
+			cfg.TracesSchema = TableSchema{
+				CreateSQL: "CREATE TABLE {table} (StartTime DateTime64(9), TraceID String) ENGINE MergeTree() ORDER BY StartTime",
+				Columns:   map[string]string{"Timestamp": "StartTime", "TraceId": "TraceID"},
+			}
+		})
+		mustPushTracesData(t, exporter, simpleTraces(1))
+
+		require.Len(t, queries, 5)
+		require.Equal(t, "CREATE TABLE otel_traces (StartTime DateTime64(9), TraceID String) ENGINE MergeTree() ORDER BY StartTime", queries[1])
+		require.Contains(t, queries[3], "TraceID AS TraceId,\nmin(StartTime) as Start,\nmax(StartTime) as End")
+		require.Contains(t, queries[3], "WHERE TraceID!=''\nGROUP BY TraceID;")
+		require.True(t, strings.HasPrefix(queries[4], "INSERT INTO otel_traces (StartTime, TraceID, SpanId,"))
+	})
 }
 
 func newTestTracesExporter(t *testing.T, dsn string, fns ...func(*Config)) *tracesExporter {
@@ -49,6 +49,8 @@ func createDefaultConfig() component.ExporterConfig {
 		LogsTableName:    "otel_logs",
 		TracesTableName:  "otel_traces",
 		TTLDays:          7,
+		CreateSchema:     true,
+		AsyncInsert:      AsyncInsertSettings{Wait: true},
 	}
 }
 
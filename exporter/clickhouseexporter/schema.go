@@ -0,0 +1,129 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clickhouseexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/clickhouseexporter"
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// TableSchema overrides the schema of a table.
+type TableSchema struct {
+	// CreateSQL is the statement creating the table, replacing the default one.
+	// `{table}` is replaced by the table name, and `{ttl}` by the TTL clause.
+	CreateSQL string `mapstructure:"create_sql"`
+	// Columns renames the columns the data is inserted into. The keys are the
+	// default column names, e.g. `Body`, and the values the column names of the table.
+	Columns map[string]string `mapstructure:"columns"`
+}
+
+var (
+	logsColumns = []string{
+		"Timestamp",
+		"TraceId",
+		"SpanId",
+		"TraceFlags",
+		"SeverityText",
+		"SeverityNumber",
+		"ServiceName",
+		"Body",
+		"ResourceAttributes",
+		"LogAttributes",
+	}
+	tracesColumns = []string{
+		"Timestamp",
+		"TraceId",
+		"SpanId",
+		"ParentSpanId",
+		"TraceState",
+		"SpanName",
+		"SpanKind",
+		"ServiceName",
+		"ResourceAttributes",
+		"SpanAttributes",
+		"Duration",
+		"StatusCode",
+		"StatusMessage",
+		"Events.Timestamp",
+		"Events.Name",
+		"Events.Attributes",
+		"Links.TraceId",
+		"Links.SpanId",
+		"Links.TraceState",
+		"Links.Attributes",
+	}
+)
+
+func (s TableSchema) validate(name string, columns []string, createSchema bool) error {
+	known := make(map[string]bool, len(columns))
+	for _, column := range columns {
+		known[column] = true
+	}
+	// sort the columns so that the error is stable
+	keys := make([]string, 0, len(s.Columns))
+	for column := range s.Columns {
+		keys = append(keys, column)
+	}
+	sort.Strings(keys)
+
+	renamed := false
+	for _, column := range keys {
+		if !known[column] {
+			return fmt.Errorf("%s::columns: unknown column %q", name, column)
+		}
+		if s.Columns[column] == "" {
+			return fmt.Errorf("%s::columns: column %q must not be renamed to an empty name", name, column)
+		}
+		renamed = renamed || s.Columns[column] != column
+	}
+	if renamed && createSchema && s.CreateSQL == "" {
+		return fmt.Errorf("%s::create_sql must be set to rename columns when create_schema is enabled", name)
+	}
+	return nil
+}
+
+// column returns the name of the column the values of the default column are inserted into.
+func (s TableSchema) column(name string) string {
+	if column, ok := s.Columns[name]; ok {
+		return column
+	}
+	return name
+}
+
+func (s TableSchema) renderCreateTableSQL(defaultSQL, table, ttlExpr string) string {
+	if s.CreateSQL == "" {
+		return fmt.Sprintf(defaultSQL, table, ttlExpr)
+	}
+	return strings.NewReplacer("{table}", table, "{ttl}", ttlExpr).Replace(s.CreateSQL)
+}
+
+func (s TableSchema) renderInsertSQL(table string, columns []string) string {
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		names[i] = s.column(column)
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(columns)), ", ")
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", table, strings.Join(names, ", "), placeholders)
+}
+
+// renderTTLExpr returns the TTL clause deleting rows older than the TTL, or an empty
+// string if the data is kept forever.
+func renderTTLExpr(ttlDays uint, timestampColumn string) string {
+	if ttlDays == 0 {
+		return ""
+	}
+	return fmt.Sprintf(`TTL toDateTime(%s) + toIntervalDay(%d)`, timestampColumn, ttlDays)
+}
@@ -316,6 +316,15 @@ type HostMetadataConfig struct {
 	Tags []string `mapstructure:"tags"`
 }
 
+// KubernetesConfig defines how the Kubernetes metadata added by the k8sattributes processor is used.
+type KubernetesConfig struct {
+	// TagsFromPodLabels derives tags from the pod labels extracted by the k8sattributes processor
+	// as `k8s.pod.labels.<label>` attributes. The unified service tagging labels set the `env`,
+	// `service` and `version` tags, and the recommended `app.kubernetes.io/*` labels set the
+	// `kube_app_*` tags. Attributes set on the resource take precedence over the labels.
+	TagsFromPodLabels bool `mapstructure:"tags_from_pod_labels"`
+}
+
 // LimitedTLSClientSetting is a subset of TLSClientSetting, see LimitedHTTPClientSettings for more details
 type LimitedTLSClientSettings struct {
 	// InsecureSkipVerify controls whether a client verifies the server's
@@ -353,6 +362,9 @@ type Config struct {
 	// HostMetadata defines the host metadata specific configuration
 	HostMetadata HostMetadataConfig `mapstructure:"host_metadata"`
 
+	// Kubernetes defines the use of the metadata added by the k8sattributes processor
+	Kubernetes KubernetesConfig `mapstructure:"kubernetes"`
+
 	// OnlyMetadata defines whether to only send metadata
 	// This is useful for agent-collector setups, so that
 	// metadata about a host is sent to the backend even
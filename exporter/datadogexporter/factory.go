@@ -200,6 +200,7 @@ func (f *factory) createMetricsExporter(
 			cancel()
 			return nil
 		}),
+		exporterhelper.WithCapabilities(capabilitiesFromConfig(cfg)),
 	)
 	if err != nil {
 		return nil, err
@@ -268,6 +269,7 @@ func (f *factory) createTracesExporter(
 		exporterhelper.WithRetry(exporterhelper.RetrySettings{Enabled: false}),
 		exporterhelper.WithQueue(cfg.QueueSettings),
 		exporterhelper.WithShutdown(stop),
+		exporterhelper.WithCapabilities(capabilitiesFromConfig(cfg)),
 	)
 }
 
@@ -316,5 +318,12 @@ func (f *factory) createLogsExporter(
 			cancel()
 			return nil
 		}),
+		exporterhelper.WithCapabilities(capabilitiesFromConfig(cfg)),
 	)
 }
+
+// capabilitiesFromConfig returns the capabilities of the exporters. Deriving tags
+// from pod labels adds resource attributes to the exported data.
+func capabilitiesFromConfig(cfg *Config) consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: cfg.Kubernetes.TagsFromPodLabels}
+}
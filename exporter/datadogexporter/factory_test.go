@@ -251,6 +251,9 @@ func TestLoadConfig(t *testing.T) {
 					HostnameSource: HostnameSourceConfigOrSystem,
 					Tags:           []string{"example:tag"},
 				},
+				Kubernetes: KubernetesConfig{
+					TagsFromPodLabels: true,
+				},
 			},
 		},
 	}
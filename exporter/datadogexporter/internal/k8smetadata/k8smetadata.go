@@ -0,0 +1,95 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package k8smetadata maps the Kubernetes metadata added by the k8sattributes processor
+// to the resource attributes which Datadog tags are derived from.
+package k8smetadata // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/k8smetadata"
+
+import (
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+)
+
+// podLabelPrefix is the prefix of the attributes holding the pod labels
+// extracted by the k8sattributes processor.
+const podLabelPrefix = "k8s.pod.labels."
+
+// unknownServicePrefix is the prefix of the service name set by SDKs when none is configured.
+const unknownServicePrefix = "unknown_service"
+
+// labelMappings maps pod labels to the attributes the Datadog translation turns into tags.
+// The labels follow the unified service tagging and the Kubernetes recommended labels:
+// https://docs.datadoghq.com/getting_started/tagging/unified_service_tagging/?tab=kubernetes
+// https://kubernetes.io/docs/concepts/overview/working-with-objects/common-labels/
+var labelMappings = []struct {
+	label     string
+	attribute string
+}{
+	{label: "tags.datadoghq.com/env", attribute: conventions.AttributeDeploymentEnvironment},
+	{label: "tags.datadoghq.com/service", attribute: conventions.AttributeServiceName},
+	{label: "tags.datadoghq.com/version", attribute: conventions.AttributeServiceVersion},
+	{label: "app.kubernetes.io/name", attribute: "app.kubernetes.io/name"},
+	{label: "app.kubernetes.io/instance", attribute: "app.kubernetes.io/instance"},
+	{label: "app.kubernetes.io/version", attribute: "app.kubernetes.io/version"},
+	{label: "app.kubernetes.io/part-of", attribute: "app.kubernetes.io/part-of"},
+	{label: "app.kubernetes.io/managed-by", attribute: "app.kubernetes.io/managed-by"},
+}
+
+// AddAttributes adds to attrs the attributes derived from the pod labels, unless attrs
+// has them already. A service name defaulted by an SDK is replaced.
+func AddAttributes(attrs pcommon.Map) {
+	for _, m := range labelMappings {
+		value, ok := attrs.Get(podLabelPrefix + m.label)
+		if !ok || value.AsString() == "" {
+			continue
+		}
+		if existing, ok := attrs.Get(m.attribute); ok && !isDefaultServiceName(m.attribute, existing) {
+			continue
+		}
+		attrs.PutStr(m.attribute, value.AsString())
+	}
+}
+
+func isDefaultServiceName(attribute string, value pcommon.Value) bool {
+	return attribute == conventions.AttributeServiceName && strings.HasPrefix(value.Str(), unknownServicePrefix)
+}
+
+// Traces adds the attributes derived from the pod labels to the resources of td.
+func Traces(td ptrace.Traces) {
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		AddAttributes(rss.At(i).Resource().Attributes())
+	}
+}
+
+// Metrics adds the attributes derived from the pod labels to the resources of md.
+func Metrics(md pmetric.Metrics) {
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		AddAttributes(rms.At(i).Resource().Attributes())
+	}
+}
+
+// Logs adds the attributes derived from the pod labels to the resources of ld.
+func Logs(ld plog.Logs) {
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		AddAttributes(rls.At(i).Resource().Attributes())
+	}
+}
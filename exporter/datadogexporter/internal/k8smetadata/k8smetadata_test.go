@@ -0,0 +1,120 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8smetadata
+
+import (
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/otlp/model/attributes"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+)
+
+func TestAddAttributes(t *testing.T) {
+	tests := []struct {
+		name  string
+		attrs map[string]interface{}
+		want  map[string]interface{}
+	}{
+		{
+			name:  "no labels",
+			attrs: map[string]interface{}{conventions.AttributeK8SPodName: "checkout-1"},
+			want:  map[string]interface{}{conventions.AttributeK8SPodName: "checkout-1"},
+		},
+		{
+			name: "unified service tagging labels",
+			attrs: map[string]interface{}{
+				"k8s.pod.labels.tags.datadoghq.com/env":     "prod",
+				"k8s.pod.labels.tags.datadoghq.com/service": "checkout",
+				"k8s.pod.labels.tags.datadoghq.com/version": "1.2.0",
+			},
+			want: map[string]interface{}{
+				"k8s.pod.labels.tags.datadoghq.com/env":     "prod",
+				"k8s.pod.labels.tags.datadoghq.com/service": "checkout",
+				"k8s.pod.labels.tags.datadoghq.com/version": "1.2.0",
+				conventions.AttributeDeploymentEnvironment:  "prod",
+				conventions.AttributeServiceName:            "checkout",
+				conventions.AttributeServiceVersion:         "1.2.0",
+			},
+		},
+		{
+			name: "existing attributes take precedence",
+			attrs: map[string]interface{}{
+				"k8s.pod.labels.tags.datadoghq.com/env":     "prod",
+				"k8s.pod.labels.tags.datadoghq.com/service": "checkout",
+				conventions.AttributeDeploymentEnvironment:  "staging",
+				conventions.AttributeServiceName:            "cart",
+			},
+			want: map[string]interface{}{
+				"k8s.pod.labels.tags.datadoghq.com/env":     "prod",
+				"k8s.pod.labels.tags.datadoghq.com/service": "checkout",
+				conventions.AttributeDeploymentEnvironment:  "staging",
+				conventions.AttributeServiceName:            "cart",
+			},
+		},
+		{
+			name: "default service name",
+			attrs: map[string]interface{}{
+				"k8s.pod.labels.tags.datadoghq.com/service": "checkout",
+				conventions.AttributeServiceName:            "unknown_service:java",
+			},
+			want: map[string]interface{}{
+				"k8s.pod.labels.tags.datadoghq.com/service": "checkout",
+				conventions.AttributeServiceName:            "checkout",
+			},
+		},
+		{
+			name: "recommended labels",
+			attrs: map[string]interface{}{
+				"k8s.pod.labels.app.kubernetes.io/name":    "checkout",
+				"k8s.pod.labels.app.kubernetes.io/part-of": "shop",
+				"k8s.pod.labels.app.kubernetes.io/other":   "ignored",
+				"k8s.pod.labels.tags.datadoghq.com/env":    "",
+			},
+			want: map[string]interface{}{
+				"k8s.pod.labels.app.kubernetes.io/name":    "checkout",
+				"k8s.pod.labels.app.kubernetes.io/part-of": "shop",
+				"k8s.pod.labels.app.kubernetes.io/other":   "ignored",
+				"k8s.pod.labels.tags.datadoghq.com/env":    "",
+				"app.kubernetes.io/name":                   "checkout",
+				"app.kubernetes.io/part-of":                "shop",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attrs := pcommon.NewMap()
+			attrs.FromRaw(tt.attrs)
+			AddAttributes(attrs)
+			assert.Equal(t, tt.want, attrs.AsRaw())
+		})
+	}
+}
+
+func TestTracesTags(t *testing.T) {
+	td := ptrace.NewTraces()
+	attrs := td.ResourceSpans().AppendEmpty().Resource().Attributes()
+	attrs.PutStr(conventions.AttributeK8SPodName, "checkout-1")
+	attrs.PutStr("k8s.pod.labels.tags.datadoghq.com/env", "prod")
+	attrs.PutStr("k8s.pod.labels.app.kubernetes.io/name", "checkout")
+
+	Traces(td)
+	assert.ElementsMatch(t,
+		[]string{"pod_name:checkout-1", "env:prod", "kube_app_name:checkout"},
+		attributes.TagsFromAttributes(td.ResourceSpans().At(0).Resource().Attributes()),
+	)
+}
@@ -26,6 +26,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/plog"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/clientutil"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/k8smetadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/logs"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/scrub"
@@ -69,6 +70,9 @@ var _ consumer.ConsumeLogsFunc = (*logsExporter)(nil).consumeLogs
 // consumeLogs is implementation of cosumer.ConsumeLogsFunc
 func (exp *logsExporter) consumeLogs(ctx context.Context, ld plog.Logs) (err error) {
 	defer func() { err = exp.scrubber.Scrub(err) }()
+	if exp.cfg.Kubernetes.TagsFromPodLabels {
+		k8smetadata.Logs(ld)
+	}
 	if exp.cfg.HostMetadata.Enabled {
 		// start host metadata with resource attributes from
 		// the first payload.
@@ -33,6 +33,7 @@ import (
 	"gopkg.in/zorkian/go-datadog-api.v2"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/clientutil"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/k8smetadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/metrics"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/metrics/sketches"
@@ -160,6 +161,9 @@ func (exp *metricsExporter) PushMetricsDataScrubbed(ctx context.Context, md pmet
 }
 
 func (exp *metricsExporter) PushMetricsData(ctx context.Context, md pmetric.Metrics) error {
+	if exp.cfg.Kubernetes.TagsFromPodLabels {
+		k8smetadata.Metrics(md)
+	}
 	// Start host metadata with resource attributes from
 	// the first payload.
 	if exp.cfg.HostMetadata.Enabled {
@@ -34,6 +34,7 @@ import (
 	"gopkg.in/zorkian/go-datadog-api.v2"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/clientutil"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/k8smetadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/metrics"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter/internal/scrub"
@@ -105,6 +106,9 @@ func (exp *traceExporter) consumeTraces(
 	td ptrace.Traces,
 ) (err error) {
 	defer func() { err = exp.scrubber.Scrub(err) }()
+	if exp.cfg.Kubernetes.TagsFromPodLabels {
+		k8smetadata.Traces(td)
+	}
 	if exp.cfg.HostMetadata.Enabled {
 		// start host metadata with resource attributes from
 		// the first payload.
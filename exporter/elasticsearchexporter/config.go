@@ -58,6 +58,10 @@ type Config struct {
 	// This setting is required when traces pipelines used.
 	TracesIndex string `mapstructure:"traces_index"`
 
+	// DataStream configures routing documents to data streams computed from resource
+	// attributes. When enabled, it takes precedence over the index settings.
+	DataStream DataStreamSettings `mapstructure:"data_stream"`
+
 	// Pipeline configures the ingest node pipeline name that should be used to process the
 	// events.
 	//
@@ -150,6 +154,29 @@ type RetrySettings struct {
 	MaxInterval time.Duration `mapstructure:"max_interval"`
 }
 
+// DataStreamSettings defines how documents are routed to data streams named after the
+// Elastic data stream naming scheme `<type>-<dataset>-<namespace>`, where type is `logs`
+// or `traces`. Such names match the index templates shipped with Elasticsearch, which
+// manage the data streams with index lifecycle management (ILM).
+//
+// https://www.elastic.co/guide/en/fleet/current/data-streams.html#data-streams-naming-scheme
+type DataStreamSettings struct {
+	// Enabled routes documents to data streams instead of the configured index.
+	Enabled bool `mapstructure:"enabled"`
+
+	// DatasetAttribute is the resource attribute the dataset is taken from.
+	DatasetAttribute string `mapstructure:"dataset_attribute"`
+
+	// NamespaceAttribute is the resource attribute the namespace is taken from.
+	NamespaceAttribute string `mapstructure:"namespace_attribute"`
+
+	// DefaultDataset is the dataset of resources without the dataset attribute.
+	DefaultDataset string `mapstructure:"default_dataset"`
+
+	// DefaultNamespace is the namespace of resources without the namespace attribute.
+	DefaultNamespace string `mapstructure:"default_namespace"`
+}
+
 type MappingsSettings struct {
 	// Mode configures the field mappings.
 	Mode string `mapstructure:"mode"`
@@ -177,6 +204,7 @@ const (
 var (
 	errConfigNoEndpoint    = errors.New("endpoints or cloudid must be specified")
 	errConfigEmptyEndpoint = errors.New("endpoints must not include empty entries")
+	errConfigDataStream    = errors.New("data_stream default_dataset and default_namespace must not be empty")
 )
 
 func (m MappingMode) String() string {
@@ -222,6 +250,10 @@ func (cfg *Config) Validate() error {
 		}
 	}
 
+	if cfg.DataStream.Enabled && (cfg.DataStream.DefaultDataset == "" || cfg.DataStream.DefaultNamespace == "") {
+		return errConfigDataStream
+	}
+
 	if _, ok := mappingModes[cfg.Mapping.Mode]; !ok {
 		return fmt.Errorf("unknown mapping mode %v", cfg.Mapping.Mode)
 	}
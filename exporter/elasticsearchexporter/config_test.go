@@ -44,6 +44,12 @@ func TestLoad_DeprecatedIndexConfigOption(t *testing.T) {
 		LogsIndex:        "logs-generic-default",
 		TracesIndex:      "traces-generic-default",
 		Pipeline:         "mypipeline",
+		DataStream: DataStreamSettings{
+			DatasetAttribute:   "service.name",
+			NamespaceAttribute: "service.namespace",
+			DefaultDataset:     "generic",
+			DefaultNamespace:   "default",
+		},
 		HTTPClientSettings: HTTPClientSettings{
 			Authentication: AuthenticationSettings{
 				User:     "elastic",
@@ -102,6 +108,12 @@ func TestLoadConfig(t *testing.T) {
 				LogsIndex:        "logs-generic-default",
 				TracesIndex:      "trace_index",
 				Pipeline:         "mypipeline",
+				DataStream: DataStreamSettings{
+					DatasetAttribute:   "service.name",
+					NamespaceAttribute: "service.namespace",
+					DefaultDataset:     "generic",
+					DefaultNamespace:   "default",
+				},
 				HTTPClientSettings: HTTPClientSettings{
 					Authentication: AuthenticationSettings{
 						User:     "elastic",
@@ -142,6 +154,13 @@ func TestLoadConfig(t *testing.T) {
 				LogsIndex:        "my_log_index",
 				TracesIndex:      "traces-generic-default",
 				Pipeline:         "mypipeline",
+				DataStream: DataStreamSettings{
+					Enabled:            true,
+					DatasetAttribute:   "service.name",
+					NamespaceAttribute: "k8s.namespace.name",
+					DefaultDataset:     "generic",
+					DefaultNamespace:   "default",
+				},
 				HTTPClientSettings: HTTPClientSettings{
 					Authentication: AuthenticationSettings{
 						User:     "elastic",
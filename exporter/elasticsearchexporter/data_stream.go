@@ -0,0 +1,94 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearchexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter"
+
+import (
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+const (
+	dataStreamTypeLogs   = "logs"
+	dataStreamTypeTraces = "traces"
+
+	// maxDataStreamPartLength is the maximum length in bytes of the dataset and the
+	// namespace of a data stream name.
+	maxDataStreamPartLength = 100
+)
+
+// dataStream identifies the data stream a document is routed to.
+type dataStream struct {
+	typ       string
+	dataset   string
+	namespace string
+}
+
+// index returns the name of the data stream, following the Elastic data stream
+// naming scheme `<type>-<dataset>-<namespace>`.
+func (ds dataStream) index() string {
+	return ds.typ + "-" + ds.dataset + "-" + ds.namespace
+}
+
+// dataStreamRouter computes the data stream of documents from their resource attributes.
+type dataStreamRouter struct {
+	typ      string
+	settings DataStreamSettings
+}
+
+func newDataStreamRouter(typ string, settings DataStreamSettings) *dataStreamRouter {
+	if !settings.Enabled {
+		return nil
+	}
+	return &dataStreamRouter{typ: typ, settings: settings}
+}
+
+// route returns the data stream of documents with the given resource. Resources which
+// do not have the dataset or namespace attribute use the configured defaults.
+func (r *dataStreamRouter) route(resource pcommon.Resource) dataStream {
+	return dataStream{
+		typ:       r.typ,
+		dataset:   dataStreamPart(resource, r.settings.DatasetAttribute, r.settings.DefaultDataset),
+		namespace: dataStreamPart(resource, r.settings.NamespaceAttribute, r.settings.DefaultNamespace),
+	}
+}
+
+func dataStreamPart(resource pcommon.Resource, attribute, defaultValue string) string {
+	value := defaultValue
+	if v, ok := resource.Attributes().Get(attribute); ok && v.AsString() != "" {
+		value = v.AsString()
+	}
+	return sanitizeDataStreamPart(value)
+}
+
+// sanitizeDataStreamPart makes value usable as the dataset or namespace of a data
+// stream name: it is lowercased, the characters which are not allowed in index names
+// and the `-` separator are replaced with `_`, and it is truncated to 100 bytes.
+func sanitizeDataStreamPart(value string) string {
+	value = strings.Map(func(r rune) rune {
+		switch r {
+		case '\\', '/', '*', '?', '"', '<', '>', '|', ' ', ',', '#', ':', '-':
+			return '_'
+		default:
+			return r
+		}
+	}, strings.ToLower(value))
+	if len(value) > maxDataStreamPartLength {
+		value = value[:maxDataStreamPartLength]
+		// do not leave a partial UTF-8 sequence behind
+		value = strings.ToValidUTF8(value, "")
+	}
+	return value
+}
@@ -0,0 +1,70 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearchexporter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestDataStreamRouter(t *testing.T) {
+	settings := withDefaultConfig().DataStream
+	settings.Enabled = true
+	router := newDataStreamRouter(dataStreamTypeLogs, settings)
+
+	tests := map[string]struct {
+		attributes map[string]interface{}
+		want       string
+	}{
+		"defaults": {
+			want: "logs-generic-default",
+		},
+		"dataset and namespace": {
+			attributes: map[string]interface{}{"service.name": "checkout", "service.namespace": "shop"},
+			want:       "logs-checkout-shop",
+		},
+		"empty values use the defaults": {
+			attributes: map[string]interface{}{"service.name": "", "service.namespace": "shop"},
+			want:       "logs-generic-shop",
+		},
+		"sanitized": {
+			attributes: map[string]interface{}{"service.name": "Cart-Service", "service.namespace": "team a/b"},
+			want:       "logs-cart_service-team_a_b",
+		},
+		"non-string values": {
+			attributes: map[string]interface{}{"service.name": "checkout", "service.namespace": 42},
+			want:       "logs-checkout-42",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resource := pcommon.NewResource()
+			resource.Attributes().FromRaw(test.attributes)
+			assert.Equal(t, test.want, router.route(resource).index())
+		})
+	}
+
+	assert.Nil(t, newDataStreamRouter(dataStreamTypeLogs, withDefaultConfig().DataStream))
+}
+
+func TestSanitizeDataStreamPart(t *testing.T) {
+	assert.Equal(t, "a_b_c_d_e_f_g_h_i_j_k_l_m", sanitizeDataStreamPart(`a\b/c*d?e"f<g>h|i j,k#l:m`))
+	assert.Equal(t, strings.Repeat("x", maxDataStreamPartLength), sanitizeDataStreamPart(strings.Repeat("x", 150)))
+	// truncating does not split multi-byte characters
+	assert.Equal(t, strings.Repeat("x", 99), sanitizeDataStreamPart(strings.Repeat("x", 99)+"é"))
+}
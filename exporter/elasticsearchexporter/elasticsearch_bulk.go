@@ -249,9 +249,15 @@ func pushDocuments(ctx context.Context, logger *zap.Logger, index string, docume
 				zap.NamedError("reason", err))
 
 		default:
-			logger.Error(fmt.Sprintf("Drop dcos: failed to index: %#v", resp.Error),
+			// The item was rejected by Elasticsearch, e.g. because of a mapping conflict,
+			// or it was still failing with a retryable status after the last attempt.
+			logger.Error("Drop docs: failed to index",
+				zap.String("name", index),
 				zap.Int("attempt", attempts),
-				zap.Int("status", resp.Status))
+				zap.Int("status", resp.Status),
+				zap.String("error.type", resp.Error.Type),
+				zap.String("error.reason", resp.Error.Reason),
+				zap.String("error.cause", resp.Error.Cause.Reason))
 		}
 	}
 
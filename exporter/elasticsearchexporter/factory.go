@@ -29,6 +29,9 @@ const (
 	typeStr            = "elasticsearch"
 	defaultLogsIndex   = "logs-generic-default"
 	defaultTracesIndex = "traces-generic-default"
+	// The defaults of the data stream routing, matching the default indices.
+	defaultDataStreamDataset   = "generic"
+	defaultDataStreamNamespace = "default"
 	// The stability level of the exporter.
 	stability = component.StabilityLevelBeta
 )
@@ -52,6 +55,12 @@ func createDefaultConfig() component.ExporterConfig {
 		Index:       "",
 		LogsIndex:   defaultLogsIndex,
 		TracesIndex: defaultTracesIndex,
+		DataStream: DataStreamSettings{
+			DatasetAttribute:   "service.name",
+			NamespaceAttribute: "service.namespace",
+			DefaultDataset:     defaultDataStreamDataset,
+			DefaultNamespace:   defaultDataStreamNamespace,
+		},
 		Retry: RetrySettings{
 			Enabled:         true,
 			MaxRequests:     3,
@@ -30,6 +30,7 @@ type elasticsearchLogsExporter struct {
 	logger *zap.Logger
 
 	index       string
+	dataStream  *dataStreamRouter
 	maxAttempts int
 
 	client      *esClientCurrent
@@ -61,8 +62,8 @@ func newLogsExporter(logger *zap.Logger, cfg *Config) (*elasticsearchLogsExporte
 		maxAttempts = cfg.Retry.MaxRequests
 	}
 
-	// TODO: Apply encoding and field mapping settings.
-	model := &encodeModel{dedup: true, dedot: false}
+	// TODO: Apply encoding and field mapping settings other than the mode.
+	model := &encodeModel{dedup: true, dedot: false, mode: mappingModes[cfg.Mapping.Mode]}
 
 	indexStr := cfg.LogsIndex
 	if cfg.Index != "" {
@@ -73,6 +74,7 @@ func newLogsExporter(logger *zap.Logger, cfg *Config) (*elasticsearchLogsExporte
 		client:      client,
 		bulkIndexer: bulkIndexer,
 		index:       indexStr,
+		dataStream:  newDataStreamRouter(dataStreamTypeLogs, cfg.DataStream),
 		maxAttempts: maxAttempts,
 		model:       model,
 	}
@@ -90,11 +92,12 @@ func (e *elasticsearchLogsExporter) pushLogsData(ctx context.Context, ld plog.Lo
 	for i := 0; i < rls.Len(); i++ {
 		rl := rls.At(i)
 		resource := rl.Resource()
+		index, ds := e.route(resource)
 		ills := rl.ScopeLogs()
 		for j := 0; j < ills.Len(); j++ {
 			logs := ills.At(j).LogRecords()
 			for k := 0; k < logs.Len(); k++ {
-				if err := e.pushLogRecord(ctx, resource, logs.At(k)); err != nil {
+				if err := e.pushLogRecord(ctx, resource, logs.At(k), index, ds); err != nil {
 					if cerr := ctx.Err(); cerr != nil {
 						return cerr
 					}
@@ -108,10 +111,20 @@ func (e *elasticsearchLogsExporter) pushLogsData(ctx context.Context, ld plog.Lo
 	return multierr.Combine(errs...)
 }
 
-func (e *elasticsearchLogsExporter) pushLogRecord(ctx context.Context, resource pcommon.Resource, record plog.LogRecord) error {
-	document, err := e.model.encodeLog(resource, record)
+// route returns the index and, when data stream routing is enabled, the data stream
+// the records of the given resource are indexed in.
+func (e *elasticsearchLogsExporter) route(resource pcommon.Resource) (string, *dataStream) {
+	if e.dataStream == nil {
+		return e.index, nil
+	}
+	ds := e.dataStream.route(resource)
+	return ds.index(), &ds
+}
+
+func (e *elasticsearchLogsExporter) pushLogRecord(ctx context.Context, resource pcommon.Resource, record plog.LogRecord, index string, ds *dataStream) error {
+	document, err := e.model.encodeLog(resource, record, ds)
 	if err != nil {
 		return fmt.Errorf("Failed to encode log event: %w", err)
 	}
-	return pushDocuments(ctx, e.logger, e.index, document, e.bulkIndexer, e.maxAttempts)
+	return pushDocuments(ctx, e.logger, index, document, e.bulkIndexer, e.maxAttempts)
 }
@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"runtime"
 	"sync"
@@ -26,6 +27,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest"
@@ -110,6 +112,14 @@ func TestExporter_New(t *testing.T) {
 			}),
 			want: failWithMessage("Addresses and CloudID are set"),
 		},
+		"fail with empty data stream defaults": {
+			config: withDefaultConfig(func(cfg *Config) {
+				cfg.Endpoints = []string{"test:9200"}
+				cfg.DataStream.Enabled = true
+				cfg.DataStream.DefaultNamespace = ""
+			}),
+			want: failWith(errConfigDataStream),
+		},
 		"create with custom request header": {
 			config: withDefaultConfig(func(cfg *Config) {
 				cfg.Endpoints = []string{"test:9200"}
@@ -162,6 +172,47 @@ func TestExporter_PushEvent(t *testing.T) {
 		rec.WaitItems(2)
 	})
 
+	t.Run("route to data streams", func(t *testing.T) {
+		rec := newBulkRecorder()
+		server := newESTestServer(t, func(docs []itemRequest) ([]itemResponse, error) {
+			rec.Record(docs)
+			return itemsAllOK(docs)
+		})
+
+		exporter := newTestExporter(t, server.URL, func(cfg *Config) {
+			cfg.DataStream.Enabled = true
+		})
+
+		ld := plog.NewLogs()
+		for _, service := range []string{"checkout", ""} {
+			rl := ld.ResourceLogs().AppendEmpty()
+			if service != "" {
+				rl.Resource().Attributes().PutStr("service.name", service)
+				rl.Resource().Attributes().PutStr("service.namespace", "shop")
+			}
+			rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("test")
+		}
+		require.NoError(t, exporter.pushLogsData(context.TODO(), ld))
+
+		rec.WaitItems(2)
+		var indices []string
+		for _, item := range rec.Items() {
+			var action struct {
+				Create struct {
+					Index string `json:"_index"`
+				} `json:"create"`
+			}
+			require.NoError(t, json.Unmarshal(item.Action, &action))
+			indices = append(indices, action.Create.Index)
+
+			var doc map[string]interface{}
+			require.NoError(t, json.Unmarshal(item.Document, &doc))
+			assert.Equal(t, "logs", doc["data_stream.type"])
+			assert.Equal(t, action.Create.Index, fmt.Sprintf("logs-%v-%v", doc["data_stream.dataset"], doc["data_stream.namespace"]))
+		}
+		assert.ElementsMatch(t, []string{"logs-checkout-shop", "logs-generic-default"}, indices)
+	})
+
 	t.Run("retry http request", func(t *testing.T) {
 		failures := 0
 		rec := newBulkRecorder()
@@ -27,8 +27,8 @@ import (
 )
 
 type mappingModel interface {
-	encodeLog(pcommon.Resource, plog.LogRecord) ([]byte, error)
-	encodeSpan(pcommon.Resource, ptrace.Span) ([]byte, error)
+	encodeLog(pcommon.Resource, plog.LogRecord, *dataStream) ([]byte, error)
+	encodeSpan(pcommon.Resource, ptrace.Span, *dataStream) ([]byte, error)
 }
 
 // encodeModel tries to keep the event as close to the original open telemetry semantics as is.
@@ -36,10 +36,14 @@ type mappingModel interface {
 //
 // Field deduplication and dedotting of attributes is supported by the encodeModel.
 //
+// In the MappingECS mode, records are mapped to the Elastic Common Schema instead, see
+// encodeLogECS and encodeSpanECS.
+//
 // See: https://github.com/open-telemetry/oteps/blob/master/text/logs/0097-log-data-model.md
 type encodeModel struct {
 	dedup bool
 	dedot bool
+	mode  MappingMode
 }
 
 const (
@@ -48,7 +52,11 @@ const (
 	attributeField = "attribute"
 )
 
-func (m *encodeModel) encodeLog(resource pcommon.Resource, record plog.LogRecord) ([]byte, error) {
+func (m *encodeModel) encodeLog(resource pcommon.Resource, record plog.LogRecord, ds *dataStream) ([]byte, error) {
+	if m.mode == MappingECS {
+		return m.encode(encodeLogECS(resource, record), ds)
+	}
+
 	var document objmodel.Document
 	document.AddTimestamp("@timestamp", record.Timestamp()) // We use @timestamp in order to ensure that we can index if the default data stream logs template is used.
 	document.AddID("TraceId", record.TraceID())
@@ -60,18 +68,14 @@ func (m *encodeModel) encodeLog(resource pcommon.Resource, record plog.LogRecord
 	document.AddAttributes("Attributes", record.Attributes())
 	document.AddAttributes("Resource", resource.Attributes())
 
-	if m.dedup {
-		document.Dedup()
-	} else if m.dedot {
-		document.Sort()
-	}
-
-	var buf bytes.Buffer
-	err := document.Serialize(&buf, m.dedot)
-	return buf.Bytes(), err
+	return m.encode(document, ds)
 }
 
-func (m *encodeModel) encodeSpan(resource pcommon.Resource, span ptrace.Span) ([]byte, error) {
+func (m *encodeModel) encodeSpan(resource pcommon.Resource, span ptrace.Span, ds *dataStream) ([]byte, error) {
+	if m.mode == MappingECS {
+		return m.encode(encodeSpanECS(resource, span), ds)
+	}
+
 	var document objmodel.Document
 	document.AddTimestamp("@timestamp", span.StartTimestamp()) // We use @timestamp in order to ensure that we can index if the default data stream logs template is used.
 	document.AddTimestamp("EndTimestamp", span.EndTimestamp())
@@ -85,6 +89,18 @@ func (m *encodeModel) encodeSpan(resource pcommon.Resource, span ptrace.Span) ([
 	document.AddAttributes("Attributes", span.Attributes())
 	document.AddAttributes("Resource", resource.Attributes())
 
+	return m.encode(document, ds)
+}
+
+// encode serializes the document. Documents routed to a data stream get the
+// `data_stream` fields Elasticsearch expects to match the data stream name.
+func (m *encodeModel) encode(document objmodel.Document, ds *dataStream) ([]byte, error) {
+	if ds != nil {
+		document.AddString("data_stream.type", ds.typ)
+		document.AddString("data_stream.dataset", ds.dataset)
+		document.AddString("data_stream.namespace", ds.namespace)
+	}
+
 	if m.dedup {
 		document.Dedup()
 	} else if m.dedot {
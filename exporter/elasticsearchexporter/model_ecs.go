@@ -0,0 +1,126 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearchexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter"
+
+import (
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter/internal/objmodel"
+)
+
+// ecsResourceFields maps the resource attributes of the OpenTelemetry semantic
+// conventions to their Elastic Common Schema fields. Attributes which are not
+// listed keep their name.
+//
+// See: https://www.elastic.co/guide/en/ecs/current/ecs-field-reference.html
+var ecsResourceFields = map[string]string{
+	"service.instance.id":     "service.node.name",
+	"deployment.environment":  "service.environment",
+	"telemetry.sdk.name":      "agent.name",
+	"telemetry.sdk.version":   "agent.version",
+	"host.name":               "host.hostname",
+	"host.arch":               "host.architecture",
+	"os.type":                 "host.os.platform",
+	"os.description":          "host.os.full",
+	"os.name":                 "host.os.name",
+	"os.version":              "host.os.version",
+	"process.executable.path": "process.executable",
+	"process.executable.name": "process.name",
+	"process.parent_pid":      "process.parent.pid",
+	"k8s.namespace.name":      "kubernetes.namespace",
+	"k8s.node.name":           "kubernetes.node.name",
+	"k8s.pod.name":            "kubernetes.pod.name",
+	"k8s.pod.uid":             "kubernetes.pod.uid",
+	"k8s.deployment.name":     "kubernetes.deployment.name",
+	"k8s.statefulset.name":    "kubernetes.statefulset.name",
+	"k8s.daemonset.name":      "kubernetes.daemonset.name",
+	"k8s.container.name":      "kubernetes.container.name",
+}
+
+// encodeLogECS maps a log record to an Elastic Common Schema document. The body
+// becomes the `message`, and the record attributes are added as they are. Fixed
+// fields take precedence over attributes of the same name when deduplicating.
+func encodeLogECS(resource pcommon.Resource, record plog.LogRecord) objmodel.Document {
+	var document objmodel.Document
+	addResourceECS(&document, resource)
+	document.AddAttributes("", record.Attributes())
+
+	document.AddTimestamp("@timestamp", record.Timestamp())
+	document.AddID("trace.id", record.TraceID())
+	document.AddID("span.id", record.SpanID())
+	document.AddString("log.level", record.SeverityText())
+	if record.SeverityNumber() != plog.SeverityNumberUnspecified {
+		document.AddInt("event.severity", int64(record.SeverityNumber()))
+	}
+	if record.Body().Type() == pcommon.ValueTypeMap {
+		document.AddAttribute("message", record.Body())
+	} else {
+		document.AddString("message", record.Body().AsString())
+	}
+	return document
+}
+
+// encodeSpanECS maps a span to an Elastic Common Schema document. The span
+// attributes are added as they are. Fixed fields take precedence over attributes of
+// the same name when deduplicating.
+func encodeSpanECS(resource pcommon.Resource, span ptrace.Span) objmodel.Document {
+	var document objmodel.Document
+	addResourceECS(&document, resource)
+	document.AddAttributes("", span.Attributes())
+
+	document.AddTimestamp("@timestamp", span.StartTimestamp())
+	document.AddID("trace.id", span.TraceID())
+	document.AddID("span.id", span.SpanID())
+	document.AddID("parent.id", span.ParentSpanID())
+	document.AddString("span.name", span.Name())
+	document.AddString("span.kind", ecsSpanKind(span.Kind()))
+	document.AddInt("event.duration", int64(span.EndTimestamp()-span.StartTimestamp()))
+	document.AddString("event.outcome", ecsOutcome(span.Status().Code()))
+	return document
+}
+
+func addResourceECS(document *objmodel.Document, resource pcommon.Resource) {
+	resource.Attributes().Range(func(k string, v pcommon.Value) bool {
+		if field, ok := ecsResourceFields[k]; ok {
+			k = field
+		}
+		document.AddAttribute(k, v)
+		return true
+	})
+}
+
+// ecsSpanKind returns the span kind the way Elastic APM reports it, e.g. `SERVER`.
+func ecsSpanKind(kind ptrace.SpanKind) string {
+	if kind == ptrace.SpanKindUnspecified {
+		return ""
+	}
+	return strings.ToUpper(kind.String())
+}
+
+// ecsOutcome maps a span status code to the ECS `event.outcome` values.
+func ecsOutcome(code ptrace.StatusCode) string {
+	switch code {
+	case ptrace.StatusCodeOk:
+		return "success"
+	case ptrace.StatusCodeError:
+		return "failure"
+	default:
+		return "unknown"
+	}
+}
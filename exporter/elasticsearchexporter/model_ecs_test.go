@@ -0,0 +1,97 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearchexporter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+var ecsTestTime = time.Date(2022, 11, 15, 9, 4, 30, 0, time.UTC)
+
+func newECSTestResource() pcommon.Resource {
+	resource := pcommon.NewResource()
+	resource.Attributes().PutStr("service.name", "checkout")
+	resource.Attributes().PutStr("host.name", "node-1")
+	resource.Attributes().PutStr("k8s.namespace.name", "shop")
+	return resource
+}
+
+func TestEncodeLogECS(t *testing.T) {
+	record := plog.NewLogRecord()
+	record.SetTimestamp(pcommon.NewTimestampFromTime(ecsTestTime))
+	record.SetTraceID([16]byte{1})
+	record.SetSeverityText("WARN")
+	record.SetSeverityNumber(plog.SeverityNumberWarn)
+	record.Body().SetStr("disk almost full")
+	record.Attributes().PutStr("log.level", "overridden")
+	record.Attributes().PutInt("disk.usage", 95)
+
+	model := &encodeModel{dedup: true, mode: MappingECS}
+	document, err := model.encodeLog(newECSTestResource(), record, nil)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"@timestamp": "2022-11-15T09:04:30.000000000Z",
+		"disk.usage": 95,
+		"event.severity": 13,
+		"host.hostname": "node-1",
+		"kubernetes.namespace": "shop",
+		"log.level": "WARN",
+		"message": "disk almost full",
+		"service.name": "checkout",
+		"trace.id": "01000000000000000000000000000000"
+	}`, string(document))
+}
+
+func TestEncodeSpanECS(t *testing.T) {
+	span := ptrace.NewSpan()
+	span.SetName("GET /cart")
+	span.SetKind(ptrace.SpanKindServer)
+	span.SetTraceID([16]byte{1})
+	span.SetSpanID([8]byte{2})
+	span.SetParentSpanID([8]byte{3})
+	span.SetStartTimestamp(pcommon.NewTimestampFromTime(ecsTestTime))
+	span.SetEndTimestamp(pcommon.NewTimestampFromTime(ecsTestTime.Add(1500 * time.Microsecond)))
+	span.Status().SetCode(ptrace.StatusCodeError)
+	span.Attributes().PutStr("http.method", "GET")
+
+	model := &encodeModel{dedup: true, mode: MappingECS}
+	ds := &dataStream{typ: dataStreamTypeTraces, dataset: "checkout", namespace: "shop"}
+	document, err := model.encodeSpan(newECSTestResource(), span, ds)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"@timestamp": "2022-11-15T09:04:30.000000000Z",
+		"data_stream.dataset": "checkout",
+		"data_stream.namespace": "shop",
+		"data_stream.type": "traces",
+		"event.duration": 1500000,
+		"event.outcome": "failure",
+		"host.hostname": "node-1",
+		"http.method": "GET",
+		"kubernetes.namespace": "shop",
+		"parent.id": "0300000000000000",
+		"service.name": "checkout",
+		"span.id": "0200000000000000",
+		"span.kind": "SERVER",
+		"span.name": "GET /cart",
+		"trace.id": "01000000000000000000000000000000"
+	}`, string(document))
+}
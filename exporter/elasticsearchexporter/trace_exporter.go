@@ -30,6 +30,7 @@ type elasticsearchTracesExporter struct {
 	logger *zap.Logger
 
 	index       string
+	dataStream  *dataStreamRouter
 	maxAttempts int
 
 	client      *esClientCurrent
@@ -57,8 +58,8 @@ func newTracesExporter(logger *zap.Logger, cfg *Config) (*elasticsearchTracesExp
 		maxAttempts = cfg.Retry.MaxRequests
 	}
 
-	// TODO: Apply encoding and field mapping settings.
-	model := &encodeModel{dedup: true, dedot: false}
+	// TODO: Apply encoding and field mapping settings other than the mode.
+	model := &encodeModel{dedup: true, dedot: false, mode: mappingModes[cfg.Mapping.Mode]}
 
 	return &elasticsearchTracesExporter{
 		logger:      logger,
@@ -66,6 +67,7 @@ func newTracesExporter(logger *zap.Logger, cfg *Config) (*elasticsearchTracesExp
 		bulkIndexer: bulkIndexer,
 
 		index:       cfg.TracesIndex,
+		dataStream:  newDataStreamRouter(dataStreamTypeTraces, cfg.DataStream),
 		maxAttempts: maxAttempts,
 		model:       model,
 	}, nil
@@ -84,11 +86,12 @@ func (e *elasticsearchTracesExporter) pushTraceData(
 	for i := 0; i < resourceSpans.Len(); i++ {
 		il := resourceSpans.At(i)
 		resource := il.Resource()
+		index, ds := e.route(resource)
 		scopeSpans := il.ScopeSpans()
 		for j := 0; j < scopeSpans.Len(); j++ {
 			spans := scopeSpans.At(j).Spans()
 			for k := 0; k < spans.Len(); k++ {
-				if err := e.pushTraceRecord(ctx, resource, spans.At(k)); err != nil {
+				if err := e.pushTraceRecord(ctx, resource, spans.At(k), index, ds); err != nil {
 					if cerr := ctx.Err(); cerr != nil {
 						return cerr
 					}
@@ -101,10 +104,20 @@ func (e *elasticsearchTracesExporter) pushTraceData(
 	return multierr.Combine(errs...)
 }
 
-func (e *elasticsearchTracesExporter) pushTraceRecord(ctx context.Context, resource pcommon.Resource, span ptrace.Span) error {
-	document, err := e.model.encodeSpan(resource, span)
+// route returns the index and, when data stream routing is enabled, the data stream
+// the spans of the given resource are indexed in.
+func (e *elasticsearchTracesExporter) route(resource pcommon.Resource) (string, *dataStream) {
+	if e.dataStream == nil {
+		return e.index, nil
+	}
+	ds := e.dataStream.route(resource)
+	return ds.index(), &ds
+}
+
+func (e *elasticsearchTracesExporter) pushTraceRecord(ctx context.Context, resource pcommon.Resource, span ptrace.Span, index string, ds *dataStream) error {
+	document, err := e.model.encodeSpan(resource, span, ds)
 	if err != nil {
 		return fmt.Errorf("Failed to encode trace record: %w", err)
 	}
-	return pushDocuments(ctx, e.logger, e.index, document, e.bulkIndexer, e.maxAttempts)
+	return pushDocuments(ctx, e.logger, index, document, e.bulkIndexer, e.maxAttempts)
 }
@@ -14,30 +14,16 @@
 
 package fileexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fileexporter"
 
-import "github.com/klauspost/compress/zstd"
+import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/partitioner"
 
-// compressFunc defines how to compress encoded telemetry data.
-type compressFunc func(src []byte) []byte
-
-var encoder, _ = zstd.NewWriter(nil)
-
-var encoders = map[string]compressFunc{
-	compressionZSTD: zstdCompress,
-}
+type compressFunc = partitioner.Compressor
 
+// buildCompressor returns the compressor of a validated compression algorithm.
 func buildCompressor(compression string) compressFunc {
-	if compression == "" {
-		return noneCompress
-	}
-	return encoders[compression]
-}
-
-// zstdCompress compress a buffer with zstd
-func zstdCompress(src []byte) []byte {
-	return encoder.EncodeAll(src, make([]byte, 0, len(src)))
+	compressor, _ := partitioner.NewCompressor(compression)
+	return compressor
 }
 
-// noneCompress return src
 func noneCompress(src []byte) []byte {
 	return src
 }
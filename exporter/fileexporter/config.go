@@ -16,10 +16,13 @@ package fileexporter // import "github.com/open-telemetry/opentelemetry-collecto
 
 import (
 	"errors"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/confmap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/partitioner"
 )
 
 const (
@@ -32,6 +35,9 @@ type Config struct {
 	config.ExporterSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
 
 	// Path of the file to write to. Path is relative to current directory.
+	// It may reference resource attributes as `{<attribute name>}` and the time telemetry
+	// is exported with strftime-like directives, e.g. `./out/{service.name}/%Y-%m-%d.json`,
+	// in which case telemetry is written to the file of its resource and time.
 	Path string `mapstructure:"path"`
 
 	// Rotation defines an option about rotation of telemetry files
@@ -41,11 +47,17 @@ type Config struct {
 	// Options:
 	// - json[default]:  OTLP json bytes.
 	// - proto:  OTLP binary protobuf bytes.
+	// - parquet: Parquet files with a row per span, data point or log record, each batch
+	//   written to a file of its own below the directory at Path.
 	FormatType string `mapstructure:"format"`
 
 	// Compression Codec used to export telemetry data
-	// Supported compression algorithms:`zstd`
+	// Supported compression algorithms:`gzip`, `zstd`
 	Compression string `mapstructure:"compression"`
+
+	// Partition lays out telemetry in files below the directory at Path, partitioned
+	// by time and resource attributes. It cannot be used together with Rotation.
+	Partition *partitioner.Config `mapstructure:"partition"`
 }
 
 // Rotation an option to rolling log files
@@ -69,6 +81,14 @@ type Rotation struct {
 	// backup files is the computer's local time.  The default is to use UTC
 	// time.
 	LocalTime bool `mapstructure:"localtime"`
+
+	// Interval is how often the file is rotated, on the clock, e.g. every hour on the hour
+	// for `1h`. The default is not to rotate files based on time.
+	Interval time.Duration `mapstructure:"interval"`
+
+	// Compression is the algorithm rotated files are compressed with, `gzip` or `zstd`.
+	// The default is not to compress rotated files.
+	Compression string `mapstructure:"compression"`
 }
 
 var _ component.ExporterConfig = (*Config)(nil)
@@ -78,12 +98,45 @@ func (cfg *Config) Validate() error {
 	if cfg.Path == "" {
 		return errors.New("path must be non-empty")
 	}
-	if cfg.FormatType != formatTypeJSON && cfg.FormatType != formatTypeProto {
+	if cfg.FormatType != formatTypeJSON && cfg.FormatType != formatTypeProto && cfg.FormatType != formatTypeParquet {
 		return errors.New("format type is not supported")
 	}
-	if cfg.Compression != "" && cfg.Compression != compressionZSTD {
+	if _, err := partitioner.NewCompressor(cfg.Compression); err != nil {
 		return errors.New("compression is not supported")
 	}
+	if cfg.FormatType == formatTypeParquet {
+		if cfg.Rotation != nil {
+			return errors.New("rotation cannot be used together with the parquet format")
+		}
+		if isPathTemplate(cfg.Path) {
+			return errors.New("the parquet format cannot be used together with a path template")
+		}
+		if cfg.Partition != nil {
+			return cfg.Partition.Validate()
+		}
+		return nil
+	}
+	if cfg.Rotation != nil {
+		if cfg.Rotation.Interval < 0 {
+			return errors.New("rotation interval must not be negative")
+		}
+		if cfg.Rotation.Compression != "" && cfg.Rotation.Compression != partitioner.CompressionGzip && cfg.Rotation.Compression != partitioner.CompressionZstd {
+			return errors.New("rotation compression is not supported")
+		}
+	}
+	if isPathTemplate(cfg.Path) {
+		if cfg.Partition != nil {
+			return errors.New("partition cannot be used together with a path template")
+		}
+		_, err := parsePathTemplate(cfg.Path)
+		return err
+	}
+	if cfg.Partition != nil {
+		if cfg.Rotation != nil {
+			return errors.New("rotation cannot be used together with partition")
+		}
+		return cfg.Partition.Validate()
+	}
 	return nil
 }
 
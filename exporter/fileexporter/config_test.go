@@ -17,12 +17,15 @@ package fileexporter
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/partitioner"
 )
 
 func TestLoadConfig(t *testing.T) {
@@ -88,6 +91,90 @@ func TestLoadConfig(t *testing.T) {
 				FormatType: formatTypeJSON,
 			},
 		},
+		{
+			id: component.NewIDWithName(typeStr, "rotation_with_interval_and_compression"),
+			expected: &Config{
+				ExporterSettings: config.NewExporterSettings(component.NewID(typeStr)),
+				Path:             "./foo",
+				Rotation: &Rotation{
+					MaxBackups:  defaultMaxBackups,
+					Interval:    time.Hour,
+					Compression: partitioner.CompressionZstd,
+				},
+				FormatType: formatTypeJSON,
+			},
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "rotation_compression_error"),
+			errorMessage: "rotation compression is not supported",
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "rotation_interval_error"),
+			errorMessage: "rotation interval must not be negative",
+		},
+		{
+			id: component.NewIDWithName(typeStr, "template"),
+			expected: &Config{
+				ExporterSettings: config.NewExporterSettings(component.NewID(typeStr)),
+				Path:             "./out/{service.name}/%Y-%m-%d.json",
+				Rotation: &Rotation{
+					MaxMegabytes: 10,
+					MaxBackups:   defaultMaxBackups,
+					Compression:  partitioner.CompressionGzip,
+				},
+				FormatType: formatTypeJSON,
+			},
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "template_with_partition"),
+			errorMessage: "partition cannot be used together with a path template",
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "template_error"),
+			errorMessage: `path "./out/{service.name/%Y.json" has an unterminated attribute reference`,
+		},
+		{
+			id: component.NewIDWithName(typeStr, "partition"),
+			expected: &Config{
+				ExporterSettings: config.NewExporterSettings(component.NewID(typeStr)),
+				Path:             "./telemetry",
+				FormatType:       formatTypeJSON,
+				Compression:      partitioner.CompressionGzip,
+				Partition: &partitioner.Config{
+					TimeGranularity:    partitioner.GranularityHour,
+					ResourceAttributes: []string{"service.name"},
+					MaxSize:            1048576,
+				},
+			},
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "partition_with_rotation"),
+			errorMessage: "rotation cannot be used together with partition",
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "partition_error"),
+			errorMessage: `unsupported time_granularity "week"`,
+		},
+		{
+			id: component.NewIDWithName(typeStr, "parquet"),
+			expected: &Config{
+				ExporterSettings: config.NewExporterSettings(component.NewID(typeStr)),
+				Path:             "./telemetry",
+				FormatType:       formatTypeParquet,
+				Compression:      partitioner.CompressionZstd,
+				Partition: &partitioner.Config{
+					TimeGranularity: partitioner.GranularityDay,
+				},
+			},
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "parquet_with_rotation"),
+			errorMessage: "rotation cannot be used together with the parquet format",
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "parquet_with_template"),
+			errorMessage: "the parquet format cannot be used together with a path template",
+		},
 		{
 			id:           component.NewIDWithName(typeStr, "compression_error"),
 			errorMessage: "compression is not supported",
@@ -18,12 +18,18 @@ import (
 	"context"
 	"io"
 	"os"
+	"path/filepath"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 	"gopkg.in/natefinch/lumberjack.v2"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/partitioner"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/partitioner/parquet"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
 )
 
@@ -37,11 +43,12 @@ const (
 	defaultMaxBackups = 100
 
 	// the format of encoded telemetry data
-	formatTypeJSON  = "json"
-	formatTypeProto = "proto"
+	formatTypeJSON    = "json"
+	formatTypeProto   = "proto"
+	formatTypeParquet = "parquet"
 
 	// the type of compression codec
-	compressionZSTD = "zstd"
+	compressionZSTD = partitioner.CompressionZstd
 )
 
 // NewFactory creates a factory for OTLP exporter.
@@ -77,10 +84,14 @@ func createTracesExporter(
 			path:            conf.Path,
 			formatType:      conf.FormatType,
 			file:            writer,
-			tracesMarshaler: tracesMarshalers[conf.FormatType],
+			tracesMarshaler: buildTracesMarshaler(conf),
 			exporter:        buildExportFunc(conf),
 			compression:     conf.Compression,
 			compressor:      buildCompressor(conf.Compression),
+			partitioner:     buildPartitioner(conf),
+			extension:       buildExtension(conf),
+			template:        buildPathTemplate(conf),
+			writers:         buildFileWriters(conf),
 		}
 	})
 	return exporterhelper.NewTracesExporter(
@@ -108,10 +119,14 @@ func createMetricsExporter(
 			path:             conf.Path,
 			formatType:       conf.FormatType,
 			file:             writer,
-			metricsMarshaler: metricsMarshalers[conf.FormatType],
+			metricsMarshaler: buildMetricsMarshaler(conf),
 			exporter:         buildExportFunc(conf),
 			compression:      conf.Compression,
 			compressor:       buildCompressor(conf.Compression),
+			partitioner:      buildPartitioner(conf),
+			extension:        buildExtension(conf),
+			template:         buildPathTemplate(conf),
+			writers:          buildFileWriters(conf),
 		}
 	})
 	return exporterhelper.NewMetricsExporter(
@@ -139,10 +154,14 @@ func createLogsExporter(
 			path:          conf.Path,
 			formatType:    conf.FormatType,
 			file:          writer,
-			logsMarshaler: logsMarshalers[conf.FormatType],
+			logsMarshaler: buildLogsMarshaler(conf),
 			exporter:      buildExportFunc(conf),
 			compression:   conf.Compression,
 			compressor:    buildCompressor(conf.Compression),
+			partitioner:   buildPartitioner(conf),
+			extension:     buildExtension(conf),
+			template:      buildPathTemplate(conf),
+			writers:       buildFileWriters(conf),
 		}
 	})
 	return exporterhelper.NewLogsExporter(
@@ -156,20 +175,97 @@ func createLogsExporter(
 }
 
 func buildFileWriter(cfg *Config) (io.WriteCloser, error) {
+	if cfg.Partition != nil || isPathTemplate(cfg.Path) || cfg.FormatType == formatTypeParquet {
+		// files are opened as telemetry is written to them
+		return nil, nil
+	}
 	if cfg.Rotation == nil {
 		return os.OpenFile(cfg.Path, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
 	}
+	return buildRotatingWriter(cfg.Path, cfg.Rotation), nil
+}
+
+func buildRotatingWriter(path string, rotation *Rotation) io.WriteCloser {
+	if rotation.Interval > 0 || rotation.Compression != "" {
+		return newRotatingFile(path, rotation)
+	}
 	return &lumberjack.Logger{
-		Filename:   cfg.Path,
-		MaxSize:    cfg.Rotation.MaxMegabytes,
-		MaxAge:     cfg.Rotation.MaxDays,
-		MaxBackups: cfg.Rotation.MaxBackups,
-		LocalTime:  cfg.Rotation.LocalTime,
-	}, nil
+		Filename:   path,
+		MaxSize:    rotation.MaxMegabytes,
+		MaxAge:     rotation.MaxDays,
+		MaxBackups: rotation.MaxBackups,
+		LocalTime:  rotation.LocalTime,
+	}
+}
+
+// buildPathTemplate returns the template of a templated path, nil otherwise.
+func buildPathTemplate(cfg *Config) *pathTemplate {
+	if !isPathTemplate(cfg.Path) {
+		return nil
+	}
+	template, _ := parsePathTemplate(cfg.Path)
+	return template
+}
+
+// buildFileWriters returns the writers of the files of a templated path, nil otherwise.
+func buildFileWriters(cfg *Config) *fileWriters {
+	if !isPathTemplate(cfg.Path) {
+		return nil
+	}
+	return newFileWriters(func(path string) (io.WriteCloser, error) {
+		if cfg.Rotation != nil {
+			return buildRotatingWriter(path, cfg.Rotation), nil
+		}
+		// files closed while idle are appended to when they are written to again
+		return os.OpenFile(filepath.Clean(path), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	})
 }
 
 // This is the map of already created File exporters for particular configurations.
 // We maintain this map because the Factory is asked trace and metric receivers separately
 // when it gets CreateTracesReceiver() and CreateMetricsReceiver() but they must not
 // create separate objects, they must use one Receiver object per configuration.
+func buildPartitioner(cfg *Config) *partitioner.Partitioner {
+	if cfg.Partition == nil {
+		if cfg.FormatType == formatTypeParquet {
+			// each batch is written to a Parquet file of its own below path
+			return partitioner.New(partitioner.Config{})
+		}
+		return nil
+	}
+	return partitioner.New(*cfg.Partition)
+}
+
+// buildTracesMarshaler returns the marshaler of the configured format.
+func buildTracesMarshaler(cfg *Config) ptrace.Marshaler {
+	if cfg.FormatType == formatTypeParquet {
+		marshaler, _ := parquet.NewMarshaler(cfg.Compression)
+		return marshaler
+	}
+	return tracesMarshalers[cfg.FormatType]
+}
+
+// buildMetricsMarshaler returns the marshaler of the configured format.
+func buildMetricsMarshaler(cfg *Config) pmetric.Marshaler {
+	if cfg.FormatType == formatTypeParquet {
+		marshaler, _ := parquet.NewMarshaler(cfg.Compression)
+		return marshaler
+	}
+	return metricsMarshalers[cfg.FormatType]
+}
+
+// buildLogsMarshaler returns the marshaler of the configured format.
+func buildLogsMarshaler(cfg *Config) plog.Marshaler {
+	if cfg.FormatType == formatTypeParquet {
+		marshaler, _ := parquet.NewMarshaler(cfg.Compression)
+		return marshaler
+	}
+	return logsMarshalers[cfg.FormatType]
+}
+
+// buildExtension returns the extension of partitioned files, e.g. `.json.zst`.
+func buildExtension(cfg *Config) string {
+	return "." + cfg.FormatType + partitioner.Extension(cfg.Compression)
+}
+
 var exporters = sharedcomponent.NewSharedComponents()
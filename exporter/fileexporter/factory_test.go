@@ -19,6 +19,7 @@ import (
 	"io"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -172,6 +173,39 @@ func TestBuildFileWriter(t *testing.T) {
 				assert.Equal(t, true, writer.LocalTime)
 			},
 		},
+		{
+			name: "rotation file with interval and compression",
+			args: args{
+				cfg: &Config{
+					Path: tempFileName(t),
+					Rotation: &Rotation{
+						MaxMegabytes: 30,
+						MaxBackups:   3,
+						Interval:     time.Hour,
+						Compression:  "gzip",
+					},
+				},
+			},
+			validate: func(t *testing.T, closer io.WriteCloser) {
+				writer, ok := closer.(*rotatingFile)
+				assert.Equal(t, true, ok)
+				assert.Equal(t, int64(30*megabyte), writer.maxSize)
+				assert.Equal(t, time.Hour, writer.interval)
+				assert.Equal(t, "gzip", writer.compression)
+				assert.Equal(t, 3, writer.logger.MaxBackups)
+			},
+		},
+		{
+			name: "path template",
+			args: args{
+				cfg: &Config{
+					Path: "{service.name}.json",
+				},
+			},
+			validate: func(t *testing.T, closer io.WriteCloser) {
+				assert.Nil(t, closer)
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
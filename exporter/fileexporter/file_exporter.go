@@ -15,16 +15,23 @@
 package fileexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fileexporter"
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
+	"fmt"
 	"io"
+	"os"
+	"path/filepath"
 	"sync"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/partitioner"
 )
 
 // Marshaler configuration used for marhsaling Protobuf
@@ -42,7 +49,7 @@ var logsMarshalers = map[string]plog.Marshaler{
 }
 
 // exportFunc defines how to export encoded telemetry data.
-type exportFunc func(e *fileExporter, buf []byte) error
+type exportFunc func(w io.Writer, buf []byte) error
 
 // fileExporter is the implementation of file exporter that writes telemetry data to a file
 type fileExporter struct {
@@ -59,6 +66,19 @@ type fileExporter struct {
 
 	formatType string
 	exporter   exportFunc
+
+	// partitioner lays out telemetry in objects below path when partitioning is enabled,
+	// in which case file is nil.
+	partitioner *partitioner.Partitioner
+	extension   string
+
+	// template resolves the file telemetry is written to when path is a template, in which
+	// case file is nil and writers holds the open files.
+	template *pathTemplate
+	writers  *fileWriters
+
+	// parquetFiles is the number of Parquet files written, which makes their names unique.
+	parquetFiles uint64
 }
 
 func (e *fileExporter) Capabilities() consumer.Capabilities {
@@ -66,55 +86,199 @@ func (e *fileExporter) Capabilities() consumer.Capabilities {
 }
 
 func (e *fileExporter) ConsumeTraces(_ context.Context, td ptrace.Traces) error {
+	if e.partitioner != nil {
+		now := time.Now()
+		for prefix, part := range e.partitioner.SplitTraces(td, now) {
+			buf, err := e.tracesMarshaler.MarshalTraces(part)
+			if err != nil {
+				return err
+			}
+			if err = e.writeObject(prefix, "traces", buf, now); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if e.template != nil {
+		now := time.Now()
+		for path, part := range e.template.splitTraces(td, now) {
+			buf, err := e.tracesMarshaler.MarshalTraces(part)
+			if err != nil {
+				return err
+			}
+			if err = e.writeTemplated(path, buf, now); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
 	buf, err := e.tracesMarshaler.MarshalTraces(td)
 	if err != nil {
 		return err
 	}
-	buf = e.compressor(buf)
-	return e.exporter(e, buf)
+	return e.write(buf)
 }
 
 func (e *fileExporter) ConsumeMetrics(_ context.Context, md pmetric.Metrics) error {
+	if e.partitioner != nil {
+		now := time.Now()
+		for prefix, part := range e.partitioner.SplitMetrics(md, now) {
+			buf, err := e.metricsMarshaler.MarshalMetrics(part)
+			if err != nil {
+				return err
+			}
+			if err = e.writeObject(prefix, "metrics", buf, now); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if e.template != nil {
+		now := time.Now()
+		for path, part := range e.template.splitMetrics(md, now) {
+			buf, err := e.metricsMarshaler.MarshalMetrics(part)
+			if err != nil {
+				return err
+			}
+			if err = e.writeTemplated(path, buf, now); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
 	buf, err := e.metricsMarshaler.MarshalMetrics(md)
 	if err != nil {
 		return err
 	}
-	buf = e.compressor(buf)
-	return e.exporter(e, buf)
+	return e.write(buf)
 }
 
 func (e *fileExporter) ConsumeLogs(_ context.Context, ld plog.Logs) error {
+	if e.partitioner != nil {
+		now := time.Now()
+		for prefix, part := range e.partitioner.SplitLogs(ld, now) {
+			buf, err := e.logsMarshaler.MarshalLogs(part)
+			if err != nil {
+				return err
+			}
+			if err = e.writeObject(prefix, "logs", buf, now); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if e.template != nil {
+		now := time.Now()
+		for path, part := range e.template.splitLogs(ld, now) {
+			buf, err := e.logsMarshaler.MarshalLogs(part)
+			if err != nil {
+				return err
+			}
+			if err = e.writeTemplated(path, buf, now); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
 	buf, err := e.logsMarshaler.MarshalLogs(ld)
 	if err != nil {
 		return err
 	}
+	return e.write(buf)
+}
+
+// write compresses buf and writes it to the file.
+func (e *fileExporter) write(buf []byte) error {
 	buf = e.compressor(buf)
-	return e.exporter(e, buf)
+	// Ensure only one write operation happens at a time.
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	return e.exporter(e.file, buf)
 }
 
-func exportMessageAsLine(e *fileExporter, buf []byte) error {
+// writeObject compresses buf and appends it to the object of the given signal below
+// prefix, which the partitioner rolls over once it reaches the maximum object size.
+func (e *fileExporter) writeObject(prefix, signal string, buf []byte, now time.Time) error {
+	if e.formatType == formatTypeParquet {
+		return e.writeParquet(prefix, signal, buf, now)
+	}
+	var record bytes.Buffer
+	if err := e.exporter(&record, e.compressor(buf)); err != nil {
+		return err
+	}
+	name := filepath.Join(e.path, filepath.FromSlash(e.partitioner.Object(prefix, signal, e.extension, record.Len(), now)))
+
 	// Ensure only one write operation happens at a time.
 	e.mutex.Lock()
 	defer e.mutex.Unlock()
-	if _, err := e.file.Write(buf); err != nil {
+	if err := os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
 		return err
 	}
-	if _, err := io.WriteString(e.file, "\n"); err != nil {
+	if _, err = f.Write(record.Bytes()); err != nil {
+		_ = f.Close()
 		return err
 	}
-	return nil
+	return f.Close()
 }
 
-func exportMessageAsBuffer(e *fileExporter, buf []byte) error {
+// writeParquet writes buf, a Parquet file, to a new file of the given signal below prefix,
+// e.g. `<prefix>/traces-1668503070000000000-1.parquet`. The file is written under a hidden
+// name first, so that query engines never read partially written files.
+func (e *fileExporter) writeParquet(prefix, signal string, buf []byte, now time.Time) error {
+	if len(buf) == 0 {
+		return nil
+	}
+
 	// Ensure only one write operation happens at a time.
 	e.mutex.Lock()
 	defer e.mutex.Unlock()
+	e.parquetFiles++
+	dir := filepath.Join(e.path, filepath.FromSlash(prefix))
+	name := fmt.Sprintf("%s-%d-%d.parquet", signal, now.UnixNano(), e.parquetFiles)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	tmp := filepath.Join(dir, "."+name)
+	if err := os.WriteFile(tmp, buf, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(dir, name))
+}
+
+// writeTemplated compresses buf and writes it to the file at path.
+func (e *fileExporter) writeTemplated(path string, buf []byte, now time.Time) error {
+	var record bytes.Buffer
+	if err := e.exporter(&record, e.compressor(buf)); err != nil {
+		return err
+	}
+
+	// Ensure only one write operation happens at a time.
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	return e.writers.write(path, record.Bytes(), now)
+}
+
+func exportMessageAsLine(w io.Writer, buf []byte) error {
+	if _, err := w.Write(buf); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, "\n"); err != nil {
+		return err
+	}
+	return nil
+}
+
+func exportMessageAsBuffer(w io.Writer, buf []byte) error {
 	// write the size of each message before writing the message itself.  https://developers.google.com/protocol-buffers/docs/techniques
 	// each encoded object is preceded by 4 bytes (an unsigned 32 bit integer)
 	data := make([]byte, 4, 4+len(buf))
 	binary.BigEndian.PutUint32(data, uint32(len(buf)))
 	data = append(data, buf...)
-	if err := binary.Write(e.file, binary.BigEndian, data); err != nil {
+	if err := binary.Write(w, binary.BigEndian, data); err != nil {
 		return err
 	}
 	return nil
@@ -126,10 +290,18 @@ func (e *fileExporter) Start(context.Context, component.Host) error {
 
 // Shutdown stops the exporter and is invoked during shutdown.
 func (e *fileExporter) Shutdown(context.Context) error {
+	if e.writers != nil {
+		e.mutex.Lock()
+		defer e.mutex.Unlock()
+		return e.writers.close()
+	}
+	if e.file == nil {
+		return nil
+	}
 	return e.file.Close()
 }
 
-func buildExportFunc(cfg *Config) func(e *fileExporter, buf []byte) error {
+func buildExportFunc(cfg *Config) exportFunc {
 	if cfg.FormatType == formatTypeProto {
 		return exportMessageAsBuffer
 	}
@@ -15,13 +15,17 @@ package fileexporter
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"encoding/binary"
 	"errors"
 	"io"
 	"os"
+	"path/filepath"
+	"strconv"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/klauspost/compress/zstd"
 	"github.com/stretchr/testify/assert"
@@ -33,6 +37,7 @@ import (
 	"gopkg.in/natefinch/lumberjack.v2"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/testdata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/partitioner"
 )
 
 func buildUnCompressor(compressor string) func([]byte) ([]byte, error) {
@@ -481,11 +486,178 @@ func TestExportMessageAsBuffer(t *testing.T) {
 	marshaler := &plog.ProtoMarshaler{}
 	buf, err := marshaler.MarshalLogs(ld)
 	assert.NoError(t, err)
-	assert.Error(t, exportMessageAsBuffer(fe, buf))
+	assert.Error(t, exportMessageAsBuffer(fe.file, buf))
 	assert.NoError(t, fe.Shutdown(context.Background()))
 
 }
 
+func TestPartitionedExport(t *testing.T) {
+	conf := &Config{
+		Path:       t.TempDir(),
+		FormatType: formatTypeJSON,
+		Partition: &partitioner.Config{
+			ResourceAttributes: []string{"service.name"},
+			MaxSize:            1,
+		},
+	}
+	writer, err := buildFileWriter(conf)
+	require.NoError(t, err)
+	assert.Nil(t, writer)
+	fe := &fileExporter{
+		path:            conf.Path,
+		formatType:      conf.FormatType,
+		tracesMarshaler: tracesMarshalers[conf.FormatType],
+		logsMarshaler:   logsMarshalers[conf.FormatType],
+		exporter:        buildExportFunc(conf),
+		compressor:      buildCompressor(conf.Compression),
+		partitioner:     buildPartitioner(conf),
+		extension:       buildExtension(conf),
+	}
+
+	td := ptrace.NewTraces()
+	for _, service := range []string{"checkout", "cart"} {
+		rs := td.ResourceSpans().AppendEmpty()
+		rs.Resource().Attributes().PutStr("service.name", service)
+		rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName(service)
+	}
+	ld := testdata.GenerateLogsTwoLogRecordsSameResource()
+
+	assert.NoError(t, fe.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, fe.ConsumeTraces(context.Background(), td))
+	assert.NoError(t, fe.ConsumeTraces(context.Background(), td))
+	assert.NoError(t, fe.ConsumeLogs(context.Background(), ld))
+	assert.NoError(t, fe.Shutdown(context.Background()))
+
+	// every record exceeds the maximum size, so each one is written to a file of its own
+	for _, name := range []string{
+		"service.name=checkout/traces-0.json",
+		"service.name=checkout/traces-1.json",
+		"service.name=cart/traces-0.json",
+		"service.name=cart/traces-1.json",
+	} {
+		buf, err := os.ReadFile(filepath.Join(conf.Path, name))
+		require.NoError(t, err)
+		got, err := (&ptrace.JSONUnmarshaler{}).UnmarshalTraces(bytes.TrimSuffix(buf, []byte("\n")))
+		require.NoError(t, err)
+		assert.Equal(t, 1, got.SpanCount())
+	}
+	_, err = os.Stat(filepath.Join(conf.Path, "service.name=unknown", "logs-0.json"))
+	assert.NoError(t, err)
+}
+
+func TestParquetExport(t *testing.T) {
+	conf := &Config{
+		Path:        t.TempDir(),
+		FormatType:  formatTypeParquet,
+		Compression: partitioner.CompressionGzip,
+	}
+	require.NoError(t, conf.Validate())
+	writer, err := buildFileWriter(conf)
+	require.NoError(t, err)
+	assert.Nil(t, writer)
+	fe := &fileExporter{
+		path:            conf.Path,
+		formatType:      conf.FormatType,
+		tracesMarshaler: buildTracesMarshaler(conf),
+		logsMarshaler:   buildLogsMarshaler(conf),
+		exporter:        buildExportFunc(conf),
+		compressor:      buildCompressor(conf.Compression),
+		partitioner:     buildPartitioner(conf),
+		extension:       buildExtension(conf),
+	}
+
+	td := testdata.GenerateTracesTwoSpansSameResource()
+	assert.NoError(t, fe.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, fe.ConsumeTraces(context.Background(), td))
+	assert.NoError(t, fe.ConsumeTraces(context.Background(), td))
+	// batches without log records do not write empty files
+	assert.NoError(t, fe.ConsumeLogs(context.Background(), plog.NewLogs()))
+	assert.NoError(t, fe.Shutdown(context.Background()))
+
+	// each batch is written to a Parquet file of its own
+	entries, err := os.ReadDir(conf.Path)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	for _, entry := range entries {
+		assert.Regexp(t, `^traces-\d+-\d+\.parquet$`, entry.Name())
+		buf, err := os.ReadFile(filepath.Join(conf.Path, entry.Name()))
+		require.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(buf, []byte("PAR1")))
+		assert.True(t, bytes.HasSuffix(buf, []byte("PAR1")))
+	}
+}
+
+func TestTemplatedExport(t *testing.T) {
+	conf := &Config{
+		Path:       filepath.Join(t.TempDir(), "{service.name}", "%Y.json"),
+		FormatType: formatTypeJSON,
+	}
+	writer, err := buildFileWriter(conf)
+	require.NoError(t, err)
+	assert.Nil(t, writer)
+	fe := &fileExporter{
+		path:            conf.Path,
+		formatType:      conf.FormatType,
+		tracesMarshaler: tracesMarshalers[conf.FormatType],
+		exporter:        buildExportFunc(conf),
+		compressor:      buildCompressor(conf.Compression),
+		template:        buildPathTemplate(conf),
+		writers:         buildFileWriters(conf),
+	}
+
+	td := ptrace.NewTraces()
+	for _, service := range []string{"checkout", "cart", "checkout"} {
+		rs := td.ResourceSpans().AppendEmpty()
+		rs.Resource().Attributes().PutStr("service.name", service)
+		rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName(service)
+	}
+
+	assert.NoError(t, fe.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, fe.ConsumeTraces(context.Background(), td))
+	assert.NoError(t, fe.ConsumeTraces(context.Background(), td))
+	assert.NoError(t, fe.Shutdown(context.Background()))
+
+	year := strconv.Itoa(time.Now().UTC().Year())
+	for service, spans := range map[string]int{"checkout": 2, "cart": 1} {
+		buf, err := os.ReadFile(filepath.Join(filepath.Dir(filepath.Dir(conf.Path)), service, year+".json"))
+		require.NoError(t, err)
+		lines := bytes.Split(bytes.TrimSuffix(buf, []byte("\n")), []byte("\n"))
+		require.Len(t, lines, 2)
+		for _, line := range lines {
+			got, err := (&ptrace.JSONUnmarshaler{}).UnmarshalTraces(line)
+			require.NoError(t, err)
+			assert.Equal(t, spans, got.SpanCount())
+		}
+	}
+}
+
+func TestFileWritersCloseIdleFiles(t *testing.T) {
+	dir := t.TempDir()
+	opened := map[string]int{}
+	writers := newFileWriters(func(path string) (io.WriteCloser, error) {
+		opened[filepath.Base(path)]++
+		return os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	})
+
+	require.NoError(t, writers.write(filepath.Join(dir, "a"), []byte("1"), exportTime))
+	require.NoError(t, writers.write(filepath.Join(dir, "b"), []byte("1"), exportTime.Add(30*time.Second)))
+	// a is idle and closed, b is kept open
+	require.NoError(t, writers.write(filepath.Join(dir, "b"), []byte("2"), exportTime.Add(time.Minute+time.Second)))
+	assert.Len(t, writers.files, 1)
+
+	// a is opened again, and appended to
+	require.NoError(t, writers.write(filepath.Join(dir, "a"), []byte("2"), exportTime.Add(2*time.Minute)))
+	require.NoError(t, writers.close())
+	assert.Empty(t, writers.files)
+
+	assert.Equal(t, map[string]int{"a": 2, "b": 1}, opened)
+	for _, name := range []string{"a", "b"} {
+		buf, err := os.ReadFile(filepath.Join(dir, name))
+		require.NoError(t, err)
+		assert.Equal(t, "12", string(buf))
+	}
+}
+
 // tempFileName provides a temporary file name for testing.
 func tempFileName(t *testing.T) string {
 	tmpfile, err := os.CreateTemp("", "*")
@@ -547,6 +719,7 @@ func decompress(src []byte) ([]byte, error) {
 }
 
 func TestConcurrentlyCompress(t *testing.T) {
+	zstdCompress := buildCompressor(compressionZSTD)
 	wg := sync.WaitGroup{}
 	wg.Add(3)
 	var (
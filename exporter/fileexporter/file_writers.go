@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fileexporter"
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"go.uber.org/multierr"
+)
+
+// idleFileTimeout is how long the files of a path template are kept open without being
+// written to, as a template referencing the time stops writing to the files of past times.
+const idleFileTimeout = time.Minute
+
+type openFile struct {
+	writer    io.WriteCloser
+	lastWrite time.Time
+}
+
+// fileWriters keeps the files of a path template open while they are written to. It is not
+// safe for concurrent use, the exporter serializing the writes.
+type fileWriters struct {
+	open      func(path string) (io.WriteCloser, error)
+	files     map[string]*openFile
+	lastSweep time.Time
+}
+
+func newFileWriters(open func(path string) (io.WriteCloser, error)) *fileWriters {
+	return &fileWriters{
+		open:  open,
+		files: map[string]*openFile{},
+	}
+}
+
+// write writes buf to the file at path, opening it if needed, and closes the files which
+// haven't been written to recently.
+func (w *fileWriters) write(path string, buf []byte, now time.Time) error {
+	f, ok := w.files[path]
+	if !ok {
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			return err
+		}
+		writer, err := w.open(path)
+		if err != nil {
+			return err
+		}
+		f = &openFile{writer: writer}
+		w.files[path] = f
+	}
+	f.lastWrite = now
+	_, err := f.writer.Write(buf)
+
+	if now.Sub(w.lastSweep) >= idleFileTimeout {
+		w.lastSweep = now
+		err = multierr.Append(err, w.closeIdle(now))
+	}
+	return err
+}
+
+func (w *fileWriters) closeIdle(now time.Time) error {
+	var errs error
+	for path, f := range w.files {
+		if now.Sub(f.lastWrite) >= idleFileTimeout {
+			errs = multierr.Append(errs, f.writer.Close())
+			delete(w.files, path)
+		}
+	}
+	return errs
+}
+
+// close closes all the open files.
+func (w *fileWriters) close() error {
+	var errs error
+	for path, f := range w.files {
+		errs = multierr.Append(errs, f.writer.Close())
+		delete(w.files, path)
+	}
+	return errs
+}
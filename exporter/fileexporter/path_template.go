@@ -0,0 +1,196 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fileexporter"
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/observiq/ctimefmt"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// unknownAttributeValue replaces the resource attributes a resource is missing in a path.
+const unknownAttributeValue = "unknown"
+
+type templatePartKind int
+
+const (
+	literalPart templatePartKind = iota
+	attributePart
+	timePart
+)
+
+type templatePart struct {
+	kind templatePartKind
+	// value is the literal text, the attribute name or the time layout of the part.
+	value string
+}
+
+// pathTemplate is the parsed form of a path which references resource attributes as
+// `{<attribute name>}` and the export time with strftime-like directives such as `%Y`,
+// e.g. `./out/{service.name}/%Y-%m-%d.json`.
+type pathTemplate struct {
+	parts []templatePart
+}
+
+// isPathTemplate tells whether the path references resource attributes or the time.
+func isPathTemplate(path string) bool {
+	return strings.ContainsAny(path, "{%")
+}
+
+func parsePathTemplate(path string) (*pathTemplate, error) {
+	t := &pathTemplate{}
+	var literal strings.Builder
+	flushLiteral := func() {
+		if literal.Len() > 0 {
+			t.parts = append(t.parts, templatePart{kind: literalPart, value: literal.String()})
+			literal.Reset()
+		}
+	}
+
+	for i := 0; i < len(path); i++ {
+		switch path[i] {
+		case '{':
+			end := strings.IndexByte(path[i:], '}')
+			if end < 0 {
+				return nil, fmt.Errorf("path %q has an unterminated attribute reference", path)
+			}
+			name := path[i+1 : i+end]
+			if name == "" {
+				return nil, fmt.Errorf("path %q has an empty attribute reference", path)
+			}
+			flushLiteral()
+			t.parts = append(t.parts, templatePart{kind: attributePart, value: name})
+			i += end
+		case '}':
+			return nil, fmt.Errorf("path %q has an unmatched '}'", path)
+		case '%':
+			if i+1 >= len(path) {
+				return nil, fmt.Errorf("path %q ends with an incomplete time directive", path)
+			}
+			directive := path[i : i+2]
+			i++
+			if directive == "%%" {
+				literal.WriteByte('%')
+				continue
+			}
+			// each directive is converted on its own, so that the literal text of the
+			// path isn't interpreted as a time layout
+			layout, err := ctimefmt.ToNative(directive)
+			if err != nil {
+				return nil, fmt.Errorf("path %q has an unsupported time directive %q", path, directive)
+			}
+			flushLiteral()
+			t.parts = append(t.parts, templatePart{kind: timePart, value: layout})
+		default:
+			literal.WriteByte(path[i])
+		}
+	}
+	flushLiteral()
+	return t, nil
+}
+
+// resolve returns the path of the data of the given resource exported at t, in UTC.
+// Missing attributes are replaced with `unknown`.
+func (t *pathTemplate) resolve(resource pcommon.Resource, now time.Time) string {
+	var sb strings.Builder
+	for _, part := range t.parts {
+		switch part.kind {
+		case literalPart:
+			sb.WriteString(part.value)
+		case attributePart:
+			value, ok := resource.Attributes().Get(part.value)
+			if !ok || value.AsString() == "" {
+				sb.WriteString(unknownAttributeValue)
+				continue
+			}
+			sb.WriteString(sanitizePathElement(value.AsString()))
+		case timePart:
+			sb.WriteString(now.UTC().Format(part.value))
+		}
+	}
+	return filepath.Clean(sb.String())
+}
+
+// sanitizePathElement replaces the characters which would otherwise add or climb up
+// directories, e.g. `shop/prod` becomes `shop_prod`.
+func sanitizePathElement(s string) string {
+	if s == "." || s == ".." {
+		return strings.Repeat("_", len(s))
+	}
+	return strings.Map(func(r rune) rune {
+		if r == '/' || r == '\\' || r == filepath.Separator {
+			return '_'
+		}
+		return r
+	}, s)
+}
+
+// splitTraces groups the resource spans by path.
+func (t *pathTemplate) splitTraces(td ptrace.Traces, now time.Time) map[string]ptrace.Traces {
+	parts := map[string]ptrace.Traces{}
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		path := t.resolve(rs.Resource(), now)
+		part, ok := parts[path]
+		if !ok {
+			part = ptrace.NewTraces()
+			parts[path] = part
+		}
+		rs.CopyTo(part.ResourceSpans().AppendEmpty())
+	}
+	return parts
+}
+
+// splitMetrics groups the resource metrics by path.
+func (t *pathTemplate) splitMetrics(md pmetric.Metrics, now time.Time) map[string]pmetric.Metrics {
+	parts := map[string]pmetric.Metrics{}
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		path := t.resolve(rm.Resource(), now)
+		part, ok := parts[path]
+		if !ok {
+			part = pmetric.NewMetrics()
+			parts[path] = part
+		}
+		rm.CopyTo(part.ResourceMetrics().AppendEmpty())
+	}
+	return parts
+}
+
+// splitLogs groups the resource logs by path.
+func (t *pathTemplate) splitLogs(ld plog.Logs, now time.Time) map[string]plog.Logs {
+	parts := map[string]plog.Logs{}
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		path := t.resolve(rl.Resource(), now)
+		part, ok := parts[path]
+		if !ok {
+			part = plog.NewLogs()
+			parts[path] = part
+		}
+		rl.CopyTo(part.ResourceLogs().AppendEmpty())
+	}
+	return parts
+}
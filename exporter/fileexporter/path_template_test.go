@@ -0,0 +1,117 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileexporter
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+var exportTime = time.Date(2023, 1, 2, 9, 4, 30, 0, time.UTC)
+
+func TestParsePathTemplateErrors(t *testing.T) {
+	for _, tt := range []struct {
+		path string
+		err  string
+	}{
+		{path: "./out/{service.name", err: `path "./out/{service.name" has an unterminated attribute reference`},
+		{path: "./out/{}/data.json", err: `path "./out/{}/data.json" has an empty attribute reference`},
+		{path: "./out/service.name}/data.json", err: `path "./out/service.name}/data.json" has an unmatched '}'`},
+		{path: "./out/data-%", err: `path "./out/data-%" ends with an incomplete time directive`},
+		{path: "./out/%Q.json", err: `path "./out/%Q.json" has an unsupported time directive "%Q"`},
+	} {
+		t.Run(tt.path, func(t *testing.T) {
+			_, err := parsePathTemplate(tt.path)
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestPathTemplateResolve(t *testing.T) {
+	resource := pcommon.NewResource()
+	resource.Attributes().PutStr("service.name", "checkout")
+	resource.Attributes().PutStr("k8s.namespace.name", "shop/prod")
+	resource.Attributes().PutStr("dots", "..")
+	resource.Attributes().PutInt("shard", 3)
+
+	for _, tt := range []struct {
+		path string
+		want string
+	}{
+		{path: "./out/{service.name}/%Y-%m-%d.json", want: "out/checkout/2023-01-02.json"},
+		{path: "./out/{k8s.namespace.name}/{shard}/%H%M.json", want: "out/shop_prod/3/0904.json"},
+		{path: "./out/{host.name}/data.json", want: "out/unknown/data.json"},
+		{path: "./out/{dots}/data.json", want: "out/__/data.json"},
+		// literal text isn't interpreted as a time layout
+		{path: "./out/Jan-2006-%d-100%%.json", want: "out/Jan-2006-02-100%.json"},
+	} {
+		t.Run(tt.path, func(t *testing.T) {
+			template, err := parsePathTemplate(tt.path)
+			require.NoError(t, err)
+			assert.Equal(t, filepath.FromSlash(tt.want), template.resolve(resource, exportTime))
+		})
+	}
+}
+
+func TestPathTemplateUsesUTC(t *testing.T) {
+	template, err := parsePathTemplate("%Y-%m-%dT%H.json")
+	require.NoError(t, err)
+	local := exportTime.In(time.FixedZone("UTC+10", 10*60*60))
+	assert.Equal(t, "2023-01-02T09.json", template.resolve(pcommon.NewResource(), local))
+}
+
+func TestPathTemplateSplit(t *testing.T) {
+	template, err := parsePathTemplate("{service.name}.json")
+	require.NoError(t, err)
+	services := []string{"checkout", "cart", "checkout"}
+
+	td := ptrace.NewTraces()
+	md := pmetric.NewMetrics()
+	ld := plog.NewLogs()
+	for _, service := range services {
+		rs := td.ResourceSpans().AppendEmpty()
+		rs.Resource().Attributes().PutStr("service.name", service)
+		rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName(service)
+		rm := md.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().PutStr("service.name", service)
+		rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName(service)
+		rl := ld.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("service.name", service)
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr(service)
+	}
+
+	traces := template.splitTraces(td, exportTime)
+	require.Len(t, traces, 2)
+	assert.Equal(t, 2, traces["checkout.json"].SpanCount())
+	assert.Equal(t, 1, traces["cart.json"].SpanCount())
+
+	metrics := template.splitMetrics(md, exportTime)
+	require.Len(t, metrics, 2)
+	assert.Equal(t, 2, metrics["checkout.json"].MetricCount())
+	assert.Equal(t, 1, metrics["cart.json"].MetricCount())
+
+	logs := template.splitLogs(ld, exportTime)
+	require.Len(t, logs, 2)
+	assert.Equal(t, 2, logs["checkout.json"].LogRecordCount())
+	assert.Equal(t, 1, logs["cart.json"].LogRecordCount())
+}
@@ -0,0 +1,273 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fileexporter"
+
+import (
+	"compress/gzip"
+	"errors"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/partitioner"
+)
+
+const (
+	megabyte = 1024 * 1024
+	// defaultMaxMegabytes is the size lumberjack rotates files at when none is configured.
+	defaultMaxMegabytes = 100
+	// backupTimeFormat is the format of the timestamp lumberjack puts in the names of rotated files.
+	backupTimeFormat = "2006-01-02T15-04-05.000"
+)
+
+// rotatingFile rotates a lumberjack file when it reaches its maximum size, as well as on
+// a time interval, and compresses the rotated files. Lumberjack keeps renaming the rotated
+// files and removing the old ones, but only knows of gzip compressed files, so the
+// compressed files are also pruned here.
+type rotatingFile struct {
+	logger      *lumberjack.Logger
+	maxSize     int64
+	interval    time.Duration
+	compression string
+	now         func() time.Time
+
+	mutex        sync.Mutex
+	size         int64
+	nextRotation time.Time
+	opened       bool
+
+	// compressMutex ensures the rotated files are compressed by one goroutine at a time
+	compressMutex sync.Mutex
+	compressWg    sync.WaitGroup
+}
+
+func newRotatingFile(path string, rotation *Rotation) *rotatingFile {
+	maxMegabytes := rotation.MaxMegabytes
+	if maxMegabytes == 0 {
+		maxMegabytes = defaultMaxMegabytes
+	}
+	return &rotatingFile{
+		logger: &lumberjack.Logger{
+			Filename: path,
+			// the file is rotated here before it reaches its maximum size, so that the
+			// rotated files can be compressed
+			MaxSize:    math.MaxInt32,
+			MaxAge:     rotation.MaxDays,
+			MaxBackups: rotation.MaxBackups,
+			LocalTime:  rotation.LocalTime,
+		},
+		maxSize:     int64(maxMegabytes) * megabyte,
+		interval:    rotation.Interval,
+		compression: rotation.Compression,
+		now:         time.Now,
+	}
+}
+
+func (r *rotatingFile) Write(p []byte) (int, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	now := r.now()
+	if !r.opened {
+		// lumberjack appends to an existing file
+		if info, err := os.Stat(r.logger.Filename); err == nil {
+			r.size = info.Size()
+		}
+		r.nextRotation = r.nextRotationAfter(now)
+		r.opened = true
+	}
+
+	if int64(len(p)) > r.maxSize {
+		return 0, errors.New("write length exceeds the maximum file size")
+	}
+
+	// empty files aren't rotated, even when the interval elapses
+	if r.size > 0 && (r.size+int64(len(p)) > r.maxSize || (r.interval > 0 && !now.Before(r.nextRotation))) {
+		if err := r.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	if r.interval > 0 && !now.Before(r.nextRotation) {
+		r.nextRotation = r.nextRotationAfter(now)
+	}
+
+	n, err := r.logger.Write(p)
+	r.size += int64(n)
+	return n, err
+}
+
+// nextRotationAfter returns the next multiple of the interval after now, so that files are
+// rotated on the clock, e.g. every hour on the hour.
+func (r *rotatingFile) nextRotationAfter(now time.Time) time.Time {
+	if r.interval <= 0 {
+		return time.Time{}
+	}
+	return now.Truncate(r.interval).Add(r.interval)
+}
+
+func (r *rotatingFile) rotate() error {
+	if err := r.logger.Rotate(); err != nil {
+		return err
+	}
+	r.size = 0
+
+	if r.compression != "" {
+		r.compressWg.Add(1)
+		go func() {
+			defer r.compressWg.Done()
+			r.compressBackups()
+		}()
+	}
+	return nil
+}
+
+// Close closes the file, waiting for the rotated files to be compressed.
+func (r *rotatingFile) Close() error {
+	r.compressWg.Wait()
+	return r.logger.Close()
+}
+
+// backup is a file rotated by lumberjack.
+type backup struct {
+	path       string
+	timestamp  time.Time
+	compressed bool
+}
+
+// backups lists the rotated files, newest first.
+func (r *rotatingFile) backups() ([]backup, error) {
+	dir := filepath.Dir(r.logger.Filename)
+	filename := filepath.Base(r.logger.Filename)
+	ext := filepath.Ext(filename)
+	prefix := filename[:len(filename)-len(ext)] + "-"
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var backups []backup
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		trimmed := name[len(prefix):]
+		compressed := false
+		for _, suffix := range []string{partitioner.Extension(partitioner.CompressionGzip), partitioner.Extension(partitioner.CompressionZstd)} {
+			if strings.HasSuffix(trimmed, ext+suffix) {
+				trimmed = strings.TrimSuffix(trimmed, suffix)
+				compressed = true
+				break
+			}
+		}
+		if !strings.HasSuffix(trimmed, ext) {
+			continue
+		}
+		timestamp, err := time.Parse(backupTimeFormat, strings.TrimSuffix(trimmed, ext))
+		if err != nil {
+			continue
+		}
+		backups = append(backups, backup{path: filepath.Join(dir, name), timestamp: timestamp, compressed: compressed})
+	}
+
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].timestamp.After(backups[j].timestamp)
+	})
+	return backups, nil
+}
+
+// compressBackups compresses the rotated files which aren't compressed yet, and removes
+// the compressed files beyond the configured retention.
+func (r *rotatingFile) compressBackups() {
+	r.compressMutex.Lock()
+	defer r.compressMutex.Unlock()
+
+	backups, err := r.backups()
+	if err != nil {
+		return
+	}
+	for i, b := range backups {
+		if b.compressed {
+			continue
+		}
+		compressed := b.path + partitioner.Extension(r.compression)
+		// a file which can't be compressed is left as it is
+		if err = compressFile(b.path, compressed, r.compression); err == nil {
+			backups[i] = backup{path: compressed, timestamp: b.timestamp, compressed: true}
+		}
+	}
+
+	cutoff := time.Time{}
+	if r.logger.MaxAge > 0 {
+		cutoff = r.now().Add(-time.Duration(r.logger.MaxAge) * 24 * time.Hour)
+	}
+	for i, b := range backups {
+		if (r.logger.MaxBackups > 0 && i >= r.logger.MaxBackups) || b.timestamp.Before(cutoff) {
+			_ = os.Remove(b.path)
+		}
+	}
+}
+
+// compressFile compresses src into dst with the given algorithm, and removes src.
+func compressFile(src, dst, compression string) (err error) {
+	in, err := os.Open(filepath.Clean(src))
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(filepath.Clean(dst), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = out.Close()
+			_ = os.Remove(dst)
+		}
+	}()
+
+	var w io.WriteCloser
+	switch compression {
+	case partitioner.CompressionGzip:
+		w = gzip.NewWriter(out)
+	case partitioner.CompressionZstd:
+		if w, err = zstd.NewWriter(out); err != nil {
+			return err
+		}
+	default:
+		return errors.New("compression is not supported")
+	}
+
+	if _, err = io.Copy(w, in); err != nil {
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+	if err = out.Close(); err != nil {
+		return err
+	}
+	return os.Remove(src)
+}
@@ -0,0 +1,198 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileexporter
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func newTestRotatingFile(t *testing.T, rotation *Rotation, maxSize int64) (*rotatingFile, *fakeClock) {
+	clock := &fakeClock{now: exportTime}
+	r := newRotatingFile(filepath.Join(t.TempDir(), "data.json"), rotation)
+	r.maxSize = maxSize
+	r.now = clock.Now
+	return r, clock
+}
+
+// listBackups returns the names of the rotated files, oldest first.
+func listBackups(t *testing.T, r *rotatingFile) []string {
+	entries, err := os.ReadDir(filepath.Dir(r.logger.Filename))
+	require.NoError(t, err)
+	var names []string
+	for _, entry := range entries {
+		if entry.Name() != filepath.Base(r.logger.Filename) {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func readBackup(t *testing.T, path string) string {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var r io.Reader = f
+	switch {
+	case strings.HasSuffix(path, ".gz"):
+		gz, err := gzip.NewReader(f)
+		require.NoError(t, err)
+		r = gz
+	case strings.HasSuffix(path, ".zst"):
+		zr, err := zstd.NewReader(f)
+		require.NoError(t, err)
+		defer zr.Close()
+		r = zr
+	}
+	buf, err := io.ReadAll(r)
+	require.NoError(t, err)
+	return string(buf)
+}
+
+func TestRotatingFileSize(t *testing.T) {
+	for _, compression := range []string{"", "gzip", "zstd"} {
+		t.Run(compression, func(t *testing.T) {
+			r, _ := newTestRotatingFile(t, &Rotation{Compression: compression}, 10)
+
+			for _, record := range []string{"first\n", "second\n", "third\n"} {
+				_, err := r.Write([]byte(record))
+				require.NoError(t, err)
+				// lumberjack names the rotated files after the time, to the millisecond
+				time.Sleep(2 * time.Millisecond)
+			}
+			require.NoError(t, r.Close())
+
+			backups := listBackups(t, r)
+			require.Len(t, backups, 2)
+			var content []string
+			for _, name := range backups {
+				if compression != "" {
+					assert.True(t, strings.HasSuffix(name, ".json."+map[string]string{"gzip": "gz", "zstd": "zst"}[compression]), name)
+				}
+				content = append(content, readBackup(t, filepath.Join(filepath.Dir(r.logger.Filename), name)))
+			}
+			assert.Equal(t, []string{"first\n", "second\n"}, content)
+			assert.Equal(t, "third\n", readBackup(t, r.logger.Filename))
+		})
+	}
+}
+
+func TestRotatingFileInterval(t *testing.T) {
+	r, clock := newTestRotatingFile(t, &Rotation{Interval: time.Hour, Compression: "gzip"}, 1024)
+
+	_, err := r.Write([]byte("09:04\n"))
+	require.NoError(t, err)
+	clock.now = exportTime.Add(50 * time.Minute)
+	_, err = r.Write([]byte("09:54\n"))
+	require.NoError(t, err)
+	assert.Empty(t, listBackups(t, r))
+
+	// the file is rotated on the hour
+	clock.now = exportTime.Add(56 * time.Minute)
+	_, err = r.Write([]byte("10:00\n"))
+	require.NoError(t, err)
+	r.compressWg.Wait()
+	backups := listBackups(t, r)
+	require.Len(t, backups, 1)
+	assert.Equal(t, "09:04\n09:54\n", readBackup(t, filepath.Join(filepath.Dir(r.logger.Filename), backups[0])))
+
+	// files without writes during an interval aren't rotated
+	clock.now = exportTime.Add(5 * time.Hour)
+	require.NoError(t, r.Close())
+	assert.Len(t, listBackups(t, r), 1)
+	assert.Equal(t, "10:00\n", readBackup(t, r.logger.Filename))
+}
+
+func TestRotatingFileAppendsToExistingFile(t *testing.T) {
+	r, _ := newTestRotatingFile(t, &Rotation{Compression: "zstd"}, 10)
+	require.NoError(t, os.WriteFile(r.logger.Filename, []byte("existing\n"), 0600))
+
+	_, err := r.Write([]byte("new\n"))
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+
+	backups := listBackups(t, r)
+	require.Len(t, backups, 1)
+	assert.Equal(t, "existing\n", readBackup(t, filepath.Join(filepath.Dir(r.logger.Filename), backups[0])))
+	assert.Equal(t, "new\n", readBackup(t, r.logger.Filename))
+}
+
+func TestRotatingFileMaxBackups(t *testing.T) {
+	r, _ := newTestRotatingFile(t, &Rotation{MaxBackups: 2, Compression: "zstd"}, 1)
+	dir := filepath.Dir(r.logger.Filename)
+
+	// backups of previous rotations, oldest first
+	for i, timestamp := range []string{"2023-01-01T00-00-00.000", "2023-01-01T01-00-00.000", "2023-01-01T02-00-00.000"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "data-"+timestamp+".json.zst"), []byte{byte(i)}, 0600))
+	}
+	// files which aren't backups are kept
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "data-other.json"), nil, 0600))
+
+	_, err := r.Write([]byte("a"))
+	require.NoError(t, err)
+	_, err = r.Write([]byte("b"))
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+
+	backups := listBackups(t, r)
+	require.Len(t, backups, 3)
+	assert.Equal(t, "data-2023-01-01T02-00-00.000.json.zst", backups[0])
+	assert.Equal(t, "data-other.json", backups[2])
+	assert.True(t, strings.HasSuffix(backups[1], ".json.zst"))
+	assert.Equal(t, "a", readBackup(t, filepath.Join(dir, backups[1])))
+}
+
+func TestRotatingFileWriteExceedsMaxSize(t *testing.T) {
+	r, _ := newTestRotatingFile(t, &Rotation{}, 2)
+	_, err := r.Write([]byte("too long"))
+	assert.EqualError(t, err, "write length exceeds the maximum file size")
+	require.NoError(t, r.Close())
+}
+
+func TestCompressFile(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "data.json")
+	require.NoError(t, os.WriteFile(src, bytes.Repeat([]byte("data\n"), 100), 0600))
+
+	assert.EqualError(t, compressFile(src, src+".snappy", "snappy"), "compression is not supported")
+	_, err := os.Stat(src + ".snappy")
+	assert.True(t, os.IsNotExist(err))
+
+	require.NoError(t, compressFile(src, src+".gz", "gzip"))
+	_, err = os.Stat(src)
+	assert.True(t, os.IsNotExist(err))
+	assert.Equal(t, strings.Repeat("data\n", 100), readBackup(t, src+".gz"))
+}
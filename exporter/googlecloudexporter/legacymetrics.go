@@ -32,6 +32,7 @@ import (
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+	"go.uber.org/zap"
 	"google.golang.org/api/option"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -42,6 +43,11 @@ import (
 // metricsExporter is a wrapper struct of OC stackdriver exporter
 type metricsExporter struct {
 	mexporter *stackdriver.Exporter
+	logger    *zap.Logger
+
+	// warnExponentialHistogramsOnce logs the first drop of exponential histograms,
+	// which the OpenCensus translation does not support.
+	warnExponentialHistogramsOnce sync.Once
 }
 
 func (me *metricsExporter) Shutdown(context.Context) error {
@@ -138,7 +144,7 @@ func newLegacyGoogleCloudMetricsExporter(cfg *LegacyConfig, set component.Export
 	if serr != nil {
 		return nil, fmt.Errorf("cannot configure Google Cloud metric exporter: %w", serr)
 	}
-	mExp := &metricsExporter{mexporter: sde}
+	mExp := &metricsExporter{mexporter: sde, logger: set.Logger}
 
 	return exporterhelper.NewMetricsExporter(
 		context.TODO(),
@@ -155,6 +161,15 @@ func newLegacyGoogleCloudMetricsExporter(cfg *LegacyConfig, set component.Export
 
 // pushMetrics calls StackdriverExporter.PushMetricsProto on each element of the given metrics
 func (me *metricsExporter) pushMetrics(ctx context.Context, m pmetric.Metrics) error {
+	// Exponential histograms have no OpenCensus equivalent and are dropped by the translation.
+	unsupported := numExponentialHistogramPoints(m)
+	if unsupported > 0 {
+		me.warnExponentialHistogramsOnce.Do(func() {
+			me.logger.Warn("Dropping exponential histograms, which are only exported when the "+pdataExporterFeatureGate+" feature gate is enabled",
+				zap.Int("dropped_points", unsupported))
+		})
+	}
+
 	rms := m.ResourceMetrics()
 	mds := make([]*agentmetricspb.ExportMetricsServiceRequest, 0, rms.Len())
 	for i := 0; i < rms.Len(); i++ {
@@ -188,6 +203,9 @@ func (me *metricsExporter) pushMetrics(ctx context.Context, m pmetric.Metrics) e
 	// (which we just moved to individual metrics).
 	dropped, err := me.mexporter.PushMetricsProto(ctx, nil, nil, metrics)
 	recordPointCount(ctx, points-dropped, dropped, err)
+	if unsupported > 0 {
+		recordPointCountDataPoint(ctx, unsupported, "UNIMPLEMENTED")
+	}
 	return err
 }
 
@@ -206,6 +224,23 @@ func exportAdditionalLabels(mds []*agentmetricspb.ExportMetricsServiceRequest) [
 	return mds
 }
 
+func numExponentialHistogramPoints(m pmetric.Metrics) int {
+	numPoints := 0
+	rms := m.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		sms := rms.At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			ms := sms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				if ms.At(k).Type() == pmetric.MetricTypeExponentialHistogram {
+					numPoints += ms.At(k).ExponentialHistogram().DataPoints().Len()
+				}
+			}
+		}
+	}
+	return numPoints
+}
+
 func numPoints(metrics []*metricspb.Metric) int {
 	numPoints := 0
 	for _, metric := range metrics {
@@ -0,0 +1,124 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package googlecloudexporter
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	cloudmonitoringpb "cloud.google.com/go/monitoring/apiv3/v2/monitoringpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+)
+
+func TestGoogleCloudExponentialHistogramExport(t *testing.T) {
+	defer setPdataFeatureGateForTest(t, true)()
+
+	srv := grpc.NewServer()
+	timeSeriesReqCh := make(chan *requestWithMetadata)
+	cloudmonitoringpb.RegisterMetricServiceServer(srv, &mockMetricServer{
+		descriptorReqCh: make(chan *requestWithMetadata),
+		timeSeriesReqCh: timeSeriesReqCh,
+	})
+
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	defer lis.Close()
+	defer srv.Stop()
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.ProjectID = "idk"
+	cfg.MetricConfig.SkipCreateMetricDescriptor = true
+	cfg.MetricConfig.ClientConfig.Endpoint = lis.Addr().String()
+	cfg.MetricConfig.ClientConfig.UseInsecure = true
+	cfg.MetricConfig.ClientConfig.GetClientOptions = func() []option.ClientOption {
+		return []option.ClientOption{option.WithoutAuthentication(), option.WithTelemetryDisabled()}
+	}
+	exp, err := factory.CreateMetricsExporter(context.Background(), componenttest.NewNopExporterCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, exp.Shutdown(context.Background())) }()
+
+	md := pmetric.NewMetrics()
+	m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("latency")
+	m.SetEmptyExponentialHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	dp := m.ExponentialHistogram().DataPoints().AppendEmpty()
+	now := time.Now()
+	dp.SetStartTimestamp(pcommon.NewTimestampFromTime(now.Add(-time.Minute)))
+	dp.SetTimestamp(pcommon.NewTimestampFromTime(now))
+	dp.SetScale(1)
+	dp.SetCount(7)
+	dp.SetSum(21)
+	dp.SetZeroCount(1)
+	dp.Positive().SetOffset(2)
+	dp.Positive().BucketCounts().FromRaw([]uint64{2, 4})
+	ex := dp.Exemplars().AppendEmpty()
+	ex.SetDoubleValue(3)
+	ex.SetTimestamp(pcommon.NewTimestampFromTime(now))
+	ex.SetTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	ex.SetSpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8})
+	require.NoError(t, exp.ConsumeMetrics(context.Background(), md))
+
+	var req *cloudmonitoringpb.CreateTimeSeriesRequest
+	select {
+	case r := <-timeSeriesReqCh:
+		req = r.req.(*cloudmonitoringpb.CreateTimeSeriesRequest)
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the time series")
+	}
+	require.Len(t, req.TimeSeries, 1)
+	require.Len(t, req.TimeSeries[0].Points, 1)
+	dist := req.TimeSeries[0].Points[0].Value.GetDistributionValue()
+	require.NotNil(t, dist)
+
+	assert.EqualValues(t, 7, dist.Count)
+	assert.Equal(t, 3.0, dist.Mean)
+	// underflow, the two positive buckets and the empty overflow bucket
+	assert.Equal(t, []int64{1, 2, 4, 0}, dist.BucketCounts)
+	exponential := dist.BucketOptions.GetExponentialBuckets()
+	require.NotNil(t, exponential)
+	assert.EqualValues(t, 2, exponential.NumFiniteBuckets)
+	assert.InDelta(t, 1.4142, exponential.GrowthFactor, 1e-4)
+	assert.InDelta(t, 2, exponential.Scale, 1e-9)
+
+	require.Len(t, dist.Exemplars, 1)
+	assert.Equal(t, 3.0, dist.Exemplars[0].Value)
+	require.Len(t, dist.Exemplars[0].Attachments, 1)
+	spanContext := &cloudmonitoringpb.SpanContext{}
+	require.NoError(t, dist.Exemplars[0].Attachments[0].UnmarshalTo(spanContext))
+	assert.Equal(t, "projects/idk/traces/0102030405060708090a0b0c0d0e0f10/spans/0102030405060708", spanContext.SpanName)
+}
+
+func TestNumExponentialHistogramPoints(t *testing.T) {
+	md := pmetric.NewMetrics()
+	ms := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	ms.AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty()
+	eh := ms.AppendEmpty().SetEmptyExponentialHistogram()
+	eh.DataPoints().AppendEmpty()
+	eh.DataPoints().AppendEmpty()
+	assert.Equal(t, 2, numExponentialHistogramPoints(md))
+}
@@ -15,6 +15,7 @@
 package influxdbexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/influxdbexporter"
 
 import (
+	"errors"
 	"fmt"
 
 	"go.opentelemetry.io/collector/component"
@@ -28,8 +29,32 @@ const (
 	typeStr = "influxdb"
 	// The stability level of the exporter.
 	stability = component.StabilityLevelBeta
+
+	apiVersionV2 = "v2"
+	apiVersionV3 = "v3"
 )
 
+// SchemaSettings defines how the points of a signal are laid out in line protocol.
+type SchemaSettings struct {
+	// Measurement is the name of the measurement that points are written to.
+	Measurement string `mapstructure:"measurement"`
+	// Dimensions lists the attribute keys that are written as tags; every other
+	// attribute is written as a field. When empty, resource and instrumentation
+	// scope attributes are written as tags, following the influxdb-observability schema.
+	Dimensions []string `mapstructure:"dimensions"`
+}
+
+// MetricSchemaSettings defines how the metric points are laid out in line protocol.
+type MetricSchemaSettings struct {
+	// MeasurementPerMetric writes the points of each metric family to a measurement
+	// named after the metric, instead of the shared `prometheus` measurement of the
+	// telegraf-prometheus-v2 schema.
+	MeasurementPerMetric bool `mapstructure:"measurement_per_metric"`
+	// Dimensions lists the attribute keys that are written as tags; every other
+	// attribute is written as a field. When empty, all attributes are written as tags.
+	Dimensions []string `mapstructure:"dimensions"`
+}
+
 // Config defines configuration for the InfluxDB exporter.
 type Config struct {
 	config.ExporterSettings       `mapstructure:",squash"`
@@ -37,23 +62,50 @@ type Config struct {
 	exporterhelper.QueueSettings  `mapstructure:"sending_queue"`
 	exporterhelper.RetrySettings  `mapstructure:"retry_on_failure"`
 
+	// APIVersion is the InfluxDB write API that line protocol is sent to.
+	// Options:
+	// - v2: the /api/v2/write API of InfluxDB 2 and InfluxDB Cloud
+	// - v3: the /api/v3/write_lp API of InfluxDB 3
+	APIVersion string `mapstructure:"api_version"`
+
 	// Org is the InfluxDB organization name of the destination bucket.
 	Org string `mapstructure:"org"`
 	// Bucket is the InfluxDB bucket name that telemetry will be written to.
 	Bucket string `mapstructure:"bucket"`
 	// Token is used to identify InfluxDB permissions within the organization.
 	Token string `mapstructure:"token"`
+	// Database is the InfluxDB 3 database that telemetry will be written to.
+	// Defaults to Bucket. Only used with the v3 API.
+	Database string `mapstructure:"database"`
 
 	// MetricsSchema indicates the metrics schema to emit to line protocol.
 	// Options:
 	// - telegraf-prometheus-v1
 	// - telegraf-prometheus-v2
 	MetricsSchema string `mapstructure:"metrics_schema"`
+	// MetricPointSchema configures the measurements and tags of metric points.
+	MetricPointSchema MetricSchemaSettings `mapstructure:"metric_point_schema"`
+
+	// SpanSchema configures the measurement and tags of spans.
+	SpanSchema SchemaSettings `mapstructure:"span_schema"`
+	// LogRecordSchema configures the measurement and tags of log records and span events.
+	LogRecordSchema SchemaSettings `mapstructure:"log_record_schema"`
 }
 
 func (cfg *Config) Validate() error {
 	if err := cfg.ExporterSettings.Validate(); err != nil {
 		return fmt.Errorf("exporter settings are invalid :%w", err)
 	}
+	switch cfg.APIVersion {
+	case apiVersionV2, apiVersionV3:
+	default:
+		return fmt.Errorf("api_version must be %q or %q, got %q", apiVersionV2, apiVersionV3, cfg.APIVersion)
+	}
+	if cfg.SpanSchema.Measurement == "" {
+		return errors.New("span_schema.measurement must not be empty")
+	}
+	if cfg.LogRecordSchema.Measurement == "" {
+		return errors.New("log_record_schema.measurement must not be empty")
+	}
 	return nil
 }
@@ -62,12 +62,37 @@ func TestLoadConfig(t *testing.T) {
 					MaxInterval:     3 * time.Second,
 					MaxElapsedTime:  10 * time.Second,
 				},
+				APIVersion:    "v2",
 				Org:           "my-org",
 				Bucket:        "my-bucket",
 				Token:         "my-token",
 				MetricsSchema: "telegraf-prometheus-v2",
+				SpanSchema: SchemaSettings{
+					Measurement: "otel_spans",
+					Dimensions:  []string{"service.name", "name"},
+				},
+				LogRecordSchema: SchemaSettings{
+					Measurement: "otel_logs",
+					Dimensions:  []string{"service.name"},
+				},
 			},
 		},
+		{
+			id: component.NewIDWithName(typeStr, "v3"),
+			expected: func() component.ExporterConfig {
+				cfg := createDefaultConfig().(*Config)
+				cfg.Endpoint = "http://localhost:8181"
+				cfg.APIVersion = "v3"
+				cfg.Database = "my-db"
+				cfg.Token = "my-token"
+				cfg.MetricsSchema = "telegraf-prometheus-v2"
+				cfg.MetricPointSchema = MetricSchemaSettings{
+					MeasurementPerMetric: true,
+					Dimensions:           []string{"service.name", "host.name"},
+				}
+				return cfg
+			}(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -84,3 +109,17 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateConfig(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.SpanSchema.Measurement = ""
+	assert.EqualError(t, cfg.Validate(), "span_schema.measurement must not be empty")
+
+	cfg = createDefaultConfig().(*Config)
+	cfg.LogRecordSchema.Measurement = ""
+	assert.EqualError(t, cfg.Validate(), "log_record_schema.measurement must not be empty")
+
+	cfg = createDefaultConfig().(*Config)
+	cfg.APIVersion = "v1"
+	assert.EqualError(t, cfg.Validate(), `api_version must be "v2" or "v3", got "v1"`)
+}
@@ -32,6 +32,7 @@ type tracesExporter struct {
 	cfg       *Config
 	writer    *influxHTTPWriter
 	converter *otel2influx.OtelTracesToLineProtocol
+	schemas   map[string]measurementSchema
 	settings  component.TelemetrySettings
 }
 
@@ -43,14 +44,19 @@ func newTracesExporter(config *Config, params component.ExporterCreateSettings)
 		logger:    logger,
 		cfg:       config,
 		converter: converter,
-		settings:  params.TelemetrySettings,
+		schemas: map[string]measurementSchema{
+			common.MeasurementSpans: newMeasurementSchema(config.SpanSchema),
+			// span events are written as log records
+			common.MeasurementLogs: newMeasurementSchema(config.LogRecordSchema),
+		},
+		settings: params.TelemetrySettings,
 	}
 }
 
 func (e *tracesExporter) pushTraces(ctx context.Context, td ptrace.Traces) error {
 	batch := e.writer.newBatch()
 
-	err := e.converter.WriteTraces(ctx, td, batch)
+	err := e.converter.WriteTraces(ctx, td, &schemaWriter{next: batch, schemas: e.schemas})
 	if err != nil {
 		return consumererror.NewPermanent(err)
 	}
@@ -74,6 +80,7 @@ type metricsExporter struct {
 	cfg       *Config
 	writer    *influxHTTPWriter
 	converter *otel2influx.OtelMetricsToLineProtocol
+	schema    measurementSchema
 	settings  component.TelemetrySettings
 }
 
@@ -98,6 +105,7 @@ func newMetricsExporter(config *Config, params component.ExporterCreateSettings)
 		logger:    logger,
 		cfg:       config,
 		converter: converter,
+		schema:    newMetricSchema(config.MetricPointSchema),
 		settings:  params.TelemetrySettings,
 	}, nil
 }
@@ -105,7 +113,7 @@ func newMetricsExporter(config *Config, params component.ExporterCreateSettings)
 func (e *metricsExporter) pushMetrics(ctx context.Context, md pmetric.Metrics) error {
 	batch := e.writer.newBatch()
 
-	err := e.converter.WriteMetrics(ctx, md, batch)
+	err := e.converter.WriteMetrics(ctx, md, &schemaWriter{next: batch, defaultSchema: &e.schema})
 	if err != nil {
 		return consumererror.NewPermanent(err)
 	}
@@ -129,6 +137,7 @@ type logsExporter struct {
 	cfg       *Config
 	writer    *influxHTTPWriter
 	converter *otel2influx.OtelLogsToLineProtocol
+	schemas   map[string]measurementSchema
 	settings  component.TelemetrySettings
 }
 
@@ -140,14 +149,17 @@ func newLogsExporter(config *Config, params component.ExporterCreateSettings) *l
 		logger:    logger,
 		converter: converter,
 		cfg:       config,
-		settings:  params.TelemetrySettings,
+		schemas: map[string]measurementSchema{
+			common.MeasurementLogs: newMeasurementSchema(config.LogRecordSchema),
+		},
+		settings: params.TelemetrySettings,
 	}
 }
 
 func (e *logsExporter) pushLogs(ctx context.Context, ld plog.Logs) error {
 	batch := e.writer.newBatch()
 
-	err := e.converter.WriteLogs(ctx, ld, batch)
+	err := e.converter.WriteLogs(ctx, ld, &schemaWriter{next: batch, schemas: e.schemas})
 	if err != nil {
 		return consumererror.NewPermanent(err)
 	}
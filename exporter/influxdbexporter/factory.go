@@ -18,6 +18,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/influxdata/influxdb-observability/common"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/config/confighttp"
@@ -97,6 +98,13 @@ func createDefaultConfig() component.ExporterConfig {
 		},
 		QueueSettings: exporterhelper.NewDefaultQueueSettings(),
 		RetrySettings: exporterhelper.NewDefaultRetrySettings(),
+		APIVersion:    apiVersionV2,
 		MetricsSchema: "telegraf-prometheus-v1",
+		SpanSchema: SchemaSettings{
+			Measurement: common.MeasurementSpans,
+		},
+		LogRecordSchema: SchemaSettings{
+			Measurement: common.MeasurementLogs,
+		},
 	}
 }
@@ -0,0 +1,159 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package influxdbexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/influxdbexporter"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/influxdata/influxdb-observability/common"
+	"github.com/influxdata/influxdb-observability/otel2influx"
+)
+
+// identifierTags are always written as tags, regardless of the configured dimensions,
+// so that spans and log records can be correlated by trace.
+var identifierTags = map[string]struct{}{
+	common.AttributeTraceID: {},
+	common.AttributeSpanID:  {},
+}
+
+// measurementSchema is the compiled form of SchemaSettings.
+type measurementSchema struct {
+	// measurement is empty when the converter's measurement is kept as is.
+	measurement string
+	// measurementPerMetric names the measurement of metric points after their metric family.
+	measurementPerMetric bool
+	// dimensions is nil when the converter's tag layout is kept as is.
+	dimensions map[string]struct{}
+}
+
+func newMeasurementSchema(settings SchemaSettings) measurementSchema {
+	return measurementSchema{
+		measurement: settings.Measurement,
+		dimensions:  newDimensions(settings.Dimensions),
+	}
+}
+
+func newMetricSchema(settings MetricSchemaSettings) measurementSchema {
+	return measurementSchema{
+		measurementPerMetric: settings.MeasurementPerMetric,
+		dimensions:           newDimensions(settings.Dimensions),
+	}
+}
+
+func newDimensions(keys []string) map[string]struct{} {
+	if len(keys) == 0 {
+		return nil
+	}
+	dimensions := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		dimensions[key] = struct{}{}
+	}
+	return dimensions
+}
+
+// schemaWriter rewrites the points produced by the otel2influx converters according to
+// the configured schema, keyed by the measurement the converter writes to.
+type schemaWriter struct {
+	next    otel2influx.InfluxWriter
+	schemas map[string]measurementSchema
+	// defaultSchema applies to the measurements missing from schemas, if set.
+	defaultSchema *measurementSchema
+}
+
+var _ otel2influx.InfluxWriter = (*schemaWriter)(nil)
+
+func (w *schemaWriter) WritePoint(ctx context.Context, measurement string, tags map[string]string, fields map[string]interface{}, ts time.Time, vType common.InfluxMetricValueType) error {
+	schema, ok := w.schemas[measurement]
+	if !ok {
+		if w.defaultSchema == nil {
+			return w.next.WritePoint(ctx, measurement, tags, fields, ts, vType)
+		}
+		schema = *w.defaultSchema
+	}
+
+	switch {
+	case schema.measurementPerMetric && measurement == common.MeasurementPrometheus:
+		if family := metricFamily(fields, vType); family != "" {
+			measurement = family
+		}
+	case schema.measurement != "":
+		measurement = schema.measurement
+	}
+	if schema.dimensions != nil {
+		tags, fields = schema.applyDimensions(tags, fields)
+	}
+	return w.next.WritePoint(ctx, measurement, tags, fields, ts, vType)
+}
+
+// metricFamily returns the name of the metric whose values are the fields of a point of
+// the telegraf-prometheus-v2 schema, e.g. `http_duration` for `http_duration_bucket`,
+// or an empty string if the fields belong to different metrics.
+func metricFamily(fields map[string]interface{}, vType common.InfluxMetricValueType) string {
+	var suffixes []string
+	switch vType {
+	case common.InfluxMetricValueTypeHistogram:
+		suffixes = []string{common.MetricHistogramBucketSuffix, common.MetricHistogramCountSuffix, common.MetricHistogramSumSuffix}
+	case common.InfluxMetricValueTypeSummary:
+		suffixes = []string{common.MetricSummaryCountSuffix, common.MetricSummarySumSuffix}
+	}
+
+	var family string
+	for k := range fields {
+		name := k
+		for _, suffix := range suffixes {
+			if trimmed := strings.TrimSuffix(k, suffix); trimmed != k {
+				name = trimmed
+				break
+			}
+		}
+		if family != "" && family != name {
+			return ""
+		}
+		family = name
+	}
+	return family
+}
+
+// applyDimensions moves tags which are not dimensions to fields, and fields which are
+// dimensions to tags.
+func (s measurementSchema) applyDimensions(tags map[string]string, fields map[string]interface{}) (map[string]string, map[string]interface{}) {
+	newTags := make(map[string]string, len(s.dimensions)+len(identifierTags))
+	for k, v := range tags {
+		_, isDimension := s.dimensions[k]
+		_, isIdentifier := identifierTags[k]
+		if isDimension || isIdentifier {
+			newTags[k] = v
+		} else if _, exists := fields[k]; !exists {
+			fields[k] = v
+		}
+	}
+
+	for k := range s.dimensions {
+		if v, ok := fields[k]; ok {
+			newTags[k] = fmt.Sprint(v)
+			delete(fields, k)
+		}
+	}
+
+	if len(fields) == 0 {
+		// line protocol requires at least one field per point
+		fields["count"] = uint64(1)
+	}
+
+	return newTags, fields
+}
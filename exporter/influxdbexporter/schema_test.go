@@ -0,0 +1,261 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package influxdbexporter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb-observability/common"
+	"github.com/influxdata/influxdb-observability/otel2influx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+type point struct {
+	measurement string
+	tags        map[string]string
+	fields      map[string]interface{}
+}
+
+type recordingWriter struct {
+	points []point
+}
+
+func (w *recordingWriter) WritePoint(_ context.Context, measurement string, tags map[string]string, fields map[string]interface{}, _ time.Time, _ common.InfluxMetricValueType) error {
+	w.points = append(w.points, point{measurement: measurement, tags: tags, fields: fields})
+	return nil
+}
+
+func TestSchemaWriterDefaultLayout(t *testing.T) {
+	recorder := &recordingWriter{}
+	writer := &schemaWriter{
+		next: recorder,
+		schemas: map[string]measurementSchema{
+			common.MeasurementSpans: newMeasurementSchema(SchemaSettings{Measurement: "otel_spans"}),
+		},
+	}
+
+	tags := map[string]string{"service.name": "checkout", common.AttributeTraceID: "01"}
+	fields := map[string]interface{}{"http.method": "GET"}
+	require.NoError(t, writer.WritePoint(context.Background(), common.MeasurementSpans, tags, fields, time.Now(), common.InfluxMetricValueTypeUntyped))
+	require.NoError(t, writer.WritePoint(context.Background(), common.MeasurementSpanLinks, tags, fields, time.Now(), common.InfluxMetricValueTypeUntyped))
+
+	require.Len(t, recorder.points, 2)
+	assert.Equal(t, point{measurement: "otel_spans", tags: tags, fields: fields}, recorder.points[0])
+	assert.Equal(t, point{measurement: common.MeasurementSpanLinks, tags: tags, fields: fields}, recorder.points[1])
+}
+
+func TestSchemaWriterDimensions(t *testing.T) {
+	recorder := &recordingWriter{}
+	writer := &schemaWriter{
+		next: recorder,
+		schemas: map[string]measurementSchema{
+			common.MeasurementSpans: newMeasurementSchema(SchemaSettings{
+				Measurement: common.MeasurementSpans,
+				Dimensions:  []string{"service.name", "http.status_code"},
+			}),
+		},
+	}
+
+	tags := map[string]string{
+		"service.name":             "checkout",
+		"host.name":                "node-1",
+		common.AttributeTraceID:    "01",
+		common.AttributeSpanID:     "02",
+		common.AttributeSpanKind:   "SPAN_KIND_SERVER",
+		common.AttributeStatusCode: "STATUS_CODE_OK",
+	}
+	fields := map[string]interface{}{
+		"http.status_code": int64(200),
+		"http.method":      "GET",
+	}
+	require.NoError(t, writer.WritePoint(context.Background(), common.MeasurementSpans, tags, fields, time.Now(), common.InfluxMetricValueTypeUntyped))
+
+	require.Len(t, recorder.points, 1)
+	assert.Equal(t, map[string]string{
+		"service.name":          "checkout",
+		"http.status_code":      "200",
+		common.AttributeTraceID: "01",
+		common.AttributeSpanID:  "02",
+	}, recorder.points[0].tags)
+	assert.Equal(t, map[string]interface{}{
+		"host.name":                "node-1",
+		"http.method":              "GET",
+		common.AttributeSpanKind:   "SPAN_KIND_SERVER",
+		common.AttributeStatusCode: "STATUS_CODE_OK",
+	}, recorder.points[0].fields)
+}
+
+func TestSchemaWriterKeepsOneField(t *testing.T) {
+	recorder := &recordingWriter{}
+	writer := &schemaWriter{
+		next: recorder,
+		schemas: map[string]measurementSchema{
+			common.MeasurementLogs: newMeasurementSchema(SchemaSettings{
+				Measurement: common.MeasurementLogs,
+				Dimensions:  []string{"body"},
+			}),
+		},
+	}
+
+	require.NoError(t, writer.WritePoint(context.Background(), common.MeasurementLogs, map[string]string{}, map[string]interface{}{"body": "hello"}, time.Now(), common.InfluxMetricValueTypeUntyped))
+
+	require.Len(t, recorder.points, 1)
+	assert.Equal(t, map[string]string{"body": "hello"}, recorder.points[0].tags)
+	assert.Equal(t, map[string]interface{}{"count": uint64(1)}, recorder.points[0].fields)
+}
+
+func TestTracesAndLogsSchema(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.SpanSchema = SchemaSettings{Measurement: "otel_spans", Dimensions: []string{"service.name"}}
+	cfg.LogRecordSchema = SchemaSettings{Measurement: "otel_logs", Dimensions: []string{"service.name"}}
+	logger := newZapInfluxLogger(zap.NewNop())
+
+	traces := ptrace.NewTraces()
+	resourceSpans := traces.ResourceSpans().AppendEmpty()
+	resourceSpans.Resource().Attributes().PutStr("service.name", "checkout")
+	resourceSpans.Resource().Attributes().PutStr("host.name", "node-1")
+	span := resourceSpans.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.SetTraceID([16]byte{1})
+	span.SetSpanID([8]byte{2})
+	span.SetStartTimestamp(pcommon.NewTimestampFromTime(time.Unix(1, 0)))
+	span.SetEndTimestamp(pcommon.NewTimestampFromTime(time.Unix(2, 0)))
+	event := span.Events().AppendEmpty()
+	event.SetName("exception")
+	event.SetTimestamp(pcommon.NewTimestampFromTime(time.Unix(1, 0)))
+
+	recorder := &recordingWriter{}
+	te := newTracesExporter(cfg, componenttest.NewNopExporterCreateSettings())
+	require.NoError(t, otel2influx.NewOtelTracesToLineProtocol(logger).WriteTraces(context.Background(), traces, &schemaWriter{next: recorder, schemas: te.schemas}))
+
+	require.Len(t, recorder.points, 2)
+	assert.Equal(t, "otel_logs", recorder.points[0].measurement)
+	assert.Equal(t, "otel_spans", recorder.points[1].measurement)
+	assert.Equal(t, "checkout", recorder.points[1].tags["service.name"])
+	assert.NotContains(t, recorder.points[1].tags, "host.name")
+	assert.Equal(t, "node-1", recorder.points[1].fields["host.name"])
+
+	logs := plog.NewLogs()
+	resourceLogs := logs.ResourceLogs().AppendEmpty()
+	resourceLogs.Resource().Attributes().PutStr("service.name", "checkout")
+	logRecord := resourceLogs.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	logRecord.SetTimestamp(pcommon.NewTimestampFromTime(time.Unix(1, 0)))
+	logRecord.Body().SetStr("hello")
+
+	recorder = &recordingWriter{}
+	le := newLogsExporter(cfg, componenttest.NewNopExporterCreateSettings())
+	require.NoError(t, otel2influx.NewOtelLogsToLineProtocol(logger).WriteLogs(context.Background(), logs, &schemaWriter{next: recorder, schemas: le.schemas}))
+
+	require.Len(t, recorder.points, 1)
+	assert.Equal(t, "otel_logs", recorder.points[0].measurement)
+	assert.Equal(t, map[string]string{"service.name": "checkout"}, recorder.points[0].tags)
+}
+
+func TestMetricFamily(t *testing.T) {
+	tests := []struct {
+		name     string
+		fields   map[string]interface{}
+		vType    common.InfluxMetricValueType
+		expected string
+	}{
+		{
+			name:     "gauge",
+			fields:   map[string]interface{}{"cpu_temp": 87.3},
+			vType:    common.InfluxMetricValueTypeGauge,
+			expected: "cpu_temp",
+		},
+		{
+			name:     "counter ending with a histogram suffix",
+			fields:   map[string]interface{}{"requests_count": 12},
+			vType:    common.InfluxMetricValueTypeSum,
+			expected: "requests_count",
+		},
+		{
+			name:     "histogram count and sum",
+			fields:   map[string]interface{}{"http_duration_count": 10.0, "http_duration_sum": 3.2},
+			vType:    common.InfluxMetricValueTypeHistogram,
+			expected: "http_duration",
+		},
+		{
+			name:     "histogram bucket",
+			fields:   map[string]interface{}{"http_duration_bucket": 4.0},
+			vType:    common.InfluxMetricValueTypeHistogram,
+			expected: "http_duration",
+		},
+		{
+			name:     "summary quantile",
+			fields:   map[string]interface{}{"rpc_duration": 0.5},
+			vType:    common.InfluxMetricValueTypeSummary,
+			expected: "rpc_duration",
+		},
+		{
+			name:   "different metrics",
+			fields: map[string]interface{}{"a": 1, "b": 2},
+			vType:  common.InfluxMetricValueTypeGauge,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, metricFamily(tt.fields, tt.vType))
+		})
+	}
+}
+
+func TestMetricsSchemaMeasurementPerMetric(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.MetricsSchema = "telegraf-prometheus-v2"
+	cfg.MetricPointSchema = MetricSchemaSettings{MeasurementPerMetric: true, Dimensions: []string{"service.name"}}
+
+	metrics := pmetric.NewMetrics()
+	resourceMetrics := metrics.ResourceMetrics().AppendEmpty()
+	resourceMetrics.Resource().Attributes().PutStr("service.name", "checkout")
+	resourceMetrics.Resource().Attributes().PutStr("host.name", "node-1")
+	ms := resourceMetrics.ScopeMetrics().AppendEmpty().Metrics()
+	gauge := ms.AppendEmpty()
+	gauge.SetName("cpu_temp")
+	dp := gauge.SetEmptyGauge().DataPoints().AppendEmpty()
+	dp.SetTimestamp(pcommon.NewTimestampFromTime(time.Unix(1, 0)))
+	dp.SetDoubleValue(87.3)
+	histogram := ms.AppendEmpty()
+	histogram.SetName("http_duration")
+	histogram.SetEmptyHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	hdp := histogram.Histogram().DataPoints().AppendEmpty()
+	hdp.SetTimestamp(pcommon.NewTimestampFromTime(time.Unix(1, 0)))
+	hdp.SetCount(3)
+	hdp.SetSum(1.5)
+	hdp.ExplicitBounds().FromRaw([]float64{1})
+	hdp.BucketCounts().FromRaw([]uint64{2, 1})
+
+	me, err := newMetricsExporter(cfg, componenttest.NewNopExporterCreateSettings())
+	require.NoError(t, err)
+	recorder := &recordingWriter{}
+	require.NoError(t, me.converter.WriteMetrics(context.Background(), metrics, &schemaWriter{next: recorder, defaultSchema: &me.schema}))
+
+	require.Len(t, recorder.points, 3)
+	assert.Equal(t, "cpu_temp", recorder.points[0].measurement)
+	assert.Equal(t, map[string]string{"service.name": "checkout"}, recorder.points[0].tags)
+	assert.Equal(t, map[string]interface{}{"cpu_temp": 87.3, "host.name": "node-1"}, recorder.points[0].fields)
+	assert.Equal(t, "http_duration", recorder.points[1].measurement)
+	assert.Equal(t, "http_duration", recorder.points[2].measurement)
+	assert.Contains(t, recorder.points[2].fields, "http_duration_bucket")
+}
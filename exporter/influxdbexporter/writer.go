@@ -40,24 +40,17 @@ type influxHTTPWriter struct {
 }
 
 func newInfluxHTTPWriter(logger common.Logger, config *Config, host component.Host, settings component.TelemetrySettings) (*influxHTTPWriter, error) {
-	writeURL, err := url.Parse(config.HTTPClientSettings.Endpoint)
+	writeURL, err := composeWriteURL(config)
 	if err != nil {
 		return nil, err
 	}
-	if writeURL.Path == "" || writeURL.Path == "/" {
-		writeURL, err = writeURL.Parse("api/v2/write")
-		if err != nil {
-			return nil, err
-		}
-	}
-	queryValues := writeURL.Query()
-	queryValues.Set("org", config.Org)
-	queryValues.Set("bucket", config.Bucket)
-	queryValues.Set("precision", "ns")
-	writeURL.RawQuery = queryValues.Encode()
 
 	if config.Token != "" {
-		config.HTTPClientSettings.Headers["Authorization"] = "Token " + config.Token
+		if config.APIVersion == apiVersionV3 {
+			config.HTTPClientSettings.Headers["Authorization"] = "Bearer " + config.Token
+		} else {
+			config.HTTPClientSettings.Headers["Authorization"] = "Token " + config.Token
+		}
 	}
 
 	httpClient, err := config.HTTPClientSettings.ToClient(host, settings)
@@ -80,6 +73,41 @@ func newInfluxHTTPWriter(logger common.Logger, config *Config, host component.Ho
 	}, nil
 }
 
+// composeWriteURL returns the URL of the write API selected by the configured API version.
+func composeWriteURL(config *Config) (*url.URL, error) {
+	writeURL, err := url.Parse(config.HTTPClientSettings.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	writePath := "api/v2/write"
+	if config.APIVersion == apiVersionV3 {
+		writePath = "api/v3/write_lp"
+	}
+	if writeURL.Path == "" || writeURL.Path == "/" {
+		writeURL, err = writeURL.Parse(writePath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	queryValues := writeURL.Query()
+	if config.APIVersion == apiVersionV3 {
+		database := config.Database
+		if database == "" {
+			database = config.Bucket
+		}
+		queryValues.Set("db", database)
+		queryValues.Set("precision", "nanosecond")
+	} else {
+		queryValues.Set("org", config.Org)
+		queryValues.Set("bucket", config.Bucket)
+		queryValues.Set("precision", "ns")
+	}
+	writeURL.RawQuery = queryValues.Encode()
+	return writeURL, nil
+}
+
 func (w *influxHTTPWriter) newBatch() *influxHTTPWriterBatch {
 	return &influxHTTPWriterBatch{
 		w:       w,
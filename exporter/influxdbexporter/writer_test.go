@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package influxdbexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComposeWriteURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(cfg *Config)
+		expected string
+	}{
+		{
+			name: "v2",
+			modify: func(cfg *Config) {
+				cfg.Endpoint = "http://localhost:8086"
+				cfg.Org = "my-org"
+				cfg.Bucket = "my-bucket"
+			},
+			expected: "http://localhost:8086/api/v2/write?bucket=my-bucket&org=my-org&precision=ns",
+		},
+		{
+			name: "v3",
+			modify: func(cfg *Config) {
+				cfg.Endpoint = "http://localhost:8181/"
+				cfg.APIVersion = apiVersionV3
+				cfg.Database = "my-db"
+				cfg.Bucket = "my-bucket"
+			},
+			expected: "http://localhost:8181/api/v3/write_lp?db=my-db&precision=nanosecond",
+		},
+		{
+			name: "v3 database defaults to bucket",
+			modify: func(cfg *Config) {
+				cfg.Endpoint = "http://localhost:8181"
+				cfg.APIVersion = apiVersionV3
+				cfg.Bucket = "my-bucket"
+			},
+			expected: "http://localhost:8181/api/v3/write_lp?db=my-bucket&precision=nanosecond",
+		},
+		{
+			name: "custom path",
+			modify: func(cfg *Config) {
+				cfg.Endpoint = "http://localhost:8181/influx/write"
+				cfg.APIVersion = apiVersionV3
+				cfg.Database = "my-db"
+			},
+			expected: "http://localhost:8181/influx/write?db=my-db&precision=nanosecond",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tt.modify(cfg)
+			writeURL, err := composeWriteURL(cfg)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, writeURL.String())
+		})
+	}
+}
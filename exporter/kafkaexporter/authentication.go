@@ -45,10 +45,13 @@ type SASLConfig struct {
 	Username string `mapstructure:"username"`
 	// Password to be used on authentication
 	Password string `mapstructure:"password"`
-	// SASL Mechanism to be used, possible values are: (PLAIN, AWS_MSK_IAM, SCRAM-SHA-256 or SCRAM-SHA-512).
+	// SASL Mechanism to be used, possible values are: (PLAIN, AWS_MSK_IAM, SCRAM-SHA-256, SCRAM-SHA-512 or OAUTHBEARER).
 	Mechanism string `mapstructure:"mechanism"`
 
 	AWSMSK AWSMSKConfig `mapstructure:"aws_msk"`
+
+	// OAuthBearer configures the token provider of the OAUTHBEARER mechanism.
+	OAuthBearer OAuthBearerConfig `mapstructure:"oauthbearer"`
 }
 
 // AWSMSKConfig defines the additional SASL authentication
@@ -100,6 +103,16 @@ func configurePlaintext(config PlainTextConfig, saramaConfig *sarama.Config) {
 }
 
 func configureSASL(config SASLConfig, saramaConfig *sarama.Config) error {
+	if config.Mechanism == sarama.SASLTypeOAuth {
+		tokenProvider, err := newTokenProvider(config, saramaConfig.ClientID)
+		if err != nil {
+			return err
+		}
+		saramaConfig.Net.SASL.Enable = true
+		saramaConfig.Net.SASL.Mechanism = sarama.SASLTypeOAuth
+		saramaConfig.Net.SASL.TokenProvider = tokenProvider
+		return nil
+	}
 
 	if config.Username == "" {
 		return fmt.Errorf("username have to be provided")
@@ -128,7 +141,7 @@ func configureSASL(config SASLConfig, saramaConfig *sarama.Config) error {
 		}
 		saramaConfig.Net.SASL.Mechanism = awsmsk.Mechanism
 	default:
-		return fmt.Errorf(`invalid SASL Mechanism %q: can be either "PLAIN", "AWS_MSK_IAM", "SCRAM-SHA-256" or "SCRAM-SHA-512"`, config.Mechanism)
+		return fmt.Errorf(`invalid SASL Mechanism %q: can be either "PLAIN", "AWS_MSK_IAM", "SCRAM-SHA-256", "SCRAM-SHA-512" or "OAUTHBEARER"`, config.Mechanism)
 	}
 
 	return nil
@@ -48,6 +48,10 @@ func TestAuthentication(t *testing.T) {
 
 	saramaSASLPLAINConfig.Net.SASL.Mechanism = sarama.SASLTypePlaintext
 
+	saramaSASLOAuthBearerConfig := &sarama.Config{}
+	saramaSASLOAuthBearerConfig.Net.SASL.Enable = true
+	saramaSASLOAuthBearerConfig.Net.SASL.Mechanism = sarama.SASLTypeOAuth
+
 	saramaTLSCfg := &sarama.Config{}
 	saramaTLSCfg.Net.TLS.Enable = true
 	tlsClient := configtls.TLSClientSetting{}
@@ -123,6 +127,46 @@ func TestAuthentication(t *testing.T) {
 			saramaConfig: saramaSASLSCRAM512Config,
 			err:          "password have to be provided",
 		},
+		{
+			auth: Authentication{SASL: &SASLConfig{Mechanism: "OAUTHBEARER", OAuthBearer: OAuthBearerConfig{
+				ClientCredentials: ClientCredentialsConfig{TokenURL: "https://login.example.com/token", ClientID: "id", ClientSecret: "secret"},
+			}}},
+			saramaConfig: saramaSASLOAuthBearerConfig,
+		},
+		{
+			auth: Authentication{SASL: &SASLConfig{Mechanism: "OAUTHBEARER", AWSMSK: AWSMSKConfig{Region: "us-east-1"}, OAuthBearer: OAuthBearerConfig{
+				Provider: "aws_msk_iam",
+			}}},
+			saramaConfig: saramaSASLOAuthBearerConfig,
+		},
+		{
+			auth: Authentication{SASL: &SASLConfig{Mechanism: "OAUTHBEARER", OAuthBearer: OAuthBearerConfig{
+				ClientCredentials: ClientCredentialsConfig{ClientID: "id"},
+			}}},
+			saramaConfig: saramaSASLOAuthBearerConfig,
+			err:          "token_url have to be provided",
+		},
+		{
+			auth: Authentication{SASL: &SASLConfig{Mechanism: "OAUTHBEARER", OAuthBearer: OAuthBearerConfig{
+				ClientCredentials: ClientCredentialsConfig{TokenURL: "https://login.example.com/token"},
+			}}},
+			saramaConfig: saramaSASLOAuthBearerConfig,
+			err:          "client_id have to be provided",
+		},
+		{
+			auth: Authentication{SASL: &SASLConfig{Mechanism: "OAUTHBEARER", OAuthBearer: OAuthBearerConfig{
+				Provider: "aws_msk_iam",
+			}}},
+			saramaConfig: saramaSASLOAuthBearerConfig,
+			err:          "aws_msk region have to be provided",
+		},
+		{
+			auth: Authentication{SASL: &SASLConfig{Mechanism: "OAUTHBEARER", OAuthBearer: OAuthBearerConfig{
+				Provider: "static",
+			}}},
+			saramaConfig: saramaSASLOAuthBearerConfig,
+			err:          "invalid OAUTHBEARER provider",
+		},
 	}
 	for _, test := range tests {
 		t.Run("", func(t *testing.T) {
@@ -134,6 +178,11 @@ func TestAuthentication(t *testing.T) {
 			} else {
 				// equalizes SCRAMClientGeneratorFunc to do assertion with the same reference.
 				config.Net.SASL.SCRAMClientGeneratorFunc = test.saramaConfig.Net.SASL.SCRAMClientGeneratorFunc
+				// the token provider is checked separately, as it holds the parsed configuration.
+				if test.saramaConfig.Net.SASL.Mechanism == sarama.SASLTypeOAuth {
+					assert.NotNil(t, config.Net.SASL.TokenProvider)
+					config.Net.SASL.TokenProvider = nil
+				}
 				assert.Equal(t, test.saramaConfig, config)
 			}
 		})
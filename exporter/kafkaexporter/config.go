@@ -35,12 +35,22 @@ type Config struct {
 	Brokers []string `mapstructure:"brokers"`
 	// Kafka protocol version
 	ProtocolVersion string `mapstructure:"protocol_version"`
-	// The name of the kafka topic to export to (default otlp_spans for traces, otlp_metrics for metrics)
+	// The name of the kafka topic to export to (default otlp_spans for traces, otlp_metrics for metrics).
+	// It may reference resource attributes as `{<attribute name>}`, e.g. `otlp_logs_{k8s.namespace.name}`.
 	Topic string `mapstructure:"topic"`
 
+	// FallbackTopic is the topic of data whose resource does not have all the attributes
+	// referenced by Topic (default is the same as the default topic).
+	FallbackTopic string `mapstructure:"fallback_topic"`
+
 	// Encoding of messages (default "otlp_proto")
 	Encoding string `mapstructure:"encoding"`
 
+	// PartitionKey splits the data and keys the messages so that related data lands in the
+	// same partition. Either "trace_id" (traces and logs), or "resource.<attribute name>"
+	// to key by the value of a resource attribute. By default, the messages are not split.
+	PartitionKey string `mapstructure:"partition_key"`
+
 	// Metadata is the namespace for metadata management properties used by the
 	// Client, and shared by the Producer/Consumer.
 	Metadata Metadata `mapstructure:"metadata"`
@@ -113,6 +123,14 @@ func (cfg *Config) Validate() error {
 		return err
 	}
 
+	if _, err = parsePartitionKey(cfg.PartitionKey); err != nil {
+		return err
+	}
+
+	if _, err = parseTopicTemplate(cfg.Topic, cfg.FallbackTopic); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -155,3 +155,27 @@ func Test_saramaProducerCompressionCodec(t *testing.T) {
 		})
 	}
 }
+
+func TestValidate_err_partition_key(t *testing.T) {
+	config := &Config{
+		Producer: Producer{
+			Compression: "none",
+		},
+		PartitionKey: "service.name",
+	}
+
+	err := config.Validate()
+	assert.EqualError(t, err, `partition_key "service.name" must be either "trace_id" or "resource.<attribute name>"`)
+}
+
+func TestValidate_err_topic(t *testing.T) {
+	config := &Config{
+		Producer: Producer{
+			Compression: "none",
+		},
+		Topic: "otlp_logs_{k8s.namespace.name",
+	}
+
+	err := config.Validate()
+	assert.EqualError(t, err, `topic "otlp_logs_{k8s.namespace.name" has an unterminated attribute reference`)
+}
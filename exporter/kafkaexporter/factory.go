@@ -122,6 +122,9 @@ func (f *kafkaExporterFactory) createTracesExporter(
 	if oCfg.Topic == "" {
 		oCfg.Topic = defaultTracesTopic
 	}
+	if oCfg.FallbackTopic == "" {
+		oCfg.FallbackTopic = defaultTracesTopic
+	}
 	if oCfg.Encoding == "otlp_json" {
 		set.Logger.Info("otlp_json is considered experimental and should not be used in a production environment")
 	}
@@ -152,6 +155,9 @@ func (f *kafkaExporterFactory) createMetricsExporter(
 	if oCfg.Topic == "" {
 		oCfg.Topic = defaultMetricsTopic
 	}
+	if oCfg.FallbackTopic == "" {
+		oCfg.FallbackTopic = defaultMetricsTopic
+	}
 	if oCfg.Encoding == "otlp_json" {
 		set.Logger.Info("otlp_json is considered experimental and should not be used in a production environment")
 	}
@@ -182,6 +188,9 @@ func (f *kafkaExporterFactory) createLogsExporter(
 	if oCfg.Topic == "" {
 		oCfg.Topic = defaultLogsTopic
 	}
+	if oCfg.FallbackTopic == "" {
+		oCfg.FallbackTopic = defaultLogsTopic
+	}
 	if oCfg.Encoding == "otlp_json" {
 		set.Logger.Info("otlp_json is considered experimental and should not be used in a production environment")
 	}
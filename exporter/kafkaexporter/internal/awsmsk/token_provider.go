@@ -0,0 +1,81 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awsmsk // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/kafkaexporter/internal/awsmsk"
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/defaults"
+	sign "github.com/aws/aws-sdk-go/aws/signer/v4"
+)
+
+// tokenExpiry is how long a token signed by the TokenProvider remains valid.
+const tokenExpiry = 15 * time.Minute
+
+var _ sarama.AccessTokenProvider = (*TokenProvider)(nil)
+
+// TokenProvider signs the OAUTHBEARER tokens accepted by MSK clusters with IAM
+// access control. A new token is signed for every authentication, using the
+// default AWS credential chain.
+type TokenProvider struct {
+	Region     string
+	UserAgent  string
+	Extensions map[string]string
+
+	credentials *credentials.Credentials
+	now         func() time.Time
+}
+
+// NewTokenProvider creates a TokenProvider for the MSK cluster in the given region.
+func NewTokenProvider(region, userAgent string, extensions map[string]string) *TokenProvider {
+	return &TokenProvider{
+		Region:      region,
+		UserAgent:   userAgent,
+		Extensions:  extensions,
+		credentials: defaults.CredChain(defaults.Config(), defaults.Handlers()),
+		now:         time.Now,
+	}
+}
+
+// Token returns a base64 encoded presigned kafka-cluster:Connect request.
+func (tp *TokenProvider) Token() (*sarama.AccessToken, error) {
+	if tp.Region == "" {
+		return nil, errors.New("missing MSK cluster region")
+	}
+
+	endpoint := fmt.Sprintf("https://kafka.%s.amazonaws.com/?Action=%s", tp.Region, "kafka-cluster%3AConnect")
+	req, err := http.NewRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = sign.NewSigner(tp.credentials).Presign(req, nil, service, tp.Region, tokenExpiry, tp.now().UTC()); err != nil {
+		return nil, fmt.Errorf("failed to sign MSK token: %w", err)
+	}
+
+	query := req.URL.Query()
+	query.Set("User-Agent", tp.UserAgent)
+	req.URL.RawQuery = query.Encode()
+
+	return &sarama.AccessToken{
+		Token:      base64.RawURLEncoding.EncodeToString([]byte(req.URL.String())),
+		Extensions: tp.Extensions,
+	}, nil
+}
@@ -0,0 +1,56 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awsmsk
+
+import (
+	"encoding/base64"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenProvider(t *testing.T) {
+	tp := NewTokenProvider("us-west-2", "otelcol", map[string]string{"key": "value"})
+	tp.credentials = credentials.NewStaticCredentials("AKID", "SECRET", "")
+	tp.now = func() time.Time { return time.Date(2022, 11, 10, 12, 0, 0, 0, time.UTC) }
+
+	token, err := tp.Token()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"key": "value"}, token.Extensions)
+
+	decoded, err := base64.RawURLEncoding.DecodeString(token.Token)
+	require.NoError(t, err)
+	signed, err := url.Parse(string(decoded))
+	require.NoError(t, err)
+
+	assert.Equal(t, "kafka.us-west-2.amazonaws.com", signed.Host)
+	query := signed.Query()
+	assert.Equal(t, "kafka-cluster:Connect", query.Get("Action"))
+	assert.Equal(t, "otelcol", query.Get("User-Agent"))
+	assert.Equal(t, "AWS4-HMAC-SHA256", query.Get("X-Amz-Algorithm"))
+	assert.Equal(t, "AKID/20221110/us-west-2/kafka-cluster/aws4_request", query.Get("X-Amz-Credential"))
+	assert.Equal(t, "20221110T120000Z", query.Get("X-Amz-Date"))
+	assert.Equal(t, "900", query.Get("X-Amz-Expires"))
+	assert.NotEmpty(t, query.Get("X-Amz-Signature"))
+}
+
+func TestTokenProviderMissingRegion(t *testing.T) {
+	_, err := NewTokenProvider("", "otelcol", nil).Token()
+	assert.EqualError(t, err, "missing MSK cluster region")
+}
@@ -32,10 +32,11 @@ var errUnrecognizedEncoding = fmt.Errorf("unrecognized encoding")
 
 // kafkaTracesProducer uses sarama to produce trace messages to Kafka.
 type kafkaTracesProducer struct {
-	producer  sarama.SyncProducer
-	topic     string
-	marshaler TracesMarshaler
-	logger    *zap.Logger
+	producer     sarama.SyncProducer
+	topic        topicTemplate
+	marshaler    TracesMarshaler
+	partitionKey partitionKey
+	logger       *zap.Logger
 }
 
 type kafkaErrors struct {
@@ -48,7 +49,7 @@ func (ke kafkaErrors) Error() string {
 }
 
 func (e *kafkaTracesProducer) tracesPusher(_ context.Context, td ptrace.Traces) error {
-	messages, err := e.marshaler.Marshal(td, e.topic)
+	messages, err := e.marshal(td)
 	if err != nil {
 		return consumererror.NewPermanent(err)
 	}
@@ -65,20 +66,51 @@ func (e *kafkaTracesProducer) tracesPusher(_ context.Context, td ptrace.Traces)
 	return nil
 }
 
+// marshal marshals the data in one batch of messages per topic.
+func (e *kafkaTracesProducer) marshal(td ptrace.Traces) ([]*sarama.ProducerMessage, error) {
+	var messages []*sarama.ProducerMessage
+	for _, part := range e.topic.splitTraces(td) {
+		partMessages, err := e.marshalTopic(part.traces, part.topic)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, partMessages...)
+	}
+	return messages, nil
+}
+
+// marshalTopic marshals the data of a topic in one batch of messages per partition key, if any.
+func (e *kafkaTracesProducer) marshalTopic(td ptrace.Traces, topic string) ([]*sarama.ProducerMessage, error) {
+	if !e.partitionKey.enabled() {
+		return e.marshaler.Marshal(td, topic)
+	}
+	var messages []*sarama.ProducerMessage
+	for _, part := range e.partitionKey.splitTraces(td) {
+		partMessages, err := e.marshaler.Marshal(part.traces, topic)
+		if err != nil {
+			return nil, err
+		}
+		setKey(partMessages, part.key)
+		messages = append(messages, partMessages...)
+	}
+	return messages, nil
+}
+
 func (e *kafkaTracesProducer) Close(context.Context) error {
 	return e.producer.Close()
 }
 
 // kafkaMetricsProducer uses sarama to produce metrics messages to kafka
 type kafkaMetricsProducer struct {
-	producer  sarama.SyncProducer
-	topic     string
-	marshaler MetricsMarshaler
-	logger    *zap.Logger
+	producer     sarama.SyncProducer
+	topic        topicTemplate
+	marshaler    MetricsMarshaler
+	partitionKey partitionKey
+	logger       *zap.Logger
 }
 
 func (e *kafkaMetricsProducer) metricsDataPusher(_ context.Context, md pmetric.Metrics) error {
-	messages, err := e.marshaler.Marshal(md, e.topic)
+	messages, err := e.marshal(md)
 	if err != nil {
 		return consumererror.NewPermanent(err)
 	}
@@ -95,20 +127,51 @@ func (e *kafkaMetricsProducer) metricsDataPusher(_ context.Context, md pmetric.M
 	return nil
 }
 
+// marshal marshals the data in one batch of messages per topic.
+func (e *kafkaMetricsProducer) marshal(md pmetric.Metrics) ([]*sarama.ProducerMessage, error) {
+	var messages []*sarama.ProducerMessage
+	for _, part := range e.topic.splitMetrics(md) {
+		partMessages, err := e.marshalTopic(part.metrics, part.topic)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, partMessages...)
+	}
+	return messages, nil
+}
+
+// marshalTopic marshals the data of a topic in one batch of messages per partition key, if any.
+func (e *kafkaMetricsProducer) marshalTopic(md pmetric.Metrics, topic string) ([]*sarama.ProducerMessage, error) {
+	if !e.partitionKey.enabled() {
+		return e.marshaler.Marshal(md, topic)
+	}
+	var messages []*sarama.ProducerMessage
+	for _, part := range e.partitionKey.splitMetrics(md) {
+		partMessages, err := e.marshaler.Marshal(part.metrics, topic)
+		if err != nil {
+			return nil, err
+		}
+		setKey(partMessages, part.key)
+		messages = append(messages, partMessages...)
+	}
+	return messages, nil
+}
+
 func (e *kafkaMetricsProducer) Close(context.Context) error {
 	return e.producer.Close()
 }
 
 // kafkaLogsProducer uses sarama to produce logs messages to kafka
 type kafkaLogsProducer struct {
-	producer  sarama.SyncProducer
-	topic     string
-	marshaler LogsMarshaler
-	logger    *zap.Logger
+	producer     sarama.SyncProducer
+	topic        topicTemplate
+	marshaler    LogsMarshaler
+	partitionKey partitionKey
+	logger       *zap.Logger
 }
 
 func (e *kafkaLogsProducer) logsDataPusher(_ context.Context, ld plog.Logs) error {
-	messages, err := e.marshaler.Marshal(ld, e.topic)
+	messages, err := e.marshal(ld)
 	if err != nil {
 		return consumererror.NewPermanent(err)
 	}
@@ -125,6 +188,36 @@ func (e *kafkaLogsProducer) logsDataPusher(_ context.Context, ld plog.Logs) erro
 	return nil
 }
 
+// marshal marshals the data in one batch of messages per topic.
+func (e *kafkaLogsProducer) marshal(ld plog.Logs) ([]*sarama.ProducerMessage, error) {
+	var messages []*sarama.ProducerMessage
+	for _, part := range e.topic.splitLogs(ld) {
+		partMessages, err := e.marshalTopic(part.logs, part.topic)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, partMessages...)
+	}
+	return messages, nil
+}
+
+// marshalTopic marshals the data of a topic in one batch of messages per partition key, if any.
+func (e *kafkaLogsProducer) marshalTopic(ld plog.Logs, topic string) ([]*sarama.ProducerMessage, error) {
+	if !e.partitionKey.enabled() {
+		return e.marshaler.Marshal(ld, topic)
+	}
+	var messages []*sarama.ProducerMessage
+	for _, part := range e.partitionKey.splitLogs(ld) {
+		partMessages, err := e.marshaler.Marshal(part.logs, topic)
+		if err != nil {
+			return nil, err
+		}
+		setKey(partMessages, part.key)
+		messages = append(messages, partMessages...)
+	}
+	return messages, nil
+}
+
 func (e *kafkaLogsProducer) Close(context.Context) error {
 	return e.producer.Close()
 }
@@ -173,16 +266,28 @@ func newMetricsExporter(config Config, set component.ExporterCreateSettings, mar
 	if marshaler == nil {
 		return nil, errUnrecognizedEncoding
 	}
+	key, err := parsePartitionKey(config.PartitionKey)
+	if err != nil {
+		return nil, err
+	}
+	if key.traceID {
+		return nil, errPartitionKeyTraceIDMetrics
+	}
+	topic, err := parseTopicTemplate(config.Topic, config.FallbackTopic)
+	if err != nil {
+		return nil, err
+	}
 	producer, err := newSaramaProducer(config)
 	if err != nil {
 		return nil, err
 	}
 
 	return &kafkaMetricsProducer{
-		producer:  producer,
-		topic:     config.Topic,
-		marshaler: marshaler,
-		logger:    set.Logger,
+		producer:     producer,
+		topic:        topic,
+		marshaler:    marshaler,
+		partitionKey: key,
+		logger:       set.Logger,
 	}, nil
 
 }
@@ -193,15 +298,24 @@ func newTracesExporter(config Config, set component.ExporterCreateSettings, mars
 	if marshaler == nil {
 		return nil, errUnrecognizedEncoding
 	}
+	key, err := parsePartitionKey(config.PartitionKey)
+	if err != nil {
+		return nil, err
+	}
+	topic, err := parseTopicTemplate(config.Topic, config.FallbackTopic)
+	if err != nil {
+		return nil, err
+	}
 	producer, err := newSaramaProducer(config)
 	if err != nil {
 		return nil, err
 	}
 	return &kafkaTracesProducer{
-		producer:  producer,
-		topic:     config.Topic,
-		marshaler: marshaler,
-		logger:    set.Logger,
+		producer:     producer,
+		topic:        topic,
+		marshaler:    marshaler,
+		partitionKey: key,
+		logger:       set.Logger,
 	}, nil
 }
 
@@ -210,16 +324,25 @@ func newLogsExporter(config Config, set component.ExporterCreateSettings, marsha
 	if marshaler == nil {
 		return nil, errUnrecognizedEncoding
 	}
+	key, err := parsePartitionKey(config.PartitionKey)
+	if err != nil {
+		return nil, err
+	}
+	topic, err := parseTopicTemplate(config.Topic, config.FallbackTopic)
+	if err != nil {
+		return nil, err
+	}
 	producer, err := newSaramaProducer(config)
 	if err != nil {
 		return nil, err
 	}
 
 	return &kafkaLogsProducer{
-		producer:  producer,
-		topic:     config.Topic,
-		marshaler: marshaler,
-		logger:    set.Logger,
+		producer:     producer,
+		topic:        topic,
+		marshaler:    marshaler,
+		partitionKey: key,
+		logger:       set.Logger,
 	}, nil
 
 }
@@ -61,6 +61,13 @@ func TestNewMetricsExporter_err_encoding(t *testing.T) {
 	assert.Nil(t, mexp)
 }
 
+func TestNewMetricsExporter_err_partition_key(t *testing.T) {
+	c := Config{Encoding: defaultEncoding, PartitionKey: "trace_id"}
+	mexp, err := newMetricsExporter(c, componenttest.NewNopExporterCreateSettings(), metricsMarshalers())
+	assert.EqualError(t, err, `partition_key "trace_id" is not supported for metrics`)
+	assert.Nil(t, mexp)
+}
+
 func TestNewMetricsExporter_err_traces_encoding(t *testing.T) {
 	c := Config{Encoding: "jaeger_proto"}
 	mexp, err := newMetricsExporter(c, componenttest.NewNopExporterCreateSettings(), metricsMarshalers())
@@ -151,6 +158,37 @@ func TestTracesPusher(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestTracesPusher_partition_key(t *testing.T) {
+	c := sarama.NewConfig()
+	producer := mocks.NewSyncProducer(t, c)
+	keys := map[string]bool{}
+	checkKey := func(msg *sarama.ProducerMessage) error {
+		key, err := msg.Key.Encode()
+		keys[string(key)] = true
+		return err
+	}
+	producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(checkKey)
+	producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(checkKey)
+
+	p := kafkaTracesProducer{
+		producer:     producer,
+		marshaler:    newPdataTracesMarshaler(&ptrace.ProtoMarshaler{}, defaultEncoding),
+		partitionKey: partitionKey{traceID: true},
+	}
+	t.Cleanup(func() {
+		require.NoError(t, p.Close(context.Background()))
+	})
+	td := testdata.GenerateTracesTwoSpansSameResource()
+	td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).SetTraceID([16]byte{1})
+	td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(1).SetTraceID([16]byte{2})
+	err := p.tracesPusher(context.Background(), td)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{
+		"01000000000000000000000000000000": true,
+		"02000000000000000000000000000000": true,
+	}, keys)
+}
+
 func TestTracesPusher_err(t *testing.T) {
 	c := sarama.NewConfig()
 	producer := mocks.NewSyncProducer(t, c)
@@ -245,6 +283,38 @@ func TestLogsDataPusher(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestLogsDataPusher_topic_template(t *testing.T) {
+	c := sarama.NewConfig()
+	producer := mocks.NewSyncProducer(t, c)
+	topics := map[string]bool{}
+	checkTopic := func(msg *sarama.ProducerMessage) error {
+		topics[msg.Topic] = true
+		return nil
+	}
+	producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(checkTopic)
+	producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(checkTopic)
+
+	topic, err := parseTopicTemplate("otlp_logs_{k8s.namespace.name}", defaultLogsTopic)
+	require.NoError(t, err)
+	p := kafkaLogsProducer{
+		producer:  producer,
+		topic:     topic,
+		marshaler: newPdataLogsMarshaler(&plog.ProtoMarshaler{}, defaultEncoding),
+	}
+	t.Cleanup(func() {
+		require.NoError(t, p.Close(context.Background()))
+	})
+	ld := testdata.GenerateLogsTwoLogRecordsSameResource()
+	ld.ResourceLogs().At(0).CopyTo(ld.ResourceLogs().AppendEmpty())
+	ld.ResourceLogs().At(0).Resource().Attributes().PutStr("k8s.namespace.name", "shop")
+	err = p.logsDataPusher(context.Background(), ld)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{
+		"otlp_logs_shop": true,
+		"otlp_logs":      true,
+	}, topics)
+}
+
 func TestLogsDataPusher_err(t *testing.T) {
 	c := sarama.NewConfig()
 	producer := mocks.NewSyncProducer(t, c)
@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkaexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/kafkaexporter"
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/Shopify/sarama"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/kafkaexporter/internal/awsmsk"
+)
+
+const (
+	// OAuthBearerClientCredentials fetches OAUTHBEARER tokens with the OAuth2 client credentials flow.
+	OAuthBearerClientCredentials = "client_credentials"
+	// OAuthBearerAWSMSKIAM signs OAUTHBEARER tokens for MSK clusters with IAM access control.
+	OAuthBearerAWSMSKIAM = "aws_msk_iam"
+)
+
+// OAuthBearerConfig defines the token provider used by the OAUTHBEARER mechanism.
+type OAuthBearerConfig struct {
+	// Provider of the tokens, either "client_credentials" (default) or "aws_msk_iam".
+	// The "aws_msk_iam" provider uses the region configured in `aws_msk`.
+	Provider string `mapstructure:"provider"`
+	// ClientCredentials configures the "client_credentials" provider.
+	ClientCredentials ClientCredentialsConfig `mapstructure:"client_credentials"`
+	// Extensions are SASL extensions sent along with the token.
+	Extensions map[string]string `mapstructure:"extensions"`
+}
+
+// ClientCredentialsConfig defines the OAuth2 client credentials flow, as used by
+// Azure Event Hubs and other OAuth2 enabled brokers.
+type ClientCredentialsConfig struct {
+	TokenURL       string              `mapstructure:"token_url"`
+	ClientID       string              `mapstructure:"client_id"`
+	ClientSecret   string              `mapstructure:"client_secret"`
+	Scopes         []string            `mapstructure:"scopes"`
+	EndpointParams map[string][]string `mapstructure:"endpoint_params"`
+}
+
+var _ sarama.AccessTokenProvider = (*oauth2TokenProvider)(nil)
+
+// oauth2TokenProvider adapts an oauth2.TokenSource, which caches the token and
+// refreshes it once it expires, to sarama.
+type oauth2TokenProvider struct {
+	source     oauth2.TokenSource
+	extensions map[string]string
+}
+
+func (tp *oauth2TokenProvider) Token() (*sarama.AccessToken, error) {
+	token, err := tp.source.Token()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch OAUTHBEARER token: %w", err)
+	}
+	return &sarama.AccessToken{Token: token.AccessToken, Extensions: tp.extensions}, nil
+}
+
+func newTokenProvider(config SASLConfig, clientID string) (sarama.AccessTokenProvider, error) {
+	oauth := config.OAuthBearer
+	switch oauth.Provider {
+	case "", OAuthBearerClientCredentials:
+		cc := oauth.ClientCredentials
+		if cc.TokenURL == "" {
+			return nil, fmt.Errorf("token_url have to be provided")
+		}
+		if cc.ClientID == "" {
+			return nil, fmt.Errorf("client_id have to be provided")
+		}
+		ccConfig := &clientcredentials.Config{
+			ClientID:       cc.ClientID,
+			ClientSecret:   cc.ClientSecret,
+			TokenURL:       cc.TokenURL,
+			Scopes:         cc.Scopes,
+			EndpointParams: url.Values(cc.EndpointParams),
+		}
+		return &oauth2TokenProvider{source: ccConfig.TokenSource(context.Background()), extensions: oauth.Extensions}, nil
+	case OAuthBearerAWSMSKIAM:
+		if config.AWSMSK.Region == "" {
+			return nil, fmt.Errorf("aws_msk region have to be provided")
+		}
+		return awsmsk.NewTokenProvider(config.AWSMSK.Region, clientID, oauth.Extensions), nil
+	default:
+		return nil, fmt.Errorf(`invalid OAUTHBEARER provider %q: can be either %q or %q`,
+			oauth.Provider, OAuthBearerClientCredentials, OAuthBearerAWSMSKIAM)
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkaexporter
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientCredentialsTokenProvider(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "https://example.servicebus.windows.net/.default", r.PostForm.Get("scope"))
+		assert.Equal(t, "tenant", r.PostForm.Get("resource"))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"Bearer","expires_in":3600}`, requests)
+	}))
+	defer server.Close()
+
+	tp, err := newTokenProvider(SASLConfig{
+		Mechanism: "OAUTHBEARER",
+		OAuthBearer: OAuthBearerConfig{
+			ClientCredentials: ClientCredentialsConfig{
+				TokenURL:       server.URL,
+				ClientID:       "id",
+				ClientSecret:   "secret",
+				Scopes:         []string{"https://example.servicebus.windows.net/.default"},
+				EndpointParams: map[string][]string{"resource": {"tenant"}},
+			},
+			Extensions: map[string]string{"logicalCluster": "lkc-1"},
+		},
+	}, "sarama")
+	require.NoError(t, err)
+
+	token, err := tp.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", token.Token)
+	assert.Equal(t, map[string]string{"logicalCluster": "lkc-1"}, token.Extensions)
+
+	// the token is reused until it expires.
+	token, err = tp.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", token.Token)
+	assert.Equal(t, 1, requests)
+}
+
+func TestClientCredentialsTokenProviderError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	tp, err := newTokenProvider(SASLConfig{
+		Mechanism: "OAUTHBEARER",
+		OAuthBearer: OAuthBearerConfig{
+			ClientCredentials: ClientCredentialsConfig{TokenURL: server.URL, ClientID: "id"},
+		},
+	}, "sarama")
+	require.NoError(t, err)
+
+	_, err = tp.Token()
+	assert.ErrorContains(t, err, "failed to fetch OAUTHBEARER token")
+}
@@ -0,0 +1,252 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkaexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/kafkaexporter"
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Shopify/sarama"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+const (
+	partitionKeyTraceID        = "trace_id"
+	partitionKeyResourcePrefix = "resource."
+)
+
+var errPartitionKeyTraceIDMetrics = errors.New(`partition_key "trace_id" is not supported for metrics`)
+
+// partitionKey is the parsed form of the partition_key setting. The zero value
+// leaves the data unsplit and the messages keyed by the marshaler.
+type partitionKey struct {
+	traceID   bool
+	attribute string
+}
+
+func parsePartitionKey(key string) (partitionKey, error) {
+	switch {
+	case key == "":
+		return partitionKey{}, nil
+	case key == partitionKeyTraceID:
+		return partitionKey{traceID: true}, nil
+	case strings.HasPrefix(key, partitionKeyResourcePrefix) && len(key) > len(partitionKeyResourcePrefix):
+		return partitionKey{attribute: strings.TrimPrefix(key, partitionKeyResourcePrefix)}, nil
+	default:
+		return partitionKey{}, fmt.Errorf(`partition_key %q must be either "trace_id" or "resource.<attribute name>"`, key)
+	}
+}
+
+func (p partitionKey) enabled() bool {
+	return p.traceID || p.attribute != ""
+}
+
+// setKey sets the key of all messages, overriding any key set by the marshaler so
+// that every message of a partition lands on the same Kafka partition.
+func setKey(messages []*sarama.ProducerMessage, key []byte) {
+	for _, message := range messages {
+		if key == nil {
+			message.Key = nil
+		} else {
+			message.Key = sarama.ByteEncoder(key)
+		}
+	}
+}
+
+func traceIDKey(traceID pcommon.TraceID) []byte {
+	if traceID.IsEmpty() {
+		return nil
+	}
+	return []byte(hex.EncodeToString(traceID[:]))
+}
+
+// resourceKey returns the value of the attribute, or nil if the resource does not have it.
+func resourceKey(resource pcommon.Resource, attribute string) []byte {
+	value, ok := resource.Attributes().Get(attribute)
+	if !ok {
+		return nil
+	}
+	return []byte(value.AsString())
+}
+
+type keyedTraces struct {
+	key    []byte
+	traces ptrace.Traces
+}
+
+func (p partitionKey) splitTraces(td ptrace.Traces) []keyedTraces {
+	if p.traceID {
+		return splitTracesByTraceID(td)
+	}
+	return splitTracesByResource(td, p.attribute)
+}
+
+func splitTracesByResource(td ptrace.Traces, attribute string) []keyedTraces {
+	var parts []keyedTraces
+	index := map[string]int{}
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		key := resourceKey(rs.Resource(), attribute)
+		idx, ok := index[string(key)]
+		if !ok {
+			idx = len(parts)
+			index[string(key)] = idx
+			parts = append(parts, keyedTraces{key: key, traces: ptrace.NewTraces()})
+		}
+		rs.CopyTo(parts[idx].traces.ResourceSpans().AppendEmpty())
+	}
+	return parts
+}
+
+func splitTracesByTraceID(td ptrace.Traces) []keyedTraces {
+	var parts []keyedTraces
+	index := map[pcommon.TraceID]int{}
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		rsByTrace := map[pcommon.TraceID]ptrace.ResourceSpans{}
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			ss := rs.ScopeSpans().At(j)
+			ssByTrace := map[pcommon.TraceID]ptrace.ScopeSpans{}
+			for k := 0; k < ss.Spans().Len(); k++ {
+				span := ss.Spans().At(k)
+				traceID := span.TraceID()
+				destSS, ok := ssByTrace[traceID]
+				if !ok {
+					destRS, ok := rsByTrace[traceID]
+					if !ok {
+						idx, ok := index[traceID]
+						if !ok {
+							idx = len(parts)
+							index[traceID] = idx
+							parts = append(parts, keyedTraces{key: traceIDKey(traceID), traces: ptrace.NewTraces()})
+						}
+						destRS = parts[idx].traces.ResourceSpans().AppendEmpty()
+						rs.Resource().CopyTo(destRS.Resource())
+						destRS.SetSchemaUrl(rs.SchemaUrl())
+						rsByTrace[traceID] = destRS
+					}
+					destSS = destRS.ScopeSpans().AppendEmpty()
+					ss.Scope().CopyTo(destSS.Scope())
+					destSS.SetSchemaUrl(ss.SchemaUrl())
+					ssByTrace[traceID] = destSS
+				}
+				span.CopyTo(destSS.Spans().AppendEmpty())
+			}
+		}
+	}
+	return parts
+}
+
+type keyedMetrics struct {
+	key     []byte
+	metrics pmetric.Metrics
+}
+
+func (p partitionKey) splitMetrics(md pmetric.Metrics) []keyedMetrics {
+	var parts []keyedMetrics
+	index := map[string]int{}
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		key := resourceKey(rm.Resource(), p.attribute)
+		idx, ok := index[string(key)]
+		if !ok {
+			idx = len(parts)
+			index[string(key)] = idx
+			parts = append(parts, keyedMetrics{key: key, metrics: pmetric.NewMetrics()})
+		}
+		rm.CopyTo(parts[idx].metrics.ResourceMetrics().AppendEmpty())
+	}
+	return parts
+}
+
+type keyedLogs struct {
+	key  []byte
+	logs plog.Logs
+}
+
+func (p partitionKey) splitLogs(ld plog.Logs) []keyedLogs {
+	if p.traceID {
+		return splitLogsByTraceID(ld)
+	}
+	return splitLogsByResource(ld, p.attribute)
+}
+
+func splitLogsByResource(ld plog.Logs, attribute string) []keyedLogs {
+	var parts []keyedLogs
+	index := map[string]int{}
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		key := resourceKey(rl.Resource(), attribute)
+		idx, ok := index[string(key)]
+		if !ok {
+			idx = len(parts)
+			index[string(key)] = idx
+			parts = append(parts, keyedLogs{key: key, logs: plog.NewLogs()})
+		}
+		rl.CopyTo(parts[idx].logs.ResourceLogs().AppendEmpty())
+	}
+	return parts
+}
+
+// splitLogsByTraceID groups log records by trace ID. Records without a trace ID are
+// grouped together, and sent without a key.
+func splitLogsByTraceID(ld plog.Logs) []keyedLogs {
+	var parts []keyedLogs
+	index := map[pcommon.TraceID]int{}
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		rlByTrace := map[pcommon.TraceID]plog.ResourceLogs{}
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			slByTrace := map[pcommon.TraceID]plog.ScopeLogs{}
+			for k := 0; k < sl.LogRecords().Len(); k++ {
+				record := sl.LogRecords().At(k)
+				traceID := record.TraceID()
+				destSL, ok := slByTrace[traceID]
+				if !ok {
+					destRL, ok := rlByTrace[traceID]
+					if !ok {
+						idx, ok := index[traceID]
+						if !ok {
+							idx = len(parts)
+							index[traceID] = idx
+							parts = append(parts, keyedLogs{key: traceIDKey(traceID), logs: plog.NewLogs()})
+						}
+						destRL = parts[idx].logs.ResourceLogs().AppendEmpty()
+						rl.Resource().CopyTo(destRL.Resource())
+						destRL.SetSchemaUrl(rl.SchemaUrl())
+						rlByTrace[traceID] = destRL
+					}
+					destSL = destRL.ScopeLogs().AppendEmpty()
+					sl.Scope().CopyTo(destSL.Scope())
+					destSL.SetSchemaUrl(sl.SchemaUrl())
+					slByTrace[traceID] = destSL
+				}
+				record.CopyTo(destSL.LogRecords().AppendEmpty())
+			}
+		}
+	}
+	return parts
+}
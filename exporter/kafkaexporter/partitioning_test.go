@@ -0,0 +1,124 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkaexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func TestParsePartitionKey(t *testing.T) {
+	key, err := parsePartitionKey("")
+	require.NoError(t, err)
+	assert.False(t, key.enabled())
+
+	key, err = parsePartitionKey("trace_id")
+	require.NoError(t, err)
+	assert.Equal(t, partitionKey{traceID: true}, key)
+
+	key, err = parsePartitionKey("resource.service.name")
+	require.NoError(t, err)
+	assert.Equal(t, partitionKey{attribute: "service.name"}, key)
+
+	_, err = parsePartitionKey("resource.")
+	assert.Error(t, err)
+}
+
+func TestSplitTracesByTraceID(t *testing.T) {
+	td := ptrace.NewTraces()
+	for _, service := range []string{"a", "b"} {
+		rs := td.ResourceSpans().AppendEmpty()
+		rs.Resource().Attributes().PutStr("service.name", service)
+		ss := rs.ScopeSpans().AppendEmpty()
+		ss.Scope().SetName("scope")
+		for _, traceID := range [][16]byte{{1}, {2}, {1}} {
+			ss.Spans().AppendEmpty().SetTraceID(traceID)
+		}
+	}
+
+	parts := partitionKey{traceID: true}.splitTraces(td)
+	require.Len(t, parts, 2)
+	assert.Equal(t, []byte("01000000000000000000000000000000"), parts[0].key)
+	assert.Equal(t, []byte("02000000000000000000000000000000"), parts[1].key)
+
+	first := parts[0].traces
+	assert.Equal(t, 4, first.SpanCount())
+	require.Equal(t, 2, first.ResourceSpans().Len())
+	service, _ := first.ResourceSpans().At(1).Resource().Attributes().Get("service.name")
+	assert.Equal(t, "b", service.Str())
+	assert.Equal(t, "scope", first.ResourceSpans().At(1).ScopeSpans().At(0).Scope().Name())
+	assert.Equal(t, 2, parts[1].traces.SpanCount())
+}
+
+func TestSplitTracesByResource(t *testing.T) {
+	td := ptrace.NewTraces()
+	for _, service := range []string{"a", "b", "a", ""} {
+		rs := td.ResourceSpans().AppendEmpty()
+		if service != "" {
+			rs.Resource().Attributes().PutStr("service.name", service)
+		}
+		rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	}
+
+	parts := partitionKey{attribute: "service.name"}.splitTraces(td)
+	require.Len(t, parts, 3)
+	assert.Equal(t, []byte("a"), parts[0].key)
+	assert.Equal(t, 2, parts[0].traces.SpanCount())
+	assert.Equal(t, []byte("b"), parts[1].key)
+	assert.Nil(t, parts[2].key)
+}
+
+func TestSplitMetricsByResource(t *testing.T) {
+	md := pmetric.NewMetrics()
+	for _, host := range []int64{1, 2, 1} {
+		rm := md.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().PutInt("host.id", host)
+		rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty()
+	}
+
+	parts := partitionKey{attribute: "host.id"}.splitMetrics(md)
+	require.Len(t, parts, 2)
+	assert.Equal(t, []byte("1"), parts[0].key)
+	assert.Equal(t, 2, parts[0].metrics.MetricCount())
+	assert.Equal(t, []byte("2"), parts[1].key)
+}
+
+func TestSplitLogs(t *testing.T) {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "a")
+	records := rl.ScopeLogs().AppendEmpty().LogRecords()
+	records.AppendEmpty().SetTraceID([16]byte{1})
+	records.AppendEmpty()
+	records.AppendEmpty().SetTraceID([16]byte{1})
+
+	parts := partitionKey{traceID: true}.splitLogs(ld)
+	require.Len(t, parts, 2)
+	assert.Equal(t, []byte("01000000000000000000000000000000"), parts[0].key)
+	assert.Equal(t, 2, parts[0].logs.LogRecordCount())
+	assert.Nil(t, parts[1].key)
+	assert.Equal(t, pcommon.NewTraceIDEmpty(), parts[1].logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).TraceID())
+
+	parts = partitionKey{attribute: "service.name"}.splitLogs(ld)
+	require.Len(t, parts, 1)
+	assert.Equal(t, []byte("a"), parts[0].key)
+	assert.Equal(t, 3, parts[0].logs.LogRecordCount())
+}
@@ -0,0 +1,182 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkaexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/kafkaexporter"
+
+import (
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// topicTemplate is the parsed form of a topic which may reference resource attributes
+// as `{<attribute name>}`, e.g. `otlp_logs_{k8s.namespace.name}`.
+type topicTemplate struct {
+	// parts alternates literal text and attribute names, starting with literal text.
+	parts []string
+	// fallback is the topic of data whose resource misses any of the attributes.
+	fallback string
+}
+
+func parseTopicTemplate(topic, fallback string) (topicTemplate, error) {
+	var parts []string
+	rest := topic
+	for {
+		open := strings.IndexByte(rest, '{')
+		if open < 0 {
+			break
+		}
+		end := strings.IndexByte(rest[open:], '}')
+		if end < 0 {
+			return topicTemplate{}, fmt.Errorf("topic %q has an unterminated attribute reference", topic)
+		}
+		name := rest[open+1 : open+end]
+		if name == "" {
+			return topicTemplate{}, fmt.Errorf("topic %q has an empty attribute reference", topic)
+		}
+		parts = append(parts, rest[:open], name)
+		rest = rest[open+end+1:]
+	}
+	if strings.IndexByte(rest, '}') >= 0 {
+		return topicTemplate{}, fmt.Errorf("topic %q has an unmatched '}'", topic)
+	}
+	return topicTemplate{parts: append(parts, rest), fallback: fallback}, nil
+}
+
+func (t topicTemplate) templated() bool {
+	return len(t.parts) > 1
+}
+
+// static returns the topic of an untemplated topic.
+func (t topicTemplate) static() string {
+	if len(t.parts) == 0 {
+		return ""
+	}
+	return t.parts[0]
+}
+
+// resolve returns the topic of data with the given resource, or the fallback topic
+// if the resource does not have all the referenced attributes.
+func (t topicTemplate) resolve(resource pcommon.Resource) string {
+	var sb strings.Builder
+	for i, part := range t.parts {
+		if i%2 == 0 {
+			sb.WriteString(part)
+			continue
+		}
+		value, ok := resource.Attributes().Get(part)
+		if !ok || value.AsString() == "" {
+			return t.fallback
+		}
+		sb.WriteString(sanitizeTopic(value.AsString()))
+	}
+	return sb.String()
+}
+
+// sanitizeTopic replaces the characters which are not allowed in Kafka topic names.
+func sanitizeTopic(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '_', r == '-':
+			return r
+		default:
+			return '_'
+		}
+	}, s)
+}
+
+type topicTraces struct {
+	topic  string
+	traces ptrace.Traces
+}
+
+// splitTraces groups the resource spans by topic. Untemplated topics leave the data unsplit.
+func (t topicTemplate) splitTraces(td ptrace.Traces) []topicTraces {
+	if !t.templated() {
+		return []topicTraces{{topic: t.static(), traces: td}}
+	}
+	var parts []topicTraces
+	index := map[string]int{}
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		topic := t.resolve(rs.Resource())
+		idx, ok := index[topic]
+		if !ok {
+			idx = len(parts)
+			index[topic] = idx
+			parts = append(parts, topicTraces{topic: topic, traces: ptrace.NewTraces()})
+		}
+		rs.CopyTo(parts[idx].traces.ResourceSpans().AppendEmpty())
+	}
+	return parts
+}
+
+type topicMetrics struct {
+	topic   string
+	metrics pmetric.Metrics
+}
+
+// splitMetrics groups the resource metrics by topic. Untemplated topics leave the data unsplit.
+func (t topicTemplate) splitMetrics(md pmetric.Metrics) []topicMetrics {
+	if !t.templated() {
+		return []topicMetrics{{topic: t.static(), metrics: md}}
+	}
+	var parts []topicMetrics
+	index := map[string]int{}
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		topic := t.resolve(rm.Resource())
+		idx, ok := index[topic]
+		if !ok {
+			idx = len(parts)
+			index[topic] = idx
+			parts = append(parts, topicMetrics{topic: topic, metrics: pmetric.NewMetrics()})
+		}
+		rm.CopyTo(parts[idx].metrics.ResourceMetrics().AppendEmpty())
+	}
+	return parts
+}
+
+type topicLogs struct {
+	topic string
+	logs  plog.Logs
+}
+
+// splitLogs groups the resource logs by topic. Untemplated topics leave the data unsplit.
+func (t topicTemplate) splitLogs(ld plog.Logs) []topicLogs {
+	if !t.templated() {
+		return []topicLogs{{topic: t.static(), logs: ld}}
+	}
+	var parts []topicLogs
+	index := map[string]int{}
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		topic := t.resolve(rl.Resource())
+		idx, ok := index[topic]
+		if !ok {
+			idx = len(parts)
+			index[topic] = idx
+			parts = append(parts, topicLogs{topic: topic, logs: plog.NewLogs()})
+		}
+		rl.CopyTo(parts[idx].logs.ResourceLogs().AppendEmpty())
+	}
+	return parts
+}
@@ -0,0 +1,127 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkaexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func TestParseTopicTemplate(t *testing.T) {
+	topic, err := parseTopicTemplate("otlp_spans", "fallback")
+	require.NoError(t, err)
+	assert.False(t, topic.templated())
+	assert.Equal(t, "otlp_spans", topic.static())
+
+	topic, err = parseTopicTemplate("otlp_logs_{k8s.namespace.name}_{service.name}", "otlp_logs")
+	require.NoError(t, err)
+	assert.True(t, topic.templated())
+	assert.Equal(t, []string{"otlp_logs_", "k8s.namespace.name", "_", "service.name", ""}, topic.parts)
+
+	_, err = parseTopicTemplate("otlp_logs_{k8s.namespace.name", "otlp_logs")
+	assert.EqualError(t, err, `topic "otlp_logs_{k8s.namespace.name" has an unterminated attribute reference`)
+	_, err = parseTopicTemplate("otlp_logs_{}", "otlp_logs")
+	assert.EqualError(t, err, `topic "otlp_logs_{}" has an empty attribute reference`)
+	_, err = parseTopicTemplate("otlp_logs_}", "otlp_logs")
+	assert.EqualError(t, err, `topic "otlp_logs_}" has an unmatched '}'`)
+}
+
+func TestTopicTemplateResolve(t *testing.T) {
+	topic, err := parseTopicTemplate("otlp_logs_{k8s.namespace.name}", "otlp_logs")
+	require.NoError(t, err)
+
+	resource := pcommon.NewResource()
+	assert.Equal(t, "otlp_logs", topic.resolve(resource))
+	resource.Attributes().PutStr("k8s.namespace.name", "")
+	assert.Equal(t, "otlp_logs", topic.resolve(resource))
+	resource.Attributes().PutStr("k8s.namespace.name", "shop")
+	assert.Equal(t, "otlp_logs_shop", topic.resolve(resource))
+	resource.Attributes().PutStr("k8s.namespace.name", "shop/prod: eu")
+	assert.Equal(t, "otlp_logs_shop_prod__eu", topic.resolve(resource))
+}
+
+func TestTopicTemplateSplitTraces(t *testing.T) {
+	topic, err := parseTopicTemplate("otlp_spans_{tenant}", "otlp_spans")
+	require.NoError(t, err)
+
+	td := ptrace.NewTraces()
+	for _, tenant := range []string{"a", "b", "a", ""} {
+		rs := td.ResourceSpans().AppendEmpty()
+		if tenant != "" {
+			rs.Resource().Attributes().PutStr("tenant", tenant)
+		}
+		rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	}
+
+	parts := topic.splitTraces(td)
+	require.Len(t, parts, 3)
+	assert.Equal(t, "otlp_spans_a", parts[0].topic)
+	assert.Equal(t, 2, parts[0].traces.SpanCount())
+	assert.Equal(t, "otlp_spans_b", parts[1].topic)
+	assert.Equal(t, 1, parts[1].traces.SpanCount())
+	assert.Equal(t, "otlp_spans", parts[2].topic)
+	assert.Equal(t, 1, parts[2].traces.SpanCount())
+
+	static, err := parseTopicTemplate("otlp_spans", "otlp_spans")
+	require.NoError(t, err)
+	parts = static.splitTraces(td)
+	require.Len(t, parts, 1)
+	assert.Equal(t, "otlp_spans", parts[0].topic)
+	assert.Equal(t, td, parts[0].traces)
+}
+
+func TestTopicTemplateSplitMetrics(t *testing.T) {
+	topic, err := parseTopicTemplate("otlp_metrics_{tenant}", "otlp_metrics")
+	require.NoError(t, err)
+
+	md := pmetric.NewMetrics()
+	for _, tenant := range []string{"a", "b", "a"} {
+		rm := md.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().PutStr("tenant", tenant)
+		rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	}
+
+	parts := topic.splitMetrics(md)
+	require.Len(t, parts, 2)
+	assert.Equal(t, "otlp_metrics_a", parts[0].topic)
+	assert.Equal(t, 2, parts[0].metrics.MetricCount())
+	assert.Equal(t, "otlp_metrics_b", parts[1].topic)
+	assert.Equal(t, 1, parts[1].metrics.MetricCount())
+}
+
+func TestTopicTemplateSplitLogs(t *testing.T) {
+	topic, err := parseTopicTemplate("otlp_logs_{tenant}", "otlp_logs")
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	for _, tenant := range []string{"a", "b", "a"} {
+		rl := ld.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("tenant", tenant)
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	}
+
+	parts := topic.splitLogs(ld)
+	require.Len(t, parts, 2)
+	assert.Equal(t, "otlp_logs_a", parts[0].topic)
+	assert.Equal(t, 2, parts[0].logs.LogRecordCount())
+	assert.Equal(t, "otlp_logs_b", parts[1].topic)
+	assert.Equal(t, 1, parts[1].logs.LogRecordCount())
+}
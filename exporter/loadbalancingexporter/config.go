@@ -19,6 +19,8 @@ import (
 
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/exporter/otlpexporter"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 )
 
 type routingKey int
@@ -26,6 +28,7 @@ type routingKey int
 const (
 	traceIDRouting routingKey = iota
 	svcRouting
+	resourceRouting
 )
 
 // Config defines configuration for the exporter.
@@ -34,6 +37,13 @@ type Config struct {
 	Protocol                Protocol         `mapstructure:"protocol"`
 	Resolver                ResolverSettings `mapstructure:"resolver"`
 	RoutingKey              string           `mapstructure:"routing_key"`
+
+	// RoutingAttributes are the resource attributes whose values make up the routing key, when the
+	// routing key is "resource".
+	RoutingAttributes []string `mapstructure:"routing_attributes"`
+
+	// ConsistentHashing configures the ring distributing the routing keys among the backends.
+	ConsistentHashing ConsistentHashingSettings `mapstructure:"consistent_hashing"`
 }
 
 // Protocol holds the individual protocol-specific settings. Only OTLP is supported at the moment.
@@ -41,10 +51,24 @@ type Protocol struct {
 	OTLP otlpexporter.Config `mapstructure:"otlp"`
 }
 
+// ConsistentHashingSettings defines the configuration for the consistent hash ring
+type ConsistentHashingSettings struct {
+	// VirtualNodes is the number of positions in the ring for each backend of weight 1. More
+	// virtual nodes spread the routing keys more evenly at the cost of a larger ring.
+	VirtualNodes int `mapstructure:"virtual_nodes"`
+
+	// Weights are the relative weights of the backends, keyed by endpoint. A backend with a weight
+	// of 2 gets twice as many virtual nodes, and so receives about twice as much data, as a backend
+	// with a weight of 1, which is the weight of backends that are not listed.
+	Weights map[string]int `mapstructure:"weights"`
+}
+
 // ResolverSettings defines the configurations for the backend resolver
 type ResolverSettings struct {
-	Static *StaticResolver `mapstructure:"static"`
-	DNS    *DNSResolver    `mapstructure:"dns"`
+	Static      *StaticResolver      `mapstructure:"static"`
+	DNS         *DNSResolver         `mapstructure:"dns"`
+	AWSCloudMap *AWSCloudMapResolver `mapstructure:"aws_cloud_map"`
+	K8sSvc      *K8sSvcResolver      `mapstructure:"k8s"`
 }
 
 // StaticResolver defines the configuration for the resolver providing a fixed list of backends
@@ -59,3 +83,22 @@ type DNSResolver struct {
 	Interval time.Duration `mapstructure:"interval"`
 	Timeout  time.Duration `mapstructure:"timeout"`
 }
+
+// AWSCloudMapResolver defines the configuration for the resolver discovering backends from AWS Cloud Map
+type AWSCloudMapResolver struct {
+	NamespaceName string        `mapstructure:"namespace"`
+	ServiceName   string        `mapstructure:"service_name"`
+	HealthStatus  string        `mapstructure:"health_status"`
+	Port          string        `mapstructure:"port"`
+	Interval      time.Duration `mapstructure:"interval"`
+	Timeout       time.Duration `mapstructure:"timeout"`
+}
+
+// K8sSvcResolver defines the configuration for the resolver watching the endpoints of a Kubernetes service
+type K8sSvcResolver struct {
+	k8sconfig.APIConfig `mapstructure:",squash"`
+
+	Service string        `mapstructure:"service"`
+	Port    string        `mapstructure:"port"`
+	Timeout time.Duration `mapstructure:"timeout"`
+}
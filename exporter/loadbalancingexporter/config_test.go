@@ -17,10 +17,14 @@ package loadbalancingexporter
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 )
 
 func TestLoadConfig(t *testing.T) {
@@ -33,4 +37,37 @@ func TestLoadConfig(t *testing.T) {
 	require.NoError(t, err)
 	require.NoError(t, component.UnmarshalExporterConfig(sub, cfg))
 	require.NotNil(t, cfg)
+
+	cfg = factory.CreateDefaultConfig()
+	sub, err = cm.Sub(component.NewIDWithName(typeStr, "4").String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalExporterConfig(sub, cfg))
+	assert.Equal(t, &AWSCloudMapResolver{
+		NamespaceName: "cloudmap-1",
+		ServiceName:   "service-1",
+		HealthStatus:  "HEALTHY_OR_ELSE_ALL",
+		Port:          "4317",
+		Interval:      30 * time.Second,
+		Timeout:       5 * time.Second,
+	}, cfg.(*Config).Resolver.AWSCloudMap)
+
+	cfg = factory.CreateDefaultConfig()
+	sub, err = cm.Sub(component.NewIDWithName(typeStr, "6").String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalExporterConfig(sub, cfg))
+	assert.Equal(t, &K8sSvcResolver{
+		APIConfig: k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeServiceAccount},
+		Service:   "lb-svc.observability",
+		Port:      "4317",
+	}, cfg.(*Config).Resolver.K8sSvc)
+
+	cfg = factory.CreateDefaultConfig()
+	assert.Equal(t, ConsistentHashingSettings{VirtualNodes: defaultVirtualNodes}, cfg.(*Config).ConsistentHashing)
+	sub, err = cm.Sub(component.NewIDWithName(typeStr, "5").String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalExporterConfig(sub, cfg))
+	assert.Equal(t, ConsistentHashingSettings{
+		VirtualNodes: 200,
+		Weights:      map[string]int{"endpoint-2:55678": 2},
+	}, cfg.(*Config).ConsistentHashing)
 }
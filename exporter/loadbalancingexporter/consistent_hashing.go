@@ -15,18 +15,22 @@
 package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
 
 import (
+	"crypto/sha256"
+	"encoding/binary"
 	"hash/crc32"
 	"sort"
+	"strconv"
 )
 
-const maxPositions uint32 = 36000 // 360 degrees with two decimal places
-const defaultWeight int = 100     // the number of points in the ring for each entry. For better results, it should be higher than 100.
+const (
+	defaultVirtualNodes = 100 // the number of points in the ring for each backend of weight 1
+	defaultWeight       = 1
+)
 
-// position represents a specific angle in the ring.
-// Each entry in the ring is positioned at an angle in a hypothetical circle, meaning that it ranges from 0 to 360.
+// position represents a specific point in the ring, ranging from 0 to the highest uint32.
 type position uint32
 
-// ringItem connects a specific angle in the ring with a specific endpoint.
+// ringItem connects a specific point in the ring with a specific endpoint.
 type ringItem struct {
 	pos      position
 	endpoint string
@@ -38,11 +42,12 @@ type hashRing struct {
 	items []ringItem
 }
 
-// newHashRing builds a new immutable consistent hash ring based on the given endpoints.
-func newHashRing(endpoints []string) *hashRing {
-	items := positionsForEndpoints(endpoints, defaultWeight)
+// newHashRing builds a new immutable consistent hash ring based on the given endpoints. Each endpoint
+// gets virtualNodes positions in the ring for each unit of its weight, so that it receives a share of
+// the identifiers proportional to its weight. Endpoints without a weight have a weight of 1.
+func newHashRing(endpoints []string, virtualNodes int, weights map[string]int) *hashRing {
 	return &hashRing{
-		items: items,
+		items: positionsForEndpoints(endpoints, virtualNodes, weights),
 	}
 }
 
@@ -52,12 +57,7 @@ func (h *hashRing) endpointFor(identifier []byte) string {
 		// perhaps the ring itself couldn't get initialized yet?
 		return ""
 	}
-	hasher := crc32.NewIEEE()
-	hasher.Write(identifier)
-	hash := hasher.Sum32()
-	pos := hash % maxPositions
-
-	return h.findEndpoint(position(pos))
+	return h.findEndpoint(position(crc32.ChecksumIEEE(identifier)))
 }
 
 // findEndpoint returns the "next" endpoint starting from the given position, or an empty string in case no endpoints are available
@@ -66,71 +66,40 @@ func (h *hashRing) findEndpoint(pos position) string {
 	if ringSize == 0 {
 		return ""
 	}
-	left, right := h.items[:ringSize/2], h.items[ringSize/2:]
-	found := bsearch(pos, left, right)
-	return found.endpoint
-}
-
-// bsearch is a binary search-like algorithm, returning the closest "next" item instead of an exact match
-func bsearch(pos position, left []ringItem, right []ringItem) ringItem {
-	// if it's the last item of the left side, return it
-	if left[len(left)-1].pos == pos {
-		return left[len(left)-1]
-	}
-
-	// if it's the first item of the right side, return it
-	if right[0].pos == pos {
-		return right[0]
-	}
-
-	// if we want a higher angle than the highest from the ring, the first angle is the right one
-	if pos > right[len(right)-1].pos {
-		return left[0]
-	}
-
-	// if the requested position is higher than the highest in the left, the item is in the right side
-	if pos > left[len(left)-1].pos {
-		size := len(right)
-		if size == 1 {
-			return right[0]
-		}
-
-		l, r := right[:size/2], right[size/2:]
-		return bsearch(pos, l, r)
-	}
-
-	// not on the right side, has to be on the left side
-	size := len(left)
-	if size == 1 {
-		return left[0]
+	i := sort.Search(ringSize, func(i int) bool {
+		return h.items[i].pos >= pos
+	})
+	// if we want a higher position than the highest from the ring, the first position is the right one
+	if i == ringSize {
+		i = 0
 	}
-	l, r := left[:size/2], left[size/2:]
-	return bsearch(pos, l, r)
+	return h.items[i].endpoint
 }
 
-// positionFor calculates all the positions in the ring based. The numPoints indicates how many positions to calculate.
+// positionsFor calculates the positions of the virtual nodes of the given endpoint. The positions
+// depend only on the endpoint and the index of the virtual node, so that changing the weight of an
+// endpoint, or adding and removing other endpoints, moves only the identifiers of the changed nodes.
 // The slice length of the result matches the numPoints.
 func positionsFor(endpoint string, numPoints int) []position {
 	res := make([]position, 0, numPoints)
 	for i := 0; i < numPoints; i++ {
-		h := crc32.NewIEEE()
-		h.Write([]byte(endpoint))
-		h.Write([]byte{byte(i)})
-		hash := h.Sum32()
-		pos := hash % maxPositions
-		res = append(res, position(pos))
+		sum := sha256.Sum256([]byte(endpoint + "-" + strconv.Itoa(i)))
+		res = append(res, position(binary.BigEndian.Uint32(sum[:4])))
 	}
 
 	return res
 }
 
 // positionsForEndpoints calculates all the positions for all the given endpoints
-func positionsForEndpoints(endpoints []string, weight int) []ringItem {
+func positionsForEndpoints(endpoints []string, virtualNodes int, weights map[string]int) []ringItem {
 	var items []ringItem
 	positions := map[position]bool{} // tracking the used positions
 	for _, endpoint := range endpoints {
-		// for this initial implementation, we don't allow endpoints to have custom weights
-		for _, pos := range positionsFor(endpoint, weight) {
+		weight, ok := weights[endpointWithPort(endpoint)]
+		if !ok {
+			weight = defaultWeight
+		}
+		for _, pos := range positionsFor(endpoint, virtualNodes*weight) {
 			// if this position is occupied already, skip this item
 			if _, found := positions[pos]; found {
 				continue
@@ -26,16 +26,16 @@ func TestNewHashRing(t *testing.T) {
 	endpoints := []string{"endpoint-1", "endpoint-2"}
 
 	// test
-	ring := newHashRing(endpoints)
+	ring := newHashRing(endpoints, defaultVirtualNodes, nil)
 
 	// verify
-	assert.Len(t, ring.items, 2*defaultWeight)
+	assert.Len(t, ring.items, 2*defaultVirtualNodes)
 }
 
 func TestEndpointFor(t *testing.T) {
 	// prepare
 	endpoints := []string{"endpoint-1", "endpoint-2"}
-	ring := newHashRing(endpoints)
+	ring := newHashRing(endpoints, defaultVirtualNodes, nil)
 
 	for _, tt := range []struct {
 		id       []byte
@@ -43,9 +43,10 @@ func TestEndpointFor(t *testing.T) {
 	}{
 		// check that we are indeed alternating endpoints for different inputs
 		{[]byte{1, 2, 0, 0}, "endpoint-1"},
-		{[]byte{128, 128, 0, 0}, "endpoint-2"},
-		{[]byte("ad-service-7"), "endpoint-1"},
-		{[]byte("get-recommendations-1"), "endpoint-2"},
+		{[]byte{2, 2, 0, 0}, "endpoint-2"},
+		{[]byte("ad-service-7"), "endpoint-2"},
+		{[]byte("get-recommendations-1"), "endpoint-1"},
+		{[]byte("get-recommendations-2"), "endpoint-2"},
 	} {
 		t.Run(fmt.Sprintf("Endpoint for id %s", string(tt.id)), func(t *testing.T) {
 			// test
@@ -69,42 +70,39 @@ func TestPositionsFor(t *testing.T) {
 	assert.Len(t, positions, 10)
 }
 
-func TestBinarySearch(t *testing.T) {
+func TestFindEndpoint(t *testing.T) {
 	// prepare
-	items := []ringItem{
-		{pos: 14},
-		{pos: 25},
-		{pos: 33},
-		{pos: 47},
-		{pos: 56},
-		{pos: 121},
-		{pos: 134},
-		{pos: 158},
-		{pos: 240},
-		{pos: 270},
-		{pos: 350},
+	ring := &hashRing{
+		items: []ringItem{
+			{pos: 14, endpoint: "endpoint-1"},
+			{pos: 25, endpoint: "endpoint-2"},
+			{pos: 121, endpoint: "endpoint-3"},
+			{pos: 270, endpoint: "endpoint-1"},
+			{pos: 350, endpoint: "endpoint-2"},
+		},
 	}
-	ringSize := len(items)
-	left, right := items[:ringSize/2], items[ringSize/2:]
 
 	for _, tt := range []struct {
 		requested position
-		expected  position
+		expected  string
 	}{
-		{position(85), position(121)},
-		{position(14), position(14)},
-		{position(351), position(14)},
-		{position(270), position(270)},
-		{position(271), position(350)},
+		{position(0), "endpoint-1"},
+		{position(14), "endpoint-1"},
+		{position(15), "endpoint-2"},
+		{position(85), "endpoint-3"},
+		{position(271), "endpoint-2"},
+		{position(351), "endpoint-1"},
 	} {
-		t.Run(fmt.Sprintf("Angle %d Requested", uint32(tt.requested)), func(t *testing.T) {
+		t.Run(fmt.Sprintf("Position %d Requested", uint32(tt.requested)), func(t *testing.T) {
 			// test
-			found := bsearch(tt.requested, left, right)
+			found := ring.findEndpoint(tt.requested)
 
 			// verify
-			assert.Equal(t, tt.expected, found.pos)
+			assert.Equal(t, tt.expected, found)
 		})
 	}
+
+	assert.Equal(t, "", (&hashRing{}).findEndpoint(position(1)))
 }
 
 func TestPositionsForEndpoints(t *testing.T) {
@@ -118,11 +116,11 @@ func TestPositionsForEndpoints(t *testing.T) {
 			[]string{"endpoint-1"},
 			[]ringItem{
 				// this was first calculated by running the algorithm and taking its output
-				{pos: 1401, endpoint: "endpoint-1"},
-				{pos: 4175, endpoint: "endpoint-1"},
-				{pos: 14133, endpoint: "endpoint-1"},
-				{pos: 17836, endpoint: "endpoint-1"},
-				{pos: 21667, endpoint: "endpoint-1"},
+				{pos: 477088345, endpoint: "endpoint-1"},
+				{pos: 480287977, endpoint: "endpoint-1"},
+				{pos: 1792841733, endpoint: "endpoint-1"},
+				{pos: 2610462682, endpoint: "endpoint-1"},
+				{pos: 2737351287, endpoint: "endpoint-1"},
 			},
 		},
 		{
@@ -130,11 +128,11 @@ func TestPositionsForEndpoints(t *testing.T) {
 			[]string{"endpoint-1", "endpoint-1"},
 			[]ringItem{
 				// we expect to not have duplicate items
-				{pos: 1401, endpoint: "endpoint-1"},
-				{pos: 4175, endpoint: "endpoint-1"},
-				{pos: 14133, endpoint: "endpoint-1"},
-				{pos: 17836, endpoint: "endpoint-1"},
-				{pos: 21667, endpoint: "endpoint-1"},
+				{pos: 477088345, endpoint: "endpoint-1"},
+				{pos: 480287977, endpoint: "endpoint-1"},
+				{pos: 1792841733, endpoint: "endpoint-1"},
+				{pos: 2610462682, endpoint: "endpoint-1"},
+				{pos: 2737351287, endpoint: "endpoint-1"},
 			},
 		},
 		{
@@ -142,22 +140,22 @@ func TestPositionsForEndpoints(t *testing.T) {
 			[]string{"endpoint-1", "endpoint-2"},
 			[]ringItem{
 				// we expect to have 5 positions for each endpoint
-				{pos: 1401, endpoint: "endpoint-1"},
-				{pos: 4175, endpoint: "endpoint-1"},
-				{pos: 10240, endpoint: "endpoint-2"},
-				{pos: 14133, endpoint: "endpoint-1"},
-				{pos: 15002, endpoint: "endpoint-2"},
-				{pos: 17836, endpoint: "endpoint-1"},
-				{pos: 21263, endpoint: "endpoint-2"},
-				{pos: 21667, endpoint: "endpoint-1"},
-				{pos: 26806, endpoint: "endpoint-2"},
-				{pos: 27020, endpoint: "endpoint-2"},
+				{pos: 477088345, endpoint: "endpoint-1"},
+				{pos: 480287977, endpoint: "endpoint-1"},
+				{pos: 507366517, endpoint: "endpoint-2"},
+				{pos: 1153640644, endpoint: "endpoint-2"},
+				{pos: 1319357936, endpoint: "endpoint-2"},
+				{pos: 1413620911, endpoint: "endpoint-2"},
+				{pos: 1792841733, endpoint: "endpoint-1"},
+				{pos: 2610462682, endpoint: "endpoint-1"},
+				{pos: 2737351287, endpoint: "endpoint-1"},
+				{pos: 3927552731, endpoint: "endpoint-2"},
 			},
 		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			// test
-			items := positionsForEndpoints(tt.endpoints, 5)
+			items := positionsForEndpoints(tt.endpoints, 5, nil)
 
 			// verify
 			assert.Equal(t, tt.expected, items)
@@ -165,6 +163,65 @@ func TestPositionsForEndpoints(t *testing.T) {
 	}
 }
 
+func TestPositionsForEndpointsWeighted(t *testing.T) {
+	// test
+	items := positionsForEndpoints([]string{"endpoint-1", "endpoint-2:4317"}, 5, map[string]int{"endpoint-2:4317": 3})
+
+	// verify
+	count := map[string]int{}
+	for _, item := range items {
+		count[item.endpoint]++
+	}
+	assert.Equal(t, map[string]int{"endpoint-1": 5, "endpoint-2:4317": 15}, count)
+}
+
+func TestPositionsForManyVirtualNodes(t *testing.T) {
+	// test
+	positions := positionsFor("endpoint-1", 1000)
+
+	// verify
+	unique := map[position]bool{}
+	for _, pos := range positions {
+		unique[pos] = true
+	}
+	assert.Len(t, unique, 1000)
+}
+
+func TestWeightedDistribution(t *testing.T) {
+	// prepare
+	endpoints := []string{"endpoint-1", "endpoint-2", "endpoint-3"}
+	ring := newHashRing(endpoints, defaultVirtualNodes, map[string]int{"endpoint-3:4317": 2})
+
+	// test
+	count := map[string]int{}
+	for i := 0; i < 10000; i++ {
+		count[ring.endpointFor([]byte(fmt.Sprintf("trace-%d", i)))]++
+	}
+
+	// verify that each backend gets its share, within a tolerance
+	assert.InDelta(t, 2500, count["endpoint-1"], 500)
+	assert.InDelta(t, 2500, count["endpoint-2"], 500)
+	assert.InDelta(t, 5000, count["endpoint-3"], 500)
+}
+
+func TestScaleInMovesOnlyRemovedBackend(t *testing.T) {
+	// prepare
+	before := newHashRing([]string{"endpoint-1", "endpoint-2", "endpoint-3", "endpoint-4"}, defaultVirtualNodes, nil)
+	after := newHashRing([]string{"endpoint-1", "endpoint-2", "endpoint-3"}, defaultVirtualNodes, nil)
+
+	for i := 0; i < 10000; i++ {
+		id := []byte(fmt.Sprintf("trace-%d", i))
+
+		// test
+		previous, current := before.endpointFor(id), after.endpointFor(id)
+
+		// verify that only the identifiers of the removed backend are moved
+		if previous != "endpoint-4" {
+			assert.Equal(t, previous, current)
+		}
+	}
+}
+
 func TestEqual(t *testing.T) {
 	original := &hashRing{
 		[]ringItem{
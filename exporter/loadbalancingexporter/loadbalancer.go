@@ -32,6 +32,7 @@ const (
 var (
 	errNoResolver                = errors.New("no resolvers specified for the exporter")
 	errMultipleResolversProvided = errors.New("only one resolver should be specified")
+	errInvalidVirtualNodes       = errors.New("the number of virtual nodes must not be negative")
 )
 
 var _ loadBalancer = (*loadBalancerImp)(nil)
@@ -51,6 +52,9 @@ type loadBalancerImp struct {
 	res  resolver
 	ring *hashRing
 
+	virtualNodes int
+	weights      map[string]int
+
 	componentFactory componentFactory
 	exporters        map[string]component.Exporter
 
@@ -62,10 +66,27 @@ type loadBalancerImp struct {
 func newLoadBalancer(params component.ExporterCreateSettings, cfg component.ExporterConfig, factory componentFactory) (*loadBalancerImp, error) {
 	oCfg := cfg.(*Config)
 
-	if oCfg.Resolver.DNS != nil && oCfg.Resolver.Static != nil {
+	if countResolvers(oCfg.Resolver) > 1 {
 		return nil, errMultipleResolversProvided
 	}
 
+	virtualNodes := oCfg.ConsistentHashing.VirtualNodes
+	if virtualNodes < 0 {
+		return nil, errInvalidVirtualNodes
+	}
+	if virtualNodes == 0 {
+		virtualNodes = defaultVirtualNodes
+	}
+
+	// the resolved endpoints might come with or without the default port
+	weights := make(map[string]int, len(oCfg.ConsistentHashing.Weights))
+	for endpoint, weight := range oCfg.ConsistentHashing.Weights {
+		if weight <= 0 {
+			return nil, fmt.Errorf("the weight of the endpoint %q must be positive", endpoint)
+		}
+		weights[endpointWithPort(endpoint)] = weight
+	}
+
 	var res resolver
 	if oCfg.Resolver.Static != nil {
 		var err error
@@ -84,6 +105,26 @@ func newLoadBalancer(params component.ExporterCreateSettings, cfg component.Expo
 		}
 	}
 
+	if oCfg.Resolver.AWSCloudMap != nil {
+		awsCloudMapLogger := params.Logger.With(zap.String("resolver", "aws_cloud_map"))
+
+		var err error
+		res, err = newAWSCloudMapResolver(awsCloudMapLogger, oCfg.Resolver.AWSCloudMap)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if oCfg.Resolver.K8sSvc != nil {
+		k8sLogger := params.Logger.With(zap.String("resolver", "k8s"))
+
+		var err error
+		res, err = newK8sResolver(k8sLogger, oCfg.Resolver.K8sSvc)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if res == nil {
 		return nil, errNoResolver
 	}
@@ -91,11 +132,30 @@ func newLoadBalancer(params component.ExporterCreateSettings, cfg component.Expo
 	return &loadBalancerImp{
 		logger:           params.Logger,
 		res:              res,
+		virtualNodes:     virtualNodes,
+		weights:          weights,
 		componentFactory: factory,
 		exporters:        map[string]component.Exporter{},
 	}, nil
 }
 
+func countResolvers(settings ResolverSettings) int {
+	count := 0
+	if settings.Static != nil {
+		count++
+	}
+	if settings.DNS != nil {
+		count++
+	}
+	if settings.AWSCloudMap != nil {
+		count++
+	}
+	if settings.K8sSvc != nil {
+		count++
+	}
+	return count
+}
+
 func (lb *loadBalancerImp) Start(ctx context.Context, host component.Host) error {
 	lb.res.onChange(lb.onBackendChanges)
 	lb.host = host
@@ -103,7 +163,7 @@ func (lb *loadBalancerImp) Start(ctx context.Context, host component.Host) error
 }
 
 func (lb *loadBalancerImp) onBackendChanges(resolved []string) {
-	newRing := newHashRing(resolved)
+	newRing := newHashRing(resolved, lb.virtualNodes, lb.weights)
 
 	if !newRing.equal(lb.ring) {
 		lb.updateLock.Lock()
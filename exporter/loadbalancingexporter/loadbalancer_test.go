@@ -72,6 +72,72 @@ func TestNewLoadBalancerInvalidDNSResolver(t *testing.T) {
 	require.Equal(t, errNoHostname, err)
 }
 
+func TestNewLoadBalancerInvalidAWSCloudMapResolver(t *testing.T) {
+	// prepare
+	cfg := &Config{
+		Resolver: ResolverSettings{
+			AWSCloudMap: &AWSCloudMapResolver{
+				ServiceName: "collector",
+			},
+		},
+	}
+
+	// test
+	p, err := newLoadBalancer(componenttest.NewNopExporterCreateSettings(), cfg, nil)
+
+	// verify
+	require.Nil(t, p)
+	require.Equal(t, errNoNamespace, err)
+}
+
+func TestNewLoadBalancerInvalidConsistentHashing(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		settings ConsistentHashingSettings
+		err      string
+	}{
+		{
+			"negative virtual nodes",
+			ConsistentHashingSettings{VirtualNodes: -1},
+			errInvalidVirtualNodes.Error(),
+		},
+		{
+			"zero weight",
+			ConsistentHashingSettings{Weights: map[string]int{"endpoint-1": 0}},
+			`the weight of the endpoint "endpoint-1" must be positive`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			// prepare
+			cfg := simpleConfig()
+			cfg.ConsistentHashing = tt.settings
+
+			// test
+			p, err := newLoadBalancer(componenttest.NewNopExporterCreateSettings(), cfg, nil)
+
+			// verify
+			require.Nil(t, p)
+			require.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestNewLoadBalancerInvalidK8sResolver(t *testing.T) {
+	// prepare
+	cfg := &Config{
+		Resolver: ResolverSettings{
+			K8sSvc: &K8sSvcResolver{},
+		},
+	}
+
+	// test
+	p, err := newLoadBalancer(componenttest.NewNopExporterCreateSettings(), cfg, nil)
+
+	// verify
+	require.Nil(t, p)
+	require.Equal(t, errNoK8sService, err)
+}
+
 func TestLoadBalancerStart(t *testing.T) {
 	// prepare
 	cfg := simpleConfig()
@@ -126,7 +192,7 @@ func TestWithDNSResolverNoEndpoints(t *testing.T) {
 	require.NoError(t, err)
 
 	// test
-	e := p.Endpoint([]byte{128, 128, 0, 0})
+	e := p.Endpoint([]byte{2, 2, 0, 0})
 
 	// verify
 	assert.Equal(t, "", e)
@@ -152,6 +218,27 @@ func TestMultipleResolvers(t *testing.T) {
 	assert.Equal(t, errMultipleResolversProvided, err)
 }
 
+func TestMultipleResolversWithAWSCloudMap(t *testing.T) {
+	cfg := &Config{
+		Resolver: ResolverSettings{
+			DNS: &DNSResolver{
+				Hostname: "service-1",
+			},
+			AWSCloudMap: &AWSCloudMapResolver{
+				NamespaceName: "otel",
+				ServiceName:   "collector",
+			},
+		},
+	}
+
+	// test
+	p, err := newLoadBalancer(componenttest.NewNopExporterCreateSettings(), cfg, nil)
+
+	// verify
+	assert.Nil(t, p)
+	assert.Equal(t, errMultipleResolversProvided, err)
+}
+
 func TestStartFailureStaticResolver(t *testing.T) {
 	// prepare
 	cfg := simpleConfig()
@@ -199,14 +286,39 @@ func TestOnBackendChanges(t *testing.T) {
 
 	// test
 	p.onBackendChanges([]string{"endpoint-1"})
-	require.Len(t, p.ring.items, defaultWeight)
+	require.Len(t, p.ring.items, defaultVirtualNodes)
 
 	// this should resolve to two endpoints
 	endpoints := []string{"endpoint-1", "endpoint-2"}
 	p.onBackendChanges(endpoints)
 
 	// verify
-	assert.Len(t, p.ring.items, 2*defaultWeight)
+	assert.Len(t, p.ring.items, 2*defaultVirtualNodes)
+}
+
+func TestOnBackendChangesWithConsistentHashing(t *testing.T) {
+	// prepare
+	cfg := simpleConfig()
+	cfg.ConsistentHashing = ConsistentHashingSettings{
+		VirtualNodes: 10,
+		Weights:      map[string]int{"endpoint-2": 3},
+	}
+	componentFactory := func(ctx context.Context, endpoint string) (component.Exporter, error) {
+		return newNopMockExporter(), nil
+	}
+	p, err := newLoadBalancer(componenttest.NewNopExporterCreateSettings(), cfg, componentFactory)
+	require.NotNil(t, p)
+	require.NoError(t, err)
+
+	// test
+	p.onBackendChanges([]string{"endpoint-1", "endpoint-2:4317"})
+
+	// verify that the weight applies regardless of the default port
+	count := map[string]int{}
+	for _, item := range p.ring.items {
+		count[item.endpoint]++
+	}
+	assert.Equal(t, map[string]int{"endpoint-1": 10, "endpoint-2:4317": 30}, count)
 }
 
 func TestRemoveExtraExporters(t *testing.T) {
@@ -366,14 +478,14 @@ func TestFailedExporterInRing(t *testing.T) {
 
 	// test
 	// this trace ID will reach the endpoint-2 -- see the consistent hashing tests for more info
-	_, err = p.Exporter(p.Endpoint([]byte{128, 128, 0, 0}))
+	_, err = p.Exporter(p.Endpoint([]byte{2, 2, 0, 0}))
 
 	// verify
 	assert.Error(t, err)
 
 	// test
 	// this service name will reach the endpoint-2 -- see the consistent hashing tests for more info
-	_, err = p.Exporter(p.Endpoint([]byte("get-recommendations-1")))
+	_, err = p.Exporter(p.Endpoint([]byte("get-recommendations-2")))
 
 	// verify
 	assert.Error(t, err)
@@ -0,0 +1,175 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/exporter/otlpexporter"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	conventions "go.opentelemetry.io/collector/semconv/v1.9.0"
+	"go.uber.org/multierr"
+)
+
+var _ component.MetricsExporter = (*metricExporterImp)(nil)
+
+var errNoRoutingAttributes = errors.New("routing_attributes must be set when routing metrics by resource")
+
+type metricExporterImp struct {
+	loadBalancer      loadBalancer
+	routingKey        routingKey
+	routingAttributes []string
+
+	stopped    bool
+	shutdownWg sync.WaitGroup
+}
+
+// Create new metrics exporter
+func newMetricsExporter(params component.ExporterCreateSettings, cfg component.ExporterConfig) (*metricExporterImp, error) {
+	exporterFactory := otlpexporter.NewFactory()
+
+	lb, err := newLoadBalancer(params, cfg, func(ctx context.Context, endpoint string) (component.Exporter, error) {
+		oCfg := buildExporterConfig(cfg.(*Config), endpoint)
+		return exporterFactory.CreateMetricsExporter(ctx, params, &oCfg)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// metrics have no trace ID, they are routed by service unless routed by resource attributes
+	metricExporter := metricExporterImp{loadBalancer: lb, routingKey: svcRouting}
+
+	switch cfg.(*Config).RoutingKey {
+	case "service", "":
+	case "resource":
+		if len(cfg.(*Config).RoutingAttributes) == 0 {
+			return nil, errNoRoutingAttributes
+		}
+		metricExporter.routingKey = resourceRouting
+		metricExporter.routingAttributes = cfg.(*Config).RoutingAttributes
+	default:
+		return nil, fmt.Errorf("unsupported routing_key for metrics: %s", cfg.(*Config).RoutingKey)
+	}
+	return &metricExporter, nil
+}
+
+func (e *metricExporterImp) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+func (e *metricExporterImp) Start(ctx context.Context, host component.Host) error {
+	return e.loadBalancer.Start(ctx, host)
+}
+
+func (e *metricExporterImp) Shutdown(context.Context) error {
+	e.stopped = true
+	e.shutdownWg.Wait()
+	return nil
+}
+
+func (e *metricExporterImp) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	var errs error
+	batches := make(map[string]pmetric.Metrics)
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		rid, err := e.routingIdentifier(rm.Resource())
+		if err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+
+		// the resources routed to the same backend are exported together
+		endpoint := e.loadBalancer.Endpoint([]byte(rid))
+		batch, ok := batches[endpoint]
+		if !ok {
+			batch = pmetric.NewMetrics()
+			batches[endpoint] = batch
+		}
+		rm.CopyTo(batch.ResourceMetrics().AppendEmpty())
+	}
+
+	for endpoint, batch := range batches {
+		errs = multierr.Append(errs, e.consumeMetric(ctx, endpoint, batch))
+	}
+	return errs
+}
+
+func (e *metricExporterImp) consumeMetric(ctx context.Context, endpoint string, md pmetric.Metrics) error {
+	exp, err := e.loadBalancer.Exporter(endpoint)
+	if err != nil {
+		return err
+	}
+
+	me, ok := exp.(component.MetricsExporter)
+	if !ok {
+		expectType := (*component.MetricsExporter)(nil)
+		return fmt.Errorf("unable to export metrics, unexpected exporter type: expected %T but got %T", expectType, exp)
+	}
+
+	start := time.Now()
+	err = me.ConsumeMetrics(ctx, md)
+	duration := time.Since(start)
+	if err == nil {
+		_ = stats.RecordWithTags(
+			ctx,
+			[]tag.Mutator{tag.Upsert(endpointTagKey, endpoint), successTrueMutator},
+			mBackendLatency.M(duration.Milliseconds()))
+	} else {
+		_ = stats.RecordWithTags(
+			ctx,
+			[]tag.Mutator{tag.Upsert(endpointTagKey, endpoint), successFalseMutator},
+			mBackendLatency.M(duration.Milliseconds()))
+	}
+
+	return err
+}
+
+// routingIdentifier returns the identifier the backend of the metrics of the given resource is chosen by.
+func (e *metricExporterImp) routingIdentifier(resource pcommon.Resource) (string, error) {
+	if e.routingKey == resourceRouting {
+		return routingIdentifierFromAttributes(resource.Attributes(), e.routingAttributes), nil
+	}
+
+	svc, ok := resource.Attributes().Get(conventions.AttributeServiceName)
+	if !ok {
+		return "", errors.New("unable to get service name")
+	}
+	return svc.Str(), nil
+}
+
+// routingIdentifierFromAttributes joins the values of the given attributes, missing attributes
+// having an empty value, so that resources with the same values are routed to the same backend.
+func routingIdentifierFromAttributes(attrs pcommon.Map, keys []string) string {
+	var sb strings.Builder
+	for _, key := range keys {
+		if value, ok := attrs.Get(key); ok {
+			sb.WriteString(value.AsString())
+		}
+		// the separator keeps values such as ("a", "bc") and ("ab", "c") apart
+		sb.WriteByte(0)
+	}
+	return sb.String()
+}
@@ -0,0 +1,325 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loadbalancingexporter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	conventions "go.opentelemetry.io/collector/semconv/v1.9.0"
+)
+
+func TestNewMetricsExporter(t *testing.T) {
+	for _, tt := range []struct {
+		desc   string
+		config *Config
+		err    error
+	}{
+		{
+			"simple",
+			simpleConfig(),
+			nil,
+		},
+		{
+			"resource",
+			resourceBasedRoutingConfig("tenant.id"),
+			nil,
+		},
+		{
+			"empty",
+			&Config{},
+			errNoResolver,
+		},
+		{
+			"resource without attributes",
+			resourceBasedRoutingConfig(),
+			errNoRoutingAttributes,
+		},
+		{
+			"traceID",
+			func() *Config {
+				cfg := simpleConfig()
+				cfg.RoutingKey = "traceID"
+				return cfg
+			}(),
+			errors.New("unsupported routing_key for metrics: traceID"),
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			// test
+			_, err := newMetricsExporter(componenttest.NewNopExporterCreateSettings(), tt.config)
+
+			// verify
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestMetricsExporterShutdown(t *testing.T) {
+	p, err := newMetricsExporter(componenttest.NewNopExporterCreateSettings(), simpleConfig())
+	require.NotNil(t, p)
+	require.NoError(t, err)
+
+	// test
+	res := p.Shutdown(context.Background())
+
+	// verify
+	assert.Nil(t, res)
+}
+
+func TestConsumeMetricsServiceBased(t *testing.T) {
+	received := newReceivedMetrics()
+	p := newTestMetricsExporter(t, simpleConfig(), received)
+	assert.Equal(t, svcRouting, p.routingKey)
+
+	md := pmetric.NewMetrics()
+	for i := 0; i < 20; i++ {
+		appendResourceMetrics(md, map[string]string{conventions.AttributeServiceName: fmt.Sprintf("service-%d", i%5)})
+	}
+
+	// test
+	res := p.ConsumeMetrics(context.Background(), md)
+
+	// verify
+	assert.Nil(t, res)
+	assert.Equal(t, 20, received.resourceCount())
+	for i := 0; i < 5; i++ {
+		assert.Len(t, received.endpointsFor(conventions.AttributeServiceName, fmt.Sprintf("service-%d", i)), 1)
+	}
+}
+
+func TestConsumeMetricsResourceBased(t *testing.T) {
+	received := newReceivedMetrics()
+	p := newTestMetricsExporter(t, resourceBasedRoutingConfig("tenant.id"), received)
+	assert.Equal(t, resourceRouting, p.routingKey)
+
+	md := pmetric.NewMetrics()
+	for i := 0; i < 20; i++ {
+		appendResourceMetrics(md, map[string]string{
+			conventions.AttributeServiceName: fmt.Sprintf("service-%d", i),
+			"tenant.id":                      fmt.Sprintf("tenant-%d", i%4),
+		})
+	}
+	// resources without the attribute are routed together
+	appendResourceMetrics(md, map[string]string{conventions.AttributeServiceName: "service-a"})
+	appendResourceMetrics(md, map[string]string{conventions.AttributeServiceName: "service-b"})
+
+	// test
+	res := p.ConsumeMetrics(context.Background(), md)
+
+	// verify
+	assert.Nil(t, res)
+	assert.Equal(t, 22, received.resourceCount())
+	for i := 0; i < 4; i++ {
+		assert.Len(t, received.endpointsFor("tenant.id", fmt.Sprintf("tenant-%d", i)), 1)
+	}
+	assert.Equal(t, received.endpointsFor(conventions.AttributeServiceName, "service-a"), received.endpointsFor(conventions.AttributeServiceName, "service-b"))
+}
+
+func TestConsumeMetricsMissingServiceName(t *testing.T) {
+	received := newReceivedMetrics()
+	p := newTestMetricsExporter(t, simpleConfig(), received)
+
+	md := pmetric.NewMetrics()
+	appendResourceMetrics(md, map[string]string{conventions.AttributeServiceName: "service-1"})
+	appendResourceMetrics(md, map[string]string{"host.name": "host-1"})
+
+	// test
+	res := p.ConsumeMetrics(context.Background(), md)
+
+	// verify
+	assert.EqualError(t, res, "unable to get service name")
+	assert.Equal(t, 1, received.resourceCount())
+}
+
+func TestConsumeMetricsUnexpectedExporterType(t *testing.T) {
+	componentFactory := func(ctx context.Context, endpoint string) (component.Exporter, error) {
+		return newNopMockExporter(), nil
+	}
+	lb, err := newLoadBalancer(componenttest.NewNopExporterCreateSettings(), simpleConfig(), componentFactory)
+	require.NotNil(t, lb)
+	require.NoError(t, err)
+
+	p, err := newMetricsExporter(componenttest.NewNopExporterCreateSettings(), simpleConfig())
+	require.NotNil(t, p)
+	require.NoError(t, err)
+
+	// pre-load an exporter here, so that we don't use the actual OTLP exporter
+	lb.addMissingExporters(context.Background(), []string{"endpoint-1"})
+	lb.res = &mockResolver{
+		triggerCallbacks: true,
+		onResolve: func(ctx context.Context) ([]string, error) {
+			return []string{"endpoint-1"}, nil
+		},
+	}
+	p.loadBalancer = lb
+
+	err = p.Start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+
+	md := pmetric.NewMetrics()
+	appendResourceMetrics(md, map[string]string{conventions.AttributeServiceName: "service-1"})
+
+	// test
+	res := p.ConsumeMetrics(context.Background(), md)
+
+	// verify
+	assert.EqualError(t, res, fmt.Sprintf("unable to export metrics, unexpected exporter type: expected *component.MetricsExporter but got %T", newNopMockExporter()))
+}
+
+func TestRoutingIdentifierFromAttributes(t *testing.T) {
+	attrs := pcommon.NewMap()
+	attrs.PutStr("a", "x")
+	attrs.PutInt("b", 1)
+
+	assert.Equal(t, "x\x001\x00", routingIdentifierFromAttributes(attrs, []string{"a", "b"}))
+	assert.Equal(t, "1\x00x\x00", routingIdentifierFromAttributes(attrs, []string{"b", "a"}))
+	assert.Equal(t, "x\x00\x00", routingIdentifierFromAttributes(attrs, []string{"a", "missing"}))
+
+	other := pcommon.NewMap()
+	other.PutStr("a", "x\x001")
+	assert.NotEqual(t, routingIdentifierFromAttributes(attrs, []string{"a", "b"}), routingIdentifierFromAttributes(other, []string{"a", "b"}))
+}
+
+// newTestMetricsExporter returns a started exporter balancing among two backends, which record the metrics they receive
+func newTestMetricsExporter(t *testing.T, cfg *Config, received *receivedMetrics) *metricExporterImp {
+	endpoints := []string{"endpoint-1", "endpoint-2"}
+	componentFactory := func(ctx context.Context, endpoint string) (component.Exporter, error) {
+		return newMockMetricsExporter(func(ctx context.Context, md pmetric.Metrics) error {
+			received.add(endpoint, md)
+			return nil
+		}), nil
+	}
+	lb, err := newLoadBalancer(componenttest.NewNopExporterCreateSettings(), cfg, componentFactory)
+	require.NotNil(t, lb)
+	require.NoError(t, err)
+
+	p, err := newMetricsExporter(componenttest.NewNopExporterCreateSettings(), cfg)
+	require.NotNil(t, p)
+	require.NoError(t, err)
+
+	lb.res = &mockResolver{
+		triggerCallbacks: true,
+		onResolve: func(ctx context.Context) ([]string, error) {
+			return endpoints, nil
+		},
+	}
+	p.loadBalancer = lb
+
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	})
+	return p
+}
+
+func appendResourceMetrics(md pmetric.Metrics, attrs map[string]string) {
+	rm := md.ResourceMetrics().AppendEmpty()
+	for k, v := range attrs {
+		rm.Resource().Attributes().PutStr(k, v)
+	}
+	rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName("requests")
+}
+
+func resourceBasedRoutingConfig(attributes ...string) *Config {
+	return &Config{
+		ExporterSettings: config.NewExporterSettings(component.NewID(typeStr)),
+		Resolver: ResolverSettings{
+			Static: &StaticResolver{Hostnames: []string{"endpoint-1"}},
+		},
+		RoutingKey:        "resource",
+		RoutingAttributes: attributes,
+	}
+}
+
+// receivedMetrics records the resources each backend received
+type receivedMetrics struct {
+	mu        sync.Mutex
+	resources map[string][]pcommon.Resource
+}
+
+func newReceivedMetrics() *receivedMetrics {
+	return &receivedMetrics{resources: map[string][]pcommon.Resource{}}
+}
+
+func (r *receivedMetrics) add(endpoint string, md pmetric.Metrics) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		r.resources[endpoint] = append(r.resources[endpoint], md.ResourceMetrics().At(i).Resource())
+	}
+}
+
+func (r *receivedMetrics) resourceCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	count := 0
+	for _, resources := range r.resources {
+		count += len(resources)
+	}
+	return count
+}
+
+// endpointsFor returns the backends which received resources with the given attribute value
+func (r *receivedMetrics) endpointsFor(key, value string) map[string]bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	endpoints := map[string]bool{}
+	for endpoint, resources := range r.resources {
+		for _, resource := range resources {
+			if v, ok := resource.Attributes().Get(key); ok && v.Str() == value {
+				endpoints[endpoint] = true
+			}
+		}
+	}
+	return endpoints
+}
+
+type mockMetricsExporter struct {
+	component.Component
+	ConsumeMetricsFn func(ctx context.Context, md pmetric.Metrics) error
+}
+
+func newMockMetricsExporter(consumeMetricsFn func(ctx context.Context, md pmetric.Metrics) error) component.MetricsExporter {
+	return &mockMetricsExporter{
+		Component:        mockComponent{},
+		ConsumeMetricsFn: consumeMetricsFn,
+	}
+}
+
+func (e *mockMetricsExporter) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+func (e *mockMetricsExporter) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	if e.ConsumeMetricsFn == nil {
+		return nil
+	}
+	return e.ConsumeMetricsFn(ctx, md)
+}
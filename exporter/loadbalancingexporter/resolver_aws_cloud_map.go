@@ -0,0 +1,237 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+)
+
+var _ resolver = (*cloudMapResolver)(nil)
+
+const (
+	defaultCloudMapInterval     = 30 * time.Second
+	defaultCloudMapTimeout      = 5 * time.Second
+	defaultCloudMapHealthStatus = servicediscovery.HealthStatusFilterHealthy
+)
+
+var (
+	errNoNamespace          = errors.New("no Cloud Map namespace specified to resolve the backends")
+	errNoServiceName        = errors.New("no Cloud Map service_name specified to resolve the backends")
+	errInvalidHealthStatus  = fmt.Errorf("invalid Cloud Map health_status, must be one of %v", servicediscovery.HealthStatusFilter_Values())
+	cloudMapResolverMutator = tag.Upsert(tag.MustNewKey("resolver"), "aws_cloud_map")
+
+	cloudMapResolverSuccessTrueMutators  = []tag.Mutator{cloudMapResolverMutator, successTrueMutator}
+	cloudMapResolverSuccessFalseMutators = []tag.Mutator{cloudMapResolverMutator, successFalseMutator}
+)
+
+type cloudMapResolver struct {
+	logger *zap.Logger
+
+	namespaceName string
+	serviceName   string
+	healthStatus  string
+	port          string
+	discoverer    cloudMapDiscoverer
+	resInterval   time.Duration
+	resTimeout    time.Duration
+
+	endpoints         []string
+	onChangeCallbacks []func([]string)
+
+	stopCh             chan (struct{})
+	updateLock         sync.Mutex
+	shutdownWg         sync.WaitGroup
+	changeCallbackLock sync.RWMutex
+}
+
+type cloudMapDiscoverer interface {
+	DiscoverInstancesWithContext(ctx aws.Context, input *servicediscovery.DiscoverInstancesInput, opts ...request.Option) (*servicediscovery.DiscoverInstancesOutput, error)
+}
+
+func newAWSCloudMapResolver(logger *zap.Logger, cfg *AWSCloudMapResolver) (*cloudMapResolver, error) {
+	if len(cfg.NamespaceName) == 0 {
+		return nil, errNoNamespace
+	}
+	if len(cfg.ServiceName) == 0 {
+		return nil, errNoServiceName
+	}
+
+	healthStatus := cfg.HealthStatus
+	if healthStatus == "" {
+		healthStatus = defaultCloudMapHealthStatus
+	}
+	if !isValidHealthStatus(healthStatus) {
+		return nil, errInvalidHealthStatus
+	}
+
+	interval := cfg.Interval
+	if interval == 0 {
+		interval = defaultCloudMapInterval
+	}
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = defaultCloudMapTimeout
+	}
+
+	sess, err := session.NewSessionWithOptions(session.Options{SharedConfigState: session.SharedConfigEnable})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the AWS session: %w", err)
+	}
+
+	return &cloudMapResolver{
+		logger:        logger,
+		namespaceName: cfg.NamespaceName,
+		serviceName:   cfg.ServiceName,
+		healthStatus:  healthStatus,
+		port:          cfg.Port,
+		discoverer:    servicediscovery.New(sess),
+		resInterval:   interval,
+		resTimeout:    timeout,
+		stopCh:        make(chan struct{}),
+	}, nil
+}
+
+func isValidHealthStatus(status string) bool {
+	for _, valid := range servicediscovery.HealthStatusFilter_Values() {
+		if status == valid {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *cloudMapResolver) start(ctx context.Context) error {
+	if _, err := r.resolve(ctx); err != nil {
+		r.logger.Warn("failed to resolve", zap.Error(err))
+	}
+
+	go r.periodicallyResolve()
+
+	r.logger.Debug("AWS Cloud Map resolver started",
+		zap.String("namespace", r.namespaceName), zap.String("service_name", r.serviceName),
+		zap.String("health_status", r.healthStatus), zap.String("port", r.port),
+		zap.Duration("interval", r.resInterval), zap.Duration("timeout", r.resTimeout))
+	return nil
+}
+
+func (r *cloudMapResolver) shutdown(ctx context.Context) error {
+	r.changeCallbackLock.Lock()
+	r.onChangeCallbacks = nil
+	r.changeCallbackLock.Unlock()
+
+	close(r.stopCh)
+	r.shutdownWg.Wait()
+	return nil
+}
+
+func (r *cloudMapResolver) periodicallyResolve() {
+	ticker := time.NewTicker(r.resInterval)
+
+	for {
+		select {
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), r.resTimeout)
+			if _, err := r.resolve(ctx); err != nil {
+				r.logger.Warn("failed to resolve", zap.Error(err))
+			} else {
+				r.logger.Debug("resolved successfully")
+			}
+			cancel()
+		case <-r.stopCh:
+			return
+		}
+	}
+}
+
+func (r *cloudMapResolver) resolve(ctx context.Context) ([]string, error) {
+	r.shutdownWg.Add(1)
+	defer r.shutdownWg.Done()
+
+	// DiscoverInstances returns at most 1000 instances, which is the Cloud Map limit of instances per service
+	out, err := r.discoverer.DiscoverInstancesWithContext(ctx, &servicediscovery.DiscoverInstancesInput{
+		NamespaceName: aws.String(r.namespaceName),
+		ServiceName:   aws.String(r.serviceName),
+		HealthStatus:  aws.String(r.healthStatus),
+		MaxResults:    aws.Int64(1000),
+	})
+	if err != nil {
+		_ = stats.RecordWithTags(ctx, cloudMapResolverSuccessFalseMutators, mNumResolutions.M(1))
+		return nil, err
+	}
+
+	_ = stats.RecordWithTags(ctx, cloudMapResolverSuccessTrueMutators, mNumResolutions.M(1))
+
+	var backends []string
+	for _, instance := range out.Instances {
+		ip := aws.StringValue(instance.Attributes["AWS_INSTANCE_IPV4"])
+		if ip == "" {
+			r.logger.Debug("skipping instance without an IPv4 address", zap.String("instance", aws.StringValue(instance.InstanceId)))
+			continue
+		}
+
+		// the configured port takes precedence over the port the instance was registered with
+		port := r.port
+		if port == "" {
+			port = aws.StringValue(instance.Attributes["AWS_INSTANCE_PORT"])
+		}
+
+		backend := ip
+		if port != "" {
+			backend = fmt.Sprintf("%s:%s", ip, port)
+		}
+		backends = append(backends, backend)
+	}
+
+	// keep it always in the same order
+	sort.Strings(backends)
+
+	if equalStringSlice(r.endpoints, backends) {
+		return r.endpoints, nil
+	}
+
+	// the list has changed!
+	r.updateLock.Lock()
+	r.endpoints = backends
+	r.updateLock.Unlock()
+	_ = stats.RecordWithTags(ctx, cloudMapResolverSuccessTrueMutators, mNumBackends.M(int64(len(backends))))
+
+	// propagate the change
+	r.changeCallbackLock.RLock()
+	for _, callback := range r.onChangeCallbacks {
+		callback(r.endpoints)
+	}
+	r.changeCallbackLock.RUnlock()
+
+	return r.endpoints, nil
+}
+
+func (r *cloudMapResolver) onChange(f func([]string)) {
+	r.changeCallbackLock.Lock()
+	defer r.changeCallbackLock.Unlock()
+	r.onChangeCallbacks = append(r.onChangeCallbacks, f)
+}
@@ -0,0 +1,206 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loadbalancingexporter
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+func TestInitialCloudMapResolution(t *testing.T) {
+	// prepare
+	res, err := newAWSCloudMapResolver(zap.NewNop(), &AWSCloudMapResolver{NamespaceName: "otel", ServiceName: "collector"})
+	require.NoError(t, err)
+
+	var input *servicediscovery.DiscoverInstancesInput
+	res.discoverer = &mockCloudMapDiscoverer{
+		onDiscoverInstances: func(_ context.Context, in *servicediscovery.DiscoverInstancesInput) (*servicediscovery.DiscoverInstancesOutput, error) {
+			input = in
+			return &servicediscovery.DiscoverInstancesOutput{
+				Instances: []*servicediscovery.HttpInstanceSummary{
+					cloudMapInstance("i-2", "10.0.0.2", "4317"),
+					cloudMapInstance("i-1", "10.0.0.1", "4317"),
+					cloudMapInstance("i-3", "10.0.0.3", ""),
+					cloudMapInstance("i-4", "", "4317"),
+				},
+			}, nil
+		},
+	}
+
+	// test
+	var resolved []string
+	res.onChange(func(endpoints []string) {
+		resolved = endpoints
+	})
+	require.NoError(t, res.start(context.Background()))
+	defer func() {
+		require.NoError(t, res.shutdown(context.Background()))
+	}()
+
+	// verify
+	assert.Equal(t, []string{"10.0.0.1:4317", "10.0.0.2:4317", "10.0.0.3"}, resolved)
+	assert.Equal(t, "otel", aws.StringValue(input.NamespaceName))
+	assert.Equal(t, "collector", aws.StringValue(input.ServiceName))
+	assert.Equal(t, servicediscovery.HealthStatusFilterHealthy, aws.StringValue(input.HealthStatus))
+}
+
+func TestInitialCloudMapResolutionWithPort(t *testing.T) {
+	// prepare
+	res, err := newAWSCloudMapResolver(zap.NewNop(), &AWSCloudMapResolver{
+		NamespaceName: "otel",
+		ServiceName:   "collector",
+		HealthStatus:  servicediscovery.HealthStatusFilterHealthyOrElseAll,
+		Port:          "55690",
+	})
+	require.NoError(t, err)
+
+	var healthStatus string
+	res.discoverer = &mockCloudMapDiscoverer{
+		onDiscoverInstances: func(_ context.Context, in *servicediscovery.DiscoverInstancesInput) (*servicediscovery.DiscoverInstancesOutput, error) {
+			healthStatus = aws.StringValue(in.HealthStatus)
+			return &servicediscovery.DiscoverInstancesOutput{
+				Instances: []*servicediscovery.HttpInstanceSummary{
+					cloudMapInstance("i-1", "10.0.0.1", "4317"),
+					cloudMapInstance("i-2", "10.0.0.2", ""),
+				},
+			}, nil
+		},
+	}
+
+	// test
+	resolved, err := res.resolve(context.Background())
+
+	// verify
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.1:55690", "10.0.0.2:55690"}, resolved)
+	assert.Equal(t, servicediscovery.HealthStatusFilterHealthyOrElseAll, healthStatus)
+}
+
+func TestErrNoNamespace(t *testing.T) {
+	res, err := newAWSCloudMapResolver(zap.NewNop(), &AWSCloudMapResolver{ServiceName: "collector"})
+	assert.Nil(t, res)
+	assert.Equal(t, errNoNamespace, err)
+}
+
+func TestErrNoServiceName(t *testing.T) {
+	res, err := newAWSCloudMapResolver(zap.NewNop(), &AWSCloudMapResolver{NamespaceName: "otel"})
+	assert.Nil(t, res)
+	assert.Equal(t, errNoServiceName, err)
+}
+
+func TestErrInvalidHealthStatus(t *testing.T) {
+	res, err := newAWSCloudMapResolver(zap.NewNop(), &AWSCloudMapResolver{NamespaceName: "otel", ServiceName: "collector", HealthStatus: "ALIVE"})
+	assert.Nil(t, res)
+	assert.Equal(t, errInvalidHealthStatus, err)
+}
+
+func TestCantResolveCloudMap(t *testing.T) {
+	// prepare
+	res, err := newAWSCloudMapResolver(zap.NewNop(), &AWSCloudMapResolver{NamespaceName: "otel", ServiceName: "collector"})
+	require.NoError(t, err)
+
+	expectedErr := errors.New("some expected error")
+	res.discoverer = &mockCloudMapDiscoverer{
+		onDiscoverInstances: func(context.Context, *servicediscovery.DiscoverInstancesInput) (*servicediscovery.DiscoverInstancesOutput, error) {
+			return nil, expectedErr
+		},
+	}
+
+	// test
+	require.NoError(t, res.start(context.Background()))
+
+	// verify
+	assert.NoError(t, res.shutdown(context.Background()))
+}
+
+func TestPeriodicallyResolveCloudMap(t *testing.T) {
+	// prepare
+	res, err := newAWSCloudMapResolver(zap.NewNop(), &AWSCloudMapResolver{
+		NamespaceName: "otel",
+		ServiceName:   "collector",
+		Interval:      10 * time.Millisecond,
+		Timeout:       time.Second,
+	})
+	require.NoError(t, err)
+
+	counter := atomic.NewInt64(0)
+	res.discoverer = &mockCloudMapDiscoverer{
+		onDiscoverInstances: func(context.Context, *servicediscovery.DiscoverInstancesInput) (*servicediscovery.DiscoverInstancesOutput, error) {
+			instances := []*servicediscovery.HttpInstanceSummary{cloudMapInstance("i-1", "10.0.0.1", "")}
+			if counter.Inc() > 1 {
+				// a new backend registered after the first resolution
+				instances = append(instances, cloudMapInstance("i-2", "10.0.0.2", ""))
+			}
+			return &servicediscovery.DiscoverInstancesOutput{Instances: instances}, nil
+		},
+	}
+
+	changes := make(chan []string, 10)
+	res.onChange(func(endpoints []string) {
+		changes <- endpoints
+	})
+
+	// test
+	require.NoError(t, res.start(context.Background()))
+	defer func() {
+		require.NoError(t, res.shutdown(context.Background()))
+	}()
+
+	// verify
+	assert.Equal(t, []string{"10.0.0.1"}, <-changes)
+	select {
+	case resolved := <-changes:
+		assert.Equal(t, []string{"10.0.0.1", "10.0.0.2"}, resolved)
+	case <-time.After(time.Second):
+		t.Fatal("backends were not refreshed")
+	}
+}
+
+func cloudMapInstance(id, ip, port string) *servicediscovery.HttpInstanceSummary {
+	attributes := map[string]*string{}
+	if ip != "" {
+		attributes["AWS_INSTANCE_IPV4"] = aws.String(ip)
+	}
+	if port != "" {
+		attributes["AWS_INSTANCE_PORT"] = aws.String(port)
+	}
+	return &servicediscovery.HttpInstanceSummary{
+		InstanceId: aws.String(id),
+		Attributes: attributes,
+	}
+}
+
+var _ cloudMapDiscoverer = (*mockCloudMapDiscoverer)(nil)
+
+type mockCloudMapDiscoverer struct {
+	onDiscoverInstances func(context.Context, *servicediscovery.DiscoverInstancesInput) (*servicediscovery.DiscoverInstancesOutput, error)
+}
+
+func (m *mockCloudMapDiscoverer) DiscoverInstancesWithContext(ctx aws.Context, input *servicediscovery.DiscoverInstancesInput, _ ...request.Option) (*servicediscovery.DiscoverInstancesOutput, error) {
+	if m.onDiscoverInstances != nil {
+		return m.onDiscoverInstances(ctx, input)
+	}
+	return &servicediscovery.DiscoverInstancesOutput{}, nil
+}
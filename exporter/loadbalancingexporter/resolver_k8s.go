@@ -0,0 +1,250 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	discoverylisters "k8s.io/client-go/listers/discovery/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+var _ resolver = (*k8sResolver)(nil)
+
+const (
+	defaultK8sNamespace = "default"
+	defaultK8sTimeout   = 5 * time.Second
+)
+
+var (
+	errNoK8sService = errors.New("no Kubernetes service specified to resolve the backends")
+
+	k8sResolverMutator = tag.Upsert(tag.MustNewKey("resolver"), "k8s")
+
+	k8sResolverSuccessTrueMutators = []tag.Mutator{k8sResolverMutator, successTrueMutator}
+)
+
+// k8sResolver watches the EndpointSlices of a Kubernetes service, using its ready endpoints as the backends.
+type k8sResolver struct {
+	logger *zap.Logger
+
+	service   string
+	namespace string
+	port      string
+	timeout   time.Duration
+
+	factory informers.SharedInformerFactory
+	lister  discoverylisters.EndpointSliceLister
+
+	endpoints         []string
+	onChangeCallbacks []func([]string)
+
+	stopCh             chan (struct{})
+	updateLock         sync.Mutex
+	changeCallbackLock sync.RWMutex
+}
+
+func newK8sResolver(logger *zap.Logger, cfg *K8sSvcResolver) (*k8sResolver, error) {
+	if len(cfg.Service) == 0 {
+		return nil, errNoK8sService
+	}
+
+	apiCfg := cfg.APIConfig
+	if apiCfg.AuthType == "" {
+		apiCfg.AuthType = k8sconfig.AuthTypeServiceAccount
+	}
+	if err := apiCfg.Validate(); err != nil {
+		return nil, err
+	}
+	client, err := k8sconfig.MakeClient(apiCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the Kubernetes client: %w", err)
+	}
+
+	return newK8sResolverWithClient(logger, client, cfg), nil
+}
+
+func newK8sResolverWithClient(logger *zap.Logger, client kubernetes.Interface, cfg *K8sSvcResolver) *k8sResolver {
+	// the service is either "name" or "name.namespace"
+	service, namespace := cfg.Service, defaultK8sNamespace
+	if idx := strings.IndexByte(cfg.Service, '.'); idx >= 0 {
+		service, namespace = cfg.Service[:idx], cfg.Service[idx+1:]
+	}
+
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = defaultK8sTimeout
+	}
+
+	// only the slices of the service are watched
+	factory := informers.NewSharedInformerFactoryWithOptions(client, 0,
+		informers.WithNamespace(namespace),
+		informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+			opts.LabelSelector = labels.SelectorFromSet(labels.Set{discoveryv1.LabelServiceName: service}).String()
+		}),
+	)
+
+	return &k8sResolver{
+		logger:    logger,
+		service:   service,
+		namespace: namespace,
+		port:      cfg.Port,
+		timeout:   timeout,
+		factory:   factory,
+		lister:    factory.Discovery().V1().EndpointSlices().Lister(),
+		stopCh:    make(chan struct{}),
+	}
+}
+
+func (r *k8sResolver) start(ctx context.Context) error {
+	informer := r.factory.Discovery().V1().EndpointSlices().Informer()
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(interface{}) { r.resolveAndLog() },
+		UpdateFunc: func(interface{}, interface{}) { r.resolveAndLog() },
+		DeleteFunc: func(interface{}) { r.resolveAndLog() },
+	})
+	r.factory.Start(r.stopCh)
+
+	// don't block the start of the collector for too long: the backends are resolved
+	// once the slices are received anyway
+	syncCtx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	if cache.WaitForCacheSync(syncCtx.Done(), informer.HasSynced) {
+		r.resolveAndLog()
+	} else {
+		r.logger.Warn("the endpoint slices of the service haven't been received yet",
+			zap.String("service", r.service), zap.String("namespace", r.namespace))
+	}
+
+	r.logger.Debug("Kubernetes resolver started",
+		zap.String("service", r.service), zap.String("namespace", r.namespace),
+		zap.String("port", r.port), zap.Duration("timeout", r.timeout))
+	return nil
+}
+
+func (r *k8sResolver) shutdown(ctx context.Context) error {
+	r.changeCallbackLock.Lock()
+	r.onChangeCallbacks = nil
+	r.changeCallbackLock.Unlock()
+
+	// stops the informer
+	close(r.stopCh)
+	return nil
+}
+
+func (r *k8sResolver) resolveAndLog() {
+	if _, err := r.resolve(context.Background()); err != nil {
+		r.logger.Warn("failed to resolve", zap.Error(err))
+	}
+}
+
+func (r *k8sResolver) resolve(ctx context.Context) ([]string, error) {
+	// the informer resolves on each change of the slices: resolutions are serialized,
+	// so that the callbacks don't receive an outdated list of backends after a newer one
+	r.updateLock.Lock()
+	defer r.updateLock.Unlock()
+
+	// the slices are read from the informer cache, which can't fail
+	slices, err := r.lister.EndpointSlices(r.namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	_ = stats.RecordWithTags(ctx, k8sResolverSuccessTrueMutators, mNumResolutions.M(1))
+
+	seen := map[string]bool{}
+	var backends []string
+	for _, slice := range slices {
+		if slice.AddressType == discoveryv1.AddressTypeFQDN {
+			continue
+		}
+		port := r.portFor(slice)
+		for _, endpoint := range slice.Endpoints {
+			// endpoints without the ready condition are to be considered ready, while
+			// terminating endpoints are never ready
+			if endpoint.Conditions.Ready != nil && !*endpoint.Conditions.Ready {
+				continue
+			}
+			for _, address := range endpoint.Addresses {
+				backend := address
+				if port != "" {
+					backend = net.JoinHostPort(address, port)
+				} else if slice.AddressType == discoveryv1.AddressTypeIPv6 {
+					backend = fmt.Sprintf("[%s]", address)
+				}
+				if !seen[backend] {
+					seen[backend] = true
+					backends = append(backends, backend)
+				}
+			}
+		}
+	}
+
+	// keep it always in the same order
+	sort.Strings(backends)
+
+	if equalStringSlice(r.endpoints, backends) {
+		return backends, nil
+	}
+
+	// the list has changed!
+	r.endpoints = backends
+	_ = stats.RecordWithTags(ctx, k8sResolverSuccessTrueMutators, mNumBackends.M(int64(len(backends))))
+
+	// propagate the change
+	r.changeCallbackLock.RLock()
+	for _, callback := range r.onChangeCallbacks {
+		callback(backends)
+	}
+	r.changeCallbackLock.RUnlock()
+
+	return backends, nil
+}
+
+// portFor returns the port to export to the endpoints of the slice: the configured port takes precedence
+// over the port of the slice, which is only used when the service exposes a single port. Otherwise, the
+// default port is used.
+func (r *k8sResolver) portFor(slice *discoveryv1.EndpointSlice) string {
+	if r.port != "" {
+		return r.port
+	}
+	if len(slice.Ports) == 1 && slice.Ports[0].Port != nil {
+		return strconv.Itoa(int(*slice.Ports[0].Port))
+	}
+	return ""
+}
+
+func (r *k8sResolver) onChange(f func([]string)) {
+	r.changeCallbackLock.Lock()
+	defer r.changeCallbackLock.Unlock()
+	r.onChangeCallbacks = append(r.onChangeCallbacks, f)
+}
@@ -0,0 +1,238 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loadbalancingexporter
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestK8sResolverNoService(t *testing.T) {
+	// test
+	res, err := newK8sResolver(zap.NewNop(), &K8sSvcResolver{})
+
+	// verify
+	assert.Nil(t, res)
+	assert.Equal(t, errNoK8sService, err)
+}
+
+func TestK8sResolverServiceNamespace(t *testing.T) {
+	for _, tt := range []struct {
+		service   string
+		name      string
+		namespace string
+	}{
+		{"collector", "collector", "default"},
+		{"collector.observability", "collector", "observability"},
+		{"collector.observability.svc.cluster.local", "collector", "observability.svc.cluster.local"},
+	} {
+		t.Run(tt.service, func(t *testing.T) {
+			res := newK8sResolverWithClient(zap.NewNop(), fake.NewSimpleClientset(), &K8sSvcResolver{Service: tt.service})
+			assert.Equal(t, tt.name, res.service)
+			assert.Equal(t, tt.namespace, res.namespace)
+		})
+	}
+}
+
+func TestInitialK8sResolution(t *testing.T) {
+	// prepare
+	client := fake.NewSimpleClientset(
+		endpointSlice("collector-abc", "observability", "collector", 4317,
+			endpoint("10.0.0.2", ptrBool(true)),
+			endpoint("10.0.0.1", nil),
+			endpoint("10.0.0.3", ptrBool(false)),
+		),
+		endpointSlice("collector-def", "observability", "collector", 4317,
+			endpoint("10.0.0.4", ptrBool(true)),
+			endpoint("10.0.0.1", ptrBool(true)),
+		),
+		// slices of other services and namespaces are ignored
+		endpointSlice("other-abc", "observability", "other", 4317, endpoint("10.0.1.1", ptrBool(true))),
+		endpointSlice("collector-xyz", "default", "collector", 4317, endpoint("10.0.2.1", ptrBool(true))),
+	)
+	res := newK8sResolverWithClient(zap.NewNop(), client, &K8sSvcResolver{Service: "collector.observability"})
+
+	var mu sync.Mutex
+	var resolved []string
+	res.onChange(func(endpoints []string) {
+		mu.Lock()
+		defer mu.Unlock()
+		resolved = endpoints
+	})
+
+	// test
+	require.NoError(t, res.start(context.Background()))
+	defer func() {
+		require.NoError(t, res.shutdown(context.Background()))
+	}()
+
+	// verify that only the ready endpoints are used
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{"10.0.0.1:4317", "10.0.0.2:4317", "10.0.0.4:4317"}, resolved)
+}
+
+func TestK8sResolutionWithPort(t *testing.T) {
+	// prepare
+	slice := endpointSlice("collector-abc", "default", "collector", 4317, endpoint("10.0.0.1", ptrBool(true)))
+	res := newK8sResolverWithClient(zap.NewNop(), fake.NewSimpleClientset(slice), &K8sSvcResolver{Service: "collector", Port: "55690"})
+	require.NoError(t, res.start(context.Background()))
+	defer func() {
+		require.NoError(t, res.shutdown(context.Background()))
+	}()
+
+	// test
+	resolved, err := res.resolve(context.Background())
+
+	// verify that the configured port takes precedence
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.1:55690"}, resolved)
+}
+
+func TestK8sResolutionWithMultiplePorts(t *testing.T) {
+	// prepare
+	slice := endpointSlice("collector-abc", "default", "collector", 4317, endpoint("10.0.0.1", ptrBool(true)))
+	otherPort := int32(4318)
+	slice.Ports = append(slice.Ports, discoveryv1.EndpointPort{Port: &otherPort})
+	res := newK8sResolverWithClient(zap.NewNop(), fake.NewSimpleClientset(slice), &K8sSvcResolver{Service: "collector"})
+	require.NoError(t, res.start(context.Background()))
+	defer func() {
+		require.NoError(t, res.shutdown(context.Background()))
+	}()
+
+	// test
+	resolved, err := res.resolve(context.Background())
+
+	// verify that the default port is used
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.1"}, resolved)
+}
+
+func TestK8sResolutionOnEndpointChanges(t *testing.T) {
+	// prepare
+	ctx := context.Background()
+	slice := endpointSlice("collector-abc", "default", "collector", 4317,
+		endpoint("10.0.0.1", ptrBool(true)),
+		endpoint("10.0.0.2", ptrBool(true)),
+	)
+	client := fake.NewSimpleClientset(slice)
+	res := newK8sResolverWithClient(zap.NewNop(), client, &K8sSvcResolver{Service: "collector"})
+
+	var mu sync.Mutex
+	var resolved []string
+	res.onChange(func(endpoints []string) {
+		mu.Lock()
+		defer mu.Unlock()
+		resolved = endpoints
+	})
+	current := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return resolved
+	}
+
+	require.NoError(t, res.start(ctx))
+	defer func() {
+		require.NoError(t, res.shutdown(ctx))
+	}()
+	require.Equal(t, []string{"10.0.0.1:4317", "10.0.0.2:4317"}, current())
+
+	// test: a pod of the rollout is terminating, while a new one is not ready yet
+	updated := slice.DeepCopy()
+	updated.Endpoints = []discoveryv1.Endpoint{
+		endpoint("10.0.0.1", ptrBool(true)),
+		endpoint("10.0.0.2", ptrBool(false)),
+		endpoint("10.0.0.3", ptrBool(false)),
+	}
+	_, err := client.DiscoveryV1().EndpointSlices("default").Update(ctx, updated, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	// verify
+	assert.Eventually(t, func() bool {
+		return assert.ObjectsAreEqual([]string{"10.0.0.1:4317"}, current())
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// test: the new pod becomes ready
+	updated = updated.DeepCopy()
+	updated.Endpoints[2] = endpoint("10.0.0.3", ptrBool(true))
+	_, err = client.DiscoveryV1().EndpointSlices("default").Update(ctx, updated, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	// verify
+	assert.Eventually(t, func() bool {
+		return assert.ObjectsAreEqual([]string{"10.0.0.1:4317", "10.0.0.3:4317"}, current())
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// test: the slice is removed
+	require.NoError(t, client.DiscoveryV1().EndpointSlices("default").Delete(ctx, slice.Name, metav1.DeleteOptions{}))
+
+	// verify
+	assert.Eventually(t, func() bool {
+		return len(current()) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestK8sResolverShutdownStopsCallbacks(t *testing.T) {
+	// prepare
+	res := newK8sResolverWithClient(zap.NewNop(), fake.NewSimpleClientset(), &K8sSvcResolver{Service: "collector"})
+	calls := atomic.NewInt32(0)
+	res.onChange(func([]string) {
+		calls.Inc()
+	})
+	require.NoError(t, res.start(context.Background()))
+
+	// test
+	require.NoError(t, res.shutdown(context.Background()))
+	res.endpoints = []string{"10.0.0.1:4317"}
+	_, err := res.resolve(context.Background())
+
+	// verify
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), calls.Load())
+}
+
+func endpointSlice(name, namespace, service string, port int32, endpoints ...discoveryv1.Endpoint) *discoveryv1.EndpointSlice {
+	return &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    map[string]string{discoveryv1.LabelServiceName: service},
+		},
+		AddressType: discoveryv1.AddressTypeIPv4,
+		Endpoints:   endpoints,
+		Ports:       []discoveryv1.EndpointPort{{Port: &port}},
+	}
+}
+
+func endpoint(address string, ready *bool) discoveryv1.Endpoint {
+	return discoveryv1.Endpoint{
+		Addresses:  []string{address},
+		Conditions: discoveryv1.EndpointConditions{Ready: ready},
+	}
+}
+
+func ptrBool(b bool) *bool {
+	return &b
+}
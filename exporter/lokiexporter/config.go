@@ -50,18 +50,46 @@ type Config struct {
 	// Deprecated: [v0.57.0] use the attribute processor to add a `loki.tenant` hint.
 	// See this component's documentation for more information on how to specify the hint.
 	Tenant *Tenant `mapstructure:"tenant"`
+
+	// Protocol is the Loki API the logs are sent to.
+	// Options:
+	// - push[default]: the Loki push API, e.g. `http://loki:3100/loki/api/v1/push`.
+	// - otlp: the native OTLP endpoint of Loki 3.x, e.g. `http://loki:3100/otlp/v1/logs`.
+	Protocol string `mapstructure:"protocol"`
+
+	// StructuredMetadata sends the attributes which are not promoted to labels as structured
+	// metadata, supported by Loki 2.9 and later, instead of encoding them into the log line.
+	// Only applies to the push protocol.
+	StructuredMetadata bool `mapstructure:"structured_metadata"`
 }
 
+const (
+	protocolPush = "push"
+	protocolOTLP = "otlp"
+)
+
 func (c *Config) Validate() error {
 	if _, err := url.Parse(c.Endpoint); c.Endpoint == "" || err != nil {
 		return fmt.Errorf("\"endpoint\" must be a valid URL")
 	}
 
+	if c.Protocol != "" && c.Protocol != protocolPush && c.Protocol != protocolOTLP {
+		return fmt.Errorf("invalid protocol, must be one of '%s', '%s', but is %s", protocolPush, protocolOTLP, c.Protocol)
+	}
+
+	if c.Protocol == protocolOTLP && c.StructuredMetadata {
+		return fmt.Errorf("structured_metadata only applies to the '%s' protocol", protocolPush)
+	}
+
 	// further validation is needed only if we are in legacy mode
 	if !c.isLegacy() {
 		return nil
 	}
 
+	if c.Protocol == protocolOTLP || c.StructuredMetadata {
+		return fmt.Errorf("protocol and structured_metadata cannot be used together with deprecated settings")
+	}
+
 	if c.Tenant != nil {
 		if c.Tenant.Source != "attributes" && c.Tenant.Source != "context" && c.Tenant.Source != "static" {
 			return fmt.Errorf("invalid tenant source, must be one of 'attributes', 'context', 'static', but is %s", c.Tenant.Source)
@@ -162,6 +162,49 @@ func TestIsLegacy(t *testing.T) {
 	}
 }
 
+func TestValidateProtocol(t *testing.T) {
+	testCases := []struct {
+		desc string
+		cfg  *Config
+		err  string
+	}{
+		{
+			desc: "otlp protocol",
+			cfg:  &Config{Protocol: protocolOTLP},
+		},
+		{
+			desc: "structured metadata",
+			cfg:  &Config{Protocol: protocolPush, StructuredMetadata: true},
+		},
+		{
+			desc: "invalid protocol",
+			cfg:  &Config{Protocol: "grpc"},
+			err:  "invalid protocol, must be one of 'push', 'otlp', but is grpc",
+		},
+		{
+			desc: "structured metadata with otlp protocol",
+			cfg:  &Config{Protocol: protocolOTLP, StructuredMetadata: true},
+			err:  "structured_metadata only applies to the 'push' protocol",
+		},
+		{
+			desc: "structured metadata with deprecated settings",
+			cfg:  &Config{StructuredMetadata: true, TenantID: stringp("acme")},
+			err:  "protocol and structured_metadata cannot be used together with deprecated settings",
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			tC.cfg.Endpoint = "https://loki.example.com"
+			err := tC.cfg.Validate()
+			if tC.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tC.err)
+			}
+		})
+	}
+}
+
 func stringp(str string) *string {
 	return &str
 }
@@ -18,6 +18,7 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -26,6 +27,7 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
@@ -49,6 +51,19 @@ func newNextExporter(config *Config, settings component.TelemetrySettings) *next
 }
 
 func (l *nextLokiExporter) pushLogData(ctx context.Context, ld plog.Logs) error {
+	if l.config.Protocol == protocolOTLP {
+		return l.sendOTLPRequest(ctx, ld)
+	}
+
+	if l.config.StructuredMetadata {
+		var errs error
+		for tenant, request := range loki.LogsToLokiStructuredRequests(ld) {
+			err := l.sendStructuredPushRequest(ctx, tenant, request, ld)
+			errs = multierr.Append(errs, err)
+		}
+		return errs
+	}
+
 	requests := loki.LogsToLokiRequests(ld)
 
 	var errs error
@@ -79,6 +94,36 @@ func (l *nextLokiExporter) sendPushRequest(ctx context.Context, tenant string, r
 		return consumererror.NewPermanent(err)
 	}
 
+	return l.post(ctx, tenant, buf, "application/x-protobuf", ld)
+}
+
+// sendStructuredPushRequest sends the request with its structured metadata to the push API,
+// which only accepts structured metadata in its JSON form.
+func (l *nextLokiExporter) sendStructuredPushRequest(ctx context.Context, tenant string, request loki.StructuredPushRequest, ld plog.Logs) error {
+	if len(request.Streams) == 0 {
+		return consumererror.NewPermanent(fmt.Errorf("failed to transform logs into Loki log streams"))
+	}
+
+	buf, err := json.Marshal(request)
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+
+	return l.post(ctx, tenant, buf, "application/json", ld)
+}
+
+// sendOTLPRequest sends the logs as they are to the native OTLP endpoint of Loki, which
+// maps them to streams and structured metadata itself.
+func (l *nextLokiExporter) sendOTLPRequest(ctx context.Context, ld plog.Logs) error {
+	buf, err := plogotlp.NewExportRequestFromLogs(ld).MarshalProto()
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+
+	return l.post(ctx, "", buf, "application/x-protobuf", ld)
+}
+
+func (l *nextLokiExporter) post(ctx context.Context, tenant string, buf []byte, contentType string, ld plog.Logs) error {
 	req, err := http.NewRequestWithContext(ctx, "POST", l.config.HTTPClientSettings.Endpoint, bytes.NewReader(buf))
 	if err != nil {
 		return consumererror.NewPermanent(err)
@@ -87,7 +132,7 @@ func (l *nextLokiExporter) sendPushRequest(ctx context.Context, tenant string, r
 	for k, v := range l.config.HTTPClientSettings.Headers {
 		req.Header.Set(k, v)
 	}
-	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("Content-Type", contentType)
 	if len(tenant) > 0 {
 		req.Header.Set("X-Scope-OrgID", tenant)
 	}
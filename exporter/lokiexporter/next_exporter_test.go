@@ -16,6 +16,7 @@ package lokiexporter // import "github.com/open-telemetry/opentelemetry-collecto
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -30,6 +31,7 @@ import (
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
 )
 
 func TestPushLogData(t *testing.T) {
@@ -134,6 +136,86 @@ func TestPushLogData(t *testing.T) {
 	}
 }
 
+func TestPushLogDataStructuredMetadata(t *testing.T) {
+	var contentType string
+	var actualPushRequest map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&actualPushRequest))
+	}))
+	defer ts.Close()
+
+	cfg := &Config{
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: ts.URL,
+		},
+		StructuredMetadata: true,
+	}
+
+	f := NewFactory()
+	exp, err := f.CreateLogsExporter(context.Background(), componenttest.NewNopExporterCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+
+	ld := plog.NewLogs()
+	lr := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.SetTimestamp(1)
+	lr.Body().SetStr("payment declined")
+	lr.Attributes().PutStr("host.name", "guarana")
+	lr.Attributes().PutInt("http.status", 402)
+	lr.Attributes().PutStr("loki.attribute.labels", "host.name")
+
+	require.NoError(t, exp.ConsumeLogs(context.Background(), ld))
+	assert.Equal(t, "application/json", contentType)
+	assert.Equal(t, map[string]interface{}{
+		"streams": []interface{}{
+			map[string]interface{}{
+				"stream": map[string]interface{}{"exporter": "OTLP", "host.name": "guarana"},
+				"values": []interface{}{
+					[]interface{}{"1", "payment declined", map[string]interface{}{"http_status": "402"}},
+				},
+			},
+		},
+	}, actualPushRequest)
+
+	assert.NoError(t, exp.Shutdown(context.Background()))
+}
+
+func TestPushLogDataOTLP(t *testing.T) {
+	var contentType string
+	actualRequest := plogotlp.NewExportRequest()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		payload, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.NoError(t, actualRequest.UnmarshalProto(payload))
+	}))
+	defer ts.Close()
+
+	cfg := &Config{
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: ts.URL,
+		},
+		Protocol: protocolOTLP,
+	}
+
+	f := NewFactory()
+	exp, err := f.CreateLogsExporter(context.Background(), componenttest.NewNopExporterCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "checkout")
+	rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("payment declined")
+
+	require.NoError(t, exp.ConsumeLogs(context.Background(), ld))
+	assert.Equal(t, "application/x-protobuf", contentType)
+	assert.Equal(t, ld, actualRequest.Logs())
+
+	assert.NoError(t, exp.Shutdown(context.Background()))
+}
+
 func TestLogsToLokiRequestWithGroupingByTenant(t *testing.T) {
 	tests := []struct {
 		desc     string
@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opensearchexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/opensearchexporter"
+
+import (
+	"errors"
+	"net/url"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+)
+
+const (
+	// defaultTracesIndex is the alias of the span indexes created by Data Prepper,
+	// which the Trace Analytics plugin of OpenSearch Dashboards reads from.
+	defaultTracesIndex = "otel-v1-apm-span"
+	// defaultLogsIndex is the index of the logs written by the Data Prepper examples.
+	defaultLogsIndex = "logs-otel-v1"
+)
+
+// Config defines configuration for the OpenSearch exporter.
+type Config struct {
+	config.ExporterSettings       `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
+	confighttp.HTTPClientSettings `mapstructure:",squash"`
+	exporterhelper.QueueSettings  `mapstructure:"sending_queue"`
+	exporterhelper.RetrySettings  `mapstructure:"retry_on_failure"`
+
+	// TracesIndex is the index or alias the spans are written to.
+	TracesIndex string `mapstructure:"traces_index"`
+
+	// LogsIndex is the index or alias the log records are written to.
+	LogsIndex string `mapstructure:"logs_index"`
+}
+
+var _ component.ExporterConfig = (*Config)(nil)
+
+// Validate checks if the exporter configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.Endpoint == "" {
+		return errors.New("endpoint must be specified")
+	}
+	u, err := url.Parse(cfg.Endpoint)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return errors.New("endpoint must be a valid URL, e.g. https://opensearch:9200")
+	}
+	if cfg.TracesIndex == "" {
+		return errors.New("traces_index must not be empty")
+	}
+	if cfg.LogsIndex == "" {
+		return errors.New("logs_index must not be empty")
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opensearchexporter
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id           component.ID
+		expected     component.ExporterConfig
+		errorMessage string
+	}{
+		{
+			id:           component.NewIDWithName(typeStr, ""),
+			expected:     createDefaultConfig(),
+			errorMessage: "endpoint must be specified",
+		},
+		{
+			id: component.NewIDWithName(typeStr, "trace"),
+			expected: &Config{
+				ExporterSettings: config.NewExporterSettings(component.NewID(typeStr)),
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "https://opensearch.example.com:9200",
+					Timeout:  2 * time.Minute,
+					Headers: map[string]string{
+						"myheader": "test",
+					},
+				},
+				QueueSettings: exporterhelper.QueueSettings{
+					Enabled:      true,
+					NumConsumers: 2,
+					QueueSize:    10,
+				},
+				RetrySettings: exporterhelper.RetrySettings{
+					Enabled:         true,
+					InitialInterval: 10 * time.Second,
+					MaxInterval:     1 * time.Minute,
+					MaxElapsedTime:  10 * time.Minute,
+				},
+				TracesIndex: "otel-v1-apm-span-custom",
+				LogsIndex:   "logs-otel-v1-custom",
+			},
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "invalid_endpoint"),
+			errorMessage: "endpoint must be a valid URL, e.g. https://opensearch:9200",
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "empty_traces_index"),
+			errorMessage: "traces_index must not be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalExporterConfig(sub, cfg))
+
+			if tt.errorMessage != "" {
+				assert.EqualError(t, cfg.Validate(), tt.errorMessage)
+			} else {
+				assert.NoError(t, cfg.Validate())
+			}
+			if tt.expected != nil {
+				assert.Equal(t, tt.expected, cfg)
+			}
+		})
+	}
+}
@@ -0,0 +1,312 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opensearchexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/opensearchexporter"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+type openSearchExporter struct {
+	config   *Config
+	settings component.TelemetrySettings
+	client   *http.Client
+	bulkURL  string
+}
+
+func newOpenSearchExporter(cfg *Config, set component.ExporterCreateSettings) *openSearchExporter {
+	return &openSearchExporter{
+		config:   cfg,
+		settings: set.TelemetrySettings,
+		bulkURL:  strings.TrimSuffix(cfg.Endpoint, "/") + "/_bulk",
+	}
+}
+
+func (e *openSearchExporter) start(_ context.Context, host component.Host) error {
+	client, err := e.config.HTTPClientSettings.ToClient(host, e.settings)
+	if err != nil {
+		return err
+	}
+	e.client = client
+	return nil
+}
+
+func (e *openSearchExporter) pushTraces(ctx context.Context, td ptrace.Traces) error {
+	groups := traceGroups(td)
+
+	var docs [][]byte
+	var encodeErrs error
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		sss := rs.ScopeSpans()
+		for j := 0; j < sss.Len(); j++ {
+			ss := sss.At(j)
+			spans := ss.Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				doc, err := encodeSpan(rs.Resource(), ss.Scope(), span, groups[span.TraceID()])
+				if err != nil {
+					encodeErrs = multierr.Append(encodeErrs, err)
+					doc = nil
+				}
+				docs = append(docs, doc)
+			}
+		}
+	}
+
+	failed, err := e.bulk(ctx, e.config.TracesIndex, docs)
+	if err != nil {
+		return err
+	}
+	if len(failed) > 0 {
+		return consumererror.NewTraces(
+			fmt.Errorf("failed to index %d spans", len(failed)),
+			filterTraces(td, failed))
+	}
+	if encodeErrs != nil {
+		return consumererror.NewPermanent(encodeErrs)
+	}
+	return nil
+}
+
+func (e *openSearchExporter) pushLogs(ctx context.Context, ld plog.Logs) error {
+	var docs [][]byte
+	var encodeErrs error
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		sls := rl.ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			sl := sls.At(j)
+			records := sl.LogRecords()
+			for k := 0; k < records.Len(); k++ {
+				doc, err := encodeLog(rl.Resource(), sl.Scope(), records.At(k))
+				if err != nil {
+					encodeErrs = multierr.Append(encodeErrs, err)
+					doc = nil
+				}
+				docs = append(docs, doc)
+			}
+		}
+	}
+
+	failed, err := e.bulk(ctx, e.config.LogsIndex, docs)
+	if err != nil {
+		return err
+	}
+	if len(failed) > 0 {
+		return consumererror.NewLogs(
+			fmt.Errorf("failed to index %d log records", len(failed)),
+			filterLogs(ld, failed))
+	}
+	if encodeErrs != nil {
+		return consumererror.NewPermanent(encodeErrs)
+	}
+	return nil
+}
+
+// bulkResponse is the part of the bulk API response the exporter uses.
+type bulkResponse struct {
+	Errors bool `json:"errors"`
+	Items  []map[string]struct {
+		Status int `json:"status"`
+		Error  struct {
+			Type   string `json:"type"`
+			Reason string `json:"reason"`
+		} `json:"error"`
+	} `json:"items"`
+}
+
+// bulk writes the documents to the index with the bulk API, skipping nil documents.
+// It returns the positions of the documents which failed with a status a retry may
+// fix. Documents rejected for other reasons, e.g. mapping conflicts, are dropped and
+// logged.
+func (e *openSearchExporter) bulk(ctx context.Context, index string, docs [][]byte) (map[int]bool, error) {
+	action, err := json.Marshal(map[string]interface{}{"create": map[string]string{"_index": index}})
+	if err != nil {
+		return nil, consumererror.NewPermanent(err)
+	}
+
+	var body bytes.Buffer
+	// positions maps the items of the response to the documents.
+	positions := make([]int, 0, len(docs))
+	for i, doc := range docs {
+		if doc == nil {
+			continue
+		}
+		body.Write(action)
+		body.WriteByte('\n')
+		body.Write(doc)
+		body.WriteByte('\n')
+		positions = append(positions, i)
+	}
+	if len(positions) == 0 {
+		return nil, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.bulkURL, &body)
+	if err != nil {
+		return nil, consumererror.NewPermanent(err)
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send bulk request: %w", err)
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		err = fmt.Errorf("bulk request failed with status %d: %s", resp.StatusCode, respBody)
+		if isRetryableStatus(resp.StatusCode) {
+			return nil, err
+		}
+		return nil, consumererror.NewPermanent(err)
+	}
+
+	var result bulkResponse
+	if err = json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, consumererror.NewPermanent(fmt.Errorf("failed to decode bulk response: %w", err))
+	}
+	if !result.Errors {
+		return nil, nil
+	}
+	if len(result.Items) != len(positions) {
+		return nil, consumererror.NewPermanent(fmt.Errorf("bulk response has %d items for %d documents", len(result.Items), len(positions)))
+	}
+
+	failed := map[int]bool{}
+	for i, item := range result.Items {
+		for _, res := range item {
+			if res.Status < 300 {
+				continue
+			}
+			if isRetryableStatus(res.Status) {
+				failed[positions[i]] = true
+				continue
+			}
+			e.settings.Logger.Warn("Document dropped by OpenSearch",
+				zap.String("index", index),
+				zap.Int("status", res.Status),
+				zap.String("error_type", res.Error.Type),
+				zap.String("error_reason", res.Error.Reason))
+		}
+	}
+	return failed, nil
+}
+
+func isRetryableStatus(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// filterTraces returns the spans at the given positions, in the order they are
+// iterated over.
+func filterTraces(td ptrace.Traces, positions map[int]bool) ptrace.Traces {
+	filtered := ptrace.NewTraces()
+	pos := 0
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		var frs ptrace.ResourceSpans
+		hasResource := false
+		sss := rs.ScopeSpans()
+		for j := 0; j < sss.Len(); j++ {
+			ss := sss.At(j)
+			var fss ptrace.ScopeSpans
+			hasScope := false
+			spans := ss.Spans()
+			for k := 0; k < spans.Len(); k++ {
+				if positions[pos] {
+					if !hasResource {
+						hasResource = true
+						frs = filtered.ResourceSpans().AppendEmpty()
+						rs.Resource().CopyTo(frs.Resource())
+						frs.SetSchemaUrl(rs.SchemaUrl())
+					}
+					if !hasScope {
+						hasScope = true
+						fss = frs.ScopeSpans().AppendEmpty()
+						ss.Scope().CopyTo(fss.Scope())
+						fss.SetSchemaUrl(ss.SchemaUrl())
+					}
+					spans.At(k).CopyTo(fss.Spans().AppendEmpty())
+				}
+				pos++
+			}
+		}
+	}
+	return filtered
+}
+
+// filterLogs returns the log records at the given positions, in the order they are
+// iterated over.
+func filterLogs(ld plog.Logs, positions map[int]bool) plog.Logs {
+	filtered := plog.NewLogs()
+	pos := 0
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		var frl plog.ResourceLogs
+		hasResource := false
+		sls := rl.ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			sl := sls.At(j)
+			var fsl plog.ScopeLogs
+			hasScope := false
+			records := sl.LogRecords()
+			for k := 0; k < records.Len(); k++ {
+				if positions[pos] {
+					if !hasResource {
+						hasResource = true
+						frl = filtered.ResourceLogs().AppendEmpty()
+						rl.Resource().CopyTo(frl.Resource())
+						frl.SetSchemaUrl(rl.SchemaUrl())
+					}
+					if !hasScope {
+						hasScope = true
+						fsl = frl.ScopeLogs().AppendEmpty()
+						sl.Scope().CopyTo(fsl.Scope())
+						fsl.SetSchemaUrl(sl.SchemaUrl())
+					}
+					records.At(k).CopyTo(fsl.LogRecords().AppendEmpty())
+				}
+				pos++
+			}
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,237 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opensearchexporter
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+type bulkItem struct {
+	action map[string]map[string]string
+	doc    map[string]interface{}
+}
+
+// bulkServer is an OpenSearch bulk API answering each document with the status
+// returned by statusFn.
+type bulkServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests [][]bulkItem
+}
+
+func newBulkServer(t *testing.T, statusFn func(doc map[string]interface{}) int) *bulkServer {
+	s := &bulkServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/_bulk", r.URL.Path)
+		assert.Equal(t, "application/x-ndjson", r.Header.Get("Content-Type"))
+
+		var items []bulkItem
+		scanner := bufio.NewScanner(r.Body)
+		scanner.Buffer(nil, 1024*1024)
+		for scanner.Scan() {
+			var item bulkItem
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &item.action))
+			require.True(t, scanner.Scan())
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &item.doc))
+			items = append(items, item)
+		}
+		s.mu.Lock()
+		s.requests = append(s.requests, items)
+		s.mu.Unlock()
+
+		resp := map[string]interface{}{"errors": false}
+		var respItems []interface{}
+		for _, item := range items {
+			status := statusFn(item.doc)
+			res := map[string]interface{}{"status": status}
+			if status >= 300 {
+				resp["errors"] = true
+				res["error"] = map[string]string{"type": "mapper_parsing_exception", "reason": "failed to parse"}
+			}
+			respItems = append(respItems, map[string]interface{}{"create": res})
+		}
+		resp["items"] = respItems
+		assert.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func newTestExporter(t *testing.T, endpoint string) *openSearchExporter {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = endpoint
+	exp := newOpenSearchExporter(cfg, componenttest.NewNopExporterCreateSettings())
+	require.NoError(t, exp.start(context.Background(), componenttest.NewNopHost()))
+	return exp
+}
+
+func newTestTraces() ptrace.Traces {
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "checkout")
+	spans := rs.ScopeSpans().AppendEmpty().Spans()
+
+	root := spans.AppendEmpty()
+	root.SetTraceID(testTraceID)
+	root.SetSpanID(testSpanID)
+	root.SetName("GET /checkout")
+
+	child := spans.AppendEmpty()
+	child.SetTraceID(testTraceID)
+	child.SetSpanID(pcommon.SpanID([8]byte{2, 2, 2, 2, 2, 2, 2, 2}))
+	child.SetParentSpanID(testSpanID)
+	child.SetName("SELECT carts")
+
+	rs = td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "payment")
+	span := rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.SetTraceID(testTraceID)
+	span.SetSpanID(pcommon.SpanID([8]byte{3, 3, 3, 3, 3, 3, 3, 3}))
+	span.SetParentSpanID(testSpanID)
+	span.SetName("charge")
+	return td
+}
+
+func TestPushTraces(t *testing.T) {
+	server := newBulkServer(t, func(map[string]interface{}) int { return http.StatusCreated })
+	exp := newTestExporter(t, server.URL)
+
+	require.NoError(t, exp.pushTraces(context.Background(), newTestTraces()))
+
+	require.Len(t, server.requests, 1)
+	items := server.requests[0]
+	require.Len(t, items, 3)
+	var names []string
+	for _, item := range items {
+		assert.Equal(t, map[string]map[string]string{"create": {"_index": defaultTracesIndex}}, item.action)
+		names = append(names, item.doc["name"].(string))
+		// all the spans get the trace group of the root span
+		assert.Equal(t, "GET /checkout", item.doc["traceGroup"])
+	}
+	assert.Equal(t, []string{"GET /checkout", "SELECT carts", "charge"}, names)
+	assert.Equal(t, "payment", items[2].doc["serviceName"])
+}
+
+func TestPushTracesPartialFailure(t *testing.T) {
+	server := newBulkServer(t, func(doc map[string]interface{}) int {
+		switch doc["name"] {
+		case "charge":
+			return http.StatusTooManyRequests
+		case "SELECT carts":
+			return http.StatusBadRequest
+		}
+		return http.StatusCreated
+	})
+	exp := newTestExporter(t, server.URL)
+
+	err := exp.pushTraces(context.Background(), newTestTraces())
+	require.Error(t, err)
+	assert.False(t, consumererror.IsPermanent(err))
+
+	// only the span rejected with a retryable status is retried
+	var tracesErr consumererror.Traces
+	require.True(t, errors.As(err, &tracesErr))
+	retried := tracesErr.GetTraces()
+	require.Equal(t, 1, retried.SpanCount())
+	rs := retried.ResourceSpans().At(0)
+	serviceName, _ := rs.Resource().Attributes().Get("service.name")
+	assert.Equal(t, "payment", serviceName.Str())
+	assert.Equal(t, "charge", rs.ScopeSpans().At(0).Spans().At(0).Name())
+}
+
+func TestPushLogs(t *testing.T) {
+	server := newBulkServer(t, func(doc map[string]interface{}) int {
+		if doc["body"] == "retry" {
+			return http.StatusServiceUnavailable
+		}
+		return http.StatusCreated
+	})
+	exp := newTestExporter(t, server.URL)
+
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "checkout")
+	records := rl.ScopeLogs().AppendEmpty().LogRecords()
+	records.AppendEmpty().Body().SetStr("first")
+	records.AppendEmpty().Body().SetStr("retry")
+
+	err := exp.pushLogs(context.Background(), ld)
+	require.Error(t, err)
+
+	require.Len(t, server.requests, 1)
+	items := server.requests[0]
+	require.Len(t, items, 2)
+	assert.Equal(t, map[string]map[string]string{"create": {"_index": defaultLogsIndex}}, items[0].action)
+	assert.Equal(t, "first", items[0].doc["body"])
+	assert.Equal(t, "checkout", items[0].doc["serviceName"])
+
+	var logsErr consumererror.Logs
+	require.True(t, errors.As(err, &logsErr))
+	retried := logsErr.GetLogs()
+	require.Equal(t, 1, retried.LogRecordCount())
+	assert.Equal(t, "retry", retried.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().Str())
+}
+
+func TestPushTracesRequestFailure(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		permanent bool
+	}{
+		{
+			name:   "retryable",
+			status: http.StatusServiceUnavailable,
+		},
+		{
+			name:      "permanent",
+			status:    http.StatusUnauthorized,
+			permanent: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+			exp := newTestExporter(t, server.URL)
+
+			err := exp.pushTraces(context.Background(), newTestTraces())
+			require.Error(t, err)
+			assert.Equal(t, tt.permanent, consumererror.IsPermanent(err))
+		})
+	}
+}
+
+func TestPushTracesEmpty(t *testing.T) {
+	exp := newTestExporter(t, "http://localhost:1")
+	assert.NoError(t, exp.pushTraces(context.Background(), ptrace.NewTraces()))
+}
@@ -0,0 +1,218 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opensearchexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/opensearchexporter"
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/traceutil"
+)
+
+// The documents follow the mappings of the Data Prepper otel-v1-apm-span index
+// template, and of the log documents of its OpenSearch sink, so that the Trace
+// Analytics and Observability plugins of OpenSearch Dashboards can read them.
+const (
+	spanAttributesPrefix     = "span.attributes."
+	logAttributesPrefix      = "log.attributes."
+	resourceAttributesPrefix = "resource.attributes."
+)
+
+// traceGroup describes the root span of a trace. Data Prepper copies it to all the
+// spans of the trace, so that they can be filtered by the operation of their trace.
+type traceGroup struct {
+	name       string
+	endTime    string
+	duration   int64
+	statusCode int
+}
+
+// traceGroups returns the trace groups of the traces whose root span is in the batch.
+func traceGroups(td ptrace.Traces) map[pcommon.TraceID]*traceGroup {
+	groups := map[pcommon.TraceID]*traceGroup{}
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		sss := rss.At(i).ScopeSpans()
+		for j := 0; j < sss.Len(); j++ {
+			spans := sss.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				if !span.ParentSpanID().IsEmpty() {
+					continue
+				}
+				groups[span.TraceID()] = &traceGroup{
+					name:       span.Name(),
+					endTime:    formatTimestamp(span.EndTimestamp()),
+					duration:   spanDuration(span),
+					statusCode: int(span.Status().Code()),
+				}
+			}
+		}
+	}
+	return groups
+}
+
+// encodeSpan encodes a span as a Data Prepper span document. The trace group is
+// only set when the root span of the trace is known.
+func encodeSpan(resource pcommon.Resource, scope pcommon.InstrumentationScope, span ptrace.Span, group *traceGroup) ([]byte, error) {
+	doc := map[string]interface{}{
+		"traceId":                span.TraceID().HexString(),
+		"spanId":                 span.SpanID().HexString(),
+		"parentSpanId":           span.ParentSpanID().HexString(),
+		"traceState":             span.TraceState().AsRaw(),
+		"name":                   span.Name(),
+		"kind":                   traceutil.SpanKindStr(span.Kind()),
+		"startTime":              formatTimestamp(span.StartTimestamp()),
+		"endTime":                formatTimestamp(span.EndTimestamp()),
+		"durationInNanos":        spanDuration(span),
+		"serviceName":            serviceName(resource),
+		"events":                 encodeEvents(span.Events()),
+		"links":                  encodeLinks(span.Links()),
+		"droppedAttributesCount": span.DroppedAttributesCount(),
+		"droppedEventsCount":     span.DroppedEventsCount(),
+		"droppedLinksCount":      span.DroppedLinksCount(),
+		"status": map[string]interface{}{
+			"code":    int(span.Status().Code()),
+			"message": span.Status().Message(),
+		},
+		"instrumentationScope": encodeScope(scope),
+	}
+	if group != nil {
+		doc["traceGroup"] = group.name
+		doc["traceGroupFields"] = map[string]interface{}{
+			"endTime":         group.endTime,
+			"durationInNanos": group.duration,
+			"statusCode":      group.statusCode,
+		}
+	}
+	addFlattenedAttributes(doc, spanAttributesPrefix, span.Attributes())
+	addFlattenedAttributes(doc, resourceAttributesPrefix, resource.Attributes())
+	return json.Marshal(doc)
+}
+
+// encodeLog encodes a log record as a Data Prepper log document.
+func encodeLog(resource pcommon.Resource, scope pcommon.InstrumentationScope, record plog.LogRecord) ([]byte, error) {
+	doc := map[string]interface{}{
+		"traceId":                record.TraceID().HexString(),
+		"spanId":                 record.SpanID().HexString(),
+		"time":                   formatTimestamp(record.Timestamp()),
+		"observedTime":           formatTimestamp(record.ObservedTimestamp()),
+		"severityText":           record.SeverityText(),
+		"severityNumber":         int32(record.SeverityNumber()),
+		"body":                   record.Body().AsString(),
+		"flags":                  uint32(record.Flags()),
+		"droppedAttributesCount": record.DroppedAttributesCount(),
+		"serviceName":            serviceName(resource),
+		"instrumentationScope":   encodeScope(scope),
+	}
+	addFlattenedAttributes(doc, logAttributesPrefix, record.Attributes())
+	addFlattenedAttributes(doc, resourceAttributesPrefix, resource.Attributes())
+	return json.Marshal(doc)
+}
+
+func encodeEvents(events ptrace.SpanEventSlice) []interface{} {
+	encoded := make([]interface{}, 0, events.Len())
+	for i := 0; i < events.Len(); i++ {
+		event := events.At(i)
+		encoded = append(encoded, map[string]interface{}{
+			"name":                   event.Name(),
+			"time":                   formatTimestamp(event.Timestamp()),
+			"attributes":             encodeAttributes(event.Attributes()),
+			"droppedAttributesCount": event.DroppedAttributesCount(),
+		})
+	}
+	return encoded
+}
+
+func encodeLinks(links ptrace.SpanLinkSlice) []interface{} {
+	encoded := make([]interface{}, 0, links.Len())
+	for i := 0; i < links.Len(); i++ {
+		link := links.At(i)
+		encoded = append(encoded, map[string]interface{}{
+			"traceId":                link.TraceID().HexString(),
+			"spanId":                 link.SpanID().HexString(),
+			"traceState":             link.TraceState().AsRaw(),
+			"attributes":             encodeAttributes(link.Attributes()),
+			"droppedAttributesCount": link.DroppedAttributesCount(),
+		})
+	}
+	return encoded
+}
+
+func encodeScope(scope pcommon.InstrumentationScope) map[string]interface{} {
+	return map[string]interface{}{
+		"name":    scope.Name(),
+		"version": scope.Version(),
+	}
+}
+
+func encodeAttributes(attrs pcommon.Map) map[string]interface{} {
+	encoded := make(map[string]interface{}, attrs.Len())
+	attrs.Range(func(k string, v pcommon.Value) bool {
+		encoded[k] = attributeValue(v)
+		return true
+	})
+	return encoded
+}
+
+// addFlattenedAttributes adds the attributes as top level fields, replacing the dots
+// of their names with `@` like Data Prepper, so that they are not mapped as objects.
+func addFlattenedAttributes(doc map[string]interface{}, prefix string, attrs pcommon.Map) {
+	attrs.Range(func(k string, v pcommon.Value) bool {
+		doc[prefix+strings.ReplaceAll(k, ".", "@")] = attributeValue(v)
+		return true
+	})
+}
+
+// attributeValue returns the value of an attribute. Maps, slices and bytes are
+// encoded as strings, like Data Prepper does, as the index templates map attributes
+// to scalar types.
+func attributeValue(v pcommon.Value) interface{} {
+	switch v.Type() {
+	case pcommon.ValueTypeStr:
+		return v.Str()
+	case pcommon.ValueTypeBool:
+		return v.Bool()
+	case pcommon.ValueTypeInt:
+		return v.Int()
+	case pcommon.ValueTypeDouble:
+		return v.Double()
+	case pcommon.ValueTypeEmpty:
+		return nil
+	default:
+		return v.AsString()
+	}
+}
+
+func serviceName(resource pcommon.Resource) string {
+	if name, ok := resource.Attributes().Get(conventions.AttributeServiceName); ok {
+		return name.AsString()
+	}
+	return ""
+}
+
+func spanDuration(span ptrace.Span) int64 {
+	return int64(span.EndTimestamp() - span.StartTimestamp())
+}
+
+func formatTimestamp(ts pcommon.Timestamp) string {
+	return ts.AsTime().UTC().Format(time.RFC3339Nano)
+}
@@ -0,0 +1,194 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opensearchexporter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+var (
+	testTraceID = pcommon.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	testSpanID  = pcommon.SpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8})
+	testStart   = time.Date(2022, 11, 1, 10, 0, 0, 0, time.UTC)
+)
+
+func newTestResource() pcommon.Resource {
+	resource := pcommon.NewResource()
+	resource.Attributes().PutStr("service.name", "checkout")
+	resource.Attributes().PutStr("host.name", "node-1")
+	return resource
+}
+
+func newTestScope() pcommon.InstrumentationScope {
+	scope := pcommon.NewInstrumentationScope()
+	scope.SetName("io.opentelemetry.http")
+	scope.SetVersion("1.2.0")
+	return scope
+}
+
+func TestEncodeSpan(t *testing.T) {
+	span := ptrace.NewSpan()
+	span.SetTraceID(testTraceID)
+	span.SetSpanID(testSpanID)
+	span.SetParentSpanID(pcommon.SpanID([8]byte{8, 7, 6, 5, 4, 3, 2, 1}))
+	span.SetName("GET /cart")
+	span.SetKind(ptrace.SpanKindServer)
+	span.SetStartTimestamp(pcommon.NewTimestampFromTime(testStart))
+	span.SetEndTimestamp(pcommon.NewTimestampFromTime(testStart.Add(1500 * time.Millisecond)))
+	span.Status().SetCode(ptrace.StatusCodeError)
+	span.Status().SetMessage("not found")
+	span.Attributes().PutStr("http.method", "GET")
+	span.Attributes().PutInt("http.status_code", 404)
+	span.Attributes().PutEmptySlice("tags").AppendEmpty().SetStr("a")
+	event := span.Events().AppendEmpty()
+	event.SetName("exception")
+	event.SetTimestamp(pcommon.NewTimestampFromTime(testStart.Add(time.Second)))
+	event.Attributes().PutStr("exception.type", "NotFound")
+	link := span.Links().AppendEmpty()
+	link.SetTraceID(testTraceID)
+	link.SetSpanID(pcommon.SpanID([8]byte{2, 2, 2, 2, 2, 2, 2, 2}))
+
+	group := &traceGroup{
+		name:       "GET /checkout",
+		endTime:    "2022-11-01T10:00:02Z",
+		duration:   2000000000,
+		statusCode: 2,
+	}
+
+	doc, err := encodeSpan(newTestResource(), newTestScope(), span, group)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"traceId": "0102030405060708090a0b0c0d0e0f10",
+		"spanId": "0102030405060708",
+		"parentSpanId": "0807060504030201",
+		"traceState": "",
+		"name": "GET /cart",
+		"kind": "SPAN_KIND_SERVER",
+		"startTime": "2022-11-01T10:00:00Z",
+		"endTime": "2022-11-01T10:00:01.5Z",
+		"durationInNanos": 1500000000,
+		"serviceName": "checkout",
+		"events": [{
+			"name": "exception",
+			"time": "2022-11-01T10:00:01Z",
+			"attributes": {"exception.type": "NotFound"},
+			"droppedAttributesCount": 0
+		}],
+		"links": [{
+			"traceId": "0102030405060708090a0b0c0d0e0f10",
+			"spanId": "0202020202020202",
+			"traceState": "",
+			"attributes": {},
+			"droppedAttributesCount": 0
+		}],
+		"droppedAttributesCount": 0,
+		"droppedEventsCount": 0,
+		"droppedLinksCount": 0,
+		"status": {"code": 2, "message": "not found"},
+		"instrumentationScope": {"name": "io.opentelemetry.http", "version": "1.2.0"},
+		"traceGroup": "GET /checkout",
+		"traceGroupFields": {
+			"endTime": "2022-11-01T10:00:02Z",
+			"durationInNanos": 2000000000,
+			"statusCode": 2
+		},
+		"span.attributes.http@method": "GET",
+		"span.attributes.http@status_code": 404,
+		"span.attributes.tags": "[\"a\"]",
+		"resource.attributes.service@name": "checkout",
+		"resource.attributes.host@name": "node-1"
+	}`, string(doc))
+}
+
+func TestEncodeSpanWithoutTraceGroup(t *testing.T) {
+	span := ptrace.NewSpan()
+	span.SetTraceID(testTraceID)
+	span.SetSpanID(testSpanID)
+
+	doc, err := encodeSpan(pcommon.NewResource(), pcommon.NewInstrumentationScope(), span, nil)
+	require.NoError(t, err)
+	assert.NotContains(t, string(doc), "traceGroup")
+	assert.Contains(t, string(doc), `"serviceName":""`)
+}
+
+func TestTraceGroups(t *testing.T) {
+	td := ptrace.NewTraces()
+	spans := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+
+	root := spans.AppendEmpty()
+	root.SetTraceID(testTraceID)
+	root.SetSpanID(testSpanID)
+	root.SetName("GET /checkout")
+	root.SetStartTimestamp(pcommon.NewTimestampFromTime(testStart))
+	root.SetEndTimestamp(pcommon.NewTimestampFromTime(testStart.Add(2 * time.Second)))
+	root.Status().SetCode(ptrace.StatusCodeOk)
+
+	child := spans.AppendEmpty()
+	child.SetTraceID(testTraceID)
+	child.SetSpanID(pcommon.SpanID([8]byte{2, 2, 2, 2, 2, 2, 2, 2}))
+	child.SetParentSpanID(testSpanID)
+
+	orphan := spans.AppendEmpty()
+	orphan.SetTraceID(pcommon.TraceID([16]byte{2}))
+	orphan.SetSpanID(pcommon.SpanID([8]byte{3}))
+	orphan.SetParentSpanID(pcommon.SpanID([8]byte{4}))
+
+	assert.Equal(t, map[pcommon.TraceID]*traceGroup{
+		testTraceID: {
+			name:       "GET /checkout",
+			endTime:    "2022-11-01T10:00:02Z",
+			duration:   2000000000,
+			statusCode: 1,
+		},
+	}, traceGroups(td))
+}
+
+func TestEncodeLog(t *testing.T) {
+	record := plog.NewLogRecord()
+	record.SetTraceID(testTraceID)
+	record.SetSpanID(testSpanID)
+	record.SetTimestamp(pcommon.NewTimestampFromTime(testStart))
+	record.SetObservedTimestamp(pcommon.NewTimestampFromTime(testStart.Add(time.Millisecond)))
+	record.SetSeverityText("ERROR")
+	record.SetSeverityNumber(plog.SeverityNumberError)
+	record.Body().SetStr("cart not found")
+	record.Attributes().PutStr("log.file.name", "app.log")
+
+	doc, err := encodeLog(newTestResource(), newTestScope(), record)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"traceId": "0102030405060708090a0b0c0d0e0f10",
+		"spanId": "0102030405060708",
+		"time": "2022-11-01T10:00:00Z",
+		"observedTime": "2022-11-01T10:00:00.001Z",
+		"severityText": "ERROR",
+		"severityNumber": 17,
+		"body": "cart not found",
+		"flags": 0,
+		"droppedAttributesCount": 0,
+		"serviceName": "checkout",
+		"instrumentationScope": {"name": "io.opentelemetry.http", "version": "1.2.0"},
+		"log.attributes.log@file@name": "app.log",
+		"resource.attributes.service@name": "checkout",
+		"resource.attributes.host@name": "node-1"
+	}`, string(doc))
+}
@@ -0,0 +1,99 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheuspushgatewayexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheuspushgatewayexporter"
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/resourcetotelemetry"
+)
+
+const (
+	// methodPost replaces the pushed metrics with the same name in the group.
+	methodPost = "post"
+	// methodPut replaces all the metrics in the group.
+	methodPut = "put"
+)
+
+// Config defines configuration for the Prometheus Pushgateway exporter.
+type Config struct {
+	config.ExporterSettings       `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
+	confighttp.HTTPClientSettings `mapstructure:",squash"`
+	exporterhelper.QueueSettings  `mapstructure:"sending_queue"`
+	exporterhelper.RetrySettings  `mapstructure:"retry_on_failure"`
+
+	// Job is the value of the job label of the pushed metrics. It may reference
+	// resource attributes as {attribute}.
+	Job string `mapstructure:"job"`
+
+	// GroupingKey are the labels, besides job, identifying the group of the pushed
+	// metrics. Values may reference resource attributes as {attribute}.
+	GroupingKey map[string]string `mapstructure:"grouping_key"`
+
+	// Method is either "post", which replaces the metrics with the same name in the
+	// group, or "put", which replaces all the metrics in the group.
+	Method string `mapstructure:"method"`
+
+	// DeleteOnShutdown deletes the groups pushed by the exporter when it shuts down.
+	DeleteOnShutdown bool `mapstructure:"delete_on_shutdown"`
+
+	// Namespace if set, exports metrics under the provided value.
+	Namespace string `mapstructure:"namespace"`
+
+	// ConstLabels are values that are applied for every exported metric.
+	ConstLabels prometheus.Labels `mapstructure:"const_labels"`
+
+	// ResourceToTelemetrySettings defines configuration for converting resource attributes to metric labels.
+	ResourceToTelemetrySettings resourcetotelemetry.Settings `mapstructure:"resource_to_telemetry_conversion"`
+}
+
+var _ component.ExporterConfig = (*Config)(nil)
+
+// Validate checks if the exporter configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.Endpoint == "" {
+		return errors.New("endpoint must be specified")
+	}
+	if _, err := url.Parse(cfg.Endpoint); err != nil {
+		return fmt.Errorf("invalid endpoint %q: %w", cfg.Endpoint, err)
+	}
+	if strings.TrimSpace(cfg.Job) == "" {
+		return errors.New("job must be specified")
+	}
+	for name := range cfg.GroupingKey {
+		if name == model.JobLabel {
+			return errors.New("grouping_key must not contain job, use the job setting instead")
+		}
+		if !model.LabelName(name).IsValid() || strings.HasPrefix(name, model.ReservedLabelPrefix) {
+			return fmt.Errorf("invalid grouping_key label name %q", name)
+		}
+	}
+	switch cfg.Method {
+	case methodPost, methodPut:
+	default:
+		return fmt.Errorf("method must be either %q or %q, got %q", methodPost, methodPut, cfg.Method)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheuspushgatewayexporter
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/resourcetotelemetry"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id           component.ID
+		expected     component.ExporterConfig
+		errorMessage string
+	}{
+		{
+			id:           component.NewIDWithName(typeStr, ""),
+			expected:     createDefaultConfig(),
+			errorMessage: "endpoint must be specified",
+		},
+		{
+			id: component.NewIDWithName(typeStr, "2"),
+			expected: &Config{
+				ExporterSettings: config.NewExporterSettings(component.NewID(typeStr)),
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "http://pushgateway:9091",
+					Timeout:  5 * time.Second,
+					Headers:  map[string]string{},
+				},
+				QueueSettings: func() exporterhelper.QueueSettings {
+					queue := exporterhelper.NewDefaultQueueSettings()
+					queue.Enabled = false
+					return queue
+				}(),
+				RetrySettings: func() exporterhelper.RetrySettings {
+					retry := exporterhelper.NewDefaultRetrySettings()
+					retry.Enabled = false
+					return retry
+				}(),
+				Job: "batch",
+				GroupingKey: map[string]string{
+					"instance": "{host.name}",
+					"env":      "production",
+				},
+				Method:           methodPut,
+				DeleteOnShutdown: true,
+				Namespace:        "test-space",
+				ConstLabels: map[string]string{
+					"label1": "value1",
+				},
+				ResourceToTelemetrySettings: resourcetotelemetry.Settings{Enabled: true},
+			},
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "missing_job"),
+			errorMessage: "job must be specified",
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "job_in_grouping_key"),
+			errorMessage: "grouping_key must not contain job, use the job setting instead",
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "invalid_grouping_key"),
+			errorMessage: `invalid grouping_key label name "host.name"`,
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "invalid_method"),
+			errorMessage: `method must be either "post" or "put", got "patch"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalExporterConfig(sub, cfg))
+
+			if tt.errorMessage != "" {
+				assert.EqualError(t, cfg.Validate(), tt.errorMessage)
+			} else {
+				assert.NoError(t, cfg.Validate())
+			}
+			if tt.expected != nil {
+				assert.Equal(t, tt.expected, cfg)
+			}
+		})
+	}
+}
@@ -0,0 +1,253 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheuspushgatewayexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheuspushgatewayexporter"
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/push"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/model"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/multierr"
+)
+
+// placeholderPattern matches the {attribute} references to resource attributes in
+// the job and grouping key values.
+var placeholderPattern = regexp.MustCompile(`\{([^{}]+)\}`)
+
+// group identifies a group of metrics in the Pushgateway.
+type group struct {
+	job         string
+	groupingKey map[string]string
+}
+
+func (g *group) key() string {
+	names := make([]string, 0, len(g.groupingKey))
+	for name := range g.groupingKey {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	b.WriteString(g.job)
+	for _, name := range names {
+		b.WriteString("\xff" + name + "=" + g.groupingKey[name])
+	}
+	return b.String()
+}
+
+type pushgatewayExporter struct {
+	config   *Config
+	settings component.TelemetrySettings
+	client   *http.Client
+
+	pushedMutex sync.Mutex
+	// pushed are the groups deleted on shutdown, when enabled.
+	pushed map[string]*group
+}
+
+func newPushgatewayExporter(cfg *Config, set component.ExporterCreateSettings) *pushgatewayExporter {
+	return &pushgatewayExporter{
+		config:   cfg,
+		settings: set.TelemetrySettings,
+		pushed:   map[string]*group{},
+	}
+}
+
+func (e *pushgatewayExporter) start(_ context.Context, host component.Host) error {
+	client, err := e.config.HTTPClientSettings.ToClient(host, e.settings)
+	if err != nil {
+		return err
+	}
+	e.client = client
+	return nil
+}
+
+func (e *pushgatewayExporter) shutdown(context.Context) error {
+	if !e.config.DeleteOnShutdown || e.client == nil {
+		return nil
+	}
+
+	e.pushedMutex.Lock()
+	defer e.pushedMutex.Unlock()
+
+	var errs error
+	for _, g := range e.pushed {
+		if err := e.newPusher(g).Delete(); err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("failed to delete group of job %q: %w", g.job, err))
+		}
+	}
+	e.pushed = map[string]*group{}
+	return errs
+}
+
+func (e *pushgatewayExporter) pushMetrics(ctx context.Context, md pmetric.Metrics) error {
+	type groupMetrics struct {
+		group    *group
+		families *metricFamilies
+	}
+	groups := map[string]*groupMetrics{}
+
+	// Errors which a retry would not fix are only returned when all the
+	// pushes succeed, so that failed pushes are retried.
+	var pushErrs, permanentErrs error
+
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		g, err := e.resolveGroup(rm.Resource())
+		if err != nil {
+			permanentErrs = multierr.Append(permanentErrs, err)
+			continue
+		}
+
+		gm, ok := groups[g.key()]
+		if !ok {
+			gm = &groupMetrics{group: g, families: e.newMetricFamilies(g)}
+			groups[g.key()] = gm
+		}
+
+		sms := rm.ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			metrics := sms.At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				gm.families.addMetric(metrics.At(k))
+			}
+		}
+	}
+
+	for _, gm := range groups {
+		families := make([]*dto.MetricFamily, 0, len(gm.families.families))
+		for _, family := range gm.families.families {
+			families = append(families, family)
+		}
+		if len(families) == 0 {
+			continue
+		}
+
+		// Gathering checks the consistency of the metric families, e.g. that there
+		// are no data points with the same labels.
+		gathered, err := prometheus.Gatherers{staticGatherer(families)}.Gather()
+		if err != nil {
+			permanentErrs = multierr.Append(permanentErrs, fmt.Errorf("invalid metrics for job %q: %w", gm.group.job, err))
+			continue
+		}
+
+		if err := e.push(ctx, gm.group, gathered); err != nil {
+			pushErrs = multierr.Append(pushErrs, err)
+		}
+	}
+
+	if pushErrs != nil {
+		return pushErrs
+	}
+	if permanentErrs != nil {
+		return consumererror.NewPermanent(permanentErrs)
+	}
+	return nil
+}
+
+func (e *pushgatewayExporter) push(ctx context.Context, g *group, families []*dto.MetricFamily) error {
+	pusher := e.newPusher(g).Gatherer(staticGatherer(families))
+
+	var err error
+	if e.config.Method == methodPut {
+		err = pusher.PushContext(ctx)
+	} else {
+		err = pusher.AddContext(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to push metrics of job %q: %w", g.job, err)
+	}
+
+	if e.config.DeleteOnShutdown {
+		e.pushedMutex.Lock()
+		e.pushed[g.key()] = g
+		e.pushedMutex.Unlock()
+	}
+	return nil
+}
+
+func (e *pushgatewayExporter) newPusher(g *group) *push.Pusher {
+	pusher := push.New(e.config.Endpoint, g.job).Client(e.client)
+	for name, value := range g.groupingKey {
+		pusher = pusher.Grouping(name, value)
+	}
+	return pusher
+}
+
+func (e *pushgatewayExporter) newMetricFamilies(g *group) *metricFamilies {
+	excludedLabels := map[string]struct{}{model.JobLabel: {}}
+	for name := range g.groupingKey {
+		excludedLabels[name] = struct{}{}
+	}
+	return &metricFamilies{
+		logger:         e.settings.Logger,
+		namespace:      e.config.Namespace,
+		constLabels:    e.config.ConstLabels,
+		excludedLabels: excludedLabels,
+		families:       map[string]*dto.MetricFamily{},
+	}
+}
+
+// resolveGroup expands the resource attribute references of the job and grouping key.
+func (e *pushgatewayExporter) resolveGroup(resource pcommon.Resource) (*group, error) {
+	job, err := expandPlaceholders(e.config.Job, resource.Attributes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve job: %w", err)
+	}
+	if job == "" {
+		return nil, fmt.Errorf("job %q resolved to an empty value", e.config.Job)
+	}
+
+	g := &group{job: job, groupingKey: make(map[string]string, len(e.config.GroupingKey))}
+	for name, value := range e.config.GroupingKey {
+		if g.groupingKey[name], err = expandPlaceholders(value, resource.Attributes()); err != nil {
+			return nil, fmt.Errorf("failed to resolve grouping key %q: %w", name, err)
+		}
+	}
+	return g, nil
+}
+
+func expandPlaceholders(s string, attrs pcommon.Map) (string, error) {
+	var errs error
+	expanded := placeholderPattern.ReplaceAllStringFunc(s, func(placeholder string) string {
+		name := placeholder[1 : len(placeholder)-1]
+		value, ok := attrs.Get(name)
+		if !ok {
+			errs = multierr.Append(errs, fmt.Errorf("resource attribute %q not found", name))
+			return ""
+		}
+		return value.AsString()
+	})
+	return expanded, errs
+}
+
+func staticGatherer(families []*dto.MetricFamily) prometheus.Gatherer {
+	return prometheus.GathererFunc(func() ([]*dto.MetricFamily, error) {
+		return families, nil
+	})
+}
@@ -0,0 +1,311 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheuspushgatewayexporter
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+type pushRequest struct {
+	method   string
+	path     string
+	families map[string]*dto.MetricFamily
+}
+
+// fakePushgateway records the requests it receives.
+type fakePushgateway struct {
+	*httptest.Server
+	mu         sync.Mutex
+	requests   []pushRequest
+	statusCode int
+}
+
+func newFakePushgateway(t *testing.T) *fakePushgateway {
+	pg := &fakePushgateway{statusCode: http.StatusOK}
+	pg.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := pushRequest{method: r.Method, path: r.URL.Path, families: map[string]*dto.MetricFamily{}}
+		if r.Method != http.MethodDelete {
+			decoder := expfmt.NewDecoder(r.Body, expfmt.ResponseFormat(r.Header))
+			for {
+				family := &dto.MetricFamily{}
+				err := decoder.Decode(family)
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				assert.NoError(t, err)
+				req.families[family.GetName()] = family
+			}
+		}
+
+		pg.mu.Lock()
+		defer pg.mu.Unlock()
+		pg.requests = append(pg.requests, req)
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		w.WriteHeader(pg.statusCode)
+	}))
+	t.Cleanup(pg.Close)
+	return pg
+}
+
+func (pg *fakePushgateway) requestsByPath() map[string]pushRequest {
+	pg.mu.Lock()
+	defer pg.mu.Unlock()
+	byPath := make(map[string]pushRequest, len(pg.requests))
+	for _, req := range pg.requests {
+		byPath[req.path] = req
+	}
+	return byPath
+}
+
+func newTestExporter(t *testing.T, endpoint string, configure func(*Config)) *pushgatewayExporter {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = endpoint
+	cfg.Job = "batch"
+	cfg.GroupingKey = map[string]string{"instance": "{host.name}"}
+	if configure != nil {
+		configure(cfg)
+	}
+	require.NoError(t, cfg.Validate())
+
+	exp := newPushgatewayExporter(cfg, componenttest.NewNopExporterCreateSettings())
+	require.NoError(t, exp.start(context.Background(), componenttest.NewNopHost()))
+	return exp
+}
+
+func appendResourceMetrics(md pmetric.Metrics, host string) pmetric.MetricSlice {
+	rm := md.ResourceMetrics().AppendEmpty()
+	if host != "" {
+		rm.Resource().Attributes().PutStr("host.name", host)
+	}
+	return rm.ScopeMetrics().AppendEmpty().Metrics()
+}
+
+func TestPushMetrics(t *testing.T) {
+	pg := newFakePushgateway(t)
+	exp := newTestExporter(t, pg.URL, func(cfg *Config) {
+		cfg.ConstLabels = map[string]string{"team": "data"}
+	})
+
+	md := pmetric.NewMetrics()
+	metrics := appendResourceMetrics(md, "node-1")
+
+	gauge := metrics.AppendEmpty()
+	gauge.SetName("job.duration")
+	gauge.SetDescription("Duration of the job.")
+	dp := gauge.SetEmptyGauge().DataPoints().AppendEmpty()
+	dp.SetDoubleValue(12.5)
+	dp.Attributes().PutStr("step", "load")
+	// the grouping key label is dropped from the data point
+	dp.Attributes().PutStr("instance", "ignored")
+
+	counter := metrics.AppendEmpty()
+	counter.SetName("records.processed")
+	sum := counter.SetEmptySum()
+	sum.SetIsMonotonic(true)
+	sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	sum.DataPoints().AppendEmpty().SetIntValue(42)
+
+	delta := metrics.AppendEmpty()
+	delta.SetName("records.delta")
+	delta.SetEmptySum().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	delta.Sum().DataPoints().AppendEmpty().SetIntValue(1)
+
+	histogram := metrics.AppendEmpty()
+	histogram.SetName("batch.size")
+	histogram.SetEmptyHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	hdp := histogram.Histogram().DataPoints().AppendEmpty()
+	hdp.SetCount(6)
+	hdp.SetSum(30)
+	hdp.ExplicitBounds().FromRaw([]float64{1, 10})
+	hdp.BucketCounts().FromRaw([]uint64{1, 2, 3})
+
+	summary := metrics.AppendEmpty()
+	summary.SetName("latency")
+	sdp := summary.SetEmptySummary().DataPoints().AppendEmpty()
+	sdp.SetCount(2)
+	sdp.SetSum(3)
+	quantile := sdp.QuantileValues().AppendEmpty()
+	quantile.SetQuantile(0.5)
+	quantile.SetValue(1.5)
+
+	other := appendResourceMetrics(md, "node-2").AppendEmpty()
+	other.SetName("records.processed")
+	other.SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(7)
+
+	require.NoError(t, exp.pushMetrics(context.Background(), md))
+
+	requests := pg.requestsByPath()
+	require.Len(t, requests, 2)
+
+	req, ok := requests["/metrics/job/batch/instance/node-1"]
+	require.True(t, ok)
+	assert.Equal(t, http.MethodPost, req.method)
+	require.Len(t, req.families, 4)
+	assert.NotContains(t, req.families, "records_delta")
+
+	family := req.families["job_duration"]
+	assert.Equal(t, dto.MetricType_GAUGE, family.GetType())
+	assert.Equal(t, "Duration of the job.", family.GetHelp())
+	assert.Equal(t, 12.5, family.Metric[0].GetGauge().GetValue())
+	assert.Equal(t, map[string]string{"step": "load", "team": "data"}, labelMap(family.Metric[0]))
+
+	family = req.families["records_processed"]
+	assert.Equal(t, dto.MetricType_COUNTER, family.GetType())
+	assert.Equal(t, 42.0, family.Metric[0].GetCounter().GetValue())
+
+	family = req.families["batch_size"]
+	assert.Equal(t, dto.MetricType_HISTOGRAM, family.GetType())
+	h := family.Metric[0].GetHistogram()
+	assert.Equal(t, uint64(6), h.GetSampleCount())
+	assert.Equal(t, 30.0, h.GetSampleSum())
+	require.Len(t, h.Bucket, 2)
+	assert.Equal(t, uint64(1), h.Bucket[0].GetCumulativeCount())
+	assert.Equal(t, uint64(3), h.Bucket[1].GetCumulativeCount())
+
+	family = req.families["latency"]
+	assert.Equal(t, dto.MetricType_SUMMARY, family.GetType())
+	assert.Equal(t, 1.5, family.Metric[0].GetSummary().Quantile[0].GetValue())
+
+	req, ok = requests["/metrics/job/batch/instance/node-2"]
+	require.True(t, ok)
+	require.Len(t, req.families, 1)
+	assert.Equal(t, dto.MetricType_GAUGE, req.families["records_processed"].GetType())
+}
+
+func TestPushMetricsPut(t *testing.T) {
+	pg := newFakePushgateway(t)
+	exp := newTestExporter(t, pg.URL, func(cfg *Config) {
+		cfg.Method = methodPut
+	})
+
+	md := pmetric.NewMetrics()
+	appendResourceMetrics(md, "node-1").AppendEmpty().SetName("up")
+	md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(1)
+
+	require.NoError(t, exp.pushMetrics(context.Background(), md))
+	assert.Equal(t, http.MethodPut, pg.requestsByPath()["/metrics/job/batch/instance/node-1"].method)
+}
+
+func TestPushMetricsMissingResourceAttribute(t *testing.T) {
+	pg := newFakePushgateway(t)
+	exp := newTestExporter(t, pg.URL, nil)
+
+	md := pmetric.NewMetrics()
+	for _, host := range []string{"", "node-1"} {
+		gauge := appendResourceMetrics(md, host).AppendEmpty()
+		gauge.SetName("up")
+		gauge.SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(1)
+	}
+
+	err := exp.pushMetrics(context.Background(), md)
+	require.Error(t, err)
+	assert.True(t, consumererror.IsPermanent(err))
+	assert.Contains(t, err.Error(), `resource attribute "host.name" not found`)
+
+	requests := pg.requestsByPath()
+	require.Len(t, requests, 1)
+	assert.Contains(t, requests, "/metrics/job/batch/instance/node-1")
+}
+
+func TestPushMetricsServerError(t *testing.T) {
+	pg := newFakePushgateway(t)
+	pg.statusCode = http.StatusInternalServerError
+	exp := newTestExporter(t, pg.URL, nil)
+
+	md := pmetric.NewMetrics()
+	gauge := appendResourceMetrics(md, "node-1").AppendEmpty()
+	gauge.SetName("up")
+	gauge.SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(1)
+
+	err := exp.pushMetrics(context.Background(), md)
+	require.Error(t, err)
+	assert.False(t, consumererror.IsPermanent(err))
+}
+
+func TestDeleteOnShutdown(t *testing.T) {
+	for _, deleteOnShutdown := range []bool{false, true} {
+		pg := newFakePushgateway(t)
+		exp := newTestExporter(t, pg.URL, func(cfg *Config) {
+			cfg.DeleteOnShutdown = deleteOnShutdown
+		})
+
+		md := pmetric.NewMetrics()
+		for _, host := range []string{"node-1", "node-2"} {
+			gauge := appendResourceMetrics(md, host).AppendEmpty()
+			gauge.SetName("up")
+			gauge.SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(1)
+		}
+		require.NoError(t, exp.pushMetrics(context.Background(), md))
+		require.NoError(t, exp.shutdown(context.Background()))
+
+		var deleted []string
+		pg.mu.Lock()
+		for _, req := range pg.requests {
+			if req.method == http.MethodDelete {
+				deleted = append(deleted, req.path)
+			}
+		}
+		pg.mu.Unlock()
+
+		if deleteOnShutdown {
+			assert.ElementsMatch(t, []string{"/metrics/job/batch/instance/node-1", "/metrics/job/batch/instance/node-2"}, deleted)
+		} else {
+			assert.Empty(t, deleted)
+		}
+	}
+}
+
+func TestExpandPlaceholders(t *testing.T) {
+	md := pmetric.NewMetrics()
+	attrs := md.ResourceMetrics().AppendEmpty().Resource().Attributes()
+	attrs.PutStr("service.name", "etl")
+	attrs.PutInt("shard", 3)
+
+	expanded, err := expandPlaceholders("{service.name}-{shard}", attrs)
+	require.NoError(t, err)
+	assert.Equal(t, "etl-3", expanded)
+
+	expanded, err = expandPlaceholders("static", attrs)
+	require.NoError(t, err)
+	assert.Equal(t, "static", expanded)
+
+	_, err = expandPlaceholders("{host.name}", attrs)
+	assert.EqualError(t, err, `resource attribute "host.name" not found`)
+}
+
+func labelMap(m *dto.Metric) map[string]string {
+	labels := make(map[string]string, len(m.Label))
+	for _, l := range m.Label {
+		labels[l.GetName()] = l.GetValue()
+	}
+	return labels
+}
@@ -0,0 +1,86 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheuspushgatewayexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheuspushgatewayexporter"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/resourcetotelemetry"
+)
+
+const (
+	// The value of "type" key in configuration.
+	typeStr = "prometheuspushgateway"
+	// The stability level of the exporter.
+	stability = component.StabilityLevelAlpha
+)
+
+// NewFactory creates a new Prometheus Pushgateway exporter factory.
+func NewFactory() component.ExporterFactory {
+	return component.NewExporterFactory(
+		typeStr,
+		createDefaultConfig,
+		component.WithMetricsExporter(createMetricsExporter, stability))
+}
+
+func createDefaultConfig() component.ExporterConfig {
+	return &Config{
+		ExporterSettings: config.NewExporterSettings(component.NewID(typeStr)),
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Timeout: exporterhelper.NewDefaultTimeoutSettings().Timeout,
+			Headers: map[string]string{},
+		},
+		QueueSettings: exporterhelper.NewDefaultQueueSettings(),
+		RetrySettings: exporterhelper.NewDefaultRetrySettings(),
+		GroupingKey:   map[string]string{},
+		Method:        methodPost,
+		ConstLabels:   map[string]string{},
+	}
+}
+
+func createMetricsExporter(
+	ctx context.Context,
+	set component.ExporterCreateSettings,
+	cfg component.ExporterConfig,
+) (component.MetricsExporter, error) {
+	pcfg := cfg.(*Config)
+
+	pushgateway := newPushgatewayExporter(pcfg, set)
+
+	exporter, err := exporterhelper.NewMetricsExporter(
+		ctx,
+		set,
+		cfg,
+		pushgateway.pushMetrics,
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		// explicitly disable since we rely on http.Client timeout logic.
+		exporterhelper.WithTimeout(exporterhelper.TimeoutSettings{Timeout: 0}),
+		exporterhelper.WithQueue(pcfg.QueueSettings),
+		exporterhelper.WithRetry(pcfg.RetrySettings),
+		exporterhelper.WithStart(pushgateway.start),
+		exporterhelper.WithShutdown(pushgateway.shutdown),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return resourcetotelemetry.WrapMetricsExporter(pcfg.ResourceToTelemetrySettings, exporter), nil
+}
@@ -0,0 +1,201 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheuspushgatewayexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheuspushgatewayexporter"
+
+import (
+	"math"
+	"sort"
+
+	dto "github.com/prometheus/client_model/go"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	prometheustranslator "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/prometheus"
+)
+
+// metricFamilies converts pdata metrics to Prometheus metric families, keyed by name.
+type metricFamilies struct {
+	logger      *zap.Logger
+	namespace   string
+	constLabels map[string]string
+	// excludedLabels are the job and grouping key labels, which the Pushgateway
+	// rejects when they are also set on the pushed metrics.
+	excludedLabels map[string]struct{}
+	families       map[string]*dto.MetricFamily
+}
+
+func (mf *metricFamilies) addMetric(metric pmetric.Metric) {
+	name := prometheustranslator.BuildPromCompliantName(metric, mf.namespace)
+
+	var metricType dto.MetricType
+	var metrics []*dto.Metric
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		metricType = dto.MetricType_GAUGE
+		metrics = mf.convertNumberDataPoints(metric.Gauge().DataPoints(), metricType)
+	case pmetric.MetricTypeSum:
+		sum := metric.Sum()
+		if sum.AggregationTemporality() != pmetric.AggregationTemporalityCumulative {
+			mf.logger.Debug("Dropping sum with delta temporality", zap.String("metric", metric.Name()))
+			return
+		}
+		metricType = dto.MetricType_GAUGE
+		if sum.IsMonotonic() {
+			metricType = dto.MetricType_COUNTER
+		}
+		metrics = mf.convertNumberDataPoints(sum.DataPoints(), metricType)
+	case pmetric.MetricTypeHistogram:
+		if metric.Histogram().AggregationTemporality() != pmetric.AggregationTemporalityCumulative {
+			mf.logger.Debug("Dropping histogram with delta temporality", zap.String("metric", metric.Name()))
+			return
+		}
+		metricType = dto.MetricType_HISTOGRAM
+		metrics = mf.convertHistogramDataPoints(metric.Histogram().DataPoints())
+	case pmetric.MetricTypeSummary:
+		metricType = dto.MetricType_SUMMARY
+		metrics = mf.convertSummaryDataPoints(metric.Summary().DataPoints())
+	default:
+		mf.logger.Debug("Dropping metric of unsupported type", zap.String("metric", metric.Name()), zap.String("type", metric.Type().String()))
+		return
+	}
+
+	if len(metrics) == 0 {
+		return
+	}
+
+	family, ok := mf.families[name]
+	if !ok {
+		family = &dto.MetricFamily{
+			Name: proto.String(name),
+			Type: metricType.Enum(),
+		}
+		if metric.Description() != "" {
+			family.Help = proto.String(metric.Description())
+		}
+		mf.families[name] = family
+	} else if family.GetType() != metricType {
+		mf.logger.Debug("Dropping metric with the name of a metric of another type", zap.String("metric", metric.Name()), zap.String("name", name))
+		return
+	}
+	family.Metric = append(family.Metric, metrics...)
+}
+
+func (mf *metricFamilies) convertNumberDataPoints(dps pmetric.NumberDataPointSlice, metricType dto.MetricType) []*dto.Metric {
+	metrics := make([]*dto.Metric, 0, dps.Len())
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		if dp.Flags().NoRecordedValue() {
+			continue
+		}
+		var value float64
+		switch dp.ValueType() {
+		case pmetric.NumberDataPointValueTypeInt:
+			value = float64(dp.IntValue())
+		case pmetric.NumberDataPointValueTypeDouble:
+			value = dp.DoubleValue()
+		default:
+			continue
+		}
+		m := &dto.Metric{Label: mf.labels(dp.Attributes())}
+		if metricType == dto.MetricType_COUNTER {
+			m.Counter = &dto.Counter{Value: proto.Float64(value)}
+		} else {
+			m.Gauge = &dto.Gauge{Value: proto.Float64(value)}
+		}
+		metrics = append(metrics, m)
+	}
+	return metrics
+}
+
+func (mf *metricFamilies) convertHistogramDataPoints(dps pmetric.HistogramDataPointSlice) []*dto.Metric {
+	metrics := make([]*dto.Metric, 0, dps.Len())
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		if dp.Flags().NoRecordedValue() {
+			continue
+		}
+		histogram := &dto.Histogram{
+			SampleCount: proto.Uint64(dp.Count()),
+			SampleSum:   proto.Float64(dp.Sum()),
+		}
+		// OTLP bucket counts are per bucket, Prometheus ones are cumulative. The
+		// +Inf bucket is implied by the sample count.
+		var cumulative uint64
+		bounds := dp.ExplicitBounds()
+		counts := dp.BucketCounts()
+		for j := 0; j < bounds.Len() && j < counts.Len(); j++ {
+			cumulative += counts.At(j)
+			histogram.Bucket = append(histogram.Bucket, &dto.Bucket{
+				UpperBound:      proto.Float64(bounds.At(j)),
+				CumulativeCount: proto.Uint64(cumulative),
+			})
+		}
+		metrics = append(metrics, &dto.Metric{Label: mf.labels(dp.Attributes()), Histogram: histogram})
+	}
+	return metrics
+}
+
+func (mf *metricFamilies) convertSummaryDataPoints(dps pmetric.SummaryDataPointSlice) []*dto.Metric {
+	metrics := make([]*dto.Metric, 0, dps.Len())
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		if dp.Flags().NoRecordedValue() {
+			continue
+		}
+		summary := &dto.Summary{
+			SampleCount: proto.Uint64(dp.Count()),
+			SampleSum:   proto.Float64(dp.Sum()),
+		}
+		for j := 0; j < dp.QuantileValues().Len(); j++ {
+			q := dp.QuantileValues().At(j)
+			if math.IsNaN(q.Quantile()) {
+				continue
+			}
+			summary.Quantile = append(summary.Quantile, &dto.Quantile{
+				Quantile: proto.Float64(q.Quantile()),
+				Value:    proto.Float64(q.Value()),
+			})
+		}
+		metrics = append(metrics, &dto.Metric{Label: mf.labels(dp.Attributes()), Summary: summary})
+	}
+	return metrics
+}
+
+// labels returns the sorted labels of a data point, which are its attributes and the
+// const labels, without the job and grouping key labels.
+func (mf *metricFamilies) labels(attributes pcommon.Map) []*dto.LabelPair {
+	values := make(map[string]string, attributes.Len()+len(mf.constLabels))
+	attributes.Range(func(k string, v pcommon.Value) bool {
+		values[prometheustranslator.NormalizeLabel(k)] = v.AsString()
+		return true
+	})
+	for k, v := range mf.constLabels {
+		values[prometheustranslator.NormalizeLabel(k)] = v
+	}
+
+	labels := make([]*dto.LabelPair, 0, len(values))
+	for k, v := range values {
+		if _, ok := mf.excludedLabels[k]; ok {
+			continue
+		}
+		labels = append(labels, &dto.LabelPair{Name: proto.String(k), Value: proto.String(v)})
+	}
+	sort.Slice(labels, func(i, j int) bool {
+		return labels[i].GetName() < labels[j].GetName()
+	})
+	return labels
+}
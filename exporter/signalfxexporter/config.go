@@ -20,6 +20,7 @@ import (
 	"net/url"
 	"time"
 
+	sfxpb "github.com/signalfx/com_signalfx_metrics_protobuf/model"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
@@ -110,6 +111,35 @@ type Config struct {
 
 	// MaxConnections is used to set a limit to the maximum idle HTTP connection the exporter can keep open.
 	MaxConnections int `mapstructure:"max_connections"`
+
+	// LogEvents defines rules to convert log records, which are not SignalFx events already,
+	// to SignalFx custom events. The first rule matching a log record is used, log records
+	// matching no rule are dropped.
+	LogEvents []LogEventConfig `mapstructure:"log_events"`
+
+	// SendOTLPHistograms defines whether histograms are sent in the OTLP format, to be ingested
+	// as native SignalFx histograms, instead of being converted to a set of counters.
+	// Translation rules and metric filters do not apply to the histograms sent this way.
+	SendOTLPHistograms bool `mapstructure:"send_otlp_histograms"`
+}
+
+// LogEventConfig defines the conversion of the matching log records to SignalFx events.
+type LogEventConfig struct {
+	// Condition is an OTTL condition selecting the log records to convert.
+	Condition string `mapstructure:"condition"`
+
+	// EventType is the type of the events.
+	EventType string `mapstructure:"event_type"`
+
+	// Category is the category of the events, USER_DEFINED by default.
+	Category string `mapstructure:"category"`
+
+	// Dimensions maps log record or resource attributes to event dimensions.
+	// When not set, all string attributes are used as dimensions.
+	Dimensions map[string]string `mapstructure:"dimensions"`
+
+	// Properties maps log record or resource attributes to event properties.
+	Properties map[string]string `mapstructure:"properties"`
 }
 
 func (cfg *Config) getOptionsFromConfig() (*exporterOptions, error) {
@@ -165,6 +195,18 @@ func (cfg *Config) validateConfig() error {
 		return errors.New(`cannot have a negative "max_connections"`)
 	}
 
+	for i, logEvent := range cfg.LogEvents {
+		if logEvent.Condition == "" {
+			return fmt.Errorf(`requires a non-empty "condition" in "log_events" rule %d`, i)
+		}
+		if logEvent.EventType == "" {
+			return fmt.Errorf(`requires a non-empty "event_type" in "log_events" rule %d`, i)
+		}
+		if _, ok := sfxpb.EventCategory_value[logEvent.category()]; !ok {
+			return fmt.Errorf(`invalid "category" %q in "log_events" rule %d`, logEvent.Category, i)
+		}
+	}
+
 	return nil
 }
 
@@ -213,3 +255,10 @@ func (cfg *Config) Validate() error {
 	}
 	return nil
 }
+
+func (cfg *LogEventConfig) category() string {
+	if cfg.Category == "" {
+		return sfxpb.EventCategory_USER_DEFINED.String()
+	}
+	return cfg.Category
+}
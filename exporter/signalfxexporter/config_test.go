@@ -178,6 +178,16 @@ func TestLoadConfig(t *testing.T) {
 					},
 				},
 				NonAlphanumericDimensionChars: "_-.",
+				LogEvents: []LogEventConfig{
+					{
+						Condition:  `attributes["event.domain"] == "deploy"`,
+						EventType:  "deployment",
+						Category:   "AUDIT",
+						Dimensions: map[string]string{"service.name": "service"},
+						Properties: map[string]string{"service.version": "version"},
+					},
+				},
+				SendOTLPHistograms: true,
 			},
 		},
 	}
@@ -212,6 +222,7 @@ func TestConfig_getOptionsFromConfig(t *testing.T) {
 		Headers          map[string]string
 		TranslationRules []translation.Rule
 		SyncHostMetadata bool
+		LogEvents        []LogEventConfig
 	}
 	tests := []struct {
 		name    string
@@ -317,6 +328,42 @@ func TestConfig_getOptionsFromConfig(t *testing.T) {
 			want:    nil,
 			wantErr: true,
 		},
+		{
+			name: "Test log event without event type",
+			fields: fields{
+				Realm:       "us0",
+				AccessToken: "access_token",
+				LogEvents: []LogEventConfig{
+					{Condition: `attributes["event.domain"] == "deploy"`},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test log event without condition",
+			fields: fields{
+				Realm:       "us0",
+				AccessToken: "access_token",
+				LogEvents: []LogEventConfig{
+					{EventType: "deployment"},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test log event with invalid category",
+			fields: fields{
+				Realm:       "us0",
+				AccessToken: "access_token",
+				LogEvents: []LogEventConfig{
+					{Condition: `attributes["event.domain"] == "deploy"`, EventType: "deployment", Category: "DEPLOYMENT"},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -333,6 +380,7 @@ func TestConfig_getOptionsFromConfig(t *testing.T) {
 				TranslationRules:    tt.fields.TranslationRules,
 				SyncHostMetadata:    tt.fields.SyncHostMetadata,
 				DeltaTranslationTTL: 3600,
+				LogEvents:           tt.fields.LogEvents,
 			}
 
 			got, err := cfg.getOptionsFromConfig()
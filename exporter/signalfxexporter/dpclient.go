@@ -28,6 +28,7 @@ import (
 	sfxpb "github.com/signalfx/com_signalfx_metrics_protobuf/model"
 	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/translation"
@@ -69,6 +70,7 @@ type sfxDPClient struct {
 	logger                 *zap.Logger
 	accessTokenPassthrough bool
 	converter              *translation.MetricsConverter
+	sendOTLPHistograms     bool
 }
 
 func (s *sfxDPClient) pushMetricsData(
@@ -92,13 +94,31 @@ func (s *sfxDPClient) pushMetricsData(
 	// All metrics in the pmetric.Metrics will have the same access token because of the BatchPerResourceMetrics.
 	metricToken := s.retrieveAccessToken(rms.At(0))
 
+	histograms := pmetric.NewMetrics()
+	if s.sendOTLPHistograms {
+		md, histograms = splitHistograms(md)
+	}
+
 	sfxDataPoints := s.converter.MetricsToSignalFxV2(md)
 	if s.logDataPoints {
 		for _, dp := range sfxDataPoints {
 			s.logger.Debug("Dispatching SFx datapoint", zap.String("dp", translation.DatapointToString(dp)))
 		}
 	}
-	return s.pushMetricsDataForToken(ctx, sfxDataPoints, metricToken)
+	if len(sfxDataPoints) > 0 || histograms.ResourceMetrics().Len() == 0 {
+		if droppedDataPoints, err = s.pushMetricsDataForToken(ctx, sfxDataPoints, metricToken); err != nil {
+			return droppedDataPoints, err
+		}
+	}
+
+	if histograms.ResourceMetrics().Len() == 0 {
+		return 0, nil
+	}
+	if droppedDataPoints, err = s.pushOTLPMetricsDataForToken(ctx, histograms, metricToken); err != nil && !consumererror.IsPermanent(err) {
+		// The other datapoints were accepted already, only retry the histograms.
+		err = consumererror.NewMetrics(err, histograms)
+	}
+	return droppedDataPoints, err
 }
 
 func (s *sfxDPClient) pushMetricsDataForToken(ctx context.Context, sfxDataPoints []*sfxpb.DataPoint, accessToken string) (int, error) {
@@ -106,14 +126,47 @@ func (s *sfxDPClient) pushMetricsDataForToken(ctx context.Context, sfxDataPoints
 	if err != nil {
 		return len(sfxDataPoints), consumererror.NewPermanent(err)
 	}
+	return s.postDatapoints(ctx, s.datapointURL(""), body, compressed, accessToken, len(sfxDataPoints))
+}
 
+// pushOTLPMetricsDataForToken sends the metrics, in the OTLP format, to the endpoint
+// ingesting them as native SignalFx histograms.
+func (s *sfxDPClient) pushOTLPMetricsDataForToken(ctx context.Context, md pmetric.Metrics, accessToken string) (int, error) {
+	if s.logDataPoints {
+		buf, err := metricsMarshaler.MarshalMetrics(md)
+		if err != nil {
+			s.logger.Error("Failed to marshal histograms for logging", zap.Error(err))
+		} else {
+			s.logger.Debug("Dispatching OTLP histograms", zap.String("pdata", string(buf)))
+		}
+	}
+
+	numDataPoints := md.DataPointCount()
+	b, err := pmetricotlp.NewExportRequestFromMetrics(md).MarshalProto()
+	if err != nil {
+		return numDataPoints, consumererror.NewPermanent(err)
+	}
+	body, compressed, err := s.getReader(b)
+	if err != nil {
+		return numDataPoints, consumererror.NewPermanent(err)
+	}
+	return s.postDatapoints(ctx, s.datapointURL("otlp"), body, compressed, accessToken, numDataPoints)
+}
+
+// datapointURL returns the URL of the datapoint ingest endpoint, followed by suffix.
+func (s *sfxDPClient) datapointURL(suffix string) string {
 	datapointURL := *s.ingestURL
 	if !strings.HasSuffix(datapointURL.Path, "v2/datapoint") {
 		datapointURL.Path = path.Join(datapointURL.Path, "v2/datapoint")
 	}
-	req, err := http.NewRequestWithContext(ctx, "POST", datapointURL.String(), body)
+	datapointURL.Path = path.Join(datapointURL.Path, suffix)
+	return datapointURL.String()
+}
+
+func (s *sfxDPClient) postDatapoints(ctx context.Context, endpoint string, body io.Reader, compressed bool, accessToken string, numDataPoints int) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, body)
 	if err != nil {
-		return len(sfxDataPoints), consumererror.NewPermanent(err)
+		return numDataPoints, consumererror.NewPermanent(err)
 	}
 
 	for k, v := range s.headers {
@@ -133,7 +186,7 @@ func (s *sfxDPClient) pushMetricsDataForToken(ctx context.Context, sfxDataPoints
 	// error for metrics is available.
 	resp, err := s.client.Do(req)
 	if err != nil {
-		return len(sfxDataPoints), err
+		return numDataPoints, err
 	}
 
 	defer func() {
@@ -143,7 +196,7 @@ func (s *sfxDPClient) pushMetricsDataForToken(ctx context.Context, sfxDataPoints
 
 	err = splunk.HandleHTTPCode(resp)
 	if err != nil {
-		return len(sfxDataPoints), err
+		return numDataPoints, err
 	}
 	return 0, nil
 }
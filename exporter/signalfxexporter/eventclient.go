@@ -36,6 +36,7 @@ type sfxEventClient struct {
 	sfxClientBase
 	logger                 *zap.Logger
 	accessTokenPassthrough bool
+	logEventRules          []*logEventRule
 }
 
 func (s *sfxEventClient) pushLogsData(ctx context.Context, ld plog.Logs) (int, error) {
@@ -54,9 +55,22 @@ func (s *sfxEventClient) pushLogsData(ctx context.Context, ld plog.Logs) (int, e
 		ills := rl.ScopeLogs()
 		for j := 0; j < ills.Len(); j++ {
 			sl := ills.At(j)
-			events, dropped := translation.LogRecordSliceToSignalFxV2(s.logger, sl.LogRecords(), rl.Resource().Attributes())
-			sfxEvents = append(sfxEvents, events...)
-			numDroppedLogRecords += dropped
+			if len(s.logEventRules) == 0 {
+				events, dropped := translation.LogRecordSliceToSignalFxV2(s.logger, sl.LogRecords(), rl.Resource().Attributes())
+				sfxEvents = append(sfxEvents, events...)
+				numDroppedLogRecords += dropped
+				continue
+			}
+
+			lrs := sl.LogRecords()
+			for k := 0; k < lrs.Len(); k++ {
+				event, ok := s.convertLogRecord(ctx, lrs.At(k), sl.Scope(), rl.Resource())
+				if !ok {
+					numDroppedLogRecords++
+					continue
+				}
+				sfxEvents = append(sfxEvents, event)
+			}
 		}
 	}
 
@@ -104,6 +118,26 @@ func (s *sfxEventClient) pushLogsData(ctx context.Context, ld plog.Logs) (int, e
 	return numDroppedLogRecords, nil
 }
 
+// convertLogRecord converts a log record to a SignalFx event, either because it is a SignalFx event
+// already, or because it matches one of the log event rules.
+func (s *sfxEventClient) convertLogRecord(ctx context.Context, lr plog.LogRecord, scope pcommon.InstrumentationScope, resource pcommon.Resource) (*sfxpb.Event, bool) {
+	if event, ok := translation.LogRecordToSignalFxV2(s.logger, lr, resource.Attributes()); ok {
+		return event, true
+	}
+
+	for _, rule := range s.logEventRules {
+		matches, err := rule.matches(ctx, lr, scope, resource)
+		if err != nil {
+			s.logger.Debug("Failed to evaluate log event condition", zap.Error(err))
+			continue
+		}
+		if matches {
+			return translation.MappedLogRecordToSignalFxV2(s.logger, lr, resource.Attributes(), rule.mapping), true
+		}
+	}
+	return nil, false
+}
+
 func (s *sfxEventClient) encodeBody(events []*sfxpb.Event) (bodyReader io.Reader, compressed bool, err error) {
 	msg := sfxpb.EventUploadMessage{
 		Events: events,
@@ -122,6 +122,7 @@ func newSignalFxExporter(
 		logger:                 logger,
 		accessTokenPassthrough: config.AccessTokenPassthrough,
 		converter:              converter,
+		sendOTLPHistograms:     config.SendOTLPHistograms,
 	}
 
 	dimClient := dimensions.NewDimensionClient(
@@ -173,6 +174,11 @@ func newEventExporter(config *Config, logger *zap.Logger) (*signalfxExporter, er
 
 	headers := buildHeaders(config)
 
+	logEventRules, err := newLogEventRules(config.LogEvents, component.TelemetrySettings{Logger: logger})
+	if err != nil {
+		return nil, err
+	}
+
 	transport := http.DefaultTransport.(*http.Transport).Clone()
 	transport.MaxIdleConns = config.MaxConnections
 	transport.MaxIdleConnsPerHost = config.MaxConnections
@@ -190,6 +196,7 @@ func newEventExporter(config *Config, logger *zap.Logger) (*signalfxExporter, er
 		},
 		logger:                 logger,
 		accessTokenPassthrough: config.AccessTokenPassthrough,
+		logEventRules:          logEventRules,
 	}
 
 	return &signalfxExporter{
@@ -41,6 +41,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/dimensions"
@@ -246,6 +247,115 @@ func TestConsumeMetrics(t *testing.T) {
 	}
 }
 
+func TestConsumeMetricsWithOTLPHistograms(t *testing.T) {
+	md := pmetric.NewMetrics()
+	ms := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	gauge := ms.AppendEmpty()
+	gauge.SetName("test_gauge")
+	gauge.SetEmptyGauge().DataPoints().AppendEmpty().SetDoubleValue(123)
+	histogram := ms.AppendEmpty()
+	histogram.SetName("test_histogram")
+	hdp := histogram.SetEmptyHistogram().DataPoints().AppendEmpty()
+	hdp.SetCount(3)
+	hdp.SetSum(30)
+	hdp.ExplicitBounds().FromRaw([]float64{10, 20})
+	hdp.BucketCounts().FromRaw([]uint64{1, 1, 1})
+	expHistogram := ms.AppendEmpty()
+	expHistogram.SetName("test_exponential_histogram")
+	expHistogram.SetEmptyExponentialHistogram().DataPoints().AppendEmpty().SetCount(2)
+
+	tests := []struct {
+		name               string
+		sendOTLPHistograms bool
+		otlpResponseCode   int
+		wantSFxMetrics     []string
+		wantOTLPMetrics    []string
+		wantRetried        bool
+	}{
+		{
+			name:           "disabled",
+			wantSFxMetrics: []string{"test_gauge", "test_histogram_bucket", "test_histogram_bucket", "test_histogram_bucket", "test_histogram_count", "test_histogram_sum"},
+		},
+		{
+			name:               "enabled",
+			sendOTLPHistograms: true,
+			otlpResponseCode:   http.StatusAccepted,
+			wantSFxMetrics:     []string{"test_gauge"},
+			wantOTLPMetrics:    []string{"test_histogram", "test_exponential_histogram"},
+		},
+		{
+			name:               "histograms_rejected",
+			sendOTLPHistograms: true,
+			otlpResponseCode:   http.StatusServiceUnavailable,
+			wantSFxMetrics:     []string{"test_gauge"},
+			wantOTLPMetrics:    []string{"test_histogram", "test_exponential_histogram"},
+			wantRetried:        true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sfxMetrics, otlpMetrics []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				switch r.URL.Path {
+				case "/v2/datapoint":
+					msg := &sfxpb.DataPointUploadMessage{}
+					require.NoError(t, msg.Unmarshal(body))
+					for _, dp := range msg.Datapoints {
+						sfxMetrics = append(sfxMetrics, dp.Metric)
+					}
+					w.WriteHeader(http.StatusAccepted)
+				case "/v2/datapoint/otlp":
+					assert.Equal(t, "application/x-protobuf", r.Header.Get("Content-Type"))
+					req := pmetricotlp.NewExportRequest()
+					require.NoError(t, req.UnmarshalProto(body))
+					received := req.Metrics().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+					for i := 0; i < received.Len(); i++ {
+						otlpMetrics = append(otlpMetrics, received.At(i).Name())
+					}
+					w.WriteHeader(tt.otlpResponseCode)
+				default:
+					t.Errorf("unexpected request to %s", r.URL.Path)
+				}
+			}))
+			defer server.Close()
+
+			serverURL, err := url.Parse(server.URL)
+			require.NoError(t, err)
+			c, err := translation.NewMetricsConverter(zap.NewNop(), nil, nil, nil, "")
+			require.NoError(t, err)
+			dpClient := &sfxDPClient{
+				sfxClientBase: sfxClientBase{
+					ingestURL: serverURL,
+					headers:   map[string]string{"Content-Type": "application/x-protobuf"},
+					client:    &http.Client{Timeout: 1 * time.Second},
+					zippers:   newGzipPool(),
+				},
+				logger:             zap.NewNop(),
+				converter:          c,
+				sendOTLPHistograms: tt.sendOTLPHistograms,
+			}
+
+			numDroppedDataPoints, err := dpClient.pushMetricsData(context.Background(), md)
+			assert.ElementsMatch(t, tt.wantSFxMetrics, sfxMetrics)
+			assert.Equal(t, tt.wantOTLPMetrics, otlpMetrics)
+			if !tt.wantRetried {
+				assert.NoError(t, err)
+				assert.Zero(t, numDroppedDataPoints)
+				return
+			}
+
+			assert.Equal(t, 2, numDroppedDataPoints)
+			var metricsErr consumererror.Metrics
+			require.ErrorAs(t, err, &metricsErr)
+			assert.Equal(t, 2, metricsErr.GetMetrics().MetricCount())
+			// the input is left unchanged
+			assert.Equal(t, 3, md.MetricCount())
+		})
+	}
+}
+
 func TestConsumeMetricsWithAccessTokenPassthrough(t *testing.T) {
 	fromHeaders := "AccessTokenFromClientHeaders"
 	fromLabels := []string{"AccessTokenFromLabel0", "AccessTokenFromLabel1"}
@@ -603,6 +713,80 @@ func TestConsumeEventData(t *testing.T) {
 	}
 }
 
+func TestConsumeEventDataWithLogEventRules(t *testing.T) {
+	var received []*sfxpb.Event
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body io.Reader = r.Body
+		if r.Header.Get("Content-Encoding") == "gzip" {
+			gr, err := gzip.NewReader(r.Body)
+			require.NoError(t, err)
+			body = gr
+		}
+		b, err := io.ReadAll(body)
+		require.NoError(t, err)
+		msg := sfxpb.EventUploadMessage{}
+		require.NoError(t, msg.Unmarshal(b))
+		received = msg.Events
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	rules, err := newLogEventRules([]LogEventConfig{
+		{
+			Condition:  `attributes["event.domain"] == "deploy"`,
+			EventType:  "deployment",
+			Category:   "AUDIT",
+			Dimensions: map[string]string{"service.name": "service"},
+		},
+	}, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	eventClient := &sfxEventClient{
+		sfxClientBase: sfxClientBase{
+			ingestURL: serverURL,
+			client: &http.Client{
+				Timeout: 1 * time.Second,
+			},
+			zippers: newGzipPool(),
+		},
+		logger:        zap.NewNop(),
+		logEventRules: rules,
+	}
+
+	logs := makeSampleResourceLogs()
+	rl := logs.ResourceLogs().At(0)
+	rl.Resource().Attributes().PutStr("service.name", "checkout")
+	lrs := rl.ScopeLogs().At(0).LogRecords()
+	matching := lrs.AppendEmpty()
+	matching.SetTimestamp(pcommon.Timestamp(1000000000))
+	matching.Attributes().PutStr("event.domain", "deploy")
+	lrs.AppendEmpty().Attributes().PutStr("event.domain", "browser")
+
+	numDroppedLogRecords, err := eventClient.pushLogsData(context.Background(), logs)
+	require.NoError(t, err)
+	assert.Equal(t, 1, numDroppedLogRecords)
+
+	require.Len(t, received, 2)
+	assert.Equal(t, "shutdown", received[0].EventType)
+	category := sfxpb.EventCategory_AUDIT
+	assert.Equal(t, &sfxpb.Event{
+		EventType:  "deployment",
+		Category:   &category,
+		Timestamp:  1000,
+		Dimensions: []*sfxpb.Dimension{{Key: "service", Value: "checkout"}},
+	}, received[1])
+}
+
+func TestNewLogEventRulesInvalidCondition(t *testing.T) {
+	_, err := newLogEventRules([]LogEventConfig{
+		{Condition: `attributes["event.domain"] ==`, EventType: "deployment"},
+	}, componenttest.NewNopTelemetrySettings())
+	assert.ErrorContains(t, err, `invalid condition in "log_events" rule 0`)
+}
+
 func TestConsumeLogsDataWithAccessTokenPassthrough(t *testing.T) {
 	fromHeaders := "AccessTokenFromClientHeaders"
 	fromLabels := "AccessTokenFromLabel"
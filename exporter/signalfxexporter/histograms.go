@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signalfxexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func isHistogram(m pmetric.Metric) bool {
+	return m.Type() == pmetric.MetricTypeHistogram || m.Type() == pmetric.MetricTypeExponentialHistogram
+}
+
+func hasHistograms(md pmetric.Metrics) bool {
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		sms := rms.At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			ms := sms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				if isHistogram(ms.At(k)) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// splitHistograms separates the explicit bucket and exponential histograms of md from the
+// other metrics, keeping their resource and scope. md itself is left unchanged.
+func splitHistograms(md pmetric.Metrics) (others pmetric.Metrics, histograms pmetric.Metrics) {
+	if !hasHistograms(md) {
+		return md, pmetric.NewMetrics()
+	}
+	others = pmetric.NewMetrics()
+	md.CopyTo(others)
+	histograms = pmetric.NewMetrics()
+	md.CopyTo(histograms)
+
+	filterMetrics(others, isHistogram)
+	filterMetrics(histograms, func(m pmetric.Metric) bool { return !isHistogram(m) })
+	return others, histograms
+}
+
+// filterMetrics removes the metrics matching remove, and the scopes and resources
+// left without metrics.
+func filterMetrics(md pmetric.Metrics, remove func(pmetric.Metric) bool) {
+	md.ResourceMetrics().RemoveIf(func(rm pmetric.ResourceMetrics) bool {
+		rm.ScopeMetrics().RemoveIf(func(sm pmetric.ScopeMetrics) bool {
+			sm.Metrics().RemoveIf(remove)
+			return sm.Metrics().Len() == 0
+		})
+		return rm.ScopeMetrics().Len() == 0
+	})
+}
@@ -0,0 +1,57 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signalfxexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestSplitHistograms(t *testing.T) {
+	md := pmetric.NewMetrics()
+	gauges := md.ResourceMetrics().AppendEmpty()
+	gauges.Resource().Attributes().PutStr("service.name", "gauges")
+	gauges.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptyGauge()
+
+	others, histograms := splitHistograms(md)
+	assert.Equal(t, md, others)
+	assert.Equal(t, 0, histograms.ResourceMetrics().Len())
+
+	mixed := md.ResourceMetrics().AppendEmpty()
+	mixed.Resource().Attributes().PutStr("service.name", "mixed")
+	sm := mixed.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName("scope")
+	sm.Metrics().AppendEmpty().SetEmptySum()
+	sm.Metrics().AppendEmpty().SetEmptyHistogram()
+	mixed.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptyExponentialHistogram()
+
+	others, histograms = splitHistograms(md)
+	assert.Equal(t, 4, md.MetricCount())
+	assert.Equal(t, 2, others.ResourceMetrics().Len())
+	assert.Equal(t, 2, others.MetricCount())
+	assert.Equal(t, 1, others.ResourceMetrics().At(1).ScopeMetrics().Len())
+
+	require.Equal(t, 1, histograms.ResourceMetrics().Len())
+	rm := histograms.ResourceMetrics().At(0)
+	serviceName, _ := rm.Resource().Attributes().Get("service.name")
+	assert.Equal(t, "mixed", serviceName.Str())
+	require.Equal(t, 2, rm.ScopeMetrics().Len())
+	assert.Equal(t, "scope", rm.ScopeMetrics().At(0).Scope().Name())
+	assert.Equal(t, pmetric.MetricTypeHistogram, rm.ScopeMetrics().At(0).Metrics().At(0).Type())
+	assert.Equal(t, pmetric.MetricTypeExponentialHistogram, rm.ScopeMetrics().At(1).Metrics().At(0).Type())
+}
@@ -16,6 +16,7 @@ package translation // import "github.com/open-telemetry/opentelemetry-collector
 
 import (
 	"fmt"
+	"sort"
 
 	sfxpb "github.com/signalfx/com_signalfx_metrics_protobuf/model"
 	"go.opentelemetry.io/collector/pdata/pcommon"
@@ -46,6 +47,93 @@ func LogRecordSliceToSignalFxV2(
 	return events, numDroppedLogRecords
 }
 
+// LogRecordToSignalFxV2 converts a log record which carries the SignalFx event attributes,
+// as produced by the signalfx receiver, to a SignalFx event. It returns false if the log
+// record is not a SignalFx event.
+func LogRecordToSignalFxV2(logger *zap.Logger, lr plog.LogRecord, resourceAttrs pcommon.Map) (*sfxpb.Event, bool) {
+	return convertLogRecord(lr, resourceAttrs, logger)
+}
+
+// EventMapping defines how an arbitrary log record is converted to a SignalFx event.
+type EventMapping struct {
+	EventType string
+	Category  sfxpb.EventCategory
+	// Dimensions maps log record or resource attribute keys to dimension keys.
+	// When empty, all string attributes are used as dimensions.
+	Dimensions map[string]string
+	// Properties maps log record or resource attribute keys to property keys.
+	Properties map[string]string
+}
+
+// MappedLogRecordToSignalFxV2 converts a log record to a SignalFx event according to the mapping.
+// Log record attributes take priority over resource attributes with the same key.
+func MappedLogRecordToSignalFxV2(logger *zap.Logger, lr plog.LogRecord, resourceAttrs pcommon.Map, mapping *EventMapping) *sfxpb.Event {
+	category := mapping.Category
+	event := &sfxpb.Event{
+		EventType: mapping.EventType,
+		Category:  &category,
+	}
+
+	ts := lr.Timestamp()
+	if ts == 0 {
+		ts = lr.ObservedTimestamp()
+	}
+	// Convert nanoseconds to milliseconds, which is the unit of SignalFx event timestamps.
+	event.Timestamp = int64(ts) / 1e6
+
+	lookup := func(k string) (pcommon.Value, bool) {
+		if v, ok := lr.Attributes().Get(k); ok {
+			return v, true
+		}
+		return resourceAttrs.Get(k)
+	}
+
+	if len(mapping.Dimensions) == 0 {
+		dimensions := pcommon.NewMap()
+		resourceAttrs.CopyTo(dimensions)
+		lr.Attributes().Range(func(k string, v pcommon.Value) bool {
+			v.CopyTo(dimensions.PutEmpty(k))
+			return true
+		})
+		dimensions.Sort().Range(func(k string, v pcommon.Value) bool {
+			if v.Type() == pcommon.ValueTypeStr {
+				event.Dimensions = append(event.Dimensions, &sfxpb.Dimension{Key: k, Value: v.Str()})
+			}
+			return true
+		})
+	} else {
+		for _, k := range sortedKeys(mapping.Dimensions) {
+			if v, ok := lookup(k); ok {
+				event.Dimensions = append(event.Dimensions, &sfxpb.Dimension{Key: mapping.Dimensions[k], Value: v.AsString()})
+			}
+		}
+	}
+
+	for _, k := range sortedKeys(mapping.Properties) {
+		v, ok := lookup(k)
+		if !ok {
+			continue
+		}
+		val, err := attributeValToPropertyVal(v)
+		if err != nil {
+			logger.Debug("Failed to convert log record property value to SignalFx property value", zap.Error(err), zap.String("key", k))
+			continue
+		}
+		event.Properties = append(event.Properties, &sfxpb.Property{Key: mapping.Properties[k], Value: val})
+	}
+
+	return event
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func convertLogRecord(lr plog.LogRecord, resourceAttrs pcommon.Map, logger *zap.Logger) (*sfxpb.Event, bool) {
 	attrs := lr.Attributes()
 
@@ -149,6 +149,72 @@ func TestLogDataToSignalFxEvents(t *testing.T) {
 	}
 }
 
+func TestMappedLogRecordToSignalFxV2(t *testing.T) {
+	now := pcommon.NewTimestampFromTime(time.Now())
+	msec := now.AsTime().UnixNano() / 1e6
+
+	newLogRecord := func() (plog.LogRecord, pcommon.Map) {
+		resourceAttrs := pcommon.NewMap()
+		resourceAttrs.PutStr("service.name", "checkout")
+		resourceAttrs.PutStr("host.name", "node-1")
+		lr := plog.NewLogRecord()
+		lr.SetObservedTimestamp(now)
+		lr.Attributes().PutStr("host.name", "node-2")
+		lr.Attributes().PutStr("service.version", "1.2.3")
+		lr.Attributes().PutInt("replicas", 3)
+		return lr, resourceAttrs
+	}
+
+	tests := []struct {
+		name     string
+		mapping  *EventMapping
+		expected *sfxpb.Event
+	}{
+		{
+			name:    "all string attributes as dimensions",
+			mapping: &EventMapping{EventType: "deployment", Category: sfxpb.EventCategory_USER_DEFINED},
+			expected: &sfxpb.Event{
+				EventType: "deployment",
+				Category:  &[]sfxpb.EventCategory{sfxpb.EventCategory_USER_DEFINED}[0],
+				Timestamp: msec,
+				Dimensions: []*sfxpb.Dimension{
+					{Key: "host.name", Value: "node-2"},
+					{Key: "service.name", Value: "checkout"},
+					{Key: "service.version", Value: "1.2.3"},
+				},
+			},
+		},
+		{
+			name: "mapped dimensions and properties",
+			mapping: &EventMapping{
+				EventType:  "deployment",
+				Category:   sfxpb.EventCategory_AUDIT,
+				Dimensions: map[string]string{"service.name": "service", "replicas": "replicas", "missing": "missing"},
+				Properties: map[string]string{"service.version": "version", "replicas": "count"},
+			},
+			expected: &sfxpb.Event{
+				EventType: "deployment",
+				Category:  &[]sfxpb.EventCategory{sfxpb.EventCategory_AUDIT}[0],
+				Timestamp: msec,
+				Dimensions: []*sfxpb.Dimension{
+					{Key: "replicas", Value: "3"},
+					{Key: "service", Value: "checkout"},
+				},
+				Properties: []*sfxpb.Property{
+					{Key: "count", Value: &sfxpb.PropertyValue{IntValue: &[]int64{3}[0]}},
+					{Key: "version", Value: &sfxpb.PropertyValue{StrValue: &[]string{"1.2.3"}[0]}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lr, resourceAttrs := newLogRecord()
+			assert.Equal(t, tt.expected, MappedLogRecordToSignalFxV2(zap.NewNop(), lr, resourceAttrs, tt.mapping))
+		})
+	}
+}
+
 func mapToEventProps(m map[string]interface{}) []*sfxpb.Property {
 	var out []*sfxpb.Property
 	for k, v := range m {
@@ -0,0 +1,68 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signalfxexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter"
+
+import (
+	"context"
+	"fmt"
+
+	sfxpb "github.com/signalfx/com_signalfx_metrics_protobuf/model"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/translation"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllogs"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+)
+
+// logEventFunctions are the functions available to log event conditions.
+func logEventFunctions() map[string]interface{} {
+	return map[string]interface{}{
+		"IsMatch": ottlfuncs.IsMatch[ottllogs.TransformContext],
+	}
+}
+
+// logEventRule converts the log records matching its condition to SignalFx events.
+type logEventRule struct {
+	condition *ottl.Condition[ottllogs.TransformContext]
+	mapping   *translation.EventMapping
+}
+
+func newLogEventRules(cfgs []LogEventConfig, settings component.TelemetrySettings) ([]*logEventRule, error) {
+	parser := ottllogs.NewParser(logEventFunctions(), settings)
+	rules := make([]*logEventRule, 0, len(cfgs))
+	for i, cfg := range cfgs {
+		conditions, err := parser.ParseConditions([]string{cfg.Condition})
+		if err != nil {
+			return nil, fmt.Errorf("invalid condition in \"log_events\" rule %d: %w", i, err)
+		}
+		rules = append(rules, &logEventRule{
+			condition: conditions[0],
+			mapping: &translation.EventMapping{
+				EventType:  cfg.EventType,
+				Category:   sfxpb.EventCategory(sfxpb.EventCategory_value[cfg.category()]),
+				Dimensions: cfg.Dimensions,
+				Properties: cfg.Properties,
+			},
+		})
+	}
+	return rules, nil
+}
+
+func (r *logEventRule) matches(ctx context.Context, lr plog.LogRecord, scope pcommon.InstrumentationScope, resource pcommon.Resource) (bool, error) {
+	return r.condition.Eval(ctx, ottllogs.NewTransformContext(lr, scope, resource))
+}
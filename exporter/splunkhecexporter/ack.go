@@ -0,0 +1,226 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package splunkhecexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/splunkhecexporter"
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/splunk"
+)
+
+// splunkRequestChannelHeader identifies the HEC channel events are sent to. Indexer
+// acknowledgement IDs are scoped to a channel.
+const splunkRequestChannelHeader = "X-Splunk-Request-Channel"
+
+// hecResponse is the body of a successful HEC request.
+type hecResponse struct {
+	Text string `json:"text"`
+	Code int    `json:"code"`
+	// AckID is only set when indexer acknowledgement is enabled on the token.
+	AckID *uint64 `json:"ackId"`
+}
+
+// ackRequest is the body of a request to the HEC ack endpoint.
+type ackRequest struct {
+	Acks []uint64 `json:"acks"`
+}
+
+// ackResponse is the body of a response of the HEC ack endpoint, which reports
+// whether each requested ackID was indexed, e.g. `{"acks":{"0":true,"1":false}}`.
+type ackResponse struct {
+	Acks map[string]bool `json:"acks"`
+}
+
+// ackPoller waits for Splunk to acknowledge that the event batches sent to a channel
+// were indexed. It polls the ack endpoint for all the pending ackIDs at once, grouped
+// by the token the batches were sent with, as ackIDs are only visible to that token.
+type ackPoller struct {
+	url      string
+	client   *http.Client
+	headers  map[string]string
+	interval time.Duration
+	logger   *zap.Logger
+
+	mu sync.Mutex
+	// pending holds the channels closed when an ackID is acknowledged, by the
+	// Authorization header the batch was sent with.
+	pending map[string]map[uint64]chan struct{}
+
+	stopOnce sync.Once
+	stopCh   chan struct{}
+	wg       sync.WaitGroup
+}
+
+func newAckPoller(url string, client *http.Client, headers map[string]string, interval time.Duration, logger *zap.Logger) *ackPoller {
+	return &ackPoller{
+		url:      url,
+		client:   client,
+		headers:  headers,
+		interval: interval,
+		logger:   logger,
+		pending:  map[string]map[uint64]chan struct{}{},
+		stopCh:   make(chan struct{}),
+	}
+}
+
+func (p *ackPoller) start() {
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		ticker := time.NewTicker(p.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				p.poll()
+			case <-p.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+func (p *ackPoller) stop() {
+	p.stopOnce.Do(func() {
+		close(p.stopCh)
+	})
+	p.wg.Wait()
+}
+
+// wait blocks until ackID is acknowledged, and returns an error if it is not
+// acknowledged within timeout so that the batch is retried.
+func (p *ackPoller) wait(ctx context.Context, authorization string, ackID uint64, timeout time.Duration) error {
+	acked := make(chan struct{})
+	p.mu.Lock()
+	if p.pending[authorization] == nil {
+		p.pending[authorization] = map[uint64]chan struct{}{}
+	}
+	p.pending[authorization][ackID] = acked
+	p.mu.Unlock()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	var err error
+	select {
+	case <-acked:
+		return nil
+	case <-timer.C:
+		err = fmt.Errorf("events with ackID %d were not acknowledged by Splunk within %s", ackID, timeout)
+	case <-ctx.Done():
+		err = ctx.Err()
+	case <-p.stopCh:
+		err = fmt.Errorf("exporter stopped before events with ackID %d were acknowledged", ackID)
+	}
+
+	p.mu.Lock()
+	delete(p.pending[authorization], ackID)
+	if len(p.pending[authorization]) == 0 {
+		delete(p.pending, authorization)
+	}
+	p.mu.Unlock()
+	return err
+}
+
+// poll queries the status of the pending ackIDs, and releases the acknowledged ones.
+func (p *ackPoller) poll() {
+	p.mu.Lock()
+	requests := make(map[string][]uint64, len(p.pending))
+	for authorization, acks := range p.pending {
+		ids := make([]uint64, 0, len(acks))
+		for id := range acks {
+			ids = append(ids, id)
+		}
+		requests[authorization] = ids
+	}
+	p.mu.Unlock()
+
+	for authorization, ids := range requests {
+		acked, err := p.queryAcks(authorization, ids)
+		if err != nil {
+			p.logger.Debug("Failed to poll indexer acknowledgements", zap.Error(err))
+			continue
+		}
+
+		p.mu.Lock()
+		for _, id := range acked {
+			if ch, ok := p.pending[authorization][id]; ok {
+				close(ch)
+				delete(p.pending[authorization], id)
+			}
+		}
+		if len(p.pending[authorization]) == 0 {
+			delete(p.pending, authorization)
+		}
+		p.mu.Unlock()
+	}
+}
+
+// queryAcks returns the ackIDs among ids that were acknowledged.
+func (p *ackPoller) queryAcks(authorization string, ids []uint64) ([]uint64, error) {
+	body, err := jsoniter.Marshal(ackRequest{Acks: ids})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range p.headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Authorization", authorization)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err = splunk.HandleHTTPCode(resp); err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var ackResp ackResponse
+	if err = jsoniter.Unmarshal(respBody, &ackResp); err != nil {
+		return nil, fmt.Errorf("invalid ack response %q: %w", respBody, err)
+	}
+
+	var acked []uint64
+	for id, ok := range ackResp.Acks {
+		if !ok {
+			continue
+		}
+		ackID, err := strconv.ParseUint(id, 10, 64)
+		if err != nil {
+			continue
+		}
+		acked = append(acked, ackID)
+	}
+	return acked, nil
+}
@@ -0,0 +1,175 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package splunkhecexporter
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.uber.org/zap/zaptest"
+)
+
+// fakeAckHEC is a HEC endpoint with indexer acknowledgement, which acknowledges the
+// batches once they were polled ackAfter times.
+type fakeAckHEC struct {
+	ackAfter int
+	noAckID  bool
+
+	mu       sync.Mutex
+	nextID   uint64
+	polls    map[uint64]int
+	channels map[string]bool
+	tokens   map[string]bool
+	events   int
+}
+
+func newFakeAckHEC(ackAfter int) *fakeAckHEC {
+	return &fakeAckHEC{
+		ackAfter: ackAfter,
+		polls:    map[uint64]int{},
+		channels: map[string]bool{},
+		tokens:   map[string]bool{},
+	}
+}
+
+func (h *fakeAckHEC) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.channels[r.Header.Get(splunkRequestChannelHeader)] = true
+	h.tokens[r.Header.Get("Authorization")] = true
+	body, _ := io.ReadAll(r.Body)
+
+	switch r.URL.Path {
+	case "/services/collector/ack":
+		var req ackRequest
+		if err := jsoniter.Unmarshal(body, &req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		resp := ackResponse{Acks: map[string]bool{}}
+		for _, id := range req.Acks {
+			h.polls[id]++
+			resp.Acks[strconv.FormatUint(id, 10)] = h.polls[id] >= h.ackAfter
+		}
+		b, _ := jsoniter.Marshal(resp)
+		_, _ = w.Write(b)
+	case "/services/collector":
+		h.events++
+		if h.noAckID {
+			_, _ = w.Write([]byte(`{"text":"Success","code":0}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"text":"Success","code":0,"ackId":` + strconv.FormatUint(h.nextID, 10) + `}`))
+		h.nextID++
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newAckTestClient(t *testing.T, hec *fakeAckHEC, timeout time.Duration) *client {
+	server := httptest.NewServer(hec)
+	t.Cleanup(server.Close)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = server.URL
+	cfg.Token = "1234"
+	cfg.DisableCompression = true
+	cfg.Ack = AckSettings{Enabled: true, PollInterval: 10 * time.Millisecond, Timeout: timeout}
+	require.NoError(t, cfg.Validate())
+	options, err := cfg.getOptionsFromConfig()
+	require.NoError(t, err)
+
+	c, err := buildClient(options, cfg, zaptest.NewLogger(t))
+	require.NoError(t, err)
+	require.NoError(t, c.start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { assert.NoError(t, c.stop(context.Background())) })
+	return c
+}
+
+func TestAckAcknowledged(t *testing.T) {
+	hec := newFakeAckHEC(2)
+	c := newAckTestClient(t, hec, time.Minute)
+
+	require.NoError(t, c.pushLogData(context.Background(), createLogData(1, 1, 3)))
+	require.NoError(t, c.pushTraceData(context.Background(), createTraceData(3)))
+
+	hec.mu.Lock()
+	defer hec.mu.Unlock()
+	assert.Equal(t, 2, hec.events)
+	assert.Equal(t, map[uint64]int{0: 2, 1: 2}, hec.polls)
+	// events and polls use the same channel
+	assert.Len(t, hec.channels, 1)
+	assert.False(t, hec.channels[""])
+	assert.Equal(t, map[string]bool{"Splunk 1234": true}, hec.tokens)
+}
+
+func TestAckTimeout(t *testing.T) {
+	hec := newFakeAckHEC(1000)
+	c := newAckTestClient(t, hec, 50*time.Millisecond)
+
+	logs := createLogData(1, 1, 3)
+	err := c.pushLogData(context.Background(), logs)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "events with ackID 0 were not acknowledged by Splunk within 50ms")
+	// unacknowledged events are retried
+	assert.False(t, consumererror.IsPermanent(err))
+	var logsErr consumererror.Logs
+	require.ErrorAs(t, err, &logsErr)
+	assert.Equal(t, logs, logsErr.GetLogs())
+}
+
+func TestAckNotEnabledOnToken(t *testing.T) {
+	hec := newFakeAckHEC(1)
+	hec.noAckID = true
+	c := newAckTestClient(t, hec, time.Minute)
+
+	require.NoError(t, c.pushMetricsData(context.Background(), createMetricsData(3)))
+	assert.Empty(t, hec.polls)
+}
+
+func TestAckPollerStopped(t *testing.T) {
+	hec := newFakeAckHEC(1000)
+	c := newAckTestClient(t, hec, time.Minute)
+
+	done := make(chan error)
+	go func() {
+		done <- c.pushTraceData(context.Background(), createTraceData(1))
+	}()
+	require.Eventually(t, func() bool {
+		hec.mu.Lock()
+		defer hec.mu.Unlock()
+		return len(hec.polls) > 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	c.ackPoller.stop()
+	select {
+	case err := <-done:
+		assert.ErrorContains(t, err, "exporter stopped before events with ackID 0 were acknowledged")
+	case <-time.After(5 * time.Second):
+		t.Fatal("waiting for the acknowledgement was not interrupted")
+	}
+}
@@ -53,6 +53,8 @@ type client struct {
 	wg             sync.WaitGroup
 	headers        map[string]string
 	gzipWriterPool *sync.Pool
+	// ackPoller waits for the acknowledgement of the sent batches, if enabled.
+	ackPoller *ackPoller
 }
 
 // bufferState encapsulates intermediate buffer state when pushing data
@@ -669,8 +671,29 @@ func (c *client) postEvents(ctx context.Context, events io.Reader, headers map[s
 		return err
 	}
 
-	_, errCopy := io.Copy(io.Discard, resp.Body)
-	return multierr.Combine(err, errCopy)
+	if c.ackPoller == nil {
+		_, errCopy := io.Copy(io.Discard, resp.Body)
+		return multierr.Combine(err, errCopy)
+	}
+	return c.waitForAck(ctx, req.Header.Get("Authorization"), resp.Body)
+}
+
+// waitForAck waits for the batch of the given HEC response to be indexed.
+func (c *client) waitForAck(ctx context.Context, authorization string, body io.Reader) error {
+	respBody, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	var hecResp hecResponse
+	if err = jsoniter.Unmarshal(respBody, &hecResp); err != nil {
+		return fmt.Errorf("invalid HEC response %q: %w", respBody, err)
+	}
+	if hecResp.AckID == nil {
+		// the events were accepted, but Splunk cannot confirm they are indexed
+		c.logger.Warn("Indexer acknowledgement is not enabled on the HEC token, events are not acknowledged")
+		return nil
+	}
+	return c.ackPoller.wait(ctx, authorization, *hecResp.AckID, c.config.Ack.Timeout)
 }
 
 // subLogs returns a subset of `ld` starting from `profilingBufFront` for profiling data
@@ -836,9 +859,15 @@ func subTracesByType(src ptrace.Traces, from *index, dst ptrace.Traces) {
 
 func (c *client) stop(context.Context) error {
 	c.wg.Wait()
+	if c.ackPoller != nil {
+		c.ackPoller.stop()
+	}
 	return nil
 }
 
 func (c *client) start(context.Context, component.Host) (err error) {
+	if c.ackPoller != nil {
+		c.ackPoller.start()
+	}
 	return nil
 }
@@ -19,6 +19,8 @@ import (
 	"fmt"
 	"net/url"
 	"path"
+	"strings"
+	"time"
 
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/config/configtls"
@@ -36,8 +38,22 @@ const (
 	maxContentLengthLogsLimit        = 800 * 1024 * 1024
 	maxContentLengthMetricsLimit     = 800 * 1024 * 1024
 	maxContentLengthTracesLimit      = 800 * 1024 * 1024
+	defaultAckPollInterval           = 10 * time.Second
+	defaultAckTimeout                = 2 * time.Minute
 )
 
+// AckSettings defines the indexer acknowledgement settings.
+type AckSettings struct {
+	// Enabled makes the exporter wait for Splunk to acknowledge that each batch of events
+	// was indexed before reporting it as delivered, and retry the batches which are not
+	// acknowledged in time. Requires indexer acknowledgement to be enabled on the HEC token.
+	Enabled bool `mapstructure:"enabled"`
+	// PollInterval is the interval the acknowledgement status is polled at. Defaults to 10s.
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	// Timeout is how long to wait for the acknowledgement of a batch before retrying it. Defaults to 2m.
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
 // OtelToHecFields defines the mapping of attributes to HEC fields
 type OtelToHecFields struct {
 	// SeverityText informs the exporter to map the severity text field to a specific HEC field.
@@ -107,6 +123,8 @@ type Config struct {
 	HecToOtelAttrs splunk.HecToOtelAttrs `mapstructure:"hec_metadata_to_otel_attrs"`
 	// HecFields creates a mapping from attributes to HEC fields.
 	HecFields OtelToHecFields `mapstructure:"otel_to_hec_fields"`
+	// Ack defines the indexer acknowledgement settings.
+	Ack AckSettings `mapstructure:"ack"`
 }
 
 func (cfg *Config) getOptionsFromConfig() (*exporterOptions, error) {
@@ -162,6 +180,21 @@ func (cfg *Config) getURL() (out *url.URL, err error) {
 	return
 }
 
+// getAckURL returns the URL of the HEC ack endpoint, which is a sibling of the event
+// endpoints, e.g. `services/collector/ack` for `services/collector/event`.
+func (cfg *Config) getAckURL() (*url.URL, error) {
+	out, err := cfg.getURL()
+	if err != nil {
+		return nil, err
+	}
+	p := strings.TrimSuffix(out.Path, "/")
+	for _, suffix := range []string{"/event", "/raw"} {
+		p = strings.TrimSuffix(p, suffix)
+	}
+	out.Path = path.Join(p, "ack")
+	return out, nil
+}
+
 // Validate checks if the exporter configuration is valid.
 func (cfg *Config) Validate() error {
 	if err := cfg.QueueSettings.Validate(); err != nil {
@@ -170,5 +203,13 @@ func (cfg *Config) Validate() error {
 	if !cfg.LogDataEnabled && !cfg.ProfilingDataEnabled {
 		return errors.New(`either "log_data_enabled" or "profiling_data_enabled" has to be true`)
 	}
+	if cfg.Ack.Enabled {
+		if cfg.Ack.PollInterval <= 0 {
+			return errors.New(`requires "ack.poll_interval" > 0`)
+		}
+		if cfg.Ack.Timeout < cfg.Ack.PollInterval {
+			return errors.New(`requires "ack.timeout" >= "ack.poll_interval"`)
+		}
+	}
 	return nil
 }
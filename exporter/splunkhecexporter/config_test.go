@@ -100,6 +100,11 @@ func TestLoadConfig(t *testing.T) {
 					SeverityNumber: "myseveritynumfield",
 					Name:           "mynamefield",
 				},
+				Ack: AckSettings{
+					Enabled:      true,
+					PollInterval: 5 * time.Second,
+					Timeout:      time.Minute,
+				},
 			},
 		},
 	}
@@ -225,3 +230,58 @@ func TestConfig_getOptionsFromConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_ValidateAck(t *testing.T) {
+	tests := []struct {
+		name    string
+		ack     AckSettings
+		wantErr string
+	}{
+		{
+			name: "disabled",
+			ack:  AckSettings{},
+		},
+		{
+			name: "enabled",
+			ack:  AckSettings{Enabled: true, PollInterval: time.Second, Timeout: time.Minute},
+		},
+		{
+			name:    "missing poll interval",
+			ack:     AckSettings{Enabled: true, Timeout: time.Minute},
+			wantErr: `requires "ack.poll_interval" > 0`,
+		},
+		{
+			name:    "timeout shorter than poll interval",
+			ack:     AckSettings{Enabled: true, PollInterval: time.Minute, Timeout: time.Second},
+			wantErr: `requires "ack.timeout" >= "ack.poll_interval"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Ack = tt.ack
+			err := cfg.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConfig_getAckURL(t *testing.T) {
+	for endpoint, want := range map[string]string{
+		"https://splunk:8088":                              "https://splunk:8088/services/collector/ack",
+		"https://splunk:8088/services/collector":           "https://splunk:8088/services/collector/ack",
+		"https://splunk:8088/services/collector/event":     "https://splunk:8088/services/collector/ack",
+		"https://splunk:8088/services/collector/raw/":      "https://splunk:8088/services/collector/ack",
+		"https://splunk:8088/proxy/services/collector/":    "https://splunk:8088/proxy/services/collector/ack",
+		"https://splunk:8088/services/collector/event?x=1": "https://splunk:8088/services/collector/ack?x=1",
+	} {
+		cfg := &Config{Endpoint: endpoint}
+		got, err := cfg.getAckURL()
+		require.NoError(t, err)
+		assert.Equal(t, want, got.String(), endpoint)
+	}
+}
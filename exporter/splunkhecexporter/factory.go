@@ -86,6 +86,10 @@ func createDefaultConfig() component.ExporterConfig {
 			SeverityNumber: splunk.DefaultSeverityNumberLabel,
 			Name:           splunk.DefaultNameLabel,
 		},
+		Ack: AckSettings{
+			PollInterval: defaultAckPollInterval,
+			Timeout:      defaultAckTimeout,
+		},
 	}
 }
 
@@ -30,6 +30,9 @@ type HtpasswdSettings struct {
 	File string `mapstructure:"file"`
 	// Inline contents of the htpasswd file.
 	Inline string `mapstructure:"inline"`
+	// Attributes of the users, keyed by username, exposed with the auth data of
+	// the authenticated clients, e.g. to set their tenant as an attribute of their data.
+	Attributes map[string]map[string]string `mapstructure:"attributes"`
 }
 
 type ClientAuthSettings struct {
@@ -43,6 +43,9 @@ func TestLoadConfig(t *testing.T) {
 				ExtensionSettings: config.NewExtensionSettings(component.NewID(typeStr)),
 				Htpasswd: &HtpasswdSettings{
 					Inline: "username1:password1\nusername2:password2\n",
+					Attributes: map[string]map[string]string{
+						"username1": {"tenant": "acme"},
+					},
 				},
 			},
 		},
@@ -23,11 +23,14 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"sync"
 
+	"github.com/fsnotify/fsnotify"
 	"github.com/tg123/go-htpasswd"
 	"go.opentelemetry.io/collector/client"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configauth"
+	"go.uber.org/zap"
 	creds "google.golang.org/grpc/credentials"
 )
 
@@ -41,7 +44,13 @@ var (
 type basicAuth struct {
 	htpasswd   *HtpasswdSettings
 	clientAuth *ClientAuthSettings
-	matchFunc  func(username, password string) bool
+	logger     *zap.Logger
+
+	matchFuncMu sync.RWMutex
+	matchFunc   func(username, password string) bool
+
+	shutdownCH chan struct{}
+	watcherWG  sync.WaitGroup
 }
 
 func newClientAuthExtension(cfg *Config) (configauth.ClientAuthenticator, error) {
@@ -58,22 +67,94 @@ func newClientAuthExtension(cfg *Config) (configauth.ClientAuthenticator, error)
 	), nil
 }
 
-func newServerAuthExtension(cfg *Config) (configauth.ServerAuthenticator, error) {
+func newServerAuthExtension(cfg *Config, logger *zap.Logger) (configauth.ServerAuthenticator, error) {
 
 	if cfg.Htpasswd == nil || (cfg.Htpasswd.File == "" && cfg.Htpasswd.Inline == "") {
 		return nil, errNoCredentialSource
 	}
 
-	ba := basicAuth{
+	ba := &basicAuth{
 		htpasswd: cfg.Htpasswd,
+		logger:   logger,
 	}
 	return configauth.NewServerAuthenticator(
 		configauth.WithStart(ba.serverStart),
+		configauth.WithShutdown(ba.serverShutdown),
 		configauth.WithAuthenticate(ba.authenticate),
 	), nil
 }
 
 func (ba *basicAuth) serverStart(ctx context.Context, host component.Host) error {
+	if err := ba.loadHtpasswd(); err != nil {
+		return err
+	}
+	if ba.htpasswd.File == "" {
+		return nil
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("watch htpasswd file: %w", err)
+	}
+	if err = watcher.Add(ba.htpasswd.File); err != nil {
+		_ = watcher.Close()
+		return fmt.Errorf("watch htpasswd file: %w", err)
+	}
+
+	ba.shutdownCH = make(chan struct{})
+	ba.watcherWG.Add(1)
+	go ba.watchHtpasswd(watcher)
+	return nil
+}
+
+func (ba *basicAuth) serverShutdown(context.Context) error {
+	if ba.shutdownCH != nil {
+		close(ba.shutdownCH)
+		ba.watcherWG.Wait()
+		ba.shutdownCH = nil
+	}
+	return nil
+}
+
+// watchHtpasswd reloads the htpasswd file when it changes, until the extension shuts down.
+func (ba *basicAuth) watchHtpasswd(watcher *fsnotify.Watcher) {
+	defer ba.watcherWG.Done()
+	defer watcher.Close()
+	for {
+		select {
+		case <-ba.shutdownCH:
+			return
+		case err, ok := <-watcher.Errors:
+			if ok {
+				ba.logger.Error("Failed to watch the htpasswd file", zap.Error(err))
+			}
+		case event, ok := <-watcher.Events:
+			if !ok {
+				continue
+			}
+			// NOTE: k8s configmaps and secrets replace the file by updating a symlink,
+			// so the watch of the removed file is replaced by a watch of the new one.
+			// SEE: https://martensson.io/go-fsnotify-and-kubernetes-configmaps/
+			if event.Op&(fsnotify.Remove|fsnotify.Chmod) != 0 {
+				_ = watcher.Remove(event.Name)
+				if err := watcher.Add(ba.htpasswd.File); err != nil {
+					ba.logger.Error("Failed to watch the htpasswd file", zap.Error(err))
+				}
+			}
+			if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Remove|fsnotify.Chmod) == 0 {
+				continue
+			}
+			if err := ba.loadHtpasswd(); err != nil {
+				ba.logger.Error("Failed to reload the htpasswd file, keeping the previous credentials", zap.Error(err))
+				continue
+			}
+			ba.logger.Info("Reloaded the htpasswd file", zap.String("file", ba.htpasswd.File))
+		}
+	}
+}
+
+// loadHtpasswd reads the credentials of the htpasswd file and inline content.
+func (ba *basicAuth) loadHtpasswd() error {
 	var rs []io.Reader
 
 	if ba.htpasswd.File != "" {
@@ -97,7 +178,9 @@ func (ba *basicAuth) serverStart(ctx context.Context, host component.Host) error
 		return fmt.Errorf("read htpasswd content: %w", err)
 	}
 
+	ba.matchFuncMu.Lock()
 	ba.matchFunc = htp.Match
+	ba.matchFuncMu.Unlock()
 
 	return nil
 }
@@ -113,9 +196,13 @@ func (ba *basicAuth) authenticate(ctx context.Context, headers map[string][]stri
 		return ctx, err
 	}
 
-	if !ba.matchFunc(authData.username, authData.password) {
+	ba.matchFuncMu.RLock()
+	matchFunc := ba.matchFunc
+	ba.matchFuncMu.RUnlock()
+	if !matchFunc(authData.username, authData.password) {
 		return ctx, errInvalidCredentials
 	}
+	authData.attributes = ba.htpasswd.Attributes[authData.username]
 
 	cl := client.FromContext(ctx)
 	cl.Auth = authData
@@ -179,9 +266,10 @@ func parseBasicAuth(auth string) (*authData, error) {
 var _ client.AuthData = (*authData)(nil)
 
 type authData struct {
-	username string
-	password string
-	raw      string
+	username   string
+	password   string
+	raw        string
+	attributes map[string]string
 }
 
 func (a *authData) GetAttribute(name string) interface{} {
@@ -191,12 +279,21 @@ func (a *authData) GetAttribute(name string) interface{} {
 	case "raw":
 		return a.raw
 	default:
+		if value, ok := a.attributes[name]; ok {
+			return value
+		}
 		return nil
 	}
 }
 
-func (*authData) GetAttributeNames() []string {
-	return []string{"username", "raw"}
+func (a *authData) GetAttributeNames() []string {
+	names := []string{"username", "raw"}
+	for name := range a.attributes {
+		if name != "username" && name != "raw" {
+			names = append(names, name)
+		}
+	}
+	return names
 }
 
 // perRPCAuth is a gRPC credentials.PerRPCCredentials implementation that returns an 'authorization' header.
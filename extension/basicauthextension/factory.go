@@ -41,10 +41,10 @@ func createDefaultConfig() component.ExtensionConfig {
 	}
 }
 
-func createExtension(_ context.Context, _ component.ExtensionCreateSettings, cfg component.ExtensionConfig) (component.Extension, error) {
+func createExtension(_ context.Context, set component.ExtensionCreateSettings, cfg component.ExtensionConfig) (component.Extension, error) {
 	// check if config is a server auth(Htpasswd should be set)
 	if cfg.(*Config).Htpasswd != nil {
-		return newServerAuthExtension(cfg.(*Config))
+		return newServerAuthExtension(cfg.(*Config), set.Logger)
 	}
 	return newClientAuthExtension(cfg.(*Config))
 }
@@ -3,16 +3,17 @@ module github.com/open-telemetry/opentelemetry-collector-contrib/extension/basic
 go 1.18
 
 require (
+	github.com/fsnotify/fsnotify v1.6.0
 	github.com/stretchr/testify v1.8.1
 	github.com/tg123/go-htpasswd v1.2.0
 	go.opentelemetry.io/collector v0.64.2-0.20221110222631-20e3aac00413
+	go.uber.org/zap v1.23.0
 	google.golang.org/grpc v1.50.1
 )
 
 require (
 	github.com/GehirnInc/crypt v0.0.0-20200316065508-bb7000b8a962 // indirect
 	github.com/davecgh/go-spew v1.1.1 // indirect
-	github.com/fsnotify/fsnotify v1.6.0 // indirect
 	github.com/gogo/protobuf v1.3.2 // indirect
 	github.com/golang/protobuf v1.5.2 // indirect
 	github.com/json-iterator/go v1.1.12 // indirect
@@ -31,7 +32,6 @@ require (
 	go.opentelemetry.io/otel/trace v1.11.1 // indirect
 	go.uber.org/atomic v1.10.0 // indirect
 	go.uber.org/multierr v1.8.0 // indirect
-	go.uber.org/zap v1.23.0 // indirect
 	golang.org/x/crypto v0.0.0-20220507011949-2cf3adece122 // indirect
 	golang.org/x/net v0.0.0-20220225172249-27dd8689420f // indirect
 	golang.org/x/sys v0.0.0-20220919091848-fb04ddd9f9c8 // indirect
@@ -16,28 +16,42 @@ package bearertokenauthextension // import "github.com/open-telemetry/openteleme
 
 import (
 	"context"
+	"crypto/subtle"
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
+	"strings"
 	"sync"
 
 	"github.com/fsnotify/fsnotify"
+	"go.opentelemetry.io/collector/client"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configauth"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/credentials"
 )
 
+var (
+	errNoAuth              = errors.New("no bearer token provided")
+	errInvalidSchemePrefix = errors.New("invalid authorization scheme prefix")
+	errInvalidToken        = errors.New("invalid bearer token")
+)
+
 var _ credentials.PerRPCCredentials = (*PerRPCAuth)(nil)
 
 // PerRPCAuth is a gRPC credentials.PerRPCCredentials implementation that returns an 'authorization' header.
 type PerRPCAuth struct {
-	metadata map[string]string
+	auth *BearerTokenAuth
 }
 
 // GetRequestMetadata returns the request metadata to be used with the RPC.
-func (c *PerRPCAuth) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
-	return c.metadata, nil
+func (c *PerRPCAuth) GetRequestMetadata(ctx context.Context, _ ...string) (map[string]string, error) {
+	token, err := c.auth.authorization(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{"authorization": token}, nil
 }
 
 // RequireTransportSecurity always returns true for this implementation. Passing bearer tokens in plain-text connections is a bad idea.
@@ -45,37 +59,71 @@ func (c *PerRPCAuth) RequireTransportSecurity() bool {
 	return true
 }
 
+// acceptedToken is a token accepted by the server authenticator, read from a file when filename is set.
+type acceptedToken struct {
+	token      string
+	filename   string
+	attributes map[string]string
+}
+
 // BearerTokenAuth is an implementation of configauth.GRPCClientAuthenticator. It embeds a static authorization "bearer" token in every rpc call.
+// It also implements configauth.ServerAuthenticator, accepting the requests bearing one of the configured tokens.
 type BearerTokenAuth struct {
 	muTokenString sync.RWMutex
 	scheme        string
 	tokenString   string
+	tokens        []acceptedToken
+
+	command *commandTokenSource
 
 	shutdownCH chan struct{}
 
 	filename string
-	logger   *zap.Logger
+	// files are the files containing the token to be transferred and the accepted tokens, they
+	// don't change once the tokens are refreshed.
+	files  []string
+	logger *zap.Logger
 }
 
 var _ configauth.ClientAuthenticator = (*BearerTokenAuth)(nil)
+var _ configauth.ServerAuthenticator = (*BearerTokenAuth)(nil)
 
 func newBearerTokenAuth(cfg *Config, logger *zap.Logger) *BearerTokenAuth {
 	if cfg.Filename != "" && cfg.BearerToken != "" {
 		logger.Warn("a filename is specified. Configured token is ignored!")
 	}
-	return &BearerTokenAuth{
+	b := &BearerTokenAuth{
 		scheme:      cfg.Scheme,
 		tokenString: cfg.BearerToken,
 		filename:    cfg.Filename,
 		logger:      logger,
 	}
+	for _, token := range cfg.Tokens {
+		b.tokens = append(b.tokens, acceptedToken{
+			token:      token.Token,
+			filename:   token.Filename,
+			attributes: token.Attributes,
+		})
+	}
+	if cfg.Command != nil {
+		b.command = newCommandTokenSource(cfg.Command)
+	}
+	if b.filename != "" {
+		b.files = append(b.files, b.filename)
+	}
+	for _, token := range b.tokens {
+		if token.filename != "" {
+			b.files = append(b.files, token.filename)
+		}
+	}
+	return b
 }
 
 // Start of BearerTokenAuth does nothing and returns nil if no filename
-// is specified. Otherwise a routine is started to monitor the file containing
-// the token to be transferred.
+// is specified. Otherwise a routine is started to monitor the files containing
+// the token to be transferred and the accepted tokens.
 func (b *BearerTokenAuth) Start(ctx context.Context, host component.Host) error {
-	if b.filename == "" {
+	if len(b.files) == 0 {
 		return nil
 	}
 
@@ -83,7 +131,7 @@ func (b *BearerTokenAuth) Start(ctx context.Context, host component.Host) error
 		return fmt.Errorf("bearerToken file monitoring is already running")
 	}
 
-	// Read file once
+	// Read files once
 	b.refreshToken()
 
 	b.shutdownCH = make(chan struct{})
@@ -95,7 +143,12 @@ func (b *BearerTokenAuth) Start(ctx context.Context, host component.Host) error
 	// start file watcher
 	go b.startWatcher(ctx, watcher)
 
-	return watcher.Add(b.filename)
+	for _, file := range b.files {
+		if err := watcher.Add(file); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (b *BearerTokenAuth) startWatcher(ctx context.Context, watcher *fsnotify.Watcher) {
@@ -120,7 +173,7 @@ func (b *BearerTokenAuth) startWatcher(ctx context.Context, watcher *fsnotify.Wa
 					b.logger.Error(err.Error())
 				}
 				// add a new watcher pointing to the new symlink/file
-				if err := watcher.Add(b.filename); err != nil {
+				if err := watcher.Add(event.Name); err != nil {
 					b.logger.Error(err.Error())
 				}
 				b.refreshToken()
@@ -133,21 +186,45 @@ func (b *BearerTokenAuth) startWatcher(ctx context.Context, watcher *fsnotify.Wa
 	}
 }
 
+// refreshToken reads the token to be transferred and the accepted tokens from their files.
 func (b *BearerTokenAuth) refreshToken() {
-	b.logger.Info("refresh token", zap.String("filename", b.filename))
-	token, err := os.ReadFile(b.filename)
-	if err != nil {
-		b.logger.Error(err.Error())
-		return
+	tokenString := b.tokenString
+	if b.filename != "" {
+		b.logger.Info("refresh token", zap.String("filename", b.filename))
+		token, err := os.ReadFile(b.filename)
+		if err != nil {
+			b.logger.Error(err.Error())
+		} else {
+			tokenString = string(token)
+		}
 	}
+
+	b.muTokenString.RLock()
+	tokens := make([]acceptedToken, len(b.tokens))
+	copy(tokens, b.tokens)
+	b.muTokenString.RUnlock()
+	for i := range tokens {
+		if tokens[i].filename == "" {
+			continue
+		}
+		b.logger.Info("refresh accepted token", zap.String("filename", tokens[i].filename))
+		token, err := os.ReadFile(tokens[i].filename)
+		if err != nil {
+			b.logger.Error(err.Error())
+			continue
+		}
+		tokens[i].token = strings.TrimSpace(string(token))
+	}
+
 	b.muTokenString.Lock()
-	b.tokenString = string(token)
+	b.tokenString = tokenString
+	b.tokens = tokens
 	b.muTokenString.Unlock()
 }
 
 // Shutdown of BearerTokenAuth does nothing and returns nil
 func (b *BearerTokenAuth) Shutdown(ctx context.Context) error {
-	if b.filename == "" {
+	if len(b.files) == 0 {
 		return nil
 	}
 
@@ -161,10 +238,9 @@ func (b *BearerTokenAuth) Shutdown(ctx context.Context) error {
 }
 
 // PerRPCCredentials returns PerRPCAuth an implementation of credentials.PerRPCCredentials that
+// sends the current token in every rpc call.
 func (b *BearerTokenAuth) PerRPCCredentials() (credentials.PerRPCCredentials, error) {
-	return &PerRPCAuth{
-		metadata: map[string]string{"authorization": b.bearerToken()},
-	}, nil
+	return &PerRPCAuth{auth: b}, nil
 }
 
 func (b *BearerTokenAuth) bearerToken() string {
@@ -174,26 +250,136 @@ func (b *BearerTokenAuth) bearerToken() string {
 	return token
 }
 
-// RoundTripper is not implemented by BearerTokenAuth
+// authorization returns the value of the authorization header of a client call, obtaining the token
+// from the command when one is configured.
+func (b *BearerTokenAuth) authorization(ctx context.Context) (string, error) {
+	if b.command == nil {
+		return b.bearerToken(), nil
+	}
+	token, err := b.command.Token(ctx)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s %s", b.scheme, token), nil
+}
+
+// RoundTripper returns a BearerAuthRoundTripper adding the current token to every http request.
 func (b *BearerTokenAuth) RoundTripper(base http.RoundTripper) (http.RoundTripper, error) {
 	return &BearerAuthRoundTripper{
 		baseTransport: base,
-		bearerToken:   b.bearerToken(),
+		auth:          b,
 	}, nil
 }
 
 // BearerAuthRoundTripper intercepts and adds Bearer token Authorization headers to each http request.
 type BearerAuthRoundTripper struct {
 	baseTransport http.RoundTripper
-	bearerToken   string
+	auth          *BearerTokenAuth
 }
 
 // RoundTrip modifies the original request and adds Bearer token Authorization headers.
 func (interceptor *BearerAuthRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := interceptor.auth.authorization(req.Context())
+	if err != nil {
+		return nil, err
+	}
 	req2 := req.Clone(req.Context())
 	if req2.Header == nil {
 		req2.Header = make(http.Header)
 	}
-	req2.Header.Set("Authorization", interceptor.bearerToken)
+	req2.Header.Set("Authorization", token)
 	return interceptor.baseTransport.RoundTrip(req2)
 }
+
+// Authenticate checks that the authorization header of the request bears one of the accepted tokens,
+// and exposes the attributes of the token as the auth data of the client.
+func (b *BearerTokenAuth) Authenticate(ctx context.Context, headers map[string][]string) (context.Context, error) {
+	auth := getAuthHeader(headers)
+	if auth == "" {
+		return ctx, errNoAuth
+	}
+
+	prefix := b.scheme + " "
+	if len(auth) < len(prefix) || !strings.EqualFold(auth[:len(prefix)], prefix) {
+		return ctx, errInvalidSchemePrefix
+	}
+
+	attributes, ok := b.acceptToken(auth[len(prefix):])
+	if !ok {
+		return ctx, errInvalidToken
+	}
+
+	cl := client.FromContext(ctx)
+	cl.Auth = &authData{attributes: attributes}
+	return client.NewContext(ctx, cl), nil
+}
+
+// acceptToken returns the attributes of the accepted token matching the given one.
+func (b *BearerTokenAuth) acceptToken(token string) (map[string]string, bool) {
+	b.muTokenString.RLock()
+	defer b.muTokenString.RUnlock()
+
+	accepted := false
+	var attributes map[string]string
+	// all the tokens are compared, in constant time, to not leak which of them matched
+	if primary := strings.TrimSpace(b.tokenString); primary != "" && b.command == nil {
+		accepted = subtle.ConstantTimeCompare([]byte(token), []byte(primary)) == 1
+	}
+	for _, t := range b.tokens {
+		if t.token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(t.token)) == 1 && !accepted {
+			accepted = true
+			attributes = t.attributes
+		}
+	}
+	return attributes, accepted
+}
+
+func getAuthHeader(h map[string][]string) string {
+	const (
+		canonicalHeaderKey = "Authorization"
+		metadataKey        = "authorization"
+	)
+
+	authHeaders, ok := h[canonicalHeaderKey]
+
+	if !ok {
+		authHeaders, ok = h[metadataKey]
+	}
+
+	if !ok {
+		for k, v := range h {
+			if strings.EqualFold(k, metadataKey) {
+				authHeaders = v
+				break
+			}
+		}
+	}
+
+	if len(authHeaders) == 0 {
+		return ""
+	}
+
+	return authHeaders[0]
+}
+
+var _ client.AuthData = (*authData)(nil)
+
+// authData exposes the attributes of the accepted token.
+type authData struct {
+	attributes map[string]string
+}
+
+func (a *authData) GetAttribute(name string) interface{} {
+	if value, ok := a.attributes[name]; ok {
+		return value
+	}
+	return nil
+}
+
+func (a *authData) GetAttributeNames() []string {
+	names := make([]string, 0, len(a.attributes))
+	for name := range a.attributes {
+		names = append(names, name)
+	}
+	return names
+}
@@ -19,10 +19,14 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"path/filepath"
+	"runtime"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/client"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.uber.org/zap/zaptest"
 )
@@ -33,7 +37,7 @@ func TestPerRPCAuth(t *testing.T) {
 	}
 
 	// test meta data is properly
-	perRPCAuth := &PerRPCAuth{metadata: metadata}
+	perRPCAuth := &PerRPCAuth{auth: &BearerTokenAuth{scheme: "Bearer", tokenString: "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."}}
 	md, err := perRPCAuth.GetRequestMetadata(context.Background())
 	assert.NoError(t, err)
 	assert.Equal(t, md, metadata)
@@ -159,3 +163,179 @@ func TestBearerStartWatchStop(t *testing.T) {
 	assert.Nil(t, bauth.Shutdown(context.Background()))
 	assert.Nil(t, bauth.shutdownCH)
 }
+
+func TestBearerAuthenticatorFollowsTokenRefresh(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.BearerToken = "first"
+
+	bauth := newBearerTokenAuth(cfg, nil)
+	credential, err := bauth.PerRPCCredentials()
+	require.NoError(t, err)
+	roundTripper, err := bauth.RoundTripper(&mockRoundTripper{})
+	require.NoError(t, err)
+
+	bauth.muTokenString.Lock()
+	bauth.tokenString = "second"
+	bauth.muTokenString.Unlock()
+
+	md, err := credential.GetRequestMetadata(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer second", md["authorization"])
+	resp, err := roundTripper.RoundTrip(&http.Request{})
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer second", resp.Header.Get("Authorization"))
+}
+
+func TestBearerServerAuthenticate(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.BearerToken = "primary"
+	cfg.Tokens = []TokenConfig{
+		{Token: "tenant-a", Attributes: map[string]string{"tenant": "a"}},
+		{Token: "tenant-b", Attributes: map[string]string{"tenant": "b"}},
+	}
+	bauth := newBearerTokenAuth(cfg, zaptest.NewLogger(t))
+
+	tests := []struct {
+		name    string
+		headers map[string][]string
+		tenant  interface{}
+		err     error
+	}{
+		{name: "primary token", headers: map[string][]string{"Authorization": {"Bearer primary"}}},
+		{name: "token with attributes", headers: map[string][]string{"authorization": {"bearer tenant-a"}}, tenant: "a"},
+		{name: "other token", headers: map[string][]string{"AUTHORIZATION": {"Bearer tenant-b"}}, tenant: "b"},
+		{name: "no header", headers: map[string][]string{}, err: errNoAuth},
+		{name: "other scheme", headers: map[string][]string{"Authorization": {"Basic tenant-a"}}, err: errInvalidSchemePrefix},
+		{name: "unknown token", headers: map[string][]string{"Authorization": {"Bearer tenant-c"}}, err: errInvalidToken},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, err := bauth.Authenticate(context.Background(), tt.headers)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			auth := client.FromContext(ctx).Auth
+			require.NotNil(t, auth)
+			assert.Equal(t, tt.tenant, auth.GetAttribute("tenant"))
+		})
+	}
+}
+
+func TestBearerServerTokenFileRotation(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "tenant.token")
+	require.NoError(t, os.WriteFile(filename, []byte("old\n"), 0600))
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Tokens = []TokenConfig{{Filename: filename, Attributes: map[string]string{"tenant": "a"}}}
+	require.NoError(t, cfg.Validate())
+
+	bauth := newBearerTokenAuth(cfg, zaptest.NewLogger(t))
+	require.NoError(t, bauth.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		assert.NoError(t, bauth.Shutdown(context.Background()))
+	}()
+
+	_, err := bauth.Authenticate(context.Background(), map[string][]string{"Authorization": {"Bearer old"}})
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filename, []byte("new\n"), 0600))
+	assert.Eventually(t, func() bool {
+		_, err := bauth.Authenticate(context.Background(), map[string][]string{"Authorization": {"Bearer new"}})
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	_, err = bauth.Authenticate(context.Background(), map[string][]string{"Authorization": {"Bearer old"}})
+	assert.ErrorIs(t, err, errInvalidToken)
+}
+
+func TestBearerServerTokenFileRotationDuringShutdown(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "tenant.token")
+	require.NoError(t, os.WriteFile(filename, []byte("token\n"), 0600))
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Tokens = []TokenConfig{{Filename: filename}}
+	require.NoError(t, cfg.Validate())
+
+	bauth := newBearerTokenAuth(cfg, zaptest.NewLogger(t))
+	require.NoError(t, bauth.Start(context.Background(), componenttest.NewNopHost()))
+
+	// the tokens are refreshed while the extension shuts down, which the race detector checks
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 10; i++ {
+			bauth.refreshToken()
+		}
+	}()
+	assert.NoError(t, bauth.Shutdown(context.Background()))
+	<-done
+}
+
+func TestBearerAuthenticatorCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the token command is a shell script")
+	}
+	counter := filepath.Join(t.TempDir(), "counter")
+	cfg := createDefaultConfig().(*Config)
+	cfg.Command = &CommandConfig{
+		Args:            []string{"sh", "-c", `echo x >> "$0"; echo "token-$(wc -l < "$0" | tr -d ' ')"`, counter},
+		RefreshInterval: time.Minute,
+	}
+	require.NoError(t, cfg.Validate())
+
+	bauth := newBearerTokenAuth(cfg, zaptest.NewLogger(t))
+	now := time.Now()
+	bauth.command.now = func() time.Time { return now }
+
+	credential, err := bauth.PerRPCCredentials()
+	require.NoError(t, err)
+	md, err := credential.GetRequestMetadata(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer token-1", md["authorization"])
+
+	// the token is cached until the refresh interval elapses
+	roundTripper, err := bauth.RoundTripper(&mockRoundTripper{})
+	require.NoError(t, err)
+	resp, err := roundTripper.RoundTrip(&http.Request{})
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer token-1", resp.Header.Get("Authorization"))
+
+	now = now.Add(time.Minute)
+	md, err = credential.GetRequestMetadata(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer token-2", md["authorization"])
+
+	// the command token is not accepted by the server authenticator
+	_, err = bauth.Authenticate(context.Background(), map[string][]string{"Authorization": {"Bearer token-2"}})
+	assert.ErrorIs(t, err, errInvalidToken)
+}
+
+func TestBearerAuthenticatorCommandFailure(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the token command is a shell script")
+	}
+	tests := []struct {
+		name string
+		args []string
+		err  string
+	}{
+		{name: "exit code", args: []string{"sh", "-c", "echo denied >&2; exit 1"}, err: "denied"},
+		{name: "empty token", args: []string{"sh", "-c", "echo"}, err: errEmptyCommandToken.Error()},
+		{name: "timeout", args: []string{"sleep", "5"}, err: "killed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Command = &CommandConfig{Args: tt.args, Timeout: 100 * time.Millisecond}
+			bauth := newBearerTokenAuth(cfg, zaptest.NewLogger(t))
+
+			roundTripper, err := bauth.RoundTripper(&mockRoundTripper{})
+			require.NoError(t, err)
+			_, err = roundTripper.RoundTrip(&http.Request{})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
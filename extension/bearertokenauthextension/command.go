@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bearertokenauthextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/bearertokenauthextension"
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultCommandRefreshInterval = 5 * time.Minute
+	defaultCommandTimeout         = 10 * time.Second
+)
+
+var errEmptyCommandToken = errors.New("the token command printed an empty token")
+
+// commandTokenSource runs a command printing a token, and caches the token for the refresh interval.
+type commandTokenSource struct {
+	args            []string
+	refreshInterval time.Duration
+	timeout         time.Duration
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+	now    func() time.Time
+}
+
+func newCommandTokenSource(cfg *CommandConfig) *commandTokenSource {
+	source := &commandTokenSource{
+		args:            cfg.Args,
+		refreshInterval: cfg.RefreshInterval,
+		timeout:         cfg.Timeout,
+		now:             time.Now,
+	}
+	if source.refreshInterval == 0 {
+		source.refreshInterval = defaultCommandRefreshInterval
+	}
+	if source.timeout == 0 {
+		source.timeout = defaultCommandTimeout
+	}
+	return source
+}
+
+// Token returns the cached token, running the command again once the token is stale.
+func (c *commandTokenSource) Token(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.token != "" && c.now().Before(c.expiry) {
+		return c.token, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, c.args[0], c.args[1:]...) // #nosec
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("failed to run the token command: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	token := strings.TrimSpace(stdout.String())
+	if token == "" {
+		return "", errEmptyCommandToken
+	}
+	c.token = token
+	c.expiry = c.now().Add(c.refreshInterval)
+	return token, nil
+}
@@ -16,6 +16,7 @@ package bearertokenauthextension // import "github.com/open-telemetry/openteleme
 
 import (
 	"errors"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
@@ -33,15 +34,69 @@ type Config struct {
 
 	// Filename points to a file that contains the bearer token to use for every RPC.
 	Filename string `mapstructure:"filename,omitempty"`
+
+	// Tokens are the tokens accepted by the extension when used as a server authenticator, in addition to the
+	// token specified by BearerToken or Filename.
+	Tokens []TokenConfig `mapstructure:"tokens,omitempty"`
+
+	// Command specifies a command printing a short-lived token to its standard output, which is used instead of
+	// BearerToken or Filename for the client calls.
+	Command *CommandConfig `mapstructure:"command,omitempty"`
+}
+
+// TokenConfig specifies a token accepted by the server authenticator.
+type TokenConfig struct {
+	// Token is the value of the token.
+	Token string `mapstructure:"token,omitempty"`
+
+	// Filename points to a file that contains the token. The file is reloaded when it changes.
+	Filename string `mapstructure:"filename,omitempty"`
+
+	// Attributes are exposed as the auth data of the clients authenticated with this token.
+	Attributes map[string]string `mapstructure:"attributes,omitempty"`
+}
+
+// CommandConfig specifies the command printing the token used by the client.
+type CommandConfig struct {
+	// Args are the command to run followed by its arguments.
+	Args []string `mapstructure:"args"`
+
+	// RefreshInterval is the time after which the command is run again to obtain a new token. Defaults to 5 minutes.
+	RefreshInterval time.Duration `mapstructure:"refresh_interval"`
+
+	// Timeout is the maximum duration of a command run. Defaults to 10 seconds.
+	Timeout time.Duration `mapstructure:"timeout"`
 }
 
 var _ component.ExtensionConfig = (*Config)(nil)
-var errNoTokenProvided = errors.New("no bearer token provided")
+var (
+	errNoTokenProvided         = errors.New("no bearer token provided")
+	errInvalidTokenConfig      = errors.New("either token or filename must be specified for each of the tokens")
+	errConflictingTokenSources = errors.New("command cannot be specified together with token or filename")
+	errNoCommandArgs           = errors.New("command args must not be empty")
+	errInvalidCommandDuration  = errors.New("command refresh_interval and timeout must not be negative")
+)
 
 // Validate checks if the extension configuration is valid
 func (cfg *Config) Validate() error {
-	if cfg.BearerToken == "" && cfg.Filename == "" {
+	if cfg.BearerToken == "" && cfg.Filename == "" && len(cfg.Tokens) == 0 && cfg.Command == nil {
 		return errNoTokenProvided
 	}
+	for _, token := range cfg.Tokens {
+		if (token.Token == "") == (token.Filename == "") {
+			return errInvalidTokenConfig
+		}
+	}
+	if cfg.Command != nil {
+		if cfg.BearerToken != "" || cfg.Filename != "" {
+			return errConflictingTokenSources
+		}
+		if len(cfg.Command.Args) == 0 {
+			return errNoCommandArgs
+		}
+		if cfg.Command.RefreshInterval < 0 || cfg.Command.Timeout < 0 {
+			return errInvalidCommandDuration
+		}
+	}
 	return nil
 }
@@ -17,6 +17,7 @@ package bearertokenauthextension
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -31,11 +32,11 @@ func TestLoadConfig(t *testing.T) {
 	tests := []struct {
 		id          component.ID
 		expected    component.ExtensionConfig
-		expectedErr bool
+		expectedErr error
 	}{
 		{
 			id:          component.NewID(typeStr),
-			expectedErr: true,
+			expectedErr: errNoTokenProvided,
 		},
 		{
 			id: component.NewIDWithName(typeStr, "sometoken"),
@@ -53,6 +54,41 @@ func TestLoadConfig(t *testing.T) {
 				BearerToken:       "my-token",
 			},
 		},
+		{
+			id: component.NewIDWithName(typeStr, "tokens"),
+			expected: &Config{
+				ExtensionSettings: config.NewExtensionSettings(component.NewID(typeStr)),
+				Scheme:            defaultScheme,
+				Tokens: []TokenConfig{
+					{Token: "tenant-a-token", Attributes: map[string]string{"tenant": "a"}},
+					{Filename: "/var/run/secrets/tenant-b.token", Attributes: map[string]string{"tenant": "b"}},
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(typeStr, "command"),
+			expected: &Config{
+				ExtensionSettings: config.NewExtensionSettings(component.NewID(typeStr)),
+				Scheme:            defaultScheme,
+				Command: &CommandConfig{
+					Args:            []string{"gcloud", "auth", "print-identity-token"},
+					RefreshInterval: 10 * time.Minute,
+					Timeout:         5 * time.Second,
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(typeStr, "invalidtoken"),
+			expectedErr: errInvalidTokenConfig,
+		},
+		{
+			id:          component.NewIDWithName(typeStr, "conflictingcommand"),
+			expectedErr: errConflictingTokenSources,
+		},
+		{
+			id:          component.NewIDWithName(typeStr, "emptycommand"),
+			expectedErr: errNoCommandArgs,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.String(), func(t *testing.T) {
@@ -63,8 +99,8 @@ func TestLoadConfig(t *testing.T) {
 			sub, err := cm.Sub(tt.id.String())
 			require.NoError(t, err)
 			require.NoError(t, component.UnmarshalExtensionConfig(sub, cfg))
-			if tt.expectedErr {
-				assert.Error(t, cfg.Validate())
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, cfg.Validate(), tt.expectedErr)
 				return
 			}
 			assert.NoError(t, cfg.Validate())
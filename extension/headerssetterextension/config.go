@@ -23,8 +23,8 @@ import (
 var (
 	errMissingHeader        = fmt.Errorf("missing header name")
 	errMissingHeadersConfig = fmt.Errorf("missing headers configuration")
-	errMissingSource        = fmt.Errorf("missing header source, must be 'from_context' or 'value'")
-	errConflictingSources   = fmt.Errorf("invalid header source, must either 'from_context' or 'value'")
+	errMissingSource        = fmt.Errorf("missing header source, must be 'from_context', 'from_auth' or 'value'")
+	errConflictingSources   = fmt.Errorf("invalid header source, must either 'from_context', 'from_auth' or 'value'")
 )
 
 type Config struct {
@@ -36,6 +36,7 @@ type HeaderConfig struct {
 	Key         *string `mapstructure:"key"`
 	Value       *string `mapstructure:"value"`
 	FromContext *string `mapstructure:"from_context"`
+	FromAuth    *string `mapstructure:"from_auth"`
 }
 
 // Validate checks if the extension configuration is valid
@@ -47,10 +48,16 @@ func (cfg *Config) Validate() error {
 		if header.Key == nil || *header.Key == "" {
 			return errMissingHeader
 		}
-		if header.FromContext == nil && header.Value == nil {
+		sources := 0
+		for _, source := range []*string{header.Value, header.FromContext, header.FromAuth} {
+			if source != nil {
+				sources++
+			}
+		}
+		if sources == 0 {
 			return errMissingSource
 		}
-		if header.FromContext != nil && header.Value != nil {
+		if sources > 1 {
 			return errConflictingSources
 		}
 	}
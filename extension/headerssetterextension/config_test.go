@@ -51,6 +51,10 @@ func TestLoadConfig(t *testing.T) {
 						FromContext: stringp("user_id"),
 						Value:       nil,
 					},
+					{
+						Key:      stringp("X-Subject"),
+						FromAuth: stringp("subject"),
+					},
 				},
 			},
 		},
@@ -95,6 +99,27 @@ func TestValidateConfig(t *testing.T) {
 			},
 			nil,
 		},
+		{
+			"header value from auth",
+			[]HeaderConfig{
+				{
+					Key:      stringp("name"),
+					FromAuth: stringp("tenant"),
+				},
+			},
+			nil,
+		},
+		{
+			"header value from context and auth",
+			[]HeaderConfig{
+				{
+					Key:         stringp("name"),
+					FromContext: stringp("tenant"),
+					FromAuth:    stringp("tenant"),
+				},
+			},
+			errConflictingSources,
+		},
 		{
 			"missing header name for from value",
 			[]HeaderConfig{
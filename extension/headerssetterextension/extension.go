@@ -47,6 +47,10 @@ func newHeadersSetterExtension(cfg *Config) (configauth.ClientAuthenticator, err
 			s = &source.ContextSource{
 				Key: *header.FromContext,
 			}
+		} else if header.FromAuth != nil {
+			s = &source.AuthSource{
+				Key: *header.FromAuth,
+			}
 		}
 		headers = append(headers, Header{key: *header.Key, source: s})
 	}
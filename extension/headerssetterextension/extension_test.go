@@ -48,6 +48,7 @@ func TestRoundTripper(t *testing.T) {
 				context.Background(),
 				client.Info{
 					Metadata: tt.metadata,
+					Auth:     tt.auth,
 				},
 			)
 			req, err := http.NewRequestWithContext(ctx, "GET", "", nil)
@@ -81,7 +82,7 @@ func TestPerRPCCredentials(t *testing.T) {
 
 			ctx := client.NewContext(
 				context.Background(),
-				client.Info{Metadata: tt.metadata},
+				client.Info{Metadata: tt.metadata, Auth: tt.auth},
 			)
 
 			metadata, err := perRPC.GetRequestMetadata(ctx)
@@ -105,6 +106,7 @@ var (
 	tests         = []struct {
 		cfg             *Config
 		metadata        client.Metadata
+		auth            client.AuthData
 		expectedHeaders map[string]string
 	}{
 		{
@@ -220,9 +222,42 @@ var (
 				"header_name": "",
 			},
 		},
+		{
+			cfg: &Config{
+				HeadersConfig: []HeaderConfig{
+					{
+						Key:      &header,
+						FromAuth: stringp("tenant"),
+					},
+					{
+						Key:      &anotherHeader,
+						FromAuth: stringp("subject"),
+					},
+				},
+			},
+			auth: authData{"tenant": "acme"},
+			expectedHeaders: map[string]string{
+				"header_name":         "acme",
+				"another_header_name": "",
+			},
+		},
 	}
 )
 
+type authData map[string]interface{}
+
+func (a authData) GetAttribute(name string) interface{} {
+	return a[name]
+}
+
+func (a authData) GetAttributeNames() []string {
+	names := make([]string, 0, len(a))
+	for name := range a {
+		names = append(names, name)
+	}
+	return names
+}
+
 func stringp(str string) *string {
 	return &str
 }
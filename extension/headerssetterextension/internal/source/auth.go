@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/headerssetterextension/internal/source"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/client"
+)
+
+var _ Source = (*AuthSource)(nil)
+
+// AuthSource gets the value of an attribute of the authenticated client,
+// such as a claim of its token.
+type AuthSource struct {
+	Key string
+}
+
+func (ts *AuthSource) Get(ctx context.Context) (string, error) {
+	cl := client.FromContext(ctx)
+	if cl.Auth == nil {
+		return "", nil
+	}
+
+	switch value := cl.Auth.GetAttribute(ts.Key).(type) {
+	case nil:
+		return "", nil
+	case string:
+		return value, nil
+	case []string:
+		if len(value) > 1 {
+			return "", fmt.Errorf("%d values found for the auth attribute, can't determine which one to use", len(value))
+		}
+		if len(value) == 0 {
+			return "", nil
+		}
+		return value[0], nil
+	default:
+		return fmt.Sprint(value), nil
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/client"
+)
+
+type authData map[string]interface{}
+
+func (a authData) GetAttribute(name string) interface{} {
+	return a[name]
+}
+
+func (a authData) GetAttributeNames() []string {
+	names := make([]string, 0, len(a))
+	for name := range a {
+		names = append(names, name)
+	}
+	return names
+}
+
+func TestAuthSource(t *testing.T) {
+	tests := []struct {
+		name        string
+		auth        client.AuthData
+		expected    string
+		expectedErr bool
+	}{
+		{name: "no_auth", expected: ""},
+		{name: "missing", auth: authData{"other": "acme"}, expected: ""},
+		{name: "string", auth: authData{"tenant": "acme"}, expected: "acme"},
+		{name: "single_value", auth: authData{"tenant": []string{"acme"}}, expected: "acme"},
+		{name: "no_value", auth: authData{"tenant": []string{}}, expected: ""},
+		{name: "multiple_values", auth: authData{"tenant": []string{"acme", "globex"}}, expectedErr: true},
+		{name: "number", auth: authData{"tenant": 42}, expected: "42"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := &AuthSource{Key: "tenant"}
+			ctx := client.NewContext(context.Background(), client.Info{Auth: tt.auth})
+
+			value, err := ts.Get(ctx)
+
+			if tt.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, value)
+		})
+	}
+}
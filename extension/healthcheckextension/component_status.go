@@ -0,0 +1,202 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheckextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/healthcheckextension"
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"go.opencensus.io/stats/view"
+	"go.opentelemetry.io/collector/component"
+)
+
+// componentKinds are the kinds of the components reporting their status through the obsreport views,
+// which are also the names of the view prefixes and of the tags holding the component IDs.
+var componentKinds = []string{"receiver", "processor", "exporter"}
+
+// componentViewSuffixes maps the suffixes of the obsreport views of the components to the data type
+// of their pipelines.
+var componentViewSuffixes = map[string]component.DataType{
+	"spans":         component.DataTypeTraces,
+	"metric_points": component.DataTypeMetrics,
+	"log_records":   component.DataTypeLogs,
+}
+
+// componentFailureViews are the prefixes of the obsreport views counting the failures of the components.
+var componentFailureViews = []string{"receiver/refused_", "processor/refused_", "exporter/send_failed_"}
+
+// componentStatus is the status of a component, sourced from the obsreport views.
+type componentStatus struct {
+	dataTypes map[component.DataType]bool
+	// failures are the times of the reports in which the component had new failures.
+	failures []time.Time
+}
+
+// parseComponentRef validates a component reference, made of its kind and ID, e.g. "exporter/otlp/backend".
+func parseComponentRef(ref string) error {
+	kind, id, found := strings.Cut(ref, "/")
+	if !found {
+		return fmt.Errorf("component %q must be prefixed by its kind", ref)
+	}
+	if !isComponentKind(kind) {
+		return fmt.Errorf("component %q has unsupported kind %q", ref, kind)
+	}
+	return new(component.ID).UnmarshalText([]byte(id))
+}
+
+func isComponentKind(kind string) bool {
+	for _, k := range componentKinds {
+		if kind == k {
+			return true
+		}
+	}
+	return false
+}
+
+// trackComponents updates the status of the components from a view. Each row of the
+// cumulative failure views with a new value counts as a failure of its component.
+func (e *healthCheckExporter) trackComponents(vd *view.Data) {
+	kind, metric, found := strings.Cut(vd.View.Name, "/")
+	if !found || !isComponentKind(kind) {
+		return
+	}
+	var dataType component.DataType
+	for suffix, dt := range componentViewSuffixes {
+		if strings.HasSuffix(metric, "_"+suffix) {
+			dataType = dt
+		}
+	}
+	if dataType == "" {
+		return
+	}
+	failureView := false
+	for _, prefix := range componentFailureViews {
+		failureView = failureView || strings.HasPrefix(vd.View.Name, prefix)
+	}
+
+	for _, row := range vd.Rows {
+		id := ""
+		for _, t := range row.Tags {
+			if t.Key.Name() == kind {
+				id = t.Value
+			}
+		}
+		if id == "" {
+			continue
+		}
+
+		ref := kind + "/" + id
+		if e.components == nil {
+			e.components = map[string]*componentStatus{}
+			e.failureTotals = map[string]float64{}
+		}
+		status, ok := e.components[ref]
+		if !ok {
+			status = &componentStatus{dataTypes: map[component.DataType]bool{}}
+			e.components[ref] = status
+		}
+		status.dataTypes[dataType] = true
+
+		sum, ok := row.Data.(*view.SumData)
+		if !failureView || !ok {
+			continue
+		}
+		rowKey := vd.View.Name + "|" + fmt.Sprint(row.Tags)
+		if sum.Value > e.failureTotals[rowKey] {
+			status.failures = append(status.failures, vd.End)
+		}
+		e.failureTotals[rowKey] = sum.Value
+	}
+}
+
+// rotateComponents forgets the failures of the components older than interval.
+func (e *healthCheckExporter) rotateComponents(now time.Time, interval time.Duration) {
+	for _, status := range e.components {
+		failures := status.failures[:0]
+		for _, failure := range status.failures {
+			if failure.Add(interval).After(now) {
+				failures = append(failures, failure)
+			}
+		}
+		status.failures = failures
+	}
+}
+
+// componentHealth is the health of a component in the status document.
+type componentHealth struct {
+	Healthy  bool `json:"healthy"`
+	Failures int  `json:"failures"`
+}
+
+// pipelineHealth is the health of the components of the pipelines of a data type in the status document.
+type pipelineHealth struct {
+	Healthy    bool                       `json:"healthy"`
+	Components map[string]componentHealth `json:"components"`
+}
+
+// componentsHealth returns the health of the components, healthy with up to threshold recent failures.
+func (e *healthCheckExporter) componentsHealth(threshold int) map[string]componentHealth {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	health := make(map[string]componentHealth, len(e.components))
+	for ref, status := range e.components {
+		health[ref] = componentHealth{
+			Healthy:  len(status.failures) <= threshold,
+			Failures: len(status.failures),
+		}
+	}
+	return health
+}
+
+// pipelinesHealth returns the health of the components grouped by the data type of their pipelines.
+func (e *healthCheckExporter) pipelinesHealth(threshold int) map[component.DataType]*pipelineHealth {
+	health := e.componentsHealth(threshold)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	pipelines := map[component.DataType]*pipelineHealth{}
+	for ref, status := range e.components {
+		for dataType := range status.dataTypes {
+			pipeline, ok := pipelines[dataType]
+			if !ok {
+				pipeline = &pipelineHealth{Healthy: true, Components: map[string]componentHealth{}}
+				pipelines[dataType] = pipeline
+			}
+			pipeline.Components[ref] = health[ref]
+			pipeline.Healthy = pipeline.Healthy && health[ref].Healthy
+		}
+	}
+	return pipelines
+}
+
+// unhealthyComponents returns the unhealthy components among refs, or among all the components when refs is empty.
+func unhealthyComponents(health map[string]componentHealth, refs []string) []string {
+	if len(refs) == 0 {
+		for ref := range health {
+			refs = append(refs, ref)
+		}
+	}
+	var unhealthy []string
+	for _, ref := range refs {
+		if h, ok := health[ref]; ok && !h.Healthy {
+			unhealthy = append(unhealthy, ref)
+		}
+	}
+	sort.Strings(unhealthy)
+	return unhealthy
+}
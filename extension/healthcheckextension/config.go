@@ -16,6 +16,7 @@ package healthcheckextension // import "github.com/open-telemetry/opentelemetry-
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
@@ -43,6 +44,9 @@ var (
 	errNoEndpointProvided                      = errors.New("bad config: endpoint must be specified")
 	errInvalidExporterFailureThresholdProvided = errors.New("bad config: exporter_failure_threshold expects a positive number")
 	errInvalidPath                             = errors.New("bad config: path must start with /")
+	errDuplicatePath                           = errors.New("bad config: path, status_path, readiness and liveness paths must be different")
+	errInvalidComponent                        = errors.New("bad config: invalid component")
+	errCheckCollectorPipelineDisabled          = errors.New("bad config: check_collector_pipeline must be enabled to serve the status and probes")
 )
 
 // Validate checks if the extension configuration is valid
@@ -60,6 +64,33 @@ func (cfg *Config) Validate() error {
 	if !strings.HasPrefix(cfg.Path, "/") {
 		return errInvalidPath
 	}
+	return cfg.CheckCollectorPipeline.validate(cfg.Path)
+}
+
+func (settings *checkCollectorPipelineSettings) validate(path string) error {
+	paths := map[string]bool{path: true}
+	for _, p := range []string{settings.StatusPath, settings.Readiness.Path, settings.Liveness.Path} {
+		if p == "" {
+			continue
+		}
+		if !settings.Enabled {
+			return errCheckCollectorPipelineDisabled
+		}
+		if !strings.HasPrefix(p, "/") {
+			return errInvalidPath
+		}
+		if paths[p] {
+			return errDuplicatePath
+		}
+		paths[p] = true
+	}
+	for _, probe := range []probeSettings{settings.Readiness, settings.Liveness} {
+		for _, ref := range probe.Components {
+			if err := parseComponentRef(ref); err != nil {
+				return fmt.Errorf("%w: %v", errInvalidComponent, err)
+			}
+		}
+	}
 	return nil
 }
 
@@ -70,4 +101,19 @@ type checkCollectorPipelineSettings struct {
 	Interval string `mapstructure:"interval"`
 	// ExporterFailureThreshold is the threshold of exporter failure numbers during the Interval
 	ExporterFailureThreshold int `mapstructure:"exporter_failure_threshold"`
+	// StatusPath is the path serving the health of the components of the pipelines as JSON, not served if empty.
+	StatusPath string `mapstructure:"status_path"`
+	// Readiness is the probe gated on the collector pipelines being ready and on the health of its components.
+	Readiness probeSettings `mapstructure:"readiness"`
+	// Liveness is the probe gated on the health of its components.
+	Liveness probeSettings `mapstructure:"liveness"`
+}
+
+// probeSettings configures a probe gated on the health of components.
+type probeSettings struct {
+	// Path is the path serving the probe, not served if empty.
+	Path string `mapstructure:"path"`
+	// Components are the components gating the probe, made of their kind and ID, e.g. "exporter/otlp".
+	// The readiness probe is gated on all the components if empty, the liveness probe on none.
+	Components []string `mapstructure:"components"`
 }
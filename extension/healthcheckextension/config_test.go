@@ -69,6 +69,42 @@ func TestLoadConfig(t *testing.T) {
 			id:          component.NewIDWithName(typeStr, "invalidpath"),
 			expectedErr: errInvalidPath,
 		},
+		{
+			id: component.NewIDWithName(typeStr, "componentstatus"),
+			expected: &Config{
+				ExtensionSettings: config.NewExtensionSettings(component.NewID(typeStr)),
+				HTTPServerSettings: confighttp.HTTPServerSettings{
+					Endpoint: "localhost:13",
+				},
+				CheckCollectorPipeline: checkCollectorPipelineSettings{
+					Enabled:                  true,
+					Interval:                 "5m",
+					ExporterFailureThreshold: 5,
+					StatusPath:               "/status",
+					Readiness: probeSettings{
+						Path:       "/ready",
+						Components: []string{"receiver/otlp", "exporter/otlp/backend"},
+					},
+					Liveness: probeSettings{
+						Path:       "/live",
+						Components: []string{"exporter/otlp/backend"},
+					},
+				},
+				Path: "/",
+			},
+		},
+		{
+			id:          component.NewIDWithName(typeStr, "duplicatepath"),
+			expectedErr: errDuplicatePath,
+		},
+		{
+			id:          component.NewIDWithName(typeStr, "invalidcomponent"),
+			expectedErr: errInvalidComponent,
+		},
+		{
+			id:          component.NewIDWithName(typeStr, "checkcollectorpipelinedisabled"),
+			expectedErr: errCheckCollectorPipelineDisabled,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.String(), func(t *testing.T) {
@@ -29,6 +29,10 @@ const (
 type healthCheckExporter struct {
 	mu                   sync.Mutex
 	exporterFailureQueue []*view.Data
+	// components are the statuses of the components by reference, e.g. "exporter/otlp".
+	components map[string]*componentStatus
+	// failureTotals are the last values of the rows of the failure views of the components.
+	failureTotals map[string]float64
 }
 
 func newHealthCheckExporter() *healthCheckExporter {
@@ -43,6 +47,7 @@ func (e *healthCheckExporter) ExportView(vd *view.Data) {
 	if vd.View.Name == exporterFailureView {
 		e.exporterFailureQueue = append(e.exporterFailureQueue, vd)
 	}
+	e.trackComponents(vd)
 }
 
 func (e *healthCheckExporter) checkHealthStatus(exporterFailureThreshold int) bool {
@@ -66,4 +71,5 @@ func (e *healthCheckExporter) rotate(interval time.Duration) {
 		}
 		e.exporterFailureQueue = e.exporterFailureQueue[1:]
 	}
+	e.rotateComponents(currentTime, interval)
 }
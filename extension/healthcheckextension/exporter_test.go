@@ -15,11 +15,14 @@
 package healthcheckextension
 
 import (
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/collector/component"
 )
 
 func TestHealthCheckExporter_ExportView(t *testing.T) {
@@ -59,3 +62,64 @@ func TestHealthCheckExporter_rotate(t *testing.T) {
 	exporter.rotate(5 * time.Minute)
 	assert.Equal(t, 1, len(exporter.exporterFailureQueue))
 }
+
+// componentViewData returns the data of a cumulative obsreport view with the values of the components.
+func componentViewData(name string, end time.Time, values map[string]float64) *view.Data {
+	kind := tag.MustNewKey(name[:strings.Index(name, "/")])
+	vd := &view.Data{View: &view.View{Name: name}, End: end}
+	for id, value := range values {
+		vd.Rows = append(vd.Rows, &view.Row{
+			Tags: []tag.Tag{{Key: kind, Value: id}},
+			Data: &view.SumData{Value: value},
+		})
+	}
+	return vd
+}
+
+func TestHealthCheckExporter_trackComponents(t *testing.T) {
+	exporter := &healthCheckExporter{}
+	now := time.Now()
+
+	exporter.ExportView(componentViewData("receiver/accepted_spans", now, map[string]float64{"otlp": 10}))
+	exporter.ExportView(componentViewData("receiver/accepted_log_records", now, map[string]float64{"otlp": 10}))
+	exporter.ExportView(componentViewData("exporter/sent_spans", now, map[string]float64{"otlp/backend": 5}))
+	exporter.ExportView(componentViewData("exporter/send_failed_spans", now, map[string]float64{"otlp/backend": 5}))
+	exporter.ExportView(componentViewData("exporter/send_failed_spans", now, map[string]float64{"otlp/backend": 5}))
+	exporter.ExportView(componentViewData("exporter/send_failed_spans", now, map[string]float64{"otlp/backend": 7}))
+	exporter.ExportView(componentViewData("scraper/scraped_metric_points", now, map[string]float64{"cpu": 1}))
+
+	assert.Equal(t, map[string]componentHealth{
+		"receiver/otlp":         {Healthy: true, Failures: 0},
+		"exporter/otlp/backend": {Healthy: false, Failures: 2},
+	}, exporter.componentsHealth(1))
+	assert.Equal(t, map[component.DataType]*pipelineHealth{
+		component.DataTypeTraces: {
+			Healthy: false,
+			Components: map[string]componentHealth{
+				"receiver/otlp":         {Healthy: true, Failures: 0},
+				"exporter/otlp/backend": {Healthy: false, Failures: 2},
+			},
+		},
+		component.DataTypeLogs: {
+			Healthy:    true,
+			Components: map[string]componentHealth{"receiver/otlp": {Healthy: true, Failures: 0}},
+		},
+	}, exporter.pipelinesHealth(1))
+
+	exporter.rotate(time.Minute)
+	assert.Equal(t, 2, exporter.componentsHealth(1)["exporter/otlp/backend"].Failures)
+	exporter.components["exporter/otlp/backend"].failures[0] = now.Add(-2 * time.Minute)
+	exporter.rotate(time.Minute)
+	assert.Equal(t, componentHealth{Healthy: true, Failures: 1}, exporter.componentsHealth(1)["exporter/otlp/backend"])
+}
+
+func TestUnhealthyComponents(t *testing.T) {
+	health := map[string]componentHealth{
+		"receiver/otlp":         {Healthy: false, Failures: 3},
+		"exporter/otlp/backend": {Healthy: false, Failures: 2},
+		"exporter/logging":      {Healthy: true},
+	}
+	assert.Equal(t, []string{"exporter/otlp/backend", "receiver/otlp"}, unhealthyComponents(health, nil))
+	assert.Equal(t, []string{"exporter/otlp/backend"}, unhealthyComponents(health, []string{"exporter/otlp/backend", "exporter/logging", "exporter/kafka"}))
+	assert.Empty(t, unhealthyComponents(health, []string{"exporter/logging"}))
+}
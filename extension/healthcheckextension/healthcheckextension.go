@@ -16,6 +16,7 @@ package healthcheckextension // import "github.com/open-telemetry/opentelemetry-
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -81,6 +82,16 @@ func (hc *healthCheckExtension) Start(_ context.Context, host component.Host) er
 
 		mux := http.NewServeMux()
 		mux.Handle(hc.config.Path, hc.handler())
+		settings := hc.config.CheckCollectorPipeline
+		if settings.StatusPath != "" {
+			mux.Handle(settings.StatusPath, hc.statusHandler())
+		}
+		if settings.Readiness.Path != "" {
+			mux.Handle(settings.Readiness.Path, hc.probeHandler(true, settings.Readiness.Components))
+		}
+		if settings.Liveness.Path != "" {
+			mux.Handle(settings.Liveness.Path, hc.probeHandler(false, settings.Liveness.Components))
+		}
 		hc.server.Handler = mux
 		hc.stopCh = make(chan struct{})
 		go func() {
@@ -119,6 +130,40 @@ func (hc *healthCheckExtension) handler() http.Handler {
 	})
 }
 
+// statusHandler serves the readiness of the collector and the health of the components of its pipelines.
+func (hc *healthCheckExtension) statusHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		status := struct {
+			Ready     bool                                   `json:"ready"`
+			Pipelines map[component.DataType]*pipelineHealth `json:"pipelines"`
+		}{
+			Ready:     hc.state.Get() == healthcheck.Ready,
+			Pipelines: hc.exporter.pipelinesHealth(hc.config.CheckCollectorPipeline.ExporterFailureThreshold),
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(status); err != nil {
+			hc.logger.Debug("Failed to write the health check status", zap.Error(err))
+		}
+	})
+}
+
+// probeHandler serves a probe failing while a component gating it is unhealthy, or all the components
+// if none gates a readiness probe, and while the collector is not ready for a readiness probe.
+func (hc *healthCheckExtension) probeHandler(readiness bool, components []string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		healthy := !readiness || hc.state.Get() == healthcheck.Ready
+		if healthy && (readiness || len(components) > 0) {
+			health := hc.exporter.componentsHealth(hc.config.CheckCollectorPipeline.ExporterFailureThreshold)
+			healthy = len(unhealthyComponents(health, components)) == 0
+		}
+		if healthy {
+			w.WriteHeader(200)
+		} else {
+			w.WriteHeader(500)
+		}
+	})
+}
+
 func (hc *healthCheckExtension) check() bool {
 	return hc.exporter.checkHealthStatus(hc.config.CheckCollectorPipeline.ExporterFailureThreshold)
 }
@@ -16,6 +16,7 @@ package healthcheckextension
 
 import (
 	"context"
+	"io"
 	"net"
 	"net/http"
 	"runtime"
@@ -336,3 +337,79 @@ func newAssertNoErrorHost(t *testing.T) component.Host {
 func (aneh *assertNoErrorHost) ReportFatalError(err error) {
 	assert.NoError(aneh, err)
 }
+
+func TestHealthCheckExtensionComponentStatus(t *testing.T) {
+	config := Config{
+		HTTPServerSettings: confighttp.HTTPServerSettings{
+			Endpoint: testutil.GetAvailableLocalAddress(t),
+		},
+		CheckCollectorPipeline: checkCollectorPipelineSettings{
+			Enabled:                  true,
+			Interval:                 "5m",
+			ExporterFailureThreshold: 1,
+			StatusPath:               "/status",
+			Readiness:                probeSettings{Path: "/ready"},
+			Liveness:                 probeSettings{Path: "/live", Components: []string{"exporter/otlp/backend"}},
+		},
+		Path: "/",
+	}
+
+	hcExt := newServer(config, componenttest.NewNopTelemetrySettings())
+	require.NotNil(t, hcExt)
+
+	require.NoError(t, hcExt.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, hcExt.Shutdown(context.Background())) })
+	require.Eventuallyf(t, ensureServerRunning(config.Endpoint), 30*time.Second, 1*time.Second, "Failed to start the testing server.")
+
+	client := &http.Client{}
+	get := func(path string) (int, string) {
+		resp, err := client.Get("http://" + config.Endpoint + path)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, string(body)
+	}
+
+	code, _ := get("/ready")
+	assert.Equal(t, http.StatusInternalServerError, code)
+	code, _ = get("/live")
+	assert.Equal(t, http.StatusOK, code)
+
+	require.NoError(t, hcExt.Ready())
+	now := time.Now()
+	hcExt.exporter.ExportView(componentViewData("receiver/refused_spans", now, map[string]float64{"otlp": 1}))
+	hcExt.exporter.ExportView(componentViewData("exporter/send_failed_spans", now, map[string]float64{"otlp/backend": 1}))
+
+	code, _ = get("/ready")
+	assert.Equal(t, http.StatusOK, code)
+	code, _ = get("/live")
+	assert.Equal(t, http.StatusOK, code)
+
+	hcExt.exporter.ExportView(componentViewData("receiver/refused_spans", now, map[string]float64{"otlp": 2}))
+
+	code, _ = get("/ready")
+	assert.Equal(t, http.StatusInternalServerError, code)
+	code, _ = get("/live")
+	assert.Equal(t, http.StatusOK, code)
+
+	hcExt.exporter.ExportView(componentViewData("exporter/send_failed_spans", now, map[string]float64{"otlp/backend": 3}))
+
+	code, _ = get("/live")
+	assert.Equal(t, http.StatusInternalServerError, code)
+
+	code, body := get("/status")
+	assert.Equal(t, http.StatusOK, code)
+	assert.JSONEq(t, `{
+		"ready": true,
+		"pipelines": {
+			"traces": {
+				"healthy": false,
+				"components": {
+					"receiver/otlp": {"healthy": false, "failures": 2},
+					"exporter/otlp/backend": {"healthy": false, "failures": 2}
+				}
+			}
+		}
+	}`, body)
+}
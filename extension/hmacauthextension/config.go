@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hmacauthextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/hmacauthextension"
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/config"
+	"go.uber.org/multierr"
+)
+
+const (
+	algorithmSHA256 = "sha256"
+	algorithmSHA512 = "sha512"
+
+	encodingHex    = "hex"
+	encodingBase64 = "base64"
+)
+
+var (
+	errNoSecretProvided       = errors.New("exactly one of secret and secret_file must be provided")
+	errNoSignatureHeader      = errors.New("no signature_header provided")
+	errNoTimestampHeader      = errors.New("no timestamp_header provided")
+	errNoKeyIDHeader          = errors.New("no key_id_header provided while key_id is set")
+	errUnsupportedAlgorithm   = errors.New("unsupported algorithm")
+	errUnsupportedEncoding    = errors.New("unsupported encoding")
+	errGRPCNotSupported       = errors.New("the hmacauth extension does not support gRPC, as the body of the requests cannot be signed")
+	errEmptySecretFileContent = errors.New("secret_file is empty")
+)
+
+// Config is the configuration of the HMAC client authenticator.
+type Config struct {
+	config.ExtensionSettings `mapstructure:",squash"`
+
+	// KeyID identifies the secret to the server. It is sent in KeyIDHeader and signed when set.
+	KeyID string `mapstructure:"key_id"`
+
+	// Secret is the secret shared with the server.
+	Secret string `mapstructure:"secret"`
+
+	// SecretFile is the path of a file holding the secret, read at start.
+	SecretFile string `mapstructure:"secret_file"`
+
+	// Algorithm is the hash function of the HMAC and of the body digest: sha256 or sha512.
+	Algorithm string `mapstructure:"algorithm"`
+
+	// Encoding is the encoding of the signature: hex or base64.
+	Encoding string `mapstructure:"encoding"`
+
+	// SignatureHeader is the header holding the signature.
+	SignatureHeader string `mapstructure:"signature_header"`
+
+	// TimestampHeader is the header holding the signing time, in Unix seconds.
+	TimestampHeader string `mapstructure:"timestamp_header"`
+
+	// KeyIDHeader is the header holding KeyID.
+	KeyIDHeader string `mapstructure:"key_id_header"`
+
+	// SignedHeaders are the request headers included in the signature.
+	SignedHeaders []string `mapstructure:"signed_headers"`
+}
+
+// Validate checks if the extension configuration is valid.
+func (cfg *Config) Validate() error {
+	var errs error
+	if (cfg.Secret == "") == (cfg.SecretFile == "") {
+		errs = multierr.Append(errs, errNoSecretProvided)
+	}
+	if cfg.Algorithm != algorithmSHA256 && cfg.Algorithm != algorithmSHA512 {
+		errs = multierr.Append(errs, fmt.Errorf("%w %q", errUnsupportedAlgorithm, cfg.Algorithm))
+	}
+	if cfg.Encoding != encodingHex && cfg.Encoding != encodingBase64 {
+		errs = multierr.Append(errs, fmt.Errorf("%w %q", errUnsupportedEncoding, cfg.Encoding))
+	}
+	if cfg.SignatureHeader == "" {
+		errs = multierr.Append(errs, errNoSignatureHeader)
+	}
+	if cfg.TimestampHeader == "" {
+		errs = multierr.Append(errs, errNoTimestampHeader)
+	}
+	if cfg.KeyID != "" && cfg.KeyIDHeader == "" {
+		errs = multierr.Append(errs, errNoKeyIDHeader)
+	}
+	return errs
+}
@@ -0,0 +1,101 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hmacauthextension
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id          component.ID
+		expected    component.ExtensionConfig
+		expectedErr error
+	}{
+		{
+			id: component.NewID(typeStr),
+			expected: &Config{
+				ExtensionSettings: config.NewExtensionSettings(component.NewID(typeStr)),
+				Secret:            "my-secret",
+				Algorithm:         algorithmSHA256,
+				Encoding:          encodingHex,
+				SignatureHeader:   defaultSignatureHeader,
+				TimestampHeader:   defaultTimestampHeader,
+				KeyIDHeader:       defaultKeyIDHeader,
+				SignedHeaders:     []string{"Content-Type"},
+			},
+		},
+		{
+			id: component.NewIDWithName(typeStr, "custom"),
+			expected: &Config{
+				ExtensionSettings: config.NewExtensionSettings(component.NewID(typeStr)),
+				KeyID:             "collector-1",
+				SecretFile:        "/etc/otelcol/hmac-secret",
+				Algorithm:         algorithmSHA512,
+				Encoding:          encodingBase64,
+				SignatureHeader:   "Authorization-Signature",
+				TimestampHeader:   "Authorization-Date",
+				KeyIDHeader:       "Authorization-Key",
+				SignedHeaders:     []string{"Content-Type", "Content-Encoding"},
+			},
+		},
+		{
+			id:          component.NewIDWithName(typeStr, "nosecret"),
+			expectedErr: errNoSecretProvided,
+		},
+		{
+			id:          component.NewIDWithName(typeStr, "twosecrets"),
+			expectedErr: errNoSecretProvided,
+		},
+		{
+			id:          component.NewIDWithName(typeStr, "invalidalgorithm"),
+			expectedErr: errUnsupportedAlgorithm,
+		},
+		{
+			id:          component.NewIDWithName(typeStr, "invalidencoding"),
+			expectedErr: errUnsupportedEncoding,
+		},
+		{
+			id:          component.NewIDWithName(typeStr, "nosignatureheader"),
+			expectedErr: errNoSignatureHeader,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalExtensionConfig(sub, cfg))
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, cfg.Validate(), tt.expectedErr)
+				return
+			}
+			assert.NoError(t, cfg.Validate())
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
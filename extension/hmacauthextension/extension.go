@@ -0,0 +1,171 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hmacauthextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/hmacauthextension"
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configauth"
+	"google.golang.org/grpc/credentials"
+)
+
+// hmacAuth signs the HTTP requests with an HMAC of their canonical representation.
+type hmacAuth struct {
+	cfg     *Config
+	secret  []byte
+	newHash func() hash.Hash
+	now     func() time.Time
+}
+
+func newHMACAuth(cfg *Config) *hmacAuth {
+	a := &hmacAuth{
+		cfg:     cfg,
+		secret:  []byte(cfg.Secret),
+		newHash: sha256.New,
+		now:     time.Now,
+	}
+	if cfg.Algorithm == algorithmSHA512 {
+		a.newHash = sha512.New
+	}
+	return a
+}
+
+func newHMACAuthExtension(cfg *Config) configauth.ClientAuthenticator {
+	a := newHMACAuth(cfg)
+	return configauth.NewClientAuthenticator(
+		configauth.WithClientStart(a.start),
+		configauth.WithClientRoundTripper(func(base http.RoundTripper) (http.RoundTripper, error) {
+			return &signingRoundTripper{base: base, auth: a}, nil
+		}),
+		configauth.WithPerRPCCredentials(func() (credentials.PerRPCCredentials, error) {
+			return nil, errGRPCNotSupported
+		}),
+	)
+}
+
+func (a *hmacAuth) start(context.Context, component.Host) error {
+	if a.cfg.SecretFile == "" {
+		return nil
+	}
+	secret, err := os.ReadFile(filepath.Clean(a.cfg.SecretFile))
+	if err != nil {
+		return fmt.Errorf("failed to read secret_file: %w", err)
+	}
+	secret = bytes.TrimRight(secret, "\r\n")
+	if len(secret) == 0 {
+		return errEmptySecretFileContent
+	}
+	a.secret = secret
+	return nil
+}
+
+// stringToSign returns the canonical representation of the request covered by the signature:
+// its method, path, query, timestamp, key ID, signed headers and body digest, one per line.
+func (a *hmacAuth) stringToSign(req *http.Request, timestamp string, body []byte) string {
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	lines := []string{req.Method, path, req.URL.RawQuery, timestamp, a.cfg.KeyID}
+	for _, name := range a.cfg.SignedHeaders {
+		lines = append(lines, strings.ToLower(name)+":"+strings.Join(req.Header.Values(name), ","))
+	}
+	digest := a.newHash()
+	_, _ = digest.Write(body)
+	lines = append(lines, hex.EncodeToString(digest.Sum(nil)))
+	return strings.Join(lines, "\n")
+}
+
+// sign sets the signature headers of the request.
+func (a *hmacAuth) sign(req *http.Request, body []byte) {
+	timestamp := strconv.FormatInt(a.now().Unix(), 10)
+	mac := hmac.New(a.newHash, a.secret)
+	_, _ = mac.Write([]byte(a.stringToSign(req, timestamp, body)))
+	signature := mac.Sum(nil)
+
+	var encoded string
+	if a.cfg.Encoding == encodingBase64 {
+		encoded = base64.StdEncoding.EncodeToString(signature)
+	} else {
+		encoded = hex.EncodeToString(signature)
+	}
+	req.Header.Set(a.cfg.SignatureHeader, encoded)
+	req.Header.Set(a.cfg.TimestampHeader, timestamp)
+	if a.cfg.KeyID != "" {
+		req.Header.Set(a.cfg.KeyIDHeader, a.cfg.KeyID)
+	}
+}
+
+// signingRoundTripper signs the requests before sending them with the base round tripper.
+type signingRoundTripper struct {
+	base http.RoundTripper
+	auth *hmacAuth
+}
+
+// RoundTrip copies the original request and signs it.
+func (s *signingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	req2 := req.Clone(req.Context())
+	if req2.Header == nil {
+		req2.Header = make(http.Header)
+	}
+	body, err := readBody(req2)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the request body to sign: %w", err)
+	}
+	s.auth.sign(req2, body)
+	return s.base.RoundTrip(req2)
+}
+
+// readBody returns the body of the request, which can still be sent afterwards.
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+		return io.ReadAll(body)
+	}
+
+	defer req.Body.Close()
+	content, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(content))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(content)), nil
+	}
+	return content, nil
+}
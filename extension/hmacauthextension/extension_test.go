@@ -0,0 +1,184 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hmacauthextension
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+)
+
+type mockRoundTripper struct {
+	req  *http.Request
+	body []byte
+}
+
+func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	m.req = req
+	if req.Body != nil {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		m.body = body
+	}
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+}
+
+func testConfig() *Config {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Secret = "my-secret"
+	cfg.KeyID = "collector-1"
+	return cfg
+}
+
+func newTestAuth(cfg *Config) *hmacAuth {
+	a := newHMACAuth(cfg)
+	a.now = func() time.Time { return time.Unix(1668000000, 0) }
+	return a
+}
+
+func TestRoundTripper(t *testing.T) {
+	a := newTestAuth(testConfig())
+	base := &mockRoundTripper{}
+	rt := &signingRoundTripper{base: base, auth: a}
+
+	req, err := http.NewRequest(http.MethodPost, "http://localhost:4318/v1/traces?tenant=a", bytes.NewBufferString("payload"))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/x-protobuf")
+
+	resp, err := rt.RoundTrip(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	// the original request is not modified
+	assert.Empty(t, req.Header.Get(defaultSignatureHeader))
+
+	require.NotNil(t, base.req)
+	assert.Equal(t, []byte("payload"), base.body)
+	assert.Equal(t, "1668000000", base.req.Header.Get(defaultTimestampHeader))
+	assert.Equal(t, "collector-1", base.req.Header.Get(defaultKeyIDHeader))
+
+	bodyDigest := sha256.Sum256([]byte("payload"))
+	stringToSign := strings.Join([]string{
+		"POST",
+		"/v1/traces",
+		"tenant=a",
+		"1668000000",
+		"collector-1",
+		"content-type:application/x-protobuf",
+		hex.EncodeToString(bodyDigest[:]),
+	}, "\n")
+	mac := hmac.New(sha256.New, []byte("my-secret"))
+	_, _ = mac.Write([]byte(stringToSign))
+	assert.Equal(t, hex.EncodeToString(mac.Sum(nil)), base.req.Header.Get(defaultSignatureHeader))
+}
+
+func TestRoundTripperWithoutGetBody(t *testing.T) {
+	a := newTestAuth(testConfig())
+	base := &mockRoundTripper{}
+	rt := &signingRoundTripper{base: base, auth: a}
+
+	req, err := http.NewRequest(http.MethodPost, "http://localhost:4318/v1/logs", io.NopCloser(strings.NewReader("payload")))
+	require.NoError(t, err)
+	require.Nil(t, req.GetBody)
+
+	_, err = rt.RoundTrip(req)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("payload"), base.body)
+	assert.NotEmpty(t, base.req.Header.Get(defaultSignatureHeader))
+}
+
+func TestSignSHA512Base64(t *testing.T) {
+	cfg := testConfig()
+	cfg.KeyID = ""
+	cfg.Algorithm = algorithmSHA512
+	cfg.Encoding = encodingBase64
+	cfg.SignedHeaders = nil
+	a := newTestAuth(cfg)
+
+	req, err := http.NewRequest(http.MethodGet, "http://localhost", nil)
+	require.NoError(t, err)
+	a.sign(req, nil)
+
+	bodyDigest := sha512.Sum512(nil)
+	stringToSign := strings.Join([]string{"GET", "/", "", "1668000000", "", hex.EncodeToString(bodyDigest[:])}, "\n")
+	mac := hmac.New(sha512.New, []byte("my-secret"))
+	_, _ = mac.Write([]byte(stringToSign))
+	assert.Equal(t, base64.StdEncoding.EncodeToString(mac.Sum(nil)), req.Header.Get(defaultSignatureHeader))
+	assert.Empty(t, req.Header.Get(defaultKeyIDHeader))
+}
+
+func TestSignatureCoversSignedHeaders(t *testing.T) {
+	a := newTestAuth(testConfig())
+
+	signature := func(contentType string) string {
+		req, err := http.NewRequest(http.MethodPost, "http://localhost", nil)
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", contentType)
+		req.Header.Set("User-Agent", contentType)
+		a.sign(req, []byte("payload"))
+		return req.Header.Get(defaultSignatureHeader)
+	}
+	assert.NotEqual(t, signature("application/json"), signature("application/x-protobuf"))
+}
+
+func TestStartSecretFile(t *testing.T) {
+	dir := t.TempDir()
+	secretFile := filepath.Join(dir, "secret")
+	require.NoError(t, os.WriteFile(secretFile, []byte("file-secret\n"), 0600))
+
+	cfg := testConfig()
+	cfg.Secret = ""
+	cfg.SecretFile = secretFile
+	a := newHMACAuth(cfg)
+	require.NoError(t, a.start(context.Background(), componenttest.NewNopHost()))
+	assert.Equal(t, []byte("file-secret"), a.secret)
+
+	require.NoError(t, os.WriteFile(secretFile, []byte("\n"), 0600))
+	assert.ErrorIs(t, a.start(context.Background(), componenttest.NewNopHost()), errEmptySecretFileContent)
+
+	cfg.SecretFile = filepath.Join(dir, "missing")
+	assert.Error(t, a.start(context.Background(), componenttest.NewNopHost()))
+}
+
+func TestExtension(t *testing.T) {
+	ext := newHMACAuthExtension(testConfig())
+	require.NoError(t, ext.Start(context.Background(), componenttest.NewNopHost()))
+
+	rt, err := ext.RoundTripper(&mockRoundTripper{})
+	require.NoError(t, err)
+	assert.NotNil(t, rt)
+
+	_, err = ext.PerRPCCredentials()
+	assert.ErrorIs(t, err, errGRPCNotSupported)
+
+	assert.NoError(t, ext.Shutdown(context.Background()))
+}
@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hmacauthextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/hmacauthextension"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+)
+
+const (
+	typeStr = "hmacauth"
+
+	defaultSignatureHeader = "X-Signature"
+	defaultTimestampHeader = "X-Signature-Timestamp"
+	defaultKeyIDHeader     = "X-Signature-Key-Id"
+)
+
+// NewFactory creates a factory for the HMAC client authenticator extension.
+func NewFactory() component.ExtensionFactory {
+	return component.NewExtensionFactory(
+		typeStr,
+		createDefaultConfig,
+		createExtension,
+		component.StabilityLevelInDevelopment,
+	)
+}
+
+func createDefaultConfig() component.ExtensionConfig {
+	return &Config{
+		ExtensionSettings: config.NewExtensionSettings(component.NewID(typeStr)),
+		Algorithm:         algorithmSHA256,
+		Encoding:          encodingHex,
+		SignatureHeader:   defaultSignatureHeader,
+		TimestampHeader:   defaultTimestampHeader,
+		KeyIDHeader:       defaultKeyIDHeader,
+		SignedHeaders:     []string{"Content-Type"},
+	}
+}
+
+func createExtension(
+	_ context.Context,
+	_ component.ExtensionCreateSettings,
+	cfg component.ExtensionConfig,
+) (component.Extension, error) {
+	return newHMACAuthExtension(cfg.(*Config)), nil
+}
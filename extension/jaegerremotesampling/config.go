@@ -16,17 +16,20 @@ package jaegerremotesampling // import "github.com/open-telemetry/opentelemetry-
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/config/configgrpc"
 	"go.opentelemetry.io/collector/config/confighttp"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/jaegerremotesampling/internal"
 )
 
 var (
-	errTooManySources     = errors.New("too many sources specified, has to be either 'file' or 'remote'")
-	errNoSources          = errors.New("no sources specified, has to be either 'file' or 'remote'")
+	errTooManySources     = errors.New("too many sources specified, has to be either 'file', 'remote' or 'http'")
+	errNoSources          = errors.New("no sources specified, has to be either 'file', 'remote' or 'http'")
 	errAtLeastOneProtocol = errors.New("no protocols selected to serve the strategies, use 'grpc', 'http', or both")
 )
 
@@ -37,8 +40,20 @@ type Config struct {
 	*confighttp.HTTPServerSettings `mapstructure:"http"`
 	*configgrpc.GRPCServerSettings `mapstructure:"grpc"`
 
-	// Source configures the source for the strategies file. One of `remote` or `file` has to be specified.
+	// Source configures the source for the strategies file. One of `remote`, `file` or `http` has to be specified.
 	Source Source `mapstructure:"source"`
+
+	// ServiceOverrides replaces the strategies of the source for the given services, keyed by service name.
+	ServiceOverrides map[string]StrategyOverride `mapstructure:"service_overrides"`
+}
+
+// StrategyOverride is a service strategy, with the same semantics as in the strategies file.
+type StrategyOverride struct {
+	// Type is either `probabilistic` or `ratelimiting`
+	Type string `mapstructure:"type"`
+
+	// Param is the sampling probability for `probabilistic`, and the maximum number of traces per second for `ratelimiting`
+	Param float64 `mapstructure:"param"`
 }
 
 type Source struct {
@@ -48,6 +63,9 @@ type Source struct {
 	// File specifies a local file as the strategies source
 	File string `mapstructure:"file"`
 
+	// HTTP defines an HTTP endpoint serving the strategies file, refreshed using its ETag
+	HTTP *confighttp.HTTPClientSettings `mapstructure:"http"`
+
 	// ReloadInterval determines the periodicity to refresh the strategies
 	ReloadInterval time.Duration `mapstructure:"reload_interval"`
 }
@@ -60,13 +78,30 @@ func (cfg *Config) Validate() error {
 		return errAtLeastOneProtocol
 	}
 
-	if cfg.Source.File != "" && cfg.Source.Remote != nil {
+	sources := 0
+	if cfg.Source.File != "" {
+		sources++
+	}
+	if cfg.Source.Remote != nil {
+		sources++
+	}
+	if cfg.Source.HTTP != nil {
+		sources++
+	}
+
+	if sources > 1 {
 		return errTooManySources
 	}
 
-	if cfg.Source.File == "" && cfg.Source.Remote == nil {
+	if sources == 0 {
 		return errNoSources
 	}
 
+	for service, override := range cfg.ServiceOverrides {
+		if _, err := internal.NewStrategy(override.Type, override.Param); err != nil {
+			return fmt.Errorf("invalid override for service %q: %w", service, err)
+		}
+	}
+
 	return nil
 }
@@ -15,6 +15,7 @@
 package jaegerremotesampling
 
 import (
+	"errors"
 	"path/filepath"
 	"testing"
 	"time"
@@ -67,6 +68,27 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(typeStr, "2"),
+			expected: &Config{
+				ExtensionSettings:  config.NewExtensionSettings(component.NewID(typeStr)),
+				HTTPServerSettings: &confighttp.HTTPServerSettings{Endpoint: ":5778"},
+				GRPCServerSettings: &configgrpc.GRPCServerSettings{NetAddr: confignet.NetAddr{
+					Endpoint:  ":14250",
+					Transport: "tcp",
+				}},
+				Source: Source{
+					ReloadInterval: 30 * time.Second,
+					HTTP: &confighttp.HTTPClientSettings{
+						Endpoint: "http://sampling-config.example.com/strategies.json",
+					},
+				},
+				ServiceOverrides: map[string]StrategyOverride{
+					"checkout": {Type: "probabilistic", Param: 0.1},
+					"payments": {Type: "ratelimiting", Param: 5},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.String(), func(t *testing.T) {
@@ -113,11 +135,35 @@ func TestValidate(t *testing.T) {
 			},
 			expected: errTooManySources,
 		},
+		{
+			desc: "too many sources with http",
+			cfg: Config{
+				GRPCServerSettings: &configgrpc.GRPCServerSettings{},
+				Source: Source{
+					HTTP: &confighttp.HTTPClientSettings{},
+					File: "/tmp/some-file",
+				},
+			},
+			expected: errTooManySources,
+		},
+		{
+			desc: "invalid service override",
+			cfg: Config{
+				GRPCServerSettings: &configgrpc.GRPCServerSettings{},
+				Source: Source{
+					File: "/tmp/some-file",
+				},
+				ServiceOverrides: map[string]StrategyOverride{
+					"checkout": {Type: "probabilistic", Param: 1.5},
+				},
+			},
+			expected: errors.New(`invalid override for service "checkout": the sampling probability must be between 0 and 1, got 1.5`),
+		},
 	}
 	for _, tC := range testCases {
 		t.Run(tC.desc, func(t *testing.T) {
 			res := tC.cfg.Validate()
-			assert.Equal(t, tC.expected, res)
+			assert.EqualError(t, res, tC.expected.Error())
 		})
 	}
 }
@@ -53,6 +53,7 @@ func (jrse *jrsExtension) Start(ctx context.Context, host component.Host) error
 	// source of the sampling config:
 	// - remote (gRPC)
 	// - local file
+	// - HTTP backend
 	// we can then use a simplified logic here to assign the appropriate store
 	if jrse.cfg.Source.File != "" {
 		opts := static.Options{
@@ -81,6 +82,29 @@ func (jrse *jrsExtension) Start(ctx context.Context, host component.Host) error
 		})
 	}
 
+	if jrse.cfg.Source.HTTP != nil {
+		client, err := jrse.cfg.Source.HTTP.ToClient(host, jrse.telemetry)
+		if err != nil {
+			return fmt.Errorf("error while creating the HTTP client for the sampling source: %w", err)
+		}
+
+		ss := internal.NewHTTPStrategyStore(jrse.telemetry.Logger, client, jrse.cfg.Source.HTTP.Endpoint, jrse.cfg.Source.ReloadInterval)
+		if err := ss.Start(ctx); err != nil {
+			return fmt.Errorf("failed to load the sampling strategies from the HTTP source: %w", err)
+		}
+
+		jrse.samplingStore = ss
+		jrse.closers = append(jrse.closers, ss.Close)
+	}
+
+	if len(jrse.cfg.ServiceOverrides) > 0 {
+		ss, err := newOverridesStore(jrse.samplingStore, jrse.cfg.ServiceOverrides)
+		if err != nil {
+			return err
+		}
+		jrse.samplingStore = ss
+	}
+
 	if jrse.cfg.HTTPServerSettings != nil {
 		httpServer, err := internal.NewHTTP(jrse.telemetry, *jrse.cfg.HTTPServerSettings, jrse.samplingStore)
 		if err != nil {
@@ -18,14 +18,18 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/http"
+	"net/http/httptest"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/jaegertracing/jaeger/proto-gen/api_v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/configgrpc"
+	"go.opentelemetry.io/collector/config/confighttp"
 	"google.golang.org/grpc"
 )
 
@@ -52,6 +56,39 @@ func TestStartAndShutdownLocalFile(t *testing.T) {
 	assert.NoError(t, e.Shutdown(context.Background()))
 }
 
+func TestStartAndShutdownHTTPSource(t *testing.T) {
+	// prepare the backend serving the strategies
+	backend := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("ETag", `"v1"`)
+		_, _ = rw.Write([]byte(`{"default_strategy": {"type": "probabilistic", "param": 0.5}}`))
+	}))
+	defer backend.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.HTTPServerSettings = nil
+	cfg.GRPCServerSettings = nil
+	cfg.Source.HTTP = &confighttp.HTTPClientSettings{Endpoint: backend.URL}
+	cfg.Source.ReloadInterval = time.Second
+	cfg.ServiceOverrides = map[string]StrategyOverride{
+		"checkout": {Type: "ratelimiting", Param: 5},
+	}
+
+	e := newExtension(cfg, componenttest.NewNopTelemetrySettings())
+	require.NotNil(t, e)
+	require.NoError(t, e.Start(context.Background(), componenttest.NewNopHost()))
+
+	// test and verify
+	resp, err := e.samplingStore.GetSamplingStrategy(context.Background(), "checkout")
+	require.NoError(t, err)
+	assert.Equal(t, int16(5), resp.RateLimitingSampling.MaxTracesPerSecond)
+
+	resp, err = e.samplingStore.GetSamplingStrategy(context.Background(), "cart")
+	require.NoError(t, err)
+	assert.Equal(t, 0.5, resp.ProbabilisticSampling.SamplingRate)
+
+	assert.NoError(t, e.Shutdown(context.Background()))
+}
+
 func TestStartAndShutdownRemote(t *testing.T) {
 	// prepare the socket the mock server will listen at
 	lis, err := net.Listen("tcp", "localhost:0")
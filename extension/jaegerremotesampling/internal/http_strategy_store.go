@@ -0,0 +1,150 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/jaegerremotesampling/internal"
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/jaegertracing/jaeger/cmd/collector/app/sampling/strategystore"
+	"github.com/jaegertracing/jaeger/thrift-gen/sampling"
+	"go.uber.org/zap"
+)
+
+var _ strategystore.StrategyStore = (*HTTPStrategyStore)(nil)
+
+// HTTPStrategyStore serves the strategies of a Jaeger strategies file fetched from an HTTP backend.
+// The strategies are refreshed periodically with requests conditional on the ETag of the last
+// response, so that unchanged strategies are neither transferred nor parsed again.
+type HTTPStrategyStore struct {
+	logger         *zap.Logger
+	client         *http.Client
+	endpoint       string
+	reloadInterval time.Duration
+
+	// etag is only accessed by the goroutine reloading the strategies
+	etag string
+
+	mu         sync.RWMutex
+	strategies *storedStrategies
+
+	cancel     context.CancelFunc
+	shutdownWG sync.WaitGroup
+}
+
+// NewHTTPStrategyStore returns a new strategy store for the given endpoint. The strategies are not
+// refreshed when the reload interval is zero.
+func NewHTTPStrategyStore(logger *zap.Logger, client *http.Client, endpoint string, reloadInterval time.Duration) *HTTPStrategyStore {
+	return &HTTPStrategyStore{
+		logger:         logger,
+		client:         client,
+		endpoint:       endpoint,
+		reloadInterval: reloadInterval,
+	}
+}
+
+// Start fetches the strategies and starts refreshing them in the background.
+func (s *HTTPStrategyStore) Start(ctx context.Context) error {
+	if err := s.reload(ctx); err != nil {
+		return err
+	}
+
+	if s.reloadInterval <= 0 {
+		return nil
+	}
+
+	var reloadCtx context.Context
+	reloadCtx, s.cancel = context.WithCancel(context.Background())
+	s.shutdownWG.Add(1)
+	go func() {
+		defer s.shutdownWG.Done()
+
+		ticker := time.NewTicker(s.reloadInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := s.reload(reloadCtx); err != nil && reloadCtx.Err() == nil {
+					s.logger.Warn("failed to reload the sampling strategies, serving the previous ones", zap.Error(err))
+				}
+			case <-reloadCtx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// Close stops refreshing the strategies.
+func (s *HTTPStrategyStore) Close() error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.shutdownWG.Wait()
+	return nil
+}
+
+// GetSamplingStrategy returns the strategy of the service, or the default strategy if the service has none.
+func (s *HTTPStrategyStore) GetSamplingStrategy(_ context.Context, serviceName string) (*sampling.SamplingStrategyResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.strategies.get(serviceName), nil
+}
+
+func (s *HTTPStrategyStore) reload(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create the request for the sampling strategies: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if s.etag != "" {
+		req.Header.Set("If-None-Match", s.etag)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to fetch the sampling strategies: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotModified:
+		return nil
+	default:
+		return fmt.Errorf("failed to fetch the sampling strategies: unexpected status code %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read the sampling strategies: %w", err)
+	}
+
+	strategies, err := parseStrategies(body)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.strategies = strategies
+	s.mu.Unlock()
+	s.etag = resp.Header.Get("ETag")
+	s.logger.Debug("loaded the sampling strategies", zap.String("etag", s.etag))
+	return nil
+}
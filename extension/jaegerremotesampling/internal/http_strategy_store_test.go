@@ -0,0 +1,121 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type strategiesBackend struct {
+	mu         sync.Mutex
+	etag       string
+	strategies string
+	requests   int
+	notMatched int
+}
+
+func (b *strategiesBackend) set(etag, strategies string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.etag = etag
+	b.strategies = strategies
+}
+
+func (b *strategiesBackend) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.requests++
+	if r.Header.Get("If-None-Match") == b.etag {
+		rw.WriteHeader(http.StatusNotModified)
+		return
+	}
+	b.notMatched++
+	rw.Header().Set("ETag", b.etag)
+	_, _ = rw.Write([]byte(b.strategies))
+}
+
+func (b *strategiesBackend) counts() (int, int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.requests, b.notMatched
+}
+
+func TestHTTPStrategyStoreReload(t *testing.T) {
+	backend := &strategiesBackend{}
+	backend.set(`"v1"`, `{"default_strategy": {"type": "probabilistic", "param": 0.5}}`)
+	server := httptest.NewServer(backend)
+	defer server.Close()
+
+	store := NewHTTPStrategyStore(zap.NewNop(), server.Client(), server.URL, 10*time.Millisecond)
+	require.NoError(t, store.Start(context.Background()))
+	defer func() { assert.NoError(t, store.Close()) }()
+
+	resp, err := store.GetSamplingStrategy(context.Background(), "foo")
+	require.NoError(t, err)
+	assert.Equal(t, 0.5, resp.ProbabilisticSampling.SamplingRate)
+
+	// unchanged strategies are not transferred again
+	require.Eventually(t, func() bool {
+		requests, _ := backend.counts()
+		return requests > 2
+	}, 5*time.Second, 10*time.Millisecond)
+	_, notMatched := backend.counts()
+	assert.Equal(t, 1, notMatched)
+
+	backend.set(`"v2"`, `{"service_strategies": [{"service": "foo", "type": "ratelimiting", "param": 10}]}`)
+	assert.Eventually(t, func() bool {
+		resp, err = store.GetSamplingStrategy(context.Background(), "foo")
+		return err == nil && resp.RateLimitingSampling != nil && resp.RateLimitingSampling.MaxTracesPerSecond == 10
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestHTTPStrategyStoreKeepsStrategiesOnInvalidUpdate(t *testing.T) {
+	backend := &strategiesBackend{}
+	backend.set(`"v1"`, `{"default_strategy": {"type": "probabilistic", "param": 0.5}}`)
+	server := httptest.NewServer(backend)
+	defer server.Close()
+
+	store := NewHTTPStrategyStore(zap.NewNop(), server.Client(), server.URL, 0)
+	require.NoError(t, store.Start(context.Background()))
+
+	backend.set(`"v2"`, `{"default_strategy": {"type": "unknown"}}`)
+	assert.Error(t, store.reload(context.Background()))
+
+	resp, err := store.GetSamplingStrategy(context.Background(), "foo")
+	require.NoError(t, err)
+	assert.Equal(t, 0.5, resp.ProbabilisticSampling.SamplingRate)
+	assert.Equal(t, `"v1"`, store.etag)
+	assert.NoError(t, store.Close())
+}
+
+func TestHTTPStrategyStoreStartFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	store := NewHTTPStrategyStore(zap.NewNop(), server.Client(), server.URL, time.Second)
+	assert.ErrorContains(t, store.Start(context.Background()), "unexpected status code 500")
+	assert.NoError(t, store.Close())
+}
@@ -0,0 +1,202 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/jaegerremotesampling/internal"
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/jaegertracing/jaeger/thrift-gen/sampling"
+)
+
+const (
+	samplerTypeProbabilistic = "probabilistic"
+	samplerTypeRateLimiting  = "ratelimiting"
+
+	// defaultSamplingProbability is the probability served when the strategies do not have a default strategy,
+	// the same as Jaeger's static strategy store.
+	defaultSamplingProbability = 0.001
+)
+
+// The following types follow the format of Jaeger's sampling strategies file,
+// see https://www.jaegertracing.io/docs/1.39/sampling/#collector-sampling-configuration
+type strategy struct {
+	Type  string  `json:"type"`
+	Param float64 `json:"param"`
+}
+
+type operationStrategy struct {
+	Operation string `json:"operation"`
+	strategy
+}
+
+type serviceStrategy struct {
+	Service             string               `json:"service"`
+	OperationStrategies []*operationStrategy `json:"operation_strategies"`
+	strategy
+}
+
+type strategies struct {
+	ServiceStrategies []*serviceStrategy `json:"service_strategies"`
+	DefaultStrategy   *serviceStrategy   `json:"default_strategy"`
+}
+
+// storedStrategies are the parsed strategies, ready to be served.
+type storedStrategies struct {
+	defaultStrategy   *sampling.SamplingStrategyResponse
+	serviceStrategies map[string]*sampling.SamplingStrategyResponse
+}
+
+func (s *storedStrategies) get(serviceName string) *sampling.SamplingStrategyResponse {
+	if strategy, ok := s.serviceStrategies[serviceName]; ok {
+		return strategy
+	}
+	return s.defaultStrategy
+}
+
+// NewStrategy returns the sampling strategy of the given type, with the same semantics
+// for the parameter as in the strategies file.
+func NewStrategy(strategyType string, param float64) (*sampling.SamplingStrategyResponse, error) {
+	switch strategyType {
+	case samplerTypeProbabilistic:
+		if param < 0 || param > 1 {
+			return nil, fmt.Errorf("the sampling probability must be between 0 and 1, got %v", param)
+		}
+		return &sampling.SamplingStrategyResponse{
+			StrategyType: sampling.SamplingStrategyType_PROBABILISTIC,
+			ProbabilisticSampling: &sampling.ProbabilisticSamplingStrategy{
+				SamplingRate: param,
+			},
+		}, nil
+	case samplerTypeRateLimiting:
+		if param < 0 || param > math.MaxInt16 {
+			return nil, fmt.Errorf("the number of traces per second must be between 0 and %d, got %v", math.MaxInt16, param)
+		}
+		return &sampling.SamplingStrategyResponse{
+			StrategyType: sampling.SamplingStrategyType_RATE_LIMITING,
+			RateLimitingSampling: &sampling.RateLimitingSamplingStrategy{
+				MaxTracesPerSecond: int16(param),
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown sampling strategy type %q, has to be either %q or %q", strategyType, samplerTypeProbabilistic, samplerTypeRateLimiting)
+	}
+}
+
+// parseStrategies parses the content of a strategies file. Unlike Jaeger's static strategy store, invalid
+// strategies are reported as errors, so that a broken file does not replace the strategies being served.
+func parseStrategies(data []byte) (*storedStrategies, error) {
+	var raw strategies
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal strategies: %w", err)
+	}
+
+	stored := &storedStrategies{
+		defaultStrategy: &sampling.SamplingStrategyResponse{
+			StrategyType: sampling.SamplingStrategyType_PROBABILISTIC,
+			ProbabilisticSampling: &sampling.ProbabilisticSamplingStrategy{
+				SamplingRate: defaultSamplingProbability,
+			},
+		},
+		serviceStrategies: make(map[string]*sampling.SamplingStrategyResponse, len(raw.ServiceStrategies)),
+	}
+
+	if raw.DefaultStrategy != nil {
+		defaultStrategy, err := parseServiceStrategy(raw.DefaultStrategy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid default strategy: %w", err)
+		}
+		stored.defaultStrategy = defaultStrategy
+	}
+	defaultOperationSampling := stored.defaultStrategy.OperationSampling
+
+	for _, s := range raw.ServiceStrategies {
+		serviceStrategy, err := parseServiceStrategy(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid strategy for service %q: %w", s.Service, err)
+		}
+		stored.serviceStrategies[s.Service] = serviceStrategy
+
+		if defaultOperationSampling == nil {
+			continue
+		}
+
+		// The default operation strategies apply to the services as well, with their own probability
+		// as the default one when they don't have operation strategies.
+		if serviceStrategy.OperationSampling == nil {
+			if serviceStrategy.ProbabilisticSampling != nil {
+				operationSampling := *defaultOperationSampling
+				operationSampling.DefaultSamplingProbability = serviceStrategy.ProbabilisticSampling.SamplingRate
+				serviceStrategy.OperationSampling = &operationSampling
+			}
+			continue
+		}
+		serviceStrategy.OperationSampling.PerOperationStrategies = mergeOperationStrategies(
+			serviceStrategy.OperationSampling.PerOperationStrategies,
+			defaultOperationSampling.PerOperationStrategies,
+		)
+	}
+
+	return stored, nil
+}
+
+func parseServiceStrategy(s *serviceStrategy) (*sampling.SamplingStrategyResponse, error) {
+	resp, err := NewStrategy(s.Type, s.Param)
+	if err != nil {
+		return nil, err
+	}
+	if len(s.OperationStrategies) == 0 {
+		return resp, nil
+	}
+
+	operationSampling := &sampling.PerOperationSamplingStrategies{
+		DefaultSamplingProbability: defaultSamplingProbability,
+	}
+	if resp.ProbabilisticSampling != nil {
+		operationSampling.DefaultSamplingProbability = resp.ProbabilisticSampling.SamplingRate
+	}
+
+	for _, op := range s.OperationStrategies {
+		// operation strategies only support probabilistic sampling
+		if op.Type != samplerTypeProbabilistic {
+			return nil, fmt.Errorf("invalid strategy for operation %q: only %q is supported", op.Operation, samplerTypeProbabilistic)
+		}
+		opResp, err := NewStrategy(op.Type, op.Param)
+		if err != nil {
+			return nil, fmt.Errorf("invalid strategy for operation %q: %w", op.Operation, err)
+		}
+		operationSampling.PerOperationStrategies = append(operationSampling.PerOperationStrategies, &sampling.OperationSamplingStrategy{
+			Operation:             op.Operation,
+			ProbabilisticSampling: opResp.ProbabilisticSampling,
+		})
+	}
+	resp.OperationSampling = operationSampling
+	return resp, nil
+}
+
+// mergeOperationStrategies appends the strategies of b for the operations which are not in a.
+func mergeOperationStrategies(a, b []*sampling.OperationSamplingStrategy) []*sampling.OperationSamplingStrategy {
+	operations := make(map[string]struct{}, len(a))
+	for _, s := range a {
+		operations[s.Operation] = struct{}{}
+	}
+	for _, s := range b {
+		if _, ok := operations[s.Operation]; !ok {
+			a = append(a, s)
+		}
+	}
+	return a
+}
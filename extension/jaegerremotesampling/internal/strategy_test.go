@@ -0,0 +1,120 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"testing"
+
+	"github.com/jaegertracing/jaeger/thrift-gen/sampling"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testStrategies = `{
+  "service_strategies": [
+    {
+      "service": "foo",
+      "type": "probabilistic",
+      "param": 0.8,
+      "operation_strategies": [
+        {"operation": "op1", "type": "probabilistic", "param": 0.2}
+      ]
+    },
+    {"service": "bar", "type": "ratelimiting", "param": 5},
+    {"service": "baz", "type": "probabilistic", "param": 0.3}
+  ],
+  "default_strategy": {
+    "type": "probabilistic",
+    "param": 0.5,
+    "operation_strategies": [
+      {"operation": "/health", "type": "probabilistic", "param": 0.0}
+    ]
+  }
+}`
+
+func TestParseStrategies(t *testing.T) {
+	stored, err := parseStrategies([]byte(testStrategies))
+	require.NoError(t, err)
+
+	health := &sampling.OperationSamplingStrategy{
+		Operation:             "/health",
+		ProbabilisticSampling: &sampling.ProbabilisticSamplingStrategy{SamplingRate: 0},
+	}
+
+	// service operation strategies are merged with the default ones
+	foo := stored.get("foo")
+	assert.Equal(t, sampling.SamplingStrategyType_PROBABILISTIC, foo.StrategyType)
+	assert.Equal(t, 0.8, foo.ProbabilisticSampling.SamplingRate)
+	assert.Equal(t, 0.8, foo.OperationSampling.DefaultSamplingProbability)
+	assert.Equal(t, []*sampling.OperationSamplingStrategy{
+		{Operation: "op1", ProbabilisticSampling: &sampling.ProbabilisticSamplingStrategy{SamplingRate: 0.2}},
+		health,
+	}, foo.OperationSampling.PerOperationStrategies)
+
+	bar := stored.get("bar")
+	assert.Equal(t, sampling.SamplingStrategyType_RATE_LIMITING, bar.StrategyType)
+	assert.Equal(t, int16(5), bar.RateLimitingSampling.MaxTracesPerSecond)
+	assert.Nil(t, bar.OperationSampling)
+
+	// services without operation strategies get the default ones, with their own probability
+	baz := stored.get("baz")
+	assert.Equal(t, 0.3, baz.OperationSampling.DefaultSamplingProbability)
+	assert.Equal(t, []*sampling.OperationSamplingStrategy{health}, baz.OperationSampling.PerOperationStrategies)
+
+	unknown := stored.get("unknown")
+	assert.Equal(t, 0.5, unknown.ProbabilisticSampling.SamplingRate)
+}
+
+func TestParseStrategiesWithoutDefault(t *testing.T) {
+	stored, err := parseStrategies([]byte(`{"service_strategies": [{"service": "foo", "type": "probabilistic", "param": 1}]}`))
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, stored.get("foo").ProbabilisticSampling.SamplingRate)
+	assert.Equal(t, defaultSamplingProbability, stored.get("bar").ProbabilisticSampling.SamplingRate)
+}
+
+func TestParseStrategiesErrors(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		strategies string
+		expected   string
+	}{
+		{
+			desc:       "invalid json",
+			strategies: `{`,
+			expected:   "failed to unmarshal strategies",
+		},
+		{
+			desc:       "unknown type",
+			strategies: `{"service_strategies": [{"service": "foo", "type": "adaptive", "param": 1}]}`,
+			expected:   `invalid strategy for service "foo": unknown sampling strategy type "adaptive"`,
+		},
+		{
+			desc:       "invalid default probability",
+			strategies: `{"default_strategy": {"type": "probabilistic", "param": 2}}`,
+			expected:   "invalid default strategy: the sampling probability must be between 0 and 1, got 2",
+		},
+		{
+			desc:       "rate limited operation",
+			strategies: `{"default_strategy": {"type": "probabilistic", "param": 1, "operation_strategies": [{"operation": "op1", "type": "ratelimiting", "param": 1}]}}`,
+			expected:   `invalid strategy for operation "op1": only "probabilistic" is supported`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := parseStrategies([]byte(tc.strategies))
+			assert.ErrorContains(t, err, tc.expected)
+		})
+	}
+}
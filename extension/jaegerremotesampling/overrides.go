@@ -0,0 +1,56 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jaegerremotesampling // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/jaegerremotesampling"
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jaegertracing/jaeger/cmd/collector/app/sampling/strategystore"
+	"github.com/jaegertracing/jaeger/thrift-gen/sampling"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/jaegerremotesampling/internal"
+)
+
+var _ strategystore.StrategyStore = (*overridesStore)(nil)
+
+// overridesStore serves the configured strategies for the overridden services,
+// and the strategies of the source for all the others.
+type overridesStore struct {
+	source    strategystore.StrategyStore
+	overrides map[string]*sampling.SamplingStrategyResponse
+}
+
+func newOverridesStore(source strategystore.StrategyStore, overrides map[string]StrategyOverride) (*overridesStore, error) {
+	store := &overridesStore{
+		source:    source,
+		overrides: make(map[string]*sampling.SamplingStrategyResponse, len(overrides)),
+	}
+	for service, override := range overrides {
+		strategy, err := internal.NewStrategy(override.Type, override.Param)
+		if err != nil {
+			return nil, fmt.Errorf("invalid override for service %q: %w", service, err)
+		}
+		store.overrides[service] = strategy
+	}
+	return store, nil
+}
+
+func (s *overridesStore) GetSamplingStrategy(ctx context.Context, serviceName string) (*sampling.SamplingStrategyResponse, error) {
+	if strategy, ok := s.overrides[serviceName]; ok {
+		return strategy, nil
+	}
+	return s.source.GetSamplingStrategy(ctx, serviceName)
+}
@@ -16,6 +16,7 @@ package oauth2clientauthextension // import "github.com/open-telemetry/opentelem
 
 import (
 	"errors"
+	"fmt"
 	"net/url"
 	"time"
 
@@ -28,6 +29,9 @@ var (
 	errNoClientIDProvided     = errors.New("no ClientID provided in the OAuth2 exporter configuration")
 	errNoTokenURLProvided     = errors.New("no TokenURL provided in OAuth Client Credentials configuration")
 	errNoClientSecretProvided = errors.New("no ClientSecret provided in OAuth Client Credentials configuration")
+	errNoClientCertProvided   = errors.New("no client certificate provided in the TLS configuration for TLS client authentication")
+	errNoScopeSetHosts        = errors.New("no hosts provided for the scope set")
+	errDuplicateScopeSetHost  = errors.New("host configured in several scope sets")
 )
 
 // Config stores the configuration for OAuth2 Client Credentials (2-legged OAuth2 flow) setup.
@@ -54,14 +58,39 @@ type Config struct {
 	// See https://datatracker.ietf.org/doc/html/rfc6749#section-3.3
 	Scopes []string `mapstructure:"scopes,omitempty"`
 
+	// ScopeSets configures the scopes and endpoint parameters of the tokens used for specific hosts, such as the
+	// endpoints of exporters targeting different audiences. Each scope set caches its own token. The requests to other
+	// hosts use the tokens of Scopes and EndpointParams.
+	ScopeSets []ScopeSet `mapstructure:"scope_sets,omitempty"`
+
 	// TLSSetting struct exposes TLS client configuration for the underneath client to authorization server.
 	TLSSetting configtls.TLSClientSetting `mapstructure:"tls,omitempty"`
 
+	// TLSClientAuth authenticates the client to the authorization server with the client certificate of TLSSetting
+	// instead of ClientSecret, and requests tokens bound to this certificate. The exporters must then use the same
+	// certificate to connect to the resource servers.
+	// See https://datatracker.ietf.org/doc/html/rfc8705
+	TLSClientAuth bool `mapstructure:"tls_client_auth,omitempty"`
+
 	// Timeout parameter configures `http.Client.Timeout` for the underneath client to authorization
 	// server while fetching and refreshing tokens.
 	Timeout time.Duration `mapstructure:"timeout,omitempty"`
 }
 
+// ScopeSet configures the tokens used for requests to a set of hosts.
+type ScopeSet struct {
+	// Hosts are the hosts of the requests using the tokens of this scope set, optionally with a port,
+	// e.g. "api.example.com" or "api.example.com:4317".
+	Hosts []string `mapstructure:"hosts"`
+
+	// Scopes specifies the requested permissions.
+	Scopes []string `mapstructure:"scopes,omitempty"`
+
+	// EndpointParams specifies additional parameters for requests to the token endpoint, overriding the ones of
+	// the extension.
+	EndpointParams url.Values `mapstructure:"endpoint_params"`
+}
+
 var _ component.ExtensionConfig = (*Config)(nil)
 
 // Validate checks if the extension configuration is valid
@@ -69,11 +98,27 @@ func (cfg *Config) Validate() error {
 	if cfg.ClientID == "" {
 		return errNoClientIDProvided
 	}
-	if cfg.ClientSecret == "" {
+	if cfg.TLSClientAuth {
+		if cfg.TLSSetting.CertFile == "" || cfg.TLSSetting.KeyFile == "" {
+			return errNoClientCertProvided
+		}
+	} else if cfg.ClientSecret == "" {
 		return errNoClientSecretProvided
 	}
 	if cfg.TokenURL == "" {
 		return errNoTokenURLProvided
 	}
+	hosts := map[string]bool{}
+	for i, set := range cfg.ScopeSets {
+		if len(set.Hosts) == 0 {
+			return fmt.Errorf("scope_sets[%d]: %w", i, errNoScopeSetHosts)
+		}
+		for _, host := range set.Hosts {
+			if hosts[host] {
+				return fmt.Errorf("scope_sets[%d]: %w: %q", i, errDuplicateScopeSetHost, host)
+			}
+			hosts[host] = true
+		}
+	}
 	return nil
 }
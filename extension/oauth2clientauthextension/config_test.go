@@ -69,6 +69,42 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(typeStr, "scopesets"),
+			expected: &Config{
+				ExtensionSettings: config.NewExtensionSettings(component.NewID(typeStr)),
+				ClientSecret:      "someclientsecret",
+				ClientID:          "someclientid",
+				Scopes:            []string{"api.metrics"},
+				TokenURL:          "https://example.com/oauth2/default/v1/token",
+				ScopeSets: []ScopeSet{
+					{
+						Hosts:          []string{"traces.example.com", "traces.example.com:4317"},
+						Scopes:         []string{"api.traces"},
+						EndpointParams: url.Values{"audience": []string{"traces"}},
+					},
+					{
+						Hosts:  []string{"logs.example.com"},
+						Scopes: []string{"api.logs"},
+					},
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(typeStr, "tlsclientauth"),
+			expected: &Config{
+				ExtensionSettings: config.NewExtensionSettings(component.NewID(typeStr)),
+				ClientID:          "someclientid",
+				TokenURL:          "https://example.com/oauth2/default/v1/token",
+				TLSClientAuth:     true,
+				TLSSetting: configtls.TLSClientSetting{
+					TLSSetting: configtls.TLSSetting{
+						CertFile: "certfile",
+						KeyFile:  "keyfile",
+					},
+				},
+			},
+		},
 		{
 			id:          component.NewIDWithName(typeStr, "missingurl"),
 			expectedErr: errNoTokenURLProvided,
@@ -81,6 +117,18 @@ func TestLoadConfig(t *testing.T) {
 			id:          component.NewIDWithName(typeStr, "missingsecret"),
 			expectedErr: errNoClientSecretProvided,
 		},
+		{
+			id:          component.NewIDWithName(typeStr, "missingcert"),
+			expectedErr: errNoClientCertProvided,
+		},
+		{
+			id:          component.NewIDWithName(typeStr, "missingscopesethosts"),
+			expectedErr: errNoScopeSetHosts,
+		},
+		{
+			id:          component.NewIDWithName(typeStr, "duplicatescopesethost"),
+			expectedErr: errDuplicateScopeSetHost,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.String(), func(t *testing.T) {
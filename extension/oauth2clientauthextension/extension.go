@@ -17,7 +17,9 @@ package oauth2clientauthextension // import "github.com/open-telemetry/opentelem
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
+	"net/url"
 
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
@@ -31,10 +33,19 @@ import (
 // workflow for both gRPC and HTTP clients.
 type clientAuthenticator struct {
 	clientCredentials *clientcredentials.Config
+	tokenSource       oauth2.TokenSource
+	scopeSets         []scopeSet
 	logger            *zap.Logger
 	client            *http.Client
 }
 
+// scopeSet caches the tokens used for requests to its hosts.
+type scopeSet struct {
+	hosts             []string
+	clientCredentials *clientcredentials.Config
+	tokenSource       oauth2.TokenSource
+}
+
 type errorWrappingTokenSource struct {
 	ts       oauth2.TokenSource
 	tokenURL string
@@ -50,7 +61,7 @@ func newClientAuthenticator(cfg *Config, logger *zap.Logger) (*clientAuthenticat
 	if cfg.ClientID == "" {
 		return nil, errNoClientIDProvided
 	}
-	if cfg.ClientSecret == "" {
+	if cfg.ClientSecret == "" && !cfg.TLSClientAuth {
 		return nil, errNoClientSecretProvided
 	}
 	if cfg.TokenURL == "" {
@@ -65,7 +76,7 @@ func newClientAuthenticator(cfg *Config, logger *zap.Logger) (*clientAuthenticat
 	}
 	transport.TLSClientConfig = tlsCfg
 
-	return &clientAuthenticator{
+	o := &clientAuthenticator{
 		clientCredentials: &clientcredentials.Config{
 			ClientID:       cfg.ClientID,
 			ClientSecret:   cfg.ClientSecret,
@@ -78,7 +89,65 @@ func newClientAuthenticator(cfg *Config, logger *zap.Logger) (*clientAuthenticat
 			Transport: transport,
 			Timeout:   cfg.Timeout,
 		},
-	}, nil
+	}
+	if cfg.TLSClientAuth {
+		// RFC 8705 clients authenticate with their certificate and only send their ID.
+		o.clientCredentials.ClientSecret = ""
+		o.clientCredentials.AuthStyle = oauth2.AuthStyleInParams
+	}
+	o.tokenSource = o.newTokenSource(o.clientCredentials)
+
+	for _, set := range cfg.ScopeSets {
+		clientCredentials := *o.clientCredentials
+		clientCredentials.Scopes = set.Scopes
+		clientCredentials.EndpointParams = mergeEndpointParams(cfg.EndpointParams, set.EndpointParams)
+		o.scopeSets = append(o.scopeSets, scopeSet{
+			hosts:             set.Hosts,
+			clientCredentials: &clientCredentials,
+			tokenSource:       o.newTokenSource(&clientCredentials),
+		})
+	}
+	return o, nil
+}
+
+// mergeEndpointParams returns the endpoint parameters of the extension overridden by the ones of a scope set.
+func mergeEndpointParams(params url.Values, overrides url.Values) url.Values {
+	if len(params) == 0 && len(overrides) == 0 {
+		return nil
+	}
+	merged := url.Values{}
+	for key, values := range params {
+		merged[key] = values
+	}
+	for key, values := range overrides {
+		merged[key] = values
+	}
+	return merged
+}
+
+// newTokenSource returns a token source caching the tokens of the client credentials until they expire.
+func (o *clientAuthenticator) newTokenSource(clientCredentials *clientcredentials.Config) oauth2.TokenSource {
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, o.client)
+	return errorWrappingTokenSource{
+		ts:       clientCredentials.TokenSource(ctx),
+		tokenURL: clientCredentials.TokenURL,
+	}
+}
+
+// tokenSourceFor returns the token source of the scope set of the host, or the default one.
+func (o *clientAuthenticator) tokenSourceFor(host string) oauth2.TokenSource {
+	hostname := host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		hostname = h
+	}
+	for _, set := range o.scopeSets {
+		for _, h := range set.hosts {
+			if h == host || h == hostname {
+				return set.tokenSource
+			}
+		}
+	}
+	return o.tokenSource
 }
 
 func (ewts errorWrappingTokenSource) Token() (*oauth2.Token, error) {
@@ -91,27 +160,54 @@ func (ewts errorWrappingTokenSource) Token() (*oauth2.Token, error) {
 	return tok, nil
 }
 
-// roundTripper returns oauth2.Transport, an http.RoundTripper that performs "client-credential" OAuth flow and
+// scopedTransport is an http.RoundTripper authenticating the requests with the tokens of the scope set
+// of their host.
+type scopedTransport struct {
+	auth *clientAuthenticator
+	base http.RoundTripper
+}
+
+func (t *scopedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := &oauth2.Transport{
+		Source: t.auth.tokenSourceFor(req.URL.Host),
+		Base:   t.base,
+	}
+	return transport.RoundTrip(req)
+}
+
+// roundTripper returns an http.RoundTripper that performs "client-credential" OAuth flow and
 // also auto refreshes OAuth tokens as needed.
 func (o *clientAuthenticator) roundTripper(base http.RoundTripper) (http.RoundTripper, error) {
-	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, o.client)
-	return &oauth2.Transport{
-		Source: errorWrappingTokenSource{
-			ts:       o.clientCredentials.TokenSource(ctx),
-			tokenURL: o.clientCredentials.TokenURL,
-		},
-		Base: base,
-	}, nil
+	return &scopedTransport{auth: o, base: base}, nil
+}
+
+// scopedPerRPCCredentials authenticates the RPCs with the tokens of the scope set of their host.
+type scopedPerRPCCredentials struct {
+	auth *clientAuthenticator
+}
+
+func (c scopedPerRPCCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return grpcOAuth.TokenSource{TokenSource: c.auth.tokenSourceFor(uriHost(uri))}.GetRequestMetadata(ctx, uri...)
+}
+
+// uriHost returns the host of the URI of the entry point of an RPC.
+func uriHost(uri []string) string {
+	if len(uri) == 0 {
+		return ""
+	}
+	u, err := url.Parse(uri[0])
+	if err != nil {
+		return ""
+	}
+	return u.Host
+}
+
+func (c scopedPerRPCCredentials) RequireTransportSecurity() bool {
+	return true
 }
 
 // perRPCCredentials returns gRPC PerRPCCredentials that supports "client-credential" OAuth flow. The underneath
-// oauth2.clientcredentials.Config instance will manage tokens performing auto refresh as necessary.
+// oauth2.clientcredentials.Config instances will manage tokens performing auto refresh as necessary.
 func (o *clientAuthenticator) perRPCCredentials() (credentials.PerRPCCredentials, error) {
-	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, o.client)
-	return grpcOAuth.TokenSource{
-		TokenSource: errorWrappingTokenSource{
-			ts:       o.clientCredentials.TokenSource(ctx),
-			tokenURL: o.clientCredentials.TokenURL,
-		},
-	}, nil
+	return scopedPerRPCCredentials{auth: o}, nil
 }
@@ -16,18 +16,19 @@ package oauth2clientauthextension
 
 import (
 	"context"
+	"crypto/tls"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.uber.org/zap"
-	"golang.org/x/oauth2"
-	grpcOAuth "google.golang.org/grpc/credentials/oauth"
 )
 
 func TestOAuthClientSettings(t *testing.T) {
@@ -197,11 +198,11 @@ func TestRoundTripper(t *testing.T) {
 			assert.Nil(t, err)
 
 			// test roundTripper is an OAuth RoundTripper
-			oAuth2Transport, ok := roundTripper.(*oauth2.Transport)
+			oAuth2Transport, ok := roundTripper.(*scopedTransport)
 			assert.True(t, ok)
 
 			// test oAuthRoundTripper wrapped the base roundTripper properly
-			wrappedRoundTripper, ok := oAuth2Transport.Base.(*testRoundTripper)
+			wrappedRoundTripper, ok := oAuth2Transport.base.(*testRoundTripper)
 			assert.True(t, ok)
 			assert.Equal(t, wrappedRoundTripper.testString, testString)
 		})
@@ -248,9 +249,10 @@ func TestOAuth2PerRPCCredentials(t *testing.T) {
 			assert.NoError(t, err)
 			perRPCCredentials, err := oauth2Authenticator.perRPCCredentials()
 			assert.Nil(t, err)
-			// test perRPCCredentials is an grpc OAuthTokenSource
-			_, ok := perRPCCredentials.(grpcOAuth.TokenSource)
+			// test perRPCCredentials is an OAuth PerRPCCredentials
+			_, ok := perRPCCredentials.(scopedPerRPCCredentials)
 			assert.True(t, ok)
+			assert.True(t, perRPCCredentials.RequireTransportSecurity())
 		})
 	}
 }
@@ -296,3 +298,121 @@ func TestFailContactingOAuth(t *testing.T) {
 	assert.ErrorIs(t, err, errFailedToGetSecurityToken)
 	assert.Contains(t, err.Error(), serverURL.String())
 }
+
+func TestScopeSets(t *testing.T) {
+	var requests []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		requests = append(requests, r.PostForm)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := fmt.Fprintf(w, `{"access_token":%q,"token_type":"bearer","expires_in":3600}`, r.PostForm.Get("scope"))
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	oauth2Authenticator, err := newClientAuthenticator(&Config{
+		ClientID:       "testclientid",
+		ClientSecret:   "testsecret",
+		TokenURL:       server.URL,
+		Scopes:         []string{"default"},
+		EndpointParams: url.Values{"audience": []string{"default"}, "resource": []string{"metrics"}},
+		ScopeSets: []ScopeSet{
+			{
+				Hosts:          []string{"traces.example.com"},
+				Scopes:         []string{"traces"},
+				EndpointParams: url.Values{"audience": []string{"traces"}},
+			},
+			{
+				Hosts:  []string{"logs.example.com:4317"},
+				Scopes: []string{"logs"},
+			},
+		},
+	}, zap.NewNop())
+	require.NoError(t, err)
+
+	tests := []struct {
+		uri           string
+		expectedToken string
+	}{
+		{uri: "https://traces.example.com/v1/traces", expectedToken: "traces"},
+		{uri: "https://traces.example.com:4317/opentelemetry.proto.collector.trace.v1.TraceService", expectedToken: "traces"},
+		{uri: "https://logs.example.com:4317/opentelemetry.proto.collector.logs.v1.LogsService", expectedToken: "logs"},
+		{uri: "https://logs.example.com/v1/logs", expectedToken: "default"},
+		{uri: "https://metrics.example.com/v1/metrics", expectedToken: "default"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.uri, func(t *testing.T) {
+			token, err := oauth2Authenticator.tokenSourceFor(uriHost([]string{tt.uri})).Token()
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedToken, token.AccessToken)
+		})
+	}
+
+	// each scope set caches its own token
+	require.Len(t, requests, 3)
+	assert.Equal(t, url.Values{
+		"grant_type": []string{"client_credentials"},
+		"scope":      []string{"traces"},
+		"audience":   []string{"traces"},
+		"resource":   []string{"metrics"},
+	}, requests[0])
+	assert.Equal(t, "logs", requests[1].Get("scope"))
+	assert.Equal(t, "default", requests[1].Get("audience"))
+	assert.Equal(t, "default", requests[2].Get("scope"))
+
+	// HTTP requests share the token caches
+	var authorization string
+	roundTripper, err := oauth2Authenticator.roundTripper(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		authorization = req.Header.Get("Authorization")
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	}))
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPost, "https://traces.example.com/v1/traces", nil)
+	require.NoError(t, err)
+	resp, err := roundTripper.RoundTrip(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, "Bearer traces", authorization)
+	assert.Len(t, requests, 3)
+}
+
+func TestTLSClientAuth(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "testclientid", r.PostForm.Get("client_id"))
+		assert.Empty(t, r.PostForm.Get("client_secret"))
+		_, _, ok := r.BasicAuth()
+		assert.False(t, ok)
+		assert.Len(t, r.TLS.PeerCertificates, 1)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"access_token":"bound","token_type":"bearer","expires_in":3600}`))
+		assert.NoError(t, err)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	oauth2Authenticator, err := newClientAuthenticator(&Config{
+		ClientID:      "testclientid",
+		TokenURL:      server.URL,
+		TLSClientAuth: true,
+		TLSSetting: configtls.TLSClientSetting{
+			TLSSetting: configtls.TLSSetting{
+				CertFile: "testdata/test-cert.pem",
+				KeyFile:  "testdata/test-key.pem",
+			},
+			InsecureSkipVerify: true,
+		},
+	}, zap.NewNop())
+	require.NoError(t, err)
+
+	token, err := oauth2Authenticator.tokenSourceFor("example.com").Token()
+	require.NoError(t, err)
+	assert.Equal(t, "bound", token.AccessToken)
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
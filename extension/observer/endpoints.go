@@ -36,6 +36,10 @@ const (
 	PodType EndpointType = "pod"
 	// K8sNodeType is a Kubernetes Node endpoint.
 	K8sNodeType EndpointType = "k8s.node"
+	// K8sServiceType is a Kubernetes Service port endpoint.
+	K8sServiceType EndpointType = "k8s.service"
+	// K8sIngressType is a Kubernetes Ingress path endpoint.
+	K8sIngressType EndpointType = "k8s.ingress"
 	// HostPortType is a hostport endpoint.
 	HostPortType EndpointType = "hostport"
 	// ContainerType is a container endpoint.
@@ -46,6 +50,8 @@ var (
 	_ EndpointDetails = (*Pod)(nil)
 	_ EndpointDetails = (*Port)(nil)
 	_ EndpointDetails = (*K8sNode)(nil)
+	_ EndpointDetails = (*K8sService)(nil)
+	_ EndpointDetails = (*K8sIngress)(nil)
 	_ EndpointDetails = (*HostPort)(nil)
 	_ EndpointDetails = (*Container)(nil)
 )
@@ -170,6 +176,13 @@ type HostPort struct {
 	Transport Transport
 	// IsIPv6 indicates whether or not the Endpoint is IPv6.
 	IsIPv6 bool
+	// Username of the owner of the process associated to Endpoint.
+	Username string
+	// CgroupPath is the path of the cgroup of the process associated to Endpoint.
+	CgroupPath string
+	// ContainerID is the id of the container running the process associated to
+	// Endpoint. It is an empty string if the process is not containerized.
+	ContainerID string
 }
 
 func (h *HostPort) Env() EndpointEnv {
@@ -179,6 +192,9 @@ func (h *HostPort) Env() EndpointEnv {
 		"is_ipv6":      h.IsIPv6,
 		"port":         h.Port,
 		"transport":    h.Transport,
+		"username":     h.Username,
+		"cgroup_path":  h.CgroupPath,
+		"container_id": h.ContainerID,
 	}
 }
 
@@ -273,3 +289,83 @@ func (n *K8sNode) Env() EndpointEnv {
 func (n *K8sNode) Type() EndpointType {
 	return K8sNodeType
 }
+
+// K8sService represents a port of a Kubernetes Service object.
+type K8sService struct {
+	// Name is the name of the Kubernetes Service.
+	Name string
+	// UID is the unique ID for the service.
+	UID string
+	// Namespace is the namespace of the service.
+	Namespace string
+	// Annotations is an arbitrary key-value map of non-identifying, user-specified service metadata.
+	Annotations map[string]string
+	// Labels is the map of identifying, user-specified service metadata.
+	Labels map[string]string
+	// ServiceType is the type of the service, e.g. "ClusterIP", "NodePort", "LoadBalancer" or "ExternalName".
+	ServiceType string
+	// ClusterIP is the cluster IP of the service, or "None" for a headless service.
+	ClusterIP string
+	// PortName is the name of the service port.
+	PortName string
+	// Port is the port number of the service port.
+	Port uint16
+	// Transport is the transport protocol used by the service port. (TCP or UDP).
+	Transport Transport
+}
+
+func (s *K8sService) Env() EndpointEnv {
+	return map[string]interface{}{
+		"name":         s.Name,
+		"uid":          s.UID,
+		"namespace":    s.Namespace,
+		"annotations":  s.Annotations,
+		"labels":       s.Labels,
+		"service_type": s.ServiceType,
+		"cluster_ip":   s.ClusterIP,
+		"port_name":    s.PortName,
+		"port":         s.Port,
+		"transport":    s.Transport,
+	}
+}
+
+func (s *K8sService) Type() EndpointType {
+	return K8sServiceType
+}
+
+// K8sIngress represents a path of a rule of a Kubernetes Ingress object.
+type K8sIngress struct {
+	// Name is the name of the Kubernetes Ingress.
+	Name string
+	// UID is the unique ID for the ingress.
+	UID string
+	// Namespace is the namespace of the ingress.
+	Namespace string
+	// Annotations is an arbitrary key-value map of non-identifying, user-specified ingress metadata.
+	Annotations map[string]string
+	// Labels is the map of identifying, user-specified ingress metadata.
+	Labels map[string]string
+	// Scheme is "https" if the host of the rule has a TLS configuration, "http" otherwise.
+	Scheme string
+	// Host is the host of the rule, or the address of the load balancer of the ingress if the rule has no host.
+	Host string
+	// Path is the path of the rule.
+	Path string
+}
+
+func (i *K8sIngress) Env() EndpointEnv {
+	return map[string]interface{}{
+		"name":        i.Name,
+		"uid":         i.UID,
+		"namespace":   i.Namespace,
+		"annotations": i.Annotations,
+		"labels":      i.Labels,
+		"scheme":      i.Scheme,
+		"host":        i.Host,
+		"path":        i.Path,
+	}
+}
+
+func (i *K8sIngress) Type() EndpointType {
+	return K8sIngressType
+}
@@ -115,6 +115,9 @@ func TestEndpointEnv(t *testing.T) {
 					Port:        2379,
 					Transport:   ProtocolUDP,
 					IsIPv6:      true,
+					Username:    "etcd",
+					CgroupPath:  "/system.slice/docker-0123456789abcdef.scope",
+					ContainerID: "0123456789abcdef",
 				},
 			},
 			want: EndpointEnv{
@@ -126,6 +129,9 @@ func TestEndpointEnv(t *testing.T) {
 				"is_ipv6":      true,
 				"port":         uint16(2379),
 				"transport":    ProtocolUDP,
+				"username":     "etcd",
+				"cgroup_path":  "/system.slice/docker-0123456789abcdef.scope",
+				"container_id": "0123456789abcdef",
 			},
 			wantErr: false,
 		},
@@ -211,6 +217,72 @@ func TestEndpointEnv(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Kubernetes Service",
+			endpoint: Endpoint{
+				ID:     EndpointID("k8s_service_endpoint_id"),
+				Target: "10.0.0.10:8080",
+				Details: &K8sService{
+					Name:        "a-k8s-service",
+					UID:         "a-k8s-service-uid",
+					Namespace:   "default",
+					Annotations: map[string]string{"annotation_key": "annotation_val"},
+					Labels:      map[string]string{"label_key": "label_val"},
+					ServiceType: "ClusterIP",
+					ClusterIP:   "10.0.0.10",
+					PortName:    "http",
+					Port:        8080,
+					Transport:   ProtocolTCP,
+				},
+			},
+			want: EndpointEnv{
+				"type":         "k8s.service",
+				"id":           "k8s_service_endpoint_id",
+				"endpoint":     "10.0.0.10:8080",
+				"name":         "a-k8s-service",
+				"uid":          "a-k8s-service-uid",
+				"namespace":    "default",
+				"annotations":  map[string]string{"annotation_key": "annotation_val"},
+				"labels":       map[string]string{"label_key": "label_val"},
+				"service_type": "ClusterIP",
+				"cluster_ip":   "10.0.0.10",
+				"port_name":    "http",
+				"port":         uint16(8080),
+				"transport":    ProtocolTCP,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Kubernetes Ingress",
+			endpoint: Endpoint{
+				ID:     EndpointID("k8s_ingress_endpoint_id"),
+				Target: "https://example.com/api",
+				Details: &K8sIngress{
+					Name:        "a-k8s-ingress",
+					UID:         "a-k8s-ingress-uid",
+					Namespace:   "default",
+					Annotations: map[string]string{"annotation_key": "annotation_val"},
+					Labels:      map[string]string{"label_key": "label_val"},
+					Scheme:      "https",
+					Host:        "example.com",
+					Path:        "/api",
+				},
+			},
+			want: EndpointEnv{
+				"type":        "k8s.ingress",
+				"id":          "k8s_ingress_endpoint_id",
+				"endpoint":    "https://example.com/api",
+				"name":        "a-k8s-ingress",
+				"uid":         "a-k8s-ingress-uid",
+				"namespace":   "default",
+				"annotations": map[string]string{"annotation_key": "annotation_val"},
+				"labels":      map[string]string{"label_key": "label_val"},
+				"scheme":      "https",
+				"host":        "example.com",
+				"path":        "/api",
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,32 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hostobserver // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/hostobserver"
+
+import "regexp"
+
+// containerIDPattern matches the 64 hexadecimal characters container ids in the
+// cgroup paths of the container runtimes, e.g. "/docker/<id>",
+// "/system.slice/docker-<id>.scope" or "/kubepods/burstable/pod<uid>/<id>".
+var containerIDPattern = regexp.MustCompile(`[0-9a-f]{64}`)
+
+// containerIDFromCgroupPath returns the id of the container of the cgroup path,
+// or an empty string if the path is not the one of a container.
+func containerIDFromCgroupPath(cgroupPath string) string {
+	ids := containerIDPattern.FindAllString(cgroupPath, -1)
+	if len(ids) == 0 {
+		return ""
+	}
+	return ids[len(ids)-1]
+}
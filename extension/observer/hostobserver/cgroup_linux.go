@@ -0,0 +1,61 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+// +build linux
+
+package hostobserver // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/hostobserver"
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// processCgroupPath returns the cgroup path of the process, read from
+// /proc/<pid>/cgroup. The root of the proc filesystem can be changed with the
+// HOST_PROC environment variable, as for the process details.
+func processCgroupPath(pid int32) (string, error) {
+	procRoot := os.Getenv("HOST_PROC")
+	if procRoot == "" {
+		procRoot = "/proc"
+	}
+	content, err := os.ReadFile(filepath.Join(procRoot, fmt.Sprint(pid), "cgroup"))
+	if err != nil {
+		return "", err
+	}
+	return parseCgroupPath(string(content)), nil
+}
+
+// parseCgroupPath returns the cgroup path of the content of a /proc/<pid>/cgroup
+// file, whose lines are formatted as "hierarchy-ID:controller-list:cgroup-path".
+// The unified (cgroup v2) hierarchy is preferred, falling back to the first
+// hierarchy of a cgroup v1 controller.
+func parseCgroupPath(content string) string {
+	var v1Path string
+	for _, line := range strings.Split(content, "\n") {
+		parts := strings.SplitN(strings.TrimSpace(line), ":", 3)
+		if len(parts) != 3 || parts[2] == "" {
+			continue
+		}
+		if parts[0] == "0" && parts[1] == "" {
+			return parts[2]
+		}
+		if v1Path == "" && parts[1] != "" && !strings.HasPrefix(parts[1], "name=") {
+			v1Path = parts[2]
+		}
+	}
+	return v1Path
+}
@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+// +build linux
+
+package hostobserver
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCgroupPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			name:    "cgroup v2",
+			content: "0::/system.slice/docker-0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef.scope\n",
+			want:    "/system.slice/docker-0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef.scope",
+		},
+		{
+			name: "cgroup v1",
+			content: "12:name=systemd:/docker/0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef\n" +
+				"11:cpu,cpuacct:/docker/0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef\n" +
+				"10:memory:/docker/0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef\n",
+			want: "/docker/0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+		},
+		{
+			name:    "hybrid",
+			content: "1:name=systemd:/user.slice\n2:cpu:/user.slice/cpu\n0::/user.slice/user-1000.slice\n",
+			want:    "/user.slice/user-1000.slice",
+		},
+		{name: "empty", content: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseCgroupPath(tt.content))
+		})
+	}
+}
+
+func TestProcessCgroupPath(t *testing.T) {
+	procRoot := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(procRoot, "42"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(procRoot, "42", "cgroup"), []byte("0::/docker/0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef\n"), 0600))
+	t.Setenv("HOST_PROC", procRoot)
+
+	cgroupPath, err := processCgroupPath(42)
+	require.NoError(t, err)
+	assert.Equal(t, "/docker/0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef", cgroupPath)
+
+	_, err = processCgroupPath(43)
+	assert.Error(t, err)
+}
@@ -0,0 +1,45 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hostobserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainerIDFromCgroupPath(t *testing.T) {
+	tests := []struct {
+		name       string
+		cgroupPath string
+		want       string
+	}{
+		{name: "host process", cgroupPath: "/system.slice/sshd.service", want: ""},
+		{name: "docker", cgroupPath: "/docker/0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef", want: "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"},
+		{name: "docker systemd", cgroupPath: "/system.slice/docker-0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef.scope", want: "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"},
+		{name: "containerd", cgroupPath: "/system.slice/containerd.service/kubepods-burstable-pod1234.slice:cri-containerd:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef", want: "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"},
+		{
+			name:       "kubernetes",
+			cgroupPath: "/kubepods/burstable/podfedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210/0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+			want:       "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+		},
+		{name: "empty", cgroupPath: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, containerIDFromCgroupPath(tt.cgroupPath))
+		})
+	}
+}
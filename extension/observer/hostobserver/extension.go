@@ -172,7 +172,10 @@ func (e endpointsLister) collectEndpoints(conns []net.ConnectionStat) []observer
 					Transport:   cd.transport,
 					// TODO: Move this field to observer.Endpoint and
 					// update receiver_creator to filter IPv4/IPv6.
-					IsIPv6: cd.isIPv6,
+					IsIPv6:      cd.isIPv6,
+					Username:    pd.username,
+					CgroupPath:  pd.cgroupPath,
+					ContainerID: pd.containerID,
 				},
 			}
 			endpoints = append(endpoints, e)
@@ -220,8 +223,11 @@ func collectConnectionDetails(c *net.ConnectionStat) connectionDetails {
 }
 
 type processDetails struct {
-	name string
-	args string
+	name        string
+	args        string
+	username    string
+	cgroupPath  string
+	containerID string
 }
 
 func collectProcessDetails(proc *process.Process) (*processDetails, error) {
@@ -235,9 +241,18 @@ func collectProcessDetails(proc *process.Process) (*processDetails, error) {
 		return nil, fmt.Errorf("could not get process args: %w", err)
 	}
 
+	// The owner and the cgroup of the process are best effort metadata: the
+	// user might not exist in the user database of the collector host, and the
+	// cgroup is only available on Linux.
+	username, _ := proc.Username()
+	cgroupPath, _ := processCgroupPath(proc.Pid)
+
 	return &processDetails{
-		name: name,
-		args: args,
+		name:        name,
+		args:        args,
+		username:    username,
+		cgroupPath:  cgroupPath,
+		containerID: containerIDFromCgroupPath(cgroupPath),
 	}, nil
 }
 
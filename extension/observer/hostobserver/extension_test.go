@@ -529,6 +529,48 @@ func TestCollectEndpoints(t *testing.T) {
 			},
 			want: []observer.Endpoint{},
 		},
+		{
+			name: "Listening TCP socket of a containerized process",
+			conns: []psnet.ConnectionStat{
+				{
+					Family: syscall.AF_INET,
+					Type:   syscall.SOCK_STREAM,
+					Laddr: psnet.Addr{
+						IP:   "0.0.0.0",
+						Port: 6379,
+					},
+					Status: "LISTEN",
+					Pid:    9999,
+				},
+			},
+			newProc: func(pid int32) (*process.Process, error) {
+				return &process.Process{Pid: pid}, nil
+			},
+			procDetails: func(proc *process.Process) (*processDetails, error) {
+				return &processDetails{
+					name:        "redis-server",
+					args:        "redis-server *:6379",
+					username:    "redis",
+					cgroupPath:  "/docker/0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+					containerID: "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+				}, nil
+			},
+			want: []observer.Endpoint{
+				{
+					ID:     observer.EndpointID("()127.0.0.1-6379-TCP-9999"),
+					Target: "127.0.0.1:6379",
+					Details: &observer.HostPort{
+						ProcessName: "redis-server",
+						Command:     "redis-server *:6379",
+						Port:        6379,
+						Transport:   observer.ProtocolTCP,
+						Username:    "redis",
+						CgroupPath:  "/docker/0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+						ContainerID: "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
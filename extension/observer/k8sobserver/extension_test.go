@@ -223,3 +223,50 @@ func TestExtensionObserveNodes(t *testing.T) {
 
 	require.NoError(t, ext.Shutdown(context.Background()))
 }
+
+func TestExtensionObserveServicesAndIngresses(t *testing.T) {
+	factory := NewFactory()
+	config := factory.CreateDefaultConfig().(*Config)
+	config.ObservePods = false
+	config.ObserveServices = true
+	config.ObserveIngresses = true
+	mockServiceHost(t, config)
+
+	ext, err := newObserver(config, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	require.NotNil(t, ext)
+
+	obs := ext.(*k8sObserver)
+	require.Nil(t, obs.podListerWatcher)
+	serviceListerWatcher := framework.NewFakeControllerSource()
+	obs.serviceListerWatcher = serviceListerWatcher
+	ingressListerWatcher := framework.NewFakeControllerSource()
+	obs.ingressListerWatcher = ingressListerWatcher
+
+	serviceListerWatcher.Add(service1V1)
+	ingressListerWatcher.Add(ingress1V1)
+
+	require.NoError(t, ext.Start(context.Background(), componenttest.NewNopHost()))
+
+	sink := &endpointSink{}
+	obs.ListAndWatch(sink)
+
+	requireSink(t, sink, func() bool {
+		return len(sink.added) == 3
+	})
+
+	var targets []string
+	for _, e := range sink.added {
+		targets = append(targets, e.Target)
+	}
+	assert.ElementsMatch(t, []string{"10.0.0.1:80", "10.0.0.1:53", "https://secure.example.com/api"}, targets)
+
+	serviceListerWatcher.Delete(service1V1)
+	ingressListerWatcher.Delete(ingress1V1)
+
+	requireSink(t, sink, func() bool {
+		return len(sink.removed) == 3
+	})
+
+	require.NoError(t, ext.Shutdown(context.Background()))
+}
@@ -45,6 +45,8 @@ func createDefaultConfig() component.ExtensionConfig {
 		APIConfig:         k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeServiceAccount},
 		ObservePods:       true,
 		ObserveNodes:      false,
+		ObserveServices:   false,
+		ObserveIngresses:  false,
 	}
 }
 
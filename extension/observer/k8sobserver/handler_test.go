@@ -203,3 +203,72 @@ func TestNodeEndpointsChanged(t *testing.T) {
 		},
 	}, th.ListEndpoints())
 }
+
+func TestServiceEndpointsAddedAndRemoved(t *testing.T) {
+	th := newTestHandler()
+	th.OnAdd(service1V1)
+	assert.ElementsMatch(t, convertServiceToEndpoints("test-1", service1V1), th.ListEndpoints())
+	require.Len(t, th.ListEndpoints(), 2)
+
+	th.OnDelete(service1V1)
+	assert.Empty(t, th.ListEndpoints())
+}
+
+func TestServiceEndpointsChanged(t *testing.T) {
+	th := newTestHandler()
+	th.OnAdd(service1V1)
+
+	// One port removed and the labels of the other changed.
+	updatedService := service1V1.DeepCopy()
+	updatedService.Spec.Ports = updatedService.Spec.Ports[:1]
+	updatedService.Labels["updated-label"] = "true"
+	th.OnUpdate(service1V1, updatedService)
+	assert.ElementsMatch(t, []observer.Endpoint{
+		{
+			ID:     "test-1/service1-UID/http(80)",
+			Target: "10.0.0.1:80",
+			Details: &observer.K8sService{
+				Name:        "service1",
+				UID:         "service1-UID",
+				Namespace:   "default",
+				Labels:      map[string]string{"env": "prod", "updated-label": "true"},
+				ServiceType: "ClusterIP",
+				ClusterIP:   "10.0.0.1",
+				PortName:    "http",
+				Port:        80,
+				Transport:   observer.ProtocolTCP,
+			},
+		},
+	}, th.ListEndpoints())
+}
+
+func TestIngressEndpointsAddedAndRemoved(t *testing.T) {
+	th := newTestHandler()
+	th.OnAdd(ingress1V1)
+	assert.ElementsMatch(t, []observer.Endpoint{
+		{
+			ID:     "test-1/ingress1-UID/secure.example.com/api",
+			Target: "https://secure.example.com/api",
+			Details: &observer.K8sIngress{
+				Name:        "ingress1",
+				UID:         "ingress1-UID",
+				Namespace:   "default",
+				Annotations: map[string]string{"annotation-key": "annotation-value"},
+				Scheme:      "https",
+				Host:        "secure.example.com",
+				Path:        "/api",
+			},
+		},
+	}, th.ListEndpoints())
+
+	// Path changed.
+	updatedIngress := ingress1V1.DeepCopy()
+	updatedIngress.Spec.Rules[0].HTTP.Paths[0].Path = "/v2"
+	th.OnUpdate(ingress1V1, updatedIngress)
+	endpoints := th.ListEndpoints()
+	require.Len(t, endpoints, 1)
+	assert.Equal(t, "https://secure.example.com/v2", endpoints[0].Target)
+
+	th.OnDelete(updatedIngress)
+	assert.Empty(t, th.ListEndpoints())
+}
@@ -0,0 +1,56 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sobserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+)
+
+func TestIngressObjectToK8sIngressEndpoints(t *testing.T) {
+	endpoints := convertIngressToEndpoints("namespace", NewIngress("ingress"))
+	require.Equal(t, []observer.Endpoint{
+		{
+			ID:     "namespace/ingress-UID/secure.example.com/api",
+			Target: "https://secure.example.com/api",
+			Details: &observer.K8sIngress{
+				Name:        "ingress",
+				UID:         "ingress-UID",
+				Namespace:   "default",
+				Annotations: map[string]string{"annotation-key": "annotation-value"},
+				Scheme:      "https",
+				Host:        "secure.example.com",
+				Path:        "/api",
+			},
+		},
+	}, endpoints)
+}
+
+func TestIngressRuleWithoutHost(t *testing.T) {
+	ingress := NewIngress("ingress")
+	ingress.Spec.Rules = []networkingv1.IngressRule{{}}
+	require.Empty(t, convertIngressToEndpoints("namespace", ingress))
+
+	ingress.Status.LoadBalancer.Ingress = []v1.LoadBalancerIngress{{Hostname: "lb.example.com"}}
+	endpoints := convertIngressToEndpoints("namespace", ingress)
+	require.Len(t, endpoints, 1)
+	require.Equal(t, observer.EndpointID("namespace/ingress-UID/lb.example.com/"), endpoints[0].ID)
+	require.Equal(t, "http://lb.example.com/", endpoints[0].Target)
+}
@@ -16,6 +16,7 @@ package k8sobserver
 
 import (
 	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -164,3 +165,59 @@ var node1V2 = func() *v1.Node {
 	node.Labels["node-version"] = "2"
 	return node
 }()
+
+// NewService is a helper function for creating Services for testing.
+func NewService(name string) *v1.Service {
+	return &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      name,
+			UID:       types.UID(name + "-UID"),
+			Labels: map[string]string{
+				"env": "prod",
+			},
+		},
+		Spec: v1.ServiceSpec{
+			Type:      v1.ServiceTypeClusterIP,
+			ClusterIP: "10.0.0.1",
+			Ports: []v1.ServicePort{
+				{Name: "http", Port: 80, Protocol: v1.ProtocolTCP},
+				{Name: "dns", Port: 53, Protocol: v1.ProtocolUDP},
+			},
+		},
+	}
+}
+
+var service1V1 = NewService("service1")
+
+// NewIngress is a helper function for creating Ingresses for testing.
+func NewIngress(name string) *networkingv1.Ingress {
+	return &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      name,
+			UID:       types.UID(name + "-UID"),
+			Annotations: map[string]string{
+				"annotation-key": "annotation-value",
+			},
+		},
+		Spec: networkingv1.IngressSpec{
+			TLS: []networkingv1.IngressTLS{
+				{Hosts: []string{"secure.example.com"}},
+			},
+			Rules: []networkingv1.IngressRule{
+				{
+					Host: "secure.example.com",
+					IngressRuleValue: networkingv1.IngressRuleValue{
+						HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: []networkingv1.HTTPIngressPath{{Path: "/api"}},
+						},
+					},
+				},
+				{Host: "*.example.com"},
+			},
+		},
+	}
+}
+
+var ingress1V1 = NewIngress("ingress1")
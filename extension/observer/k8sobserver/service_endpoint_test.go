@@ -0,0 +1,76 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sobserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+)
+
+func TestServiceObjectToK8sServiceEndpoints(t *testing.T) {
+	endpoints := convertServiceToEndpoints("namespace", NewService("service"))
+	require.Equal(t, []observer.Endpoint{
+		{
+			ID:     "namespace/service-UID/http(80)",
+			Target: "10.0.0.1:80",
+			Details: &observer.K8sService{
+				Name:        "service",
+				UID:         "service-UID",
+				Namespace:   "default",
+				Labels:      map[string]string{"env": "prod"},
+				ServiceType: "ClusterIP",
+				ClusterIP:   "10.0.0.1",
+				PortName:    "http",
+				Port:        80,
+				Transport:   observer.ProtocolTCP,
+			},
+		},
+		{
+			ID:     "namespace/service-UID/dns(53)",
+			Target: "10.0.0.1:53",
+			Details: &observer.K8sService{
+				Name:        "service",
+				UID:         "service-UID",
+				Namespace:   "default",
+				Labels:      map[string]string{"env": "prod"},
+				ServiceType: "ClusterIP",
+				ClusterIP:   "10.0.0.1",
+				PortName:    "dns",
+				Port:        53,
+				Transport:   observer.ProtocolUDP,
+			},
+		},
+	}, endpoints)
+}
+
+func TestServiceTargets(t *testing.T) {
+	headless := NewService("headless")
+	headless.Spec.ClusterIP = v1.ClusterIPNone
+	endpoints := convertServiceToEndpoints("namespace", headless)
+	require.Len(t, endpoints, 2)
+	require.Equal(t, "headless.default.svc:80", endpoints[0].Target)
+
+	external := NewService("external")
+	external.Spec.Type = v1.ServiceTypeExternalName
+	external.Spec.ClusterIP = ""
+	external.Spec.ExternalName = "db.example.com"
+	endpoints = convertServiceToEndpoints("namespace", external)
+	require.Len(t, endpoints, 2)
+	require.Equal(t, "db.example.com:80", endpoints[0].Target)
+}
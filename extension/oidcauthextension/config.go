@@ -14,7 +14,11 @@
 
 package oidcauthextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/oidcauthextension"
 
-import "go.opentelemetry.io/collector/config"
+import (
+	"time"
+
+	"go.opentelemetry.io/collector/config"
+)
 
 // Config has the configuration for the OIDC Authenticator extension.
 type Config struct {
@@ -43,4 +47,13 @@ type Config struct {
 	// The claim that holds the subject's group membership information.
 	// Optional.
 	GroupsClaim string `mapstructure:"groups_claim"`
+
+	// The algorithms the tokens may be signed with, e.g. "RS256", "ES256" or "EdDSA".
+	// Optional, defaults to the algorithms supported by the provider, or "RS256" when the provider doesn't advertise them.
+	SigningAlgorithms []string `mapstructure:"signing_algorithms"`
+
+	// How long the keys removed from the provider's key set remain valid, so that the tokens signed
+	// before a key rotation are accepted until they are renewed.
+	// Optional, default value: 0, the removed keys aren't valid anymore.
+	KeyRotationGracePeriod time.Duration `mapstructure:"key_rotation_grace_period"`
 }
@@ -33,6 +33,7 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configauth"
 	"go.uber.org/zap"
+	"gopkg.in/square/go-jose.v2"
 )
 
 type oidcExtension struct {
@@ -53,8 +54,17 @@ var (
 	errUsernameNotString                 = errors.New("the username returned by the OIDC provider isn't a regular string")
 	errGroupsClaimNotFound               = errors.New("groups claim from the OIDC configuration not found on the token returned by the OIDC provider")
 	errNotAuthenticated                  = errors.New("authentication didn't succeed")
+	errNegativeGracePeriod               = errors.New("the key rotation grace period can't be negative")
 )
 
+// supportedSigningAlgorithms are the algorithms the tokens can be signed with.
+var supportedSigningAlgorithms = []string{
+	oidc.RS256, oidc.RS384, oidc.RS512,
+	oidc.ES256, oidc.ES384, oidc.ES512,
+	oidc.PS256, oidc.PS384, oidc.PS512,
+	string(jose.EdDSA),
+}
+
 func newExtension(cfg *Config, logger *zap.Logger) (configauth.ServerAuthenticator, error) {
 	if cfg.Audience == "" {
 		return nil, errNoAudienceProvided
@@ -63,6 +73,15 @@ func newExtension(cfg *Config, logger *zap.Logger) (configauth.ServerAuthenticat
 		return nil, errNoIssuerURL
 	}
 
+	for _, alg := range cfg.SigningAlgorithms {
+		if !isSupportedSigningAlgorithm(alg) {
+			return nil, fmt.Errorf("unsupported signing algorithm %q, the supported algorithms are %s", alg, strings.Join(supportedSigningAlgorithms, ", "))
+		}
+	}
+	if cfg.KeyRotationGracePeriod < 0 {
+		return nil, errNegativeGracePeriod
+	}
+
 	if cfg.Attribute == "" {
 		cfg.Attribute = defaultAttribute
 	}
@@ -75,19 +94,63 @@ func newExtension(cfg *Config, logger *zap.Logger) (configauth.ServerAuthenticat
 }
 
 func (e *oidcExtension) start(context.Context, component.Host) error {
-	provider, err := getProviderForConfig(e.cfg)
+	httpClient, err := getHTTPClientForConfig(e.cfg)
+	if err != nil {
+		return err // the errors from this path have enough context already
+	}
+
+	provider, err := getProviderForConfig(e.cfg, httpClient)
 	if err != nil {
 		return fmt.Errorf("failed to get configuration from the auth server: %w", err)
 	}
 	e.provider = provider
 
-	e.verifier = e.provider.Verifier(&oidc.Config{
-		ClientID: e.cfg.Audience,
+	var metadata struct {
+		Issuer     string   `json:"issuer"`
+		JWKSURL    string   `json:"jwks_uri"`
+		Algorithms []string `json:"id_token_signing_alg_values_supported"`
+	}
+	if err = provider.Claims(&metadata); err != nil {
+		return fmt.Errorf("failed to read the configuration from the auth server: %w", err)
+	}
+
+	keys := newKeySet(metadata.JWKSURL, httpClient, e.cfg.KeyRotationGracePeriod, e.logger)
+	e.verifier = oidc.NewVerifier(metadata.Issuer, keys, &oidc.Config{
+		ClientID:             e.cfg.Audience,
+		SupportedSigningAlgs: signingAlgorithms(e.cfg.SigningAlgorithms, metadata.Algorithms),
 	})
 
 	return nil
 }
 
+// signingAlgorithms returns the configured algorithms, or the supported algorithms advertised by the provider.
+func signingAlgorithms(configured []string, advertised []string) []string {
+	if len(configured) > 0 {
+		return configured
+	}
+
+	var algs []string
+	for _, alg := range advertised {
+		if isSupportedSigningAlgorithm(alg) {
+			algs = append(algs, alg)
+		}
+	}
+	if len(algs) == 0 {
+		// RS256 is the algorithm every provider has to support
+		return []string{oidc.RS256}
+	}
+	return algs
+}
+
+func isSupportedSigningAlgorithm(alg string) bool {
+	for _, supported := range supportedSigningAlgorithms {
+		if alg == supported {
+			return true
+		}
+	}
+	return false
+}
+
 // authenticate checks whether the given context contains valid auth data. Successfully authenticated calls will always return a nil error and a context with the auth data.
 func (e *oidcExtension) authenticate(ctx context.Context, headers map[string][]string) (context.Context, error) {
 	authHeaders := headers[e.cfg.Attribute]
@@ -179,7 +242,12 @@ func getGroupsFromClaims(claims map[string]interface{}, groupsClaim string) ([]s
 	return []string{}, nil
 }
 
-func getProviderForConfig(config *Config) (*oidc.Provider, error) {
+func getProviderForConfig(config *Config, client *http.Client) (*oidc.Provider, error) {
+	oidcContext := oidc.ClientContext(context.Background(), client)
+	return oidc.NewProvider(oidcContext, config.IssuerURL)
+}
+
+func getHTTPClientForConfig(config *Config) (*http.Client, error) {
 	t := &http.Transport{
 		Proxy: http.ProxyFromEnvironment,
 		DialContext: (&net.Dialer{
@@ -206,12 +274,10 @@ func getProviderForConfig(config *Config) (*oidc.Provider, error) {
 		t.TLSClientConfig.RootCAs.AddCert(cert)
 	}
 
-	client := &http.Client{
+	return &http.Client{
 		Timeout:   5 * time.Second,
 		Transport: t,
-	}
-	oidcContext := oidc.ClientContext(context.Background(), client)
-	return oidc.NewProvider(oidcContext, config.IssuerURL)
+	}, nil
 }
 
 func getIssuerCACertFromPath(path string) (*x509.Certificate, error) {
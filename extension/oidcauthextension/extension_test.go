@@ -31,8 +31,10 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/client"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.uber.org/zap"
+	"gopkg.in/square/go-jose.v2"
 )
 
 func TestOIDCAuthenticationSucceeded(t *testing.T) {
@@ -74,6 +76,107 @@ func TestOIDCAuthenticationSucceeded(t *testing.T) {
 	// TODO(jpkroehling): assert that the authentication routine set the subject/membership to the resource
 }
 
+func TestOIDCAuthenticationWithSigningAlgorithms(t *testing.T) {
+	// prepare
+	oidcServer, err := newOIDCServer()
+	require.NoError(t, err)
+	oidcServer.algorithms = []string{"RS256", "ES256", "ES384", "EdDSA", "HS256"}
+	oidcServer.Start()
+	defer oidcServer.Close()
+
+	p, err := newExtension(&Config{
+		IssuerURL: oidcServer.URL,
+		Audience:  "unit-test",
+	}, zap.NewNop())
+	require.NoError(t, err)
+
+	err = p.Start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err)
+
+	payload, _ := json.Marshal(map[string]interface{}{
+		"sub": "jdoe@example.com",
+		"iss": oidcServer.URL,
+		"aud": "unit-test",
+		"exp": time.Now().Add(time.Minute).Unix(),
+	})
+
+	// the keys are published beforehand, as the key set isn't fetched again right after the first verification
+	keys := map[jose.SignatureAlgorithm]*signingKey{}
+	for _, alg := range []jose.SignatureAlgorithm{jose.ES256, jose.ES384, jose.EdDSA} {
+		keys[alg], err = oidcServer.addKey(alg, string(alg))
+		require.NoError(t, err)
+	}
+
+	for alg, key := range keys {
+		key := key
+		t.Run(string(alg), func(t *testing.T) {
+			token, err := key.token(payload)
+			require.NoError(t, err)
+
+			// test
+			ctx, err := p.Authenticate(context.Background(), map[string][]string{"authorization": {fmt.Sprintf("Bearer %s", token)}})
+
+			// verify
+			require.NoError(t, err)
+			assert.Equal(t, "jdoe@example.com", client.FromContext(ctx).Auth.GetAttribute("subject"))
+		})
+	}
+}
+
+func TestOIDCAuthenticationRejectsUnexpectedSigningAlgorithm(t *testing.T) {
+	// prepare
+	oidcServer, err := newOIDCServer()
+	require.NoError(t, err)
+	oidcServer.Start()
+	defer oidcServer.Close()
+
+	p, err := newExtension(&Config{
+		IssuerURL:         oidcServer.URL,
+		Audience:          "unit-test",
+		SigningAlgorithms: []string{"RS256"},
+	}, zap.NewNop())
+	require.NoError(t, err)
+
+	err = p.Start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err)
+
+	key, err := oidcServer.addKey(jose.ES256, "es256")
+	require.NoError(t, err)
+	payload, _ := json.Marshal(map[string]interface{}{
+		"sub": "jdoe@example.com",
+		"iss": oidcServer.URL,
+		"aud": "unit-test",
+		"exp": time.Now().Add(time.Minute).Unix(),
+	})
+	token, err := key.token(payload)
+	require.NoError(t, err)
+
+	// test
+	_, err = p.Authenticate(context.Background(), map[string][]string{"authorization": {fmt.Sprintf("Bearer %s", token)}})
+
+	// verify
+	assert.ErrorContains(t, err, "unsupported algorithm")
+}
+
+func TestSigningAlgorithms(t *testing.T) {
+	for _, tt := range []struct {
+		casename   string
+		configured []string
+		advertised []string
+		expected   []string
+	}{
+		{"default", nil, nil, []string{"RS256"}},
+		{"advertised", nil, []string{"RS256", "ES256", "EdDSA"}, []string{"RS256", "ES256", "EdDSA"}},
+		{"unsupportedAdvertised", nil, []string{"HS256", "ES384"}, []string{"ES384"}},
+		{"onlyUnsupportedAdvertised", nil, []string{"none"}, []string{"RS256"}},
+		{"configured", []string{"EdDSA"}, []string{"RS256", "ES256"}, []string{"EdDSA"}},
+	} {
+		t.Run(tt.casename, func(t *testing.T) {
+			assert.Equal(t, tt.expected, signingAlgorithms(tt.configured, tt.advertised))
+		})
+	}
+}
+
 func TestOIDCProviderForConfigWithTLS(t *testing.T) {
 	// prepare the CA cert for the TLS handler
 	cert := x509.Certificate{
@@ -117,7 +220,9 @@ func TestOIDCProviderForConfigWithTLS(t *testing.T) {
 	}
 
 	// test
-	provider, err := getProviderForConfig(config)
+	client, err := getHTTPClientForConfig(config)
+	require.NoError(t, err)
+	provider, err := getProviderForConfig(config, client)
 
 	// verify
 	assert.NoError(t, err)
@@ -190,11 +295,11 @@ func TestOIDCFailedToLoadIssuerCAFromPathInvalidContent(t *testing.T) {
 	}
 
 	// test
-	provider, err := getProviderForConfig(config) // cross test with getIssuerCACertFromPath
+	client, err := getHTTPClientForConfig(config) // cross test with getIssuerCACertFromPath
 
 	// verify
 	assert.Error(t, err)
-	assert.Nil(t, provider)
+	assert.Nil(t, client)
 }
 
 func TestOIDCInvalidAuthHeader(t *testing.T) {
@@ -439,6 +544,38 @@ func TestMissingIssuerURL(t *testing.T) {
 	assert.Equal(t, errNoIssuerURL, err)
 }
 
+func TestUnsupportedSigningAlgorithm(t *testing.T) {
+	// prepare
+	config := &Config{
+		Audience:          "some-audience",
+		IssuerURL:         "http://example.com/",
+		SigningAlgorithms: []string{"ES256", "HS256"},
+	}
+
+	// test
+	p, err := newExtension(config, zap.NewNop())
+
+	// verify
+	assert.Nil(t, p)
+	assert.ErrorContains(t, err, `unsupported signing algorithm "HS256"`)
+}
+
+func TestNegativeKeyRotationGracePeriod(t *testing.T) {
+	// prepare
+	config := &Config{
+		Audience:               "some-audience",
+		IssuerURL:              "http://example.com/",
+		KeyRotationGracePeriod: -time.Minute,
+	}
+
+	// test
+	p, err := newExtension(config, zap.NewNop())
+
+	// verify
+	assert.Nil(t, p)
+	assert.Equal(t, errNegativeGracePeriod, err)
+}
+
 func TestShutdown(t *testing.T) {
 	// prepare
 	config := &Config{
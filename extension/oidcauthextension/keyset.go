@@ -0,0 +1,223 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidcauthextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/oidcauthextension"
+
+import (
+	"context"
+	"crypto"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"gopkg.in/square/go-jose.v2"
+)
+
+// minKeysRefreshInterval limits how often the keys are fetched from the provider, as each token
+// signed with an unknown key would otherwise cause a request to the provider
+const minKeysRefreshInterval = 5 * time.Second
+
+var errTokenSignature = errors.New("failed to verify the token signature")
+
+// retiredKey is a key which was removed from the provider's key set, still valid until the given time.
+type retiredKey struct {
+	key   jose.JSONWebKey
+	until time.Time
+}
+
+// keySet is an oidc.KeySet verifying the tokens with the keys published by the provider. Unlike the
+// go-oidc remote key set, which forgets the keys as soon as they are removed from the provider's key
+// set, the keys removed by a rotation remain valid for the grace period, so that the tokens signed
+// before the rotation aren't rejected until they are renewed.
+type keySet struct {
+	jwksURL     string
+	client      *http.Client
+	gracePeriod time.Duration
+	logger      *zap.Logger
+	now         func() time.Time
+
+	// refreshMutex serializes the refreshes, so that concurrent verifications don't all fetch the keys
+	refreshMutex sync.Mutex
+
+	mu          sync.Mutex
+	keys        []jose.JSONWebKey
+	retired     []retiredKey
+	lastRefresh time.Time
+}
+
+func newKeySet(jwksURL string, client *http.Client, gracePeriod time.Duration, logger *zap.Logger) *keySet {
+	return &keySet{
+		jwksURL:     jwksURL,
+		client:      client,
+		gracePeriod: gracePeriod,
+		logger:      logger,
+		now:         time.Now,
+	}
+}
+
+// VerifySignature verifies the signature of the token, returning its payload. The keys are fetched
+// from the provider when none of the known keys verify the token.
+func (s *keySet) VerifySignature(ctx context.Context, jwt string) ([]byte, error) {
+	jws, err := jose.ParseSigned(jwt)
+	if err != nil {
+		return nil, fmt.Errorf("malformed jwt: %w", err)
+	}
+
+	if payload, ok := verifyWithKeys(jws, s.validKeys()); ok {
+		return payload, nil
+	}
+
+	if err = s.refresh(ctx); err != nil {
+		return nil, fmt.Errorf("failed to fetch the keys: %w", err)
+	}
+
+	if payload, ok := verifyWithKeys(jws, s.validKeys()); ok {
+		return payload, nil
+	}
+	return nil, errTokenSignature
+}
+
+func verifyWithKeys(jws *jose.JSONWebSignature, keys []jose.JSONWebKey) ([]byte, bool) {
+	// tokens with multiple signatures aren't supported
+	keyID := ""
+	if len(jws.Signatures) > 0 {
+		keyID = jws.Signatures[0].Header.KeyID
+	}
+
+	for i := range keys {
+		if keyID != "" && keys[i].KeyID != keyID {
+			continue
+		}
+		if payload, err := jws.Verify(&keys[i]); err == nil {
+			return payload, true
+		}
+	}
+	return nil, false
+}
+
+// validKeys returns the current keys of the provider and the retired keys still within the grace period.
+func (s *keySet) validKeys() []jose.JSONWebKey {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	keys := make([]jose.JSONWebKey, 0, len(s.keys)+len(s.retired))
+	keys = append(keys, s.keys...)
+	for _, r := range s.retired {
+		if now.Before(r.until) {
+			keys = append(keys, r.key)
+		}
+	}
+	return keys
+}
+
+// refresh fetches the keys from the provider, unless they were fetched recently.
+func (s *keySet) refresh(ctx context.Context) error {
+	s.refreshMutex.Lock()
+	defer s.refreshMutex.Unlock()
+
+	s.mu.Lock()
+	recent := !s.lastRefresh.IsZero() && s.now().Sub(s.lastRefresh) < minKeysRefreshInterval
+	if !recent {
+		// failed refreshes count as well, not to overload a provider which is having trouble
+		s.lastRefresh = s.now()
+	}
+	s.mu.Unlock()
+	if recent {
+		return nil
+	}
+
+	keys, err := s.fetchKeys(ctx)
+	if err != nil {
+		return err
+	}
+	s.update(keys)
+	return nil
+}
+
+// update replaces the current keys, retiring the keys which aren't part of the new keys.
+func (s *keySet) update(keys []jose.JSONWebKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	current := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		current[keyIdentity(key)] = true
+	}
+
+	// keep the retired keys within their grace period, unless they are part of the key set again
+	retired := s.retired[:0]
+	for _, r := range s.retired {
+		if now.Before(r.until) && !current[keyIdentity(r.key)] {
+			retired = append(retired, r)
+		}
+	}
+
+	if s.gracePeriod > 0 {
+		for _, key := range s.keys {
+			if current[keyIdentity(key)] {
+				continue
+			}
+			s.logger.Debug("key removed from the provider's key set, keeping it for the grace period",
+				zap.String("kid", key.KeyID), zap.Duration("grace_period", s.gracePeriod))
+			retired = append(retired, retiredKey{key: key, until: now.Add(s.gracePeriod)})
+		}
+	}
+
+	s.keys = keys
+	s.retired = retired
+}
+
+// keyIdentity identifies a key by its ID and its thumbprint, as providers might not set key IDs.
+func keyIdentity(key jose.JSONWebKey) string {
+	thumbprint, err := key.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return key.KeyID
+	}
+	return key.KeyID + "." + base64.RawURLEncoding.EncodeToString(thumbprint)
+}
+
+func (s *keySet) fetchKeys(ctx context.Context) ([]jose.JSONWebKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.jwksURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %q: %s", resp.Status, body)
+	}
+
+	var jwks jose.JSONWebKeySet
+	if err = json.Unmarshal(body, &jwks); err != nil {
+		return nil, fmt.Errorf("failed to decode the keys: %w", err)
+	}
+	return jwks.Keys, nil
+}
@@ -0,0 +1,209 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidcauthextension
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gopkg.in/square/go-jose.v2"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newTestKeySet(server *oidcServer, gracePeriod time.Duration) (*keySet, *fakeClock) {
+	clock := &fakeClock{now: time.Now()}
+	keys := newKeySet(fmt.Sprintf("%s/.well-known/jwks.json", server.URL), http.DefaultClient, gracePeriod, zap.NewNop())
+	keys.now = clock.Now
+	return keys, clock
+}
+
+func signedToken(t *testing.T, key *signingKey) string {
+	token, err := key.token([]byte(`{"sub":"jdoe@example.com"}`))
+	require.NoError(t, err)
+	return token
+}
+
+func TestKeySetVerifySignature(t *testing.T) {
+	// prepare
+	oidcServer, err := newOIDCServer()
+	require.NoError(t, err)
+	oidcServer.Start()
+	defer oidcServer.Close()
+
+	key, err := oidcServer.addKey(jose.ES256, "key-1")
+	require.NoError(t, err)
+	keys, _ := newTestKeySet(oidcServer, 0)
+
+	// test
+	payload, err := keys.VerifySignature(context.Background(), signedToken(t, key))
+
+	// verify
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"sub":"jdoe@example.com"}`, string(payload))
+}
+
+func TestKeySetUnknownKey(t *testing.T) {
+	// prepare
+	oidcServer, err := newOIDCServer()
+	require.NoError(t, err)
+	oidcServer.Start()
+	defer oidcServer.Close()
+
+	key, err := oidcServer.addKey(jose.EdDSA, "key-1")
+	require.NoError(t, err)
+	oidcServer.removeKey("key-1")
+	keys, _ := newTestKeySet(oidcServer, time.Hour)
+
+	// test
+	_, err = keys.VerifySignature(context.Background(), signedToken(t, key))
+
+	// verify
+	assert.Equal(t, errTokenSignature, err)
+}
+
+func TestKeySetRotationGracePeriod(t *testing.T) {
+	// prepare
+	oidcServer, err := newOIDCServer()
+	require.NoError(t, err)
+	oidcServer.Start()
+	defer oidcServer.Close()
+
+	oldKey, err := oidcServer.addKey(jose.ES256, "old")
+	require.NoError(t, err)
+	keys, clock := newTestKeySet(oidcServer, 10*time.Minute)
+
+	_, err = keys.VerifySignature(context.Background(), signedToken(t, oldKey))
+	require.NoError(t, err)
+
+	// rotate the keys, the new key being fetched when a token signed with it is verified
+	newKey, err := oidcServer.addKey(jose.ES256, "new")
+	require.NoError(t, err)
+	oidcServer.removeKey("old")
+	clock.Advance(time.Minute)
+	_, err = keys.VerifySignature(context.Background(), signedToken(t, newKey))
+	require.NoError(t, err)
+
+	// test
+	_, errWithinGracePeriod := keys.VerifySignature(context.Background(), signedToken(t, oldKey))
+	clock.Advance(10 * time.Minute)
+	_, errAfterGracePeriod := keys.VerifySignature(context.Background(), signedToken(t, oldKey))
+
+	// verify
+	assert.NoError(t, errWithinGracePeriod)
+	assert.Equal(t, errTokenSignature, errAfterGracePeriod)
+	_, err = keys.VerifySignature(context.Background(), signedToken(t, newKey))
+	assert.NoError(t, err)
+}
+
+func TestKeySetRotationWithoutGracePeriod(t *testing.T) {
+	// prepare
+	oidcServer, err := newOIDCServer()
+	require.NoError(t, err)
+	oidcServer.Start()
+	defer oidcServer.Close()
+
+	oldKey, err := oidcServer.addKey(jose.ES384, "old")
+	require.NoError(t, err)
+	keys, clock := newTestKeySet(oidcServer, 0)
+
+	_, err = keys.VerifySignature(context.Background(), signedToken(t, oldKey))
+	require.NoError(t, err)
+
+	newKey, err := oidcServer.addKey(jose.ES384, "new")
+	require.NoError(t, err)
+	oidcServer.removeKey("old")
+	clock.Advance(time.Minute)
+	_, err = keys.VerifySignature(context.Background(), signedToken(t, newKey))
+	require.NoError(t, err)
+
+	// test
+	_, err = keys.VerifySignature(context.Background(), signedToken(t, oldKey))
+
+	// verify
+	assert.Equal(t, errTokenSignature, err)
+}
+
+func TestKeySetRestoredKey(t *testing.T) {
+	// prepare
+	oidcServer, err := newOIDCServer()
+	require.NoError(t, err)
+	oidcServer.Start()
+	defer oidcServer.Close()
+
+	key, err := oidcServer.addKey(jose.EdDSA, "key-1")
+	require.NoError(t, err)
+	keys, clock := newTestKeySet(oidcServer, time.Minute)
+	keys.update([]jose.JSONWebKey{key.key.Public()})
+
+	// test
+	keys.update(nil)
+	keys.update([]jose.JSONWebKey{key.key.Public()})
+	clock.Advance(time.Hour)
+
+	// verify
+	assert.Len(t, keys.validKeys(), 1)
+	_, err = keys.VerifySignature(context.Background(), signedToken(t, key))
+	assert.NoError(t, err)
+}
+
+func TestKeySetRefreshRateLimited(t *testing.T) {
+	// prepare
+	oidcServer, err := newOIDCServer()
+	require.NoError(t, err)
+	oidcServer.Start()
+	defer oidcServer.Close()
+
+	key, err := oidcServer.addKey(jose.ES256, "key-1")
+	require.NoError(t, err)
+	unknownKey, err := oidcServer.addKey(jose.ES256, "key-2")
+	require.NoError(t, err)
+	oidcServer.removeKey("key-2")
+	keys, clock := newTestKeySet(oidcServer, 0)
+
+	_, err = keys.VerifySignature(context.Background(), signedToken(t, key))
+	require.NoError(t, err)
+	require.Equal(t, 1, oidcServer.requests())
+
+	// test
+	for i := 0; i < 5; i++ {
+		_, err = keys.VerifySignature(context.Background(), signedToken(t, unknownKey))
+		assert.Error(t, err)
+	}
+	requestsWithinInterval := oidcServer.requests()
+	clock.Advance(minKeysRefreshInterval)
+	_, err = keys.VerifySignature(context.Background(), signedToken(t, unknownKey))
+	assert.Error(t, err)
+
+	// verify
+	assert.Equal(t, 1, requestsWithinInterval)
+	assert.Equal(t, 2, oidcServer.requests())
+}
@@ -17,6 +17,9 @@ package oidcauthextension
 import (
 	"bytes"
 	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/sha1" // #nosec
@@ -30,7 +33,10 @@ import (
 	"math/big"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"time"
+
+	"gopkg.in/square/go-jose.v2"
 )
 
 // oidcServer is an overly simplified OIDC mock server, good enough to sign the tokens required by the test
@@ -39,26 +45,46 @@ type oidcServer struct {
 	*httptest.Server
 	x509Cert   []byte
 	privateKey *rsa.PrivateKey
+
+	// algorithms are advertised as the supported signing algorithms, when set
+	algorithms []string
+
+	mu   sync.Mutex
+	keys []interface{}
+	// jwksRequests counts the requests for the key set
+	jwksRequests int
 }
 
-func newOIDCServer() (*oidcServer, error) {
-	jwks := map[string]interface{}{}
+// signingKey is a key the tokens can be signed with, besides the default RSA key
+type signingKey struct {
+	alg jose.SignatureAlgorithm
+	key jose.JSONWebKey
+}
 
+func newOIDCServer() (*oidcServer, error) {
 	mux := http.NewServeMux()
-	server := httptest.NewUnstartedServer(mux)
+	s := &oidcServer{Server: httptest.NewUnstartedServer(mux)}
 
 	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, req *http.Request) {
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		err := json.NewEncoder(w).Encode(map[string]interface{}{
-			"issuer":   server.URL,
-			"jwks_uri": fmt.Sprintf("%s/.well-known/jwks.json", server.URL),
-		})
-		if err != nil {
+		metadata := map[string]interface{}{
+			"issuer":   s.URL,
+			"jwks_uri": fmt.Sprintf("%s/.well-known/jwks.json", s.URL),
+		}
+		if len(s.algorithms) > 0 {
+			metadata["id_token_signing_alg_values_supported"] = s.algorithms
+		}
+		if err := json.NewEncoder(w).Encode(metadata); err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 			return
 		}
 	})
 	mux.HandleFunc("/.well-known/jwks.json", func(w http.ResponseWriter, req *http.Request) {
+		s.mu.Lock()
+		s.jwksRequests++
+		jwks := map[string]interface{}{"keys": s.keys}
+		s.mu.Unlock()
+
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		if err := json.NewEncoder(w).Encode(jwks); err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
@@ -82,7 +108,7 @@ func newOIDCServer() (*oidcServer, error) {
 
 	// #nosec
 	sum := sha1.Sum(x509Cert)
-	jwks["keys"] = []map[string]interface{}{{
+	s.keys = []interface{}{map[string]interface{}{
 		"alg": "RS256",
 		"kty": "RSA",
 		"use": "sig",
@@ -92,8 +118,69 @@ func newOIDCServer() (*oidcServer, error) {
 		"kid": base64.RawURLEncoding.EncodeToString(sum[:]),
 		"x5t": base64.RawURLEncoding.EncodeToString(sum[:]),
 	}}
+	s.x509Cert = x509Cert
+	s.privateKey = privateKey
+
+	return s, nil
+}
+
+// addKey generates a key for the given algorithm and publishes it in the key set
+func (s *oidcServer) addKey(alg jose.SignatureAlgorithm, kid string) (*signingKey, error) {
+	var privateKey interface{}
+	var err error
+	switch alg {
+	case jose.ES256:
+		privateKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case jose.ES384:
+		privateKey, err = ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	case jose.EdDSA:
+		_, privateKey, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return nil, fmt.Errorf("unsupported algorithm %q", alg)
+	}
+	if err != nil {
+		return nil, err
+	}
 
-	return &oidcServer{server, x509Cert, privateKey}, nil
+	key := &signingKey{alg: alg, key: jose.JSONWebKey{Key: privateKey, KeyID: kid, Algorithm: string(alg), Use: "sig"}}
+	s.mu.Lock()
+	s.keys = append(s.keys, key.key.Public())
+	s.mu.Unlock()
+	return key, nil
+}
+
+// removeKey removes the key with the given ID from the key set, as a key rotation would
+func (s *oidcServer) removeKey(kid string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := s.keys[:0]
+	for _, key := range s.keys {
+		if jwk, ok := key.(jose.JSONWebKey); ok && jwk.KeyID == kid {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	s.keys = keys
+}
+
+func (s *oidcServer) requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.jwksRequests
+}
+
+func (k *signingKey) token(jsonPayload []byte) (string, error) {
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: k.alg, Key: k.key}, (&jose.SignerOptions{}).WithType("JWT"))
+	if err != nil {
+		return "", err
+	}
+
+	jws, err := signer.Sign(jsonPayload)
+	if err != nil {
+		return "", err
+	}
+	return jws.CompactSerialize()
 }
 
 func (s *oidcServer) token(jsonPayload []byte) (string, error) {
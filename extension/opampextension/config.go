@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opampextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/opampextension"
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/configtls"
+)
+
+var (
+	errMissingEndpoint        = errors.New(`"endpoint" must be specified`)
+	errInvalidPollingInterval = errors.New(`"polling_interval" must be positive`)
+)
+
+// Config defines the configuration of the OpAMP extension.
+type Config struct {
+	config.ExtensionSettings `mapstructure:",squash"`
+
+	// Endpoint is the URL of the OpAMP server. The HTTP transport is used with an http or https URL,
+	// and the WebSocket transport with a ws or wss URL.
+	Endpoint string `mapstructure:"endpoint"`
+
+	// Headers are the additional headers of the requests to the OpAMP server.
+	Headers map[string]string `mapstructure:"headers"`
+
+	// TLSSetting configures the TLS connection to the OpAMP server.
+	TLSSetting configtls.TLSClientSetting `mapstructure:"tls"`
+
+	// InstanceUID identifies the collector to the OpAMP server. A random one is generated at start when unset.
+	InstanceUID string `mapstructure:"instance_uid"`
+
+	// PollingInterval is the interval between the polls of the server with the HTTP transport.
+	PollingInterval time.Duration `mapstructure:"polling_interval"`
+
+	// RemoteConfig configures how the remote configuration is applied.
+	RemoteConfig RemoteConfigSettings `mapstructure:"remote_config"`
+}
+
+// RemoteConfigSettings configures how the remote configuration is applied.
+type RemoteConfigSettings struct {
+	// File is the collector configuration file managed by the OpAMP server. Remote configuration
+	// is only accepted, and the effective configuration only reported, when it is set.
+	File string `mapstructure:"file"`
+
+	// RestartOnApply shuts the collector down after a new configuration is written, so that
+	// its supervisor (e.g. systemd or Kubernetes) restarts it with the new configuration.
+	// A new configuration the collector fails to start with is rolled back to the previous one.
+	RestartOnApply bool `mapstructure:"restart_on_apply"`
+}
+
+// Validate checks if the extension configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.Endpoint == "" {
+		return errMissingEndpoint
+	}
+	u, err := url.Parse(cfg.Endpoint)
+	if err != nil {
+		return fmt.Errorf(`"endpoint" is invalid: %w`, err)
+	}
+	switch u.Scheme {
+	case "http", "https", "ws", "wss":
+	default:
+		return fmt.Errorf(`"endpoint" must be an http, https, ws or wss URL, got %q`, cfg.Endpoint)
+	}
+	if cfg.InstanceUID != "" {
+		if _, err := uuid.Parse(cfg.InstanceUID); err != nil {
+			return fmt.Errorf(`"instance_uid" must be a UUID: %w`, err)
+		}
+	}
+	if cfg.PollingInterval <= 0 {
+		return errInvalidPollingInterval
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opampextension
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	expected := createDefaultConfig().(*Config)
+	expected.ExtensionSettings = config.NewExtensionSettings(component.NewID(typeStr))
+	expected.Endpoint = "https://opamp.example.com/v1/opamp"
+	expected.Headers = map[string]string{"authorization": "secret"}
+	expected.InstanceUID = "6b2a4e72-5a4d-4b0b-9f64-5f43ed8e5c0a"
+	expected.PollingInterval = time.Minute
+	expected.RemoteConfig = RemoteConfigSettings{
+		File:           "/etc/otelcol/remote.yaml",
+		RestartOnApply: true,
+	}
+
+	tests := []struct {
+		id          component.ID
+		expected    component.ExtensionConfig
+		expectedErr string
+	}{
+		{
+			id:          component.NewID(typeStr),
+			expectedErr: errMissingEndpoint.Error(),
+		},
+		{
+			id:       component.NewIDWithName(typeStr, "1"),
+			expected: expected,
+		},
+		{
+			id:          component.NewIDWithName(typeStr, "missingendpoint"),
+			expectedErr: errMissingEndpoint.Error(),
+		},
+		{
+			id:          component.NewIDWithName(typeStr, "invalidendpoint"),
+			expectedErr: `"endpoint" must be an http, https, ws or wss URL`,
+		},
+		{
+			id:          component.NewIDWithName(typeStr, "invalidinstanceuid"),
+			expectedErr: `"instance_uid" must be a UUID`,
+		},
+		{
+			id:          component.NewIDWithName(typeStr, "invalidpollinginterval"),
+			expectedErr: errInvalidPollingInterval.Error(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalExtensionConfig(sub, cfg))
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, cfg.Validate(), tt.expectedErr)
+				return
+			}
+			assert.NoError(t, cfg.Validate())
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
@@ -0,0 +1,315 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opampextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/opampextension"
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/open-telemetry/opamp-go/client"
+	"github.com/open-telemetry/opamp-go/client/types"
+	"github.com/open-telemetry/opamp-go/protobufs"
+	"go.opentelemetry.io/collector/component"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+// configContentType is the content type of the collector configuration files.
+const configContentType = "text/yaml"
+
+// errRestartOnApply is reported to the host to shut the collector down, so that its supervisor restarts it
+// with the remote configuration.
+var errRestartOnApply = errors.New("shutting down the collector to restart it with the remote configuration")
+
+var (
+	_ component.Extension       = (*opampAgent)(nil)
+	_ component.PipelineWatcher = (*opampAgent)(nil)
+)
+
+// opampAgent reports the status of the collector to an OpAMP server, and applies the remote configuration
+// it receives.
+type opampAgent struct {
+	cfg       *Config
+	logger    *zap.Logger
+	set       component.ExtensionCreateSettings
+	host      component.Host
+	client    client.OpAMPClient
+	newClient func(cfg *Config, logger types.Logger) client.OpAMPClient
+
+	mu                 sync.Mutex
+	instanceUID        string
+	startTime          time.Time
+	healthy            bool
+	remoteConfigStatus *protobufs.RemoteConfigStatus
+	// unconfirmed is set while the configuration file holds a remote configuration which the collector
+	// didn't start with successfully yet.
+	unconfirmed bool
+}
+
+func newOpAMPAgent(cfg *Config, set component.ExtensionCreateSettings) *opampAgent {
+	return &opampAgent{
+		cfg:         cfg,
+		logger:      set.Logger,
+		set:         set,
+		instanceUID: cfg.InstanceUID,
+		newClient:   newClient,
+	}
+}
+
+// newClient returns an OpAMP client using the transport of the endpoint scheme.
+func newClient(cfg *Config, logger types.Logger) client.OpAMPClient {
+	if u, err := url.Parse(cfg.Endpoint); err == nil && (u.Scheme == "ws" || u.Scheme == "wss") {
+		return client.NewWebSocket(logger)
+	}
+	httpClient := client.NewHTTP(logger)
+	httpClient.SetPollingInterval(cfg.PollingInterval)
+	return httpClient
+}
+
+func (a *opampAgent) Start(_ context.Context, host component.Host) error {
+	tlsConfig, err := a.cfg.TLSSetting.LoadTLSConfig()
+	if err != nil {
+		return err
+	}
+	header := http.Header{}
+	for key, value := range a.cfg.Headers {
+		header.Set(key, value)
+	}
+
+	a.host = host
+	a.startTime = time.Now()
+	if a.instanceUID == "" {
+		a.instanceUID = uuid.NewString()
+	}
+	if a.cfg.RemoteConfig.File != "" {
+		if a.unconfirmed, err = hasUnconfirmedConfigFile(a.cfg.RemoteConfig.File); err != nil {
+			return err
+		}
+	}
+
+	a.client = a.newClient(a.cfg, a.logger.Sugar())
+	if err = a.client.SetAgentDescription(a.description()); err != nil {
+		return err
+	}
+	settings := types.StartSettings{
+		OpAMPServerURL: a.cfg.Endpoint,
+		Header:         header,
+		TLSConfig:      tlsConfig,
+		InstanceUid:    a.instanceUID,
+		Callbacks: types.CallbacksStruct{
+			OnConnectFunc: func() {
+				a.logger.Debug("Connected to the OpAMP server")
+			},
+			OnConnectFailedFunc: func(err error) {
+				a.logger.Warn("Failed to connect to the OpAMP server", zap.Error(err))
+			},
+			OnErrorFunc: func(resp *protobufs.ServerErrorResponse) {
+				a.logger.Warn("The OpAMP server returned an error", zap.String("error", resp.GetErrorMessage()))
+			},
+			OnMessageFunc:          a.onMessage,
+			GetEffectiveConfigFunc: a.effectiveConfig,
+		},
+	}
+	if err = a.client.Start(context.Background(), settings); err != nil {
+		return err
+	}
+	return a.reportHealth()
+}
+
+func (a *opampAgent) Shutdown(ctx context.Context) error {
+	var errs error
+	if a.client != nil {
+		// Let the server know that the collector is going away.
+		errs = a.client.Stop(ctx)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.unconfirmed {
+		// The collector is shutting down without ever being ready with the remote configuration, so that it
+		// is restarted with the previous one.
+		a.logger.Error("The collector failed to start with the remote configuration, rolling it back",
+			zap.String("file", a.cfg.RemoteConfig.File))
+		errs = multierr.Append(errs, rollbackConfigFile(a.cfg.RemoteConfig.File))
+		a.unconfirmed = false
+	}
+	return errs
+}
+
+// Ready marks the collector as healthy once its pipelines are started, which confirms the remote
+// configuration it started with.
+func (a *opampAgent) Ready() error {
+	a.mu.Lock()
+	a.healthy = true
+	if a.unconfirmed {
+		if err := confirmConfigFile(a.cfg.RemoteConfig.File); err != nil {
+			a.logger.Warn("Failed to remove the previous configuration", zap.Error(err))
+		}
+		a.unconfirmed = false
+	}
+	a.mu.Unlock()
+	return a.reportHealth()
+}
+
+// NotReady marks the collector as unhealthy once its pipelines are stopping.
+func (a *opampAgent) NotReady() error {
+	a.mu.Lock()
+	a.healthy = false
+	a.mu.Unlock()
+	return a.reportHealth()
+}
+
+// reportHealth sets the health reported by the client, once it is started.
+func (a *opampAgent) reportHealth() error {
+	if a.client == nil {
+		return nil
+	}
+	a.mu.Lock()
+	health := &protobufs.AgentHealth{Up: a.healthy, StartTimeUnixNano: uint64(a.startTime.UnixNano())}
+	a.mu.Unlock()
+	return a.client.SetHealth(health)
+}
+
+// onMessage handles the messages of the server: the new instance UID and the remote configuration.
+func (a *opampAgent) onMessage(ctx context.Context, msg *types.MessageData) {
+	if msg.AgentIdentification != nil && msg.AgentIdentification.NewInstanceUid != "" {
+		a.mu.Lock()
+		a.instanceUID = msg.AgentIdentification.NewInstanceUid
+		a.mu.Unlock()
+		if err := a.client.SetAgentDescription(a.description()); err != nil {
+			a.logger.Warn("Failed to update the agent description", zap.Error(err))
+		}
+	}
+	if msg.RemoteConfig == nil {
+		return
+	}
+
+	status, changed := a.applyRemoteConfig(msg.RemoteConfig)
+	if status == nil {
+		return
+	}
+	if err := a.client.SetRemoteConfigStatus(status); err != nil {
+		a.logger.Warn("Failed to report the status of the remote configuration", zap.Error(err))
+	}
+	if !changed {
+		return
+	}
+	if err := a.client.UpdateEffectiveConfig(ctx); err != nil {
+		a.logger.Warn("Failed to report the effective configuration", zap.Error(err))
+	}
+	if a.cfg.RemoteConfig.RestartOnApply {
+		a.logger.Info("Shutting down the collector to restart it with the remote configuration")
+		a.host.ReportFatalError(errRestartOnApply)
+	}
+}
+
+// applyRemoteConfig applies a remote configuration which was not processed yet. It returns its status,
+// or nil if it was already processed, and whether the configuration file changed.
+func (a *opampAgent) applyRemoteConfig(remote *protobufs.AgentRemoteConfig) (*protobufs.RemoteConfigStatus, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.remoteConfigStatus != nil && bytes.Equal(a.remoteConfigStatus.LastRemoteConfigHash, remote.ConfigHash) {
+		return nil, false
+	}
+
+	status := &protobufs.RemoteConfigStatus{
+		LastRemoteConfigHash: remote.ConfigHash,
+		Status:               protobufs.RemoteConfigStatuses_RemoteConfigStatuses_APPLIED,
+	}
+	a.remoteConfigStatus = status
+	if a.cfg.RemoteConfig.File == "" {
+		status.Status = protobufs.RemoteConfigStatuses_RemoteConfigStatuses_FAILED
+		status.ErrorMessage = "remote configuration is not accepted"
+		return status, false
+	}
+
+	body, err := remoteConfigBody(remote.Config)
+	var changed bool
+	if err == nil {
+		changed, err = applyConfigFile(a.host, a.cfg.RemoteConfig.File, body)
+	}
+	if err != nil {
+		a.logger.Error("Failed to apply the remote configuration", zap.Error(err))
+		status.Status = protobufs.RemoteConfigStatuses_RemoteConfigStatuses_FAILED
+		status.ErrorMessage = err.Error()
+		return status, false
+	}
+	if changed {
+		a.logger.Info("Applied the remote configuration", zap.String("file", a.cfg.RemoteConfig.File))
+	}
+	return status, changed
+}
+
+// effectiveConfig returns the content of the configuration file managed by the server.
+func (a *opampAgent) effectiveConfig(context.Context) (*protobufs.EffectiveConfig, error) {
+	if a.cfg.RemoteConfig.File == "" {
+		return nil, nil
+	}
+	body, err := os.ReadFile(filepath.Clean(a.cfg.RemoteConfig.File))
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			a.logger.Warn("Failed to read the effective configuration", zap.Error(err))
+		}
+		return nil, nil
+	}
+	return &protobufs.EffectiveConfig{
+		ConfigMap: &protobufs.AgentConfigMap{
+			ConfigMap: map[string]*protobufs.AgentConfigFile{
+				"": {Body: body, ContentType: configContentType},
+			},
+		},
+	}, nil
+}
+
+// description returns the description of the collector.
+func (a *opampAgent) description() *protobufs.AgentDescription {
+	a.mu.Lock()
+	instanceUID := a.instanceUID
+	a.mu.Unlock()
+
+	description := &protobufs.AgentDescription{
+		IdentifyingAttributes: []*protobufs.KeyValue{
+			stringKeyValue("service.name", a.set.BuildInfo.Command),
+			stringKeyValue("service.version", a.set.BuildInfo.Version),
+			stringKeyValue("service.instance.id", instanceUID),
+		},
+		NonIdentifyingAttributes: []*protobufs.KeyValue{
+			stringKeyValue("os.type", runtime.GOOS),
+			stringKeyValue("host.arch", runtime.GOARCH),
+		},
+	}
+	if hostname, err := os.Hostname(); err == nil {
+		description.NonIdentifyingAttributes = append(description.NonIdentifyingAttributes, stringKeyValue("host.name", hostname))
+	}
+	return description
+}
+
+func stringKeyValue(key, value string) *protobufs.KeyValue {
+	return &protobufs.KeyValue{
+		Key: key,
+		Value: &protobufs.AnyValue{
+			Value: &protobufs.AnyValue_StringValue{StringValue: value},
+		},
+	}
+}
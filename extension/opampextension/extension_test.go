@@ -0,0 +1,275 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opampextension
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/open-telemetry/opamp-go/client"
+	"github.com/open-telemetry/opamp-go/client/types"
+	"github.com/open-telemetry/opamp-go/protobufs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.uber.org/zap"
+)
+
+// fakeClient is an OpAMP client recording what the agent reports to the server.
+type fakeClient struct {
+	client.OpAMPClient
+
+	mu                     sync.Mutex
+	settings               types.StartSettings
+	stopped                bool
+	description            *protobufs.AgentDescription
+	health                 *protobufs.AgentHealth
+	remoteConfigStatuses   []*protobufs.RemoteConfigStatus
+	effectiveConfigUpdates int
+}
+
+func (c *fakeClient) Start(_ context.Context, settings types.StartSettings) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.settings = settings
+	return nil
+}
+
+func (c *fakeClient) Stop(context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.stopped = true
+	return nil
+}
+
+func (c *fakeClient) SetAgentDescription(description *protobufs.AgentDescription) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.description = description
+	return nil
+}
+
+func (c *fakeClient) SetHealth(health *protobufs.AgentHealth) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.health = health
+	return nil
+}
+
+func (c *fakeClient) SetRemoteConfigStatus(status *protobufs.RemoteConfigStatus) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.remoteConfigStatuses = append(c.remoteConfigStatuses, status)
+	return nil
+}
+
+func (c *fakeClient) UpdateEffectiveConfig(context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.effectiveConfigUpdates++
+	return nil
+}
+
+// fatalErrorsHost is a host providing the nop factories and recording the fatal errors.
+type fatalErrorsHost struct {
+	*factoriesHost
+	errs []error
+}
+
+func (h *fatalErrorsHost) ReportFatalError(err error) {
+	h.errs = append(h.errs, err)
+}
+
+func newTestAgent(t *testing.T, configure func(cfg *Config)) (*opampAgent, *fakeClient) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = "http://localhost:4320/v1/opamp"
+	if configure != nil {
+		configure(cfg)
+	}
+	require.NoError(t, cfg.Validate())
+	set := componenttest.NewNopExtensionCreateSettings()
+	set.BuildInfo = component.BuildInfo{Command: "otelcol", Version: "1.2.3"}
+	agent := newOpAMPAgent(cfg, set)
+	fake := &fakeClient{}
+	agent.newClient = func(*Config, types.Logger) client.OpAMPClient { return fake }
+	return agent, fake
+}
+
+func newRemoteConfig(body string, hash string) *types.MessageData {
+	return &types.MessageData{RemoteConfig: &protobufs.AgentRemoteConfig{
+		Config:     newConfigMap(map[string]string{"": body}),
+		ConfigHash: []byte(hash),
+	}}
+}
+
+func TestNewClient(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	for _, endpoint := range []string{"http://localhost:4320/v1/opamp", "ws://localhost:4320/v1/opamp"} {
+		cfg.Endpoint = endpoint
+		assert.NotNil(t, newClient(cfg, zap.NewNop().Sugar()))
+	}
+}
+
+func TestAgentReportsStatus(t *testing.T) {
+	agent, fake := newTestAgent(t, func(cfg *Config) {
+		cfg.Headers = map[string]string{"authorization": "secret"}
+	})
+
+	require.NoError(t, agent.Start(context.Background(), componenttest.NewNopHost()))
+	assert.Equal(t, "http://localhost:4320/v1/opamp", fake.settings.OpAMPServerURL)
+	assert.Equal(t, "secret", fake.settings.Header.Get("authorization"))
+	_, err := uuid.Parse(fake.settings.InstanceUid)
+	assert.NoError(t, err)
+	assert.False(t, fake.health.Up)
+	assert.NotZero(t, fake.health.StartTimeUnixNano)
+	assert.Equal(t, []*protobufs.KeyValue{
+		stringKeyValue("service.name", "otelcol"),
+		stringKeyValue("service.version", "1.2.3"),
+		stringKeyValue("service.instance.id", fake.settings.InstanceUid),
+	}, fake.description.IdentifyingAttributes)
+
+	effectiveConfig, err := fake.settings.Callbacks.GetEffectiveConfig(context.Background())
+	require.NoError(t, err)
+	assert.Nil(t, effectiveConfig)
+
+	require.NoError(t, agent.Ready())
+	assert.True(t, fake.health.Up)
+
+	fake.settings.Callbacks.OnMessage(context.Background(), &types.MessageData{
+		AgentIdentification: &protobufs.AgentIdentification{NewInstanceUid: "0f6d0f5e-8a9e-4c2c-a2b7-7cbd5e3e6c45"},
+	})
+	assert.Contains(t, fake.description.IdentifyingAttributes, stringKeyValue("service.instance.id", "0f6d0f5e-8a9e-4c2c-a2b7-7cbd5e3e6c45"))
+
+	require.NoError(t, agent.NotReady())
+	assert.False(t, fake.health.Up)
+	require.NoError(t, agent.Shutdown(context.Background()))
+	assert.True(t, fake.stopped)
+}
+
+func TestAgentAppliesRemoteConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "remote.yaml")
+	agent, fake := newTestAgent(t, func(cfg *Config) {
+		cfg.InstanceUID = "6b2a4e72-5a4d-4b0b-9f64-5f43ed8e5c0a"
+		cfg.RemoteConfig = RemoteConfigSettings{File: path, RestartOnApply: true}
+	})
+	host := &fatalErrorsHost{factoriesHost: newFactoriesHost(t)}
+
+	require.NoError(t, agent.Start(context.Background(), host))
+	require.NoError(t, agent.Ready())
+	assert.Equal(t, "6b2a4e72-5a4d-4b0b-9f64-5f43ed8e5c0a", fake.settings.InstanceUid)
+
+	agent.onMessage(context.Background(), newRemoteConfig(validCollectorConfig, "hash"))
+	assert.Equal(t, []*protobufs.RemoteConfigStatus{{
+		LastRemoteConfigHash: []byte("hash"),
+		Status:               protobufs.RemoteConfigStatuses_RemoteConfigStatuses_APPLIED,
+	}}, fake.remoteConfigStatuses)
+	assert.Equal(t, 1, fake.effectiveConfigUpdates)
+	assert.Equal(t, []error{errRestartOnApply}, host.errs)
+	assertFileContent(t, validCollectorConfig, path)
+
+	effectiveConfig, err := agent.effectiveConfig(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []byte(validCollectorConfig), effectiveConfig.ConfigMap.ConfigMap[""].Body)
+
+	// The same remote configuration is only processed once.
+	agent.onMessage(context.Background(), newRemoteConfig(validCollectorConfig, "hash"))
+	assert.Len(t, fake.remoteConfigStatuses, 1)
+	assert.Len(t, host.errs, 1)
+
+	// The remote configuration was applied while the collector was ready, so it isn't rolled back.
+	require.NoError(t, agent.Shutdown(context.Background()))
+	assertFileContent(t, validCollectorConfig, path)
+}
+
+func TestAgentConfirmsRemoteConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "remote.yaml")
+	require.NoError(t, os.WriteFile(path+backupSuffix, []byte("previous"), 0600))
+	require.NoError(t, os.WriteFile(path, []byte(validCollectorConfig), 0600))
+	agent, _ := newTestAgent(t, func(cfg *Config) {
+		cfg.RemoteConfig = RemoteConfigSettings{File: path}
+	})
+
+	require.NoError(t, agent.Start(context.Background(), newFactoriesHost(t)))
+	require.NoError(t, agent.Ready())
+	assert.NoFileExists(t, path+backupSuffix)
+	require.NoError(t, agent.Shutdown(context.Background()))
+	assertFileContent(t, validCollectorConfig, path)
+}
+
+func TestAgentRollsBackRemoteConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "remote.yaml")
+	require.NoError(t, os.WriteFile(path+backupSuffix, []byte("previous"), 0600))
+	require.NoError(t, os.WriteFile(path, []byte(validCollectorConfig), 0600))
+	agent, _ := newTestAgent(t, func(cfg *Config) {
+		cfg.RemoteConfig = RemoteConfigSettings{File: path}
+	})
+
+	// The collector shuts down without being ready, e.g. because a pipeline failed to start.
+	require.NoError(t, agent.Start(context.Background(), newFactoriesHost(t)))
+	require.NoError(t, agent.Shutdown(context.Background()))
+	assertFileContent(t, "previous", path)
+	assertFileContent(t, validCollectorConfig, path+rejectedSuffix)
+	assert.NoFileExists(t, path+backupSuffix)
+
+	// The rolled back configuration is reported as failed when the server offers it again.
+	restarted, fake := newTestAgent(t, func(cfg *Config) {
+		cfg.RemoteConfig = RemoteConfigSettings{File: path}
+	})
+	require.NoError(t, restarted.Start(context.Background(), newFactoriesHost(t)))
+	restarted.onMessage(context.Background(), newRemoteConfig(validCollectorConfig, "hash"))
+	require.Len(t, fake.remoteConfigStatuses, 1)
+	assert.Equal(t, protobufs.RemoteConfigStatuses_RemoteConfigStatuses_FAILED, fake.remoteConfigStatuses[0].Status)
+	assert.Contains(t, fake.remoteConfigStatuses[0].ErrorMessage, "rolled back")
+	require.NoError(t, restarted.Shutdown(context.Background()))
+	assertFileContent(t, "previous", path)
+}
+
+func TestAgentRejectsInvalidRemoteConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "remote.yaml")
+	agent, fake := newTestAgent(t, func(cfg *Config) {
+		cfg.RemoteConfig = RemoteConfigSettings{File: path, RestartOnApply: true}
+	})
+	host := &fatalErrorsHost{factoriesHost: newFactoriesHost(t)}
+
+	require.NoError(t, agent.Start(context.Background(), host))
+	agent.onMessage(context.Background(), newRemoteConfig("receivers:\n  otlp:\n", "hash"))
+	require.NoError(t, agent.Shutdown(context.Background()))
+
+	require.Len(t, fake.remoteConfigStatuses, 1)
+	assert.Equal(t, protobufs.RemoteConfigStatuses_RemoteConfigStatuses_FAILED, fake.remoteConfigStatuses[0].Status)
+	assert.Contains(t, fake.remoteConfigStatuses[0].ErrorMessage, `unknown type "otlp"`)
+	assert.Zero(t, fake.effectiveConfigUpdates)
+	assert.Empty(t, host.errs)
+	assert.NoFileExists(t, path)
+}
+
+func TestAgentRemoteConfigNotAccepted(t *testing.T) {
+	agent, fake := newTestAgent(t, nil)
+
+	require.NoError(t, agent.Start(context.Background(), newFactoriesHost(t)))
+	agent.onMessage(context.Background(), newRemoteConfig(validCollectorConfig, "hash"))
+	require.NoError(t, agent.Shutdown(context.Background()))
+
+	assert.Equal(t, []*protobufs.RemoteConfigStatus{{
+		LastRemoteConfigHash: []byte("hash"),
+		Status:               protobufs.RemoteConfigStatuses_RemoteConfigStatuses_FAILED,
+		ErrorMessage:         "remote configuration is not accepted",
+	}}, fake.remoteConfigStatuses)
+}
@@ -0,0 +1,53 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opampextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/opampextension"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+)
+
+const (
+	// The value of extension "type" in configuration.
+	typeStr = "opamp"
+	// The stability level of the extension.
+	stability = component.StabilityLevelInDevelopment
+
+	defaultPollingInterval = 30 * time.Second
+)
+
+// NewFactory creates a factory for the OpAMP extension.
+func NewFactory() component.ExtensionFactory {
+	return component.NewExtensionFactory(
+		typeStr,
+		createDefaultConfig,
+		createExtension,
+		stability,
+	)
+}
+
+func createDefaultConfig() component.ExtensionConfig {
+	return &Config{
+		ExtensionSettings: config.NewExtensionSettings(component.NewID(typeStr)),
+		PollingInterval:   defaultPollingInterval,
+	}
+}
+
+func createExtension(_ context.Context, set component.ExtensionCreateSettings, cfg component.ExtensionConfig) (component.Extension, error) {
+	return newOpAMPAgent(cfg.(*Config), set), nil
+}
@@ -0,0 +1,285 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opampextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/opampextension"
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/open-telemetry/opamp-go/protobufs"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+	"go.uber.org/multierr"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// backupSuffix is appended to the configuration file name to keep the configuration replaced by the remote one,
+	// until the collector starts successfully with the remote one.
+	backupSuffix = ".bak"
+	// rejectedSuffix is appended to the configuration file name to keep the remote configuration rolled back
+	// because the collector failed to start with it.
+	rejectedSuffix = ".rejected"
+)
+
+// remoteConfigBody returns the body of the single configuration file of a remote configuration.
+func remoteConfigBody(configMap *protobufs.AgentConfigMap) ([]byte, error) {
+	files := configMap.GetConfigMap()
+	if file, ok := files[""]; ok {
+		return file.GetBody(), nil
+	}
+	if len(files) != 1 {
+		return nil, fmt.Errorf("remote configuration must have a single file, got %d", len(files))
+	}
+	for _, file := range files {
+		return file.GetBody(), nil
+	}
+	return nil, nil
+}
+
+// applyConfigFile validates the configuration body and atomically replaces the file at path with it,
+// keeping the replaced file next to it until the replacement is confirmed or rolled back. It returns
+// whether the file changed.
+func applyConfigFile(host component.Host, path string, body []byte) (bool, error) {
+	current, err := os.ReadFile(filepath.Clean(path))
+	switch {
+	case err == nil && bytes.Equal(current, body):
+		return false, nil
+	case err != nil && !errors.Is(err, os.ErrNotExist):
+		return false, err
+	}
+
+	rejected, err := os.ReadFile(filepath.Clean(path + rejectedSuffix))
+	switch {
+	case err == nil && bytes.Equal(rejected, body):
+		return false, errors.New("the collector failed to start with this configuration, which was rolled back")
+	case err != nil && !errors.Is(err, os.ErrNotExist):
+		return false, err
+	}
+
+	if err = validateCollectorConfig(host, body); err != nil {
+		return false, fmt.Errorf("invalid configuration: %w", err)
+	}
+
+	// An empty backup stands for a configuration file which didn't exist.
+	if err = os.WriteFile(path+backupSuffix, current, 0600); err != nil {
+		return false, err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return false, err
+	}
+	_, err = tmp.Write(body)
+	err = multierr.Append(err, tmp.Close())
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		// The file at path is left untouched, so the previous configuration stays in place.
+		_ = os.Remove(tmp.Name())
+		return false, err
+	}
+	return true, nil
+}
+
+// hasUnconfirmedConfigFile returns whether the file at path holds a remote configuration which the collector
+// didn't start with successfully yet.
+func hasUnconfirmedConfigFile(path string) (bool, error) {
+	_, err := os.Stat(path + backupSuffix)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// confirmConfigFile removes the configurations kept next to the file at path, once the collector started
+// successfully with it.
+func confirmConfigFile(path string) error {
+	var errs error
+	for _, suffix := range []string{backupSuffix, rejectedSuffix} {
+		if err := os.Remove(path + suffix); err != nil && !errors.Is(err, os.ErrNotExist) {
+			errs = multierr.Append(errs, err)
+		}
+	}
+	return errs
+}
+
+// rollbackConfigFile restores the configuration replaced by the remote one at path, and keeps the remote one
+// so that it isn't applied again.
+func rollbackConfigFile(path string) error {
+	previous, err := os.ReadFile(filepath.Clean(path + backupSuffix))
+	if err != nil {
+		return err
+	}
+	if err = os.Rename(path, path+rejectedSuffix); err != nil {
+		return err
+	}
+	if len(previous) == 0 {
+		return os.Remove(path + backupSuffix)
+	}
+	return os.Rename(path+backupSuffix, path)
+}
+
+// componentSections are the collector configuration sections defining components.
+var componentSections = []struct {
+	key      string
+	kind     component.Kind
+	validate func(factory component.Factory, id component.ID, conf *confmap.Conf) error
+}{
+	{key: "receivers", kind: component.KindReceiver, validate: validateReceiver},
+	{key: "processors", kind: component.KindProcessor, validate: validateProcessor},
+	{key: "exporters", kind: component.KindExporter, validate: validateExporter},
+	{key: "extensions", kind: component.KindExtension, validate: validateExtension},
+}
+
+// serviceConfig is the part of the service configuration referencing components.
+type serviceConfig struct {
+	Extensions []string                  `mapstructure:"extensions"`
+	Pipelines  map[string]pipelineConfig `mapstructure:"pipelines"`
+}
+
+type pipelineConfig struct {
+	Receivers  []string `mapstructure:"receivers"`
+	Processors []string `mapstructure:"processors"`
+	Exporters  []string `mapstructure:"exporters"`
+}
+
+// validateCollectorConfig checks that the collector configuration body only uses components built in the
+// collector, that their configurations are valid and that the service only references defined components.
+func validateCollectorConfig(host component.Host, body []byte) error {
+	var raw map[string]interface{}
+	if err := yaml.Unmarshal(body, &raw); err != nil {
+		return err
+	}
+	conf := confmap.NewFromStringMap(raw)
+
+	var errs error
+	defined := map[string]map[string]bool{}
+	for _, section := range componentSections {
+		sectionConf, err := conf.Sub(section.key)
+		if err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("%s: %w", section.key, err))
+			continue
+		}
+		defined[section.key] = map[string]bool{}
+		for key := range sectionConf.ToStringMap() {
+			defined[section.key][key] = true
+			id := component.ID{}
+			if err = id.UnmarshalText([]byte(key)); err != nil {
+				errs = multierr.Append(errs, fmt.Errorf("%s: %w", section.key, err))
+				continue
+			}
+			factory := host.GetFactory(section.kind, id.Type())
+			if factory == nil {
+				errs = multierr.Append(errs, fmt.Errorf("%s: unknown type %q for %q", section.key, id.Type(), key))
+				continue
+			}
+			componentConf, err := sectionConf.Sub(key)
+			if err == nil {
+				err = section.validate(factory, id, componentConf)
+			}
+			if err != nil {
+				errs = multierr.Append(errs, fmt.Errorf("%s: %q: %w", section.key, key, err))
+			}
+		}
+	}
+
+	serviceConf, err := conf.Sub("service")
+	if err != nil {
+		return multierr.Append(errs, fmt.Errorf("service: %w", err))
+	}
+	var service serviceConfig
+	if err = serviceConf.Unmarshal(&service); err != nil {
+		return multierr.Append(errs, fmt.Errorf("service: %w", err))
+	}
+	if len(service.Pipelines) == 0 {
+		errs = multierr.Append(errs, errors.New("service must have at least one pipeline"))
+	}
+	for _, key := range service.Extensions {
+		if !defined["extensions"][key] {
+			errs = multierr.Append(errs, fmt.Errorf("service references undefined extension %q", key))
+		}
+	}
+	for name, pipeline := range service.Pipelines {
+		references := map[string][]string{
+			"receivers":  pipeline.Receivers,
+			"processors": pipeline.Processors,
+			"exporters":  pipeline.Exporters,
+		}
+		for _, section := range []string{"receivers", "processors", "exporters"} {
+			for _, key := range references[section] {
+				if !defined[section][key] {
+					errs = multierr.Append(errs, fmt.Errorf("pipeline %q references undefined %s %q", name, section, key))
+				}
+			}
+		}
+	}
+	return errs
+}
+
+func validateReceiver(factory component.Factory, id component.ID, conf *confmap.Conf) error {
+	receiverFactory, ok := factory.(component.ReceiverFactory)
+	if !ok {
+		return fmt.Errorf("%q is not a receiver factory", id.Type())
+	}
+	cfg := receiverFactory.CreateDefaultConfig()
+	cfg.SetIDName(id.Name())
+	if err := component.UnmarshalReceiverConfig(conf, cfg); err != nil {
+		return err
+	}
+	return cfg.Validate()
+}
+
+func validateProcessor(factory component.Factory, id component.ID, conf *confmap.Conf) error {
+	processorFactory, ok := factory.(component.ProcessorFactory)
+	if !ok {
+		return fmt.Errorf("%q is not a processor factory", id.Type())
+	}
+	cfg := processorFactory.CreateDefaultConfig()
+	cfg.SetIDName(id.Name())
+	if err := component.UnmarshalProcessorConfig(conf, cfg); err != nil {
+		return err
+	}
+	return cfg.Validate()
+}
+
+func validateExporter(factory component.Factory, id component.ID, conf *confmap.Conf) error {
+	exporterFactory, ok := factory.(component.ExporterFactory)
+	if !ok {
+		return fmt.Errorf("%q is not an exporter factory", id.Type())
+	}
+	cfg := exporterFactory.CreateDefaultConfig()
+	cfg.SetIDName(id.Name())
+	if err := component.UnmarshalExporterConfig(conf, cfg); err != nil {
+		return err
+	}
+	return cfg.Validate()
+}
+
+func validateExtension(factory component.Factory, id component.ID, conf *confmap.Conf) error {
+	extensionFactory, ok := factory.(component.ExtensionFactory)
+	if !ok {
+		return fmt.Errorf("%q is not an extension factory", id.Type())
+	}
+	cfg := extensionFactory.CreateDefaultConfig()
+	cfg.SetIDName(id.Name())
+	if err := component.UnmarshalExtensionConfig(conf, cfg); err != nil {
+		return err
+	}
+	return cfg.Validate()
+}
@@ -0,0 +1,278 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opampextension
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/open-telemetry/opamp-go/protobufs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+)
+
+const validCollectorConfig = `
+receivers:
+  nop:
+processors:
+  nop/1:
+exporters:
+  nop:
+extensions:
+  nop:
+service:
+  extensions: [nop]
+  pipelines:
+    metrics:
+      receivers: [nop]
+      processors: [nop/1]
+      exporters: [nop]
+`
+
+// factoriesHost is a host providing the nop factories.
+type factoriesHost struct {
+	component.Host
+	factories component.Factories
+}
+
+func newFactoriesHost(t *testing.T) *factoriesHost {
+	factories, err := componenttest.NopFactories()
+	require.NoError(t, err)
+	return &factoriesHost{Host: componenttest.NewNopHost(), factories: factories}
+}
+
+func (h *factoriesHost) GetFactory(kind component.Kind, componentType component.Type) component.Factory {
+	switch kind {
+	case component.KindReceiver:
+		return h.factories.Receivers[componentType]
+	case component.KindProcessor:
+		return h.factories.Processors[componentType]
+	case component.KindExporter:
+		return h.factories.Exporters[componentType]
+	case component.KindExtension:
+		return h.factories.Extensions[componentType]
+	}
+	return nil
+}
+
+func TestValidateCollectorConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      string
+		expectedErr string
+	}{
+		{
+			name:   "valid",
+			config: validCollectorConfig,
+		},
+		{
+			name:        "invalid yaml",
+			config:      "receivers: [",
+			expectedErr: "yaml",
+		},
+		{
+			name: "unknown type",
+			config: `
+receivers:
+  otlp:
+exporters:
+  nop:
+service:
+  pipelines:
+    traces:
+      receivers: [otlp]
+      exporters: [nop]
+`,
+			expectedErr: `receivers: unknown type "otlp" for "otlp"`,
+		},
+		{
+			name: "invalid component config",
+			config: `
+receivers:
+  nop:
+    endpoint: localhost:4317
+exporters:
+  nop:
+service:
+  pipelines:
+    traces:
+      receivers: [nop]
+      exporters: [nop]
+`,
+			expectedErr: `receivers: "nop"`,
+		},
+		{
+			name: "undefined pipeline component",
+			config: `
+receivers:
+  nop:
+exporters:
+  nop:
+service:
+  pipelines:
+    traces:
+      receivers: [nop]
+      exporters: [nop/2]
+`,
+			expectedErr: `pipeline "traces" references undefined exporters "nop/2"`,
+		},
+		{
+			name: "undefined extension",
+			config: `
+receivers:
+  nop:
+exporters:
+  nop:
+service:
+  extensions: [nop]
+  pipelines:
+    traces:
+      receivers: [nop]
+      exporters: [nop]
+`,
+			expectedErr: `service references undefined extension "nop"`,
+		},
+		{
+			name: "no pipeline",
+			config: `
+receivers:
+  nop:
+`,
+			expectedErr: "service must have at least one pipeline",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCollectorConfig(newFactoriesHost(t), []byte(tt.config))
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestApplyConfigFile(t *testing.T) {
+	host := newFactoriesHost(t)
+	path := filepath.Join(t.TempDir(), "remote.yaml")
+
+	changed, err := applyConfigFile(host, path, []byte(validCollectorConfig))
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assertFileContent(t, "", path+backupSuffix)
+
+	changed, err = applyConfigFile(host, path, []byte(validCollectorConfig))
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	updated := validCollectorConfig + "  telemetry:\n    logs:\n      level: debug\n"
+	changed, err = applyConfigFile(host, path, []byte(updated))
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assertFileContent(t, updated, path)
+	assertFileContent(t, validCollectorConfig, path+backupSuffix)
+
+	changed, err = applyConfigFile(host, path, []byte("receivers: ["))
+	assert.ErrorContains(t, err, "invalid configuration")
+	assert.False(t, changed)
+	assertFileContent(t, updated, path)
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+}
+
+func TestRollbackConfigFile(t *testing.T) {
+	host := newFactoriesHost(t)
+	path := filepath.Join(t.TempDir(), "remote.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(validCollectorConfig), 0600))
+
+	unconfirmed, err := hasUnconfirmedConfigFile(path)
+	require.NoError(t, err)
+	assert.False(t, unconfirmed)
+
+	updated := validCollectorConfig + "  telemetry:\n    logs:\n      level: debug\n"
+	changed, err := applyConfigFile(host, path, []byte(updated))
+	require.NoError(t, err)
+	assert.True(t, changed)
+	unconfirmed, err = hasUnconfirmedConfigFile(path)
+	require.NoError(t, err)
+	assert.True(t, unconfirmed)
+
+	require.NoError(t, rollbackConfigFile(path))
+	assertFileContent(t, validCollectorConfig, path)
+	assertFileContent(t, updated, path+rejectedSuffix)
+	assert.NoFileExists(t, path+backupSuffix)
+
+	// The rolled back configuration isn't applied again.
+	changed, err = applyConfigFile(host, path, []byte(updated))
+	assert.ErrorContains(t, err, "rolled back")
+	assert.False(t, changed)
+	assertFileContent(t, validCollectorConfig, path)
+
+	// A new configuration is applied, and confirmed once the collector started with it.
+	changed, err = applyConfigFile(host, path, []byte(validCollectorConfig+"  telemetry:\n    logs:\n      level: info\n"))
+	require.NoError(t, err)
+	assert.True(t, changed)
+	require.NoError(t, confirmConfigFile(path))
+	assert.NoFileExists(t, path+backupSuffix)
+	assert.NoFileExists(t, path+rejectedSuffix)
+}
+
+func TestRollbackConfigFileWithoutPrevious(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "remote.yaml")
+	changed, err := applyConfigFile(newFactoriesHost(t), path, []byte(validCollectorConfig))
+	require.NoError(t, err)
+	assert.True(t, changed)
+
+	require.NoError(t, rollbackConfigFile(path))
+	assert.NoFileExists(t, path)
+	assert.NoFileExists(t, path+backupSuffix)
+	assertFileContent(t, validCollectorConfig, path+rejectedSuffix)
+}
+
+func TestRemoteConfigBody(t *testing.T) {
+	body, err := remoteConfigBody(newConfigMap(map[string]string{"": "a", "other.yaml": "b"}))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("a"), body)
+
+	body, err = remoteConfigBody(newConfigMap(map[string]string{"collector.yaml": "b"}))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("b"), body)
+
+	_, err = remoteConfigBody(newConfigMap(map[string]string{"a.yaml": "", "b.yaml": ""}))
+	assert.ErrorContains(t, err, "got 2")
+	_, err = remoteConfigBody(nil)
+	assert.ErrorContains(t, err, "got 0")
+}
+
+// newConfigMap returns the AgentConfigMap of the bodies of the files by name.
+func newConfigMap(files map[string]string) *protobufs.AgentConfigMap {
+	configMap := &protobufs.AgentConfigMap{ConfigMap: map[string]*protobufs.AgentConfigFile{}}
+	for name, body := range files {
+		configMap.ConfigMap[name] = &protobufs.AgentConfigFile{Body: []byte(body), ContentType: configContentType}
+	}
+	return configMap
+}
+
+func assertFileContent(t *testing.T, expected string, path string) {
+	content, err := os.ReadFile(filepath.Clean(path))
+	require.NoError(t, err)
+	assert.Equal(t, expected, string(content))
+}
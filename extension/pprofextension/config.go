@@ -15,6 +15,11 @@
 package pprofextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/pprofextension"
 
 import (
+	"errors"
+	"fmt"
+	"runtime/pprof"
+	"time"
+
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/config/confignet"
@@ -42,11 +47,74 @@ type Config struct {
 	// Optional file name to save the CPU profile to. The profiling starts when the
 	// Collector starts and is saved to the file when the Collector is terminated.
 	SaveToFile string `mapstructure:"save_to_file"`
+
+	// MemoryProfiles configures the profiles automatically captured when the
+	// memory usage of the Collector exceeds a threshold.
+	MemoryProfiles MemoryProfilesConfig `mapstructure:"memory_profiles"`
+}
+
+// MemoryProfilesConfig has the configuration of the profiles captured when the
+// memory usage exceeds a threshold. The capture is enabled when Directory is set.
+type MemoryProfilesConfig struct {
+	// Directory in which the profiles are saved.
+	Directory string `mapstructure:"directory"`
+
+	// CheckInterval is the time between measurements of the memory usage.
+	CheckInterval time.Duration `mapstructure:"check_interval"`
+
+	// RSSLimitMiB is the resident set size of the process, in MiB, above which
+	// the profiles are captured. Only supported on Linux. A value of 0 disables the threshold.
+	RSSLimitMiB uint64 `mapstructure:"rss_limit_mib"`
+
+	// HeapLimitMiB is the size of the allocated heap objects, in MiB, above which
+	// the profiles are captured. A value of 0 disables the threshold.
+	HeapLimitMiB uint64 `mapstructure:"heap_limit_mib"`
+
+	// Profiles are the names of the runtime profiles captured, e.g. "heap" or "goroutine".
+	Profiles []string `mapstructure:"profiles"`
+
+	// MinInterval is the minimum time between two captures.
+	MinInterval time.Duration `mapstructure:"min_interval"`
+
+	// MaxCaptures is the maximum number of captures while the Collector is running.
+	// A value of 0 does not limit the number of captures.
+	MaxCaptures int `mapstructure:"max_captures"`
 }
 
 var _ component.ExtensionConfig = (*Config)(nil)
 
 // Validate checks if the extension configuration is valid
 func (cfg *Config) Validate() error {
+	return cfg.MemoryProfiles.Validate()
+}
+
+// Validate checks if the memory profiles configuration is valid
+func (cfg *MemoryProfilesConfig) Validate() error {
+	if cfg.Directory == "" {
+		if cfg.RSSLimitMiB != 0 || cfg.HeapLimitMiB != 0 {
+			return errors.New("\"memory_profiles::directory\" is required when a memory limit is set")
+		}
+		return nil
+	}
+	if cfg.RSSLimitMiB == 0 && cfg.HeapLimitMiB == 0 {
+		return errors.New("either \"rss_limit_mib\" or \"heap_limit_mib\" is required when capturing memory profiles")
+	}
+	if cfg.CheckInterval <= 0 {
+		return errors.New("\"check_interval\" must be positive")
+	}
+	if cfg.MinInterval < 0 {
+		return errors.New("\"min_interval\" must not be negative")
+	}
+	if cfg.MaxCaptures < 0 {
+		return errors.New("\"max_captures\" must not be negative")
+	}
+	if len(cfg.Profiles) == 0 {
+		return errors.New("at least one profile is required when capturing memory profiles")
+	}
+	for _, name := range cfg.Profiles {
+		if pprof.Lookup(name) == nil {
+			return fmt.Errorf("unknown profile %q", name)
+		}
+	}
 	return nil
 }
@@ -17,6 +17,7 @@ package pprofextension
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -44,6 +45,28 @@ func TestLoadConfig(t *testing.T) {
 				TCPAddr:              confignet.TCPAddr{Endpoint: "127.0.0.1:1777"},
 				BlockProfileFraction: 3,
 				MutexProfileFraction: 5,
+				MemoryProfiles: MemoryProfilesConfig{
+					CheckInterval: defaultCheckInterval,
+					Profiles:      []string{"heap", "goroutine"},
+					MinInterval:   defaultMinInterval,
+					MaxCaptures:   defaultMaxCaptures,
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(typeStr, "2"),
+			expected: &Config{
+				ExtensionSettings: config.NewExtensionSettings(component.NewID(typeStr)),
+				TCPAddr:           confignet.TCPAddr{Endpoint: defaultEndpoint},
+				MemoryProfiles: MemoryProfilesConfig{
+					Directory:     "/var/lib/otelcol/profiles",
+					CheckInterval: 10 * time.Second,
+					RSSLimitMiB:   1800,
+					HeapLimitMiB:  1500,
+					Profiles:      []string{"heap", "goroutine", "allocs"},
+					MinInterval:   30 * time.Minute,
+					MaxCaptures:   3,
+				},
 			},
 		},
 	}
@@ -62,3 +85,56 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateMemoryProfiles(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(cfg *MemoryProfilesConfig)
+		err    string
+	}{
+		{
+			name:   "limit without directory",
+			modify: func(cfg *MemoryProfilesConfig) { cfg.HeapLimitMiB = 100 },
+			err:    "\"memory_profiles::directory\" is required when a memory limit is set",
+		},
+		{
+			name:   "directory without limit",
+			modify: func(cfg *MemoryProfilesConfig) { cfg.Directory = "profiles" },
+			err:    "either \"rss_limit_mib\" or \"heap_limit_mib\" is required when capturing memory profiles",
+		},
+		{
+			name: "no check interval",
+			modify: func(cfg *MemoryProfilesConfig) {
+				cfg.Directory = "profiles"
+				cfg.HeapLimitMiB = 100
+				cfg.CheckInterval = 0
+			},
+			err: "\"check_interval\" must be positive",
+		},
+		{
+			name: "negative max captures",
+			modify: func(cfg *MemoryProfilesConfig) {
+				cfg.Directory = "profiles"
+				cfg.HeapLimitMiB = 100
+				cfg.MaxCaptures = -1
+			},
+			err: "\"max_captures\" must not be negative",
+		},
+		{
+			name: "unknown profile",
+			modify: func(cfg *MemoryProfilesConfig) {
+				cfg.Directory = "profiles"
+				cfg.HeapLimitMiB = 100
+				cfg.Profiles = []string{"heap", "cpu"}
+			},
+			err: "unknown profile \"cpu\"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tt.modify(&cfg.MemoryProfiles)
+			assert.EqualError(t, cfg.Validate(), tt.err)
+		})
+	}
+}
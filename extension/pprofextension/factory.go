@@ -17,6 +17,7 @@ package pprofextension // import "github.com/open-telemetry/opentelemetry-collec
 import (
 	"context"
 	"errors"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
@@ -28,6 +29,10 @@ const (
 	typeStr = "pprof"
 
 	defaultEndpoint = "localhost:1777"
+
+	defaultCheckInterval = 5 * time.Second
+	defaultMinInterval   = 10 * time.Minute
+	defaultMaxCaptures   = 10
 )
 
 // NewFactory creates a factory for pprof extension.
@@ -46,6 +51,12 @@ func createDefaultConfig() component.ExtensionConfig {
 		TCPAddr: confignet.TCPAddr{
 			Endpoint: defaultEndpoint,
 		},
+		MemoryProfiles: MemoryProfilesConfig{
+			CheckInterval: defaultCheckInterval,
+			Profiles:      []string{"heap", "goroutine"},
+			MinInterval:   defaultMinInterval,
+			MaxCaptures:   defaultMaxCaptures,
+		},
 	}
 }
 
@@ -33,6 +33,12 @@ func TestFactory_CreateDefaultConfig(t *testing.T) {
 	assert.Equal(t, &Config{
 		ExtensionSettings: config.NewExtensionSettings(component.NewID(typeStr)),
 		TCPAddr:           confignet.TCPAddr{Endpoint: defaultEndpoint},
+		MemoryProfiles: MemoryProfilesConfig{
+			CheckInterval: defaultCheckInterval,
+			Profiles:      []string{"heap", "goroutine"},
+			MinInterval:   defaultMinInterval,
+			MaxCaptures:   defaultMaxCaptures,
+		},
 	},
 		cfg)
 
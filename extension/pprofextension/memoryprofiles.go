@@ -0,0 +1,179 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pprofextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/pprofextension"
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	"time"
+
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+const mibBytes = 1024 * 1024
+
+var errRSSNotSupported = errors.New("the resident set size is not supported on this platform")
+
+// memoryUsage is the memory used by the process, in bytes.
+type memoryUsage struct {
+	rss  uint64
+	heap uint64
+}
+
+// memoryProfiler captures profiles when the memory usage exceeds a threshold,
+// at most once per MinInterval and MaxCaptures times.
+type memoryProfiler struct {
+	config MemoryProfilesConfig
+	logger *zap.Logger
+
+	readRSS func() (uint64, error)
+	now     func() time.Time
+
+	lastCapture time.Time
+	captures    int
+
+	stopCh chan struct{}
+	doneCh chan struct{}
+}
+
+func newMemoryProfiler(config MemoryProfilesConfig, logger *zap.Logger) *memoryProfiler {
+	return &memoryProfiler{
+		config:  config,
+		logger:  logger,
+		readRSS: processRSS,
+		now:     time.Now,
+	}
+}
+
+func (m *memoryProfiler) start() error {
+	if m.config.RSSLimitMiB != 0 {
+		if _, err := m.readRSS(); err != nil {
+			return fmt.Errorf("\"rss_limit_mib\" cannot be used: %w", err)
+		}
+	}
+	if err := os.MkdirAll(m.config.Directory, 0700); err != nil {
+		return err
+	}
+
+	m.stopCh = make(chan struct{})
+	m.doneCh = make(chan struct{})
+	go func() {
+		defer close(m.doneCh)
+		ticker := time.NewTicker(m.config.CheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				m.check()
+			case <-m.stopCh:
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+func (m *memoryProfiler) stop() {
+	if m.stopCh == nil {
+		return
+	}
+	close(m.stopCh)
+	<-m.doneCh
+	m.stopCh = nil
+}
+
+// check captures the profiles if the memory usage exceeds a threshold and the rate limits allow it.
+func (m *memoryProfiler) check() {
+	if m.config.MaxCaptures != 0 && m.captures >= m.config.MaxCaptures {
+		return
+	}
+	now := m.now()
+	if !m.lastCapture.IsZero() && now.Sub(m.lastCapture) < m.config.MinInterval {
+		return
+	}
+
+	usage, err := m.readUsage()
+	if err != nil {
+		m.logger.Warn("Failed to read the memory usage", zap.Error(err))
+		return
+	}
+	if !m.exceeded(usage) {
+		return
+	}
+
+	m.lastCapture = now
+	m.captures++
+	files, err := m.capture(now)
+	m.logger.Warn("Memory usage exceeded a threshold, captured profiles",
+		zap.Uint64("rss_mib", usage.rss/mibBytes),
+		zap.Uint64("heap_mib", usage.heap/mibBytes),
+		zap.Strings("files", files),
+		zap.Error(err))
+}
+
+func (m *memoryProfiler) readUsage() (memoryUsage, error) {
+	var usage memoryUsage
+	if m.config.RSSLimitMiB != 0 {
+		rss, err := m.readRSS()
+		if err != nil {
+			return usage, err
+		}
+		usage.rss = rss
+	}
+	if m.config.HeapLimitMiB != 0 {
+		var ms runtime.MemStats
+		runtime.ReadMemStats(&ms)
+		usage.heap = ms.HeapAlloc
+	}
+	return usage, nil
+}
+
+func (m *memoryProfiler) exceeded(usage memoryUsage) bool {
+	return (m.config.RSSLimitMiB != 0 && usage.rss > m.config.RSSLimitMiB*mibBytes) ||
+		(m.config.HeapLimitMiB != 0 && usage.heap > m.config.HeapLimitMiB*mibBytes)
+}
+
+// capture writes the configured profiles to the directory, and returns the names of the written files.
+func (m *memoryProfiler) capture(now time.Time) ([]string, error) {
+	timestamp := now.UTC().Format("20060102T150405.000Z")
+	var files []string
+	var errs error
+	for _, name := range m.config.Profiles {
+		file := filepath.Join(m.config.Directory, fmt.Sprintf("%s-%s.pb.gz", name, timestamp))
+		if err := writeProfile(name, file); err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+		files = append(files, file)
+	}
+	return files, errs
+}
+
+func writeProfile(name string, file string) error {
+	f, err := os.Create(filepath.Clean(file))
+	if err != nil {
+		return err
+	}
+	if err = pprof.Lookup(name).WriteTo(f, 0); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
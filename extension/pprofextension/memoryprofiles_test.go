@@ -0,0 +1,130 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pprofextension
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confignet"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
+)
+
+func newTestMemoryProfiler(t *testing.T, rss *uint64, now *time.Time) *memoryProfiler {
+	cfg := createDefaultConfig().(*Config).MemoryProfiles
+	cfg.Directory = t.TempDir()
+	cfg.RSSLimitMiB = 100
+	cfg.MaxCaptures = 2
+	require.NoError(t, cfg.Validate())
+
+	m := newMemoryProfiler(cfg, zap.NewNop())
+	m.readRSS = func() (uint64, error) { return *rss, nil }
+	m.now = func() time.Time { return *now }
+	return m
+}
+
+func capturedFiles(t *testing.T, dir string) []string {
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	var files []string
+	for _, entry := range entries {
+		files = append(files, entry.Name())
+	}
+	return files
+}
+
+func TestMemoryProfilerCapture(t *testing.T) {
+	rss := uint64(50 * mibBytes)
+	now := time.Date(2022, 11, 15, 10, 0, 0, 0, time.UTC)
+	m := newTestMemoryProfiler(t, &rss, &now)
+
+	// below the threshold
+	m.check()
+	assert.Empty(t, capturedFiles(t, m.config.Directory))
+
+	rss = 150 * mibBytes
+	m.check()
+	assert.ElementsMatch(t, []string{
+		"heap-20221115T100000.000Z.pb.gz",
+		"goroutine-20221115T100000.000Z.pb.gz",
+	}, capturedFiles(t, m.config.Directory))
+	info, err := os.Stat(filepath.Join(m.config.Directory, "heap-20221115T100000.000Z.pb.gz"))
+	require.NoError(t, err)
+	assert.NotZero(t, info.Size())
+
+	// rate limited by the min interval
+	now = now.Add(defaultMinInterval / 2)
+	m.check()
+	assert.Len(t, capturedFiles(t, m.config.Directory), 2)
+
+	now = now.Add(defaultMinInterval)
+	m.check()
+	assert.Len(t, capturedFiles(t, m.config.Directory), 4)
+
+	// limited by the max captures
+	now = now.Add(defaultMinInterval)
+	m.check()
+	assert.Len(t, capturedFiles(t, m.config.Directory), 4)
+}
+
+func TestMemoryProfilerRSSNotSupported(t *testing.T) {
+	cfg := createDefaultConfig().(*Config).MemoryProfiles
+	cfg.Directory = t.TempDir()
+	cfg.RSSLimitMiB = 100
+
+	m := newMemoryProfiler(cfg, zap.NewNop())
+	m.readRSS = func() (uint64, error) { return 0, errRSSNotSupported }
+	assert.ErrorIs(t, m.start(), errRSSNotSupported)
+}
+
+func TestProcessRSS(t *testing.T) {
+	rss, err := processRSS()
+	if runtime.GOOS != "linux" {
+		assert.ErrorIs(t, err, errRSSNotSupported)
+		return
+	}
+	require.NoError(t, err)
+	assert.NotZero(t, rss)
+}
+
+func TestPerformanceProfilerMemoryProfiles(t *testing.T) {
+	config := createDefaultConfig().(*Config)
+	config.TCPAddr = confignet.TCPAddr{Endpoint: testutil.GetAvailableLocalAddress(t)}
+	config.MemoryProfiles.Directory = filepath.Join(t.TempDir(), "profiles")
+	config.MemoryProfiles.HeapLimitMiB = 1
+	config.MemoryProfiles.CheckInterval = 10 * time.Millisecond
+	require.NoError(t, config.Validate())
+
+	// keep more than the heap limit allocated
+	ballast := make([]byte, 2*mibBytes)
+
+	pprofExt := newServer(*config, zap.NewNop())
+	require.NoError(t, pprofExt.Start(context.Background(), componenttest.NewNopHost()))
+	assert.Eventually(t, func() bool {
+		entries, err := os.ReadDir(config.MemoryProfiles.Directory)
+		return err == nil && len(entries) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, pprofExt.Shutdown(context.Background()))
+	runtime.KeepAlive(ballast)
+}
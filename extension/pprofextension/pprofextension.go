@@ -37,6 +37,8 @@ type pprofExtension struct {
 	file   *os.File
 	server http.Server
 	stopCh chan struct{}
+
+	memoryProfiler *memoryProfiler
 }
 
 func (p *pprofExtension) Start(_ context.Context, host component.Host) error {
@@ -89,7 +91,14 @@ func (p *pprofExtension) Start(_ context.Context, host component.Host) error {
 			return startErr
 		}
 		p.file = f
-		startErr = pprof.StartCPUProfile(f)
+		if startErr = pprof.StartCPUProfile(f); startErr != nil {
+			return startErr
+		}
+	}
+
+	if p.config.MemoryProfiles.Directory != "" {
+		p.memoryProfiler = newMemoryProfiler(p.config.MemoryProfiles, p.logger)
+		startErr = p.memoryProfiler.start()
 	}
 
 	return startErr
@@ -97,6 +106,9 @@ func (p *pprofExtension) Start(_ context.Context, host component.Host) error {
 
 func (p *pprofExtension) Shutdown(context.Context) error {
 	defer running.Store(false)
+	if p.memoryProfiler != nil {
+		p.memoryProfiler.stop()
+	}
 	if p.file != nil {
 		pprof.StopCPUProfile()
 		_ = p.file.Close() // ignore the error
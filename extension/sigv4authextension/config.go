@@ -15,6 +15,7 @@
 package sigv4authextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/sigv4authextension"
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -22,6 +23,8 @@ import (
 	"go.opentelemetry.io/collector/config"
 )
 
+var errNoRoleARNForWebIdentity = errors.New("assume_role::arn must be set to use a web identity token file")
+
 // Config stores the configuration for the Sigv4 Authenticator
 type Config struct {
 	config.ExtensionSettings `mapstructure:",squash"`
@@ -36,6 +39,11 @@ type AssumeRole struct {
 	ARN         string `mapstructure:"arn,omitempty"`
 	SessionName string `mapstructure:"session_name,omitempty"`
 	STSRegion   string `mapstructure:"sts_region,omitempty"`
+	// STSEndpoint overrides the regional STS endpoint of STSRegion, e.g. with a VPC endpoint.
+	STSEndpoint string `mapstructure:"sts_endpoint,omitempty"`
+	// WebIdentityTokenFile is the path of a web identity token file, such as the service account token
+	// of EKS IAM roles for service accounts, used to assume the role instead of the default credentials.
+	WebIdentityTokenFile string `mapstructure:"web_identity_token_file,omitempty"`
 }
 
 // compile time check that the Config struct satisfies the component.ExtensionConfig interface
@@ -48,6 +56,9 @@ func (cfg *Config) Validate() error {
 	if cfg.AssumeRole.STSRegion == "" && cfg.Region != "" {
 		cfg.AssumeRole.STSRegion = cfg.Region
 	}
+	if cfg.AssumeRole.WebIdentityTokenFile != "" && cfg.AssumeRole.ARN == "" {
+		return errNoRoleARNForWebIdentity
+	}
 
 	credsProvider, err := getCredsProviderFromConfig(cfg)
 	if err != nil {
@@ -65,3 +65,14 @@ func TestLoadConfigError(t *testing.T) {
 	require.NoError(t, component.UnmarshalExtensionConfig(sub, cfg))
 	assert.Error(t, cfg.Validate())
 }
+
+func TestLoadConfigWebIdentityWithoutRole(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	sub, err := cm.Sub(component.NewIDWithName(typeStr, "missing_role_arn").String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalExtensionConfig(sub, cfg))
+	assert.ErrorIs(t, cfg.Validate(), errNoRoleARNForWebIdentity)
+}
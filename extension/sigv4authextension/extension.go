@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	sigv4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
@@ -79,6 +80,9 @@ func newSigv4Extension(cfg *Config, awsSDKInfo string, logger *zap.Logger) *sigv
 	}
 }
 
+// credentialsExpiryWindow is how long before their expiration the credentials of an assumed role are refreshed.
+const credentialsExpiryWindow = time.Minute
+
 // getCredsProviderFromConfig() is a helper function that gets AWS credentials
 // from the Config.
 func getCredsProviderFromConfig(cfg *Config) (*aws.CredentialsProvider, error) {
@@ -89,10 +93,29 @@ func getCredsProviderFromConfig(cfg *Config) (*aws.CredentialsProvider, error) {
 		return nil, err
 	}
 	if cfg.AssumeRole.ARN != "" {
-		stsSvc := sts.NewFromConfig(awscfg)
+		var stsOpts []func(*sts.Options)
+		if cfg.AssumeRole.STSEndpoint != "" {
+			stsOpts = append(stsOpts, sts.WithEndpointResolver(sts.EndpointResolverFromURL(cfg.AssumeRole.STSEndpoint)))
+		}
+		stsSvc := sts.NewFromConfig(awscfg, stsOpts...)
 
-		provider := stscreds.NewAssumeRoleProvider(stsSvc, cfg.AssumeRole.ARN)
-		awscfg.Credentials = aws.NewCredentialsCache(provider)
+		var provider aws.CredentialsProvider
+		if cfg.AssumeRole.WebIdentityTokenFile != "" {
+			provider = stscreds.NewWebIdentityRoleProvider(stsSvc, cfg.AssumeRole.ARN,
+				stscreds.IdentityTokenFile(cfg.AssumeRole.WebIdentityTokenFile),
+				func(o *stscreds.WebIdentityRoleOptions) {
+					o.RoleSessionName = cfg.AssumeRole.SessionName
+				})
+		} else {
+			provider = stscreds.NewAssumeRoleProvider(stsSvc, cfg.AssumeRole.ARN,
+				func(o *stscreds.AssumeRoleOptions) {
+					o.RoleSessionName = cfg.AssumeRole.SessionName
+				})
+		}
+		// The cache refreshes the temporary credentials of the role before they expire.
+		awscfg.Credentials = aws.NewCredentialsCache(provider, func(o *aws.CredentialsCacheOptions) {
+			o.ExpiryWindow = credentialsExpiryWindow
+		})
 	}
 
 	_, err = awscfg.Credentials.Retrieve(context.Background())
@@ -16,8 +16,13 @@ package sigv4authextension
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
@@ -113,6 +118,60 @@ func TestGetCredsProviderFromConfig(t *testing.T) {
 	}
 }
 
+func TestGetCredsProviderFromConfigWithWebIdentity(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "")
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("web-identity-token"), 0600))
+
+	requests := 0
+	sts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "AssumeRoleWithWebIdentity", r.PostForm.Get("Action"))
+		assert.Equal(t, "arn:aws:iam::123456789012:role/collector", r.PostForm.Get("RoleArn"))
+		assert.Equal(t, "collector", r.PostForm.Get("RoleSessionName"))
+		assert.Equal(t, "web-identity-token", r.PostForm.Get("WebIdentityToken"))
+		// the request to assume a role with a web identity is not signed
+		assert.Empty(t, r.Header.Get("Authorization"))
+
+		w.Header().Set("Content-Type", "text/xml")
+		_, err := fmt.Fprintf(w, `<AssumeRoleWithWebIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleWithWebIdentityResult>
+    <Credentials>
+      <AccessKeyId>AssumedAccessKeyID</AccessKeyId>
+      <SecretAccessKey>AssumedSecretAccessKey</SecretAccessKey>
+      <SessionToken>AssumedSessionToken</SessionToken>
+      <Expiration>%s</Expiration>
+    </Credentials>
+  </AssumeRoleWithWebIdentityResult>
+</AssumeRoleWithWebIdentityResponse>`, time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+		assert.NoError(t, err)
+	}))
+	defer sts.Close()
+
+	cfg := &Config{Region: "region", Service: "service", AssumeRole: AssumeRole{
+		ARN:                  "arn:aws:iam::123456789012:role/collector",
+		SessionName:          "collector",
+		STSRegion:            "region",
+		STSEndpoint:          sts.URL,
+		WebIdentityTokenFile: tokenFile,
+	}}
+	credsProvider, err := getCredsProviderFromConfig(cfg)
+	require.NoError(t, err)
+	require.NotNil(t, credsProvider)
+
+	creds, err := (*credsProvider).Retrieve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "AssumedAccessKeyID", creds.AccessKeyID)
+	assert.Equal(t, "AssumedSecretAccessKey", creds.SecretAccessKey)
+	assert.Equal(t, "AssumedSessionToken", creds.SessionToken)
+	assert.True(t, creds.CanExpire)
+	// the credentials are cached until they expire
+	assert.Equal(t, 1, requests)
+}
+
 func TestCloneRequest(t *testing.T) {
 	req1, err := http.NewRequest("GET", "https://example.com", nil)
 	assert.NoError(t, err)
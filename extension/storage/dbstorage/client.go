@@ -20,6 +20,8 @@ import (
 	"errors"
 	"fmt"
 
+	// MySQL driver
+	_ "github.com/go-sql-driver/mysql"
 	// Postgres driver
 	_ "github.com/jackc/pgx/v4/stdlib"
 	// SQLite driver
@@ -27,13 +29,6 @@ import (
 	"go.opentelemetry.io/collector/extension/experimental/storage"
 )
 
-const (
-	createTable     = "create table if not exists %s (key text primary key, value blob)"
-	getQueryText    = "select value from %s where key=?"
-	setQueryText    = "insert into %s(key, value) values(?,?) on conflict(key) do update set value=?"
-	deleteQueryText = "delete from %s where key=?"
-)
-
 type dbStorageClient struct {
 	db          *sql.DB
 	getQuery    *sql.Stmt
@@ -41,22 +36,22 @@ type dbStorageClient struct {
 	deleteQuery *sql.Stmt
 }
 
-func newClient(ctx context.Context, db *sql.DB, tableName string) (*dbStorageClient, error) {
+func newClient(ctx context.Context, db *sql.DB, d dialect, tableName string) (*dbStorageClient, error) {
 	var err error
-	_, err = db.ExecContext(ctx, fmt.Sprintf(createTable, tableName))
+	_, err = db.ExecContext(ctx, fmt.Sprintf(d.createTable, tableName))
 	if err != nil {
 		return nil, err
 	}
 
-	selectQuery, err := db.PrepareContext(ctx, fmt.Sprintf(getQueryText, tableName))
+	selectQuery, err := db.PrepareContext(ctx, fmt.Sprintf(d.getQueryText, tableName))
 	if err != nil {
 		return nil, err
 	}
-	setQuery, err := db.PrepareContext(ctx, fmt.Sprintf(setQueryText, tableName))
+	setQuery, err := db.PrepareContext(ctx, fmt.Sprintf(d.setQueryText, tableName))
 	if err != nil {
 		return nil, err
 	}
-	deleteQuery, err := db.PrepareContext(ctx, fmt.Sprintf(deleteQueryText, tableName))
+	deleteQuery, err := db.PrepareContext(ctx, fmt.Sprintf(d.deleteQueryText, tableName))
 	if err != nil {
 		return nil, err
 	}
@@ -65,25 +60,12 @@ func newClient(ctx context.Context, db *sql.DB, tableName string) (*dbStorageCli
 
 // Get will retrieve data from storage that corresponds to the specified key
 func (c *dbStorageClient) Get(ctx context.Context, key string) ([]byte, error) {
-	rows, err := c.getQuery.QueryContext(ctx, key)
-	if err != nil {
-		return nil, err
-	}
-	if !rows.Next() {
-		return nil, nil
-	}
-	var result []byte
-	err = rows.Scan(&result)
-	if err != nil {
-		return result, err
-	}
-	err = rows.Close()
-	return result, err
+	return get(ctx, c.getQuery, key)
 }
 
 // Set will store data. The data can be retrieved using the same key
 func (c *dbStorageClient) Set(ctx context.Context, key string, value []byte) error {
-	_, err := c.setQuery.ExecContext(ctx, key, value, value)
+	_, err := c.setQuery.ExecContext(ctx, key, value)
 	return err
 }
 
@@ -93,26 +75,45 @@ func (c *dbStorageClient) Delete(ctx context.Context, key string) error {
 	return err
 }
 
-// Batch executes the specified operations in order. Get operation results are updated in place
+// Batch executes the specified operations in order, in a transaction. Either all or none of the
+// operations are applied. Get operation results are updated in place
 func (c *dbStorageClient) Batch(ctx context.Context, ops ...storage.Operation) error {
-	var err error
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	getQuery := tx.StmtContext(ctx, c.getQuery)
+	setQuery := tx.StmtContext(ctx, c.setQuery)
+	deleteQuery := tx.StmtContext(ctx, c.deleteQuery)
+
 	for _, op := range ops {
 		switch op.Type {
 		case storage.Get:
-			op.Value, err = c.Get(ctx, op.Key)
+			op.Value, err = get(ctx, getQuery, op.Key)
 		case storage.Set:
-			err = c.Set(ctx, op.Key, op.Value)
+			_, err = setQuery.ExecContext(ctx, op.Key, op.Value)
 		case storage.Delete:
-			err = c.Delete(ctx, op.Key)
+			_, err = deleteQuery.ExecContext(ctx, op.Key)
 		default:
-			return errors.New("wrong operation type")
+			err = errors.New("wrong operation type")
 		}
 
 		if err != nil {
+			_ = tx.Rollback()
 			return err
 		}
 	}
-	return err
+	return tx.Commit()
+}
+
+// get retrieves the value of the key with the query, or nil if the key is not found
+func get(ctx context.Context, query *sql.Stmt, key string) ([]byte, error) {
+	var result []byte
+	err := query.QueryRowContext(ctx, key).Scan(&result)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return result, err
 }
 
 // Close will close the database
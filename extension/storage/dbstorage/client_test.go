@@ -0,0 +1,128 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Skip tests on Windows temporarily, see https://github.com/open-telemetry/opentelemetry-collector-contrib/issues/11451
+//go:build !windows
+// +build !windows
+
+package dbstorage
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+)
+
+func newTestClient(t *testing.T) storage.Client {
+	ctx := context.Background()
+	se := newTestExtension(t)
+	require.NoError(t, se.Start(ctx, componenttest.NewNopHost()))
+	t.Cleanup(func() { assert.NoError(t, se.Shutdown(ctx)) })
+
+	client, err := se.GetClient(ctx, component.KindReceiver, newTestEntity("receiver"), "")
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, client.Close(ctx)) })
+	return client
+}
+
+func TestClientGetMissingKey(t *testing.T) {
+	client := newTestClient(t)
+
+	value, err := client.Get(context.Background(), "missing")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func TestClientBatch(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	require.NoError(t, client.Set(ctx, "deleted", []byte("value")))
+
+	get := storage.GetOperation("key")
+	require.NoError(t, client.Batch(ctx,
+		storage.SetOperation("key", []byte("first")),
+		storage.SetOperation("key", []byte("second")),
+		get,
+		storage.DeleteOperation("deleted"),
+	))
+	assert.Equal(t, []byte("second"), get.Value)
+
+	value, err := client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("second"), value)
+	value, err = client.Get(ctx, "deleted")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func TestClientBatchRollback(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	require.NoError(t, client.Set(ctx, "key", []byte("value")))
+
+	invalid := storage.GetOperation("other")
+	invalid.Type = 42
+	assert.Error(t, client.Batch(ctx,
+		storage.SetOperation("key", []byte("updated")),
+		storage.SetOperation("new", []byte("value")),
+		invalid,
+	))
+
+	// none of the operations of the failed batch are applied
+	value, err := client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+	value, err = client.Get(ctx, "new")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func TestClientSanitizedTableName(t *testing.T) {
+	ctx := context.Background()
+	se := newTestExtension(t)
+	require.NoError(t, se.Start(ctx, componenttest.NewNopHost()))
+	defer func() { assert.NoError(t, se.Shutdown(ctx)) }()
+
+	client, err := se.GetClient(ctx, component.KindReceiver, component.NewIDWithName("filelog", "app-1.logs"), "")
+	require.NoError(t, err)
+	require.NoError(t, client.Set(ctx, "key", []byte("value")))
+	assert.NoError(t, client.Close(ctx))
+}
+
+func TestDialects(t *testing.T) {
+	assert.Equal(t, sqliteDialect, dialectFor("custom"))
+	assert.Equal(t, sqliteDialect, dialectFor(driverSQLite))
+
+	for _, statement := range []string{
+		dialectFor(driverPostgres).getQueryText,
+		dialectFor(driverPostgres).setQueryText,
+		dialectFor(driverPostgres).deleteQueryText,
+	} {
+		assert.NotContains(t, statement, "?")
+	}
+	for _, statement := range []string{
+		dialectFor(driverMySQL).getQueryText,
+		dialectFor(driverMySQL).setQueryText,
+		dialectFor(driverMySQL).deleteQueryText,
+	} {
+		// key is a reserved word in MySQL
+		assert.True(t, strings.Contains(statement, "`key`"), statement)
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbstorage // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/dbstorage"
+
+const (
+	driverSQLite   = "sqlite3"
+	driverPostgres = "pgx"
+	driverMySQL    = "mysql"
+)
+
+// dialect holds the statements of a database, where %s is the name of the table of a client.
+// The get and delete statements take the key, and the set statement takes the key and the value.
+type dialect struct {
+	createTable     string
+	getQueryText    string
+	setQueryText    string
+	deleteQueryText string
+}
+
+var sqliteDialect = dialect{
+	createTable:     "create table if not exists %s (key text primary key, value blob)",
+	getQueryText:    "select value from %s where key=?",
+	setQueryText:    "insert into %s(key, value) values(?,?) on conflict(key) do update set value=excluded.value",
+	deleteQueryText: "delete from %s where key=?",
+}
+
+var dialects = map[string]dialect{
+	driverSQLite: sqliteDialect,
+	driverPostgres: {
+		createTable:     "create table if not exists %s (key text primary key, value bytea)",
+		getQueryText:    "select value from %s where key=$1",
+		setQueryText:    "insert into %s(key, value) values($1,$2) on conflict(key) do update set value=excluded.value",
+		deleteQueryText: "delete from %s where key=$1",
+	},
+	driverMySQL: {
+		createTable:     "create table if not exists %s (`key` varchar(255) primary key, value longblob)",
+		getQueryText:    "select value from %s where `key`=?",
+		setQueryText:    "insert into %s(`key`, value) values(?,?) on duplicate key update value=values(value)",
+		deleteQueryText: "delete from %s where `key`=?",
+	},
+}
+
+// dialectFor returns the dialect of the driver, defaulting to the SQLite one for the drivers
+// imported by implementors.
+func dialectFor(driverName string) dialect {
+	if d, ok := dialects[driverName]; ok {
+		return d
+	}
+	return sqliteDialect
+}
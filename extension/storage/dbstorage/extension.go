@@ -18,6 +18,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"go.opentelemetry.io/collector/component"
@@ -25,6 +26,8 @@ import (
 	"go.uber.org/zap"
 )
 
+var invalidTableNameChars = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
 type databaseStorage struct {
 	driverName     string
 	datasourceName string
@@ -71,7 +74,9 @@ func (ds *databaseStorage) GetClient(ctx context.Context, kind component.Kind, e
 		fullName = fmt.Sprintf("%s_%s_%s_%s", kindString(kind), ent.Type(), ent.Name(), name)
 	}
 	fullName = strings.ReplaceAll(fullName, " ", "")
-	return newClient(ctx, ds.db, fullName)
+	// component names may contain characters which are not allowed in unquoted table names, such as "-" or "."
+	fullName = invalidTableNameChars.ReplaceAllString(fullName, "_")
+	return newClient(ctx, ds.db, dialectFor(ds.driverName), fullName)
 }
 
 func kindString(k component.Kind) string {
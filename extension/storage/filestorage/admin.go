@@ -0,0 +1,260 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestorage // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/filestorage"
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+)
+
+const defaultAdminKeysLimit = 1000
+
+var (
+	errClientClosed   = errors.New("client is closed")
+	errBucketNotFound = errors.New("bucket not found")
+)
+
+// clientInfo describes the database of a client.
+type clientInfo struct {
+	Name    string       `json:"name"`
+	Path    string       `json:"path"`
+	Buckets []bucketInfo `json:"buckets"`
+}
+
+// bucketInfo describes a bucket of a database. Size is the total size of its values, in bytes.
+type bucketInfo struct {
+	Name string `json:"name"`
+	Keys int    `json:"keys"`
+	Size int    `json:"size"`
+}
+
+type keyInfo struct {
+	Key  string `json:"key"`
+	Size int    `json:"size"`
+}
+
+type keysResponse struct {
+	Client string    `json:"client"`
+	Bucket string    `json:"bucket"`
+	Keys   []keyInfo `json:"keys"`
+	// Truncated is set when the bucket has more keys than the requested limit
+	Truncated bool `json:"truncated"`
+}
+
+// info describes the database, with the number of keys and size of its buckets.
+func (c *fileStorageClient) info(name string) (clientInfo, error) {
+	c.compactionMutex.RLock()
+	defer c.compactionMutex.RUnlock()
+	if c.closed {
+		return clientInfo{}, errClientClosed
+	}
+
+	info := clientInfo{Name: name, Path: c.db.Path(), Buckets: []bucketInfo{}}
+	err := c.db.View(func(tx *bbolt.Tx) error {
+		return tx.ForEach(func(name []byte, b *bbolt.Bucket) error {
+			bucket := bucketInfo{Name: string(name)}
+			err := b.ForEach(func(_, v []byte) error {
+				bucket.Keys++
+				bucket.Size += len(v)
+				return nil
+			})
+			info.Buckets = append(info.Buckets, bucket)
+			return err
+		})
+	})
+	return info, err
+}
+
+// keys returns up to limit keys of the bucket, in byte order, with the size of their values.
+// The returned flag is set when the bucket has more keys.
+func (c *fileStorageClient) keys(bucket string, limit int) ([]keyInfo, bool, error) {
+	c.compactionMutex.RLock()
+	defer c.compactionMutex.RUnlock()
+	if c.closed {
+		return nil, false, errClientClosed
+	}
+
+	keys := []keyInfo{}
+	truncated := false
+	err := c.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(bucket))
+		if b == nil {
+			return errBucketNotFound
+		}
+		cursor := b.Cursor()
+		for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+			if len(keys) == limit {
+				truncated = true
+				break
+			}
+			keys = append(keys, keyInfo{Key: string(k), Size: len(v)})
+		}
+		return nil
+	})
+	return keys, truncated, err
+}
+
+// deleteKey deletes the key from the bucket, returning whether the key existed.
+func (c *fileStorageClient) deleteKey(bucket string, key string) (bool, error) {
+	c.compactionMutex.RLock()
+	defer c.compactionMutex.RUnlock()
+	if c.closed {
+		return false, errClientClosed
+	}
+
+	found := false
+	err := c.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(bucket))
+		if b == nil {
+			return errBucketNotFound
+		}
+		if b.Get([]byte(key)) == nil {
+			return nil
+		}
+		found = true
+		return b.Delete([]byte(key))
+	})
+	return found, err
+}
+
+// adminHandler serves the admin endpoint:
+//
+//	GET    /clients                                 lists the databases and their buckets
+//	GET    /clients/<name>?bucket=<b>&limit=<n>     lists the keys of a bucket and the size of their values
+//	DELETE /clients/<name>?bucket=<b>&key=<k>       deletes a key
+//
+// The bucket defaults to the one the clients store their data in.
+func (lfs *localFileStorage) adminHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/clients", lfs.handleClients)
+	mux.HandleFunc("/clients/", lfs.handleClient)
+	return mux
+}
+
+func (lfs *localFileStorage) handleClients(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	lfs.clientsMutex.Lock()
+	names := make([]string, 0, len(lfs.clients))
+	for name := range lfs.clients {
+		names = append(names, name)
+	}
+	lfs.clientsMutex.Unlock()
+	sort.Strings(names)
+
+	infos := []clientInfo{}
+	for _, name := range names {
+		client := lfs.client(name)
+		if client == nil {
+			continue
+		}
+		info, err := client.info(name)
+		if errors.Is(err, errClientClosed) {
+			continue
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		infos = append(infos, info)
+	}
+	writeJSON(w, lfs.logger, infos)
+}
+
+func (lfs *localFileStorage) handleClient(w http.ResponseWriter, r *http.Request) {
+	name := strings.TrimPrefix(r.URL.Path, "/clients/")
+	client := lfs.client(name)
+	if client == nil {
+		http.Error(w, "client not found", http.StatusNotFound)
+		return
+	}
+
+	query := r.URL.Query()
+	bucket := string(defaultBucket)
+	if query.Has("bucket") {
+		bucket = query.Get("bucket")
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		limit := defaultAdminKeysLimit
+		if query.Has("limit") {
+			var err error
+			if limit, err = strconv.Atoi(query.Get("limit")); err != nil || limit <= 0 {
+				http.Error(w, "limit must be a positive integer", http.StatusBadRequest)
+				return
+			}
+		}
+		keys, truncated, err := client.keys(bucket, limit)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, lfs.logger, keysResponse{Client: name, Bucket: bucket, Keys: keys, Truncated: truncated})
+	case http.MethodDelete:
+		if !query.Has("key") {
+			http.Error(w, "key must be specified", http.StatusBadRequest)
+			return
+		}
+		key := query.Get("key")
+		found, err := client.deleteKey(bucket, key)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		if !found {
+			http.Error(w, "key not found", http.StatusNotFound)
+			return
+		}
+		lfs.logger.Info("deleted key through the admin endpoint",
+			zap.String("client", name), zap.String("bucket", bucket), zap.String("key", key))
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// client returns the client of the given name, or nil if it doesn't exist.
+func (lfs *localFileStorage) client(name string) *fileStorageClient {
+	lfs.clientsMutex.Lock()
+	defer lfs.clientsMutex.Unlock()
+	return lfs.clients[name]
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, errClientClosed), errors.Is(err, errBucketNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, logger *zap.Logger, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		logger.Warn("failed to write admin endpoint response", zap.Error(err))
+	}
+}
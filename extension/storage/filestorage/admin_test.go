@@ -0,0 +1,170 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestorage
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
+)
+
+func newTestAdminStorage(t *testing.T) (*localFileStorage, *httptest.Server) {
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	cfg.Directory = t.TempDir()
+
+	ext, err := f.CreateExtension(context.Background(), componenttest.NewNopExtensionCreateSettings(), cfg)
+	require.NoError(t, err)
+	lfs := ext.(*localFileStorage)
+
+	server := httptest.NewServer(lfs.adminHandler())
+	t.Cleanup(server.Close)
+	return lfs, server
+}
+
+func doAdminRequest(t *testing.T, method string, url string, v interface{}) int {
+	req, err := http.NewRequest(method, url, nil)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	if v != nil && resp.StatusCode == http.StatusOK {
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(v))
+	}
+	return resp.StatusCode
+}
+
+func TestAdminListClients(t *testing.T) {
+	ctx := context.Background()
+	lfs, server := newTestAdminStorage(t)
+
+	receiver, err := lfs.GetClient(ctx, component.KindReceiver, newTestEntity("receiver"), "")
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, receiver.Close(ctx)) })
+	require.NoError(t, receiver.Set(ctx, "checkpoint", []byte("12345")))
+
+	exporter, err := lfs.GetClient(ctx, component.KindExporter, newTestEntity("exporter"), "queue")
+	require.NoError(t, err)
+	require.NoError(t, exporter.Set(ctx, "1", []byte("a")))
+	require.NoError(t, exporter.Set(ctx, "2", []byte("bc")))
+
+	var infos []clientInfo
+	require.Equal(t, http.StatusOK, doAdminRequest(t, http.MethodGet, server.URL+"/clients", &infos))
+	assert.Equal(t, []clientInfo{
+		{
+			Name:    "exporter_nop_exporter_queue",
+			Path:    filepath.Join(lfs.cfg.Directory, "exporter_nop_exporter_queue"),
+			Buckets: []bucketInfo{{Name: "default", Keys: 2, Size: 3}},
+		},
+		{
+			Name:    "receiver_nop_receiver",
+			Path:    filepath.Join(lfs.cfg.Directory, "receiver_nop_receiver"),
+			Buckets: []bucketInfo{{Name: "default", Keys: 1, Size: 5}},
+		},
+	}, infos)
+
+	// closed clients are not listed
+	require.NoError(t, exporter.Close(ctx))
+	require.Equal(t, http.StatusOK, doAdminRequest(t, http.MethodGet, server.URL+"/clients", &infos))
+	require.Len(t, infos, 1)
+	assert.Equal(t, "receiver_nop_receiver", infos[0].Name)
+
+	assert.Equal(t, http.StatusMethodNotAllowed, doAdminRequest(t, http.MethodPost, server.URL+"/clients", nil))
+}
+
+func TestAdminListKeys(t *testing.T) {
+	ctx := context.Background()
+	lfs, server := newTestAdminStorage(t)
+
+	client, err := lfs.GetClient(ctx, component.KindExporter, newTestEntity("exporter"), "")
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, client.Close(ctx)) })
+	require.NoError(t, client.Set(ctx, "b", []byte("bb")))
+	require.NoError(t, client.Set(ctx, "a", []byte("a")))
+	require.NoError(t, client.Set(ctx, "c", []byte("ccc")))
+
+	url := server.URL + "/clients/exporter_nop_exporter"
+
+	var resp keysResponse
+	require.Equal(t, http.StatusOK, doAdminRequest(t, http.MethodGet, url, &resp))
+	assert.Equal(t, keysResponse{
+		Client: "exporter_nop_exporter",
+		Bucket: "default",
+		Keys:   []keyInfo{{Key: "a", Size: 1}, {Key: "b", Size: 2}, {Key: "c", Size: 3}},
+	}, resp)
+
+	resp = keysResponse{}
+	require.Equal(t, http.StatusOK, doAdminRequest(t, http.MethodGet, url+"?limit=2", &resp))
+	assert.Equal(t, []keyInfo{{Key: "a", Size: 1}, {Key: "b", Size: 2}}, resp.Keys)
+	assert.True(t, resp.Truncated)
+
+	assert.Equal(t, http.StatusBadRequest, doAdminRequest(t, http.MethodGet, url+"?limit=0", nil))
+	assert.Equal(t, http.StatusNotFound, doAdminRequest(t, http.MethodGet, url+"?bucket=missing", nil))
+	assert.Equal(t, http.StatusNotFound, doAdminRequest(t, http.MethodGet, server.URL+"/clients/missing", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, doAdminRequest(t, http.MethodPut, url, nil))
+}
+
+func TestAdminDeleteKey(t *testing.T) {
+	ctx := context.Background()
+	lfs, server := newTestAdminStorage(t)
+
+	client, err := lfs.GetClient(ctx, component.KindExporter, newTestEntity("exporter"), "")
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, client.Close(ctx)) })
+	require.NoError(t, client.Set(ctx, "stuck/item", []byte("data")))
+	require.NoError(t, client.Set(ctx, "other", []byte("data")))
+
+	url := server.URL + "/clients/exporter_nop_exporter"
+
+	assert.Equal(t, http.StatusBadRequest, doAdminRequest(t, http.MethodDelete, url, nil))
+	assert.Equal(t, http.StatusNotFound, doAdminRequest(t, http.MethodDelete, url+"?key=missing", nil))
+	assert.Equal(t, http.StatusNoContent, doAdminRequest(t, http.MethodDelete, url+"?key=stuck%2Fitem", nil))
+
+	value, err := client.Get(ctx, "stuck/item")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+	value, err = client.Get(ctx, "other")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data"), value)
+
+	// deleting from a closed client fails
+	require.NoError(t, client.Close(ctx))
+	assert.Equal(t, http.StatusNotFound, doAdminRequest(t, http.MethodDelete, url+"?key=other", nil))
+}
+
+func TestAdminStartShutdown(t *testing.T) {
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	cfg.Directory = t.TempDir()
+	cfg.Admin = AdminConfig{
+		Enabled:            true,
+		HTTPServerSettings: confighttp.HTTPServerSettings{Endpoint: "localhost:0"},
+	}
+
+	ext, err := f.CreateExtension(context.Background(), componenttest.NewNopExtensionCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, ext.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NotNil(t, ext.(*localFileStorage).server)
+	require.NoError(t, ext.Shutdown(context.Background()))
+}
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/confighttp"
 )
 
 // Config defines configuration for file storage extension.
@@ -32,6 +33,8 @@ type Config struct {
 	Timeout   time.Duration `mapstructure:"timeout,omitempty"`
 
 	Compaction *CompactionConfig `mapstructure:"compaction,omitempty"`
+
+	Admin AdminConfig `mapstructure:"admin,omitempty"`
 }
 
 // CompactionConfig defines configuration for optional file storage compaction.
@@ -59,6 +62,15 @@ type CompactionConfig struct {
 	CheckInterval time.Duration `mapstructure:"check_interval,omitempty"`
 }
 
+// AdminConfig defines configuration for the optional admin endpoint.
+type AdminConfig struct {
+	// Enabled starts an HTTP server which lists the buckets, keys and value sizes of the databases
+	// in use by the components, and deletes keys from them.
+	Enabled bool `mapstructure:"enabled"`
+
+	confighttp.HTTPServerSettings `mapstructure:",squash"`
+}
+
 func (cfg *Config) Validate() error {
 	var dirs []string
 	if cfg.Compaction.OnStart {
@@ -91,5 +103,9 @@ func (cfg *Config) Validate() error {
 		return errors.New("compaction check interval must be positive when rebound compaction is set")
 	}
 
+	if cfg.Admin.Enabled && cfg.Admin.Endpoint == "" {
+		return errors.New("admin endpoint must be specified when the admin endpoint is enabled")
+	}
+
 	return nil
 }
@@ -25,6 +25,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 )
 
@@ -58,6 +59,12 @@ func TestLoadConfig(t *testing.T) {
 					CheckInterval:              time.Second * 5,
 				},
 				Timeout: 2 * time.Second,
+				Admin: AdminConfig{
+					Enabled: true,
+					HTTPServerSettings: confighttp.HTTPServerSettings{
+						Endpoint: "localhost:1777",
+					},
+				},
 			},
 		},
 	}
@@ -104,3 +111,14 @@ func TestHandleProvidingFilePathAsDirWithAnError(t *testing.T) {
 	require.Error(t, err)
 	require.EqualError(t, err, file.Name()+" is not a directory")
 }
+
+func TestHandleAdminEnabledWithoutEndpointWithAnError(t *testing.T) {
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	cfg.Directory = t.TempDir()
+	cfg.Admin.Enabled = true
+	cfg.Admin.Endpoint = ""
+
+	err := cfg.Validate()
+	require.EqualError(t, err, "admin endpoint must be specified when the admin endpoint is enabled")
+}
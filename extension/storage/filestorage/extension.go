@@ -16,8 +16,11 @@ package filestorage // import "github.com/open-telemetry/opentelemetry-collector
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"path/filepath"
+	"sync"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/extension/experimental/storage"
@@ -25,30 +28,70 @@ import (
 )
 
 type localFileStorage struct {
-	cfg    *Config
-	logger *zap.Logger
+	cfg      *Config
+	logger   *zap.Logger
+	settings component.TelemetrySettings
+
+	// clients holds the clients created for the components, keyed by database file name,
+	// for the admin endpoint to inspect them
+	clientsMutex sync.Mutex
+	clients      map[string]*fileStorageClient
+
+	server *http.Server
+	stopCh chan struct{}
 }
 
 // Ensure this storage extension implements the appropriate interface
 var _ storage.Extension = (*localFileStorage)(nil)
 
-func newLocalFileStorage(logger *zap.Logger, config *Config) (component.Extension, error) {
+func newLocalFileStorage(settings component.TelemetrySettings, config *Config) (component.Extension, error) {
 	return &localFileStorage{
-		cfg:    config,
-		logger: logger,
+		cfg:      config,
+		logger:   settings.Logger,
+		settings: settings,
+		clients:  map[string]*fileStorageClient{},
 	}, nil
 }
 
-// Start does nothing
-func (lfs *localFileStorage) Start(context.Context, component.Host) error {
+// Start starts the admin endpoint, if enabled
+func (lfs *localFileStorage) Start(_ context.Context, host component.Host) error {
+	if !lfs.cfg.Admin.Enabled {
+		return nil
+	}
+
+	lfs.logger.Info("Starting file storage admin endpoint", zap.String("endpoint", lfs.cfg.Admin.Endpoint))
+	ln, err := lfs.cfg.Admin.ToListener()
+	if err != nil {
+		return fmt.Errorf("failed to bind to address %s: %w", lfs.cfg.Admin.Endpoint, err)
+	}
+
+	lfs.server, err = lfs.cfg.Admin.ToServer(host, lfs.settings, lfs.adminHandler())
+	if err != nil {
+		return err
+	}
+
+	lfs.stopCh = make(chan struct{})
+	go func() {
+		defer close(lfs.stopCh)
+
+		// The listener ownership goes to the server.
+		if errHTTP := lfs.server.Serve(ln); !errors.Is(errHTTP, http.ErrServerClosed) && errHTTP != nil {
+			host.ReportFatalError(errHTTP)
+		}
+	}()
 	return nil
 }
 
-// Shutdown will close any open databases
+// Shutdown stops the admin endpoint
 func (lfs *localFileStorage) Shutdown(context.Context) error {
 	// TODO clean up data files that did not have a client
 	// and are older than a threshold (possibly configurable)
-	return nil
+	if lfs.server == nil {
+		return nil
+	}
+	err := lfs.server.Close()
+	<-lfs.stopCh
+	return err
 }
 
 // GetClient returns a storage client for an individual component
@@ -67,6 +110,10 @@ func (lfs *localFileStorage) GetClient(ctx context.Context, kind component.Kind,
 		return nil, err
 	}
 
+	lfs.clientsMutex.Lock()
+	lfs.clients[rawName] = client
+	lfs.clientsMutex.Unlock()
+
 	// return if compaction is not required
 	if lfs.cfg.Compaction.OnStart {
 		compactionErr := client.Compact(lfs.cfg.Compaction.Directory, lfs.cfg.Timeout, lfs.cfg.Compaction.MaxTransactionSize)
@@ -20,6 +20,7 @@ import (
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/confighttp"
 )
 
 // The value of extension "type" in configuration.
@@ -32,6 +33,9 @@ const (
 	defaultReboundTriggerThresholdMib = 10
 	defaultReboundNeededThresholdMib  = 100
 	defaultCompactionInterval         = time.Second * 5
+
+	// the admin endpoint is only reachable locally by default, as it allows deleting data
+	defaultAdminEndpoint = "localhost:13134"
 )
 
 // NewFactory creates a factory for HostObserver extension.
@@ -58,6 +62,11 @@ func createDefaultConfig() component.ExtensionConfig {
 			CheckInterval:              defaultCompactionInterval,
 		},
 		Timeout: time.Second,
+		Admin: AdminConfig{
+			HTTPServerSettings: confighttp.HTTPServerSettings{
+				Endpoint: defaultAdminEndpoint,
+			},
+		},
 	}
 }
 
@@ -66,5 +75,5 @@ func createExtension(
 	params component.ExtensionCreateSettings,
 	cfg component.ExtensionConfig,
 ) (component.Extension, error) {
-	return newLocalFileStorage(params.Logger, cfg.(*Config))
+	return newLocalFileStorage(params.TelemetrySettings, cfg.(*Config))
 }
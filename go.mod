@@ -7,7 +7,9 @@ require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awscloudwatchlogsexporter v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awsemfexporter v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awskinesisexporter v0.64.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awss3exporter v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awsxrayexporter v0.64.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/azureblobexporter v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/azuredataexplorerexporter v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/azuremonitorexporter v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/carbonexporter v0.64.0
@@ -32,8 +34,10 @@ require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/lokiexporter v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/mezmoexporter v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/opencensusexporter v0.64.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/opensearchexporter v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/parquetexporter v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusexporter v0.64.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheuspushgatewayexporter v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusremotewriteexporter v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/pulsarexporter v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sapmexporter v0.64.0
@@ -52,12 +56,14 @@ require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/extension/fluentbitextension v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/extension/headerssetterextension v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/extension/healthcheckextension v0.64.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/extension/hmacauthextension v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/extension/httpforwarder v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/extension/oauth2clientauthextension v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/ecstaskobserver v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/hostobserver v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/k8sobserver v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/extension/oidcauthextension v0.64.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/extension/opampextension v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/extension/pprofextension v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/extension/sigv4authextension v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage v0.64.0
@@ -73,6 +79,7 @@ require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8sattributesprocessor v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/metricsgenerationprocessor v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/metricstransformprocessor v0.64.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/processor/mirrorprocessor v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/probabilisticsamplerprocessor v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourceprocessor v0.64.0
@@ -94,6 +101,7 @@ require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/bigipreceiver v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/carbonreceiver v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/chronyreceiver v0.64.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/cloudflarereceiver v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/cloudfoundryreceiver v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/collectdreceiver v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/couchdbreceiver v0.64.0
@@ -104,6 +112,7 @@ require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/filelogreceiver v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/flinkmetricsreceiver v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/fluentforwardreceiver v0.64.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/gitproviderreceiver v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/googlecloudpubsubreceiver v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/googlecloudspannerreceiver v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver v0.64.0
@@ -125,6 +134,7 @@ require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mysqlreceiver v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nsxtreceiver v0.64.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ntpreceiver v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/opencensusreceiver v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/oracledbreceiver v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/otlpjsonfilereceiver v0.64.0
@@ -147,12 +157,15 @@ require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/splunkhecreceiver v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlqueryreceiver v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlserverreceiver v0.64.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sshcheckreceiver v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/statsdreceiver v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/syslogreceiver v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/tcplogreceiver v0.64.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/tlscheckreceiver v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/udplogreceiver v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/vcenterreceiver v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/wavefrontreceiver v0.64.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/webhookeventreceiver v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/windowseventlogreceiver v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/windowsperfcountersreceiver v0.64.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/zipkinreceiver v0.64.0
@@ -236,6 +249,7 @@ require (
 	github.com/GoogleCloudPlatform/opentelemetry-operations-go/exporter/trace v1.10.1 // indirect
 	github.com/GoogleCloudPlatform/opentelemetry-operations-go/internal/resourcemapping v0.34.1 // indirect
 	github.com/Microsoft/go-winio v0.5.2 // indirect
+	github.com/NVIDIA/go-nvml v0.11.6-0 // indirect
 	github.com/ReneKroon/ttlcache/v2 v2.11.0 // indirect
 	github.com/SAP/go-hdb v0.109.1 // indirect
 	github.com/SermoDigital/jose v0.9.2-0.20161205224733-f6df55f235c2 // indirect
@@ -435,6 +449,7 @@ require (
 	github.com/klauspost/compress v1.15.12 // indirect
 	github.com/knadh/koanf v1.4.4 // indirect
 	github.com/kolo/xmlrpc v0.0.0-20201022064351-38db28db192b // indirect
+	github.com/kr/fs v0.1.0 // indirect
 	github.com/kylelemons/godebug v1.1.0 // indirect
 	github.com/leodido/ragel-machinery v0.0.0-20181214104525-299bdde78165 // indirect
 	github.com/leoluk/perflib_exporter v0.2.0 // indirect
@@ -472,6 +487,7 @@ require (
 	github.com/mwitkow/go-conntrack v0.0.0-20190716064945-2f068394615f // indirect
 	github.com/nginxinc/nginx-prometheus-exporter v0.8.1-0.20201110005315-f5a5f8086c19 // indirect
 	github.com/observiq/ctimefmt v1.0.0 // indirect
+	github.com/open-telemetry/opamp-go v0.5.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer v0.64.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/awsutil v0.64.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/containerinsight v0.64.0 // indirect
@@ -485,6 +501,7 @@ require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig v0.64.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/kubelet v0.64.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/metadataproviders v0.64.0 // indirect
+	github.com/open-telemetry/opentelemetry-collector-contrib/internal/partitioner v0.64.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent v0.64.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/splunk v0.64.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/pkg/batchperresourceattr v0.64.0 // indirect
@@ -521,6 +538,7 @@ require (
 	github.com/pierrec/lz4/v4 v4.1.17 // indirect
 	github.com/pkg/browser v0.0.0-20210911075715-681adbf594b8 // indirect
 	github.com/pkg/errors v0.9.1 // indirect
+	github.com/pkg/sftp v1.13.5 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	github.com/power-devops/perfstat v0.0.0-20220216144756-c35f1ee13d7c // indirect
 	github.com/pquerna/cachecontrol v0.1.0 // indirect
@@ -683,6 +701,8 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/internal/kubel
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/internal/metadataproviders => ./internal/metadataproviders
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/internal/partitioner => ./internal/partitioner
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/internal/scrapertest => ./internal/scrapertest
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent => ./internal/sharedcomponent
@@ -697,8 +717,12 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awsem
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awskinesisexporter => ./exporter/awskinesisexporter
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awss3exporter => ./exporter/awss3exporter
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awsxrayexporter => ./exporter/awsxrayexporter
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/azureblobexporter => ./exporter/azureblobexporter
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/azuredataexplorerexporter => ./exporter/azuredataexplorerexporter
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/azuremonitorexporter => ./exporter/azuremonitorexporter
@@ -745,10 +769,14 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/mezmo
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/opencensusexporter => ./exporter/opencensusexporter
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/opensearchexporter => ./exporter/opensearchexporter
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/parquetexporter => ./exporter/parquetexporter
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusexporter => ./exporter/prometheusexporter
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheuspushgatewayexporter => ./exporter/prometheuspushgatewayexporter
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusremotewriteexporter => ./exporter/prometheusremotewriteexporter
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/pulsarexporter => ./exporter/pulsarexporter
@@ -787,6 +815,8 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/extension/head
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/extension/healthcheckextension => ./extension/healthcheckextension
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/extension/hmacauthextension => ./extension/hmacauthextension
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/extension/httpforwarder => ./extension/httpforwarder
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/extension/jaegerremotesampling => ./extension/jaegerremotesampling
@@ -803,6 +833,8 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/extension/obse
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/extension/oidcauthextension => ./extension/oidcauthextension
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/extension/opampextension => ./extension/opampextension
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/extension/pprofextension => ./extension/pprofextension
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/extension/sigv4authextension => ./extension/sigv4authextension
@@ -855,6 +887,8 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/metr
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/metricstransformprocessor => ./processor/metricstransformprocessor/
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/mirrorprocessor => ./processor/mirrorprocessor/
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/probabilisticsamplerprocessor => ./processor/probabilisticsamplerprocessor/
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor => ./processor/resourcedetectionprocessor/
@@ -899,6 +933,8 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/carbo
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/chronyreceiver => ./receiver/chronyreceiver
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/cloudflarereceiver => ./receiver/cloudflarereceiver
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/cloudfoundryreceiver => ./receiver/cloudfoundryreceiver
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/collectdreceiver => ./receiver/collectdreceiver
@@ -943,6 +979,8 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sob
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/fluentforwardreceiver => ./receiver/fluentforwardreceiver
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/gitproviderreceiver => ./receiver/gitproviderreceiver
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkametricsreceiver => ./receiver/kafkametricsreceiver
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkareceiver => ./receiver/kafkareceiver
@@ -961,6 +999,8 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginx
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nsxtreceiver => ./receiver/nsxtreceiver
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ntpreceiver => ./receiver/ntpreceiver
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/opencensusreceiver => ./receiver/opencensusreceiver
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/oracledbreceiver => ./receiver/oracledbreceiver
@@ -1005,18 +1045,24 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlqu
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlserverreceiver => ./receiver/sqlserverreceiver
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sshcheckreceiver => ./receiver/sshcheckreceiver
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/statsdreceiver => ./receiver/statsdreceiver
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/syslogreceiver => ./receiver/syslogreceiver
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/tcplogreceiver => ./receiver/tcplogreceiver
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/tlscheckreceiver => ./receiver/tlscheckreceiver
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/udplogreceiver => ./receiver/udplogreceiver
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/vcenterreceiver => ./receiver/vcenterreceiver
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/wavefrontreceiver => ./receiver/wavefrontreceiver
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/webhookeventreceiver => ./receiver/webhookeventreceiver
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/windowseventlogreceiver => ./receiver/windowseventlogreceiver
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/windowsperfcountersreceiver => ./receiver/windowsperfcountersreceiver
@@ -42,23 +42,37 @@ const (
 type Client struct {
 	svc          cloudwatchlogsiface.CloudWatchLogsAPI
 	logRetention int64
+	kmsKeyID     string
 	logger       *zap.Logger
 }
 
+// ClientOption configures optional settings of the Client.
+type ClientOption func(*Client)
+
+// WithKMSKeyID sets the ARN of the KMS key used to encrypt the log groups created by the Client.
+func WithKMSKeyID(kmsKeyID string) ClientOption {
+	return func(client *Client) {
+		client.kmsKeyID = kmsKeyID
+	}
+}
+
 // Create a log client based on the actual cloudwatch logs client.
-func newCloudWatchLogClient(svc cloudwatchlogsiface.CloudWatchLogsAPI, logRetention int64, logger *zap.Logger) *Client {
+func newCloudWatchLogClient(svc cloudwatchlogsiface.CloudWatchLogsAPI, logRetention int64, logger *zap.Logger, opts ...ClientOption) *Client {
 	logClient := &Client{svc: svc,
 		logRetention: logRetention,
 		logger:       logger}
+	for _, opt := range opts {
+		opt(logClient)
+	}
 	return logClient
 }
 
 // NewClient create Client
-func NewClient(logger *zap.Logger, awsConfig *aws.Config, buildInfo component.BuildInfo, logGroupName string, logRetention int64, sess *session.Session) *Client {
+func NewClient(logger *zap.Logger, awsConfig *aws.Config, buildInfo component.BuildInfo, logGroupName string, logRetention int64, sess *session.Session, opts ...ClientOption) *Client {
 	client := cloudwatchlogs.New(sess, awsConfig)
 	client.Handlers.Build.PushBackNamed(handler.RequestStructuredLogHandler)
 	client.Handlers.Build.PushFrontNamed(newCollectorUserAgentHandler(buildInfo, logGroupName))
-	return newCloudWatchLogClient(client, logRetention, logger)
+	return newCloudWatchLogClient(client, logRetention, logger, opts...)
 }
 
 // PutLogEvents mainly handles different possible error could be returned from server side, and retries them
@@ -154,9 +168,13 @@ func (client *Client) CreateStream(logGroup, streamName *string) (token string,
 		client.logger.Debug("cwlog_client: creating stream fail", zap.Error(err))
 		var awsErr awserr.Error
 		if errors.As(err, &awsErr) && awsErr.Code() == cloudwatchlogs.ErrCodeResourceNotFoundException {
-			_, err = client.svc.CreateLogGroup(&cloudwatchlogs.CreateLogGroupInput{
+			createLogGroupInput := &cloudwatchlogs.CreateLogGroupInput{
 				LogGroupName: logGroup,
-			})
+			}
+			if client.kmsKeyID != "" {
+				createLogGroupInput.KmsKeyId = aws.String(client.kmsKeyID)
+			}
+			_, err = client.svc.CreateLogGroup(createLogGroupInput)
 			if err == nil {
 				// For newly created log groups, set the log retention polic if specified or non-zero.  Otheriwse, set to Never Expire
 				if client.logRetention != 0 {
@@ -484,6 +484,36 @@ func TestCreateStream_CreateLogStream_ResourceNotFound(t *testing.T) {
 	assert.Equal(t, emptySequenceToken, token)
 }
 
+func TestCreateStream_CreateLogGroup_KMSKeyID(t *testing.T) {
+	logger := zap.NewNop()
+	svc := new(mockCloudWatchLogsClient)
+	kmsKeyID := "arn:aws:kms:us-east-1:123456789012:key/abcd"
+
+	resourceNotFoundException := &cloudwatchlogs.ResourceNotFoundException{}
+	svc.On("CreateLogStream",
+		&cloudwatchlogs.CreateLogStreamInput{LogGroupName: &logGroup, LogStreamName: &logStreamName}).Return(
+		new(cloudwatchlogs.CreateLogStreamOutput), resourceNotFoundException).Once()
+
+	svc.On("CreateLogGroup",
+		&cloudwatchlogs.CreateLogGroupInput{LogGroupName: &logGroup, KmsKeyId: &kmsKeyID}).Return(
+		new(cloudwatchlogs.CreateLogGroupOutput), nil).Once()
+
+	svc.On("PutRetentionPolicy",
+		&cloudwatchlogs.PutRetentionPolicyInput{LogGroupName: &logGroup, RetentionInDays: aws.Int64(30)}).Return(
+		new(cloudwatchlogs.PutRetentionPolicyOutput), nil).Once()
+
+	svc.On("CreateLogStream",
+		&cloudwatchlogs.CreateLogStreamInput{LogGroupName: &logGroup, LogStreamName: &logStreamName}).Return(
+		new(cloudwatchlogs.CreateLogStreamOutput), nil).Once()
+
+	client := newCloudWatchLogClient(svc, 30, logger, WithKMSKeyID(kmsKeyID))
+	token, err := client.CreateStream(&logGroup, &logStreamName)
+
+	svc.AssertExpectations(t)
+	assert.NoError(t, err)
+	assert.Equal(t, emptySequenceToken, token)
+}
+
 type UnknownError struct {
 	otherField string
 }
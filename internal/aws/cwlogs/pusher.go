@@ -126,7 +126,7 @@ func newEventBatch(logGroupName, logStreamName *string) *eventBatch {
 
 func (batch eventBatch) exceedsLimit(nextByteTotal int) bool {
 	return len(batch.putLogEventsInput.LogEvents) == cap(batch.putLogEventsInput.LogEvents) ||
-		batch.byteTotal+nextByteTotal > maxEventPayloadBytes
+		batch.byteTotal+nextByteTotal > maxRequestPayloadBytes
 }
 
 // isActive checks whether the eventBatch spans more than 24 hours. Returns
@@ -213,6 +213,10 @@ func TestPusher_addLogEventBatch(t *testing.T) {
 	// the actual log event add operation happens after the func newLogEventBatchIfNeeded
 	assert.Equal(t, 1, len(p.logEventBatch.putLogEventsInput.LogEvents))
 
+	p.logEventBatch.byteTotal = maxRequestPayloadBytes - logEvent.eventPayloadBytes()
+	assert.Nil(t, p.addLogEvent(logEvent))
+	assert.Equal(t, 2, len(p.logEventBatch.putLogEventsInput.LogEvents))
+
 	p.logEventBatch.byteTotal = maxRequestPayloadBytes - logEvent.eventPayloadBytes() + 1
 	assert.NotNil(t, p.addLogEvent(logEvent))
 	assert.Equal(t, 1, len(p.logEventBatch.putLogEventsInput.LogEvents))
@@ -246,6 +250,8 @@ func TestAddLogEventWithValidation(t *testing.T) {
 	}
 	assert.Equal(t, expectedTruncatedContent, *logEvent.InputLogEvent.Message)
 
+	// the truncated event leaves room in the batch for more events
 	logEvent = NewEvent(timestampMs, "")
-	assert.NotNil(t, p.addLogEvent(logEvent))
+	assert.Nil(t, p.addLogEvent(logEvent))
+	assert.Equal(t, 2, len(p.logEventBatch.putLogEventsInput.LogEvents))
 }
@@ -37,7 +37,9 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awscloudwatchlogsexporter"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awsemfexporter"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awskinesisexporter"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awss3exporter"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awsxrayexporter"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/azureblobexporter"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/azuredataexplorerexporter"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/azuremonitorexporter"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/carbonexporter"
@@ -59,8 +61,10 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/lokiexporter"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/mezmoexporter"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/opencensusexporter"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/opensearchexporter"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/parquetexporter"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusexporter"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheuspushgatewayexporter"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/pulsarexporter"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sapmexporter"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sentryexporter"
@@ -134,6 +138,14 @@ func TestDefaultExporters(t *testing.T) {
 				return cfg
 			},
 		},
+		{
+			exporter: "opensearch",
+			getConfigFn: func() component.ExporterConfig {
+				cfg := expFactories["opensearch"].CreateDefaultConfig().(*opensearchexporter.Config)
+				cfg.Endpoint = "http://" + endpoint
+				return cfg
+			},
+		},
 		{
 			exporter: "otlp",
 			getConfigFn: func() component.ExporterConfig {
@@ -168,6 +180,15 @@ func TestDefaultExporters(t *testing.T) {
 				return cfg
 			},
 		},
+		{
+			exporter: "prometheuspushgateway",
+			getConfigFn: func() component.ExporterConfig {
+				cfg := expFactories["prometheuspushgateway"].CreateDefaultConfig().(*prometheuspushgatewayexporter.Config)
+				cfg.Endpoint = "http://" + endpoint
+				cfg.Job = "test"
+				return cfg
+			},
+		},
 		{
 			exporter: "prometheusremotewrite",
 		},
@@ -250,6 +271,15 @@ func TestDefaultExporters(t *testing.T) {
 				return cfg
 			},
 		},
+		{
+			exporter: "awss3",
+			getConfigFn: func() component.ExporterConfig {
+				cfg := expFactories["awss3"].CreateDefaultConfig().(*awss3exporter.Config)
+				cfg.S3Uploader.Endpoint = "http://" + endpoint
+				cfg.S3Uploader.S3Bucket = "otel-testing"
+				return cfg
+			},
+		},
 		{
 			exporter: "awsxray",
 			getConfigFn: func() component.ExporterConfig {
@@ -259,6 +289,14 @@ func TestDefaultExporters(t *testing.T) {
 				return cfg
 			},
 		},
+		{
+			exporter: "azureblob",
+			getConfigFn: func() component.ExporterConfig {
+				cfg := expFactories["azureblob"].CreateDefaultConfig().(*azureblobexporter.Config)
+				cfg.Auth.ConnectionString = "DefaultEndpointsProtocol=http;AccountName=otel;AccountKey=b3RlbA==;BlobEndpoint=http://" + endpoint + "/otel;"
+				return cfg
+			},
+		},
 		{
 			exporter: "azuredataexplorer",
 			getConfigFn: func() component.ExporterConfig {
@@ -38,10 +38,12 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/fluentbitextension"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/headerssetterextension"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/healthcheckextension"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/hmacauthextension"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/httpforwarder"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/oauth2clientauthextension"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/ecstaskobserver"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/hostobserver"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/opampextension"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/pprofextension"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/sigv4authextension"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/dbstorage"
@@ -69,6 +71,14 @@ func TestDefaultExtensions(t *testing.T) {
 				return cfg
 			},
 		},
+		{
+			extension: "opamp",
+			getConfigFn: func() component.ExtensionConfig {
+				cfg := extFactories["opamp"].CreateDefaultConfig().(*opampextension.Config)
+				cfg.Endpoint = "http://" + endpoint + "/v1/opamp"
+				return cfg
+			},
+		},
 		{
 			extension: "pprof",
 			getConfigFn: func() component.ExtensionConfig {
@@ -218,6 +228,14 @@ func TestDefaultExtensions(t *testing.T) {
 				return cfg
 			},
 		},
+		{
+			extension: "hmacauth",
+			getConfigFn: func() component.ExtensionConfig {
+				cfg := extFactories["hmacauth"].CreateDefaultConfig().(*hmacauthextension.Config)
+				cfg.Secret = "secret"
+				return cfg
+			},
+		},
 	}
 
 	assert.Len(t, tests, len(extFactories), "All extensions must be added to the lifecycle tests")
@@ -92,6 +92,10 @@ func TestDefaultProcessors(t *testing.T) {
 		{
 			processor: "experimental_metricsgeneration",
 		},
+		{
+			processor:     "mirror",
+			skipLifecycle: true, // Requires external exporters to be configured to mirror data
+		},
 		{
 			processor: "probabilistic_sampler",
 		},
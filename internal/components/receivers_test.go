@@ -128,6 +128,9 @@ func TestDefaultReceivers(t *testing.T) {
 				return cfg
 			},
 		},
+		{
+			receiver: "cloudflare",
+		},
 		{
 			receiver: "collectd",
 		},
@@ -162,6 +165,10 @@ func TestDefaultReceivers(t *testing.T) {
 		{
 			receiver: "fluentforward",
 		},
+		{
+			receiver:     "gitprovider",
+			skipLifecyle: true, // Requires an owner and access to the API of a Git provider
+		},
 		{
 			receiver: "googlecloudspanner",
 		},
@@ -241,6 +248,9 @@ func TestDefaultReceivers(t *testing.T) {
 		{
 			receiver: "nsxt",
 		},
+		{
+			receiver: "ntp",
+		},
 		{
 			receiver:     "opencensus",
 			skipLifecyle: true, // TODO: Usage of CMux doesn't allow proper shutdown.
@@ -339,6 +349,10 @@ func TestDefaultReceivers(t *testing.T) {
 			receiver:     "sqlserver",
 			skipLifecyle: true, // Requires a running windows process
 		},
+		{
+			receiver:     "sshcheck",
+			skipLifecyle: true, // Requires credentials and a host key policy for an SSH server
+		},
 		{
 			receiver: "statsd",
 		},
@@ -346,6 +360,9 @@ func TestDefaultReceivers(t *testing.T) {
 			receiver:     "wavefront",
 			skipLifecyle: true, // Depends on carbon receiver to be running correctly
 		},
+		{
+			receiver: "webhookevent",
+		},
 		{
 			receiver:     "windowseventlog",
 			skipLifecyle: true, // Requires a running windows process
@@ -378,6 +395,9 @@ func TestDefaultReceivers(t *testing.T) {
 				return cfg
 			},
 		},
+		{
+			receiver: "tlscheck",
+		},
 		{
 			receiver: "udplog",
 			getConfigFn: func() component.ReceiverConfig {
@@ -0,0 +1,53 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pdatautil provides helper functions to build pdata values.
+package pdatautil // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/pdatautil"
+
+import (
+	"encoding/json"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// SetJSONValue sets dest to a JSON value decoded by a json.Decoder using numbers, keeping integers as integers.
+// JSON null values leave dest empty.
+func SetJSONValue(dest pcommon.Value, value interface{}) {
+	switch v := value.(type) {
+	case string:
+		dest.SetStr(v)
+	case bool:
+		dest.SetBool(v)
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			dest.SetInt(i)
+		} else if f, err := v.Float64(); err == nil {
+			dest.SetDouble(f)
+		} else {
+			dest.SetStr(v.String())
+		}
+	case map[string]interface{}:
+		m := dest.SetEmptyMap()
+		m.EnsureCapacity(len(v))
+		for key, elem := range v {
+			SetJSONValue(m.PutEmpty(key), elem)
+		}
+	case []interface{}:
+		s := dest.SetEmptySlice()
+		s.EnsureCapacity(len(v))
+		for _, elem := range v {
+			SetJSONValue(s.AppendEmpty(), elem)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pdatautil
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestSetJSONValue(t *testing.T) {
+	decoder := json.NewDecoder(strings.NewReader(
+		`{"str":"a","bool":true,"int":42,"double":1.5,"big":1e400,"null":null,"map":{"key":1},"slice":[1,"b"]}`))
+	decoder.UseNumber()
+	var value interface{}
+	require.NoError(t, decoder.Decode(&value))
+
+	dest := pcommon.NewValueEmpty()
+	SetJSONValue(dest, value)
+
+	expected := pcommon.NewMap()
+	expected.PutStr("str", "a")
+	expected.PutBool("bool", true)
+	expected.PutInt("int", 42)
+	expected.PutDouble("double", 1.5)
+	expected.PutStr("big", "1e400")
+	expected.PutEmpty("null")
+	expected.PutEmptyMap("map").PutInt("key", 1)
+	slice := expected.PutEmptySlice("slice")
+	slice.AppendEmpty().SetInt(1)
+	slice.AppendEmpty().SetStr("b")
+
+	require.Equal(t, pcommon.ValueTypeMap, dest.Type())
+	assert.Equal(t, expected.AsRaw(), dest.Map().AsRaw())
+}
@@ -212,6 +212,12 @@ func (dc *Client) Events(ctx context.Context, options dtypes.EventsOptions) (<-c
 	return dc.client.Events(ctx, options)
 }
 
+// ContainerLogs exposes the underlying Docker clients ContainerLogs stream.
+// Caller is responsible for closing the returned reader.
+func (dc *Client) ContainerLogs(ctx context.Context, cid string, options dtypes.ContainerLogsOptions) (io.ReadCloser, error) {
+	return dc.client.ContainerLogs(ctx, cid, options)
+}
+
 func (dc *Client) ContainerEventLoop(ctx context.Context) {
 	filters := dfilters.NewArgs([]dfilters.KeyValuePair{
 		{Key: "type", Value: "container"},
@@ -0,0 +1,78 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package partitioner // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/partitioner"
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// CompressionGzip compresses data with gzip.
+	CompressionGzip = "gzip"
+	// CompressionZstd compresses data with zstd.
+	CompressionZstd = "zstd"
+)
+
+// Compressor compresses an encoded record.
+type Compressor func(src []byte) []byte
+
+var zstdEncoder, _ = zstd.NewWriter(nil)
+
+var compressors = map[string]Compressor{
+	"":              noneCompress,
+	CompressionGzip: gzipCompress,
+	CompressionZstd: zstdCompress,
+}
+
+var extensions = map[string]string{
+	CompressionGzip: ".gz",
+	CompressionZstd: ".zst",
+}
+
+// NewCompressor returns the Compressor of the given compression algorithm. An empty
+// compression returns the data unchanged.
+func NewCompressor(compression string) (Compressor, error) {
+	c, ok := compressors[compression]
+	if !ok {
+		return nil, fmt.Errorf("unsupported compression %q", compression)
+	}
+	return c, nil
+}
+
+// Extension returns the file extension of the given compression algorithm, e.g. `.gz`.
+func Extension(compression string) string {
+	return extensions[compression]
+}
+
+func noneCompress(src []byte) []byte {
+	return src
+}
+
+func gzipCompress(src []byte) []byte {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	// Writing to a bytes.Buffer does not fail.
+	_, _ = w.Write(src)
+	_ = w.Close()
+	return buf.Bytes()
+}
+
+func zstdCompress(src []byte) []byte {
+	return zstdEncoder.EncodeAll(src, make([]byte, 0, len(src)))
+}
@@ -0,0 +1,419 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/partitioner/parquet"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/partitioner"
+)
+
+// The Parquet schemas have a row per span, data point and log record. Attributes and
+// other nested values are encoded as JSON strings.
+var (
+	spanSchema = []schemaField{
+		{name: "start_time", typ: typeInt64, logical: logicalTimestamp},
+		{name: "end_time", typ: typeInt64, logical: logicalTimestamp},
+		{name: "trace_id", typ: typeByteArray, logical: logicalString},
+		{name: "span_id", typ: typeByteArray, logical: logicalString},
+		{name: "parent_span_id", typ: typeByteArray, logical: logicalString, optional: true},
+		{name: "trace_state", typ: typeByteArray, logical: logicalString, optional: true},
+		{name: "name", typ: typeByteArray, logical: logicalString},
+		{name: "kind", typ: typeByteArray, logical: logicalString},
+		{name: "status_code", typ: typeByteArray, logical: logicalString},
+		{name: "status_message", typ: typeByteArray, logical: logicalString, optional: true},
+		{name: "service_name", typ: typeByteArray, logical: logicalString, optional: true},
+		{name: "resource_attributes", typ: typeByteArray, logical: logicalJSON},
+		{name: "scope_name", typ: typeByteArray, logical: logicalString},
+		{name: "scope_version", typ: typeByteArray, logical: logicalString},
+		{name: "attributes", typ: typeByteArray, logical: logicalJSON},
+		{name: "events", typ: typeByteArray, logical: logicalJSON},
+		{name: "links", typ: typeByteArray, logical: logicalJSON},
+	}
+
+	dataPointSchema = []schemaField{
+		{name: "time", typ: typeInt64, logical: logicalTimestamp},
+		{name: "start_time", typ: typeInt64, logical: logicalTimestamp, optional: true},
+		{name: "service_name", typ: typeByteArray, logical: logicalString, optional: true},
+		{name: "resource_attributes", typ: typeByteArray, logical: logicalJSON},
+		{name: "scope_name", typ: typeByteArray, logical: logicalString},
+		{name: "scope_version", typ: typeByteArray, logical: logicalString},
+		{name: "metric_name", typ: typeByteArray, logical: logicalString},
+		{name: "metric_description", typ: typeByteArray, logical: logicalString},
+		{name: "metric_unit", typ: typeByteArray, logical: logicalString},
+		{name: "metric_type", typ: typeByteArray, logical: logicalString},
+		{name: "aggregation_temporality", typ: typeByteArray, logical: logicalString, optional: true},
+		{name: "is_monotonic", typ: typeBoolean, optional: true},
+		{name: "attributes", typ: typeByteArray, logical: logicalJSON},
+		{name: "value_int", typ: typeInt64, optional: true},
+		{name: "value_double", typ: typeDouble, optional: true},
+		{name: "count", typ: typeInt64, optional: true},
+		{name: "sum", typ: typeDouble, optional: true},
+		{name: "min", typ: typeDouble, optional: true},
+		{name: "max", typ: typeDouble, optional: true},
+		{name: "bucket_counts", typ: typeByteArray, logical: logicalJSON, optional: true},
+		{name: "explicit_bounds", typ: typeByteArray, logical: logicalJSON, optional: true},
+		{name: "exponential_buckets", typ: typeByteArray, logical: logicalJSON, optional: true},
+		{name: "quantiles", typ: typeByteArray, logical: logicalJSON, optional: true},
+	}
+
+	logRecordSchema = []schemaField{
+		{name: "time", typ: typeInt64, logical: logicalTimestamp, optional: true},
+		{name: "observed_time", typ: typeInt64, logical: logicalTimestamp, optional: true},
+		{name: "trace_id", typ: typeByteArray, logical: logicalString, optional: true},
+		{name: "span_id", typ: typeByteArray, logical: logicalString, optional: true},
+		{name: "flags", typ: typeInt32},
+		{name: "severity_number", typ: typeInt32},
+		{name: "severity_text", typ: typeByteArray, logical: logicalString, optional: true},
+		{name: "body", typ: typeByteArray, logical: logicalString},
+		{name: "service_name", typ: typeByteArray, logical: logicalString, optional: true},
+		{name: "resource_attributes", typ: typeByteArray, logical: logicalJSON},
+		{name: "scope_name", typ: typeByteArray, logical: logicalString},
+		{name: "scope_version", typ: typeByteArray, logical: logicalString},
+		{name: "attributes", typ: typeByteArray, logical: logicalJSON},
+	}
+)
+
+// Marshaler encodes telemetry as Parquet files, with the column chunks compressed
+// by the configured compression.
+type Marshaler struct {
+	codec    compressionCodec
+	compress partitioner.Compressor
+}
+
+var _ ptrace.Marshaler = (*Marshaler)(nil)
+var _ pmetric.Marshaler = (*Marshaler)(nil)
+var _ plog.Marshaler = (*Marshaler)(nil)
+
+// NewMarshaler creates a Marshaler whose column chunks are compressed with the given
+// compression, `gzip`, `zstd` or none when empty.
+func NewMarshaler(compression string) (*Marshaler, error) {
+	compress, err := partitioner.NewCompressor(compression)
+	if err != nil {
+		return nil, err
+	}
+	codec := codecUncompressed
+	switch compression {
+	case partitioner.CompressionGzip:
+		codec = codecGzip
+	case partitioner.CompressionZstd:
+		codec = codecZstd
+	}
+	return &Marshaler{codec: codec, compress: compress}, nil
+}
+
+// MarshalTraces returns a Parquet file with a row per span, nil if td has no spans.
+func (m *Marshaler) MarshalTraces(td ptrace.Traces) ([]byte, error) {
+	table := newFile(spanSchema)
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		resource := rss.At(i).Resource()
+		sss := rss.At(i).ScopeSpans()
+		for j := 0; j < sss.Len(); j++ {
+			scope := sss.At(j).Scope()
+			spans := sss.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				var parentSpanID interface{}
+				if !span.ParentSpanID().IsEmpty() {
+					parentSpanID = span.ParentSpanID().HexString()
+				}
+				err := table.appendRow(
+					span.StartTimestamp(),
+					span.EndTimestamp(),
+					span.TraceID().HexString(),
+					span.SpanID().HexString(),
+					parentSpanID,
+					optionalString(span.TraceState().AsRaw()),
+					span.Name(),
+					span.Kind().String(),
+					span.Status().Code().String(),
+					optionalString(span.Status().Message()),
+					serviceName(resource),
+					jsonValue{resource.Attributes().AsRaw()},
+					scope.Name(),
+					scope.Version(),
+					jsonValue{span.Attributes().AsRaw()},
+					jsonValue{spanEvents(span.Events())},
+					jsonValue{spanLinks(span.Links())},
+				)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return m.encode(table), nil
+}
+
+// MarshalMetrics returns a Parquet file with a row per data point, nil if md has no data points.
+func (m *Marshaler) MarshalMetrics(md pmetric.Metrics) ([]byte, error) {
+	table := newFile(dataPointSchema)
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		resource := rms.At(i).Resource()
+		sms := rms.At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			scope := sms.At(j).Scope()
+			metrics := sms.At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				metric := metrics.At(k)
+				// row returns the row of a data point from its values following the attributes
+				row := func(start, ts pcommon.Timestamp, temporality, monotonic interface{}, attrs pcommon.Map, values ...interface{}) []interface{} {
+					return append([]interface{}{
+						ts,
+						optionalTimestamp(start),
+						serviceName(resource),
+						jsonValue{resource.Attributes().AsRaw()},
+						scope.Name(),
+						scope.Version(),
+						metric.Name(),
+						metric.Description(),
+						metric.Unit(),
+						metric.Type().String(),
+						temporality,
+						monotonic,
+						jsonValue{attrs.AsRaw()},
+					}, values...)
+				}
+				if err := appendMetricRows(table, metric, row); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return m.encode(table), nil
+}
+
+type metricRowFunc func(start, ts pcommon.Timestamp, temporality, monotonic interface{}, attrs pcommon.Map, values ...interface{}) []interface{}
+
+func appendMetricRows(table *file, metric pmetric.Metric, row metricRowFunc) error {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		return appendNumberRows(table, metric.Gauge().DataPoints(), nil, nil, row)
+	case pmetric.MetricTypeSum:
+		sum := metric.Sum()
+		return appendNumberRows(table, sum.DataPoints(), sum.AggregationTemporality().String(), sum.IsMonotonic(), row)
+	case pmetric.MetricTypeHistogram:
+		histogram := metric.Histogram()
+		dps := histogram.DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			err := table.appendRow(row(dp.StartTimestamp(), dp.Timestamp(), histogram.AggregationTemporality().String(), nil, dp.Attributes(),
+				nil,
+				nil,
+				int64(dp.Count()),
+				optionalDouble(dp.HasSum(), dp.Sum()),
+				optionalDouble(dp.HasMin(), dp.Min()),
+				optionalDouble(dp.HasMax(), dp.Max()),
+				jsonValue{dp.BucketCounts().AsRaw()},
+				jsonValue{dp.ExplicitBounds().AsRaw()},
+				nil,
+				nil,
+			)...)
+			if err != nil {
+				return err
+			}
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		histogram := metric.ExponentialHistogram()
+		dps := histogram.DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			buckets := map[string]interface{}{
+				"scale":      dp.Scale(),
+				"zero_count": dp.ZeroCount(),
+				"positive":   exponentialBuckets(dp.Positive()),
+				"negative":   exponentialBuckets(dp.Negative()),
+			}
+			err := table.appendRow(row(dp.StartTimestamp(), dp.Timestamp(), histogram.AggregationTemporality().String(), nil, dp.Attributes(),
+				nil,
+				nil,
+				int64(dp.Count()),
+				optionalDouble(dp.HasSum(), dp.Sum()),
+				optionalDouble(dp.HasMin(), dp.Min()),
+				optionalDouble(dp.HasMax(), dp.Max()),
+				nil,
+				nil,
+				jsonValue{buckets},
+				nil,
+			)...)
+			if err != nil {
+				return err
+			}
+		}
+	case pmetric.MetricTypeSummary:
+		dps := metric.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			quantiles := make([]map[string]float64, 0, dp.QuantileValues().Len())
+			for j := 0; j < dp.QuantileValues().Len(); j++ {
+				q := dp.QuantileValues().At(j)
+				quantiles = append(quantiles, map[string]float64{"quantile": q.Quantile(), "value": q.Value()})
+			}
+			err := table.appendRow(row(dp.StartTimestamp(), dp.Timestamp(), nil, nil, dp.Attributes(),
+				nil,
+				nil,
+				int64(dp.Count()),
+				dp.Sum(),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				jsonValue{quantiles},
+			)...)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func appendNumberRows(table *file, dps pmetric.NumberDataPointSlice, temporality, monotonic interface{}, row metricRowFunc) error {
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		var intValue, doubleValue interface{}
+		switch dp.ValueType() {
+		case pmetric.NumberDataPointValueTypeInt:
+			intValue = dp.IntValue()
+		case pmetric.NumberDataPointValueTypeDouble:
+			doubleValue = dp.DoubleValue()
+		}
+		err := table.appendRow(row(dp.StartTimestamp(), dp.Timestamp(), temporality, monotonic, dp.Attributes(),
+			intValue, doubleValue, nil, nil, nil, nil, nil, nil, nil, nil)...)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalLogs returns a Parquet file with a row per log record, nil if ld has no log records.
+func (m *Marshaler) MarshalLogs(ld plog.Logs) ([]byte, error) {
+	table := newFile(logRecordSchema)
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		resource := rls.At(i).Resource()
+		sls := rls.At(i).ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			scope := sls.At(j).Scope()
+			logs := sls.At(j).LogRecords()
+			for k := 0; k < logs.Len(); k++ {
+				lr := logs.At(k)
+				var traceID, spanID interface{}
+				if !lr.TraceID().IsEmpty() {
+					traceID = lr.TraceID().HexString()
+				}
+				if !lr.SpanID().IsEmpty() {
+					spanID = lr.SpanID().HexString()
+				}
+				err := table.appendRow(
+					optionalTimestamp(lr.Timestamp()),
+					optionalTimestamp(lr.ObservedTimestamp()),
+					traceID,
+					spanID,
+					int32(lr.Flags()),
+					int32(lr.SeverityNumber()),
+					optionalString(lr.SeverityText()),
+					lr.Body().AsString(),
+					serviceName(resource),
+					jsonValue{resource.Attributes().AsRaw()},
+					scope.Name(),
+					scope.Version(),
+					jsonValue{lr.Attributes().AsRaw()},
+				)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return m.encode(table), nil
+}
+
+func (m *Marshaler) encode(table *file) []byte {
+	if table.rows == 0 {
+		return nil
+	}
+	return table.encode(m.codec, m.compress)
+}
+
+func spanEvents(events ptrace.SpanEventSlice) []map[string]interface{} {
+	raw := make([]map[string]interface{}, 0, events.Len())
+	for i := 0; i < events.Len(); i++ {
+		event := events.At(i)
+		raw = append(raw, map[string]interface{}{
+			"time":       event.Timestamp().AsTime(),
+			"name":       event.Name(),
+			"attributes": event.Attributes().AsRaw(),
+		})
+	}
+	return raw
+}
+
+func spanLinks(links ptrace.SpanLinkSlice) []map[string]interface{} {
+	raw := make([]map[string]interface{}, 0, links.Len())
+	for i := 0; i < links.Len(); i++ {
+		link := links.At(i)
+		raw = append(raw, map[string]interface{}{
+			"trace_id":    link.TraceID().HexString(),
+			"span_id":     link.SpanID().HexString(),
+			"trace_state": link.TraceState().AsRaw(),
+			"attributes":  link.Attributes().AsRaw(),
+		})
+	}
+	return raw
+}
+
+func exponentialBuckets(buckets pmetric.ExponentialHistogramDataPointBuckets) map[string]interface{} {
+	return map[string]interface{}{
+		"offset":        buckets.Offset(),
+		"bucket_counts": buckets.BucketCounts().AsRaw(),
+	}
+}
+
+func serviceName(resource pcommon.Resource) interface{} {
+	if v, ok := resource.Attributes().Get(conventions.AttributeServiceName); ok && v.AsString() != "" {
+		return v.AsString()
+	}
+	return nil
+}
+
+func optionalString(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+func optionalTimestamp(ts pcommon.Timestamp) interface{} {
+	if ts == 0 {
+		return nil
+	}
+	return ts
+}
+
+func optionalDouble(ok bool, v float64) interface{} {
+	if !ok {
+		return nil
+	}
+	return v
+}
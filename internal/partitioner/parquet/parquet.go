@@ -0,0 +1,455 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package parquet encodes telemetry as Parquet files with a flat schema per signal. It
+// implements the subset of the Parquet format (https://github.com/apache/parquet-format)
+// needed to write flat tables: required and optional columns of primitive types, PLAIN
+// encoded in a single data page per column, in a single row group per file.
+package parquet // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/partitioner/parquet"
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/partitioner"
+)
+
+const magic = "PAR1"
+
+// physicalType is the physical type of a column.
+type physicalType int32
+
+const (
+	typeBoolean   physicalType = 0
+	typeInt32     physicalType = 1
+	typeInt64     physicalType = 2
+	typeDouble    physicalType = 5
+	typeByteArray physicalType = 6
+)
+
+// logicalType is how the physical values of a column are interpreted.
+type logicalType int
+
+const (
+	logicalNone logicalType = iota
+	logicalString
+	logicalJSON
+	// logicalTimestamp is an INT64 timestamp in nanoseconds since the Unix epoch, in UTC.
+	logicalTimestamp
+)
+
+// compressionCodec is the compression codec of the column chunks.
+type compressionCodec int32
+
+const (
+	codecUncompressed compressionCodec = 0
+	codecGzip         compressionCodec = 2
+	codecZstd         compressionCodec = 6
+)
+
+const (
+	encodingPlain = 0
+	encodingRLE   = 3
+)
+
+// schemaField describes a column of a table.
+type schemaField struct {
+	name     string
+	typ      physicalType
+	logical  logicalType
+	optional bool
+}
+
+// jsonValue is a row value encoded as a JSON string.
+type jsonValue struct {
+	v interface{}
+}
+
+// file accumulates rows before they are encoded as a Parquet file.
+type file struct {
+	fields  []schemaField
+	columns []column
+	rows    int
+}
+
+// column holds the PLAIN encoded values of a column and, for optional columns,
+// whether each row has a value.
+type column struct {
+	values  bytes.Buffer
+	bools   []bool
+	defined []bool
+}
+
+func newFile(fields []schemaField) *file {
+	return &file{
+		fields:  fields,
+		columns: make([]column, len(fields)),
+	}
+}
+
+// appendRow appends a row holding a value for each field of the table, nil for a missing
+// optional value.
+func (t *file) appendRow(values ...interface{}) error {
+	if len(values) != len(t.fields) {
+		return fmt.Errorf("parquet row has %d values, expected %d", len(values), len(t.fields))
+	}
+	for i, value := range values {
+		if err := t.appendValue(i, value); err != nil {
+			return err
+		}
+	}
+	t.rows++
+	return nil
+}
+
+func (t *file) appendValue(i int, value interface{}) error {
+	field, col := t.fields[i], &t.columns[i]
+	if v, ok := value.(jsonValue); ok {
+		encoded, err := json.Marshal(v.v)
+		if err != nil {
+			return fmt.Errorf("failed to encode parquet column %q: %w", field.name, err)
+		}
+		value = string(encoded)
+	}
+	if value == nil {
+		if !field.optional {
+			return fmt.Errorf("parquet column %q is required", field.name)
+		}
+		col.defined = append(col.defined, false)
+		return nil
+	}
+	if field.optional {
+		col.defined = append(col.defined, true)
+	}
+
+	var buf [8]byte
+	switch v := value.(type) {
+	case bool:
+		if field.typ == typeBoolean {
+			col.bools = append(col.bools, v)
+			return nil
+		}
+	case int32:
+		if field.typ == typeInt32 {
+			binary.LittleEndian.PutUint32(buf[:], uint32(v))
+			col.values.Write(buf[:4])
+			return nil
+		}
+	case int64:
+		if field.typ == typeInt64 {
+			binary.LittleEndian.PutUint64(buf[:], uint64(v))
+			col.values.Write(buf[:])
+			return nil
+		}
+	case pcommon.Timestamp:
+		if field.typ == typeInt64 {
+			binary.LittleEndian.PutUint64(buf[:], uint64(v))
+			col.values.Write(buf[:])
+			return nil
+		}
+	case float64:
+		if field.typ == typeDouble {
+			binary.LittleEndian.PutUint64(buf[:], math.Float64bits(v))
+			col.values.Write(buf[:])
+			return nil
+		}
+	case string:
+		if field.typ == typeByteArray {
+			binary.LittleEndian.PutUint32(buf[:], uint32(len(v)))
+			col.values.Write(buf[:4])
+			col.values.WriteString(v)
+			return nil
+		}
+	}
+	return fmt.Errorf("unexpected %T value for parquet column %q", value, field.name)
+}
+
+// pageData returns the content of the data page of the column: the RLE encoded definition
+// levels of optional columns followed by the values.
+func (c *column) pageData(field schemaField) []byte {
+	var buf bytes.Buffer
+	if field.optional {
+		levels := encodeDefinitionLevels(c.defined)
+		var length [4]byte
+		binary.LittleEndian.PutUint32(length[:], uint32(len(levels)))
+		buf.Write(length[:])
+		buf.Write(levels)
+	}
+	if field.typ == typeBoolean {
+		// booleans are bit packed, least significant bit first
+		packed := make([]byte, (len(c.bools)+7)/8)
+		for i, b := range c.bools {
+			if b {
+				packed[i/8] |= 1 << (i % 8)
+			}
+		}
+		buf.Write(packed)
+		return buf.Bytes()
+	}
+	buf.Write(c.values.Bytes())
+	return buf.Bytes()
+}
+
+// encodeDefinitionLevels encodes the definition levels, of bit width 1, as RLE runs of the
+// RLE/bit-packing hybrid encoding.
+func encodeDefinitionLevels(defined []bool) []byte {
+	var buf []byte
+	for i := 0; i < len(defined); {
+		j := i
+		for j < len(defined) && defined[j] == defined[i] {
+			j++
+		}
+		buf = appendUvarint(buf, uint64(j-i)<<1)
+		if defined[i] {
+			buf = append(buf, 1)
+		} else {
+			buf = append(buf, 0)
+		}
+		i = j
+	}
+	return buf
+}
+
+// encode returns the table as a Parquet file made of a single row group, whose column
+// chunks are compressed with codec by compress.
+func (t *file) encode(codec compressionCodec, compress partitioner.Compressor) []byte {
+	type chunk struct {
+		offset           int64
+		uncompressedSize int64
+		compressedSize   int64
+	}
+	var out bytes.Buffer
+	out.WriteString(magic)
+
+	chunks := make([]chunk, len(t.fields))
+	var totalSize int64
+	for i, field := range t.fields {
+		data := t.columns[i].pageData(field)
+		compressed := data
+		if codec != codecUncompressed {
+			compressed = compress(data)
+		}
+
+		header := newThriftWriter()
+		header.i32(1, 0) // DATA_PAGE
+		header.i32(2, int32(len(data)))
+		header.i32(3, int32(len(compressed)))
+		header.beginStruct(5)
+		header.i32(1, int32(t.rows))
+		header.i32(2, encodingPlain)
+		header.i32(3, encodingRLE)
+		header.i32(4, encodingRLE)
+		header.endStruct()
+		headerBytes := header.finish()
+
+		chunks[i] = chunk{
+			offset:           int64(out.Len()),
+			uncompressedSize: int64(len(headerBytes) + len(data)),
+			compressedSize:   int64(len(headerBytes) + len(compressed)),
+		}
+		totalSize += chunks[i].uncompressedSize
+		out.Write(headerBytes)
+		out.Write(compressed)
+	}
+
+	meta := newThriftWriter()
+	meta.i32(1, 1)
+	meta.list(2, thriftStruct, len(t.fields)+1)
+	meta.beginElement()
+	meta.binary(4, "schema")
+	meta.i32(5, int32(len(t.fields)))
+	meta.endStruct()
+	for _, field := range t.fields {
+		meta.beginElement()
+		meta.i32(1, int32(field.typ))
+		if field.optional {
+			meta.i32(3, 1) // OPTIONAL
+		} else {
+			meta.i32(3, 0) // REQUIRED
+		}
+		meta.binary(4, field.name)
+		switch field.logical {
+		case logicalString:
+			meta.i32(6, 0) // UTF8
+			meta.beginStruct(10)
+			meta.beginStruct(1) // STRING
+			meta.endStruct()
+			meta.endStruct()
+		case logicalJSON:
+			meta.i32(6, 19) // JSON
+			meta.beginStruct(10)
+			meta.beginStruct(12) // JSON
+			meta.endStruct()
+			meta.endStruct()
+		case logicalTimestamp:
+			meta.beginStruct(10)
+			meta.beginStruct(8) // TIMESTAMP
+			meta.bool(1, true)
+			meta.beginStruct(2)
+			meta.beginStruct(3) // NANOS
+			meta.endStruct()
+			meta.endStruct()
+			meta.endStruct()
+			meta.endStruct()
+		}
+		meta.endStruct()
+	}
+	meta.i64(3, int64(t.rows))
+	meta.list(4, thriftStruct, 1)
+	meta.beginElement()
+	meta.list(1, thriftStruct, len(t.fields))
+	for i, field := range t.fields {
+		meta.beginElement()
+		meta.i64(2, chunks[i].offset)
+		meta.beginStruct(3)
+		meta.i32(1, int32(field.typ))
+		meta.list(2, thriftI32, 2)
+		meta.i32Element(encodingPlain)
+		meta.i32Element(encodingRLE)
+		meta.list(3, thriftBinary, 1)
+		meta.binaryElement(field.name)
+		meta.i32(4, int32(codec))
+		meta.i64(5, int64(t.rows))
+		meta.i64(6, chunks[i].uncompressedSize)
+		meta.i64(7, chunks[i].compressedSize)
+		meta.i64(9, chunks[i].offset)
+		meta.endStruct()
+		meta.endStruct()
+	}
+	meta.i64(2, totalSize)
+	meta.i64(3, int64(t.rows))
+	meta.endStruct()
+	meta.binary(6, "opentelemetry-collector-contrib fileexporter")
+	footer := meta.finish()
+
+	out.Write(footer)
+	var length [4]byte
+	binary.LittleEndian.PutUint32(length[:], uint32(len(footer)))
+	out.Write(length[:])
+	out.WriteString(magic)
+	return out.Bytes()
+}
+
+// Types of the Thrift compact protocol, which encodes the Parquet metadata.
+const (
+	thriftTrue   = 1
+	thriftFalse  = 2
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+// thriftWriter encodes structs with the Thrift compact protocol.
+type thriftWriter struct {
+	buf []byte
+	// lastID is the stack of the last field ID written to each open struct.
+	lastID []int16
+}
+
+func newThriftWriter() *thriftWriter {
+	return &thriftWriter{lastID: []int16{0}}
+}
+
+// finish ends the top-level struct and returns its encoding.
+func (w *thriftWriter) finish() []byte {
+	w.endStruct()
+	return w.buf
+}
+
+func (w *thriftWriter) field(id int16, typ byte) {
+	last := &w.lastID[len(w.lastID)-1]
+	if delta := id - *last; delta > 0 && delta <= 15 {
+		w.buf = append(w.buf, byte(delta)<<4|typ)
+	} else {
+		w.buf = append(w.buf, typ)
+		w.buf = appendVarint(w.buf, int64(id))
+	}
+	*last = id
+}
+
+func (w *thriftWriter) i32(id int16, v int32) {
+	w.field(id, thriftI32)
+	w.buf = appendVarint(w.buf, int64(v))
+}
+
+func (w *thriftWriter) i64(id int16, v int64) {
+	w.field(id, thriftI64)
+	w.buf = appendVarint(w.buf, v)
+}
+
+func (w *thriftWriter) binary(id int16, v string) {
+	w.field(id, thriftBinary)
+	w.binaryElement(v)
+}
+
+func (w *thriftWriter) bool(id int16, v bool) {
+	if v {
+		w.field(id, thriftTrue)
+	} else {
+		w.field(id, thriftFalse)
+	}
+}
+
+func (w *thriftWriter) beginStruct(id int16) {
+	w.field(id, thriftStruct)
+	w.beginElement()
+}
+
+func (w *thriftWriter) endStruct() {
+	w.buf = append(w.buf, 0)
+	w.lastID = w.lastID[:len(w.lastID)-1]
+}
+
+// list begins a list field of size elements, which are written next.
+func (w *thriftWriter) list(id int16, elemType byte, size int) {
+	w.field(id, thriftList)
+	if size < 15 {
+		w.buf = append(w.buf, byte(size)<<4|elemType)
+		return
+	}
+	w.buf = append(w.buf, 0xf0|elemType)
+	w.buf = appendUvarint(w.buf, uint64(size))
+}
+
+// beginElement begins a struct element of a list, ended by endStruct.
+func (w *thriftWriter) beginElement() {
+	w.lastID = append(w.lastID, 0)
+}
+
+func (w *thriftWriter) i32Element(v int32) {
+	w.buf = appendVarint(w.buf, int64(v))
+}
+
+func (w *thriftWriter) binaryElement(v string) {
+	w.buf = appendUvarint(w.buf, uint64(len(v)))
+	w.buf = append(w.buf, v...)
+}
+
+// appendVarint appends the zigzag varint encoding of v.
+func appendVarint(buf []byte, v int64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	return append(buf, tmp[:binary.PutVarint(tmp[:], v)]...)
+}
+
+func appendUvarint(buf []byte, v uint64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	return append(buf, tmp[:binary.PutUvarint(tmp[:], v)]...)
+}
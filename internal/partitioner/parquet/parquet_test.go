@@ -0,0 +1,389 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"io"
+	"math"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/partitioner"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func TestMarshalTraces(t *testing.T) {
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("resource-attr", "resource-attr-val-1")
+	rs.Resource().Attributes().PutStr("service.name", "checkout")
+	spans := rs.ScopeSpans().AppendEmpty().Spans()
+	span := spans.AppendEmpty()
+	span.SetName("operationA")
+	span.SetStartTimestamp(1581452772000000321)
+	span.Status().SetCode(ptrace.StatusCodeError)
+	span = spans.AppendEmpty()
+	span.SetName("operationB")
+	span.SetStartTimestamp(1581452773000000789)
+	span.SetParentSpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8})
+	span.Links().AppendEmpty().Attributes().PutStr("span-link-attr", "span-link-attr-val")
+
+	for _, compression := range []string{"", partitioner.CompressionGzip, partitioner.CompressionZstd} {
+		t.Run(compression, func(t *testing.T) {
+			buf, err := newMarshaler(t, compression).MarshalTraces(td)
+			require.NoError(t, err)
+
+			file := readFile(t, buf)
+			assert.EqualValues(t, 2, file.rows)
+			names := make([]string, 0, len(spanSchema))
+			for _, field := range spanSchema {
+				names = append(names, field.name)
+			}
+			assert.Equal(t, names, file.names)
+			assert.Equal(t, []interface{}{"operationA", "operationB"}, file.column(t, "name"))
+			assert.Equal(t, []interface{}{int64(spans.At(0).StartTimestamp()), int64(spans.At(1).StartTimestamp())}, file.column(t, "start_time"))
+			assert.Equal(t, []interface{}{nil, "0102030405060708"}, file.column(t, "parent_span_id"))
+			assert.Equal(t, []interface{}{"checkout", "checkout"}, file.column(t, "service_name"))
+			assert.Equal(t, []interface{}{"Error", "Unset"}, file.column(t, "status_code"))
+			assert.Equal(t, []interface{}{
+				`{"resource-attr":"resource-attr-val-1","service.name":"checkout"}`,
+				`{"resource-attr":"resource-attr-val-1","service.name":"checkout"}`,
+			}, file.column(t, "resource_attributes"))
+			assert.Contains(t, file.column(t, "links")[1], `"span-link-attr":"span-link-attr-val"`)
+		})
+	}
+}
+
+func TestMarshalMetrics(t *testing.T) {
+	md := pmetric.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+
+	gauge := metrics.AppendEmpty()
+	gauge.SetName("gauge")
+	dp := gauge.SetEmptyGauge().DataPoints().AppendEmpty()
+	dp.SetTimestamp(10)
+	dp.SetIntValue(3)
+	dp.Attributes().PutStr("state", "idle")
+
+	sum := metrics.AppendEmpty()
+	sum.SetName("sum")
+	sum.SetEmptySum().SetIsMonotonic(true)
+	sum.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	dp = sum.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(5)
+	dp.SetTimestamp(10)
+	dp.SetDoubleValue(1.5)
+
+	histogram := metrics.AppendEmpty()
+	histogram.SetName("histogram")
+	histogram.SetEmptyHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	hdp := histogram.Histogram().DataPoints().AppendEmpty()
+	hdp.SetTimestamp(10)
+	hdp.SetCount(4)
+	hdp.SetSum(12)
+	hdp.BucketCounts().FromRaw([]uint64{1, 3})
+	hdp.ExplicitBounds().FromRaw([]float64{2.5})
+
+	summary := metrics.AppendEmpty()
+	summary.SetName("summary")
+	sdp := summary.SetEmptySummary().DataPoints().AppendEmpty()
+	sdp.SetTimestamp(10)
+	sdp.SetCount(2)
+	sdp.SetSum(7)
+	q := sdp.QuantileValues().AppendEmpty()
+	q.SetQuantile(0.5)
+	q.SetValue(3)
+
+	buf, err := newMarshaler(t, "").MarshalMetrics(md)
+	require.NoError(t, err)
+
+	file := readFile(t, buf)
+	assert.EqualValues(t, 4, file.rows)
+	assert.Equal(t, []interface{}{"gauge", "sum", "histogram", "summary"}, file.column(t, "metric_name"))
+	assert.Equal(t, []interface{}{"Gauge", "Sum", "Histogram", "Summary"}, file.column(t, "metric_type"))
+	assert.Equal(t, []interface{}{nil, int64(5), nil, nil}, file.column(t, "start_time"))
+	assert.Equal(t, []interface{}{nil, "Cumulative", "Delta", nil}, file.column(t, "aggregation_temporality"))
+	assert.Equal(t, []interface{}{nil, true, nil, nil}, file.column(t, "is_monotonic"))
+	assert.Equal(t, []interface{}{`{"state":"idle"}`, "{}", "{}", "{}"}, file.column(t, "attributes"))
+	assert.Equal(t, []interface{}{int64(3), nil, nil, nil}, file.column(t, "value_int"))
+	assert.Equal(t, []interface{}{nil, 1.5, nil, nil}, file.column(t, "value_double"))
+	assert.Equal(t, []interface{}{nil, nil, int64(4), int64(2)}, file.column(t, "count"))
+	assert.Equal(t, []interface{}{nil, nil, 12.0, 7.0}, file.column(t, "sum"))
+	assert.Equal(t, []interface{}{nil, nil, "[1,3]", nil}, file.column(t, "bucket_counts"))
+	assert.Equal(t, []interface{}{nil, nil, "[2.5]", nil}, file.column(t, "explicit_bounds"))
+	assert.Equal(t, []interface{}{nil, nil, nil, `[{"quantile":0.5,"value":3}]`}, file.column(t, "quantiles"))
+}
+
+func TestMarshalLogs(t *testing.T) {
+	ld := plog.NewLogs()
+	logs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	lr := logs.AppendEmpty()
+	lr.SetSeverityNumber(plog.SeverityNumberInfo)
+	lr.SetTraceID([16]byte{0x08, 0x04, 0x02, 0x01})
+	lr.Body().SetStr("This is a log message")
+	lr = logs.AppendEmpty()
+	lr.SetSeverityNumber(plog.SeverityNumberInfo)
+	lr.Body().SetStr("something happened")
+
+	buf, err := newMarshaler(t, partitioner.CompressionZstd).MarshalLogs(ld)
+	require.NoError(t, err)
+
+	file := readFile(t, buf)
+	assert.EqualValues(t, 2, file.rows)
+	assert.Equal(t, []interface{}{"This is a log message", "something happened"}, file.column(t, "body"))
+	assert.Equal(t, []interface{}{int64(plog.SeverityNumberInfo), int64(plog.SeverityNumberInfo)}, file.column(t, "severity_number"))
+	assert.Equal(t, []interface{}{"08040201000000000000000000000000", nil}, file.column(t, "trace_id"))
+	assert.Equal(t, []interface{}{nil, nil}, file.column(t, "service_name"))
+}
+
+func TestMarshalEmpty(t *testing.T) {
+	m := newMarshaler(t, "")
+	buf, err := m.MarshalTraces(ptrace.NewTraces())
+	require.NoError(t, err)
+	assert.Nil(t, buf)
+	buf, err = m.MarshalMetrics(pmetric.NewMetrics())
+	require.NoError(t, err)
+	assert.Nil(t, buf)
+	buf, err = m.MarshalLogs(plog.NewLogs())
+	require.NoError(t, err)
+	assert.Nil(t, buf)
+}
+
+func TestMarshalInvalidJSON(t *testing.T) {
+	ld := plog.NewLogs()
+	lr := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.Attributes().PutDouble("ratio", math.NaN())
+
+	_, err := newMarshaler(t, "").MarshalLogs(ld)
+	assert.ErrorContains(t, err, `failed to encode parquet column "attributes"`)
+}
+
+func TestTableRowErrors(t *testing.T) {
+	table := newFile([]schemaField{{name: "count", typ: typeInt64}})
+	assert.EqualError(t, table.appendRow(), "parquet row has 0 values, expected 1")
+	assert.EqualError(t, table.appendRow(nil), `parquet column "count" is required`)
+	assert.EqualError(t, table.appendRow("1"), `unexpected string value for parquet column "count"`)
+	assert.NoError(t, table.appendRow(pcommon.Timestamp(1)))
+	assert.Equal(t, 1, table.rows)
+}
+
+func TestNewMarshalerUnsupportedCompression(t *testing.T) {
+	_, err := NewMarshaler("snappy")
+	assert.EqualError(t, err, `unsupported compression "snappy"`)
+}
+
+func newMarshaler(t *testing.T, compression string) *Marshaler {
+	m, err := NewMarshaler(compression)
+	require.NoError(t, err)
+	return m
+}
+
+// decodedFile is the decoded content of a Parquet file written by file.
+type decodedFile struct {
+	buf    []byte
+	rows   int64
+	names  []string
+	fields []schemaField
+	chunks []thriftStructValue
+}
+
+type thriftStructValue map[int16]interface{}
+
+// readFile decodes the metadata of a Parquet file made of a single row group.
+func readFile(t *testing.T, buf []byte) *decodedFile {
+	require.True(t, bytes.HasPrefix(buf, []byte(magic)))
+	require.True(t, bytes.HasSuffix(buf, []byte(magic)))
+	footerLength := int(binary.LittleEndian.Uint32(buf[len(buf)-8:]))
+	footer := bytes.NewReader(buf[len(buf)-8-footerLength : len(buf)-8])
+	meta := readThriftStruct(t, footer)
+	require.Zero(t, footer.Len())
+
+	file := &decodedFile{buf: buf, rows: meta[3].(int64)}
+	schema := meta[2].([]interface{})
+	require.EqualValues(t, len(schema)-1, schema[0].(thriftStructValue)[5])
+	for _, element := range schema[1:] {
+		e := element.(thriftStructValue)
+		file.names = append(file.names, string(e[4].([]byte)))
+		file.fields = append(file.fields, schemaField{
+			name:     string(e[4].([]byte)),
+			typ:      physicalType(e[1].(int64)),
+			optional: e[3].(int64) == 1,
+		})
+	}
+	rowGroups := meta[4].([]interface{})
+	require.Len(t, rowGroups, 1)
+	require.Equal(t, file.rows, rowGroups[0].(thriftStructValue)[3])
+	for _, chunk := range rowGroups[0].(thriftStructValue)[1].([]interface{}) {
+		file.chunks = append(file.chunks, chunk.(thriftStructValue)[3].(thriftStructValue))
+	}
+	require.Len(t, file.chunks, len(file.fields))
+	return file
+}
+
+// column returns the values of the named column, nil for missing optional values.
+func (f *decodedFile) column(t *testing.T, name string) []interface{} {
+	for i, field := range f.fields {
+		if field.name != name {
+			continue
+		}
+		chunk := f.chunks[i]
+		r := bytes.NewReader(f.buf[chunk[9].(int64):])
+		header := readThriftStruct(t, r)
+		data := make([]byte, header[3].(int64))
+		_, err := io.ReadFull(r, data)
+		require.NoError(t, err)
+		data = decompressPage(t, compressionCodec(chunk[4].(int64)), data)
+		require.EqualValues(t, header[2], len(data))
+		return decodePage(t, field, int(f.rows), data)
+	}
+	t.Fatalf("no column %q", name)
+	return nil
+}
+
+func decompressPage(t *testing.T, codec compressionCodec, data []byte) []byte {
+	switch codec {
+	case codecGzip:
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		require.NoError(t, err)
+		data, err = io.ReadAll(r)
+		require.NoError(t, err)
+	case codecZstd:
+		decoder, err := zstd.NewReader(nil)
+		require.NoError(t, err)
+		data, err = decoder.DecodeAll(data, nil)
+		require.NoError(t, err)
+	}
+	return data
+}
+
+func decodePage(t *testing.T, field schemaField, rows int, data []byte) []interface{} {
+	defined := make([]bool, rows)
+	for i := range defined {
+		defined[i] = true
+	}
+	if field.optional {
+		length := binary.LittleEndian.Uint32(data)
+		levels := bytes.NewReader(data[4 : 4+length])
+		data = data[4+length:]
+		defined = defined[:0]
+		for levels.Len() > 0 {
+			header, err := binary.ReadUvarint(levels)
+			require.NoError(t, err)
+			require.Zero(t, header&1, "bit packed runs are not expected")
+			value, err := levels.ReadByte()
+			require.NoError(t, err)
+			for i := uint64(0); i < header>>1; i++ {
+				defined = append(defined, value == 1)
+			}
+		}
+		require.Len(t, defined, rows)
+	}
+
+	values := make([]interface{}, rows)
+	n := 0
+	for i := range values {
+		if !defined[i] {
+			continue
+		}
+		switch field.typ {
+		case typeBoolean:
+			values[i] = data[n/8]&(1<<(n%8)) != 0
+		case typeInt32:
+			values[i] = int64(int32(binary.LittleEndian.Uint32(data)))
+			data = data[4:]
+		case typeInt64:
+			values[i] = int64(binary.LittleEndian.Uint64(data))
+			data = data[8:]
+		case typeDouble:
+			values[i] = math.Float64frombits(binary.LittleEndian.Uint64(data))
+			data = data[8:]
+		case typeByteArray:
+			length := binary.LittleEndian.Uint32(data)
+			values[i] = string(data[4 : 4+length])
+			data = data[4+length:]
+		}
+		n++
+	}
+	if field.typ != typeBoolean {
+		require.Empty(t, data)
+	}
+	return values
+}
+
+// readThriftStruct decodes a struct encoded with the Thrift compact protocol.
+func readThriftStruct(t *testing.T, r *bytes.Reader) thriftStructValue {
+	s := thriftStructValue{}
+	var id int16
+	for {
+		b, err := r.ReadByte()
+		require.NoError(t, err)
+		if b == 0 {
+			return s
+		}
+		if delta := int16(b >> 4); delta != 0 {
+			id += delta
+		} else {
+			v, err := binary.ReadVarint(r)
+			require.NoError(t, err)
+			id = int16(v)
+		}
+		s[id] = readThriftValue(t, r, b&0x0f)
+	}
+}
+
+func readThriftValue(t *testing.T, r *bytes.Reader, typ byte) interface{} {
+	switch typ {
+	case thriftTrue:
+		return true
+	case thriftFalse:
+		return false
+	case thriftI32, thriftI64:
+		v, err := binary.ReadVarint(r)
+		require.NoError(t, err)
+		return v
+	case thriftBinary:
+		length, err := binary.ReadUvarint(r)
+		require.NoError(t, err)
+		v := make([]byte, length)
+		_, err = io.ReadFull(r, v)
+		require.NoError(t, err)
+		return v
+	case thriftList:
+		header, err := r.ReadByte()
+		require.NoError(t, err)
+		size := uint64(header >> 4)
+		if size == 15 {
+			size, err = binary.ReadUvarint(r)
+			require.NoError(t, err)
+		}
+		list := make([]interface{}, 0, size)
+		for i := uint64(0); i < size; i++ {
+			list = append(list, readThriftValue(t, r, header&0x0f))
+		}
+		return list
+	case thriftStruct:
+		return readThriftStruct(t, r)
+	}
+	t.Fatalf("unexpected thrift type %d", typ)
+	return nil
+}
@@ -0,0 +1,256 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package partitioner lays out exported telemetry into folders and objects, so that
+// exporters writing to files or object stores share the same layout semantics.
+package partitioner // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/partitioner"
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+const (
+	// GranularityDay partitions telemetry into `year=YYYY/month=MM/day=DD` folders.
+	GranularityDay = "day"
+	// GranularityHour adds an `hour=HH` folder below the day.
+	GranularityHour = "hour"
+	// GranularityMinute adds a `minute=MM` folder below the hour.
+	GranularityMinute = "minute"
+
+	// unknownValue is the folder value used for resources missing a partitioning attribute.
+	unknownValue = "unknown"
+)
+
+var granularities = map[string]time.Duration{
+	GranularityDay:    24 * time.Hour,
+	GranularityHour:   time.Hour,
+	GranularityMinute: time.Minute,
+}
+
+// Config defines how telemetry is partitioned.
+type Config struct {
+	// TimeGranularity is the size of the time buckets telemetry is partitioned into,
+	// based on the time it is exported. One of `day`, `hour` or `minute`; when empty
+	// telemetry is not partitioned by time.
+	TimeGranularity string `mapstructure:"time_granularity"`
+
+	// ResourceAttributes are the resource attributes telemetry is partitioned by, each
+	// one adding an `<attribute>=<value>` folder in the given order.
+	ResourceAttributes []string `mapstructure:"resource_attributes"`
+
+	// MaxSize is the maximum size in bytes of an object before a new one is started.
+	// A record larger than MaxSize is written to an object of its own. Zero means no limit.
+	MaxSize int `mapstructure:"max_size"`
+}
+
+// Validate checks if the partitioning configuration is valid.
+func (cfg *Config) Validate() error {
+	if _, ok := granularities[cfg.TimeGranularity]; cfg.TimeGranularity != "" && !ok {
+		return fmt.Errorf("unsupported time_granularity %q", cfg.TimeGranularity)
+	}
+	for _, attr := range cfg.ResourceAttributes {
+		if attr == "" {
+			return errors.New("resource_attributes must not contain empty attribute names")
+		}
+	}
+	if cfg.MaxSize < 0 {
+		return errors.New("max_size must not be negative")
+	}
+	return nil
+}
+
+// Partitioner assigns telemetry to folders, called prefixes, and the records written
+// under a prefix to numbered objects. It is safe for concurrent use.
+type Partitioner struct {
+	granularity time.Duration
+	attributes  []string
+	maxSize     int
+
+	mu      sync.Mutex
+	objects map[string]*object
+}
+
+// object is the state of the object currently written for a prefix and name.
+type object struct {
+	seq    int
+	size   int
+	bucket time.Time
+}
+
+// New creates a Partitioner for a valid Config.
+func New(cfg Config) *Partitioner {
+	return &Partitioner{
+		granularity: granularities[cfg.TimeGranularity],
+		attributes:  cfg.ResourceAttributes,
+		maxSize:     cfg.MaxSize,
+		objects:     map[string]*object{},
+	}
+}
+
+// Prefix returns the folder that telemetry of the given resource exported at t is written
+// to, for example `service.name=checkout/year=2022/month=11/day=15/hour=09`. Resource
+// folders come first, followed by the time bucket in UTC. It returns an empty string
+// when no partitioning is configured.
+func (p *Partitioner) Prefix(resource pcommon.Resource, t time.Time) string {
+	var segments []string
+	for _, attr := range p.attributes {
+		value := unknownValue
+		if v, ok := resource.Attributes().Get(attr); ok && v.AsString() != "" {
+			value = sanitize(v.AsString())
+		}
+		segments = append(segments, sanitize(attr)+"="+value)
+	}
+
+	if p.granularity == 0 {
+		return path.Join(segments...)
+	}
+	t = t.UTC()
+	segments = append(segments,
+		fmt.Sprintf("year=%04d", t.Year()),
+		fmt.Sprintf("month=%02d", t.Month()),
+		fmt.Sprintf("day=%02d", t.Day()))
+	if p.granularity <= time.Hour {
+		segments = append(segments, fmt.Sprintf("hour=%02d", t.Hour()))
+	}
+	if p.granularity <= time.Minute {
+		segments = append(segments, fmt.Sprintf("minute=%02d", t.Minute()))
+	}
+	return path.Join(segments...)
+}
+
+// sanitize replaces the characters which would otherwise add folders to a prefix.
+func sanitize(s string) string {
+	return strings.NewReplacer("/", "_", `\`, "_").Replace(s)
+}
+
+// SplitTraces groups the resource spans of td by their prefix.
+func (p *Partitioner) SplitTraces(td ptrace.Traces, t time.Time) map[string]ptrace.Traces {
+	if p.unpartitioned() {
+		return map[string]ptrace.Traces{"": td}
+	}
+	split := map[string]ptrace.Traces{}
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		prefix := p.Prefix(rs.Resource(), t)
+		dest, ok := split[prefix]
+		if !ok {
+			dest = ptrace.NewTraces()
+			split[prefix] = dest
+		}
+		rs.CopyTo(dest.ResourceSpans().AppendEmpty())
+	}
+	return split
+}
+
+// SplitMetrics groups the resource metrics of md by their prefix.
+func (p *Partitioner) SplitMetrics(md pmetric.Metrics, t time.Time) map[string]pmetric.Metrics {
+	if p.unpartitioned() {
+		return map[string]pmetric.Metrics{"": md}
+	}
+	split := map[string]pmetric.Metrics{}
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		prefix := p.Prefix(rm.Resource(), t)
+		dest, ok := split[prefix]
+		if !ok {
+			dest = pmetric.NewMetrics()
+			split[prefix] = dest
+		}
+		rm.CopyTo(dest.ResourceMetrics().AppendEmpty())
+	}
+	return split
+}
+
+// SplitLogs groups the resource logs of ld by their prefix.
+func (p *Partitioner) SplitLogs(ld plog.Logs, t time.Time) map[string]plog.Logs {
+	if p.unpartitioned() {
+		return map[string]plog.Logs{"": ld}
+	}
+	split := map[string]plog.Logs{}
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		prefix := p.Prefix(rl.Resource(), t)
+		dest, ok := split[prefix]
+		if !ok {
+			dest = plog.NewLogs()
+			split[prefix] = dest
+		}
+		rl.CopyTo(dest.ResourceLogs().AppendEmpty())
+	}
+	return split
+}
+
+func (p *Partitioner) unpartitioned() bool {
+	return p.granularity == 0 && len(p.attributes) == 0
+}
+
+// Object returns the path of the object a record of the given size, written under
+// prefix at t, belongs to, e.g. `<prefix>/<name>-<seq><ext>`. The sequence number starts
+// at 0 and is incremented whenever adding the record would make the current object
+// exceed the configured maximum size.
+func (p *Partitioner) Object(prefix, name, ext string, size int, t time.Time) string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := path.Join(prefix, name+ext)
+	bucket := p.bucket(t)
+	p.evict(bucket)
+
+	obj, ok := p.objects[key]
+	if !ok {
+		obj = &object{bucket: bucket}
+		p.objects[key] = obj
+	}
+	if p.maxSize > 0 && obj.size > 0 && obj.size+size > p.maxSize {
+		obj.seq++
+		obj.size = 0
+	}
+	obj.size += size
+	return path.Join(prefix, fmt.Sprintf("%s-%d%s", name, obj.seq, ext))
+}
+
+func (p *Partitioner) bucket(t time.Time) time.Time {
+	if p.granularity == 0 {
+		return time.Time{}
+	}
+	return t.UTC().Truncate(p.granularity)
+}
+
+// evict forgets the objects of time buckets older than the one preceding bucket. The
+// preceding bucket is kept since telemetry split just before a bucket ends may still
+// be written after it.
+func (p *Partitioner) evict(bucket time.Time) {
+	if p.granularity == 0 {
+		return
+	}
+	oldest := bucket.Add(-p.granularity)
+	for key, obj := range p.objects {
+		if obj.bucket.Before(oldest) {
+			delete(p.objects, key)
+		}
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package partitioner
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+var exportTime = time.Date(2022, 11, 15, 9, 4, 30, 0, time.UTC)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  Config
+		err  string
+	}{
+		{name: "empty"},
+		{name: "valid", cfg: Config{TimeGranularity: GranularityMinute, ResourceAttributes: []string{"service.name"}, MaxSize: 1024}},
+		{name: "granularity", cfg: Config{TimeGranularity: "week"}, err: `unsupported time_granularity "week"`},
+		{name: "attribute", cfg: Config{ResourceAttributes: []string{""}}, err: "resource_attributes must not contain empty attribute names"},
+		{name: "max_size", cfg: Config{MaxSize: -1}, err: "max_size must not be negative"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestPrefix(t *testing.T) {
+	resource := pcommon.NewResource()
+	resource.Attributes().PutStr("service.name", "checkout")
+	resource.Attributes().PutStr("k8s.namespace.name", "shop/prod")
+	resource.Attributes().PutInt("shard", 3)
+
+	tests := []struct {
+		name string
+		cfg  Config
+		want string
+	}{
+		{name: "none", want: ""},
+		{name: "day", cfg: Config{TimeGranularity: GranularityDay}, want: "year=2022/month=11/day=15"},
+		{name: "hour", cfg: Config{TimeGranularity: GranularityHour}, want: "year=2022/month=11/day=15/hour=09"},
+		{name: "minute", cfg: Config{TimeGranularity: GranularityMinute}, want: "year=2022/month=11/day=15/hour=09/minute=04"},
+		{
+			name: "attributes",
+			cfg:  Config{ResourceAttributes: []string{"service.name", "shard"}},
+			want: "service.name=checkout/shard=3",
+		},
+		{
+			name: "missing and sanitized attributes",
+			cfg:  Config{ResourceAttributes: []string{"host.name", "k8s.namespace.name"}},
+			want: "host.name=unknown/k8s.namespace.name=shop_prod",
+		},
+		{
+			name: "attributes and time",
+			cfg:  Config{TimeGranularity: GranularityDay, ResourceAttributes: []string{"service.name"}},
+			want: "service.name=checkout/year=2022/month=11/day=15",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, New(tt.cfg).Prefix(resource, exportTime))
+		})
+	}
+}
+
+func TestPrefixUsesUTC(t *testing.T) {
+	p := New(Config{TimeGranularity: GranularityHour})
+	local := exportTime.In(time.FixedZone("UTC+10", 10*60*60))
+	assert.Equal(t, "year=2022/month=11/day=15/hour=09", p.Prefix(pcommon.NewResource(), local))
+}
+
+func TestSplitTraces(t *testing.T) {
+	td := ptrace.NewTraces()
+	for _, service := range []string{"checkout", "cart", "checkout"} {
+		rs := td.ResourceSpans().AppendEmpty()
+		rs.Resource().Attributes().PutStr("service.name", service)
+		rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName(service)
+	}
+
+	split := New(Config{ResourceAttributes: []string{"service.name"}}).SplitTraces(td, exportTime)
+	require.Len(t, split, 2)
+	assert.Equal(t, 2, split["service.name=checkout"].SpanCount())
+	assert.Equal(t, 1, split["service.name=cart"].SpanCount())
+
+	split = New(Config{}).SplitTraces(td, exportTime)
+	require.Len(t, split, 1)
+	assert.Equal(t, td, split[""])
+}
+
+func TestSplitMetrics(t *testing.T) {
+	md := pmetric.NewMetrics()
+	for _, service := range []string{"checkout", "cart", "checkout"} {
+		rm := md.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().PutStr("service.name", service)
+		rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName(service)
+	}
+
+	split := New(Config{ResourceAttributes: []string{"service.name"}}).SplitMetrics(md, exportTime)
+	require.Len(t, split, 2)
+	assert.Equal(t, 2, split["service.name=checkout"].MetricCount())
+	assert.Equal(t, 1, split["service.name=cart"].MetricCount())
+}
+
+func TestSplitLogs(t *testing.T) {
+	ld := plog.NewLogs()
+	for _, service := range []string{"checkout", "cart", "checkout"} {
+		rl := ld.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("service.name", service)
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr(service)
+	}
+
+	split := New(Config{TimeGranularity: GranularityDay, ResourceAttributes: []string{"service.name"}}).SplitLogs(ld, exportTime)
+	require.Len(t, split, 2)
+	assert.Equal(t, 2, split["service.name=checkout/year=2022/month=11/day=15"].LogRecordCount())
+	assert.Equal(t, 1, split["service.name=cart/year=2022/month=11/day=15"].LogRecordCount())
+}
+
+func TestObject(t *testing.T) {
+	p := New(Config{MaxSize: 10})
+	assert.Equal(t, "a/traces-0.json", p.Object("a", "traces", ".json", 6, exportTime))
+	assert.Equal(t, "a/traces-0.json", p.Object("a", "traces", ".json", 4, exportTime))
+	assert.Equal(t, "a/traces-1.json", p.Object("a", "traces", ".json", 1, exportTime))
+	// records larger than the maximum size get an object of their own
+	assert.Equal(t, "a/traces-2.json", p.Object("a", "traces", ".json", 20, exportTime))
+	assert.Equal(t, "a/traces-3.json", p.Object("a", "traces", ".json", 1, exportTime))
+	// objects are numbered per prefix and name
+	assert.Equal(t, "b/traces-0.json", p.Object("b", "traces", ".json", 6, exportTime))
+	assert.Equal(t, "a/logs-0.json", p.Object("a", "logs", ".json", 6, exportTime))
+	assert.Equal(t, "traces-0.json", p.Object("", "traces", ".json", 6, exportTime))
+
+	unlimited := New(Config{})
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, "traces-0.json.gz", unlimited.Object("", "traces", ".json.gz", 1<<20, exportTime))
+	}
+}
+
+func TestObjectEviction(t *testing.T) {
+	p := New(Config{TimeGranularity: GranularityMinute, MaxSize: 10})
+	first := p.Prefix(pcommon.NewResource(), exportTime)
+	assert.Equal(t, first+"/traces-0", p.Object(first, "traces", "", 10, exportTime))
+	assert.Equal(t, first+"/traces-1", p.Object(first, "traces", "", 10, exportTime))
+
+	// the objects of the preceding bucket are kept
+	next := exportTime.Add(time.Minute)
+	p.Object(p.Prefix(pcommon.NewResource(), next), "traces", "", 10, next)
+	assert.Equal(t, first+"/traces-2", p.Object(first, "traces", "", 10, exportTime))
+	assert.Len(t, p.objects, 2)
+
+	// older ones are forgotten
+	later := exportTime.Add(2 * time.Minute)
+	p.Object(p.Prefix(pcommon.NewResource(), later), "traces", "", 10, later)
+	assert.Len(t, p.objects, 2)
+}
+
+func TestCompressor(t *testing.T) {
+	data := []byte(`{"resourceSpans":[]}`)
+
+	none, err := NewCompressor("")
+	require.NoError(t, err)
+	assert.Equal(t, data, none(data))
+	assert.Equal(t, "", Extension(""))
+
+	gz, err := NewCompressor(CompressionGzip)
+	require.NoError(t, err)
+	r, err := gzip.NewReader(bytes.NewReader(gz(data)))
+	require.NoError(t, err)
+	got, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, data, got)
+	assert.Equal(t, ".gz", Extension(CompressionGzip))
+
+	zs, err := NewCompressor(CompressionZstd)
+	require.NoError(t, err)
+	decoder, err := zstd.NewReader(nil)
+	require.NoError(t, err)
+	got, err = decoder.DecodeAll(zs(data), nil)
+	require.NoError(t, err)
+	assert.Equal(t, data, got)
+	assert.Equal(t, ".zst", Extension(CompressionZstd))
+
+	_, err = NewCompressor("snappy")
+	assert.EqualError(t, err, `unsupported compression "snappy"`)
+}
@@ -0,0 +1,171 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottl // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+
+import (
+	containerlist "container/list"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// maxCacheSize bounds the number of statements held by each cache, so that the statements of parsers which are
+// no longer used, e.g. after the configuration is reloaded, are eventually evicted.
+const maxCacheSize = 1000
+
+// grammarCache caches the grammar of the statements by their text. The grammar of a statement does not depend
+// on the context it is used in and is never modified once parsed, so it is shared by all the parsers.
+var grammarCache = newLRUCache[string, *parsedStatement](maxCacheSize)
+
+// statementCache caches the statements compiled by the parsers using WithStatementCache, keyed by
+// statementCacheKey. The values are *Statement[K] of the TransformContext type of the context.
+var statementCache = newLRUCache[statementCacheKey, interface{}](maxCacheSize)
+
+type statementCacheKey struct {
+	context   string
+	statement string
+}
+
+// Option configures a Parser.
+type Option[K any] func(*Parser[K])
+
+// WithStatementCache makes the Parser share the statements it compiles through a bounded package-level cache, keyed by the
+// statement text and the context of the Parser: its TransformContext type, its path and enum parsers and its
+// functions. Identical statements parsed in the same context, e.g. by several pipelines or processors, are then
+// compiled once and the same Statement is returned to all of them.
+//
+// The functions and the path and enum parsers are identified by their code, so they must not be closures capturing
+// state that changes the statements they build. A Statement is shared across goroutines, so the functions must also
+// return expressions that are safe for concurrent use. When one of the functions accepts the
+// component.TelemetrySettings of the Parser, the statements are only shared by the parsers using the same logger.
+func WithStatementCache[K any]() Option[K] {
+	return func(p *Parser[K]) {
+		p.cacheContext = p.statementCacheContext()
+	}
+}
+
+// statementCacheContext returns the identity of the context of the statements compiled by the Parser.
+func (p *Parser[K]) statementCacheContext() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%v|%x|%x", reflect.TypeOf((*K)(nil)).Elem(), funcPointer(p.pathParser), funcPointer(p.enumParser))
+
+	names := make([]string, 0, len(p.functions))
+	for name := range p.functions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	usesTelemetry := false
+	for _, name := range names {
+		f := p.functions[name]
+		fmt.Fprintf(&b, "|%s=%x", name, funcPointer(f))
+		if fType := reflect.TypeOf(f); fType != nil && fType.Kind() == reflect.Func {
+			for i := 0; i < fType.NumIn(); i++ {
+				if fType.In(i).Name() == "TelemetrySettings" {
+					usesTelemetry = true
+				}
+			}
+		}
+	}
+	if usesTelemetry {
+		fmt.Fprintf(&b, "|%p", p.telemetrySettings.Logger)
+	}
+	return b.String()
+}
+
+func funcPointer(f interface{}) uintptr {
+	v := reflect.ValueOf(f)
+	if v.Kind() != reflect.Func {
+		return 0
+	}
+	return v.Pointer()
+}
+
+// cachedStatement returns the statement compiled by compile, sharing it with the other parsers of the same context
+// when the Parser uses WithStatementCache. Statements failing to compile are not cached.
+func (p *Parser[K]) cachedStatement(statement string, compile func(string) (*Statement[K], error)) (*Statement[K], error) {
+	if p.cacheContext == "" {
+		return compile(statement)
+	}
+	key := statementCacheKey{context: p.cacheContext, statement: statement}
+	if cached, ok := statementCache.get(key); ok {
+		return cached.(*Statement[K]), nil
+	}
+	compiled, err := compile(statement)
+	if err != nil {
+		return nil, err
+	}
+	return statementCache.add(key, compiled).(*Statement[K]), nil
+}
+
+// lruCache holds up to size values, evicting the least recently used one when it is full.
+type lruCache[K comparable, V any] struct {
+	lock    sync.Mutex
+	size    int
+	entries map[K]*containerlist.Element
+	// order holds the lruEntry of the values, the most recently used first.
+	order *containerlist.List
+}
+
+type lruEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+func newLRUCache[K comparable, V any](size int) *lruCache[K, V] {
+	return &lruCache[K, V]{
+		size:    size,
+		entries: make(map[K]*containerlist.Element),
+		order:   containerlist.New(),
+	}
+}
+
+// get returns the value of the key, if it is cached.
+func (c *lruCache[K, V]) get(key K) (V, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if elem, ok := c.entries[key]; ok {
+		c.order.MoveToFront(elem)
+		return elem.Value.(*lruEntry[K, V]).value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// add caches the value of the key, unless a value was cached concurrently, and returns the cached value.
+func (c *lruCache[K, V]) add(key K, value V) V {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if elem, ok := c.entries[key]; ok {
+		c.order.MoveToFront(elem)
+		return elem.Value.(*lruEntry[K, V]).value
+	}
+	c.entries[key] = c.order.PushFront(&lruEntry[K, V]{key: key, value: value})
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*lruEntry[K, V]).key)
+	}
+	return value
+}
+
+// len returns the number of cached values.
+func (c *lruCache[K, V]) len() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.order.Len()
+}
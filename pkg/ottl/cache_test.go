@@ -0,0 +1,122 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.uber.org/zap"
+)
+
+func goodbye[K any]() (ExprFunc[K], error) {
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		return "goodbye", nil
+	}, nil
+}
+
+func Test_parseStatement_cached(t *testing.T) {
+	first, err := parseStatement(`hello() where name == "cached"`)
+	require.NoError(t, err)
+	second, err := parseStatement(`hello() where name == "cached"`)
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+
+	_, err = parseStatement(`hello( where`)
+	assert.Error(t, err)
+	_, ok := grammarCache.get(`hello( where`)
+	assert.False(t, ok)
+}
+
+func Test_lruCache(t *testing.T) {
+	cache := newLRUCache[string, int](2)
+	assert.Equal(t, 1, cache.add("one", 1))
+	assert.Equal(t, 2, cache.add("two", 2))
+	assert.Equal(t, 1, cache.add("one", 10), "the cached value is kept")
+
+	// "two" is the least recently used
+	assert.Equal(t, 3, cache.add("three", 3))
+	assert.Equal(t, 2, cache.len())
+	_, ok := cache.get("two")
+	assert.False(t, ok)
+
+	value, ok := cache.get("one")
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+	cache.add("four", 4)
+	_, ok = cache.get("three")
+	assert.False(t, ok)
+	_, ok = cache.get("one")
+	assert.True(t, ok)
+}
+
+func Test_WithStatementCache(t *testing.T) {
+	statements := []string{`hello()`}
+	newParser := func(functions map[string]interface{}, settings component.TelemetrySettings, options ...Option[interface{}]) Parser[interface{}] {
+		return NewParser[interface{}](functions, testParsePath, testParseEnum, settings, options...)
+	}
+	parse := func(p Parser[interface{}]) *Statement[interface{}] {
+		parsed, err := p.ParseStatements(statements)
+		require.NoError(t, err)
+		require.Len(t, parsed, 1)
+		return parsed[0]
+	}
+
+	cached := newParser(map[string]interface{}{"hello": hello[interface{}]}, componenttest.NewNopTelemetrySettings(), WithStatementCache[interface{}]())
+	first := parse(cached)
+
+	t.Run("same context", func(t *testing.T) {
+		p := newParser(map[string]interface{}{"hello": hello[interface{}]}, componenttest.NewNopTelemetrySettings(), WithStatementCache[interface{}]())
+		assert.Same(t, first, parse(p))
+	})
+
+	t.Run("without cache", func(t *testing.T) {
+		p := newParser(map[string]interface{}{"hello": hello[interface{}]}, componenttest.NewNopTelemetrySettings())
+		assert.NotSame(t, first, parse(p))
+	})
+
+	t.Run("different functions", func(t *testing.T) {
+		p := newParser(map[string]interface{}{"hello": goodbye[interface{}]}, componenttest.NewNopTelemetrySettings(), WithStatementCache[interface{}]())
+		statement := parse(p)
+		assert.NotSame(t, first, statement)
+		result, _, err := statement.Execute(context.Background(), nil)
+		require.NoError(t, err)
+		assert.Equal(t, "goodbye", result)
+	})
+
+	t.Run("functions using telemetry settings", func(t *testing.T) {
+		functions := map[string]interface{}{
+			"hello":                            hello[interface{}],
+			"testing_telemetry_settings_first": functionWithTelemetrySettingsFirst,
+		}
+		settings := componenttest.NewNopTelemetrySettings()
+		statement := parse(newParser(functions, settings, WithStatementCache[interface{}]()))
+		assert.Same(t, statement, parse(newParser(functions, settings, WithStatementCache[interface{}]())))
+
+		settings.Logger = zap.NewExample()
+		assert.NotSame(t, statement, parse(newParser(functions, settings, WithStatementCache[interface{}]())))
+	})
+
+	t.Run("invalid statement", func(t *testing.T) {
+		_, err := cached.ParseStatements([]string{`unknown() where name == "statement cache"`})
+		assert.Error(t, err)
+		_, err = cached.ParseStatements([]string{`unknown() where name == "statement cache"`})
+		assert.Error(t, err)
+	})
+}
@@ -15,7 +15,11 @@
 package ottlcommon // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ottlcommon"
 
 import (
+	"fmt"
+
 	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 )
 
 func GetMapValue(attrs pcommon.Map, mapKey string) interface{} {
@@ -38,3 +42,21 @@ func SetMapValue(attrs pcommon.Map, mapKey string, val interface{}) {
 	SetValue(value, val)
 	value.CopyTo(attrs.PutEmpty(mapKey))
 }
+
+// CheckMapKeys returns an error when a field of the path is indexed by a string key while it is not one of the
+// indexable fields, since the PathExpressionParser would ignore the key.
+func CheckMapKeys(path []ottl.Field, indexable ...string) error {
+	for _, field := range path {
+		if field.MapKey == nil {
+			continue
+		}
+		supported := false
+		for _, name := range indexable {
+			supported = supported || field.Name == name
+		}
+		if !supported {
+			return fmt.Errorf("invalid path expression %v: %q can't be indexed by a key", path, field.Name)
+		}
+	}
+	return nil
+}
@@ -68,8 +68,8 @@ func (tCtx TransformContext) GetMetrics() pmetric.MetricSlice {
 	return tCtx.metrics
 }
 
-func NewParser(functions map[string]interface{}, telemetrySettings component.TelemetrySettings) ottl.Parser[TransformContext] {
-	return ottl.NewParser[TransformContext](functions, parsePath, parseEnum, telemetrySettings)
+func NewParser(functions map[string]interface{}, telemetrySettings component.TelemetrySettings, options ...ottl.Option[TransformContext]) ottl.Parser[TransformContext] {
+	return ottl.NewParser[TransformContext](functions, parsePath, parseEnum, telemetrySettings, options...)
 }
 
 var symbolTable = map[ottl.EnumSymbol]ottl.Enum{
@@ -95,6 +95,9 @@ func parseEnum(val *ottl.EnumSymbol) (*ottl.Enum, error) {
 
 func parsePath(val *ottl.Path) (ottl.GetSetter[TransformContext], error) {
 	if val != nil && len(val.Fields) > 0 {
+		if err := ottlcommon.CheckMapKeys(val.Fields, "attributes"); err != nil {
+			return nil, err
+		}
 		return newPathGetSetter(val.Fields)
 	}
 	return nil, fmt.Errorf("bad path %v", val)
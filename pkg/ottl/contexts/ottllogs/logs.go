@@ -58,8 +58,8 @@ func (tCtx TransformContext) GetResource() pcommon.Resource {
 	return tCtx.resource
 }
 
-func NewParser(functions map[string]interface{}, telemetrySettings component.TelemetrySettings) ottl.Parser[TransformContext] {
-	return ottl.NewParser[TransformContext](functions, parsePath, parseEnum, telemetrySettings)
+func NewParser(functions map[string]interface{}, telemetrySettings component.TelemetrySettings, options ...ottl.Option[TransformContext]) ottl.Parser[TransformContext] {
+	return ottl.NewParser[TransformContext](functions, parsePath, parseEnum, telemetrySettings, options...)
 }
 
 var symbolTable = map[ottl.EnumSymbol]ottl.Enum{
@@ -102,6 +102,9 @@ func parseEnum(val *ottl.EnumSymbol) (*ottl.Enum, error) {
 
 func parsePath(val *ottl.Path) (ottl.GetSetter[TransformContext], error) {
 	if val != nil && len(val.Fields) > 0 {
+		if err := ottlcommon.CheckMapKeys(val.Fields, "attributes", "body"); err != nil {
+			return nil, err
+		}
 		return newPathGetSetter(val.Fields)
 	}
 	return nil, fmt.Errorf("bad path %v", val)
@@ -122,7 +125,11 @@ func newPathGetSetter(path []ottl.Field) (ottl.GetSetter[TransformContext], erro
 	case "severity_text":
 		return accessSeverityText(), nil
 	case "body":
-		return accessBody(), nil
+		mapKey := path[0].MapKey
+		if mapKey == nil {
+			return accessBody(), nil
+		}
+		return accessBodyKey(mapKey), nil
 	case "attributes":
 		mapKey := path[0].MapKey
 		if mapKey == nil {
@@ -220,6 +227,30 @@ func accessBody() ottl.StandardGetSetter[TransformContext] {
 	}
 }
 
+// accessBodyKey accesses a key of a map body. Getting a key of a body that isn't a map gets nil, and setting it
+// does nothing unless the body is empty, which is then set to a map.
+func accessBodyKey(mapKey *string) ottl.StandardGetSetter[TransformContext] {
+	return ottl.StandardGetSetter[TransformContext]{
+		Getter: func(ctx context.Context, tCtx TransformContext) (interface{}, error) {
+			body := tCtx.GetLogRecord().Body()
+			if body.Type() != pcommon.ValueTypeMap {
+				return nil, nil
+			}
+			return ottlcommon.GetMapValue(body.Map(), *mapKey), nil
+		},
+		Setter: func(ctx context.Context, tCtx TransformContext, val interface{}) error {
+			body := tCtx.GetLogRecord().Body()
+			if body.Type() == pcommon.ValueTypeEmpty {
+				body.SetEmptyMap()
+			}
+			if body.Type() == pcommon.ValueTypeMap {
+				ottlcommon.SetMapValue(body.Map(), *mapKey, val)
+			}
+			return nil
+		},
+	}
+}
+
 func accessAttributes() ottl.StandardGetSetter[TransformContext] {
 	return ottl.StandardGetSetter[TransformContext]{
 		Getter: func(ctx context.Context, tCtx TransformContext) (interface{}, error) {
@@ -466,6 +466,34 @@ func createTelemetry() (plog.LogRecord, pcommon.InstrumentationScope, pcommon.Re
 	return log, il, resource
 }
 
+func Test_accessBodyKey(t *testing.T) {
+	log := plog.NewLogRecord()
+	getSetter := accessBodyKey(ottltest.Strp("key"))
+
+	// An empty body is set to a map.
+	assert.NoError(t, getSetter.Set(context.Background(), NewTransformContext(log, pcommon.NewInstrumentationScope(), pcommon.NewResource()), "value"))
+	got, err := getSetter.Get(context.Background(), NewTransformContext(log, pcommon.NewInstrumentationScope(), pcommon.NewResource()))
+	assert.NoError(t, err)
+	assert.Equal(t, "value", got)
+	assert.Equal(t, map[string]interface{}{"key": "value"}, log.Body().Map().AsRaw())
+
+	// The keys of a body that isn't a map can't be accessed.
+	log.Body().SetStr("body")
+	assert.NoError(t, getSetter.Set(context.Background(), NewTransformContext(log, pcommon.NewInstrumentationScope(), pcommon.NewResource()), "value"))
+	got, err = getSetter.Get(context.Background(), NewTransformContext(log, pcommon.NewInstrumentationScope(), pcommon.NewResource()))
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+	assert.Equal(t, "body", log.Body().Str())
+}
+
+func Test_parsePath_InvalidMapKey(t *testing.T) {
+	_, err := parsePath(&ottl.Path{Fields: []ottl.Field{{Name: "severity_text", MapKey: ottltest.Strp("key")}}})
+	assert.ErrorContains(t, err, `"severity_text" can't be indexed by a key`)
+
+	_, err = parsePath(&ottl.Path{Fields: []ottl.Field{{Name: "resource"}, {Name: "attributes", MapKey: ottltest.Strp("key")}}})
+	assert.NoError(t, err)
+}
+
 func Test_ParseEnum(t *testing.T) {
 	tests := []struct {
 		name string
@@ -102,7 +102,7 @@ func (p *Parser[K]) newGetter(val value) (Getter[K], error) {
 			return &literal[K]{value: *i}, nil
 		}
 		if eL.Path != nil {
-			return p.pathParser(eL.Path)
+			return p.parsePath(eL.Path)
 		}
 		if eL.Invocation != nil {
 			call, err := p.newFunctionCall(*eL.Invocation)
@@ -143,7 +143,7 @@ func (p *Parser[K]) buildArg(argDef value, argType reflect.Type, index int) (any
 		if argDef.Literal == nil || argDef.Literal.Path == nil {
 			return nil, fmt.Errorf("invalid argument at position %v must be a Path", index)
 		}
-		arg, err := p.pathParser(argDef.Literal.Path)
+		arg, err := p.parsePath(argDef.Literal.Path)
 		if err != nil {
 			return nil, fmt.Errorf("invalid argument at position %v %w", index, err)
 		}
@@ -26,6 +26,12 @@ type parsedStatement struct {
 	WhereClause *booleanExpression `parser:"( 'where' @@ )?"`
 }
 
+// parsedCondition represents a parsed condition, a boolean expression used on its own rather than as the where
+// clause of a statement. It is the entry point into the condition DSL.
+type parsedCondition struct {
+	BooleanExpression *booleanExpression `parser:"@@"`
+}
+
 // booleanValue represents something that evaluates to a boolean --
 // either an equality or inequality, explicit true or false, or
 // a parenthesized subexpression.
@@ -145,9 +151,21 @@ type Path struct {
 }
 
 // Field is an item within a Path.
+// MapKey holds the first key of the field when it is a string literal, which is interpreted by the
+// PathExpressionParser. The other keys, e.g. the keys following MapKey in `attributes["foo"][0]["bar"]` or all the
+// keys of `attributes[attributes["key"]]`, are held by Keys and are resolved by the OTTL to index into the value
+// returned by the PathExpressionParser.
 type Field struct {
 	Name   string  `parser:"@Lowercase"`
 	MapKey *string `parser:"( '[' @String ']' )?"`
+	Keys   []Key   `parser:"@@*"`
+}
+
+// Key is an index into a map or a slice. Dynamic keys are paths that are evaluated when the statement is executed.
+type Key struct {
+	String *string `parser:"'[' ( @String"`
+	Int    *int64  `parser:"| @Int"`
+	Path   *Path   `parser:"| @@ ) ']'"`
 }
 
 type list struct {
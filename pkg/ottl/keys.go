@@ -0,0 +1,241 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottl // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// parsePath returns the GetSetter of a path. The PathExpressionParser interprets the fields of the path and the MapKey
+// of its last field, while the Keys of the last field are resolved by indexing into the value of the GetSetter
+// returned by the PathExpressionParser.
+func (p *Parser[K]) parsePath(path *Path) (GetSetter[K], error) {
+	if len(path.Fields) == 0 {
+		return p.pathParser(path)
+	}
+	for _, field := range path.Fields[:len(path.Fields)-1] {
+		if len(field.Keys) > 0 {
+			return nil, fmt.Errorf("invalid path: only the last field %q can be indexed by more than one key", path.Fields[len(path.Fields)-1].Name)
+		}
+	}
+	last := path.Fields[len(path.Fields)-1]
+	if len(last.Keys) == 0 {
+		return p.pathParser(path)
+	}
+
+	keys := make([]Getter[K], 0, len(last.Keys))
+	for _, key := range last.Keys {
+		switch {
+		case key.String != nil:
+			keys = append(keys, &literal[K]{value: *key.String})
+		case key.Int != nil:
+			keys = append(keys, &literal[K]{value: *key.Int})
+		case key.Path != nil:
+			getter, err := p.parsePath(key.Path)
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, getter)
+		}
+	}
+
+	// The PathExpressionParser is given the path without the keys it doesn't interpret.
+	fields := make([]Field, len(path.Fields))
+	copy(fields, path.Fields)
+	fields[len(fields)-1].Keys = nil
+	getSetter, err := p.pathParser(&Path{Fields: fields})
+	if err != nil {
+		return nil, err
+	}
+	return &keysGetSetter[K]{getSetter: getSetter, keys: keys}, nil
+}
+
+// keysGetSetter indexes into the maps and slices returned by a GetSetter. Like a missing map key, indexing outside of
+// a slice or into a value that is not a map or a slice gets nil, and setting a value there does nothing. Indexing a
+// map by a key that is not a string or a slice by an index that is not an int is an error.
+type keysGetSetter[K any] struct {
+	getSetter GetSetter[K]
+	keys      []Getter[K]
+}
+
+func (g *keysGetSetter[K]) Get(ctx context.Context, tCtx K) (interface{}, error) {
+	val, err := g.getSetter.Get(ctx, tCtx)
+	if err != nil {
+		return nil, err
+	}
+	for _, keyGetter := range g.keys {
+		key, err := keyGetter.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		value, ok, err := index(val, key)
+		if err != nil || !ok {
+			return nil, err
+		}
+		val = rawValue(value)
+	}
+	return val, nil
+}
+
+// Set sets the value at the last key. The maps of the missing string keys before it are created, including the value
+// of the path when it is missing, so that nested values can be set at once.
+func (g *keysGetSetter[K]) Set(ctx context.Context, tCtx K, val interface{}) error {
+	container, err := g.getSetter.Get(ctx, tCtx)
+	if err != nil {
+		return err
+	}
+	for i, keyGetter := range g.keys {
+		key, err := keyGetter.Get(ctx, tCtx)
+		if err != nil {
+			return err
+		}
+		if _, isString := key.(string); i == 0 && isString && container == nil {
+			if err = g.getSetter.Set(ctx, tCtx, pcommon.NewMap()); err != nil {
+				return err
+			}
+			if container, err = g.getSetter.Get(ctx, tCtx); err != nil {
+				return err
+			}
+		}
+		if i == len(g.keys)-1 {
+			return setIndex(container, key, val)
+		}
+		value, ok, err := index(container, key)
+		if err != nil {
+			return err
+		}
+		if !ok || value.Type() == pcommon.ValueTypeEmpty {
+			m, isMap := container.(pcommon.Map)
+			if !isMap {
+				return nil
+			}
+			value = m.PutEmpty(key.(string))
+			value.SetEmptyMap()
+		}
+		container = rawValue(value)
+	}
+	return nil
+}
+
+// index returns the value of a key in a map or a slice, and whether it exists.
+func index(container interface{}, key interface{}) (pcommon.Value, bool, error) {
+	switch c := container.(type) {
+	case pcommon.Map:
+		k, ok := key.(string)
+		if !ok {
+			return pcommon.Value{}, false, fmt.Errorf("invalid key %v: a map can only be indexed by a string", key)
+		}
+		v, ok := c.Get(k)
+		return v, ok, nil
+	case pcommon.Slice:
+		i, ok := key.(int64)
+		if !ok {
+			return pcommon.Value{}, false, fmt.Errorf("invalid index %v: a slice can only be indexed by an int", key)
+		}
+		if i < 0 || i >= int64(c.Len()) {
+			return pcommon.Value{}, false, nil
+		}
+		return c.At(int(i)), true, nil
+	case pcommon.Value:
+		return index(rawValue(c), key)
+	default:
+		return pcommon.Value{}, false, nil
+	}
+}
+
+// setIndex sets the value of a key in a map or a slice.
+func setIndex(container interface{}, key interface{}, val interface{}) error {
+	if v, ok := container.(pcommon.Value); ok {
+		container = rawValue(v)
+	}
+	if m, ok := container.(pcommon.Map); ok {
+		k, ok := key.(string)
+		if !ok {
+			return fmt.Errorf("invalid key %v: a map can only be indexed by a string", key)
+		}
+		setValue(m.PutEmpty(k), val)
+		return nil
+	}
+	value, ok, err := index(container, key)
+	if err != nil || !ok {
+		return err
+	}
+	setValue(value, val)
+	return nil
+}
+
+// rawValue returns the maps and slices of a pcommon.Value, so that they can be indexed, and the Go value of the
+// other types of values.
+func rawValue(v pcommon.Value) interface{} {
+	switch v.Type() {
+	case pcommon.ValueTypeStr:
+		return v.Str()
+	case pcommon.ValueTypeBool:
+		return v.Bool()
+	case pcommon.ValueTypeInt:
+		return v.Int()
+	case pcommon.ValueTypeDouble:
+		return v.Double()
+	case pcommon.ValueTypeMap:
+		return v.Map()
+	case pcommon.ValueTypeSlice:
+		return v.Slice()
+	case pcommon.ValueTypeBytes:
+		return v.Bytes().AsRaw()
+	}
+	return nil
+}
+
+func setValue(value pcommon.Value, val interface{}) {
+	switch v := val.(type) {
+	case pcommon.Value:
+		v.CopyTo(value)
+	case pcommon.Map:
+		v.CopyTo(value.SetEmptyMap())
+	case pcommon.Slice:
+		v.CopyTo(value.SetEmptySlice())
+	case []string:
+		s := value.SetEmptySlice()
+		for _, str := range v {
+			s.AppendEmpty().SetStr(str)
+		}
+	case []bool:
+		s := value.SetEmptySlice()
+		for _, b := range v {
+			s.AppendEmpty().SetBool(b)
+		}
+	case []int64:
+		s := value.SetEmptySlice()
+		for _, i := range v {
+			s.AppendEmpty().SetInt(i)
+		}
+	case []float64:
+		s := value.SetEmptySlice()
+		for _, f := range v {
+			s.AppendEmpty().SetDouble(f)
+		}
+	case [][]byte:
+		s := value.SetEmptySlice()
+		for _, b := range v {
+			s.AppendEmpty().SetEmptyBytes().FromRaw(b)
+		}
+	default:
+		// Supports nil, strings, bools, ints, floats, byte slices and raw maps and slices.
+		value.FromRaw(val)
+	}
+}
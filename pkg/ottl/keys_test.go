@@ -0,0 +1,242 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottl
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// testParseAttributesPath interprets the `attributes` path of a pcommon.Map transform context, like the
+// PathExpressionParsers of the contexts.
+func testParseAttributesPath(path *Path) (GetSetter[pcommon.Map], error) {
+	if len(path.Fields) != 1 || path.Fields[0].Name != "attributes" || len(path.Fields[0].Keys) > 0 {
+		return nil, fmt.Errorf("bad path %v", path)
+	}
+	mapKey := path.Fields[0].MapKey
+	if mapKey == nil {
+		return &StandardGetSetter[pcommon.Map]{
+			Getter: func(ctx context.Context, tCtx pcommon.Map) (interface{}, error) {
+				return tCtx, nil
+			},
+		}, nil
+	}
+	return &StandardGetSetter[pcommon.Map]{
+		Getter: func(ctx context.Context, tCtx pcommon.Map) (interface{}, error) {
+			v, ok := tCtx.Get(*mapKey)
+			if !ok {
+				return nil, nil
+			}
+			return rawValue(v), nil
+		},
+		Setter: func(ctx context.Context, tCtx pcommon.Map, val interface{}) error {
+			setValue(tCtx.PutEmpty(*mapKey), val)
+			return nil
+		},
+	}, nil
+}
+
+func testSet(target Setter[pcommon.Map], value Getter[pcommon.Map]) (ExprFunc[pcommon.Map], error) {
+	return func(ctx context.Context, tCtx pcommon.Map) (interface{}, error) {
+		val, err := value.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		return nil, target.Set(ctx, tCtx, val)
+	}, nil
+}
+
+func testGet(value Getter[pcommon.Map]) (ExprFunc[pcommon.Map], error) {
+	return value.Get, nil
+}
+
+func newKeysTestAttributes() pcommon.Map {
+	attrs := pcommon.NewMap()
+	attrs.PutStr("key", "bar")
+	attrs.PutInt("index", 1)
+	attrs.PutDouble("float", 1.5)
+	foo := attrs.PutEmptySlice("foo")
+	foo.AppendEmpty().SetEmptyMap().PutStr("bar", "first")
+	second := foo.AppendEmpty().SetEmptyMap()
+	second.PutStr("bar", "second")
+	second.PutEmptySlice("values").FromRaw([]interface{}{"a", "b"})
+	return attrs
+}
+
+func Test_keysGetSetter(t *testing.T) {
+	p := NewParser[pcommon.Map](
+		map[string]interface{}{"set": testSet, "get": testGet},
+		testParseAttributesPath,
+		testParseEnum,
+		componenttest.NewNopTelemetrySettings(),
+	)
+
+	tests := []struct {
+		name      string
+		statement string
+		want      interface{}
+		wantAttrs func(pcommon.Map)
+	}{
+		{
+			name:      "nested string key",
+			statement: `get(attributes["foo"][0]["bar"])`,
+			want:      "first",
+		},
+		{
+			name:      "dynamic index",
+			statement: `get(attributes["foo"][attributes["index"]]["values"][0])`,
+			want:      "a",
+		},
+		{
+			name:      "dynamic first key",
+			statement: `get(attributes[attributes["key"]])`,
+			want:      nil,
+		},
+		{
+			name:      "dynamic key in nested map",
+			statement: `get(attributes["foo"][1][attributes["key"]])`,
+			want:      "second",
+		},
+		{
+			name:      "nested map",
+			statement: `get(attributes["foo"][0])`,
+			want: func() interface{} {
+				m := pcommon.NewMap()
+				m.PutStr("bar", "first")
+				return m
+			}(),
+		},
+		{
+			name:      "index out of range",
+			statement: `get(attributes["foo"][5]["bar"])`,
+			want:      nil,
+		},
+		{
+			name:      "missing key",
+			statement: `get(attributes["missing"]["bar"])`,
+			want:      nil,
+		},
+		{
+			name:      "value not indexable",
+			statement: `get(attributes["key"]["bar"])`,
+			want:      nil,
+		},
+		{
+			name:      "set nested value",
+			statement: `set(attributes["foo"][1]["values"][1], "c")`,
+			wantAttrs: func(attrs pcommon.Map) {
+				foo, _ := attrs.Get("foo")
+				foo.Slice().At(1).Map().PutEmptySlice("values").FromRaw([]interface{}{"a", "c"})
+			},
+		},
+		{
+			name:      "set creates missing maps",
+			statement: `set(attributes["foo"][0]["nested"]["value"], attributes["index"])`,
+			wantAttrs: func(attrs pcommon.Map) {
+				foo, _ := attrs.Get("foo")
+				foo.Slice().At(0).Map().PutEmptyMap("nested").PutInt("value", 1)
+			},
+		},
+		{
+			name:      "set creates missing path",
+			statement: `set(attributes["new"]["value"], "created")`,
+			wantAttrs: func(attrs pcommon.Map) {
+				attrs.PutEmptyMap("new").PutStr("value", "created")
+			},
+		},
+		{
+			name:      "set with dynamic key",
+			statement: `set(attributes[attributes["key"]], "value")`,
+			wantAttrs: func(attrs pcommon.Map) {
+				attrs.PutStr("bar", "value")
+			},
+		},
+		{
+			name:      "set out of range",
+			statement: `set(attributes["foo"][5], "value")`,
+			wantAttrs: func(attrs pcommon.Map) {},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statements, err := p.ParseStatements([]string{tt.statement})
+			require.NoError(t, err)
+
+			attrs := newKeysTestAttributes()
+			result, _, err := statements[0].Execute(context.Background(), attrs)
+			require.NoError(t, err)
+
+			if tt.wantAttrs == nil {
+				assert.Equal(t, tt.want, result)
+				return
+			}
+			expected := newKeysTestAttributes()
+			tt.wantAttrs(expected)
+			assert.Equal(t, expected.AsRaw(), attrs.AsRaw())
+		})
+	}
+}
+
+func Test_keysGetSetter_invalidKeys(t *testing.T) {
+	p := NewParser[pcommon.Map](
+		map[string]interface{}{"set": testSet, "get": testGet},
+		testParseAttributesPath,
+		testParseEnum,
+		componenttest.NewNopTelemetrySettings(),
+	)
+
+	tests := []struct {
+		name      string
+		statement string
+	}{
+		{
+			name:      "map indexed by int",
+			statement: `get(attributes["foo"][0][0])`,
+		},
+		{
+			name:      "slice indexed by string",
+			statement: `get(attributes["foo"]["bar"])`,
+		},
+		{
+			name:      "slice indexed by float",
+			statement: `set(attributes["foo"][attributes["float"]], "value")`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statements, err := p.ParseStatements([]string{tt.statement})
+			require.NoError(t, err)
+			_, _, err = statements[0].Execute(context.Background(), newKeysTestAttributes())
+			assert.Error(t, err)
+		})
+	}
+}
+
+func Test_parsePath_keysOnlyOnLastField(t *testing.T) {
+	p := NewParser[pcommon.Map](
+		map[string]interface{}{"get": testGet},
+		testParseAttributesPath,
+		testParseEnum,
+		componenttest.NewNopTelemetrySettings(),
+	)
+	_, err := p.ParseStatements([]string{`get(attributes["foo"][0].name)`})
+	assert.Error(t, err)
+}
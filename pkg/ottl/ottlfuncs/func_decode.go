@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"html"
+	"net/url"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Decode[K any](target ottl.Getter[K], encoding string) (ottl.ExprFunc[K], error) {
+	var decode func([]byte) (interface{}, error)
+	switch encoding {
+	case "hex":
+		decode = binaryDecoder(func(b []byte) ([]byte, error) {
+			decoded := make([]byte, hex.DecodedLen(len(b)))
+			n, err := hex.Decode(decoded, b)
+			return decoded[:n], err
+		})
+	case "base64":
+		decode = binaryDecoder(base64Decoder(base64.StdEncoding))
+	case "base64url":
+		decode = binaryDecoder(base64Decoder(base64.URLEncoding))
+	case "base64raw":
+		decode = binaryDecoder(base64Decoder(base64.RawStdEncoding))
+	case "url":
+		decode = func(b []byte) (interface{}, error) {
+			return url.QueryUnescape(string(b))
+		}
+	case "html":
+		decode = func(b []byte) (interface{}, error) {
+			return html.UnescapeString(string(b)), nil
+		}
+	case "utf16":
+		decode = func(b []byte) (interface{}, error) {
+			switch {
+			case len(b) >= 2 && b[0] == 0xFF && b[1] == 0xFE:
+				return decodeUTF16(b[2:], binary.LittleEndian)
+			case len(b) >= 2 && b[0] == 0xFE && b[1] == 0xFF:
+				return decodeUTF16(b[2:], binary.BigEndian)
+			default:
+				return decodeUTF16(b, binary.BigEndian)
+			}
+		}
+	case "utf16le":
+		decode = func(b []byte) (interface{}, error) {
+			return decodeUTF16(b, binary.LittleEndian)
+		}
+	case "utf16be":
+		decode = func(b []byte) (interface{}, error) {
+			return decodeUTF16(b, binary.BigEndian)
+		}
+	default:
+		return nil, fmt.Errorf("invalid encoding: %s, allowed encodings are: hex, base64, base64url, base64raw, url, html, utf16, utf16le, utf16be", encoding)
+	}
+
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		var encoded []byte
+		switch v := val.(type) {
+		case string:
+			encoded = []byte(v)
+		case []byte:
+			encoded = v
+		default:
+			return nil, nil
+		}
+
+		decoded, err := decode(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode %s value: %w", encoding, err)
+		}
+		return decoded, nil
+	}, nil
+}
+
+// binaryDecoder returns the decoded bytes as a string when they are valid UTF-8, so that decoded text can be used
+// inline, and as a byte slice otherwise.
+func binaryDecoder(decode func([]byte) ([]byte, error)) func([]byte) (interface{}, error) {
+	return func(b []byte) (interface{}, error) {
+		decoded, err := decode(b)
+		if err != nil {
+			return nil, err
+		}
+		if utf8.Valid(decoded) {
+			return string(decoded), nil
+		}
+		return decoded, nil
+	}
+}
+
+func base64Decoder(encoding *base64.Encoding) func([]byte) ([]byte, error) {
+	return func(b []byte) ([]byte, error) {
+		decoded := make([]byte, encoding.DecodedLen(len(b)))
+		n, err := encoding.Decode(decoded, b)
+		return decoded[:n], err
+	}
+}
+
+func decodeUTF16(b []byte, order binary.ByteOrder) (string, error) {
+	if len(b)%2 != 0 {
+		return "", fmt.Errorf("odd length %d of UTF-16 input", len(b))
+	}
+	units := make([]uint16, len(b)/2)
+	for i := range units {
+		units[i] = order.Uint16(b[2*i:])
+	}
+	return string(utf16.Decode(units)), nil
+}
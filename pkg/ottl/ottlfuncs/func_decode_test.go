@@ -0,0 +1,221 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_decode(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		encoding string
+		expected interface{}
+	}{
+		{
+			name:     "hex",
+			value:    "68656c6c6f20776f726c64",
+			encoding: "hex",
+			expected: "hello world",
+		},
+		{
+			name:     "hex uppercase",
+			value:    "68656C6C6F",
+			encoding: "hex",
+			expected: "hello",
+		},
+		{
+			name:     "base64",
+			value:    "aGVsbG8gd29ybGQ=",
+			encoding: "base64",
+			expected: "hello world",
+		},
+		{
+			name:     "url",
+			value:    "%2Fapi%2Fv1%3Fname%3Dhello+world",
+			encoding: "url",
+			expected: "/api/v1?name=hello world",
+		},
+		{
+			name:     "html",
+			value:    "&lt;div class=&quot;a&quot;&gt;Tom &amp; Jerry&#39;s&lt;/div&gt;",
+			encoding: "html",
+			expected: `<div class="a">Tom & Jerry's</div>`,
+		},
+		{
+			name:     "base64 binary",
+			value:    "AP8Q",
+			encoding: "base64",
+			expected: []byte{0x00, 0xff, 0x10},
+		},
+		{
+			name:     "base64 byte slice",
+			value:    []byte("aGVsbG8="),
+			encoding: "base64",
+			expected: "hello",
+		},
+		{
+			name:     "base64url",
+			value:    "P3E9YSZiPT8_",
+			encoding: "base64url",
+			expected: "?q=a&b=??",
+		},
+		{
+			name:     "base64raw",
+			value:    "aGVsbG8",
+			encoding: "base64raw",
+			expected: "hello",
+		},
+		{
+			name:     "hex binary",
+			value:    "cafe",
+			encoding: "hex",
+			expected: []byte{0xca, 0xfe},
+		},
+		{
+			name:     "utf16 little endian with byte order mark",
+			value:    []byte{0xff, 0xfe, 'h', 0, 'i', 0, 0x3d, 0xd8, 0x00, 0xde},
+			encoding: "utf16",
+			expected: "hi😀",
+		},
+		{
+			name:     "utf16 big endian with byte order mark",
+			value:    []byte{0xfe, 0xff, 0, 'h', 0, 'i'},
+			encoding: "utf16",
+			expected: "hi",
+		},
+		{
+			name:     "utf16 without byte order mark",
+			value:    []byte{0, 'h', 0, 'i'},
+			encoding: "utf16",
+			expected: "hi",
+		},
+		{
+			name:     "utf16le",
+			value:    []byte{'h', 0, 0xe9, 0},
+			encoding: "utf16le",
+			expected: "hé",
+		},
+		{
+			name:     "utf16be",
+			value:    []byte{0, 'h', 0, 0xe9},
+			encoding: "utf16be",
+			expected: "hé",
+		},
+		{
+			name:     "empty string",
+			value:    "",
+			encoding: "base64",
+			expected: "",
+		},
+		{
+			name:     "nil",
+			value:    nil,
+			encoding: "hex",
+			expected: nil,
+		},
+		{
+			name:     "non-string",
+			value:    int64(10),
+			encoding: "hex",
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := Decode[interface{}](target, tt.encoding)
+			require.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_decodeError(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		encoding string
+	}{
+		{
+			name:     "invalid hex",
+			value:    "zz",
+			encoding: "hex",
+		},
+		{
+			name:     "odd length hex",
+			value:    "abc",
+			encoding: "hex",
+		},
+		{
+			name:     "invalid base64",
+			value:    "not base64!",
+			encoding: "base64",
+		},
+		{
+			name:     "invalid base64url",
+			value:    "aGVsbG8+",
+			encoding: "base64url",
+		},
+		{
+			name:     "odd length utf16",
+			value:    "abc",
+			encoding: "utf16le",
+		},
+		{
+			name:     "invalid url escape",
+			value:    "%zz",
+			encoding: "url",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := Decode[interface{}](target, tt.encoding)
+			require.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			assert.Error(t, err)
+			assert.Nil(t, result)
+		})
+	}
+}
+
+func Test_decodeInvalidEncoding(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+			return "value", nil
+		},
+	}
+	exprFunc, err := Decode[interface{}](target, "rot13")
+	assert.EqualError(t, err, "invalid encoding: rot13, allowed encodings are: hex, base64, base64url, base64raw, url, html, utf16, utf16le, utf16be")
+	assert.Nil(t, exprFunc)
+}
@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_join(t *testing.T) {
+	slice := pcommon.NewSlice()
+	slice.AppendEmpty().SetStr("a")
+	slice.AppendEmpty().SetInt(1)
+	slice.AppendEmpty().SetBool(true)
+
+	tests := []struct {
+		name      string
+		value     interface{}
+		delimiter string
+		expected  interface{}
+	}{
+		{
+			name:      "join string slice",
+			value:     []string{"A", "B", "C"},
+			delimiter: ",",
+			expected:  "A,B,C",
+		},
+		{
+			name:      "join empty delimiter",
+			value:     []string{"A", "B", "C"},
+			delimiter: "",
+			expected:  "ABC",
+		},
+		{
+			name:      "join empty slice",
+			value:     []string{},
+			delimiter: ",",
+			expected:  "",
+		},
+		{
+			name:      "join pcommon slice",
+			value:     slice,
+			delimiter: "|",
+			expected:  "a|1|true",
+		},
+		{
+			name:      "join non-slice",
+			value:     "A,B,C",
+			delimiter: ",",
+			expected:  nil,
+		},
+		{
+			name:      "join nil",
+			value:     nil,
+			delimiter: ",",
+			expected:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := Join[interface{}](target, tt.delimiter)
+			require.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
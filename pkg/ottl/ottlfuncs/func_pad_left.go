@@ -0,0 +1,46 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func PadLeft[K any](target ottl.Getter[K], length int64, pad string) (ottl.ExprFunc[K], error) {
+	if utf8.RuneCountInString(pad) != 1 {
+		return nil, fmt.Errorf("invalid pad for pad left function, %q must be a single character", pad)
+	}
+
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		valStr, ok := val.(string)
+		if !ok {
+			return nil, nil
+		}
+		missing := length - int64(utf8.RuneCountInString(valStr))
+		if missing <= 0 {
+			return valStr, nil
+		}
+		return strings.Repeat(pad, int(missing)) + valStr, nil
+	}, nil
+}
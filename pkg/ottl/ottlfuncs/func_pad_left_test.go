@@ -0,0 +1,105 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_padLeft(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		length   int64
+		pad      string
+		expected interface{}
+	}{
+		{
+			name:     "pad with zeros",
+			value:    "42",
+			length:   5,
+			pad:      "0",
+			expected: "00042",
+		},
+		{
+			name:     "already long enough",
+			value:    "123456",
+			length:   5,
+			pad:      "0",
+			expected: "123456",
+		},
+		{
+			name:     "empty string",
+			value:    "",
+			length:   3,
+			pad:      " ",
+			expected: "   ",
+		},
+		{
+			name:     "multi-byte characters",
+			value:    "é",
+			length:   3,
+			pad:      "·",
+			expected: "··é",
+		},
+		{
+			name:     "non-string",
+			value:    int64(42),
+			length:   5,
+			pad:      "0",
+			expected: nil,
+		},
+		{
+			name:     "nil",
+			value:    nil,
+			length:   5,
+			pad:      "0",
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := PadLeft[interface{}](target, tt.length, tt.pad)
+			require.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_padLeftInvalidPad(t *testing.T) {
+	for _, pad := range []string{"", "ab"} {
+		target := &ottl.StandardGetSetter[interface{}]{
+			Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+				return "42", nil
+			},
+		}
+		exprFunc, err := PadLeft[interface{}](target, 5, pad)
+		assert.Error(t, err)
+		assert.Nil(t, exprFunc)
+	}
+}
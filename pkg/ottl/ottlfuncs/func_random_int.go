@@ -0,0 +1,39 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func RandomInt[K any](min int64, max int64) (ottl.ExprFunc[K], error) {
+	if min >= max {
+		return nil, fmt.Errorf("invalid range [%d, %d): min must be less than max", min, max)
+	}
+	// The range is computed with big integers since max - min can overflow an int64.
+	n := new(big.Int).Sub(big.NewInt(max), big.NewInt(min))
+	return func(context.Context, K) (interface{}, error) {
+		r, err := rand.Int(rand.Reader, n)
+		if err != nil {
+			return nil, err
+		}
+		return r.Add(r, big.NewInt(min)).Int64(), nil
+	}, nil
+}
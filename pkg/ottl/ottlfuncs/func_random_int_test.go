@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_randomInt(t *testing.T) {
+	tests := []struct {
+		name string
+		min  int64
+		max  int64
+	}{
+		{
+			name: "positive range",
+			min:  10,
+			max:  20,
+		},
+		{
+			name: "negative range",
+			min:  -5,
+			max:  0,
+		},
+		{
+			name: "single value",
+			min:  7,
+			max:  8,
+		},
+		{
+			name: "full range",
+			min:  math.MinInt64,
+			max:  math.MaxInt64,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := RandomInt[interface{}](tt.min, tt.max)
+			require.NoError(t, err)
+			for i := 0; i < 100; i++ {
+				result, err := exprFunc(nil, nil)
+				require.NoError(t, err)
+				require.IsType(t, int64(0), result)
+				assert.GreaterOrEqual(t, result.(int64), tt.min)
+				assert.Less(t, result.(int64), tt.max)
+			}
+		})
+	}
+}
+
+func Test_randomInt_validation(t *testing.T) {
+	_, err := RandomInt[interface{}](10, 10)
+	assert.EqualError(t, err, "invalid range [10, 10): min must be less than max")
+}
@@ -0,0 +1,35 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_random(t *testing.T) {
+	exprFunc, err := Random[interface{}]()
+	require.NoError(t, err)
+
+	for i := 0; i < 100; i++ {
+		result, err := exprFunc(nil, nil)
+		require.NoError(t, err)
+		require.IsType(t, float64(0), result)
+		assert.GreaterOrEqual(t, result.(float64), float64(0))
+		assert.Less(t, result.(float64), float64(1))
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func Test_randomTraceID(t *testing.T) {
+	exprFunc, err := RandomTraceID[interface{}]()
+	require.NoError(t, err)
+
+	first, err := exprFunc(nil, nil)
+	require.NoError(t, err)
+	require.IsType(t, pcommon.TraceID{}, first)
+	assert.False(t, first.(pcommon.TraceID).IsEmpty())
+
+	second, err := exprFunc(nil, nil)
+	require.NoError(t, err)
+	assert.NotEqual(t, first, second)
+}
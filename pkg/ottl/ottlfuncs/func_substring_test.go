@@ -0,0 +1,135 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_substring(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		start    int64
+		length   int64
+		expected interface{}
+	}{
+		{
+			name:     "substring",
+			value:    "123456789",
+			start:    1,
+			length:   3,
+			expected: "234",
+		},
+		{
+			name:     "substring from the start",
+			value:    "123456789",
+			start:    0,
+			length:   9,
+			expected: "123456789",
+		},
+		{
+			name:     "substring past the end",
+			value:    "123456789",
+			start:    7,
+			length:   5,
+			expected: "89",
+		},
+		{
+			name:     "start past the end",
+			value:    "123",
+			start:    3,
+			length:   1,
+			expected: "",
+		},
+		{
+			name:     "multi-byte characters",
+			value:    "héllo wörld",
+			start:    1,
+			length:   4,
+			expected: "éllo",
+		},
+		{
+			name:     "non-string",
+			value:    int64(123),
+			start:    0,
+			length:   1,
+			expected: nil,
+		},
+		{
+			name:     "nil",
+			value:    nil,
+			start:    0,
+			length:   1,
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := Substring[interface{}](target, tt.start, tt.length)
+			require.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_substringError(t *testing.T) {
+	tests := []struct {
+		name   string
+		start  int64
+		length int64
+	}{
+		{
+			name:   "negative start",
+			start:  -1,
+			length: 3,
+		},
+		{
+			name:   "zero length",
+			start:  0,
+			length: 0,
+		},
+		{
+			name:   "negative length",
+			start:  0,
+			length: -1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+					return "123456789", nil
+				},
+			}
+			exprFunc, err := Substring[interface{}](target, tt.start, tt.length)
+			assert.Error(t, err)
+			assert.Nil(t, exprFunc)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_trim(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		cutset   string
+		expected interface{}
+	}{
+		{
+			name:     "trim cutset",
+			value:    "--my-metric--",
+			cutset:   "-",
+			expected: "my-metric",
+		},
+		{
+			name:     "trim multiple characters",
+			value:    "\"'value'\"",
+			cutset:   "\"'",
+			expected: "value",
+		},
+		{
+			name:     "trim whitespace",
+			value:    " \t value\n",
+			cutset:   "",
+			expected: "value",
+		},
+		{
+			name:     "nothing to trim",
+			value:    "value",
+			cutset:   " ",
+			expected: "value",
+		},
+		{
+			name:     "non-string",
+			value:    int64(123),
+			cutset:   " ",
+			expected: nil,
+		},
+		{
+			name:     "nil",
+			value:    nil,
+			cutset:   " ",
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := Trim[interface{}](target, tt.cutset)
+			require.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -27,6 +27,8 @@ type Parser[K any] struct {
 	pathParser        PathExpressionParser[K]
 	enumParser        EnumParser
 	telemetrySettings component.TelemetrySettings
+	// cacheContext identifies the context of the Parser in the statement cache, the cache is not used when empty.
+	cacheContext string
 }
 
 // Statement holds a top level Statement for processing telemetry data. A Statement is a combination of a function
@@ -55,13 +57,28 @@ func (s *Statement[K]) Execute(ctx context.Context, tCtx K) (any, bool, error) {
 	return result, condition, nil
 }
 
-func NewParser[K any](functions map[string]interface{}, pathParser PathExpressionParser[K], enumParser EnumParser, telemetrySettings component.TelemetrySettings) Parser[K] {
-	return Parser[K]{
+// Condition holds a top level Condition, a boolean expression matching telemetry data, e.g. to select the telemetry
+// to route or filter.
+type Condition[K any] struct {
+	condition BoolExpr[K]
+}
+
+// Eval returns whether the telemetry data matches the condition.
+func (c *Condition[K]) Eval(ctx context.Context, tCtx K) (bool, error) {
+	return c.condition.Eval(ctx, tCtx)
+}
+
+func NewParser[K any](functions map[string]interface{}, pathParser PathExpressionParser[K], enumParser EnumParser, telemetrySettings component.TelemetrySettings, options ...Option[K]) Parser[K] {
+	p := Parser[K]{
 		functions:         functions,
 		pathParser:        pathParser,
 		enumParser:        enumParser,
 		telemetrySettings: telemetrySettings,
 	}
+	for _, option := range options {
+		option(&p)
+	}
+	return p
 }
 
 func (p *Parser[K]) ParseStatements(statements []string) ([]*Statement[K], error) {
@@ -69,41 +86,83 @@ func (p *Parser[K]) ParseStatements(statements []string) ([]*Statement[K], error
 	var errors error
 
 	for _, statement := range statements {
-		parsed, err := parseStatement(statement)
+		compiled, err := p.cachedStatement(statement, p.compileStatement)
 		if err != nil {
 			errors = multierr.Append(errors, err)
 			continue
 		}
-		function, err := p.newFunctionCall(parsed.Invocation)
-		if err != nil {
-			errors = multierr.Append(errors, err)
-			continue
-		}
-		expression, err := p.newBoolExpr(parsed.WhereClause)
+		parsedStatements = append(parsedStatements, compiled)
+	}
+
+	if errors != nil {
+		return nil, errors
+	}
+	return parsedStatements, nil
+}
+
+func (p *Parser[K]) compileStatement(statement string) (*Statement[K], error) {
+	parsed, err := parseStatement(statement)
+	if err != nil {
+		return nil, err
+	}
+	function, err := p.newFunctionCall(parsed.Invocation)
+	if err != nil {
+		return nil, err
+	}
+	expression, err := p.newBoolExpr(parsed.WhereClause)
+	if err != nil {
+		return nil, err
+	}
+	return &Statement[K]{
+		function:  function,
+		condition: expression,
+	}, nil
+}
+
+// ParseConditions parses the conditions, which have the syntax of the where clauses of statements.
+func (p *Parser[K]) ParseConditions(conditions []string) ([]*Condition[K], error) {
+	var parsedConditions []*Condition[K]
+	var errors error
+
+	for _, condition := range conditions {
+		compiled, err := p.compileCondition(condition)
 		if err != nil {
 			errors = multierr.Append(errors, err)
 			continue
 		}
-		parsedStatements = append(parsedStatements, &Statement[K]{
-			function:  function,
-			condition: expression,
-		})
+		parsedConditions = append(parsedConditions, compiled)
 	}
 
 	if errors != nil {
 		return nil, errors
 	}
-	return parsedStatements, nil
+	return parsedConditions, nil
+}
+
+func (p *Parser[K]) compileCondition(condition string) (*Condition[K], error) {
+	parsed, err := conditionParser.ParseString("", condition)
+	if err != nil {
+		return nil, err
+	}
+	expression, err := p.newBoolExpr(parsed.BooleanExpression)
+	if err != nil {
+		return nil, err
+	}
+	return &Condition[K]{condition: expression}, nil
 }
 
 var parser = newParser[parsedStatement]()
+var conditionParser = newParser[parsedCondition]()
 
 func parseStatement(raw string) (*parsedStatement, error) {
+	if cached, ok := grammarCache.get(raw); ok {
+		return cached, nil
+	}
 	parsed, err := parser.ParseString("", raw)
 	if err != nil {
 		return nil, err
 	}
-	return parsed, nil
+	return grammarCache.add(raw, parsed), nil
 }
 
 // newParser returns a parser that can be used to read a string into a parsedStatement. An error will be returned if the string
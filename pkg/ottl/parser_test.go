@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/component"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottltest"
 )
@@ -157,6 +158,79 @@ func Test_parse(t *testing.T) {
 				WhereClause: nil,
 			},
 		},
+		{
+			name:      "nested keys",
+			statement: `set(attributes["foo"][0]["bar"], "dog")`,
+			expected: &parsedStatement{
+				Invocation: invocation{
+					Function: "set",
+					Arguments: []value{
+						{
+							Literal: &mathExprLiteral{
+								Path: &Path{
+									Fields: []Field{
+										{
+											Name:   "attributes",
+											MapKey: ottltest.Strp("foo"),
+											Keys: []Key{
+												{
+													Int: ottltest.Intp(0),
+												},
+												{
+													String: ottltest.Strp("bar"),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						{
+							String: ottltest.Strp("dog"),
+						},
+					},
+				},
+				WhereClause: nil,
+			},
+		},
+		{
+			name:      "dynamic keys",
+			statement: `set(body[attributes["index"]], "dog")`,
+			expected: &parsedStatement{
+				Invocation: invocation{
+					Function: "set",
+					Arguments: []value{
+						{
+							Literal: &mathExprLiteral{
+								Path: &Path{
+									Fields: []Field{
+										{
+											Name: "body",
+											Keys: []Key{
+												{
+													Path: &Path{
+														Fields: []Field{
+															{
+																Name:   "attributes",
+																MapKey: ottltest.Strp("index"),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						{
+							String: ottltest.Strp("dog"),
+						},
+					},
+				},
+				WhereClause: nil,
+			},
+		},
 		{
 			name:      "where == clause",
 			statement: `set(foo.attributes["bar"].cat, "dog") where name == "fido"`,
@@ -1235,3 +1309,81 @@ func Test_Execute(t *testing.T) {
 		})
 	}
 }
+
+func Test_ParseConditions(t *testing.T) {
+	p := NewParser(
+		defaultFunctionsForTests(),
+		testParsePath,
+		testParseEnum,
+		component.TelemetrySettings{},
+	)
+
+	tests := []struct {
+		condition string
+		wantErr   bool
+	}{
+		{`name == "fido"`, false},
+		{`name != "fido" and (name == "pinger" or name == "/x/alive")`, false},
+		{`true`, false},
+		{`name == nil`, false},
+		{`name`, true},
+		{`name ==`, true},
+		{`== name`, true},
+		{`name = "fido"`, true},
+		{`(name == "fido"`, true},
+		{`unknown == "fido"`, true},
+		{`set(name, "fido")`, true},
+		{`set(name, "fido") where name == "fido"`, true},
+	}
+	pat := regexp.MustCompile("[^a-zA-Z0-9]+")
+	for _, tt := range tests {
+		name := pat.ReplaceAllString(tt.condition, "_")
+		t.Run(name, func(t *testing.T) {
+			conditions, err := p.ParseConditions([]string{tt.condition})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, conditions, 1)
+		})
+	}
+}
+
+func Test_Condition_Eval(t *testing.T) {
+	p := NewParser(
+		defaultFunctionsForTests(),
+		testParsePath,
+		testParseEnum,
+		component.TelemetrySettings{},
+	)
+
+	conditions, err := p.ParseConditions([]string{`name == "fido"`, `name == "fido" and false`})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		tCtx     interface{}
+		expected []bool
+	}{
+		{
+			name:     "matched",
+			tCtx:     "fido",
+			expected: []bool{true, false},
+		},
+		{
+			name:     "not matched",
+			tCtx:     "pinger",
+			expected: []bool{false, false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i, condition := range conditions {
+				result, err := condition.Eval(context.Background(), tt.tCtx)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected[i], result)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileconsumer // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	compressionNone = ""
+	compressionGzip = "gzip"
+	compressionZstd = "zstd"
+	compressionAuto = "auto"
+)
+
+func validateCompression(compression string) error {
+	switch compression {
+	case compressionNone, compressionGzip, compressionZstd, compressionAuto:
+		return nil
+	default:
+		return fmt.Errorf("invalid compression '%s', supported: gzip, zstd, auto", compression)
+	}
+}
+
+// fileCompression returns the compression of the file at the given path.
+// With auto detection, the compression is determined by the file extension.
+func fileCompression(compression string, path string) string {
+	if compression != compressionAuto {
+		return compression
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".gz":
+		return compressionGzip
+	case ".zst":
+		return compressionZstd
+	default:
+		return compressionNone
+	}
+}
+
+// newDecompressor returns a reader of the decompressed contents of src.
+func newDecompressor(compression string, src io.Reader) (io.ReadCloser, error) {
+	switch compression {
+	case compressionGzip:
+		return gzip.NewReader(src)
+	case compressionZstd:
+		dec, err := zstd.NewReader(src, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		return dec.IOReadCloser(), nil
+	default:
+		return nil, fmt.Errorf("unsupported compression '%s'", compression)
+	}
+}
+
+// countingReader counts the bytes read from the underlying reader.
+type countingReader struct {
+	io.Reader
+	count int64
+}
+
+func (c *countingReader) Read(dst []byte) (int, error) {
+	n, err := c.Reader.Read(dst)
+	c.count += int64(n)
+	return n, err
+}
@@ -0,0 +1,244 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileconsumer
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func gzipBytes(t *testing.T, s string) []byte {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, err := w.Write([]byte(s))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func zstdBytes(t *testing.T, s string) []byte {
+	var buf bytes.Buffer
+	w, err := zstd.NewWriter(&buf)
+	require.NoError(t, err)
+	_, err = w.Write([]byte(s))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func appendFile(t *testing.T, path string, b []byte) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	require.NoError(t, err)
+	_, err = file.Write(b)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+}
+
+func TestFileCompression(t *testing.T) {
+	cases := []struct {
+		compression string
+		path        string
+		expected    string
+	}{
+		{compressionNone, "app.log.gz", compressionNone},
+		{compressionGzip, "app.log", compressionGzip},
+		{compressionZstd, "app.log", compressionZstd},
+		{compressionAuto, "app.log", compressionNone},
+		{compressionAuto, "app.log.gz", compressionGzip},
+		{compressionAuto, "app.log.GZ", compressionGzip},
+		{compressionAuto, "app.log.zst", compressionZstd},
+	}
+	for _, tc := range cases {
+		require.Equal(t, tc.expected, fileCompression(tc.compression, tc.path), "%s %s", tc.compression, tc.path)
+	}
+}
+
+func TestReadCompressedFiles(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.Compression = compressionAuto
+	operator, emitCalls := buildTestManager(t, cfg)
+	operator.persister = testutil.NewMockPersister("test")
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	appendFile(t, filepath.Join(tempDir, "app.log"), []byte("plain1\nplain2\n"))
+	appendFile(t, filepath.Join(tempDir, "app.log.1.gz"), gzipBytes(t, "gzip1\ngzip2\n"))
+	appendFile(t, filepath.Join(tempDir, "app.log.2.zst"), zstdBytes(t, "zstd1\nzstd2\n"))
+
+	operator.poll(context.Background())
+	waitForTokens(t, emitCalls, [][]byte{
+		[]byte("plain1"), []byte("plain2"),
+		[]byte("gzip1"), []byte("gzip2"),
+		[]byte("zstd1"), []byte("zstd2"),
+	})
+	expectNoTokens(t, emitCalls)
+
+	// compressed files are not read again
+	operator.poll(context.Background())
+	expectNoTokens(t, emitCalls)
+}
+
+// TestCompressedFileOffset tests that the offset of a compressed file is
+// checkpointed, so that only new compressed streams are read after a restart
+func TestCompressedFileOffset(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.Compression = compressionGzip
+	persister := testutil.NewMockPersister("test")
+	path := filepath.Join(tempDir, "app.log.gz")
+
+	appendFile(t, path, gzipBytes(t, "testlog1\ntestlog2\n"))
+
+	operatorOne, emitCallsOne := buildTestManager(t, cfg)
+	require.NoError(t, operatorOne.Start(persister))
+	waitForTokens(t, emitCallsOne, [][]byte{[]byte("testlog1"), []byte("testlog2")})
+	require.NoError(t, operatorOne.Stop())
+
+	// a gzip file may consist of multiple compressed streams
+	appendFile(t, path, gzipBytes(t, "testlog3\n"))
+
+	operatorTwo, emitCallsTwo := buildTestManager(t, cfg)
+	require.NoError(t, operatorTwo.Start(persister))
+	waitForToken(t, emitCallsTwo, []byte("testlog3"))
+	expectNoTokensUntil(t, emitCallsTwo, 100*time.Millisecond)
+	require.NoError(t, operatorTwo.Stop())
+}
+
+// TestCompressedFileStartAtEnd tests that the existing contents of compressed
+// files are skipped when `start_at` is configured to `end`
+func TestCompressedFileStartAtEnd(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.Compression = compressionGzip
+	operator, emitCalls := buildTestManager(t, cfg)
+	operator.persister = testutil.NewMockPersister("test")
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	path := filepath.Join(tempDir, "app.log.gz")
+	appendFile(t, path, gzipBytes(t, "testlog1\n"))
+
+	operator.poll(context.Background())
+	expectNoTokens(t, emitCalls)
+
+	appendFile(t, path, gzipBytes(t, "testlog2\n"))
+	operator.poll(context.Background())
+	waitForToken(t, emitCalls, []byte("testlog2"))
+}
+
+// TestIncompleteCompressedFile tests that a compressed file which is still being
+// written is read as far as possible, and only deleted once it is complete
+func TestIncompleteCompressedFile(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.Compression = compressionGzip
+	cfg.DeleteAfterRead = true
+	operator, emitCalls := buildTestManager(t, cfg)
+	operator.persister = testutil.NewMockPersister("test")
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	path := filepath.Join(tempDir, "app.log.gz")
+	compressed := gzipBytes(t, "testlog1\ntestlog2\n")
+	// leave out the gzip trailer
+	appendFile(t, path, compressed[:len(compressed)-8])
+
+	operator.poll(context.Background())
+	waitForTokens(t, emitCalls, [][]byte{[]byte("testlog1"), []byte("testlog2")})
+	require.FileExists(t, path)
+
+	appendFile(t, path, compressed[len(compressed)-8:])
+	operator.poll(context.Background())
+	expectNoTokens(t, emitCalls)
+	require.FileExists(t, path)
+
+	// the complete file is deleted once it is unchanged for another poll
+	operator.poll(context.Background())
+	expectNoTokens(t, emitCalls)
+	require.NoFileExists(t, path)
+}
+
+// TestCompressedFileNotDecompressedAgain tests that compressed files are only
+// decompressed again once their size or modification time changes
+func TestCompressedFileNotDecompressedAgain(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.Compression = compressionGzip
+	cfg.FingerprintSize = MinFingerprintSize
+	operator, emitCalls := buildTestManager(t, cfg)
+	operator.persister = testutil.NewMockPersister("test")
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	path := filepath.Join(tempDir, "app.log.gz")
+	compressed := gzipBytes(t, "testlog1\ntestlog2\n")
+	require.Greater(t, len(compressed), 2*MinFingerprintSize)
+	appendFile(t, path, compressed)
+
+	operator.poll(context.Background())
+	waitForTokens(t, emitCalls, [][]byte{[]byte("testlog1"), []byte("testlog2")})
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+
+	// replace the file with contents that cannot be decompressed, keeping its size,
+	// fingerprint and modification time, so that decompressing it again would fail
+	corrupted := append([]byte{}, compressed...)
+	for i := MinFingerprintSize; i < len(corrupted); i++ {
+		corrupted[i] = 0xff
+	}
+	require.NoError(t, os.WriteFile(path, corrupted, 0600))
+	require.NoError(t, os.Chtimes(path, info.ModTime(), info.ModTime()))
+
+	operator.poll(context.Background())
+	expectNoTokens(t, emitCalls)
+	reader := operator.knownFiles[len(operator.knownFiles)-1]
+	require.True(t, reader.decompressed.toEnd)
+
+	// once the file changes, it is decompressed again
+	require.NoError(t, os.Chtimes(path, info.ModTime(), info.ModTime().Add(time.Second)))
+	operator.poll(context.Background())
+	expectNoTokens(t, emitCalls)
+	reader = operator.knownFiles[len(operator.knownFiles)-1]
+	require.False(t, reader.decompressed.toEnd)
+}
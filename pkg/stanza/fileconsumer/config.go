@@ -16,6 +16,7 @@ package fileconsumer // import "github.com/open-telemetry/opentelemetry-collecto
 
 import (
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/bmatcuk/doublestar/v3"
@@ -58,6 +59,9 @@ type Config struct {
 	MaxLogSize              helper.ByteSize       `mapstructure:"max_log_size,omitempty"`
 	MaxConcurrentFiles      int                   `mapstructure:"max_concurrent_files,omitempty"`
 	Splitter                helper.SplitterConfig `mapstructure:",squash,omitempty"`
+	DeleteAfterRead         bool                  `mapstructure:"delete_after_read,omitempty"`
+	ArchiveDir              string                `mapstructure:"archive_dir,omitempty"`
+	Compression             string                `mapstructure:"compression,omitempty"`
 }
 
 // Build will build a file input operator from the supplied configuration
@@ -100,6 +104,10 @@ func (c Config) Build(logger *zap.SugaredLogger, emit EmitFunc) (*Manager, error
 		return nil, fmt.Errorf("`fingerprint_size` must be at least %d bytes", MinFingerprintSize)
 	}
 
+	if err := validateCompression(c.Compression); err != nil {
+		return nil, err
+	}
+
 	// Ensure that splitter is buildable
 	factory := newMultilineSplitterFactory(c.Splitter.EncodingConfig, c.Splitter.Flusher, c.Splitter.Multiline)
 	_, err := factory.Build(int(c.MaxLogSize))
@@ -117,6 +125,24 @@ func (c Config) Build(logger *zap.SugaredLogger, emit EmitFunc) (*Manager, error
 		return nil, fmt.Errorf("invalid start_at location '%s'", c.StartAt)
 	}
 
+	if c.DeleteAfterRead && c.ArchiveDir != "" {
+		return nil, fmt.Errorf("only one of `delete_after_read` and `archive_dir` can be set")
+	}
+
+	if (c.DeleteAfterRead || c.ArchiveDir != "") && !startAtBeginning {
+		return nil, fmt.Errorf("`delete_after_read` and `archive_dir` require `start_at` to be `beginning`")
+	}
+
+	if c.ArchiveDir != "" {
+		info, err := os.Stat(c.ArchiveDir)
+		if err != nil {
+			return nil, fmt.Errorf("stat `archive_dir`: %w", err)
+		}
+		if !info.IsDir() {
+			return nil, fmt.Errorf("`archive_dir` '%s' is not a directory", c.ArchiveDir)
+		}
+	}
+
 	return &Manager{
 		SugaredLogger: logger.With("component", "fileconsumer"),
 		cancel:        func() {},
@@ -125,17 +151,20 @@ func (c Config) Build(logger *zap.SugaredLogger, emit EmitFunc) (*Manager, error
 			readerConfig: &readerConfig{
 				fingerprintSize: int(c.FingerprintSize),
 				maxLogSize:      int(c.MaxLogSize),
+				compression:     c.Compression,
 				emit:            emit,
 			},
 			fromBeginning:   startAtBeginning,
 			splitterFactory: factory,
 			encodingConfig:  c.Splitter.EncodingConfig,
 		},
-		finder:        c.Finder,
-		roller:        newRoller(),
-		pollInterval:  c.PollInterval,
-		maxBatchFiles: c.MaxConcurrentFiles / 2,
-		knownFiles:    make([]*Reader, 0, 10),
-		seenPaths:     make(map[string]struct{}, 100),
+		finder:          c.Finder,
+		roller:          newRoller(),
+		pollInterval:    c.PollInterval,
+		maxBatchFiles:   c.MaxConcurrentFiles / 2,
+		deleteAfterRead: c.DeleteAfterRead,
+		archiveDir:      c.ArchiveDir,
+		knownFiles:      make([]*Reader, 0, 10),
+		seenPaths:       make(map[string]struct{}, 100),
 	}, nil
 }
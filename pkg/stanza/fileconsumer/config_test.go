@@ -296,6 +296,32 @@ func TestUnmarshal(t *testing.T) {
 					return newMockOperatorConfig(cfg)
 				}(),
 			},
+			{
+				Name: "delete_after_read",
+				Expect: func() *mockOperatorConfig {
+					cfg := NewConfig()
+					cfg.StartAt = "beginning"
+					cfg.DeleteAfterRead = true
+					return newMockOperatorConfig(cfg)
+				}(),
+			},
+			{
+				Name: "archive_dir",
+				Expect: func() *mockOperatorConfig {
+					cfg := NewConfig()
+					cfg.StartAt = "beginning"
+					cfg.ArchiveDir = "/var/log/archive"
+					return newMockOperatorConfig(cfg)
+				}(),
+			},
+			{
+				Name: "compression",
+				Expect: func() *mockOperatorConfig {
+					cfg := NewConfig()
+					cfg.Compression = "gzip"
+					return newMockOperatorConfig(cfg)
+				}(),
+			},
 			{
 				Name: "max_concurrent_large",
 				Expect: func() *mockOperatorConfig {
@@ -483,6 +509,82 @@ func TestBuild(t *testing.T) {
 			require.Error,
 			nil,
 		},
+		{
+			"DeleteAfterRead",
+			func(f *Config) {
+				f.StartAt = "beginning"
+				f.DeleteAfterRead = true
+			},
+			require.NoError,
+			func(t *testing.T, f *Manager) {
+				require.True(t, f.deleteAfterRead)
+			},
+		},
+		{
+			"ArchiveDir",
+			func(f *Config) {
+				f.StartAt = "beginning"
+				f.ArchiveDir = "."
+			},
+			require.NoError,
+			func(t *testing.T, f *Manager) {
+				require.Equal(t, ".", f.archiveDir)
+			},
+		},
+		{
+			"DeleteAfterReadAndArchiveDir",
+			func(f *Config) {
+				f.StartAt = "beginning"
+				f.DeleteAfterRead = true
+				f.ArchiveDir = "."
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"DeleteAfterReadStartAtEnd",
+			func(f *Config) {
+				f.DeleteAfterRead = true
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"CompressionAuto",
+			func(f *Config) {
+				f.Compression = "auto"
+			},
+			require.NoError,
+			func(t *testing.T, f *Manager) {
+				require.Equal(t, "auto", f.readerFactory.readerConfig.compression)
+			},
+		},
+		{
+			"InvalidCompression",
+			func(f *Config) {
+				f.Compression = "lz4"
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"ArchiveDirNotExists",
+			func(f *Config) {
+				f.StartAt = "beginning"
+				f.ArchiveDir = "testdata/missing"
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"ArchiveDirNotDirectory",
+			func(f *Config) {
+				f.StartAt = "beginning"
+				f.ArchiveDir = "testdata/config.yaml"
+			},
+			require.Error,
+			nil,
+		},
 	}
 
 	for _, tc := range cases {
@@ -18,8 +18,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"sync"
 	"time"
 
@@ -28,6 +30,9 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
 )
 
+// archiveTimeFormat is the format of the time suffixed to the names of archived files
+const archiveTimeFormat = "20060102T150405.000000000Z"
+
 type EmitFunc func(ctx context.Context, attrs *FileAttributes, token []byte)
 
 type Manager struct {
@@ -43,6 +48,9 @@ type Manager struct {
 	pollInterval  time.Duration
 	maxBatchFiles int
 
+	deleteAfterRead bool
+	archiveDir      string
+
 	knownFiles []*Reader
 	seenPaths  map[string]struct{}
 }
@@ -142,9 +150,85 @@ func (m *Manager) consume(ctx context.Context, paths []string) {
 	// Any new files that appear should be consumed entirely
 	m.readerFactory.fromBeginning = true
 
-	m.roller.roll(ctx, readers)
 	m.saveCurrent(readers)
 	m.syncLastPollFiles(ctx)
+
+	// Files are only completed once their offsets have been persisted,
+	// so that they are not read again if the collector restarts
+	readers = m.completeFiles(readers)
+	m.roller.roll(ctx, readers)
+}
+
+// completeFiles deletes or archives the files which have been read entirely, according to
+// the configured completion policy. A file is only completed once it has remained unchanged
+// since the previous poll, so that a writer which is still appending to it is not cut off.
+// It returns the readers of the files which remain in place.
+func (m *Manager) completeFiles(readers []*Reader) []*Reader {
+	if !m.deleteAfterRead && m.archiveDir == "" {
+		return readers
+	}
+
+	remaining := make([]*Reader, 0, len(readers))
+	for _, reader := range readers {
+		readEntirely, err := reader.readEntirely()
+		if err != nil {
+			m.Errorw("Failed to check whether file was read entirely", "path", reader.file.Name(), zap.Error(err))
+			remaining = append(remaining, reader)
+			continue
+		}
+		if !readEntirely {
+			reader.completeOffset = -1
+			remaining = append(remaining, reader)
+			continue
+		}
+		if reader.completeOffset != reader.Offset {
+			// Read entirely for the first time, or more was read since the previous poll
+			reader.completeOffset = reader.Offset
+			remaining = append(remaining, reader)
+			continue
+		}
+
+		// Close the file first, since open files cannot be moved or removed on windows
+		path := reader.file.Name()
+		reader.Close()
+		if m.deleteAfterRead {
+			if err := os.Remove(path); err != nil {
+				m.Errorw("Failed to delete file", "path", path, zap.Error(err))
+				continue
+			}
+			m.Debugw("Deleted file", "path", path)
+			continue
+		}
+		archivePath, err := m.archivePath(path)
+		if err != nil {
+			m.Errorw("Failed to archive file", "path", path, zap.Error(err))
+			continue
+		}
+		if err := os.Rename(path, archivePath); err != nil {
+			m.Errorw("Failed to archive file", "path", path, zap.Error(err))
+			continue
+		}
+		m.Debugw("Archived file", "path", path, "archive_path", archivePath)
+	}
+	return remaining
+}
+
+// archivePath returns the path in the archive directory to which the file at the given path is moved.
+// The name of the file is suffixed with the time at which it is archived, so that files with the same
+// name, e.g. from different directories or recreated after being archived, do not overwrite each other.
+func (m *Manager) archivePath(path string) (string, error) {
+	name := fmt.Sprintf("%s.%s", filepath.Base(path), time.Now().UTC().Format(archiveTimeFormat))
+	archivePath := filepath.Join(m.archiveDir, name)
+	for i := 1; ; i++ {
+		_, err := os.Lstat(archivePath)
+		if errors.Is(err, os.ErrNotExist) {
+			return archivePath, nil
+		}
+		if err != nil {
+			return "", err
+		}
+		archivePath = filepath.Join(m.archiveDir, fmt.Sprintf("%s.%d", name, i))
+	}
 }
 
 // makeReaders takes a list of paths, then creates readers from each of those paths,
@@ -507,6 +507,161 @@ func TestNoNewline(t *testing.T) {
 	waitForToken(t, emitCalls, []byte("testlog2"))
 }
 
+// TestDeleteAfterRead tests that files are deleted once they have been read entirely
+// and have remained unchanged for another poll
+func TestDeleteAfterRead(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.DeleteAfterRead = true
+	operator, emitCalls := buildTestManager(t, cfg)
+	operator.persister = testutil.NewMockPersister("test")
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	complete := openTemp(t, tempDir)
+	writeString(t, complete, "testlog1\ntestlog2\n")
+
+	// the last line has not been flushed yet, so the file is not complete
+	partial := openTemp(t, tempDir)
+	writeString(t, partial, "testlog3\ntestlog4")
+
+	operator.poll(context.Background())
+	waitForTokens(t, emitCalls, [][]byte{[]byte("testlog1"), []byte("testlog2"), []byte("testlog3")})
+
+	// files are not deleted at the first poll at which they are read entirely
+	require.FileExists(t, complete.Name())
+	require.FileExists(t, partial.Name())
+
+	operator.poll(context.Background())
+
+	require.NoFileExists(t, complete.Name())
+	require.FileExists(t, partial.Name())
+
+	// the offsets are persisted before the file is deleted
+	checkpoint, err := operator.persister.Get(context.Background(), knownFilesKey)
+	require.NoError(t, err)
+	require.NotEmpty(t, checkpoint)
+}
+
+// TestDeleteAfterReadAppended tests that files which are appended to between polls are not deleted
+func TestDeleteAfterReadAppended(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.DeleteAfterRead = true
+	operator, emitCalls := buildTestManager(t, cfg)
+	operator.persister = testutil.NewMockPersister("test")
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	temp := openTemp(t, tempDir)
+	writeString(t, temp, "testlog1\n")
+
+	operator.poll(context.Background())
+	waitForToken(t, emitCalls, []byte("testlog1"))
+
+	writeString(t, temp, "testlog2\n")
+	operator.poll(context.Background())
+	waitForToken(t, emitCalls, []byte("testlog2"))
+	require.FileExists(t, temp.Name())
+
+	operator.poll(context.Background())
+	expectNoTokens(t, emitCalls)
+	require.NoFileExists(t, temp.Name())
+}
+
+// TestArchiveAfterRead tests that files are moved to the archive directory once they have been read entirely
+func TestArchiveAfterRead(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	archiveDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.ArchiveDir = archiveDir
+	operator, emitCalls := buildTestManager(t, cfg)
+	operator.persister = testutil.NewMockPersister("test")
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	temp := openTemp(t, tempDir)
+	writeString(t, temp, "testlog1\ntestlog2\n")
+
+	operator.poll(context.Background())
+	waitForTokens(t, emitCalls, [][]byte{[]byte("testlog1"), []byte("testlog2")})
+	require.FileExists(t, temp.Name())
+
+	operator.poll(context.Background())
+	expectNoTokens(t, emitCalls)
+	require.NoFileExists(t, temp.Name())
+
+	archived, err := filepath.Glob(filepath.Join(archiveDir, filepath.Base(temp.Name())+".*"))
+	require.NoError(t, err)
+	require.Len(t, archived, 1)
+	content, err := os.ReadFile(archived[0])
+	require.NoError(t, err)
+	require.Equal(t, "testlog1\ntestlog2\n", string(content))
+
+	// the archived file is not read again
+	operator.poll(context.Background())
+	expectNoTokens(t, emitCalls)
+}
+
+// TestArchiveAfterReadSameName tests that archived files with the same name do not overwrite each other
+func TestArchiveAfterReadSameName(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	archiveDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.ArchiveDir = archiveDir
+	operator, emitCalls := buildTestManager(t, cfg)
+	operator.persister = testutil.NewMockPersister("test")
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	path := filepath.Join(tempDir, "app.log")
+	for _, line := range []string{"testlog1", "testlog2"} {
+		require.NoError(t, os.WriteFile(path, []byte(line+"\n"), 0600))
+		operator.poll(context.Background())
+		waitForToken(t, emitCalls, []byte(line))
+		operator.poll(context.Background())
+		require.NoFileExists(t, path)
+	}
+
+	archived, err := filepath.Glob(filepath.Join(archiveDir, "app.log.*"))
+	require.NoError(t, err)
+	require.Len(t, archived, 2)
+}
+
+// TestArchivePath tests that archive paths do not collide with existing archived files
+func TestArchivePath(t *testing.T) {
+	t.Parallel()
+
+	archiveDir := t.TempDir()
+	m := &Manager{archiveDir: archiveDir}
+
+	first, err := m.archivePath(filepath.Join("a", "app.log"))
+	require.NoError(t, err)
+	require.Equal(t, archiveDir, filepath.Dir(first))
+	require.NoError(t, os.WriteFile(first, nil, 0600))
+
+	// a file with the same name in the same instant gets another name
+	second, err := m.archivePath(filepath.Join("b", "app.log"))
+	require.NoError(t, err)
+	require.NotEqual(t, first, second)
+}
+
 // TestEmptyLine tests that the any empty lines are consumed
 func TestEmptyLine(t *testing.T) {
 	t.Parallel()
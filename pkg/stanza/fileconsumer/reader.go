@@ -17,8 +17,11 @@ package fileconsumer // import "github.com/open-telemetry/opentelemetry-collecto
 import (
 	"bufio"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -28,6 +31,7 @@ import (
 type readerConfig struct {
 	fingerprintSize int
 	maxLogSize      int
+	compression     string
 	emit            EmitFunc
 }
 
@@ -43,10 +47,43 @@ type Reader struct {
 	generation     int
 	file           *os.File
 	fileAttributes *FileAttributes
+
+	// compression of the file. The offset of a compressed file is a position
+	// in its decompressed contents.
+	compression string
+	// decompressed describes the compressed file when it was last decompressed.
+	decompressed decompressedState
+
+	// completeOffset is the offset at which the file was found to be read entirely
+	// at the previous poll, or -1 if it was not read entirely.
+	completeOffset int64
+}
+
+// decompressedState describes a compressed file when it was last decompressed.
+// Since the offset of a compressed file can only be reached by decompressing it
+// from the beginning, the file is only decompressed again once it has changed.
+type decompressedState struct {
+	size    int64
+	modTime time.Time
+	// toEnd is true if all the decompressed contents have been read
+	toEnd bool
+}
+
+func newDecompressedState(info os.FileInfo) decompressedState {
+	return decompressedState{size: info.Size(), modTime: info.ModTime()}
+}
+
+// unchanged returns true if the file has not changed since it was last decompressed
+func (s decompressedState) unchanged(info os.FileInfo) bool {
+	return !s.modTime.IsZero() && s.size == info.Size() && s.modTime.Equal(info.ModTime())
 }
 
 // offsetToEnd sets the starting offset
 func (r *Reader) offsetToEnd() error {
+	if r.compression != compressionNone {
+		return r.decompressedOffsetToEnd()
+	}
+
 	info, err := r.file.Stat()
 	if err != nil {
 		return fmt.Errorf("stat: %w", err)
@@ -55,29 +92,136 @@ func (r *Reader) offsetToEnd() error {
 	return nil
 }
 
+// decompressedOffsetToEnd sets the starting offset to the size of the decompressed contents
+func (r *Reader) decompressedOffsetToEnd() error {
+	info, err := r.file.Stat()
+	if err != nil {
+		return fmt.Errorf("stat: %w", err)
+	}
+	if _, err = r.file.Seek(0, 0); err != nil {
+		return fmt.Errorf("seek: %w", err)
+	}
+	dec, err := newDecompressor(r.compression, r.file)
+	if err != nil {
+		return fmt.Errorf("decompress: %w", err)
+	}
+	defer dec.Close()
+
+	size, err := io.Copy(io.Discard, dec)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return fmt.Errorf("decompress: %w", err)
+	}
+	r.Offset = size
+	r.decompressed = newDecompressedState(info)
+	r.decompressed.toEnd = err == nil
+	return nil
+}
+
+// readEntirely returns true if the contents of the file have been read up to its end
+func (r *Reader) readEntirely() (bool, error) {
+	if r.compression != compressionNone {
+		return r.decompressed.toEnd, nil
+	}
+
+	info, err := r.file.Stat()
+	if err != nil {
+		return false, fmt.Errorf("stat: %w", err)
+	}
+	return r.Offset >= info.Size(), nil
+}
+
 // ReadToEnd will read until the end of the file
 func (r *Reader) ReadToEnd(ctx context.Context) {
+	if r.compression != compressionNone {
+		r.readCompressedToEnd(ctx)
+		return
+	}
+
 	if _, err := r.file.Seek(r.Offset, 0); err != nil {
 		r.Errorw("Failed to seek", zap.Error(err))
 		return
 	}
 
-	scanner := NewPositionalScanner(r, r.maxLogSize, r.Offset, r.splitFunc)
+	r.scan(ctx, NewPositionalScanner(r, r.maxLogSize, r.Offset, r.splitFunc))
+}
+
+// readCompressedToEnd decompresses the file from the beginning, skips the contents
+// which have already been read, and reads the rest until the end of the file.
+// Files which have not changed since they were last decompressed are skipped.
+func (r *Reader) readCompressedToEnd(ctx context.Context) {
+	info, err := r.file.Stat()
+	if err != nil {
+		r.Errorw("Failed to stat", zap.Error(err))
+		return
+	}
+	if r.decompressed.unchanged(info) {
+		return
+	}
+	// the state is only updated once nothing more can be read from the file until it changes
+	state := newDecompressedState(info)
+	r.decompressed.toEnd = false
+
+	if _, err = r.file.Seek(0, 0); err != nil {
+		r.Errorw("Failed to seek", zap.Error(err))
+		return
+	}
+
+	dec, err := newDecompressor(r.compression, r.file)
+	if err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			r.Debugw("Compressed file is incomplete", zap.Error(err))
+			r.decompressed = state
+			return
+		}
+		r.Errorw("Failed to decompress", zap.Error(err))
+		return
+	}
+	defer dec.Close()
+
+	if _, err = io.CopyN(io.Discard, dec, r.Offset); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			r.Debugw("Compressed file is incomplete", zap.Error(err))
+			r.decompressed = state
+			return
+		}
+		r.Errorw("Failed to skip to offset", zap.Error(err))
+		return
+	}
+
+	counter := &countingReader{Reader: dec}
+	scanner := NewPositionalScanner(counter, r.maxLogSize, r.Offset, r.splitFunc)
+	startOffset := r.Offset
+	if r.scan(ctx, scanner) {
+		state.toEnd = r.Offset == startOffset+counter.count
+		r.decompressed = state
+	} else if errors.Is(scanner.Err(), io.ErrUnexpectedEOF) {
+		r.decompressed = state
+	}
+}
+
+// scan emits the tokens of the scanner. It returns true if the scanner reached the end of its input.
+func (r *Reader) scan(ctx context.Context, scanner *PositionalScanner) bool {
 
 	// Iterate over the tokenized file, emitting entries as we go
 	for {
 		select {
 		case <-ctx.Done():
-			return
+			return false
 		default:
 		}
 
 		ok := scanner.Scan()
 		if !ok {
+			if errors.Is(scanner.Err(), io.ErrUnexpectedEOF) {
+				// a compressed file which is still being written
+				r.Debugw("Compressed file is incomplete", zap.Error(scanner.Err()))
+				return false
+			}
 			if err := scanner.getError(); err != nil {
 				r.Errorw("Failed during scan", zap.Error(err))
+				return false
 			}
-			break
+			return true
 		}
 
 		token, err := r.encoding.Decode(scanner.Bytes())
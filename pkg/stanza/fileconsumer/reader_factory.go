@@ -44,6 +44,8 @@ func (f *readerFactory) copy(old *Reader, newFile *os.File) (*Reader, error) {
 		withFile(newFile).
 		withFingerprint(old.Fingerprint.Copy()).
 		withOffset(old.Offset).
+		withCompleteOffset(old.completeOffset).
+		withDecompressedState(old.decompressed).
 		withSplitterFunc(old.splitFunc).
 		build()
 }
@@ -58,14 +60,16 @@ func (f *readerFactory) newFingerprint(file *os.File) (*Fingerprint, error) {
 
 type readerBuilder struct {
 	*readerFactory
-	file      *os.File
-	fp        *Fingerprint
-	offset    int64
-	splitFunc bufio.SplitFunc
+	file           *os.File
+	fp             *Fingerprint
+	offset         int64
+	completeOffset int64
+	decompressed   decompressedState
+	splitFunc      bufio.SplitFunc
 }
 
 func (f *readerFactory) newReaderBuilder() *readerBuilder {
-	return &readerBuilder{readerFactory: f}
+	return &readerBuilder{readerFactory: f, completeOffset: -1}
 }
 
 func (b *readerBuilder) withSplitterFunc(s bufio.SplitFunc) *readerBuilder {
@@ -88,10 +92,22 @@ func (b *readerBuilder) withOffset(offset int64) *readerBuilder {
 	return b
 }
 
+func (b *readerBuilder) withCompleteOffset(offset int64) *readerBuilder {
+	b.completeOffset = offset
+	return b
+}
+
+func (b *readerBuilder) withDecompressedState(state decompressedState) *readerBuilder {
+	b.decompressed = state
+	return b
+}
+
 func (b *readerBuilder) build() (r *Reader, err error) {
 	r = &Reader{
-		readerConfig: b.readerConfig,
-		Offset:       b.offset,
+		readerConfig:   b.readerConfig,
+		Offset:         b.offset,
+		completeOffset: b.completeOffset,
+		decompressed:   b.decompressed,
 	}
 
 	if b.splitFunc != nil {
@@ -112,6 +128,7 @@ func (b *readerBuilder) build() (r *Reader, err error) {
 	if b.file != nil {
 		r.file = b.file
 		r.SugaredLogger = b.SugaredLogger.With("path", b.file.Name())
+		r.compression = fileCompression(b.readerConfig.compression, b.file.Name())
 		r.fileAttributes, err = resolveFileAttributes(b.file.Name())
 		if err != nil {
 			b.Errorf("resolve attributes: %w", err)
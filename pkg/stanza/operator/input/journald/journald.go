@@ -25,6 +25,7 @@ import (
 	"io"
 	"os/exec"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -65,6 +66,10 @@ type Config struct {
 	StartAt   string   `mapstructure:"start_at,omitempty"`
 	Units     []string `mapstructure:"units,omitempty"`
 	Priority  string   `mapstructure:"priority,omitempty"`
+
+	// UnitAsResource sets the unit of each entry as a resource attribute, rather than a log
+	// attribute, so that the entries of each unit are grouped under a resource of their own.
+	UnitAsResource bool `mapstructure:"unit_as_resource,omitempty"`
 }
 
 // Build will build a journald input operator from the supplied configuration
@@ -94,9 +99,15 @@ func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 	}
 
 	for _, unit := range c.Units {
+		if unit == "" {
+			return nil, errors.New("'units' must not contain empty patterns")
+		}
 		args = append(args, "--unit", unit)
 	}
 
+	if err := validatePriority(c.Priority); err != nil {
+		return nil, err
+	}
 	args = append(args, "--priority", c.Priority)
 
 	switch {
@@ -111,16 +122,63 @@ func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 	return &Input{
 		InputOperator: inputOperator,
 		newCmd: func(ctx context.Context, cursor []byte) cmd {
+			// copy the arguments, so restarting with a new cursor does not accumulate them
+			cmdArgs := append(make([]string, 0, len(args)+2), args...)
 			if cursor != nil {
-				args = append(args, "--after-cursor", string(cursor))
+				cmdArgs = append(cmdArgs, "--after-cursor", string(cursor))
 			}
-			return exec.CommandContext(ctx, "journalctl", args...) // #nosec - ...
+			return exec.CommandContext(ctx, "journalctl", cmdArgs...) // #nosec - ...
 			// journalctl is an executable that is required for this operator to function
 		},
-		json: jsoniter.ConfigFastest,
+		json:           jsoniter.ConfigFastest,
+		unitAsResource: c.UnitAsResource,
 	}, nil
 }
 
+// priorities are the journald priority levels, indexed by their numeric value
+var priorities = []string{"emerg", "alert", "crit", "err", "warning", "notice", "info", "debug"}
+
+// severities are the severities of the journald priority levels, as mapped by the syslog parser
+var severities = []entry.Severity{entry.Fatal, entry.Error3, entry.Error2, entry.Error, entry.Warn, entry.Info2, entry.Info, entry.Debug}
+
+const (
+	unitAttribute     = "journald.unit"
+	priorityAttribute = "journald.priority"
+)
+
+// attributeFields are the journald fields copied to attributes, keyed by attribute name
+var attributeFields = map[string]string{
+	"journald.boot_id":   "_BOOT_ID",
+	"journald.transport": "_TRANSPORT",
+}
+
+// validatePriority checks that priority is a single level or a "FROM..TO" range
+// of levels, each given either by name or by number.
+func validatePriority(priority string) error {
+	levels := strings.Split(priority, "..")
+	if len(levels) > 2 {
+		return fmt.Errorf("invalid value '%s' for parameter 'priority'", priority)
+	}
+	for _, level := range levels {
+		if !isPriorityLevel(level) {
+			return fmt.Errorf("invalid value '%s' for parameter 'priority'", priority)
+		}
+	}
+	return nil
+}
+
+func isPriorityLevel(level string) bool {
+	if n, err := strconv.Atoi(level); err == nil {
+		return n >= 0 && n < len(priorities)
+	}
+	for _, p := range priorities {
+		if strings.EqualFold(level, p) {
+			return true
+		}
+	}
+	return false
+}
+
 // Input is an operator that process logs using journald
 type Input struct {
 	helper.InputOperator
@@ -130,7 +188,9 @@ type Input struct {
 	persister operator.Persister
 	json      jsoniter.API
 	cancel    context.CancelFunc
-	wg        sync.WaitGroup
+
+	unitAsResource bool
+	wg             sync.WaitGroup
 }
 
 type cmd interface {
@@ -235,9 +295,42 @@ func (operator *Input) parseJournalEntry(line []byte) (*entry.Entry, string, err
 	}
 
 	entry.Timestamp = time.Unix(0, timestampInt*1000) // in microseconds
+	operator.addFields(entry, body)
 	return entry, cursorString, nil
 }
 
+// addFields adds the unit, priority, boot ID and transport of a journal entry to the
+// attributes of the log entry, and sets its severity from the priority.
+func (operator *Input) addFields(e *entry.Entry, body map[string]interface{}) {
+	if e.Attributes == nil {
+		e.Attributes = map[string]interface{}{}
+	}
+	for attribute, field := range attributeFields {
+		if value, ok := body[field].(string); ok {
+			e.Attributes[attribute] = value
+		}
+	}
+
+	if unit, ok := body["_SYSTEMD_UNIT"].(string); ok {
+		if operator.unitAsResource {
+			if e.Resource == nil {
+				e.Resource = map[string]interface{}{}
+			}
+			e.Resource[unitAttribute] = unit
+		} else {
+			e.Attributes[unitAttribute] = unit
+		}
+	}
+
+	if priority, ok := body["PRIORITY"].(string); ok {
+		if n, err := strconv.Atoi(priority); err == nil && n >= 0 && n < len(priorities) {
+			e.Attributes[priorityAttribute] = priorities[n]
+			e.Severity = severities[n]
+			e.SeverityText = priorities[n]
+		}
+	}
+}
+
 // Stop will stop generating logs.
 func (operator *Input) Stop() error {
 	operator.cancel()
@@ -21,6 +21,7 @@ import (
 	"bytes"
 	"context"
 	"io"
+	"os/exec"
 	"testing"
 	"time"
 
@@ -111,7 +112,167 @@ func TestInputJournald(t *testing.T) {
 	select {
 	case e := <-received:
 		require.Equal(t, expected, e.Body)
+		require.Equal(t, map[string]interface{}{
+			"journald.unit":      "user@1000.service",
+			"journald.priority":  "info",
+			"journald.boot_id":   "c4fa36de06824d21835c05ff80c54468",
+			"journald.transport": "journal",
+		}, e.Attributes)
+		require.Nil(t, e.Resource)
+		require.Equal(t, entry.Info, e.Severity)
+		require.Equal(t, "info", e.SeverityText)
 	case <-time.After(time.Second):
 		require.FailNow(t, "Timed out waiting for entry to be read")
 	}
 }
+
+func TestInputJournaldUnitAsResource(t *testing.T) {
+	cfg := NewConfigWithID("my_journald_input")
+	cfg.OutputIDs = []string{"output"}
+	cfg.UnitAsResource = true
+
+	op, err := cfg.Build(testutil.Logger(t))
+	require.NoError(t, err)
+
+	mockOutput := testutil.NewMockOperator("output")
+	received := make(chan *entry.Entry)
+	mockOutput.On("Process", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		received <- args.Get(1).(*entry.Entry)
+	}).Return(nil)
+	require.NoError(t, op.SetOutputs([]operator.Operator{mockOutput}))
+
+	op.(*Input).newCmd = func(ctx context.Context, cursor []byte) cmd {
+		return &fakeJournaldCmd{}
+	}
+
+	require.NoError(t, op.Start(testutil.NewMockPersister("test")))
+	defer func() {
+		require.NoError(t, op.Stop())
+	}()
+
+	select {
+	case e := <-received:
+		require.Equal(t, map[string]interface{}{"journald.unit": "user@1000.service"}, e.Resource)
+		require.NotContains(t, e.Attributes, "journald.unit")
+		require.Equal(t, "info", e.Attributes["journald.priority"])
+	case <-time.After(time.Second):
+		require.FailNow(t, "Timed out waiting for entry to be read")
+	}
+}
+
+func TestInputJournaldCursor(t *testing.T) {
+	cfg := NewConfigWithID("my_journald_input")
+	cfg.OutputIDs = []string{"output"}
+
+	op, err := cfg.Build(testutil.Logger(t))
+	require.NoError(t, err)
+
+	mockOutput := testutil.NewMockOperator("output")
+	received := make(chan *entry.Entry)
+	mockOutput.On("Process", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		received <- args.Get(1).(*entry.Entry)
+	}).Return(nil)
+	require.NoError(t, op.SetOutputs([]operator.Operator{mockOutput}))
+
+	var startCursor []byte
+	op.(*Input).newCmd = func(ctx context.Context, cursor []byte) cmd {
+		startCursor = cursor
+		return &fakeJournaldCmd{}
+	}
+
+	persister := testutil.NewMockPersister("test")
+	require.NoError(t, persister.Set(context.Background(), lastReadCursorKey, []byte("previous")))
+
+	require.NoError(t, op.Start(persister))
+	select {
+	case <-received:
+	case <-time.After(time.Second):
+		require.FailNow(t, "Timed out waiting for entry to be read")
+	}
+	require.NoError(t, op.Stop())
+
+	require.Equal(t, []byte("previous"), startCursor)
+	cursor, err := persister.Get(context.Background(), lastReadCursorKey)
+	require.NoError(t, err)
+	require.Equal(t, "s=b1e713b587ae4001a9ca482c4b12c005;i=1eed30;b=c4fa36de06824d21835c05ff80c54468;m=9f9d630205;t=5a369604ee333;x=16c2d4fd4fdb7c36", string(cursor))
+}
+
+func TestBuildConfig(t *testing.T) {
+	testCases := []struct {
+		name         string
+		modifyConfig func(cfg *Config)
+		expectedArgs []string
+		expectedErr  string
+	}{
+		{
+			name:         "Default",
+			modifyConfig: func(cfg *Config) {},
+			expectedArgs: []string{"--utc", "--output=json", "--follow", "--priority", "info"},
+		},
+		{
+			name: "UnitPatterns",
+			modifyConfig: func(cfg *Config) {
+				cfg.Units = []string{"ssh", "docker*", "kube?et"}
+			},
+			expectedArgs: []string{"--utc", "--output=json", "--follow", "--unit", "ssh", "--unit", "docker*", "--unit", "kube?et", "--priority", "info"},
+		},
+		{
+			name: "PriorityRange",
+			modifyConfig: func(cfg *Config) {
+				cfg.Priority = "emerg..warning"
+			},
+			expectedArgs: []string{"--utc", "--output=json", "--follow", "--priority", "emerg..warning"},
+		},
+		{
+			name: "NumericPriorityRange",
+			modifyConfig: func(cfg *Config) {
+				cfg.Priority = "0..4"
+			},
+			expectedArgs: []string{"--utc", "--output=json", "--follow", "--priority", "0..4"},
+		},
+		{
+			name: "InvalidPriority",
+			modifyConfig: func(cfg *Config) {
+				cfg.Priority = "loud"
+			},
+			expectedErr: "invalid value 'loud' for parameter 'priority'",
+		},
+		{
+			name: "InvalidPriorityRange",
+			modifyConfig: func(cfg *Config) {
+				cfg.Priority = "err..8"
+			},
+			expectedErr: "invalid value 'err..8' for parameter 'priority'",
+		},
+		{
+			name: "EmptyUnit",
+			modifyConfig: func(cfg *Config) {
+				cfg.Units = []string{"ssh", ""}
+			},
+			expectedErr: "'units' must not contain empty patterns",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := NewConfigWithID("my_journald_input")
+			tc.modifyConfig(cfg)
+
+			op, err := cfg.Build(testutil.Logger(t))
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			journal := op.(*Input).newCmd(context.Background(), nil).(*exec.Cmd)
+			require.Equal(t, tc.expectedArgs, journal.Args[1:])
+
+			// a cursor is only added to the command it is created for
+			journal = op.(*Input).newCmd(context.Background(), []byte("cursor")).(*exec.Cmd)
+			require.Equal(t, append(tc.expectedArgs, "--after-cursor", "cursor"), journal.Args[1:])
+			journal = op.(*Input).newCmd(context.Background(), nil).(*exec.Cmd)
+			require.Equal(t, tc.expectedArgs, journal.Args[1:])
+		})
+	}
+}
@@ -15,10 +15,14 @@
 package syslog // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/input/syslog"
 
 import (
+	"bufio"
+	"bytes"
 	"errors"
 	"fmt"
+	"strconv"
 
 	"go.uber.org/zap"
+	"golang.org/x/text/encoding"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
@@ -70,6 +74,9 @@ func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 	if c.TCP != nil {
 		tcpInputCfg := tcp.NewConfigWithID(inputBase.ID() + "_internal_tcp")
 		tcpInputCfg.BaseConfig = *c.TCP
+		if syslogParserCfg.EnableOctetCounting {
+			tcpInputCfg.SplitFuncBuilder = OctetSplitFuncBuilder
+		}
 
 		tcpInput, err := tcpInputCfg.Build(logger)
 		if err != nil {
@@ -147,3 +154,49 @@ func (t *Input) SetOutputs(operators []operator.Operator) error {
 	t.parser.SetOutputIDs(t.GetOutputIDs())
 	return t.parser.SetOutputs(operators)
 }
+
+// OctetSplitFuncBuilder builds a split function which frames the stream of a
+// connection according to RFC 6587 octet counting.
+func OctetSplitFuncBuilder(_ encoding.Encoding, maxLogSize int) (bufio.SplitFunc, error) {
+	return newOctetFrameSplitFunc(maxLogSize), nil
+}
+
+// newOctetFrameSplitFunc returns a split function emitting "MSG-LEN SP SYSLOG-MSG"
+// frames, including the length prefix which is consumed by the syslog parser.
+func newOctetFrameSplitFunc(maxLogSize int) bufio.SplitFunc {
+	return func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+		// Skip any whitespace left between frames, e.g. by forwarders appending a newline
+		start := 0
+		for start < len(data) && (data[start] == '\n' || data[start] == '\r' || data[start] == ' ') {
+			start++
+		}
+		if start == len(data) {
+			return len(data), nil, nil
+		}
+
+		sp := bytes.IndexByte(data[start:], ' ')
+		if sp == -1 {
+			if atEOF {
+				return 0, nil, fmt.Errorf("incomplete octet counting frame header: %q", data[start:])
+			}
+			return start, nil, nil
+		}
+
+		msgLen, err := strconv.Atoi(string(data[start : start+sp]))
+		if err != nil || msgLen <= 0 {
+			return 0, nil, fmt.Errorf("invalid octet counting frame length: %q", data[start:start+sp])
+		}
+		if msgLen > maxLogSize {
+			return 0, nil, fmt.Errorf("octet counting frame length %d exceeds max_log_size %d", msgLen, maxLogSize)
+		}
+
+		end := start + sp + 1 + msgLen
+		if end > len(data) {
+			if atEOF {
+				return 0, nil, fmt.Errorf("incomplete octet counting frame: expected %d bytes", msgLen)
+			}
+			return start, nil, nil
+		}
+		return end, data[start:end], nil
+	}
+}
@@ -15,8 +15,10 @@
 package syslog
 
 import (
+	"bufio"
 	"fmt"
 	"net"
+	"strings"
 	"testing"
 	"time"
 
@@ -131,6 +133,113 @@ func TestSyslogIDs(t *testing.T) {
 	})
 }
 
+func TestOctetCountingOverTCP(t *testing.T) {
+	syslogCfg := syslog.NewConfigWithID("test_syslog_parser")
+	syslogCfg.Protocol = syslog.RFC5424
+	syslogCfg.EnableOctetCounting = true
+	cfg := NewConfigWithTCP(&syslogCfg.BaseConfig)
+
+	op, err := cfg.Build(testutil.Logger(t))
+	require.NoError(t, err)
+
+	fake := testutil.NewFakeOutput(t)
+	p, err := pipeline.NewDirectedPipeline([]operator.Operator{op, fake})
+	require.NoError(t, err)
+	require.NoError(t, p.Start(testutil.NewMockPersister("test")))
+	defer func() {
+		require.NoError(t, p.Stop())
+	}()
+
+	conn, err := net.Dial("tcp", cfg.TCP.ListenAddress)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	messages := []string{
+		"<86>1 2015-08-05T21:58:59.693Z host app 1 ID1 - first message",
+		"<86>1 2015-08-05T21:58:59.693Z host app 1 ID2 - second\nmessage spanning lines",
+	}
+	var frames strings.Builder
+	for _, msg := range messages {
+		fmt.Fprintf(&frames, "%d %s", len(msg), msg)
+	}
+	_, err = conn.Write([]byte(frames.String()))
+	require.NoError(t, err)
+
+	for _, expected := range []string{"first message", "second\nmessage spanning lines"} {
+		select {
+		case e := <-fake.Received:
+			require.Equal(t, expected, e.Attributes["message"])
+		case <-time.After(time.Second):
+			require.FailNow(t, "Timed out waiting for entry to be processed")
+		}
+	}
+}
+
+func TestOctetSplitFunc(t *testing.T) {
+	testCases := []struct {
+		name        string
+		input       string
+		expected    []string
+		expectedErr string
+	}{
+		{
+			name:     "Single",
+			input:    "5 hello",
+			expected: []string{"5 hello"},
+		},
+		{
+			name:     "Multiple",
+			input:    "5 hello6 world!",
+			expected: []string{"5 hello", "6 world!"},
+		},
+		{
+			name:     "TrailingNewlines",
+			input:    "5 hello\n6 world!\n",
+			expected: []string{"5 hello", "6 world!"},
+		},
+		{
+			name:     "EmbeddedNewline",
+			input:    "11 hello\nworld",
+			expected: []string{"11 hello\nworld"},
+		},
+		{
+			name:        "InvalidLength",
+			input:       "abc hello",
+			expectedErr: "invalid octet counting frame length",
+		},
+		{
+			name:        "TooLong",
+			input:       "100 hello",
+			expectedErr: "exceeds max_log_size",
+		},
+		{
+			name:        "Incomplete",
+			input:       "10 hello",
+			expectedErr: "incomplete octet counting frame",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			splitFunc, err := OctetSplitFuncBuilder(nil, 64)
+			require.NoError(t, err)
+
+			scanner := bufio.NewScanner(strings.NewReader(tc.input))
+			scanner.Split(splitFunc)
+			var tokens []string
+			for scanner.Scan() {
+				tokens = append(tokens, scanner.Text())
+			}
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, scanner.Err(), tc.expectedErr)
+				return
+			}
+			require.NoError(t, scanner.Err())
+			require.Equal(t, tc.expected, tokens)
+		})
+	}
+}
+
 func NewConfigWithTCP(syslogCfg *syslog.BaseConfig) *Config {
 	cfg := NewConfigWithID("test_syslog")
 	cfg.BaseConfig = *syslogCfg
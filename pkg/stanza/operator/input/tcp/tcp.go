@@ -28,6 +28,7 @@ import (
 	"github.com/jpillora/backoff"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.uber.org/zap"
+	"golang.org/x/text/encoding"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
@@ -79,6 +80,17 @@ type BaseConfig struct {
 	AddAttributes bool                        `mapstructure:"add_attributes,omitempty"`
 	Encoding      helper.EncodingConfig       `mapstructure:",squash,omitempty"`
 	Multiline     helper.MultilineConfig      `mapstructure:"multiline,omitempty"`
+
+	// SplitFuncBuilder overrides the multiline configuration when framing is
+	// dictated by the protocol carried over the connection.
+	SplitFuncBuilder SplitFuncBuilder `mapstructure:"-"`
+}
+
+// SplitFuncBuilder builds the function used to split a connection's stream into entries.
+type SplitFuncBuilder func(enc encoding.Encoding, maxLogSize int) (bufio.SplitFunc, error)
+
+func (c BaseConfig) defaultSplitFuncBuilder(enc encoding.Encoding, maxLogSize int) (bufio.SplitFunc, error) {
+	return c.Multiline.Build(enc, true, nil, maxLogSize)
 }
 
 // Build will build a tcp input operator.
@@ -111,8 +123,12 @@ func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 		return nil, err
 	}
 
-	// Build multiline
-	splitFunc, err := c.Multiline.Build(encoding.Encoding, true, nil, int(c.MaxLogSize))
+	if c.SplitFuncBuilder == nil {
+		c.SplitFuncBuilder = c.defaultSplitFuncBuilder
+	}
+
+	// Build split func
+	splitFunc, err := c.SplitFuncBuilder(encoding.Encoding, int(c.MaxLogSize))
 	if err != nil {
 		return nil, err
 	}
@@ -19,6 +19,7 @@ import (
 	"math/rand"
 	"net"
 	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 	"time"
@@ -379,6 +380,82 @@ func TestTLSTCPInput(t *testing.T) {
 	t.Run("CarriageReturn", tlsInputTest([]byte("message\r\n"), []string{"message"}))
 }
 
+func TestMutualTLSTCPInput(t *testing.T) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "test.crt")
+	keyFile := filepath.Join(dir, "test.key")
+	require.NoError(t, os.WriteFile(certFile, []byte(testTLSCertificate+"\n"), 0600))
+	require.NoError(t, os.WriteFile(keyFile, []byte(testTLSPrivateKey+"\n"), 0600))
+
+	cfg := NewConfigWithID("test_id")
+	cfg.ListenAddress = ":0"
+	cfg.TLS = &configtls.TLSServerSetting{
+		TLSSetting: configtls.TLSSetting{
+			CertFile: certFile,
+			KeyFile:  keyFile,
+		},
+		ClientCAFile: certFile,
+	}
+
+	op, err := cfg.Build(testutil.Logger(t))
+	require.NoError(t, err)
+
+	mockOutput := testutil.Operator{}
+	tcpInput := op.(*Input)
+	tcpInput.InputOperator.OutputOperators = []operator.Operator{&mockOutput}
+
+	entryChan := make(chan *entry.Entry, 1)
+	mockOutput.On("Process", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		entryChan <- args.Get(1).(*entry.Entry)
+	}).Return(nil)
+
+	require.NoError(t, tcpInput.Start(testutil.NewMockPersister("test")))
+	defer func() {
+		require.NoError(t, tcpInput.Stop(), "expected to stop tcp input operator without error")
+	}()
+
+	t.Run("WithClientCertificate", func(t *testing.T) {
+		clientCert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		require.NoError(t, err)
+
+		conn, err := tls.Dial("tcp", tcpInput.listener.Addr().String(), &tls.Config{
+			InsecureSkipVerify: true, // nolint:gosec
+			Certificates:       []tls.Certificate{clientCert},
+		})
+		require.NoError(t, err)
+		defer conn.Close()
+
+		_, err = conn.Write([]byte("message\n"))
+		require.NoError(t, err)
+
+		select {
+		case entry := <-entryChan:
+			require.Equal(t, "message", entry.Body)
+		case <-time.After(time.Second):
+			require.FailNow(t, "Timed out waiting for message to be written")
+		}
+	})
+
+	t.Run("WithoutClientCertificate", func(t *testing.T) {
+		conn, err := tls.Dial("tcp", tcpInput.listener.Addr().String(), &tls.Config{
+			InsecureSkipVerify: true, // nolint:gosec
+		})
+		if err == nil {
+			// With TLS 1.3 the client learns about the rejected handshake on its first read
+			defer conn.Close()
+			_, _ = conn.Write([]byte("message\n"))
+			_, err = conn.Read(make([]byte, 1))
+		}
+		require.Error(t, err)
+
+		select {
+		case entry := <-entryChan:
+			require.FailNow(t, "Unexpected entry: %s", entry)
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+}
+
 func TestFailToBind(t *testing.T) {
 	ip := "localhost"
 	port := 0
@@ -25,8 +25,10 @@ import (
 )
 
 var (
-	api = windows.NewLazySystemDLL("wevtapi.dll")
+	api      = windows.NewLazySystemDLL("wevtapi.dll")
+	kernel32 = windows.NewLazySystemDLL("kernel32.dll")
 
+	openSessionProc           SyscallProc = api.NewProc("EvtOpenSession")
 	subscribeProc             SyscallProc = api.NewProc("EvtSubscribe")
 	nextProc                  SyscallProc = api.NewProc("EvtNext")
 	renderProc                SyscallProc = api.NewProc("EvtRender")
@@ -35,6 +37,7 @@ var (
 	updateBookmarkProc        SyscallProc = api.NewProc("EvtUpdateBookmark")
 	openPublisherMetadataProc SyscallProc = api.NewProc("EvtOpenPublisherMetadata")
 	formatMessageProc         SyscallProc = api.NewProc("EvtFormatMessage")
+	localeNameToLCIDProc      SyscallProc = kernel32.NewProc("LocaleNameToLCID")
 )
 
 // SyscallProc is a syscall procedure.
@@ -64,6 +67,25 @@ const (
 	ErrorInvalidOperation syscall.Errno = 4317
 )
 
+const (
+	// EvtRPCLogin is the login class of a remote session, using an EvtRPCLoginInfo.
+	EvtRPCLogin uint32 = 1
+)
+
+const (
+	// EvtRPCLoginAuthDefault is a flag to use the default authentication method, which is Negotiate.
+	EvtRPCLoginAuthDefault uint32 = 0
+)
+
+// EvtRPCLoginInfo is the EVT_RPC_LOGIN structure (https://learn.microsoft.com/en-us/windows/win32/api/winevt/ns-winevt-evt_rpc_login)
+type EvtRPCLoginInfo struct {
+	Server   *uint16
+	User     *uint16
+	Domain   *uint16
+	Password *uint16
+	Flags    uint32
+}
+
 const (
 	// EvtFormatMessageXML is flag that formats a message as an XML string that contains all event details and message strings.
 	EvtFormatMessageXML uint32 = 9
@@ -76,6 +98,16 @@ const (
 	EvtRenderBookmark uint32 = 2
 )
 
+// evtOpenSession is the direct syscall implementation of EvtOpenSession (https://learn.microsoft.com/en-us/windows/win32/api/winevt/nf-winevt-evtopensession)
+func evtOpenSession(loginClass uint32, login *EvtRPCLoginInfo, timeout uint32, flags uint32) (uintptr, error) {
+	handle, _, err := openSessionProc.Call(uintptr(loginClass), uintptr(unsafe.Pointer(login)), uintptr(timeout), uintptr(flags))
+	if err != ErrorSuccess {
+		return 0, err
+	}
+
+	return handle, nil
+}
+
 // evtSubscribe is the direct syscall implementation of EvtSubscribe (https://docs.microsoft.com/en-us/windows/win32/api/winevt/nf-winevt-evtsubscribe)
 func evtSubscribe(session uintptr, signalEvent windows.Handle, channelPath *uint16, query *uint16, bookmark uintptr, context uintptr, callback uintptr, flags uint32) (uintptr, error) {
 	handle, _, err := subscribeProc.Call(session, uintptr(signalEvent), uintptr(unsafe.Pointer(channelPath)), uintptr(unsafe.Pointer(query)), bookmark, context, callback, uintptr(flags))
@@ -157,3 +189,13 @@ func evtFormatMessage(publisherMetadata uintptr, event uintptr, messageID uint32
 
 	return nil
 }
+
+// localeNameToLCID is the direct syscall implementation of LocaleNameToLCID (https://learn.microsoft.com/en-us/windows/win32/api/winnls/nf-winnls-localenametolcid)
+func localeNameToLCID(name *uint16, flags uint32) (uint32, error) {
+	lcid, _, err := localeNameToLCIDProc.Call(uintptr(unsafe.Pointer(name)), uintptr(flags))
+	if lcid == 0 {
+		return 0, err
+	}
+
+	return uint32(lcid), nil
+}
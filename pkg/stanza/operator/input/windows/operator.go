@@ -21,8 +21,10 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"syscall"
 	"time"
 
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
@@ -57,6 +59,16 @@ type Config struct {
 	MaxReads           int           `mapstructure:"max_reads,omitempty"`
 	StartAt            string        `mapstructure:"start_at,omitempty"`
 	PollInterval       time.Duration `mapstructure:"poll_interval,omitempty"`
+	Remote             RemoteConfig  `mapstructure:"remote,omitempty"`
+	Locale             string        `mapstructure:"locale,omitempty"`
+}
+
+// RemoteConfig is the configuration for subscribing to the channel of a remote host.
+type RemoteConfig struct {
+	Server   string `mapstructure:"server"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+	Domain   string `mapstructure:"domain"`
 }
 
 // Build will build a windows event log operator.
@@ -78,6 +90,21 @@ func (c *Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 		return nil, fmt.Errorf("the `start_at` field must be set to `beginning` or `end`")
 	}
 
+	if c.Remote.Server == "" && (c.Remote.Username != "" || c.Remote.Password != "" || c.Remote.Domain != "") {
+		return nil, fmt.Errorf("the `remote.server` field must be set when remote credentials are configured")
+	}
+
+	var locale uint32
+	if c.Locale != "" {
+		localeName, err := syscall.UTF16PtrFromString(c.Locale)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert locale to utf16: %w", err)
+		}
+		if locale, err = localeNameToLCID(localeName, 0); err != nil {
+			return nil, fmt.Errorf("invalid `locale` %q: %w", c.Locale, err)
+		}
+	}
+
 	return &Input{
 		InputOperator: inputOperator,
 		buffer:        NewBuffer(),
@@ -85,6 +112,8 @@ func (c *Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 		maxReads:      c.MaxReads,
 		startAt:       c.StartAt,
 		pollInterval:  c.PollInterval,
+		remote:        c.Remote,
+		locale:        locale,
 	}, nil
 }
 
@@ -98,6 +127,9 @@ type Input struct {
 	maxReads     int
 	startAt      string
 	pollInterval time.Duration
+	remote       RemoteConfig
+	locale       uint32
+	session      Session
 	persister    operator.Persister
 	cancel       context.CancelFunc
 	wg           sync.WaitGroup
@@ -110,11 +142,18 @@ func (e *Input) Start(persister operator.Persister) error {
 
 	e.persister = persister
 
+	e.session = NewSession()
+	if e.remote.Server != "" {
+		if err := e.session.Open(e.remote); err != nil {
+			return fmt.Errorf("failed to open remote session: %w", err)
+		}
+	}
+
 	e.bookmark = NewBookmark()
 	offsetXML, err := e.getBookmarkOffset(ctx)
 	if err != nil {
 		e.Errorf("Failed to open bookmark, continuing without previous bookmark: %s", err)
-		e.persister.Delete(ctx, e.channel)
+		e.persister.Delete(ctx, e.bookmarkKey())
 	}
 
 	if offsetXML != "" {
@@ -124,7 +163,7 @@ func (e *Input) Start(persister operator.Persister) error {
 	}
 
 	e.subscription = NewSubscription()
-	if err := e.subscription.Open(e.channel, e.startAt, e.bookmark); err != nil {
+	if err := e.subscription.Open(e.session, e.channel, e.startAt, e.bookmark); err != nil {
 		return fmt.Errorf("failed to open subscription: %w", err)
 	}
 
@@ -138,15 +177,21 @@ func (e *Input) Stop() error {
 	e.cancel()
 	e.wg.Wait()
 
+	// all the handles are closed, even when closing one of them fails
+	var errs error
 	if err := e.subscription.Close(); err != nil {
-		return fmt.Errorf("failed to close subscription: %w", err)
+		errs = multierr.Append(errs, fmt.Errorf("failed to close subscription: %w", err))
 	}
 
 	if err := e.bookmark.Close(); err != nil {
-		return fmt.Errorf("failed to close bookmark: %w", err)
+		errs = multierr.Append(errs, fmt.Errorf("failed to close bookmark: %w", err))
 	}
 
-	return nil
+	if err := e.session.Close(); err != nil {
+		errs = multierr.Append(errs, fmt.Errorf("failed to close remote session: %w", err))
+	}
+
+	return errs
 }
 
 // readOnInterval will read events with respect to the polling interval.
@@ -208,7 +253,7 @@ func (e *Input) processEvent(ctx context.Context, event Event) {
 	}
 
 	publisher := NewPublisher()
-	if err := publisher.Open(simpleEvent.Provider.Name); err != nil {
+	if err := publisher.Open(e.session, simpleEvent.Provider.Name, e.locale); err != nil {
 		e.Errorf("Failed to open publisher: %s: writing log entry to pipeline without metadata", err)
 		e.sendEvent(ctx, simpleEvent)
 		return
@@ -239,9 +284,18 @@ func (e *Input) sendEvent(ctx context.Context, eventXML EventXML) {
 	e.Write(ctx, entry)
 }
 
+// bookmarkKey is the key of the bookmark in the offsets database. Bookmarks of remote
+// channels are kept separate from the ones of the local channels with the same name.
+func (e *Input) bookmarkKey() string {
+	if e.remote.Server != "" {
+		return e.remote.Server + "/" + e.channel
+	}
+	return e.channel
+}
+
 // getBookmarkXML will get the bookmark xml from the offsets database.
 func (e *Input) getBookmarkOffset(ctx context.Context) (string, error) {
-	bytes, err := e.persister.Get(ctx, e.channel)
+	bytes, err := e.persister.Get(ctx, e.bookmarkKey())
 	return string(bytes), err
 }
 
@@ -258,7 +312,7 @@ func (e *Input) updateBookmarkOffset(ctx context.Context, event Event) {
 		return
 	}
 
-	if err := e.persister.Set(ctx, e.channel, []byte(bookmarkXML)); err != nil {
+	if err := e.persister.Set(ctx, e.bookmarkKey(), []byte(bookmarkXML)); err != nil {
 		e.Errorf("failed to set offsets: %s", err)
 		return
 	}
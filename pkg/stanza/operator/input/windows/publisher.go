@@ -27,8 +27,9 @@ type Publisher struct {
 	handle uintptr
 }
 
-// Open will open the publisher handle using the supplied provider.
-func (p *Publisher) Open(provider string) error {
+// Open will open the publisher handle using the supplied provider, in the session it belongs to.
+// Messages are formatted in the locale identified by the LCID, or in the user's locale when zero.
+func (p *Publisher) Open(session Session, provider string, locale uint32) error {
 	if p.handle != 0 {
 		return fmt.Errorf("publisher handle is already open")
 	}
@@ -38,7 +39,7 @@ func (p *Publisher) Open(provider string) error {
 		return fmt.Errorf("failed to convert provider to utf16: %w", err)
 	}
 
-	handle, err := evtOpenPublisherMetadata(0, utf16, nil, 0, 0)
+	handle, err := evtOpenPublisherMetadata(session.handle, utf16, nil, locale, 0)
 	if err != nil {
 		return fmt.Errorf("failed to open publisher handle: %w", err)
 	}
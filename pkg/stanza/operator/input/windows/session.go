@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build windows
+// +build windows
+
+package windows // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/input/windows"
+
+import (
+	"fmt"
+	"syscall"
+)
+
+// Session is a session to the event log service of a remote host.
+// A session with an empty handle refers to the local host.
+type Session struct {
+	handle uintptr
+}
+
+// Open will open a session to the remote host using the supplied configuration.
+func (s *Session) Open(remote RemoteConfig) error {
+	if s.handle != 0 {
+		return fmt.Errorf("session handle is already open")
+	}
+
+	login := EvtRPCLoginInfo{Flags: EvtRPCLoginAuthDefault}
+	for _, field := range []struct {
+		value string
+		ptr   **uint16
+	}{
+		{remote.Server, &login.Server},
+		{remote.Username, &login.User},
+		{remote.Domain, &login.Domain},
+		{remote.Password, &login.Password},
+	} {
+		if field.value == "" {
+			continue
+		}
+		utf16, err := syscall.UTF16PtrFromString(field.value)
+		if err != nil {
+			return fmt.Errorf("failed to convert remote configuration to utf16: %w", err)
+		}
+		*field.ptr = utf16
+	}
+
+	handle, err := evtOpenSession(EvtRPCLogin, &login, 0, 0)
+	if err != nil {
+		return fmt.Errorf("failed to open session to %s: %w", remote.Server, err)
+	}
+
+	s.handle = handle
+	return nil
+}
+
+// Close will close the session handle.
+func (s *Session) Close() error {
+	if s.handle == 0 {
+		return nil
+	}
+
+	if err := evtClose(s.handle); err != nil {
+		return fmt.Errorf("failed to close session: %w", err)
+	}
+
+	s.handle = 0
+	return nil
+}
+
+// NewSession will create a new session with an empty handle.
+func NewSession() Session {
+	return Session{
+		handle: 0,
+	}
+}
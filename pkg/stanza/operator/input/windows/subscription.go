@@ -30,8 +30,8 @@ type Subscription struct {
 	handle uintptr
 }
 
-// Open will open the subscription handle.
-func (s *Subscription) Open(channel string, startAt string, bookmark Bookmark) error {
+// Open will open the subscription handle to the channel of the host the session belongs to.
+func (s *Subscription) Open(session Session, channel string, startAt string, bookmark Bookmark) error {
 	if s.handle != 0 {
 		return fmt.Errorf("subscription handle is already open")
 	}
@@ -48,7 +48,7 @@ func (s *Subscription) Open(channel string, startAt string, bookmark Bookmark) e
 	}
 
 	flags := s.createFlags(startAt, bookmark)
-	subscriptionHandle, err := evtSubscribe(0, signalEvent, channelPtr, nil, bookmark.handle, 0, 0, flags)
+	subscriptionHandle, err := evtSubscribe(session.handle, signalEvent, channelPtr, nil, bookmark.handle, 0, 0, flags)
 	if err != nil {
 		return fmt.Errorf("failed to subscribe to %s channel: %w", channel, err)
 	}
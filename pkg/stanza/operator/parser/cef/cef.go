@@ -0,0 +1,230 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cef // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/cef"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+)
+
+const (
+	operatorType = "cef_parser"
+
+	cefPrefix = "CEF:"
+)
+
+// headerFields are the names of the CEF header fields, in order.
+var headerFields = []string{
+	"version",
+	"device_vendor",
+	"device_product",
+	"device_version",
+	"signature_id",
+	"name",
+	"severity",
+}
+
+func init() {
+	operator.Register(operatorType, func() operator.Builder { return NewConfig() })
+}
+
+// NewConfig creates a new CEF parser config with default values
+func NewConfig() *Config {
+	return NewConfigWithID(operatorType)
+}
+
+// NewConfigWithID creates a new CEF parser config with default values
+func NewConfigWithID(operatorID string) *Config {
+	return &Config{
+		ParserConfig: helper.NewParserConfig(operatorID, operatorType),
+	}
+}
+
+// Config is the configuration of a CEF parser operator.
+type Config struct {
+	helper.ParserConfig `mapstructure:",squash"`
+}
+
+// Build will build a CEF parser operator.
+func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
+	parserOperator, err := c.ParserConfig.Build(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Parser{
+		ParserOperator: parserOperator,
+	}, nil
+}
+
+// Parser is an operator that parses ArcSight Common Event Format (CEF) messages.
+type Parser struct {
+	helper.ParserOperator
+}
+
+// Process will parse an entry as a CEF message.
+func (p *Parser) Process(ctx context.Context, entry *entry.Entry) error {
+	return p.ParserOperator.ProcessWith(ctx, entry, p.parse)
+}
+
+// parse will parse a value as a CEF message.
+func (p *Parser) parse(value interface{}) (interface{}, error) {
+	switch m := value.(type) {
+	case string:
+		return parseCEF(m)
+	default:
+		return nil, fmt.Errorf("type %T cannot be parsed as CEF", value)
+	}
+}
+
+// parseCEF parses a CEF message, which may be preceded by a syslog header.
+func parseCEF(input string) (map[string]interface{}, error) {
+	start := strings.Index(input, cefPrefix)
+	if start < 0 {
+		return nil, fmt.Errorf("missing %q prefix", cefPrefix)
+	}
+
+	fields, extension, err := splitHeader(input[start+len(cefPrefix):], len(headerFields))
+	if err != nil {
+		return nil, err
+	}
+
+	parsed := make(map[string]interface{}, len(headerFields)+1)
+	for i, name := range headerFields {
+		parsed[name] = fields[i]
+	}
+
+	extensions, err := parseExtension(extension)
+	if err != nil {
+		return nil, err
+	}
+	parsed["extensions"] = extensions
+	return parsed, nil
+}
+
+// splitHeader splits the n pipe separated header fields, in which pipes and
+// backslashes are escaped with a backslash, from the rest of the message.
+func splitHeader(s string, n int) ([]string, string, error) {
+	fields := make([]string, 0, n)
+	var field strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && i+1 < len(s) && (s[i+1] == '|' || s[i+1] == '\\'):
+			i++
+			field.WriteByte(s[i])
+		case s[i] == '|':
+			fields = append(fields, field.String())
+			field.Reset()
+			if len(fields) == n {
+				return fields, s[i+1:], nil
+			}
+		default:
+			field.WriteByte(s[i])
+		}
+	}
+	return nil, "", fmt.Errorf("expected %d header fields, got %d", n, len(fields))
+}
+
+// parseExtension parses the space separated key=value pairs of the extension. Values
+// may contain spaces, while equal signs and backslashes in values are escaped with a
+// backslash, so a key is a word directly followed by an unescaped equal sign.
+func parseExtension(s string) (map[string]interface{}, error) {
+	type pair struct {
+		keyStart, equal int
+	}
+	var pairs []pair
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' {
+			i++
+			continue
+		}
+		if s[i] != '=' {
+			continue
+		}
+		keyStart := strings.LastIndexByte(s[:i], ' ') + 1
+		if isKey(s[keyStart:i]) {
+			pairs = append(pairs, pair{keyStart: keyStart, equal: i})
+		}
+	}
+
+	extensions := make(map[string]interface{}, len(pairs))
+	if len(pairs) == 0 {
+		if strings.TrimSpace(s) != "" {
+			return nil, errors.New("extension contains no key=value pairs")
+		}
+		return extensions, nil
+	}
+	if strings.TrimSpace(s[:pairs[0].keyStart]) != "" {
+		return nil, fmt.Errorf("unexpected %q before the first extension key", strings.TrimSpace(s[:pairs[0].keyStart]))
+	}
+
+	for i, p := range pairs {
+		end := len(s)
+		if i+1 < len(pairs) {
+			end = pairs[i+1].keyStart
+		}
+		extensions[s[p.keyStart:p.equal]] = unescapeValue(strings.TrimRight(s[p.equal+1:end], " "))
+	}
+	return extensions, nil
+}
+
+func isKey(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '.' || c == '-' || c == '[' || c == ']') {
+			return false
+		}
+	}
+	return true
+}
+
+// unescapeValue replaces the escape sequences of an extension value. Unknown escape
+// sequences are kept as is.
+func unescapeValue(s string) string {
+	if !strings.Contains(s, "\\") {
+		return s
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			b.WriteByte(s[i])
+			continue
+		}
+		switch s[i+1] {
+		case '\\', '=', '|':
+			b.WriteByte(s[i+1])
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		default:
+			b.WriteByte(s[i])
+			b.WriteByte(s[i+1])
+		}
+		i++
+	}
+	return b.String()
+}
@@ -0,0 +1,196 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cef
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func newTestParser(t *testing.T) *Parser {
+	config := NewConfigWithID("test")
+	op, err := config.Build(testutil.Logger(t))
+	require.NoError(t, err)
+	return op.(*Parser)
+}
+
+func TestInit(t *testing.T) {
+	builder, ok := operator.DefaultRegistry.Lookup("cef_parser")
+	require.True(t, ok, "expected cef_parser to be registered")
+	require.Equal(t, "cef_parser", builder().Type())
+}
+
+func TestConfigBuildFailure(t *testing.T) {
+	config := NewConfigWithID("test")
+	config.OnError = "invalid_on_error"
+	_, err := config.Build(testutil.Logger(t))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid `on_error` field")
+}
+
+func TestParserInvalidType(t *testing.T) {
+	parser := newTestParser(t)
+	_, err := parser.parse([]int{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "type []int cannot be parsed as CEF")
+}
+
+func TestParse(t *testing.T) {
+	header := func(extensions map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"version":        "0",
+			"device_vendor":  "Security",
+			"device_product": "threatmanager",
+			"device_version": "1.0",
+			"signature_id":   "100",
+			"name":           "worm successfully stopped",
+			"severity":       "10",
+			"extensions":     extensions,
+		}
+	}
+
+	cases := []struct {
+		name      string
+		input     string
+		expect    map[string]interface{}
+		expectErr string
+	}{
+		{
+			name:  "simple",
+			input: "CEF:0|Security|threatmanager|1.0|100|worm successfully stopped|10|src=10.0.0.1 dst=2.1.2.2 spt=1232",
+			expect: header(map[string]interface{}{
+				"src": "10.0.0.1",
+				"dst": "2.1.2.2",
+				"spt": "1232",
+			}),
+		},
+		{
+			name:  "syslog-header",
+			input: "Sep 19 08:26:10 host CEF:0|Security|threatmanager|1.0|100|worm successfully stopped|10|src=10.0.0.1",
+			expect: header(map[string]interface{}{
+				"src": "10.0.0.1",
+			}),
+		},
+		{
+			name:   "no-extension",
+			input:  "CEF:0|Security|threatmanager|1.0|100|worm successfully stopped|10|",
+			expect: header(map[string]interface{}{}),
+		},
+		{
+			name:  "spaces-in-values",
+			input: "CEF:0|Security|threatmanager|1.0|100|worm successfully stopped|10|msg=worm stopped on host  act=blocked  suser=John Doe",
+			expect: header(map[string]interface{}{
+				"msg":   "worm stopped on host",
+				"act":   "blocked",
+				"suser": "John Doe",
+			}),
+		},
+		{
+			name:  "escaped-extension",
+			input: `CEF:0|Security|threatmanager|1.0|100|worm successfully stopped|10|msg=a\=b c\\d|e\nf request=http://example.com/?q\=1&r=2 cs1Label=x`,
+			expect: header(map[string]interface{}{
+				"msg":      "a=b c\\d|e\nf",
+				"request":  "http://example.com/?q=1&r=2",
+				"cs1Label": "x",
+			}),
+		},
+		{
+			name:  "escaped-header",
+			input: `CEF:0|Sec\|urity|threat\\manager|1.0|100|worm successfully stopped|10|`,
+			expect: func() map[string]interface{} {
+				parsed := header(map[string]interface{}{})
+				parsed["device_vendor"] = "Sec|urity"
+				parsed["device_product"] = `threat\manager`
+				return parsed
+			}(),
+		},
+		{
+			name:      "missing-prefix",
+			input:     "0|Security|threatmanager|1.0|100|worm successfully stopped|10|",
+			expectErr: `missing "CEF:" prefix`,
+		},
+		{
+			name:      "missing-header-fields",
+			input:     "CEF:0|Security|threatmanager|1.0|100|worm successfully stopped",
+			expectErr: "expected 7 header fields, got 5",
+		},
+		{
+			name:      "extension-without-pairs",
+			input:     "CEF:0|Security|threatmanager|1.0|100|worm successfully stopped|10|garbage",
+			expectErr: "extension contains no key=value pairs",
+		},
+		{
+			name:      "text-before-first-key",
+			input:     "CEF:0|Security|threatmanager|1.0|100|worm successfully stopped|10|garbage src=10.0.0.1",
+			expectErr: `unexpected "garbage" before the first extension key`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			parsed, err := newTestParser(t).parse(tc.input)
+			if tc.expectErr != "" {
+				require.ErrorContains(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expect, parsed)
+		})
+	}
+}
+
+func TestParser(t *testing.T) {
+	cfg := NewConfigWithID("test")
+	cfg.OutputIDs = []string{"fake"}
+	cfg.ParseFrom = entry.NewAttributeField("message")
+
+	op, err := cfg.Build(testutil.Logger(t))
+	require.NoError(t, err)
+
+	fake := testutil.NewFakeOutput(t)
+	require.NoError(t, op.SetOutputs([]operator.Operator{fake}))
+
+	message := "CEF:1|Vendor|Product|2.0|login|User logged in|3|suser=admin src=192.168.0.1"
+	ots := time.Now()
+	input := &entry.Entry{
+		ObservedTimestamp: ots,
+		Attributes:        map[string]interface{}{"message": message},
+	}
+	require.NoError(t, op.Process(context.Background(), input))
+	fake.ExpectEntry(t, &entry.Entry{
+		ObservedTimestamp: ots,
+		Attributes: map[string]interface{}{
+			"message":        message,
+			"version":        "1",
+			"device_vendor":  "Vendor",
+			"device_product": "Product",
+			"device_version": "2.0",
+			"signature_id":   "login",
+			"name":           "User logged in",
+			"severity":       "3",
+			"extensions": map[string]interface{}{
+				"suser": "admin",
+				"src":   "192.168.0.1",
+			},
+		},
+	})
+}
@@ -0,0 +1,190 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package leef // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/leef"
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+)
+
+const (
+	operatorType = "leef_parser"
+
+	leefPrefix = "LEEF:"
+
+	// defaultDelimiter separates the event attributes of LEEF 1.0 messages, and of
+	// LEEF 2.0 messages which don't specify a delimiter.
+	defaultDelimiter = "\t"
+)
+
+// headerFields are the names of the LEEF header fields common to all versions, in order.
+var headerFields = []string{
+	"version",
+	"vendor",
+	"product",
+	"product_version",
+	"event_id",
+}
+
+func init() {
+	operator.Register(operatorType, func() operator.Builder { return NewConfig() })
+}
+
+// NewConfig creates a new LEEF parser config with default values
+func NewConfig() *Config {
+	return NewConfigWithID(operatorType)
+}
+
+// NewConfigWithID creates a new LEEF parser config with default values
+func NewConfigWithID(operatorID string) *Config {
+	return &Config{
+		ParserConfig: helper.NewParserConfig(operatorID, operatorType),
+	}
+}
+
+// Config is the configuration of a LEEF parser operator.
+type Config struct {
+	helper.ParserConfig `mapstructure:",squash"`
+}
+
+// Build will build a LEEF parser operator.
+func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
+	parserOperator, err := c.ParserConfig.Build(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Parser{
+		ParserOperator: parserOperator,
+	}, nil
+}
+
+// Parser is an operator that parses IBM Log Event Extended Format (LEEF) messages.
+type Parser struct {
+	helper.ParserOperator
+}
+
+// Process will parse an entry as a LEEF message.
+func (p *Parser) Process(ctx context.Context, entry *entry.Entry) error {
+	return p.ParserOperator.ProcessWith(ctx, entry, p.parse)
+}
+
+// parse will parse a value as a LEEF message.
+func (p *Parser) parse(value interface{}) (interface{}, error) {
+	switch m := value.(type) {
+	case string:
+		return parseLEEF(m)
+	default:
+		return nil, fmt.Errorf("type %T cannot be parsed as LEEF", value)
+	}
+}
+
+// parseLEEF parses a LEEF 1.0 or 2.0 message, which may be preceded by a syslog header.
+func parseLEEF(input string) (map[string]interface{}, error) {
+	start := strings.Index(input, leefPrefix)
+	if start < 0 {
+		return nil, fmt.Errorf("missing %q prefix", leefPrefix)
+	}
+	input = input[start+len(leefPrefix):]
+
+	numFields := len(headerFields)
+	if strings.HasPrefix(input, "2") {
+		// LEEF 2.0 adds the delimiter of the event attributes to the header
+		numFields++
+	} else if !strings.HasPrefix(input, "1") {
+		return nil, fmt.Errorf("unsupported LEEF version in %q", input[:strings.IndexByte(input+"|", '|')])
+	}
+
+	fields, attributes, err := splitHeader(input, numFields)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed := make(map[string]interface{}, len(headerFields)+1)
+	for i, name := range headerFields {
+		parsed[name] = fields[i]
+	}
+
+	delimiter := defaultDelimiter
+	if numFields > len(headerFields) {
+		if delimiter, err = parseDelimiter(fields[len(headerFields)]); err != nil {
+			return nil, err
+		}
+	}
+
+	eventAttributes := make(map[string]interface{})
+	for _, raw := range strings.Split(attributes, delimiter) {
+		if strings.TrimSpace(raw) == "" {
+			continue
+		}
+		key, value, found := strings.Cut(raw, "=")
+		if !found {
+			return nil, fmt.Errorf("expected event attribute %q to be a key=value pair", raw)
+		}
+		eventAttributes[strings.TrimSpace(key)] = value
+	}
+	parsed["event_attributes"] = eventAttributes
+	return parsed, nil
+}
+
+// splitHeader splits the n pipe separated header fields, in which pipes and
+// backslashes are escaped with a backslash, from the rest of the message.
+func splitHeader(s string, n int) ([]string, string, error) {
+	fields := make([]string, 0, n)
+	var field strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && i+1 < len(s) && (s[i+1] == '|' || s[i+1] == '\\'):
+			i++
+			field.WriteByte(s[i])
+		case s[i] == '|':
+			fields = append(fields, field.String())
+			field.Reset()
+			if len(fields) == n {
+				return fields, s[i+1:], nil
+			}
+		default:
+			field.WriteByte(s[i])
+		}
+	}
+	return nil, "", fmt.Errorf("expected %d header fields, got %d", n, len(fields))
+}
+
+// parseDelimiter parses the LEEF 2.0 delimiter header field, which is either a single
+// character or its hexadecimal code, as in x09 or 0x09.
+func parseDelimiter(s string) (string, error) {
+	switch {
+	case s == "":
+		return defaultDelimiter, nil
+	case len(s) == 1:
+		return s, nil
+	case strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "x"):
+		code, err := strconv.ParseUint(s[strings.IndexByte(s, 'x')+1:], 16, 8)
+		if err != nil || code == 0 {
+			return "", fmt.Errorf("invalid delimiter %q", s)
+		}
+		return string(rune(code)), nil
+	default:
+		return "", fmt.Errorf("invalid delimiter %q", s)
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package leef
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func newTestParser(t *testing.T) *Parser {
+	config := NewConfigWithID("test")
+	op, err := config.Build(testutil.Logger(t))
+	require.NoError(t, err)
+	return op.(*Parser)
+}
+
+func TestInit(t *testing.T) {
+	builder, ok := operator.DefaultRegistry.Lookup("leef_parser")
+	require.True(t, ok, "expected leef_parser to be registered")
+	require.Equal(t, "leef_parser", builder().Type())
+}
+
+func TestConfigBuildFailure(t *testing.T) {
+	config := NewConfigWithID("test")
+	config.OnError = "invalid_on_error"
+	_, err := config.Build(testutil.Logger(t))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid `on_error` field")
+}
+
+func TestParserInvalidType(t *testing.T) {
+	parser := newTestParser(t)
+	_, err := parser.parse([]int{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "type []int cannot be parsed as LEEF")
+}
+
+func TestParse(t *testing.T) {
+	header := func(version string, eventAttributes map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"version":          version,
+			"vendor":           "Lancope",
+			"product":          "StealthWatch",
+			"product_version":  "1.0",
+			"event_id":         "41",
+			"event_attributes": eventAttributes,
+		}
+	}
+
+	cases := []struct {
+		name      string
+		input     string
+		expect    map[string]interface{}
+		expectErr string
+	}{
+		{
+			name:  "version-1",
+			input: "LEEF:1.0|Lancope|StealthWatch|1.0|41|src=10.0.1.8\tdst=10.0.0.5\tmsg=flow denied",
+			expect: header("1.0", map[string]interface{}{
+				"src": "10.0.1.8",
+				"dst": "10.0.0.5",
+				"msg": "flow denied",
+			}),
+		},
+		{
+			name:  "syslog-header",
+			input: "Jan 18 11:07:53 host LEEF:1.0|Lancope|StealthWatch|1.0|41|src=10.0.1.8",
+			expect: header("1.0", map[string]interface{}{
+				"src": "10.0.1.8",
+			}),
+		},
+		{
+			name:  "version-2-character-delimiter",
+			input: "LEEF:2.0|Lancope|StealthWatch|1.0|41|^|src=10.0.1.8^dst=10.0.0.5^url=http://example.com/?a=b",
+			expect: header("2.0", map[string]interface{}{
+				"src": "10.0.1.8",
+				"dst": "10.0.0.5",
+				"url": "http://example.com/?a=b",
+			}),
+		},
+		{
+			name:  "version-2-hex-delimiter",
+			input: "LEEF:2.0|Lancope|StealthWatch|1.0|41|x09|src=10.0.1.8\tdst=10.0.0.5",
+			expect: header("2.0", map[string]interface{}{
+				"src": "10.0.1.8",
+				"dst": "10.0.0.5",
+			}),
+		},
+		{
+			name:  "version-2-0x-delimiter",
+			input: "LEEF:2.0|Lancope|StealthWatch|1.0|41|0x5e|src=10.0.1.8^dst=10.0.0.5",
+			expect: header("2.0", map[string]interface{}{
+				"src": "10.0.1.8",
+				"dst": "10.0.0.5",
+			}),
+		},
+		{
+			name:  "version-2-default-delimiter",
+			input: "LEEF:2.0|Lancope|StealthWatch|1.0|41||src=10.0.1.8\tdst=10.0.0.5",
+			expect: header("2.0", map[string]interface{}{
+				"src": "10.0.1.8",
+				"dst": "10.0.0.5",
+			}),
+		},
+		{
+			name:   "no-event-attributes",
+			input:  "LEEF:1.0|Lancope|StealthWatch|1.0|41|",
+			expect: header("1.0", map[string]interface{}{}),
+		},
+		{
+			name:      "missing-prefix",
+			input:     "1.0|Lancope|StealthWatch|1.0|41|src=10.0.1.8",
+			expectErr: `missing "LEEF:" prefix`,
+		},
+		{
+			name:      "unsupported-version",
+			input:     "LEEF:3.0|Lancope|StealthWatch|1.0|41|src=10.0.1.8",
+			expectErr: `unsupported LEEF version in "3.0"`,
+		},
+		{
+			name:      "missing-header-fields",
+			input:     "LEEF:1.0|Lancope|StealthWatch",
+			expectErr: "expected 5 header fields, got 2",
+		},
+		{
+			name:      "invalid-delimiter",
+			input:     "LEEF:2.0|Lancope|StealthWatch|1.0|41|xzz|src=10.0.1.8",
+			expectErr: `invalid delimiter "xzz"`,
+		},
+		{
+			name:      "invalid-event-attribute",
+			input:     "LEEF:1.0|Lancope|StealthWatch|1.0|41|src=10.0.1.8\tgarbage",
+			expectErr: `expected event attribute "garbage" to be a key=value pair`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			parsed, err := newTestParser(t).parse(tc.input)
+			if tc.expectErr != "" {
+				require.ErrorContains(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expect, parsed)
+		})
+	}
+}
+
+func TestParser(t *testing.T) {
+	cfg := NewConfigWithID("test")
+	cfg.OutputIDs = []string{"fake"}
+	cfg.ParseTo = entry.RootableField{Field: entry.NewBodyField("leef")}
+
+	op, err := cfg.Build(testutil.Logger(t))
+	require.NoError(t, err)
+
+	fake := testutil.NewFakeOutput(t)
+	require.NoError(t, op.SetOutputs([]operator.Operator{fake}))
+
+	ots := time.Now()
+	input := &entry.Entry{
+		ObservedTimestamp: ots,
+		Body:              "LEEF:2.0|IBM|QRadar|7.3|login|^|usrName=admin^src=192.168.0.1",
+	}
+	require.NoError(t, op.Process(context.Background(), input))
+	fake.ExpectEntry(t, &entry.Entry{
+		ObservedTimestamp: ots,
+		Body: map[string]interface{}{
+			"leef": map[string]interface{}{
+				"version":         "2.0",
+				"vendor":          "IBM",
+				"product":         "QRadar",
+				"product_version": "7.3",
+				"event_id":        "login",
+				"event_attributes": map[string]interface{}{
+					"usrName": "admin",
+					"src":     "192.168.0.1",
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,175 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logfmt // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/logfmt"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+)
+
+const operatorType = "logfmt_parser"
+
+func init() {
+	operator.Register(operatorType, func() operator.Builder { return NewConfig() })
+}
+
+// NewConfig creates a new logfmt parser config with default values
+func NewConfig() *Config {
+	return NewConfigWithID(operatorType)
+}
+
+// NewConfigWithID creates a new logfmt parser config with default values
+func NewConfigWithID(operatorID string) *Config {
+	return &Config{
+		ParserConfig: helper.NewParserConfig(operatorID, operatorType),
+	}
+}
+
+// Config is the configuration of a logfmt parser operator.
+type Config struct {
+	helper.ParserConfig `mapstructure:",squash"`
+}
+
+// Build will build a logfmt parser operator.
+func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
+	parserOperator, err := c.ParserConfig.Build(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Parser{
+		ParserOperator: parserOperator,
+	}, nil
+}
+
+// Parser is an operator that parses logfmt formatted lines.
+type Parser struct {
+	helper.ParserOperator
+}
+
+// Process will parse an entry for logfmt key value pairs.
+func (p *Parser) Process(ctx context.Context, entry *entry.Entry) error {
+	return p.ParserOperator.ProcessWith(ctx, entry, p.parse)
+}
+
+// parse will parse a value as logfmt.
+func (p *Parser) parse(value interface{}) (interface{}, error) {
+	switch m := value.(type) {
+	case string:
+		return p.parser(m)
+	default:
+		return nil, fmt.Errorf("type %T cannot be parsed as logfmt", value)
+	}
+}
+
+func (p *Parser) parser(input string) (map[string]interface{}, error) {
+	if input == "" {
+		return nil, fmt.Errorf("parse from field %s is empty", p.ParseFrom.String())
+	}
+
+	parsed := make(map[string]interface{})
+	for i := 0; i < len(input); {
+		if isSpace(input[i]) {
+			i++
+			continue
+		}
+
+		start := i
+		for i < len(input) && isKeyChar(input[i]) {
+			i++
+		}
+		if i == start {
+			return nil, fmt.Errorf("unexpected %q at position %d, expected a key", input[i], i)
+		}
+		key := input[start:i]
+
+		// a key without a value, e.g. `debug` in `level=info debug`
+		if i == len(input) || isSpace(input[i]) {
+			parsed[key] = ""
+			continue
+		}
+		if input[i] != '=' {
+			return nil, fmt.Errorf("unexpected %q at position %d in key %q", input[i], i, key)
+		}
+		i++
+
+		value, n, err := readValue(input[i:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for key %q at position %d: %w", key, i, err)
+		}
+		parsed[key] = value
+		i += n
+	}
+
+	return parsed, nil
+}
+
+// readValue reads the value at the start of s, which is either a bare word or
+// a double quoted string, and returns it along with the number of bytes consumed.
+func readValue(s string) (string, int, error) {
+	if s == "" || isSpace(s[0]) {
+		return "", 0, nil
+	}
+
+	if s[0] != '"' {
+		i := 0
+		for i < len(s) && !isSpace(s[i]) {
+			if s[i] == '"' || s[i] == '=' {
+				return "", 0, fmt.Errorf("unexpected %q in unquoted value", s[i])
+			}
+			i++
+		}
+		return s[:i], i, nil
+	}
+
+	i := 1
+	for ; i < len(s); i++ {
+		if s[i] == '\\' {
+			i++
+			continue
+		}
+		if s[i] == '"' {
+			break
+		}
+	}
+	if i >= len(s) {
+		return "", 0, errors.New("unterminated quoted value")
+	}
+	if i+1 < len(s) && !isSpace(s[i+1]) {
+		return "", 0, fmt.Errorf("unexpected %q after quoted value", s[i+1])
+	}
+
+	value, err := strconv.Unquote(s[:i+1])
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid quoted value %s: %w", s[:i+1], err)
+	}
+	return value, i + 1, nil
+}
+
+func isSpace(c byte) bool {
+	return c <= ' '
+}
+
+func isKeyChar(c byte) bool {
+	return !isSpace(c) && c != '=' && c != '"'
+}
@@ -0,0 +1,260 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logfmt
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func newTestParser(t *testing.T) *Parser {
+	config := NewConfigWithID("test")
+	op, err := config.Build(testutil.Logger(t))
+	require.NoError(t, err)
+	return op.(*Parser)
+}
+
+func TestInit(t *testing.T) {
+	builder, ok := operator.DefaultRegistry.Lookup("logfmt_parser")
+	require.True(t, ok, "expected logfmt_parser to be registered")
+	require.Equal(t, "logfmt_parser", builder().Type())
+}
+
+func TestConfigBuild(t *testing.T) {
+	config := NewConfigWithID("test")
+	op, err := config.Build(testutil.Logger(t))
+	require.NoError(t, err)
+	require.IsType(t, &Parser{}, op)
+}
+
+func TestConfigBuildFailure(t *testing.T) {
+	config := NewConfigWithID("test")
+	config.OnError = "invalid_on_error"
+	_, err := config.Build(testutil.Logger(t))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid `on_error` field")
+}
+
+func TestParserInvalidType(t *testing.T) {
+	parser := newTestParser(t)
+	_, err := parser.parse([]int{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "type []int cannot be parsed as logfmt")
+}
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		name      string
+		input     string
+		expect    map[string]interface{}
+		expectErr string
+	}{
+		{
+			name:   "simple",
+			input:  "level=info msg=started port=8080",
+			expect: map[string]interface{}{"level": "info", "msg": "started", "port": "8080"},
+		},
+		{
+			name:   "extra-whitespace",
+			input:  "  level=info \t msg=started  ",
+			expect: map[string]interface{}{"level": "info", "msg": "started"},
+		},
+		{
+			name:   "quoted",
+			input:  `level=info msg="request completed" path=/api`,
+			expect: map[string]interface{}{"level": "info", "msg": "request completed", "path": "/api"},
+		},
+		{
+			name:   "escaped",
+			input:  `msg="say \"hi\"\n\tnow" dir="C:\\temp" unicode="caf\u00e9"`,
+			expect: map[string]interface{}{"msg": "say \"hi\"\n\tnow", "dir": `C:\temp`, "unicode": "café"},
+		},
+		{
+			name:   "empty-values",
+			input:  `a= b="" c=d`,
+			expect: map[string]interface{}{"a": "", "b": "", "c": "d"},
+		},
+		{
+			name:   "bare-key",
+			input:  "level=debug verbose msg=x",
+			expect: map[string]interface{}{"level": "debug", "verbose": "", "msg": "x"},
+		},
+		{
+			name:   "duplicate-key",
+			input:  "a=1 a=2",
+			expect: map[string]interface{}{"a": "2"},
+		},
+		{
+			name:   "non-ascii",
+			input:  "user=jürgen msg=ünïcödé",
+			expect: map[string]interface{}{"user": "jürgen", "msg": "ünïcödé"},
+		},
+		{
+			name:      "empty",
+			input:     "",
+			expectErr: "parse from field body is empty",
+		},
+		{
+			name:      "missing-key",
+			input:     "=value",
+			expectErr: `unexpected '=' at position 0, expected a key`,
+		},
+		{
+			name:      "quote-in-key",
+			input:     `ke"y=value`,
+			expectErr: `unexpected '"' at position 2 in key "ke"`,
+		},
+		{
+			name:      "unterminated-quote",
+			input:     `msg="unterminated`,
+			expectErr: `invalid value for key "msg" at position 4: unterminated quoted value`,
+		},
+		{
+			name:      "escaped-closing-quote",
+			input:     `msg="unterminated\"`,
+			expectErr: "unterminated quoted value",
+		},
+		{
+			name:      "garbage-after-quote",
+			input:     `msg="a"b`,
+			expectErr: `unexpected 'b' after quoted value`,
+		},
+		{
+			name:      "quote-in-unquoted-value",
+			input:     `msg=a"b`,
+			expectErr: `unexpected '"' in unquoted value`,
+		},
+		{
+			name:      "equals-in-unquoted-value",
+			input:     `msg=a=b`,
+			expectErr: `unexpected '=' in unquoted value`,
+		},
+		{
+			name:      "invalid-escape",
+			input:     `msg="\q"`,
+			expectErr: `invalid quoted value "\q"`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			parsed, err := newTestParser(t).parse(tc.input)
+			if tc.expectErr != "" {
+				require.ErrorContains(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expect, parsed)
+		})
+	}
+}
+
+func TestParser(t *testing.T) {
+	cases := []struct {
+		name      string
+		configure func(*Config)
+		input     *entry.Entry
+		expect    *entry.Entry
+	}{
+		{
+			"simple",
+			func(p *Config) {},
+			&entry.Entry{
+				Body: `level=info msg="request completed" status=200`,
+			},
+			&entry.Entry{
+				Attributes: map[string]interface{}{
+					"level":  "info",
+					"msg":    "request completed",
+					"status": "200",
+				},
+				Body: `level=info msg="request completed" status=200`,
+			},
+		},
+		{
+			"from-to",
+			func(p *Config) {
+				p.ParseFrom = entry.NewAttributeField("from")
+				p.ParseTo = entry.RootableField{Field: entry.NewBodyField("to")}
+			},
+			&entry.Entry{
+				Attributes: map[string]interface{}{
+					"from": "name=stanza age=10",
+				},
+			},
+			&entry.Entry{
+				Attributes: map[string]interface{}{
+					"from": "name=stanza age=10",
+				},
+				Body: map[string]interface{}{
+					"to": map[string]interface{}{
+						"name": "stanza",
+						"age":  "10",
+					},
+				},
+			},
+		},
+		{
+			"severity",
+			func(p *Config) {
+				sevField := entry.NewAttributeField("level")
+				sevCfg := helper.NewSeverityConfig()
+				sevCfg.ParseFrom = &sevField
+				p.SeverityConfig = &sevCfg
+			},
+			&entry.Entry{
+				Body: "level=error msg=failed",
+			},
+			&entry.Entry{
+				Attributes: map[string]interface{}{
+					"level": "error",
+					"msg":   "failed",
+				},
+				Body:         "level=error msg=failed",
+				Severity:     entry.Error,
+				SeverityText: "error",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := NewConfigWithID("test")
+			cfg.OutputIDs = []string{"fake"}
+			tc.configure(cfg)
+
+			op, err := cfg.Build(testutil.Logger(t))
+			require.NoError(t, err)
+
+			fake := testutil.NewFakeOutput(t)
+			require.NoError(t, op.SetOutputs([]operator.Operator{fake}))
+
+			ots := time.Now()
+			tc.input.ObservedTimestamp = ots
+			tc.expect.ObservedTimestamp = ots
+
+			require.NoError(t, op.Process(context.Background(), tc.input))
+			fake.ExpectEntry(t, tc.expect)
+		})
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syslogheader // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/syslogheader"
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+)
+
+const (
+	operatorType = "syslog_header_parser"
+
+	nilValue = "-"
+)
+
+func init() {
+	operator.Register(operatorType, func() operator.Builder { return NewConfig() })
+}
+
+// NewConfig creates a new syslog header parser config with default values
+func NewConfig() *Config {
+	return NewConfigWithID(operatorType)
+}
+
+// NewConfigWithID creates a new syslog header parser config with default values
+func NewConfigWithID(operatorID string) *Config {
+	return &Config{
+		ParserConfig: helper.NewParserConfig(operatorID, operatorType),
+	}
+}
+
+// Config is the configuration of a syslog header parser operator.
+type Config struct {
+	helper.ParserConfig `mapstructure:",squash"`
+}
+
+// Build will build a syslog header parser operator.
+func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
+	parserOperator, err := c.ParserConfig.Build(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Parser{
+		ParserOperator: parserOperator,
+	}, nil
+}
+
+// Parser is an operator that parses the priority and header of syslog messages, falling back from
+// RFC 5424 to RFC 3164 headers, and to the priority alone for messages which follow neither.
+type Parser struct {
+	helper.ParserOperator
+}
+
+var (
+	priorityRegexp = regexp.MustCompile(`^<(\d{1,3})>`)
+	// RFC 5424: VERSION SP TIMESTAMP SP HOSTNAME SP APP-NAME SP PROCID SP MSGID [SP MSG]
+	rfc5424Regexp = regexp.MustCompile(`^[1-9]\d{0,2} (\S+) (\S+) (\S+) (\S+) (\S+)(?: (.*))?$`)
+	// RFC 3164: TIMESTAMP SP HOSTNAME SP TAG[PID]: MSG
+	rfc3164Regexp = regexp.MustCompile(`^([A-Z][a-z]{2} [ \d]\d \d{2}:\d{2}:\d{2}) (\S+) ([^\s\[:]+)(?:\[([^\]]+)\])?: ?(.*)$`)
+)
+
+var severityMapping = [...]entry.Severity{
+	0: entry.Fatal,
+	1: entry.Error3,
+	2: entry.Error2,
+	3: entry.Error,
+	4: entry.Warn,
+	5: entry.Info2,
+	6: entry.Info,
+	7: entry.Debug,
+}
+
+var severityText = [...]string{
+	0: "emerg",
+	1: "alert",
+	2: "crit",
+	3: "err",
+	4: "warning",
+	5: "notice",
+	6: "info",
+	7: "debug",
+}
+
+var facilityNames = [...]string{
+	0:  "kern",
+	1:  "user",
+	2:  "mail",
+	3:  "daemon",
+	4:  "auth",
+	5:  "syslog",
+	6:  "lpr",
+	7:  "news",
+	8:  "uucp",
+	9:  "cron",
+	10: "authpriv",
+	11: "ftp",
+	12: "ntp",
+	13: "security",
+	14: "console",
+	15: "solaris-cron",
+	16: "local0",
+	17: "local1",
+	18: "local2",
+	19: "local3",
+	20: "local4",
+	21: "local5",
+	22: "local6",
+	23: "local7",
+}
+
+// Process will parse the syslog header of an entry.
+func (p *Parser) Process(ctx context.Context, e *entry.Entry) error {
+	var severity int
+	parse := func(value interface{}) (interface{}, error) {
+		parsed, sev, err := parse(value)
+		severity = sev
+		return parsed, err
+	}
+
+	return p.ProcessWithCallback(ctx, e, parse, func(e *entry.Entry) error {
+		// an explicitly configured severity parser takes precedence
+		if p.SeverityParser == nil {
+			e.Severity = severityMapping[severity]
+			e.SeverityText = severityText[severity]
+		}
+		return nil
+	})
+}
+
+// parse will parse the syslog header of a value, returning the parsed fields and the syslog severity.
+func parse(value interface{}) (map[string]interface{}, int, error) {
+	message, ok := value.(string)
+	if !ok {
+		return nil, 0, fmt.Errorf("type '%T' cannot be parsed as syslog", value)
+	}
+
+	match := priorityRegexp.FindStringSubmatch(message)
+	if match == nil {
+		return nil, 0, fmt.Errorf("missing syslog priority")
+	}
+
+	priority, err := strconv.Atoi(match[1])
+	if err != nil || priority > 191 {
+		return nil, 0, fmt.Errorf("invalid syslog priority '%s'", match[1])
+	}
+
+	facility := priority / 8
+	parsed := map[string]interface{}{
+		"priority":      priority,
+		"facility":      facility,
+		"facility_name": facilityNames[facility],
+	}
+
+	rest := message[len(match[0]):]
+	switch {
+	case rfc5424Regexp.MatchString(rest):
+		header := rfc5424Regexp.FindStringSubmatch(rest)
+		setUnlessNil(parsed, "timestamp", header[1])
+		setUnlessNil(parsed, "hostname", header[2])
+		setUnlessNil(parsed, "appname", header[3])
+		setUnlessNil(parsed, "proc_id", header[4])
+		setUnlessNil(parsed, "msg_id", header[5])
+		// structured data is kept as part of the message, unless it is a NILVALUE
+		msg := header[6]
+		if msg == nilValue {
+			msg = ""
+		}
+		msg = strings.TrimPrefix(msg, nilValue+" ")
+		if msg = strings.TrimPrefix(msg, "\ufeff"); msg != "" {
+			parsed["message"] = msg
+		}
+	case rfc3164Regexp.MatchString(rest):
+		header := rfc3164Regexp.FindStringSubmatch(rest)
+		parsed["timestamp"] = header[1]
+		parsed["hostname"] = header[2]
+		parsed["appname"] = header[3]
+		if header[4] != "" {
+			parsed["proc_id"] = header[4]
+		}
+		parsed["message"] = header[5]
+	default:
+		parsed["message"] = rest
+	}
+
+	return parsed, priority % 8, nil
+}
+
+func setUnlessNil(parsed map[string]interface{}, key string, value string) {
+	if value != nilValue {
+		parsed[key] = value
+	}
+}
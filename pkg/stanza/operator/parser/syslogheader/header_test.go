@@ -0,0 +1,173 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syslogheader
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func TestParser(t *testing.T) {
+	cases := []struct {
+		name             string
+		body             string
+		expected         map[string]interface{}
+		expectedSeverity entry.Severity
+		expectedText     string
+	}{
+		{
+			name: "rfc5424",
+			body: `<34>1 2003-10-11T22:14:15.003Z mymachine.example.com su - ID47 [exampleSDID@32473 iut="3"] 'su root' failed`,
+			expected: map[string]interface{}{
+				"priority":      34,
+				"facility":      4,
+				"facility_name": "auth",
+				"timestamp":     "2003-10-11T22:14:15.003Z",
+				"hostname":      "mymachine.example.com",
+				"appname":       "su",
+				"msg_id":        "ID47",
+				"message":       `[exampleSDID@32473 iut="3"] 'su root' failed`,
+			},
+			expectedSeverity: entry.Error2,
+			expectedText:     "crit",
+		},
+		{
+			name: "rfc5424_without_message",
+			body: "<165>1 2003-08-24T05:14:15.000003-07:00 192.0.2.1 myproc 8710 - -",
+			expected: map[string]interface{}{
+				"priority":      165,
+				"facility":      20,
+				"facility_name": "local4",
+				"timestamp":     "2003-08-24T05:14:15.000003-07:00",
+				"hostname":      "192.0.2.1",
+				"appname":       "myproc",
+				"proc_id":       "8710",
+			},
+			expectedSeverity: entry.Info2,
+			expectedText:     "notice",
+		},
+		{
+			name: "rfc3164",
+			body: "<30>Oct  5 22:14:15 web-1 nginx[1024]: connection reset by peer",
+			expected: map[string]interface{}{
+				"priority":      30,
+				"facility":      3,
+				"facility_name": "daemon",
+				"timestamp":     "Oct  5 22:14:15",
+				"hostname":      "web-1",
+				"appname":       "nginx",
+				"proc_id":       "1024",
+				"message":       "connection reset by peer",
+			},
+			expectedSeverity: entry.Info,
+			expectedText:     "info",
+		},
+		{
+			name: "rfc3164_without_pid",
+			body: "<13>Feb 11 09:00:01 host cron: job started",
+			expected: map[string]interface{}{
+				"priority":      13,
+				"facility":      1,
+				"facility_name": "user",
+				"timestamp":     "Feb 11 09:00:01",
+				"hostname":      "host",
+				"appname":       "cron",
+				"message":       "job started",
+			},
+			expectedSeverity: entry.Info2,
+			expectedText:     "notice",
+		},
+		{
+			name: "priority_only",
+			body: "<191>something went wrong",
+			expected: map[string]interface{}{
+				"priority":      191,
+				"facility":      23,
+				"facility_name": "local7",
+				"message":       "something went wrong",
+			},
+			expectedSeverity: entry.Debug,
+			expectedText:     "debug",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			op, err := NewConfig().Build(testutil.Logger(t))
+			require.NoError(t, err)
+
+			e := entry.New()
+			e.Body = tc.body
+			require.NoError(t, op.(*Parser).Process(context.Background(), e))
+
+			require.Equal(t, tc.expected, e.Attributes)
+			require.Equal(t, tc.expectedSeverity, e.Severity)
+			require.Equal(t, tc.expectedText, e.SeverityText)
+		})
+	}
+}
+
+func TestParserInvalid(t *testing.T) {
+	cases := []struct {
+		name string
+		body interface{}
+	}{
+		{
+			name: "missing_priority",
+			body: "Oct  5 22:14:15 web-1 nginx[1024]: connection reset by peer",
+		},
+		{
+			name: "priority_out_of_range",
+			body: "<192>1 - - - - - -",
+		},
+		{
+			name: "not_a_string",
+			body: map[string]interface{}{"message": "<34>hello"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			op, err := NewConfig().Build(testutil.Logger(t))
+			require.NoError(t, err)
+
+			e := entry.New()
+			e.Body = tc.body
+			require.Error(t, op.(*Parser).Process(context.Background(), e))
+		})
+	}
+}
+
+func TestParserSeverityOverride(t *testing.T) {
+	cfg := NewConfig()
+	sevField := entry.NewAttributeField("appname")
+	cfg.SeverityConfig = &helper.SeverityConfig{
+		ParseFrom: &sevField,
+		Mapping:   map[interface{}]interface{}{"error": "nginx"},
+	}
+	op, err := cfg.Build(testutil.Logger(t))
+	require.NoError(t, err)
+
+	e := entry.New()
+	e.Body = "<30>Oct  5 22:14:15 web-1 nginx[1024]: connection reset by peer"
+	require.NoError(t, op.(*Parser).Process(context.Background(), e))
+	require.Equal(t, entry.Error, e.Severity)
+}
@@ -18,6 +18,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/operatortest"
 )
 
@@ -31,6 +32,16 @@ func TestUnmarshal(t *testing.T) {
 				ExpectErr: false,
 				Expect:    NewConfig(),
 			},
+			{
+				Name:      "first_entry_condition",
+				ExpectErr: false,
+				Expect: func() *Config {
+					cfg := NewConfig()
+					cfg.CombineField = entry.NewBodyField()
+					cfg.IsFirstEntryCondition = `attributes["level"] != nil`
+					return cfg
+				}(),
+			},
 			{
 				Name:      "custom_id",
 				ExpectErr: false,
@@ -0,0 +1,80 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recombine // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/transformer/recombine"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllogs"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+)
+
+// ottlFunctions are the functions available to OTTL conditions.
+func ottlFunctions() map[string]interface{} {
+	return map[string]interface{}{
+		"IsMatch": ottlfuncs.IsMatch[ottllogs.TransformContext],
+	}
+}
+
+// ottlCondition is an OTTL boolean expression evaluated against entries. The recombine operator
+// is the only part of pkg/stanza depending on pkg/ottl, which doesn't depend on pkg/stanza, so
+// that its conditions share the syntax and functions of the OTTL processors rather than a copy.
+type ottlCondition struct {
+	condition *ottl.Condition[ottllogs.TransformContext]
+}
+
+func newOTTLCondition(condition string, logger *zap.SugaredLogger) (*ottlCondition, error) {
+	parser := ottllogs.NewParser(ottlFunctions(), component.TelemetrySettings{Logger: logger.Desugar()})
+	conditions, err := parser.ParseConditions([]string{condition})
+	if err != nil {
+		return nil, err
+	}
+	return &ottlCondition{condition: conditions[0]}, nil
+}
+
+// matches returns true if the entry matches the condition.
+func (c *ottlCondition) matches(ctx context.Context, e *entry.Entry) (bool, error) {
+	return c.condition.Eval(ctx, entryToTransformContext(e))
+}
+
+// entryToTransformContext exposes the fields of the entry to OTTL as a log record.
+func entryToTransformContext(e *entry.Entry) ottllogs.TransformContext {
+	lr := plog.NewLogRecord()
+	if !e.Timestamp.IsZero() {
+		lr.SetTimestamp(pcommon.NewTimestampFromTime(e.Timestamp))
+	}
+	lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(e.ObservedTimestamp))
+	// entry severities have the same values as the OpenTelemetry severity numbers
+	lr.SetSeverityNumber(plog.SeverityNumber(e.Severity))
+	lr.SetSeverityText(e.SeverityText)
+	lr.Body().FromRaw(e.Body)
+	if e.Attributes != nil {
+		lr.Attributes().FromRaw(e.Attributes)
+	}
+
+	resource := pcommon.NewResource()
+	if e.Resource != nil {
+		resource.Attributes().FromRaw(e.Resource)
+	}
+
+	return ottllogs.NewTransformContext(lr, pcommon.NewInstrumentationScope(), resource)
+}
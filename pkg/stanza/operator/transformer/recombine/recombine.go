@@ -62,6 +62,8 @@ type Config struct {
 	helper.TransformerConfig `mapstructure:",squash"`
 	IsFirstEntry             string        `mapstructure:"is_first_entry"`
 	IsLastEntry              string        `mapstructure:"is_last_entry"`
+	IsFirstEntryCondition    string        `mapstructure:"is_first_entry_condition"`
+	IsLastEntryCondition     string        `mapstructure:"is_last_entry_condition"`
 	MaxBatchSize             int           `mapstructure:"max_batch_size"`
 	CombineField             entry.Field   `mapstructure:"combine_field"`
 	CombineWith              string        `mapstructure:"combine_with"`
@@ -78,28 +80,52 @@ func (c *Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 		return nil, fmt.Errorf("failed to build transformer config: %w", err)
 	}
 
-	if c.IsLastEntry != "" && c.IsFirstEntry != "" {
-		return nil, fmt.Errorf("only one of is_first_entry and is_last_entry can be set")
+	var expressions []string
+	for key, expression := range map[string]string{
+		"is_first_entry":           c.IsFirstEntry,
+		"is_last_entry":            c.IsLastEntry,
+		"is_first_entry_condition": c.IsFirstEntryCondition,
+		"is_last_entry_condition":  c.IsLastEntryCondition,
+	} {
+		if expression != "" {
+			expressions = append(expressions, key)
+		}
 	}
-
-	if c.IsLastEntry == "" && c.IsFirstEntry == "" {
-		return nil, fmt.Errorf("one of is_first_entry and is_last_entry must be set")
+	if len(expressions) > 1 {
+		return nil, fmt.Errorf("only one of is_first_entry, is_last_entry, is_first_entry_condition and is_last_entry_condition can be set")
+	}
+	if len(expressions) == 0 {
+		return nil, fmt.Errorf("one of is_first_entry, is_last_entry, is_first_entry_condition and is_last_entry_condition must be set")
 	}
 
 	var matchesFirst bool
 	var prog *vm.Program
-	if c.IsFirstEntry != "" {
+	var condition *ottlCondition
+	switch {
+	case c.IsFirstEntry != "":
 		matchesFirst = true
 		prog, err = expr.Compile(c.IsFirstEntry, expr.AsBool(), expr.AllowUndefinedVariables())
 		if err != nil {
 			return nil, fmt.Errorf("failed to compile is_first_entry: %w", err)
 		}
-	} else {
+	case c.IsLastEntry != "":
 		matchesFirst = false
 		prog, err = expr.Compile(c.IsLastEntry, expr.AsBool(), expr.AllowUndefinedVariables())
 		if err != nil {
 			return nil, fmt.Errorf("failed to compile is_last_entry: %w", err)
 		}
+	case c.IsFirstEntryCondition != "":
+		matchesFirst = true
+		condition, err = newOTTLCondition(c.IsFirstEntryCondition, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse is_first_entry_condition: %w", err)
+		}
+	default:
+		matchesFirst = false
+		condition, err = newOTTLCondition(c.IsLastEntryCondition, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse is_last_entry_condition: %w", err)
+		}
 	}
 
 	if c.CombineField.FieldInterface == nil {
@@ -120,6 +146,7 @@ func (c *Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 		TransformerOperator: transformer,
 		matchFirstLine:      matchesFirst,
 		prog:                prog,
+		condition:           condition,
 		maxBatchSize:        c.MaxBatchSize,
 		maxSources:          c.MaxSources,
 		overwriteWithOldest: overwriteWithOldest,
@@ -139,6 +166,7 @@ type Transformer struct {
 	helper.TransformerOperator
 	matchFirstLine      bool
 	prog                *vm.Program
+	condition           *ottlCondition
 	maxBatchSize        int
 	maxSources          int
 	overwriteWithOldest bool
@@ -205,20 +233,11 @@ func (r *Transformer) Process(ctx context.Context, e *entry.Entry) error {
 	r.Lock()
 	defer r.Unlock()
 
-	// Get the environment for executing the expression.
-	// In the future, we may want to provide access to the currently
-	// batched entries so users can do comparisons to other entries
-	// rather than just use absolute rules.
-	env := helper.GetExprEnv(e)
-	defer helper.PutExprEnv(env)
-
-	m, err := expr.Run(r.prog, env)
+	matches, err := r.matches(ctx, e)
 	if err != nil {
 		return r.HandleEntryError(ctx, e, err)
 	}
 
-	// this is guaranteed to be a boolean because of expr.AsBool
-	matches := m.(bool)
 	var s string
 	err = e.Read(r.sourceIdentifier, &s)
 	if err != nil {
@@ -257,6 +276,28 @@ func (r *Transformer) Process(ctx context.Context, e *entry.Entry) error {
 	return nil
 }
 
+// matches evaluates the configured expression or OTTL condition against the entry.
+func (r *Transformer) matches(ctx context.Context, e *entry.Entry) (bool, error) {
+	if r.condition != nil {
+		return r.condition.matches(ctx, e)
+	}
+
+	// Get the environment for executing the expression.
+	// In the future, we may want to provide access to the currently
+	// batched entries so users can do comparisons to other entries
+	// rather than just use absolute rules.
+	env := helper.GetExprEnv(e)
+	defer helper.PutExprEnv(env)
+
+	m, err := expr.Run(r.prog, env)
+	if err != nil {
+		return false, err
+	}
+
+	// this is guaranteed to be a boolean because of expr.AsBool
+	return m.(bool), nil
+}
+
 func (r *Transformer) matchIndicatesFirst() bool {
 	return r.matchFirstLine
 }
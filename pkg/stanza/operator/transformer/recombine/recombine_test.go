@@ -285,6 +285,46 @@ func TestTransformer(t *testing.T) {
 				entryWithBodyAttr(t2, "end", map[string]string{"file.path": "file2"}),
 			},
 		},
+		{
+			"OTTLFirstEntryCondition",
+			func() *Config {
+				cfg := NewConfig()
+				cfg.CombineField = entry.NewBodyField()
+				cfg.IsFirstEntryCondition = `attributes["level"] != nil`
+				cfg.OutputIDs = []string{"fake"}
+				return cfg
+			}(),
+			[]*entry.Entry{
+				entryWithBodyAttr(t1, "starting", map[string]string{"level": "info"}),
+				entryWithBodyAttr(t1, "request failed", map[string]string{"level": "error"}),
+				entryWithBody(t1, "  at checkout()"),
+				entryWithBody(t1, "  at main()"),
+				entryWithBodyAttr(t2, "done", map[string]string{"level": "info"}),
+			},
+			[]*entry.Entry{
+				entryWithBodyAttr(t1, "starting", map[string]string{"level": "info"}),
+				entryWithBodyAttr(t1, "request failed\n  at checkout()\n  at main()", map[string]string{"level": "error"}),
+			},
+		},
+		{
+			"OTTLLastEntryCondition",
+			func() *Config {
+				cfg := NewConfig()
+				cfg.CombineField = entry.NewBodyField()
+				cfg.IsLastEntryCondition = `IsMatch(body, "}$") == true`
+				cfg.OutputIDs = []string{"fake"}
+				return cfg
+			}(),
+			[]*entry.Entry{
+				entryWithBody(t1, `{"msg":`),
+				entryWithBody(t1, `"test"}`),
+				entryWithBody(t2, `{}`),
+			},
+			[]*entry.Entry{
+				entryWithBody(t1, "{\"msg\":\n\"test\"}"),
+				entryWithBody(t2, `{}`),
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -348,6 +388,45 @@ func TestTransformer(t *testing.T) {
 	})
 }
 
+func TestBuildErrors(t *testing.T) {
+	cases := []struct {
+		name      string
+		config    func(cfg *Config)
+		expectErr string
+	}{
+		{
+			"NoExpression",
+			func(cfg *Config) {},
+			"one of is_first_entry, is_last_entry, is_first_entry_condition and is_last_entry_condition must be set",
+		},
+		{
+			"ExpressionAndCondition",
+			func(cfg *Config) {
+				cfg.IsFirstEntry = MatchAll
+				cfg.IsLastEntryCondition = `body == "end"`
+			},
+			"only one of is_first_entry, is_last_entry, is_first_entry_condition and is_last_entry_condition can be set",
+		},
+		{
+			"InvalidCondition",
+			func(cfg *Config) {
+				cfg.IsFirstEntryCondition = `body ==`
+			},
+			"failed to parse is_first_entry_condition",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := NewConfig()
+			cfg.CombineField = entry.NewBodyField()
+			tc.config(cfg)
+			_, err := cfg.Build(testutil.Logger(t))
+			require.ErrorContains(t, err, tc.expectErr)
+		})
+	}
+}
+
 func BenchmarkRecombine(b *testing.B) {
 	cfg := NewConfig()
 	cfg.CombineField = entry.NewBodyField()
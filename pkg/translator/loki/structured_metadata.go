@@ -0,0 +1,196 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loki // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/loki"
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+const (
+	structuredMetadataTraceID = "trace_id"
+	structuredMetadataSpanID  = "span_id"
+)
+
+// StructuredPushRequest is a Loki push request whose entries carry structured metadata,
+// which Loki 2.9 and later store next to the log line without indexing it. It is sent
+// to the push API in its JSON form.
+type StructuredPushRequest struct {
+	Streams []StructuredStream
+	Report  *PushReport
+}
+
+// StructuredStream is a stream of entries sharing the same labels.
+type StructuredStream struct {
+	Labels  model.LabelSet
+	Entries []StructuredEntry
+}
+
+// StructuredEntry is a log line and its structured metadata.
+type StructuredEntry struct {
+	Timestamp          time.Time
+	Line               string
+	StructuredMetadata map[string]string
+}
+
+// MarshalJSON encodes the request in the JSON format of the Loki push API.
+func (r StructuredPushRequest) MarshalJSON() ([]byte, error) {
+	type jsonStream struct {
+		Stream model.LabelSet  `json:"stream"`
+		Values [][]interface{} `json:"values"`
+	}
+	streams := make([]jsonStream, 0, len(r.Streams))
+	for _, s := range r.Streams {
+		values := make([][]interface{}, 0, len(s.Entries))
+		for _, e := range s.Entries {
+			value := []interface{}{strconv.FormatInt(e.Timestamp.UnixNano(), 10), e.Line}
+			if len(e.StructuredMetadata) > 0 {
+				value = append(value, e.StructuredMetadata)
+			}
+			values = append(values, value)
+		}
+		streams = append(streams, jsonStream{Stream: s.Labels, Values: values})
+	}
+	return json.Marshal(struct {
+		Streams []jsonStream `json:"streams"`
+	}{Streams: streams})
+}
+
+// LogsToLokiStructuredRequests converts a Logs pipeline data into Loki push requests
+// grouped by tenant, like LogsToLokiRequests. Instead of encoding the record into the
+// log line, the line holds only the body, and the resource and record attributes that
+// are not promoted to labels are sent as structured metadata, along with the trace and
+// span IDs. Attribute names are sanitized to valid label names, and record attributes
+// take precedence over resource attributes of the same name. The `loki.format` hint
+// does not apply.
+func LogsToLokiStructuredRequests(ld plog.Logs) map[string]StructuredPushRequest {
+	type group struct {
+		streams map[string]*StructuredStream
+		report  *PushReport
+	}
+	groups := map[string]group{}
+
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		ills := rls.At(i).ScopeLogs()
+
+		for j := 0; j < ills.Len(); j++ {
+			logs := ills.At(j).LogRecords()
+			for k := 0; k < logs.Len(); k++ {
+
+				// we may remove attributes, so we make copies and change our versions
+				log := plog.NewLogRecord()
+				logs.At(k).CopyTo(log)
+				resource := pcommon.NewResource()
+				rls.At(i).Resource().CopyTo(resource)
+
+				// adds level attribute from log.severityNumber
+				addLogLevelAttributeAndHint(log)
+
+				tenant := getTenantFromTenantHint(log.Attributes(), resource.Attributes())
+				g, ok := groups[tenant]
+				if !ok {
+					g = group{
+						streams: map[string]*StructuredStream{},
+						report:  &PushReport{},
+					}
+					groups[tenant] = g
+				}
+
+				mergedLabels := convertAttributesAndMerge(log.Attributes(), resource.Attributes())
+				// remove the attributes that were promoted to labels
+				removeAttributes(log.Attributes(), mergedLabels)
+				removeAttributes(resource.Attributes(), mergedLabels)
+
+				entry := StructuredEntry{
+					Timestamp:          timestampFromLogRecord(log),
+					Line:               log.Body().AsString(),
+					StructuredMetadata: structuredMetadata(log, resource),
+				}
+				g.report.NumSubmitted++
+
+				labels := mergedLabels.String()
+				if stream, ok := g.streams[labels]; ok {
+					stream.Entries = append(stream.Entries, entry)
+					continue
+				}
+				g.streams[labels] = &StructuredStream{
+					Labels:  mergedLabels,
+					Entries: []StructuredEntry{entry},
+				}
+			}
+		}
+	}
+
+	requests := make(map[string]StructuredPushRequest, len(groups))
+	for tenant, g := range groups {
+		// sort the streams so that requests are stable
+		keys := make([]string, 0, len(g.streams))
+		for labels := range g.streams {
+			keys = append(keys, labels)
+		}
+		sort.Strings(keys)
+
+		streams := make([]StructuredStream, 0, len(keys))
+		for _, labels := range keys {
+			streams = append(streams, *g.streams[labels])
+		}
+		requests[tenant] = StructuredPushRequest{
+			Streams: streams,
+			Report:  g.report,
+		}
+	}
+	return requests
+}
+
+func structuredMetadata(lr plog.LogRecord, res pcommon.Resource) map[string]string {
+	metadata := map[string]string{}
+	put := func(k string, v pcommon.Value) bool {
+		metadata[sanitizeLabelName(k)] = v.AsString()
+		return true
+	}
+	res.Attributes().Range(put)
+	lr.Attributes().Range(put)
+
+	if traceID := lr.TraceID(); !traceID.IsEmpty() {
+		metadata[structuredMetadataTraceID] = traceID.HexString()
+	}
+	if spanID := lr.SpanID(); !spanID.IsEmpty() {
+		metadata[structuredMetadataSpanID] = spanID.HexString()
+	}
+	return metadata
+}
+
+// sanitizeLabelName replaces the characters which are not allowed in Loki label names,
+// e.g. `http.status_code` becomes `http_status_code`.
+func sanitizeLabelName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' {
+			return r
+		}
+		return '_'
+	}, name)
+	if name != "" && name[0] >= '0' && name[0] <= '9' {
+		return "_" + name
+	}
+	return name
+}
@@ -0,0 +1,99 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loki // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/loki"
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func TestLogsToLokiStructuredRequests(t *testing.T) {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("host.name", "guarana")
+	rl.Resource().Attributes().PutStr("region.az", "eu-west-1a")
+	rl.Resource().Attributes().PutStr("tenant.id", "acme")
+	rl.Resource().Attributes().PutStr(hintTenant, "tenant.id")
+
+	records := rl.ScopeLogs().AppendEmpty().LogRecords()
+	for i, service := range []string{"checkout", "cart"} {
+		lr := records.AppendEmpty()
+		lr.SetTimestamp(pcommon.Timestamp(i + 1))
+		lr.SetSeverityNumber(plog.SeverityNumberWarn)
+		lr.SetTraceID([16]byte{1, 2, 3, 4})
+		lr.Body().SetStr("payment declined")
+		lr.Attributes().PutStr("service.name", service)
+		lr.Attributes().PutInt("http.status_code", 402)
+		lr.Attributes().PutStr("region.az", "eu-west-1b")
+		lr.Attributes().PutStr(hintAttributes, "service.name")
+		lr.Attributes().PutStr(hintResources, "host.name")
+	}
+
+	requests := LogsToLokiStructuredRequests(ld)
+	require.Len(t, requests, 1)
+	request, ok := requests["acme"]
+	require.True(t, ok)
+	assert.Equal(t, 2, request.Report.NumSubmitted)
+	require.Len(t, request.Streams, 2)
+
+	stream := request.Streams[0]
+	assert.Equal(t, model.LabelSet{
+		"exporter":     "OTLP",
+		"host.name":    "guarana",
+		"level":        "WARN",
+		"tenant.id":    "acme",
+		"service.name": "cart",
+	}, stream.Labels)
+	require.Len(t, stream.Entries, 1)
+	assert.Equal(t, StructuredEntry{
+		Timestamp: time.Unix(0, 2),
+		Line:      "payment declined",
+		StructuredMetadata: map[string]string{
+			"http_status_code": "402",
+			"region_az":        "eu-west-1b",
+			"trace_id":         "01020304000000000000000000000000",
+		},
+	}, stream.Entries[0])
+	assert.Equal(t, model.LabelValue("checkout"), request.Streams[1].Labels["service.name"])
+}
+
+func TestStructuredPushRequestMarshalJSON(t *testing.T) {
+	request := StructuredPushRequest{
+		Streams: []StructuredStream{{
+			Labels: model.LabelSet{"exporter": "OTLP"},
+			Entries: []StructuredEntry{
+				{Timestamp: time.Unix(0, 1), Line: "first", StructuredMetadata: map[string]string{"trace_id": "01"}},
+				{Timestamp: time.Unix(0, 2), Line: "second"},
+			},
+		}},
+	}
+	buf, err := json.Marshal(request)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"streams":[{"stream":{"exporter":"OTLP"},"values":[["1","first",{"trace_id":"01"}],["2","second"]]}]}`, string(buf))
+}
+
+func TestSanitizeLabelName(t *testing.T) {
+	assert.Equal(t, "http_status_code", sanitizeLabelName("http.status_code"))
+	assert.Equal(t, "k8s_pod_name", sanitizeLabelName("k8s.pod.name"))
+	assert.Equal(t, "_1st", sanitizeLabelName("1st"))
+	assert.Equal(t, "", sanitizeLabelName(""))
+}
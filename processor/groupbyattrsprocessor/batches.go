@@ -0,0 +1,401 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupbyattrsprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbyattrsprocessor"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/multierr"
+)
+
+// batchLimits bounds the batches emitted by the processor. A zero value means no limit.
+type batchLimits struct {
+	maxRecords int
+	maxBytes   int
+}
+
+func (l batchLimits) enabled() bool {
+	return l.maxRecords > 0 || l.maxBytes > 0
+}
+
+// fits returns whether a record costing recordBytes can be added to a batch already holding
+// records records of the given size. A batch always accepts its first record, even when
+// that record alone is larger than maxBytes.
+func (l batchLimits) fits(records, bytes, recordBytes int) bool {
+	if records == 0 {
+		return true
+	}
+	if l.maxRecords > 0 && records >= l.maxRecords {
+		return false
+	}
+	if l.maxBytes > 0 && bytes+recordBytes > l.maxBytes {
+		return false
+	}
+	return true
+}
+
+// batchState tracks the size of the batch being filled.
+type batchState struct {
+	limits  batchLimits
+	records int
+	bytes   int
+}
+
+// add accounts for a record, returning false when it does not fit and a new batch must be started.
+// headerBytes is the cost of the resource and scope (and metric) the record needs to be added
+// to the current batch, while newBatchHeaderBytes is their full cost in a new batch.
+func (s *batchState) add(recordBytes, headerBytes, newBatchHeaderBytes int) bool {
+	if !s.limits.fits(s.records, s.bytes, recordBytes+headerBytes) {
+		s.records, s.bytes = 1, recordBytes+newBatchHeaderBytes
+		return false
+	}
+	s.records++
+	s.bytes += recordBytes + headerBytes
+	return true
+}
+
+func newSplittingTraces(next consumer.Traces, limits batchLimits) (consumer.Traces, error) {
+	return consumer.NewTraces(func(ctx context.Context, td ptrace.Traces) error {
+		var errs error
+		for _, batch := range splitTraces(td, limits) {
+			errs = multierr.Append(errs, next.ConsumeTraces(ctx, batch))
+		}
+		return errs
+	})
+}
+
+func newSplittingLogs(next consumer.Logs, limits batchLimits) (consumer.Logs, error) {
+	return consumer.NewLogs(func(ctx context.Context, ld plog.Logs) error {
+		var errs error
+		for _, batch := range splitLogs(ld, limits) {
+			errs = multierr.Append(errs, next.ConsumeLogs(ctx, batch))
+		}
+		return errs
+	})
+}
+
+func newSplittingMetrics(next consumer.Metrics, limits batchLimits) (consumer.Metrics, error) {
+	return consumer.NewMetrics(func(ctx context.Context, md pmetric.Metrics) error {
+		var errs error
+		for _, batch := range splitMetrics(md, limits) {
+			errs = multierr.Append(errs, next.ConsumeMetrics(ctx, batch))
+		}
+		return errs
+	})
+}
+
+// splitTraces splits td into batches of spans respecting the limits.
+// Sizes are the protobuf encoded sizes of the spans and of their resource and scope.
+func splitTraces(td ptrace.Traces, limits batchLimits) []ptrace.Traces {
+	if !limits.enabled() {
+		return []ptrace.Traces{td}
+	}
+
+	sizer := ptrace.ProtoMarshaler{}
+	state := batchState{limits: limits}
+	batches := []ptrace.Traces{ptrace.NewTraces()}
+
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		var destRS ptrace.ResourceSpans
+		hasRS := false
+
+		// measure the resource and scope by adding them to a scratch batch
+		scratch := ptrace.NewTraces()
+		scratchRS := scratch.ResourceSpans().AppendEmpty()
+		rs.Resource().CopyTo(scratchRS.Resource())
+		scratchRS.SetSchemaUrl(rs.SchemaUrl())
+		rsBytes := sizer.TracesSize(scratch)
+
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			ss := rs.ScopeSpans().At(j)
+			var destSS ptrace.ScopeSpans
+			hasSS := false
+
+			scratchSS := scratchRS.ScopeSpans().AppendEmpty()
+			ss.Scope().CopyTo(scratchSS.Scope())
+			scratchSS.SetSchemaUrl(ss.SchemaUrl())
+			withScope := sizer.TracesSize(scratch)
+			ssBytes := withScope - rsBytes
+
+			for k := 0; k < ss.Spans().Len(); k++ {
+				span := ss.Spans().At(k)
+
+				spanBytes := 0
+				if limits.maxBytes > 0 {
+					span.CopyTo(scratchSS.Spans().AppendEmpty())
+					spanBytes = sizer.TracesSize(scratch) - withScope
+					scratchSS.Spans().RemoveIf(func(ptrace.Span) bool { return true })
+				}
+
+				headerBytes := 0
+				if !hasRS {
+					headerBytes += rsBytes
+				}
+				if !hasSS {
+					headerBytes += ssBytes
+				}
+				if !state.add(spanBytes, headerBytes, rsBytes+ssBytes) {
+					batches = append(batches, ptrace.NewTraces())
+					hasRS, hasSS = false, false
+				}
+
+				batch := batches[len(batches)-1]
+				if !hasRS {
+					destRS = batch.ResourceSpans().AppendEmpty()
+					rs.Resource().CopyTo(destRS.Resource())
+					destRS.SetSchemaUrl(rs.SchemaUrl())
+					hasRS = true
+				}
+				if !hasSS {
+					destSS = destRS.ScopeSpans().AppendEmpty()
+					ss.Scope().CopyTo(destSS.Scope())
+					destSS.SetSchemaUrl(ss.SchemaUrl())
+					hasSS = true
+				}
+				span.CopyTo(destSS.Spans().AppendEmpty())
+			}
+			scratchRS.ScopeSpans().RemoveIf(func(ptrace.ScopeSpans) bool { return true })
+		}
+	}
+
+	return batches
+}
+
+// splitLogs splits ld into batches of log records respecting the limits.
+// Sizes are the protobuf encoded sizes of the log records and of their resource and scope.
+func splitLogs(ld plog.Logs, limits batchLimits) []plog.Logs {
+	if !limits.enabled() {
+		return []plog.Logs{ld}
+	}
+
+	sizer := plog.ProtoMarshaler{}
+	state := batchState{limits: limits}
+	batches := []plog.Logs{plog.NewLogs()}
+
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		var destRL plog.ResourceLogs
+		hasRL := false
+
+		// measure the resource and scope by adding them to a scratch batch
+		scratch := plog.NewLogs()
+		scratchRL := scratch.ResourceLogs().AppendEmpty()
+		rl.Resource().CopyTo(scratchRL.Resource())
+		scratchRL.SetSchemaUrl(rl.SchemaUrl())
+		rlBytes := sizer.LogsSize(scratch)
+
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			var destSL plog.ScopeLogs
+			hasSL := false
+
+			scratchSL := scratchRL.ScopeLogs().AppendEmpty()
+			sl.Scope().CopyTo(scratchSL.Scope())
+			scratchSL.SetSchemaUrl(sl.SchemaUrl())
+			withScope := sizer.LogsSize(scratch)
+			slBytes := withScope - rlBytes
+
+			for k := 0; k < sl.LogRecords().Len(); k++ {
+				lr := sl.LogRecords().At(k)
+
+				recordBytes := 0
+				if limits.maxBytes > 0 {
+					lr.CopyTo(scratchSL.LogRecords().AppendEmpty())
+					recordBytes = sizer.LogsSize(scratch) - withScope
+					scratchSL.LogRecords().RemoveIf(func(plog.LogRecord) bool { return true })
+				}
+
+				headerBytes := 0
+				if !hasRL {
+					headerBytes += rlBytes
+				}
+				if !hasSL {
+					headerBytes += slBytes
+				}
+				if !state.add(recordBytes, headerBytes, rlBytes+slBytes) {
+					batches = append(batches, plog.NewLogs())
+					hasRL, hasSL = false, false
+				}
+
+				batch := batches[len(batches)-1]
+				if !hasRL {
+					destRL = batch.ResourceLogs().AppendEmpty()
+					rl.Resource().CopyTo(destRL.Resource())
+					destRL.SetSchemaUrl(rl.SchemaUrl())
+					hasRL = true
+				}
+				if !hasSL {
+					destSL = destRL.ScopeLogs().AppendEmpty()
+					sl.Scope().CopyTo(destSL.Scope())
+					destSL.SetSchemaUrl(sl.SchemaUrl())
+					hasSL = true
+				}
+				lr.CopyTo(destSL.LogRecords().AppendEmpty())
+			}
+			scratchRL.ScopeLogs().RemoveIf(func(plog.ScopeLogs) bool { return true })
+		}
+	}
+
+	return batches
+}
+
+// splitMetrics splits md into batches of metric data points respecting the limits.
+// Sizes are the protobuf encoded sizes of the data points and of their resource, scope and metric.
+func splitMetrics(md pmetric.Metrics, limits batchLimits) []pmetric.Metrics {
+	if !limits.enabled() {
+		return []pmetric.Metrics{md}
+	}
+
+	sizer := pmetric.ProtoMarshaler{}
+	state := batchState{limits: limits}
+	batches := []pmetric.Metrics{pmetric.NewMetrics()}
+
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		var destRM pmetric.ResourceMetrics
+		hasRM := false
+
+		// measure the resource, scope and metric by adding them to a scratch batch
+		scratch := pmetric.NewMetrics()
+		scratchRM := scratch.ResourceMetrics().AppendEmpty()
+		rm.Resource().CopyTo(scratchRM.Resource())
+		scratchRM.SetSchemaUrl(rm.SchemaUrl())
+		rmBytes := sizer.MetricsSize(scratch)
+
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+			var destSM pmetric.ScopeMetrics
+			hasSM := false
+
+			scratchSM := scratchRM.ScopeMetrics().AppendEmpty()
+			sm.Scope().CopyTo(scratchSM.Scope())
+			scratchSM.SetSchemaUrl(sm.SchemaUrl())
+			withScope := sizer.MetricsSize(scratch)
+			smBytes := withScope - rmBytes
+
+			for k := 0; k < sm.Metrics().Len(); k++ {
+				metric := sm.Metrics().At(k)
+				var destMetric pmetric.Metric
+				hasMetric := false
+
+				scratchMetric := getMetricInInstrumentationLibrary(scratchSM, metric)
+				withMetric := sizer.MetricsSize(scratch)
+				metricBytes := withMetric - withScope
+
+				for p := 0; p < dataPointCount(metric); p++ {
+					dpBytes := 0
+					if limits.maxBytes > 0 {
+						copyDataPoint(metric, p, scratchMetric)
+						dpBytes = sizer.MetricsSize(scratch) - withMetric
+						removeDataPoints(scratchMetric)
+					}
+
+					headerBytes := 0
+					if !hasRM {
+						headerBytes += rmBytes
+					}
+					if !hasSM {
+						headerBytes += smBytes
+					}
+					if !hasMetric {
+						headerBytes += metricBytes
+					}
+					if !state.add(dpBytes, headerBytes, rmBytes+smBytes+metricBytes) {
+						batches = append(batches, pmetric.NewMetrics())
+						hasRM, hasSM, hasMetric = false, false, false
+					}
+
+					batch := batches[len(batches)-1]
+					if !hasRM {
+						destRM = batch.ResourceMetrics().AppendEmpty()
+						rm.Resource().CopyTo(destRM.Resource())
+						destRM.SetSchemaUrl(rm.SchemaUrl())
+						hasRM = true
+					}
+					if !hasSM {
+						destSM = destRM.ScopeMetrics().AppendEmpty()
+						sm.Scope().CopyTo(destSM.Scope())
+						destSM.SetSchemaUrl(sm.SchemaUrl())
+						hasSM = true
+					}
+					if !hasMetric {
+						destMetric = getMetricInInstrumentationLibrary(destSM, metric)
+						hasMetric = true
+					}
+					copyDataPoint(metric, p, destMetric)
+				}
+				scratchSM.Metrics().RemoveIf(func(pmetric.Metric) bool { return true })
+			}
+			scratchRM.ScopeMetrics().RemoveIf(func(pmetric.ScopeMetrics) bool { return true })
+		}
+	}
+
+	return batches
+}
+
+func dataPointCount(metric pmetric.Metric) int {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		return metric.Gauge().DataPoints().Len()
+	case pmetric.MetricTypeSum:
+		return metric.Sum().DataPoints().Len()
+	case pmetric.MetricTypeSummary:
+		return metric.Summary().DataPoints().Len()
+	case pmetric.MetricTypeHistogram:
+		return metric.Histogram().DataPoints().Len()
+	case pmetric.MetricTypeExponentialHistogram:
+		return metric.ExponentialHistogram().DataPoints().Len()
+	}
+	return 0
+}
+
+// copyDataPoint appends the data point at index i of src to dest, which must have the same type.
+func copyDataPoint(src pmetric.Metric, i int, dest pmetric.Metric) {
+	switch src.Type() {
+	case pmetric.MetricTypeGauge:
+		src.Gauge().DataPoints().At(i).CopyTo(dest.Gauge().DataPoints().AppendEmpty())
+	case pmetric.MetricTypeSum:
+		src.Sum().DataPoints().At(i).CopyTo(dest.Sum().DataPoints().AppendEmpty())
+	case pmetric.MetricTypeSummary:
+		src.Summary().DataPoints().At(i).CopyTo(dest.Summary().DataPoints().AppendEmpty())
+	case pmetric.MetricTypeHistogram:
+		src.Histogram().DataPoints().At(i).CopyTo(dest.Histogram().DataPoints().AppendEmpty())
+	case pmetric.MetricTypeExponentialHistogram:
+		src.ExponentialHistogram().DataPoints().At(i).CopyTo(dest.ExponentialHistogram().DataPoints().AppendEmpty())
+	}
+}
+
+func removeDataPoints(metric pmetric.Metric) {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		metric.Gauge().DataPoints().RemoveIf(func(pmetric.NumberDataPoint) bool { return true })
+	case pmetric.MetricTypeSum:
+		metric.Sum().DataPoints().RemoveIf(func(pmetric.NumberDataPoint) bool { return true })
+	case pmetric.MetricTypeSummary:
+		metric.Summary().DataPoints().RemoveIf(func(pmetric.SummaryDataPoint) bool { return true })
+	case pmetric.MetricTypeHistogram:
+		metric.Histogram().DataPoints().RemoveIf(func(pmetric.HistogramDataPoint) bool { return true })
+	case pmetric.MetricTypeExponentialHistogram:
+		metric.ExponentialHistogram().DataPoints().RemoveIf(func(pmetric.ExponentialHistogramDataPoint) bool { return true })
+	}
+}
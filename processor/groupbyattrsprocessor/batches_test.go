@@ -0,0 +1,172 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupbyattrsprocessor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func logsWithPods(pods int, recordsPerPod int) plog.Logs {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("k8s.node.name", "node-1")
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName("scope")
+	for i := 0; i < recordsPerPod; i++ {
+		for p := 0; p < pods; p++ {
+			lr := sl.LogRecords().AppendEmpty()
+			lr.Attributes().PutStr("k8s.pod.name", fmt.Sprintf("pod-%d", p))
+			lr.Body().SetStr(strings.Repeat("x", 100))
+		}
+	}
+	return ld
+}
+
+func TestSplitLogsByRecords(t *testing.T) {
+	ld := logsWithPods(2, 5)
+	batches := splitLogs(ld, batchLimits{maxRecords: 3})
+
+	require.Len(t, batches, 4)
+	total := 0
+	for _, batch := range batches {
+		assert.LessOrEqual(t, batch.LogRecordCount(), 3)
+		total += batch.LogRecordCount()
+		require.Equal(t, 1, batch.ResourceLogs().Len())
+		rl := batch.ResourceLogs().At(0)
+		assert.Equal(t, map[string]interface{}{"k8s.node.name": "node-1"}, rl.Resource().Attributes().AsRaw())
+		assert.Equal(t, "scope", rl.ScopeLogs().At(0).Scope().Name())
+	}
+	assert.Equal(t, 10, total)
+}
+
+func TestSplitLogsByBytes(t *testing.T) {
+	ld := logsWithPods(4, 25)
+	sizer := plog.ProtoMarshaler{}
+	maxBytes := sizer.LogsSize(ld) / 4
+
+	batches := splitLogs(ld, batchLimits{maxBytes: maxBytes})
+
+	require.Greater(t, len(batches), 4)
+	total := 0
+	for _, batch := range batches {
+		assert.LessOrEqual(t, sizer.LogsSize(batch), maxBytes)
+		total += batch.LogRecordCount()
+	}
+	assert.Equal(t, 100, total)
+}
+
+func TestSplitLogsOversizedRecord(t *testing.T) {
+	ld := logsWithPods(1, 3)
+	batches := splitLogs(ld, batchLimits{maxBytes: 10})
+
+	// each record is larger than the limit, so it is sent on its own
+	require.Len(t, batches, 3)
+	for _, batch := range batches {
+		assert.Equal(t, 1, batch.LogRecordCount())
+	}
+}
+
+func TestSplitWithoutLimits(t *testing.T) {
+	ld := logsWithPods(2, 5)
+	batches := splitLogs(ld, batchLimits{})
+	require.Len(t, batches, 1)
+	assert.Equal(t, ld, batches[0])
+}
+
+func TestSplitTraces(t *testing.T) {
+	td := ptrace.NewTraces()
+	for r := 0; r < 2; r++ {
+		rs := td.ResourceSpans().AppendEmpty()
+		rs.Resource().Attributes().PutStr("service.name", fmt.Sprintf("svc-%d", r))
+		ss := rs.ScopeSpans().AppendEmpty()
+		for i := 0; i < 3; i++ {
+			ss.Spans().AppendEmpty().SetName(fmt.Sprintf("span-%d-%d", r, i))
+		}
+	}
+
+	batches := splitTraces(td, batchLimits{maxRecords: 4})
+
+	require.Len(t, batches, 2)
+	assert.Equal(t, 4, batches[0].SpanCount())
+	assert.Equal(t, 2, batches[0].ResourceSpans().Len())
+	assert.Equal(t, 2, batches[1].SpanCount())
+	assert.Equal(t, 1, batches[1].ResourceSpans().Len())
+	svc, _ := batches[1].ResourceSpans().At(0).Resource().Attributes().Get("service.name")
+	assert.Equal(t, "svc-1", svc.Str())
+	assert.Equal(t, "span-1-1", batches[1].ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Name())
+}
+
+func TestSplitMetrics(t *testing.T) {
+	md := pmetric.NewMetrics()
+	sm := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty()
+	sum := sm.Metrics().AppendEmpty()
+	sum.SetName("requests")
+	sum.SetUnit("1")
+	sum.SetEmptySum().SetIsMonotonic(true)
+	sum.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	for i := 0; i < 5; i++ {
+		sum.Sum().DataPoints().AppendEmpty().SetIntValue(int64(i))
+	}
+	histogram := sm.Metrics().AppendEmpty()
+	histogram.SetName("latency")
+	histogram.SetEmptyHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	histogram.Histogram().DataPoints().AppendEmpty().SetCount(1)
+
+	batches := splitMetrics(md, batchLimits{maxRecords: 2})
+
+	require.Len(t, batches, 3)
+	for _, batch := range batches {
+		assert.Equal(t, 2, batch.DataPointCount())
+	}
+	last := batches[2].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, last.Len())
+	assert.Equal(t, "requests", last.At(0).Name())
+	assert.Equal(t, "1", last.At(0).Unit())
+	assert.True(t, last.At(0).Sum().IsMonotonic())
+	assert.Equal(t, int64(4), last.At(0).Sum().DataPoints().At(0).IntValue())
+	assert.Equal(t, "latency", last.At(1).Name())
+	assert.Equal(t, pmetric.AggregationTemporalityDelta, last.At(1).Histogram().AggregationTemporality())
+}
+
+func TestProcessorSendsBatches(t *testing.T) {
+	next := new(consumertest.LogsSink)
+	cfg := createDefaultConfig().(*Config)
+	cfg.GroupByKeys = []string{"k8s.pod.name"}
+	cfg.MaxRecordsPerBatch = 4
+
+	processor, err := createLogsProcessor(context.Background(), componenttest.NewNopProcessorCreateSettings(), cfg, next)
+	require.NoError(t, err)
+	require.NoError(t, processor.ConsumeLogs(context.Background(), logsWithPods(3, 3)))
+
+	batches := next.AllLogs()
+	require.Len(t, batches, 3)
+	assert.Equal(t, 4, batches[0].LogRecordCount())
+	assert.Equal(t, 4, batches[1].LogRecordCount())
+	assert.Equal(t, 1, batches[2].LogRecordCount())
+	// the records of a pod are grouped under its own resource
+	assert.Equal(t, 2, batches[0].ResourceLogs().Len())
+	assert.Equal(t, 2, batches[1].ResourceLogs().Len())
+}
@@ -15,6 +15,8 @@
 package groupbyattrsprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbyattrsprocessor"
 
 import (
+	"errors"
+
 	"go.opentelemetry.io/collector/config"
 )
 
@@ -25,4 +27,32 @@ type Config struct {
 	// GroupByKeys describes the attribute names that are going to be used for grouping.
 	// Empty value is allowed, since processor in such case can compact data
 	GroupByKeys []string `mapstructure:"keys"`
+
+	// MaxRecordsPerBatch limits the number of spans, log records or metric data points
+	// in each batch sent to the next consumer. Zero means no limit.
+	MaxRecordsPerBatch int `mapstructure:"max_records_per_batch"`
+
+	// MaxBytesPerBatch limits the protobuf encoded size of each batch sent to the next
+	// consumer, e.g. to stay below the gRPC message size of an exporter. Zero means no limit.
+	MaxBytesPerBatch int `mapstructure:"max_bytes_per_batch"`
+
+	// MetadataKeys are resource attributes whose values are set as client metadata of the
+	// context of the batches sent to the next consumer. The batches are split so that all
+	// their resources have the same values for these attributes.
+	MetadataKeys []string `mapstructure:"metadata_keys"`
+}
+
+// Validate checks if the processor configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.MaxRecordsPerBatch < 0 {
+		return errors.New("max_records_per_batch must not be negative")
+	}
+	if cfg.MaxBytesPerBatch < 0 {
+		return errors.New("max_bytes_per_batch must not be negative")
+	}
+	return nil
+}
+
+func (cfg *Config) batchLimits() batchLimits {
+	return batchLimits{maxRecords: cfg.MaxRecordsPerBatch, maxBytes: cfg.MaxBytesPerBatch}
 }
@@ -46,6 +46,23 @@ func TestLoadingConfig(t *testing.T) {
 				GroupByKeys:       []string{},
 			},
 		},
+		{
+			id: component.NewIDWithName(typeStr, "limits"),
+			expected: &Config{
+				ProcessorSettings:  config.NewProcessorSettings(component.NewID(typeStr)),
+				GroupByKeys:        []string{"k8s.pod.name"},
+				MaxRecordsPerBatch: 1000,
+				MaxBytesPerBatch:   4000000,
+			},
+		},
+		{
+			id: component.NewIDWithName(typeStr, "metadata"),
+			expected: &Config{
+				ProcessorSettings: config.NewProcessorSettings(component.NewID(typeStr)),
+				GroupByKeys:       []string{"tenant"},
+				MetadataKeys:      []string{"tenant"},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -65,3 +82,15 @@ func TestLoadingConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateConfig(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	assert.NoError(t, cfg.Validate())
+
+	cfg.MaxRecordsPerBatch = -1
+	assert.EqualError(t, cfg.Validate(), "max_records_per_batch must not be negative")
+
+	cfg.MaxRecordsPerBatch = 0
+	cfg.MaxBytesPerBatch = -1
+	assert.EqualError(t, cfg.Validate(), "max_bytes_per_batch must not be negative")
+}
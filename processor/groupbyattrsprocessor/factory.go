@@ -91,6 +91,20 @@ func createTracesProcessor(
 	oCfg := cfg.(*Config)
 	gap := createGroupByAttrsProcessor(set.Logger, oCfg.GroupByKeys)
 
+	if limits := oCfg.batchLimits(); limits.enabled() {
+		var err error
+		if nextConsumer, err = newSplittingTraces(nextConsumer, limits); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(oCfg.MetadataKeys) > 0 {
+		var err error
+		if nextConsumer, err = newMetadataTraces(nextConsumer, oCfg.MetadataKeys); err != nil {
+			return nil, err
+		}
+	}
+
 	return processorhelper.NewTracesProcessor(
 		ctx,
 		set,
@@ -110,6 +124,20 @@ func createLogsProcessor(
 	oCfg := cfg.(*Config)
 	gap := createGroupByAttrsProcessor(set.Logger, oCfg.GroupByKeys)
 
+	if limits := oCfg.batchLimits(); limits.enabled() {
+		var err error
+		if nextConsumer, err = newSplittingLogs(nextConsumer, limits); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(oCfg.MetadataKeys) > 0 {
+		var err error
+		if nextConsumer, err = newMetadataLogs(nextConsumer, oCfg.MetadataKeys); err != nil {
+			return nil, err
+		}
+	}
+
 	return processorhelper.NewLogsProcessor(
 		ctx,
 		set,
@@ -129,6 +157,20 @@ func createMetricsProcessor(
 	oCfg := cfg.(*Config)
 	gap := createGroupByAttrsProcessor(set.Logger, oCfg.GroupByKeys)
 
+	if limits := oCfg.batchLimits(); limits.enabled() {
+		var err error
+		if nextConsumer, err = newSplittingMetrics(nextConsumer, limits); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(oCfg.MetadataKeys) > 0 {
+		var err error
+		if nextConsumer, err = newMetadataMetrics(nextConsumer, oCfg.MetadataKeys); err != nil {
+			return nil, err
+		}
+	}
+
 	return processorhelper.NewMetricsProcessor(
 		ctx,
 		set,
@@ -0,0 +1,167 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupbyattrsprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbyattrsprocessor"
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/multierr"
+)
+
+// metadataPartitioner splits the batches by the values of resource attributes, and sets these
+// values as the client metadata of the context of each batch, e.g. for exporters setting
+// per-tenant headers from the client metadata.
+type metadataPartitioner struct {
+	keys []string
+}
+
+// partitionKey returns the values of the metadata keys in the resource attributes,
+// identifying the batch of the resource.
+func (p metadataPartitioner) partitionKey(attrs pcommon.Map) (string, map[string][]string) {
+	var sb strings.Builder
+	metadata := make(map[string][]string, len(p.keys))
+	for _, key := range p.keys {
+		// prefix the values to distinguish a missing attribute from an empty one
+		if value, ok := attrs.Get(key); ok {
+			metadata[key] = []string{value.AsString()}
+			sb.WriteByte('+')
+			sb.WriteString(value.AsString())
+		} else {
+			sb.WriteByte('-')
+		}
+		sb.WriteByte(0)
+	}
+	return sb.String(), metadata
+}
+
+// contextWithMetadata returns ctx with the metadata as client metadata. The client metadata of
+// ctx is replaced, since the existing metadata can't be enumerated to be merged.
+func contextWithMetadata(ctx context.Context, metadata map[string][]string) context.Context {
+	info := client.FromContext(ctx)
+	return client.NewContext(ctx, client.Info{
+		Addr:     info.Addr,
+		Auth:     info.Auth,
+		Metadata: client.NewMetadata(metadata),
+	})
+}
+
+// partitionTraces groups the resources of td by their partition key, in order.
+func (p metadataPartitioner) partitionTraces(td ptrace.Traces) ([]ptrace.Traces, []map[string][]string) {
+	var batches []ptrace.Traces
+	var metadata []map[string][]string
+	indexes := map[string]int{}
+
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		key, md := p.partitionKey(rs.Resource().Attributes())
+		idx, ok := indexes[key]
+		if !ok {
+			idx = len(batches)
+			indexes[key] = idx
+			batches = append(batches, ptrace.NewTraces())
+			metadata = append(metadata, md)
+		}
+		rs.CopyTo(batches[idx].ResourceSpans().AppendEmpty())
+	}
+	return batches, metadata
+}
+
+// partitionLogs groups the resources of ld by their partition key, in order.
+func (p metadataPartitioner) partitionLogs(ld plog.Logs) ([]plog.Logs, []map[string][]string) {
+	var batches []plog.Logs
+	var metadata []map[string][]string
+	indexes := map[string]int{}
+
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		key, md := p.partitionKey(rl.Resource().Attributes())
+		idx, ok := indexes[key]
+		if !ok {
+			idx = len(batches)
+			indexes[key] = idx
+			batches = append(batches, plog.NewLogs())
+			metadata = append(metadata, md)
+		}
+		rl.CopyTo(batches[idx].ResourceLogs().AppendEmpty())
+	}
+	return batches, metadata
+}
+
+// partitionMetrics groups the resources of md by their partition key, in order.
+func (p metadataPartitioner) partitionMetrics(md pmetric.Metrics) ([]pmetric.Metrics, []map[string][]string) {
+	var batches []pmetric.Metrics
+	var metadata []map[string][]string
+	indexes := map[string]int{}
+
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		key, m := p.partitionKey(rm.Resource().Attributes())
+		idx, ok := indexes[key]
+		if !ok {
+			idx = len(batches)
+			indexes[key] = idx
+			batches = append(batches, pmetric.NewMetrics())
+			metadata = append(metadata, m)
+		}
+		rm.CopyTo(batches[idx].ResourceMetrics().AppendEmpty())
+	}
+	return batches, metadata
+}
+
+func newMetadataTraces(next consumer.Traces, keys []string) (consumer.Traces, error) {
+	p := metadataPartitioner{keys: keys}
+	return consumer.NewTraces(func(ctx context.Context, td ptrace.Traces) error {
+		batches, metadata := p.partitionTraces(td)
+		var errs error
+		for i, batch := range batches {
+			errs = multierr.Append(errs, next.ConsumeTraces(contextWithMetadata(ctx, metadata[i]), batch))
+		}
+		return errs
+	})
+}
+
+func newMetadataLogs(next consumer.Logs, keys []string) (consumer.Logs, error) {
+	p := metadataPartitioner{keys: keys}
+	return consumer.NewLogs(func(ctx context.Context, ld plog.Logs) error {
+		batches, metadata := p.partitionLogs(ld)
+		var errs error
+		for i, batch := range batches {
+			errs = multierr.Append(errs, next.ConsumeLogs(contextWithMetadata(ctx, metadata[i]), batch))
+		}
+		return errs
+	})
+}
+
+func newMetadataMetrics(next consumer.Metrics, keys []string) (consumer.Metrics, error) {
+	p := metadataPartitioner{keys: keys}
+	return consumer.NewMetrics(func(ctx context.Context, md pmetric.Metrics) error {
+		batches, metadata := p.partitionMetrics(md)
+		var errs error
+		for i, batch := range batches {
+			errs = multierr.Append(errs, next.ConsumeMetrics(contextWithMetadata(ctx, metadata[i]), batch))
+		}
+		return errs
+	})
+}
@@ -0,0 +1,130 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupbyattrsprocessor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+type authData map[string]interface{}
+
+func (a authData) GetAttribute(name string) interface{} {
+	return a[name]
+}
+
+func (a authData) GetAttributeNames() []string {
+	return nil
+}
+
+func logsWithTenants(tenants ...string) plog.Logs {
+	ld := plog.NewLogs()
+	for _, tenant := range tenants {
+		lr := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+		lr.Attributes().PutStr("tenant", tenant)
+		lr.Body().SetStr(tenant)
+	}
+	return ld
+}
+
+func TestProcessorSetsMetadata(t *testing.T) {
+	var tenants [][]string
+	var bodies [][]string
+	var auths []client.AuthData
+	next, err := consumer.NewLogs(func(ctx context.Context, ld plog.Logs) error {
+		info := client.FromContext(ctx)
+		tenants = append(tenants, info.Metadata.Get("tenant"))
+		auths = append(auths, info.Auth)
+		var b []string
+		rls := ld.ResourceLogs()
+		for i := 0; i < rls.Len(); i++ {
+			lrs := rls.At(i).ScopeLogs().At(0).LogRecords()
+			for j := 0; j < lrs.Len(); j++ {
+				b = append(b, lrs.At(j).Body().Str())
+			}
+		}
+		bodies = append(bodies, b)
+		return nil
+	})
+	require.NoError(t, err)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.GroupByKeys = []string{"tenant"}
+	cfg.MetadataKeys = []string{"tenant"}
+	processor, err := createLogsProcessor(context.Background(), componenttest.NewNopProcessorCreateSettings(), cfg, next)
+	require.NoError(t, err)
+
+	ld := logsWithTenants("acme", "globex", "acme")
+	// a log record without the attribute
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("none")
+
+	auth := authData{"subject": "collector"}
+	ctx := client.NewContext(context.Background(), client.Info{
+		Auth:     auth,
+		Metadata: client.NewMetadata(map[string][]string{"tenant": {"ignored"}}),
+	})
+	require.NoError(t, processor.ConsumeLogs(ctx, ld))
+
+	assert.Equal(t, [][]string{{"acme"}, {"globex"}, nil}, tenants)
+	assert.Equal(t, [][]string{{"acme", "acme"}, {"globex"}, {"none"}}, bodies)
+	for _, a := range auths {
+		assert.Equal(t, auth, a)
+	}
+}
+
+func TestPartitionTracesAndMetrics(t *testing.T) {
+	p := metadataPartitioner{keys: []string{"tenant", "region"}}
+
+	td := ptrace.NewTraces()
+	for _, tenant := range []string{"acme", "globex", "acme"} {
+		rs := td.ResourceSpans().AppendEmpty()
+		rs.Resource().Attributes().PutStr("tenant", tenant)
+		rs.Resource().Attributes().PutStr("region", "eu")
+		rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName(tenant)
+	}
+	traceBatches, traceMetadata := p.partitionTraces(td)
+	require.Len(t, traceBatches, 2)
+	assert.Equal(t, 2, traceBatches[0].SpanCount())
+	assert.Equal(t, 1, traceBatches[1].SpanCount())
+	assert.Equal(t, []map[string][]string{
+		{"tenant": {"acme"}, "region": {"eu"}},
+		{"tenant": {"globex"}, "region": {"eu"}},
+	}, traceMetadata)
+
+	md := pmetric.NewMetrics()
+	for _, tenant := range []string{"acme", ""} {
+		rm := md.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().PutStr("tenant", tenant)
+		rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty()
+	}
+	// an empty attribute is not the same as a missing one
+	md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty()
+	metricBatches, metricMetadata := p.partitionMetrics(md)
+	require.Len(t, metricBatches, 3)
+	assert.Equal(t, []map[string][]string{
+		{"tenant": {"acme"}},
+		{"tenant": {""}},
+		{},
+	}, metricMetadata)
+}
@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mirrorprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/mirrorprocessor"
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+)
+
+var (
+	errNoExporters        = errors.New("at least one exporter must be configured")
+	errInvalidPercentage  = errors.New("sampling_percentage must be between 0 and 100")
+	errEmptyExporterEntry = errors.New("exporters must not include empty entries")
+	errInvalidQueueSize   = errors.New("queue_size must be positive")
+)
+
+// Config defines configuration for the mirror processor.
+type Config struct {
+	config.ProcessorSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
+
+	// Exporters are the exporters the mirrored telemetry is sent to, e.g. `otlp/canary`.
+	// They have to be part of another pipeline of the same type, so that the collector
+	// builds them.
+	Exporters []string `mapstructure:"exporters"`
+
+	// SamplingPercentage is the percentage of the telemetry that is mirrored. Traces are
+	// sampled by trace ID, so that a trace is either mirrored completely or not at all.
+	SamplingPercentage float32 `mapstructure:"sampling_percentage"`
+
+	// HashSeed is the seed of the hash used to sample telemetry. Mirror processors with
+	// the same seed and percentage select the same telemetry.
+	HashSeed uint32 `mapstructure:"hash_seed"`
+
+	// QueueSize is the number of sampled batches waiting to be sent to the exporters.
+	// Batches are dropped when the queue is full, so that slow exporters do not slow
+	// down the pipeline of the processor.
+	QueueSize int `mapstructure:"queue_size"`
+}
+
+var _ component.ProcessorConfig = (*Config)(nil)
+
+// Validate checks if the processor configuration is valid
+func (cfg *Config) Validate() error {
+	if len(cfg.Exporters) == 0 {
+		return errNoExporters
+	}
+	for _, exporter := range cfg.Exporters {
+		if exporter == "" {
+			return errEmptyExporterEntry
+		}
+	}
+	if cfg.SamplingPercentage < 0 || cfg.SamplingPercentage > 100 {
+		return errInvalidPercentage
+	}
+	if cfg.QueueSize <= 0 {
+		return errInvalidQueueSize
+	}
+	return nil
+}
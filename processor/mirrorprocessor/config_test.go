@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mirrorprocessor
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id       component.ID
+		expected component.ProcessorConfig
+		err      error
+	}{
+		{
+			id: component.NewID(typeStr),
+			expected: &Config{
+				ProcessorSettings:  config.NewProcessorSettings(component.NewID(typeStr)),
+				Exporters:          []string{"otlp/canary"},
+				SamplingPercentage: 10,
+				QueueSize:          1000,
+			},
+		},
+		{
+			id: component.NewIDWithName(typeStr, "custom"),
+			expected: &Config{
+				ProcessorSettings:  config.NewProcessorSettings(component.NewID(typeStr)),
+				Exporters:          []string{"otlp/canary", "logging"},
+				SamplingPercentage: 2.5,
+				HashSeed:           22,
+				QueueSize:          50,
+			},
+		},
+		{
+			id:  component.NewIDWithName(typeStr, "no_exporters"),
+			err: errNoExporters,
+		},
+		{
+			id:  component.NewIDWithName(typeStr, "empty_exporter"),
+			err: errEmptyExporterEntry,
+		},
+		{
+			id:  component.NewIDWithName(typeStr, "invalid_percentage"),
+			err: errInvalidPercentage,
+		},
+		{
+			id:  component.NewIDWithName(typeStr, "invalid_queue_size"),
+			err: errInvalidQueueSize,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalProcessorConfig(sub, cfg))
+
+			if tt.expected == nil {
+				assert.ErrorIs(t, cfg.Validate(), tt.err)
+				return
+			}
+			assert.NoError(t, cfg.Validate())
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mirrorprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/mirrorprocessor"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+)
+
+const (
+	// The value of "type" key in configuration.
+	typeStr = "mirror"
+	// The stability level of the processor.
+	stability = component.StabilityLevelInDevelopment
+)
+
+var processorCapabilities = consumer.Capabilities{MutatesData: false}
+
+// NewFactory returns a new factory for the mirror processor.
+func NewFactory() component.ProcessorFactory {
+	return component.NewProcessorFactory(
+		typeStr,
+		createDefaultConfig,
+		component.WithTracesProcessor(createTracesProcessor, stability),
+		component.WithMetricsProcessor(createMetricsProcessor, stability),
+		component.WithLogsProcessor(createLogsProcessor, stability))
+}
+
+// Note: This isn't a valid configuration because the processor has no exporters to mirror to.
+func createDefaultConfig() component.ProcessorConfig {
+	return &Config{
+		ProcessorSettings:  config.NewProcessorSettings(component.NewID(typeStr)),
+		SamplingPercentage: 10,
+		QueueSize:          1000,
+	}
+}
+
+func createTracesProcessor(
+	ctx context.Context,
+	set component.ProcessorCreateSettings,
+	cfg component.ProcessorConfig,
+	nextConsumer consumer.Traces) (component.TracesProcessor, error) {
+	proc := &tracesProcessor{newMirror[component.TracesExporter](set.Logger, cfg.(*Config), component.DataTypeTraces)}
+	return processorhelper.NewTracesProcessor(
+		ctx,
+		set,
+		cfg,
+		nextConsumer,
+		proc.processTraces,
+		processorhelper.WithStart(proc.start),
+		processorhelper.WithShutdown(proc.shutdown),
+		processorhelper.WithCapabilities(processorCapabilities))
+}
+
+func createMetricsProcessor(
+	ctx context.Context,
+	set component.ProcessorCreateSettings,
+	cfg component.ProcessorConfig,
+	nextConsumer consumer.Metrics) (component.MetricsProcessor, error) {
+	proc := &metricsProcessor{newMirror[component.MetricsExporter](set.Logger, cfg.(*Config), component.DataTypeMetrics)}
+	return processorhelper.NewMetricsProcessor(
+		ctx,
+		set,
+		cfg,
+		nextConsumer,
+		proc.processMetrics,
+		processorhelper.WithStart(proc.start),
+		processorhelper.WithShutdown(proc.shutdown),
+		processorhelper.WithCapabilities(processorCapabilities))
+}
+
+func createLogsProcessor(
+	ctx context.Context,
+	set component.ProcessorCreateSettings,
+	cfg component.ProcessorConfig,
+	nextConsumer consumer.Logs) (component.LogsProcessor, error) {
+	proc := &logsProcessor{newMirror[component.LogsExporter](set.Logger, cfg.(*Config), component.DataTypeLogs)}
+	return processorhelper.NewLogsProcessor(
+		ctx,
+		set,
+		cfg,
+		nextConsumer,
+		proc.processLogs,
+		processorhelper.WithStart(proc.start),
+		processorhelper.WithShutdown(proc.shutdown),
+		processorhelper.WithCapabilities(processorCapabilities))
+}
@@ -0,0 +1,337 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mirrorprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/mirrorprocessor"
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+// mirror holds the exporters the telemetry is mirrored to. mirror can be instantiated
+// with component.TracesExporter, component.MetricsExporter, and component.LogsExporter
+// type arguments.
+//
+// The sampled copies are queued and sent by a separate goroutine, so that slow or
+// blocking exporters do not add latency to the primary pipeline. Copies are dropped
+// when the queue is full.
+type mirror[E component.Exporter] struct {
+	logger   *zap.Logger
+	config   *Config
+	dataType component.DataType
+	sampler  sampler
+
+	exporters []E
+	queue     chan queued[E]
+	stop      chan struct{}
+	done      chan struct{}
+}
+
+// queued is a sampled copy of telemetry waiting to be sent to the exporters.
+type queued[E component.Exporter] struct {
+	ctx     context.Context
+	count   int
+	consume func(context.Context, E) error
+}
+
+func newMirror[E component.Exporter](logger *zap.Logger, cfg *Config, dataType component.DataType) mirror[E] {
+	return mirror[E]{
+		logger:   logger,
+		config:   cfg,
+		dataType: dataType,
+		sampler:  newSampler(cfg.SamplingPercentage, cfg.HashSeed),
+		queue:    make(chan queued[E], cfg.QueueSize),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// start looks up the configured exporters among the exporters the collector built for
+// the processor's data type, and starts sending the queued copies to them.
+func (p *mirror[E]) start(_ context.Context, host component.Host) error {
+	available := host.GetExporters()[p.dataType]
+	exporters := make([]E, 0, len(p.config.Exporters))
+	for _, name := range p.config.Exporters {
+		id := component.ID{}
+		if err := id.UnmarshalText([]byte(name)); err != nil {
+			return err
+		}
+		exp, ok := available[id]
+		if !ok {
+			return fmt.Errorf("failed to find %s exporter %q: it has to be part of a %s pipeline", p.dataType, name, p.dataType)
+		}
+		e, ok := exp.(E)
+		if !ok {
+			return fmt.Errorf("the exporter %q isn't a %T exporter", name, new(E))
+		}
+		exporters = append(exporters, e)
+	}
+	p.exporters = exporters
+	go p.run()
+	return nil
+}
+
+// shutdown stops sending copies once the queued ones have been sent, or the context is done.
+func (p *mirror[E]) shutdown(ctx context.Context) error {
+	if len(p.exporters) == 0 {
+		// not started
+		return nil
+	}
+	close(p.stop)
+	select {
+	case <-p.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// run sends the queued copies until the mirror is shut down, and then sends the
+// copies remaining in the queue.
+func (p *mirror[E]) run() {
+	defer close(p.done)
+	for {
+		select {
+		case q := <-p.queue:
+			p.send(q)
+		case <-p.stop:
+			for {
+				select {
+				case q := <-p.queue:
+					p.send(q)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// enqueue queues the sampled copy for the exporters, dropping it if the queue is full.
+// The copy is sent with the client info of the context, but not with its deadline or
+// cancellation, which belong to the primary pipeline.
+func (p *mirror[E]) enqueue(ctx context.Context, count int, consume func(context.Context, E) error) {
+	if count == 0 {
+		return
+	}
+	q := queued[E]{
+		ctx:     client.NewContext(context.Background(), client.FromContext(ctx)),
+		count:   count,
+		consume: consume,
+	}
+	select {
+	case p.queue <- q:
+	default:
+		p.logger.Warn("Dropped mirrored telemetry, the queue is full", zap.Int("count", count))
+	}
+}
+
+// send sends the queued copy to every exporter, logging the failures.
+func (p *mirror[E]) send(q queued[E]) {
+	var errs error
+	for _, e := range p.exporters {
+		errs = multierr.Append(errs, q.consume(q.ctx, e))
+	}
+	if errs != nil {
+		p.logger.Warn("Failed to mirror telemetry", zap.Int("count", q.count), zap.Error(errs))
+	}
+}
+
+// tracesProcessor sends a sampled copy of the spans passing through it to the
+// configured exporters. The spans themselves are passed on unchanged, and failures to
+// mirror them are logged rather than returned, so that the primary pipeline is not
+// affected.
+type tracesProcessor struct {
+	mirror[component.TracesExporter]
+}
+
+func (p *tracesProcessor) processTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	mirrored := sampleTraces(p.sampler, td)
+	p.enqueue(ctx, mirrored.SpanCount(), func(ctx context.Context, e component.TracesExporter) error {
+		return e.ConsumeTraces(ctx, mirrored)
+	})
+	return td, nil
+}
+
+// metricsProcessor mirrors metrics like tracesProcessor mirrors spans.
+type metricsProcessor struct {
+	mirror[component.MetricsExporter]
+}
+
+func (p *metricsProcessor) processMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	mirrored := sampleMetrics(p.sampler, md)
+	p.enqueue(ctx, mirrored.MetricCount(), func(ctx context.Context, e component.MetricsExporter) error {
+		return e.ConsumeMetrics(ctx, mirrored)
+	})
+	return md, nil
+}
+
+// logsProcessor mirrors log records like tracesProcessor mirrors spans.
+type logsProcessor struct {
+	mirror[component.LogsExporter]
+}
+
+func (p *logsProcessor) processLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
+	mirrored := sampleLogs(p.sampler, ld)
+	p.enqueue(ctx, mirrored.LogRecordCount(), func(ctx context.Context, e component.LogsExporter) error {
+		return e.ConsumeLogs(ctx, mirrored)
+	})
+	return ld, nil
+}
+
+// sampleTraces copies the spans of the sampled trace IDs.
+func sampleTraces(s sampler, td ptrace.Traces) ptrace.Traces {
+	mirrored := ptrace.NewTraces()
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		var destRS ptrace.ResourceSpans
+		sss := rs.ScopeSpans()
+		for j := 0; j < sss.Len(); j++ {
+			ss := sss.At(j)
+			var destSS ptrace.ScopeSpans
+			spans := ss.Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				traceID := span.TraceID()
+				if !s.sampleKey(traceID[:]) {
+					continue
+				}
+				if destRS == (ptrace.ResourceSpans{}) {
+					destRS = mirrored.ResourceSpans().AppendEmpty()
+					rs.Resource().CopyTo(destRS.Resource())
+					destRS.SetSchemaUrl(rs.SchemaUrl())
+				}
+				if destSS == (ptrace.ScopeSpans{}) {
+					destSS = destRS.ScopeSpans().AppendEmpty()
+					ss.Scope().CopyTo(destSS.Scope())
+					destSS.SetSchemaUrl(ss.SchemaUrl())
+				}
+				span.CopyTo(destSS.Spans().AppendEmpty())
+			}
+		}
+	}
+	return mirrored
+}
+
+// sampleMetrics copies the sampled metrics. Metrics are sampled by their name and the
+// attributes of their resource, so that a time series is either mirrored completely
+// or not at all.
+func sampleMetrics(s sampler, md pmetric.Metrics) pmetric.Metrics {
+	mirrored := pmetric.NewMetrics()
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		resourceKey := attributesKey(rm.Resource().Attributes())
+		var destRM pmetric.ResourceMetrics
+		sms := rm.ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			sm := sms.At(j)
+			var destSM pmetric.ScopeMetrics
+			metrics := sm.Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				metric := metrics.At(k)
+				key := append(resourceKey[:len(resourceKey):len(resourceKey)], metric.Name()...)
+				if !s.sampleKey(key) {
+					continue
+				}
+				if destRM == (pmetric.ResourceMetrics{}) {
+					destRM = mirrored.ResourceMetrics().AppendEmpty()
+					rm.Resource().CopyTo(destRM.Resource())
+					destRM.SetSchemaUrl(rm.SchemaUrl())
+				}
+				if destSM == (pmetric.ScopeMetrics{}) {
+					destSM = destRM.ScopeMetrics().AppendEmpty()
+					sm.Scope().CopyTo(destSM.Scope())
+					destSM.SetSchemaUrl(sm.SchemaUrl())
+				}
+				metric.CopyTo(destSM.Metrics().AppendEmpty())
+			}
+		}
+	}
+	return mirrored
+}
+
+// sampleLogs copies the sampled log records. Records with a trace ID are sampled by
+// it, like spans, the others are sampled randomly.
+func sampleLogs(s sampler, ld plog.Logs) plog.Logs {
+	mirrored := plog.NewLogs()
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		var destRL plog.ResourceLogs
+		sls := rl.ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			sl := sls.At(j)
+			var destSL plog.ScopeLogs
+			records := sl.LogRecords()
+			for k := 0; k < records.Len(); k++ {
+				record := records.At(k)
+				if !sampleLogRecord(s, record) {
+					continue
+				}
+				if destRL == (plog.ResourceLogs{}) {
+					destRL = mirrored.ResourceLogs().AppendEmpty()
+					rl.Resource().CopyTo(destRL.Resource())
+					destRL.SetSchemaUrl(rl.SchemaUrl())
+				}
+				if destSL == (plog.ScopeLogs{}) {
+					destSL = destRL.ScopeLogs().AppendEmpty()
+					sl.Scope().CopyTo(destSL.Scope())
+					destSL.SetSchemaUrl(sl.SchemaUrl())
+				}
+				record.CopyTo(destSL.LogRecords().AppendEmpty())
+			}
+		}
+	}
+	return mirrored
+}
+
+func sampleLogRecord(s sampler, record plog.LogRecord) bool {
+	traceID := record.TraceID()
+	if traceID.IsEmpty() {
+		return s.sampleRandom()
+	}
+	return s.sampleKey(traceID[:])
+}
+
+// attributesKey encodes attributes into a key identifying them regardless of their order.
+func attributesKey(attrs pcommon.Map) []byte {
+	names := make([]string, 0, attrs.Len())
+	attrs.Range(func(k string, _ pcommon.Value) bool {
+		names = append(names, k)
+		return true
+	})
+	sort.Strings(names)
+
+	var key []byte
+	for _, name := range names {
+		v, _ := attrs.Get(name)
+		key = append(key, name...)
+		key = append(key, '=')
+		key = append(key, v.AsString()...)
+		key = append(key, 0)
+	}
+	return key
+}
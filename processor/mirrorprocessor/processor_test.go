@@ -0,0 +1,287 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mirrorprocessor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+var canaryID = component.NewIDWithName("otlp", "canary")
+
+type testHost struct {
+	component.Host
+	exporters map[component.DataType]map[component.ID]component.Exporter
+}
+
+func (h *testHost) GetExporters() map[component.DataType]map[component.ID]component.Exporter {
+	return h.exporters
+}
+
+func newTestHost(dataType component.DataType, exporter component.Exporter) component.Host {
+	return &testHost{
+		Host: componenttest.NewNopHost(),
+		exporters: map[component.DataType]map[component.ID]component.Exporter{
+			dataType: {canaryID: exporter},
+		},
+	}
+}
+
+type tracesExporter struct {
+	component.StartFunc
+	component.ShutdownFunc
+	consumertest.TracesSink
+}
+
+type metricsExporter struct {
+	component.StartFunc
+	component.ShutdownFunc
+	consumertest.MetricsSink
+}
+
+type logsExporter struct {
+	component.StartFunc
+	component.ShutdownFunc
+	consumertest.LogsSink
+}
+
+type failingTracesExporter struct {
+	component.StartFunc
+	component.ShutdownFunc
+	consumertest.Consumer
+}
+
+func newTestConfig(percentage float32) *Config {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Exporters = []string{canaryID.String()}
+	cfg.SamplingPercentage = percentage
+	return cfg
+}
+
+func newTestTraces(traceCount int) ptrace.Traces {
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "checkout")
+	spans := rs.ScopeSpans().AppendEmpty().Spans()
+	for i := 0; i < traceCount; i++ {
+		// two spans per trace
+		for j := 0; j < 2; j++ {
+			span := spans.AppendEmpty()
+			span.SetTraceID([16]byte{byte(i), byte(i >> 8), 1})
+			span.SetSpanID([8]byte{byte(i), byte(j), 1})
+		}
+	}
+	return td
+}
+
+func TestTracesMirror(t *testing.T) {
+	tests := []struct {
+		name       string
+		percentage float32
+		check      func(t *testing.T, mirrored int)
+	}{
+		{name: "all", percentage: 100, check: func(t *testing.T, mirrored int) { assert.Equal(t, 2000, mirrored) }},
+		{name: "none", percentage: 0, check: func(t *testing.T, mirrored int) { assert.Equal(t, 0, mirrored) }},
+		{name: "some", percentage: 25, check: func(t *testing.T, mirrored int) { assert.InDelta(t, 500, mirrored, 100) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := new(consumertest.TracesSink)
+			exporter := new(tracesExporter)
+			tp, err := NewFactory().CreateTracesProcessor(context.Background(), componenttest.NewNopProcessorCreateSettings(), newTestConfig(tt.percentage), next)
+			require.NoError(t, err)
+			require.NoError(t, tp.Start(context.Background(), newTestHost(component.DataTypeTraces, exporter)))
+			assert.False(t, tp.Capabilities().MutatesData)
+
+			td := newTestTraces(1000)
+			require.NoError(t, tp.ConsumeTraces(context.Background(), td))
+
+			// the primary pipeline receives everything
+			require.Len(t, next.AllTraces(), 1)
+			assert.Equal(t, 2000, next.SpanCount())
+
+			// the queued copies are sent before the processor shuts down
+			require.NoError(t, tp.Shutdown(context.Background()))
+			tt.check(t, exporter.SpanCount())
+			for _, mirrored := range exporter.AllTraces() {
+				spans := mirrored.ResourceSpans().At(0).ScopeSpans().At(0).Spans()
+				// traces are mirrored completely
+				assert.Zero(t, spans.Len()%2)
+				for i := 0; i < spans.Len(); i += 2 {
+					assert.Equal(t, spans.At(i).TraceID(), spans.At(i+1).TraceID())
+				}
+				service, _ := mirrored.ResourceSpans().At(0).Resource().Attributes().Get("service.name")
+				assert.Equal(t, "checkout", service.Str())
+			}
+		})
+	}
+}
+
+func TestTracesMirrorFailureDoesNotAffectPipeline(t *testing.T) {
+	next := new(consumertest.TracesSink)
+	exporter := &failingTracesExporter{Consumer: consumertest.NewErr(errors.New("unavailable"))}
+	tp, err := NewFactory().CreateTracesProcessor(context.Background(), componenttest.NewNopProcessorCreateSettings(), newTestConfig(100), next)
+	require.NoError(t, err)
+	require.NoError(t, tp.Start(context.Background(), newTestHost(component.DataTypeTraces, exporter)))
+
+	require.NoError(t, tp.ConsumeTraces(context.Background(), newTestTraces(10)))
+	assert.Equal(t, 20, next.SpanCount())
+	require.NoError(t, tp.Shutdown(context.Background()))
+}
+
+type blockingTracesExporter struct {
+	component.StartFunc
+	component.ShutdownFunc
+	consumertest.TracesSink
+	unblock chan struct{}
+}
+
+func (e *blockingTracesExporter) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	<-e.unblock
+	return e.TracesSink.ConsumeTraces(ctx, td)
+}
+
+func TestTracesMirrorBlockingExporterDoesNotBlockPipeline(t *testing.T) {
+	next := new(consumertest.TracesSink)
+	exporter := &blockingTracesExporter{unblock: make(chan struct{})}
+	cfg := newTestConfig(100)
+	cfg.QueueSize = 1
+	tp, err := NewFactory().CreateTracesProcessor(context.Background(), componenttest.NewNopProcessorCreateSettings(), cfg, next)
+	require.NoError(t, err)
+	require.NoError(t, tp.Start(context.Background(), newTestHost(component.DataTypeTraces, exporter)))
+
+	// one copy is blocked in the exporter, one is queued, and the others are dropped
+	for i := 0; i < 5; i++ {
+		require.NoError(t, tp.ConsumeTraces(context.Background(), newTestTraces(1)))
+	}
+	assert.Equal(t, 10, next.SpanCount())
+
+	close(exporter.unblock)
+	require.NoError(t, tp.Shutdown(context.Background()))
+	assert.LessOrEqual(t, len(exporter.AllTraces()), 2)
+	assert.NotEmpty(t, exporter.AllTraces())
+}
+
+func TestShutdownTimeout(t *testing.T) {
+	exporter := &blockingTracesExporter{unblock: make(chan struct{})}
+	defer close(exporter.unblock)
+	tp, err := NewFactory().CreateTracesProcessor(context.Background(), componenttest.NewNopProcessorCreateSettings(), newTestConfig(100), consumertest.NewNop())
+	require.NoError(t, err)
+	require.NoError(t, tp.Start(context.Background(), newTestHost(component.DataTypeTraces, exporter)))
+	require.NoError(t, tp.ConsumeTraces(context.Background(), newTestTraces(1)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, tp.Shutdown(ctx), context.Canceled)
+}
+
+func TestMetricsMirror(t *testing.T) {
+	newMetrics := func() pmetric.Metrics {
+		md := pmetric.NewMetrics()
+		rm := md.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().PutStr("host.name", "node-1")
+		metrics := rm.ScopeMetrics().AppendEmpty().Metrics()
+		for i := 0; i < 1000; i++ {
+			metric := metrics.AppendEmpty()
+			metric.SetName("metric_" + string(rune('a'+i%26)) + string(rune('a'+i/26)))
+			metric.SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(int64(i))
+		}
+		return md
+	}
+
+	next := new(consumertest.MetricsSink)
+	exporter := new(metricsExporter)
+	mp, err := NewFactory().CreateMetricsProcessor(context.Background(), componenttest.NewNopProcessorCreateSettings(), newTestConfig(50), next)
+	require.NoError(t, err)
+	require.NoError(t, mp.Start(context.Background(), newTestHost(component.DataTypeMetrics, exporter)))
+
+	require.NoError(t, mp.ConsumeMetrics(context.Background(), newMetrics()))
+	require.NoError(t, mp.ConsumeMetrics(context.Background(), newMetrics()))
+	require.NoError(t, mp.Shutdown(context.Background()))
+
+	assert.Equal(t, 2000, next.DataPointCount())
+	require.Len(t, exporter.AllMetrics(), 2)
+	assert.InDelta(t, 500, exporter.AllMetrics()[0].MetricCount(), 100)
+	// the same time series are mirrored from every batch
+	assert.Equal(t, exporter.AllMetrics()[0], exporter.AllMetrics()[1])
+}
+
+func TestLogsMirror(t *testing.T) {
+	ld := plog.NewLogs()
+	records := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	for i := 0; i < 1000; i++ {
+		record := records.AppendEmpty()
+		record.SetTraceID([16]byte{byte(i), byte(i >> 8), 1})
+		record.Body().SetStr("test")
+	}
+
+	next := new(consumertest.LogsSink)
+	exporter := new(logsExporter)
+	cfg := newTestConfig(25)
+	lp, err := NewFactory().CreateLogsProcessor(context.Background(), componenttest.NewNopProcessorCreateSettings(), cfg, next)
+	require.NoError(t, err)
+	require.NoError(t, lp.Start(context.Background(), newTestHost(component.DataTypeLogs, exporter)))
+	require.NoError(t, lp.ConsumeLogs(context.Background(), ld))
+	require.NoError(t, lp.Shutdown(context.Background()))
+
+	assert.Equal(t, 1000, next.LogRecordCount())
+
+	// log records are mirrored along with the spans of their trace
+	mirroredTraces := sampleTraces(newSampler(cfg.SamplingPercentage, cfg.HashSeed), newTestTraces(1000))
+	assert.Equal(t, mirroredTraces.SpanCount()/2, exporter.LogRecordCount())
+}
+
+func TestStartErrors(t *testing.T) {
+	tp, err := NewFactory().CreateTracesProcessor(context.Background(), componenttest.NewNopProcessorCreateSettings(), newTestConfig(10), consumertest.NewNop())
+	require.NoError(t, err)
+
+	err = tp.Start(context.Background(), componenttest.NewNopHost())
+	assert.EqualError(t, err, `failed to find traces exporter "otlp/canary": it has to be part of a traces pipeline`)
+
+	err = tp.Start(context.Background(), newTestHost(component.DataTypeTraces, new(metricsExporter)))
+	assert.ErrorContains(t, err, `the exporter "otlp/canary" isn't a`)
+
+	// the processor can be shut down although it failed to start
+	assert.NoError(t, tp.Shutdown(context.Background()))
+}
+
+func TestAttributesKey(t *testing.T) {
+	a := pcommon.NewMap()
+	a.PutStr("b", "2")
+	a.PutStr("a", "1")
+	b := pcommon.NewMap()
+	b.PutStr("a", "1")
+	b.PutStr("b", "2")
+
+	assert.Equal(t, attributesKey(a), attributesKey(b))
+	// the attributes are not reordered
+	keys := []string{}
+	a.Range(func(k string, _ pcommon.Value) bool {
+		keys = append(keys, k)
+		return true
+	})
+	assert.Equal(t, []string{"b", "a"}, keys)
+}
@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mirrorprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/mirrorprocessor"
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"math"
+	"math/rand"
+)
+
+// sampler decides whether telemetry is mirrored from the hash of a key identifying it.
+type sampler struct {
+	seed [4]byte
+	// threshold is the hash value below which telemetry is mirrored.
+	threshold uint64
+}
+
+func newSampler(percentage float32, seed uint32) sampler {
+	s := sampler{threshold: uint64(float64(percentage) / 100 * math.MaxUint32)}
+	if percentage >= 100 {
+		// make sure every hash is below the threshold
+		s.threshold = math.MaxUint32 + 1
+	}
+	binary.LittleEndian.PutUint32(s.seed[:], seed)
+	return s
+}
+
+// sampleKey returns whether the telemetry identified by key is mirrored.
+func (s sampler) sampleKey(key []byte) bool {
+	h := fnv.New32a()
+	_, _ = h.Write(s.seed[:])
+	_, _ = h.Write(key)
+	return uint64(mix(h.Sum32())) < s.threshold
+}
+
+// mix is the finalizer of MurmurHash3. FNV hashes of keys that differ in the last bytes
+// only, e.g. metric names, are poorly distributed without it.
+func mix(h uint32) uint32 {
+	h ^= h >> 16
+	h *= 0x85ebca6b
+	h ^= h >> 13
+	h *= 0xc2b2ae35
+	h ^= h >> 16
+	return h
+}
+
+// sampleRandom returns whether telemetry without an identifying key is mirrored.
+func (s sampler) sampleRandom() bool {
+	return uint64(rand.Uint32()) < s.threshold // #nosec G404 -- sampling does not need a secure random source
+}
@@ -24,6 +24,7 @@ import (
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllogs"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/routingprocessor/internal/common"
 )
@@ -48,7 +49,7 @@ func newLogProcessor(settings component.TelemetrySettings, config component.Proc
 			cfg.Table,
 			cfg.DefaultExporters,
 			settings,
-			ottllogs.NewParser(common.Functions[ottllogs.TransformContext](), settings),
+			ottllogs.NewParser(common.Functions[ottllogs.TransformContext](), settings, ottl.WithStatementCache[ottllogs.TransformContext]()),
 		),
 		extractor: newExtractor(cfg.FromAttribute, settings.Logger),
 	}
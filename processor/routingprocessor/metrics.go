@@ -24,6 +24,7 @@ import (
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/routingprocessor/internal/common"
 )
@@ -48,7 +49,7 @@ func newMetricProcessor(settings component.TelemetrySettings, config component.P
 			cfg.Table,
 			cfg.DefaultExporters,
 			settings,
-			ottldatapoint.NewParser(common.Functions[ottldatapoint.TransformContext](), settings),
+			ottldatapoint.NewParser(common.Functions[ottldatapoint.TransformContext](), settings, ottl.WithStatementCache[ottldatapoint.TransformContext]()),
 		),
 		extractor: newExtractor(cfg.FromAttribute, settings.Logger),
 	}
@@ -24,6 +24,7 @@ import (
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/routingprocessor/internal/common"
 )
@@ -48,7 +49,7 @@ func newTracesProcessor(settings component.TelemetrySettings, config component.P
 			cfg.Table,
 			cfg.DefaultExporters,
 			settings,
-			ottlspan.NewParser(common.Functions[ottlspan.TransformContext](), settings),
+			ottlspan.NewParser(common.Functions[ottlspan.TransformContext](), settings, ottl.WithStatementCache[ottlspan.TransformContext]()),
 		),
 		extractor: newExtractor(cfg.FromAttribute, settings.Logger),
 	}
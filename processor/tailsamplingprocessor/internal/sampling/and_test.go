@@ -24,7 +24,8 @@ import (
 )
 
 func TestAndEvaluatorNotSampled(t *testing.T) {
-	n1 := NewStringAttributeFilter(zap.NewNop(), "name", []string{"value"}, false, 0, false)
+	n1, err := NewStringAttributeFilter(zap.NewNop(), "name", []string{"value"}, false, 0, false)
+	require.NoError(t, err)
 	n2, err := NewStatusCodeFilter(zap.NewNop(), []string{"ERROR"})
 	if err != nil {
 		t.FailNow()
@@ -51,7 +52,8 @@ func TestAndEvaluatorNotSampled(t *testing.T) {
 }
 
 func TestAndEvaluatorSampled(t *testing.T) {
-	n1 := NewStringAttributeFilter(zap.NewNop(), "attribute_name", []string{"attribute_value"}, false, 0, false)
+	n1, err := NewStringAttributeFilter(zap.NewNop(), "attribute_name", []string{"attribute_value"}, false, 0, false)
+	require.NoError(t, err)
 	n2, err := NewStatusCodeFilter(zap.NewNop(), []string{"ERROR"})
 	if err != nil {
 		t.FailNow()
@@ -79,7 +81,8 @@ func TestAndEvaluatorSampled(t *testing.T) {
 }
 
 func TestAndEvaluatorStringInvertSampled(t *testing.T) {
-	n1 := NewStringAttributeFilter(zap.NewNop(), "attribute_name", []string{"no_match"}, false, 0, true)
+	n1, err := NewStringAttributeFilter(zap.NewNop(), "attribute_name", []string{"no_match"}, false, 0, true)
+	require.NoError(t, err)
 	n2, err := NewStatusCodeFilter(zap.NewNop(), []string{"ERROR"})
 	if err != nil {
 		t.FailNow()
@@ -107,7 +110,8 @@ func TestAndEvaluatorStringInvertSampled(t *testing.T) {
 }
 
 func TestAndEvaluatorStringInvertNotSampled(t *testing.T) {
-	n1 := NewStringAttributeFilter(zap.NewNop(), "attribute_name", []string{"attribute_value"}, false, 0, true)
+	n1, err := NewStringAttributeFilter(zap.NewNop(), "attribute_name", []string{"attribute_value"}, false, 0, true)
+	require.NoError(t, err)
 	n2, err := NewStatusCodeFilter(zap.NewNop(), []string{"ERROR"})
 	if err != nil {
 		t.FailNow()
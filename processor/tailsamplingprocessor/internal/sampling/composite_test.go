@@ -154,8 +154,10 @@ func TestCompositeEvaluatorSampled_AlwaysSampled(t *testing.T) {
 func TestCompositeEvaluatorInverseSampled_AlwaysSampled(t *testing.T) {
 
 	// The first policy does not match, the second matches through invert
-	n1 := NewStringAttributeFilter(zap.NewNop(), "tag", []string{"foo"}, false, 0, false)
-	n2 := NewStringAttributeFilter(zap.NewNop(), "tag", []string{"foo"}, false, 0, true)
+	n1, err := NewStringAttributeFilter(zap.NewNop(), "tag", []string{"foo"}, false, 0, false)
+	require.NoError(t, err)
+	n2, err := NewStringAttributeFilter(zap.NewNop(), "tag", []string{"foo"}, false, 0, true)
+	require.NoError(t, err)
 	c := NewComposite(zap.NewNop(), 10, []SubPolicyEvalParams{{n1, 20}, {n2, 20}}, FakeTimeProvider{})
 
 	for i := 1; i <= 10; i++ {
@@ -15,6 +15,7 @@
 package sampling // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
 
 import (
+	"fmt"
 	"regexp"
 
 	"github.com/golang/groupcache/lru"
@@ -43,13 +44,16 @@ var _ PolicyEvaluator = (*stringAttributeFilter)(nil)
 
 // NewStringAttributeFilter creates a policy evaluator that samples all traces with
 // the given attribute in the given numeric range.
-func NewStringAttributeFilter(logger *zap.Logger, key string, values []string, regexMatchEnabled bool, evictSize int, invertMatch bool) PolicyEvaluator {
+func NewStringAttributeFilter(logger *zap.Logger, key string, values []string, regexMatchEnabled bool, evictSize int, invertMatch bool) (PolicyEvaluator, error) {
 	// initialize regex filter rules and LRU cache for matched results
 	if regexMatchEnabled {
 		if evictSize <= 0 {
 			evictSize = defaultCacheSize
 		}
-		filterList := addFilters(values)
+		filterList, err := addFilters(values)
+		if err != nil {
+			return nil, err
+		}
 		regexStrSetting := &regexStrSetting{
 			matchedAttrs: lru.New(evictSize),
 			filterList:   filterList,
@@ -75,7 +79,7 @@ func NewStringAttributeFilter(logger *zap.Logger, key string, values []string, r
 				return false
 			},
 			invertMatch: invertMatch,
-		}
+		}, nil
 	}
 
 	// initialize the exact value map
@@ -94,7 +98,7 @@ func NewStringAttributeFilter(logger *zap.Logger, key string, values []string, r
 			return matched
 		},
 		invertMatch: invertMatch,
-	}
+	}, nil
 }
 
 // Evaluate looks at the trace data and returns a corresponding SamplingDecision.
@@ -157,12 +161,16 @@ func (saf *stringAttributeFilter) Evaluate(_ pcommon.TraceID, trace *TraceData)
 }
 
 // addFilters compiles all the given filters and stores them as regexes.
-// All regexes are automatically anchored to enforce full string matches.
-func addFilters(exprs []string) []*regexp.Regexp {
+// The regexes are not anchored, so they match any part of the attribute value
+// unless they explicitly use ^ and $.
+func addFilters(exprs []string) ([]*regexp.Regexp, error) {
 	list := make([]*regexp.Regexp, 0, len(exprs))
 	for _, entry := range exprs {
-		rule := regexp.MustCompile(entry)
+		rule, err := regexp.Compile(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression %q: %w", entry, err)
+		}
 		list = append(list, rule)
 	}
-	return list
+	return list, nil
 }
@@ -27,8 +27,18 @@ func Functions[K any]() map[string]interface{} {
 		"IsMatch":              ottlfuncs.IsMatch[K],
 		"Concat":               ottlfuncs.Concat[K],
 		"Split":                ottlfuncs.Split[K],
+		"Join":                 ottlfuncs.Join[K],
+		"Substring":            ottlfuncs.Substring[K],
+		"Trim":                 ottlfuncs.Trim[K],
+		"PadLeft":              ottlfuncs.PadLeft[K],
 		"Int":                  ottlfuncs.Int[K],
 		"ConvertCase":          ottlfuncs.ConvertCase[K],
+		"Decode":               ottlfuncs.Decode[K],
+		"UUID":                 ottlfuncs.UUID[K],
+		"RandomTraceID":        ottlfuncs.RandomTraceID[K],
+		"RandomSpanID":         ottlfuncs.RandomSpanID[K],
+		"Random":               ottlfuncs.Random[K],
+		"RandomInt":            ottlfuncs.RandomInt[K],
 		"keep_keys":            ottlfuncs.KeepKeys[K],
 		"set":                  ottlfuncs.Set[K],
 		"truncate_all":         ottlfuncs.TruncateAll[K],
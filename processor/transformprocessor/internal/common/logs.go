@@ -68,10 +68,10 @@ func NewLogParserCollection(functions map[string]interface{}, settings component
 	lpc := &LogParserCollection{
 		parserCollection: parserCollection{
 			settings:       settings,
-			resourceParser: ottlresource.NewParser(ResourceFunctions(), settings),
-			scopeParser:    ottlscope.NewParser(ScopeFunctions(), settings),
+			resourceParser: ottlresource.NewParser(ResourceFunctions(), settings, ottl.WithStatementCache[ottlresource.TransformContext]()),
+			scopeParser:    ottlscope.NewParser(ScopeFunctions(), settings, ottl.WithStatementCache[ottlscope.TransformContext]()),
 		},
-		logParser: ottllogs.NewParser(functions, settings),
+		logParser: ottllogs.NewParser(functions, settings, ottl.WithStatementCache[ottllogs.TransformContext]()),
 	}
 
 	for _, op := range options {
@@ -165,11 +165,11 @@ func NewMetricParserCollection(functions map[string]interface{}, settings compon
 	mpc := &MetricParserCollection{
 		parserCollection: parserCollection{
 			settings:       settings,
-			resourceParser: ottlresource.NewParser(ResourceFunctions(), settings),
-			scopeParser:    ottlscope.NewParser(ScopeFunctions(), settings),
+			resourceParser: ottlresource.NewParser(ResourceFunctions(), settings, ottl.WithStatementCache[ottlresource.TransformContext]()),
+			scopeParser:    ottlscope.NewParser(ScopeFunctions(), settings, ottl.WithStatementCache[ottlscope.TransformContext]()),
 		},
-		metricParser:    ottlmetric.NewParser(functions, settings),
-		dataPointParser: ottldatapoint.NewParser(functions, settings),
+		metricParser:    ottlmetric.NewParser(functions, settings, ottl.WithStatementCache[ottlmetric.TransformContext]()),
+		dataPointParser: ottldatapoint.NewParser(functions, settings, ottl.WithStatementCache[ottldatapoint.TransformContext]()),
 	}
 
 	for _, op := range options {
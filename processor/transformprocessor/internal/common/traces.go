@@ -104,10 +104,10 @@ func NewTraceParserCollection(functions map[string]interface{}, settings compone
 	tpc := &TraceParserCollection{
 		parserCollection: parserCollection{
 			settings:       settings,
-			resourceParser: ottlresource.NewParser(ResourceFunctions(), settings),
-			scopeParser:    ottlscope.NewParser(ScopeFunctions(), settings),
+			resourceParser: ottlresource.NewParser(ResourceFunctions(), settings, ottl.WithStatementCache[ottlresource.TransformContext]()),
+			scopeParser:    ottlscope.NewParser(ScopeFunctions(), settings, ottl.WithStatementCache[ottlscope.TransformContext]()),
 		},
-		spanParser: ottlspan.NewParser(functions, settings),
+		spanParser: ottlspan.NewParser(functions, settings, ottl.WithStatementCache[ottlspan.TransformContext]()),
 	}
 
 	for _, op := range options {
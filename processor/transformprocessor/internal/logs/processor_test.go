@@ -134,6 +134,16 @@ func Test_ProcessLogs_LogContext(t *testing.T) {
 					"get")
 			},
 		},
+		{
+			statement: `set(attributes["http"]["method"], attributes["http.method"]) where body == "operationA"`,
+			want: func(td plog.Logs) {
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().PutEmptyMap("http").PutStr("method", "get")
+			},
+		},
+		{
+			statement: `set(attributes["test"], attributes["http"][attributes["field"]]) where body == "operationA"`,
+			want:      func(td plog.Logs) {},
+		},
 		{
 			statement: `set(severity_text, "ok") where attributes["http.path"] == "/health"`,
 			want: func(td plog.Logs) {
@@ -265,6 +275,18 @@ func Test_ProcessLogs_LogContext(t *testing.T) {
 			statement: `set(attributes["test"], Split(attributes["not_exist"], "|"))`,
 			want:      func(td plog.Logs) {},
 		},
+		{
+			statement: `set(attributes["test"], Decode(attributes["http.url"], "url")) where body == "operationA"`,
+			want: func(td plog.Logs) {
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().PutStr("test", "http://localhost/health")
+			},
+		},
+		{
+			statement: `set(attributes["test"], Decode("6f7065726174696f6e41", "hex")) where body == "operationA"`,
+			want: func(td plog.Logs) {
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().PutStr("test", "operationA")
+			},
+		},
 		{
 			statement: `set(attributes["test"], ConvertCase(body, "lower")) where body == "operationA"`,
 			want: func(td plog.Logs) {
@@ -425,6 +447,42 @@ func Test_ProcessLogs_MixContext(t *testing.T) {
 	}
 }
 
+func Test_ProcessLogs_BodyKeys(t *testing.T) {
+	constructBodyLogs := func() plog.Logs {
+		td := plog.NewLogs()
+		log := td.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+		items := log.Body().SetEmptyMap().PutEmptySlice("items")
+		items.AppendEmpty().SetStr("first")
+		items.AppendEmpty().SetStr("second")
+		log.Attributes().PutInt("index", 1)
+		return td
+	}
+
+	td := constructBodyLogs()
+	processor, err := NewProcessor(nil, []common.ContextStatements{{Context: "log", Statements: []string{
+		`set(attributes["item"], body["items"][attributes["index"]])`,
+		`set(body["items"][attributes["index"]], "updated")`,
+	}}}, componenttest.NewNopTelemetrySettings())
+	assert.NoError(t, err)
+
+	_, err = processor.ProcessLogs(context.Background(), td)
+	assert.NoError(t, err)
+
+	exTd := constructBodyLogs()
+	exLog := exTd.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	exLog.Attributes().PutStr("item", "second")
+	items, _ := exLog.Body().Map().Get("items")
+	items.Slice().At(1).SetStr("updated")
+	assert.Equal(t, exTd, td)
+}
+
+func Test_NewProcessor_InvalidKey(t *testing.T) {
+	_, err := NewProcessor(nil, []common.ContextStatements{{Context: "log", Statements: []string{
+		`set(severity_text["key"], "pass")`,
+	}}}, componenttest.NewNopTelemetrySettings())
+	assert.ErrorContains(t, err, `"severity_text" can't be indexed by a key`)
+}
+
 func constructLogs() plog.Logs {
 	td := plog.NewLogs()
 	rs0 := td.ResourceLogs().AppendEmpty()
@@ -28,7 +28,8 @@ type Config struct {
 	// endpoint, so TLSSettings must be used to enable that.
 	confighttp.HTTPServerSettings `mapstructure:",squash"`
 	// RecordType is the key used to determine which unmarshaler to use
-	// when receiving the requests.
+	// when receiving the requests. When empty, cwmetrics is used in
+	// metrics pipelines and cwlogs in logs pipelines.
 	RecordType string `mapstructure:"record_type"`
 	// AccessKey is checked against the one received with each request.
 	// This can be set when creating or updating the Firehose delivery
@@ -36,17 +37,14 @@ type Config struct {
 	AccessKey string `mapstructure:"access_key"`
 }
 
-// Validate checks that the endpoint and record type exist and
-// are valid.
+// Validate checks that the endpoint exists and that the record
+// type, if set, is valid.
 func (c *Config) Validate() error {
 	if c.Endpoint == "" {
 		return errors.New("must specify endpoint")
 	}
 	if c.RecordType == "" {
-		return errors.New("must specify record type")
+		return nil
 	}
-	if err := validateRecordType(c.RecordType); err != nil {
-		return err
-	}
-	return nil
+	return validateRecordType(c.RecordType)
 }
@@ -55,3 +55,18 @@ func TestLoadConfig(t *testing.T) {
 		},
 	}, cfg)
 }
+
+func TestValidate(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	assert.NoError(t, cfg.Validate())
+
+	cfg.RecordType = "cwlogs"
+	assert.NoError(t, cfg.Validate())
+
+	cfg.RecordType = "nop"
+	assert.ErrorIs(t, cfg.Validate(), errUnrecognizedRecordType)
+
+	cfg.RecordType = ""
+	cfg.Endpoint = ""
+	assert.Error(t, cfg.Validate())
+}
@@ -25,30 +25,34 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver/internal/unmarshaler"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver/internal/unmarshaler/cwlog"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver/internal/unmarshaler/cwmetricstream"
 )
 
 const (
-	typeStr           = "awsfirehose"
-	stability         = component.StabilityLevelAlpha
-	defaultRecordType = cwmetricstream.TypeStr
-	defaultEndpoint   = "0.0.0.0:4433"
+	typeStr                  = "awsfirehose"
+	stability                = component.StabilityLevelAlpha
+	defaultMetricsRecordType = cwmetricstream.TypeStr
+	defaultLogsRecordType    = cwlog.TypeStr
+	defaultEndpoint          = "0.0.0.0:4433"
 )
 
 var (
 	errUnrecognizedRecordType = errors.New("unrecognized record type")
 	availableRecordTypes      = map[string]bool{
 		cwmetricstream.TypeStr: true,
+		cwlog.TypeStr:          true,
 	}
 )
 
-// NewFactory creates a receiver factory for awsfirehose. Currently, only
-// available in metrics pipelines.
+// NewFactory creates a receiver factory for awsfirehose. Available in
+// metrics and logs pipelines.
 func NewFactory() component.ReceiverFactory {
 	return component.NewReceiverFactory(
 		typeStr,
 		createDefaultConfig,
-		component.WithMetricsReceiver(createMetricsReceiver, stability))
+		component.WithMetricsReceiver(createMetricsReceiver, stability),
+		component.WithLogsReceiver(createLogsReceiver, stability))
 }
 
 // validateRecordType checks the available record types for the
@@ -69,12 +73,21 @@ func defaultMetricsUnmarshalers(logger *zap.Logger) map[string]unmarshaler.Metri
 	}
 }
 
+// defaultLogsUnmarshalers creates a map of the available logs
+// unmarshalers.
+func defaultLogsUnmarshalers(logger *zap.Logger) map[string]unmarshaler.LogsUnmarshaler {
+	cwlu := cwlog.NewUnmarshaler(logger)
+	return map[string]unmarshaler.LogsUnmarshaler{
+		cwlu.Type(): cwlu,
+	}
+}
+
 // createDefaultConfig creates a default config with the endpoint set
-// to port 8443 and the record type set to the CloudWatch metric stream.
+// to port 4433. The record type is left empty, so the default record
+// type of each pipeline is used.
 func createDefaultConfig() component.ReceiverConfig {
 	return &Config{
 		ReceiverSettings: config.NewReceiverSettings(component.NewID(typeStr)),
-		RecordType:       defaultRecordType,
 		HTTPServerSettings: confighttp.HTTPServerSettings{
 			Endpoint: defaultEndpoint,
 		},
@@ -90,3 +103,13 @@ func createMetricsReceiver(
 ) (component.MetricsReceiver, error) {
 	return newMetricsReceiver(cfg.(*Config), set, defaultMetricsUnmarshalers(set.Logger), nextConsumer)
 }
+
+// createLogsReceiver implements the CreateLogsReceiver function type.
+func createLogsReceiver(
+	_ context.Context,
+	set component.ReceiverCreateSettings,
+	cfg component.ReceiverConfig,
+	nextConsumer consumer.Logs,
+) (component.LogsReceiver, error) {
+	return newLogsReceiver(cfg.(*Config), set, defaultLogsUnmarshalers(set.Logger), nextConsumer)
+}
@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cwlog // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver/internal/unmarshaler/cwlog"
+
+import (
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+)
+
+// resourceAttributes are the CloudWatch log attributes that define a unique resource.
+type resourceAttributes struct {
+	owner, logGroup, logStream string
+}
+
+// resourceLogsBuilder provides convenient access to a Resource's LogRecordSlice.
+type resourceLogsBuilder struct {
+	rls plog.LogRecordSlice
+}
+
+// setAttributes applies the resourceAttributes to the provided Resource.
+func (ra *resourceAttributes) setAttributes(resource pcommon.Resource) {
+	attrs := resource.Attributes()
+	attrs.PutStr(conventions.AttributeCloudProvider, conventions.AttributeCloudProviderAWS)
+	attrs.PutStr(conventions.AttributeCloudAccountID, ra.owner)
+	attrs.PutEmptySlice(conventions.AttributeAWSLogGroupNames).AppendEmpty().SetStr(ra.logGroup)
+	attrs.PutEmptySlice(conventions.AttributeAWSLogStreamNames).AppendEmpty().SetStr(ra.logStream)
+}
+
+// newResourceLogsBuilder to capture logs for the Resource defined by the provided attributes.
+func newResourceLogsBuilder(logs plog.Logs, attrs resourceAttributes) *resourceLogsBuilder {
+	rls := logs.ResourceLogs().AppendEmpty()
+	attrs.setAttributes(rls.Resource())
+	return &resourceLogsBuilder{rls: rls.ScopeLogs().AppendEmpty().LogRecords()}
+}
+
+// AddLog events to the LogRecordSlice. Resource attributes are captured when creating
+// the resourceLogsBuilder, so we only need to consider the LogEvents themselves.
+func (rlb *resourceLogsBuilder) AddLog(log cWLog) {
+	for _, event := range log.LogEvents {
+		logLine := rlb.rls.AppendEmpty()
+		logLine.SetTimestamp(pcommon.NewTimestampFromTime(time.UnixMilli(event.Timestamp)))
+		logLine.Body().SetStr(event.Message)
+	}
+}
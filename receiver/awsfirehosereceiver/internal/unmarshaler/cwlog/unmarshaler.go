@@ -0,0 +1,135 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cwlog // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver/internal/unmarshaler/cwlog"
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"io"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver/internal/unmarshaler"
+)
+
+const (
+	TypeStr = "cwlogs"
+
+	// dataMessageType is the type of the payloads holding log events. The other
+	// type, CONTROL_MESSAGE, is sent to check the destination can be reached.
+	dataMessageType = "DATA_MESSAGE"
+)
+
+var (
+	errInvalidRecords = errors.New("record format invalid")
+)
+
+// Unmarshaler for the CloudWatch Logs subscription filter format, which delivery
+// streams receive compressed with gzip.
+//
+// More details can be found at:
+// https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/SubscriptionFilters.html
+type Unmarshaler struct {
+	logger *zap.Logger
+}
+
+var _ unmarshaler.LogsUnmarshaler = (*Unmarshaler)(nil)
+
+// NewUnmarshaler creates a new instance of the Unmarshaler.
+func NewUnmarshaler(logger *zap.Logger) *Unmarshaler {
+	return &Unmarshaler{logger}
+}
+
+// Unmarshal deserializes the records into cWLogs and uses the
+// resourceLogsBuilder to group them into a single plog.Logs.
+// Skips invalid cWLogs received in the record and control messages.
+func (u Unmarshaler) Unmarshal(records [][]byte) (plog.Logs, error) {
+	ld := plog.NewLogs()
+	builders := make(map[resourceAttributes]*resourceLogsBuilder)
+	valid := false
+	for recordIndex, record := range records {
+		r, err := gzip.NewReader(bytes.NewReader(record))
+		if err != nil {
+			u.logger.Error(
+				"Unable to decompress input",
+				zap.Error(err),
+				zap.Int("record_index", recordIndex),
+			)
+			continue
+		}
+
+		// A record may hold several payloads one after the other.
+		decoder := json.NewDecoder(r)
+		for datumIndex := 0; ; datumIndex++ {
+			var log cWLog
+			err = decoder.Decode(&log)
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				u.logger.Error(
+					"Unable to unmarshal input",
+					zap.Error(err),
+					zap.Int("datum_index", datumIndex),
+					zap.Int("record_index", recordIndex),
+				)
+				break
+			}
+			if !u.isValid(log) {
+				u.logger.Error(
+					"Invalid log",
+					zap.Int("datum_index", datumIndex),
+					zap.Int("record_index", recordIndex),
+				)
+				continue
+			}
+			valid = true
+			if log.MessageType != dataMessageType {
+				continue
+			}
+			attrs := resourceAttributes{
+				owner:     log.Owner,
+				logGroup:  log.LogGroup,
+				logStream: log.LogStream,
+			}
+			lb, ok := builders[attrs]
+			if !ok {
+				lb = newResourceLogsBuilder(ld, attrs)
+				builders[attrs] = lb
+			}
+			lb.AddLog(log)
+		}
+		_ = r.Close()
+	}
+
+	if !valid {
+		return plog.NewLogs(), errInvalidRecords
+	}
+
+	return ld, nil
+}
+
+// isValid validates that the cWLog has been unmarshalled correctly.
+func (u Unmarshaler) isValid(log cWLog) bool {
+	return log.MessageType != "" && (log.MessageType != dataMessageType || (log.Owner != "" && log.LogGroup != "" && log.LogStream != ""))
+}
+
+// Type of the serialized messages.
+func (u Unmarshaler) Type() string {
+	return TypeStr
+}
@@ -0,0 +1,111 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cwlog
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+	"go.uber.org/zap"
+)
+
+func TestType(t *testing.T) {
+	unmarshaler := NewUnmarshaler(zap.NewNop())
+	require.Equal(t, TypeStr, unmarshaler.Type())
+}
+
+func TestUnmarshal(t *testing.T) {
+	unmarshaler := NewUnmarshaler(zap.NewNop())
+	testCases := map[string]struct {
+		filename          string
+		wantResourceCount int
+		wantLogCount      int
+		wantErr           error
+	}{
+		"WithMultipleRecords": {
+			filename:          "multiple_records",
+			wantResourceCount: 3,
+			wantLogCount:      8,
+		},
+		"WithSingleRecord": {
+			filename:          "single_record",
+			wantResourceCount: 1,
+			wantLogCount:      1,
+		},
+		"WithControlMessage": {
+			filename: "control_message",
+		},
+		"WithInvalidRecords": {
+			filename: "invalid_records",
+			wantErr:  errInvalidRecords,
+		},
+		"WithSomeInvalidRecords": {
+			filename:          "some_invalid_records",
+			wantResourceCount: 1,
+			wantLogCount:      2,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			record, err := os.ReadFile(filepath.Join(".", "testdata", testCase.filename))
+			require.NoError(t, err)
+
+			records := [][]byte{record}
+
+			got, err := unmarshaler.Unmarshal(records)
+			if testCase.wantErr != nil {
+				require.Error(t, err)
+				require.Equal(t, testCase.wantErr, err)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, got)
+				require.Equal(t, testCase.wantResourceCount, got.ResourceLogs().Len())
+				require.Equal(t, testCase.wantLogCount, got.LogRecordCount())
+			}
+		})
+	}
+}
+
+func TestUnmarshalUncompressed(t *testing.T) {
+	unmarshaler := NewUnmarshaler(zap.NewNop())
+	_, err := unmarshaler.Unmarshal([][]byte{[]byte(`{"messageType":"DATA_MESSAGE"}`)})
+	require.Equal(t, errInvalidRecords, err)
+}
+
+func TestLogsBuilder(t *testing.T) {
+	record, err := os.ReadFile(filepath.Join(".", "testdata", "single_record"))
+	require.NoError(t, err)
+
+	got, err := NewUnmarshaler(zap.NewNop()).Unmarshal([][]byte{record})
+	require.NoError(t, err)
+	require.Equal(t, 1, got.ResourceLogs().Len())
+
+	rl := got.ResourceLogs().At(0)
+	attrs := rl.Resource().Attributes().AsRaw()
+	require.Equal(t, map[string]interface{}{
+		conventions.AttributeCloudProvider:     conventions.AttributeCloudProviderAWS,
+		conventions.AttributeCloudAccountID:    "123456789012",
+		conventions.AttributeAWSLogGroupNames:  []interface{}{"test-group"},
+		conventions.AttributeAWSLogStreamNames: []interface{}{"test-stream"},
+	}, attrs)
+
+	lr := rl.ScopeLogs().At(0).LogRecords().At(0)
+	require.Equal(t, pcommon.Timestamp(1668008200000000000), lr.Timestamp())
+	require.Equal(t, "log line 0", lr.Body().Str())
+}
@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awsfirehosereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver"
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver/internal/unmarshaler"
+)
+
+// The logsConsumer implements the firehoseConsumer
+// to use a logs consumer and unmarshaler.
+type logsConsumer struct {
+	// consumer passes the translated logs on to the
+	// next consumer.
+	consumer consumer.Logs
+	// unmarshaler is the configured LogsUnmarshaler
+	// to use when processing the records.
+	unmarshaler unmarshaler.LogsUnmarshaler
+}
+
+var _ firehoseConsumer = (*logsConsumer)(nil)
+
+// newLogsReceiver creates a new instance of the receiver
+// with a logsConsumer.
+func newLogsReceiver(
+	config *Config,
+	set component.ReceiverCreateSettings,
+	unmarshalers map[string]unmarshaler.LogsUnmarshaler,
+	nextConsumer consumer.Logs,
+) (component.LogsReceiver, error) {
+	if nextConsumer == nil {
+		return nil, component.ErrNilNextConsumer
+	}
+
+	recordType := config.RecordType
+	if recordType == "" {
+		recordType = defaultLogsRecordType
+	}
+	configuredUnmarshaler := unmarshalers[recordType]
+	if configuredUnmarshaler == nil {
+		return nil, errUnrecognizedRecordType
+	}
+
+	lc := &logsConsumer{
+		consumer:    nextConsumer,
+		unmarshaler: configuredUnmarshaler,
+	}
+
+	return &firehoseReceiver{
+		instanceID: config.ID(),
+		settings:   set,
+		config:     config,
+		consumer:   lc,
+	}, nil
+}
+
+// Consume uses the configured unmarshaler to deserialize the records into a
+// single plog.Logs. If there are common attributes available, then it will
+// attach those to each of the pcommon.Resources. It will send the final result
+// to the next consumer.
+func (lc *logsConsumer) Consume(ctx context.Context, records [][]byte, commonAttributes map[string]string) (int, error) {
+	ld, err := lc.unmarshaler.Unmarshal(records)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+
+	if commonAttributes != nil {
+		for i := 0; i < ld.ResourceLogs().Len(); i++ {
+			rl := ld.ResourceLogs().At(i)
+			for k, v := range commonAttributes {
+				if _, found := rl.Resource().Attributes().Get(k); !found {
+					rl.Resource().Attributes().PutStr(k, v)
+				}
+			}
+		}
+	}
+
+	err = lc.consumer.ConsumeLogs(ctx, ld)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	return http.StatusOK, nil
+}
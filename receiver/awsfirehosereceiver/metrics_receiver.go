@@ -49,7 +49,11 @@ func newMetricsReceiver(
 		return nil, component.ErrNilNextConsumer
 	}
 
-	configuredUnmarshaler := unmarshalers[config.RecordType]
+	recordType := config.RecordType
+	if recordType == "" {
+		recordType = defaultMetricsRecordType
+	}
+	configuredUnmarshaler := unmarshalers[recordType]
 	if configuredUnmarshaler == nil {
 		return nil, errUnrecognizedRecordType
 	}
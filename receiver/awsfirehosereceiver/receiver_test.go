@@ -70,7 +70,7 @@ func TestStart(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			cfg := &Config{
 				ReceiverSettings: config.NewReceiverSettings(component.NewID(typeStr)),
-				RecordType:       defaultRecordType,
+				RecordType:       defaultMetricsRecordType,
 			}
 			ctx := context.TODO()
 			r := testFirehoseReceiver(cfg, nil)
@@ -89,7 +89,7 @@ func TestStart(t *testing.T) {
 		})
 		cfg := &Config{
 			ReceiverSettings: config.NewReceiverSettings(component.NewID(typeStr)),
-			RecordType:       defaultRecordType,
+			RecordType:       defaultMetricsRecordType,
 			HTTPServerSettings: confighttp.HTTPServerSettings{
 				Endpoint: listener.Addr().String(),
 			},
@@ -109,7 +109,7 @@ func TestFirehoseRequest(t *testing.T) {
 	firehoseConsumerErr := errors.New("firehose consumer error")
 	cfg := &Config{
 		ReceiverSettings: config.NewReceiverSettings(component.NewID(typeStr)),
-		RecordType:       defaultRecordType,
+		RecordType:       defaultMetricsRecordType,
 		AccessKey:        testFirehoseAccessKey,
 	}
 	var noRecords []firehoseRecord
@@ -0,0 +1,314 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azureeventhubreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/azureeventhubreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	conventions "go.opentelemetry.io/collector/semconv/v1.13.0"
+)
+
+const (
+	attributeAzureResourceID       = "azure.resource.id"
+	attributeAzureTenantID         = "azure.tenant.id"
+	attributeAzureOperationName    = "azure.operation.name"
+	attributeAzureOperationVersion = "azure.operation.version"
+	attributeAzureCategory         = "azure.category"
+	attributeAzureResultType       = "azure.result.type"
+	attributeAzureResultSignature  = "azure.result.signature"
+	attributeAzureResultDesc       = "azure.result.description"
+	attributeAzureDuration         = "azure.duration"
+	attributeAzureCorrelationID    = "azure.correlation.id"
+	attributeAzureIdentity         = "azure.identity"
+	attributeAzureProperties       = "azure.properties"
+)
+
+var (
+	errNoRecords = errors.New("no records in the event data")
+
+	// iso8601DurationPattern matches the durations used for the time grain of metrics,
+	// e.g. PT1M or P1D.
+	iso8601DurationPattern = regexp.MustCompile(`^P(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+)
+
+// azureRecords is the envelope of the resource logs and metrics that Azure
+// diagnostic settings stream to Event Hubs.
+type azureRecords struct {
+	Records []azureRecord `json:"records"`
+}
+
+// azureRecord holds the fields of the resource log and metric schemas. Metric
+// records are the ones with a metricName.
+//
+// More details can be found at:
+// https://learn.microsoft.com/en-us/azure/azure-monitor/essentials/resource-logs-schema
+// https://learn.microsoft.com/en-us/azure/azure-monitor/essentials/stream-monitoring-data-event-hubs
+type azureRecord struct {
+	Time              string                 `json:"time"`
+	Timestamp         string                 `json:"timeStamp"`
+	ResourceID        string                 `json:"resourceId"`
+	TenantID          string                 `json:"tenantId"`
+	OperationName     string                 `json:"operationName"`
+	OperationVersion  string                 `json:"operationVersion"`
+	Category          string                 `json:"category"`
+	ResultType        string                 `json:"resultType"`
+	ResultSignature   string                 `json:"resultSignature"`
+	ResultDescription string                 `json:"resultDescription"`
+	DurationMs        interface{}            `json:"durationMs"`
+	CallerIPAddress   string                 `json:"callerIpAddress"`
+	CorrelationID     string                 `json:"correlationId"`
+	Identity          map[string]interface{} `json:"identity"`
+	Level             interface{}            `json:"level"`
+	Location          string                 `json:"location"`
+	Properties        interface{}            `json:"properties"`
+
+	MetricName string  `json:"metricName"`
+	TimeGrain  string  `json:"timeGrain"`
+	Count      float64 `json:"count"`
+	Total      float64 `json:"total"`
+	Minimum    float64 `json:"minimum"`
+	Maximum    float64 `json:"maximum"`
+	Average    float64 `json:"average"`
+}
+
+func (r *azureRecord) isMetric() bool {
+	return r.MetricName != ""
+}
+
+func (r *azureRecord) timestamp() (time.Time, error) {
+	t := r.Time
+	if t == "" {
+		t = r.Timestamp
+	}
+	return time.Parse(time.RFC3339Nano, t)
+}
+
+func unmarshalAzureRecords(data []byte) ([]azureRecord, error) {
+	var records azureRecords
+	if err := jsoniter.Unmarshal(data, &records); err != nil {
+		return nil, err
+	}
+	if len(records.Records) == 0 {
+		return nil, errNoRecords
+	}
+	return records.Records, nil
+}
+
+// azureLogs translates the resource log records of the event data into logs,
+// grouped by resource ID. Metric records are skipped.
+func azureLogs(data []byte) (plog.Logs, error) {
+	records, err := unmarshalAzureRecords(data)
+	if err != nil {
+		return plog.Logs{}, err
+	}
+
+	ld := plog.NewLogs()
+	scopes := map[string]plog.LogRecordSlice{}
+	for i := range records {
+		record := &records[i]
+		if record.isMetric() {
+			continue
+		}
+		ts, err := record.timestamp()
+		if err != nil {
+			return plog.Logs{}, fmt.Errorf("invalid time of record %d: %w", i, err)
+		}
+
+		lrs, ok := scopes[record.ResourceID]
+		if !ok {
+			rl := ld.ResourceLogs().AppendEmpty()
+			putResourceAttributes(rl.Resource(), record.ResourceID)
+			sl := rl.ScopeLogs().AppendEmpty()
+			sl.Scope().SetName(scopeName)
+			lrs = sl.LogRecords()
+			scopes[record.ResourceID] = lrs
+		}
+
+		lr := lrs.AppendEmpty()
+		lr.SetTimestamp(pcommon.NewTimestampFromTime(ts))
+		if record.Level != nil {
+			level := fmt.Sprint(record.Level)
+			lr.SetSeverityText(level)
+			lr.SetSeverityNumber(azureSeverity(level))
+		}
+		putLogAttributes(lr.Attributes(), record)
+	}
+	return ld, nil
+}
+
+func putResourceAttributes(resource pcommon.Resource, resourceID string) {
+	attrs := resource.Attributes()
+	attrs.PutStr(conventions.AttributeCloudProvider, conventions.AttributeCloudProviderAzure)
+	if resourceID != "" {
+		attrs.PutStr(attributeAzureResourceID, resourceID)
+	}
+}
+
+func putLogAttributes(attrs pcommon.Map, record *azureRecord) {
+	putStr := func(k, v string) {
+		if v != "" {
+			attrs.PutStr(k, v)
+		}
+	}
+	putStr(attributeAzureTenantID, record.TenantID)
+	putStr(attributeAzureOperationName, record.OperationName)
+	putStr(attributeAzureOperationVersion, record.OperationVersion)
+	putStr(attributeAzureCategory, record.Category)
+	putStr(attributeAzureResultType, record.ResultType)
+	putStr(attributeAzureResultSignature, record.ResultSignature)
+	putStr(attributeAzureResultDesc, record.ResultDescription)
+	putStr(attributeAzureCorrelationID, record.CorrelationID)
+	putStr(conventions.AttributeNetSockPeerAddr, record.CallerIPAddress)
+	putStr(conventions.AttributeCloudRegion, record.Location)
+	if duration, ok := durationMs(record.DurationMs); ok {
+		attrs.PutInt(attributeAzureDuration, duration)
+	}
+	if len(record.Identity) > 0 {
+		attrs.PutEmptyMap(attributeAzureIdentity).FromRaw(record.Identity)
+	}
+	switch properties := record.Properties.(type) {
+	case nil:
+	case map[string]interface{}:
+		attrs.PutEmptyMap(attributeAzureProperties).FromRaw(properties)
+	default:
+		// some services send the properties as a JSON encoded string
+		attrs.PutEmpty(attributeAzureProperties).FromRaw(properties)
+	}
+}
+
+// durationMs reads the duration of a record, which services send either as
+// a number or as a string.
+func durationMs(v interface{}) (int64, bool) {
+	switch d := v.(type) {
+	case float64:
+		return int64(d), true
+	case string:
+		i, err := strconv.ParseInt(d, 10, 64)
+		return i, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// azureSeverity maps the levels of the resource logs schema, which may also
+// be numeric, to severity numbers.
+func azureSeverity(level string) plog.SeverityNumber {
+	switch level {
+	case "Verbose", "5":
+		return plog.SeverityNumberDebug
+	case "Informational", "Information", "4":
+		return plog.SeverityNumberInfo
+	case "Warning", "3":
+		return plog.SeverityNumberWarn
+	case "Error", "2":
+		return plog.SeverityNumberError
+	case "Critical", "1":
+		return plog.SeverityNumberFatal
+	default:
+		return plog.SeverityNumberUnspecified
+	}
+}
+
+// azureMetrics translates the metric records of the event data into summaries
+// grouped by resource ID. The count and total of a record become the count and
+// sum of the summary, and its minimum and maximum the 0 and 1 quantiles. Log
+// records are skipped.
+func azureMetrics(data []byte) (pmetric.Metrics, error) {
+	records, err := unmarshalAzureRecords(data)
+	if err != nil {
+		return pmetric.Metrics{}, err
+	}
+
+	md := pmetric.NewMetrics()
+	scopes := map[string]pmetric.MetricSlice{}
+	for i := range records {
+		record := &records[i]
+		if !record.isMetric() {
+			continue
+		}
+		ts, err := record.timestamp()
+		if err != nil {
+			return pmetric.Metrics{}, fmt.Errorf("invalid time of record %d: %w", i, err)
+		}
+		grain, err := parseTimeGrain(record.TimeGrain)
+		if err != nil {
+			return pmetric.Metrics{}, fmt.Errorf("invalid time grain of record %d: %w", i, err)
+		}
+
+		ms, ok := scopes[record.ResourceID]
+		if !ok {
+			rm := md.ResourceMetrics().AppendEmpty()
+			putResourceAttributes(rm.Resource(), record.ResourceID)
+			sm := rm.ScopeMetrics().AppendEmpty()
+			sm.Scope().SetName(scopeName)
+			ms = sm.Metrics()
+			scopes[record.ResourceID] = ms
+		}
+
+		m := ms.AppendEmpty()
+		m.SetName(record.MetricName)
+		dp := m.SetEmptySummary().DataPoints().AppendEmpty()
+		dp.SetStartTimestamp(pcommon.NewTimestampFromTime(ts.Add(-grain)))
+		dp.SetTimestamp(pcommon.NewTimestampFromTime(ts))
+		dp.SetCount(uint64(record.Count))
+		dp.SetSum(record.Total)
+		minimum := dp.QuantileValues().AppendEmpty()
+		minimum.SetQuantile(0)
+		minimum.SetValue(record.Minimum)
+		maximum := dp.QuantileValues().AppendEmpty()
+		maximum.SetQuantile(1)
+		maximum.SetValue(record.Maximum)
+	}
+	return md, nil
+}
+
+// parseTimeGrain parses the ISO 8601 duration of the time grain of a metric
+// record. An empty time grain is a zero duration.
+func parseTimeGrain(grain string) (time.Duration, error) {
+	if grain == "" {
+		return 0, nil
+	}
+	matches := iso8601DurationPattern.FindStringSubmatch(grain)
+	if matches == nil || grain == "P" || grain[len(grain)-1] == 'T' {
+		return 0, fmt.Errorf("unsupported duration %q", grain)
+	}
+	var d time.Duration
+	for i, unit := range []time.Duration{24 * time.Hour, time.Hour, time.Minute} {
+		if matches[i+1] == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(matches[i+1], 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		d += time.Duration(n) * unit
+	}
+	if matches[4] != "" {
+		seconds, err := strconv.ParseFloat(matches[4], 64)
+		if err != nil {
+			return 0, err
+		}
+		d += time.Duration(seconds * float64(time.Second))
+	}
+	return d, nil
+}
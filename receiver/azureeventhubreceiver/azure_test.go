@@ -0,0 +1,179 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azureeventhubreceiver
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+const keyVaultResourceID = "/SUBSCRIPTIONS/00000000-0000-0000-0000-000000000000/RESOURCEGROUPS/TEST/PROVIDERS/MICROSOFT.KEYVAULT/VAULTS/TEST-VAULT"
+
+func TestAzureLogs(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "logs.json"))
+	require.NoError(t, err)
+
+	ld, err := azureLogs(data)
+	require.NoError(t, err)
+	require.Equal(t, 2, ld.ResourceLogs().Len())
+	require.Equal(t, 3, ld.LogRecordCount())
+
+	rl := ld.ResourceLogs().At(0)
+	assert.Equal(t, map[string]interface{}{
+		"cloud.provider":    "azure",
+		"azure.resource.id": keyVaultResourceID,
+	}, rl.Resource().Attributes().AsRaw())
+	assert.Equal(t, scopeName, rl.ScopeLogs().At(0).Scope().Name())
+
+	lrs := rl.ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 2, lrs.Len())
+	lr := lrs.At(0)
+	assert.Equal(t, time.Date(2022, 11, 11, 4, 48, 27, 676714500, time.UTC), lr.Timestamp().AsTime())
+	assert.Equal(t, "Informational", lr.SeverityText())
+	assert.Equal(t, plog.SeverityNumberInfo, lr.SeverityNumber())
+	assert.Equal(t, map[string]interface{}{
+		"azure.tenant.id":         "11111111-1111-1111-1111-111111111111",
+		"azure.operation.name":    "SecretGet",
+		"azure.operation.version": "7.3",
+		"azure.category":          "AuditEvent",
+		"azure.result.type":       "Success",
+		"azure.result.signature":  "OK",
+		"azure.correlation.id":    "22222222-2222-2222-2222-222222222222",
+		"azure.duration":          int64(23),
+		"net.sock.peer.addr":      "10.0.0.1",
+		"cloud.region":            "westeurope",
+		"azure.identity": map[string]interface{}{
+			"claim": map[string]interface{}{"appid": "33333333-3333-3333-3333-333333333333"},
+		},
+		"azure.properties": map[string]interface{}{
+			"id":             "https://test-vault.vault.azure.net/secrets/test",
+			"httpStatusCode": float64(200),
+		},
+	}, lr.Attributes().AsRaw())
+
+	lr = lrs.At(1)
+	assert.Equal(t, plog.SeverityNumberError, lr.SeverityNumber())
+	duration, ok := lr.Attributes().Get("azure.duration")
+	require.True(t, ok)
+	assert.Equal(t, int64(12), duration.Int())
+
+	lr = ld.ResourceLogs().At(1).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, plog.SeverityNumberWarn, lr.SeverityNumber())
+	properties, ok := lr.Attributes().Get("azure.properties")
+	require.True(t, ok)
+	assert.Equal(t, pcommon.ValueTypeStr, properties.Type())
+}
+
+func TestAzureLogsSkipsMetrics(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "metrics.json"))
+	require.NoError(t, err)
+
+	ld, err := azureLogs(data)
+	require.NoError(t, err)
+	assert.Equal(t, 0, ld.LogRecordCount())
+}
+
+func TestAzureLogsInvalid(t *testing.T) {
+	_, err := azureLogs([]byte("hello"))
+	assert.Error(t, err)
+
+	_, err = azureLogs([]byte(`{"records":[]}`))
+	assert.ErrorIs(t, err, errNoRecords)
+
+	_, err = azureLogs([]byte(`{"records":[{"time":"yesterday"}]}`))
+	assert.Error(t, err)
+}
+
+func TestAzureMetrics(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "metrics.json"))
+	require.NoError(t, err)
+
+	md, err := azureMetrics(data)
+	require.NoError(t, err)
+	require.Equal(t, 2, md.ResourceMetrics().Len())
+	require.Equal(t, 3, md.DataPointCount())
+
+	rm := md.ResourceMetrics().At(0)
+	assert.Equal(t, map[string]interface{}{
+		"cloud.provider":    "azure",
+		"azure.resource.id": keyVaultResourceID,
+	}, rm.Resource().Attributes().AsRaw())
+
+	ms := rm.ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, ms.Len())
+	m := ms.At(0)
+	assert.Equal(t, "ServiceApiLatency", m.Name())
+	require.Equal(t, pmetric.MetricTypeSummary, m.Type())
+	dp := m.Summary().DataPoints().At(0)
+	assert.Equal(t, time.Date(2022, 11, 11, 4, 47, 0, 0, time.UTC), dp.StartTimestamp().AsTime())
+	assert.Equal(t, time.Date(2022, 11, 11, 4, 48, 0, 0, time.UTC), dp.Timestamp().AsTime())
+	assert.Equal(t, uint64(4), dp.Count())
+	assert.Equal(t, float64(19), dp.Sum())
+	require.Equal(t, 2, dp.QuantileValues().Len())
+	assert.Equal(t, float64(0), dp.QuantileValues().At(0).Quantile())
+	assert.Equal(t, float64(2), dp.QuantileValues().At(0).Value())
+	assert.Equal(t, float64(1), dp.QuantileValues().At(1).Quantile())
+	assert.Equal(t, float64(8), dp.QuantileValues().At(1).Value())
+
+	dp = md.ResourceMetrics().At(1).ScopeMetrics().At(0).Metrics().At(0).Summary().DataPoints().At(0)
+	assert.Equal(t, time.Date(2022, 11, 11, 4, 0, 0, 0, time.UTC), dp.StartTimestamp().AsTime())
+}
+
+func TestAzureMetricsSkipsLogs(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "logs.json"))
+	require.NoError(t, err)
+
+	md, err := azureMetrics(data)
+	require.NoError(t, err)
+	assert.Equal(t, 0, md.DataPointCount())
+}
+
+func TestParseTimeGrain(t *testing.T) {
+	tests := []struct {
+		grain    string
+		expected time.Duration
+		err      bool
+	}{
+		{grain: "", expected: 0},
+		{grain: "PT1M", expected: time.Minute},
+		{grain: "PT5M", expected: 5 * time.Minute},
+		{grain: "PT1H", expected: time.Hour},
+		{grain: "PT30S", expected: 30 * time.Second},
+		{grain: "PT1H30M", expected: 90 * time.Minute},
+		{grain: "P1D", expected: 24 * time.Hour},
+		{grain: "P", err: true},
+		{grain: "PT", err: true},
+		{grain: "1m", err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.grain, func(t *testing.T) {
+			d, err := parseTimeGrain(tt.grain)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, d)
+		})
+	}
+}
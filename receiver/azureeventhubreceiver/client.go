@@ -27,12 +27,17 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/adapter"
 )
 
+const scopeName = "otelcol/azureeventhubreceiver"
+
+// client receives the events of the hub and passes them on to the logs and
+// metrics consumers set by the pipelines sharing its configuration.
 type client struct {
-	logger   *zap.Logger
-	consumer consumer.Logs
-	config   *Config
-	obsrecv  *obsreport.Receiver
-	hub      hubWrapper
+	logger          *zap.Logger
+	logsConsumer    consumer.Logs
+	metricsConsumer consumer.Metrics
+	config          *Config
+	obsrecv         *obsreport.Receiver
+	hub             hubWrapper
 }
 
 type hubWrapper interface {
@@ -123,18 +128,70 @@ func (c *client) setUpOnePartition(ctx context.Context, partitionID string, appl
 }
 
 func (c *client) handle(ctx context.Context, event *eventhub.Event) error {
-	c.obsrecv.StartLogsOp(ctx)
+	if c.logsConsumer != nil {
+		if err := c.handleLogs(ctx, event); err != nil {
+			return err
+		}
+	}
+	if c.metricsConsumer != nil {
+		return c.handleMetrics(ctx, event)
+	}
+	return nil
+}
+
+func (c *client) handleLogs(ctx context.Context, event *eventhub.Event) error {
+	ctx = c.obsrecv.StartLogsOp(ctx)
+	var l plog.Logs
+	if c.config.Format == azureFormat {
+		var err error
+		l, err = azureLogs(event.Data)
+		if err != nil {
+			// the event is dropped rather than retried, as its data cannot be translated.
+			c.logger.Error("Unable to translate the event data to logs", zap.Error(err))
+			c.obsrecv.EndLogsOp(ctx, c.config.Format, 0, err)
+			return nil
+		}
+	} else {
+		l = rawLogs(event)
+	}
+	if l.LogRecordCount() == 0 {
+		c.obsrecv.EndLogsOp(ctx, c.config.Format, 0, nil)
+		return nil
+	}
+	consumerErr := c.logsConsumer.ConsumeLogs(ctx, l)
+	c.obsrecv.EndLogsOp(ctx, c.config.Format, l.LogRecordCount(), consumerErr)
+	return consumerErr
+}
+
+func (c *client) handleMetrics(ctx context.Context, event *eventhub.Event) error {
+	ctx = c.obsrecv.StartMetricsOp(ctx)
+	m, err := azureMetrics(event.Data)
+	if err != nil {
+		// the event is dropped rather than retried, as its data cannot be translated.
+		c.logger.Error("Unable to translate the event data to metrics", zap.Error(err))
+		c.obsrecv.EndMetricsOp(ctx, c.config.Format, 0, err)
+		return nil
+	}
+	if m.DataPointCount() == 0 {
+		c.obsrecv.EndMetricsOp(ctx, c.config.Format, 0, nil)
+		return nil
+	}
+	consumerErr := c.metricsConsumer.ConsumeMetrics(ctx, m)
+	c.obsrecv.EndMetricsOp(ctx, c.config.Format, m.DataPointCount(), consumerErr)
+	return consumerErr
+}
+
+// rawLogs forwards the event data as the body of a single log record.
+func rawLogs(event *eventhub.Event) plog.Logs {
 	l := plog.NewLogs()
 	lr := l.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
 	slice := lr.Body().SetEmptyBytes()
 	slice.Append(event.Data...)
 	lr.Attributes().FromRaw(event.Properties)
-	if event.SystemProperties.EnqueuedTime != nil {
+	if event.SystemProperties != nil && event.SystemProperties.EnqueuedTime != nil {
 		lr.SetTimestamp(pcommon.NewTimestampFromTime(*event.SystemProperties.EnqueuedTime))
 	}
-	consumerErr := c.consumer.ConsumeLogs(ctx, l)
-	c.obsrecv.EndLogsOp(ctx, "azureeventhub", 1, consumerErr)
-	return consumerErr
+	return l
 }
 
 func (c *client) Shutdown(ctx context.Context) error {
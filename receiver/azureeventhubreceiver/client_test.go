@@ -16,6 +16,8 @@ package azureeventhubreceiver
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -67,9 +69,9 @@ func TestClient_Start(t *testing.T) {
 	config.(*Config).Connection = "Endpoint=sb://namespace.servicebus.windows.net/;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=superSecret1234=;EntityPath=hubName"
 
 	c := &client{
-		logger:   zap.NewNop(),
-		consumer: consumertest.NewNop(),
-		config:   config.(*Config),
+		logger:       zap.NewNop(),
+		logsConsumer: consumertest.NewNop(),
+		config:       config.(*Config),
 	}
 	c.hub = &mockHubWrapper{}
 	err := c.Start(context.Background(), componenttest.NewNopHost())
@@ -91,10 +93,10 @@ func TestClient_handle(t *testing.T) {
 	})
 	require.NoError(t, err)
 	c := &client{
-		logger:   zap.NewNop(),
-		consumer: sink,
-		config:   config.(*Config),
-		obsrecv:  obsrecv,
+		logger:       zap.NewNop(),
+		logsConsumer: sink,
+		config:       config.(*Config),
+		obsrecv:      obsrecv,
 	}
 	c.hub = &mockHubWrapper{}
 	err = c.Start(context.Background(), componenttest.NewNopHost())
@@ -122,3 +124,40 @@ func TestClient_handle(t *testing.T) {
 	assert.True(t, ok)
 	assert.Equal(t, "bar", read.AsString())
 }
+
+func TestClient_handleAzureFormat(t *testing.T) {
+	config := createDefaultConfig().(*Config)
+	config.Format = azureFormat
+
+	obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{
+		ReceiverID:             config.ID(),
+		ReceiverCreateSettings: componenttest.NewNopReceiverCreateSettings(),
+	})
+	require.NoError(t, err)
+	logsSink := new(consumertest.LogsSink)
+	metricsSink := new(consumertest.MetricsSink)
+	c := &client{
+		logger:          zap.NewNop(),
+		logsConsumer:    logsSink,
+		metricsConsumer: metricsSink,
+		config:          config,
+		obsrecv:         obsrecv,
+	}
+
+	logs, err := os.ReadFile(filepath.Join("testdata", "logs.json"))
+	require.NoError(t, err)
+	require.NoError(t, c.handle(context.Background(), &eventhub.Event{Data: logs}))
+	metrics, err := os.ReadFile(filepath.Join("testdata", "metrics.json"))
+	require.NoError(t, err)
+	require.NoError(t, c.handle(context.Background(), &eventhub.Event{Data: metrics}))
+
+	require.Len(t, logsSink.AllLogs(), 1)
+	assert.Equal(t, 3, logsSink.AllLogs()[0].LogRecordCount())
+	require.Len(t, metricsSink.AllMetrics(), 1)
+	assert.Equal(t, 3, metricsSink.AllMetrics()[0].DataPointCount())
+
+	// events which cannot be translated are dropped
+	require.NoError(t, c.handle(context.Background(), &eventhub.Event{Data: []byte("hello")}))
+	assert.Len(t, logsSink.AllLogs(), 1)
+	assert.Len(t, metricsSink.AllMetrics(), 1)
+}
@@ -15,12 +15,20 @@
 package azureeventhubreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/azureeventhubreceiver"
 import (
 	"errors"
+	"fmt"
 
 	"github.com/Azure/azure-amqp-common-go/v3/conn"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
 )
 
+const (
+	// rawFormat forwards the events data as the log body.
+	rawFormat = "raw"
+	// azureFormat translates the Azure resource logs and metrics schemas.
+	azureFormat = "azure"
+)
+
 var (
 	errMissingConnection = errors.New("missing connection")
 )
@@ -31,6 +39,9 @@ type Config struct {
 	Partition               string        `mapstructure:"partition"`
 	Offset                  string        `mapstructure:"offset"`
 	StorageID               *component.ID `mapstructure:"storage"`
+	// Format of the events data, either "raw" or "azure". Metrics pipelines
+	// require the "azure" format.
+	Format string `mapstructure:"format"`
 }
 
 // Validate config
@@ -41,5 +52,8 @@ func (config *Config) Validate() error {
 	if _, err := conn.ParsedConnectionFromStr(config.Connection); err != nil {
 		return err
 	}
+	if config.Format != rawFormat && config.Format != azureFormat {
+		return fmt.Errorf("invalid format %q, must be %q or %q", config.Format, rawFormat, azureFormat)
+	}
 	return nil
 }
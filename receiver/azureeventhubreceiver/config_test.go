@@ -42,11 +42,13 @@ func TestLoadConfig(t *testing.T) {
 	assert.Equal(t, "Endpoint=sb://namespace.servicebus.windows.net/;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=superSecret1234=;EntityPath=hubName", r0.(*Config).Connection)
 	assert.Equal(t, "", r0.(*Config).Offset)
 	assert.Equal(t, "", r0.(*Config).Partition)
+	assert.Equal(t, rawFormat, r0.(*Config).Format)
 
 	r1 := cfg.Receivers[component.NewIDWithName(typeStr, "all")]
 	assert.Equal(t, "Endpoint=sb://namespace.servicebus.windows.net/;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=superSecret1234=;EntityPath=hubName", r1.(*Config).Connection)
 	assert.Equal(t, "1234-5566", r1.(*Config).Offset)
 	assert.Equal(t, "foo", r1.(*Config).Partition)
+	assert.Equal(t, azureFormat, r1.(*Config).Format)
 }
 
 func TestMissingConnection(t *testing.T) {
@@ -63,3 +65,12 @@ func TestInvalidConnectionString(t *testing.T) {
 	err := cfg.Validate()
 	assert.EqualError(t, err, "failed parsing connection string due to unmatched key value separated by '='")
 }
+
+func TestInvalidFormat(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	cfg.(*Config).Connection = "Endpoint=sb://namespace.servicebus.windows.net/;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=superSecret1234=;EntityPath=hubName"
+	cfg.(*Config).Format = "foo"
+	err := cfg.Validate()
+	assert.EqualError(t, err, `invalid format "foo", must be "raw" or "azure"`)
+}
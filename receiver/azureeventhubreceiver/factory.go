@@ -16,11 +16,14 @@ package azureeventhubreceiver // import "github.com/open-telemetry/opentelemetry
 
 import (
 	"context"
+	"errors"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/obsreport"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
 )
 
 const (
@@ -30,33 +33,71 @@ const (
 	stability = component.StabilityLevelAlpha
 )
 
+var (
+	errMetricsRequireAzureFormat = errors.New("metrics pipelines require the azure format")
+
+	// receivers shares the client of a configuration between the pipelines
+	// using it, so that they read the hub through the same connection.
+	receivers = sharedcomponent.NewSharedComponents()
+)
+
 // NewFactory creates a factory for the Azure Event Hub receiver.
 func NewFactory() component.ReceiverFactory {
 	return component.NewReceiverFactory(
 		typeStr,
 		createDefaultConfig,
-		component.WithLogsReceiver(createLogsReceiver, stability))
+		component.WithLogsReceiver(createLogsReceiver, stability),
+		component.WithMetricsReceiver(createMetricsReceiver, stability))
 }
 
 func createDefaultConfig() component.ReceiverConfig {
-	return &Config{ReceiverSettings: config.NewReceiverSettings(component.NewID(typeStr))}
+	return &Config{
+		ReceiverSettings: config.NewReceiverSettings(component.NewID(typeStr)),
+		Format:           rawFormat,
+	}
 }
 
 func createLogsReceiver(_ context.Context, settings component.ReceiverCreateSettings, receiver component.ReceiverConfig, logs consumer.Logs) (component.LogsReceiver, error) {
+	r, err := getOrAddClient(settings, receiver)
+	if err != nil {
+		return nil, err
+	}
+	r.Unwrap().(*client).logsConsumer = logs
+	return r, nil
+}
 
-	obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{
-		ReceiverID:             receiver.ID(),
-		Transport:              "azureeventhub",
-		ReceiverCreateSettings: settings,
-	})
+func createMetricsReceiver(_ context.Context, settings component.ReceiverCreateSettings, receiver component.ReceiverConfig, metrics consumer.Metrics) (component.MetricsReceiver, error) {
+	if receiver.(*Config).Format != azureFormat {
+		return nil, errMetricsRequireAzureFormat
+	}
+	r, err := getOrAddClient(settings, receiver)
 	if err != nil {
 		return nil, err
 	}
+	r.Unwrap().(*client).metricsConsumer = metrics
+	return r, nil
+}
 
-	return &client{
-		logger:   settings.Logger,
-		consumer: logs,
-		config:   receiver.(*Config),
-		obsrecv:  obsrecv,
-	}, nil
+func getOrAddClient(settings component.ReceiverCreateSettings, receiver component.ReceiverConfig) (*sharedcomponent.SharedComponent, error) {
+	var err error
+	r := receivers.GetOrAdd(receiver, func() component.Component {
+		var obsrecv *obsreport.Receiver
+		obsrecv, err = obsreport.NewReceiver(obsreport.ReceiverSettings{
+			ReceiverID:             receiver.ID(),
+			Transport:              "azureeventhub",
+			ReceiverCreateSettings: settings,
+		})
+		if err != nil {
+			return nil
+		}
+		return &client{
+			logger:  settings.Logger,
+			config:  receiver.(*Config),
+			obsrecv: obsrecv,
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
 }
@@ -28,7 +28,7 @@ import (
 func TestNewFactory(t *testing.T) {
 	f := NewFactory()
 	assert.Equal(t, component.Type("azureeventhub"), f.Type())
-	assert.Equal(t, &Config{ReceiverSettings: config.NewReceiverSettings(component.NewID(typeStr))}, f.CreateDefaultConfig())
+	assert.Equal(t, &Config{ReceiverSettings: config.NewReceiverSettings(component.NewID(typeStr)), Format: rawFormat}, f.CreateDefaultConfig())
 }
 
 func TestNewLogsReceiver(t *testing.T) {
@@ -37,3 +37,26 @@ func TestNewLogsReceiver(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, receiver)
 }
+
+func TestNewMetricsReceiver(t *testing.T) {
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig()
+	_, err := f.CreateMetricsReceiver(context.Background(), componenttest.NewNopReceiverCreateSettings(), cfg, consumertest.NewNop())
+	assert.ErrorIs(t, err, errMetricsRequireAzureFormat)
+
+	cfg.(*Config).Format = azureFormat
+	receiver, err := f.CreateMetricsReceiver(context.Background(), componenttest.NewNopReceiverCreateSettings(), cfg, consumertest.NewNop())
+	assert.NoError(t, err)
+	assert.NotNil(t, receiver)
+}
+
+func TestSharedReceiver(t *testing.T) {
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig()
+	cfg.(*Config).Format = azureFormat
+	logsReceiver, err := f.CreateLogsReceiver(context.Background(), componenttest.NewNopReceiverCreateSettings(), cfg, consumertest.NewNop())
+	assert.NoError(t, err)
+	metricsReceiver, err := f.CreateMetricsReceiver(context.Background(), componenttest.NewNopReceiverCreateSettings(), cfg, consumertest.NewNop())
+	assert.NoError(t, err)
+	assert.Same(t, logsReceiver, metricsReceiver)
+}
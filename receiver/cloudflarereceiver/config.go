@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudflarereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/cloudflarereceiver"
+
+import (
+	"errors"
+	"strings"
+
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.uber.org/multierr"
+)
+
+var (
+	errInvalidPath        = errors.New(`"path" must start with "/"`)
+	errInvalidMaxBodySize = errors.New(`"max_body_size" must be positive`)
+	errMissingTimestamp   = errors.New(`"timestamp_field" must be specified`)
+)
+
+// Config defines configuration for the Cloudflare receiver.
+type Config struct {
+	config.ReceiverSettings       `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
+	confighttp.HTTPServerSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
+
+	// Path is the path of the Logpush destination.
+	Path string `mapstructure:"path"`
+	// Secret is the value of the X-CF-Secret header, set on the destination of the
+	// Logpush job. Requests with another value are rejected.
+	Secret string `mapstructure:"secret"`
+	// MaxBodySize is the maximum size in bytes of the decompressed batches.
+	MaxBodySize int64 `mapstructure:"max_body_size"`
+	// TimestampField is the field the timestamp of the log records is taken from.
+	TimestampField string `mapstructure:"timestamp_field"`
+	// Attributes maps fields to the names of the attributes they are stored in,
+	// overriding the default names.
+	Attributes map[string]string `mapstructure:"attributes"`
+}
+
+// Validate checks if the receiver configuration is valid
+func (cfg *Config) Validate() error {
+	var err error
+	if !strings.HasPrefix(cfg.Path, "/") {
+		err = multierr.Append(err, errInvalidPath)
+	}
+	if cfg.MaxBodySize <= 0 {
+		err = multierr.Append(err, errInvalidMaxBodySize)
+	}
+	if cfg.TimestampField == "" {
+		err = multierr.Append(err, errMissingTimestamp)
+	}
+	return err
+}
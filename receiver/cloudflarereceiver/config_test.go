@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudflarereceiver
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.uber.org/multierr"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id          component.ID
+		expected    component.ReceiverConfig
+		expectedErr error
+	}{
+		{
+			id:       component.NewIDWithName(typeStr, ""),
+			expected: createDefaultConfig(),
+		},
+		{
+			id: component.NewIDWithName(typeStr, "allsettings"),
+			expected: &Config{
+				ReceiverSettings: config.NewReceiverSettings(component.NewID(typeStr)),
+				HTTPServerSettings: confighttp.HTTPServerSettings{
+					Endpoint: "0.0.0.0:12345",
+					TLSSetting: &configtls.TLSServerSetting{
+						TLSSetting: configtls.TLSSetting{
+							CertFile: "/certs/server.crt",
+							KeyFile:  "/certs/server.key",
+						},
+					},
+				},
+				Path:           "/cloudflare",
+				Secret:         "1234567890abcdef",
+				MaxBodySize:    1048576,
+				TimestampField: "EdgeEndTimestamp",
+				Attributes: map[string]string{
+					"RayID":    "http.request.id",
+					"ZoneName": "cloudflare.zone",
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(typeStr, "invalid"),
+			expectedErr: multierr.Combine(errInvalidPath, errInvalidMaxBodySize, errMissingTimestamp),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalReceiverConfig(sub, cfg))
+
+			if tt.expectedErr != nil {
+				assert.EqualError(t, cfg.Validate(), tt.expectedErr.Error())
+				return
+			}
+			assert.NoError(t, cfg.Validate())
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
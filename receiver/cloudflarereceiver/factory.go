@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudflarereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/cloudflarereceiver"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/consumer"
+)
+
+const (
+	// The value of "type" key in configuration.
+	typeStr = "cloudflare"
+	// The stability level of the receiver.
+	stability = component.StabilityLevelAlpha
+
+	// Default endpoint to bind to.
+	defaultEndpoint = ":8443"
+	// Default path of the Logpush destination.
+	defaultPath = "/logs"
+	// Default maximum size of the decompressed batches.
+	defaultMaxBodySize = 100 * 1024 * 1024
+	// Default timestamp field, present in the HTTP requests dataset.
+	defaultTimestampField = "EdgeStartTimestamp"
+)
+
+// NewFactory creates a factory for the Cloudflare receiver.
+func NewFactory() component.ReceiverFactory {
+	return component.NewReceiverFactory(
+		typeStr,
+		createDefaultConfig,
+		component.WithLogsReceiver(createLogsReceiver, stability))
+}
+
+func createDefaultConfig() component.ReceiverConfig {
+	return &Config{
+		ReceiverSettings: config.NewReceiverSettings(component.NewID(typeStr)),
+		HTTPServerSettings: confighttp.HTTPServerSettings{
+			Endpoint: defaultEndpoint,
+		},
+		Path:           defaultPath,
+		MaxBodySize:    defaultMaxBodySize,
+		TimestampField: defaultTimestampField,
+		Attributes:     map[string]string{},
+	}
+}
+
+func createLogsReceiver(
+	_ context.Context,
+	params component.ReceiverCreateSettings,
+	cfg component.ReceiverConfig,
+	consumer consumer.Logs,
+) (component.LogsReceiver, error) {
+	return newLogsReceiver(params, cfg.(*Config), consumer)
+}
@@ -0,0 +1,227 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudflarereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/cloudflarereceiver"
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/pdatautil"
+)
+
+const (
+	// attributePrefix is the prefix of the attributes of the fields without a
+	// semantic convention, e.g. RayID is stored in cloudflare.ray_id.
+	attributePrefix = "cloudflare."
+
+	statusField   = "EdgeResponseStatus"
+	protocolField = "ClientRequestProtocol"
+)
+
+// semanticAttributes maps the fields of the HTTP requests dataset to the attributes
+// of the semantic conventions of HTTP.
+var semanticAttributes = map[string]string{
+	"ClientRequestMethod":    conventions.AttributeHTTPMethod,
+	"ClientRequestHost":      conventions.AttributeHTTPHost,
+	"ClientRequestURI":       conventions.AttributeHTTPTarget,
+	"ClientRequestScheme":    conventions.AttributeHTTPScheme,
+	"ClientRequestUserAgent": conventions.AttributeHTTPUserAgent,
+	"ClientRequestBytes":     conventions.AttributeHTTPRequestContentLength,
+	"ClientIP":               conventions.AttributeHTTPClientIP,
+	"ClientSrcPort":          conventions.AttributeNetPeerPort,
+	"EdgeResponseStatus":     conventions.AttributeHTTPStatusCode,
+	"EdgeResponseBytes":      conventions.AttributeHTTPResponseContentLength,
+	"ClientRequestProtocol":  conventions.AttributeHTTPFlavor,
+	"EdgeServerIP":           conventions.AttributeNetHostIP,
+}
+
+// logConverter converts the lines of Logpush batches to log records.
+type logConverter struct {
+	timestampField string
+	attributes     map[string]string
+}
+
+func newLogConverter(cfg *Config) *logConverter {
+	attributes := make(map[string]string, len(semanticAttributes)+len(cfg.Attributes))
+	for field, name := range semanticAttributes {
+		attributes[field] = name
+	}
+	for field, name := range cfg.Attributes {
+		attributes[field] = name
+	}
+	return &logConverter{
+		timestampField: cfg.TimestampField,
+		attributes:     attributes,
+	}
+}
+
+// isTestBatch reports whether the batch is the one Cloudflare sends to check the
+// destination when the Logpush job is created.
+func isTestBatch(payload []byte) bool {
+	payload = bytes.TrimSpace(payload)
+	if string(payload) == "test" {
+		return true
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(payload, &fields); err != nil {
+		return false
+	}
+	_, ok := fields["content"]
+	return ok && len(fields) == 1
+}
+
+// toLogs converts a batch, holding a JSON object per line, to log records.
+func (c *logConverter) toLogs(payload []byte, observed time.Time) (plog.Logs, error) {
+	ld := plog.NewLogs()
+	records := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	observedTimestamp := pcommon.NewTimestampFromTime(observed)
+
+	scanner := bufio.NewScanner(bytes.NewReader(payload))
+	scanner.Buffer(nil, len(payload)+1)
+	for line := 1; scanner.Scan(); line++ {
+		raw := bytes.TrimSpace(scanner.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		decoder.UseNumber()
+		var fields map[string]interface{}
+		if err := decoder.Decode(&fields); err != nil {
+			return ld, fmt.Errorf("line %d: invalid JSON object: %w", line, err)
+		}
+
+		record := records.AppendEmpty()
+		record.SetObservedTimestamp(observedTimestamp)
+		record.Body().SetStr(string(raw))
+		if ts, ok := parseTimestamp(fields[c.timestampField]); ok {
+			record.SetTimestamp(pcommon.NewTimestampFromTime(ts))
+		}
+		if status, ok := fields[statusField].(json.Number); ok {
+			setSeverity(record, status)
+		}
+		for field, value := range fields {
+			c.setAttribute(record.Attributes(), field, value)
+		}
+	}
+	return ld, scanner.Err()
+}
+
+func (c *logConverter) setAttribute(attrs pcommon.Map, field string, value interface{}) {
+	if value == nil {
+		return
+	}
+	name, ok := c.attributes[field]
+	if !ok {
+		name = attributePrefix + snakeCase(field)
+	}
+	if field == protocolField {
+		if protocol, ok := value.(string); ok {
+			attrs.PutStr(name, httpFlavor(protocol))
+			return
+		}
+	}
+	pdatautil.SetJSONValue(attrs.PutEmpty(name), value)
+}
+
+// setSeverity sets the severity of the record from the status of the response.
+func setSeverity(record plog.LogRecord, status json.Number) {
+	code, err := status.Int64()
+	if err != nil {
+		return
+	}
+	switch {
+	case code >= 500:
+		record.SetSeverityNumber(plog.SeverityNumberError)
+		record.SetSeverityText("ERROR")
+	case code >= 400:
+		record.SetSeverityNumber(plog.SeverityNumberWarn)
+		record.SetSeverityText("WARN")
+	default:
+		record.SetSeverityNumber(plog.SeverityNumberInfo)
+		record.SetSeverityText("INFO")
+	}
+}
+
+// parseTimestamp parses a timestamp in any of the formats of Logpush: RFC 3339,
+// or a number of seconds or nanoseconds since the epoch. Milliseconds and
+// microseconds are also accepted, as transformations may produce them.
+func parseTimestamp(value interface{}) (time.Time, bool) {
+	switch v := value.(type) {
+	case string:
+		ts, err := time.Parse(time.RFC3339Nano, v)
+		return ts, err == nil
+	case json.Number:
+		n, err := v.Int64()
+		if err != nil {
+			f, ferr := v.Float64()
+			if ferr != nil {
+				return time.Time{}, false
+			}
+			return time.Unix(0, int64(f*float64(time.Second))), true
+		}
+		switch {
+		case n >= 1e17:
+			return time.Unix(0, n), true
+		case n >= 1e14:
+			return time.UnixMicro(n), true
+		case n >= 1e11:
+			return time.UnixMilli(n), true
+		default:
+			return time.Unix(n, 0), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// httpFlavor converts the protocol of a request, e.g. HTTP/2, to the flavor of the
+// semantic conventions, e.g. 2.0.
+func httpFlavor(protocol string) string {
+	version := strings.TrimPrefix(strings.ToUpper(protocol), "HTTP/")
+	if version == protocol || version == "" {
+		return protocol
+	}
+	if !strings.Contains(version, ".") {
+		version += ".0"
+	}
+	return version
+}
+
+// snakeCase converts a field name to snake case, keeping acronyms together,
+// e.g. ClientIPClass becomes client_ip_class.
+func snakeCase(s string) string {
+	runes := []rune(s)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]) ||
+				(i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				b.WriteByte('_')
+			}
+			b.WriteRune(unicode.ToLower(r))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,125 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudflarereceiver
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func TestToLogs(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Attributes["ZoneName"] = "cloudflare.zone"
+	converter := newLogConverter(cfg)
+
+	line := `{"ClientIP":"89.163.242.206","ClientRequestHost":"example.com","ClientRequestMethod":"GET",` +
+		`"ClientRequestURI":"/cart?id=1","ClientRequestProtocol":"HTTP/2","EdgeEndTimestamp":1667293201000000000,` +
+		`"EdgeResponseBytes":1024,"EdgeResponseStatus":503,"EdgeStartTimestamp":1667293200000000000,` +
+		`"RayID":"76e1b5a8bc6d2e1f","ZoneName":"example.com","WAFFlags":"0","CacheCacheStatus":null,` +
+		`"RequestHeaders":{"x-request-id":"abc"}}`
+	observed := time.Date(2022, 11, 1, 10, 0, 5, 0, time.UTC)
+
+	ld, err := converter.toLogs([]byte(line+"\n\n"+`{"EdgeStartTimestamp":"2022-11-01T09:00:00Z","EdgeResponseStatus":404}`+"\n"), observed)
+	require.NoError(t, err)
+	records := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 2, records.Len())
+
+	record := records.At(0)
+	assert.Equal(t, line, record.Body().Str())
+	assert.Equal(t, time.Unix(0, 1667293200000000000).UTC(), record.Timestamp().AsTime())
+	assert.Equal(t, observed, record.ObservedTimestamp().AsTime())
+	assert.Equal(t, plog.SeverityNumberError, record.SeverityNumber())
+	assert.Equal(t, "ERROR", record.SeverityText())
+	assert.Equal(t, map[string]interface{}{
+		"http.client_ip":                  "89.163.242.206",
+		"http.host":                       "example.com",
+		"http.method":                     "GET",
+		"http.target":                     "/cart?id=1",
+		"http.flavor":                     "2.0",
+		"http.response_content_length":    int64(1024),
+		"http.status_code":                int64(503),
+		"cloudflare.edge_end_timestamp":   int64(1667293201000000000),
+		"cloudflare.edge_start_timestamp": int64(1667293200000000000),
+		"cloudflare.ray_id":               "76e1b5a8bc6d2e1f",
+		"cloudflare.zone":                 "example.com",
+		"cloudflare.waf_flags":            "0",
+		"cloudflare.request_headers":      map[string]interface{}{"x-request-id": "abc"},
+	}, record.Attributes().AsRaw())
+
+	record = records.At(1)
+	assert.Equal(t, time.Date(2022, 11, 1, 9, 0, 0, 0, time.UTC), record.Timestamp().AsTime())
+	assert.Equal(t, plog.SeverityNumberWarn, record.SeverityNumber())
+}
+
+func TestToLogsInvalidLine(t *testing.T) {
+	converter := newLogConverter(createDefaultConfig().(*Config))
+	_, err := converter.toLogs([]byte("{}\n[1, 2]\n"), time.Now())
+	assert.ErrorContains(t, err, "line 2: invalid JSON object")
+}
+
+func TestParseTimestamp(t *testing.T) {
+	want := time.Date(2022, 11, 1, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		value interface{}
+	}{
+		{name: "rfc3339", value: "2022-11-01T09:00:00Z"},
+		{name: "unix", value: json.Number("1667293200")},
+		{name: "unix milliseconds", value: json.Number("1667293200000")},
+		{name: "unix microseconds", value: json.Number("1667293200000000")},
+		{name: "unixnano", value: json.Number("1667293200000000000")},
+		{name: "unix float", value: json.Number("1667293200.0")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts, ok := parseTimestamp(tt.value)
+			require.True(t, ok)
+			assert.True(t, want.Equal(ts), "got %v", ts)
+		})
+	}
+
+	_, ok := parseTimestamp("yesterday")
+	assert.False(t, ok)
+	_, ok = parseTimestamp(nil)
+	assert.False(t, ok)
+}
+
+func TestSnakeCase(t *testing.T) {
+	for field, want := range map[string]string{
+		"RayID":                     "ray_id",
+		"ClientIPClass":             "client_ip_class",
+		"EdgeColoCode":              "edge_colo_code",
+		"ClientSSLProtocol":         "client_ssl_protocol",
+		"OriginResponseHTTPExpires": "origin_response_http_expires",
+		"WAFFlags":                  "waf_flags",
+		"Kind":                      "kind",
+		"Datetime":                  "datetime",
+		"SecurityLevel2":            "security_level2",
+	} {
+		assert.Equal(t, want, snakeCase(field), field)
+	}
+}
+
+func TestIsTestBatch(t *testing.T) {
+	assert.True(t, isTestBatch([]byte("test")))
+	assert.True(t, isTestBatch([]byte(`{"content":"tests"}`+"\n")))
+	assert.False(t, isTestBatch([]byte(`{"content":"tests","RayID":"1"}`)))
+	assert.False(t, isTestBatch([]byte(`{"RayID":"1"}`)))
+}
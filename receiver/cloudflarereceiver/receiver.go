@@ -0,0 +1,227 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudflarereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/cloudflarereceiver"
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/obsreport"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultServerTimeout = 20 * time.Second
+
+	// secretHeader is the header set on the destination of the Logpush job.
+	secretHeader = "X-CF-Secret"
+)
+
+var (
+	errNilNextConsumer = errors.New("nil next consumer")
+	errEmptyEndpoint   = errors.New("empty endpoint")
+	errBodyTooLarge    = errors.New("request body too large")
+	errWrongSecret     = errors.New("missing or wrong secret")
+)
+
+// logpushReceiver implements component.LogsReceiver for the HTTP destination of
+// Cloudflare Logpush jobs.
+type logpushReceiver struct {
+	settings     component.ReceiverCreateSettings
+	config       *Config
+	logsConsumer consumer.Logs
+	converter    *logConverter
+	server       *http.Server
+	shutdownWG   sync.WaitGroup
+	obsrecv      *obsreport.Receiver
+}
+
+var _ component.LogsReceiver = (*logpushReceiver)(nil)
+
+func newLogsReceiver(
+	settings component.ReceiverCreateSettings,
+	config *Config,
+	nextConsumer consumer.Logs,
+) (component.LogsReceiver, error) {
+	if nextConsumer == nil {
+		return nil, errNilNextConsumer
+	}
+	if config.Endpoint == "" {
+		return nil, errEmptyEndpoint
+	}
+
+	transport := "http"
+	if config.TLSSetting != nil {
+		transport = "https"
+	}
+	obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{
+		ReceiverID:             config.ID(),
+		Transport:              transport,
+		ReceiverCreateSettings: settings,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &logpushReceiver{
+		settings:     settings,
+		config:       config,
+		logsConsumer: nextConsumer,
+		converter:    newLogConverter(config),
+		obsrecv:      obsrecv,
+	}, nil
+}
+
+// Start tells the receiver to start its processing.
+func (r *logpushReceiver) Start(_ context.Context, host component.Host) error {
+	if r.config.TLSSetting == nil {
+		r.settings.Logger.Warn("TLS is not configured, Cloudflare only pushes logs to HTTPS destinations, " +
+			"which requires a proxy terminating TLS in front of the receiver")
+	}
+
+	ln, err := r.config.HTTPServerSettings.ToListener()
+	if err != nil {
+		return fmt.Errorf("failed to bind to address %s: %w", r.config.Endpoint, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(r.config.Path, r.handleBatch)
+
+	r.server, err = r.config.HTTPServerSettings.ToServer(host, r.settings.TelemetrySettings, mux)
+	if err != nil {
+		return err
+	}
+	r.server.ReadHeaderTimeout = defaultServerTimeout
+	r.server.WriteTimeout = defaultServerTimeout
+
+	r.shutdownWG.Add(1)
+	go func() {
+		defer r.shutdownWG.Done()
+		if errHTTP := r.server.Serve(ln); !errors.Is(errHTTP, http.ErrServerClosed) && errHTTP != nil {
+			host.ReportFatalError(errHTTP)
+		}
+	}()
+	return nil
+}
+
+// Shutdown tells the receiver that should stop reception,
+// giving it a chance to perform any necessary clean-up.
+func (r *logpushReceiver) Shutdown(context.Context) error {
+	if r.server == nil {
+		return nil
+	}
+	err := r.server.Close()
+	r.shutdownWG.Wait()
+	return err
+}
+
+func (r *logpushReceiver) handleBatch(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx := r.obsrecv.StartLogsOp(req.Context())
+
+	if r.config.Secret != "" &&
+		subtle.ConstantTimeCompare([]byte(req.Header.Get(secretHeader)), []byte(r.config.Secret)) != 1 {
+		r.failRequest(ctx, w, http.StatusUnauthorized, errWrongSecret)
+		return
+	}
+
+	payload, err := r.readBody(req)
+	if err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, errBodyTooLarge) {
+			status = http.StatusRequestEntityTooLarge
+		}
+		r.failRequest(ctx, w, status, err)
+		return
+	}
+
+	if isTestBatch(payload) {
+		r.settings.Logger.Info("Received the test batch of a Logpush job")
+		r.obsrecv.EndLogsOp(ctx, typeStr, 0, nil)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	ld, err := r.converter.toLogs(payload, time.Now())
+	if err != nil {
+		r.failRequest(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	numRecords := ld.LogRecordCount()
+	if numRecords > 0 {
+		err = r.logsConsumer.ConsumeLogs(ctx, ld)
+	}
+	r.obsrecv.EndLogsOp(ctx, typeStr, numRecords, err)
+	if err != nil {
+		status := http.StatusServiceUnavailable
+		if consumererror.IsPermanent(err) {
+			status = http.StatusInternalServerError
+		}
+		http.Error(w, "failed to process the batch", status)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (r *logpushReceiver) failRequest(ctx context.Context, w http.ResponseWriter, status int, err error) {
+	r.obsrecv.EndLogsOp(ctx, typeStr, 0, err)
+	if r.settings.Logger.Core().Enabled(zap.DebugLevel) {
+		r.settings.Logger.Debug("Logpush batch rejected", zap.Int("status", status), zap.Error(err))
+	}
+	http.Error(w, err.Error(), status)
+}
+
+// readBody reads the batch, which Logpush compresses with gzip, up to the maximum
+// body size.
+func (r *logpushReceiver) readBody(req *http.Request) ([]byte, error) {
+	var reader io.Reader = req.Body
+	switch encoding := req.Header.Get("Content-Encoding"); encoding {
+	case "":
+	case "gzip":
+		gz, err := gzip.NewReader(req.Body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid gzip body: %w", err)
+		}
+		defer gz.Close()
+		reader = gz
+	default:
+		return nil, fmt.Errorf("unsupported content encoding %q", encoding)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(reader, r.config.MaxBodySize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read body: %w", err)
+	}
+	if int64(len(body)) > r.config.MaxBodySize {
+		return nil, errBodyTooLarge
+	}
+	return body, nil
+}
@@ -0,0 +1,211 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudflarereceiver
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
+)
+
+const testBatch = `{"ClientRequestMethod":"GET","EdgeResponseStatus":200,"EdgeStartTimestamp":1667293200000000000,"RayID":"1"}
+{"ClientRequestMethod":"POST","EdgeResponseStatus":500,"EdgeStartTimestamp":1667293201000000000,"RayID":"2"}
+`
+
+func gzipped(t *testing.T, s string) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err := gz.Write([]byte(s))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func newTestReceiver(t *testing.T, cfg *Config, sink *consumertest.LogsSink) *logpushReceiver {
+	r, err := newLogsReceiver(componenttest.NewNopReceiverCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	return r.(*logpushReceiver)
+}
+
+func postBatch(r *logpushReceiver, body []byte, header http.Header) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, defaultPath, bytes.NewReader(body))
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	rec := httptest.NewRecorder()
+	r.handleBatch(rec, req)
+	return rec
+}
+
+func TestHandleBatch(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Secret = "s3cr3t"
+	sink := new(consumertest.LogsSink)
+	r := newTestReceiver(t, cfg, sink)
+
+	rec := postBatch(r, gzipped(t, testBatch), http.Header{
+		"Content-Encoding": {"gzip"},
+		"X-Cf-Secret":      {"s3cr3t"},
+	})
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	require.Len(t, sink.AllLogs(), 1)
+	records := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 2, records.Len())
+	method, _ := records.At(1).Attributes().Get("http.method")
+	assert.Equal(t, "POST", method.Str())
+	rayID, _ := records.At(1).Attributes().Get("cloudflare.ray_id")
+	assert.Equal(t, "2", rayID.Str())
+}
+
+func TestHandleTestBatch(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	r := newTestReceiver(t, createDefaultConfig().(*Config), sink)
+
+	rec := postBatch(r, gzipped(t, `{"content":"tests"}`), http.Header{"Content-Encoding": {"gzip"}})
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Zero(t, sink.LogRecordCount())
+}
+
+func TestHandleBatchErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		body       string
+		header     http.Header
+		wantStatus int
+	}{
+		{
+			name:       "invalid method",
+			method:     http.MethodGet,
+			header:     http.Header{"X-Cf-Secret": {"s3cr3t"}},
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:       "missing secret",
+			body:       testBatch,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "wrong secret",
+			body:       testBatch,
+			header:     http.Header{"X-Cf-Secret": {"secret"}},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "invalid gzip",
+			body:       testBatch,
+			header:     http.Header{"X-Cf-Secret": {"s3cr3t"}, "Content-Encoding": {"gzip"}},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid JSON",
+			body:       `{"RayID":`,
+			header:     http.Header{"X-Cf-Secret": {"s3cr3t"}},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "body too large",
+			body:       strings.Repeat(testBatch, 100),
+			header:     http.Header{"X-Cf-Secret": {"s3cr3t"}},
+			wantStatus: http.StatusRequestEntityTooLarge,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Secret = "s3cr3t"
+			cfg.MaxBodySize = 1024
+			sink := new(consumertest.LogsSink)
+			r := newTestReceiver(t, cfg, sink)
+
+			method := tt.method
+			if method == "" {
+				method = http.MethodPost
+			}
+			req := httptest.NewRequest(method, defaultPath, strings.NewReader(tt.body))
+			for name, values := range tt.header {
+				req.Header[name] = values
+			}
+			rec := httptest.NewRecorder()
+			r.handleBatch(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Zero(t, sink.LogRecordCount())
+		})
+	}
+}
+
+func TestHandleBatchConsumerError(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{
+			name:       "retryable",
+			err:        errors.New("queue is full"),
+			wantStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:       "permanent",
+			err:        consumererror.NewPermanent(errors.New("invalid data")),
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := newLogsReceiver(componenttest.NewNopReceiverCreateSettings(), createDefaultConfig().(*Config), consumertest.NewErr(tt.err))
+			require.NoError(t, err)
+
+			rec := postBatch(r.(*logpushReceiver), []byte(testBatch), nil)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+		})
+	}
+}
+
+func TestReceiverLifecycle(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = testutil.GetAvailableLocalAddress(t)
+	sink := new(consumertest.LogsSink)
+	r := newTestReceiver(t, cfg, sink)
+
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, r.Shutdown(context.Background()))
+	}()
+
+	req, err := http.NewRequest(http.MethodPost, "http://"+cfg.Endpoint+defaultPath, bytes.NewReader(gzipped(t, testBatch)))
+	require.NoError(t, err)
+	req.Header.Set("Content-Encoding", "gzip")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 2, sink.LogRecordCount())
+}
@@ -0,0 +1,277 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudfoundryreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/cloudfoundryreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/go-loggregator/rpc/loggregator_v2"
+	"go.uber.org/zap"
+)
+
+const (
+	tagAppID            = "app_id"
+	tagAppName          = "app_name"
+	tagSpaceID          = "space_id"
+	tagSpaceName        = "space_name"
+	tagOrganizationID   = "organization_id"
+	tagOrganizationName = "organization_name"
+)
+
+// guidPattern matches Cloud Foundry GUIDs, used to tell application source IDs apart from platform component names.
+var guidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// appMetadata holds the names resolved from the Cloud Controller API for a single application.
+type appMetadata struct {
+	appName          string
+	spaceID          string
+	spaceName        string
+	organizationID   string
+	organizationName string
+}
+
+type appMetadataEntry struct {
+	metadata *appMetadata
+	expiry   time.Time
+}
+
+// pendingLookup is a lookup in progress, which concurrent lookups of the same application wait for.
+type pendingLookup struct {
+	done     chan struct{}
+	metadata *appMetadata
+}
+
+// capiAppResponse is the subset of the `GET /v3/apps/:guid?include=space.organization` response used by the receiver.
+type capiAppResponse struct {
+	Name          string `json:"name"`
+	Relationships struct {
+		Space struct {
+			Data struct {
+				GUID string `json:"guid"`
+			} `json:"data"`
+		} `json:"space"`
+	} `json:"relationships"`
+	Included struct {
+		Spaces []struct {
+			GUID          string `json:"guid"`
+			Name          string `json:"name"`
+			Relationships struct {
+				Organization struct {
+					Data struct {
+						GUID string `json:"guid"`
+					} `json:"data"`
+				} `json:"organization"`
+			} `json:"relationships"`
+		} `json:"spaces"`
+		Organizations []struct {
+			GUID string `json:"guid"`
+			Name string `json:"name"`
+		} `json:"organizations"`
+	} `json:"included"`
+}
+
+// appMetadataProvider resolves application, space and organization names from the Cloud Controller API and caches
+// the results, including failed lookups, for the configured TTL. Expired entries are evicted at most once per TTL,
+// so that the cache only holds the applications seen recently.
+type appMetadataProvider struct {
+	logger        *zap.Logger
+	endpoint      string
+	client        *http.Client
+	tokenProvider func() (string, error)
+	ttl           time.Duration
+	now           func() time.Time
+
+	mutex   sync.Mutex
+	cache   map[string]appMetadataEntry
+	pending map[string]*pendingLookup
+	// nextEviction is the time after which the expired entries are evicted from the cache
+	nextEviction time.Time
+}
+
+func newAppMetadataProvider(
+	logger *zap.Logger,
+	endpoint string,
+	client *http.Client,
+	tokenProvider func() (string, error),
+	ttl time.Duration) *appMetadataProvider {
+
+	return &appMetadataProvider{
+		logger:        logger,
+		endpoint:      strings.TrimSuffix(endpoint, "/"),
+		client:        client,
+		tokenProvider: tokenProvider,
+		ttl:           ttl,
+		now:           time.Now,
+		cache:         map[string]appMetadataEntry{},
+		pending:       map[string]*pendingLookup{},
+	}
+}
+
+// enrich adds the application, space and organization names and GUIDs to the envelope tags, unless they are
+// already present. Envelopes which do not originate from an application are left untouched.
+func (amp *appMetadataProvider) enrich(ctx context.Context, envelope *loggregator_v2.Envelope) {
+	if _, ok := envelope.Tags[tagAppName]; ok {
+		return
+	}
+
+	appID := envelope.Tags[tagAppID]
+	if appID == "" {
+		appID = envelope.SourceId
+	}
+
+	if !guidPattern.MatchString(appID) {
+		return
+	}
+
+	metadata := amp.lookup(ctx, appID)
+	if metadata == nil {
+		return
+	}
+
+	if envelope.Tags == nil {
+		envelope.Tags = map[string]string{}
+	}
+
+	setTagIfMissing(envelope.Tags, tagAppID, appID)
+	setTagIfMissing(envelope.Tags, tagAppName, metadata.appName)
+	setTagIfMissing(envelope.Tags, tagSpaceID, metadata.spaceID)
+	setTagIfMissing(envelope.Tags, tagSpaceName, metadata.spaceName)
+	setTagIfMissing(envelope.Tags, tagOrganizationID, metadata.organizationID)
+	setTagIfMissing(envelope.Tags, tagOrganizationName, metadata.organizationName)
+}
+
+// lookup returns the cached metadata of the application, or fetches it. The lock is not held while fetching, so
+// that a slow Cloud Controller only delays the envelopes of the application being looked up, and concurrent lookups
+// of the same application wait for the pending one instead of fetching the metadata again.
+func (amp *appMetadataProvider) lookup(ctx context.Context, appID string) *appMetadata {
+	amp.mutex.Lock()
+	if entry, ok := amp.cache[appID]; ok && amp.now().Before(entry.expiry) {
+		amp.mutex.Unlock()
+		return entry.metadata
+	}
+	if pending, ok := amp.pending[appID]; ok {
+		amp.mutex.Unlock()
+		select {
+		case <-pending.done:
+			return pending.metadata
+		case <-ctx.Done():
+			return nil
+		}
+	}
+	pending := &pendingLookup{done: make(chan struct{})}
+	amp.pending[appID] = pending
+	amp.mutex.Unlock()
+
+	metadata, err := amp.fetch(ctx, appID)
+	if err != nil {
+		amp.logger.Debug("fetching cloud foundry application metadata", zap.String("app_id", appID), zap.Error(err))
+	}
+
+	amp.mutex.Lock()
+	now := amp.now()
+	amp.evictExpired(now)
+	// Failed lookups are cached as well so that the Cloud Controller is not queried for every envelope of an
+	// application which it does not know about.
+	amp.cache[appID] = appMetadataEntry{metadata: metadata, expiry: now.Add(amp.ttl)}
+	delete(amp.pending, appID)
+	amp.mutex.Unlock()
+
+	pending.metadata = metadata
+	close(pending.done)
+	return metadata
+}
+
+// evictExpired removes the expired entries from the cache, unless they were already removed less than a TTL ago.
+// It must be called with the lock held.
+func (amp *appMetadataProvider) evictExpired(now time.Time) {
+	if now.Before(amp.nextEviction) {
+		return
+	}
+	for appID, entry := range amp.cache {
+		if !now.Before(entry.expiry) {
+			delete(amp.cache, appID)
+		}
+	}
+	amp.nextEviction = now.Add(amp.ttl)
+}
+
+func (amp *appMetadataProvider) fetch(ctx context.Context, appID string) (*appMetadata, error) {
+	token, err := amp.tokenProvider()
+	if err != nil {
+		return nil, fmt.Errorf("obtaining authentication token: %w", err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		fmt.Sprintf("%s/v3/apps/%s?include=space.organization", amp.endpoint, appID), nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Authorization", token)
+	request.Header.Set("Accept", "application/json")
+
+	response, err := amp.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d from cloud controller", response.StatusCode)
+	}
+
+	var app capiAppResponse
+	if err = json.NewDecoder(response.Body).Decode(&app); err != nil {
+		return nil, fmt.Errorf("decoding cloud controller response: %w", err)
+	}
+
+	metadata := &appMetadata{
+		appName: app.Name,
+		spaceID: app.Relationships.Space.Data.GUID,
+	}
+
+	for _, space := range app.Included.Spaces {
+		if space.GUID == metadata.spaceID {
+			metadata.spaceName = space.Name
+			metadata.organizationID = space.Relationships.Organization.Data.GUID
+			break
+		}
+	}
+
+	for _, organization := range app.Included.Organizations {
+		if organization.GUID == metadata.organizationID {
+			metadata.organizationName = organization.Name
+			break
+		}
+	}
+
+	return metadata, nil
+}
+
+func setTagIfMissing(tags map[string]string, key string, value string) {
+	if value == "" {
+		return
+	}
+
+	if _, ok := tags[key]; !ok {
+		tags[key] = value
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudfoundryreceiver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"code.cloudfoundry.org/go-loggregator/rpc/loggregator_v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const (
+	testAppID       = "5db33854-09a4-4519-ba71-af33a878df6f"
+	testAppResponse = `{
+  "guid": "5db33854-09a4-4519-ba71-af33a878df6f",
+  "name": "checkout",
+  "relationships": {"space": {"data": {"guid": "space-guid"}}},
+  "included": {
+    "spaces": [
+      {"guid": "space-guid", "name": "production", "relationships": {"organization": {"data": {"guid": "org-guid"}}}}
+    ],
+    "organizations": [
+      {"guid": "org-guid", "name": "acme"}
+    ]
+  }
+}`
+)
+
+func newTestAppMetadataProvider(t *testing.T, handler http.HandlerFunc) (*appMetadataProvider, *int) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	tokenProvider := func() (string, error) { return "bearer token", nil }
+	return newAppMetadataProvider(zap.NewNop(), server.URL, server.Client(), tokenProvider, time.Minute), &requests
+}
+
+func TestAppMetadataEnrichment(t *testing.T) {
+	provider, requests := newTestAppMetadataProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/apps/"+testAppID, r.URL.Path)
+		assert.Equal(t, "space.organization", r.URL.Query().Get("include"))
+		assert.Equal(t, "bearer token", r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(testAppResponse))
+	})
+
+	envelope := &loggregator_v2.Envelope{
+		SourceId: testAppID,
+		Tags:     map[string]string{"origin": "rep"},
+	}
+	provider.enrich(context.Background(), envelope)
+
+	assert.Equal(t, map[string]string{
+		"origin":            "rep",
+		"app_id":            testAppID,
+		"app_name":          "checkout",
+		"space_id":          "space-guid",
+		"space_name":        "production",
+		"organization_id":   "org-guid",
+		"organization_name": "acme",
+	}, envelope.Tags)
+
+	// a second envelope from the same application is served from the cache
+	envelope = &loggregator_v2.Envelope{SourceId: testAppID}
+	provider.enrich(context.Background(), envelope)
+	assert.Equal(t, "checkout", envelope.Tags["app_name"])
+	assert.Equal(t, 1, *requests)
+}
+
+func TestAppMetadataCacheExpiry(t *testing.T) {
+	provider, requests := newTestAppMetadataProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testAppResponse))
+	})
+
+	now := time.Now()
+	provider.now = func() time.Time { return now }
+
+	provider.enrich(context.Background(), &loggregator_v2.Envelope{SourceId: testAppID})
+	provider.enrich(context.Background(), &loggregator_v2.Envelope{SourceId: testAppID})
+	require.Equal(t, 1, *requests)
+
+	now = now.Add(2 * time.Minute)
+	provider.enrich(context.Background(), &loggregator_v2.Envelope{SourceId: testAppID})
+	assert.Equal(t, 2, *requests)
+}
+
+func TestAppMetadataCacheEviction(t *testing.T) {
+	provider, _ := newTestAppMetadataProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	now := time.Now()
+	provider.now = func() time.Time { return now }
+
+	provider.enrich(context.Background(), &loggregator_v2.Envelope{SourceId: testAppID})
+	require.Len(t, provider.cache, 1)
+
+	// the expired entry is evicted when the metadata of another application is cached
+	otherAppID := "0b6c4c2c-5a6f-4b8e-9a57-0c1b4f8a1c2d"
+	now = now.Add(2 * time.Minute)
+	provider.enrich(context.Background(), &loggregator_v2.Envelope{SourceId: otherAppID})
+	require.Len(t, provider.cache, 1)
+	assert.Contains(t, provider.cache, otherAppID)
+}
+
+func TestAppMetadataSkipsNonApplicationEnvelopes(t *testing.T) {
+	provider, requests := newTestAppMetadataProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testAppResponse))
+	})
+
+	// platform component envelopes do not have a GUID source ID
+	envelope := &loggregator_v2.Envelope{SourceId: "gorouter"}
+	provider.enrich(context.Background(), envelope)
+	assert.Empty(t, envelope.Tags)
+
+	// names already provided by the platform are kept
+	envelope = &loggregator_v2.Envelope{
+		SourceId: testAppID,
+		Tags:     map[string]string{"app_name": "from-platform"},
+	}
+	provider.enrich(context.Background(), envelope)
+	assert.Equal(t, map[string]string{"app_name": "from-platform"}, envelope.Tags)
+	assert.Equal(t, 0, *requests)
+}
+
+func TestAppMetadataNegativeCache(t *testing.T) {
+	provider, requests := newTestAppMetadataProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	envelope := &loggregator_v2.Envelope{SourceId: testAppID}
+	provider.enrich(context.Background(), envelope)
+	provider.enrich(context.Background(), envelope)
+
+	assert.Empty(t, envelope.Tags)
+	assert.Equal(t, 1, *requests)
+}
+
+func TestAppMetadataConcurrentLookups(t *testing.T) {
+	const otherAppID = "7a8b5c13-3b1e-4d2f-9c6e-0f1d2e3a4b5c"
+	var requests int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if r.URL.Path == "/v3/apps/"+testAppID {
+			// the Cloud Controller is slow to answer for this application
+			<-release
+		}
+		_, _ = w.Write([]byte(testAppResponse))
+	}))
+	t.Cleanup(server.Close)
+	tokenProvider := func() (string, error) { return "bearer token", nil }
+	provider := newAppMetadataProvider(zap.NewNop(), server.URL, server.Client(), tokenProvider, time.Minute)
+
+	var wg sync.WaitGroup
+	envelopes := make([]*loggregator_v2.Envelope, 5)
+	for i := range envelopes {
+		envelopes[i] = &loggregator_v2.Envelope{SourceId: testAppID}
+		wg.Add(1)
+		go func(envelope *loggregator_v2.Envelope) {
+			defer wg.Done()
+			provider.enrich(context.Background(), envelope)
+		}(envelopes[i])
+	}
+
+	// lookups of other applications are not blocked by the pending lookup
+	other := &loggregator_v2.Envelope{SourceId: otherAppID}
+	provider.enrich(context.Background(), other)
+	assert.Equal(t, "checkout", other.Tags[tagAppName])
+
+	close(release)
+	wg.Wait()
+	for _, envelope := range envelopes {
+		assert.Equal(t, "checkout", envelope.Tags[tagAppName])
+	}
+	// the concurrent lookups of the same application share a single request
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
+
+func TestAppMetadataLookupCanceled(t *testing.T) {
+	release := make(chan struct{})
+	provider, _ := newTestAppMetadataProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		_, _ = w.Write([]byte(testAppResponse))
+	})
+	defer close(release)
+
+	go provider.lookup(context.Background(), testAppID)
+	require.Eventually(t, func() bool {
+		provider.mutex.Lock()
+		defer provider.mutex.Unlock()
+		return len(provider.pending) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// a lookup waiting for a pending one gives up once its context is done
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Nil(t, provider.lookup(ctx, testAppID))
+}
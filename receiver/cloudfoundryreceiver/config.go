@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"time"
 
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/config/confighttp"
@@ -46,11 +47,20 @@ type UAAConfig struct {
 	Password                  string `mapstructure:"password"`
 }
 
+// CAPIConfig configures the lookup of application, space and organization names from the Cloud Controller API.
+// The lookup is disabled when no endpoint is set.
+type CAPIConfig struct {
+	confighttp.HTTPClientSettings `mapstructure:",squash"`
+	// CacheTTL is how long resolved (or missing) application metadata is kept before being fetched again.
+	CacheTTL time.Duration `mapstructure:"cache_ttl"`
+}
+
 // Config defines configuration for Collectd receiver.
 type Config struct {
 	config.ReceiverSettings `mapstructure:",squash"`
 	RLPGateway              RLPGatewayConfig `mapstructure:"rlp_gateway"`
 	UAA                     UAAConfig        `mapstructure:"uaa"`
+	CAPI                    CAPIConfig       `mapstructure:"capi"`
 }
 
 func (c *Config) Validate() error {
@@ -72,6 +82,16 @@ func (c *Config) Validate() error {
 		return errors.New("UAA password not specified")
 	}
 
+	if c.CAPI.Endpoint != "" {
+		if err = validateURLOption("capi.endpoint", c.CAPI.Endpoint); err != nil {
+			return err
+		}
+
+		if c.CAPI.CacheTTL <= 0 {
+			return errors.New("capi.cache_ttl must be positive")
+		}
+	}
+
 	return nil
 }
 
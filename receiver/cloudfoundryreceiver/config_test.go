@@ -64,6 +64,12 @@ func TestLoadConfig(t *testing.T) {
 					Username: "admin",
 					Password: "test",
 				},
+				CAPI: CAPIConfig{
+					HTTPClientSettings: confighttp.HTTPClientSettings{
+						Endpoint: "https://api.sys.example.internal",
+					},
+					CacheTTL: 10 * time.Minute,
+				},
 			},
 		},
 		{
@@ -74,6 +80,10 @@ func TestLoadConfig(t *testing.T) {
 			id:           component.NewIDWithName(typeStr, "invalid"),
 			errorMessage: "failed to parse rlp_gateway.endpoint as url: parse \"https://[invalid\": missing ']' in host",
 		},
+		{
+			id:           component.NewIDWithName(typeStr, "invalidcapi"),
+			errorMessage: "failed to parse capi.endpoint as url: parse \"https://[invalid\": missing ']' in host",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.String(), func(t *testing.T) {
@@ -110,6 +120,17 @@ func TestInvalidConfigValidation(t *testing.T) {
 	configuration = loadSuccessfulConfig(t)
 	configuration.UAA.Endpoint = "https://[invalid"
 	require.Error(t, configuration.Validate())
+
+	configuration = loadSuccessfulConfig(t)
+	configuration.CAPI.Endpoint = "https://api.sys.example.internal"
+	configuration.CAPI.CacheTTL = -time.Second
+	require.EqualError(t, configuration.Validate(), "capi.cache_ttl must be positive")
+
+	// a cache TTL of zero would query the Cloud Controller for every envelope
+	configuration = loadSuccessfulConfig(t)
+	configuration.CAPI.Endpoint = "https://api.sys.example.internal"
+	configuration.CAPI.CacheTTL = 0
+	require.EqualError(t, configuration.Validate(), "capi.cache_ttl must be positive")
 }
 
 func TestHTTPConfigurationStructConsistency(t *testing.T) {
@@ -19,6 +19,7 @@ import (
 
 	"code.cloudfoundry.org/go-loggregator/rpc/loggregator_v2"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 )
 
@@ -52,6 +53,29 @@ func convertEnvelopeToMetrics(envelope *loggregator_v2.Envelope, metricSlice pme
 	}
 }
 
+func convertEnvelopeToLogs(envelope *loggregator_v2.Envelope, logSlice plog.LogRecordSlice, observedTime time.Time) {
+	message, ok := envelope.Message.(*loggregator_v2.Envelope_Log)
+	if !ok {
+		return
+	}
+
+	logRecord := logSlice.AppendEmpty()
+	logRecord.SetTimestamp(pcommon.Timestamp(envelope.GetTimestamp()))
+	logRecord.SetObservedTimestamp(pcommon.NewTimestampFromTime(observedTime))
+	logRecord.Body().SetStr(string(message.Log.GetPayload()))
+
+	// Cloud Foundry only distinguishes between the stdout and stderr streams of the application
+	logRecord.SetSeverityText(message.Log.GetType().String())
+	switch message.Log.GetType() {
+	case loggregator_v2.Log_OUT:
+		logRecord.SetSeverityNumber(plog.SeverityNumberInfo)
+	case loggregator_v2.Log_ERR:
+		logRecord.SetSeverityNumber(plog.SeverityNumberError)
+	}
+
+	copyEnvelopeAttributes(logRecord.Attributes(), envelope)
+}
+
 func copyEnvelopeAttributes(attributes pcommon.Map, envelope *loggregator_v2.Envelope) {
 	for key, value := range envelope.Tags {
 		attributes.PutStr(attributeNamePrefix+key, value)
@@ -16,6 +16,7 @@ package cloudfoundryreceiver // import "github.com/open-telemetry/opentelemetry-
 
 import (
 	"context"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
@@ -29,9 +30,11 @@ import (
 const (
 	typeStr                  = "cloudfoundry"
 	stability                = component.StabilityLevelBeta
+	logsStability            = component.StabilityLevelInDevelopment
 	defaultUAAUsername       = "admin"
 	defaultRLPGatewayShardID = "opentelemetry"
 	defaultURL               = "https://localhost"
+	defaultCAPICacheTTL      = 5 * time.Minute
 )
 
 // NewFactory creates a factory for collectd receiver.
@@ -39,7 +42,8 @@ func NewFactory() component.ReceiverFactory {
 	return component.NewReceiverFactory(
 		typeStr,
 		createDefaultConfig,
-		component.WithMetricsReceiver(createMetricsReceiver, stability))
+		component.WithMetricsReceiver(createMetricsReceiver, stability),
+		component.WithLogsReceiver(createLogsReceiver, logsStability))
 }
 
 func createDefaultConfig() component.ReceiverConfig {
@@ -63,6 +67,9 @@ func createDefaultConfig() component.ReceiverConfig {
 			},
 			Username: defaultUAAUsername,
 		},
+		CAPI: CAPIConfig{
+			CacheTTL: defaultCAPICacheTTL,
+		},
 	}
 }
 
@@ -75,3 +82,13 @@ func createMetricsReceiver(
 	c := cfg.(*Config)
 	return newCloudFoundryReceiver(params, *c, nextConsumer)
 }
+
+func createLogsReceiver(
+	_ context.Context,
+	params component.ReceiverCreateSettings,
+	cfg component.ReceiverConfig,
+	nextConsumer consumer.Logs,
+) (component.LogsReceiver, error) {
+	c := cfg.(*Config)
+	return newCloudFoundryLogsReceiver(params, *c, nextConsumer)
+}
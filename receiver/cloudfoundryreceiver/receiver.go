@@ -22,9 +22,11 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/go-loggregator"
+	"code.cloudfoundry.org/go-loggregator/rpc/loggregator_v2"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/obsreport"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 )
 
@@ -35,19 +37,22 @@ const (
 )
 
 var _ component.MetricsReceiver = (*cloudFoundryReceiver)(nil)
+var _ component.LogsReceiver = (*cloudFoundryReceiver)(nil)
 
-// newCloudFoundryReceiver implements the component.MetricsReceiver for Cloud Foundry protocol.
+// newCloudFoundryReceiver implements the component.MetricsReceiver and component.LogsReceiver for Cloud Foundry protocol.
 type cloudFoundryReceiver struct {
 	settings          component.TelemetrySettings
 	cancel            context.CancelFunc
 	config            Config
-	nextConsumer      consumer.Metrics
+	nextMetrics       consumer.Metrics
+	nextLogs          consumer.Logs
 	obsrecv           *obsreport.Receiver
 	goroutines        sync.WaitGroup
 	receiverStartTime time.Time
+	appMetadata       *appMetadataProvider
 }
 
-// newCloudFoundryReceiver creates the Cloud Foundry receiver with the given parameters.
+// newCloudFoundryReceiver creates the Cloud Foundry metrics receiver with the given parameters.
 func newCloudFoundryReceiver(
 	settings component.ReceiverCreateSettings,
 	config Config,
@@ -57,6 +62,33 @@ func newCloudFoundryReceiver(
 		return nil, component.ErrNilNextConsumer
 	}
 
+	cfr, err := newReceiver(settings, config)
+	if err != nil {
+		return nil, err
+	}
+	cfr.nextMetrics = nextConsumer
+	return cfr, nil
+}
+
+// newCloudFoundryLogsReceiver creates the Cloud Foundry logs receiver with the given parameters.
+func newCloudFoundryLogsReceiver(
+	settings component.ReceiverCreateSettings,
+	config Config,
+	nextConsumer consumer.Logs) (component.LogsReceiver, error) {
+
+	if nextConsumer == nil {
+		return nil, component.ErrNilNextConsumer
+	}
+
+	cfr, err := newReceiver(settings, config)
+	if err != nil {
+		return nil, err
+	}
+	cfr.nextLogs = nextConsumer
+	return cfr, nil
+}
+
+func newReceiver(settings component.ReceiverCreateSettings, config Config) (*cloudFoundryReceiver, error) {
 	obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{
 		ReceiverID:             config.ID(),
 		Transport:              transport,
@@ -69,7 +101,6 @@ func newCloudFoundryReceiver(
 	return &cloudFoundryReceiver{
 		settings:          settings.TelemetrySettings,
 		config:            config,
-		nextConsumer:      nextConsumer,
 		obsrecv:           obsrecv,
 		receiverStartTime: time.Now(),
 	}, nil
@@ -91,6 +122,26 @@ func (cfr *cloudFoundryReceiver) Start(ctx context.Context, host component.Host)
 		return fmt.Errorf("creating cloud foundry RLP envelope stream factory: %w", streamErr)
 	}
 
+	if cfr.config.CAPI.Endpoint != "" {
+		capiClient, err := cfr.config.CAPI.ToClient(host, cfr.settings)
+		if err != nil {
+			return fmt.Errorf("creating HTTP client for Cloud Foundry Cloud Controller API: %w", err)
+		}
+
+		cfr.appMetadata = newAppMetadataProvider(
+			cfr.settings.Logger,
+			cfr.config.CAPI.Endpoint,
+			capiClient,
+			tokenProvider.ProvideToken,
+			cfr.config.CAPI.CacheTTL,
+		)
+	}
+
+	selectors := metricSelectors()
+	if cfr.nextLogs != nil {
+		selectors = logSelectors()
+	}
+
 	innerCtx, cancel := context.WithCancel(context.Background())
 	cfr.cancel = cancel
 
@@ -106,12 +157,18 @@ func (cfr *cloudFoundryReceiver) Start(ctx context.Context, host component.Host)
 			return
 		}
 
-		envelopeStream, err := streamFactory.CreateStream(innerCtx, cfr.config.RLPGateway.ShardID)
+		envelopeStream, err := streamFactory.CreateStream(innerCtx, cfr.config.RLPGateway.ShardID, selectors)
 		if err != nil {
 			host.ReportFatalError(fmt.Errorf("creating RLP gateway envelope stream: %w", err))
 			return
 		}
 
+		if cfr.nextLogs != nil {
+			cfr.streamLogs(innerCtx, envelopeStream, host)
+			cfr.settings.Logger.Debug("cloudfoundry logs streamer stopped")
+			return
+		}
+
 		cfr.streamMetrics(innerCtx, envelopeStream, host)
 		cfr.settings.Logger.Debug("cloudfoundry metrics streamer stopped")
 	}()
@@ -120,7 +177,9 @@ func (cfr *cloudFoundryReceiver) Start(ctx context.Context, host component.Host)
 }
 
 func (cfr *cloudFoundryReceiver) Shutdown(_ context.Context) error {
-	cfr.cancel()
+	if cfr.cancel != nil {
+		cfr.cancel()
+	}
 	cfr.goroutines.Wait()
 	return nil
 }
@@ -134,11 +193,7 @@ func (cfr *cloudFoundryReceiver) streamMetrics(
 		// Blocks until non-empty result or context is cancelled (returns nil in that case)
 		envelopes := stream()
 		if envelopes == nil {
-			// If context has not been cancelled, then nil means the shutdown was due to an error within stream
-			if ctx.Err() == nil {
-				host.ReportFatalError(errors.New("RLP gateway streamer shut down due to an error"))
-			}
-
+			cfr.reportStreamShutdown(ctx, host)
 			break
 		}
 
@@ -147,6 +202,7 @@ func (cfr *cloudFoundryReceiver) streamMetrics(
 
 		for _, envelope := range envelopes {
 			if envelope != nil {
+				cfr.enrich(ctx, envelope)
 				// There is no concept of startTime in CF loggregator, and we do not know the uptime of the component
 				// from which the metric originates, so just provide receiver start time as metric start time
 				convertEnvelopeToMetrics(envelope, libraryMetrics, cfr.receiverStartTime)
@@ -155,12 +211,57 @@ func (cfr *cloudFoundryReceiver) streamMetrics(
 
 		if libraryMetrics.Len() > 0 {
 			obsCtx := cfr.obsrecv.StartMetricsOp(ctx)
-			err := cfr.nextConsumer.ConsumeMetrics(ctx, metrics)
+			err := cfr.nextMetrics.ConsumeMetrics(ctx, metrics)
 			cfr.obsrecv.EndMetricsOp(obsCtx, dataFormat, metrics.DataPointCount(), err)
 		}
 	}
 }
 
+func (cfr *cloudFoundryReceiver) streamLogs(
+	ctx context.Context,
+	stream loggregator.EnvelopeStream,
+	host component.Host) {
+
+	for {
+		// Blocks until non-empty result or context is cancelled (returns nil in that case)
+		envelopes := stream()
+		if envelopes == nil {
+			cfr.reportStreamShutdown(ctx, host)
+			break
+		}
+
+		logs := plog.NewLogs()
+		libraryLogs := createLibraryLogsSlice(logs)
+		observedTime := time.Now()
+
+		for _, envelope := range envelopes {
+			if envelope != nil {
+				cfr.enrich(ctx, envelope)
+				convertEnvelopeToLogs(envelope, libraryLogs, observedTime)
+			}
+		}
+
+		if libraryLogs.Len() > 0 {
+			obsCtx := cfr.obsrecv.StartLogsOp(ctx)
+			err := cfr.nextLogs.ConsumeLogs(ctx, logs)
+			cfr.obsrecv.EndLogsOp(obsCtx, dataFormat, logs.LogRecordCount(), err)
+		}
+	}
+}
+
+func (cfr *cloudFoundryReceiver) enrich(ctx context.Context, envelope *loggregator_v2.Envelope) {
+	if cfr.appMetadata != nil {
+		cfr.appMetadata.enrich(ctx, envelope)
+	}
+}
+
+func (cfr *cloudFoundryReceiver) reportStreamShutdown(ctx context.Context, host component.Host) {
+	// If context has not been cancelled, then nil means the shutdown was due to an error within stream
+	if ctx.Err() == nil {
+		host.ReportFatalError(errors.New("RLP gateway streamer shut down due to an error"))
+	}
+}
+
 func createLibraryMetricsSlice(metrics pmetric.Metrics) pmetric.MetricSlice {
 	resourceMetrics := metrics.ResourceMetrics()
 	resourceMetric := resourceMetrics.AppendEmpty()
@@ -170,3 +271,10 @@ func createLibraryMetricsSlice(metrics pmetric.Metrics) pmetric.MetricSlice {
 	libraryMetrics.Scope().SetName(instrumentationLibName)
 	return libraryMetrics.Metrics()
 }
+
+func createLibraryLogsSlice(logs plog.Logs) plog.LogRecordSlice {
+	resourceLogs := logs.ResourceLogs().AppendEmpty()
+	libraryLogs := resourceLogs.ScopeLogs().AppendEmpty()
+	libraryLogs.Scope().SetName(instrumentationLibName)
+	return libraryLogs.LogRecords()
+}
@@ -63,3 +63,26 @@ func TestInvalidConsumer(t *testing.T) {
 	require.EqualError(t, err, "nil next Consumer")
 	require.Nil(t, receiver, "receiver creation failed")
 }
+
+// Test to make sure a new logs receiver can be created properly, started and shutdown with the default config
+func TestDefaultValidLogsReceiver(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	params := componenttest.NewNopReceiverCreateSettings()
+
+	receiver, err := newCloudFoundryLogsReceiver(
+		params,
+		*cfg,
+		consumertest.NewNop(),
+	)
+
+	require.NoError(t, err)
+	require.NotNil(t, receiver, "receiver creation failed")
+
+	ctx := context.Background()
+	err = receiver.Start(ctx, componenttest.NewNopHost())
+	require.NoError(t, err)
+
+	err = receiver.Shutdown(ctx)
+	require.NoError(t, err)
+}
@@ -57,29 +57,46 @@ func newEnvelopeStreamFactory(
 
 func (rgc *EnvelopeStreamFactory) CreateStream(
 	ctx context.Context,
-	shardID string) (loggregator.EnvelopeStream, error) {
+	shardID string,
+	selectors []*loggregator_v2.Selector) (loggregator.EnvelopeStream, error) {
 
 	if strings.TrimSpace(shardID) == "" {
 		return nil, errors.New("shardID cannot be empty")
 	}
 
 	stream := rgc.rlpGatewayClient.Stream(ctx, &loggregator_v2.EgressBatchRequest{
-		ShardId: shardID,
-		Selectors: []*loggregator_v2.Selector{
-			{
-				Message: &loggregator_v2.Selector_Counter{
-					Counter: &loggregator_v2.CounterSelector{},
-				},
+		ShardId:   shardID,
+		Selectors: selectors,
+	})
+
+	return stream, nil
+}
+
+// metricSelectors selects the envelope types which are converted to metrics.
+func metricSelectors() []*loggregator_v2.Selector {
+	return []*loggregator_v2.Selector{
+		{
+			Message: &loggregator_v2.Selector_Counter{
+				Counter: &loggregator_v2.CounterSelector{},
 			},
-			{
-				Message: &loggregator_v2.Selector_Gauge{
-					Gauge: &loggregator_v2.GaugeSelector{},
-				},
+		},
+		{
+			Message: &loggregator_v2.Selector_Gauge{
+				Gauge: &loggregator_v2.GaugeSelector{},
 			},
 		},
-	})
+	}
+}
 
-	return stream, nil
+// logSelectors selects the envelope types which are converted to logs.
+func logSelectors() []*loggregator_v2.Selector {
+	return []*loggregator_v2.Selector{
+		{
+			Message: &loggregator_v2.Selector_Log{
+				Log: &loggregator_v2.LogSelector{},
+			},
+		},
+	}
 }
 
 type authorizationProvider struct {
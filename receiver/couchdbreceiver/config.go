@@ -26,7 +26,14 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/couchdbreceiver/internal/metadata"
 )
 
-const defaultEndpoint = "http://localhost:5984"
+const (
+	defaultEndpoint = "http://localhost:5984"
+
+	// granularityDetailed reports the requests by HTTP method and the responses by status code.
+	granularityDetailed = "detailed"
+	// granularityAggregated reports the total requests and the responses by status class.
+	granularityAggregated = "aggregated"
+)
 
 var (
 	// Errors for missing required config fields.
@@ -35,6 +42,8 @@ var (
 
 	// Errors for invalid url components in the endpoint.
 	errInvalidEndpoint = errors.New(`"endpoint" %q must be in the form of <scheme>://<hostname>:<port>`)
+
+	errInvalidAttributeGranularity = errors.New(`"attribute_granularity" must be either "detailed" or "aggregated"`)
 )
 
 // Config defines the configuration for the various elements of the receiver agent.
@@ -44,6 +53,10 @@ type Config struct {
 	Metrics                                 metadata.MetricsSettings `mapstructure:"metrics"`
 	Username                                string                   `mapstructure:"username"`
 	Password                                string                   `mapstructure:"password"`
+	// AttributeGranularity controls the cardinality of the HTTP metrics: `detailed` breaks the
+	// requests down by method and the responses by status code, `aggregated` reports the total
+	// requests and the responses by status class, e.g. `2xx`.
+	AttributeGranularity string `mapstructure:"attribute_granularity"`
 }
 
 // Validate validates missing and invalid configuration fields.
@@ -56,6 +69,10 @@ func (cfg *Config) Validate() error {
 		err = multierr.Append(err, errMissingPassword)
 	}
 
+	if cfg.AttributeGranularity != granularityDetailed && cfg.AttributeGranularity != granularityAggregated {
+		err = multierr.Append(err, errInvalidAttributeGranularity)
+	}
+
 	_, parseErr := url.Parse(cfg.Endpoint)
 	if parseErr != nil {
 		err = multierr.Append(err, fmt.Errorf(errInvalidEndpoint.Error(), parseErr))
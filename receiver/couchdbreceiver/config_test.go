@@ -39,6 +39,7 @@ func TestValidate(t *testing.T) {
 				HTTPClientSettings: confighttp.HTTPClientSettings{
 					Endpoint: "http://localhost :5984",
 				},
+				AttributeGranularity: granularityDetailed,
 			},
 			expectedErr: multierr.Combine(
 				errMissingUsername,
@@ -52,7 +53,8 @@ func TestValidate(t *testing.T) {
 				HTTPClientSettings: confighttp.HTTPClientSettings{
 					Endpoint: "http://localhost :5984",
 				},
-				Username: "otelu",
+				AttributeGranularity: granularityDetailed,
+				Username:             "otelu",
 			},
 			expectedErr: multierr.Combine(
 				errMissingPassword,
@@ -65,7 +67,8 @@ func TestValidate(t *testing.T) {
 				HTTPClientSettings: confighttp.HTTPClientSettings{
 					Endpoint: "http://localhost :5984",
 				},
-				Password: "otelp",
+				AttributeGranularity: granularityDetailed,
+				Password:             "otelp",
 			},
 			expectedErr: multierr.Combine(
 				errMissingUsername,
@@ -80,6 +83,7 @@ func TestValidate(t *testing.T) {
 				HTTPClientSettings: confighttp.HTTPClientSettings{
 					Endpoint: "http://localhost :5984",
 				},
+				AttributeGranularity: granularityDetailed,
 			},
 			expectedErr: fmt.Errorf(errInvalidEndpoint.Error(), "parse \"http://localhost :5984\": invalid character \" \" in host name"),
 		},
@@ -91,9 +95,22 @@ func TestValidate(t *testing.T) {
 				HTTPClientSettings: confighttp.HTTPClientSettings{
 					Endpoint: "http://localhost:5984",
 				},
+				AttributeGranularity: granularityDetailed,
 			},
 			expectedErr: nil,
 		},
+		{
+			desc: "invalid attribute granularity",
+			cfg: &Config{
+				Username: "otel",
+				Password: "otel",
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "http://localhost:5984",
+				},
+				AttributeGranularity: "method",
+			},
+			expectedErr: errInvalidAttributeGranularity,
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -119,6 +136,7 @@ func TestLoadConfig(t *testing.T) {
 	expected.Username = "otelu"
 	expected.Password = "$COUCHDB_PASSWORD"
 	expected.CollectionInterval = time.Minute
+	expected.AttributeGranularity = granularityAggregated
 
 	require.Equal(t, expected, cfg)
 }
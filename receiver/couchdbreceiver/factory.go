@@ -53,6 +53,7 @@ func createDefaultConfig() component.ReceiverConfig {
 			Endpoint:   defaultEndpoint,
 			Timeout:    1 * time.Minute,
 		},
+		AttributeGranularity: granularityDetailed,
 	}
 }
 
@@ -44,6 +44,7 @@ type MetricsSettings struct {
 	CouchdbHttpdBulkRequests  MetricSettings `mapstructure:"couchdb.httpd.bulk_requests"`
 	CouchdbHttpdRequests      MetricSettings `mapstructure:"couchdb.httpd.requests"`
 	CouchdbHttpdResponses     MetricSettings `mapstructure:"couchdb.httpd.responses"`
+	CouchdbHttpdTimeouts      MetricSettings `mapstructure:"couchdb.httpd.timeouts"`
 	CouchdbHttpdViews         MetricSettings `mapstructure:"couchdb.httpd.views"`
 }
 
@@ -70,6 +71,9 @@ func DefaultMetricsSettings() MetricsSettings {
 		CouchdbHttpdResponses: MetricSettings{
 			Enabled: true,
 		},
+		CouchdbHttpdTimeouts: MetricSettings{
+			Enabled: true,
+		},
 		CouchdbHttpdViews: MetricSettings{
 			Enabled: true,
 		},
@@ -148,6 +152,56 @@ var MapAttributeOperation = map[string]AttributeOperation{
 	"reads":  AttributeOperationReads,
 }
 
+// AttributeRequest specifies the a value request attribute.
+type AttributeRequest int
+
+const (
+	_ AttributeRequest = iota
+	AttributeRequestAllDocs
+	AttributeRequestExplain
+	AttributeRequestFind
+	AttributeRequestView
+	AttributeRequestPartitionAllDocs
+	AttributeRequestPartitionExplain
+	AttributeRequestPartitionFind
+	AttributeRequestPartitionView
+)
+
+// String returns the string representation of the AttributeRequest.
+func (av AttributeRequest) String() string {
+	switch av {
+	case AttributeRequestAllDocs:
+		return "all_docs"
+	case AttributeRequestExplain:
+		return "explain"
+	case AttributeRequestFind:
+		return "find"
+	case AttributeRequestView:
+		return "view"
+	case AttributeRequestPartitionAllDocs:
+		return "partition_all_docs"
+	case AttributeRequestPartitionExplain:
+		return "partition_explain"
+	case AttributeRequestPartitionFind:
+		return "partition_find"
+	case AttributeRequestPartitionView:
+		return "partition_view"
+	}
+	return ""
+}
+
+// MapAttributeRequest is a helper map of string to AttributeRequest attribute value.
+var MapAttributeRequest = map[string]AttributeRequest{
+	"all_docs":           AttributeRequestAllDocs,
+	"explain":            AttributeRequestExplain,
+	"find":               AttributeRequestFind,
+	"view":               AttributeRequestView,
+	"partition_all_docs": AttributeRequestPartitionAllDocs,
+	"partition_explain":  AttributeRequestPartitionExplain,
+	"partition_find":     AttributeRequestPartitionFind,
+	"partition_view":     AttributeRequestPartitionView,
+}
+
 // AttributeView specifies the a value view attribute.
 type AttributeView int
 
@@ -535,6 +589,59 @@ func newMetricCouchdbHttpdResponses(settings MetricSettings) metricCouchdbHttpdR
 	return m
 }
 
+type metricCouchdbHttpdTimeouts struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills couchdb.httpd.timeouts metric with initial data.
+func (m *metricCouchdbHttpdTimeouts) init() {
+	m.data.SetName("couchdb.httpd.timeouts")
+	m.data.SetDescription("The number of requests which timed out. Only reported by CouchDB 3.x.")
+	m.data.SetUnit("{requests}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricCouchdbHttpdTimeouts) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, requestAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("request", requestAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricCouchdbHttpdTimeouts) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricCouchdbHttpdTimeouts) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricCouchdbHttpdTimeouts(settings MetricSettings) metricCouchdbHttpdTimeouts {
+	m := metricCouchdbHttpdTimeouts{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricCouchdbHttpdViews struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -603,6 +710,7 @@ type MetricsBuilder struct {
 	metricCouchdbHttpdBulkRequests  metricCouchdbHttpdBulkRequests
 	metricCouchdbHttpdRequests      metricCouchdbHttpdRequests
 	metricCouchdbHttpdResponses     metricCouchdbHttpdResponses
+	metricCouchdbHttpdTimeouts      metricCouchdbHttpdTimeouts
 	metricCouchdbHttpdViews         metricCouchdbHttpdViews
 }
 
@@ -628,6 +736,7 @@ func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo,
 		metricCouchdbHttpdBulkRequests:  newMetricCouchdbHttpdBulkRequests(settings.CouchdbHttpdBulkRequests),
 		metricCouchdbHttpdRequests:      newMetricCouchdbHttpdRequests(settings.CouchdbHttpdRequests),
 		metricCouchdbHttpdResponses:     newMetricCouchdbHttpdResponses(settings.CouchdbHttpdResponses),
+		metricCouchdbHttpdTimeouts:      newMetricCouchdbHttpdTimeouts(settings.CouchdbHttpdTimeouts),
 		metricCouchdbHttpdViews:         newMetricCouchdbHttpdViews(settings.CouchdbHttpdViews),
 	}
 	for _, op := range options {
@@ -695,6 +804,7 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricCouchdbHttpdBulkRequests.emit(ils.Metrics())
 	mb.metricCouchdbHttpdRequests.emit(ils.Metrics())
 	mb.metricCouchdbHttpdResponses.emit(ils.Metrics())
+	mb.metricCouchdbHttpdTimeouts.emit(ils.Metrics())
 	mb.metricCouchdbHttpdViews.emit(ils.Metrics())
 	for _, op := range rmo {
 		op(rm)
@@ -750,6 +860,11 @@ func (mb *MetricsBuilder) RecordCouchdbHttpdResponsesDataPoint(ts pcommon.Timest
 	mb.metricCouchdbHttpdResponses.recordDataPoint(mb.startTime, ts, val, httpStatusCodeAttributeValue)
 }
 
+// RecordCouchdbHttpdTimeoutsDataPoint adds a data point to couchdb.httpd.timeouts metric.
+func (mb *MetricsBuilder) RecordCouchdbHttpdTimeoutsDataPoint(ts pcommon.Timestamp, val int64, requestAttributeValue AttributeRequest) {
+	mb.metricCouchdbHttpdTimeouts.recordDataPoint(mb.startTime, ts, val, requestAttributeValue.String())
+}
+
 // RecordCouchdbHttpdViewsDataPoint adds a data point to couchdb.httpd.views metric.
 func (mb *MetricsBuilder) RecordCouchdbHttpdViewsDataPoint(ts pcommon.Timestamp, val int64, viewAttributeValue AttributeView) {
 	mb.metricCouchdbHttpdViews.recordDataPoint(mb.startTime, ts, val, viewAttributeValue.String())
@@ -16,8 +16,10 @@ package couchdbreceiver // import "github.com/open-telemetry/opentelemetry-colle
 
 import (
 	"fmt"
+	"sort"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/couchdbreceiver/internal/metadata"
@@ -74,10 +76,29 @@ func (c *couchdbScraper) recordCouchdbHttpdRequestsDataPoint(now pcommon.Timesta
 }
 
 func (c *couchdbScraper) recordCouchdbHttpdResponsesDataPoint(now pcommon.Timestamp, stats map[string]interface{}, errs *scrapererror.ScrapeErrors) {
-	codes := []string{"200", "201", "202", "204", "206", "301", "302", "304", "400", "401", "403", "404", "405", "406", "409", "412", "413", "414", "415", "416", "417", "500", "501", "503"}
+	// The reported status codes differ between the CouchDB versions, so they are taken from the stats.
+	statusCodesValue, err := getValueFromBody([]string{"httpd_status_codes"}, stats)
+	if err != nil {
+		errs.AddPartial(1, err)
+		return
+	}
+	statusCodes, ok := statusCodesValue.(map[string]interface{})
+	if !ok {
+		errs.AddPartial(1, fmt.Errorf("could not parse httpd_status_codes"))
+		return
+	}
+
+	codes := make([]string, 0, len(statusCodes))
+	for code := range statusCodes {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	classes := map[string]int64{}
+	var classOrder []string
 	for _, code := range codes {
-		httpdResponsetCodeKey := []string{"httpd_status_codes", code, "value"}
-		httpdResponsetCodeValue, err := getValueFromBody(httpdResponsetCodeKey, stats)
+		httpdResponsetCodeKey := []string{code, "value"}
+		httpdResponsetCodeValue, err := getValueFromBody(httpdResponsetCodeKey, statusCodes)
 		if err != nil {
 			errs.AddPartial(1, err)
 			continue
@@ -88,7 +109,37 @@ func (c *couchdbScraper) recordCouchdbHttpdResponsesDataPoint(now pcommon.Timest
 			errs.AddPartial(1, err)
 			continue
 		}
-		c.mb.RecordCouchdbHttpdResponsesDataPoint(now, parsedValue, code)
+		if c.config.AttributeGranularity != granularityAggregated {
+			c.mb.RecordCouchdbHttpdResponsesDataPoint(now, parsedValue, code)
+			continue
+		}
+		class := code[:1] + "xx"
+		if _, ok := classes[class]; !ok {
+			classOrder = append(classOrder, class)
+		}
+		classes[class] += parsedValue
+	}
+	for _, class := range classOrder {
+		c.mb.RecordCouchdbHttpdResponsesDataPoint(now, classes[class], class)
+	}
+}
+
+// recordCouchdbHttpdTimeoutsDataPoint records the request timeouts, which are only reported by CouchDB 3.x.
+// Missing stats are therefore not scrape errors.
+func (c *couchdbScraper) recordCouchdbHttpdTimeoutsDataPoint(now pcommon.Timestamp, stats map[string]interface{}, errs *scrapererror.ScrapeErrors) {
+	for requestVal, request := range metadata.MapAttributeRequest {
+		timeoutsKey := []string{"httpd", requestVal + "_timeouts", "value"}
+		timeoutsValue, err := getValueFromBody(timeoutsKey, stats)
+		if err != nil {
+			continue
+		}
+
+		parsedValue, err := c.parseInt(timeoutsValue)
+		if err != nil {
+			errs.AddPartial(1, err)
+			continue
+		}
+		c.mb.RecordCouchdbHttpdTimeoutsDataPoint(now, parsedValue, request)
 	}
 }
 
@@ -162,6 +213,39 @@ func (c *couchdbScraper) recordCouchdbDatabaseOperationsDataPoint(now pcommon.Ti
 	}
 }
 
+// aggregateHTTPDRequests merges the data points of couchdb.httpd.requests into a single
+// data point without the http.method attribute.
+func aggregateHTTPDRequests(md pmetric.Metrics) {
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		sms := rms.At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			ms := sms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				m := ms.At(k)
+				if m.Name() != "couchdb.httpd.requests" || m.Type() != pmetric.MetricTypeSum {
+					continue
+				}
+				dps := m.Sum().DataPoints()
+				if dps.Len() == 0 {
+					continue
+				}
+				var total int64
+				for l := 0; l < dps.Len(); l++ {
+					total += dps.At(l).IntValue()
+				}
+				idx := 0
+				dps.RemoveIf(func(pmetric.NumberDataPoint) bool {
+					idx++
+					return idx > 1
+				})
+				dps.At(0).Attributes().Clear()
+				dps.At(0).SetIntValue(total)
+			}
+		}
+	}
+}
+
 func getValueFromBody(keys []string, body map[string]interface{}) (interface{}, error) {
 	var currentValue interface{} = body
 	for _, key := range keys {
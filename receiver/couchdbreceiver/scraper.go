@@ -77,9 +77,14 @@ func (c *couchdbScraper) scrape(context.Context) (pmetric.Metrics, error) {
 	c.recordCouchdbHttpdRequestsDataPoint(now, stats, errs)
 	c.recordCouchdbHttpdResponsesDataPoint(now, stats, errs)
 	c.recordCouchdbHttpdViewsDataPoint(now, stats, errs)
+	c.recordCouchdbHttpdTimeoutsDataPoint(now, stats, errs)
 	c.recordCouchdbDatabaseOpenDataPoint(now, stats, errs)
 	c.recordCouchdbFileDescriptorOpenDataPoint(now, stats, errs)
 	c.recordCouchdbDatabaseOperationsDataPoint(now, stats, errs)
 
-	return c.mb.Emit(metadata.WithCouchdbNodeName(c.config.Endpoint)), errs.Combine()
+	md := c.mb.Emit(metadata.WithCouchdbNodeName(c.config.Endpoint))
+	if c.config.AttributeGranularity == granularityAggregated {
+		aggregateHTTPDRequests(md)
+	}
+	return md, errs.Combine()
 }
@@ -70,7 +70,27 @@ func TestScrape(t *testing.T) {
 		actualMetrics, err := scraper.scrape(context.Background())
 		require.NoError(t, err)
 
-		expectedFile := filepath.Join("testdata", "scraper", "expected.json")
+		expectedFile := filepath.Join("testdata", "scraper", "expected_3.12.json")
+		expectedMetrics, err := golden.ReadMetrics(expectedFile)
+		require.NoError(t, err)
+
+		require.NoError(t, scrapertest.CompareMetrics(expectedMetrics, actualMetrics))
+	})
+
+	t.Run("scrape aggregated attributes from couchdb 3.12", func(t *testing.T) {
+		aggregatedCfg := *cfg
+		aggregatedCfg.AttributeGranularity = granularityAggregated
+		require.NoError(t, aggregatedCfg.Validate())
+
+		mockClient := new(MockClient)
+		mockClient.On("GetStats", "_local").Return(getStats("response_3.12.json"))
+		scraper := newCouchdbScraper(componenttest.NewNopReceiverCreateSettings(), &aggregatedCfg)
+		scraper.client = mockClient
+
+		actualMetrics, err := scraper.scrape(context.Background())
+		require.NoError(t, err)
+
+		expectedFile := filepath.Join("testdata", "scraper", "expected_aggregated.json")
 		expectedMetrics, err := golden.ReadMetrics(expectedFile)
 		require.NoError(t, err)
 
@@ -61,6 +61,35 @@ type Config struct {
 
 	// Metrics config. Enable or disable stats by name.
 	MetricsConfig metadata.MetricsSettings `mapstructure:"metrics"`
+
+	// Logs config. Selects the containers whose logs are collected by the logs receiver.
+	Logs LogsConfig `mapstructure:"logs"`
+}
+
+// LogsConfig selects containers for log collection by their labels.
+type LogsConfig struct {
+	// Labels a container must have for its logs to be collected. An empty value matches
+	// any value of the label. When not set, all running containers are included.
+	IncludeLabels map[string]string `mapstructure:"include_labels"`
+
+	// Labels which exclude a container from log collection, even when it is included by
+	// IncludeLabels. An empty value matches any value of the label.
+	ExcludeLabels map[string]string `mapstructure:"exclude_labels"`
+}
+
+// matches returns true when a container with the given labels should have its logs collected.
+func (cfg LogsConfig) matches(labels map[string]string) bool {
+	for k, v := range cfg.ExcludeLabels {
+		if lv, ok := labels[k]; ok && (v == "" || v == lv) {
+			return false
+		}
+	}
+	for k, v := range cfg.IncludeLabels {
+		if lv, ok := labels[k]; !ok || (v != "" && v != lv) {
+			return false
+		}
+	}
+	return true
 }
 
 func (config Config) Validate() error {
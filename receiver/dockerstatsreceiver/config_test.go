@@ -79,6 +79,10 @@ func TestLoadConfig(t *testing.T) {
 					}
 					return m
 				}(),
+				Logs: LogsConfig{
+					IncludeLabels: map[string]string{"com.example.collect-logs": "true"},
+					ExcludeLabels: map[string]string{"com.example.sidecar": ""},
+				},
 			},
 		},
 	}
@@ -113,3 +117,19 @@ func TestValidateErrors(t *testing.T) {
 	cfg = &Config{ScraperControllerSettings: scraperhelper.ScraperControllerSettings{CollectionInterval: 1 * time.Second}, Endpoint: "someEndpoint", DockerAPIVersion: 1.21}
 	assert.Equal(t, "api_version must be at least 1.22", cfg.Validate().Error())
 }
+
+func TestLogsConfigMatches(t *testing.T) {
+	cfg := LogsConfig{}
+	assert.True(t, cfg.matches(nil))
+	assert.True(t, cfg.matches(map[string]string{"app": "web"}))
+
+	cfg = LogsConfig{
+		IncludeLabels: map[string]string{"app": "", "tier": "frontend"},
+		ExcludeLabels: map[string]string{"sidecar": "", "env": "test"},
+	}
+	assert.True(t, cfg.matches(map[string]string{"app": "web", "tier": "frontend", "env": "prod"}))
+	assert.False(t, cfg.matches(map[string]string{"app": "web"}))
+	assert.False(t, cfg.matches(map[string]string{"app": "web", "tier": "backend"}))
+	assert.False(t, cfg.matches(map[string]string{"app": "web", "tier": "frontend", "env": "test"}))
+	assert.False(t, cfg.matches(map[string]string{"app": "web", "tier": "frontend", "sidecar": "envoy"}))
+}
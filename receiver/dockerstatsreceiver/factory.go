@@ -29,6 +29,7 @@ import (
 const (
 	typeStr        = "docker_stats"
 	stability      = component.StabilityLevelAlpha
+	logsStability  = component.StabilityLevelInDevelopment
 	useScraperV2ID = "receiver.dockerstats.useScraperV2"
 )
 
@@ -44,7 +45,8 @@ func NewFactory() component.ReceiverFactory {
 	return component.NewReceiverFactory(
 		typeStr,
 		createDefaultConfig,
-		component.WithMetricsReceiver(createMetricsReceiver, stability))
+		component.WithMetricsReceiver(createMetricsReceiver, stability),
+		component.WithLogsReceiver(createLogsReceiver, logsStability))
 }
 
 func createDefaultConfig() component.ReceiverConfig {
@@ -85,3 +87,12 @@ func createMetricsReceiver(
 
 	return scraperhelper.NewScraperControllerReceiver(&dsr.config.ScraperControllerSettings, params, consumer, scraperhelper.AddScraper(scrp))
 }
+
+func createLogsReceiver(
+	_ context.Context,
+	params component.ReceiverCreateSettings,
+	config component.ReceiverConfig,
+	consumer consumer.Logs,
+) (component.LogsReceiver, error) {
+	return newLogsReceiver(params, config.(*Config), consumer)
+}
@@ -45,4 +45,8 @@ func TestCreateReceiver(t *testing.T) {
 	metricReceiver, err := factory.CreateMetricsReceiver(context.Background(), params, config, consumertest.NewNop())
 	assert.NoError(t, err, "Metric receiver creation failed")
 	assert.NotNil(t, metricReceiver, "receiver creation failed")
+
+	logsReceiver, err := factory.CreateLogsReceiver(context.Background(), params, config, consumertest.NewNop())
+	assert.NoError(t, err, "Logs receiver creation failed")
+	assert.NotNil(t, logsReceiver, "receiver creation failed")
 }
@@ -0,0 +1,359 @@
+// Copyright 2020 OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dockerstatsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerstatsreceiver"
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	dtypes "github.com/docker/docker/api/types"
+	dfilters "github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/pkg/stdcopy"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/obsreport"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/docker"
+)
+
+const (
+	attributeLogIOStream = "log.iostream"
+	streamStdout         = "stdout"
+	streamStderr         = "stderr"
+
+	// maxLogLineSize bounds the length of a single log line. The Docker json-file
+	// driver splits lines at 16KiB, so this is only reached by other drivers.
+	maxLogLineSize = 1024 * 1024
+
+	// maxLogBatchSize and logFlushInterval bound the number of records emitted at once,
+	// and how long a record is held back before it is emitted.
+	maxLogBatchSize  = 100
+	logFlushInterval = 100 * time.Millisecond
+)
+
+// logsReceiver follows the log streams of the running containers selected by the logs
+// config and emits a log record per line, in batches.
+type logsReceiver struct {
+	config   *Config
+	settings component.ReceiverCreateSettings
+	consumer consumer.Logs
+	obsrecv  *obsreport.Receiver
+	client   *docker.Client
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	followedLock sync.Mutex
+	followed     map[string]struct{}
+}
+
+func newLogsReceiver(set component.ReceiverCreateSettings, config *Config, consumer consumer.Logs) (*logsReceiver, error) {
+	obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{
+		ReceiverID:             config.ID(),
+		ReceiverCreateSettings: set,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &logsReceiver{
+		config:   config,
+		settings: set,
+		consumer: consumer,
+		obsrecv:  obsrecv,
+		followed: map[string]struct{}{},
+	}, nil
+}
+
+func (r *logsReceiver) Start(ctx context.Context, _ component.Host) error {
+	dConfig, err := docker.NewConfig(r.config.Endpoint, r.config.Timeout, r.config.ExcludedImages, r.config.DockerAPIVersion)
+	if err != nil {
+		return err
+	}
+
+	r.client, err = docker.NewDockerClient(dConfig, r.settings.Logger)
+	if err != nil {
+		return err
+	}
+
+	// Only lines written from now on are collected for the containers which are already
+	// running, containers started later are followed from their start.
+	since := time.Now()
+	if err = r.client.LoadContainerList(ctx); err != nil {
+		return err
+	}
+
+	var followCtx context.Context
+	followCtx, r.cancel = context.WithCancel(context.Background())
+	for _, container := range r.client.Containers() {
+		r.follow(followCtx, container, since)
+	}
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		r.containerStartLoop(followCtx, since)
+	}()
+	return nil
+}
+
+func (r *logsReceiver) Shutdown(context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	return nil
+}
+
+// containerStartLoop follows the logs of the containers started after the receiver.
+// Streams of stopped containers end on their own, so only start events are watched.
+func (r *logsReceiver) containerStartLoop(ctx context.Context, since time.Time) {
+	filters := dfilters.NewArgs(
+		dfilters.Arg("type", "container"),
+		dfilters.Arg("event", "start"),
+	)
+	lastTime := since
+
+EVENT_LOOP:
+	for {
+		options := dtypes.EventsOptions{
+			Filters: filters,
+			Since:   lastTime.Format(time.RFC3339Nano),
+		}
+		eventCh, errCh := r.client.Events(ctx, options)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventCh:
+				eventTime := time.Unix(0, event.TimeNano)
+				if containerJSON, ok := r.client.InspectAndPersistContainer(ctx, event.ID); ok {
+					r.follow(ctx, docker.Container{
+						ContainerJSON: containerJSON,
+						EnvMap:        docker.ContainerEnvToMap(containerJSON.Config.Env),
+					}, eventTime)
+				}
+				if eventTime.After(lastTime) {
+					lastTime = eventTime
+				}
+			case err := <-errCh:
+				if ctx.Err() != nil {
+					return
+				}
+				r.settings.Logger.Error("Error watching docker container events", zap.Error(err))
+				select {
+				case <-time.After(3 * time.Second):
+					continue EVENT_LOOP
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}
+}
+
+// follow streams the logs of the container in the background, unless the container is
+// not selected by the logs config or is already being followed.
+func (r *logsReceiver) follow(ctx context.Context, container docker.Container, since time.Time) {
+	if !r.config.Logs.matches(container.Config.Labels) {
+		r.settings.Logger.Debug("Not collecting logs of container per logs config", zap.String("id", container.ID))
+		return
+	}
+
+	r.followedLock.Lock()
+	defer r.followedLock.Unlock()
+	if _, ok := r.followed[container.ID]; ok {
+		return
+	}
+	r.followed[container.ID] = struct{}{}
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		if err := r.streamLogs(ctx, container, since); err != nil && ctx.Err() == nil {
+			r.settings.Logger.Warn("Could not stream docker container logs", zap.String("id", container.ID), zap.Error(err))
+		}
+
+		r.followedLock.Lock()
+		delete(r.followed, container.ID)
+		r.followedLock.Unlock()
+		// The stream ends when the container stops, it is picked up again by its next start event.
+		r.client.RemoveContainer(container.ID)
+	}()
+}
+
+func (r *logsReceiver) streamLogs(ctx context.Context, container docker.Container, since time.Time) error {
+	stream, err := r.client.ContainerLogs(ctx, container.ID, dtypes.ContainerLogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Follow:     true,
+		Timestamps: true,
+		Since:      since.Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	resource := pcommon.NewResource()
+	resourceAttr := resource.Attributes()
+	resourceAttr.PutStr(conventions.AttributeContainerRuntime, "docker")
+	resourceAttr.PutStr(conventions.AttributeContainerID, container.ID)
+	resourceAttr.PutStr(conventions.AttributeContainerImageName, container.Config.Image)
+	resourceAttr.PutStr(conventions.AttributeContainerName, strings.TrimPrefix(container.Name, "/"))
+	resourceAttr.PutStr("container.hostname", container.Config.Hostname)
+	updateConfiguredResourceAttributes(resourceAttr, container, r.config)
+
+	lines := make(chan logLine)
+	errs := make(chan error, 3)
+	var producers sync.WaitGroup
+	produce := func(f func() error) {
+		producers.Add(1)
+		go func() {
+			defer producers.Done()
+			errs <- f()
+		}()
+	}
+	scan := func(reader io.ReadCloser, stream string) {
+		produce(func() error {
+			defer reader.Close()
+			return scanLines(ctx, reader, stream, lines)
+		})
+	}
+
+	// Containers with a TTY have a single raw stream, the others multiplex stdout and stderr.
+	if container.Config.Tty {
+		scan(stream, streamStdout)
+	} else {
+		stdout, stdoutWriter := io.Pipe()
+		stderr, stderrWriter := io.Pipe()
+		scan(stdout, streamStdout)
+		scan(stderr, streamStderr)
+		produce(func() error {
+			_, err := stdcopy.StdCopy(stdoutWriter, stderrWriter, stream)
+			stdoutWriter.CloseWithError(err)
+			stderrWriter.CloseWithError(err)
+			return err
+		})
+	}
+	go func() {
+		producers.Wait()
+		close(lines)
+		close(errs)
+	}()
+
+	r.consumeLines(ctx, lines, resource)
+	for produceErr := range errs {
+		err = multierr.Append(err, produceErr)
+	}
+	return err
+}
+
+// logLine is a line read from a log stream of a container.
+type logLine struct {
+	stream   string
+	text     string
+	observed time.Time
+}
+
+// scanLines sends the lines read from the reader to the lines channel until the
+// reader ends or the context is done.
+func scanLines(ctx context.Context, reader io.Reader, stream string, lines chan<- logLine) error {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLogLineSize)
+	for scanner.Scan() {
+		select {
+		case lines <- logLine{stream: stream, text: scanner.Text(), observed: time.Now()}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return scanner.Err()
+}
+
+// consumeLines emits the lines of a container in batches of up to maxLogBatchSize
+// records, flushing pending records every logFlushInterval, until the lines channel
+// is closed.
+func (r *logsReceiver) consumeLines(ctx context.Context, lines <-chan logLine, resource pcommon.Resource) {
+	ticker := time.NewTicker(logFlushInterval)
+	defer ticker.Stop()
+
+	logs, records := newLogsBatch(resource)
+	for {
+		select {
+		case line, ok := <-lines:
+			if !ok {
+				r.flush(ctx, logs, records.Len())
+				return
+			}
+			record := records.AppendEmpty()
+			record.SetObservedTimestamp(pcommon.NewTimestampFromTime(line.observed))
+			record.Attributes().PutStr(attributeLogIOStream, line.stream)
+			parseLogLine(line.text, record)
+			if records.Len() >= maxLogBatchSize {
+				r.flush(ctx, logs, records.Len())
+				logs, records = newLogsBatch(resource)
+			}
+		case <-ticker.C:
+			if records.Len() > 0 {
+				r.flush(ctx, logs, records.Len())
+				logs, records = newLogsBatch(resource)
+			}
+		}
+	}
+}
+
+func newLogsBatch(resource pcommon.Resource) (plog.Logs, plog.LogRecordSlice) {
+	logs := plog.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	rl.SetSchemaUrl(conventions.SchemaURL)
+	resource.CopyTo(rl.Resource())
+	return logs, rl.ScopeLogs().AppendEmpty().LogRecords()
+}
+
+func (r *logsReceiver) flush(ctx context.Context, logs plog.Logs, count int) {
+	if count == 0 {
+		return
+	}
+	obsCtx := r.obsrecv.StartLogsOp(ctx)
+	err := r.consumer.ConsumeLogs(obsCtx, logs)
+	r.obsrecv.EndLogsOp(obsCtx, typeStr, count, err)
+	if err != nil {
+		r.settings.Logger.Debug("Failed to consume docker container logs", zap.Error(err))
+	}
+}
+
+// parseLogLine sets the timestamp and body of the record from a line prefixed by the
+// timestamp the Docker daemon adds when requested.
+func parseLogLine(line string, record plog.LogRecord) {
+	if prefix, body, ok := strings.Cut(line, " "); ok {
+		if ts, err := time.Parse(time.RFC3339Nano, prefix); err == nil {
+			record.SetTimestamp(pcommon.NewTimestampFromTime(ts))
+			record.Body().SetStr(body)
+			return
+		}
+	}
+	record.Body().SetStr(line)
+}
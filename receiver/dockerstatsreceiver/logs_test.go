@@ -0,0 +1,181 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dockerstatsreceiver
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+const testContainerID = "10b703fb312b25e8368ab5a3bce3a1610d1cee5d71a94920f1a7adbc5b0cb326"
+
+func dockerLogsMockServer(t *testing.T, tty bool, logs []byte) *httptest.Server {
+	containers, err := os.ReadFile(filepath.Join(mockFolder, "single_container", "containers.json"))
+	require.NoError(t, err)
+	container, err := os.ReadFile(filepath.Join(mockFolder, "single_container", "container.json"))
+	require.NoError(t, err)
+	if !tty {
+		container = bytes.Replace(container, []byte(`"Tty": true`), []byte(`"Tty": false`), 1)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/v1.22/containers/json":
+			_, _ = rw.Write(containers)
+		case "/v1.22/containers/" + testContainerID + "/json":
+			_, _ = rw.Write(container)
+		case "/v1.22/containers/" + testContainerID + "/logs":
+			assert.Equal(t, "1", req.URL.Query().Get("follow"))
+			assert.Equal(t, "1", req.URL.Query().Get("timestamps"))
+			_, _ = rw.Write(logs)
+		case "/v1.22/events":
+			rw.WriteHeader(http.StatusOK)
+			rw.(http.Flusher).Flush()
+			<-req.Context().Done()
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestLogsReceiver(t *testing.T) {
+	var multiplexed bytes.Buffer
+	_, err := stdcopy.NewStdWriter(&multiplexed, stdcopy.Stdout).Write([]byte("2022-11-14T10:00:00.000000001Z hello\n"))
+	require.NoError(t, err)
+	_, err = stdcopy.NewStdWriter(&multiplexed, stdcopy.Stderr).Write([]byte("2022-11-14T10:00:01.000000001Z world\n"))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		desc    string
+		tty     bool
+		logs    []byte
+		streams []string
+	}{
+		{
+			desc:    "tty",
+			tty:     true,
+			logs:    []byte("2022-11-14T10:00:00.000000001Z hello\n2022-11-14T10:00:01.000000001Z world\n"),
+			streams: []string{streamStdout, streamStdout},
+		},
+		{
+			desc:    "multiplexed",
+			logs:    multiplexed.Bytes(),
+			streams: []string{streamStdout, streamStderr},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Endpoint = dockerLogsMockServer(t, tc.tty, tc.logs).URL
+			cfg.ContainerLabelsToMetricLabels = map[string]string{"container.label": "container-metric-label"}
+
+			sink := new(consumertest.LogsSink)
+			recv, err := newLogsReceiver(componenttest.NewNopReceiverCreateSettings(), cfg, sink)
+			require.NoError(t, err)
+			require.NoError(t, recv.Start(context.Background(), componenttest.NewNopHost()))
+			defer func() { assert.NoError(t, recv.Shutdown(context.Background())) }()
+
+			require.Eventually(t, func() bool { return sink.LogRecordCount() == 2 }, 5*time.Second, 10*time.Millisecond)
+
+			bodies := map[string]string{}
+			for _, logs := range sink.AllLogs() {
+				rl := logs.ResourceLogs().At(0)
+				attrs := rl.Resource().Attributes().AsRaw()
+				assert.Equal(t, testContainerID, attrs["container.id"])
+				assert.Equal(t, "ubuntu", attrs["container.image.name"])
+				assert.Equal(t, "docker", attrs["container.runtime"])
+				assert.Equal(t, "container-label", attrs["container-metric-label"])
+
+				records := rl.ScopeLogs().At(0).LogRecords()
+				for i := 0; i < records.Len(); i++ {
+					record := records.At(i)
+					stream, ok := record.Attributes().Get(attributeLogIOStream)
+					require.True(t, ok)
+					bodies[record.Body().Str()] = stream.Str()
+					assert.NotZero(t, record.Timestamp())
+				}
+			}
+			assert.Equal(t, map[string]string{"hello": tc.streams[0], "world": tc.streams[1]}, bodies)
+		})
+	}
+}
+
+func TestLogsReceiverBatches(t *testing.T) {
+	const lineCount = 2*maxLogBatchSize + 50
+	var logs bytes.Buffer
+	for i := 0; i < lineCount; i++ {
+		fmt.Fprintf(&logs, "2022-11-14T10:00:00.000000001Z line %d\n", i)
+	}
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = dockerLogsMockServer(t, true, logs.Bytes()).URL
+
+	sink := new(consumertest.LogsSink)
+	recv, err := newLogsReceiver(componenttest.NewNopReceiverCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, recv.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { assert.NoError(t, recv.Shutdown(context.Background())) }()
+
+	require.Eventually(t, func() bool { return sink.LogRecordCount() == lineCount }, 5*time.Second, 10*time.Millisecond)
+	batches := sink.AllLogs()
+	assert.GreaterOrEqual(t, len(batches), 3)
+	assert.Less(t, len(batches), lineCount)
+	for _, batch := range batches {
+		assert.LessOrEqual(t, batch.LogRecordCount(), maxLogBatchSize)
+	}
+}
+
+func TestLogsReceiverExcludedContainer(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = dockerLogsMockServer(t, true, []byte("hello\n")).URL
+	cfg.Logs.ExcludeLabels = map[string]string{"container.label": ""}
+
+	sink := new(consumertest.LogsSink)
+	recv, err := newLogsReceiver(componenttest.NewNopReceiverCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, recv.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, recv.Shutdown(context.Background()))
+
+	assert.Zero(t, sink.LogRecordCount())
+}
+
+func TestParseLogLine(t *testing.T) {
+	record := plog.NewLogRecord()
+	parseLogLine("2022-11-14T10:00:00.5Z GET /index.html 200", record)
+	assert.Equal(t, time.Date(2022, 11, 14, 10, 0, 0, 500000000, time.UTC), record.Timestamp().AsTime())
+	assert.Equal(t, "GET /index.html 200", record.Body().Str())
+
+	record = plog.NewLogRecord()
+	parseLogLine("no timestamp here", record)
+	assert.Zero(t, record.Timestamp())
+	assert.Equal(t, "no timestamp here", record.Body().Str())
+}
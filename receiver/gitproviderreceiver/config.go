@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitproviderreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/gitproviderreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/gitproviderreceiver/internal/metadata"
+)
+
+// Predefined error responses for configuration validation failures
+var (
+	errNoProvider          = errors.New(`at least one of "github" or "gitlab" must be configured`)
+	errMissingOwner        = errors.New(`"owner" must be specified`)
+	errInvalidEndpoint     = errors.New(`"endpoint" must be in the form of <scheme>://<hostname>[:<port>][/<path>]`)
+	errInvalidMergedWindow = errors.New(`"merged_window" must be positive`)
+)
+
+const (
+	defaultGitHubEndpoint = "https://api.github.com"
+	defaultGitLabEndpoint = "https://gitlab.com"
+)
+
+// Config defines the configuration for the various elements of the receiver agent.
+type Config struct {
+	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
+	Metrics                                 metadata.MetricsSettings `mapstructure:"metrics"`
+
+	// GitHub configures the collection of the repositories of a GitHub organization or user.
+	GitHub *ProviderConfig `mapstructure:"github"`
+	// GitLab configures the collection of the projects of a GitLab group and its subgroups.
+	GitLab *ProviderConfig `mapstructure:"gitlab"`
+	// MergedWindow is the period the merged pull requests are counted over.
+	MergedWindow time.Duration `mapstructure:"merged_window"`
+}
+
+// ProviderConfig defines how to access the API of a Git provider.
+type ProviderConfig struct {
+	// Endpoint defaults to the API of the hosted service, e.g. https://api.github.com.
+	confighttp.HTTPClientSettings `mapstructure:",squash"`
+	// Token is the access token used to authenticate to the API.
+	Token string `mapstructure:"token"`
+	// Owner is the GitHub organization or user, or the GitLab group, whose repositories are collected.
+	Owner string `mapstructure:"owner"`
+}
+
+// Validate validates the configuration by checking for missing or invalid fields
+func (cfg *Config) Validate() error {
+	var err error
+
+	if cfg.GitHub == nil && cfg.GitLab == nil {
+		err = multierr.Append(err, errNoProvider)
+	}
+	if cfg.GitHub != nil {
+		err = multierr.Append(err, cfg.GitHub.validate("github"))
+	}
+	if cfg.GitLab != nil {
+		err = multierr.Append(err, cfg.GitLab.validate("gitlab"))
+	}
+	if cfg.MergedWindow <= 0 {
+		err = multierr.Append(err, errInvalidMergedWindow)
+	}
+
+	return err
+}
+
+func (cfg *ProviderConfig) validate(provider string) error {
+	var err error
+
+	if cfg.Owner == "" {
+		err = multierr.Append(err, fmt.Errorf("%s: %w", provider, errMissingOwner))
+	}
+	if cfg.Endpoint != "" {
+		u, parseErr := url.Parse(cfg.Endpoint)
+		if parseErr != nil {
+			err = multierr.Append(err, fmt.Errorf("%s: %s: %w", provider, errInvalidEndpoint.Error(), parseErr))
+		} else if u.Scheme == "" || u.Host == "" {
+			err = multierr.Append(err, fmt.Errorf("%s: %w", provider, errInvalidEndpoint))
+		}
+	}
+
+	return err
+}
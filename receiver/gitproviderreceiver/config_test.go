@@ -0,0 +1,106 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitproviderreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/gitproviderreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.uber.org/multierr"
+)
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		cfg         *Config
+		expectedErr error
+	}{
+		{
+			desc: "no provider",
+			cfg: &Config{
+				MergedWindow: time.Hour,
+			},
+			expectedErr: errNoProvider,
+		},
+		{
+			desc: "missing owners",
+			cfg: &Config{
+				GitHub:       &ProviderConfig{Token: "token"},
+				GitLab:       &ProviderConfig{Token: "token"},
+				MergedWindow: time.Hour,
+			},
+			expectedErr: multierr.Combine(
+				fmt.Errorf("github: %w", errMissingOwner),
+				fmt.Errorf("gitlab: %w", errMissingOwner),
+			),
+		},
+		{
+			desc: "invalid endpoint",
+			cfg: &Config{
+				GitLab: &ProviderConfig{
+					HTTPClientSettings: confighttp.HTTPClientSettings{Endpoint: "gitlab.example.com"},
+					Owner:              "platform",
+				},
+				MergedWindow: time.Hour,
+			},
+			expectedErr: fmt.Errorf("gitlab: %w", errInvalidEndpoint),
+		},
+		{
+			desc: "unparsable endpoint",
+			cfg: &Config{
+				GitHub: &ProviderConfig{
+					HTTPClientSettings: confighttp.HTTPClientSettings{Endpoint: "https://ghe.example.com:port"},
+					Owner:              "acme",
+				},
+				MergedWindow: time.Hour,
+			},
+			expectedErr: fmt.Errorf("github: %s: %w", errInvalidEndpoint.Error(), errors.New(`parse "https://ghe.example.com:port": invalid port ":port" after host`)),
+		},
+		{
+			desc: "invalid merged window",
+			cfg: &Config{
+				GitHub: &ProviderConfig{Owner: "acme"},
+			},
+			expectedErr: errInvalidMergedWindow,
+		},
+		{
+			desc: "valid config",
+			cfg: &Config{
+				GitHub: &ProviderConfig{
+					HTTPClientSettings: confighttp.HTTPClientSettings{Endpoint: "https://ghe.example.com/api/v3"},
+					Owner:              "acme",
+				},
+				GitLab:       &ProviderConfig{Owner: "acme/platform"},
+				MergedWindow: time.Hour,
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			actualErr := tc.cfg.Validate()
+			if tc.expectedErr != nil {
+				require.EqualError(t, actualErr, tc.expectedErr.Error())
+			} else {
+				require.NoError(t, actualErr)
+			}
+		})
+	}
+}
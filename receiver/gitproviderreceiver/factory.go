@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitproviderreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/gitproviderreceiver"
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/gitproviderreceiver/internal/metadata"
+)
+
+const (
+	typeStr   = "gitprovider"
+	stability = component.StabilityLevelInDevelopment
+)
+
+var errConfigNotGitProvider = errors.New("config was not a Git provider receiver config")
+
+// NewFactory creates a new receiver factory
+func NewFactory() component.ReceiverFactory {
+	return component.NewReceiverFactory(
+		typeStr,
+		createDefaultConfig,
+		component.WithMetricsReceiver(createMetricsReceiver, stability))
+}
+
+func createDefaultConfig() component.ReceiverConfig {
+	return &Config{
+		ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
+			ReceiverSettings:   config.NewReceiverSettings(component.NewID(typeStr)),
+			CollectionInterval: 5 * time.Minute,
+		},
+		Metrics:      metadata.DefaultMetricsSettings(),
+		MergedWindow: 24 * time.Hour,
+	}
+}
+
+func createMetricsReceiver(ctx context.Context, params component.ReceiverCreateSettings, rConf component.ReceiverConfig, consumer consumer.Metrics) (component.MetricsReceiver, error) {
+	cfg, ok := rConf.(*Config)
+	if !ok {
+		return nil, errConfigNotGitProvider
+	}
+
+	gitproviderScraper := newScraper(cfg, params)
+	scraper, err := scraperhelper.NewScraper(typeStr, gitproviderScraper.scrape, scraperhelper.WithStart(gitproviderScraper.start))
+	if err != nil {
+		return nil, err
+	}
+
+	return scraperhelper.NewScraperControllerReceiver(&cfg.ScraperControllerSettings, params, consumer, scraperhelper.AddScraper(scraper))
+}
@@ -0,0 +1,110 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitproviderreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/gitproviderreceiver"
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+type githubRepository struct {
+	FullName      string `json:"full_name"`
+	DefaultBranch string `json:"default_branch"`
+}
+
+type githubPullRequest struct {
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	MergedAt  *time.Time `json:"merged_at"`
+}
+
+type githubBranch struct {
+	Protected bool `json:"protected"`
+}
+
+// githubProvider collects the repositories of a GitHub organization or user.
+type githubProvider struct {
+	client *apiClient
+	owner  string
+}
+
+func newGitHubProvider(client *http.Client, endpoint string, cfg *ProviderConfig) *githubProvider {
+	header := http.Header{}
+	header.Set("Accept", "application/vnd.github+json")
+	if cfg.Token != "" {
+		header.Set("Authorization", "Bearer "+cfg.Token)
+	}
+	return &githubProvider{
+		client: newAPIClient(client, endpoint, header),
+		owner:  cfg.Owner,
+	}
+}
+
+func (p *githubProvider) repositories(ctx context.Context) ([]repository, error) {
+	var repos []repository
+	page := func(items []githubRepository) bool {
+		for _, item := range items {
+			repos = append(repos, repository{id: item.FullName, name: item.FullName, defaultBranch: item.DefaultBranch})
+		}
+		return true
+	}
+	err := getPages(ctx, p.client, "/orgs/"+url.PathEscape(p.owner)+"/repos?per_page=100", page)
+	if errors.Is(err, errNotFound) {
+		// the owner is a user rather than an organization
+		repos = nil
+		err = getPages(ctx, p.client, "/users/"+url.PathEscape(p.owner)+"/repos?per_page=100", page)
+	}
+	return repos, err
+}
+
+func (p *githubProvider) openPullRequests(ctx context.Context, repo repository) ([]time.Time, error) {
+	var created []time.Time
+	err := getPages(ctx, p.client, "/repos/"+repo.id+"/pulls?state=open&per_page=100", func(items []githubPullRequest) bool {
+		for _, item := range items {
+			created = append(created, item.CreatedAt)
+		}
+		return true
+	})
+	return created, err
+}
+
+func (p *githubProvider) mergedPullRequests(ctx context.Context, repo repository, since time.Time) (int, error) {
+	merged := 0
+	// pull requests are sorted by their last update, which is not before they were merged,
+	// so that the pages older than the window are not requested
+	err := getPages(ctx, p.client, "/repos/"+repo.id+"/pulls?state=closed&sort=updated&direction=desc&per_page=100", func(items []githubPullRequest) bool {
+		for _, item := range items {
+			if item.UpdatedAt.Before(since) {
+				return false
+			}
+			if item.MergedAt != nil && !item.MergedAt.Before(since) {
+				merged++
+			}
+		}
+		return true
+	})
+	return merged, err
+}
+
+func (p *githubProvider) branchProtected(ctx context.Context, repo repository, branch string) (bool, error) {
+	var b githubBranch
+	if _, err := p.client.get(ctx, "/repos/"+repo.id+"/branches/"+url.PathEscape(branch), &b); err != nil {
+		return false, err
+	}
+	return b.Protected, nil
+}
@@ -0,0 +1,101 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitproviderreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/gitproviderreceiver"
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type gitlabProject struct {
+	ID                int    `json:"id"`
+	PathWithNamespace string `json:"path_with_namespace"`
+	DefaultBranch     string `json:"default_branch"`
+}
+
+type gitlabMergeRequest struct {
+	CreatedAt time.Time  `json:"created_at"`
+	MergedAt  *time.Time `json:"merged_at"`
+}
+
+// gitlabProvider collects the projects of a GitLab group and its subgroups.
+type gitlabProvider struct {
+	client *apiClient
+	group  string
+}
+
+func newGitLabProvider(client *http.Client, endpoint string, cfg *ProviderConfig) *gitlabProvider {
+	header := http.Header{}
+	if cfg.Token != "" {
+		header.Set("PRIVATE-TOKEN", cfg.Token)
+	}
+	return &gitlabProvider{
+		client: newAPIClient(client, strings.TrimSuffix(endpoint, "/")+"/api/v4", header),
+		group:  cfg.Owner,
+	}
+}
+
+func (p *gitlabProvider) repositories(ctx context.Context) ([]repository, error) {
+	var repos []repository
+	err := getPages(ctx, p.client, "/groups/"+url.PathEscape(p.group)+"/projects?include_subgroups=true&per_page=100", func(items []gitlabProject) bool {
+		for _, item := range items {
+			repos = append(repos, repository{id: strconv.Itoa(item.ID), name: item.PathWithNamespace, defaultBranch: item.DefaultBranch})
+		}
+		return true
+	})
+	return repos, err
+}
+
+func (p *gitlabProvider) openPullRequests(ctx context.Context, repo repository) ([]time.Time, error) {
+	var created []time.Time
+	err := getPages(ctx, p.client, "/projects/"+repo.id+"/merge_requests?state=opened&per_page=100", func(items []gitlabMergeRequest) bool {
+		for _, item := range items {
+			created = append(created, item.CreatedAt)
+		}
+		return true
+	})
+	return created, err
+}
+
+func (p *gitlabProvider) mergedPullRequests(ctx context.Context, repo repository, since time.Time) (int, error) {
+	merged := 0
+	path := "/projects/" + repo.id + "/merge_requests?state=merged&per_page=100&updated_after=" + url.QueryEscape(since.UTC().Format(time.RFC3339))
+	err := getPages(ctx, p.client, path, func(items []gitlabMergeRequest) bool {
+		for _, item := range items {
+			if item.MergedAt != nil && !item.MergedAt.Before(since) {
+				merged++
+			}
+		}
+		return true
+	})
+	return merged, err
+}
+
+func (p *gitlabProvider) branchProtected(ctx context.Context, repo repository, branch string) (bool, error) {
+	var protected struct{}
+	_, err := p.client.get(ctx, "/projects/"+repo.id+"/protected_branches/"+url.PathEscape(branch), &protected)
+	switch {
+	case errors.Is(err, errNotFound):
+		return false, nil
+	case err != nil:
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,128 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitproviderreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/gitproviderreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+var errNotFound = errors.New("not found")
+
+// repository is a repository, or a GitLab project, of a Git provider.
+type repository struct {
+	// id identifies the repository in the API paths.
+	id string
+	// name is the full name of the repository, including its owner.
+	name          string
+	defaultBranch string
+}
+
+// provider collects the repositories and pull requests of an owner from the API of a Git provider.
+type provider interface {
+	repositories(ctx context.Context) ([]repository, error)
+	// openPullRequests returns the creation times of the open pull requests.
+	openPullRequests(ctx context.Context, repo repository) ([]time.Time, error)
+	// mergedPullRequests returns the number of pull requests merged since the given time.
+	mergedPullRequests(ctx context.Context, repo repository, since time.Time) (int, error)
+	branchProtected(ctx context.Context, repo repository, branch string) (bool, error)
+}
+
+// apiClient performs the requests to the REST API of a Git provider.
+type apiClient struct {
+	client   *http.Client
+	endpoint string
+	header   http.Header
+}
+
+func newAPIClient(client *http.Client, endpoint string, header http.Header) *apiClient {
+	return &apiClient{
+		client:   client,
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		header:   header,
+	}
+}
+
+// get decodes the JSON response of the API path into v, and returns the URL of the
+// next page of the response, if any.
+func (c *apiClient) get(ctx context.Context, path string, v interface{}) (string, error) {
+	return c.getURL(ctx, c.endpoint+path, v)
+}
+
+func (c *apiClient) getURL(ctx context.Context, url string, v interface{}) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return "", err
+	}
+	for key, values := range c.header {
+		req.Header[key] = values
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return "", fmt.Errorf("GET %s: %w", req.URL.Path, errNotFound)
+	case resp.StatusCode != http.StatusOK:
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 256))
+		return "", fmt.Errorf("GET %s: unexpected status %s: %s", req.URL.Path, resp.Status, strings.TrimSpace(string(body)))
+	}
+	if err = json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return "", fmt.Errorf("GET %s: failed to decode response: %w", req.URL.Path, err)
+	}
+	return nextLink(resp.Header.Get("Link")), nil
+}
+
+// getPages decodes the pages of the JSON array returned for the API path, and calls
+// page with the items of every page until it returns false.
+func getPages[T any](ctx context.Context, c *apiClient, path string, page func([]T) bool) error {
+	next := c.endpoint + path
+	for next != "" {
+		var items []T
+		var err error
+		if next, err = c.getURL(ctx, next, &items); err != nil {
+			return err
+		}
+		if !page(items) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// nextLink returns the URL of the next page from the Link header of a paginated
+// response, which both GitHub and GitLab send, e.g.
+// `<https://api.github.com/orgs/open-telemetry/repos?page=2>; rel="next"`.
+func nextLink(header string) string {
+	for _, link := range strings.Split(header, ",") {
+		parts := strings.Split(link, ";")
+		for _, param := range parts[1:] {
+			if strings.TrimSpace(param) == `rel="next"` {
+				return strings.Trim(strings.TrimSpace(parts[0]), "<>")
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,157 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitproviderreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/gitproviderreceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/gitproviderreceiver/internal/metadata"
+)
+
+var errClientNotInit = errors.New("client not initialized")
+
+// ownerProvider is a provider with the resource attributes of its metrics.
+type ownerProvider struct {
+	provider
+	name  string
+	owner string
+}
+
+type gitproviderScraper struct {
+	cfg       *Config
+	settings  component.TelemetrySettings
+	mb        *metadata.MetricsBuilder
+	providers []ownerProvider
+	now       func() time.Time
+}
+
+// start starts the scraper by creating the HTTP clients of the configured providers
+func (g *gitproviderScraper) start(_ context.Context, host component.Host) error {
+	if g.cfg.GitHub != nil {
+		client, err := g.cfg.GitHub.ToClient(host, g.settings)
+		if err != nil {
+			return err
+		}
+		endpoint := g.cfg.GitHub.Endpoint
+		if endpoint == "" {
+			endpoint = defaultGitHubEndpoint
+		}
+		g.providers = append(g.providers, ownerProvider{
+			provider: newGitHubProvider(client, endpoint, g.cfg.GitHub),
+			name:     "github",
+			owner:    g.cfg.GitHub.Owner,
+		})
+	}
+	if g.cfg.GitLab != nil {
+		client, err := g.cfg.GitLab.ToClient(host, g.settings)
+		if err != nil {
+			return err
+		}
+		endpoint := g.cfg.GitLab.Endpoint
+		if endpoint == "" {
+			endpoint = defaultGitLabEndpoint
+		}
+		g.providers = append(g.providers, ownerProvider{
+			provider: newGitLabProvider(client, endpoint, g.cfg.GitLab),
+			name:     "gitlab",
+			owner:    g.cfg.GitLab.Owner,
+		})
+	}
+	return nil
+}
+
+// scrape collects the repositories and pull requests of every provider
+func (g *gitproviderScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	if len(g.providers) == 0 {
+		return pmetric.NewMetrics(), errClientNotInit
+	}
+
+	var errs scrapererror.ScrapeErrors
+	for _, p := range g.providers {
+		g.scrapeProvider(ctx, p, &errs)
+		g.mb.EmitForResource(metadata.WithGitProvider(p.name), metadata.WithGitOwner(p.owner))
+	}
+
+	return g.mb.Emit(), errs.Combine()
+}
+
+func (g *gitproviderScraper) scrapeProvider(ctx context.Context, p ownerProvider, errs *scrapererror.ScrapeErrors) {
+	now := g.now()
+	ts := pcommon.NewTimestampFromTime(now)
+
+	repos, err := p.repositories(ctx)
+	if err != nil {
+		errs.AddPartial(1, fmt.Errorf("%s: failed to list the repositories of %q: %w", p.name, p.owner, err))
+		return
+	}
+	g.mb.RecordGitRepositoryCountDataPoint(ts, int64(len(repos)))
+
+	for _, repo := range repos {
+		created, err := p.openPullRequests(ctx, repo)
+		if err != nil {
+			errs.AddPartial(2, fmt.Errorf("%s: failed to list the open pull requests of %q: %w", p.name, repo.name, err))
+		} else {
+			g.mb.RecordGitRepositoryPullRequestOpenCountDataPoint(ts, int64(len(created)), repo.name)
+			if len(created) > 0 {
+				oldest := created[0]
+				for _, t := range created[1:] {
+					if t.Before(oldest) {
+						oldest = t
+					}
+				}
+				g.mb.RecordGitRepositoryPullRequestOpenAgeDataPoint(ts, int64(now.Sub(oldest)/time.Second), repo.name)
+			}
+		}
+
+		merged, err := p.mergedPullRequests(ctx, repo, now.Add(-g.cfg.MergedWindow))
+		if err != nil {
+			errs.AddPartial(1, fmt.Errorf("%s: failed to list the merged pull requests of %q: %w", p.name, repo.name, err))
+		} else {
+			g.mb.RecordGitRepositoryPullRequestMergedCountDataPoint(ts, int64(merged), repo.name)
+		}
+
+		// empty repositories have no default branch
+		if repo.defaultBranch == "" {
+			continue
+		}
+		protected, err := p.branchProtected(ctx, repo, repo.defaultBranch)
+		if err != nil {
+			errs.AddPartial(1, fmt.Errorf("%s: failed to get the protection of the branch %q of %q: %w", p.name, repo.defaultBranch, repo.name, err))
+			continue
+		}
+		value := int64(0)
+		if protected {
+			value = 1
+		}
+		g.mb.RecordGitRepositoryBranchProtectedDataPoint(ts, value, repo.name, repo.defaultBranch)
+	}
+}
+
+func newScraper(conf *Config, settings component.ReceiverCreateSettings) *gitproviderScraper {
+	return &gitproviderScraper{
+		cfg:      conf,
+		settings: settings.TelemetrySettings,
+		mb:       metadata.NewMetricsBuilder(conf.Metrics, settings.BuildInfo),
+		now:      time.Now,
+	}
+}
@@ -0,0 +1,229 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitproviderreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/gitproviderreceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/scrapertest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/scrapertest/golden"
+)
+
+const testToken = "secret"
+
+var scrapeTime = time.Date(2022, 11, 15, 12, 0, 0, 0, time.UTC)
+
+// newMockGitHub serves the API requests of the GitHub provider for the organization
+// acme, which has the repositories acme/api and the empty acme/docs.
+func newMockGitHub(t *testing.T) string {
+	mux := http.NewServeMux()
+	respond := func(path, query, body string) {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.RawQuery != query {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			_, _ = w.Write([]byte(body))
+		})
+	}
+	var server *httptest.Server
+	mux.HandleFunc("/orgs/acme/repos", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			_, _ = w.Write([]byte(`[{"full_name": "acme/docs", "default_branch": null}]`))
+			return
+		}
+		w.Header().Set("Link", fmt.Sprintf(`<%s/orgs/acme/repos?per_page=100&page=2>; rel="next", <%s/orgs/acme/repos?per_page=100&page=2>; rel="last"`, server.URL, server.URL))
+		_, _ = w.Write([]byte(`[{"full_name": "acme/api", "default_branch": "main"}]`))
+	})
+	respond("/repos/acme/api/pulls", "state=open&per_page=100", `[
+		{"created_at": "2022-11-15T10:00:00Z"},
+		{"created_at": "2022-11-15T11:30:00Z"}
+	]`)
+	respond("/repos/acme/docs/pulls", "state=open&per_page=100", `[]`)
+	mux.HandleFunc("/repos/acme/api/branches/main", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"name": "main", "protected": true}`))
+	})
+	server = httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	// closed pull requests are served from a separate handler, as they share the path of open ones
+	closed := map[string]string{
+		"acme/api": `[
+			{"updated_at": "2022-11-15T11:00:00Z", "merged_at": "2022-11-15T11:00:00Z"},
+			{"updated_at": "2022-11-15T10:00:00Z", "merged_at": null},
+			{"updated_at": "2022-11-13T10:00:00Z", "merged_at": "2022-11-13T10:00:00Z"}
+		]`,
+		"acme/docs": `[]`,
+	}
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		for repo, body := range closed {
+			if r.URL.Path == "/repos/"+repo+"/pulls" && r.URL.RawQuery == "state=closed&sort=updated&direction=desc&per_page=100" {
+				_, _ = w.Write([]byte(body))
+				return
+			}
+		}
+		mux.ServeHTTP(w, r)
+	})
+	return server.URL
+}
+
+// newMockGitLab serves the API requests of the GitLab provider for the group
+// acme/platform, which has the project acme/platform/web.
+func newMockGitLab(t *testing.T) string {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("PRIVATE-TOKEN") != testToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.RequestURI() {
+		case "/api/v4/groups/acme%2Fplatform/projects?include_subgroups=true&per_page=100":
+			_, _ = w.Write([]byte(`[{"id": 7, "path_with_namespace": "acme/platform/web", "default_branch": "main"}]`))
+		case "/api/v4/projects/7/merge_requests?state=opened&per_page=100":
+			_, _ = w.Write([]byte(`[{"created_at": "2022-11-14T12:00:00Z"}]`))
+		case "/api/v4/projects/7/merge_requests?state=merged&per_page=100&updated_after=2022-11-14T12%3A00%3A00Z":
+			_, _ = w.Write([]byte(`[
+				{"created_at": "2022-11-14T09:00:00Z", "merged_at": "2022-11-15T11:00:00Z"},
+				{"created_at": "2022-11-13T09:00:00Z", "merged_at": "2022-11-14T06:00:00Z"}
+			]`))
+		default:
+			// the default branch is not protected
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func TestScraperScrape(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		golden  string
+		github  bool
+		gitlab  bool
+		token   string
+		wantErr bool
+	}{
+		{
+			desc:   "GitHub",
+			golden: "github.json",
+			github: true,
+			token:  testToken,
+		},
+		{
+			desc:   "GitLab",
+			golden: "gitlab.json",
+			gitlab: true,
+			token:  testToken,
+		},
+		{
+			desc:   "GitHub and GitLab",
+			golden: "github_gitlab.json",
+			github: true,
+			gitlab: true,
+			token:  testToken,
+		},
+		{
+			desc:    "Invalid token",
+			golden:  "invalid_token.json",
+			github:  true,
+			token:   "wrong",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			if tc.github {
+				cfg.GitHub = &ProviderConfig{Token: tc.token, Owner: "acme"}
+				cfg.GitHub.Endpoint = newMockGitHub(t)
+			}
+			if tc.gitlab {
+				cfg.GitLab = &ProviderConfig{Token: tc.token, Owner: "acme/platform"}
+				cfg.GitLab.Endpoint = newMockGitLab(t)
+			}
+			require.NoError(t, cfg.Validate())
+
+			scraper := newScraper(cfg, componenttest.NewNopReceiverCreateSettings())
+			scraper.now = func() time.Time { return scrapeTime }
+			require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+			actualMetrics, err := scraper.scrape(context.Background())
+			if tc.wantErr {
+				require.ErrorContains(t, err, "401 Unauthorized")
+			} else {
+				require.NoError(t, err)
+			}
+
+			expectedMetrics, err := golden.ReadMetrics(filepath.Join("testdata", "expected_metrics", tc.golden))
+			require.NoError(t, err)
+			require.NoError(t, scrapertest.CompareMetrics(expectedMetrics, actualMetrics))
+		})
+	}
+}
+
+func TestScraperGitHubUser(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/users/jdoe/repos":
+			_, _ = w.Write([]byte(`[{"full_name": "jdoe/dotfiles", "default_branch": "main"}]`))
+		case "/repos/jdoe/dotfiles/pulls":
+			_, _ = w.Write([]byte(`[]`))
+		case "/repos/jdoe/dotfiles/branches/main":
+			_, _ = w.Write([]byte(`{"protected": false}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.GitHub = &ProviderConfig{Owner: "jdoe"}
+	cfg.GitHub.Endpoint = server.URL
+
+	scraper := newScraper(cfg, componenttest.NewNopReceiverCreateSettings())
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+	metrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	// the repository count, open and merged pull requests, and branch protection
+	require.Equal(t, 4, metrics.DataPointCount())
+}
+
+func TestScraperWithoutProviders(t *testing.T) {
+	scraper := newScraper(createDefaultConfig().(*Config), componenttest.NewNopReceiverCreateSettings())
+	_, err := scraper.scrape(context.Background())
+	require.True(t, errors.Is(err, errClientNotInit))
+}
+
+func TestNextLink(t *testing.T) {
+	require.Equal(t, "", nextLink(""))
+	require.Equal(t, "https://gitlab.com/api/v4/projects?page=2",
+		nextLink(`<https://gitlab.com/api/v4/projects?page=2>; rel="next", <https://gitlab.com/api/v4/projects?page=3>; rel="last"`))
+	require.Equal(t, "", nextLink(`<https://api.github.com/orgs/acme/repos?page=1>; rel="prev"`))
+}
@@ -47,6 +47,18 @@ type Config struct {
 
 	// The client id that will be used by Pubsub to make load balancing decisions
 	ClientID string `mapstructure:"client_id"`
+
+	// Flow control limits of the streaming pull
+	FlowControl FlowControlSettings `mapstructure:"flow_control"`
+}
+
+// FlowControlSettings limits the messages Pubsub delivers to the receiver before they are
+// acknowledged. Zero values leave the limits to Pubsub.
+type FlowControlSettings struct {
+	// Maximum number of unacknowledged messages
+	MaxOutstandingMessages int64 `mapstructure:"max_outstanding_messages"`
+	// Maximum size in bytes of the unacknowledged messages
+	MaxOutstandingBytes int64 `mapstructure:"max_outstanding_bytes"`
 }
 
 func (config *Config) validateForLog() error {
@@ -59,8 +71,9 @@ func (config *Config) validateForLog() error {
 	case "otlp_proto_log":
 	case "raw_text":
 	case "raw_json":
+	case "cloud_logging":
 	default:
-		return fmt.Errorf("log encoding %v is not supported.  supported encoding formats include [otlp_proto_log,raw_text,raw_json]", config.Encoding)
+		return fmt.Errorf("log encoding %v is not supported.  supported encoding formats include [otlp_proto_log,raw_text,raw_json,cloud_logging]", config.Encoding)
 	}
 	return nil
 }
@@ -103,5 +116,11 @@ func (config *Config) validate() error {
 	default:
 		return fmt.Errorf("compression %v is not supported.  supported compression formats include [gzip]", config.Compression)
 	}
+	if config.FlowControl.MaxOutstandingMessages < 0 {
+		return fmt.Errorf("flow_control.max_outstanding_messages %v must not be negative", config.FlowControl.MaxOutstandingMessages)
+	}
+	if config.FlowControl.MaxOutstandingBytes < 0 {
+		return fmt.Errorf("flow_control.max_outstanding_bytes %v must not be negative", config.FlowControl.MaxOutstandingBytes)
+	}
 	return nil
 }
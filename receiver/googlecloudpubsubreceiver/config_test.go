@@ -53,6 +53,10 @@ func TestLoadConfig(t *testing.T) {
 					Timeout: 20 * time.Second,
 				},
 				Subscription: "projects/my-project/subscriptions/otlp-subscription",
+				FlowControl: FlowControlSettings{
+					MaxOutstandingMessages: 1000,
+					MaxOutstandingBytes:    104857600,
+				},
 			},
 		},
 	}
@@ -84,6 +88,11 @@ func TestConfigValidation(t *testing.T) {
 	assert.Error(t, c.validate())
 	c.Subscription = "projects/my-project/subscriptions/my-subscription"
 	assert.NoError(t, c.validate())
+	c.FlowControl.MaxOutstandingMessages = -1
+	assert.Error(t, c.validate())
+	c.FlowControl.MaxOutstandingMessages = 0
+	c.FlowControl.MaxOutstandingBytes = -1
+	assert.Error(t, c.validate())
 }
 
 func TestTraceConfigValidation(t *testing.T) {
@@ -100,6 +109,8 @@ func TestTraceConfigValidation(t *testing.T) {
 	assert.Error(t, c.validateForTrace())
 	c.Encoding = "raw_json"
 	assert.Error(t, c.validateForTrace())
+	c.Encoding = "cloud_logging"
+	assert.Error(t, c.validateForTrace())
 
 	c.Encoding = "otlp_proto_trace"
 	assert.NoError(t, c.validateForTrace())
@@ -119,6 +130,8 @@ func TestMetricConfigValidation(t *testing.T) {
 	assert.Error(t, c.validateForMetric())
 	c.Encoding = "raw_json"
 	assert.Error(t, c.validateForMetric())
+	c.Encoding = "cloud_logging"
+	assert.Error(t, c.validateForMetric())
 
 	c.Encoding = "otlp_proto_metric"
 	assert.NoError(t, c.validateForMetric())
@@ -141,4 +154,6 @@ func TestLogConfigValidation(t *testing.T) {
 	assert.NoError(t, c.validateForLog())
 	c.Encoding = "otlp_proto_log"
 	assert.NoError(t, c.validateForLog())
+	c.Encoding = "cloud_logging"
+	assert.NoError(t, c.validateForLog())
 }
@@ -29,6 +29,7 @@ const (
 	stability            = component.StabilityLevelBeta
 	reportTransport      = "pubsub"
 	reportFormatProtobuf = "protobuf"
+	reportFormatJSON     = "json"
 )
 
 func NewFactory() component.ReceiverFactory {
@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 	"sync"
 	"time"
 
@@ -26,6 +27,7 @@ import (
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 	pubsubpb "google.golang.org/genproto/googleapis/pubsub/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -33,6 +35,16 @@ import (
 // Time to wait before restarting, when the stream stopped
 const streamRecoveryBackoffPeriod = 250 * time.Millisecond
 
+// Time limit of the Acknowledge calls of subscriptions with exactly-once delivery
+const exactlyOnceAckTimeout = 30 * time.Second
+
+// FlowControl limits the messages the server sends on the stream before they are
+// acknowledged. Zero values leave the limit to the server.
+type FlowControl struct {
+	MaxOutstandingMessages int64
+	MaxOutstandingBytes    int64
+}
+
 type StreamHandler struct {
 	stream      pubsubpb.Subscriber_StreamingPullClient
 	pushMessage func(ctx context.Context, message *pubsubpb.ReceivedMessage) error
@@ -42,6 +54,7 @@ type StreamHandler struct {
 
 	clientID     string
 	subscription string
+	flowControl  FlowControl
 
 	cancel context.CancelFunc
 	// wait group for the send/receive function
@@ -53,6 +66,9 @@ type StreamHandler struct {
 	ackBatchWait time.Duration
 
 	isRunning atomic.Bool
+	// exactlyOnce is set when the subscription has exactly-once delivery enabled. The
+	// acknowledgements are then sent with the Acknowledge method, which reports their result.
+	exactlyOnce atomic.Bool
 }
 
 func (handler *StreamHandler) ack(ackID string) {
@@ -67,6 +83,7 @@ func NewHandler(
 	client *pubsub.SubscriberClient,
 	clientID string,
 	subscription string,
+	flowControl FlowControl,
 	callback func(ctx context.Context, message *pubsubpb.ReceivedMessage) error) (*StreamHandler, error) {
 
 	handler := StreamHandler{
@@ -74,6 +91,7 @@ func NewHandler(
 		client:       client,
 		clientID:     clientID,
 		subscription: subscription,
+		flowControl:  flowControl,
 		pushMessage:  callback,
 		ackBatchWait: 10 * time.Second,
 	}
@@ -92,6 +110,8 @@ func (handler *StreamHandler) initStream(ctx context.Context) error {
 		Subscription:             handler.subscription,
 		StreamAckDeadlineSeconds: 60,
 		ClientId:                 handler.clientID,
+		MaxOutstandingMessages:   handler.flowControl.MaxOutstandingMessages,
+		MaxOutstandingBytes:      handler.flowControl.MaxOutstandingBytes,
 	}
 	if err := handler.stream.Send(&request); err != nil {
 		_ = handler.stream.CloseSend()
@@ -153,17 +173,83 @@ func (handler *StreamHandler) Wait() {
 
 func (handler *StreamHandler) acknowledgeMessages() error {
 	handler.mutex.Lock()
-	defer handler.mutex.Unlock()
-	if len(handler.acks) == 0 {
+	acks := handler.acks
+	handler.acks = nil
+	handler.mutex.Unlock()
+	if len(acks) == 0 {
+		return nil
+	}
+	if handler.exactlyOnce.Load() {
+		handler.acknowledgeExactlyOnce(acks)
 		return nil
 	}
 	request := pubsubpb.StreamingPullRequest{
-		AckIds: handler.acks,
+		AckIds: acks,
 	}
-	handler.acks = nil
 	return handler.stream.Send(&request)
 }
 
+// acknowledgeExactlyOnce acknowledges the messages of a subscription with exactly-once
+// delivery. The acknowledgements which failed transiently are retried with the next batch,
+// while the ones which failed permanently, e.g. because their deadline expired, are dropped
+// and the messages will be redelivered.
+func (handler *StreamHandler) acknowledgeExactlyOnce(ackIDs []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), exactlyOnceAckTimeout)
+	defer cancel()
+	err := handler.client.Acknowledge(ctx, &pubsubpb.AcknowledgeRequest{
+		Subscription: handler.subscription,
+		AckIds:       ackIDs,
+	})
+	if err == nil {
+		return
+	}
+	retry, failed := ackFailures(err, ackIDs)
+	if len(failed) > 0 {
+		handler.logger.Warn("Failed to acknowledge messages, they will be redelivered",
+			zap.Int("count", len(failed)),
+			zap.Error(err))
+	}
+	if len(retry) > 0 {
+		handler.logger.Debug("Retrying the acknowledgement of messages", zap.Int("count", len(retry)))
+		handler.mutex.Lock()
+		handler.acks = append(handler.acks, retry...)
+		handler.mutex.Unlock()
+	}
+}
+
+// ackFailures splits the acknowledgements of a failed Acknowledge call into the ones to retry
+// and the ones which failed permanently. Per acknowledgement results are reported in the
+// metadata of the ErrorInfo details of the error, the acknowledgements missing from it succeeded.
+func ackFailures(err error, ackIDs []string) (retry []string, failed []string) {
+	s, ok := status.FromError(err)
+	if !ok {
+		return nil, ackIDs
+	}
+	for _, detail := range s.Details() {
+		info, ok := detail.(*errdetails.ErrorInfo)
+		if !ok {
+			continue
+		}
+		for _, ackID := range ackIDs {
+			reason, ok := info.Metadata[ackID]
+			switch {
+			case !ok:
+			case strings.HasPrefix(reason, "TRANSIENT_"):
+				retry = append(retry, ackID)
+			default:
+				failed = append(failed, ackID)
+			}
+		}
+		return retry, failed
+	}
+	switch s.Code() {
+	case codes.DeadlineExceeded, codes.Internal, codes.ResourceExhausted, codes.Aborted, codes.Unavailable:
+		return ackIDs, nil
+	default:
+		return nil, ackIDs
+	}
+}
+
 func (handler *StreamHandler) requestStream(ctx context.Context, cancel context.CancelFunc) {
 	timer := time.NewTimer(handler.ackBatchWait)
 	for {
@@ -199,6 +285,9 @@ func (handler *StreamHandler) responseStream(ctx context.Context, cancel context
 		// block until the next message or timeout expires
 		resp, err := handler.stream.Recv()
 		if err == nil {
+			if properties := resp.SubscriptionProperties; properties != nil {
+				handler.exactlyOnce.Store(properties.ExactlyOnceDeliveryEnabled)
+			}
 			for _, message := range resp.ReceivedMessages {
 				// handle all the messages in the response, could be one or more
 				err = handler.pushMessage(context.Background(), message)
@@ -22,11 +22,15 @@ import (
 	pubsub "cloud.google.com/go/pubsub/apiv1"
 	"cloud.google.com/go/pubsub/pstest"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 	"google.golang.org/api/option"
 	pubsubpb "google.golang.org/genproto/googleapis/pubsub/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 )
 
 func TestCancelStream(t *testing.T) {
@@ -53,7 +57,7 @@ func TestCancelStream(t *testing.T) {
 	client, err := pubsub.NewSubscriberClient(ctx, copts...)
 	assert.NoError(t, err)
 
-	handler, err := NewHandler(context.Background(), zaptest.NewLogger(t), client, "client-id", "projects/my-project/subscriptions/otlp",
+	handler, err := NewHandler(context.Background(), zaptest.NewLogger(t), client, "client-id", "projects/my-project/subscriptions/otlp", FlowControl{},
 		func(ctx context.Context, message *pubsubpb.ReceivedMessage) error {
 			return nil
 		})
@@ -70,3 +74,27 @@ func TestCancelStream(t *testing.T) {
 	}()
 	handler.Wait()
 }
+
+func TestAckFailures(t *testing.T) {
+	ackIDs := []string{"ack-1", "ack-2", "ack-3"}
+
+	s, err := status.New(codes.InvalidArgument, "some acknowledgements failed").WithDetails(&errdetails.ErrorInfo{
+		Reason: "EXACTLY_ONCE_ACKID_FAILURE",
+		Metadata: map[string]string{
+			"ack-1": "TRANSIENT_FAILURE_UNORDERED_ACK_ID",
+			"ack-3": "PERMANENT_FAILURE_INVALID_ACK_ID",
+		},
+	})
+	require.NoError(t, err)
+	retry, failed := ackFailures(s.Err(), ackIDs)
+	assert.Equal(t, []string{"ack-1"}, retry)
+	assert.Equal(t, []string{"ack-3"}, failed)
+
+	retry, failed = ackFailures(status.Error(codes.Unavailable, "unavailable"), ackIDs)
+	assert.Equal(t, ackIDs, retry)
+	assert.Empty(t, failed)
+
+	retry, failed = ackFailures(status.Error(codes.PermissionDenied, "denied"), ackIDs)
+	assert.Empty(t, retry)
+	assert.Equal(t, ackIDs, failed)
+}
@@ -0,0 +1,266 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/googlecloudpubsubreceiver/internal"
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+)
+
+const (
+	gcpResourceTypeAttribute      = "gcp.resource_type"
+	gcpResourceLabelPrefix        = "gcp."
+	gcpLogNameAttribute           = "gcp.log_name"
+	gcpInsertIDAttribute          = "gcp.insert_id"
+	gcpOperationIDAttribute       = "gcp.operation.id"
+	gcpOperationProducerAttribute = "gcp.operation.producer"
+	gcpHTTPLatencyAttribute       = "gcp.http_request.latency"
+)
+
+var errMissingLogEntryTimestamp = errors.New("log entry without timestamp")
+
+// logEntry is the JSON representation of the LogEntry messages that Cloud Logging sinks
+// publish to Pubsub topics.
+//
+// More details can be found at:
+// https://cloud.google.com/logging/docs/reference/v2/rest/v2/LogEntry
+type logEntry struct {
+	LogName          string                  `json:"logName"`
+	Resource         monitoredResource       `json:"resource"`
+	Timestamp        string                  `json:"timestamp"`
+	ReceiveTimestamp string                  `json:"receiveTimestamp"`
+	Severity         string                  `json:"severity"`
+	InsertID         string                  `json:"insertId"`
+	HTTPRequest      *httpRequest            `json:"httpRequest"`
+	Labels           map[string]string       `json:"labels"`
+	Operation        *logEntryOperation      `json:"operation"`
+	Trace            string                  `json:"trace"`
+	SpanID           string                  `json:"spanId"`
+	TraceSampled     bool                    `json:"traceSampled"`
+	SourceLocation   *logEntrySourceLocation `json:"sourceLocation"`
+	TextPayload      *string                 `json:"textPayload"`
+	JSONPayload      map[string]interface{}  `json:"jsonPayload"`
+	ProtoPayload     map[string]interface{}  `json:"protoPayload"`
+}
+
+type monitoredResource struct {
+	Type   string            `json:"type"`
+	Labels map[string]string `json:"labels"`
+}
+
+type httpRequest struct {
+	RequestMethod string `json:"requestMethod"`
+	RequestURL    string `json:"requestUrl"`
+	RequestSize   string `json:"requestSize"`
+	Status        int64  `json:"status"`
+	ResponseSize  string `json:"responseSize"`
+	UserAgent     string `json:"userAgent"`
+	RemoteIP      string `json:"remoteIp"`
+	Protocol      string `json:"protocol"`
+	Latency       string `json:"latency"`
+}
+
+type logEntryOperation struct {
+	ID       string `json:"id"`
+	Producer string `json:"producer"`
+}
+
+type logEntrySourceLocation struct {
+	File     string `json:"file"`
+	Line     string `json:"line"`
+	Function string `json:"function"`
+}
+
+// TranslateLogEntry translates the JSON representation of a Cloud Logging LogEntry into
+// a log record. The monitored resource of the entry becomes the resource, its type as
+// gcp.resource_type and its labels prefixed with gcp., e.g. gcp.project_id.
+func TranslateLogEntry(data []byte) (plog.Logs, error) {
+	var entry logEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return plog.Logs{}, err
+	}
+
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	attrs := rl.Resource().Attributes()
+	attrs.PutStr(conventions.AttributeCloudProvider, conventions.AttributeCloudProviderGCP)
+	if entry.Resource.Type != "" {
+		attrs.PutStr(gcpResourceTypeAttribute, entry.Resource.Type)
+	}
+	for k, v := range entry.Resource.Labels {
+		attrs.PutStr(gcpResourceLabelPrefix+k, v)
+	}
+
+	lr := rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	if err := translateLogEntry(entry, lr); err != nil {
+		return plog.Logs{}, err
+	}
+	return ld, nil
+}
+
+func translateLogEntry(entry logEntry, lr plog.LogRecord) error {
+	timestamp := entry.Timestamp
+	if timestamp == "" {
+		timestamp = entry.ReceiveTimestamp
+	}
+	if timestamp == "" {
+		return errMissingLogEntryTimestamp
+	}
+	ts, err := time.Parse(time.RFC3339Nano, timestamp)
+	if err != nil {
+		return fmt.Errorf("invalid timestamp: %w", err)
+	}
+	lr.SetTimestamp(pcommon.NewTimestampFromTime(ts))
+	if entry.ReceiveTimestamp != "" {
+		observed, err := time.Parse(time.RFC3339Nano, entry.ReceiveTimestamp)
+		if err != nil {
+			return fmt.Errorf("invalid receive timestamp: %w", err)
+		}
+		lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(observed))
+	}
+
+	if entry.Severity != "" {
+		lr.SetSeverityText(entry.Severity)
+		lr.SetSeverityNumber(logEntrySeverity(entry.Severity))
+	}
+
+	if entry.Trace != "" {
+		traceID, err := parseTraceID(entry.Trace)
+		if err != nil {
+			return err
+		}
+		lr.SetTraceID(traceID)
+	}
+	if entry.SpanID != "" {
+		spanID, err := parseSpanID(entry.SpanID)
+		if err != nil {
+			return err
+		}
+		lr.SetSpanID(spanID)
+	}
+	if entry.TraceSampled {
+		lr.SetFlags(plog.DefaultLogRecordFlags.WithIsSampled(true))
+	}
+
+	switch {
+	case entry.TextPayload != nil:
+		lr.Body().SetStr(*entry.TextPayload)
+	case entry.JSONPayload != nil:
+		lr.Body().SetEmptyMap().FromRaw(entry.JSONPayload)
+	case entry.ProtoPayload != nil:
+		lr.Body().SetEmptyMap().FromRaw(entry.ProtoPayload)
+	}
+
+	attrs := lr.Attributes()
+	putStr := func(k, v string) {
+		if v != "" {
+			attrs.PutStr(k, v)
+		}
+	}
+	putStr(gcpLogNameAttribute, entry.LogName)
+	putStr(gcpInsertIDAttribute, entry.InsertID)
+	for k, v := range entry.Labels {
+		attrs.PutStr(k, v)
+	}
+	if op := entry.Operation; op != nil {
+		putStr(gcpOperationIDAttribute, op.ID)
+		putStr(gcpOperationProducerAttribute, op.Producer)
+	}
+	if loc := entry.SourceLocation; loc != nil {
+		putStr(conventions.AttributeCodeFilepath, loc.File)
+		putStr(conventions.AttributeCodeFunction, loc.Function)
+		if line, err := strconv.ParseInt(loc.Line, 10, 64); err == nil {
+			attrs.PutInt(conventions.AttributeCodeLineNumber, line)
+		}
+	}
+	if req := entry.HTTPRequest; req != nil {
+		putStr(conventions.AttributeHTTPMethod, req.RequestMethod)
+		putStr(conventions.AttributeHTTPURL, req.RequestURL)
+		putStr(conventions.AttributeHTTPUserAgent, req.UserAgent)
+		putStr(conventions.AttributeHTTPClientIP, req.RemoteIP)
+		putStr(conventions.AttributeHTTPFlavor, strings.TrimPrefix(req.Protocol, "HTTP/"))
+		putStr(gcpHTTPLatencyAttribute, req.Latency)
+		if req.Status != 0 {
+			attrs.PutInt(conventions.AttributeHTTPStatusCode, req.Status)
+		}
+		if size, err := strconv.ParseInt(req.RequestSize, 10, 64); err == nil {
+			attrs.PutInt(conventions.AttributeHTTPRequestContentLength, size)
+		}
+		if size, err := strconv.ParseInt(req.ResponseSize, 10, 64); err == nil {
+			attrs.PutInt(conventions.AttributeHTTPResponseContentLength, size)
+		}
+	}
+	return nil
+}
+
+// logEntrySeverity maps the LogSeverity of Cloud Logging to severity numbers.
+func logEntrySeverity(severity string) plog.SeverityNumber {
+	switch severity {
+	case "DEBUG":
+		return plog.SeverityNumberDebug
+	case "INFO":
+		return plog.SeverityNumberInfo
+	case "NOTICE":
+		return plog.SeverityNumberInfo2
+	case "WARNING":
+		return plog.SeverityNumberWarn
+	case "ERROR":
+		return plog.SeverityNumberError
+	case "CRITICAL":
+		return plog.SeverityNumberFatal
+	case "ALERT":
+		return plog.SeverityNumberFatal2
+	case "EMERGENCY":
+		return plog.SeverityNumberFatal4
+	default:
+		return plog.SeverityNumberUnspecified
+	}
+}
+
+// parseTraceID parses the trace of a log entry, which is either the hex trace ID or the
+// resource name of the trace, i.e. projects/<project_id>/traces/<trace_id>.
+func parseTraceID(trace string) (pcommon.TraceID, error) {
+	if i := strings.LastIndexByte(trace, '/'); i >= 0 {
+		trace = trace[i+1:]
+	}
+	var traceID pcommon.TraceID
+	if len(trace) != hex.EncodedLen(len(traceID)) {
+		return traceID, fmt.Errorf("invalid trace %q", trace)
+	}
+	if _, err := hex.Decode(traceID[:], []byte(trace)); err != nil {
+		return traceID, fmt.Errorf("invalid trace %q: %w", trace, err)
+	}
+	return traceID, nil
+}
+
+func parseSpanID(span string) (pcommon.SpanID, error) {
+	var spanID pcommon.SpanID
+	if len(span) != hex.EncodedLen(len(spanID)) {
+		return spanID, fmt.Errorf("invalid span ID %q", span)
+	}
+	if _, err := hex.Decode(spanID[:], []byte(span)); err != nil {
+		return spanID, fmt.Errorf("invalid span ID %q: %w", span, err)
+	}
+	return spanID, nil
+}
@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func TestTranslateLogEntry(t *testing.T) {
+	logs, err := TranslateLogEntry([]byte(`{
+		"insertId": "42",
+		"logName": "projects/my-project/logs/run.googleapis.com%2Frequests",
+		"resource": {
+			"type": "cloud_run_revision",
+			"labels": {"project_id": "my-project", "service_name": "api", "location": "europe-west1"}
+		},
+		"timestamp": "2022-11-14T10:00:00.123456789Z",
+		"receiveTimestamp": "2022-11-14T10:00:01Z",
+		"severity": "WARNING",
+		"labels": {"instanceId": "00bf4bf02d"},
+		"operation": {"id": "op-1", "producer": "github.com/MyProject/MyApplication"},
+		"trace": "projects/my-project/traces/0102030405060708090a0b0c0d0e0f10",
+		"spanId": "0102030405060708",
+		"traceSampled": true,
+		"sourceLocation": {"file": "main.go", "line": "42", "function": "main.handle"},
+		"httpRequest": {
+			"requestMethod": "GET",
+			"requestUrl": "https://api.example.com/users",
+			"requestSize": "120",
+			"status": 503,
+			"responseSize": "2048",
+			"userAgent": "curl/7.79.1",
+			"remoteIp": "10.0.0.1",
+			"protocol": "HTTP/1.1",
+			"latency": "0.250s"
+		},
+		"jsonPayload": {"message": "slow upstream", "retries": 2}
+	}`))
+	require.NoError(t, err)
+	require.Equal(t, 1, logs.LogRecordCount())
+
+	rl := logs.ResourceLogs().At(0)
+	assert.Equal(t, map[string]interface{}{
+		"cloud.provider":    "gcp",
+		"gcp.resource_type": "cloud_run_revision",
+		"gcp.project_id":    "my-project",
+		"gcp.service_name":  "api",
+		"gcp.location":      "europe-west1",
+	}, rl.Resource().Attributes().AsRaw())
+
+	lr := rl.ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, time.Date(2022, 11, 14, 10, 0, 0, 123456789, time.UTC), lr.Timestamp().AsTime())
+	assert.Equal(t, time.Date(2022, 11, 14, 10, 0, 1, 0, time.UTC), lr.ObservedTimestamp().AsTime())
+	assert.Equal(t, "WARNING", lr.SeverityText())
+	assert.Equal(t, plog.SeverityNumberWarn, lr.SeverityNumber())
+	assert.Equal(t, "0102030405060708090a0b0c0d0e0f10", lr.TraceID().HexString())
+	assert.Equal(t, "0102030405060708", lr.SpanID().HexString())
+	assert.True(t, lr.Flags().IsSampled())
+	assert.Equal(t, map[string]interface{}{
+		"message": "slow upstream",
+		"retries": float64(2),
+	}, lr.Body().Map().AsRaw())
+	assert.Equal(t, map[string]interface{}{
+		"gcp.log_name":                 "projects/my-project/logs/run.googleapis.com%2Frequests",
+		"gcp.insert_id":                "42",
+		"instanceId":                   "00bf4bf02d",
+		"gcp.operation.id":             "op-1",
+		"gcp.operation.producer":       "github.com/MyProject/MyApplication",
+		"code.filepath":                "main.go",
+		"code.function":                "main.handle",
+		"code.lineno":                  int64(42),
+		"http.method":                  "GET",
+		"http.url":                     "https://api.example.com/users",
+		"http.user_agent":              "curl/7.79.1",
+		"http.client_ip":               "10.0.0.1",
+		"http.flavor":                  "1.1",
+		"http.status_code":             int64(503),
+		"http.request_content_length":  int64(120),
+		"http.response_content_length": int64(2048),
+		"gcp.http_request.latency":     "0.250s",
+	}, lr.Attributes().AsRaw())
+}
+
+func TestTranslateLogEntryTextPayload(t *testing.T) {
+	logs, err := TranslateLogEntry([]byte(`{"timestamp":"2022-11-14T10:00:00Z","textPayload":"hello world","trace":"0102030405060708090a0b0c0d0e0f10"}`))
+	require.NoError(t, err)
+	lr := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "hello world", lr.Body().Str())
+	assert.Equal(t, "0102030405060708090a0b0c0d0e0f10", lr.TraceID().HexString())
+	assert.Equal(t, plog.SeverityNumberUnspecified, lr.SeverityNumber())
+}
+
+func TestTranslateLogEntryInvalid(t *testing.T) {
+	tests := map[string]string{
+		"json":      `hello world`,
+		"timestamp": `{"textPayload":"hello world"}`,
+		"trace":     `{"timestamp":"2022-11-14T10:00:00Z","trace":"projects/my-project/traces/abc"}`,
+		"span":      `{"timestamp":"2022-11-14T10:00:00Z","spanId":"xyz"}`,
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := TranslateLogEntry([]byte(data))
+			assert.Error(t, err)
+		})
+	}
+}
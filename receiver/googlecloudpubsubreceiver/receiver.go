@@ -66,8 +66,12 @@ const (
 	otlpProtoMetric          = iota
 	otlpProtoLog             = iota
 	rawTextLog               = iota
+	cloudLoggingLog          = iota
 )
 
+// cloudLoggingTimestampAttribute is set on the messages which Cloud Logging sinks publish.
+const cloudLoggingTimestampAttribute = "logging.googleapis.com/timestamp"
+
 type compression int
 
 const (
@@ -206,6 +210,21 @@ func (receiver *pubsubReceiver) handleLog(ctx context.Context, payload []byte, c
 	return nil
 }
 
+func (receiver *pubsubReceiver) handleCloudLoggingLog(ctx context.Context, payload []byte, compression compression) error {
+	payload, err := decompress(payload, compression)
+	if err != nil {
+		return err
+	}
+	logs, err := internal.TranslateLogEntry(payload)
+	if err != nil {
+		return err
+	}
+	ctx = receiver.obsrecv.StartLogsOp(ctx)
+	err = receiver.logsConsumer.ConsumeLogs(ctx, logs)
+	receiver.obsrecv.EndLogsOp(ctx, reportFormatJSON, logs.LogRecordCount(), err)
+	return nil
+}
+
 func (receiver *pubsubReceiver) detectEncoding(attributes map[string]string) (encoding, compression) {
 	otlpEncoding := unknown
 	otlpCompression := uncompressed
@@ -223,6 +242,8 @@ func (receiver *pubsubReceiver) detectEncoding(attributes map[string]string) (en
 		}
 	} else if strings.HasSuffix(ceContentType, "text/plain") {
 		otlpEncoding = rawTextLog
+	} else if _, ok := attributes[cloudLoggingTimestampAttribute]; ok {
+		otlpEncoding = cloudLoggingLog
 	}
 
 	if otlpEncoding == unknown && receiver.config.Encoding != "" {
@@ -235,6 +256,8 @@ func (receiver *pubsubReceiver) detectEncoding(attributes map[string]string) (en
 			otlpEncoding = otlpProtoLog
 		case "raw_text":
 			otlpEncoding = rawTextLog
+		case "cloud_logging":
+			otlpEncoding = cloudLoggingLog
 		}
 	}
 
@@ -259,6 +282,10 @@ func (receiver *pubsubReceiver) createReceiverHandler(ctx context.Context) error
 		receiver.client,
 		receiver.config.ClientID,
 		receiver.config.Subscription,
+		internal.FlowControl{
+			MaxOutstandingMessages: receiver.config.FlowControl.MaxOutstandingMessages,
+			MaxOutstandingBytes:    receiver.config.FlowControl.MaxOutstandingBytes,
+		},
 		func(ctx context.Context, message *pubsubpb.ReceivedMessage) error {
 			payload := message.Message.Data
 			encoding, compression := receiver.detectEncoding(message.Message.Attributes)
@@ -278,6 +305,10 @@ func (receiver *pubsubReceiver) createReceiverHandler(ctx context.Context) error
 				}
 			case rawTextLog:
 				return receiver.handleLogStrings(ctx, message)
+			case cloudLoggingLog:
+				if receiver.logsConsumer != nil {
+					return receiver.handleCloudLoggingLog(ctx, payload, compression)
+				}
 			}
 			return errors.New("unknown encoding")
 		})
@@ -163,6 +163,16 @@ func TestReceiver(t *testing.T) {
 		return len(logSink.AllLogs()) == 1
 	}, time.Second, 10*time.Millisecond)
 
+	// Test a Cloud Logging log entry
+	logSink.Reset()
+	srv.Publish("projects/my-project/topics/otlp", testdata.CreateLogEntryExport(), map[string]string{
+		"logging.googleapis.com/timestamp": "2022-11-14T10:00:00.123456Z",
+	})
+	assert.Eventually(t, func() bool {
+		return len(logSink.AllLogs()) == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, "hello world", logSink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().Str())
+
 	assert.Nil(t, receiver.Shutdown(ctx))
 	assert.Nil(t, receiver.Shutdown(ctx))
 }
@@ -72,3 +72,7 @@ func CreateGZippedLogExport() []byte {
 func CreateTextExport() []byte {
 	return []byte("this is text")
 }
+
+func CreateLogEntryExport() []byte {
+	return []byte(`{"insertId":"1a2b3c","logName":"projects/my-project/logs/stdout","resource":{"type":"k8s_container","labels":{"project_id":"my-project"}},"severity":"INFO","textPayload":"hello world","timestamp":"2022-11-14T10:00:00.123456Z","receiveTimestamp":"2022-11-14T10:00:01Z"}`)
+}
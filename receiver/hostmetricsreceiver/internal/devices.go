@@ -0,0 +1,180 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
+)
+
+// virtualDevicePrefixes are the name prefixes of block devices which are not backed by
+// physical storage, such as the loop devices used by snap packages.
+var virtualDevicePrefixes = []string{"loop", "ram", "zram", "nbd"}
+
+// IsVirtualDevice returns true if the device is a loop, ram, zram or nbd block device, or
+// the pseudo device of an overlay filesystem. The device may be given by name or by path.
+func IsVirtualDevice(device string) bool {
+	name := filepath.Base(device)
+	if name == "overlay" {
+		return true
+	}
+	for _, prefix := range virtualDevicePrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// DeviceIdentifiers are the filesystem labels and UUIDs of a device.
+type DeviceIdentifiers struct {
+	Labels []string
+	UUIDs  []string
+}
+
+// LookupDeviceIdentifiers returns the filesystem labels and UUIDs of the devices known
+// to udev, keyed by device name (e.g. `sda1`). The links are read from `/dev/disk/by-label`
+// and `/dev/disk/by-uuid` under rootPath; an empty map is returned where they do not exist.
+func LookupDeviceIdentifiers(rootPath string) (map[string]DeviceIdentifiers, error) {
+	ids := map[string]DeviceIdentifiers{}
+	labels, err := readDeviceLinks(filepath.Join(rootPath, "/dev/disk/by-label"))
+	if err != nil {
+		return nil, err
+	}
+	for device, names := range labels {
+		id := ids[device]
+		id.Labels = names
+		ids[device] = id
+	}
+
+	uuids, err := readDeviceLinks(filepath.Join(rootPath, "/dev/disk/by-uuid"))
+	if err != nil {
+		return nil, err
+	}
+	for device, names := range uuids {
+		id := ids[device]
+		id.UUIDs = names
+		ids[device] = id
+	}
+	return ids, nil
+}
+
+// readDeviceLinks maps the name of each device linked from dir to the unescaped names of
+// the links pointing to it.
+func readDeviceLinks(dir string) (map[string][]string, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	links := map[string][]string{}
+	for _, entry := range entries {
+		target, err := os.Readlink(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			continue
+		}
+		device := filepath.Base(target)
+		links[device] = append(links[device], unescapeUdevName(entry.Name()))
+	}
+	return links, nil
+}
+
+// unescapeUdevName decodes the `\xNN` sequences udev uses to encode characters, such as
+// spaces and slashes, which are not allowed in link names.
+func unescapeUdevName(name string) string {
+	if !strings.Contains(name, `\x`) {
+		return name
+	}
+	var sb strings.Builder
+	for i := 0; i < len(name); i++ {
+		if name[i] == '\\' && i+3 < len(name) && name[i+1] == 'x' {
+			if b, err := strconv.ParseUint(name[i+2:i+4], 16, 8); err == nil {
+				sb.WriteByte(byte(b))
+				i += 3
+				continue
+			}
+		}
+		sb.WriteByte(name[i])
+	}
+	return sb.String()
+}
+
+// DeviceMatcher matches devices by name, filesystem label or filesystem UUID.
+type DeviceMatcher struct {
+	devices filterset.FilterSet
+	labels  filterset.FilterSet
+	uuids   filterset.FilterSet
+}
+
+// NewDeviceMatcher creates a DeviceMatcher for the given device names, labels and UUIDs,
+// all matched according to cfg. It returns nil if all of them are empty.
+func NewDeviceMatcher(cfg *filterset.Config, devices, labels, uuids []string) (*DeviceMatcher, error) {
+	if len(devices) == 0 && len(labels) == 0 && len(uuids) == 0 {
+		return nil, nil
+	}
+
+	var err error
+	m := &DeviceMatcher{}
+	if len(devices) > 0 {
+		if m.devices, err = filterset.CreateFilterSet(devices, cfg); err != nil {
+			return nil, err
+		}
+	}
+	if len(labels) > 0 {
+		if m.labels, err = filterset.CreateFilterSet(labels, cfg); err != nil {
+			return nil, err
+		}
+	}
+	if len(uuids) > 0 {
+		if m.uuids, err = filterset.CreateFilterSet(uuids, cfg); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// NeedsIdentifiers returns true if the matcher matches on labels or UUIDs.
+func (m *DeviceMatcher) NeedsIdentifiers() bool {
+	return m != nil && (m.labels != nil || m.uuids != nil)
+}
+
+// Matches returns true if the device name, or any of its labels or UUIDs, matches.
+func (m *DeviceMatcher) Matches(device string, ids DeviceIdentifiers) bool {
+	if m.devices != nil && m.devices.Matches(device) {
+		return true
+	}
+	if m.labels != nil && matchesAny(m.labels, ids.Labels) {
+		return true
+	}
+	return m.uuids != nil && matchesAny(m.uuids, ids.UUIDs)
+}
+
+func matchesAny(filter filterset.FilterSet, values []string) bool {
+	for _, v := range values {
+		if filter.Matches(v) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
+)
+
+func TestIsVirtualDevice(t *testing.T) {
+	for _, device := range []string{"loop0", "/dev/loop12", "ram0", "zram0", "nbd3", "overlay"} {
+		assert.True(t, IsVirtualDevice(device), device)
+	}
+	for _, device := range []string{"sda", "/dev/sda1", "nvme0n1", "dm-0", "/dev/mapper/vg-root", "tmpfs"} {
+		assert.False(t, IsVirtualDevice(device), device)
+	}
+}
+
+func TestLookupDeviceIdentifiers(t *testing.T) {
+	rootPath := t.TempDir()
+	byLabel := filepath.Join(rootPath, "dev", "disk", "by-label")
+	byUUID := filepath.Join(rootPath, "dev", "disk", "by-uuid")
+	require.NoError(t, os.MkdirAll(byLabel, 0700))
+	require.NoError(t, os.MkdirAll(byUUID, 0700))
+	require.NoError(t, os.Symlink("../../sda1", filepath.Join(byLabel, "root")))
+	require.NoError(t, os.Symlink("../../sdb1", filepath.Join(byLabel, `data\x20disk`)))
+	require.NoError(t, os.Symlink("../../sda1", filepath.Join(byUUID, "1111-aaaa")))
+
+	ids, err := LookupDeviceIdentifiers(rootPath)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]DeviceIdentifiers{
+		"sda1": {Labels: []string{"root"}, UUIDs: []string{"1111-aaaa"}},
+		"sdb1": {Labels: []string{"data disk"}},
+	}, ids)
+
+	ids, err = LookupDeviceIdentifiers(t.TempDir())
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+}
+
+func TestDeviceMatcher(t *testing.T) {
+	m, err := NewDeviceMatcher(&filterset.Config{MatchType: filterset.Strict}, nil, nil, nil)
+	require.NoError(t, err)
+	assert.Nil(t, m)
+	assert.False(t, m.NeedsIdentifiers())
+
+	m, err = NewDeviceMatcher(&filterset.Config{MatchType: filterset.Regexp}, []string{"^sda"}, nil, []string{"^1111-"})
+	require.NoError(t, err)
+	assert.True(t, m.NeedsIdentifiers())
+	assert.True(t, m.Matches("sda1", DeviceIdentifiers{}))
+	assert.True(t, m.Matches("sdb1", DeviceIdentifiers{UUIDs: []string{"1111-aaaa"}}))
+	assert.False(t, m.Matches("sdb1", DeviceIdentifiers{Labels: []string{"1111-aaaa"}}))
+
+	_, err = NewDeviceMatcher(&filterset.Config{}, nil, []string{"data"}, nil)
+	assert.Error(t, err)
+}
@@ -31,10 +31,17 @@ type Config struct {
 	// If neither `include` or `exclude` are set, metrics will be generated for all devices.
 	Include MatchConfig `mapstructure:"include"`
 	Exclude MatchConfig `mapstructure:"exclude"`
+
+	// ExcludeVirtualDevices skips loop, ram, zram and nbd devices. Not supported on Windows.
+	ExcludeVirtualDevices bool `mapstructure:"exclude_virtual_devices"`
 }
 
 type MatchConfig struct {
 	filterset.Config `mapstructure:",squash"`
 
 	Devices []string `mapstructure:"devices"`
+	// Labels and UUIDs match devices by the label or UUID of the filesystem they hold,
+	// as reported by udev under /dev/disk. Linux only.
+	Labels []string `mapstructure:"labels"`
+	UUIDs  []string `mapstructure:"uuids"`
 }
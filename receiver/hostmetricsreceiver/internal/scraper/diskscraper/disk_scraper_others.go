@@ -29,7 +29,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/diskscraper/internal/metadata"
 )
 
@@ -44,32 +44,29 @@ type scraper struct {
 	config    *Config
 	startTime pcommon.Timestamp
 	mb        *metadata.MetricsBuilder
-	includeFS filterset.FilterSet
-	excludeFS filterset.FilterSet
+	include   *internal.DeviceMatcher
+	exclude   *internal.DeviceMatcher
 
 	// for mocking
-	bootTime   func() (uint64, error)
-	ioCounters func(names ...string) (map[string]disk.IOCountersStat, error)
+	bootTime          func() (uint64, error)
+	ioCounters        func(names ...string) (map[string]disk.IOCountersStat, error)
+	deviceIdentifiers func(rootPath string) (map[string]internal.DeviceIdentifiers, error)
 }
 
 // newDiskScraper creates a Disk Scraper
 func newDiskScraper(_ context.Context, settings component.ReceiverCreateSettings, cfg *Config) (*scraper, error) {
-	scraper := &scraper{settings: settings, config: cfg, bootTime: host.BootTime, ioCounters: disk.IOCounters}
+	scraper := &scraper{settings: settings, config: cfg, bootTime: host.BootTime, ioCounters: disk.IOCounters, deviceIdentifiers: internal.LookupDeviceIdentifiers}
 
 	var err error
 
-	if len(cfg.Include.Devices) > 0 {
-		scraper.includeFS, err = filterset.CreateFilterSet(cfg.Include.Devices, &cfg.Include.Config)
-		if err != nil {
-			return nil, fmt.Errorf("error creating device include filters: %w", err)
-		}
+	scraper.include, err = internal.NewDeviceMatcher(&cfg.Include.Config, cfg.Include.Devices, cfg.Include.Labels, cfg.Include.UUIDs)
+	if err != nil {
+		return nil, fmt.Errorf("error creating device include filters: %w", err)
 	}
 
-	if len(cfg.Exclude.Devices) > 0 {
-		scraper.excludeFS, err = filterset.CreateFilterSet(cfg.Exclude.Devices, &cfg.Exclude.Config)
-		if err != nil {
-			return nil, fmt.Errorf("error creating device exclude filters: %w", err)
-		}
+	scraper.exclude, err = internal.NewDeviceMatcher(&cfg.Exclude.Config, cfg.Exclude.Devices, cfg.Exclude.Labels, cfg.Exclude.UUIDs)
+	if err != nil {
+		return nil, fmt.Errorf("error creating device exclude filters: %w", err)
 	}
 
 	return scraper, nil
@@ -93,8 +90,11 @@ func (s *scraper) scrape(_ context.Context) (pmetric.Metrics, error) {
 		return pmetric.NewMetrics(), scrapererror.NewPartialScrapeError(err, metricsLen)
 	}
 
-	// filter devices by name
-	ioCounters = s.filterByDevice(ioCounters)
+	// filter devices by name, label and UUID
+	ioCounters, err = s.filterByDevice(ioCounters)
+	if err != nil {
+		return pmetric.NewMetrics(), scrapererror.NewPartialScrapeError(err, metricsLen)
+	}
 
 	if len(ioCounters) > 0 {
 		s.recordDiskIOMetric(now, ioCounters)
@@ -141,20 +141,29 @@ func (s *scraper) recordDiskPendingOperationsMetric(now pcommon.Timestamp, ioCou
 	}
 }
 
-func (s *scraper) filterByDevice(ioCounters map[string]disk.IOCountersStat) map[string]disk.IOCountersStat {
-	if s.includeFS == nil && s.excludeFS == nil {
-		return ioCounters
+func (s *scraper) filterByDevice(ioCounters map[string]disk.IOCountersStat) (map[string]disk.IOCountersStat, error) {
+	if s.include == nil && s.exclude == nil && !s.config.ExcludeVirtualDevices {
+		return ioCounters, nil
+	}
+
+	var ids map[string]internal.DeviceIdentifiers
+	if s.include.NeedsIdentifiers() || s.exclude.NeedsIdentifiers() {
+		var err error
+		if ids, err = s.deviceIdentifiers(s.config.RootPath); err != nil {
+			return nil, fmt.Errorf("failed to read device labels and UUIDs: %w", err)
+		}
 	}
 
 	for device := range ioCounters {
-		if !s.includeDevice(device) {
+		if !s.includeDevice(device, ids[device]) {
 			delete(ioCounters, device)
 		}
 	}
-	return ioCounters
+	return ioCounters, nil
 }
 
-func (s *scraper) includeDevice(deviceName string) bool {
-	return (s.includeFS == nil || s.includeFS.Matches(deviceName)) &&
-		(s.excludeFS == nil || !s.excludeFS.Matches(deviceName))
+func (s *scraper) includeDevice(deviceName string, ids internal.DeviceIdentifiers) bool {
+	return !(s.config.ExcludeVirtualDevices && internal.IsVirtualDevice(deviceName)) &&
+		(s.include == nil || s.include.Matches(deviceName, ids)) &&
+		(s.exclude == nil || !s.exclude.Matches(deviceName, ids))
 }
@@ -27,6 +27,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
 )
 
 func TestScrape_Others(t *testing.T) {
@@ -69,3 +72,86 @@ func TestScrape_Others(t *testing.T) {
 		})
 	}
 }
+
+func TestFilterByDevice(t *testing.T) {
+	ids := map[string]internal.DeviceIdentifiers{
+		"sda1": {Labels: []string{"root"}, UUIDs: []string{"1111-aaaa"}},
+		"sdb1": {Labels: []string{"data disk"}, UUIDs: []string{"2222-bbbb"}},
+	}
+
+	testCases := []struct {
+		name            string
+		config          Config
+		identifiersErr  error
+		expectedDevices []string
+		expectedErr     string
+	}{
+		{
+			name:            "Virtual devices included by default",
+			config:          Config{},
+			expectedDevices: []string{"loop0", "sda1", "sdb1", "zram0"},
+		},
+		{
+			name:            "Exclude virtual devices",
+			config:          Config{ExcludeVirtualDevices: true},
+			expectedDevices: []string{"sda1", "sdb1"},
+		},
+		{
+			name: "Include by label",
+			config: Config{
+				Include: MatchConfig{Config: filterset.Config{MatchType: filterset.Strict}, Labels: []string{"data disk"}},
+			},
+			expectedDevices: []string{"sdb1"},
+		},
+		{
+			name: "Include by device or UUID",
+			config: Config{
+				Include: MatchConfig{Config: filterset.Config{MatchType: filterset.Regexp}, Devices: []string{"^sdb"}, UUIDs: []string{"^1111-"}},
+			},
+			expectedDevices: []string{"sda1", "sdb1"},
+		},
+		{
+			name: "Exclude by UUID",
+			config: Config{
+				Exclude: MatchConfig{Config: filterset.Config{MatchType: filterset.Strict}, UUIDs: []string{"1111-aaaa"}},
+			},
+			expectedDevices: []string{"loop0", "sdb1", "zram0"},
+		},
+		{
+			name: "Identifiers error",
+			config: Config{
+				Include: MatchConfig{Config: filterset.Config{MatchType: filterset.Strict}, Labels: []string{"root"}},
+			},
+			identifiersErr: errors.New("err1"),
+			expectedErr:    "failed to read device labels and UUIDs: err1",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			scraper, err := newDiskScraper(context.Background(), componenttest.NewNopReceiverCreateSettings(), &test.config)
+			require.NoError(t, err, "Failed to create disk scraper: %v", err)
+			scraper.deviceIdentifiers = func(string) (map[string]internal.DeviceIdentifiers, error) {
+				return ids, test.identifiersErr
+			}
+
+			ioCounters, err := scraper.filterByDevice(map[string]disk.IOCountersStat{
+				"loop0": {Name: "loop0"},
+				"sda1":  {Name: "sda1"},
+				"sdb1":  {Name: "sdb1"},
+				"zram0": {Name: "zram0"},
+			})
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			devices := make([]string, 0, len(ioCounters))
+			for device := range ioCounters {
+				devices = append(devices, device)
+			}
+			assert.ElementsMatch(t, test.expectedDevices, devices)
+		})
+	}
+}
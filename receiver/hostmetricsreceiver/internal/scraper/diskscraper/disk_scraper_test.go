@@ -66,7 +66,7 @@ func TestScrape(t *testing.T) {
 			name: "Include Filter that matches nothing",
 			config: Config{
 				Metrics: metadata.DefaultMetricsSettings(),
-				Include: MatchConfig{filterset.Config{MatchType: "strict"}, []string{"@*^#&*$^#)"}},
+				Include: MatchConfig{Config: filterset.Config{MatchType: "strict"}, Devices: []string{"@*^#&*$^#)"}},
 			},
 			expectMetrics: 0,
 		},
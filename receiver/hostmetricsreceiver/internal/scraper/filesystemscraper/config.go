@@ -32,6 +32,10 @@ type Config struct {
 	// and other filesystem types that do no have an associated physical device.
 	IncludeVirtualFS bool `mapstructure:"include_virtual_filesystems"`
 
+	// ExcludeVirtualDevices skips filesystems on loop, ram, zram and nbd devices, and overlay
+	// filesystems.
+	ExcludeVirtualDevices bool `mapstructure:"exclude_virtual_devices"`
+
 	// IncludeDevices specifies a filter on the devices that should be included in the generated metrics.
 	IncludeDevices DeviceMatchConfig `mapstructure:"include_devices"`
 	// ExcludeDevices specifies a filter on the devices that should be excluded from the generated metrics.
@@ -54,6 +58,10 @@ type DeviceMatchConfig struct {
 	filterset.Config `mapstructure:",squash"`
 
 	Devices []string `mapstructure:"devices"`
+	// Labels and UUIDs match devices by the label or UUID of their filesystem,
+	// as reported by udev under /dev/disk. Linux only.
+	Labels []string `mapstructure:"labels"`
+	UUIDs  []string `mapstructure:"uuids"`
 }
 
 type FSTypeMatchConfig struct {
@@ -69,31 +77,30 @@ type MountPointMatchConfig struct {
 }
 
 type fsFilter struct {
-	includeDeviceFilter     filterset.FilterSet
-	excludeDeviceFilter     filterset.FilterSet
+	includeDeviceFilter     *internal.DeviceMatcher
+	excludeDeviceFilter     *internal.DeviceMatcher
 	includeFSTypeFilter     filterset.FilterSet
 	excludeFSTypeFilter     filterset.FilterSet
 	includeMountPointFilter filterset.FilterSet
 	excludeMountPointFilter filterset.FilterSet
+	excludeVirtualDevices   bool
 	filtersExist            bool
 }
 
 func (cfg *Config) createFilter() (*fsFilter, error) {
 	var err error
-	filter := fsFilter{}
+	filter := fsFilter{excludeVirtualDevices: cfg.ExcludeVirtualDevices}
 
-	if len(cfg.IncludeDevices.Devices) > 0 {
-		filter.includeDeviceFilter, err = filterset.CreateFilterSet(cfg.IncludeDevices.Devices, &cfg.IncludeDevices.Config)
-		if err != nil {
-			return nil, fmt.Errorf("error creating include_devices filter: %w", err)
-		}
+	filter.includeDeviceFilter, err = internal.NewDeviceMatcher(&cfg.IncludeDevices.Config,
+		cfg.IncludeDevices.Devices, cfg.IncludeDevices.Labels, cfg.IncludeDevices.UUIDs)
+	if err != nil {
+		return nil, fmt.Errorf("error creating include_devices filter: %w", err)
 	}
 
-	if len(cfg.ExcludeDevices.Devices) > 0 {
-		filter.excludeDeviceFilter, err = filterset.CreateFilterSet(cfg.ExcludeDevices.Devices, &cfg.ExcludeDevices.Config)
-		if err != nil {
-			return nil, fmt.Errorf("error creating exclude_devices filter: %w", err)
-		}
+	filter.excludeDeviceFilter, err = internal.NewDeviceMatcher(&cfg.ExcludeDevices.Config,
+		cfg.ExcludeDevices.Devices, cfg.ExcludeDevices.Labels, cfg.ExcludeDevices.UUIDs)
+	if err != nil {
+		return nil, fmt.Errorf("error creating exclude_devices filter: %w", err)
 	}
 
 	if len(cfg.IncludeFSTypes.FSTypes) > 0 {
@@ -132,5 +139,11 @@ func (cfg *Config) createFilter() (*fsFilter, error) {
 func (f *fsFilter) setFiltersExist() {
 	f.filtersExist = f.includeMountPointFilter != nil || f.excludeMountPointFilter != nil ||
 		f.includeFSTypeFilter != nil || f.excludeFSTypeFilter != nil ||
-		f.includeDeviceFilter != nil || f.excludeDeviceFilter != nil
+		f.includeDeviceFilter != nil || f.excludeDeviceFilter != nil ||
+		f.excludeVirtualDevices
+}
+
+// needsDeviceIdentifiers returns true if devices are filtered by label or UUID.
+func (f *fsFilter) needsDeviceIdentifiers() bool {
+	return f.includeDeviceFilter.NeedsIdentifiers() || f.excludeDeviceFilter.NeedsIdentifiers()
 }
@@ -27,6 +27,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/filesystemscraper/internal/metadata"
 )
 
@@ -43,9 +44,10 @@ type scraper struct {
 	fsFilter fsFilter
 
 	// for mocking gopsutil disk.Partitions & disk.Usage
-	bootTime   func() (uint64, error)
-	partitions func(bool) ([]disk.PartitionStat, error)
-	usage      func(string) (*disk.UsageStat, error)
+	bootTime          func() (uint64, error)
+	partitions        func(bool) ([]disk.PartitionStat, error)
+	usage             func(string) (*disk.UsageStat, error)
+	deviceIdentifiers func(rootPath string) (map[string]internal.DeviceIdentifiers, error)
 }
 
 type deviceUsage struct {
@@ -60,7 +62,8 @@ func newFileSystemScraper(_ context.Context, settings component.ReceiverCreateSe
 		return nil, err
 	}
 
-	scraper := &scraper{settings: settings, config: cfg, bootTime: host.BootTime, partitions: disk.Partitions, usage: disk.Usage, fsFilter: *fsFilter}
+	scraper := &scraper{settings: settings, config: cfg, bootTime: host.BootTime, partitions: disk.Partitions, usage: disk.Usage,
+		deviceIdentifiers: internal.LookupDeviceIdentifiers, fsFilter: *fsFilter}
 	return scraper, nil
 }
 
@@ -86,9 +89,21 @@ func (s *scraper) scrape(_ context.Context) (pmetric.Metrics, error) {
 		errors.AddPartial(0, fmt.Errorf("failed collecting partitions information: %w", err))
 	}
 
+	var ids map[string]internal.DeviceIdentifiers
+	if s.fsFilter.needsDeviceIdentifiers() {
+		if ids, err = s.deviceIdentifiers(s.config.RootPath); err != nil {
+			return pmetric.NewMetrics(), scrapererror.NewPartialScrapeError(
+				fmt.Errorf("failed to read device labels and UUIDs: %w", err), metricsLen)
+		}
+	}
+
 	usages := make([]*deviceUsage, 0, len(partitions))
 	for _, partition := range partitions {
-		if !s.fsFilter.includePartition(partition) {
+		var partitionIDs internal.DeviceIdentifiers
+		if ids != nil {
+			partitionIDs = ids[s.deviceName(partition.Device)]
+		}
+		if !s.fsFilter.includePartition(partition, partitionIDs) {
 			continue
 		}
 		translatedMountpoint := translateMountpoint(s.config.RootPath, partition.Mountpoint)
@@ -132,9 +147,19 @@ func exists(options []string, opt string) bool {
 	return false
 }
 
-func (f *fsFilter) includePartition(partition disk.PartitionStat) bool {
+// deviceName returns the name of the block device behind a partition's device path,
+// following links such as the ones under /dev/mapper.
+func (s *scraper) deviceName(device string) string {
+	if resolved, err := filepath.EvalSymlinks(translateMountpoint(s.config.RootPath, device)); err == nil {
+		return filepath.Base(resolved)
+	}
+	return filepath.Base(device)
+}
+
+func (f *fsFilter) includePartition(partition disk.PartitionStat, ids internal.DeviceIdentifiers) bool {
 	// If filters do not exist, return early.
-	if !f.filtersExist || (f.includeDevice(partition.Device) &&
+	if !f.filtersExist || (!(f.excludeVirtualDevices && internal.IsVirtualDevice(partition.Device)) &&
+		f.includeDevice(partition.Device, ids) &&
 		f.includeFSType(partition.Fstype) &&
 		f.includeMountPoint(partition.Mountpoint)) {
 		return true
@@ -142,9 +167,9 @@ func (f *fsFilter) includePartition(partition disk.PartitionStat) bool {
 	return false
 }
 
-func (f *fsFilter) includeDevice(deviceName string) bool {
-	return (f.includeDeviceFilter == nil || f.includeDeviceFilter.Matches(deviceName)) &&
-		(f.excludeDeviceFilter == nil || !f.excludeDeviceFilter.Matches(deviceName))
+func (f *fsFilter) includeDevice(deviceName string, ids internal.DeviceIdentifiers) bool {
+	return (f.includeDeviceFilter == nil || f.includeDeviceFilter.Matches(deviceName, ids)) &&
+		(f.excludeDeviceFilter == nil || !f.excludeDeviceFilter.Matches(deviceName, ids))
 }
 
 func (f *fsFilter) includeFSType(fsType string) bool {
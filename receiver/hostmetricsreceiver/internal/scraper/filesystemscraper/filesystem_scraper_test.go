@@ -43,6 +43,7 @@ func TestScrape(t *testing.T) {
 		bootTimeFunc             func() (uint64, error)
 		partitionsFunc           func(bool) ([]disk.PartitionStat, error)
 		usageFunc                func(string) (*disk.UsageStat, error)
+		deviceIdentifiersFunc    func(string) (map[string]internal.DeviceIdentifiers, error)
 		expectMetrics            bool
 		expectedDeviceDataPoints int
 		expectedDeviceAttributes []map[string]pcommon.Value
@@ -63,7 +64,7 @@ func TestScrape(t *testing.T) {
 			name: "Include single device filter",
 			config: Config{
 				Metrics:        metadata.DefaultMetricsSettings(),
-				IncludeDevices: DeviceMatchConfig{filterset.Config{MatchType: "strict"}, []string{"a"}},
+				IncludeDevices: DeviceMatchConfig{Config: filterset.Config{MatchType: "strict"}, Devices: []string{"a"}},
 			},
 			partitionsFunc: func(bool) ([]disk.PartitionStat, error) {
 				return []disk.PartitionStat{{Device: "a"}, {Device: "b"}}, nil
@@ -78,7 +79,7 @@ func TestScrape(t *testing.T) {
 			name: "Include Device Filter that matches nothing",
 			config: Config{
 				Metrics:        metadata.DefaultMetricsSettings(),
-				IncludeDevices: DeviceMatchConfig{filterset.Config{MatchType: "strict"}, []string{"@*^#&*$^#)"}},
+				IncludeDevices: DeviceMatchConfig{Config: filterset.Config{MatchType: "strict"}, Devices: []string{"@*^#&*$^#)"}},
 			},
 			expectMetrics: false,
 		},
@@ -171,6 +172,106 @@ func TestScrape(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:   "Exclude virtual devices",
+			config: Config{Metrics: metadata.DefaultMetricsSettings(), ExcludeVirtualDevices: true},
+			usageFunc: func(s string) (*disk.UsageStat, error) {
+				return &disk.UsageStat{}, nil
+			},
+			partitionsFunc: func(b bool) ([]disk.PartitionStat, error) {
+				return []disk.PartitionStat{
+					{Device: "/dev/sda1", Mountpoint: "/", Fstype: "ext4"},
+					{Device: "/dev/loop0", Mountpoint: "/snap/core/1", Fstype: "squashfs"},
+					{Device: "overlay", Mountpoint: "/var/lib/docker/overlay2/a/merged", Fstype: "overlay"},
+				}, nil
+			},
+			expectMetrics:            true,
+			expectedDeviceDataPoints: 1,
+			expectedDeviceAttributes: []map[string]pcommon.Value{
+				{
+					"device":     pcommon.NewValueStr("/dev/sda1"),
+					"mountpoint": pcommon.NewValueStr("/"),
+					"type":       pcommon.NewValueStr("ext4"),
+					"mode":       pcommon.NewValueStr("unknown"),
+				},
+			},
+		},
+		{
+			name:   "Virtual devices included by default",
+			config: Config{Metrics: metadata.DefaultMetricsSettings()},
+			usageFunc: func(s string) (*disk.UsageStat, error) {
+				return &disk.UsageStat{}, nil
+			},
+			partitionsFunc: func(b bool) ([]disk.PartitionStat, error) {
+				return []disk.PartitionStat{
+					{Device: "/dev/sda1", Mountpoint: "/", Fstype: "ext4"},
+					{Device: "/dev/loop0", Mountpoint: "/snap/core/1", Fstype: "squashfs"},
+				}, nil
+			},
+			expectMetrics:            true,
+			expectedDeviceDataPoints: 2,
+		},
+		{
+			name: "Include filter with labels and UUIDs",
+			config: Config{
+				Metrics: metadata.DefaultMetricsSettings(),
+				IncludeDevices: DeviceMatchConfig{
+					Config: filterset.Config{MatchType: filterset.Strict},
+					Labels: []string{"data"},
+					UUIDs:  []string{"1111-aaaa"},
+				},
+			},
+			usageFunc: func(s string) (*disk.UsageStat, error) {
+				return &disk.UsageStat{}, nil
+			},
+			partitionsFunc: func(b bool) ([]disk.PartitionStat, error) {
+				return []disk.PartitionStat{
+					{Device: "/dev/sda1", Mountpoint: "/", Fstype: "ext4"},
+					{Device: "/dev/sdb1", Mountpoint: "/data", Fstype: "xfs"},
+					{Device: "/dev/sdc1", Mountpoint: "/scratch", Fstype: "xfs"},
+				}, nil
+			},
+			deviceIdentifiersFunc: func(string) (map[string]internal.DeviceIdentifiers, error) {
+				return map[string]internal.DeviceIdentifiers{
+					"sda1": {UUIDs: []string{"1111-aaaa"}},
+					"sdb1": {Labels: []string{"data"}, UUIDs: []string{"2222-bbbb"}},
+					"sdc1": {Labels: []string{"scratch"}, UUIDs: []string{"3333-cccc"}},
+				}, nil
+			},
+			expectMetrics:            true,
+			expectedDeviceDataPoints: 2,
+			expectedDeviceAttributes: []map[string]pcommon.Value{
+				{
+					"device":     pcommon.NewValueStr("/dev/sda1"),
+					"mountpoint": pcommon.NewValueStr("/"),
+					"type":       pcommon.NewValueStr("ext4"),
+					"mode":       pcommon.NewValueStr("unknown"),
+				},
+				{
+					"device":     pcommon.NewValueStr("/dev/sdb1"),
+					"mountpoint": pcommon.NewValueStr("/data"),
+					"type":       pcommon.NewValueStr("xfs"),
+					"mode":       pcommon.NewValueStr("unknown"),
+				},
+			},
+		},
+		{
+			name: "Device identifiers error",
+			config: Config{
+				Metrics: metadata.DefaultMetricsSettings(),
+				ExcludeDevices: DeviceMatchConfig{
+					Config: filterset.Config{MatchType: filterset.Strict},
+					Labels: []string{"data"},
+				},
+			},
+			partitionsFunc: func(b bool) ([]disk.PartitionStat, error) {
+				return []disk.PartitionStat{{Device: "/dev/sda1", Mountpoint: "/", Fstype: "ext4"}}, nil
+			},
+			deviceIdentifiersFunc: func(string) (map[string]internal.DeviceIdentifiers, error) {
+				return nil, errors.New("err1")
+			},
+			expectedErr: "failed to read device labels and UUIDs: err1",
+		},
 		{
 			name: "RootPath at /hostfs",
 			config: Config{
@@ -340,6 +441,9 @@ func TestScrape(t *testing.T) {
 			if test.usageFunc != nil {
 				scraper.usage = test.usageFunc
 			}
+			if test.deviceIdentifiersFunc != nil {
+				scraper.deviceIdentifiers = test.deviceIdentifiersFunc
+			}
 			if test.bootTimeFunc != nil {
 				scraper.bootTime = test.bootTimeFunc
 			}
@@ -0,0 +1,130 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gpuscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/gpuscraper"
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/host"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/gpuscraper/internal/metadata"
+)
+
+// metricsLen is the number of metrics recorded per device, used to report partial scrape errors.
+const metricsLen = 5
+
+// deviceStats holds the readings of a single GPU.
+type deviceStats struct {
+	index string
+	uuid  string
+
+	// utilization, temperature and power are nil when the device does not support reading them.
+	utilization *float64
+	memoryUsed  uint64
+	memoryFree  uint64
+	memoryTotal uint64
+	temperature *int64
+	powerWatts  *float64
+}
+
+// gpuLibrary abstracts the vendor management library so that it can be mocked in tests.
+type gpuLibrary interface {
+	init() error
+	devices() ([]deviceStats, error)
+	shutdown() error
+}
+
+// scraper for GPU Metrics
+type scraper struct {
+	settings component.ReceiverCreateSettings
+	config   *Config
+	mb       *metadata.MetricsBuilder
+	library  gpuLibrary
+
+	// for mocking
+	bootTime func() (uint64, error)
+}
+
+// newGPUScraper creates a GPU Scraper
+func newGPUScraper(_ context.Context, settings component.ReceiverCreateSettings, cfg *Config) *scraper {
+	return &scraper{
+		settings: settings,
+		config:   cfg,
+		library:  newNVMLLibrary(),
+		bootTime: host.BootTime,
+	}
+}
+
+func (s *scraper) start(context.Context, component.Host) error {
+	bootTime, err := s.bootTime()
+	if err != nil {
+		return err
+	}
+
+	if err = s.library.init(); err != nil {
+		return fmt.Errorf("failed to initialize GPU management library: %w", err)
+	}
+
+	s.mb = metadata.NewMetricsBuilder(s.config.Metrics, s.settings.BuildInfo, metadata.WithStartTime(pcommon.Timestamp(bootTime*1e9)))
+	return nil
+}
+
+func (s *scraper) shutdown(context.Context) error {
+	if s.mb == nil {
+		// the library was not initialized
+		return nil
+	}
+	return s.library.shutdown()
+}
+
+func (s *scraper) scrape(_ context.Context) (pmetric.Metrics, error) {
+	now := pcommon.NewTimestampFromTime(time.Now())
+	devices, err := s.library.devices()
+	if err != nil {
+		return pmetric.NewMetrics(), scrapererror.NewPartialScrapeError(fmt.Errorf("failed to read GPU stats: %w", err), metricsLen)
+	}
+
+	for _, device := range devices {
+		s.recordDeviceDataPoints(now, device)
+	}
+
+	return s.mb.Emit(), nil
+}
+
+func (s *scraper) recordDeviceDataPoints(now pcommon.Timestamp, device deviceStats) {
+	if device.utilization != nil {
+		s.mb.RecordSystemGpuUtilizationDataPoint(now, *device.utilization, device.uuid, device.index)
+	}
+
+	s.mb.RecordSystemGpuMemoryUsageDataPoint(now, int64(device.memoryUsed), device.uuid, device.index, metadata.AttributeStateUsed)
+	s.mb.RecordSystemGpuMemoryUsageDataPoint(now, int64(device.memoryFree), device.uuid, device.index, metadata.AttributeStateFree)
+	if device.memoryTotal > 0 {
+		s.mb.RecordSystemGpuMemoryUtilizationDataPoint(now, float64(device.memoryUsed)/float64(device.memoryTotal), device.uuid, device.index)
+	}
+
+	if device.temperature != nil {
+		s.mb.RecordSystemGpuTemperatureDataPoint(now, *device.temperature, device.uuid, device.index)
+	}
+
+	if device.powerWatts != nil {
+		s.mb.RecordSystemGpuPowerUsageDataPoint(now, *device.powerWatts, device.uuid, device.index)
+	}
+}
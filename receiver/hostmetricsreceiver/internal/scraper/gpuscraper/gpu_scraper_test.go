@@ -0,0 +1,221 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gpuscraper
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/gpuscraper/internal/metadata"
+)
+
+type mockLibrary struct {
+	initErr    error
+	stats      []deviceStats
+	devicesErr error
+	shutdowns  int
+}
+
+func (m *mockLibrary) init() error {
+	return m.initErr
+}
+
+func (m *mockLibrary) devices() ([]deviceStats, error) {
+	return m.stats, m.devicesErr
+}
+
+func (m *mockLibrary) shutdown() error {
+	m.shutdowns++
+	return nil
+}
+
+func float64Ptr(v float64) *float64 { return &v }
+
+func int64Ptr(v int64) *int64 { return &v }
+
+func TestScrape(t *testing.T) {
+	type testCase struct {
+		name              string
+		library           *mockLibrary
+		config            *Config
+		expectedMetrics   int
+		initializationErr string
+		expectedErr       string
+	}
+
+	fullDevice := deviceStats{
+		index:       "0",
+		uuid:        "GPU-5f8f6c36-7e4b-4b3b-9d3b-5a9f5b7c0001",
+		utilization: float64Ptr(0.42),
+		memoryUsed:  1024,
+		memoryFree:  3072,
+		memoryTotal: 4096,
+		temperature: int64Ptr(61),
+		powerWatts:  float64Ptr(70.5),
+	}
+
+	allMetrics := metadata.DefaultMetricsSettings()
+	allMetrics.SystemGpuMemoryUtilization.Enabled = true
+
+	testCases := []testCase{
+		{
+			name:            "Standard",
+			library:         &mockLibrary{stats: []deviceStats{fullDevice}},
+			config:          &Config{Metrics: metadata.DefaultMetricsSettings()},
+			expectedMetrics: 4,
+		},
+		{
+			name:            "All metrics enabled",
+			library:         &mockLibrary{stats: []deviceStats{fullDevice}},
+			config:          &Config{Metrics: allMetrics},
+			expectedMetrics: 5,
+		},
+		{
+			name: "Unsupported readings",
+			library: &mockLibrary{stats: []deviceStats{{
+				index:       "1",
+				uuid:        "GPU-5f8f6c36-7e4b-4b3b-9d3b-5a9f5b7c0002",
+				memoryUsed:  1,
+				memoryFree:  1,
+				memoryTotal: 2,
+			}}},
+			config:          &Config{Metrics: metadata.DefaultMetricsSettings()},
+			expectedMetrics: 1,
+		},
+		{
+			name:              "Library unavailable",
+			library:           &mockLibrary{initErr: errors.New("libnvidia-ml.so not found")},
+			config:            &Config{Metrics: metadata.DefaultMetricsSettings()},
+			initializationErr: "failed to initialize GPU management library: libnvidia-ml.so not found",
+		},
+		{
+			name:        "Device error",
+			library:     &mockLibrary{devicesErr: errors.New("device lost")},
+			config:      &Config{Metrics: metadata.DefaultMetricsSettings()},
+			expectedErr: "failed to read GPU stats: device lost",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			scraper := newGPUScraper(context.Background(), componenttest.NewNopReceiverCreateSettings(), test.config)
+			scraper.library = test.library
+			scraper.bootTime = func() (uint64, error) { return 100, nil }
+
+			err := scraper.start(context.Background(), componenttest.NewNopHost())
+			if test.initializationErr != "" {
+				assert.EqualError(t, err, test.initializationErr)
+				require.NoError(t, scraper.shutdown(context.Background()))
+				assert.Equal(t, 0, test.library.shutdowns)
+				return
+			}
+			require.NoError(t, err, "Failed to initialize gpu scraper: %v", err)
+
+			md, err := scraper.scrape(context.Background())
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				isPartial := scrapererror.IsPartialScrapeError(err)
+				assert.True(t, isPartial)
+				return
+			}
+			require.NoError(t, err, "Failed to scrape metrics: %v", err)
+
+			assert.Equal(t, test.expectedMetrics, md.MetricCount())
+			metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+			for i := 0; i < metrics.Len(); i++ {
+				metric := metrics.At(i)
+				var dataPoints pmetric.NumberDataPointSlice
+				switch metric.Type() {
+				case pmetric.MetricTypeGauge:
+					dataPoints = metric.Gauge().DataPoints()
+				case pmetric.MetricTypeSum:
+					dataPoints = metric.Sum().DataPoints()
+				}
+				for j := 0; j < dataPoints.Len(); j++ {
+					assertDeviceAttributes(t, dataPoints.At(j).Attributes(), test.library.stats[0])
+					assert.Equal(t, pcommon.Timestamp(100*1e9), dataPoints.At(j).StartTimestamp())
+				}
+			}
+
+			require.NoError(t, scraper.shutdown(context.Background()))
+			assert.Equal(t, 1, test.library.shutdowns)
+		})
+	}
+}
+
+func TestRecordDeviceDataPoints(t *testing.T) {
+	settings := metadata.DefaultMetricsSettings()
+	settings.SystemGpuMemoryUtilization.Enabled = true
+	scraper := newGPUScraper(context.Background(), componenttest.NewNopReceiverCreateSettings(), &Config{Metrics: settings})
+	scraper.mb = metadata.NewMetricsBuilder(settings, componenttest.NewNopReceiverCreateSettings().BuildInfo)
+
+	scraper.recordDeviceDataPoints(pcommon.NewTimestampFromTime(time.Now()), deviceStats{
+		index:       "0",
+		uuid:        "GPU-0",
+		utilization: float64Ptr(0.5),
+		memoryUsed:  1024,
+		memoryFree:  3072,
+		memoryTotal: 4096,
+		temperature: int64Ptr(61),
+		powerWatts:  float64Ptr(70.5),
+	})
+
+	metrics := scraper.mb.Emit().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	values := map[string][]float64{}
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		var dataPoints pmetric.NumberDataPointSlice
+		if metric.Type() == pmetric.MetricTypeGauge {
+			dataPoints = metric.Gauge().DataPoints()
+		} else {
+			dataPoints = metric.Sum().DataPoints()
+		}
+		for j := 0; j < dataPoints.Len(); j++ {
+			dp := dataPoints.At(j)
+			if dp.ValueType() == pmetric.NumberDataPointValueTypeInt {
+				values[metric.Name()] = append(values[metric.Name()], float64(dp.IntValue()))
+			} else {
+				values[metric.Name()] = append(values[metric.Name()], dp.DoubleValue())
+			}
+		}
+	}
+
+	assert.Equal(t, map[string][]float64{
+		"system.gpu.utilization":        {0.5},
+		"system.gpu.memory.usage":       {1024, 3072},
+		"system.gpu.memory.utilization": {0.25},
+		"system.gpu.temperature":        {61},
+		"system.gpu.power.usage":        {70.5},
+	}, values)
+}
+
+func assertDeviceAttributes(t *testing.T, attributes pcommon.Map, device deviceStats) {
+	uuid, ok := attributes.Get("gpu.uuid")
+	assert.True(t, ok)
+	assert.Equal(t, device.uuid, uuid.Str())
+
+	index, ok := attributes.Get("gpu.index")
+	assert.True(t, ok)
+	assert.Equal(t, device.index, index.Str())
+}
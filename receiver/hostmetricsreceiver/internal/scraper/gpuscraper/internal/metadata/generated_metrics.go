@@ -0,0 +1,501 @@
+// Code generated by mdatagen. DO NOT EDIT.
+
+package metadata
+
+import (
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	conventions "go.opentelemetry.io/collector/semconv/v1.9.0"
+)
+
+// MetricSettings provides common settings for a particular metric.
+type MetricSettings struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	enabledProvidedByUser bool
+}
+
+// IsEnabledProvidedByUser returns true if `enabled` option is explicitly set in user settings to any value.
+func (ms *MetricSettings) IsEnabledProvidedByUser() bool {
+	return ms.enabledProvidedByUser
+}
+
+func (ms *MetricSettings) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+	err := parser.Unmarshal(ms, confmap.WithErrorUnused())
+	if err != nil {
+		return err
+	}
+	ms.enabledProvidedByUser = parser.IsSet("enabled")
+	return nil
+}
+
+// MetricsSettings provides settings for hostmetricsreceiver/gpu metrics.
+type MetricsSettings struct {
+	SystemGpuMemoryUsage       MetricSettings `mapstructure:"system.gpu.memory.usage"`
+	SystemGpuMemoryUtilization MetricSettings `mapstructure:"system.gpu.memory.utilization"`
+	SystemGpuPowerUsage        MetricSettings `mapstructure:"system.gpu.power.usage"`
+	SystemGpuTemperature       MetricSettings `mapstructure:"system.gpu.temperature"`
+	SystemGpuUtilization       MetricSettings `mapstructure:"system.gpu.utilization"`
+}
+
+func DefaultMetricsSettings() MetricsSettings {
+	return MetricsSettings{
+		SystemGpuMemoryUsage: MetricSettings{
+			Enabled: true,
+		},
+		SystemGpuMemoryUtilization: MetricSettings{
+			Enabled: false,
+		},
+		SystemGpuPowerUsage: MetricSettings{
+			Enabled: true,
+		},
+		SystemGpuTemperature: MetricSettings{
+			Enabled: true,
+		},
+		SystemGpuUtilization: MetricSettings{
+			Enabled: true,
+		},
+	}
+}
+
+// AttributeState specifies the a value state attribute.
+type AttributeState int
+
+const (
+	_ AttributeState = iota
+	AttributeStateFree
+	AttributeStateUsed
+)
+
+// String returns the string representation of the AttributeState.
+func (av AttributeState) String() string {
+	switch av {
+	case AttributeStateFree:
+		return "free"
+	case AttributeStateUsed:
+		return "used"
+	}
+	return ""
+}
+
+// MapAttributeState is a helper map of string to AttributeState attribute value.
+var MapAttributeState = map[string]AttributeState{
+	"free": AttributeStateFree,
+	"used": AttributeStateUsed,
+}
+
+type metricSystemGpuMemoryUsage struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.gpu.memory.usage metric with initial data.
+func (m *metricSystemGpuMemoryUsage) init() {
+	m.data.SetName("system.gpu.memory.usage")
+	m.data.SetDescription("GPU framebuffer memory usage.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemGpuMemoryUsage) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, gpuUUIDAttributeValue string, gpuIndexAttributeValue string, stateAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("gpu.uuid", gpuUUIDAttributeValue)
+	dp.Attributes().PutStr("gpu.index", gpuIndexAttributeValue)
+	dp.Attributes().PutStr("state", stateAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemGpuMemoryUsage) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemGpuMemoryUsage) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemGpuMemoryUsage(settings MetricSettings) metricSystemGpuMemoryUsage {
+	m := metricSystemGpuMemoryUsage{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemGpuMemoryUtilization struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.gpu.memory.utilization metric with initial data.
+func (m *metricSystemGpuMemoryUtilization) init() {
+	m.data.SetName("system.gpu.memory.utilization")
+	m.data.SetDescription("Fraction of GPU framebuffer memory in use.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemGpuMemoryUtilization) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, gpuUUIDAttributeValue string, gpuIndexAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("gpu.uuid", gpuUUIDAttributeValue)
+	dp.Attributes().PutStr("gpu.index", gpuIndexAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemGpuMemoryUtilization) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemGpuMemoryUtilization) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemGpuMemoryUtilization(settings MetricSettings) metricSystemGpuMemoryUtilization {
+	m := metricSystemGpuMemoryUtilization{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemGpuPowerUsage struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.gpu.power.usage metric with initial data.
+func (m *metricSystemGpuPowerUsage) init() {
+	m.data.SetName("system.gpu.power.usage")
+	m.data.SetDescription("Power drawn by the GPU and its associated circuitry.")
+	m.data.SetUnit("W")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemGpuPowerUsage) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, gpuUUIDAttributeValue string, gpuIndexAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("gpu.uuid", gpuUUIDAttributeValue)
+	dp.Attributes().PutStr("gpu.index", gpuIndexAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemGpuPowerUsage) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemGpuPowerUsage) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemGpuPowerUsage(settings MetricSettings) metricSystemGpuPowerUsage {
+	m := metricSystemGpuPowerUsage{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemGpuTemperature struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.gpu.temperature metric with initial data.
+func (m *metricSystemGpuTemperature) init() {
+	m.data.SetName("system.gpu.temperature")
+	m.data.SetDescription("GPU core temperature.")
+	m.data.SetUnit("Cel")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemGpuTemperature) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, gpuUUIDAttributeValue string, gpuIndexAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("gpu.uuid", gpuUUIDAttributeValue)
+	dp.Attributes().PutStr("gpu.index", gpuIndexAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemGpuTemperature) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemGpuTemperature) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemGpuTemperature(settings MetricSettings) metricSystemGpuTemperature {
+	m := metricSystemGpuTemperature{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemGpuUtilization struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.gpu.utilization metric with initial data.
+func (m *metricSystemGpuUtilization) init() {
+	m.data.SetName("system.gpu.utilization")
+	m.data.SetDescription("Fraction of time over the past sample period during which one or more kernels was executing on the GPU.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemGpuUtilization) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, gpuUUIDAttributeValue string, gpuIndexAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("gpu.uuid", gpuUUIDAttributeValue)
+	dp.Attributes().PutStr("gpu.index", gpuIndexAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemGpuUtilization) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemGpuUtilization) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemGpuUtilization(settings MetricSettings) metricSystemGpuUtilization {
+	m := metricSystemGpuUtilization{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+// MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
+// required to produce metric representation defined in metadata and user settings.
+type MetricsBuilder struct {
+	startTime                        pcommon.Timestamp   // start time that will be applied to all recorded data points.
+	metricsCapacity                  int                 // maximum observed number of metrics per resource.
+	resourceCapacity                 int                 // maximum observed number of resource attributes.
+	metricsBuffer                    pmetric.Metrics     // accumulates metrics data before emitting.
+	buildInfo                        component.BuildInfo // contains version information
+	metricSystemGpuMemoryUsage       metricSystemGpuMemoryUsage
+	metricSystemGpuMemoryUtilization metricSystemGpuMemoryUtilization
+	metricSystemGpuPowerUsage        metricSystemGpuPowerUsage
+	metricSystemGpuTemperature       metricSystemGpuTemperature
+	metricSystemGpuUtilization       metricSystemGpuUtilization
+}
+
+// metricBuilderOption applies changes to default metrics builder.
+type metricBuilderOption func(*MetricsBuilder)
+
+// WithStartTime sets startTime on the metrics builder.
+func WithStartTime(startTime pcommon.Timestamp) metricBuilderOption {
+	return func(mb *MetricsBuilder) {
+		mb.startTime = startTime
+	}
+}
+
+func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo, options ...metricBuilderOption) *MetricsBuilder {
+	mb := &MetricsBuilder{
+		startTime:                        pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                    pmetric.NewMetrics(),
+		buildInfo:                        buildInfo,
+		metricSystemGpuMemoryUsage:       newMetricSystemGpuMemoryUsage(settings.SystemGpuMemoryUsage),
+		metricSystemGpuMemoryUtilization: newMetricSystemGpuMemoryUtilization(settings.SystemGpuMemoryUtilization),
+		metricSystemGpuPowerUsage:        newMetricSystemGpuPowerUsage(settings.SystemGpuPowerUsage),
+		metricSystemGpuTemperature:       newMetricSystemGpuTemperature(settings.SystemGpuTemperature),
+		metricSystemGpuUtilization:       newMetricSystemGpuUtilization(settings.SystemGpuUtilization),
+	}
+	for _, op := range options {
+		op(mb)
+	}
+	return mb
+}
+
+// updateCapacity updates max length of metrics and resource attributes that will be used for the slice capacity.
+func (mb *MetricsBuilder) updateCapacity(rm pmetric.ResourceMetrics) {
+	if mb.metricsCapacity < rm.ScopeMetrics().At(0).Metrics().Len() {
+		mb.metricsCapacity = rm.ScopeMetrics().At(0).Metrics().Len()
+	}
+	if mb.resourceCapacity < rm.Resource().Attributes().Len() {
+		mb.resourceCapacity = rm.Resource().Attributes().Len()
+	}
+}
+
+// ResourceMetricsOption applies changes to provided resource metrics.
+type ResourceMetricsOption func(pmetric.ResourceMetrics)
+
+// WithStartTimeOverride overrides start time for all the resource metrics data points.
+// This option should be only used if different start time has to be set on metrics coming from different resources.
+func WithStartTimeOverride(start pcommon.Timestamp) ResourceMetricsOption {
+	return func(rm pmetric.ResourceMetrics) {
+		var dps pmetric.NumberDataPointSlice
+		metrics := rm.ScopeMetrics().At(0).Metrics()
+		for i := 0; i < metrics.Len(); i++ {
+			switch metrics.At(i).Type() {
+			case pmetric.MetricTypeGauge:
+				dps = metrics.At(i).Gauge().DataPoints()
+			case pmetric.MetricTypeSum:
+				dps = metrics.At(i).Sum().DataPoints()
+			}
+			for j := 0; j < dps.Len(); j++ {
+				dps.At(j).SetStartTimestamp(start)
+			}
+		}
+	}
+}
+
+// EmitForResource saves all the generated metrics under a new resource and updates the internal state to be ready for
+// recording another set of data points as part of another resource. This function can be helpful when one scraper
+// needs to emit metrics from several resources. Otherwise calling this function is not required,
+// just `Emit` function can be called instead.
+// Resource attributes should be provided as ResourceMetricsOption arguments.
+func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
+	rm := pmetric.NewResourceMetrics()
+	rm.SetSchemaUrl(conventions.SchemaURL)
+	rm.Resource().Attributes().EnsureCapacity(mb.resourceCapacity)
+	ils := rm.ScopeMetrics().AppendEmpty()
+	ils.Scope().SetName("otelcol/hostmetricsreceiver/gpu")
+	ils.Scope().SetVersion(mb.buildInfo.Version)
+	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
+	mb.metricSystemGpuMemoryUsage.emit(ils.Metrics())
+	mb.metricSystemGpuMemoryUtilization.emit(ils.Metrics())
+	mb.metricSystemGpuPowerUsage.emit(ils.Metrics())
+	mb.metricSystemGpuTemperature.emit(ils.Metrics())
+	mb.metricSystemGpuUtilization.emit(ils.Metrics())
+	for _, op := range rmo {
+		op(rm)
+	}
+	if ils.Metrics().Len() > 0 {
+		mb.updateCapacity(rm)
+		rm.MoveTo(mb.metricsBuffer.ResourceMetrics().AppendEmpty())
+	}
+}
+
+// Emit returns all the metrics accumulated by the metrics builder and updates the internal state to be ready for
+// recording another set of metrics. This function will be responsible for applying all the transformations required to
+// produce metric representation defined in metadata and user settings, e.g. delta or cumulative.
+func (mb *MetricsBuilder) Emit(rmo ...ResourceMetricsOption) pmetric.Metrics {
+	mb.EmitForResource(rmo...)
+	metrics := pmetric.NewMetrics()
+	mb.metricsBuffer.MoveTo(metrics)
+	return metrics
+}
+
+// RecordSystemGpuMemoryUsageDataPoint adds a data point to system.gpu.memory.usage metric.
+func (mb *MetricsBuilder) RecordSystemGpuMemoryUsageDataPoint(ts pcommon.Timestamp, val int64, gpuUUIDAttributeValue string, gpuIndexAttributeValue string, stateAttributeValue AttributeState) {
+	mb.metricSystemGpuMemoryUsage.recordDataPoint(mb.startTime, ts, val, gpuUUIDAttributeValue, gpuIndexAttributeValue, stateAttributeValue.String())
+}
+
+// RecordSystemGpuMemoryUtilizationDataPoint adds a data point to system.gpu.memory.utilization metric.
+func (mb *MetricsBuilder) RecordSystemGpuMemoryUtilizationDataPoint(ts pcommon.Timestamp, val float64, gpuUUIDAttributeValue string, gpuIndexAttributeValue string) {
+	mb.metricSystemGpuMemoryUtilization.recordDataPoint(mb.startTime, ts, val, gpuUUIDAttributeValue, gpuIndexAttributeValue)
+}
+
+// RecordSystemGpuPowerUsageDataPoint adds a data point to system.gpu.power.usage metric.
+func (mb *MetricsBuilder) RecordSystemGpuPowerUsageDataPoint(ts pcommon.Timestamp, val float64, gpuUUIDAttributeValue string, gpuIndexAttributeValue string) {
+	mb.metricSystemGpuPowerUsage.recordDataPoint(mb.startTime, ts, val, gpuUUIDAttributeValue, gpuIndexAttributeValue)
+}
+
+// RecordSystemGpuTemperatureDataPoint adds a data point to system.gpu.temperature metric.
+func (mb *MetricsBuilder) RecordSystemGpuTemperatureDataPoint(ts pcommon.Timestamp, val int64, gpuUUIDAttributeValue string, gpuIndexAttributeValue string) {
+	mb.metricSystemGpuTemperature.recordDataPoint(mb.startTime, ts, val, gpuUUIDAttributeValue, gpuIndexAttributeValue)
+}
+
+// RecordSystemGpuUtilizationDataPoint adds a data point to system.gpu.utilization metric.
+func (mb *MetricsBuilder) RecordSystemGpuUtilizationDataPoint(ts pcommon.Timestamp, val float64, gpuUUIDAttributeValue string, gpuIndexAttributeValue string) {
+	mb.metricSystemGpuUtilization.recordDataPoint(mb.startTime, ts, val, gpuUUIDAttributeValue, gpuIndexAttributeValue)
+}
+
+// Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
+// and metrics builder should update its startTime and reset it's internal state accordingly.
+func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
+	mb.startTime = pcommon.NewTimestampFromTime(time.Now())
+	for _, op := range options {
+		op(mb)
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build nvml && linux
+// +build nvml,linux
+
+package gpuscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/gpuscraper"
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+)
+
+// nvmlLibrary reads GPU stats through the NVML shared library, which is loaded at runtime
+// and therefore only needs to be present on hosts that have NVIDIA drivers installed.
+type nvmlLibrary struct{}
+
+func newNVMLLibrary() gpuLibrary {
+	return nvmlLibrary{}
+}
+
+func (nvmlLibrary) init() error {
+	if ret := nvml.Init(); ret != nvml.SUCCESS {
+		return fmt.Errorf("nvml init: %s", nvml.ErrorString(ret))
+	}
+	return nil
+}
+
+func (nvmlLibrary) devices() ([]deviceStats, error) {
+	count, ret := nvml.DeviceGetCount()
+	if ret != nvml.SUCCESS {
+		return nil, fmt.Errorf("nvml device count: %s", nvml.ErrorString(ret))
+	}
+
+	devices := make([]deviceStats, 0, count)
+	for i := 0; i < count; i++ {
+		device, ret := nvml.DeviceGetHandleByIndex(i)
+		if ret != nvml.SUCCESS {
+			return nil, fmt.Errorf("nvml device %d handle: %s", i, nvml.ErrorString(ret))
+		}
+
+		uuid, ret := device.GetUUID()
+		if ret != nvml.SUCCESS {
+			return nil, fmt.Errorf("nvml device %d uuid: %s", i, nvml.ErrorString(ret))
+		}
+
+		memory, ret := device.GetMemoryInfo()
+		if ret != nvml.SUCCESS {
+			return nil, fmt.Errorf("nvml device %d memory info: %s", i, nvml.ErrorString(ret))
+		}
+
+		stats := deviceStats{
+			index:       strconv.Itoa(i),
+			uuid:        uuid,
+			memoryUsed:  memory.Used,
+			memoryFree:  memory.Free,
+			memoryTotal: memory.Total,
+		}
+
+		// The following readings are not supported by every device, so failing to read them is not an error.
+		if utilization, ret := device.GetUtilizationRates(); ret == nvml.SUCCESS {
+			value := float64(utilization.Gpu) / 100
+			stats.utilization = &value
+		}
+		if temperature, ret := device.GetTemperature(nvml.TEMPERATURE_GPU); ret == nvml.SUCCESS {
+			value := int64(temperature)
+			stats.temperature = &value
+		}
+		if milliwatts, ret := device.GetPowerUsage(); ret == nvml.SUCCESS {
+			value := float64(milliwatts) / 1000
+			stats.powerWatts = &value
+		}
+
+		devices = append(devices, stats)
+	}
+
+	return devices, nil
+}
+
+func (nvmlLibrary) shutdown() error {
+	if ret := nvml.Shutdown(); ret != nvml.SUCCESS {
+		return fmt.Errorf("nvml shutdown: %s", nvml.ErrorString(ret))
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nvml || !linux
+// +build !nvml !linux
+
+package gpuscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/gpuscraper"
+
+import "errors"
+
+var errNVMLNotCompiled = errors.New("NVML support is only available on Linux in collectors built with the nvml build tag")
+
+// unavailableLibrary is used when NVML support was not compiled in.
+type unavailableLibrary struct{}
+
+func newNVMLLibrary() gpuLibrary {
+	return unavailableLibrary{}
+}
+
+func (unavailableLibrary) init() error {
+	return errNVMLNotCompiled
+}
+
+func (unavailableLibrary) devices() ([]deviceStats, error) {
+	return nil, errNVMLNotCompiled
+}
+
+func (unavailableLibrary) shutdown() error {
+	return nil
+}
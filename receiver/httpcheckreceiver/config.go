@@ -28,7 +28,11 @@ import (
 
 // Predefined error responses for configuration validation failures
 var (
-	errInvalidEndpoint = errors.New(`"endpoint" must be in the form of <scheme>://<hostname>:<port>`)
+	errInvalidEndpoint   = errors.New(`"endpoint" must be in the form of <scheme>://<hostname>:<port>`)
+	errMissingStepName   = errors.New(`"name" must be specified for each step`)
+	errDuplicateStepName = errors.New(`"name" must be unique`)
+	errMissingStepURL    = errors.New(`"endpoint" must be specified for each step`)
+	errInvalidMatcher    = errors.New(`exactly one of "regex" or "json_path" must be specified`)
 )
 
 const defaultEndpoint = "http://localhost:80"
@@ -39,6 +43,38 @@ type Config struct {
 	confighttp.HTTPClientSettings           `mapstructure:",squash"`
 	Metrics                                 metadata.MetricsSettings `mapstructure:"metrics"`
 	Method                                  string                   `mapstructure:"method"`
+	// Steps replaces the request to the endpoint by a sequence of requests, if not empty.
+	Steps []StepConfig `mapstructure:"steps"`
+}
+
+// StepConfig is a request of a multi-step check. The `{{name}}` references in
+// its endpoint, headers and body are replaced by the variables extracted by the
+// previous steps.
+type StepConfig struct {
+	Name     string `mapstructure:"name"`
+	Endpoint string `mapstructure:"endpoint"`
+	// Method defaults to the method of the check.
+	Method  string            `mapstructure:"method"`
+	Headers map[string]string `mapstructure:"headers"`
+	Body    string            `mapstructure:"body"`
+	// Extract sets variables, by name, to values of the response body.
+	Extract map[string]MatcherConfig `mapstructure:"extract"`
+	// Assertions must all succeed for the step to succeed.
+	Assertions []AssertionConfig `mapstructure:"assertions"`
+}
+
+// MatcherConfig selects a value of a response body.
+type MatcherConfig struct {
+	// Regex selects the first capture group of the first match, or the whole match without capture groups.
+	Regex string `mapstructure:"regex"`
+	// JSONPath selects a value of a JSON body, such as `$.items[0].id`.
+	JSONPath string `mapstructure:"json_path"`
+}
+
+// AssertionConfig checks that a value of a response body exists and, if Value is set, that it is equal to Value.
+type AssertionConfig struct {
+	MatcherConfig `mapstructure:",squash"`
+	Value         string `mapstructure:"value"`
 }
 
 // Validate validates the configuration by checking for missing or invalid fields
@@ -51,5 +87,9 @@ func (cfg *Config) Validate() error {
 		err = multierr.Append(err, wrappedErr)
 	}
 
+	if _, stepsErr := compileSteps(cfg.Steps); stepsErr != nil {
+		err = multierr.Append(err, stepsErr)
+	}
+
 	return err
 }
@@ -41,6 +41,68 @@ func TestValidate(t *testing.T) {
 				fmt.Errorf("%s: %w", errInvalidEndpoint, errors.New(`parse "invalid://endpoint:  12efg": invalid port ":  12efg" after host`)),
 			),
 		},
+		{
+			desc: "invalid steps",
+			cfg: &Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: defaultEndpoint,
+				},
+				Steps: []StepConfig{
+					{
+						Name:     "login",
+						Endpoint: "http://localhost:8080/login",
+						Extract: map[string]MatcherConfig{
+							"token": {Regex: "token=(\\w+)", JSONPath: "$.token"},
+						},
+					},
+					{
+						Endpoint: "http://localhost:8080/items?token={{token}}",
+					},
+					{
+						Name:     "login",
+						Endpoint: "http://localhost:8080/items/{{id}}",
+						Assertions: []AssertionConfig{
+							{MatcherConfig: MatcherConfig{JSONPath: "items[0]"}},
+						},
+					},
+				},
+			},
+			expectedErr: multierr.Combine(
+				fmt.Errorf("step 0 %q: %w", "login", fmt.Errorf("extract %q: %w", "token", errInvalidMatcher)),
+				fmt.Errorf("step 1 %q: %w", "", multierr.Combine(errMissingStepName, errors.New(`variable "token" is not extracted by a previous step`))),
+				fmt.Errorf("step 2 %q: %w", "login", multierr.Combine(
+					errDuplicateStepName,
+					errors.New(`variable "id" is not extracted by a previous step`),
+					fmt.Errorf("assertion 0: %w", errors.New(`json path "items[0]" must start with '$'`)),
+				)),
+			),
+		},
+		{
+			desc: "valid steps",
+			cfg: &Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: defaultEndpoint,
+				},
+				Steps: []StepConfig{
+					{
+						Name:     "login",
+						Endpoint: "http://localhost:8080/login",
+						Extract: map[string]MatcherConfig{
+							"token": {JSONPath: "$.token"},
+						},
+					},
+					{
+						Name:     "items",
+						Endpoint: "http://localhost:8080/items",
+						Headers:  map[string]string{"Authorization": "Bearer {{ token }}"},
+						Assertions: []AssertionConfig{
+							{MatcherConfig: MatcherConfig{Regex: "\"status\":\\s*\"ok\""}},
+						},
+					},
+				},
+			},
+			expectedErr: nil,
+		},
 		{
 			desc: "valid config",
 			cfg: &Config{
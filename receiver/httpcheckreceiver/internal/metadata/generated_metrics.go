@@ -37,9 +37,11 @@ func (ms *MetricSettings) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsSettings provides settings for httpcheckreceiver metrics.
 type MetricsSettings struct {
-	HttpcheckDuration MetricSettings `mapstructure:"httpcheck.duration"`
-	HttpcheckError    MetricSettings `mapstructure:"httpcheck.error"`
-	HttpcheckStatus   MetricSettings `mapstructure:"httpcheck.status"`
+	HttpcheckDuration     MetricSettings `mapstructure:"httpcheck.duration"`
+	HttpcheckError        MetricSettings `mapstructure:"httpcheck.error"`
+	HttpcheckStatus       MetricSettings `mapstructure:"httpcheck.status"`
+	HttpcheckStepDuration MetricSettings `mapstructure:"httpcheck.step.duration"`
+	HttpcheckStepSuccess  MetricSettings `mapstructure:"httpcheck.step.success"`
 }
 
 func DefaultMetricsSettings() MetricsSettings {
@@ -53,6 +55,12 @@ func DefaultMetricsSettings() MetricsSettings {
 		HttpcheckStatus: MetricSettings{
 			Enabled: true,
 		},
+		HttpcheckStepDuration: MetricSettings{
+			Enabled: true,
+		},
+		HttpcheckStepSuccess: MetricSettings{
+			Enabled: true,
+		},
 	}
 }
 
@@ -217,17 +225,124 @@ func newMetricHttpcheckStatus(settings MetricSettings) metricHttpcheckStatus {
 	return m
 }
 
+type metricHttpcheckStepDuration struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills httpcheck.step.duration metric with initial data.
+func (m *metricHttpcheckStepDuration) init() {
+	m.data.SetName("httpcheck.step.duration")
+	m.data.SetDescription("Measures the duration of a step of a multi-step HTTP check, including the read of the response body.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricHttpcheckStepDuration) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, stepNameAttributeValue string, httpURLAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("step.name", stepNameAttributeValue)
+	dp.Attributes().PutStr("http.url", httpURLAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHttpcheckStepDuration) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHttpcheckStepDuration) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHttpcheckStepDuration(settings MetricSettings) metricHttpcheckStepDuration {
+	m := metricHttpcheckStepDuration{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricHttpcheckStepSuccess struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills httpcheck.step.success metric with initial data.
+func (m *metricHttpcheckStepSuccess) init() {
+	m.data.SetName("httpcheck.step.success")
+	m.data.SetDescription("1 if the step of a multi-step HTTP check succeeded, otherwise 0.")
+	m.data.SetUnit("1")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricHttpcheckStepSuccess) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, stepNameAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("step.name", stepNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHttpcheckStepSuccess) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHttpcheckStepSuccess) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHttpcheckStepSuccess(settings MetricSettings) metricHttpcheckStepSuccess {
+	m := metricHttpcheckStepSuccess{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user settings.
 type MetricsBuilder struct {
-	startTime               pcommon.Timestamp   // start time that will be applied to all recorded data points.
-	metricsCapacity         int                 // maximum observed number of metrics per resource.
-	resourceCapacity        int                 // maximum observed number of resource attributes.
-	metricsBuffer           pmetric.Metrics     // accumulates metrics data before emitting.
-	buildInfo               component.BuildInfo // contains version information
-	metricHttpcheckDuration metricHttpcheckDuration
-	metricHttpcheckError    metricHttpcheckError
-	metricHttpcheckStatus   metricHttpcheckStatus
+	startTime                   pcommon.Timestamp   // start time that will be applied to all recorded data points.
+	metricsCapacity             int                 // maximum observed number of metrics per resource.
+	resourceCapacity            int                 // maximum observed number of resource attributes.
+	metricsBuffer               pmetric.Metrics     // accumulates metrics data before emitting.
+	buildInfo                   component.BuildInfo // contains version information
+	metricHttpcheckDuration     metricHttpcheckDuration
+	metricHttpcheckError        metricHttpcheckError
+	metricHttpcheckStatus       metricHttpcheckStatus
+	metricHttpcheckStepDuration metricHttpcheckStepDuration
+	metricHttpcheckStepSuccess  metricHttpcheckStepSuccess
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -242,12 +357,14 @@ func WithStartTime(startTime pcommon.Timestamp) metricBuilderOption {
 
 func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo, options ...metricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		startTime:               pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:           pmetric.NewMetrics(),
-		buildInfo:               buildInfo,
-		metricHttpcheckDuration: newMetricHttpcheckDuration(settings.HttpcheckDuration),
-		metricHttpcheckError:    newMetricHttpcheckError(settings.HttpcheckError),
-		metricHttpcheckStatus:   newMetricHttpcheckStatus(settings.HttpcheckStatus),
+		startTime:                   pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:               pmetric.NewMetrics(),
+		buildInfo:                   buildInfo,
+		metricHttpcheckDuration:     newMetricHttpcheckDuration(settings.HttpcheckDuration),
+		metricHttpcheckError:        newMetricHttpcheckError(settings.HttpcheckError),
+		metricHttpcheckStatus:       newMetricHttpcheckStatus(settings.HttpcheckStatus),
+		metricHttpcheckStepDuration: newMetricHttpcheckStepDuration(settings.HttpcheckStepDuration),
+		metricHttpcheckStepSuccess:  newMetricHttpcheckStepSuccess(settings.HttpcheckStepSuccess),
 	}
 	for _, op := range options {
 		op(mb)
@@ -303,6 +420,8 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricHttpcheckDuration.emit(ils.Metrics())
 	mb.metricHttpcheckError.emit(ils.Metrics())
 	mb.metricHttpcheckStatus.emit(ils.Metrics())
+	mb.metricHttpcheckStepDuration.emit(ils.Metrics())
+	mb.metricHttpcheckStepSuccess.emit(ils.Metrics())
 	for _, op := range rmo {
 		op(rm)
 	}
@@ -337,6 +456,16 @@ func (mb *MetricsBuilder) RecordHttpcheckStatusDataPoint(ts pcommon.Timestamp, v
 	mb.metricHttpcheckStatus.recordDataPoint(mb.startTime, ts, val, httpURLAttributeValue, httpStatusCodeAttributeValue, httpMethodAttributeValue, httpStatusClassAttributeValue)
 }
 
+// RecordHttpcheckStepDurationDataPoint adds a data point to httpcheck.step.duration metric.
+func (mb *MetricsBuilder) RecordHttpcheckStepDurationDataPoint(ts pcommon.Timestamp, val int64, stepNameAttributeValue string, httpURLAttributeValue string) {
+	mb.metricHttpcheckStepDuration.recordDataPoint(mb.startTime, ts, val, stepNameAttributeValue, httpURLAttributeValue)
+}
+
+// RecordHttpcheckStepSuccessDataPoint adds a data point to httpcheck.step.success metric.
+func (mb *MetricsBuilder) RecordHttpcheckStepSuccessDataPoint(ts pcommon.Timestamp, val int64, stepNameAttributeValue string) {
+	mb.metricHttpcheckStepSuccess.recordDataPoint(mb.startTime, ts, val, stepNameAttributeValue)
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
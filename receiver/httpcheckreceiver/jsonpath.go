@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpcheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver"
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// jsonPath is the subset of JSONPath selecting a single value: `$` followed by
+// `.key`, `['key']` and `[index]` segments. Each segment is either a string key
+// or an int index.
+type jsonPath []interface{}
+
+func parseJSONPath(path string) (jsonPath, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("json path %q must start with '$'", path)
+	}
+
+	var segments jsonPath
+	rest := path[1:]
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			end := strings.IndexAny(rest[1:], ".[")
+			if end < 0 {
+				end = len(rest) - 1
+			}
+			key := rest[1 : end+1]
+			if key == "" {
+				return nil, fmt.Errorf("json path %q has an empty key", path)
+			}
+			segments = append(segments, key)
+			rest = rest[end+1:]
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("json path %q has an unterminated '['", path)
+			}
+			inner := rest[1:end]
+			if len(inner) >= 2 && (inner[0] == '\'' || inner[0] == '"') && inner[len(inner)-1] == inner[0] {
+				segments = append(segments, inner[1:len(inner)-1])
+			} else {
+				index, err := strconv.Atoi(inner)
+				if err != nil || index < 0 {
+					return nil, fmt.Errorf("json path %q has an invalid index %q", path, inner)
+				}
+				segments = append(segments, index)
+			}
+			rest = rest[end+1:]
+		default:
+			return nil, fmt.Errorf("json path %q has an unexpected character %q", path, rest[0])
+		}
+	}
+	return segments, nil
+}
+
+// find returns the value selected by the path in the decoded JSON document,
+// formatted as a string, and whether it exists.
+func (p jsonPath) find(document interface{}) (string, bool) {
+	value := document
+	for _, segment := range p {
+		switch s := segment.(type) {
+		case string:
+			object, ok := value.(map[string]interface{})
+			if !ok {
+				return "", false
+			}
+			if value, ok = object[s]; !ok {
+				return "", false
+			}
+		case int:
+			array, ok := value.([]interface{})
+			if !ok || s >= len(array) {
+				return "", false
+			}
+			value = array[s]
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case json.Number:
+		return v.String(), true
+	case nil:
+		return "null", true
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return "", false
+		}
+		return string(encoded), true
+	}
+}
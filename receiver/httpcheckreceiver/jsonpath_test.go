@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpcheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver"
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONPath(t *testing.T) {
+	body := &responseBody{raw: []byte(`{"status":"ok","count":3,"ready":true,"items":[{"id":"a-1","tags":["x"]},{"id":"a-2"}],"dotted.key":null}`)}
+	document, err := body.json()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		path     string
+		expected string
+		found    bool
+	}{
+		{path: "$.status", expected: "ok", found: true},
+		{path: "$.count", expected: "3", found: true},
+		{path: "$.ready", expected: "true", found: true},
+		{path: "$.items[1].id", expected: "a-2", found: true},
+		{path: "$['items'][0]['tags']", expected: `["x"]`, found: true},
+		{path: `$["dotted.key"]`, expected: "null", found: true},
+		{path: "$.items[2].id", found: false},
+		{path: "$.status.code", found: false},
+		{path: "$.missing", found: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.path, func(t *testing.T) {
+			path, err := parseJSONPath(tc.path)
+			require.NoError(t, err)
+			value, found := path.find(document)
+			require.Equal(t, tc.found, found)
+			require.Equal(t, tc.expected, value)
+		})
+	}
+}
+
+func TestParseJSONPathErrors(t *testing.T) {
+	for _, path := range []string{"status", "$..status", "$.items[", "$.items[-1]", "$.items[a]", "$status"} {
+		_, err := parseJSONPath(path)
+		require.Error(t, err, path)
+	}
+}
@@ -37,10 +37,14 @@ type httpcheckScraper struct {
 	cfg      *Config
 	settings component.TelemetrySettings
 	mb       *metadata.MetricsBuilder
+	steps    []*checkStep
 }
 
 // start starts the scraper by creating a new HTTP Client on the scraper
 func (h *httpcheckScraper) start(ctx context.Context, host component.Host) (err error) {
+	if h.steps, err = compileSteps(h.cfg.Steps); err != nil {
+		return err
+	}
 	h.client, err = h.cfg.ToClient(host, h.settings)
 	return
 }
@@ -53,6 +57,11 @@ func (h *httpcheckScraper) scrape(ctx context.Context) (pmetric.Metrics, error)
 
 	now := pcommon.NewTimestampFromTime(time.Now())
 
+	if len(h.steps) > 0 {
+		h.scrapeSteps(ctx, now)
+		return h.mb.Emit(), nil
+	}
+
 	req, err := http.NewRequestWithContext(ctx, h.cfg.Method, h.cfg.Endpoint, http.NoBody)
 	if err != nil {
 		return pmetric.Metrics{}, err
@@ -69,16 +78,20 @@ func (h *httpcheckScraper) scrape(ctx context.Context) (pmetric.Metrics, error)
 		statusCode = resp.StatusCode
 	}
 
+	h.recordStatus(now, h.cfg.Endpoint, statusCode, req.Method)
+
+	return h.mb.Emit(), nil
+}
+
+// recordStatus records a status data point for each response class, a status code of 0 matching none.
+func (h *httpcheckScraper) recordStatus(now pcommon.Timestamp, endpoint string, statusCode int, method string) {
 	for class, intVal := range httpResponseClasses {
 		if statusCode/100 == intVal {
-			h.mb.RecordHttpcheckStatusDataPoint(now, int64(1), h.cfg.Endpoint, int64(statusCode), req.Method, class)
+			h.mb.RecordHttpcheckStatusDataPoint(now, int64(1), endpoint, int64(statusCode), method, class)
 		} else {
-			h.mb.RecordHttpcheckStatusDataPoint(now, int64(0), h.cfg.Endpoint, int64(statusCode), req.Method, class)
+			h.mb.RecordHttpcheckStatusDataPoint(now, int64(0), endpoint, int64(statusCode), method, class)
 		}
-
 	}
-
-	return h.mb.Emit(), nil
 }
 
 func newScraper(conf *Config, settings component.ReceiverCreateSettings) *httpcheckScraper {
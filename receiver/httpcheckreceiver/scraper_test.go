@@ -181,3 +181,119 @@ func TestNilClient(t *testing.T) {
 	require.NoError(t, scrapertest.CompareMetrics(pmetric.NewMetrics(), actualMetrics))
 
 }
+
+func newStepsMockServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		var err error
+		switch {
+		case req.URL.Path == "/login" && req.Method == http.MethodPost:
+			_, err = rw.Write([]byte(`{"token":"secret","user":{"id":42}}`))
+		case req.URL.Path == "/users/42" && req.Header.Get("Authorization") == "Bearer secret":
+			_, err = rw.Write([]byte(`<p>status: healthy</p>`))
+		default:
+			rw.WriteHeader(http.StatusUnauthorized)
+		}
+		require.NoError(t, err)
+	}))
+}
+
+func TestScraperScrapeSteps(t *testing.T) {
+	ms := newStepsMockServer(t)
+	defer ms.Close()
+
+	login := StepConfig{
+		Name:     "login",
+		Endpoint: ms.URL + "/login",
+		Method:   http.MethodPost,
+		Body:     `{"user":"admin"}`,
+		Extract: map[string]MatcherConfig{
+			"token": {JSONPath: "$.token"},
+			"id":    {JSONPath: "$.user.id"},
+		},
+	}
+	user := StepConfig{
+		Name:     "user",
+		Endpoint: ms.URL + "/users/{{id}}",
+		Headers:  map[string]string{"Authorization": "Bearer {{token}}"},
+		Assertions: []AssertionConfig{
+			{MatcherConfig: MatcherConfig{Regex: `status: (\w+)`}, Value: "healthy"},
+		},
+	}
+	badAssertion := user
+	badAssertion.Assertions = []AssertionConfig{
+		{MatcherConfig: MatcherConfig{Regex: `status: (\w+)`}, Value: "degraded"},
+	}
+	unauthorized := StepConfig{
+		Name:     "user",
+		Endpoint: ms.URL + "/users/42",
+	}
+
+	testCases := []struct {
+		desc            string
+		steps           []StepConfig
+		expectedSuccess map[string]int64
+		expectedError   string
+	}{
+		{
+			desc:            "all steps succeed",
+			steps:           []StepConfig{login, user},
+			expectedSuccess: map[string]int64{"login": 1, "user": 1},
+		},
+		{
+			desc:            "assertion fails",
+			steps:           []StepConfig{login, badAssertion},
+			expectedSuccess: map[string]int64{"login": 1, "user": 0},
+			expectedError:   `assertion 0: value "healthy" is not "degraded"`,
+		},
+		{
+			desc:            "status code fails and skips the next steps",
+			steps:           []StepConfig{unauthorized, login},
+			expectedSuccess: map[string]int64{"user": 0, "login": 0},
+			expectedError:   "unexpected status code 401",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Steps = tc.steps
+			scraper := newScraper(cfg, componenttest.NewNopReceiverCreateSettings())
+			require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+			actualMetrics, err := scraper.scrape(context.Background())
+			require.NoError(t, err)
+
+			success := map[string]int64{}
+			var durations int
+			var errorMessages []string
+			metrics := actualMetrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+			for i := 0; i < metrics.Len(); i++ {
+				m := metrics.At(i)
+				switch m.Name() {
+				case "httpcheck.step.success":
+					for j := 0; j < m.Sum().DataPoints().Len(); j++ {
+						dp := m.Sum().DataPoints().At(j)
+						name, _ := dp.Attributes().Get("step.name")
+						success[name.Str()] = dp.IntValue()
+					}
+				case "httpcheck.step.duration":
+					durations = m.Gauge().DataPoints().Len()
+				case "httpcheck.error":
+					for j := 0; j < m.Sum().DataPoints().Len(); j++ {
+						message, _ := m.Sum().DataPoints().At(j).Attributes().Get("error.message")
+						errorMessages = append(errorMessages, message.Str())
+					}
+				case "httpcheck.duration":
+					t.Errorf("unexpected metric %s", m.Name())
+				}
+			}
+			require.Equal(t, tc.expectedSuccess, success)
+			if tc.expectedError == "" {
+				require.Empty(t, errorMessages)
+				require.Equal(t, len(tc.steps), durations)
+			} else {
+				require.Equal(t, []string{tc.expectedError}, errorMessages)
+			}
+		})
+	}
+}
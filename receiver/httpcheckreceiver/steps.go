@@ -0,0 +1,282 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpcheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/multierr"
+)
+
+// stepVariable matches the `{{name}}` references to the variables extracted by previous steps.
+var stepVariable = regexp.MustCompile(`{{\s*([A-Za-z0-9_.-]+)\s*}}`)
+
+// checkStep is the compiled form of a StepConfig.
+type checkStep struct {
+	cfg        StepConfig
+	extract    map[string]*matcher
+	assertions []*assertion
+}
+
+type matcher struct {
+	regex *regexp.Regexp
+	path  jsonPath
+}
+
+type assertion struct {
+	matcher *matcher
+	cfg     AssertionConfig
+}
+
+func compileSteps(cfgs []StepConfig) ([]*checkStep, error) {
+	var errs error
+	steps := make([]*checkStep, 0, len(cfgs))
+	names := map[string]bool{}
+	variables := map[string]bool{}
+	for i, cfg := range cfgs {
+		step, err := compileStep(cfg, variables)
+		if cfg.Name == "" {
+			err = multierr.Append(errMissingStepName, err)
+		} else if names[cfg.Name] {
+			err = multierr.Append(errDuplicateStepName, err)
+		}
+		names[cfg.Name] = true
+		if err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("step %d %q: %w", i, cfg.Name, err))
+			continue
+		}
+		steps = append(steps, step)
+	}
+	if errs != nil {
+		return nil, errs
+	}
+	return steps, nil
+}
+
+// compileStep compiles a step, whose variable references must be in variables, and adds its extracted variables to variables.
+func compileStep(cfg StepConfig, variables map[string]bool) (*checkStep, error) {
+	var errs error
+	if cfg.Endpoint == "" {
+		errs = multierr.Append(errs, errMissingStepURL)
+	}
+
+	templates := []string{cfg.Endpoint, cfg.Body}
+	for _, value := range cfg.Headers {
+		templates = append(templates, value)
+	}
+	for _, template := range templates {
+		for _, reference := range stepVariable.FindAllStringSubmatch(template, -1) {
+			if !variables[reference[1]] {
+				errs = multierr.Append(errs, fmt.Errorf("variable %q is not extracted by a previous step", reference[1]))
+			}
+		}
+	}
+
+	step := &checkStep{cfg: cfg, extract: map[string]*matcher{}}
+	for name, matcherCfg := range cfg.Extract {
+		m, err := newMatcher(matcherCfg)
+		if err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("extract %q: %w", name, err))
+			continue
+		}
+		step.extract[name] = m
+	}
+	for i, assertionCfg := range cfg.Assertions {
+		m, err := newMatcher(assertionCfg.MatcherConfig)
+		if err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("assertion %d: %w", i, err))
+			continue
+		}
+		step.assertions = append(step.assertions, &assertion{matcher: m, cfg: assertionCfg})
+	}
+	if errs != nil {
+		return nil, errs
+	}
+
+	for name := range cfg.Extract {
+		variables[name] = true
+	}
+	return step, nil
+}
+
+func newMatcher(cfg MatcherConfig) (*matcher, error) {
+	if (cfg.Regex == "") == (cfg.JSONPath == "") {
+		return nil, errInvalidMatcher
+	}
+	if cfg.Regex != "" {
+		regex, err := regexp.Compile(cfg.Regex)
+		if err != nil {
+			return nil, err
+		}
+		return &matcher{regex: regex}, nil
+	}
+	path, err := parseJSONPath(cfg.JSONPath)
+	if err != nil {
+		return nil, err
+	}
+	return &matcher{path: path}, nil
+}
+
+// find returns the value selected in the response body and whether it exists.
+func (m *matcher) find(body *responseBody) (string, bool, error) {
+	if m.regex != nil {
+		match := m.regex.FindSubmatch(body.raw)
+		switch {
+		case match == nil:
+			return "", false, nil
+		case len(match) > 1:
+			return string(match[1]), true, nil
+		default:
+			return string(match[0]), true, nil
+		}
+	}
+
+	document, err := body.json()
+	if err != nil {
+		return "", false, err
+	}
+	value, ok := m.path.find(document)
+	return value, ok, nil
+}
+
+// responseBody decodes a JSON body once, for all the JSON paths of a step.
+type responseBody struct {
+	raw      []byte
+	document interface{}
+	err      error
+	decoded  bool
+}
+
+func (b *responseBody) json() (interface{}, error) {
+	if !b.decoded {
+		decoder := json.NewDecoder(bytes.NewReader(b.raw))
+		decoder.UseNumber()
+		b.err = decoder.Decode(&b.document)
+		if b.err != nil {
+			b.err = fmt.Errorf("response body is not JSON: %w", b.err)
+		}
+		b.decoded = true
+	}
+	return b.document, b.err
+}
+
+func expandVariables(template string, variables map[string]string) string {
+	return stepVariable.ReplaceAllStringFunc(template, func(reference string) string {
+		return variables[stepVariable.FindStringSubmatch(reference)[1]]
+	})
+}
+
+// scrapeSteps runs the steps in order, with the variables extracted by the previous ones.
+// The steps after a failed step are not run and are recorded as failed.
+func (h *httpcheckScraper) scrapeSteps(ctx context.Context, now pcommon.Timestamp) {
+	variables := map[string]string{}
+	failed := false
+	for _, step := range h.steps {
+		if failed {
+			h.mb.RecordHttpcheckStepSuccessDataPoint(now, int64(0), step.cfg.Name)
+			continue
+		}
+
+		endpoint := expandVariables(step.cfg.Endpoint, variables)
+		if err := h.runStep(ctx, now, step, endpoint, variables); err != nil {
+			h.mb.RecordHttpcheckErrorDataPoint(now, int64(1), endpoint, err.Error())
+			h.mb.RecordHttpcheckStepSuccessDataPoint(now, int64(0), step.cfg.Name)
+			failed = true
+			continue
+		}
+		h.mb.RecordHttpcheckStepSuccessDataPoint(now, int64(1), step.cfg.Name)
+	}
+}
+
+// runStep sends the request of the step, checks its assertions and adds its extracted variables to variables.
+func (h *httpcheckScraper) runStep(ctx context.Context, now pcommon.Timestamp, step *checkStep, endpoint string, variables map[string]string) error {
+	method := step.cfg.Method
+	if method == "" {
+		method = h.cfg.Method
+	}
+	var reqBody io.Reader = http.NoBody
+	if step.cfg.Body != "" {
+		reqBody = strings.NewReader(expandVariables(step.cfg.Body, variables))
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reqBody)
+	if err != nil {
+		return err
+	}
+	for name, value := range step.cfg.Headers {
+		req.Header.Set(name, expandVariables(value, variables))
+	}
+
+	start := time.Now()
+	resp, err := h.client.Do(req)
+	var raw []byte
+	if err == nil {
+		raw, err = io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+	}
+	h.mb.RecordHttpcheckStepDurationDataPoint(now, time.Since(start).Milliseconds(), step.cfg.Name, endpoint)
+
+	statusCode := 0
+	if resp != nil {
+		statusCode = resp.StatusCode
+	}
+	h.recordStatus(now, endpoint, statusCode, req.Method)
+	if err != nil {
+		return err
+	}
+	if statusCode >= http.StatusBadRequest {
+		return fmt.Errorf("unexpected status code %d", statusCode)
+	}
+
+	body := &responseBody{raw: raw}
+	for i, a := range step.assertions {
+		value, ok, findErr := a.matcher.find(body)
+		switch {
+		case findErr != nil:
+			return fmt.Errorf("assertion %d: %w", i, findErr)
+		case !ok:
+			return fmt.Errorf("assertion %d: no value found", i)
+		case a.cfg.Value != "" && value != a.cfg.Value:
+			return fmt.Errorf("assertion %d: value %q is not %q", i, value, a.cfg.Value)
+		}
+	}
+
+	// Extract in a stable order so that the reported error does not change between scrapes.
+	names := make([]string, 0, len(step.extract))
+	for name := range step.extract {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		value, ok, findErr := step.extract[name].find(body)
+		if findErr != nil {
+			return fmt.Errorf("extract %q: %w", name, findErr)
+		}
+		if !ok {
+			return fmt.Errorf("extract %q: no value found", name)
+		}
+		variables[name] = value
+	}
+	return nil
+}
@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -73,6 +74,43 @@ type Config struct {
 	// Log level used by the JMX metric gatherer. Should be one of:
 	// `"trace"`, `"debug"`, `"info"`, `"warn"`, `"error"`, `"off"`
 	LogLevel string `mapstructure:"log_level"`
+	// Jolokia scrapes the MBeans through a Jolokia HTTP endpoint instead of running the JMX Metric Gatherer JAR, if set.
+	// The `jar_path`, `endpoint`, `target_system`, `otlp`, `additional_jars`, `log_level`, keystore, truststore and
+	// remote profile settings are ignored in this mode.
+	Jolokia *JolokiaConfig `mapstructure:"jolokia"`
+}
+
+// JolokiaConfig defines the Jolokia endpoint and the MBeans to scrape from it.
+type JolokiaConfig struct {
+	confighttp.HTTPClientSettings `mapstructure:",squash"`
+	// MBeans maps the attributes of MBeans to metrics.
+	MBeans []MBeanConfig `mapstructure:"mbeans"`
+}
+
+// MBeanConfig maps the attributes of an MBean, or of all the MBeans matching an object name pattern, to metrics.
+type MBeanConfig struct {
+	// The object name of the MBean, such as `java.lang:type=Memory`, or a pattern such as `java.lang:type=GarbageCollector,name=*`.
+	ObjectName string `mapstructure:"object_name"`
+	// Map of metric attribute names to the object name keys whose values they are set to.
+	MetricAttributes map[string]string `mapstructure:"metric_attributes"`
+	// The metrics read from the attributes of the MBean.
+	Metrics []MBeanMetricConfig `mapstructure:"metrics"`
+}
+
+// MBeanMetricConfig maps an attribute of an MBean to a metric.
+type MBeanMetricConfig struct {
+	// The name of the metric.
+	Name string `mapstructure:"name"`
+	// The description of the metric.
+	Description string `mapstructure:"description"`
+	// The unit of the metric.
+	Unit string `mapstructure:"unit"`
+	// The MBean attribute the value is read from.
+	Attribute string `mapstructure:"attribute"`
+	// The `/` separated path of the value inside a composite attribute, such as `used` for `HeapMemoryUsage`.
+	Path string `mapstructure:"path"`
+	// The type of the metric, one of `gauge` (default) or `counter`, a cumulative monotonic sum.
+	Type string `mapstructure:"type"`
 }
 
 // We don't embed the existing OTLP Exporter config as most fields are unsupported
@@ -228,6 +266,10 @@ func initAdditionalTargetSystems() {
 }
 
 func (c *Config) validate() error {
+	if c.Jolokia != nil {
+		return c.validateJolokia()
+	}
+
 	var missingFields []string
 	if c.JARPath == "" {
 		missingFields = append(missingFields, "`jar_path`")
@@ -285,6 +327,42 @@ func (c *Config) validate() error {
 	return nil
 }
 
+var validMetricTypes = map[string]struct{}{metricTypeGauge: {}, metricTypeCounter: {}}
+
+func (c *Config) validateJolokia() error {
+	if c.Jolokia.Endpoint == "" {
+		return fmt.Errorf("%v missing required field: `jolokia.endpoint`", c.ID())
+	}
+	if len(c.Jolokia.MBeans) == 0 {
+		return fmt.Errorf("%v missing required field: `jolokia.mbeans`", c.ID())
+	}
+
+	if c.CollectionInterval < 0 {
+		return fmt.Errorf("%v `interval` must be positive: %vms", c.ID(), c.CollectionInterval.Milliseconds())
+	}
+
+	for i, mbean := range c.Jolokia.MBeans {
+		if mbean.ObjectName == "" {
+			return fmt.Errorf("%v `jolokia.mbeans[%d]` missing required field: `object_name`", c.ID(), i)
+		}
+		if len(mbean.Metrics) == 0 {
+			return fmt.Errorf("%v `jolokia.mbeans[%d]` missing required field: `metrics`", c.ID(), i)
+		}
+		for j, metric := range mbean.Metrics {
+			if metric.Name == "" || metric.Attribute == "" {
+				return fmt.Errorf("%v `jolokia.mbeans[%d].metrics[%d]` requires both `name` and `attribute`", c.ID(), i, j)
+			}
+			if metric.Type != "" {
+				if _, ok := validMetricTypes[metric.Type]; !ok {
+					return fmt.Errorf("%v `jolokia.mbeans[%d].metrics[%d].type` must be one of %s", c.ID(), i, j, listKeys(validMetricTypes))
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 func listKeys(presenceMap map[string]struct{}) string {
 	list := make([]string, 0, len(presenceMap))
 	for k := range presenceMap {
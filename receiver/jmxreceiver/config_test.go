@@ -23,6 +23,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 )
@@ -215,6 +216,70 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(typeStr, "jolokia"),
+			expected: &Config{
+				ReceiverSettings:   config.NewReceiverSettings(component.NewID(typeStr)),
+				JARPath:            "/opt/opentelemetry-java-contrib-jmx-metrics.jar",
+				CollectionInterval: 30 * time.Second,
+				Username:           "myusername",
+				Password:           "mypassword",
+				OTLPExporterConfig: otlpExporterConfig{
+					Endpoint: "0.0.0.0:0",
+					TimeoutSettings: exporterhelper.TimeoutSettings{
+						Timeout: 5 * time.Second,
+					},
+				},
+				Jolokia: &JolokiaConfig{
+					HTTPClientSettings: confighttp.HTTPClientSettings{
+						Endpoint: "http://localhost:8778/jolokia",
+					},
+					MBeans: []MBeanConfig{
+						{
+							ObjectName: "java.lang:type=Memory",
+							Metrics: []MBeanMetricConfig{
+								{Name: "jvm.memory.heap.used", Unit: "By", Attribute: "HeapMemoryUsage", Path: "used"},
+							},
+						},
+						{
+							ObjectName:       "java.lang:type=GarbageCollector,name=*",
+							MetricAttributes: map[string]string{"name": "name"},
+							Metrics: []MBeanMetricConfig{
+								{Name: "jvm.gc.collections.count", Attribute: "CollectionCount", Type: "counter"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(typeStr, "jolokiainvalidtype"),
+			expectedErr: "`jolokia.mbeans[0].metrics[0].type` must be one of 'counter', 'gauge'",
+			expected: &Config{
+				ReceiverSettings:   config.NewReceiverSettings(component.NewID(typeStr)),
+				JARPath:            "/opt/opentelemetry-java-contrib-jmx-metrics.jar",
+				CollectionInterval: 10 * time.Second,
+				OTLPExporterConfig: otlpExporterConfig{
+					Endpoint: "0.0.0.0:0",
+					TimeoutSettings: exporterhelper.TimeoutSettings{
+						Timeout: 5 * time.Second,
+					},
+				},
+				Jolokia: &JolokiaConfig{
+					HTTPClientSettings: confighttp.HTTPClientSettings{
+						Endpoint: "http://localhost:8778/jolokia",
+					},
+					MBeans: []MBeanConfig{
+						{
+							ObjectName: "java.lang:type=Memory",
+							Metrics: []MBeanMetricConfig{
+								{Name: "jvm.memory.heap.used", Attribute: "HeapMemoryUsage", Type: "histogram"},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -61,5 +61,8 @@ func createReceiver(
 	if err := jmxConfig.validate(); err != nil {
 		return nil, err
 	}
+	if jmxConfig.Jolokia != nil {
+		return createJolokiaReceiver(params, jmxConfig, consumer)
+	}
 	return newJMXMetricReceiver(params, jmxConfig, consumer), nil
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 )
 
@@ -62,3 +63,23 @@ func TestWithValidConfig(t *testing.T) {
 	assert.Same(t, receiver.logger, params.Logger)
 	assert.Same(t, receiver.config, cfg)
 }
+
+func TestWithJolokiaConfig(t *testing.T) {
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig()
+	cfg.(*Config).Jolokia = &JolokiaConfig{
+		HTTPClientSettings: confighttp.HTTPClientSettings{Endpoint: "http://localhost:8778/jolokia"},
+		MBeans: []MBeanConfig{
+			{
+				ObjectName: "java.lang:type=Memory",
+				Metrics:    []MBeanMetricConfig{{Name: "jvm.memory.heap.used", Attribute: "HeapMemoryUsage", Path: "used"}},
+			},
+		},
+	}
+
+	r, err := f.CreateMetricsReceiver(context.Background(), componenttest.NewNopReceiverCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	require.NotNil(t, r)
+	_, isGatherer := r.(*jmxMetricReceiver)
+	assert.False(t, isGatherer)
+}
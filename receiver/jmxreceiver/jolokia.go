@@ -0,0 +1,288 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jmxreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/jmxreceiver"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+)
+
+const (
+	metricTypeGauge   = "gauge"
+	metricTypeCounter = "counter"
+
+	jolokiaScopeName = "otelcol/jmxreceiver"
+)
+
+// jolokiaRequest is a Jolokia read request of some attributes of the MBeans matching an object name.
+type jolokiaRequest struct {
+	Type      string            `json:"type"`
+	MBean     string            `json:"mbean"`
+	Attribute []string          `json:"attribute"`
+	Config    map[string]string `json:"config,omitempty"`
+}
+
+// jolokiaResponse is the response to a jolokiaRequest. The value maps the attribute names to their values, or, for an
+// object name pattern, the matching object names to such a map.
+type jolokiaResponse struct {
+	Status int             `json:"status"`
+	Error  string          `json:"error"`
+	Value  json.RawMessage `json:"value"`
+}
+
+// jolokiaScraper reads the configured MBean attributes with a bulk request to a Jolokia agent,
+// without requiring the JMX Metric Gatherer JAR.
+type jolokiaScraper struct {
+	settings  component.ReceiverCreateSettings
+	config    *Config
+	client    *http.Client
+	startTime pcommon.Timestamp
+}
+
+func newJolokiaScraper(settings component.ReceiverCreateSettings, config *Config) *jolokiaScraper {
+	return &jolokiaScraper{
+		settings: settings,
+		config:   config,
+	}
+}
+
+func createJolokiaReceiver(
+	params component.ReceiverCreateSettings,
+	config *Config,
+	consumer consumer.Metrics,
+) (component.MetricsReceiver, error) {
+	s := newJolokiaScraper(params, config)
+	scraper, err := scraperhelper.NewScraper(typeStr, s.scrape, scraperhelper.WithStart(s.start))
+	if err != nil {
+		return nil, err
+	}
+	controllerSettings := &scraperhelper.ScraperControllerSettings{
+		ReceiverSettings:   config.ReceiverSettings,
+		CollectionInterval: config.CollectionInterval,
+	}
+	return scraperhelper.NewScraperControllerReceiver(controllerSettings, params, consumer, scraperhelper.AddScraper(scraper))
+}
+
+func (s *jolokiaScraper) start(_ context.Context, host component.Host) error {
+	client, err := s.config.Jolokia.ToClient(host, s.settings.TelemetrySettings)
+	if err != nil {
+		return err
+	}
+	s.client = client
+	s.startTime = pcommon.NewTimestampFromTime(time.Now())
+	return nil
+}
+
+func (s *jolokiaScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	responses, err := s.read(ctx)
+	if err != nil {
+		return pmetric.NewMetrics(), err
+	}
+
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	for k, v := range s.config.ResourceAttributes {
+		rm.Resource().Attributes().PutStr(k, v)
+	}
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName(jolokiaScopeName)
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+	metrics := map[string]pmetric.Metric{}
+	var errs scrapererror.ScrapeErrors
+	for i, mbean := range s.config.Jolokia.MBeans {
+		response := responses[i]
+		if response.Status != http.StatusOK {
+			errs.AddPartial(len(mbean.Metrics), fmt.Errorf("failed to read mbean %q: %s", mbean.ObjectName, response.Error))
+			continue
+		}
+
+		values, err := mbeanValues(mbean.ObjectName, response.Value)
+		if err != nil {
+			errs.AddPartial(len(mbean.Metrics), fmt.Errorf("failed to parse mbean %q: %w", mbean.ObjectName, err))
+			continue
+		}
+
+		for objectName, attributes := range values {
+			properties := objectNameProperties(objectName)
+			for _, metricCfg := range mbean.Metrics {
+				value, ok := attributeValue(attributes[metricCfg.Attribute], metricCfg.Path)
+				if !ok {
+					continue
+				}
+				metric, ok := metrics[metricCfg.Name]
+				if !ok {
+					metric = newMetric(sm.Metrics(), metricCfg)
+					metrics[metricCfg.Name] = metric
+				}
+				var dp pmetric.NumberDataPoint
+				if metric.Type() == pmetric.MetricTypeSum {
+					dp = metric.Sum().DataPoints().AppendEmpty()
+					dp.SetStartTimestamp(s.startTime)
+				} else {
+					dp = metric.Gauge().DataPoints().AppendEmpty()
+				}
+				dp.SetTimestamp(now)
+				dp.SetDoubleValue(value)
+				for attribute, key := range mbean.MetricAttributes {
+					if v, ok := properties[key]; ok {
+						dp.Attributes().PutStr(attribute, v)
+					}
+				}
+			}
+		}
+	}
+
+	return md, errs.Combine()
+}
+
+// read sends a single bulk request for all the configured MBeans, the responses are in the same order as the MBeans.
+func (s *jolokiaScraper) read(ctx context.Context) ([]jolokiaResponse, error) {
+	requests := make([]jolokiaRequest, 0, len(s.config.Jolokia.MBeans))
+	for _, mbean := range s.config.Jolokia.MBeans {
+		var attributes []string
+		seen := map[string]struct{}{}
+		for _, metric := range mbean.Metrics {
+			if _, ok := seen[metric.Attribute]; !ok {
+				seen[metric.Attribute] = struct{}{}
+				attributes = append(attributes, metric.Attribute)
+			}
+		}
+		requests = append(requests, jolokiaRequest{
+			Type:      "read",
+			MBean:     mbean.ObjectName,
+			Attribute: attributes,
+			// do not fail the whole read of a pattern because one of the matching MBeans misses an attribute
+			Config: map[string]string{"ignoreErrors": "true"},
+		})
+	}
+
+	body, err := json.Marshal(requests)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.config.Jolokia.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.config.Username != "" {
+		req.SetBasicAuth(s.config.Username, s.config.Password)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("jolokia request failed with status %d", resp.StatusCode)
+	}
+
+	var responses []jolokiaResponse
+	if err = json.NewDecoder(resp.Body).Decode(&responses); err != nil {
+		return nil, fmt.Errorf("failed to decode jolokia response: %w", err)
+	}
+	if len(responses) != len(requests) {
+		return nil, fmt.Errorf("jolokia returned %d responses to %d requests", len(responses), len(requests))
+	}
+	return responses, nil
+}
+
+func newMetric(metrics pmetric.MetricSlice, cfg MBeanMetricConfig) pmetric.Metric {
+	metric := metrics.AppendEmpty()
+	metric.SetName(cfg.Name)
+	metric.SetDescription(cfg.Description)
+	metric.SetUnit(cfg.Unit)
+	if cfg.Type == metricTypeCounter {
+		metric.SetEmptySum()
+		metric.Sum().SetIsMonotonic(true)
+		metric.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	} else {
+		metric.SetEmptyGauge()
+	}
+	return metric
+}
+
+// mbeanValues returns the attributes of each MBean read for the object name, keyed by their object names.
+func mbeanValues(objectName string, value json.RawMessage) (map[string]map[string]interface{}, error) {
+	if isObjectNamePattern(objectName) {
+		var values map[string]map[string]interface{}
+		err := json.Unmarshal(value, &values)
+		return values, err
+	}
+	var attributes map[string]interface{}
+	if err := json.Unmarshal(value, &attributes); err != nil {
+		return nil, err
+	}
+	return map[string]map[string]interface{}{objectName: attributes}, nil
+}
+
+func isObjectNamePattern(objectName string) bool {
+	return strings.ContainsAny(objectName, "*?")
+}
+
+// objectNameProperties returns the key properties of an object name, such as `type` and `name` for
+// `java.lang:type=GarbageCollector,name=G1 Young Generation`.
+func objectNameProperties(objectName string) map[string]string {
+	properties := map[string]string{}
+	_, list, ok := strings.Cut(objectName, ":")
+	if !ok {
+		return properties
+	}
+	for _, property := range strings.Split(list, ",") {
+		if key, value, ok := strings.Cut(property, "="); ok {
+			properties[key] = strings.Trim(value, `"`)
+		}
+	}
+	return properties
+}
+
+// attributeValue walks the path inside a composite attribute value, and converts the value found to a float64.
+func attributeValue(value interface{}, path string) (float64, bool) {
+	if path != "" {
+		for _, key := range strings.Split(path, "/") {
+			composite, ok := value.(map[string]interface{})
+			if !ok {
+				return 0, false
+			}
+			value = composite[key]
+		}
+	}
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case bool:
+		if v {
+			return 1, true
+		}
+		return 0, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jmxreceiver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+)
+
+func newJolokiaTestConfig(endpoint string) *Config {
+	return &Config{
+		Username:           "myusername",
+		Password:           "mypassword",
+		ResourceAttributes: map[string]string{"service.name": "myservice"},
+		Jolokia: &JolokiaConfig{
+			HTTPClientSettings: confighttp.HTTPClientSettings{Endpoint: endpoint},
+			MBeans: []MBeanConfig{
+				{
+					ObjectName: "java.lang:type=Memory",
+					Metrics: []MBeanMetricConfig{
+						{Name: "jvm.memory.heap.used", Unit: "By", Attribute: "HeapMemoryUsage", Path: "used"},
+						{Name: "jvm.memory.heap.max", Unit: "By", Attribute: "HeapMemoryUsage", Path: "max"},
+					},
+				},
+				{
+					ObjectName:       "java.lang:type=GarbageCollector,name=*",
+					MetricAttributes: map[string]string{"name": "name"},
+					Metrics: []MBeanMetricConfig{
+						{Name: "jvm.gc.collections.count", Unit: "1", Attribute: "CollectionCount", Type: metricTypeCounter},
+						{Name: "jvm.gc.valid", Attribute: "Valid"},
+					},
+				},
+				{
+					ObjectName: "kafka.server:type=BrokerTopicMetrics,name=MessagesInPerSec",
+					Metrics: []MBeanMetricConfig{
+						{Name: "kafka.message.count", Attribute: "Count", Type: metricTypeCounter},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newJolokiaTestServer(t *testing.T) *httptest.Server {
+	response, err := os.ReadFile(filepath.Join("testdata", "jolokia", "response.json"))
+	require.NoError(t, err)
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "myusername" || password != "mypassword" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		var requests []jolokiaRequest
+		if err := json.NewDecoder(r.Body).Decode(&requests); err != nil || len(requests) != 3 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		assert.Equal(t, []string{"HeapMemoryUsage"}, requests[0].Attribute)
+		assert.Equal(t, []string{"CollectionCount", "Valid"}, requests[1].Attribute)
+
+		_, _ = w.Write(response)
+	}))
+}
+
+func TestJolokiaScraper(t *testing.T) {
+	server := newJolokiaTestServer(t)
+	defer server.Close()
+
+	scraper := newJolokiaScraper(componenttest.NewNopReceiverCreateSettings(), newJolokiaTestConfig(server.URL))
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := scraper.scrape(context.Background())
+	require.Error(t, err)
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+	assert.ErrorContains(t, err, "InstanceNotFoundException")
+
+	rm := md.ResourceMetrics().At(0)
+	serviceName, ok := rm.Resource().Attributes().Get("service.name")
+	require.True(t, ok)
+	assert.Equal(t, "myservice", serviceName.Str())
+
+	metrics := map[string]pmetric.Metric{}
+	ms := rm.ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		metrics[ms.At(i).Name()] = ms.At(i)
+	}
+	require.Len(t, metrics, 4)
+
+	heapUsed := metrics["jvm.memory.heap.used"]
+	assert.Equal(t, "By", heapUsed.Unit())
+	require.Equal(t, pmetric.MetricTypeGauge, heapUsed.Type())
+	assert.Equal(t, 104857600.0, heapUsed.Gauge().DataPoints().At(0).DoubleValue())
+	assert.Equal(t, 4294967296.0, metrics["jvm.memory.heap.max"].Gauge().DataPoints().At(0).DoubleValue())
+
+	collections := metrics["jvm.gc.collections.count"]
+	require.Equal(t, pmetric.MetricTypeSum, collections.Type())
+	assert.True(t, collections.Sum().IsMonotonic())
+	assert.Equal(t, pmetric.AggregationTemporalityCumulative, collections.Sum().AggregationTemporality())
+	dps := collections.Sum().DataPoints()
+	require.Equal(t, 2, dps.Len())
+	counts := map[string]float64{}
+	for i := 0; i < dps.Len(); i++ {
+		assert.NotZero(t, dps.At(i).StartTimestamp())
+		name, ok := dps.At(i).Attributes().Get("name")
+		require.True(t, ok)
+		counts[name.Str()] = dps.At(i).DoubleValue()
+	}
+	assert.Equal(t, map[string]float64{"G1 Young Generation": 12, "G1 Old Generation": 1}, counts)
+
+	assert.Equal(t, 1.0, metrics["jvm.gc.valid"].Gauge().DataPoints().At(0).DoubleValue())
+}
+
+func TestJolokiaScraperRequestError(t *testing.T) {
+	server := newJolokiaTestServer(t)
+	defer server.Close()
+
+	config := newJolokiaTestConfig(server.URL)
+	config.Password = "wrongpassword"
+	scraper := newJolokiaScraper(componenttest.NewNopReceiverCreateSettings(), config)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	_, err := scraper.scrape(context.Background())
+	assert.EqualError(t, err, "jolokia request failed with status 401")
+}
+
+func TestObjectNameProperties(t *testing.T) {
+	assert.Equal(t, map[string]string{}, objectNameProperties("invalid"))
+	assert.Equal(t,
+		map[string]string{"type": "GarbageCollector", "name": "G1 Young Generation"},
+		objectNameProperties("java.lang:name=G1 Young Generation,type=GarbageCollector"))
+	assert.Equal(t,
+		map[string]string{"type": "Cache", "path": "/"},
+		objectNameProperties(`Catalina:type=Cache,path="/"`))
+}
+
+func TestAttributeValue(t *testing.T) {
+	composite := map[string]interface{}{"used": 10.0, "nested": map[string]interface{}{"value": false}}
+
+	value, ok := attributeValue(composite, "used")
+	assert.True(t, ok)
+	assert.Equal(t, 10.0, value)
+
+	value, ok = attributeValue(composite, "nested/value")
+	assert.True(t, ok)
+	assert.Equal(t, 0.0, value)
+
+	_, ok = attributeValue(composite, "")
+	assert.False(t, ok)
+	_, ok = attributeValue(composite, "used/value")
+	assert.False(t, ok)
+	_, ok = attributeValue("text", "")
+	assert.False(t, ok)
+}
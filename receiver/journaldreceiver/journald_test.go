@@ -65,18 +65,26 @@ func TestInputConfigFailure(t *testing.T) {
 	receiver, err := factory.CreateLogsReceiver(context.Background(), componenttest.NewNopReceiverCreateSettings(), badCfg, sink)
 	require.Error(t, err, "receiver creation should fail if input config isn't valid")
 	require.Nil(t, receiver, "receiver creation should fail if input config isn't valid")
+
+	badCfg.InputConfig.StartAt = "end"
+	badCfg.InputConfig.Priority = "loud..info"
+	receiver, err = factory.CreateLogsReceiver(context.Background(), componenttest.NewNopReceiverCreateSettings(), badCfg, sink)
+	require.Error(t, err, "receiver creation should fail if priority isn't valid")
+	require.Nil(t, receiver, "receiver creation should fail if priority isn't valid")
 }
 
 func testdataConfigYaml() *JournaldConfig {
+	storageID := component.NewID("file_storage")
 	return &JournaldConfig{
 		BaseConfig: adapter.BaseConfig{
 			ReceiverSettings: config.NewReceiverSettings(component.NewID(typeStr)),
 			Operators:        []operator.Config{},
+			StorageID:        &storageID,
 		},
 		InputConfig: func() journald.Config {
 			c := journald.NewConfig()
-			c.Units = []string{"ssh"}
-			c.Priority = "info"
+			c.Units = []string{"ssh", "kube*"}
+			c.Priority = "emerg..info"
 			dir := "/run/log/journal"
 			c.Directory = &dir
 			return *c
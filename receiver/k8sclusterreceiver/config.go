@@ -15,10 +15,12 @@
 package k8sclusterreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver"
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
 	"go.opentelemetry.io/collector/config"
+	"k8s.io/apimachinery/pkg/fields"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 )
@@ -42,6 +44,24 @@ type Config struct {
 
 	// Whether OpenShift supprot should be enabled or not.
 	Distribution string `mapstructure:"distribution"`
+
+	// Events config. Used by the logs receiver, which emits Kubernetes events as logs.
+	Events EventsConfig `mapstructure:"events"`
+}
+
+// EventsConfig selects the Kubernetes events emitted as logs.
+type EventsConfig struct {
+	// Namespaces to watch for events. All namespaces are watched when not set.
+	Namespaces []string `mapstructure:"namespaces"`
+
+	// FieldSelector filters the watched events, e.g. `type=Warning` or
+	// `involvedObject.kind=Pod,reason!=Pulled`.
+	FieldSelector string `mapstructure:"field_selector"`
+
+	// DedupInterval is the interval during which repeated occurrences of an event
+	// (same involved object, reason and message) are reported only once. Set to 0
+	// to report every occurrence.
+	DedupInterval time.Duration `mapstructure:"dedup_interval"`
 }
 
 func (cfg *Config) Validate() error {
@@ -51,5 +71,11 @@ func (cfg *Config) Validate() error {
 	default:
 		return fmt.Errorf("\"%s\" is not a supported distribution. Must be one of: \"openshift\", \"kubernetes\"", cfg.Distribution)
 	}
+	if _, err := fields.ParseSelector(cfg.Events.FieldSelector); err != nil {
+		return fmt.Errorf("invalid events field_selector: %w", err)
+	}
+	if cfg.Events.DedupInterval < 0 {
+		return errors.New("events dedup_interval must not be negative")
+	}
 	return cfg.APIConfig.Validate()
 }
@@ -55,6 +55,11 @@ func TestLoadConfig(t *testing.T) {
 				APIConfig: k8sconfig.APIConfig{
 					AuthType: k8sconfig.AuthTypeServiceAccount,
 				},
+				Events: EventsConfig{
+					Namespaces:    []string{"default", "kube-system"},
+					FieldSelector: "type=Warning",
+					DedupInterval: time.Minute,
+				},
 			},
 		},
 		{
@@ -67,6 +72,9 @@ func TestLoadConfig(t *testing.T) {
 				APIConfig: k8sconfig.APIConfig{
 					AuthType: k8sconfig.AuthTypeServiceAccount,
 				},
+				Events: EventsConfig{
+					DedupInterval: defaultEventsDedupInterval,
+				},
 			},
 		},
 	}
@@ -106,4 +114,18 @@ func TestInvalidConfig(t *testing.T) {
 	err = cfg.Validate()
 	assert.NotNil(t, err)
 	assert.Equal(t, "\"wrong\" is not a supported distribution. Must be one of: \"openshift\", \"kubernetes\"", err.Error())
+
+	// Invalid events field selector
+	cfg = createDefaultConfig().(*Config)
+	cfg.Events.FieldSelector = "type"
+	err = cfg.Validate()
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "invalid events field_selector")
+
+	// Negative events dedup interval
+	cfg = createDefaultConfig().(*Config)
+	cfg.Events.DedupInterval = -time.Second
+	err = cfg.Validate()
+	assert.NotNil(t, err)
+	assert.Equal(t, "events dedup_interval must not be negative", err.Error())
 }
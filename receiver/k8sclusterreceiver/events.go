@@ -0,0 +1,322 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sclusterreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/obsreport"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+var _ component.LogsReceiver = (*eventsReceiver)(nil)
+
+// Only two types of events are created as of now.
+var eventSeverities = map[string]plog.SeverityNumber{
+	corev1.EventTypeNormal:  plog.SeverityNumberInfo,
+	corev1.EventTypeWarning: plog.SeverityNumberWarn,
+}
+
+// eventsReceiver watches Kubernetes events and emits them as logs.
+type eventsReceiver struct {
+	config    *Config
+	settings  component.ReceiverCreateSettings
+	consumer  consumer.Logs
+	obsrecv   *obsreport.Receiver
+	startTime time.Time
+
+	makeClient func(apiConf k8sconfig.APIConfig) (kubernetes.Interface, error)
+	cancel     context.CancelFunc
+	wg         sync.WaitGroup
+
+	// seen holds the time at which each repeated event was last reported, for deduplication.
+	seen     map[eventKey]time.Time
+	seenLock sync.Mutex
+}
+
+// eventKey identifies repeated occurrences of the same event.
+type eventKey struct {
+	uid     string
+	reason  string
+	message string
+}
+
+// newEventsReceiver creates the Kubernetes cluster receiver emitting events as logs.
+func newEventsReceiver(_ context.Context, set component.ReceiverCreateSettings, cfg component.ReceiverConfig, consumer consumer.Logs) (component.LogsReceiver, error) {
+	rCfg := cfg.(*Config)
+
+	obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{
+		ReceiverID:             cfg.ID(),
+		Transport:              transport,
+		ReceiverCreateSettings: set,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &eventsReceiver{
+		config:     rCfg,
+		settings:   set,
+		consumer:   consumer,
+		obsrecv:    obsrecv,
+		startTime:  time.Now(),
+		makeClient: k8sconfig.MakeClient,
+		seen:       make(map[eventKey]time.Time),
+	}, nil
+}
+
+func (er *eventsReceiver) Start(_ context.Context, _ component.Host) error {
+	client, err := er.makeClient(er.config.APIConfig)
+	if err != nil {
+		return fmt.Errorf("Failed to create Kubernnetes client: %w", err)
+	}
+
+	// The Start context is only valid during startup, the informers run until Shutdown.
+	var ctx context.Context
+	ctx, er.cancel = context.WithCancel(context.Background())
+
+	handlers := cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			er.handleEvent(ctx, obj.(*corev1.Event))
+		},
+		// Repeated events are updated with an incremented count.
+		UpdateFunc: func(_, obj interface{}) {
+			er.handleEvent(ctx, obj.(*corev1.Event))
+		},
+	}
+
+	namespaces := er.config.Events.Namespaces
+	if len(namespaces) == 0 {
+		namespaces = []string{corev1.NamespaceAll}
+	}
+	er.settings.Logger.Info("Starting to watch Kubernetes events.", zap.Strings("namespaces", namespaces))
+	for _, ns := range namespaces {
+		_, controller := cache.NewInformer(er.listWatch(client, ns), &corev1.Event{}, 0, handlers)
+		er.wg.Add(1)
+		go func() {
+			defer er.wg.Done()
+			controller.Run(ctx.Done())
+		}()
+	}
+
+	if er.config.Events.DedupInterval > 0 {
+		er.wg.Add(1)
+		go func() {
+			defer er.wg.Done()
+			er.expireSeenEvents(ctx)
+		}()
+	}
+
+	return nil
+}
+
+func (er *eventsReceiver) Shutdown(context.Context) error {
+	if er.cancel != nil {
+		er.cancel()
+	}
+	er.wg.Wait()
+	return nil
+}
+
+func (er *eventsReceiver) listWatch(client kubernetes.Interface, ns string) *cache.ListWatch {
+	fieldSelector := er.config.Events.FieldSelector
+	return &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			options.FieldSelector = fieldSelector
+			return client.CoreV1().Events(ns).List(context.Background(), options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.FieldSelector = fieldSelector
+			return client.CoreV1().Events(ns).Watch(context.Background(), options)
+		},
+	}
+}
+
+func (er *eventsReceiver) handleEvent(ctx context.Context, ev *corev1.Event) {
+	// Events which happened before the receiver started are dropped, so that
+	// the existing events are not flooded upon startup.
+	if getEventTimestamp(ev).Before(er.startTime) {
+		return
+	}
+	if er.isDuplicate(ev) {
+		_ = stats.RecordWithTags(ctx, []tag.Mutator{tag.Upsert(tagReceiverName, er.config.ID().String())}, statEventsSuppressed.M(1))
+		return
+	}
+
+	ld := eventToLogData(er.settings.Logger, ev)
+
+	c := er.obsrecv.StartLogsOp(ctx)
+	err := er.consumer.ConsumeLogs(c, ld)
+	er.obsrecv.EndLogsOp(c, typeStr, 1, err)
+}
+
+// isDuplicate returns true if the same event was already reported within the dedup interval.
+func (er *eventsReceiver) isDuplicate(ev *corev1.Event) bool {
+	if er.config.Events.DedupInterval <= 0 {
+		return false
+	}
+
+	key := eventKey{
+		uid:     string(ev.InvolvedObject.UID),
+		reason:  ev.Reason,
+		message: ev.Message,
+	}
+	now := time.Now()
+
+	er.seenLock.Lock()
+	defer er.seenLock.Unlock()
+	if last, ok := er.seen[key]; ok && now.Sub(last) < er.config.Events.DedupInterval {
+		return true
+	}
+	er.seen[key] = now
+	return false
+}
+
+// expireSeenEvents periodically removes the events which are no longer deduplicated.
+func (er *eventsReceiver) expireSeenEvents(ctx context.Context) {
+	ticker := time.NewTicker(er.config.Events.DedupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			er.seenLock.Lock()
+			for key, last := range er.seen {
+				if now.Sub(last) >= er.config.Events.DedupInterval {
+					delete(er.seen, key)
+				}
+			}
+			er.seenLock.Unlock()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// eventToLogData converts a Kubernetes event to a log record, with the involved
+// object described by the resource attributes.
+func eventToLogData(logger *zap.Logger, ev *corev1.Event) plog.Logs {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+
+	resourceAttrs := rl.Resource().Attributes()
+	if ev.InvolvedObject.Namespace != "" {
+		resourceAttrs.PutStr(conventions.AttributeK8SNamespaceName, ev.InvolvedObject.Namespace)
+	}
+	if ev.Source.Host != "" {
+		resourceAttrs.PutStr(conventions.AttributeK8SNodeName, ev.Source.Host)
+	}
+	putInvolvedObjectAttributes(resourceAttrs, ev.InvolvedObject)
+
+	lr := rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.SetTimestamp(pcommon.NewTimestampFromTime(getEventTimestamp(ev)))
+	lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	lr.Body().SetStr(ev.Message)
+
+	if severityNumber, ok := eventSeverities[ev.Type]; ok {
+		lr.SetSeverityNumber(severityNumber)
+		lr.SetSeverityText(ev.Type)
+	} else {
+		logger.Debug("Unknown Kubernetes event type", zap.String("type", ev.Type))
+	}
+
+	attrs := lr.Attributes()
+	attrs.PutStr("k8s.event.name", ev.Name)
+	attrs.PutStr("k8s.event.uid", string(ev.UID))
+	attrs.PutStr("k8s.event.reason", ev.Reason)
+	if ev.Action != "" {
+		attrs.PutStr("k8s.event.action", ev.Action)
+	}
+	if ev.Source.Component != "" {
+		attrs.PutStr("k8s.event.source.component", ev.Source.Component)
+	}
+	// The count is not set for events which occurred only once.
+	if ev.Count != 0 {
+		attrs.PutInt("k8s.event.count", int64(ev.Count))
+	}
+
+	return ld
+}
+
+// putInvolvedObjectAttributes sets the semantic convention attributes of the object
+// involved in the event, when its kind has any.
+func putInvolvedObjectAttributes(attrs pcommon.Map, obj corev1.ObjectReference) {
+	attrs.PutStr("k8s.object.kind", obj.Kind)
+	attrs.PutStr("k8s.object.name", obj.Name)
+	attrs.PutStr("k8s.object.uid", string(obj.UID))
+	if obj.FieldPath != "" {
+		attrs.PutStr("k8s.object.fieldpath", obj.FieldPath)
+	}
+
+	var nameKey, uidKey string
+	switch obj.Kind {
+	case "Pod":
+		nameKey, uidKey = conventions.AttributeK8SPodName, conventions.AttributeK8SPodUID
+	case "Node":
+		nameKey, uidKey = conventions.AttributeK8SNodeName, conventions.AttributeK8SNodeUID
+	case "Deployment":
+		nameKey, uidKey = conventions.AttributeK8SDeploymentName, conventions.AttributeK8SDeploymentUID
+	case "ReplicaSet":
+		nameKey, uidKey = conventions.AttributeK8SReplicaSetName, conventions.AttributeK8SReplicaSetUID
+	case "StatefulSet":
+		nameKey, uidKey = conventions.AttributeK8SStatefulSetName, conventions.AttributeK8SStatefulSetUID
+	case "DaemonSet":
+		nameKey, uidKey = conventions.AttributeK8SDaemonSetName, conventions.AttributeK8SDaemonSetUID
+	case "Job":
+		nameKey, uidKey = conventions.AttributeK8SJobName, conventions.AttributeK8SJobUID
+	case "CronJob":
+		nameKey, uidKey = conventions.AttributeK8SCronJobName, conventions.AttributeK8SCronJobUID
+	default:
+		return
+	}
+	attrs.PutStr(nameKey, obj.Name)
+	attrs.PutStr(uidKey, string(obj.UID))
+
+	// The container of a pod is referenced by the field path, e.g. spec.containers{app}.
+	if obj.Kind == "Pod" && strings.HasPrefix(obj.FieldPath, "spec.containers{") {
+		attrs.PutStr(conventions.AttributeK8SContainerName, strings.TrimSuffix(strings.TrimPrefix(obj.FieldPath, "spec.containers{"), "}"))
+	}
+}
+
+// getEventTimestamp returns the time at which the event last occurred.
+// Priority: EventTime > LastTimestamp > FirstTimestamp.
+func getEventTimestamp(ev *corev1.Event) time.Time {
+	switch {
+	case !ev.EventTime.IsZero():
+		return ev.EventTime.Time
+	case !ev.LastTimestamp.IsZero():
+		return ev.LastTimestamp.Time
+	default:
+		return ev.FirstTimestamp.Time
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sclusterreceiver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+func newTestEventsReceiver(t *testing.T, cfg *Config, sink *consumertest.LogsSink, client kubernetes.Interface) *eventsReceiver {
+	r, err := newEventsReceiver(context.Background(), componenttest.NewNopReceiverCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	er := r.(*eventsReceiver)
+	er.makeClient = func(_ k8sconfig.APIConfig) (kubernetes.Interface, error) {
+		return client, nil
+	}
+	return er
+}
+
+func newTestEvent(name string) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			UID:       types.UID("event-" + name),
+		},
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "Pod",
+			Name:      "checkout-5d8f9",
+			Namespace: "default",
+			UID:       types.UID("pod-uid"),
+			FieldPath: "spec.containers{app}",
+		},
+		Reason:        "OOMKilling",
+		Message:       "Memory cgroup out of memory",
+		Type:          corev1.EventTypeWarning,
+		Count:         1,
+		LastTimestamp: metav1.Now(),
+		Source: corev1.EventSource{
+			Component: "kubelet",
+			Host:      "node-1",
+		},
+	}
+}
+
+func TestEventsReceiver(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	sink := new(consumertest.LogsSink)
+	cfg := createDefaultConfig().(*Config)
+	er := newTestEventsReceiver(t, cfg, sink, client)
+
+	require.NoError(t, er.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { assert.NoError(t, er.Shutdown(context.Background())) }()
+
+	// The informer has to be watching before the event is created.
+	time.Sleep(100 * time.Millisecond)
+	_, err := client.CoreV1().Events("default").Create(context.Background(), newTestEvent("oom"), metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	rl := sink.AllLogs()[0].ResourceLogs().At(0)
+	assert.Equal(t, map[string]interface{}{
+		"k8s.namespace.name":   "default",
+		"k8s.node.name":        "node-1",
+		"k8s.object.kind":      "Pod",
+		"k8s.object.name":      "checkout-5d8f9",
+		"k8s.object.uid":       "pod-uid",
+		"k8s.object.fieldpath": "spec.containers{app}",
+		"k8s.pod.name":         "checkout-5d8f9",
+		"k8s.pod.uid":          "pod-uid",
+		"k8s.container.name":   "app",
+	}, rl.Resource().Attributes().AsRaw())
+
+	lr := rl.ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "Memory cgroup out of memory", lr.Body().Str())
+	assert.Equal(t, plog.SeverityNumberWarn, lr.SeverityNumber())
+	assert.Equal(t, "Warning", lr.SeverityText())
+	assert.Equal(t, map[string]interface{}{
+		"k8s.event.name":             "oom",
+		"k8s.event.uid":              "event-oom",
+		"k8s.event.reason":           "OOMKilling",
+		"k8s.event.source.component": "kubelet",
+		"k8s.event.count":            int64(1),
+	}, lr.Attributes().AsRaw())
+}
+
+func TestEventsReceiverOutlivesStartContext(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	sink := new(consumertest.LogsSink)
+	er := newTestEventsReceiver(t, createDefaultConfig().(*Config), sink, client)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	require.NoError(t, er.Start(ctx, componenttest.NewNopHost()))
+	cancel()
+	defer func() { assert.NoError(t, er.Shutdown(context.Background())) }()
+
+	time.Sleep(100 * time.Millisecond)
+	_, err := client.CoreV1().Events("default").Create(context.Background(), newTestEvent("oom"), metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestEventsReceiverDedup(t *testing.T) {
+	view.Unregister(MetricViews()...)
+	views := MetricViews()
+	require.NoError(t, view.Register(views...))
+	defer view.Unregister(views...)
+
+	sink := new(consumertest.LogsSink)
+	cfg := createDefaultConfig().(*Config)
+	er := newTestEventsReceiver(t, cfg, sink, fake.NewSimpleClientset())
+
+	ev := newTestEvent("oom")
+	er.handleEvent(context.Background(), ev)
+
+	// the event is repeated with an incremented count
+	ev.Count = 2
+	er.handleEvent(context.Background(), ev)
+	assert.Equal(t, 1, sink.LogRecordCount())
+	assertEventsSuppressed(t, 1)
+
+	// the same reason with another message is a different event
+	other := newTestEvent("oom")
+	other.Message = "Memory cgroup out of memory: Killed process 42"
+	er.handleEvent(context.Background(), other)
+	assert.Equal(t, 2, sink.LogRecordCount())
+
+	// the event is reported again after the dedup interval
+	er.seen[eventKey{uid: "pod-uid", reason: "OOMKilling", message: ev.Message}] = time.Now().Add(-cfg.Events.DedupInterval)
+	er.handleEvent(context.Background(), ev)
+	assert.Equal(t, 3, sink.LogRecordCount())
+
+	// without deduplication every occurrence is reported
+	cfg.Events.DedupInterval = 0
+	er.handleEvent(context.Background(), ev)
+	assert.Equal(t, 4, sink.LogRecordCount())
+	assertEventsSuppressed(t, 1)
+}
+
+func assertEventsSuppressed(t *testing.T, expected int64) {
+	rows, err := view.RetrieveData(statEventsSuppressed.Name())
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, []tag.Tag{{Key: tagReceiverName, Value: "k8s_cluster"}}, rows[0].Tags)
+	assert.Equal(t, float64(expected), rows[0].Data.(*view.SumData).Value)
+}
+
+func TestEventsReceiverDropsEventsBeforeStart(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	er := newTestEventsReceiver(t, createDefaultConfig().(*Config), sink, fake.NewSimpleClientset())
+
+	ev := newTestEvent("old")
+	ev.LastTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+	er.handleEvent(context.Background(), ev)
+	assert.Equal(t, 0, sink.LogRecordCount())
+}
+
+func TestEventsReceiverFieldSelector(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	cfg := createDefaultConfig().(*Config)
+	cfg.Events.Namespaces = []string{"default"}
+	cfg.Events.FieldSelector = "type=Warning"
+	er := newTestEventsReceiver(t, cfg, new(consumertest.LogsSink), client)
+
+	require.NoError(t, er.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { assert.NoError(t, er.Shutdown(context.Background())) }()
+
+	require.Eventually(t, func() bool {
+		for _, action := range client.Actions() {
+			if list, ok := action.(k8stesting.ListAction); ok && list.GetResource().Resource == "events" {
+				return list.GetNamespace() == "default" && list.GetListRestrictions().Fields.String() == "type=Warning"
+			}
+		}
+		return false
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestGetEventTimestamp(t *testing.T) {
+	ev := newTestEvent("ts")
+	ev.FirstTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+	assert.Equal(t, ev.LastTimestamp.Time, getEventTimestamp(ev))
+
+	ev.EventTime = metav1.NewMicroTime(time.Now().Add(time.Hour))
+	assert.Equal(t, ev.EventTime.Time, getEventTimestamp(ev))
+
+	ev.EventTime = metav1.MicroTime{}
+	ev.LastTimestamp = metav1.Time{}
+	assert.Equal(t, ev.FirstTimestamp.Time, getEventTimestamp(ev))
+}
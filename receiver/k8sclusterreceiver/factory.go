@@ -17,6 +17,7 @@ package k8sclusterreceiver // import "github.com/open-telemetry/opentelemetry-co
 import (
 	"time"
 
+	"go.opencensus.io/stats/view"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
 
@@ -28,14 +29,17 @@ const (
 	typeStr = "k8s_cluster"
 	// The stability level of the receiver.
 	stability = component.StabilityLevelBeta
+	// The stability level of the events logs receiver.
+	eventsStability = component.StabilityLevelInDevelopment
 
 	// supported distributions
 	distributionKubernetes = "kubernetes"
 	distributionOpenShift  = "openshift"
 
 	// Default config values.
-	defaultCollectionInterval = 10 * time.Second
-	defaultDistribution       = distributionKubernetes
+	defaultCollectionInterval  = 10 * time.Second
+	defaultDistribution        = distributionKubernetes
+	defaultEventsDedupInterval = 5 * time.Minute
 )
 
 var defaultNodeConditionsToReport = []string{"Ready"}
@@ -49,13 +53,18 @@ func createDefaultConfig() component.ReceiverConfig {
 		APIConfig: k8sconfig.APIConfig{
 			AuthType: k8sconfig.AuthTypeServiceAccount,
 		},
+		Events: EventsConfig{
+			DedupInterval: defaultEventsDedupInterval,
+		},
 	}
 }
 
 // NewFactory creates a factory for k8s_cluster receiver.
 func NewFactory() component.ReceiverFactory {
+	_ = view.Register(MetricViews()...)
 	return component.NewReceiverFactory(
 		typeStr,
 		createDefaultConfig,
-		component.WithMetricsReceiver(newReceiver, stability))
+		component.WithMetricsReceiver(newReceiver, stability),
+		component.WithLogsReceiver(newEventsReceiver, eventsStability))
 }
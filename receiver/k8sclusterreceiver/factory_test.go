@@ -48,6 +48,9 @@ func TestFactory(t *testing.T) {
 		APIConfig: k8sconfig.APIConfig{
 			AuthType: k8sconfig.AuthTypeServiceAccount,
 		},
+		Events: EventsConfig{
+			DedupInterval: 5 * time.Minute,
+		},
 	}, rCfg)
 
 	r, err := f.CreateTracesReceiver(
@@ -57,6 +60,13 @@ func TestFactory(t *testing.T) {
 	require.Error(t, err)
 	require.Nil(t, r)
 
+	lr, err := f.CreateLogsReceiver(
+		context.Background(), componenttest.NewNopReceiverCreateSettings(),
+		rCfg, consumertest.NewNop(),
+	)
+	require.NoError(t, err)
+	require.NotNil(t, lr)
+
 	r = newTestReceiver(t, rCfg)
 
 	// Test metadata exporters setup.
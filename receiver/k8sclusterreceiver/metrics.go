@@ -0,0 +1,42 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sclusterreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver"
+
+import (
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+var (
+	tagReceiverName, _ = tag.NewKey("receiver")
+
+	statEventsSuppressed = stats.Int64("k8s_cluster_receiver_events_suppressed", "Number of repeated Kubernetes events not emitted because of deduplication", stats.UnitDimensionless)
+)
+
+// MetricViews returns the metric views of the k8s_cluster receiver.
+func MetricViews() []*view.View {
+	countEventsSuppressed := &view.View{
+		Name:        statEventsSuppressed.Name(),
+		Measure:     statEventsSuppressed,
+		Description: statEventsSuppressed.Description(),
+		TagKeys:     []tag.Key{tagReceiverName},
+		Aggregation: view.Sum(),
+	}
+
+	return []*view.View{
+		countEventsSuppressed,
+	}
+}
@@ -39,6 +39,8 @@ type consumerScraper struct {
 	saramaConfig *sarama.Config
 	config       Config
 	mb           *metadata.MetricsBuilder
+	// offsetTimelines holds the newest offsets seen for each partition, to estimate the lag in time.
+	offsetTimelines map[string]map[int32]*offsetTimeline
 }
 
 func (s *consumerScraper) Name() string {
@@ -102,8 +104,11 @@ func (s *consumerScraper) scrape(context.Context) (pmetric.Metrics, error) {
 	topicPartitions := map[string][]int32{}
 	// currentOffset for each partition in matchedTopics
 	topicPartitionOffset := map[string]map[int32]int64{}
+	// offset timelines of the partitions in matchedTopics, the ones of the other partitions are dropped
+	offsetTimelines := map[string]map[int32]*offsetTimeline{}
 	for topic := range matchedTopics {
 		topicPartitionOffset[topic] = map[int32]int64{}
+		offsetTimelines[topic] = map[int32]*offsetTimeline{}
 		partitions, err := s.client.Partitions(topic)
 		if err != nil {
 			scrapeError = multierr.Append(scrapeError, err)
@@ -118,8 +123,16 @@ func (s *consumerScraper) scrape(context.Context) (pmetric.Metrics, error) {
 			}
 			topicPartitions[topic] = append(topicPartitions[topic], p)
 			topicPartitionOffset[topic][p] = offset
+
+			timeline, ok := s.offsetTimelines[topic][p]
+			if !ok {
+				timeline = &offsetTimeline{}
+			}
+			timeline.add(offset, time.Now())
+			offsetTimelines[topic][p] = timeline
 		}
 	}
+	s.offsetTimelines = offsetTimelines
 	consumerGroups, listErr := s.clusterAdmin.DescribeConsumerGroups(matchedGrpIds)
 	if listErr != nil {
 		return pmetric.Metrics{}, listErr
@@ -164,6 +177,12 @@ func (s *consumerScraper) scrape(context.Context) (pmetric.Metrics, error) {
 						}
 					}
 					s.mb.RecordKafkaConsumerGroupLagDataPoint(now, consumerLag, group.GroupId, topic, int64(partition))
+
+					if timeline, ok := offsetTimelines[topic][partition]; ok && block.Offset != -1 {
+						if lagTime, ok := timeline.lag(consumerOffset); ok {
+							s.mb.RecordKafkaConsumerGroupLagTimeDataPoint(now, lagTime.Seconds(), group.GroupId, topic, int64(partition))
+						}
+					}
 				}
 				s.mb.RecordKafkaConsumerGroupOffsetSumDataPoint(now, offsetSum, group.GroupId, topic)
 				s.mb.RecordKafkaConsumerGroupLagSumDataPoint(now, lagSum, group.GroupId, topic)
@@ -24,6 +24,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkametricsreceiver/internal/metadata"
 )
 
 func TestConsumerShutdown(t *testing.T) {
@@ -237,3 +240,43 @@ func TestConsumerScraper_scrape_handlesPartitionPartialError(t *testing.T) {
 	_, err := cs.scrape(context.Background())
 	assert.Error(t, err)
 }
+
+func TestConsumerScraper_scrape_lagTime(t *testing.T) {
+	filter := regexp.MustCompile(defaultGroupMatch)
+	client := newMockClient()
+	clusterAdmin := newMockClusterAdmin()
+	settings := metadata.DefaultMetricsSettings()
+	settings.KafkaConsumerGroupLagTime.Enabled = true
+	cs := consumerScraper{
+		client:       client,
+		settings:     componenttest.NewNopReceiverCreateSettings(),
+		clusterAdmin: clusterAdmin,
+		topicFilter:  filter,
+		groupFilter:  filter,
+		config:       Config{Metrics: settings},
+	}
+	require.NoError(t, cs.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := cs.scrape(context.Background())
+	require.NoError(t, err)
+	lagTime, ok := findMetric(md, "kafka.consumer_group.lag_time")
+	require.True(t, ok, "consumer group is caught up")
+	assert.Equal(t, 0.0, lagTime.Gauge().DataPoints().At(0).DoubleValue())
+
+	client.offset = 5
+	md, err = cs.scrape(context.Background())
+	require.NoError(t, err)
+	lagTime, ok = findMetric(md, "kafka.consumer_group.lag_time")
+	require.True(t, ok)
+	assert.Greater(t, lagTime.Gauge().DataPoints().At(0).DoubleValue(), 0.0)
+}
+
+func findMetric(md pmetric.Metrics, name string) (pmetric.Metric, bool) {
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		if metrics.At(i).Name() == name {
+			return metrics.At(i), true
+		}
+	}
+	return pmetric.Metric{}, false
+}
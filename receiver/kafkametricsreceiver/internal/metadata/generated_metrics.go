@@ -40,6 +40,7 @@ type MetricsSettings struct {
 	KafkaBrokers                 MetricSettings `mapstructure:"kafka.brokers"`
 	KafkaConsumerGroupLag        MetricSettings `mapstructure:"kafka.consumer_group.lag"`
 	KafkaConsumerGroupLagSum     MetricSettings `mapstructure:"kafka.consumer_group.lag_sum"`
+	KafkaConsumerGroupLagTime    MetricSettings `mapstructure:"kafka.consumer_group.lag_time"`
 	KafkaConsumerGroupMembers    MetricSettings `mapstructure:"kafka.consumer_group.members"`
 	KafkaConsumerGroupOffset     MetricSettings `mapstructure:"kafka.consumer_group.offset"`
 	KafkaConsumerGroupOffsetSum  MetricSettings `mapstructure:"kafka.consumer_group.offset_sum"`
@@ -61,6 +62,9 @@ func DefaultMetricsSettings() MetricsSettings {
 		KafkaConsumerGroupLagSum: MetricSettings{
 			Enabled: true,
 		},
+		KafkaConsumerGroupLagTime: MetricSettings{
+			Enabled: false,
+		},
 		KafkaConsumerGroupMembers: MetricSettings{
 			Enabled: true,
 		},
@@ -242,6 +246,59 @@ func newMetricKafkaConsumerGroupLagSum(settings MetricSettings) metricKafkaConsu
 	return m
 }
 
+type metricKafkaConsumerGroupLagTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills kafka.consumer_group.lag_time metric with initial data.
+func (m *metricKafkaConsumerGroupLagTime) init() {
+	m.data.SetName("kafka.consumer_group.lag_time")
+	m.data.SetDescription("Estimated time the consumer group lags behind the latest message at partition of topic, interpolated from the offsets seen at each scrape")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricKafkaConsumerGroupLagTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, groupAttributeValue string, topicAttributeValue string, partitionAttributeValue int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("group", groupAttributeValue)
+	dp.Attributes().PutStr("topic", topicAttributeValue)
+	dp.Attributes().PutInt("partition", partitionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricKafkaConsumerGroupLagTime) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricKafkaConsumerGroupLagTime) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricKafkaConsumerGroupLagTime(settings MetricSettings) metricKafkaConsumerGroupLagTime {
+	m := metricKafkaConsumerGroupLagTime{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricKafkaConsumerGroupMembers struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -668,6 +725,7 @@ type MetricsBuilder struct {
 	metricKafkaBrokers                 metricKafkaBrokers
 	metricKafkaConsumerGroupLag        metricKafkaConsumerGroupLag
 	metricKafkaConsumerGroupLagSum     metricKafkaConsumerGroupLagSum
+	metricKafkaConsumerGroupLagTime    metricKafkaConsumerGroupLagTime
 	metricKafkaConsumerGroupMembers    metricKafkaConsumerGroupMembers
 	metricKafkaConsumerGroupOffset     metricKafkaConsumerGroupOffset
 	metricKafkaConsumerGroupOffsetSum  metricKafkaConsumerGroupOffsetSum
@@ -696,6 +754,7 @@ func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo,
 		metricKafkaBrokers:                 newMetricKafkaBrokers(settings.KafkaBrokers),
 		metricKafkaConsumerGroupLag:        newMetricKafkaConsumerGroupLag(settings.KafkaConsumerGroupLag),
 		metricKafkaConsumerGroupLagSum:     newMetricKafkaConsumerGroupLagSum(settings.KafkaConsumerGroupLagSum),
+		metricKafkaConsumerGroupLagTime:    newMetricKafkaConsumerGroupLagTime(settings.KafkaConsumerGroupLagTime),
 		metricKafkaConsumerGroupMembers:    newMetricKafkaConsumerGroupMembers(settings.KafkaConsumerGroupMembers),
 		metricKafkaConsumerGroupOffset:     newMetricKafkaConsumerGroupOffset(settings.KafkaConsumerGroupOffset),
 		metricKafkaConsumerGroupOffsetSum:  newMetricKafkaConsumerGroupOffsetSum(settings.KafkaConsumerGroupOffsetSum),
@@ -759,6 +818,7 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricKafkaBrokers.emit(ils.Metrics())
 	mb.metricKafkaConsumerGroupLag.emit(ils.Metrics())
 	mb.metricKafkaConsumerGroupLagSum.emit(ils.Metrics())
+	mb.metricKafkaConsumerGroupLagTime.emit(ils.Metrics())
 	mb.metricKafkaConsumerGroupMembers.emit(ils.Metrics())
 	mb.metricKafkaConsumerGroupOffset.emit(ils.Metrics())
 	mb.metricKafkaConsumerGroupOffsetSum.emit(ils.Metrics())
@@ -801,6 +861,11 @@ func (mb *MetricsBuilder) RecordKafkaConsumerGroupLagSumDataPoint(ts pcommon.Tim
 	mb.metricKafkaConsumerGroupLagSum.recordDataPoint(mb.startTime, ts, val, groupAttributeValue, topicAttributeValue)
 }
 
+// RecordKafkaConsumerGroupLagTimeDataPoint adds a data point to kafka.consumer_group.lag_time metric.
+func (mb *MetricsBuilder) RecordKafkaConsumerGroupLagTimeDataPoint(ts pcommon.Timestamp, val float64, groupAttributeValue string, topicAttributeValue string, partitionAttributeValue int64) {
+	mb.metricKafkaConsumerGroupLagTime.recordDataPoint(mb.startTime, ts, val, groupAttributeValue, topicAttributeValue, partitionAttributeValue)
+}
+
 // RecordKafkaConsumerGroupMembersDataPoint adds a data point to kafka.consumer_group.members metric.
 func (mb *MetricsBuilder) RecordKafkaConsumerGroupMembersDataPoint(ts pcommon.Timestamp, val int64, groupAttributeValue string) {
 	mb.metricKafkaConsumerGroupMembers.recordDataPoint(mb.startTime, ts, val, groupAttributeValue)
@@ -0,0 +1,88 @@
+// Copyright  OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkametricsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkametricsreceiver"
+
+import (
+	"time"
+)
+
+// maxOffsetSamples is the number of distinct newest offsets kept for each partition.
+const maxOffsetSamples = 60
+
+// offsetSample is the newest offset of a partition, with the time of the scrape at which it was first seen.
+type offsetSample struct {
+	offset    int64
+	timestamp time.Time
+}
+
+// offsetTimeline resolves offsets of a partition to the approximate time they were produced, by interpolating
+// between the newest offsets seen at previous scrapes.
+type offsetTimeline struct {
+	samples []offsetSample
+	// lastObserved is the time of the last scrape, which lags are relative to.
+	lastObserved time.Time
+}
+
+// add records the newest offset of the partition at the given time. While the newest offset does not change,
+// no sample is added, so idle partitions do not evict the history, and the samples keep the time at which
+// their offset was first seen.
+func (t *offsetTimeline) add(offset int64, timestamp time.Time) {
+	t.lastObserved = timestamp
+	if n := len(t.samples); n > 0 {
+		last := t.samples[n-1]
+		if offset == last.offset {
+			return
+		}
+		if offset < last.offset {
+			// the partition was recreated or truncated, the history is not relevant anymore.
+			t.samples = t.samples[:0]
+		}
+	}
+	if len(t.samples) == maxOffsetSamples {
+		t.samples = append(t.samples[:0], t.samples[1:]...)
+	}
+	t.samples = append(t.samples, offsetSample{offset: offset, timestamp: timestamp})
+}
+
+// lag returns how long ago the message at the given offset was produced, relative to the last scrape.
+// It returns false if there is not enough history yet to estimate it.
+func (t *offsetTimeline) lag(offset int64) (time.Duration, bool) {
+	n := len(t.samples)
+	if n == 0 {
+		return 0, false
+	}
+	newest := t.samples[n-1]
+	if offset >= newest.offset {
+		return 0, true
+	}
+	if n == 1 {
+		return 0, false
+	}
+
+	// extrapolate with the average rate over the whole history for offsets older than the first sample.
+	before, after := t.samples[0], newest
+	if offset >= before.offset {
+		for i := 1; i < n; i++ {
+			if t.samples[i].offset > offset {
+				before, after = t.samples[i-1], t.samples[i]
+				break
+			}
+		}
+	}
+
+	ratio := float64(offset-before.offset) / float64(after.offset-before.offset)
+	produced := before.timestamp.Add(time.Duration(ratio * float64(after.timestamp.Sub(before.timestamp))))
+	return t.lastObserved.Sub(produced), true
+}
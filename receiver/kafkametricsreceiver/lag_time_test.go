@@ -0,0 +1,99 @@
+// Copyright  OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkametricsreceiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOffsetTimelineLag(t *testing.T) {
+	start := time.Unix(1668000000, 0)
+	timeline := &offsetTimeline{}
+
+	_, ok := timeline.lag(10)
+	assert.False(t, ok, "no samples")
+
+	timeline.add(100, start)
+	lag, ok := timeline.lag(100)
+	assert.True(t, ok, "caught up consumer")
+	assert.Equal(t, time.Duration(0), lag)
+	_, ok = timeline.lag(50)
+	assert.False(t, ok, "single sample")
+
+	timeline.add(200, start.Add(10*time.Second))
+	timeline.add(200, start.Add(20*time.Second))
+	timeline.add(300, start.Add(30*time.Second))
+	assert.Len(t, timeline.samples, 3)
+
+	testCases := []struct {
+		name   string
+		offset int64
+		lag    time.Duration
+	}{
+		{name: "newest", offset: 300, lag: 0},
+		{name: "ahead", offset: 301, lag: 0},
+		{name: "interpolated", offset: 250, lag: 10 * time.Second},
+		{name: "sample", offset: 200, lag: 20 * time.Second},
+		{name: "first interval", offset: 150, lag: 25 * time.Second},
+		{name: "extrapolated", offset: 0, lag: 45 * time.Second},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			lag, ok := timeline.lag(tc.offset)
+			assert.True(t, ok)
+			assert.Equal(t, tc.lag, lag)
+		})
+	}
+}
+
+func TestOffsetTimelineIdle(t *testing.T) {
+	start := time.Unix(1668000000, 0)
+	timeline := &offsetTimeline{}
+	timeline.add(100, start)
+	timeline.add(200, start.Add(10*time.Second))
+
+	// the partition is idle for a minute
+	for i := 1; i <= 6; i++ {
+		timeline.add(200, start.Add(time.Duration(10+i*10)*time.Second))
+	}
+	assert.Equal(t, []offsetSample{
+		{offset: 100, timestamp: start},
+		{offset: 200, timestamp: start.Add(10 * time.Second)},
+	}, timeline.samples)
+
+	// the lags of messages produced before the idle period keep growing
+	lag, ok := timeline.lag(150)
+	assert.True(t, ok)
+	assert.Equal(t, 65*time.Second, lag)
+	lag, ok = timeline.lag(200)
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), lag)
+}
+
+func TestOffsetTimelineAdd(t *testing.T) {
+	start := time.Unix(1668000000, 0)
+	timeline := &offsetTimeline{}
+	for i := 0; i < maxOffsetSamples+10; i++ {
+		timeline.add(int64(i), start.Add(time.Duration(i)*time.Second))
+	}
+	assert.Len(t, timeline.samples, maxOffsetSamples)
+	assert.Equal(t, int64(10), timeline.samples[0].offset)
+
+	timeline.add(5, start.Add(time.Hour))
+	assert.Equal(t, []offsetSample{{offset: 5, timestamp: start.Add(time.Hour)}}, timeline.samples)
+}
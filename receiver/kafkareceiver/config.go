@@ -15,6 +15,8 @@
 package kafkareceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkareceiver"
 
 import (
+	"fmt"
+	"regexp"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -50,7 +52,8 @@ type Config struct {
 	Brokers []string `mapstructure:"brokers"`
 	// Kafka protocol version
 	ProtocolVersion string `mapstructure:"protocol_version"`
-	// The name of the kafka topic to consume from (default "otlp_spans")
+	// The name of the kafka topic to consume from (default "otlp_spans"). A topic starting
+	// with "^" is a regular expression, and all the topics matching it are consumed from.
 	Topic string `mapstructure:"topic"`
 	// Encoding of the messages (default "otlp_proto")
 	Encoding string `mapstructure:"encoding"`
@@ -70,11 +73,35 @@ type Config struct {
 
 	// Controls the way the messages are marked as consumed
 	MessageMarking MessageMarking `mapstructure:"message_marking"`
+
+	// Controls the extraction of record headers into attributes
+	HeaderExtraction HeaderExtraction `mapstructure:"header_extraction"`
+}
+
+type HeaderExtraction struct {
+	// The record headers to extract. Each header is added as an attribute named
+	// "kafka.header.<header>" (default none).
+	Headers []string `mapstructure:"headers"`
+
+	// Where the header attributes are added: "resource" for the resource attributes, or
+	// "record" for the attributes of each span, metric data point or log record
+	// (default "resource").
+	Target string `mapstructure:"target"`
 }
 
 var _ component.ReceiverConfig = (*Config)(nil)
 
 // Validate checks the receiver configuration is valid
 func (cfg *Config) Validate() error {
+	if isTopicPattern(cfg.Topic) {
+		if _, err := regexp.Compile(cfg.Topic); err != nil {
+			return fmt.Errorf("invalid topic pattern: %w", err)
+		}
+	}
+	switch cfg.HeaderExtraction.Target {
+	case "", headerTargetResource, headerTargetRecord:
+	default:
+		return fmt.Errorf("invalid header_extraction target %q, must be %q or %q", cfg.HeaderExtraction.Target, headerTargetResource, headerTargetRecord)
+	}
 	return nil
 }
@@ -103,6 +103,32 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(typeStr, "pattern"),
+			expected: &Config{
+				ReceiverSettings: config.NewReceiverSettings(component.NewID(typeStr)),
+				Topic:            `^tenant-.*\.logs$`,
+				Encoding:         "otlp_proto",
+				Brokers:          []string{"localhost:9092"},
+				ClientID:         "otel-collector",
+				GroupID:          "otel-collector",
+				Metadata: kafkaexporter.Metadata{
+					Full: true,
+					Retry: kafkaexporter.MetadataRetry{
+						Max:     3,
+						Backoff: time.Millisecond * 250,
+					},
+				},
+				AutoCommit: AutoCommit{
+					Enable:   true,
+					Interval: 1 * time.Second,
+				},
+				HeaderExtraction: HeaderExtraction{
+					Headers: []string{"tenant", "region"},
+					Target:  "record",
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -119,3 +145,10 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateConfig(t *testing.T) {
+	assert.NoError(t, (&Config{Topic: "spans"}).Validate())
+	assert.NoError(t, (&Config{Topic: "^spans-.*", HeaderExtraction: HeaderExtraction{Headers: []string{"tenant"}, Target: "resource"}}).Validate())
+	assert.ErrorContains(t, (&Config{Topic: "^spans-("}).Validate(), "invalid topic pattern")
+	assert.ErrorContains(t, (&Config{Topic: "spans", HeaderExtraction: HeaderExtraction{Target: "scope"}}).Validate(), "invalid header_extraction target")
+}
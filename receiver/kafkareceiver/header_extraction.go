@@ -0,0 +1,171 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkareceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkareceiver"
+
+import (
+	"github.com/Shopify/sarama"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+const (
+	// headerAttributePrefix prefixes the name of the attributes extracted from headers
+	headerAttributePrefix = "kafka.header."
+
+	headerTargetResource = "resource"
+	headerTargetRecord   = "record"
+)
+
+// headerExtractor adds the configured Kafka record headers to the telemetry unmarshaled
+// from the record. A nil headerExtractor does not extract anything.
+type headerExtractor struct {
+	headers []string
+	record  bool
+}
+
+func newHeaderExtractor(cfg HeaderExtraction) *headerExtractor {
+	if len(cfg.Headers) == 0 {
+		return nil
+	}
+	return &headerExtractor{
+		headers: cfg.Headers,
+		record:  cfg.Target == headerTargetRecord,
+	}
+}
+
+// attributes returns the configured headers present in the message. When a header is
+// repeated, its first value is used.
+func (e *headerExtractor) attributes(message *sarama.ConsumerMessage) pcommon.Map {
+	attrs := pcommon.NewMap()
+	for _, name := range e.headers {
+		for _, header := range message.Headers {
+			if header != nil && string(header.Key) == name {
+				attrs.PutStr(headerAttributePrefix+name, string(header.Value))
+				break
+			}
+		}
+	}
+	return attrs
+}
+
+func (e *headerExtractor) extractTraces(message *sarama.ConsumerMessage, traces ptrace.Traces) {
+	if e == nil {
+		return
+	}
+	attrs := e.attributes(message)
+	if attrs.Len() == 0 {
+		return
+	}
+	for i := 0; i < traces.ResourceSpans().Len(); i++ {
+		rs := traces.ResourceSpans().At(i)
+		if !e.record {
+			putAll(attrs, rs.Resource().Attributes())
+			continue
+		}
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			spans := rs.ScopeSpans().At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				putAll(attrs, spans.At(k).Attributes())
+			}
+		}
+	}
+}
+
+func (e *headerExtractor) extractMetrics(message *sarama.ConsumerMessage, metrics pmetric.Metrics) {
+	if e == nil {
+		return
+	}
+	attrs := e.attributes(message)
+	if attrs.Len() == 0 {
+		return
+	}
+	for i := 0; i < metrics.ResourceMetrics().Len(); i++ {
+		rm := metrics.ResourceMetrics().At(i)
+		if !e.record {
+			putAll(attrs, rm.Resource().Attributes())
+			continue
+		}
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			ms := rm.ScopeMetrics().At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				forEachDataPointAttributes(ms.At(k), func(dpAttrs pcommon.Map) {
+					putAll(attrs, dpAttrs)
+				})
+			}
+		}
+	}
+}
+
+func (e *headerExtractor) extractLogs(message *sarama.ConsumerMessage, logs plog.Logs) {
+	if e == nil {
+		return
+	}
+	attrs := e.attributes(message)
+	if attrs.Len() == 0 {
+		return
+	}
+	for i := 0; i < logs.ResourceLogs().Len(); i++ {
+		rl := logs.ResourceLogs().At(i)
+		if !e.record {
+			putAll(attrs, rl.Resource().Attributes())
+			continue
+		}
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			lrs := rl.ScopeLogs().At(j).LogRecords()
+			for k := 0; k < lrs.Len(); k++ {
+				putAll(attrs, lrs.At(k).Attributes())
+			}
+		}
+	}
+}
+
+func putAll(from pcommon.Map, to pcommon.Map) {
+	from.Range(func(k string, v pcommon.Value) bool {
+		to.PutStr(k, v.Str())
+		return true
+	})
+}
+
+func forEachDataPointAttributes(metric pmetric.Metric, f func(pcommon.Map)) {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		dps := metric.Gauge().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			f(dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeSum:
+		dps := metric.Sum().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			f(dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeHistogram:
+		dps := metric.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			f(dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		dps := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			f(dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeSummary:
+		dps := metric.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			f(dps.At(i).Attributes())
+		}
+	}
+}
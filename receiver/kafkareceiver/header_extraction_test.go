@@ -0,0 +1,122 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkareceiver
+
+import (
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func testHeaderMessage() *sarama.ConsumerMessage {
+	return &sarama.ConsumerMessage{
+		Headers: []*sarama.RecordHeader{
+			{Key: []byte("tenant"), Value: []byte("acme")},
+			{Key: []byte("tenant"), Value: []byte("ignored")},
+			{Key: []byte("region"), Value: []byte("eu-west-1")},
+			{Key: []byte("trace"), Value: []byte("not extracted")},
+		},
+	}
+}
+
+func TestNewHeaderExtractor(t *testing.T) {
+	assert.Nil(t, newHeaderExtractor(HeaderExtraction{}))
+	assert.Nil(t, newHeaderExtractor(HeaderExtraction{Target: headerTargetRecord}))
+
+	var extractor *headerExtractor
+	logs := plog.NewLogs()
+	logs.ResourceLogs().AppendEmpty()
+	extractor.extractLogs(testHeaderMessage(), logs)
+	assert.Equal(t, 0, logs.ResourceLogs().At(0).Resource().Attributes().Len())
+}
+
+func TestHeaderExtractionTraces(t *testing.T) {
+	expected := map[string]interface{}{
+		"kafka.header.tenant": "acme",
+		"kafka.header.region": "eu-west-1",
+	}
+
+	traces := ptrace.NewTraces()
+	rs := traces.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "checkout")
+	span := rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+
+	extractor := newHeaderExtractor(HeaderExtraction{Headers: []string{"tenant", "region", "missing"}})
+	extractor.extractTraces(testHeaderMessage(), traces)
+	assert.Equal(t, map[string]interface{}{
+		"service.name":        "checkout",
+		"kafka.header.tenant": "acme",
+		"kafka.header.region": "eu-west-1",
+	}, rs.Resource().Attributes().AsRaw())
+	assert.Equal(t, 0, span.Attributes().Len())
+
+	traces = ptrace.NewTraces()
+	rs = traces.ResourceSpans().AppendEmpty()
+	span = rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	extractor = newHeaderExtractor(HeaderExtraction{Headers: []string{"tenant", "region"}, Target: headerTargetRecord})
+	extractor.extractTraces(testHeaderMessage(), traces)
+	assert.Equal(t, 0, rs.Resource().Attributes().Len())
+	assert.Equal(t, expected, span.Attributes().AsRaw())
+}
+
+func TestHeaderExtractionMetrics(t *testing.T) {
+	metrics := pmetric.NewMetrics()
+	rm := metrics.ResourceMetrics().AppendEmpty()
+	ms := rm.ScopeMetrics().AppendEmpty().Metrics()
+	gauge := ms.AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty()
+	sum := ms.AppendEmpty().SetEmptySum().DataPoints().AppendEmpty()
+	histogram := ms.AppendEmpty().SetEmptyHistogram().DataPoints().AppendEmpty()
+	expHistogram := ms.AppendEmpty().SetEmptyExponentialHistogram().DataPoints().AppendEmpty()
+	summary := ms.AppendEmpty().SetEmptySummary().DataPoints().AppendEmpty()
+
+	extractor := newHeaderExtractor(HeaderExtraction{Headers: []string{"tenant"}, Target: headerTargetRecord})
+	extractor.extractMetrics(testHeaderMessage(), metrics)
+
+	expected := map[string]interface{}{"kafka.header.tenant": "acme"}
+	assert.Equal(t, 0, rm.Resource().Attributes().Len())
+	assert.Equal(t, expected, gauge.Attributes().AsRaw())
+	assert.Equal(t, expected, sum.Attributes().AsRaw())
+	assert.Equal(t, expected, histogram.Attributes().AsRaw())
+	assert.Equal(t, expected, expHistogram.Attributes().AsRaw())
+	assert.Equal(t, expected, summary.Attributes().AsRaw())
+
+	metrics = pmetric.NewMetrics()
+	rm = metrics.ResourceMetrics().AppendEmpty()
+	extractor = newHeaderExtractor(HeaderExtraction{Headers: []string{"tenant"}})
+	extractor.extractMetrics(testHeaderMessage(), metrics)
+	assert.Equal(t, expected, rm.Resource().Attributes().AsRaw())
+}
+
+func TestHeaderExtractionLogs(t *testing.T) {
+	logs := plog.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	lr := rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.Attributes().PutStr("kafka.header.tenant", "overwritten")
+
+	extractor := newHeaderExtractor(HeaderExtraction{Headers: []string{"tenant"}, Target: headerTargetRecord})
+	extractor.extractLogs(testHeaderMessage(), logs)
+	assert.Equal(t, 0, rl.Resource().Attributes().Len())
+	assert.Equal(t, map[string]interface{}{"kafka.header.tenant": "acme"}, lr.Attributes().AsRaw())
+
+	logs = plog.NewLogs()
+	rl = logs.ResourceLogs().AppendEmpty()
+	extractor = newHeaderExtractor(HeaderExtraction{Headers: []string{"missing"}})
+	extractor.extractLogs(testHeaderMessage(), logs)
+	assert.Equal(t, 0, rl.Resource().Attributes().Len())
+}
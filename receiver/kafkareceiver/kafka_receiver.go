@@ -41,7 +41,7 @@ type kafkaTracesConsumer struct {
 	id                component.ID
 	consumerGroup     sarama.ConsumerGroup
 	nextConsumer      consumer.Traces
-	topics            []string
+	subscription      topicSubscription
 	cancelConsumeLoop context.CancelFunc
 	unmarshaler       TracesUnmarshaler
 
@@ -49,6 +49,7 @@ type kafkaTracesConsumer struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	headerExtractor   *headerExtractor
 }
 
 // kafkaMetricsConsumer uses sarama to consume and handle messages from kafka.
@@ -56,7 +57,7 @@ type kafkaMetricsConsumer struct {
 	id                component.ID
 	consumerGroup     sarama.ConsumerGroup
 	nextConsumer      consumer.Metrics
-	topics            []string
+	subscription      topicSubscription
 	cancelConsumeLoop context.CancelFunc
 	unmarshaler       MetricsUnmarshaler
 
@@ -64,6 +65,7 @@ type kafkaMetricsConsumer struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	headerExtractor   *headerExtractor
 }
 
 // kafkaLogsConsumer uses sarama to consume and handle messages from kafka.
@@ -71,7 +73,7 @@ type kafkaLogsConsumer struct {
 	id                component.ID
 	consumerGroup     sarama.ConsumerGroup
 	nextConsumer      consumer.Logs
-	topics            []string
+	subscription      topicSubscription
 	cancelConsumeLoop context.CancelFunc
 	unmarshaler       LogsUnmarshaler
 
@@ -79,6 +81,7 @@ type kafkaLogsConsumer struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	headerExtractor   *headerExtractor
 }
 
 var _ component.Receiver = (*kafkaTracesConsumer)(nil)
@@ -106,19 +109,20 @@ func newTracesReceiver(config Config, set component.ReceiverCreateSettings, unma
 	if err := kafkaexporter.ConfigureAuthentication(config.Authentication, c); err != nil {
 		return nil, err
 	}
-	client, err := sarama.NewConsumerGroup(config.Brokers, config.GroupID, c)
+	client, subscription, err := newConsumerGroup(config, c, set.Logger)
 	if err != nil {
 		return nil, err
 	}
 	return &kafkaTracesConsumer{
 		id:                config.ID(),
 		consumerGroup:     client,
-		topics:            []string{config.Topic},
+		subscription:      subscription,
 		nextConsumer:      nextConsumer,
 		unmarshaler:       unmarshaler,
 		settings:          set,
 		autocommitEnabled: config.AutoCommit.Enable,
 		messageMarking:    config.MessageMarking,
+		headerExtractor:   newHeaderExtractor(config.HeaderExtraction),
 	}, nil
 }
 
@@ -142,13 +146,16 @@ func (c *kafkaTracesConsumer) Start(_ context.Context, host component.Host) erro
 		obsrecv:           obsrecv,
 		autocommitEnabled: c.autocommitEnabled,
 		messageMarking:    c.messageMarking,
+		headerExtractor:   c.headerExtractor,
 	}
 	go func() {
 		if err := c.consumeLoop(ctx, consumerGroup); err != nil {
 			host.ReportFatalError(err)
 		}
 	}()
-	<-consumerGroup.ready
+	if c.subscription.waitsForReady() {
+		<-consumerGroup.ready
+	}
 	return nil
 }
 
@@ -157,7 +164,7 @@ func (c *kafkaTracesConsumer) consumeLoop(ctx context.Context, handler sarama.Co
 		// `Consume` should be called inside an infinite loop, when a
 		// server-side rebalance happens, the consumer session will need to be
 		// recreated to get the new claims
-		if err := c.consumerGroup.Consume(ctx, c.topics, handler); err != nil {
+		if err := c.subscription.consume(ctx, c.consumerGroup, handler); err != nil {
 			c.settings.Logger.Error("Error from consumer", zap.Error(err))
 		}
 		// check if context was cancelled, signaling that the consumer should stop
@@ -170,7 +177,10 @@ func (c *kafkaTracesConsumer) consumeLoop(ctx context.Context, handler sarama.Co
 
 func (c *kafkaTracesConsumer) Shutdown(context.Context) error {
 	c.cancelConsumeLoop()
-	return c.consumerGroup.Close()
+	if err := c.consumerGroup.Close(); err != nil {
+		return err
+	}
+	return c.subscription.close()
 }
 
 func newMetricsReceiver(config Config, set component.ReceiverCreateSettings, unmarshalers map[string]MetricsUnmarshaler, nextConsumer consumer.Metrics) (*kafkaMetricsConsumer, error) {
@@ -197,19 +207,20 @@ func newMetricsReceiver(config Config, set component.ReceiverCreateSettings, unm
 	if err := kafkaexporter.ConfigureAuthentication(config.Authentication, c); err != nil {
 		return nil, err
 	}
-	client, err := sarama.NewConsumerGroup(config.Brokers, config.GroupID, c)
+	client, subscription, err := newConsumerGroup(config, c, set.Logger)
 	if err != nil {
 		return nil, err
 	}
 	return &kafkaMetricsConsumer{
 		id:                config.ID(),
 		consumerGroup:     client,
-		topics:            []string{config.Topic},
+		subscription:      subscription,
 		nextConsumer:      nextConsumer,
 		unmarshaler:       unmarshaler,
 		settings:          set,
 		autocommitEnabled: config.AutoCommit.Enable,
 		messageMarking:    config.MessageMarking,
+		headerExtractor:   newHeaderExtractor(config.HeaderExtraction),
 	}, nil
 }
 
@@ -233,13 +244,16 @@ func (c *kafkaMetricsConsumer) Start(_ context.Context, host component.Host) err
 		obsrecv:           obsrecv,
 		autocommitEnabled: c.autocommitEnabled,
 		messageMarking:    c.messageMarking,
+		headerExtractor:   c.headerExtractor,
 	}
 	go func() {
 		if err := c.consumeLoop(ctx, metricsConsumerGroup); err != nil {
 			host.ReportFatalError(err)
 		}
 	}()
-	<-metricsConsumerGroup.ready
+	if c.subscription.waitsForReady() {
+		<-metricsConsumerGroup.ready
+	}
 	return nil
 }
 
@@ -248,7 +262,7 @@ func (c *kafkaMetricsConsumer) consumeLoop(ctx context.Context, handler sarama.C
 		// `Consume` should be called inside an infinite loop, when a
 		// server-side rebalance happens, the consumer session will need to be
 		// recreated to get the new claims
-		if err := c.consumerGroup.Consume(ctx, c.topics, handler); err != nil {
+		if err := c.subscription.consume(ctx, c.consumerGroup, handler); err != nil {
 			c.settings.Logger.Error("Error from consumer", zap.Error(err))
 		}
 		// check if context was cancelled, signaling that the consumer should stop
@@ -261,7 +275,10 @@ func (c *kafkaMetricsConsumer) consumeLoop(ctx context.Context, handler sarama.C
 
 func (c *kafkaMetricsConsumer) Shutdown(context.Context) error {
 	c.cancelConsumeLoop()
-	return c.consumerGroup.Close()
+	if err := c.consumerGroup.Close(); err != nil {
+		return err
+	}
+	return c.subscription.close()
 }
 
 func newLogsReceiver(config Config, set component.ReceiverCreateSettings, unmarshalers map[string]LogsUnmarshaler, nextConsumer consumer.Logs) (*kafkaLogsConsumer, error) {
@@ -285,19 +302,20 @@ func newLogsReceiver(config Config, set component.ReceiverCreateSettings, unmars
 	if err := kafkaexporter.ConfigureAuthentication(config.Authentication, c); err != nil {
 		return nil, err
 	}
-	client, err := sarama.NewConsumerGroup(config.Brokers, config.GroupID, c)
+	client, subscription, err := newConsumerGroup(config, c, set.Logger)
 	if err != nil {
 		return nil, err
 	}
 	return &kafkaLogsConsumer{
 		id:                config.ID(),
 		consumerGroup:     client,
-		topics:            []string{config.Topic},
+		subscription:      subscription,
 		nextConsumer:      nextConsumer,
 		unmarshaler:       unmarshaler,
 		settings:          set,
 		autocommitEnabled: config.AutoCommit.Enable,
 		messageMarking:    config.MessageMarking,
+		headerExtractor:   newHeaderExtractor(config.HeaderExtraction),
 	}, nil
 }
 
@@ -322,13 +340,16 @@ func (c *kafkaLogsConsumer) Start(_ context.Context, host component.Host) error
 		obsrecv:           obsrecv,
 		autocommitEnabled: c.autocommitEnabled,
 		messageMarking:    c.messageMarking,
+		headerExtractor:   c.headerExtractor,
 	}
 	go func() {
 		if err := c.consumeLoop(ctx, logsConsumerGroup); err != nil {
 			host.ReportFatalError(err)
 		}
 	}()
-	<-logsConsumerGroup.ready
+	if c.subscription.waitsForReady() {
+		<-logsConsumerGroup.ready
+	}
 	return nil
 }
 
@@ -337,7 +358,7 @@ func (c *kafkaLogsConsumer) consumeLoop(ctx context.Context, handler sarama.Cons
 		// `Consume` should be called inside an infinite loop, when a
 		// server-side rebalance happens, the consumer session will need to be
 		// recreated to get the new claims
-		if err := c.consumerGroup.Consume(ctx, c.topics, handler); err != nil {
+		if err := c.subscription.consume(ctx, c.consumerGroup, handler); err != nil {
 			c.settings.Logger.Error("Error from consumer", zap.Error(err))
 		}
 		// check if context was cancelled, signaling that the consumer should stop
@@ -350,7 +371,10 @@ func (c *kafkaLogsConsumer) consumeLoop(ctx context.Context, handler sarama.Cons
 
 func (c *kafkaLogsConsumer) Shutdown(context.Context) error {
 	c.cancelConsumeLoop()
-	return c.consumerGroup.Close()
+	if err := c.consumerGroup.Close(); err != nil {
+		return err
+	}
+	return c.subscription.close()
 }
 
 type tracesConsumerGroupHandler struct {
@@ -366,6 +390,7 @@ type tracesConsumerGroupHandler struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	headerExtractor   *headerExtractor
 }
 
 type metricsConsumerGroupHandler struct {
@@ -381,6 +406,7 @@ type metricsConsumerGroupHandler struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	headerExtractor   *headerExtractor
 }
 
 type logsConsumerGroupHandler struct {
@@ -396,6 +422,7 @@ type logsConsumerGroupHandler struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	headerExtractor   *headerExtractor
 }
 
 var _ sarama.ConsumerGroupHandler = (*tracesConsumerGroupHandler)(nil)
@@ -446,6 +473,7 @@ func (c *tracesConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSe
 			}
 			return err
 		}
+		c.headerExtractor.extractTraces(message, traces)
 
 		spanCount := traces.SpanCount()
 		err = c.nextConsumer.ConsumeTraces(session.Context(), traces)
@@ -511,6 +539,7 @@ func (c *metricsConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupS
 			}
 			return err
 		}
+		c.headerExtractor.extractMetrics(message, metrics)
 
 		dataPointCount := metrics.DataPointCount()
 		err = c.nextConsumer.ConsumeMetrics(session.Context(), metrics)
@@ -580,6 +609,7 @@ func (c *logsConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSess
 			}
 			return err
 		}
+		c.headerExtractor.extractLogs(message, logs)
 
 		err = c.nextConsumer.ConsumeLogs(session.Context(), logs)
 		// TODO
@@ -565,6 +565,47 @@ func TestLogsConsumerGroupHandler(t *testing.T) {
 	wg.Wait()
 }
 
+func TestLogsConsumerGroupHandlerWithHeaderExtraction(t *testing.T) {
+	obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{ReceiverCreateSettings: componenttest.NewNopReceiverCreateSettings()})
+	require.NoError(t, err)
+	sink := &consumertest.LogsSink{}
+	c := logsConsumerGroupHandler{
+		unmarshaler:     newPdataLogsUnmarshaler(&plog.ProtoUnmarshaler{}, defaultEncoding),
+		logger:          zap.NewNop(),
+		ready:           make(chan bool),
+		nextConsumer:    sink,
+		obsrecv:         obsrecv,
+		headerExtractor: newHeaderExtractor(HeaderExtraction{Headers: []string{"tenant"}}),
+	}
+
+	groupClaim := testConsumerGroupClaim{
+		messageChan: make(chan *sarama.ConsumerMessage),
+	}
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		require.NoError(t, c.ConsumeClaim(testConsumerGroupSession{}, groupClaim))
+		wg.Done()
+	}()
+
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	bts, err := (&plog.ProtoMarshaler{}).MarshalLogs(ld)
+	require.NoError(t, err)
+	groupClaim.messageChan <- &sarama.ConsumerMessage{
+		Value:   bts,
+		Headers: []*sarama.RecordHeader{{Key: []byte("tenant"), Value: []byte("acme")}},
+	}
+	close(groupClaim.messageChan)
+	wg.Wait()
+
+	require.Len(t, sink.AllLogs(), 1)
+	tenant, ok := sink.AllLogs()[0].ResourceLogs().At(0).Resource().Attributes().Get("kafka.header.tenant")
+	require.True(t, ok)
+	assert.Equal(t, "acme", tenant.Str())
+}
+
 func TestLogsConsumerGroupHandler_error_unmarshal(t *testing.T) {
 	obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{ReceiverCreateSettings: componenttest.NewNopReceiverCreateSettings()})
 	require.NoError(t, err)
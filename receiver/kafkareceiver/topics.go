@@ -0,0 +1,184 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkareceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkareceiver"
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"go.uber.org/zap"
+)
+
+// topicPatternPrefix marks a topic configuration as a regular expression
+const topicPatternPrefix = "^"
+
+// defaultTopicRefreshInterval is how often the topics matching a pattern are listed
+const defaultTopicRefreshInterval = time.Minute
+
+// topicClient lists the topics of the cluster
+type topicClient interface {
+	RefreshMetadata(topics ...string) error
+	Topics() ([]string, error)
+	Close() error
+}
+
+// topicSubscription resolves the topics the receiver consumes from: either a single
+// named topic, or all the topics whose name matches a regular expression.
+type topicSubscription struct {
+	topics          []string
+	pattern         *regexp.Regexp
+	client          topicClient
+	refreshInterval time.Duration
+	logger          *zap.Logger
+}
+
+func isTopicPattern(topic string) bool {
+	return strings.HasPrefix(topic, topicPatternPrefix)
+}
+
+// newConsumerGroup creates the consumer group and the topic subscription of the receiver.
+// Subscribing by pattern requires a client of its own to list the topics, which the
+// consumer group is then created from.
+func newConsumerGroup(config Config, c *sarama.Config, logger *zap.Logger) (sarama.ConsumerGroup, topicSubscription, error) {
+	if !isTopicPattern(config.Topic) {
+		group, err := sarama.NewConsumerGroup(config.Brokers, config.GroupID, c)
+		return group, topicSubscription{topics: []string{config.Topic}}, err
+	}
+
+	pattern, err := regexp.Compile(config.Topic)
+	if err != nil {
+		return nil, topicSubscription{}, fmt.Errorf("invalid topic pattern: %w", err)
+	}
+	client, err := sarama.NewClient(config.Brokers, c)
+	if err != nil {
+		return nil, topicSubscription{}, err
+	}
+	group, err := sarama.NewConsumerGroupFromClient(config.GroupID, client)
+	if err != nil {
+		_ = client.Close()
+		return nil, topicSubscription{}, err
+	}
+	return group, topicSubscription{
+		pattern:         pattern,
+		client:          client,
+		refreshInterval: defaultTopicRefreshInterval,
+		logger:          logger,
+	}, nil
+}
+
+// matchingTopics lists the topics of the cluster which match the pattern, sorted by name.
+func (s *topicSubscription) matchingTopics() ([]string, error) {
+	if err := s.client.RefreshMetadata(); err != nil {
+		return nil, err
+	}
+	all, err := s.client.Topics()
+	if err != nil {
+		return nil, err
+	}
+	var topics []string
+	for _, topic := range all {
+		if s.pattern.MatchString(topic) {
+			topics = append(topics, topic)
+		}
+	}
+	sort.Strings(topics)
+	return topics, nil
+}
+
+// consume runs a consumer group session on the subscribed topics. When subscribed by
+// pattern, the session is ended as soon as the matching topics change, so that the
+// caller starts a new session on the updated topics.
+func (s *topicSubscription) consume(ctx context.Context, group sarama.ConsumerGroup, handler sarama.ConsumerGroupHandler) error {
+	if s.pattern == nil {
+		return group.Consume(ctx, s.topics, handler)
+	}
+
+	topics, err := s.matchingTopics()
+	if err != nil {
+		// wait before returning, so that the caller doesn't retry listing the topics in a busy loop while
+		// the cluster is unavailable
+		timer := time.NewTimer(s.refreshInterval)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+		case <-timer.C:
+		}
+		return fmt.Errorf("failed to list the topics matching %q: %w", s.pattern, err)
+	}
+
+	sessionCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go s.watch(sessionCtx, topics, cancel)
+
+	if len(topics) == 0 {
+		s.logger.Warn("No topic matches the pattern, waiting for one to be created", zap.Stringer("pattern", s.pattern))
+		<-sessionCtx.Done()
+		return nil
+	}
+	s.logger.Info("Consuming from the topics matching the pattern", zap.Stringer("pattern", s.pattern), zap.Strings("topics", topics))
+	return group.Consume(sessionCtx, topics, handler)
+}
+
+// watch cancels the session once the topics matching the pattern are no longer the given ones.
+func (s *topicSubscription) watch(ctx context.Context, topics []string, cancel context.CancelFunc) {
+	ticker := time.NewTicker(s.refreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			current, err := s.matchingTopics()
+			if err != nil {
+				s.logger.Warn("Failed to list the topics matching the pattern", zap.Stringer("pattern", s.pattern), zap.Error(err))
+				continue
+			}
+			if !equalTopics(topics, current) {
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+// waitsForReady returns whether the receiver start should wait for the first consumer group
+// session. It does not when subscribed by pattern, as no topic may match yet.
+func (s *topicSubscription) waitsForReady() bool {
+	return s.pattern == nil
+}
+
+func (s *topicSubscription) close() error {
+	if s.client == nil {
+		return nil
+	}
+	return s.client.Close()
+}
+
+func equalTopics(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
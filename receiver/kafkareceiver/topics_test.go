@@ -0,0 +1,191 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkareceiver
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestTopicSubscriptionNamedTopic(t *testing.T) {
+	group := &recordingConsumerGroup{}
+	subscription := topicSubscription{topics: []string{"spans"}}
+
+	require.NoError(t, subscription.consume(context.Background(), group, nil))
+
+	assert.Equal(t, [][]string{{"spans"}}, group.sessions())
+	assert.True(t, subscription.waitsForReady())
+	assert.NoError(t, subscription.close())
+}
+
+func TestTopicSubscriptionPattern(t *testing.T) {
+	client := &testTopicClient{topics: []string{"tenant-b.logs", "tenant-a.logs", "tenant-a.spans", "__consumer_offsets"}}
+	group := &recordingConsumerGroup{blockUntilCanceled: true}
+	subscription := topicSubscription{
+		pattern:         regexp.MustCompile(`^tenant-.*\.logs$`),
+		client:          client,
+		refreshInterval: 10 * time.Millisecond,
+		logger:          zap.NewNop(),
+	}
+	assert.False(t, subscription.waitsForReady())
+
+	done := make(chan error)
+	go func() {
+		done <- subscription.consume(context.Background(), group, nil)
+	}()
+
+	// the session ends once a new matching topic is created
+	require.Eventually(t, func() bool {
+		return len(group.sessions()) == 1
+	}, time.Second, 5*time.Millisecond)
+	client.setTopics([]string{"tenant-b.logs", "tenant-a.logs", "tenant-c.logs"})
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("session did not end after the topics changed")
+	}
+
+	go func() {
+		done <- subscription.consume(context.Background(), group, nil)
+	}()
+	require.Eventually(t, func() bool {
+		return len(group.sessions()) == 2
+	}, time.Second, 5*time.Millisecond)
+	client.setTopics([]string{"tenant-a.logs"})
+	require.NoError(t, <-done)
+
+	assert.Equal(t, [][]string{
+		{"tenant-a.logs", "tenant-b.logs"},
+		{"tenant-a.logs", "tenant-b.logs", "tenant-c.logs"},
+	}, group.sessions())
+
+	require.NoError(t, subscription.close())
+	assert.True(t, client.closed)
+}
+
+func TestTopicSubscriptionPatternNoMatch(t *testing.T) {
+	client := &testTopicClient{}
+	group := &recordingConsumerGroup{}
+	subscription := topicSubscription{
+		pattern:         regexp.MustCompile(`^logs-.*`),
+		client:          client,
+		refreshInterval: 10 * time.Millisecond,
+		logger:          zap.NewNop(),
+	}
+
+	done := make(chan error)
+	go func() {
+		done <- subscription.consume(context.Background(), group, nil)
+	}()
+	time.Sleep(30 * time.Millisecond)
+	client.setTopics([]string{"logs-1"})
+	require.NoError(t, <-done)
+
+	// no session is started until a topic matches
+	assert.Empty(t, group.sessions())
+}
+
+func TestTopicSubscriptionPatternListError(t *testing.T) {
+	client := &testTopicClient{err: errors.New("cluster unavailable")}
+	group := &recordingConsumerGroup{}
+	subscription := topicSubscription{
+		pattern:         regexp.MustCompile(`^logs-.*`),
+		client:          client,
+		refreshInterval: 50 * time.Millisecond,
+		logger:          zap.NewNop(),
+	}
+
+	// the error is returned after the refresh interval, so that the topics are not listed in a busy loop
+	start := time.Now()
+	require.Error(t, subscription.consume(context.Background(), group, nil))
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+
+	// the wait ends as soon as the context is canceled
+	subscription.refreshInterval = time.Hour
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.Error(t, subscription.consume(ctx, group, nil))
+	assert.Empty(t, group.sessions())
+}
+
+func TestNewConsumerGroupInvalidPattern(t *testing.T) {
+	_, _, err := newConsumerGroup(Config{Topic: "^logs-("}, sarama.NewConfig(), zap.NewNop())
+	assert.ErrorContains(t, err, "invalid topic pattern")
+}
+
+type testTopicClient struct {
+	mu     sync.Mutex
+	topics []string
+	err    error
+	closed bool
+}
+
+func (c *testTopicClient) setTopics(topics []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.topics = topics
+}
+
+func (c *testTopicClient) RefreshMetadata(...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.err
+}
+
+func (c *testTopicClient) Topics() ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string(nil), c.topics...), nil
+}
+
+func (c *testTopicClient) Close() error {
+	c.closed = true
+	return nil
+}
+
+// recordingConsumerGroup records the topics of each session it is asked to consume
+type recordingConsumerGroup struct {
+	testConsumerGroup
+	blockUntilCanceled bool
+
+	mu     sync.Mutex
+	topics [][]string
+}
+
+func (g *recordingConsumerGroup) Consume(ctx context.Context, topics []string, _ sarama.ConsumerGroupHandler) error {
+	g.mu.Lock()
+	g.topics = append(g.topics, topics)
+	g.mu.Unlock()
+	if g.blockUntilCanceled {
+		<-ctx.Done()
+	}
+	return nil
+}
+
+func (g *recordingConsumerGroup) sessions() [][]string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return append([][]string(nil), g.topics...)
+}
@@ -53,6 +53,11 @@ type Config struct {
 
 	// Metrics allows customizing scraped metrics representation.
 	Metrics metadata.MetricsSettings `mapstructure:"metrics"`
+
+	// ContainerStatsFallback enables filling container CPU time and memory working set
+	// missing from the /stats/summary endpoint with the values of the /metrics/resource
+	// endpoint, which the kubelet reports from the container runtime (CRI).
+	ContainerStatsFallback bool `mapstructure:"container_stats_fallback"`
 }
 
 func (cfg *Config) Validate() error {
@@ -89,11 +94,12 @@ func (cfg *Config) getReceiverOptions() (*scraperOptions, error) {
 	}
 
 	return &scraperOptions{
-		id:                    cfg.ID(),
-		collectionInterval:    cfg.CollectionInterval,
-		extraMetadataLabels:   cfg.ExtraMetadataLabels,
-		metricGroupsToCollect: mgs,
-		k8sAPIClient:          k8sAPIClient,
+		id:                     cfg.ID(),
+		collectionInterval:     cfg.CollectionInterval,
+		extraMetadataLabels:    cfg.ExtraMetadataLabels,
+		metricGroupsToCollect:  mgs,
+		k8sAPIClient:           k8sAPIClient,
+		containerStatsFallback: cfg.ContainerStatsFallback,
 	}, nil
 }
 
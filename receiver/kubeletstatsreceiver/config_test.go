@@ -186,6 +186,27 @@ func TestLoadConfig(t *testing.T) {
 				Metrics:      metadata.DefaultMetricsSettings(),
 			},
 		},
+		{
+			id: component.NewIDWithName(typeStr, "container_stats_fallback"),
+			expected: &Config{
+				ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
+					ReceiverSettings:   config.NewReceiverSettings(component.NewID(typeStr)),
+					CollectionInterval: duration,
+				},
+				ClientConfig: kube.ClientConfig{
+					APIConfig: k8sconfig.APIConfig{
+						AuthType: "serviceAccount",
+					},
+				},
+				MetricGroupsToCollect: []kubelet.MetricGroup{
+					kubelet.ContainerMetricGroup,
+					kubelet.PodMetricGroup,
+					kubelet.NodeMetricGroup,
+				},
+				Metrics:                metadata.DefaultMetricsSettings(),
+				ContainerStatsFallback: true,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -129,6 +129,9 @@ func (a *metricDataAccumulator) volumeStats(sPod stats.PodStats, s stats.VolumeS
 
 	currentTime := pcommon.NewTimestampFromTime(a.time)
 	addVolumeMetrics(a.mbs.OtherMetricsBuilder, metadata.K8sVolumeMetrics, s, currentTime)
+	if capacity, ok := a.metadata.getVolumeClaimCapacity(sPod.PodRef, s.Name); ok {
+		a.mbs.OtherMetricsBuilder.RecordK8sPersistentvolumeclaimCapacityDataPoint(currentTime, capacity)
+	}
 
 	a.m = append(a.m, a.mbs.OtherMetricsBuilder.Emit(ro...))
 }
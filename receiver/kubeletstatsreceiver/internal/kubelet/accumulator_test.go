@@ -42,7 +42,7 @@ func TestMetadataErrorCases(t *testing.T) {
 		numMDs                          int
 		numLogs                         int
 		logMessages                     []string
-		detailedPVCLabelsSetterOverride func(volCacheID, volumeClaim, namespace string) ([]metadata.ResourceMetricsOption, error)
+		detailedPVCLabelsSetterOverride func(volCacheID, volumeClaim, namespace string) (*VolumeClaimDetails, error)
 	}{
 		{
 			name: "Fails to get container metadata",
@@ -179,7 +179,7 @@ func TestMetadataErrorCases(t *testing.T) {
 					},
 				},
 			}, nil),
-			detailedPVCLabelsSetterOverride: func(volCacheID, volumeClaim, namespace string) ([]metadata.ResourceMetricsOption, error) {
+			detailedPVCLabelsSetterOverride: func(volCacheID, volumeClaim, namespace string) (*VolumeClaimDetails, error) {
 				// Mock failure cases.
 				return nil, errors.New("")
 			},
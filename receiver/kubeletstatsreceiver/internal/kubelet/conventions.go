@@ -28,4 +28,6 @@ const (
 	labelValueAWSEBSVolume          = "awsElasticBlockStore"
 	labelValueGCEPDVolume           = "gcePersistentDisk"
 	labelValueGlusterFSVolume       = "glusterfs"
+	labelValueCSIVolume             = "csi"
+	labelValueEphemeralVolume       = "ephemeral"
 )
@@ -57,15 +57,24 @@ func ValidateMetadataLabelsConfig(labels []MetadataLabel) error {
 	return nil
 }
 
+// VolumeClaimDetails are the details of a Persistent Volume Claim, and of the volume it is bound to,
+// fetched from the API server.
+type VolumeClaimDetails struct {
+	// ResourceOptions set the labels of the claim and of its volume.
+	ResourceOptions []metadata.ResourceMetricsOption
+	// Capacity is the storage capacity in bytes provisioned for the claim, nil if unknown.
+	Capacity *int64
+}
+
 type Metadata struct {
 	Labels                    map[MetadataLabel]bool
 	PodsMetadata              *v1.PodList
-	DetailedPVCResourceGetter func(volCacheID, volumeClaim, namespace string) ([]metadata.ResourceMetricsOption, error)
+	DetailedPVCResourceGetter func(volCacheID, volumeClaim, namespace string) (*VolumeClaimDetails, error)
 }
 
 func NewMetadata(
 	labels []MetadataLabel, podsMetadata *v1.PodList,
-	detailedPVCResourceGetter func(volCacheID, volumeClaim, namespace string) ([]metadata.ResourceMetricsOption, error)) Metadata {
+	detailedPVCResourceGetter func(volCacheID, volumeClaim, namespace string) (*VolumeClaimDetails, error)) Metadata {
 	return Metadata{
 		Labels:                    getLabelsMap(labels),
 		PodsMetadata:              podsMetadata,
@@ -107,22 +116,50 @@ func (m *Metadata) getExtraResources(podRef stats.PodReference, extraMetadataLab
 			return nil, err
 		}
 
-		ro := getResourcesFromVolume(volume)
+		ro := getResourcesFromVolume(podRef.Name, volume)
 
-		// Get more labels from PersistentVolumeClaim volume type.
-		if volume.PersistentVolumeClaim != nil {
-			volCacheID := fmt.Sprintf("%s/%s", podRef.UID, extraMetadataFrom)
-			pvcResources, err := m.DetailedPVCResourceGetter(volCacheID, volume.PersistentVolumeClaim.ClaimName, podRef.Namespace)
-			if err != nil {
-				return nil, fmt.Errorf("failed to set labels from volume claim: %w", err)
-			}
-			ro = append(ro, pvcResources...)
+		// Get more labels from the PersistentVolumeClaim of PersistentVolumeClaim and generic ephemeral volume types.
+		details, err := m.getVolumeClaimDetails(podRef, volume)
+		if err != nil {
+			return nil, fmt.Errorf("failed to set labels from volume claim: %w", err)
+		}
+		if details != nil {
+			ro = append(ro, details.ResourceOptions...)
 		}
 		return ro, nil
 	}
 	return nil, nil
 }
 
+// getVolumeClaimCapacity returns the capacity provisioned for the claim backing the volume, when the
+// volume is backed by a claim and its details are available from the API server.
+func (m *Metadata) getVolumeClaimCapacity(podRef stats.PodReference, volumeName string) (int64, bool) {
+	if !m.Labels[MetadataLabelVolumeType] || m.PodsMetadata == nil {
+		return 0, false
+	}
+
+	volume, err := m.getPodVolume(podRef.UID, volumeName)
+	if err != nil {
+		return 0, false
+	}
+
+	details, err := m.getVolumeClaimDetails(podRef, volume)
+	if err != nil || details == nil || details.Capacity == nil {
+		return 0, false
+	}
+	return *details.Capacity, true
+}
+
+func (m *Metadata) getVolumeClaimDetails(podRef stats.PodReference, volume v1.Volume) (*VolumeClaimDetails, error) {
+	claimName := volumeClaimName(podRef.Name, volume)
+	if claimName == "" || m.DetailedPVCResourceGetter == nil {
+		return nil, nil
+	}
+
+	volCacheID := fmt.Sprintf("%s/%s", podRef.UID, volume.Name)
+	return m.DetailedPVCResourceGetter(volCacheID, claimName, podRef.Namespace)
+}
+
 // getContainerID retrieves container id from metadata for given pod UID and container name,
 // returns an error if no container found in the metadata that matches the requirements
 // or if the apiServer returned a newly created container with empty containerID.
@@ -23,8 +23,6 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	stats "k8s.io/kubelet/pkg/apis/stats/v1alpha1"
-
-	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver/internal/metadata"
 )
 
 func TestValidateMetadataLabelsConfig(t *testing.T) {
@@ -364,6 +362,30 @@ func TestSetExtraLabelsForVolumeTypes(t *testing.T) {
 				"glusterfs.path":           "path",
 			},
 		},
+		{
+			name: "csi",
+			vs: v1.VolumeSource{
+				CSI: &v1.CSIVolumeSource{
+					Driver: "secrets-store.csi.k8s.io",
+				},
+			},
+			args: []string{"uid-1234", "k8s.volume.type"},
+			want: map[string]interface{}{
+				"k8s.volume.type": "csi",
+				"csi.driver":      "secrets-store.csi.k8s.io",
+			},
+		},
+		{
+			name: "ephemeral",
+			vs: v1.VolumeSource{
+				Ephemeral: &v1.EphemeralVolumeSource{},
+			},
+			args: []string{"uid-1234", "k8s.volume.type"},
+			want: map[string]interface{}{
+				"k8s.volume.type":                "ephemeral",
+				"k8s.persistentvolumeclaim.name": "pod-name-volume0",
+			},
+		},
 		{
 			name: "unsupported type",
 			vs:   v1.VolumeSource{},
@@ -390,10 +412,10 @@ func TestSetExtraLabelsForVolumeTypes(t *testing.T) {
 						},
 					},
 				},
-			}, func(volCacheID, volumeClaim, namespace string) ([]metadata.ResourceMetricsOption, error) {
+			}, func(volCacheID, volumeClaim, namespace string) (*VolumeClaimDetails, error) {
 				return nil, nil
 			})
-			ro, _ := metadata.getExtraResources(stats.PodReference{UID: tt.args[0]}, MetadataLabel(tt.args[1]), volName)
+			ro, _ := metadata.getExtraResources(stats.PodReference{UID: tt.args[0], Name: "pod-name"}, MetadataLabel(tt.args[1]), volName)
 
 			rm := pmetric.NewResourceMetrics()
 			for _, op := range ro {
@@ -38,6 +38,10 @@ func (f fakeRestClient) Pods() ([]byte, error) {
 	return os.ReadFile("../../testdata/pods.json")
 }
 
+func (f fakeRestClient) ResourceMetrics() ([]byte, error) {
+	return os.ReadFile("../../testdata/resource-metrics.txt")
+}
+
 func TestMetricAccumulator(t *testing.T) {
 	rc := &fakeRestClient{}
 	statsProvider := NewStatsProvider(rc)
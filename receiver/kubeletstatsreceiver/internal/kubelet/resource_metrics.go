@@ -0,0 +1,160 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubelet // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver/internal/kubelet"
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	stats "k8s.io/kubelet/pkg/apis/stats/v1alpha1"
+)
+
+const (
+	containerCPUUsageMetric         = "container_cpu_usage_seconds_total"
+	containerMemoryWorkingSetMetric = "container_memory_working_set_bytes"
+)
+
+type containerRef struct {
+	namespace string
+	pod       string
+	container string
+}
+
+type resourceSample struct {
+	value     float64
+	timestamp time.Time
+}
+
+// containerResourceMetrics holds the container samples of the kubelet /metrics/resource endpoint.
+type containerResourceMetrics struct {
+	cpuUsage         map[containerRef]resourceSample
+	memoryWorkingSet map[containerRef]resourceSample
+}
+
+// HasMissingContainerStats returns true if the CPU time or memory working set
+// of any container is missing from the summary.
+func HasMissingContainerStats(summary *stats.Summary) bool {
+	for _, pod := range summary.Pods {
+		for _, container := range pod.Containers {
+			if missingCPUTime(container) || missingMemoryWorkingSet(container) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func missingCPUTime(container stats.ContainerStats) bool {
+	return container.CPU == nil || container.CPU.UsageCoreNanoSeconds == nil
+}
+
+func missingMemoryWorkingSet(container stats.ContainerStats) bool {
+	return container.Memory == nil || container.Memory.WorkingSetBytes == nil
+}
+
+// FillMissingContainerStats fills the CPU time and memory working set of containers which
+// are missing from the summary with the samples of the kubelet /metrics/resource endpoint.
+// The kubelet reports these from the container runtime (CRI), so they are available when
+// the summary stats are not, e.g. with some cgroup v2 setups.
+func (p *StatsProvider) FillMissingContainerStats(summary *stats.Summary) error {
+	data, err := p.rc.ResourceMetrics()
+	if err != nil {
+		return err
+	}
+	metrics, err := parseContainerResourceMetrics(data)
+	if err != nil {
+		return err
+	}
+
+	for i := range summary.Pods {
+		pod := &summary.Pods[i]
+		for j := range pod.Containers {
+			container := &pod.Containers[j]
+			ref := containerRef{namespace: pod.PodRef.Namespace, pod: pod.PodRef.Name, container: container.Name}
+
+			if sample, ok := metrics.cpuUsage[ref]; ok && missingCPUTime(*container) {
+				if container.CPU == nil {
+					container.CPU = &stats.CPUStats{Time: metav1.NewTime(sample.timestamp)}
+				}
+				usage := uint64(sample.value * 1e9)
+				container.CPU.UsageCoreNanoSeconds = &usage
+			}
+
+			if sample, ok := metrics.memoryWorkingSet[ref]; ok && missingMemoryWorkingSet(*container) {
+				if container.Memory == nil {
+					container.Memory = &stats.MemoryStats{Time: metav1.NewTime(sample.timestamp)}
+				}
+				workingSet := uint64(sample.value)
+				container.Memory.WorkingSetBytes = &workingSet
+			}
+		}
+	}
+	return nil
+}
+
+func parseContainerResourceMetrics(data []byte) (*containerResourceMetrics, error) {
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse resource metrics: %w", err)
+	}
+
+	return &containerResourceMetrics{
+		cpuUsage:         containerSamples(families[containerCPUUsageMetric]),
+		memoryWorkingSet: containerSamples(families[containerMemoryWorkingSetMetric]),
+	}, nil
+}
+
+func containerSamples(family *dto.MetricFamily) map[containerRef]resourceSample {
+	samples := make(map[containerRef]resourceSample)
+	if family == nil {
+		return samples
+	}
+
+	for _, metric := range family.GetMetric() {
+		var ref containerRef
+		for _, label := range metric.GetLabel() {
+			switch label.GetName() {
+			case "namespace":
+				ref.namespace = label.GetValue()
+			case "pod":
+				ref.pod = label.GetValue()
+			case "container":
+				ref.container = label.GetValue()
+			}
+		}
+
+		sample := resourceSample{timestamp: time.Now()}
+		if metric.TimestampMs != nil {
+			sample.timestamp = time.UnixMilli(metric.GetTimestampMs())
+		}
+		switch {
+		case metric.Counter != nil:
+			sample.value = metric.GetCounter().GetValue()
+		case metric.Gauge != nil:
+			sample.value = metric.GetGauge().GetValue()
+		case metric.Untyped != nil:
+			sample.value = metric.GetUntyped().GetValue()
+		default:
+			continue
+		}
+		samples[ref] = sample
+	}
+	return samples
+}
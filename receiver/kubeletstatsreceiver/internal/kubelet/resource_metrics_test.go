@@ -0,0 +1,87 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubelet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	stats "k8s.io/kubelet/pkg/apis/stats/v1alpha1"
+)
+
+func TestFillMissingContainerStats(t *testing.T) {
+	cpuTime := uint64(1_000_000_000)
+	workingSet := uint64(1024)
+	summary := &stats.Summary{
+		Pods: []stats.PodStats{
+			{
+				PodRef: stats.PodReference{Namespace: "default", Name: "go-hello-world-5456b4b8cd-99vxc"},
+				Containers: []stats.ContainerStats{
+					{Name: "server"},
+				},
+			},
+			{
+				PodRef: stats.PodReference{Namespace: "kube-system", Name: "etcd-minikube"},
+				Containers: []stats.ContainerStats{
+					{
+						Name:   "etcd",
+						CPU:    &stats.CPUStats{UsageCoreNanoSeconds: &cpuTime},
+						Memory: &stats.MemoryStats{WorkingSetBytes: &workingSet},
+					},
+				},
+			},
+			{
+				PodRef: stats.PodReference{Namespace: "kube-system", Name: "kube-proxy-v48tf"},
+				Containers: []stats.ContainerStats{
+					{Name: "kube-proxy"},
+				},
+			},
+		},
+	}
+	require.True(t, HasMissingContainerStats(summary))
+
+	provider := NewStatsProvider(&fakeRestClient{})
+	require.NoError(t, provider.FillMissingContainerStats(summary))
+
+	server := summary.Pods[0].Containers[0]
+	require.NotNil(t, server.CPU)
+	require.Equal(t, uint64(12_500_000_000), *server.CPU.UsageCoreNanoSeconds)
+	require.Equal(t, int64(1667822344107), server.CPU.Time.UnixMilli())
+	require.Nil(t, server.CPU.UsageNanoCores)
+	require.NotNil(t, server.Memory)
+	require.Equal(t, uint64(4194304), *server.Memory.WorkingSetBytes)
+
+	// stats reported in the summary are kept
+	etcd := summary.Pods[1].Containers[0]
+	require.Equal(t, cpuTime, *etcd.CPU.UsageCoreNanoSeconds)
+	require.Equal(t, workingSet, *etcd.Memory.WorkingSetBytes)
+
+	// containers without resource metrics are left as they are
+	kubeProxy := summary.Pods[2].Containers[0]
+	require.Nil(t, kubeProxy.CPU)
+	require.Nil(t, kubeProxy.Memory)
+}
+
+func TestHasMissingContainerStats(t *testing.T) {
+	rc := &fakeRestClient{}
+	summary, err := NewStatsProvider(rc).StatsSummary()
+	require.NoError(t, err)
+	require.False(t, HasMissingContainerStats(summary))
+}
+
+func TestParseContainerResourceMetricsInvalid(t *testing.T) {
+	_, err := parseContainerResourceMetrics([]byte("container_cpu_usage_seconds_total{"))
+	require.Error(t, err)
+}
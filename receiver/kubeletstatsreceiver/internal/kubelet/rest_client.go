@@ -22,12 +22,14 @@ import (
 type RestClient interface {
 	StatsSummary() ([]byte, error)
 	Pods() ([]byte, error)
+	ResourceMetrics() ([]byte, error)
 }
 
 // HTTPRestClient is a thin wrapper around a kubelet client, encapsulating endpoints
 // and their corresponding http methods. The endpoints /stats/container /spec/
 // are excluded because they require cadvisor. The /metrics endpoint is excluded
-// because it returns Prometheus data.
+// because it returns Prometheus data, only /metrics/resource is used to fill gaps
+// in container stats.
 type HTTPRestClient struct {
 	client kube.Client
 }
@@ -43,3 +45,7 @@ func (c *HTTPRestClient) StatsSummary() ([]byte, error) {
 func (c *HTTPRestClient) Pods() ([]byte, error) {
 	return c.client.Get("/pods")
 }
+
+func (c *HTTPRestClient) ResourceMetrics() ([]byte, error) {
+	return c.client.Get("/metrics/resource")
+}
@@ -28,6 +28,8 @@ func TestRestClient(t *testing.T) {
 	require.Equal(t, "/stats/summary", string(resp))
 	resp, _ = rest.Pods()
 	require.Equal(t, "/pods", string(resp))
+	resp, _ = rest.ResourceMetrics()
+	require.Equal(t, "/metrics/resource", string(resp))
 }
 
 var _ kube.Client = (*fakeClient)(nil)
@@ -32,7 +32,7 @@ func addVolumeMetrics(mb *metadata.MetricsBuilder, volumeMetrics metadata.Volume
 	recordIntDataPoint(mb, volumeMetrics.InodesUsed, s.InodesUsed, currentTime)
 }
 
-func getResourcesFromVolume(volume v1.Volume) []metadata.ResourceMetricsOption {
+func getResourcesFromVolume(podName string, volume v1.Volume) []metadata.ResourceMetricsOption {
 	switch {
 	// TODO: Support more types
 	case volume.ConfigMap != nil:
@@ -46,6 +46,12 @@ func getResourcesFromVolume(volume v1.Volume) []metadata.ResourceMetricsOption {
 	case volume.PersistentVolumeClaim != nil:
 		return []metadata.ResourceMetricsOption{metadata.WithK8sVolumeType(labelValuePersistentVolumeClaim),
 			metadata.WithK8sPersistentvolumeclaimName(volume.PersistentVolumeClaim.ClaimName)}
+	case volume.Ephemeral != nil:
+		return []metadata.ResourceMetricsOption{metadata.WithK8sVolumeType(labelValueEphemeralVolume),
+			metadata.WithK8sPersistentvolumeclaimName(ephemeralVolumeClaimName(podName, volume.Name))}
+	case volume.CSI != nil:
+		return []metadata.ResourceMetricsOption{metadata.WithK8sVolumeType(labelValueCSIVolume),
+			metadata.WithCsiDriver(volume.CSI.Driver)}
 	case volume.HostPath != nil:
 		return []metadata.ResourceMetricsOption{metadata.WithK8sVolumeType(labelValueHostPathVolume)}
 	case volume.AWSElasticBlockStore != nil:
@@ -67,6 +73,14 @@ func GetPersistentVolumeLabels(pv v1.PersistentVolumeSource) []metadata.Resource
 		return awsElasticBlockStoreDims(*pv.AWSElasticBlockStore)
 	case pv.GCEPersistentDisk != nil:
 		return gcePersistentDiskDims(*pv.GCEPersistentDisk)
+	case pv.CSI != nil:
+		return []metadata.ResourceMetricsOption{
+			metadata.WithK8sVolumeType(labelValueCSIVolume),
+			// CSI specific labels.
+			metadata.WithCsiDriver(pv.CSI.Driver),
+			metadata.WithCsiVolumeHandle(pv.CSI.VolumeHandle),
+			metadata.WithFsType(pv.CSI.FSType),
+		}
 	case pv.Glusterfs != nil:
 		// pv.Glusterfs is a GlusterfsPersistentVolumeSource instead of GlusterfsVolumeSource,
 		// convert to GlusterfsVolumeSource so a single method can handle both structs. This
@@ -81,6 +95,22 @@ func GetPersistentVolumeLabels(pv v1.PersistentVolumeSource) []metadata.Resource
 	return nil
 }
 
+// volumeClaimName returns the name of the Persistent Volume Claim backing the volume, if any.
+func volumeClaimName(podName string, volume v1.Volume) string {
+	switch {
+	case volume.PersistentVolumeClaim != nil:
+		return volume.PersistentVolumeClaim.ClaimName
+	case volume.Ephemeral != nil:
+		return ephemeralVolumeClaimName(podName, volume.Name)
+	}
+	return ""
+}
+
+// ephemeralVolumeClaimName returns the name of the claim Kubernetes creates for a generic ephemeral volume.
+func ephemeralVolumeClaimName(podName string, volumeName string) string {
+	return podName + "-" + volumeName
+}
+
 func awsElasticBlockStoreDims(vs v1.AWSElasticBlockStoreVolumeSource) []metadata.ResourceMetricsOption {
 	return []metadata.ResourceMetricsOption{
 		metadata.WithK8sVolumeType(labelValueAWSEBSVolume),
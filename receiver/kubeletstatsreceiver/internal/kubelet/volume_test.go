@@ -17,6 +17,7 @@ package kubelet
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	v1 "k8s.io/api/core/v1"
@@ -40,7 +41,7 @@ func TestDetailedPVCLabels(t *testing.T) {
 		volumeName                      string
 		volumeSource                    v1.VolumeSource
 		pod                             pod
-		detailedPVCLabelsSetterOverride func(volCacheID, volumeClaim, namespace string) ([]metadata.ResourceMetricsOption, error)
+		detailedPVCLabelsSetterOverride func(volCacheID, volumeClaim, namespace string) (*VolumeClaimDetails, error)
 		want                            map[string]interface{}
 	}{
 		{
@@ -52,7 +53,7 @@ func TestDetailedPVCLabels(t *testing.T) {
 				},
 			},
 			pod: pod{uid: "uid-1234", name: "pod-name", namespace: "pod-namespace"},
-			detailedPVCLabelsSetterOverride: func(volCacheID, volumeClaim, namespace string) ([]metadata.ResourceMetricsOption, error) {
+			detailedPVCLabelsSetterOverride: func(volCacheID, volumeClaim, namespace string) (*VolumeClaimDetails, error) {
 				ro := GetPersistentVolumeLabels(v1.PersistentVolumeSource{
 					AWSElasticBlockStore: &v1.AWSElasticBlockStoreVolumeSource{
 						VolumeID:  "volume_id",
@@ -60,7 +61,7 @@ func TestDetailedPVCLabels(t *testing.T) {
 						Partition: 10,
 					},
 				})
-				return ro, nil
+				return &VolumeClaimDetails{ResourceOptions: ro}, nil
 			},
 			want: map[string]interface{}{
 				"k8s.volume.name":                "volume0",
@@ -83,7 +84,7 @@ func TestDetailedPVCLabels(t *testing.T) {
 				},
 			},
 			pod: pod{uid: "uid-1234", name: "pod-name", namespace: "pod-namespace"},
-			detailedPVCLabelsSetterOverride: func(volCacheID, volumeClaim, namespace string) ([]metadata.ResourceMetricsOption, error) {
+			detailedPVCLabelsSetterOverride: func(volCacheID, volumeClaim, namespace string) (*VolumeClaimDetails, error) {
 				ro := GetPersistentVolumeLabels(v1.PersistentVolumeSource{
 					GCEPersistentDisk: &v1.GCEPersistentDiskVolumeSource{
 						PDName:    "pd_name",
@@ -91,7 +92,7 @@ func TestDetailedPVCLabels(t *testing.T) {
 						Partition: 10,
 					},
 				})
-				return ro, nil
+				return &VolumeClaimDetails{ResourceOptions: ro}, nil
 			},
 			want: map[string]interface{}{
 				"k8s.volume.name":                "volume0",
@@ -114,14 +115,14 @@ func TestDetailedPVCLabels(t *testing.T) {
 				},
 			},
 			pod: pod{uid: "uid-1234", name: "pod-name", namespace: "pod-namespace"},
-			detailedPVCLabelsSetterOverride: func(volCacheID, volumeClaim, namespace string) ([]metadata.ResourceMetricsOption, error) {
+			detailedPVCLabelsSetterOverride: func(volCacheID, volumeClaim, namespace string) (*VolumeClaimDetails, error) {
 				ro := GetPersistentVolumeLabels(v1.PersistentVolumeSource{
 					Glusterfs: &v1.GlusterfsPersistentVolumeSource{
 						EndpointsName: "endpoints_name",
 						Path:          "path",
 					},
 				})
-				return ro, nil
+				return &VolumeClaimDetails{ResourceOptions: ro}, nil
 			},
 			want: map[string]interface{}{
 				"k8s.volume.name":                "volume0",
@@ -143,13 +144,13 @@ func TestDetailedPVCLabels(t *testing.T) {
 				},
 			},
 			pod: pod{uid: "uid-1234", name: "pod-name", namespace: "pod-namespace"},
-			detailedPVCLabelsSetterOverride: func(volCacheID, volumeClaim, namespace string) ([]metadata.ResourceMetricsOption, error) {
+			detailedPVCLabelsSetterOverride: func(volCacheID, volumeClaim, namespace string) (*VolumeClaimDetails, error) {
 				ro := GetPersistentVolumeLabels(v1.PersistentVolumeSource{
 					Local: &v1.LocalVolumeSource{
 						Path: "path",
 					},
 				})
-				return ro, nil
+				return &VolumeClaimDetails{ResourceOptions: ro}, nil
 			},
 			want: map[string]interface{}{
 				"k8s.volume.name":                "volume0",
@@ -160,6 +161,38 @@ func TestDetailedPVCLabels(t *testing.T) {
 				"k8s.namespace.name":             "pod-namespace",
 			},
 		},
+		{
+			name:       "ephemeral - with detailed PVC labels (CSI)",
+			volumeName: "volume0",
+			volumeSource: v1.VolumeSource{
+				Ephemeral: &v1.EphemeralVolumeSource{},
+			},
+			pod: pod{uid: "uid-1234", name: "pod-name", namespace: "pod-namespace"},
+			detailedPVCLabelsSetterOverride: func(volCacheID, volumeClaim, namespace string) (*VolumeClaimDetails, error) {
+				assert.Equal(t, "pod-name-volume0", volumeClaim)
+				ro := GetPersistentVolumeLabels(v1.PersistentVolumeSource{
+					CSI: &v1.CSIPersistentVolumeSource{
+						Driver:       "ebs.csi.aws.com",
+						VolumeHandle: "vol-0123",
+						FSType:       "ext4",
+					},
+				})
+				ro = append(ro, metadata.WithK8sStorageclassName("gp3"))
+				return &VolumeClaimDetails{ResourceOptions: ro}, nil
+			},
+			want: map[string]interface{}{
+				"k8s.volume.name":                "volume0",
+				"k8s.volume.type":                "csi",
+				"csi.driver":                     "ebs.csi.aws.com",
+				"csi.volume.handle":              "vol-0123",
+				"fs.type":                        "ext4",
+				"k8s.storageclass.name":          "gp3",
+				"k8s.persistentvolumeclaim.name": "pod-name-volume0",
+				"k8s.pod.uid":                    "uid-1234",
+				"k8s.pod.name":                   "pod-name",
+				"k8s.namespace.name":             "pod-namespace",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -37,48 +37,49 @@ func (ms *MetricSettings) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsSettings provides settings for kubeletstatsreceiver metrics.
 type MetricsSettings struct {
-	ContainerCPUTime               MetricSettings `mapstructure:"container.cpu.time"`
-	ContainerCPUUtilization        MetricSettings `mapstructure:"container.cpu.utilization"`
-	ContainerFilesystemAvailable   MetricSettings `mapstructure:"container.filesystem.available"`
-	ContainerFilesystemCapacity    MetricSettings `mapstructure:"container.filesystem.capacity"`
-	ContainerFilesystemUsage       MetricSettings `mapstructure:"container.filesystem.usage"`
-	ContainerMemoryAvailable       MetricSettings `mapstructure:"container.memory.available"`
-	ContainerMemoryMajorPageFaults MetricSettings `mapstructure:"container.memory.major_page_faults"`
-	ContainerMemoryPageFaults      MetricSettings `mapstructure:"container.memory.page_faults"`
-	ContainerMemoryRss             MetricSettings `mapstructure:"container.memory.rss"`
-	ContainerMemoryUsage           MetricSettings `mapstructure:"container.memory.usage"`
-	ContainerMemoryWorkingSet      MetricSettings `mapstructure:"container.memory.working_set"`
-	K8sNodeCPUTime                 MetricSettings `mapstructure:"k8s.node.cpu.time"`
-	K8sNodeCPUUtilization          MetricSettings `mapstructure:"k8s.node.cpu.utilization"`
-	K8sNodeFilesystemAvailable     MetricSettings `mapstructure:"k8s.node.filesystem.available"`
-	K8sNodeFilesystemCapacity      MetricSettings `mapstructure:"k8s.node.filesystem.capacity"`
-	K8sNodeFilesystemUsage         MetricSettings `mapstructure:"k8s.node.filesystem.usage"`
-	K8sNodeMemoryAvailable         MetricSettings `mapstructure:"k8s.node.memory.available"`
-	K8sNodeMemoryMajorPageFaults   MetricSettings `mapstructure:"k8s.node.memory.major_page_faults"`
-	K8sNodeMemoryPageFaults        MetricSettings `mapstructure:"k8s.node.memory.page_faults"`
-	K8sNodeMemoryRss               MetricSettings `mapstructure:"k8s.node.memory.rss"`
-	K8sNodeMemoryUsage             MetricSettings `mapstructure:"k8s.node.memory.usage"`
-	K8sNodeMemoryWorkingSet        MetricSettings `mapstructure:"k8s.node.memory.working_set"`
-	K8sNodeNetworkErrors           MetricSettings `mapstructure:"k8s.node.network.errors"`
-	K8sNodeNetworkIo               MetricSettings `mapstructure:"k8s.node.network.io"`
-	K8sPodCPUTime                  MetricSettings `mapstructure:"k8s.pod.cpu.time"`
-	K8sPodCPUUtilization           MetricSettings `mapstructure:"k8s.pod.cpu.utilization"`
-	K8sPodFilesystemAvailable      MetricSettings `mapstructure:"k8s.pod.filesystem.available"`
-	K8sPodFilesystemCapacity       MetricSettings `mapstructure:"k8s.pod.filesystem.capacity"`
-	K8sPodFilesystemUsage          MetricSettings `mapstructure:"k8s.pod.filesystem.usage"`
-	K8sPodMemoryAvailable          MetricSettings `mapstructure:"k8s.pod.memory.available"`
-	K8sPodMemoryMajorPageFaults    MetricSettings `mapstructure:"k8s.pod.memory.major_page_faults"`
-	K8sPodMemoryPageFaults         MetricSettings `mapstructure:"k8s.pod.memory.page_faults"`
-	K8sPodMemoryRss                MetricSettings `mapstructure:"k8s.pod.memory.rss"`
-	K8sPodMemoryUsage              MetricSettings `mapstructure:"k8s.pod.memory.usage"`
-	K8sPodMemoryWorkingSet         MetricSettings `mapstructure:"k8s.pod.memory.working_set"`
-	K8sPodNetworkErrors            MetricSettings `mapstructure:"k8s.pod.network.errors"`
-	K8sPodNetworkIo                MetricSettings `mapstructure:"k8s.pod.network.io"`
-	K8sVolumeAvailable             MetricSettings `mapstructure:"k8s.volume.available"`
-	K8sVolumeCapacity              MetricSettings `mapstructure:"k8s.volume.capacity"`
-	K8sVolumeInodes                MetricSettings `mapstructure:"k8s.volume.inodes"`
-	K8sVolumeInodesFree            MetricSettings `mapstructure:"k8s.volume.inodes.free"`
-	K8sVolumeInodesUsed            MetricSettings `mapstructure:"k8s.volume.inodes.used"`
+	ContainerCPUTime                 MetricSettings `mapstructure:"container.cpu.time"`
+	ContainerCPUUtilization          MetricSettings `mapstructure:"container.cpu.utilization"`
+	ContainerFilesystemAvailable     MetricSettings `mapstructure:"container.filesystem.available"`
+	ContainerFilesystemCapacity      MetricSettings `mapstructure:"container.filesystem.capacity"`
+	ContainerFilesystemUsage         MetricSettings `mapstructure:"container.filesystem.usage"`
+	ContainerMemoryAvailable         MetricSettings `mapstructure:"container.memory.available"`
+	ContainerMemoryMajorPageFaults   MetricSettings `mapstructure:"container.memory.major_page_faults"`
+	ContainerMemoryPageFaults        MetricSettings `mapstructure:"container.memory.page_faults"`
+	ContainerMemoryRss               MetricSettings `mapstructure:"container.memory.rss"`
+	ContainerMemoryUsage             MetricSettings `mapstructure:"container.memory.usage"`
+	ContainerMemoryWorkingSet        MetricSettings `mapstructure:"container.memory.working_set"`
+	K8sNodeCPUTime                   MetricSettings `mapstructure:"k8s.node.cpu.time"`
+	K8sNodeCPUUtilization            MetricSettings `mapstructure:"k8s.node.cpu.utilization"`
+	K8sNodeFilesystemAvailable       MetricSettings `mapstructure:"k8s.node.filesystem.available"`
+	K8sNodeFilesystemCapacity        MetricSettings `mapstructure:"k8s.node.filesystem.capacity"`
+	K8sNodeFilesystemUsage           MetricSettings `mapstructure:"k8s.node.filesystem.usage"`
+	K8sNodeMemoryAvailable           MetricSettings `mapstructure:"k8s.node.memory.available"`
+	K8sNodeMemoryMajorPageFaults     MetricSettings `mapstructure:"k8s.node.memory.major_page_faults"`
+	K8sNodeMemoryPageFaults          MetricSettings `mapstructure:"k8s.node.memory.page_faults"`
+	K8sNodeMemoryRss                 MetricSettings `mapstructure:"k8s.node.memory.rss"`
+	K8sNodeMemoryUsage               MetricSettings `mapstructure:"k8s.node.memory.usage"`
+	K8sNodeMemoryWorkingSet          MetricSettings `mapstructure:"k8s.node.memory.working_set"`
+	K8sNodeNetworkErrors             MetricSettings `mapstructure:"k8s.node.network.errors"`
+	K8sNodeNetworkIo                 MetricSettings `mapstructure:"k8s.node.network.io"`
+	K8sPersistentvolumeclaimCapacity MetricSettings `mapstructure:"k8s.persistentvolumeclaim.capacity"`
+	K8sPodCPUTime                    MetricSettings `mapstructure:"k8s.pod.cpu.time"`
+	K8sPodCPUUtilization             MetricSettings `mapstructure:"k8s.pod.cpu.utilization"`
+	K8sPodFilesystemAvailable        MetricSettings `mapstructure:"k8s.pod.filesystem.available"`
+	K8sPodFilesystemCapacity         MetricSettings `mapstructure:"k8s.pod.filesystem.capacity"`
+	K8sPodFilesystemUsage            MetricSettings `mapstructure:"k8s.pod.filesystem.usage"`
+	K8sPodMemoryAvailable            MetricSettings `mapstructure:"k8s.pod.memory.available"`
+	K8sPodMemoryMajorPageFaults      MetricSettings `mapstructure:"k8s.pod.memory.major_page_faults"`
+	K8sPodMemoryPageFaults           MetricSettings `mapstructure:"k8s.pod.memory.page_faults"`
+	K8sPodMemoryRss                  MetricSettings `mapstructure:"k8s.pod.memory.rss"`
+	K8sPodMemoryUsage                MetricSettings `mapstructure:"k8s.pod.memory.usage"`
+	K8sPodMemoryWorkingSet           MetricSettings `mapstructure:"k8s.pod.memory.working_set"`
+	K8sPodNetworkErrors              MetricSettings `mapstructure:"k8s.pod.network.errors"`
+	K8sPodNetworkIo                  MetricSettings `mapstructure:"k8s.pod.network.io"`
+	K8sVolumeAvailable               MetricSettings `mapstructure:"k8s.volume.available"`
+	K8sVolumeCapacity                MetricSettings `mapstructure:"k8s.volume.capacity"`
+	K8sVolumeInodes                  MetricSettings `mapstructure:"k8s.volume.inodes"`
+	K8sVolumeInodesFree              MetricSettings `mapstructure:"k8s.volume.inodes.free"`
+	K8sVolumeInodesUsed              MetricSettings `mapstructure:"k8s.volume.inodes.used"`
 }
 
 func DefaultMetricsSettings() MetricsSettings {
@@ -155,6 +156,9 @@ func DefaultMetricsSettings() MetricsSettings {
 		K8sNodeNetworkIo: MetricSettings{
 			Enabled: true,
 		},
+		K8sPersistentvolumeclaimCapacity: MetricSettings{
+			Enabled: true,
+		},
 		K8sPodCPUTime: MetricSettings{
 			Enabled: true,
 		},
@@ -1428,6 +1432,55 @@ func newMetricK8sNodeNetworkIo(settings MetricSettings) metricK8sNodeNetworkIo {
 	return m
 }
 
+type metricK8sPersistentvolumeclaimCapacity struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.persistentvolumeclaim.capacity metric with initial data.
+func (m *metricK8sPersistentvolumeclaimCapacity) init() {
+	m.data.SetName("k8s.persistentvolumeclaim.capacity")
+	m.data.SetDescription("The storage capacity in bytes provisioned for the Persistent Volume Claim, as reported by the API server.")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sPersistentvolumeclaimCapacity) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPersistentvolumeclaimCapacity) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPersistentvolumeclaimCapacity) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPersistentvolumeclaimCapacity(settings MetricSettings) metricK8sPersistentvolumeclaimCapacity {
+	m := metricK8sPersistentvolumeclaimCapacity{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricK8sPodCPUTime struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -2325,53 +2378,54 @@ func newMetricK8sVolumeInodesUsed(settings MetricSettings) metricK8sVolumeInodes
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user settings.
 type MetricsBuilder struct {
-	startTime                            pcommon.Timestamp   // start time that will be applied to all recorded data points.
-	metricsCapacity                      int                 // maximum observed number of metrics per resource.
-	resourceCapacity                     int                 // maximum observed number of resource attributes.
-	metricsBuffer                        pmetric.Metrics     // accumulates metrics data before emitting.
-	buildInfo                            component.BuildInfo // contains version information
-	metricContainerCPUTime               metricContainerCPUTime
-	metricContainerCPUUtilization        metricContainerCPUUtilization
-	metricContainerFilesystemAvailable   metricContainerFilesystemAvailable
-	metricContainerFilesystemCapacity    metricContainerFilesystemCapacity
-	metricContainerFilesystemUsage       metricContainerFilesystemUsage
-	metricContainerMemoryAvailable       metricContainerMemoryAvailable
-	metricContainerMemoryMajorPageFaults metricContainerMemoryMajorPageFaults
-	metricContainerMemoryPageFaults      metricContainerMemoryPageFaults
-	metricContainerMemoryRss             metricContainerMemoryRss
-	metricContainerMemoryUsage           metricContainerMemoryUsage
-	metricContainerMemoryWorkingSet      metricContainerMemoryWorkingSet
-	metricK8sNodeCPUTime                 metricK8sNodeCPUTime
-	metricK8sNodeCPUUtilization          metricK8sNodeCPUUtilization
-	metricK8sNodeFilesystemAvailable     metricK8sNodeFilesystemAvailable
-	metricK8sNodeFilesystemCapacity      metricK8sNodeFilesystemCapacity
-	metricK8sNodeFilesystemUsage         metricK8sNodeFilesystemUsage
-	metricK8sNodeMemoryAvailable         metricK8sNodeMemoryAvailable
-	metricK8sNodeMemoryMajorPageFaults   metricK8sNodeMemoryMajorPageFaults
-	metricK8sNodeMemoryPageFaults        metricK8sNodeMemoryPageFaults
-	metricK8sNodeMemoryRss               metricK8sNodeMemoryRss
-	metricK8sNodeMemoryUsage             metricK8sNodeMemoryUsage
-	metricK8sNodeMemoryWorkingSet        metricK8sNodeMemoryWorkingSet
-	metricK8sNodeNetworkErrors           metricK8sNodeNetworkErrors
-	metricK8sNodeNetworkIo               metricK8sNodeNetworkIo
-	metricK8sPodCPUTime                  metricK8sPodCPUTime
-	metricK8sPodCPUUtilization           metricK8sPodCPUUtilization
-	metricK8sPodFilesystemAvailable      metricK8sPodFilesystemAvailable
-	metricK8sPodFilesystemCapacity       metricK8sPodFilesystemCapacity
-	metricK8sPodFilesystemUsage          metricK8sPodFilesystemUsage
-	metricK8sPodMemoryAvailable          metricK8sPodMemoryAvailable
-	metricK8sPodMemoryMajorPageFaults    metricK8sPodMemoryMajorPageFaults
-	metricK8sPodMemoryPageFaults         metricK8sPodMemoryPageFaults
-	metricK8sPodMemoryRss                metricK8sPodMemoryRss
-	metricK8sPodMemoryUsage              metricK8sPodMemoryUsage
-	metricK8sPodMemoryWorkingSet         metricK8sPodMemoryWorkingSet
-	metricK8sPodNetworkErrors            metricK8sPodNetworkErrors
-	metricK8sPodNetworkIo                metricK8sPodNetworkIo
-	metricK8sVolumeAvailable             metricK8sVolumeAvailable
-	metricK8sVolumeCapacity              metricK8sVolumeCapacity
-	metricK8sVolumeInodes                metricK8sVolumeInodes
-	metricK8sVolumeInodesFree            metricK8sVolumeInodesFree
-	metricK8sVolumeInodesUsed            metricK8sVolumeInodesUsed
+	startTime                              pcommon.Timestamp   // start time that will be applied to all recorded data points.
+	metricsCapacity                        int                 // maximum observed number of metrics per resource.
+	resourceCapacity                       int                 // maximum observed number of resource attributes.
+	metricsBuffer                          pmetric.Metrics     // accumulates metrics data before emitting.
+	buildInfo                              component.BuildInfo // contains version information
+	metricContainerCPUTime                 metricContainerCPUTime
+	metricContainerCPUUtilization          metricContainerCPUUtilization
+	metricContainerFilesystemAvailable     metricContainerFilesystemAvailable
+	metricContainerFilesystemCapacity      metricContainerFilesystemCapacity
+	metricContainerFilesystemUsage         metricContainerFilesystemUsage
+	metricContainerMemoryAvailable         metricContainerMemoryAvailable
+	metricContainerMemoryMajorPageFaults   metricContainerMemoryMajorPageFaults
+	metricContainerMemoryPageFaults        metricContainerMemoryPageFaults
+	metricContainerMemoryRss               metricContainerMemoryRss
+	metricContainerMemoryUsage             metricContainerMemoryUsage
+	metricContainerMemoryWorkingSet        metricContainerMemoryWorkingSet
+	metricK8sNodeCPUTime                   metricK8sNodeCPUTime
+	metricK8sNodeCPUUtilization            metricK8sNodeCPUUtilization
+	metricK8sNodeFilesystemAvailable       metricK8sNodeFilesystemAvailable
+	metricK8sNodeFilesystemCapacity        metricK8sNodeFilesystemCapacity
+	metricK8sNodeFilesystemUsage           metricK8sNodeFilesystemUsage
+	metricK8sNodeMemoryAvailable           metricK8sNodeMemoryAvailable
+	metricK8sNodeMemoryMajorPageFaults     metricK8sNodeMemoryMajorPageFaults
+	metricK8sNodeMemoryPageFaults          metricK8sNodeMemoryPageFaults
+	metricK8sNodeMemoryRss                 metricK8sNodeMemoryRss
+	metricK8sNodeMemoryUsage               metricK8sNodeMemoryUsage
+	metricK8sNodeMemoryWorkingSet          metricK8sNodeMemoryWorkingSet
+	metricK8sNodeNetworkErrors             metricK8sNodeNetworkErrors
+	metricK8sNodeNetworkIo                 metricK8sNodeNetworkIo
+	metricK8sPersistentvolumeclaimCapacity metricK8sPersistentvolumeclaimCapacity
+	metricK8sPodCPUTime                    metricK8sPodCPUTime
+	metricK8sPodCPUUtilization             metricK8sPodCPUUtilization
+	metricK8sPodFilesystemAvailable        metricK8sPodFilesystemAvailable
+	metricK8sPodFilesystemCapacity         metricK8sPodFilesystemCapacity
+	metricK8sPodFilesystemUsage            metricK8sPodFilesystemUsage
+	metricK8sPodMemoryAvailable            metricK8sPodMemoryAvailable
+	metricK8sPodMemoryMajorPageFaults      metricK8sPodMemoryMajorPageFaults
+	metricK8sPodMemoryPageFaults           metricK8sPodMemoryPageFaults
+	metricK8sPodMemoryRss                  metricK8sPodMemoryRss
+	metricK8sPodMemoryUsage                metricK8sPodMemoryUsage
+	metricK8sPodMemoryWorkingSet           metricK8sPodMemoryWorkingSet
+	metricK8sPodNetworkErrors              metricK8sPodNetworkErrors
+	metricK8sPodNetworkIo                  metricK8sPodNetworkIo
+	metricK8sVolumeAvailable               metricK8sVolumeAvailable
+	metricK8sVolumeCapacity                metricK8sVolumeCapacity
+	metricK8sVolumeInodes                  metricK8sVolumeInodes
+	metricK8sVolumeInodesFree              metricK8sVolumeInodesFree
+	metricK8sVolumeInodesUsed              metricK8sVolumeInodesUsed
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -2386,51 +2440,52 @@ func WithStartTime(startTime pcommon.Timestamp) metricBuilderOption {
 
 func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo, options ...metricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		startTime:                            pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                        pmetric.NewMetrics(),
-		buildInfo:                            buildInfo,
-		metricContainerCPUTime:               newMetricContainerCPUTime(settings.ContainerCPUTime),
-		metricContainerCPUUtilization:        newMetricContainerCPUUtilization(settings.ContainerCPUUtilization),
-		metricContainerFilesystemAvailable:   newMetricContainerFilesystemAvailable(settings.ContainerFilesystemAvailable),
-		metricContainerFilesystemCapacity:    newMetricContainerFilesystemCapacity(settings.ContainerFilesystemCapacity),
-		metricContainerFilesystemUsage:       newMetricContainerFilesystemUsage(settings.ContainerFilesystemUsage),
-		metricContainerMemoryAvailable:       newMetricContainerMemoryAvailable(settings.ContainerMemoryAvailable),
-		metricContainerMemoryMajorPageFaults: newMetricContainerMemoryMajorPageFaults(settings.ContainerMemoryMajorPageFaults),
-		metricContainerMemoryPageFaults:      newMetricContainerMemoryPageFaults(settings.ContainerMemoryPageFaults),
-		metricContainerMemoryRss:             newMetricContainerMemoryRss(settings.ContainerMemoryRss),
-		metricContainerMemoryUsage:           newMetricContainerMemoryUsage(settings.ContainerMemoryUsage),
-		metricContainerMemoryWorkingSet:      newMetricContainerMemoryWorkingSet(settings.ContainerMemoryWorkingSet),
-		metricK8sNodeCPUTime:                 newMetricK8sNodeCPUTime(settings.K8sNodeCPUTime),
-		metricK8sNodeCPUUtilization:          newMetricK8sNodeCPUUtilization(settings.K8sNodeCPUUtilization),
-		metricK8sNodeFilesystemAvailable:     newMetricK8sNodeFilesystemAvailable(settings.K8sNodeFilesystemAvailable),
-		metricK8sNodeFilesystemCapacity:      newMetricK8sNodeFilesystemCapacity(settings.K8sNodeFilesystemCapacity),
-		metricK8sNodeFilesystemUsage:         newMetricK8sNodeFilesystemUsage(settings.K8sNodeFilesystemUsage),
-		metricK8sNodeMemoryAvailable:         newMetricK8sNodeMemoryAvailable(settings.K8sNodeMemoryAvailable),
-		metricK8sNodeMemoryMajorPageFaults:   newMetricK8sNodeMemoryMajorPageFaults(settings.K8sNodeMemoryMajorPageFaults),
-		metricK8sNodeMemoryPageFaults:        newMetricK8sNodeMemoryPageFaults(settings.K8sNodeMemoryPageFaults),
-		metricK8sNodeMemoryRss:               newMetricK8sNodeMemoryRss(settings.K8sNodeMemoryRss),
-		metricK8sNodeMemoryUsage:             newMetricK8sNodeMemoryUsage(settings.K8sNodeMemoryUsage),
-		metricK8sNodeMemoryWorkingSet:        newMetricK8sNodeMemoryWorkingSet(settings.K8sNodeMemoryWorkingSet),
-		metricK8sNodeNetworkErrors:           newMetricK8sNodeNetworkErrors(settings.K8sNodeNetworkErrors),
-		metricK8sNodeNetworkIo:               newMetricK8sNodeNetworkIo(settings.K8sNodeNetworkIo),
-		metricK8sPodCPUTime:                  newMetricK8sPodCPUTime(settings.K8sPodCPUTime),
-		metricK8sPodCPUUtilization:           newMetricK8sPodCPUUtilization(settings.K8sPodCPUUtilization),
-		metricK8sPodFilesystemAvailable:      newMetricK8sPodFilesystemAvailable(settings.K8sPodFilesystemAvailable),
-		metricK8sPodFilesystemCapacity:       newMetricK8sPodFilesystemCapacity(settings.K8sPodFilesystemCapacity),
-		metricK8sPodFilesystemUsage:          newMetricK8sPodFilesystemUsage(settings.K8sPodFilesystemUsage),
-		metricK8sPodMemoryAvailable:          newMetricK8sPodMemoryAvailable(settings.K8sPodMemoryAvailable),
-		metricK8sPodMemoryMajorPageFaults:    newMetricK8sPodMemoryMajorPageFaults(settings.K8sPodMemoryMajorPageFaults),
-		metricK8sPodMemoryPageFaults:         newMetricK8sPodMemoryPageFaults(settings.K8sPodMemoryPageFaults),
-		metricK8sPodMemoryRss:                newMetricK8sPodMemoryRss(settings.K8sPodMemoryRss),
-		metricK8sPodMemoryUsage:              newMetricK8sPodMemoryUsage(settings.K8sPodMemoryUsage),
-		metricK8sPodMemoryWorkingSet:         newMetricK8sPodMemoryWorkingSet(settings.K8sPodMemoryWorkingSet),
-		metricK8sPodNetworkErrors:            newMetricK8sPodNetworkErrors(settings.K8sPodNetworkErrors),
-		metricK8sPodNetworkIo:                newMetricK8sPodNetworkIo(settings.K8sPodNetworkIo),
-		metricK8sVolumeAvailable:             newMetricK8sVolumeAvailable(settings.K8sVolumeAvailable),
-		metricK8sVolumeCapacity:              newMetricK8sVolumeCapacity(settings.K8sVolumeCapacity),
-		metricK8sVolumeInodes:                newMetricK8sVolumeInodes(settings.K8sVolumeInodes),
-		metricK8sVolumeInodesFree:            newMetricK8sVolumeInodesFree(settings.K8sVolumeInodesFree),
-		metricK8sVolumeInodesUsed:            newMetricK8sVolumeInodesUsed(settings.K8sVolumeInodesUsed),
+		startTime:                              pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                          pmetric.NewMetrics(),
+		buildInfo:                              buildInfo,
+		metricContainerCPUTime:                 newMetricContainerCPUTime(settings.ContainerCPUTime),
+		metricContainerCPUUtilization:          newMetricContainerCPUUtilization(settings.ContainerCPUUtilization),
+		metricContainerFilesystemAvailable:     newMetricContainerFilesystemAvailable(settings.ContainerFilesystemAvailable),
+		metricContainerFilesystemCapacity:      newMetricContainerFilesystemCapacity(settings.ContainerFilesystemCapacity),
+		metricContainerFilesystemUsage:         newMetricContainerFilesystemUsage(settings.ContainerFilesystemUsage),
+		metricContainerMemoryAvailable:         newMetricContainerMemoryAvailable(settings.ContainerMemoryAvailable),
+		metricContainerMemoryMajorPageFaults:   newMetricContainerMemoryMajorPageFaults(settings.ContainerMemoryMajorPageFaults),
+		metricContainerMemoryPageFaults:        newMetricContainerMemoryPageFaults(settings.ContainerMemoryPageFaults),
+		metricContainerMemoryRss:               newMetricContainerMemoryRss(settings.ContainerMemoryRss),
+		metricContainerMemoryUsage:             newMetricContainerMemoryUsage(settings.ContainerMemoryUsage),
+		metricContainerMemoryWorkingSet:        newMetricContainerMemoryWorkingSet(settings.ContainerMemoryWorkingSet),
+		metricK8sNodeCPUTime:                   newMetricK8sNodeCPUTime(settings.K8sNodeCPUTime),
+		metricK8sNodeCPUUtilization:            newMetricK8sNodeCPUUtilization(settings.K8sNodeCPUUtilization),
+		metricK8sNodeFilesystemAvailable:       newMetricK8sNodeFilesystemAvailable(settings.K8sNodeFilesystemAvailable),
+		metricK8sNodeFilesystemCapacity:        newMetricK8sNodeFilesystemCapacity(settings.K8sNodeFilesystemCapacity),
+		metricK8sNodeFilesystemUsage:           newMetricK8sNodeFilesystemUsage(settings.K8sNodeFilesystemUsage),
+		metricK8sNodeMemoryAvailable:           newMetricK8sNodeMemoryAvailable(settings.K8sNodeMemoryAvailable),
+		metricK8sNodeMemoryMajorPageFaults:     newMetricK8sNodeMemoryMajorPageFaults(settings.K8sNodeMemoryMajorPageFaults),
+		metricK8sNodeMemoryPageFaults:          newMetricK8sNodeMemoryPageFaults(settings.K8sNodeMemoryPageFaults),
+		metricK8sNodeMemoryRss:                 newMetricK8sNodeMemoryRss(settings.K8sNodeMemoryRss),
+		metricK8sNodeMemoryUsage:               newMetricK8sNodeMemoryUsage(settings.K8sNodeMemoryUsage),
+		metricK8sNodeMemoryWorkingSet:          newMetricK8sNodeMemoryWorkingSet(settings.K8sNodeMemoryWorkingSet),
+		metricK8sNodeNetworkErrors:             newMetricK8sNodeNetworkErrors(settings.K8sNodeNetworkErrors),
+		metricK8sNodeNetworkIo:                 newMetricK8sNodeNetworkIo(settings.K8sNodeNetworkIo),
+		metricK8sPersistentvolumeclaimCapacity: newMetricK8sPersistentvolumeclaimCapacity(settings.K8sPersistentvolumeclaimCapacity),
+		metricK8sPodCPUTime:                    newMetricK8sPodCPUTime(settings.K8sPodCPUTime),
+		metricK8sPodCPUUtilization:             newMetricK8sPodCPUUtilization(settings.K8sPodCPUUtilization),
+		metricK8sPodFilesystemAvailable:        newMetricK8sPodFilesystemAvailable(settings.K8sPodFilesystemAvailable),
+		metricK8sPodFilesystemCapacity:         newMetricK8sPodFilesystemCapacity(settings.K8sPodFilesystemCapacity),
+		metricK8sPodFilesystemUsage:            newMetricK8sPodFilesystemUsage(settings.K8sPodFilesystemUsage),
+		metricK8sPodMemoryAvailable:            newMetricK8sPodMemoryAvailable(settings.K8sPodMemoryAvailable),
+		metricK8sPodMemoryMajorPageFaults:      newMetricK8sPodMemoryMajorPageFaults(settings.K8sPodMemoryMajorPageFaults),
+		metricK8sPodMemoryPageFaults:           newMetricK8sPodMemoryPageFaults(settings.K8sPodMemoryPageFaults),
+		metricK8sPodMemoryRss:                  newMetricK8sPodMemoryRss(settings.K8sPodMemoryRss),
+		metricK8sPodMemoryUsage:                newMetricK8sPodMemoryUsage(settings.K8sPodMemoryUsage),
+		metricK8sPodMemoryWorkingSet:           newMetricK8sPodMemoryWorkingSet(settings.K8sPodMemoryWorkingSet),
+		metricK8sPodNetworkErrors:              newMetricK8sPodNetworkErrors(settings.K8sPodNetworkErrors),
+		metricK8sPodNetworkIo:                  newMetricK8sPodNetworkIo(settings.K8sPodNetworkIo),
+		metricK8sVolumeAvailable:               newMetricK8sVolumeAvailable(settings.K8sVolumeAvailable),
+		metricK8sVolumeCapacity:                newMetricK8sVolumeCapacity(settings.K8sVolumeCapacity),
+		metricK8sVolumeInodes:                  newMetricK8sVolumeInodes(settings.K8sVolumeInodes),
+		metricK8sVolumeInodesFree:              newMetricK8sVolumeInodesFree(settings.K8sVolumeInodesFree),
+		metricK8sVolumeInodesUsed:              newMetricK8sVolumeInodesUsed(settings.K8sVolumeInodesUsed),
 	}
 	for _, op := range options {
 		op(mb)
@@ -2465,6 +2520,20 @@ func WithContainerID(val string) ResourceMetricsOption {
 	}
 }
 
+// WithCsiDriver sets provided value as "csi.driver" attribute for current resource.
+func WithCsiDriver(val string) ResourceMetricsOption {
+	return func(rm pmetric.ResourceMetrics) {
+		rm.Resource().Attributes().PutStr("csi.driver", val)
+	}
+}
+
+// WithCsiVolumeHandle sets provided value as "csi.volume.handle" attribute for current resource.
+func WithCsiVolumeHandle(val string) ResourceMetricsOption {
+	return func(rm pmetric.ResourceMetrics) {
+		rm.Resource().Attributes().PutStr("csi.volume.handle", val)
+	}
+}
+
 // WithFsType sets provided value as "fs.type" attribute for current resource.
 func WithFsType(val string) ResourceMetricsOption {
 	return func(rm pmetric.ResourceMetrics) {
@@ -2535,6 +2604,13 @@ func WithK8sPodUID(val string) ResourceMetricsOption {
 	}
 }
 
+// WithK8sStorageclassName sets provided value as "k8s.storageclass.name" attribute for current resource.
+func WithK8sStorageclassName(val string) ResourceMetricsOption {
+	return func(rm pmetric.ResourceMetrics) {
+		rm.Resource().Attributes().PutStr("k8s.storageclass.name", val)
+	}
+}
+
 // WithK8sVolumeName sets provided value as "k8s.volume.name" attribute for current resource.
 func WithK8sVolumeName(val string) ResourceMetricsOption {
 	return func(rm pmetric.ResourceMetrics) {
@@ -2612,6 +2688,7 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricK8sNodeMemoryWorkingSet.emit(ils.Metrics())
 	mb.metricK8sNodeNetworkErrors.emit(ils.Metrics())
 	mb.metricK8sNodeNetworkIo.emit(ils.Metrics())
+	mb.metricK8sPersistentvolumeclaimCapacity.emit(ils.Metrics())
 	mb.metricK8sPodCPUTime.emit(ils.Metrics())
 	mb.metricK8sPodCPUUtilization.emit(ils.Metrics())
 	mb.metricK8sPodFilesystemAvailable.emit(ils.Metrics())
@@ -2769,6 +2846,11 @@ func (mb *MetricsBuilder) RecordK8sNodeNetworkIoDataPoint(ts pcommon.Timestamp,
 	mb.metricK8sNodeNetworkIo.recordDataPoint(mb.startTime, ts, val, interfaceAttributeValue, directionAttributeValue.String())
 }
 
+// RecordK8sPersistentvolumeclaimCapacityDataPoint adds a data point to k8s.persistentvolumeclaim.capacity metric.
+func (mb *MetricsBuilder) RecordK8sPersistentvolumeclaimCapacityDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sPersistentvolumeclaimCapacity.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordK8sPodCPUTimeDataPoint adds a data point to k8s.pod.cpu.time metric.
 func (mb *MetricsBuilder) RecordK8sPodCPUTimeDataPoint(ts pcommon.Timestamp, val float64) {
 	mb.metricK8sPodCPUTime.recordDataPoint(mb.startTime, ts, val)
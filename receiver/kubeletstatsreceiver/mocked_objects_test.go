@@ -16,6 +16,7 @@ package kubeletstatsreceiver
 
 import (
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -138,3 +139,33 @@ func getMockedObjectsWithNonExistentVolumeName() []runtime.Object {
 		volumeClaim3,
 	}
 }
+
+// getMockedObjectsWithCSIVolume returns a bound volume claim with a storage class and
+// capacity, backed by a CSI persistent volume.
+func getMockedObjectsWithCSIVolume() []runtime.Object {
+	storageClassName := "gp3"
+	claim := getPVC("volume_claim_1", "kube-system", "storage-provisioner-token-qzlx6")
+	claim.Spec.StorageClassName = &storageClassName
+	claim.Status.Capacity = v1.ResourceList{
+		v1.ResourceStorage: resource.MustParse("10Gi"),
+	}
+
+	return []runtime.Object{
+		claim,
+		&v1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "storage-provisioner-token-qzlx6",
+				UID:  "volume_name_1",
+			},
+			Spec: v1.PersistentVolumeSpec{
+				PersistentVolumeSource: v1.PersistentVolumeSource{
+					CSI: &v1.CSIPersistentVolumeSource{
+						Driver:       "ebs.csi.aws.com",
+						VolumeHandle: "vol-0123",
+						FSType:       "ext4",
+					},
+				},
+			},
+		},
+	}
+}
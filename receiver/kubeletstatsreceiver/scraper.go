@@ -31,23 +31,35 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver/internal/metadata"
 )
 
+// volumeClaimCacheTTL is how long the details of a volume claim are cached before being fetched again,
+// so that changes of the claims, such as their expansion, are eventually reported.
+const volumeClaimCacheTTL = 5 * time.Minute
+
 type scraperOptions struct {
-	id                    component.ID
-	collectionInterval    time.Duration
-	extraMetadataLabels   []kubelet.MetadataLabel
-	metricGroupsToCollect map[kubelet.MetricGroup]bool
-	k8sAPIClient          kubernetes.Interface
+	id                     component.ID
+	collectionInterval     time.Duration
+	extraMetadataLabels    []kubelet.MetadataLabel
+	metricGroupsToCollect  map[kubelet.MetricGroup]bool
+	k8sAPIClient           kubernetes.Interface
+	containerStatsFallback bool
 }
 
 type kubletScraper struct {
-	statsProvider         *kubelet.StatsProvider
-	metadataProvider      *kubelet.MetadataProvider
-	logger                *zap.Logger
-	extraMetadataLabels   []kubelet.MetadataLabel
-	metricGroupsToCollect map[kubelet.MetricGroup]bool
-	k8sAPIClient          kubernetes.Interface
-	cachedVolumeLabels    map[string][]metadata.ResourceMetricsOption
-	mbs                   *metadata.MetricsBuilders
+	statsProvider          *kubelet.StatsProvider
+	metadataProvider       *kubelet.MetadataProvider
+	logger                 *zap.Logger
+	extraMetadataLabels    []kubelet.MetadataLabel
+	metricGroupsToCollect  map[kubelet.MetricGroup]bool
+	k8sAPIClient           kubernetes.Interface
+	cachedVolumeClaims     map[string]volumeClaimCacheEntry
+	now                    func() time.Time
+	mbs                    *metadata.MetricsBuilders
+	containerStatsFallback bool
+}
+
+type volumeClaimCacheEntry struct {
+	details *kubelet.VolumeClaimDetails
+	expiry  time.Time
 }
 
 func newKubletScraper(
@@ -57,13 +69,15 @@ func newKubletScraper(
 	metricsConfig metadata.MetricsSettings,
 ) (scraperhelper.Scraper, error) {
 	ks := &kubletScraper{
-		statsProvider:         kubelet.NewStatsProvider(restClient),
-		metadataProvider:      kubelet.NewMetadataProvider(restClient),
-		logger:                set.Logger,
-		extraMetadataLabels:   rOptions.extraMetadataLabels,
-		metricGroupsToCollect: rOptions.metricGroupsToCollect,
-		k8sAPIClient:          rOptions.k8sAPIClient,
-		cachedVolumeLabels:    make(map[string][]metadata.ResourceMetricsOption),
+		statsProvider:          kubelet.NewStatsProvider(restClient),
+		metadataProvider:       kubelet.NewMetadataProvider(restClient),
+		logger:                 set.Logger,
+		extraMetadataLabels:    rOptions.extraMetadataLabels,
+		metricGroupsToCollect:  rOptions.metricGroupsToCollect,
+		k8sAPIClient:           rOptions.k8sAPIClient,
+		cachedVolumeClaims:     make(map[string]volumeClaimCacheEntry),
+		now:                    time.Now,
+		containerStatsFallback: rOptions.containerStatsFallback,
 		mbs: &metadata.MetricsBuilders{
 			NodeMetricsBuilder:      metadata.NewMetricsBuilder(metricsConfig, set.BuildInfo),
 			PodMetricsBuilder:       metadata.NewMetricsBuilder(metricsConfig, set.BuildInfo),
@@ -75,12 +89,21 @@ func newKubletScraper(
 }
 
 func (r *kubletScraper) scrape(context.Context) (pmetric.Metrics, error) {
+	r.evictExpiredVolumeClaims()
+
 	summary, err := r.statsProvider.StatsSummary()
 	if err != nil {
 		r.logger.Error("call to /stats/summary endpoint failed", zap.Error(err))
 		return pmetric.Metrics{}, err
 	}
 
+	if r.containerStatsFallback && r.metricGroupsToCollect[kubelet.ContainerMetricGroup] && kubelet.HasMissingContainerStats(summary) {
+		// the summary is still usable, so failing to fill the missing stats is not fatal
+		if err = r.statsProvider.FillMissingContainerStats(summary); err != nil {
+			r.logger.Warn("call to /metrics/resource endpoint failed, container stats missing from /stats/summary are not reported", zap.Error(err))
+		}
+	}
+
 	var podsMetadata *v1.PodList
 	// fetch metadata only when extra metadata labels are needed
 	if len(r.extraMetadataLabels) > 0 {
@@ -100,13 +123,14 @@ func (r *kubletScraper) scrape(context.Context) (pmetric.Metrics, error) {
 	return md, nil
 }
 
-func (r *kubletScraper) detailedPVCLabelsSetter() func(volCacheID, volumeClaim, namespace string) ([]metadata.ResourceMetricsOption, error) {
-	return func(volCacheID, volumeClaim, namespace string) ([]metadata.ResourceMetricsOption, error) {
+func (r *kubletScraper) detailedPVCLabelsSetter() func(volCacheID, volumeClaim, namespace string) (*kubelet.VolumeClaimDetails, error) {
+	return func(volCacheID, volumeClaim, namespace string) (*kubelet.VolumeClaimDetails, error) {
 		if r.k8sAPIClient == nil {
 			return nil, nil
 		}
 
-		if r.cachedVolumeLabels[volCacheID] == nil {
+		entry, ok := r.cachedVolumeClaims[volCacheID]
+		if !ok || !r.now().Before(entry.expiry) {
 			ctx := context.Background()
 			pvc, err := r.k8sAPIClient.CoreV1().PersistentVolumeClaims(namespace).Get(ctx, volumeClaim, metav1.GetOptions{})
 			if err != nil {
@@ -124,10 +148,30 @@ func (r *kubletScraper) detailedPVCLabelsSetter() func(volCacheID, volumeClaim,
 			}
 
 			ro := kubelet.GetPersistentVolumeLabels(pv.Spec.PersistentVolumeSource)
+			if pvc.Spec.StorageClassName != nil && *pvc.Spec.StorageClassName != "" {
+				ro = append(ro, metadata.WithK8sStorageclassName(*pvc.Spec.StorageClassName))
+			}
+
+			details := &kubelet.VolumeClaimDetails{ResourceOptions: ro}
+			if capacity, ok := pvc.Status.Capacity[v1.ResourceStorage]; ok {
+				value := capacity.Value()
+				details.Capacity = &value
+			}
 
 			// Cache collected labels.
-			r.cachedVolumeLabels[volCacheID] = ro
+			entry = volumeClaimCacheEntry{details: details, expiry: r.now().Add(volumeClaimCacheTTL)}
+			r.cachedVolumeClaims[volCacheID] = entry
+		}
+		return entry.details, nil
+	}
+}
+
+// evictExpiredVolumeClaims removes the expired volume claims from the cache, such as the ones of deleted pods.
+func (r *kubletScraper) evictExpiredVolumeClaims() {
+	now := r.now()
+	for volCacheID, entry := range r.cachedVolumeClaims {
+		if !now.Before(entry.expiry) {
+			delete(r.cachedVolumeClaims, volCacheID)
 		}
-		return r.cachedVolumeLabels[volCacheID], nil
 	}
 }
@@ -16,18 +16,24 @@ package kubeletstatsreceiver
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest/observer"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
+	stats "k8s.io/kubelet/pkg/apis/stats/v1alpha1"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver/internal/kubelet"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver/internal/metadata"
@@ -385,6 +391,94 @@ func TestScraperWithPVCDetailedLabels(t *testing.T) {
 	}
 }
 
+func TestScraperWithPVCCapacity(t *testing.T) {
+	r, err := newKubletScraper(
+		&fakeRestClient{},
+		componenttest.NewNopReceiverCreateSettings(),
+		&scraperOptions{
+			extraMetadataLabels: []kubelet.MetadataLabel{kubelet.MetadataLabelVolumeType},
+			metricGroupsToCollect: map[kubelet.MetricGroup]bool{
+				kubelet.VolumeMetricGroup: true,
+			},
+			k8sAPIClient: fake.NewSimpleClientset(getMockedObjectsWithCSIVolume()...),
+		},
+		metadata.DefaultMetricsSettings(),
+	)
+	require.NoError(t, err)
+
+	md, err := r.Scrape(context.Background())
+	require.NoError(t, err)
+
+	found := false
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		claimName, ok := rms.At(i).Resource().Attributes().Get("k8s.persistentvolumeclaim.name")
+		if !ok || claimName.Str() != "volume_claim_1" {
+			continue
+		}
+		found = true
+
+		requireExpectedVolume(t, expectedVolume{
+			name: "storage-provisioner-token-qzlx6",
+			typ:  "csi",
+			labels: map[string]string{
+				"csi.driver":            "ebs.csi.aws.com",
+				"csi.volume.handle":     "vol-0123",
+				"fs.type":               "ext4",
+				"k8s.storageclass.name": "gp3",
+			},
+		}, rms.At(i).Resource())
+
+		metrics := rms.At(i).ScopeMetrics().At(0).Metrics()
+		require.Equal(t, volumeMetrics+1, metrics.Len())
+		capacityFound := false
+		for j := 0; j < metrics.Len(); j++ {
+			if metrics.At(j).Name() == "k8s.persistentvolumeclaim.capacity" {
+				capacityFound = true
+				require.Equal(t, int64(10*1024*1024*1024), metrics.At(j).Gauge().DataPoints().At(0).IntValue())
+			}
+		}
+		require.True(t, capacityFound)
+	}
+	require.True(t, found)
+}
+
+func TestScraperVolumeClaimCacheExpiry(t *testing.T) {
+	client := fake.NewSimpleClientset(getMockedObjectsWithCSIVolume()...)
+	now := time.Now()
+	r := &kubletScraper{
+		k8sAPIClient:       client,
+		cachedVolumeClaims: make(map[string]volumeClaimCacheEntry),
+		now:                func() time.Time { return now },
+	}
+	getter := r.detailedPVCLabelsSetter()
+
+	details, err := getter("pod/volume", "volume_claim_1", "kube-system")
+	require.NoError(t, err)
+	require.Equal(t, int64(10*1024*1024*1024), *details.Capacity)
+
+	// the claim is expanded
+	claim, err := client.CoreV1().PersistentVolumeClaims("kube-system").Get(context.Background(), "volume_claim_1", metav1.GetOptions{})
+	require.NoError(t, err)
+	claim.Status.Capacity = v1.ResourceList{v1.ResourceStorage: resource.MustParse("20Gi")}
+	_, err = client.CoreV1().PersistentVolumeClaims("kube-system").Update(context.Background(), claim, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	details, err = getter("pod/volume", "volume_claim_1", "kube-system")
+	require.NoError(t, err)
+	require.Equal(t, int64(10*1024*1024*1024), *details.Capacity)
+
+	now = now.Add(volumeClaimCacheTTL)
+	details, err = getter("pod/volume", "volume_claim_1", "kube-system")
+	require.NoError(t, err)
+	require.Equal(t, int64(20*1024*1024*1024), *details.Capacity)
+
+	// the expired claims are evicted
+	now = now.Add(volumeClaimCacheTTL)
+	r.evictExpiredVolumeClaims()
+	require.Empty(t, r.cachedVolumeClaims)
+}
+
 func requireExpectedVolume(t *testing.T, ev expectedVolume, resource pcommon.Resource) {
 	require.NotNil(t, ev)
 
@@ -476,20 +570,125 @@ func TestClientErrors(t *testing.T) {
 	}
 }
 
+func TestScraperContainerStatsFallback(t *testing.T) {
+	// remove the container CPU and memory stats, as the summary of some cgroup v2 setups does
+	data, err := os.ReadFile("testdata/stats-summary.json")
+	require.NoError(t, err)
+	var summary stats.Summary
+	require.NoError(t, json.Unmarshal(data, &summary))
+	for i := range summary.Pods {
+		for j := range summary.Pods[i].Containers {
+			summary.Pods[i].Containers[j].CPU = nil
+			summary.Pods[i].Containers[j].Memory = nil
+		}
+	}
+	data, err = json.Marshal(summary)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name                   string
+		containerStatsFallback bool
+		resourceMetricsFail    bool
+		expectedCPUTime        map[string]float64
+		expectedWorkingSet     map[string]int64
+		numLogs                int
+	}{
+		{
+			name:               "fallback_disabled",
+			expectedCPUTime:    map[string]float64{},
+			expectedWorkingSet: map[string]int64{},
+		},
+		{
+			name:                   "fallback_enabled",
+			containerStatsFallback: true,
+			expectedCPUTime:        map[string]float64{"server": 12.5, "etcd": 340.25},
+			expectedWorkingSet:     map[string]int64{"server": 4194304, "etcd": 33554432},
+		},
+		{
+			name:                   "resource_metrics_endpoint_error",
+			containerStatsFallback: true,
+			resourceMetricsFail:    true,
+			expectedCPUTime:        map[string]float64{},
+			expectedWorkingSet:     map[string]int64{},
+			numLogs:                1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			core, observedLogs := observer.New(zap.WarnLevel)
+			settings := componenttest.NewNopReceiverCreateSettings()
+			settings.Logger = zap.New(core)
+			options := &scraperOptions{
+				metricGroupsToCollect: map[kubelet.MetricGroup]bool{
+					kubelet.ContainerMetricGroup: true,
+				},
+				containerStatsFallback: test.containerStatsFallback,
+			}
+			r, err := newKubletScraper(
+				&fakeRestClient{
+					statsSummary:        data,
+					resourceMetricsFail: test.resourceMetricsFail,
+				},
+				settings,
+				options,
+				metadata.DefaultMetricsSettings(),
+			)
+			require.NoError(t, err)
+
+			md, err := r.Scrape(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, test.numLogs, observedLogs.Len())
+
+			cpuTime := map[string]float64{}
+			workingSet := map[string]int64{}
+			rms := md.ResourceMetrics()
+			for i := 0; i < rms.Len(); i++ {
+				rm := rms.At(i)
+				name, _ := rm.Resource().Attributes().Get("k8s.container.name")
+				metrics := rm.ScopeMetrics().At(0).Metrics()
+				for j := 0; j < metrics.Len(); j++ {
+					m := metrics.At(j)
+					switch m.Name() {
+					case "container.cpu.time":
+						cpuTime[name.Str()] = m.Sum().DataPoints().At(0).DoubleValue()
+					case "container.memory.working_set":
+						workingSet[name.Str()] = m.Gauge().DataPoints().At(0).IntValue()
+					}
+				}
+			}
+			require.Equal(t, test.expectedCPUTime, cpuTime)
+			require.Equal(t, test.expectedWorkingSet, workingSet)
+		})
+	}
+}
+
 var _ kubelet.RestClient = (*fakeRestClient)(nil)
 
 type fakeRestClient struct {
-	statsSummaryFail bool
-	podsFail         bool
+	statsSummaryFail    bool
+	podsFail            bool
+	resourceMetricsFail bool
+	// statsSummary overrides the contents of testdata/stats-summary.json
+	statsSummary []byte
 }
 
 func (f *fakeRestClient) StatsSummary() ([]byte, error) {
 	if f.statsSummaryFail {
 		return nil, errors.New("")
 	}
+	if f.statsSummary != nil {
+		return f.statsSummary, nil
+	}
 	return os.ReadFile("testdata/stats-summary.json")
 }
 
+func (f *fakeRestClient) ResourceMetrics() ([]byte, error) {
+	if f.resourceMetricsFail {
+		return nil, errors.New("")
+	}
+	return os.ReadFile("testdata/resource-metrics.txt")
+}
+
 func (f *fakeRestClient) Pods() ([]byte, error) {
 	if f.podsFail {
 		return nil, errors.New("")
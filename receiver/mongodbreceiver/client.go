@@ -37,6 +37,9 @@ type client interface {
 	DBStats(ctx context.Context, DBName string) (bson.M, error)
 	TopStats(ctx context.Context) (bson.M, error)
 	IndexStats(ctx context.Context, DBName, collectionName string) ([]bson.M, error)
+	CollectionStats(ctx context.Context, DBName, collectionName string) (bson.M, error)
+	CurrentOp(ctx context.Context) (bson.M, error)
+	ReplSetStatus(ctx context.Context) (bson.M, error)
 }
 
 // mongodbClient is a mongodb metric scraper client
@@ -89,6 +92,25 @@ func (c *mongodbClient) TopStats(ctx context.Context) (bson.M, error) {
 	return c.RunCommand(ctx, "admin", bson.M{"top": 1})
 }
 
+// CollectionStats returns the result of db.runCommand({ collStats: <collection> })
+// more information can be found here: https://www.mongodb.com/docs/manual/reference/command/collStats/
+func (c *mongodbClient) CollectionStats(ctx context.Context, database, collectionName string) (bson.M, error) {
+	return c.RunCommand(ctx, database, bson.M{"collStats": collectionName})
+}
+
+// CurrentOp is an admin command that returns the operations in progress, the result of
+// db.adminCommand({ currentOp: 1, active: true })
+// more information can be found here: https://www.mongodb.com/docs/manual/reference/command/currentOp/
+func (c *mongodbClient) CurrentOp(ctx context.Context) (bson.M, error) {
+	return c.RunCommand(ctx, "admin", bson.M{"currentOp": 1, "active": true})
+}
+
+// ReplSetStatus is an admin command that return the result of db.adminCommand({ replSetGetStatus: 1 })
+// more information can be found here: https://www.mongodb.com/docs/manual/reference/command/replSetGetStatus/
+func (c *mongodbClient) ReplSetStatus(ctx context.Context) (bson.M, error) {
+	return c.RunCommand(ctx, "admin", bson.M{"replSetGetStatus": 1})
+}
+
 // ListCollectionNames returns a list of collection names for a given database
 // SetAuthorizedCollections allows a user without the required privilege to run the command ListCollections.
 // more information can be found here: https://pkg.go.dev/go.mongodb.org/mongo-driver@v1.9.0/mongo#Database.ListCollectionNames
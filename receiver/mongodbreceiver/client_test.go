@@ -79,6 +79,21 @@ func (fc *fakeClient) IndexStats(ctx context.Context, dbName, collectionName str
 	return args.Get(0).([]bson.M), args.Error(1)
 }
 
+func (fc *fakeClient) CollectionStats(ctx context.Context, dbName, collectionName string) (bson.M, error) {
+	args := fc.Called(ctx, dbName, collectionName)
+	return args.Get(0).(bson.M), args.Error(1)
+}
+
+func (fc *fakeClient) CurrentOp(ctx context.Context) (bson.M, error) {
+	args := fc.Called(ctx)
+	return args.Get(0).(bson.M), args.Error(1)
+}
+
+func (fc *fakeClient) ReplSetStatus(ctx context.Context) (bson.M, error) {
+	args := fc.Called(ctx)
+	return args.Get(0).(bson.M), args.Error(1)
+}
+
 func TestListDatabaseNames(t *testing.T) {
 	mont := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
 	defer mont.Close()
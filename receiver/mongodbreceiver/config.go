@@ -17,6 +17,7 @@ package mongodbreceiver // import "github.com/open-telemetry/opentelemetry-colle
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
 
@@ -39,6 +40,66 @@ type Config struct {
 	Password   string                   `mapstructure:"password"`
 	ReplicaSet string                   `mapstructure:"replica_set,omitempty"`
 	Timeout    time.Duration            `mapstructure:"timeout"`
+	// Collections filters the namespaces of the collection and active operation metrics.
+	Collections CollectionsConfig `mapstructure:"collections"`
+}
+
+// CollectionsConfig filters namespaces, as `<database>.<collection>`, with regular expressions.
+type CollectionsConfig struct {
+	// Include restricts the namespaces to the ones matching any of the expressions, if not empty.
+	Include []string `mapstructure:"include"`
+	// Exclude removes the namespaces matching any of the expressions, after Include was applied.
+	Exclude []string `mapstructure:"exclude"`
+}
+
+// namespaceFilter is the compiled form of a CollectionsConfig.
+type namespaceFilter struct {
+	include []*regexp.Regexp
+	exclude []*regexp.Regexp
+}
+
+func newNamespaceFilter(cfg CollectionsConfig) (*namespaceFilter, error) {
+	var err error
+	f := &namespaceFilter{}
+	if f.include, err = compileExpressions(cfg.Include); err != nil {
+		return nil, fmt.Errorf("invalid collections include: %w", err)
+	}
+	if f.exclude, err = compileExpressions(cfg.Exclude); err != nil {
+		return nil, fmt.Errorf("invalid collections exclude: %w", err)
+	}
+	return f, nil
+}
+
+func compileExpressions(expressions []string) ([]*regexp.Regexp, error) {
+	compiled := make([]*regexp.Regexp, 0, len(expressions))
+	for _, expr := range expressions {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, err
+		}
+		compiled = append(compiled, re)
+	}
+	return compiled, nil
+}
+
+// matches returns whether the namespace is scraped, a nil filter matches every namespace.
+func (f *namespaceFilter) matches(namespace string) bool {
+	if f == nil {
+		return true
+	}
+	if len(f.include) > 0 && !matchesAny(f.include, namespace) {
+		return false
+	}
+	return !matchesAny(f.exclude, namespace)
+}
+
+func matchesAny(expressions []*regexp.Regexp, s string) bool {
+	for _, re := range expressions {
+		if re.MatchString(s) {
+			return true
+		}
+	}
+	return false
 }
 
 func (c *Config) Validate() error {
@@ -59,6 +120,10 @@ func (c *Config) Validate() error {
 		err = multierr.Append(err, errors.New("password provided without user"))
 	}
 
+	if _, filterErr := newNamespaceFilter(c.Collections); filterErr != nil {
+		err = multierr.Append(err, filterErr)
+	}
+
 	if _, tlsErr := c.LoadTLSConfig(); tlsErr != nil {
 		err = multierr.Append(err, fmt.Errorf("error loading tls configuration: %w", tlsErr))
 	}
@@ -106,6 +106,27 @@ func TestValidate(t *testing.T) {
 	}
 }
 
+func TestNamespaceFilter(t *testing.T) {
+	filter, err := newNamespaceFilter(CollectionsConfig{
+		Include: []string{`^shop\.`, `^users\.accounts$`},
+		Exclude: []string{`\.tmp_`},
+	})
+	require.NoError(t, err)
+	require.True(t, filter.matches("shop.orders"))
+	require.True(t, filter.matches("users.accounts"))
+	require.False(t, filter.matches("users.sessions"))
+	require.False(t, filter.matches("shop.tmp_import"))
+
+	var nilFilter *namespaceFilter
+	require.True(t, nilFilter.matches("any.collection"))
+
+	cfg := Config{
+		Hosts:       []confignet.NetAddr{{Endpoint: "localhost:27017"}},
+		Collections: CollectionsConfig{Exclude: []string{"("}},
+	}
+	require.ErrorContains(t, cfg.Validate(), "invalid collections exclude")
+}
+
 func TestBadTLSConfigs(t *testing.T) {
 	testCases := []struct {
 		desc        string
@@ -37,32 +37,37 @@ func (ms *MetricSettings) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsSettings provides settings for mongodbreceiver metrics.
 type MetricsSettings struct {
-	MongodbCacheOperations        MetricSettings `mapstructure:"mongodb.cache.operations"`
-	MongodbCollectionCount        MetricSettings `mapstructure:"mongodb.collection.count"`
-	MongodbConnectionCount        MetricSettings `mapstructure:"mongodb.connection.count"`
-	MongodbCursorCount            MetricSettings `mapstructure:"mongodb.cursor.count"`
-	MongodbCursorTimeoutCount     MetricSettings `mapstructure:"mongodb.cursor.timeout.count"`
-	MongodbDataSize               MetricSettings `mapstructure:"mongodb.data.size"`
-	MongodbDatabaseCount          MetricSettings `mapstructure:"mongodb.database.count"`
-	MongodbDocumentOperationCount MetricSettings `mapstructure:"mongodb.document.operation.count"`
-	MongodbExtentCount            MetricSettings `mapstructure:"mongodb.extent.count"`
-	MongodbGlobalLockTime         MetricSettings `mapstructure:"mongodb.global_lock.time"`
-	MongodbIndexAccessCount       MetricSettings `mapstructure:"mongodb.index.access.count"`
-	MongodbIndexCount             MetricSettings `mapstructure:"mongodb.index.count"`
-	MongodbIndexSize              MetricSettings `mapstructure:"mongodb.index.size"`
-	MongodbLockAcquireCount       MetricSettings `mapstructure:"mongodb.lock.acquire.count"`
-	MongodbLockAcquireTime        MetricSettings `mapstructure:"mongodb.lock.acquire.time"`
-	MongodbLockAcquireWaitCount   MetricSettings `mapstructure:"mongodb.lock.acquire.wait_count"`
-	MongodbLockDeadlockCount      MetricSettings `mapstructure:"mongodb.lock.deadlock.count"`
-	MongodbMemoryUsage            MetricSettings `mapstructure:"mongodb.memory.usage"`
-	MongodbNetworkIoReceive       MetricSettings `mapstructure:"mongodb.network.io.receive"`
-	MongodbNetworkIoTransmit      MetricSettings `mapstructure:"mongodb.network.io.transmit"`
-	MongodbNetworkRequestCount    MetricSettings `mapstructure:"mongodb.network.request.count"`
-	MongodbObjectCount            MetricSettings `mapstructure:"mongodb.object.count"`
-	MongodbOperationCount         MetricSettings `mapstructure:"mongodb.operation.count"`
-	MongodbOperationTime          MetricSettings `mapstructure:"mongodb.operation.time"`
-	MongodbSessionCount           MetricSettings `mapstructure:"mongodb.session.count"`
-	MongodbStorageSize            MetricSettings `mapstructure:"mongodb.storage.size"`
+	MongodbCacheOperations         MetricSettings `mapstructure:"mongodb.cache.operations"`
+	MongodbCollectionCount         MetricSettings `mapstructure:"mongodb.collection.count"`
+	MongodbCollectionDocumentCount MetricSettings `mapstructure:"mongodb.collection.document.count"`
+	MongodbCollectionIndexSize     MetricSettings `mapstructure:"mongodb.collection.index.size"`
+	MongodbCollectionSize          MetricSettings `mapstructure:"mongodb.collection.size"`
+	MongodbConnectionCount         MetricSettings `mapstructure:"mongodb.connection.count"`
+	MongodbCursorCount             MetricSettings `mapstructure:"mongodb.cursor.count"`
+	MongodbCursorTimeoutCount      MetricSettings `mapstructure:"mongodb.cursor.timeout.count"`
+	MongodbDataSize                MetricSettings `mapstructure:"mongodb.data.size"`
+	MongodbDatabaseCount           MetricSettings `mapstructure:"mongodb.database.count"`
+	MongodbDocumentOperationCount  MetricSettings `mapstructure:"mongodb.document.operation.count"`
+	MongodbExtentCount             MetricSettings `mapstructure:"mongodb.extent.count"`
+	MongodbGlobalLockTime          MetricSettings `mapstructure:"mongodb.global_lock.time"`
+	MongodbIndexAccessCount        MetricSettings `mapstructure:"mongodb.index.access.count"`
+	MongodbIndexCount              MetricSettings `mapstructure:"mongodb.index.count"`
+	MongodbIndexSize               MetricSettings `mapstructure:"mongodb.index.size"`
+	MongodbLockAcquireCount        MetricSettings `mapstructure:"mongodb.lock.acquire.count"`
+	MongodbLockAcquireTime         MetricSettings `mapstructure:"mongodb.lock.acquire.time"`
+	MongodbLockAcquireWaitCount    MetricSettings `mapstructure:"mongodb.lock.acquire.wait_count"`
+	MongodbLockDeadlockCount       MetricSettings `mapstructure:"mongodb.lock.deadlock.count"`
+	MongodbMemoryUsage             MetricSettings `mapstructure:"mongodb.memory.usage"`
+	MongodbNetworkIoReceive        MetricSettings `mapstructure:"mongodb.network.io.receive"`
+	MongodbNetworkIoTransmit       MetricSettings `mapstructure:"mongodb.network.io.transmit"`
+	MongodbNetworkRequestCount     MetricSettings `mapstructure:"mongodb.network.request.count"`
+	MongodbObjectCount             MetricSettings `mapstructure:"mongodb.object.count"`
+	MongodbOperationActiveCount    MetricSettings `mapstructure:"mongodb.operation.active.count"`
+	MongodbOperationCount          MetricSettings `mapstructure:"mongodb.operation.count"`
+	MongodbOperationTime           MetricSettings `mapstructure:"mongodb.operation.time"`
+	MongodbReplsetMemberLag        MetricSettings `mapstructure:"mongodb.replset.member.lag"`
+	MongodbSessionCount            MetricSettings `mapstructure:"mongodb.session.count"`
+	MongodbStorageSize             MetricSettings `mapstructure:"mongodb.storage.size"`
 }
 
 func DefaultMetricsSettings() MetricsSettings {
@@ -73,6 +78,15 @@ func DefaultMetricsSettings() MetricsSettings {
 		MongodbCollectionCount: MetricSettings{
 			Enabled: true,
 		},
+		MongodbCollectionDocumentCount: MetricSettings{
+			Enabled: false,
+		},
+		MongodbCollectionIndexSize: MetricSettings{
+			Enabled: false,
+		},
+		MongodbCollectionSize: MetricSettings{
+			Enabled: false,
+		},
 		MongodbConnectionCount: MetricSettings{
 			Enabled: true,
 		},
@@ -133,12 +147,18 @@ func DefaultMetricsSettings() MetricsSettings {
 		MongodbObjectCount: MetricSettings{
 			Enabled: true,
 		},
+		MongodbOperationActiveCount: MetricSettings{
+			Enabled: false,
+		},
 		MongodbOperationCount: MetricSettings{
 			Enabled: true,
 		},
 		MongodbOperationTime: MetricSettings{
 			Enabled: true,
 		},
+		MongodbReplsetMemberLag: MetricSettings{
+			Enabled: false,
+		},
 		MongodbSessionCount: MetricSettings{
 			Enabled: true,
 		},
@@ -462,6 +482,168 @@ func newMetricMongodbCollectionCount(settings MetricSettings) metricMongodbColle
 	return m
 }
 
+type metricMongodbCollectionDocumentCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mongodb.collection.document.count metric with initial data.
+func (m *metricMongodbCollectionDocumentCount) init() {
+	m.data.SetName("mongodb.collection.document.count")
+	m.data.SetDescription("The number of documents in the collection.")
+	m.data.SetUnit("{documents}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricMongodbCollectionDocumentCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, databaseAttributeValue string, collectionAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("database", databaseAttributeValue)
+	dp.Attributes().PutStr("collection", collectionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMongodbCollectionDocumentCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMongodbCollectionDocumentCount) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMongodbCollectionDocumentCount(settings MetricSettings) metricMongodbCollectionDocumentCount {
+	m := metricMongodbCollectionDocumentCount{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricMongodbCollectionIndexSize struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mongodb.collection.index.size metric with initial data.
+func (m *metricMongodbCollectionIndexSize) init() {
+	m.data.SetName("mongodb.collection.index.size")
+	m.data.SetDescription("The total size of the indexes of the collection.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricMongodbCollectionIndexSize) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, databaseAttributeValue string, collectionAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("database", databaseAttributeValue)
+	dp.Attributes().PutStr("collection", collectionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMongodbCollectionIndexSize) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMongodbCollectionIndexSize) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMongodbCollectionIndexSize(settings MetricSettings) metricMongodbCollectionIndexSize {
+	m := metricMongodbCollectionIndexSize{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricMongodbCollectionSize struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mongodb.collection.size metric with initial data.
+func (m *metricMongodbCollectionSize) init() {
+	m.data.SetName("mongodb.collection.size")
+	m.data.SetDescription("The size of the documents in the collection. Data compression does not affect this value.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricMongodbCollectionSize) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, databaseAttributeValue string, collectionAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("database", databaseAttributeValue)
+	dp.Attributes().PutStr("collection", collectionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMongodbCollectionSize) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMongodbCollectionSize) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMongodbCollectionSize(settings MetricSettings) metricMongodbCollectionSize {
+	m := metricMongodbCollectionSize{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricMongodbConnectionCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -1520,6 +1702,60 @@ func newMetricMongodbObjectCount(settings MetricSettings) metricMongodbObjectCou
 	return m
 }
 
+type metricMongodbOperationActiveCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mongodb.operation.active.count metric with initial data.
+func (m *metricMongodbOperationActiveCount) init() {
+	m.data.SetName("mongodb.operation.active.count")
+	m.data.SetDescription("The number of operations in progress.")
+	m.data.SetUnit("{operations}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricMongodbOperationActiveCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, operationAttributeValue string, namespaceAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("operation", operationAttributeValue)
+	dp.Attributes().PutStr("namespace", namespaceAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMongodbOperationActiveCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMongodbOperationActiveCount) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMongodbOperationActiveCount(settings MetricSettings) metricMongodbOperationActiveCount {
+	m := metricMongodbOperationActiveCount{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricMongodbOperationCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -1626,6 +1862,58 @@ func newMetricMongodbOperationTime(settings MetricSettings) metricMongodbOperati
 	return m
 }
 
+type metricMongodbReplsetMemberLag struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mongodb.replset.member.lag metric with initial data.
+func (m *metricMongodbReplsetMemberLag) init() {
+	m.data.SetName("mongodb.replset.member.lag")
+	m.data.SetDescription("The delay of the replication of the operations of the primary to the secondary member.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricMongodbReplsetMemberLag) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, replicaSetAttributeValue string, memberAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("replica_set", replicaSetAttributeValue)
+	dp.Attributes().PutStr("member", memberAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMongodbReplsetMemberLag) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMongodbReplsetMemberLag) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMongodbReplsetMemberLag(settings MetricSettings) metricMongodbReplsetMemberLag {
+	m := metricMongodbReplsetMemberLag{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricMongodbSessionCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -1733,37 +2021,42 @@ func newMetricMongodbStorageSize(settings MetricSettings) metricMongodbStorageSi
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user settings.
 type MetricsBuilder struct {
-	startTime                           pcommon.Timestamp   // start time that will be applied to all recorded data points.
-	metricsCapacity                     int                 // maximum observed number of metrics per resource.
-	resourceCapacity                    int                 // maximum observed number of resource attributes.
-	metricsBuffer                       pmetric.Metrics     // accumulates metrics data before emitting.
-	buildInfo                           component.BuildInfo // contains version information
-	metricMongodbCacheOperations        metricMongodbCacheOperations
-	metricMongodbCollectionCount        metricMongodbCollectionCount
-	metricMongodbConnectionCount        metricMongodbConnectionCount
-	metricMongodbCursorCount            metricMongodbCursorCount
-	metricMongodbCursorTimeoutCount     metricMongodbCursorTimeoutCount
-	metricMongodbDataSize               metricMongodbDataSize
-	metricMongodbDatabaseCount          metricMongodbDatabaseCount
-	metricMongodbDocumentOperationCount metricMongodbDocumentOperationCount
-	metricMongodbExtentCount            metricMongodbExtentCount
-	metricMongodbGlobalLockTime         metricMongodbGlobalLockTime
-	metricMongodbIndexAccessCount       metricMongodbIndexAccessCount
-	metricMongodbIndexCount             metricMongodbIndexCount
-	metricMongodbIndexSize              metricMongodbIndexSize
-	metricMongodbLockAcquireCount       metricMongodbLockAcquireCount
-	metricMongodbLockAcquireTime        metricMongodbLockAcquireTime
-	metricMongodbLockAcquireWaitCount   metricMongodbLockAcquireWaitCount
-	metricMongodbLockDeadlockCount      metricMongodbLockDeadlockCount
-	metricMongodbMemoryUsage            metricMongodbMemoryUsage
-	metricMongodbNetworkIoReceive       metricMongodbNetworkIoReceive
-	metricMongodbNetworkIoTransmit      metricMongodbNetworkIoTransmit
-	metricMongodbNetworkRequestCount    metricMongodbNetworkRequestCount
-	metricMongodbObjectCount            metricMongodbObjectCount
-	metricMongodbOperationCount         metricMongodbOperationCount
-	metricMongodbOperationTime          metricMongodbOperationTime
-	metricMongodbSessionCount           metricMongodbSessionCount
-	metricMongodbStorageSize            metricMongodbStorageSize
+	startTime                            pcommon.Timestamp   // start time that will be applied to all recorded data points.
+	metricsCapacity                      int                 // maximum observed number of metrics per resource.
+	resourceCapacity                     int                 // maximum observed number of resource attributes.
+	metricsBuffer                        pmetric.Metrics     // accumulates metrics data before emitting.
+	buildInfo                            component.BuildInfo // contains version information
+	metricMongodbCacheOperations         metricMongodbCacheOperations
+	metricMongodbCollectionCount         metricMongodbCollectionCount
+	metricMongodbCollectionDocumentCount metricMongodbCollectionDocumentCount
+	metricMongodbCollectionIndexSize     metricMongodbCollectionIndexSize
+	metricMongodbCollectionSize          metricMongodbCollectionSize
+	metricMongodbConnectionCount         metricMongodbConnectionCount
+	metricMongodbCursorCount             metricMongodbCursorCount
+	metricMongodbCursorTimeoutCount      metricMongodbCursorTimeoutCount
+	metricMongodbDataSize                metricMongodbDataSize
+	metricMongodbDatabaseCount           metricMongodbDatabaseCount
+	metricMongodbDocumentOperationCount  metricMongodbDocumentOperationCount
+	metricMongodbExtentCount             metricMongodbExtentCount
+	metricMongodbGlobalLockTime          metricMongodbGlobalLockTime
+	metricMongodbIndexAccessCount        metricMongodbIndexAccessCount
+	metricMongodbIndexCount              metricMongodbIndexCount
+	metricMongodbIndexSize               metricMongodbIndexSize
+	metricMongodbLockAcquireCount        metricMongodbLockAcquireCount
+	metricMongodbLockAcquireTime         metricMongodbLockAcquireTime
+	metricMongodbLockAcquireWaitCount    metricMongodbLockAcquireWaitCount
+	metricMongodbLockDeadlockCount       metricMongodbLockDeadlockCount
+	metricMongodbMemoryUsage             metricMongodbMemoryUsage
+	metricMongodbNetworkIoReceive        metricMongodbNetworkIoReceive
+	metricMongodbNetworkIoTransmit       metricMongodbNetworkIoTransmit
+	metricMongodbNetworkRequestCount     metricMongodbNetworkRequestCount
+	metricMongodbObjectCount             metricMongodbObjectCount
+	metricMongodbOperationActiveCount    metricMongodbOperationActiveCount
+	metricMongodbOperationCount          metricMongodbOperationCount
+	metricMongodbOperationTime           metricMongodbOperationTime
+	metricMongodbReplsetMemberLag        metricMongodbReplsetMemberLag
+	metricMongodbSessionCount            metricMongodbSessionCount
+	metricMongodbStorageSize             metricMongodbStorageSize
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -1778,35 +2071,40 @@ func WithStartTime(startTime pcommon.Timestamp) metricBuilderOption {
 
 func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo, options ...metricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		startTime:                           pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                       pmetric.NewMetrics(),
-		buildInfo:                           buildInfo,
-		metricMongodbCacheOperations:        newMetricMongodbCacheOperations(settings.MongodbCacheOperations),
-		metricMongodbCollectionCount:        newMetricMongodbCollectionCount(settings.MongodbCollectionCount),
-		metricMongodbConnectionCount:        newMetricMongodbConnectionCount(settings.MongodbConnectionCount),
-		metricMongodbCursorCount:            newMetricMongodbCursorCount(settings.MongodbCursorCount),
-		metricMongodbCursorTimeoutCount:     newMetricMongodbCursorTimeoutCount(settings.MongodbCursorTimeoutCount),
-		metricMongodbDataSize:               newMetricMongodbDataSize(settings.MongodbDataSize),
-		metricMongodbDatabaseCount:          newMetricMongodbDatabaseCount(settings.MongodbDatabaseCount),
-		metricMongodbDocumentOperationCount: newMetricMongodbDocumentOperationCount(settings.MongodbDocumentOperationCount),
-		metricMongodbExtentCount:            newMetricMongodbExtentCount(settings.MongodbExtentCount),
-		metricMongodbGlobalLockTime:         newMetricMongodbGlobalLockTime(settings.MongodbGlobalLockTime),
-		metricMongodbIndexAccessCount:       newMetricMongodbIndexAccessCount(settings.MongodbIndexAccessCount),
-		metricMongodbIndexCount:             newMetricMongodbIndexCount(settings.MongodbIndexCount),
-		metricMongodbIndexSize:              newMetricMongodbIndexSize(settings.MongodbIndexSize),
-		metricMongodbLockAcquireCount:       newMetricMongodbLockAcquireCount(settings.MongodbLockAcquireCount),
-		metricMongodbLockAcquireTime:        newMetricMongodbLockAcquireTime(settings.MongodbLockAcquireTime),
-		metricMongodbLockAcquireWaitCount:   newMetricMongodbLockAcquireWaitCount(settings.MongodbLockAcquireWaitCount),
-		metricMongodbLockDeadlockCount:      newMetricMongodbLockDeadlockCount(settings.MongodbLockDeadlockCount),
-		metricMongodbMemoryUsage:            newMetricMongodbMemoryUsage(settings.MongodbMemoryUsage),
-		metricMongodbNetworkIoReceive:       newMetricMongodbNetworkIoReceive(settings.MongodbNetworkIoReceive),
-		metricMongodbNetworkIoTransmit:      newMetricMongodbNetworkIoTransmit(settings.MongodbNetworkIoTransmit),
-		metricMongodbNetworkRequestCount:    newMetricMongodbNetworkRequestCount(settings.MongodbNetworkRequestCount),
-		metricMongodbObjectCount:            newMetricMongodbObjectCount(settings.MongodbObjectCount),
-		metricMongodbOperationCount:         newMetricMongodbOperationCount(settings.MongodbOperationCount),
-		metricMongodbOperationTime:          newMetricMongodbOperationTime(settings.MongodbOperationTime),
-		metricMongodbSessionCount:           newMetricMongodbSessionCount(settings.MongodbSessionCount),
-		metricMongodbStorageSize:            newMetricMongodbStorageSize(settings.MongodbStorageSize),
+		startTime:                            pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                        pmetric.NewMetrics(),
+		buildInfo:                            buildInfo,
+		metricMongodbCacheOperations:         newMetricMongodbCacheOperations(settings.MongodbCacheOperations),
+		metricMongodbCollectionCount:         newMetricMongodbCollectionCount(settings.MongodbCollectionCount),
+		metricMongodbCollectionDocumentCount: newMetricMongodbCollectionDocumentCount(settings.MongodbCollectionDocumentCount),
+		metricMongodbCollectionIndexSize:     newMetricMongodbCollectionIndexSize(settings.MongodbCollectionIndexSize),
+		metricMongodbCollectionSize:          newMetricMongodbCollectionSize(settings.MongodbCollectionSize),
+		metricMongodbConnectionCount:         newMetricMongodbConnectionCount(settings.MongodbConnectionCount),
+		metricMongodbCursorCount:             newMetricMongodbCursorCount(settings.MongodbCursorCount),
+		metricMongodbCursorTimeoutCount:      newMetricMongodbCursorTimeoutCount(settings.MongodbCursorTimeoutCount),
+		metricMongodbDataSize:                newMetricMongodbDataSize(settings.MongodbDataSize),
+		metricMongodbDatabaseCount:           newMetricMongodbDatabaseCount(settings.MongodbDatabaseCount),
+		metricMongodbDocumentOperationCount:  newMetricMongodbDocumentOperationCount(settings.MongodbDocumentOperationCount),
+		metricMongodbExtentCount:             newMetricMongodbExtentCount(settings.MongodbExtentCount),
+		metricMongodbGlobalLockTime:          newMetricMongodbGlobalLockTime(settings.MongodbGlobalLockTime),
+		metricMongodbIndexAccessCount:        newMetricMongodbIndexAccessCount(settings.MongodbIndexAccessCount),
+		metricMongodbIndexCount:              newMetricMongodbIndexCount(settings.MongodbIndexCount),
+		metricMongodbIndexSize:               newMetricMongodbIndexSize(settings.MongodbIndexSize),
+		metricMongodbLockAcquireCount:        newMetricMongodbLockAcquireCount(settings.MongodbLockAcquireCount),
+		metricMongodbLockAcquireTime:         newMetricMongodbLockAcquireTime(settings.MongodbLockAcquireTime),
+		metricMongodbLockAcquireWaitCount:    newMetricMongodbLockAcquireWaitCount(settings.MongodbLockAcquireWaitCount),
+		metricMongodbLockDeadlockCount:       newMetricMongodbLockDeadlockCount(settings.MongodbLockDeadlockCount),
+		metricMongodbMemoryUsage:             newMetricMongodbMemoryUsage(settings.MongodbMemoryUsage),
+		metricMongodbNetworkIoReceive:        newMetricMongodbNetworkIoReceive(settings.MongodbNetworkIoReceive),
+		metricMongodbNetworkIoTransmit:       newMetricMongodbNetworkIoTransmit(settings.MongodbNetworkIoTransmit),
+		metricMongodbNetworkRequestCount:     newMetricMongodbNetworkRequestCount(settings.MongodbNetworkRequestCount),
+		metricMongodbObjectCount:             newMetricMongodbObjectCount(settings.MongodbObjectCount),
+		metricMongodbOperationActiveCount:    newMetricMongodbOperationActiveCount(settings.MongodbOperationActiveCount),
+		metricMongodbOperationCount:          newMetricMongodbOperationCount(settings.MongodbOperationCount),
+		metricMongodbOperationTime:           newMetricMongodbOperationTime(settings.MongodbOperationTime),
+		metricMongodbReplsetMemberLag:        newMetricMongodbReplsetMemberLag(settings.MongodbReplsetMemberLag),
+		metricMongodbSessionCount:            newMetricMongodbSessionCount(settings.MongodbSessionCount),
+		metricMongodbStorageSize:             newMetricMongodbStorageSize(settings.MongodbStorageSize),
 	}
 	for _, op := range options {
 		op(mb)
@@ -1868,6 +2166,9 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
 	mb.metricMongodbCacheOperations.emit(ils.Metrics())
 	mb.metricMongodbCollectionCount.emit(ils.Metrics())
+	mb.metricMongodbCollectionDocumentCount.emit(ils.Metrics())
+	mb.metricMongodbCollectionIndexSize.emit(ils.Metrics())
+	mb.metricMongodbCollectionSize.emit(ils.Metrics())
 	mb.metricMongodbConnectionCount.emit(ils.Metrics())
 	mb.metricMongodbCursorCount.emit(ils.Metrics())
 	mb.metricMongodbCursorTimeoutCount.emit(ils.Metrics())
@@ -1888,8 +2189,10 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricMongodbNetworkIoTransmit.emit(ils.Metrics())
 	mb.metricMongodbNetworkRequestCount.emit(ils.Metrics())
 	mb.metricMongodbObjectCount.emit(ils.Metrics())
+	mb.metricMongodbOperationActiveCount.emit(ils.Metrics())
 	mb.metricMongodbOperationCount.emit(ils.Metrics())
 	mb.metricMongodbOperationTime.emit(ils.Metrics())
+	mb.metricMongodbReplsetMemberLag.emit(ils.Metrics())
 	mb.metricMongodbSessionCount.emit(ils.Metrics())
 	mb.metricMongodbStorageSize.emit(ils.Metrics())
 	for _, op := range rmo {
@@ -1921,6 +2224,21 @@ func (mb *MetricsBuilder) RecordMongodbCollectionCountDataPoint(ts pcommon.Times
 	mb.metricMongodbCollectionCount.recordDataPoint(mb.startTime, ts, val, databaseAttributeValue)
 }
 
+// RecordMongodbCollectionDocumentCountDataPoint adds a data point to mongodb.collection.document.count metric.
+func (mb *MetricsBuilder) RecordMongodbCollectionDocumentCountDataPoint(ts pcommon.Timestamp, val int64, databaseAttributeValue string, collectionAttributeValue string) {
+	mb.metricMongodbCollectionDocumentCount.recordDataPoint(mb.startTime, ts, val, databaseAttributeValue, collectionAttributeValue)
+}
+
+// RecordMongodbCollectionIndexSizeDataPoint adds a data point to mongodb.collection.index.size metric.
+func (mb *MetricsBuilder) RecordMongodbCollectionIndexSizeDataPoint(ts pcommon.Timestamp, val int64, databaseAttributeValue string, collectionAttributeValue string) {
+	mb.metricMongodbCollectionIndexSize.recordDataPoint(mb.startTime, ts, val, databaseAttributeValue, collectionAttributeValue)
+}
+
+// RecordMongodbCollectionSizeDataPoint adds a data point to mongodb.collection.size metric.
+func (mb *MetricsBuilder) RecordMongodbCollectionSizeDataPoint(ts pcommon.Timestamp, val int64, databaseAttributeValue string, collectionAttributeValue string) {
+	mb.metricMongodbCollectionSize.recordDataPoint(mb.startTime, ts, val, databaseAttributeValue, collectionAttributeValue)
+}
+
 // RecordMongodbConnectionCountDataPoint adds a data point to mongodb.connection.count metric.
 func (mb *MetricsBuilder) RecordMongodbConnectionCountDataPoint(ts pcommon.Timestamp, val int64, databaseAttributeValue string, connectionTypeAttributeValue AttributeConnectionType) {
 	mb.metricMongodbConnectionCount.recordDataPoint(mb.startTime, ts, val, databaseAttributeValue, connectionTypeAttributeValue.String())
@@ -2021,6 +2339,11 @@ func (mb *MetricsBuilder) RecordMongodbObjectCountDataPoint(ts pcommon.Timestamp
 	mb.metricMongodbObjectCount.recordDataPoint(mb.startTime, ts, val, databaseAttributeValue)
 }
 
+// RecordMongodbOperationActiveCountDataPoint adds a data point to mongodb.operation.active.count metric.
+func (mb *MetricsBuilder) RecordMongodbOperationActiveCountDataPoint(ts pcommon.Timestamp, val int64, operationAttributeValue AttributeOperation, namespaceAttributeValue string) {
+	mb.metricMongodbOperationActiveCount.recordDataPoint(mb.startTime, ts, val, operationAttributeValue.String(), namespaceAttributeValue)
+}
+
 // RecordMongodbOperationCountDataPoint adds a data point to mongodb.operation.count metric.
 func (mb *MetricsBuilder) RecordMongodbOperationCountDataPoint(ts pcommon.Timestamp, val int64, operationAttributeValue AttributeOperation) {
 	mb.metricMongodbOperationCount.recordDataPoint(mb.startTime, ts, val, operationAttributeValue.String())
@@ -2031,6 +2354,11 @@ func (mb *MetricsBuilder) RecordMongodbOperationTimeDataPoint(ts pcommon.Timesta
 	mb.metricMongodbOperationTime.recordDataPoint(mb.startTime, ts, val, operationAttributeValue.String())
 }
 
+// RecordMongodbReplsetMemberLagDataPoint adds a data point to mongodb.replset.member.lag metric.
+func (mb *MetricsBuilder) RecordMongodbReplsetMemberLagDataPoint(ts pcommon.Timestamp, val int64, replicaSetAttributeValue string, memberAttributeValue string) {
+	mb.metricMongodbReplsetMemberLag.recordDataPoint(mb.startTime, ts, val, replicaSetAttributeValue, memberAttributeValue)
+}
+
 // RecordMongodbSessionCountDataPoint adds a data point to mongodb.session.count metric.
 func (mb *MetricsBuilder) RecordMongodbSessionCountDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricMongodbSessionCount.recordDataPoint(mb.startTime, ts, val)
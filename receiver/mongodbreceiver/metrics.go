@@ -18,9 +18,11 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/hashicorp/go-version"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
 
@@ -44,6 +46,17 @@ var documentMap = map[string]metadata.AttributeOperation{
 	"deleted":  metadata.AttributeOperationDelete,
 }
 
+// currentOpMap maps the op field of the current operations, other operations such as
+// killcursors are not counted.
+var currentOpMap = map[string]metadata.AttributeOperation{
+	"insert":  metadata.AttributeOperationInsert,
+	"query":   metadata.AttributeOperationQuery,
+	"update":  metadata.AttributeOperationUpdate,
+	"remove":  metadata.AttributeOperationDelete,
+	"getmore": metadata.AttributeOperationGetmore,
+	"command": metadata.AttributeOperationCommand,
+}
+
 var lockTypeMap = map[string]metadata.AttributeLockType{
 	"ParallelBatchWriterMode":    metadata.AttributeLockTypeParallelBatchWriteMode,
 	"ReplicationStateTransition": metadata.AttributeLockTypeReplicationStateTransition,
@@ -456,6 +469,127 @@ func (s *mongodbScraper) recordIndexAccess(now pcommon.Timestamp, documents []bs
 	s.mb.RecordMongodbIndexAccessCountDataPoint(now, indexAccessTotal, dbName, collectionName)
 }
 
+// Collection Stats
+func (s *mongodbScraper) recordCollectionStats(now pcommon.Timestamp, doc bson.M, dbName string, collectionName string, errs *scrapererror.ScrapeErrors) {
+	metricAttributes := fmt.Sprintf("%s, %s", dbName, collectionName)
+	if val, err := collectMetric(doc, []string{"count"}); err != nil {
+		errs.AddPartial(1, fmt.Errorf(collectMetricWithAttributes, "mongodb.collection.document.count", metricAttributes, err))
+	} else {
+		s.mb.RecordMongodbCollectionDocumentCountDataPoint(now, val, dbName, collectionName)
+	}
+	if val, err := collectMetric(doc, []string{"size"}); err != nil {
+		errs.AddPartial(1, fmt.Errorf(collectMetricWithAttributes, "mongodb.collection.size", metricAttributes, err))
+	} else {
+		s.mb.RecordMongodbCollectionSizeDataPoint(now, val, dbName, collectionName)
+	}
+	if val, err := collectMetric(doc, []string{"totalIndexSize"}); err != nil {
+		errs.AddPartial(1, fmt.Errorf(collectMetricWithAttributes, "mongodb.collection.index.size", metricAttributes, err))
+	} else {
+		s.mb.RecordMongodbCollectionIndexSizeDataPoint(now, val, dbName, collectionName)
+	}
+}
+
+// Current Operations
+func (s *mongodbScraper) recordActiveOperations(now pcommon.Timestamp, doc bson.M, errs *scrapererror.ScrapeErrors) {
+	metricName := "mongodb.operation.active.count"
+	inprog, ok := doc["inprog"].(primitive.A)
+	if !ok {
+		errs.AddPartial(1, fmt.Errorf(collectMetricError, metricName, errKeyNotFound))
+		return
+	}
+
+	type opKey struct {
+		operation metadata.AttributeOperation
+		namespace string
+	}
+	counts := map[opKey]int64{}
+	for _, item := range inprog {
+		op, ok := item.(bson.M)
+		if !ok {
+			continue
+		}
+		// the currentOp command reports itself
+		if command, ok := op["command"].(bson.M); ok {
+			if _, ok = command["currentOp"]; ok {
+				continue
+			}
+		}
+		opName, _ := op["op"].(string)
+		operation, ok := currentOpMap[opName]
+		if !ok {
+			continue
+		}
+		namespace, _ := op["ns"].(string)
+		if !s.namespaceFilter.matches(namespace) {
+			continue
+		}
+		counts[opKey{operation: operation, namespace: namespace}]++
+	}
+
+	for key, count := range counts {
+		s.mb.RecordMongodbOperationActiveCountDataPoint(now, count, key.operation, key.namespace)
+	}
+}
+
+// Replica Set Status
+func (s *mongodbScraper) recordReplSetMemberLag(now pcommon.Timestamp, doc bson.M, errs *scrapererror.ScrapeErrors) {
+	metricName := "mongodb.replset.member.lag"
+	replicaSet, _ := doc["set"].(string)
+	members, ok := doc["members"].(primitive.A)
+	if !ok {
+		errs.AddPartial(1, fmt.Errorf(collectMetricWithAttributes, metricName, replicaSet, errKeyNotFound))
+		return
+	}
+
+	var primaryOptime time.Time
+	var secondaries []bson.M
+	for _, item := range members {
+		member, ok := item.(bson.M)
+		if !ok {
+			continue
+		}
+		switch member["stateStr"] {
+		case "PRIMARY":
+			if primaryOptime, ok = optimeDate(member); !ok {
+				errs.AddPartial(1, fmt.Errorf(collectMetricWithAttributes, metricName, replicaSet, errors.New("could not find the optime of the primary")))
+				return
+			}
+		case "SECONDARY":
+			secondaries = append(secondaries, member)
+		}
+	}
+	if primaryOptime.IsZero() {
+		// the lag is unknown while a primary is elected
+		errs.AddPartial(len(secondaries), fmt.Errorf(collectMetricWithAttributes, metricName, replicaSet, errors.New("no primary member")))
+		return
+	}
+
+	for _, member := range secondaries {
+		name, _ := member["name"].(string)
+		secondaryOptime, ok := optimeDate(member)
+		if !ok {
+			errs.AddPartial(1, fmt.Errorf(collectMetricWithAttributes, metricName, name, errKeyNotFound))
+			continue
+		}
+		lag := primaryOptime.Sub(secondaryOptime)
+		if lag < 0 {
+			lag = 0
+		}
+		s.mb.RecordMongodbReplsetMemberLagDataPoint(now, lag.Milliseconds(), replicaSet, name)
+	}
+}
+
+func optimeDate(member bson.M) (time.Time, bool) {
+	switch v := member["optimeDate"].(type) {
+	case primitive.DateTime:
+		return v.Time(), true
+	case time.Time:
+		return v, true
+	default:
+		return time.Time{}, false
+	}
+}
+
 // Top Stats
 func (s *mongodbScraper) recordOperationTime(now pcommon.Timestamp, doc bson.M, errs *scrapererror.ScrapeErrors) {
 	metricName := "mongodb.operation.time"
@@ -22,6 +22,7 @@ import (
 
 	"github.com/hashicorp/go-version"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
@@ -37,8 +38,13 @@ type mongodbScraper struct {
 	client       client
 	mongoVersion *version.Version
 	mb           *metadata.MetricsBuilder
+	// namespaceFilter filters the namespaces of the collection and active operation metrics.
+	namespaceFilter *namespaceFilter
 }
 
+// errCodeNoReplicationEnabled is the code of the error of replSetGetStatus on standalone servers.
+const errCodeNoReplicationEnabled = 76
+
 func newMongodbScraper(settings component.ReceiverCreateSettings, config *Config) *mongodbScraper {
 	return &mongodbScraper{
 		logger: settings.Logger,
@@ -48,6 +54,12 @@ func newMongodbScraper(settings component.ReceiverCreateSettings, config *Config
 }
 
 func (s *mongodbScraper) start(ctx context.Context, _ component.Host) error {
+	filter, err := newNamespaceFilter(s.config.Collections)
+	if err != nil {
+		return err
+	}
+	s.namespaceFilter = filter
+
 	c, err := NewClient(ctx, s.config, s.logger)
 	if err != nil {
 		return fmt.Errorf("create mongo client: %w", err)
@@ -94,6 +106,8 @@ func (s *mongodbScraper) collectMetrics(ctx context.Context, errs *scrapererror.
 	s.mb.RecordMongodbDatabaseCountDataPoint(now, int64(len(dbNames)))
 	s.collectAdminDatabase(ctx, now, errs)
 	s.collectTopStats(ctx, now, errs)
+	s.collectActiveOperations(ctx, now, errs)
+	s.collectReplSetStatus(ctx, now, errs)
 
 	for _, dbName := range dbNames {
 		s.collectDatabase(ctx, now, dbName, errs)
@@ -105,6 +119,7 @@ func (s *mongodbScraper) collectMetrics(ctx context.Context, errs *scrapererror.
 
 		for _, collectionName := range collectionNames {
 			s.collectIndexStats(ctx, now, dbName, collectionName, errs)
+			s.collectCollectionStats(ctx, now, dbName, collectionName, errs)
 		}
 	}
 }
@@ -157,6 +172,55 @@ func (s *mongodbScraper) collectIndexStats(ctx context.Context, now pcommon.Time
 	s.mb.EmitForResource()
 }
 
+func (s *mongodbScraper) collectCollectionStats(ctx context.Context, now pcommon.Timestamp, databaseName string, collectionName string, errs *scrapererror.ScrapeErrors) {
+	if !s.config.Metrics.MongodbCollectionDocumentCount.Enabled &&
+		!s.config.Metrics.MongodbCollectionSize.Enabled &&
+		!s.config.Metrics.MongodbCollectionIndexSize.Enabled {
+		return
+	}
+	if !s.namespaceFilter.matches(databaseName + "." + collectionName) {
+		return
+	}
+	collectionStats, err := s.client.CollectionStats(ctx, databaseName, collectionName)
+	if err != nil {
+		errs.AddPartial(1, fmt.Errorf("failed to fetch collection stats metrics: %w", err))
+		return
+	}
+	s.recordCollectionStats(now, collectionStats, databaseName, collectionName, errs)
+	s.mb.EmitForResource()
+}
+
+func (s *mongodbScraper) collectActiveOperations(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !s.config.Metrics.MongodbOperationActiveCount.Enabled {
+		return
+	}
+	currentOp, err := s.client.CurrentOp(ctx)
+	if err != nil {
+		errs.AddPartial(1, fmt.Errorf("failed to fetch current operations metrics: %w", err))
+		return
+	}
+	s.recordActiveOperations(now, currentOp, errs)
+	s.mb.EmitForResource()
+}
+
+func (s *mongodbScraper) collectReplSetStatus(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !s.config.Metrics.MongodbReplsetMemberLag.Enabled {
+		return
+	}
+	replSetStatus, err := s.client.ReplSetStatus(ctx)
+	if err != nil {
+		var cmdErr mongo.CommandError
+		if errors.As(err, &cmdErr) && cmdErr.Code == errCodeNoReplicationEnabled {
+			// standalone servers have no replica set members
+			return
+		}
+		errs.AddPartial(1, fmt.Errorf("failed to fetch replica set status metrics: %w", err))
+		return
+	}
+	s.recordReplSetMemberLag(now, replSetStatus, errs)
+	s.mb.EmitForResource()
+}
+
 func (s *mongodbScraper) recordDBStats(now pcommon.Timestamp, doc bson.M, dbName string, errs *scrapererror.ScrapeErrors) {
 	s.recordCollections(now, doc, dbName, errs)
 	s.recordDataSize(now, doc, dbName, errs)
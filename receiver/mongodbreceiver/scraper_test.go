@@ -27,8 +27,11 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
 	"go.uber.org/zap"
@@ -365,3 +368,101 @@ func TestTopMetricsAggregation(t *testing.T) {
 		require.EqualValues(t, expectedCommandValues, actualOperationTimeValues["commands"])
 	})
 }
+
+func TestScraperCollectionAndReplicaSetMetrics(t *testing.T) {
+	primaryOptime := time.Date(2022, 11, 15, 10, 0, 10, 0, time.UTC)
+	fc := &fakeClient{}
+	mongo40, err := version.NewVersion("4.0")
+	require.NoError(t, err)
+	fc.On("GetVersion", mock.Anything).Return(mongo40, nil)
+	fc.On("ListDatabaseNames", mock.Anything, mock.Anything, mock.Anything).Return([]string{"shop"}, nil)
+	fc.On("ServerStatus", mock.Anything, mock.Anything).Return(bson.M{}, nil)
+	fc.On("DBStats", mock.Anything, "shop").Return(bson.M{}, nil)
+	fc.On("TopStats", mock.Anything).Return(bson.M{}, nil)
+	fc.On("ListCollectionNames", mock.Anything, "shop").Return([]string{"orders", "tmp_import"}, nil)
+	fc.On("IndexStats", mock.Anything, "shop", mock.Anything).Return([]bson.M{}, nil)
+	fc.On("CollectionStats", mock.Anything, "shop", "orders").Return(bson.M{"count": int32(42), "size": int32(4096), "totalIndexSize": int64(8192)}, nil)
+	fc.On("CurrentOp", mock.Anything).Return(bson.M{"inprog": primitive.A{
+		bson.M{"op": "query", "ns": "shop.orders"},
+		bson.M{"op": "query", "ns": "shop.orders"},
+		bson.M{"op": "remove", "ns": "shop.orders"},
+		bson.M{"op": "insert", "ns": "shop.tmp_import"},
+		bson.M{"op": "none", "ns": ""},
+		bson.M{"op": "command", "ns": "admin.$cmd", "command": bson.M{"currentOp": int32(1)}},
+	}}, nil)
+	fc.On("ReplSetStatus", mock.Anything).Return(bson.M{"set": "rs0", "members": primitive.A{
+		bson.M{"name": "mongo-0:27017", "stateStr": "PRIMARY", "optimeDate": primitive.NewDateTimeFromTime(primaryOptime)},
+		bson.M{"name": "mongo-1:27017", "stateStr": "SECONDARY", "optimeDate": primitive.NewDateTimeFromTime(primaryOptime.Add(-1500 * time.Millisecond))},
+		bson.M{"name": "mongo-2:27017", "stateStr": "ARBITER"},
+	}}, nil)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics.MongodbCollectionDocumentCount.Enabled = true
+	cfg.Metrics.MongodbCollectionSize.Enabled = true
+	cfg.Metrics.MongodbCollectionIndexSize.Enabled = true
+	cfg.Metrics.MongodbOperationActiveCount.Enabled = true
+	cfg.Metrics.MongodbReplsetMemberLag.Enabled = true
+	cfg.Collections.Exclude = []string{`\.tmp_`}
+	scraper := newMongodbScraper(componenttest.NewNopReceiverCreateSettings(), cfg)
+	scraper.namespaceFilter, err = newNamespaceFilter(cfg.Collections)
+	require.NoError(t, err)
+	scraper.client = fc
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.Error(t, err) // the empty server status and stats documents
+	fc.AssertNotCalled(t, "CollectionStats", mock.Anything, "shop", "tmp_import")
+
+	points := map[string][]pmetric.NumberDataPoint{}
+	rms := actualMetrics.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		ms := rms.At(i).ScopeMetrics().At(0).Metrics()
+		for j := 0; j < ms.Len(); j++ {
+			m := ms.At(j)
+			dps := pmetric.NewNumberDataPointSlice()
+			switch m.Type() {
+			case pmetric.MetricTypeSum:
+				dps = m.Sum().DataPoints()
+			case pmetric.MetricTypeGauge:
+				dps = m.Gauge().DataPoints()
+			}
+			for k := 0; k < dps.Len(); k++ {
+				points[m.Name()] = append(points[m.Name()], dps.At(k))
+			}
+		}
+	}
+
+	require.Len(t, points["mongodb.collection.document.count"], 1)
+	require.EqualValues(t, 42, points["mongodb.collection.document.count"][0].IntValue())
+	require.EqualValues(t, 4096, points["mongodb.collection.size"][0].IntValue())
+	require.EqualValues(t, 8192, points["mongodb.collection.index.size"][0].IntValue())
+
+	activeOps := map[string]int64{}
+	for _, dp := range points["mongodb.operation.active.count"] {
+		operation, _ := dp.Attributes().Get("operation")
+		namespace, _ := dp.Attributes().Get("namespace")
+		activeOps[operation.Str()+" "+namespace.Str()] = dp.IntValue()
+	}
+	require.Equal(t, map[string]int64{"query shop.orders": 2, "delete shop.orders": 1}, activeOps)
+
+	require.Len(t, points["mongodb.replset.member.lag"], 1)
+	lag := points["mongodb.replset.member.lag"][0]
+	require.EqualValues(t, 1500, lag.IntValue())
+	member, _ := lag.Attributes().Get("member")
+	require.Equal(t, "mongo-1:27017", member.Str())
+	replicaSet, _ := lag.Attributes().Get("replica_set")
+	require.Equal(t, "rs0", replicaSet.Str())
+}
+
+func TestScraperReplSetStatusStandalone(t *testing.T) {
+	fc := &fakeClient{}
+	fc.On("ReplSetStatus", mock.Anything).Return(bson.M{}, mongo.CommandError{Code: errCodeNoReplicationEnabled, Message: "not running with --replSet"})
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics.MongodbReplsetMemberLag.Enabled = true
+	scraper := newMongodbScraper(componenttest.NewNopReceiverCreateSettings(), cfg)
+	scraper.client = fc
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.collectReplSetStatus(context.Background(), pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+}
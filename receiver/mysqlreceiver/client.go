@@ -31,6 +31,7 @@ type client interface {
 	getIndexIoWaitsStats() ([]IndexIoWaitsStats, error)
 	getStatementEventsStats() ([]StatementEventStats, error)
 	getTableLockWaitEventStats() ([]tableLockWaitEventStats, error)
+	getReplicaStatusStats() ([]replicaStatusStats, error)
 	Close() error
 }
 
@@ -57,6 +58,8 @@ type IoWaitsStats struct {
 
 type TableIoWaitsStats struct {
 	IoWaitsStats
+	avgTimerRead  int64
+	avgTimerWrite int64
 }
 
 type IndexIoWaitsStats struct {
@@ -106,6 +109,13 @@ type tableLockWaitEventStats struct {
 	sumTimerWriteExternal         int64
 }
 
+type replicaStatusStats struct {
+	// secondsBehindSource is NULL while the SQL thread is not running.
+	secondsBehindSource sql.NullInt64
+	ioRunning           string
+	sqlRunning          string
+}
+
 var _ client = (*mySQLClient)(nil)
 
 func newMySQLClient(conf *Config) client {
@@ -152,7 +162,8 @@ func (c *mySQLClient) getInnodbStats() (map[string]string, error) {
 func (c *mySQLClient) getTableIoWaitsStats() ([]TableIoWaitsStats, error) {
 	query := "SELECT OBJECT_SCHEMA, OBJECT_NAME, " +
 		"COUNT_DELETE, COUNT_FETCH, COUNT_INSERT, COUNT_UPDATE," +
-		"SUM_TIMER_DELETE, SUM_TIMER_FETCH, SUM_TIMER_INSERT, SUM_TIMER_UPDATE " +
+		"SUM_TIMER_DELETE, SUM_TIMER_FETCH, SUM_TIMER_INSERT, SUM_TIMER_UPDATE, " +
+		"AVG_TIMER_READ, AVG_TIMER_WRITE " +
 		"FROM performance_schema.table_io_waits_summary_by_table " +
 		"WHERE OBJECT_SCHEMA NOT IN ('mysql', 'performance_schema');"
 	rows, err := c.client.Query(query)
@@ -165,7 +176,8 @@ func (c *mySQLClient) getTableIoWaitsStats() ([]TableIoWaitsStats, error) {
 		var s TableIoWaitsStats
 		err := rows.Scan(&s.schema, &s.name,
 			&s.countDelete, &s.countFetch, &s.countInsert, &s.countUpdate,
-			&s.timeDelete, &s.timeFetch, &s.timeInsert, &s.timeUpdate)
+			&s.timeDelete, &s.timeFetch, &s.timeInsert, &s.timeUpdate,
+			&s.avgTimerRead, &s.avgTimerWrite)
 		if err != nil {
 			return nil, err
 		}
@@ -274,6 +286,57 @@ func (c *mySQLClient) getTableLockWaitEventStats() ([]tableLockWaitEventStats, e
 	return stats, nil
 }
 
+// getReplicaStatusStats queries the db for the status of the replication channels. MySQL 8.0.22
+// renamed the statement and its columns, so the former names are used when the new ones fail.
+func (c *mySQLClient) getReplicaStatusStats() ([]replicaStatusStats, error) {
+	rows, err := c.client.Query("SHOW REPLICA STATUS")
+	if err != nil {
+		rows, err = c.client.Query("SHOW SLAVE STATUS")
+		if err != nil {
+			return nil, err
+		}
+	}
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	var stats []replicaStatusStats
+	for rows.Next() {
+		values := make([]sql.NullString, len(cols))
+		dest := make([]interface{}, len(cols))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+
+		var s replicaStatusStats
+		for i, col := range cols {
+			switch col {
+			case "Seconds_Behind_Source", "Seconds_Behind_Master":
+				if values[i].Valid {
+					behind, err := parseInt(values[i].String)
+					if err != nil {
+						return nil, err
+					}
+					s.secondsBehindSource = sql.NullInt64{Int64: behind, Valid: true}
+				}
+			case "Replica_IO_Running", "Slave_IO_Running":
+				s.ioRunning = values[i].String
+			case "Replica_SQL_Running", "Slave_SQL_Running":
+				s.sqlRunning = values[i].String
+			}
+		}
+		stats = append(stats, s)
+	}
+
+	return stats, nil
+}
+
 func Query(c mySQLClient, query string) (map[string]string, error) {
 	rows, err := c.client.Query(query)
 	if err != nil {
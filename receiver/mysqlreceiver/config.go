@@ -15,6 +15,8 @@
 package mysqlreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mysqlreceiver"
 
 import (
+	"fmt"
+	"regexp"
 	"time"
 
 	"go.opentelemetry.io/collector/config/confignet"
@@ -38,6 +40,7 @@ type Config struct {
 	confignet.NetAddr                       `mapstructure:",squash"`
 	Metrics                                 metadata.MetricsSettings `mapstructure:"metrics"`
 	StatementEvents                         StatementEventsConfig    `mapstructure:"statement_events"`
+	Tables                                  TablesConfig             `mapstructure:"tables"`
 }
 
 type StatementEventsConfig struct {
@@ -45,3 +48,19 @@ type StatementEventsConfig struct {
 	Limit           int           `mapstructure:"limit"`
 	TimeLimit       time.Duration `mapstructure:"time_limit"`
 }
+
+// TablesConfig bounds the cardinality of the per-table and per-index metrics.
+type TablesConfig struct {
+	// Include is a list of regular expressions matched against `<schema>.<table>`.
+	// When empty, the metrics of all tables are reported.
+	Include []string `mapstructure:"include"`
+}
+
+func (cfg *Config) Validate() error {
+	for _, pattern := range cfg.Tables.Include {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("invalid table include pattern %q: %w", pattern, err)
+		}
+	}
+	return nil
+}
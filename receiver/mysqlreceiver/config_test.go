@@ -41,6 +41,15 @@ func TestLoadConfig(t *testing.T) {
 	expected.Password = "$MYSQL_PASSWORD"
 	expected.Database = "otel"
 	expected.CollectionInterval = 10 * time.Second
+	expected.Tables.Include = []string{`^otel\.`}
 
 	require.Equal(t, expected, cfg)
 }
+
+func TestValidate(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	require.NoError(t, cfg.Validate())
+
+	cfg.Tables.Include = []string{`^otel\.`, "("}
+	require.ErrorContains(t, cfg.Validate(), `invalid table include pattern "("`)
+}
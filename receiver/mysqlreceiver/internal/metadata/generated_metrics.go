@@ -39,45 +39,48 @@ func (ms *MetricSettings) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsSettings provides settings for mysqlreceiver metrics.
 type MetricsSettings struct {
-	MysqlBufferPoolDataPages     MetricSettings `mapstructure:"mysql.buffer_pool.data_pages"`
-	MysqlBufferPoolLimit         MetricSettings `mapstructure:"mysql.buffer_pool.limit"`
-	MysqlBufferPoolOperations    MetricSettings `mapstructure:"mysql.buffer_pool.operations"`
-	MysqlBufferPoolPageFlushes   MetricSettings `mapstructure:"mysql.buffer_pool.page_flushes"`
-	MysqlBufferPoolPages         MetricSettings `mapstructure:"mysql.buffer_pool.pages"`
-	MysqlBufferPoolUsage         MetricSettings `mapstructure:"mysql.buffer_pool.usage"`
-	MysqlClientNetworkIo         MetricSettings `mapstructure:"mysql.client.network.io"`
-	MysqlCommands                MetricSettings `mapstructure:"mysql.commands"`
-	MysqlConnectionErrors        MetricSettings `mapstructure:"mysql.connection.errors"`
-	MysqlDoubleWrites            MetricSettings `mapstructure:"mysql.double_writes"`
-	MysqlHandlers                MetricSettings `mapstructure:"mysql.handlers"`
-	MysqlIndexIoWaitCount        MetricSettings `mapstructure:"mysql.index.io.wait.count"`
-	MysqlIndexIoWaitTime         MetricSettings `mapstructure:"mysql.index.io.wait.time"`
-	MysqlJoins                   MetricSettings `mapstructure:"mysql.joins"`
-	MysqlLockedConnects          MetricSettings `mapstructure:"mysql.locked_connects"`
-	MysqlLocks                   MetricSettings `mapstructure:"mysql.locks"`
-	MysqlLogOperations           MetricSettings `mapstructure:"mysql.log_operations"`
-	MysqlMysqlxConnections       MetricSettings `mapstructure:"mysql.mysqlx_connections"`
-	MysqlMysqlxWorkerThreads     MetricSettings `mapstructure:"mysql.mysqlx_worker_threads"`
-	MysqlOpenedResources         MetricSettings `mapstructure:"mysql.opened_resources"`
-	MysqlOperations              MetricSettings `mapstructure:"mysql.operations"`
-	MysqlPageOperations          MetricSettings `mapstructure:"mysql.page_operations"`
-	MysqlQueryClientCount        MetricSettings `mapstructure:"mysql.query.client.count"`
-	MysqlQueryCount              MetricSettings `mapstructure:"mysql.query.count"`
-	MysqlQuerySlowCount          MetricSettings `mapstructure:"mysql.query.slow.count"`
-	MysqlRowLocks                MetricSettings `mapstructure:"mysql.row_locks"`
-	MysqlRowOperations           MetricSettings `mapstructure:"mysql.row_operations"`
-	MysqlSorts                   MetricSettings `mapstructure:"mysql.sorts"`
-	MysqlStatementEventCount     MetricSettings `mapstructure:"mysql.statement_event.count"`
-	MysqlStatementEventWaitTime  MetricSettings `mapstructure:"mysql.statement_event.wait.time"`
-	MysqlTableIoWaitCount        MetricSettings `mapstructure:"mysql.table.io.wait.count"`
-	MysqlTableIoWaitTime         MetricSettings `mapstructure:"mysql.table.io.wait.time"`
-	MysqlTableLockWaitReadCount  MetricSettings `mapstructure:"mysql.table.lock_wait.read.count"`
-	MysqlTableLockWaitReadTime   MetricSettings `mapstructure:"mysql.table.lock_wait.read.time"`
-	MysqlTableLockWaitWriteCount MetricSettings `mapstructure:"mysql.table.lock_wait.write.count"`
-	MysqlTableLockWaitWriteTime  MetricSettings `mapstructure:"mysql.table.lock_wait.write.time"`
-	MysqlTableOpenCache          MetricSettings `mapstructure:"mysql.table_open_cache"`
-	MysqlThreads                 MetricSettings `mapstructure:"mysql.threads"`
-	MysqlTmpResources            MetricSettings `mapstructure:"mysql.tmp_resources"`
+	MysqlBufferPoolDataPages       MetricSettings `mapstructure:"mysql.buffer_pool.data_pages"`
+	MysqlBufferPoolLimit           MetricSettings `mapstructure:"mysql.buffer_pool.limit"`
+	MysqlBufferPoolOperations      MetricSettings `mapstructure:"mysql.buffer_pool.operations"`
+	MysqlBufferPoolPageFlushes     MetricSettings `mapstructure:"mysql.buffer_pool.page_flushes"`
+	MysqlBufferPoolPages           MetricSettings `mapstructure:"mysql.buffer_pool.pages"`
+	MysqlBufferPoolUsage           MetricSettings `mapstructure:"mysql.buffer_pool.usage"`
+	MysqlClientNetworkIo           MetricSettings `mapstructure:"mysql.client.network.io"`
+	MysqlCommands                  MetricSettings `mapstructure:"mysql.commands"`
+	MysqlConnectionErrors          MetricSettings `mapstructure:"mysql.connection.errors"`
+	MysqlDoubleWrites              MetricSettings `mapstructure:"mysql.double_writes"`
+	MysqlHandlers                  MetricSettings `mapstructure:"mysql.handlers"`
+	MysqlIndexIoWaitCount          MetricSettings `mapstructure:"mysql.index.io.wait.count"`
+	MysqlIndexIoWaitTime           MetricSettings `mapstructure:"mysql.index.io.wait.time"`
+	MysqlJoins                     MetricSettings `mapstructure:"mysql.joins"`
+	MysqlLockedConnects            MetricSettings `mapstructure:"mysql.locked_connects"`
+	MysqlLocks                     MetricSettings `mapstructure:"mysql.locks"`
+	MysqlLogOperations             MetricSettings `mapstructure:"mysql.log_operations"`
+	MysqlMysqlxConnections         MetricSettings `mapstructure:"mysql.mysqlx_connections"`
+	MysqlMysqlxWorkerThreads       MetricSettings `mapstructure:"mysql.mysqlx_worker_threads"`
+	MysqlOpenedResources           MetricSettings `mapstructure:"mysql.opened_resources"`
+	MysqlOperations                MetricSettings `mapstructure:"mysql.operations"`
+	MysqlPageOperations            MetricSettings `mapstructure:"mysql.page_operations"`
+	MysqlQueryClientCount          MetricSettings `mapstructure:"mysql.query.client.count"`
+	MysqlQueryCount                MetricSettings `mapstructure:"mysql.query.count"`
+	MysqlQuerySlowCount            MetricSettings `mapstructure:"mysql.query.slow.count"`
+	MysqlReplicaThreadRunning      MetricSettings `mapstructure:"mysql.replica.thread.running"`
+	MysqlReplicaTimeBehindSource   MetricSettings `mapstructure:"mysql.replica.time_behind_source"`
+	MysqlRowLocks                  MetricSettings `mapstructure:"mysql.row_locks"`
+	MysqlRowOperations             MetricSettings `mapstructure:"mysql.row_operations"`
+	MysqlSorts                     MetricSettings `mapstructure:"mysql.sorts"`
+	MysqlStatementEventCount       MetricSettings `mapstructure:"mysql.statement_event.count"`
+	MysqlStatementEventWaitTime    MetricSettings `mapstructure:"mysql.statement_event.wait.time"`
+	MysqlTableIoWaitAverageLatency MetricSettings `mapstructure:"mysql.table.io.wait.average_latency"`
+	MysqlTableIoWaitCount          MetricSettings `mapstructure:"mysql.table.io.wait.count"`
+	MysqlTableIoWaitTime           MetricSettings `mapstructure:"mysql.table.io.wait.time"`
+	MysqlTableLockWaitReadCount    MetricSettings `mapstructure:"mysql.table.lock_wait.read.count"`
+	MysqlTableLockWaitReadTime     MetricSettings `mapstructure:"mysql.table.lock_wait.read.time"`
+	MysqlTableLockWaitWriteCount   MetricSettings `mapstructure:"mysql.table.lock_wait.write.count"`
+	MysqlTableLockWaitWriteTime    MetricSettings `mapstructure:"mysql.table.lock_wait.write.time"`
+	MysqlTableOpenCache            MetricSettings `mapstructure:"mysql.table_open_cache"`
+	MysqlThreads                   MetricSettings `mapstructure:"mysql.threads"`
+	MysqlTmpResources              MetricSettings `mapstructure:"mysql.tmp_resources"`
 }
 
 func DefaultMetricsSettings() MetricsSettings {
@@ -157,6 +160,12 @@ func DefaultMetricsSettings() MetricsSettings {
 		MysqlQuerySlowCount: MetricSettings{
 			Enabled: false,
 		},
+		MysqlReplicaThreadRunning: MetricSettings{
+			Enabled: false,
+		},
+		MysqlReplicaTimeBehindSource: MetricSettings{
+			Enabled: false,
+		},
 		MysqlRowLocks: MetricSettings{
 			Enabled: true,
 		},
@@ -172,6 +181,9 @@ func DefaultMetricsSettings() MetricsSettings {
 		MysqlStatementEventWaitTime: MetricSettings{
 			Enabled: false,
 		},
+		MysqlTableIoWaitAverageLatency: MetricSettings{
+			Enabled: false,
+		},
 		MysqlTableIoWaitCount: MetricSettings{
 			Enabled: true,
 		},
@@ -648,6 +660,32 @@ var MapAttributeHandler = map[string]AttributeHandler{
 	"write":              AttributeHandlerWrite,
 }
 
+// AttributeIoOperation specifies the a value io_operation attribute.
+type AttributeIoOperation int
+
+const (
+	_ AttributeIoOperation = iota
+	AttributeIoOperationRead
+	AttributeIoOperationWrite
+)
+
+// String returns the string representation of the AttributeIoOperation.
+func (av AttributeIoOperation) String() string {
+	switch av {
+	case AttributeIoOperationRead:
+		return "read"
+	case AttributeIoOperationWrite:
+		return "write"
+	}
+	return ""
+}
+
+// MapAttributeIoOperation is a helper map of string to AttributeIoOperation attribute value.
+var MapAttributeIoOperation = map[string]AttributeIoOperation{
+	"read":  AttributeIoOperationRead,
+	"write": AttributeIoOperationWrite,
+}
+
 // AttributeIoWaitsOperations specifies the a value io_waits_operations attribute.
 type AttributeIoWaitsOperations int
 
@@ -930,6 +968,32 @@ var MapAttributeReadLockType = map[string]AttributeReadLockType{
 	"external":          AttributeReadLockTypeExternal,
 }
 
+// AttributeReplicaThread specifies the a value replica_thread attribute.
+type AttributeReplicaThread int
+
+const (
+	_ AttributeReplicaThread = iota
+	AttributeReplicaThreadIo
+	AttributeReplicaThreadSql
+)
+
+// String returns the string representation of the AttributeReplicaThread.
+func (av AttributeReplicaThread) String() string {
+	switch av {
+	case AttributeReplicaThreadIo:
+		return "io"
+	case AttributeReplicaThreadSql:
+		return "sql"
+	}
+	return ""
+}
+
+// MapAttributeReplicaThread is a helper map of string to AttributeReplicaThread attribute value.
+var MapAttributeReplicaThread = map[string]AttributeReplicaThread{
+	"io":  AttributeReplicaThreadIo,
+	"sql": AttributeReplicaThreadSql,
+}
+
 // AttributeRowLocks specifies the a value row_locks attribute.
 type AttributeRowLocks int
 
@@ -2445,6 +2509,106 @@ func newMetricMysqlQuerySlowCount(settings MetricSettings) metricMysqlQuerySlowC
 	return m
 }
 
+type metricMysqlReplicaThreadRunning struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mysql.replica.thread.running metric with initial data.
+func (m *metricMysqlReplicaThreadRunning) init() {
+	m.data.SetName("mysql.replica.thread.running")
+	m.data.SetDescription("Whether the replication thread is running (1) or not (0).")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricMysqlReplicaThreadRunning) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, replicaThreadAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("thread", replicaThreadAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMysqlReplicaThreadRunning) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMysqlReplicaThreadRunning) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMysqlReplicaThreadRunning(settings MetricSettings) metricMysqlReplicaThreadRunning {
+	m := metricMysqlReplicaThreadRunning{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricMysqlReplicaTimeBehindSource struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mysql.replica.time_behind_source metric with initial data.
+func (m *metricMysqlReplicaTimeBehindSource) init() {
+	m.data.SetName("mysql.replica.time_behind_source")
+	m.data.SetDescription("The time the replica is behind the source, as reported by `Seconds_Behind_Source`.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricMysqlReplicaTimeBehindSource) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMysqlReplicaTimeBehindSource) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMysqlReplicaTimeBehindSource) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMysqlReplicaTimeBehindSource(settings MetricSettings) metricMysqlReplicaTimeBehindSource {
+	m := metricMysqlReplicaTimeBehindSource{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricMysqlRowLocks struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -2715,6 +2879,59 @@ func newMetricMysqlStatementEventWaitTime(settings MetricSettings) metricMysqlSt
 	return m
 }
 
+type metricMysqlTableIoWaitAverageLatency struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mysql.table.io.wait.average_latency metric with initial data.
+func (m *metricMysqlTableIoWaitAverageLatency) init() {
+	m.data.SetName("mysql.table.io.wait.average_latency")
+	m.data.SetDescription("The average latency of the read and write I/O wait events for a table.")
+	m.data.SetUnit("ns")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricMysqlTableIoWaitAverageLatency) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, ioOperationAttributeValue string, tableNameAttributeValue string, schemaAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("operation", ioOperationAttributeValue)
+	dp.Attributes().PutStr("table", tableNameAttributeValue)
+	dp.Attributes().PutStr("schema", schemaAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMysqlTableIoWaitAverageLatency) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMysqlTableIoWaitAverageLatency) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMysqlTableIoWaitAverageLatency(settings MetricSettings) metricMysqlTableIoWaitAverageLatency {
+	m := metricMysqlTableIoWaitAverageLatency{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricMysqlTableIoWaitCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -3207,50 +3424,53 @@ func newMetricMysqlTmpResources(settings MetricSettings) metricMysqlTmpResources
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user settings.
 type MetricsBuilder struct {
-	startTime                          pcommon.Timestamp   // start time that will be applied to all recorded data points.
-	metricsCapacity                    int                 // maximum observed number of metrics per resource.
-	resourceCapacity                   int                 // maximum observed number of resource attributes.
-	metricsBuffer                      pmetric.Metrics     // accumulates metrics data before emitting.
-	buildInfo                          component.BuildInfo // contains version information
-	metricMysqlBufferPoolDataPages     metricMysqlBufferPoolDataPages
-	metricMysqlBufferPoolLimit         metricMysqlBufferPoolLimit
-	metricMysqlBufferPoolOperations    metricMysqlBufferPoolOperations
-	metricMysqlBufferPoolPageFlushes   metricMysqlBufferPoolPageFlushes
-	metricMysqlBufferPoolPages         metricMysqlBufferPoolPages
-	metricMysqlBufferPoolUsage         metricMysqlBufferPoolUsage
-	metricMysqlClientNetworkIo         metricMysqlClientNetworkIo
-	metricMysqlCommands                metricMysqlCommands
-	metricMysqlConnectionErrors        metricMysqlConnectionErrors
-	metricMysqlDoubleWrites            metricMysqlDoubleWrites
-	metricMysqlHandlers                metricMysqlHandlers
-	metricMysqlIndexIoWaitCount        metricMysqlIndexIoWaitCount
-	metricMysqlIndexIoWaitTime         metricMysqlIndexIoWaitTime
-	metricMysqlJoins                   metricMysqlJoins
-	metricMysqlLockedConnects          metricMysqlLockedConnects
-	metricMysqlLocks                   metricMysqlLocks
-	metricMysqlLogOperations           metricMysqlLogOperations
-	metricMysqlMysqlxConnections       metricMysqlMysqlxConnections
-	metricMysqlMysqlxWorkerThreads     metricMysqlMysqlxWorkerThreads
-	metricMysqlOpenedResources         metricMysqlOpenedResources
-	metricMysqlOperations              metricMysqlOperations
-	metricMysqlPageOperations          metricMysqlPageOperations
-	metricMysqlQueryClientCount        metricMysqlQueryClientCount
-	metricMysqlQueryCount              metricMysqlQueryCount
-	metricMysqlQuerySlowCount          metricMysqlQuerySlowCount
-	metricMysqlRowLocks                metricMysqlRowLocks
-	metricMysqlRowOperations           metricMysqlRowOperations
-	metricMysqlSorts                   metricMysqlSorts
-	metricMysqlStatementEventCount     metricMysqlStatementEventCount
-	metricMysqlStatementEventWaitTime  metricMysqlStatementEventWaitTime
-	metricMysqlTableIoWaitCount        metricMysqlTableIoWaitCount
-	metricMysqlTableIoWaitTime         metricMysqlTableIoWaitTime
-	metricMysqlTableLockWaitReadCount  metricMysqlTableLockWaitReadCount
-	metricMysqlTableLockWaitReadTime   metricMysqlTableLockWaitReadTime
-	metricMysqlTableLockWaitWriteCount metricMysqlTableLockWaitWriteCount
-	metricMysqlTableLockWaitWriteTime  metricMysqlTableLockWaitWriteTime
-	metricMysqlTableOpenCache          metricMysqlTableOpenCache
-	metricMysqlThreads                 metricMysqlThreads
-	metricMysqlTmpResources            metricMysqlTmpResources
+	startTime                            pcommon.Timestamp   // start time that will be applied to all recorded data points.
+	metricsCapacity                      int                 // maximum observed number of metrics per resource.
+	resourceCapacity                     int                 // maximum observed number of resource attributes.
+	metricsBuffer                        pmetric.Metrics     // accumulates metrics data before emitting.
+	buildInfo                            component.BuildInfo // contains version information
+	metricMysqlBufferPoolDataPages       metricMysqlBufferPoolDataPages
+	metricMysqlBufferPoolLimit           metricMysqlBufferPoolLimit
+	metricMysqlBufferPoolOperations      metricMysqlBufferPoolOperations
+	metricMysqlBufferPoolPageFlushes     metricMysqlBufferPoolPageFlushes
+	metricMysqlBufferPoolPages           metricMysqlBufferPoolPages
+	metricMysqlBufferPoolUsage           metricMysqlBufferPoolUsage
+	metricMysqlClientNetworkIo           metricMysqlClientNetworkIo
+	metricMysqlCommands                  metricMysqlCommands
+	metricMysqlConnectionErrors          metricMysqlConnectionErrors
+	metricMysqlDoubleWrites              metricMysqlDoubleWrites
+	metricMysqlHandlers                  metricMysqlHandlers
+	metricMysqlIndexIoWaitCount          metricMysqlIndexIoWaitCount
+	metricMysqlIndexIoWaitTime           metricMysqlIndexIoWaitTime
+	metricMysqlJoins                     metricMysqlJoins
+	metricMysqlLockedConnects            metricMysqlLockedConnects
+	metricMysqlLocks                     metricMysqlLocks
+	metricMysqlLogOperations             metricMysqlLogOperations
+	metricMysqlMysqlxConnections         metricMysqlMysqlxConnections
+	metricMysqlMysqlxWorkerThreads       metricMysqlMysqlxWorkerThreads
+	metricMysqlOpenedResources           metricMysqlOpenedResources
+	metricMysqlOperations                metricMysqlOperations
+	metricMysqlPageOperations            metricMysqlPageOperations
+	metricMysqlQueryClientCount          metricMysqlQueryClientCount
+	metricMysqlQueryCount                metricMysqlQueryCount
+	metricMysqlQuerySlowCount            metricMysqlQuerySlowCount
+	metricMysqlReplicaThreadRunning      metricMysqlReplicaThreadRunning
+	metricMysqlReplicaTimeBehindSource   metricMysqlReplicaTimeBehindSource
+	metricMysqlRowLocks                  metricMysqlRowLocks
+	metricMysqlRowOperations             metricMysqlRowOperations
+	metricMysqlSorts                     metricMysqlSorts
+	metricMysqlStatementEventCount       metricMysqlStatementEventCount
+	metricMysqlStatementEventWaitTime    metricMysqlStatementEventWaitTime
+	metricMysqlTableIoWaitAverageLatency metricMysqlTableIoWaitAverageLatency
+	metricMysqlTableIoWaitCount          metricMysqlTableIoWaitCount
+	metricMysqlTableIoWaitTime           metricMysqlTableIoWaitTime
+	metricMysqlTableLockWaitReadCount    metricMysqlTableLockWaitReadCount
+	metricMysqlTableLockWaitReadTime     metricMysqlTableLockWaitReadTime
+	metricMysqlTableLockWaitWriteCount   metricMysqlTableLockWaitWriteCount
+	metricMysqlTableLockWaitWriteTime    metricMysqlTableLockWaitWriteTime
+	metricMysqlTableOpenCache            metricMysqlTableOpenCache
+	metricMysqlThreads                   metricMysqlThreads
+	metricMysqlTmpResources              metricMysqlTmpResources
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -3265,48 +3485,51 @@ func WithStartTime(startTime pcommon.Timestamp) metricBuilderOption {
 
 func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo, options ...metricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		startTime:                          pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                      pmetric.NewMetrics(),
-		buildInfo:                          buildInfo,
-		metricMysqlBufferPoolDataPages:     newMetricMysqlBufferPoolDataPages(settings.MysqlBufferPoolDataPages),
-		metricMysqlBufferPoolLimit:         newMetricMysqlBufferPoolLimit(settings.MysqlBufferPoolLimit),
-		metricMysqlBufferPoolOperations:    newMetricMysqlBufferPoolOperations(settings.MysqlBufferPoolOperations),
-		metricMysqlBufferPoolPageFlushes:   newMetricMysqlBufferPoolPageFlushes(settings.MysqlBufferPoolPageFlushes),
-		metricMysqlBufferPoolPages:         newMetricMysqlBufferPoolPages(settings.MysqlBufferPoolPages),
-		metricMysqlBufferPoolUsage:         newMetricMysqlBufferPoolUsage(settings.MysqlBufferPoolUsage),
-		metricMysqlClientNetworkIo:         newMetricMysqlClientNetworkIo(settings.MysqlClientNetworkIo),
-		metricMysqlCommands:                newMetricMysqlCommands(settings.MysqlCommands),
-		metricMysqlConnectionErrors:        newMetricMysqlConnectionErrors(settings.MysqlConnectionErrors),
-		metricMysqlDoubleWrites:            newMetricMysqlDoubleWrites(settings.MysqlDoubleWrites),
-		metricMysqlHandlers:                newMetricMysqlHandlers(settings.MysqlHandlers),
-		metricMysqlIndexIoWaitCount:        newMetricMysqlIndexIoWaitCount(settings.MysqlIndexIoWaitCount),
-		metricMysqlIndexIoWaitTime:         newMetricMysqlIndexIoWaitTime(settings.MysqlIndexIoWaitTime),
-		metricMysqlJoins:                   newMetricMysqlJoins(settings.MysqlJoins),
-		metricMysqlLockedConnects:          newMetricMysqlLockedConnects(settings.MysqlLockedConnects),
-		metricMysqlLocks:                   newMetricMysqlLocks(settings.MysqlLocks),
-		metricMysqlLogOperations:           newMetricMysqlLogOperations(settings.MysqlLogOperations),
-		metricMysqlMysqlxConnections:       newMetricMysqlMysqlxConnections(settings.MysqlMysqlxConnections),
-		metricMysqlMysqlxWorkerThreads:     newMetricMysqlMysqlxWorkerThreads(settings.MysqlMysqlxWorkerThreads),
-		metricMysqlOpenedResources:         newMetricMysqlOpenedResources(settings.MysqlOpenedResources),
-		metricMysqlOperations:              newMetricMysqlOperations(settings.MysqlOperations),
-		metricMysqlPageOperations:          newMetricMysqlPageOperations(settings.MysqlPageOperations),
-		metricMysqlQueryClientCount:        newMetricMysqlQueryClientCount(settings.MysqlQueryClientCount),
-		metricMysqlQueryCount:              newMetricMysqlQueryCount(settings.MysqlQueryCount),
-		metricMysqlQuerySlowCount:          newMetricMysqlQuerySlowCount(settings.MysqlQuerySlowCount),
-		metricMysqlRowLocks:                newMetricMysqlRowLocks(settings.MysqlRowLocks),
-		metricMysqlRowOperations:           newMetricMysqlRowOperations(settings.MysqlRowOperations),
-		metricMysqlSorts:                   newMetricMysqlSorts(settings.MysqlSorts),
-		metricMysqlStatementEventCount:     newMetricMysqlStatementEventCount(settings.MysqlStatementEventCount),
-		metricMysqlStatementEventWaitTime:  newMetricMysqlStatementEventWaitTime(settings.MysqlStatementEventWaitTime),
-		metricMysqlTableIoWaitCount:        newMetricMysqlTableIoWaitCount(settings.MysqlTableIoWaitCount),
-		metricMysqlTableIoWaitTime:         newMetricMysqlTableIoWaitTime(settings.MysqlTableIoWaitTime),
-		metricMysqlTableLockWaitReadCount:  newMetricMysqlTableLockWaitReadCount(settings.MysqlTableLockWaitReadCount),
-		metricMysqlTableLockWaitReadTime:   newMetricMysqlTableLockWaitReadTime(settings.MysqlTableLockWaitReadTime),
-		metricMysqlTableLockWaitWriteCount: newMetricMysqlTableLockWaitWriteCount(settings.MysqlTableLockWaitWriteCount),
-		metricMysqlTableLockWaitWriteTime:  newMetricMysqlTableLockWaitWriteTime(settings.MysqlTableLockWaitWriteTime),
-		metricMysqlTableOpenCache:          newMetricMysqlTableOpenCache(settings.MysqlTableOpenCache),
-		metricMysqlThreads:                 newMetricMysqlThreads(settings.MysqlThreads),
-		metricMysqlTmpResources:            newMetricMysqlTmpResources(settings.MysqlTmpResources),
+		startTime:                            pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                        pmetric.NewMetrics(),
+		buildInfo:                            buildInfo,
+		metricMysqlBufferPoolDataPages:       newMetricMysqlBufferPoolDataPages(settings.MysqlBufferPoolDataPages),
+		metricMysqlBufferPoolLimit:           newMetricMysqlBufferPoolLimit(settings.MysqlBufferPoolLimit),
+		metricMysqlBufferPoolOperations:      newMetricMysqlBufferPoolOperations(settings.MysqlBufferPoolOperations),
+		metricMysqlBufferPoolPageFlushes:     newMetricMysqlBufferPoolPageFlushes(settings.MysqlBufferPoolPageFlushes),
+		metricMysqlBufferPoolPages:           newMetricMysqlBufferPoolPages(settings.MysqlBufferPoolPages),
+		metricMysqlBufferPoolUsage:           newMetricMysqlBufferPoolUsage(settings.MysqlBufferPoolUsage),
+		metricMysqlClientNetworkIo:           newMetricMysqlClientNetworkIo(settings.MysqlClientNetworkIo),
+		metricMysqlCommands:                  newMetricMysqlCommands(settings.MysqlCommands),
+		metricMysqlConnectionErrors:          newMetricMysqlConnectionErrors(settings.MysqlConnectionErrors),
+		metricMysqlDoubleWrites:              newMetricMysqlDoubleWrites(settings.MysqlDoubleWrites),
+		metricMysqlHandlers:                  newMetricMysqlHandlers(settings.MysqlHandlers),
+		metricMysqlIndexIoWaitCount:          newMetricMysqlIndexIoWaitCount(settings.MysqlIndexIoWaitCount),
+		metricMysqlIndexIoWaitTime:           newMetricMysqlIndexIoWaitTime(settings.MysqlIndexIoWaitTime),
+		metricMysqlJoins:                     newMetricMysqlJoins(settings.MysqlJoins),
+		metricMysqlLockedConnects:            newMetricMysqlLockedConnects(settings.MysqlLockedConnects),
+		metricMysqlLocks:                     newMetricMysqlLocks(settings.MysqlLocks),
+		metricMysqlLogOperations:             newMetricMysqlLogOperations(settings.MysqlLogOperations),
+		metricMysqlMysqlxConnections:         newMetricMysqlMysqlxConnections(settings.MysqlMysqlxConnections),
+		metricMysqlMysqlxWorkerThreads:       newMetricMysqlMysqlxWorkerThreads(settings.MysqlMysqlxWorkerThreads),
+		metricMysqlOpenedResources:           newMetricMysqlOpenedResources(settings.MysqlOpenedResources),
+		metricMysqlOperations:                newMetricMysqlOperations(settings.MysqlOperations),
+		metricMysqlPageOperations:            newMetricMysqlPageOperations(settings.MysqlPageOperations),
+		metricMysqlQueryClientCount:          newMetricMysqlQueryClientCount(settings.MysqlQueryClientCount),
+		metricMysqlQueryCount:                newMetricMysqlQueryCount(settings.MysqlQueryCount),
+		metricMysqlQuerySlowCount:            newMetricMysqlQuerySlowCount(settings.MysqlQuerySlowCount),
+		metricMysqlReplicaThreadRunning:      newMetricMysqlReplicaThreadRunning(settings.MysqlReplicaThreadRunning),
+		metricMysqlReplicaTimeBehindSource:   newMetricMysqlReplicaTimeBehindSource(settings.MysqlReplicaTimeBehindSource),
+		metricMysqlRowLocks:                  newMetricMysqlRowLocks(settings.MysqlRowLocks),
+		metricMysqlRowOperations:             newMetricMysqlRowOperations(settings.MysqlRowOperations),
+		metricMysqlSorts:                     newMetricMysqlSorts(settings.MysqlSorts),
+		metricMysqlStatementEventCount:       newMetricMysqlStatementEventCount(settings.MysqlStatementEventCount),
+		metricMysqlStatementEventWaitTime:    newMetricMysqlStatementEventWaitTime(settings.MysqlStatementEventWaitTime),
+		metricMysqlTableIoWaitAverageLatency: newMetricMysqlTableIoWaitAverageLatency(settings.MysqlTableIoWaitAverageLatency),
+		metricMysqlTableIoWaitCount:          newMetricMysqlTableIoWaitCount(settings.MysqlTableIoWaitCount),
+		metricMysqlTableIoWaitTime:           newMetricMysqlTableIoWaitTime(settings.MysqlTableIoWaitTime),
+		metricMysqlTableLockWaitReadCount:    newMetricMysqlTableLockWaitReadCount(settings.MysqlTableLockWaitReadCount),
+		metricMysqlTableLockWaitReadTime:     newMetricMysqlTableLockWaitReadTime(settings.MysqlTableLockWaitReadTime),
+		metricMysqlTableLockWaitWriteCount:   newMetricMysqlTableLockWaitWriteCount(settings.MysqlTableLockWaitWriteCount),
+		metricMysqlTableLockWaitWriteTime:    newMetricMysqlTableLockWaitWriteTime(settings.MysqlTableLockWaitWriteTime),
+		metricMysqlTableOpenCache:            newMetricMysqlTableOpenCache(settings.MysqlTableOpenCache),
+		metricMysqlThreads:                   newMetricMysqlThreads(settings.MysqlThreads),
+		metricMysqlTmpResources:              newMetricMysqlTmpResources(settings.MysqlTmpResources),
 	}
 	for _, op := range options {
 		op(mb)
@@ -3391,11 +3614,14 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricMysqlQueryClientCount.emit(ils.Metrics())
 	mb.metricMysqlQueryCount.emit(ils.Metrics())
 	mb.metricMysqlQuerySlowCount.emit(ils.Metrics())
+	mb.metricMysqlReplicaThreadRunning.emit(ils.Metrics())
+	mb.metricMysqlReplicaTimeBehindSource.emit(ils.Metrics())
 	mb.metricMysqlRowLocks.emit(ils.Metrics())
 	mb.metricMysqlRowOperations.emit(ils.Metrics())
 	mb.metricMysqlSorts.emit(ils.Metrics())
 	mb.metricMysqlStatementEventCount.emit(ils.Metrics())
 	mb.metricMysqlStatementEventWaitTime.emit(ils.Metrics())
+	mb.metricMysqlTableIoWaitAverageLatency.emit(ils.Metrics())
 	mb.metricMysqlTableIoWaitCount.emit(ils.Metrics())
 	mb.metricMysqlTableIoWaitTime.emit(ils.Metrics())
 	mb.metricMysqlTableLockWaitReadCount.emit(ils.Metrics())
@@ -3654,6 +3880,16 @@ func (mb *MetricsBuilder) RecordMysqlQuerySlowCountDataPoint(ts pcommon.Timestam
 	return nil
 }
 
+// RecordMysqlReplicaThreadRunningDataPoint adds a data point to mysql.replica.thread.running metric.
+func (mb *MetricsBuilder) RecordMysqlReplicaThreadRunningDataPoint(ts pcommon.Timestamp, val int64, replicaThreadAttributeValue AttributeReplicaThread) {
+	mb.metricMysqlReplicaThreadRunning.recordDataPoint(mb.startTime, ts, val, replicaThreadAttributeValue.String())
+}
+
+// RecordMysqlReplicaTimeBehindSourceDataPoint adds a data point to mysql.replica.time_behind_source metric.
+func (mb *MetricsBuilder) RecordMysqlReplicaTimeBehindSourceDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricMysqlReplicaTimeBehindSource.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordMysqlRowLocksDataPoint adds a data point to mysql.row_locks metric.
 func (mb *MetricsBuilder) RecordMysqlRowLocksDataPoint(ts pcommon.Timestamp, inputVal string, rowLocksAttributeValue AttributeRowLocks) error {
 	val, err := strconv.ParseInt(inputVal, 10, 64)
@@ -3694,6 +3930,11 @@ func (mb *MetricsBuilder) RecordMysqlStatementEventWaitTimeDataPoint(ts pcommon.
 	mb.metricMysqlStatementEventWaitTime.recordDataPoint(mb.startTime, ts, val, schemaAttributeValue, digestAttributeValue, digestTextAttributeValue)
 }
 
+// RecordMysqlTableIoWaitAverageLatencyDataPoint adds a data point to mysql.table.io.wait.average_latency metric.
+func (mb *MetricsBuilder) RecordMysqlTableIoWaitAverageLatencyDataPoint(ts pcommon.Timestamp, val int64, ioOperationAttributeValue AttributeIoOperation, tableNameAttributeValue string, schemaAttributeValue string) {
+	mb.metricMysqlTableIoWaitAverageLatency.recordDataPoint(mb.startTime, ts, val, ioOperationAttributeValue.String(), tableNameAttributeValue, schemaAttributeValue)
+}
+
 // RecordMysqlTableIoWaitCountDataPoint adds a data point to mysql.table.io.wait.count metric.
 func (mb *MetricsBuilder) RecordMysqlTableIoWaitCountDataPoint(ts pcommon.Timestamp, val int64, ioWaitsOperationsAttributeValue AttributeIoWaitsOperations, tableNameAttributeValue string, schemaAttributeValue string) {
 	mb.metricMysqlTableIoWaitCount.recordDataPoint(mb.startTime, ts, val, ioWaitsOperationsAttributeValue.String(), tableNameAttributeValue, schemaAttributeValue)
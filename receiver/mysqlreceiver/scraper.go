@@ -17,6 +17,7 @@ package mysqlreceiver // import "github.com/open-telemetry/opentelemetry-collect
 import (
 	"context"
 	"errors"
+	"regexp"
 	"strconv"
 	"time"
 
@@ -34,10 +35,40 @@ const (
 )
 
 type mySQLScraper struct {
-	sqlclient client
-	logger    *zap.Logger
-	config    *Config
-	mb        *metadata.MetricsBuilder
+	sqlclient   client
+	logger      *zap.Logger
+	config      *Config
+	mb          *metadata.MetricsBuilder
+	tableFilter tableFilter
+}
+
+// tableFilter matches `<schema>.<table>` against the configured include patterns.
+// An empty filter matches all tables.
+type tableFilter []*regexp.Regexp
+
+func newTableFilter(include []string) (tableFilter, error) {
+	var filter tableFilter
+	for _, pattern := range include {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+		filter = append(filter, re)
+	}
+	return filter, nil
+}
+
+func (f tableFilter) matches(schema, table string) bool {
+	if len(f) == 0 {
+		return true
+	}
+	name := schema + "." + table
+	for _, re := range f {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	return false
 }
 
 func newMySQLScraper(
@@ -53,10 +84,15 @@ func newMySQLScraper(
 
 // start starts the scraper by initializing the db client connection.
 func (m *mySQLScraper) start(_ context.Context, host component.Host) error {
+	filter, err := newTableFilter(m.config.Tables.Include)
+	if err != nil {
+		return err
+	}
+	m.tableFilter = filter
+
 	sqlclient := newMySQLClient(m.config)
 
-	err := sqlclient.Connect()
-	if err != nil {
+	if err = sqlclient.Connect(); err != nil {
 		return err
 	}
 	m.sqlclient = sqlclient
@@ -103,6 +139,9 @@ func (m *mySQLScraper) scrape(context.Context) (pmetric.Metrics, error) {
 	// collect lock table events metrics
 	m.scrapeTableLockWaitEventStats(now, errs)
 
+	// collect replication metrics.
+	m.scrapeReplicaStatusStats(now, errs)
+
 	// collect global status metrics.
 	m.scrapeGlobalStats(now, errs)
 
@@ -392,6 +431,9 @@ func (m *mySQLScraper) scrapeTableIoWaitsStats(now pcommon.Timestamp, errs *scra
 
 	for i := 0; i < len(tableIoWaitsStats); i++ {
 		s := tableIoWaitsStats[i]
+		if !m.tableFilter.matches(s.schema, s.name) {
+			continue
+		}
 		// counts
 		m.mb.RecordMysqlTableIoWaitCountDataPoint(now, s.countDelete, metadata.AttributeIoWaitsOperationsDelete, s.name, s.schema)
 		m.mb.RecordMysqlTableIoWaitCountDataPoint(now, s.countFetch, metadata.AttributeIoWaitsOperationsFetch, s.name, s.schema)
@@ -411,6 +453,14 @@ func (m *mySQLScraper) scrapeTableIoWaitsStats(now pcommon.Timestamp, errs *scra
 		m.mb.RecordMysqlTableIoWaitTimeDataPoint(
 			now, s.timeUpdate/picosecondsInNanoseconds, metadata.AttributeIoWaitsOperationsUpdate, s.name, s.schema,
 		)
+
+		// average latencies
+		m.mb.RecordMysqlTableIoWaitAverageLatencyDataPoint(
+			now, s.avgTimerRead/picosecondsInNanoseconds, metadata.AttributeIoOperationRead, s.name, s.schema,
+		)
+		m.mb.RecordMysqlTableIoWaitAverageLatencyDataPoint(
+			now, s.avgTimerWrite/picosecondsInNanoseconds, metadata.AttributeIoOperationWrite, s.name, s.schema,
+		)
 	}
 }
 
@@ -424,6 +474,9 @@ func (m *mySQLScraper) scrapeIndexIoWaitsStats(now pcommon.Timestamp, errs *scra
 
 	for i := 0; i < len(indexIoWaitsStats); i++ {
 		s := indexIoWaitsStats[i]
+		if !m.tableFilter.matches(s.schema, s.name) {
+			continue
+		}
 		// counts
 		m.mb.RecordMysqlIndexIoWaitCountDataPoint(now, s.countDelete, metadata.AttributeIoWaitsOperationsDelete, s.name, s.schema, s.index)
 		m.mb.RecordMysqlIndexIoWaitCountDataPoint(now, s.countFetch, metadata.AttributeIoWaitsOperationsFetch, s.name, s.schema, s.index)
@@ -481,6 +534,9 @@ func (m *mySQLScraper) scrapeTableLockWaitEventStats(now pcommon.Timestamp, errs
 
 	for i := 0; i < len(tableLockWaitEventStats); i++ {
 		s := tableLockWaitEventStats[i]
+		if !m.tableFilter.matches(s.schema, s.name) {
+			continue
+		}
 		// read data points
 		m.mb.RecordMysqlTableLockWaitReadCountDataPoint(now, s.countReadNormal, s.schema, s.name, metadata.AttributeReadLockTypeNormal)
 		m.mb.RecordMysqlTableLockWaitReadCountDataPoint(now, s.countReadWithSharedLocks, s.schema, s.name, metadata.AttributeReadLockTypeWithSharedLocks)
@@ -511,6 +567,36 @@ func (m *mySQLScraper) scrapeTableLockWaitEventStats(now pcommon.Timestamp, errs
 	}
 }
 
+func (m *mySQLScraper) scrapeReplicaStatusStats(now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !m.config.Metrics.MysqlReplicaTimeBehindSource.Enabled && !m.config.Metrics.MysqlReplicaThreadRunning.Enabled {
+		return
+	}
+
+	replicaStatusStats, err := m.sqlclient.getReplicaStatusStats()
+	if err != nil {
+		m.logger.Error("Failed to fetch replica status stats", zap.Error(err))
+		errs.AddPartial(3, err)
+		return
+	}
+
+	for i := 0; i < len(replicaStatusStats); i++ {
+		s := replicaStatusStats[i]
+		if s.secondsBehindSource.Valid {
+			m.mb.RecordMysqlReplicaTimeBehindSourceDataPoint(now, s.secondsBehindSource.Int64)
+		}
+		m.mb.RecordMysqlReplicaThreadRunningDataPoint(now, threadRunning(s.ioRunning), metadata.AttributeReplicaThreadIo)
+		m.mb.RecordMysqlReplicaThreadRunningDataPoint(now, threadRunning(s.sqlRunning), metadata.AttributeReplicaThreadSql)
+	}
+}
+
+// threadRunning converts the state of a replication thread, e.g. `Yes`, `No` or `Connecting`, to 1 if it is running.
+func threadRunning(state string) int64 {
+	if state == "Yes" {
+		return 1
+	}
+	return 0
+}
+
 func addPartialIfError(errors *scrapererror.ScrapeErrors, err error) {
 	if err != nil {
 		errors.AddPartial(1, err)
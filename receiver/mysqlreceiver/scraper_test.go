@@ -17,6 +17,7 @@ package mysqlreceiver
 import (
 	"bufio"
 	"context"
+	"database/sql"
 	"errors"
 	"os"
 	"path/filepath"
@@ -56,6 +57,10 @@ func TestScrape(t *testing.T) {
 
 		cfg.Metrics.MysqlClientNetworkIo.Enabled = true
 
+		cfg.Metrics.MysqlTableIoWaitAverageLatency.Enabled = true
+		cfg.Metrics.MysqlReplicaTimeBehindSource.Enabled = true
+		cfg.Metrics.MysqlReplicaThreadRunning.Enabled = true
+
 		scraper := newMySQLScraper(componenttest.NewNopReceiverCreateSettings(), cfg)
 		scraper.sqlclient = &mockClient{
 			globalStatsFile:             "global_stats",
@@ -64,6 +69,7 @@ func TestScrape(t *testing.T) {
 			indexIoWaitsFile:            "index_io_waits_stats",
 			statementEventsFile:         "statement_events",
 			tableLockWaitEventStatsFile: "table_lock_wait_event_stats",
+			replicaStatusFile:           "replica_stats",
 		}
 
 		actualMetrics, err := scraper.scrape(context.Background())
@@ -112,6 +118,51 @@ func TestScrape(t *testing.T) {
 		require.Equal(t, partialError.Failed, 5, "Expected partial error count to be 5")
 	})
 
+	t.Run("scrape with table filter", func(t *testing.T) {
+		cfg := createDefaultConfig().(*Config)
+		cfg.Metrics.MysqlTableLockWaitReadCount.Enabled = true
+
+		scraper := newMySQLScraper(componenttest.NewNopReceiverCreateSettings(), cfg)
+		var err error
+		scraper.tableFilter, err = newTableFilter([]string{`^a_schema\.`})
+		require.NoError(t, err)
+		scraper.sqlclient = &mockClient{
+			globalStatsFile:             "global_stats",
+			innodbStatsFile:             "innodb_stats",
+			tableIoWaitsFile:            "table_io_waits_stats",
+			indexIoWaitsFile:            "index_io_waits_stats",
+			statementEventsFile:         "statement_events",
+			tableLockWaitEventStatsFile: "table_lock_wait_event_stats",
+		}
+
+		actualMetrics, err := scraper.scrape(context.Background())
+		require.NoError(t, err)
+
+		names := map[string]bool{}
+		metrics := actualMetrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+		for i := 0; i < metrics.Len(); i++ {
+			names[metrics.At(i).Name()] = true
+		}
+		assert.True(t, names["mysql.table.io.wait.count"])
+		assert.True(t, names["mysql.index.io.wait.count"])
+		// the lock waits are only reported for the otel.otel table
+		assert.False(t, names["mysql.table.lock_wait.read.count"])
+	})
+}
+
+func TestTableFilter(t *testing.T) {
+	filter, err := newTableFilter(nil)
+	require.NoError(t, err)
+	assert.True(t, filter.matches("otel", "otel"))
+
+	filter, err = newTableFilter([]string{`^otel\.`, `\.users$`})
+	require.NoError(t, err)
+	assert.True(t, filter.matches("otel", "orders"))
+	assert.True(t, filter.matches("shop", "users"))
+	assert.False(t, filter.matches("shop", "orders"))
+
+	_, err = newTableFilter([]string{"("})
+	assert.Error(t, err)
 }
 
 var _ client = (*mockClient)(nil)
@@ -123,6 +174,7 @@ type mockClient struct {
 	indexIoWaitsFile            string
 	statementEventsFile         string
 	tableLockWaitEventStatsFile string
+	replicaStatusFile           string
 }
 
 func readFile(fname string) (map[string]string, error) {
@@ -176,6 +228,8 @@ func (c *mockClient) getTableIoWaitsStats() ([]TableIoWaitsStats, error) {
 		s.timeFetch, _ = parseInt(text[7])
 		s.timeInsert, _ = parseInt(text[8])
 		s.timeUpdate, _ = parseInt(text[9])
+		s.avgTimerRead, _ = parseInt(text[10])
+		s.avgTimerWrite, _ = parseInt(text[11])
 
 		stats = append(stats, s)
 	}
@@ -286,6 +340,21 @@ func (c *mockClient) getTableLockWaitEventStats() ([]tableLockWaitEventStats, er
 	return stats, nil
 }
 
+func (c *mockClient) getReplicaStatusStats() ([]replicaStatusStats, error) {
+	status, err := readFile(c.replicaStatusFile)
+	if err != nil {
+		return nil, err
+	}
+	s := replicaStatusStats{
+		ioRunning:  status["Replica_IO_Running"],
+		sqlRunning: status["Replica_SQL_Running"],
+	}
+	if behind, err := parseInt(status["Seconds_Behind_Source"]); err == nil {
+		s.secondsBehindSource = sql.NullInt64{Int64: behind, Valid: true}
+	}
+	return []replicaStatusStats{s}, nil
+}
+
 func (c *mockClient) Close() error {
 	return nil
 }
@@ -15,14 +15,36 @@
 package nginxreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver"
 
 import (
+	"fmt"
+
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver/internal/metadata"
 )
 
+const (
+	// moduleStubStatus scrapes the basic status page of ngx_http_stub_status_module.
+	moduleStubStatus = "stub_status"
+	// modulePlusAPI scrapes the REST API of NGINX Plus, the endpoint being the versioned API root, e.g. `/api/8`.
+	modulePlusAPI = "plus_api"
+	// moduleVTS scrapes the JSON status page of nginx-module-vts, e.g. `/status/format/json`.
+	moduleVTS = "vts"
+)
+
 type Config struct {
 	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
 	confighttp.HTTPClientSettings           `mapstructure:",squash"`
-	Metrics                                 metadata.MetricsSettings `mapstructure:"metrics"`
+	// Module is the NGINX module which serves the status at the endpoint.
+	Module  string                   `mapstructure:"module"`
+	Metrics metadata.MetricsSettings `mapstructure:"metrics"`
+}
+
+func (cfg *Config) Validate() error {
+	switch cfg.Module {
+	case moduleStubStatus, modulePlusAPI, moduleVTS:
+		return nil
+	default:
+		return fmt.Errorf("invalid module %q, must be one of %q, %q or %q", cfg.Module, moduleStubStatus, modulePlusAPI, moduleVTS)
+	}
 }
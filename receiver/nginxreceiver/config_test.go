@@ -36,3 +36,14 @@ func TestLoadConfig(t *testing.T) {
 
 	assert.Equal(t, factory.CreateDefaultConfig(), cfg)
 }
+
+func TestValidate(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	require.NoError(t, cfg.Validate())
+
+	cfg.Module = moduleVTS
+	require.NoError(t, cfg.Validate())
+
+	cfg.Module = "lua"
+	require.EqualError(t, cfg.Validate(), `invalid module "lua", must be one of "stub_status", "plus_api" or "vts"`)
+}
@@ -50,6 +50,7 @@ func createDefaultConfig() component.ReceiverConfig {
 			Endpoint: "http://localhost:80/status",
 			Timeout:  10 * time.Second,
 		},
+		Module:  moduleStubStatus,
 		Metrics: metadata.DefaultMetricsSettings(),
 	}
 }
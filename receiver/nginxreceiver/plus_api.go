@@ -0,0 +1,114 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nginxreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver"
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver/internal/metadata"
+)
+
+// plusConnections is the response of the NGINX Plus API `/connections` endpoint.
+type plusConnections struct {
+	Accepted int64 `json:"accepted"`
+	Dropped  int64 `json:"dropped"`
+	Active   int64 `json:"active"`
+	Idle     int64 `json:"idle"`
+}
+
+// plusHTTPRequests is the response of the NGINX Plus API `/http/requests` endpoint.
+type plusHTTPRequests struct {
+	Total int64 `json:"total"`
+}
+
+type plusServerZone struct {
+	Requests  int64           `json:"requests"`
+	Responses statusResponses `json:"responses"`
+	Received  int64           `json:"received"`
+	Sent      int64           `json:"sent"`
+}
+
+type plusUpstream struct {
+	Peers []plusUpstreamPeer `json:"peers"`
+}
+
+type plusUpstreamPeer struct {
+	Server       string          `json:"server"`
+	Requests     int64           `json:"requests"`
+	Responses    statusResponses `json:"responses"`
+	ResponseTime *int64          `json:"response_time"`
+}
+
+// scrapePlusAPI scrapes the connection, server zone and upstream statistics of the NGINX Plus REST API.
+func (r *nginxScraper) scrapePlusAPI(ctx context.Context) (pmetric.Metrics, error) {
+	endpoint := strings.TrimSuffix(r.cfg.HTTPClientSettings.Endpoint, "/")
+
+	var connections plusConnections
+	if err := r.getJSON(ctx, endpoint+"/connections", &connections); err != nil {
+		r.settings.Logger.Error("Failed to fetch nginx stats", zap.Error(err))
+		return pmetric.Metrics{}, err
+	}
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+	var errs scrapererror.ScrapeErrors
+
+	r.mb.RecordNginxConnectionsAcceptedDataPoint(now, connections.Accepted)
+	r.mb.RecordNginxConnectionsHandledDataPoint(now, connections.Accepted-connections.Dropped)
+	// like stub_status, the active connections include the idle ones
+	r.mb.RecordNginxConnectionsCurrentDataPoint(now, connections.Active+connections.Idle, metadata.AttributeStateActive)
+	r.mb.RecordNginxConnectionsCurrentDataPoint(now, connections.Idle, metadata.AttributeStateWaiting)
+
+	var requests plusHTTPRequests
+	if err := r.getJSON(ctx, endpoint+"/http/requests", &requests); err != nil {
+		errs.AddPartial(1, err)
+	} else {
+		r.mb.RecordNginxRequestsDataPoint(now, requests.Total)
+	}
+
+	serverZones := map[string]plusServerZone{}
+	if err := r.getJSON(ctx, endpoint+"/http/server_zones", &serverZones); err != nil {
+		errs.AddPartial(1, err)
+	}
+	for zone, stats := range serverZones {
+		r.mb.RecordNginxServerZoneRequestsDataPoint(now, stats.Requests, zone)
+		r.recordServerZoneResponses(now, zone, stats.Responses)
+		r.mb.RecordNginxServerZoneIoDataPoint(now, stats.Received, zone, metadata.AttributeDirectionReceived)
+		r.mb.RecordNginxServerZoneIoDataPoint(now, stats.Sent, zone, metadata.AttributeDirectionSent)
+	}
+
+	upstreams := map[string]plusUpstream{}
+	if err := r.getJSON(ctx, endpoint+"/http/upstreams", &upstreams); err != nil {
+		errs.AddPartial(1, err)
+	}
+	for upstream, stats := range upstreams {
+		for _, peer := range stats.Peers {
+			r.mb.RecordNginxUpstreamPeerRequestsDataPoint(now, peer.Requests, upstream, peer.Server)
+			r.recordUpstreamPeerResponses(now, upstream, peer.Server, peer.Responses)
+			// the response time is only reported once the peer has served a request
+			if peer.ResponseTime != nil {
+				r.mb.RecordNginxUpstreamPeerResponseTimeDataPoint(now, *peer.ResponseTime, upstream, peer.Server)
+			}
+		}
+	}
+
+	return r.mb.Emit(), errs.Combine()
+}
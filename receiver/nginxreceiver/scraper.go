@@ -16,6 +16,8 @@ package nginxreceiver // import "github.com/open-telemetry/opentelemetry-collect
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"time"
 
@@ -58,7 +60,18 @@ func (r *nginxScraper) start(_ context.Context, host component.Host) error {
 	return nil
 }
 
-func (r *nginxScraper) scrape(context.Context) (pmetric.Metrics, error) {
+func (r *nginxScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	switch r.cfg.Module {
+	case modulePlusAPI:
+		return r.scrapePlusAPI(ctx)
+	case moduleVTS:
+		return r.scrapeVTS(ctx)
+	default:
+		return r.scrapeStubStatus()
+	}
+}
+
+func (r *nginxScraper) scrapeStubStatus() (pmetric.Metrics, error) {
 	// Init client in scrape method in case there are transient errors in the constructor.
 	if r.client == nil {
 		var err error
@@ -87,3 +100,50 @@ func (r *nginxScraper) scrape(context.Context) (pmetric.Metrics, error) {
 
 	return r.mb.Emit(), nil
 }
+
+// getJSON decodes the JSON document served at the given URL into v.
+func (r *nginxScraper) getJSON(ctx context.Context, url string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to get %v: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("expected %v response from %v, got %v", http.StatusOK, url, resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode response from %v: %w", url, err)
+	}
+	return nil
+}
+
+// statusResponses is the number of responses by status code class, as reported by both the
+// NGINX Plus API and the VTS module.
+type statusResponses struct {
+	Responses1xx int64 `json:"1xx"`
+	Responses2xx int64 `json:"2xx"`
+	Responses3xx int64 `json:"3xx"`
+	Responses4xx int64 `json:"4xx"`
+	Responses5xx int64 `json:"5xx"`
+}
+
+func (r *nginxScraper) recordServerZoneResponses(now pcommon.Timestamp, zone string, responses statusResponses) {
+	r.mb.RecordNginxServerZoneResponsesDataPoint(now, responses.Responses1xx, zone, metadata.AttributeStatusRange1xx)
+	r.mb.RecordNginxServerZoneResponsesDataPoint(now, responses.Responses2xx, zone, metadata.AttributeStatusRange2xx)
+	r.mb.RecordNginxServerZoneResponsesDataPoint(now, responses.Responses3xx, zone, metadata.AttributeStatusRange3xx)
+	r.mb.RecordNginxServerZoneResponsesDataPoint(now, responses.Responses4xx, zone, metadata.AttributeStatusRange4xx)
+	r.mb.RecordNginxServerZoneResponsesDataPoint(now, responses.Responses5xx, zone, metadata.AttributeStatusRange5xx)
+}
+
+func (r *nginxScraper) recordUpstreamPeerResponses(now pcommon.Timestamp, upstream, peer string, responses statusResponses) {
+	r.mb.RecordNginxUpstreamPeerResponsesDataPoint(now, responses.Responses1xx, upstream, peer, metadata.AttributeStatusRange1xx)
+	r.mb.RecordNginxUpstreamPeerResponsesDataPoint(now, responses.Responses2xx, upstream, peer, metadata.AttributeStatusRange2xx)
+	r.mb.RecordNginxUpstreamPeerResponsesDataPoint(now, responses.Responses3xx, upstream, peer, metadata.AttributeStatusRange3xx)
+	r.mb.RecordNginxUpstreamPeerResponsesDataPoint(now, responses.Responses4xx, upstream, peer, metadata.AttributeStatusRange4xx)
+	r.mb.RecordNginxUpstreamPeerResponsesDataPoint(now, responses.Responses5xx, upstream, peer, metadata.AttributeStatusRange5xx)
+}
@@ -19,6 +19,7 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"path/filepath"
 	"testing"
 
@@ -26,6 +27,7 @@ import (
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/scrapertest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/scrapertest/golden"
@@ -52,6 +54,99 @@ func TestScraper(t *testing.T) {
 	require.NoError(t, scrapertest.CompareMetrics(expectedMetrics, actualMetrics))
 }
 
+func TestScraperPlusAPI(t *testing.T) {
+	nginxMock := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		files := map[string]string{
+			"/api/8/connections":       "connections.json",
+			"/api/8/http/requests":     "http_requests.json",
+			"/api/8/http/server_zones": "http_server_zones.json",
+			"/api/8/http/upstreams":    "http_upstreams.json",
+		}
+		file, ok := files[req.URL.Path]
+		if !ok {
+			rw.WriteHeader(404)
+			return
+		}
+		body, err := os.ReadFile(filepath.Join("testdata", "scraper", "plus_api", file))
+		require.NoError(t, err)
+		_, err = rw.Write(body)
+		require.NoError(t, err)
+	}))
+	defer nginxMock.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = nginxMock.URL + "/api/8/"
+	cfg.Module = modulePlusAPI
+	require.NoError(t, cfg.Validate())
+
+	scraper := newNginxScraper(componenttest.NewNopReceiverCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	expectedFile := filepath.Join("testdata", "scraper", "expected_plus_api.json")
+	expectedMetrics, err := golden.ReadMetrics(expectedFile)
+	require.NoError(t, err)
+
+	require.NoError(t, scrapertest.CompareMetrics(expectedMetrics, actualMetrics))
+}
+
+func TestScraperVTS(t *testing.T) {
+	nginxMock := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/status/format/json" {
+			rw.WriteHeader(404)
+			return
+		}
+		body, err := os.ReadFile(filepath.Join("testdata", "scraper", "vts.json"))
+		require.NoError(t, err)
+		_, err = rw.Write(body)
+		require.NoError(t, err)
+	}))
+	defer nginxMock.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = nginxMock.URL + "/status/format/json"
+	cfg.Module = moduleVTS
+	require.NoError(t, cfg.Validate())
+
+	scraper := newNginxScraper(componenttest.NewNopReceiverCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	expectedFile := filepath.Join("testdata", "scraper", "expected_vts.json")
+	expectedMetrics, err := golden.ReadMetrics(expectedFile)
+	require.NoError(t, err)
+
+	require.NoError(t, scrapertest.CompareMetrics(expectedMetrics, actualMetrics))
+}
+
+func TestScraperPlusAPIPartialError(t *testing.T) {
+	nginxMock := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/8/connections" {
+			rw.WriteHeader(404)
+			return
+		}
+		_, err := rw.Write([]byte(`{"accepted":10,"dropped":1,"active":2,"idle":3}`))
+		require.NoError(t, err)
+	}))
+	defer nginxMock.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = nginxMock.URL + "/api/8"
+	cfg.Module = modulePlusAPI
+
+	scraper := newNginxScraper(componenttest.NewNopReceiverCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.Error(t, err)
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	require.Equal(t, 4, actualMetrics.DataPointCount())
+}
+
 func TestScraperError(t *testing.T) {
 	nginxMock := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		if req.URL.Path == "/status" {
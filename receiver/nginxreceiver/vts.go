@@ -0,0 +1,101 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nginxreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver/internal/metadata"
+)
+
+// vtsAllServerZones is the server zone which sums up all the other server zones.
+const vtsAllServerZones = "*"
+
+// vtsStatus is the JSON status document of nginx-module-vts.
+type vtsStatus struct {
+	Connections   vtsConnections                 `json:"connections"`
+	ServerZones   map[string]vtsServerZone       `json:"serverZones"`
+	UpstreamZones map[string][]vtsUpstreamServer `json:"upstreamZones"`
+}
+
+type vtsConnections struct {
+	Active   int64 `json:"active"`
+	Reading  int64 `json:"reading"`
+	Writing  int64 `json:"writing"`
+	Waiting  int64 `json:"waiting"`
+	Accepted int64 `json:"accepted"`
+	Handled  int64 `json:"handled"`
+	Requests int64 `json:"requests"`
+}
+
+type vtsServerZone struct {
+	RequestCounter int64           `json:"requestCounter"`
+	InBytes        int64           `json:"inBytes"`
+	OutBytes       int64           `json:"outBytes"`
+	Responses      statusResponses `json:"responses"`
+	RequestMsec    int64           `json:"requestMsec"`
+}
+
+type vtsUpstreamServer struct {
+	Server         string          `json:"server"`
+	RequestCounter int64           `json:"requestCounter"`
+	Responses      statusResponses `json:"responses"`
+	ResponseMsec   int64           `json:"responseMsec"`
+}
+
+// scrapeVTS scrapes the connection, server zone and upstream statistics of nginx-module-vts.
+func (r *nginxScraper) scrapeVTS(ctx context.Context) (pmetric.Metrics, error) {
+	var status vtsStatus
+	if err := r.getJSON(ctx, r.cfg.HTTPClientSettings.Endpoint, &status); err != nil {
+		r.settings.Logger.Error("Failed to fetch nginx stats", zap.Error(err))
+		return pmetric.Metrics{}, err
+	}
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	r.mb.RecordNginxRequestsDataPoint(now, status.Connections.Requests)
+	r.mb.RecordNginxConnectionsAcceptedDataPoint(now, status.Connections.Accepted)
+	r.mb.RecordNginxConnectionsHandledDataPoint(now, status.Connections.Handled)
+	r.mb.RecordNginxConnectionsCurrentDataPoint(now, status.Connections.Active, metadata.AttributeStateActive)
+	r.mb.RecordNginxConnectionsCurrentDataPoint(now, status.Connections.Reading, metadata.AttributeStateReading)
+	r.mb.RecordNginxConnectionsCurrentDataPoint(now, status.Connections.Writing, metadata.AttributeStateWriting)
+	r.mb.RecordNginxConnectionsCurrentDataPoint(now, status.Connections.Waiting, metadata.AttributeStateWaiting)
+
+	for zone, stats := range status.ServerZones {
+		if zone == vtsAllServerZones {
+			continue
+		}
+		r.mb.RecordNginxServerZoneRequestsDataPoint(now, stats.RequestCounter, zone)
+		r.recordServerZoneResponses(now, zone, stats.Responses)
+		r.mb.RecordNginxServerZoneIoDataPoint(now, stats.InBytes, zone, metadata.AttributeDirectionReceived)
+		r.mb.RecordNginxServerZoneIoDataPoint(now, stats.OutBytes, zone, metadata.AttributeDirectionSent)
+		r.mb.RecordNginxServerZoneRequestTimeDataPoint(now, stats.RequestMsec, zone)
+	}
+
+	for upstream, servers := range status.UpstreamZones {
+		for _, server := range servers {
+			r.mb.RecordNginxUpstreamPeerRequestsDataPoint(now, server.RequestCounter, upstream, server.Server)
+			r.recordUpstreamPeerResponses(now, upstream, server.Server, server.Responses)
+			r.mb.RecordNginxUpstreamPeerResponseTimeDataPoint(now, server.ResponseMsec, upstream, server.Server)
+		}
+	}
+
+	return r.mb.Emit(), nil
+}
@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ntpreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ntpreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ntpreceiver/internal/metadata"
+)
+
+// Predefined error responses for configuration validation failures
+var (
+	errMissingServers = errors.New(`at least one server must be specified`)
+	errInvalidServer  = errors.New(`"servers" must be in the form of <hostname> or <hostname>:<port>`)
+)
+
+const defaultPort = "123"
+
+// Config defines the configuration for the various elements of the receiver agent.
+type Config struct {
+	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
+	// Servers are the NTP servers to query, as <hostname> or <hostname>:<port>.
+	Servers []string `mapstructure:"servers"`
+	// Timeout bounds each query.
+	Timeout time.Duration            `mapstructure:"timeout"`
+	Metrics metadata.MetricsSettings `mapstructure:"metrics"`
+}
+
+// Validate validates the configuration by checking for missing or invalid fields
+func (cfg *Config) Validate() error {
+	if len(cfg.Servers) == 0 {
+		return errMissingServers
+	}
+
+	var err error
+	for _, server := range cfg.Servers {
+		if host, port, splitErr := net.SplitHostPort(serverAddress(server)); splitErr != nil || host == "" || port == "" {
+			err = multierr.Append(err, fmt.Errorf("%s: %q", errInvalidServer.Error(), server))
+		}
+	}
+
+	return err
+}
+
+// serverAddress adds the default NTP port to a server without one.
+func serverAddress(server string) string {
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		return net.JoinHostPort(strings.Trim(server, "[]"), defaultPort)
+	}
+	return server
+}
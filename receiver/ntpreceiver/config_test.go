@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ntpreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ntpreceiver"
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/multierr"
+)
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		cfg         *Config
+		expectedErr error
+	}{
+		{
+			desc:        "missing servers",
+			cfg:         &Config{},
+			expectedErr: errMissingServers,
+		},
+		{
+			desc: "invalid servers",
+			cfg: &Config{
+				Servers: []string{"", "pool.ntp.org:"},
+			},
+			expectedErr: multierr.Combine(
+				fmt.Errorf("%s: %q", errInvalidServer.Error(), ""),
+				fmt.Errorf("%s: %q", errInvalidServer.Error(), "pool.ntp.org:"),
+			),
+		},
+		{
+			desc: "valid config",
+			cfg: &Config{
+				Servers: []string{"pool.ntp.org", "time.example.com:1123", "::1", "[::1]:123"},
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			actualErr := tc.cfg.Validate()
+			if tc.expectedErr != nil {
+				require.EqualError(t, actualErr, tc.expectedErr.Error())
+			} else {
+				require.NoError(t, actualErr)
+			}
+		})
+	}
+}
+
+func TestServerAddress(t *testing.T) {
+	require.Equal(t, "pool.ntp.org:123", serverAddress("pool.ntp.org"))
+	require.Equal(t, "time.example.com:1123", serverAddress("time.example.com:1123"))
+	require.Equal(t, "[::1]:123", serverAddress("::1"))
+	require.Equal(t, "[::1]:123", serverAddress("[::1]"))
+	require.Equal(t, "[::1]:1123", serverAddress("[::1]:1123"))
+}
@@ -0,0 +1,506 @@
+// Code generated by mdatagen. DO NOT EDIT.
+
+package metadata
+
+import (
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// MetricSettings provides common settings for a particular metric.
+type MetricSettings struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	enabledProvidedByUser bool
+}
+
+// IsEnabledProvidedByUser returns true if `enabled` option is explicitly set in user settings to any value.
+func (ms *MetricSettings) IsEnabledProvidedByUser() bool {
+	return ms.enabledProvidedByUser
+}
+
+func (ms *MetricSettings) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+	err := parser.Unmarshal(ms, confmap.WithErrorUnused())
+	if err != nil {
+		return err
+	}
+	ms.enabledProvidedByUser = parser.IsSet("enabled")
+	return nil
+}
+
+// MetricsSettings provides settings for ntpreceiver metrics.
+type MetricsSettings struct {
+	NtpError        MetricSettings `mapstructure:"ntp.error"`
+	NtpOffset       MetricSettings `mapstructure:"ntp.offset"`
+	NtpRootDistance MetricSettings `mapstructure:"ntp.root_distance"`
+	NtpRtt          MetricSettings `mapstructure:"ntp.rtt"`
+	NtpStratum      MetricSettings `mapstructure:"ntp.stratum"`
+}
+
+func DefaultMetricsSettings() MetricsSettings {
+	return MetricsSettings{
+		NtpError: MetricSettings{
+			Enabled: true,
+		},
+		NtpOffset: MetricSettings{
+			Enabled: true,
+		},
+		NtpRootDistance: MetricSettings{
+			Enabled: false,
+		},
+		NtpRtt: MetricSettings{
+			Enabled: true,
+		},
+		NtpStratum: MetricSettings{
+			Enabled: true,
+		},
+	}
+}
+
+// AttributeErrorType specifies the a value error.type attribute.
+type AttributeErrorType int
+
+const (
+	_ AttributeErrorType = iota
+	AttributeErrorTypeConnection
+	AttributeErrorTypeTimeout
+	AttributeErrorTypeInvalidResponse
+	AttributeErrorTypeKissOfDeath
+	AttributeErrorTypeUnsynchronized
+)
+
+// String returns the string representation of the AttributeErrorType.
+func (av AttributeErrorType) String() string {
+	switch av {
+	case AttributeErrorTypeConnection:
+		return "connection"
+	case AttributeErrorTypeTimeout:
+		return "timeout"
+	case AttributeErrorTypeInvalidResponse:
+		return "invalid_response"
+	case AttributeErrorTypeKissOfDeath:
+		return "kiss_of_death"
+	case AttributeErrorTypeUnsynchronized:
+		return "unsynchronized"
+	}
+	return ""
+}
+
+// MapAttributeErrorType is a helper map of string to AttributeErrorType attribute value.
+var MapAttributeErrorType = map[string]AttributeErrorType{
+	"connection":       AttributeErrorTypeConnection,
+	"timeout":          AttributeErrorTypeTimeout,
+	"invalid_response": AttributeErrorTypeInvalidResponse,
+	"kiss_of_death":    AttributeErrorTypeKissOfDeath,
+	"unsynchronized":   AttributeErrorTypeUnsynchronized,
+}
+
+type metricNtpError struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills ntp.error metric with initial data.
+func (m *metricNtpError) init() {
+	m.data.SetName("ntp.error")
+	m.data.SetDescription("Records errors occurring during NTP query.")
+	m.data.SetUnit("{error}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNtpError) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, ntpServerAttributeValue string, errorTypeAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("ntp.server", ntpServerAttributeValue)
+	dp.Attributes().PutStr("error.type", errorTypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNtpError) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNtpError) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNtpError(settings MetricSettings) metricNtpError {
+	m := metricNtpError{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNtpOffset struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills ntp.offset metric with initial data.
+func (m *metricNtpOffset) init() {
+	m.data.SetName("ntp.offset")
+	m.data.SetDescription("Offset of the server clock from the host clock, positive when the host clock is behind.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNtpOffset) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, ntpServerAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("ntp.server", ntpServerAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNtpOffset) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNtpOffset) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNtpOffset(settings MetricSettings) metricNtpOffset {
+	m := metricNtpOffset{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNtpRootDistance struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills ntp.root_distance metric with initial data.
+func (m *metricNtpRootDistance) init() {
+	m.data.SetName("ntp.root_distance")
+	m.data.SetDescription("Estimate of the maximum error of the server clock, from its root delay and root dispersion.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNtpRootDistance) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, ntpServerAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("ntp.server", ntpServerAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNtpRootDistance) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNtpRootDistance) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNtpRootDistance(settings MetricSettings) metricNtpRootDistance {
+	m := metricNtpRootDistance{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNtpRtt struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills ntp.rtt metric with initial data.
+func (m *metricNtpRtt) init() {
+	m.data.SetName("ntp.rtt")
+	m.data.SetDescription("Round-trip time of the query, excluding the processing time of the server.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNtpRtt) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, ntpServerAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("ntp.server", ntpServerAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNtpRtt) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNtpRtt) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNtpRtt(settings MetricSettings) metricNtpRtt {
+	m := metricNtpRtt{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNtpStratum struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills ntp.stratum metric with initial data.
+func (m *metricNtpStratum) init() {
+	m.data.SetName("ntp.stratum")
+	m.data.SetDescription("Stratum of the server, its distance from a reference clock.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNtpStratum) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, ntpServerAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("ntp.server", ntpServerAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNtpStratum) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNtpStratum) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNtpStratum(settings MetricSettings) metricNtpStratum {
+	m := metricNtpStratum{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+// MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
+// required to produce metric representation defined in metadata and user settings.
+type MetricsBuilder struct {
+	startTime             pcommon.Timestamp   // start time that will be applied to all recorded data points.
+	metricsCapacity       int                 // maximum observed number of metrics per resource.
+	resourceCapacity      int                 // maximum observed number of resource attributes.
+	metricsBuffer         pmetric.Metrics     // accumulates metrics data before emitting.
+	buildInfo             component.BuildInfo // contains version information
+	metricNtpError        metricNtpError
+	metricNtpOffset       metricNtpOffset
+	metricNtpRootDistance metricNtpRootDistance
+	metricNtpRtt          metricNtpRtt
+	metricNtpStratum      metricNtpStratum
+}
+
+// metricBuilderOption applies changes to default metrics builder.
+type metricBuilderOption func(*MetricsBuilder)
+
+// WithStartTime sets startTime on the metrics builder.
+func WithStartTime(startTime pcommon.Timestamp) metricBuilderOption {
+	return func(mb *MetricsBuilder) {
+		mb.startTime = startTime
+	}
+}
+
+func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo, options ...metricBuilderOption) *MetricsBuilder {
+	mb := &MetricsBuilder{
+		startTime:             pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:         pmetric.NewMetrics(),
+		buildInfo:             buildInfo,
+		metricNtpError:        newMetricNtpError(settings.NtpError),
+		metricNtpOffset:       newMetricNtpOffset(settings.NtpOffset),
+		metricNtpRootDistance: newMetricNtpRootDistance(settings.NtpRootDistance),
+		metricNtpRtt:          newMetricNtpRtt(settings.NtpRtt),
+		metricNtpStratum:      newMetricNtpStratum(settings.NtpStratum),
+	}
+	for _, op := range options {
+		op(mb)
+	}
+	return mb
+}
+
+// updateCapacity updates max length of metrics and resource attributes that will be used for the slice capacity.
+func (mb *MetricsBuilder) updateCapacity(rm pmetric.ResourceMetrics) {
+	if mb.metricsCapacity < rm.ScopeMetrics().At(0).Metrics().Len() {
+		mb.metricsCapacity = rm.ScopeMetrics().At(0).Metrics().Len()
+	}
+	if mb.resourceCapacity < rm.Resource().Attributes().Len() {
+		mb.resourceCapacity = rm.Resource().Attributes().Len()
+	}
+}
+
+// ResourceMetricsOption applies changes to provided resource metrics.
+type ResourceMetricsOption func(pmetric.ResourceMetrics)
+
+// WithStartTimeOverride overrides start time for all the resource metrics data points.
+// This option should be only used if different start time has to be set on metrics coming from different resources.
+func WithStartTimeOverride(start pcommon.Timestamp) ResourceMetricsOption {
+	return func(rm pmetric.ResourceMetrics) {
+		var dps pmetric.NumberDataPointSlice
+		metrics := rm.ScopeMetrics().At(0).Metrics()
+		for i := 0; i < metrics.Len(); i++ {
+			switch metrics.At(i).Type() {
+			case pmetric.MetricTypeGauge:
+				dps = metrics.At(i).Gauge().DataPoints()
+			case pmetric.MetricTypeSum:
+				dps = metrics.At(i).Sum().DataPoints()
+			}
+			for j := 0; j < dps.Len(); j++ {
+				dps.At(j).SetStartTimestamp(start)
+			}
+		}
+	}
+}
+
+// EmitForResource saves all the generated metrics under a new resource and updates the internal state to be ready for
+// recording another set of data points as part of another resource. This function can be helpful when one scraper
+// needs to emit metrics from several resources. Otherwise calling this function is not required,
+// just `Emit` function can be called instead.
+// Resource attributes should be provided as ResourceMetricsOption arguments.
+func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
+	rm := pmetric.NewResourceMetrics()
+	rm.Resource().Attributes().EnsureCapacity(mb.resourceCapacity)
+	ils := rm.ScopeMetrics().AppendEmpty()
+	ils.Scope().SetName("otelcol/ntpreceiver")
+	ils.Scope().SetVersion(mb.buildInfo.Version)
+	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
+	mb.metricNtpError.emit(ils.Metrics())
+	mb.metricNtpOffset.emit(ils.Metrics())
+	mb.metricNtpRootDistance.emit(ils.Metrics())
+	mb.metricNtpRtt.emit(ils.Metrics())
+	mb.metricNtpStratum.emit(ils.Metrics())
+	for _, op := range rmo {
+		op(rm)
+	}
+	if ils.Metrics().Len() > 0 {
+		mb.updateCapacity(rm)
+		rm.MoveTo(mb.metricsBuffer.ResourceMetrics().AppendEmpty())
+	}
+}
+
+// Emit returns all the metrics accumulated by the metrics builder and updates the internal state to be ready for
+// recording another set of metrics. This function will be responsible for applying all the transformations required to
+// produce metric representation defined in metadata and user settings, e.g. delta or cumulative.
+func (mb *MetricsBuilder) Emit(rmo ...ResourceMetricsOption) pmetric.Metrics {
+	mb.EmitForResource(rmo...)
+	metrics := pmetric.NewMetrics()
+	mb.metricsBuffer.MoveTo(metrics)
+	return metrics
+}
+
+// RecordNtpErrorDataPoint adds a data point to ntp.error metric.
+func (mb *MetricsBuilder) RecordNtpErrorDataPoint(ts pcommon.Timestamp, val int64, ntpServerAttributeValue string, errorTypeAttributeValue AttributeErrorType) {
+	mb.metricNtpError.recordDataPoint(mb.startTime, ts, val, ntpServerAttributeValue, errorTypeAttributeValue.String())
+}
+
+// RecordNtpOffsetDataPoint adds a data point to ntp.offset metric.
+func (mb *MetricsBuilder) RecordNtpOffsetDataPoint(ts pcommon.Timestamp, val float64, ntpServerAttributeValue string) {
+	mb.metricNtpOffset.recordDataPoint(mb.startTime, ts, val, ntpServerAttributeValue)
+}
+
+// RecordNtpRootDistanceDataPoint adds a data point to ntp.root_distance metric.
+func (mb *MetricsBuilder) RecordNtpRootDistanceDataPoint(ts pcommon.Timestamp, val float64, ntpServerAttributeValue string) {
+	mb.metricNtpRootDistance.recordDataPoint(mb.startTime, ts, val, ntpServerAttributeValue)
+}
+
+// RecordNtpRttDataPoint adds a data point to ntp.rtt metric.
+func (mb *MetricsBuilder) RecordNtpRttDataPoint(ts pcommon.Timestamp, val float64, ntpServerAttributeValue string) {
+	mb.metricNtpRtt.recordDataPoint(mb.startTime, ts, val, ntpServerAttributeValue)
+}
+
+// RecordNtpStratumDataPoint adds a data point to ntp.stratum metric.
+func (mb *MetricsBuilder) RecordNtpStratumDataPoint(ts pcommon.Timestamp, val int64, ntpServerAttributeValue string) {
+	mb.metricNtpStratum.recordDataPoint(mb.startTime, ts, val, ntpServerAttributeValue)
+}
+
+// Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
+// and metrics builder should update its startTime and reset it's internal state accordingly.
+func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
+	mb.startTime = pcommon.NewTimestampFromTime(time.Now())
+	for _, op := range options {
+		op(mb)
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ntpreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ntpreceiver"
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+)
+
+const (
+	ntpPacketSize = 48
+	// ntpEpochOffset is the number of seconds between the NTP epoch, 1900, and the Unix epoch, 1970.
+	ntpEpochOffset = 2208988800
+
+	ntpVersion    = 4
+	ntpModeClient = 3
+	ntpModeServer = 4
+	// ntpLeapNotSynchronized is the leap indicator of a server whose clock is not synchronized.
+	ntpLeapNotSynchronized = 3
+)
+
+var (
+	errResponseTooShort = errors.New("response is too short")
+	errNotServerMode    = errors.New("response is not from an NTP server")
+	errOriginMismatch   = errors.New("response does not match the request")
+	errKissOfDeath      = errors.New("server sent a kiss-o'-death packet")
+	errNotSynchronized  = errors.New("server clock is not synchronized")
+)
+
+// ntpResponse is the outcome of an SNTP query, as described by RFC 4330.
+type ntpResponse struct {
+	// offset is the offset of the server clock from the local clock.
+	offset time.Duration
+	// rtt is the round-trip time, excluding the processing time of the server.
+	rtt            time.Duration
+	stratum        uint8
+	rootDelay      time.Duration
+	rootDispersion time.Duration
+}
+
+// rootDistance estimates the maximum error of the server clock as seen by the local clock.
+func (r *ntpResponse) rootDistance() time.Duration {
+	return r.rootDelay/2 + r.rootDispersion + r.rtt/2
+}
+
+// queryNTP sends a client request to the server and parses its response.
+func queryNTP(ctx context.Context, server string, timeout time.Duration) (*ntpResponse, error) {
+	dialer := net.Dialer{Timeout: timeout}
+	conn, err := dialer.DialContext(ctx, "udp", server)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if timeout > 0 {
+		_ = conn.SetDeadline(time.Now().Add(timeout))
+	}
+
+	request := make([]byte, ntpPacketSize)
+	request[0] = ntpVersion<<3 | ntpModeClient
+	originTime := time.Now()
+	// the transmit timestamp is echoed back as the origin timestamp of the response
+	binary.BigEndian.PutUint64(request[40:], toNTPTime(originTime))
+	if _, err = conn.Write(request); err != nil {
+		return nil, err
+	}
+
+	response := make([]byte, ntpPacketSize)
+	n, err := conn.Read(response)
+	destinationTime := time.Now()
+	if err != nil {
+		return nil, err
+	}
+	return parseNTPResponse(response[:n], request, originTime, destinationTime)
+}
+
+func parseNTPResponse(response, request []byte, originTime, destinationTime time.Time) (*ntpResponse, error) {
+	if len(response) < ntpPacketSize {
+		return nil, fmt.Errorf("%w: %d bytes", errResponseTooShort, len(response))
+	}
+	if mode := response[0] & 0x7; mode != ntpModeServer {
+		return nil, errNotServerMode
+	}
+	if binary.BigEndian.Uint64(response[24:]) != binary.BigEndian.Uint64(request[40:]) {
+		return nil, errOriginMismatch
+	}
+	stratum := response[1]
+	if stratum == 0 {
+		// a kiss-o'-death packet carries its code as the reference ID
+		return nil, fmt.Errorf("%w with code %q", errKissOfDeath, string(response[12:16]))
+	}
+	if leap := response[0] >> 6; leap == ntpLeapNotSynchronized {
+		return nil, errNotSynchronized
+	}
+
+	receiveTime := fromNTPTime(binary.BigEndian.Uint64(response[32:]))
+	transmitTime := fromNTPTime(binary.BigEndian.Uint64(response[40:]))
+	return &ntpResponse{
+		offset:         (receiveTime.Sub(originTime) + transmitTime.Sub(destinationTime)) / 2,
+		rtt:            destinationTime.Sub(originTime) - transmitTime.Sub(receiveTime),
+		stratum:        stratum,
+		rootDelay:      fromNTPShortDuration(binary.BigEndian.Uint32(response[4:])),
+		rootDispersion: fromNTPShortDuration(binary.BigEndian.Uint32(response[8:])),
+	}, nil
+}
+
+// toNTPTime converts t to a 64-bit NTP timestamp, 32-bit seconds and 32-bit fraction since the NTP epoch.
+func toNTPTime(t time.Time) uint64 {
+	nanos := uint64(t.UnixNano()) + ntpEpochOffset*uint64(time.Second)
+	seconds := nanos / uint64(time.Second)
+	fraction := (nanos % uint64(time.Second)) << 32 / uint64(time.Second)
+	return seconds<<32 | fraction
+}
+
+func fromNTPTime(ntpTime uint64) time.Time {
+	seconds := int64(ntpTime>>32) - ntpEpochOffset
+	nanos := int64((ntpTime & 0xffffffff) * uint64(time.Second) >> 32)
+	return time.Unix(seconds, nanos)
+}
+
+// fromNTPShortDuration converts a 32-bit NTP short format, 16-bit seconds and 16-bit fraction, to a duration.
+func fromNTPShortDuration(short uint32) time.Duration {
+	return time.Duration(uint64(short) * uint64(time.Second) >> 16)
+}
@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ntpreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ntpreceiver"
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ntpreceiver/internal/metadata"
+)
+
+type ntpScraper struct {
+	cfg      *Config
+	settings component.TelemetrySettings
+	mb       *metadata.MetricsBuilder
+}
+
+// scrape queries every server and produces metrics based on the responses
+func (s *ntpScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	for _, server := range s.cfg.Servers {
+		address := serverAddress(server)
+		response, err := queryNTP(ctx, address, s.cfg.Timeout)
+		if err != nil {
+			s.recordError(now, address, err)
+			continue
+		}
+		s.mb.RecordNtpOffsetDataPoint(now, response.offset.Seconds(), address)
+		s.mb.RecordNtpRttDataPoint(now, response.rtt.Seconds(), address)
+		s.mb.RecordNtpStratumDataPoint(now, int64(response.stratum), address)
+		s.mb.RecordNtpRootDistanceDataPoint(now, response.rootDistance().Seconds(), address)
+	}
+
+	return s.mb.Emit(), nil
+}
+
+// recordError records an error of the server by its type, as error messages may hold
+// unbounded values, and logs the full message
+func (s *ntpScraper) recordError(now pcommon.Timestamp, address string, err error) {
+	errType := queryErrorType(err)
+	s.settings.Logger.Warn("NTP query failed",
+		zap.String("server", address),
+		zap.String("error_type", errType.String()),
+		zap.Error(err))
+	s.mb.RecordNtpErrorDataPoint(now, int64(1), address, errType)
+}
+
+// queryErrorType returns the type of an NTP query error
+func queryErrorType(err error) metadata.AttributeErrorType {
+	var netErr net.Error
+	switch {
+	case errors.Is(err, errNotSynchronized):
+		return metadata.AttributeErrorTypeUnsynchronized
+	case errors.Is(err, errKissOfDeath):
+		return metadata.AttributeErrorTypeKissOfDeath
+	case errors.Is(err, errResponseTooShort), errors.Is(err, errNotServerMode), errors.Is(err, errOriginMismatch):
+		return metadata.AttributeErrorTypeInvalidResponse
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return metadata.AttributeErrorTypeTimeout
+	default:
+		return metadata.AttributeErrorTypeConnection
+	}
+}
+
+func newScraper(conf *Config, settings component.ReceiverCreateSettings) *ntpScraper {
+	return &ntpScraper{
+		cfg:      conf,
+		settings: settings.TelemetrySettings,
+		mb:       metadata.NewMetricsBuilder(conf.Metrics, settings.BuildInfo),
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ntpreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ntpreceiver"
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/scrapertest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/scrapertest/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ntpreceiver/internal/metadata"
+)
+
+// mockServer describes the responses of a mock NTP server
+type mockServer struct {
+	offset  time.Duration
+	stratum uint8
+	leap    uint8
+	// kissCode is sent as the reference ID, it is a kiss code when stratum is 0
+	kissCode string
+	silent   bool
+}
+
+// start starts the NTP server on a local UDP port and returns its address
+func (m mockServer) start(t *testing.T) string {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	go func() {
+		request := make([]byte, ntpPacketSize)
+		for {
+			n, addr, err := conn.ReadFrom(request)
+			if err != nil {
+				return
+			}
+			if m.silent || n < ntpPacketSize {
+				continue
+			}
+
+			response := make([]byte, ntpPacketSize)
+			response[0] = m.leap<<6 | ntpVersion<<3 | ntpModeServer
+			response[1] = m.stratum
+			// root delay of 0.5s and root dispersion of 0.25s
+			binary.BigEndian.PutUint32(response[4:], 1<<15)
+			binary.BigEndian.PutUint32(response[8:], 1<<14)
+			copy(response[12:16], m.kissCode)
+			copy(response[24:32], request[40:48])
+			serverTime := toNTPTime(time.Now().Add(m.offset))
+			binary.BigEndian.PutUint64(response[32:], serverTime)
+			binary.BigEndian.PutUint64(response[40:], serverTime)
+			_, _ = conn.WriteTo(response, addr)
+		}
+	}()
+	return conn.LocalAddr().String()
+}
+
+func TestScraperScrape(t *testing.T) {
+	testCases := []struct {
+		desc           string
+		server         mockServer
+		goldenFile     string
+		compareOptions []scrapertest.CompareOption
+	}{
+		{
+			desc:       "Synchronized server",
+			server:     mockServer{offset: 10 * time.Second, stratum: 2},
+			goldenFile: "metrics_golden.json",
+			compareOptions: []scrapertest.CompareOption{
+				scrapertest.IgnoreMetricAttributeValue("ntp.server"),
+				scrapertest.IgnoreMetricValues("ntp.offset", "ntp.rtt", "ntp.root_distance"),
+			},
+		},
+		{
+			desc:       "Kiss-o'-death",
+			server:     mockServer{kissCode: "RATE"},
+			goldenFile: "kiss_of_death.json",
+			compareOptions: []scrapertest.CompareOption{
+				scrapertest.IgnoreMetricAttributeValue("ntp.server"),
+			},
+		},
+		{
+			desc:       "Unsynchronized server",
+			server:     mockServer{stratum: 16, leap: ntpLeapNotSynchronized},
+			goldenFile: "unsynchronized.json",
+			compareOptions: []scrapertest.CompareOption{
+				scrapertest.IgnoreMetricAttributeValue("ntp.server"),
+			},
+		},
+		{
+			desc:       "No response",
+			server:     mockServer{silent: true},
+			goldenFile: "no_response.json",
+			compareOptions: []scrapertest.CompareOption{
+				scrapertest.IgnoreMetricAttributeValue("ntp.server"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Servers = []string{tc.server.start(t)}
+			cfg.Timeout = 200 * time.Millisecond
+			cfg.Metrics.NtpRootDistance.Enabled = true
+			scraper := newScraper(cfg, componenttest.NewNopReceiverCreateSettings())
+
+			actualMetrics, err := scraper.scrape(context.Background())
+			require.NoError(t, err)
+
+			expectedMetrics, err := golden.ReadMetrics(filepath.Join("testdata", "expected_metrics", tc.goldenFile))
+			require.NoError(t, err)
+			require.NoError(t, scrapertest.CompareMetrics(expectedMetrics, actualMetrics, tc.compareOptions...))
+		})
+	}
+}
+
+func TestQueryErrorType(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		err      error
+		expected metadata.AttributeErrorType
+	}{
+		{
+			desc:     "unsynchronized",
+			err:      errNotSynchronized,
+			expected: metadata.AttributeErrorTypeUnsynchronized,
+		},
+		{
+			desc:     "kiss-o'-death",
+			err:      fmt.Errorf("%w with code %q", errKissOfDeath, "RATE"),
+			expected: metadata.AttributeErrorTypeKissOfDeath,
+		},
+		{
+			desc:     "invalid response",
+			err:      errOriginMismatch,
+			expected: metadata.AttributeErrorTypeInvalidResponse,
+		},
+		{
+			desc:     "timeout",
+			err:      &net.OpError{Op: "read", Net: "udp", Err: os.ErrDeadlineExceeded},
+			expected: metadata.AttributeErrorTypeTimeout,
+		},
+		{
+			desc:     "other error",
+			err:      errors.New("connection refused"),
+			expected: metadata.AttributeErrorTypeConnection,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, tc.expected, queryErrorType(tc.err))
+		})
+	}
+}
+
+func TestQueryNTP(t *testing.T) {
+	server := mockServer{offset: -3 * time.Second, stratum: 1}.start(t)
+
+	response, err := queryNTP(context.Background(), server, time.Second)
+	require.NoError(t, err)
+	require.InDelta(t, -3, response.offset.Seconds(), 0.1)
+	require.GreaterOrEqual(t, response.rtt, time.Duration(0))
+	require.Less(t, response.rtt, time.Second)
+	require.EqualValues(t, 1, response.stratum)
+	require.Equal(t, 500*time.Millisecond, response.rootDelay)
+	require.Equal(t, 250*time.Millisecond, response.rootDispersion)
+	require.Equal(t, 500*time.Millisecond, response.rootDistance()-response.rtt/2)
+}
+
+func TestNTPTime(t *testing.T) {
+	now := time.Unix(1700000000, 123456789)
+	require.WithinDuration(t, now, fromNTPTime(toNTPTime(now)), time.Microsecond)
+	require.Equal(t, uint64(ntpEpochOffset)<<32, toNTPTime(time.Unix(0, 0)))
+}
@@ -31,6 +31,11 @@ type Config struct {
 	// An empty list means that CORS is not enabled at all. A wildcard (*) can be
 	// used to match any origin or one or more characters of an origin.
 	CorsOrigins []string `mapstructure:"cors_allowed_origins"`
+
+	// TranslateStackdriverAttributes enables adding the semantic conventions equivalents
+	// of the Stackdriver-specific span attributes, e.g. `http.method` for `/http/method`.
+	// The original attributes are kept.
+	TranslateStackdriverAttributes bool `mapstructure:"translate_stackdriver_attributes"`
 }
 
 func (cfg *Config) buildOptions() []ocOption {
@@ -38,6 +43,9 @@ func (cfg *Config) buildOptions() []ocOption {
 	if len(cfg.CorsOrigins) > 0 {
 		opts = append(opts, withCorsOrigins(cfg.CorsOrigins))
 	}
+	if cfg.TranslateStackdriverAttributes {
+		opts = append(opts, withStackdriverAttributesTranslation())
+	}
 
 	opts = append(opts, withGRPCServerSettings(cfg.GRPCServerSettings))
 
@@ -132,6 +132,20 @@ func TestLoadConfig(t *testing.T) {
 				CorsOrigins: []string{"https://*.test.com", "https://test.com"},
 			},
 		},
+		{
+			id: component.NewIDWithName(typeStr, "stackdriver"),
+			expected: &Config{
+				ReceiverSettings: config.NewReceiverSettings(component.NewID(typeStr)),
+				GRPCServerSettings: configgrpc.GRPCServerSettings{
+					NetAddr: confignet.NetAddr{
+						Endpoint:  "0.0.0.0:55678",
+						Transport: "tcp",
+					},
+					ReadBufferSize: 512 * 1024,
+				},
+				TranslateStackdriverAttributes: true,
+			},
+		},
 		{
 			id: component.NewIDWithName(typeStr, "uds"),
 			expected: &Config{
@@ -41,10 +41,23 @@ type Receiver struct {
 	nextConsumer consumer.Traces
 	id           component.ID
 	obsrecv      *obsreport.Receiver
+
+	translateStackdriverAttributes bool
+}
+
+// Option configures the Receiver.
+type Option func(*Receiver)
+
+// WithStackdriverAttributesTranslation adds the semantic conventions equivalents of the
+// Stackdriver-specific span attributes during translation.
+func WithStackdriverAttributesTranslation() Option {
+	return func(ocr *Receiver) {
+		ocr.translateStackdriverAttributes = true
+	}
 }
 
 // New creates a new opencensus.Receiver reference.
-func New(id component.ID, nextConsumer consumer.Traces, set component.ReceiverCreateSettings) (*Receiver, error) {
+func New(id component.ID, nextConsumer consumer.Traces, set component.ReceiverCreateSettings, opts ...Option) (*Receiver, error) {
 	if nextConsumer == nil {
 		return nil, component.ErrNilNextConsumer
 	}
@@ -58,11 +71,15 @@ func New(id component.ID, nextConsumer consumer.Traces, set component.ReceiverCr
 	if err != nil {
 		return nil, err
 	}
-	return &Receiver{
+	ocr := &Receiver{
 		nextConsumer: nextConsumer,
 		id:           id,
 		obsrecv:      obsrecv,
-	}, nil
+	}
+	for _, opt := range opts {
+		opt(ocr)
+	}
+	return ocr, nil
 }
 
 var _ agenttracepb.TraceServiceServer = (*Receiver)(nil)
@@ -136,6 +153,9 @@ func (ocr *Receiver) processReceivedMsg(
 	}
 
 	td := internaldata.OCToTraces(lastNonNilNode, resource, recv.Spans)
+	if ocr.translateStackdriverAttributes {
+		translateStackdriverAttributes(td)
+	}
 	err := ocr.sendToNextConsumer(longLivedRPCCtx, td)
 	return lastNonNilNode, resource, err
 }
@@ -0,0 +1,101 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package octrace // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/opencensusreceiver/internal/octrace"
+
+import (
+	"strconv"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+)
+
+// stackdriverAttribute describes the semantic conventions equivalent of a
+// Stackdriver-specific attribute.
+type stackdriverAttribute struct {
+	key string
+	// isInt is true when the equivalent is an integer, while Stackdriver labels are strings.
+	isInt bool
+}
+
+// stackdriverAttributes maps the attributes set by the OpenCensus Stackdriver
+// integrations to their semantic conventions equivalents.
+// See https://cloud.google.com/trace/docs/trace-labels.
+var stackdriverAttributes = map[string]stackdriverAttribute{
+	"/http/host":          {key: conventions.AttributeHTTPHost},
+	"/http/method":        {key: conventions.AttributeHTTPMethod},
+	"/http/path":          {key: conventions.AttributeHTTPTarget},
+	"/http/route":         {key: conventions.AttributeHTTPRoute},
+	"/http/url":           {key: conventions.AttributeHTTPURL},
+	"/http/user_agent":    {key: conventions.AttributeHTTPUserAgent},
+	"/http/status_code":   {key: conventions.AttributeHTTPStatusCode, isInt: true},
+	"/http/request/size":  {key: conventions.AttributeHTTPRequestContentLength, isInt: true},
+	"/http/response/size": {key: conventions.AttributeHTTPResponseContentLength, isInt: true},
+	"/error/name":         {key: conventions.AttributeExceptionType},
+	"/error/message":      {key: conventions.AttributeExceptionMessage},
+	"/stacktrace":         {key: conventions.AttributeExceptionStacktrace},
+}
+
+// translateStackdriverAttributes adds the semantic conventions equivalents of the
+// Stackdriver-specific attributes of spans and their annotations. The original
+// attributes are kept, so that existing consumers of them are not broken.
+func translateStackdriverAttributes(td ptrace.Traces) {
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		ilss := rss.At(i).ScopeSpans()
+		for j := 0; j < ilss.Len(); j++ {
+			spans := ilss.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				translateStackdriverAttributeMap(span.Attributes())
+				events := span.Events()
+				for l := 0; l < events.Len(); l++ {
+					translateStackdriverAttributeMap(events.At(l).Attributes())
+				}
+			}
+		}
+	}
+}
+
+func translateStackdriverAttributeMap(attrs pcommon.Map) {
+	type translated struct {
+		key   string
+		value pcommon.Value
+	}
+	var toAdd []translated
+	attrs.Range(func(k string, v pcommon.Value) bool {
+		sdAttr, ok := stackdriverAttributes[k]
+		if !ok {
+			return true
+		}
+		// An attribute already set by the instrumentation takes precedence.
+		if _, exists := attrs.Get(sdAttr.key); exists {
+			return true
+		}
+		if sdAttr.isInt && v.Type() == pcommon.ValueTypeStr {
+			i, err := strconv.ParseInt(v.Str(), 10, 64)
+			if err != nil {
+				return true
+			}
+			toAdd = append(toAdd, translated{key: sdAttr.key, value: pcommon.NewValueInt(i)})
+			return true
+		}
+		toAdd = append(toAdd, translated{key: sdAttr.key, value: v})
+		return true
+	})
+	for _, t := range toAdd {
+		t.value.CopyTo(attrs.PutEmpty(t.key))
+	}
+}
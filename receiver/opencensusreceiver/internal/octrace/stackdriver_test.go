@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package octrace
+
+import (
+	"context"
+	"testing"
+
+	commonpb "github.com/census-instrumentation/opencensus-proto/gen-go/agent/common/v1"
+	agenttracepb "github.com/census-instrumentation/opencensus-proto/gen-go/agent/trace/v1"
+	tracepb "github.com/census-instrumentation/opencensus-proto/gen-go/trace/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func TestTranslateStackdriverAttributes(t *testing.T) {
+	td := ptrace.NewTraces()
+	span := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.Attributes().PutStr("/http/method", "GET")
+	span.Attributes().PutStr("/http/status_code", "503")
+	span.Attributes().PutStr("/http/request/size", "not a number")
+	span.Attributes().PutStr("/http/route", "/cart/:id")
+	span.Attributes().PutStr("http.route", "/cart/{id}")
+	span.Attributes().PutStr("/component", "grpc")
+	event := span.Events().AppendEmpty()
+	event.Attributes().PutStr("/error/name", "TimeoutError")
+	event.Attributes().PutStr("/stacktrace", "at checkout()")
+
+	translateStackdriverAttributes(td)
+
+	assert.Equal(t, map[string]interface{}{
+		"/http/method":       "GET",
+		"/http/status_code":  "503",
+		"/http/request/size": "not a number",
+		"/http/route":        "/cart/:id",
+		"/component":         "grpc",
+		"http.method":        "GET",
+		"http.status_code":   int64(503),
+		"http.route":         "/cart/{id}",
+	}, span.Attributes().AsRaw())
+	assert.Equal(t, map[string]interface{}{
+		"/error/name":          "TimeoutError",
+		"/stacktrace":          "at checkout()",
+		"exception.type":       "TimeoutError",
+		"exception.stacktrace": "at checkout()",
+	}, event.Attributes().AsRaw())
+}
+
+func TestReceiverStackdriverAttributesTranslation(t *testing.T) {
+	ocSpan := &tracepb.Span{
+		TraceId: []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+		SpanId:  []byte{1, 2, 3, 4, 5, 6, 7, 8},
+		Attributes: &tracepb.Span_Attributes{
+			AttributeMap: map[string]*tracepb.AttributeValue{
+				"/http/host": {Value: &tracepb.AttributeValue_StringValue{StringValue: &tracepb.TruncatableString{Value: "example.com"}}},
+			},
+		},
+	}
+	node := &commonpb.Node{ServiceInfo: &commonpb.ServiceInfo{Name: "checkout"}}
+
+	for _, translate := range []bool{false, true} {
+		sink := new(consumertest.TracesSink)
+		var opts []Option
+		if translate {
+			opts = append(opts, WithStackdriverAttributesTranslation())
+		}
+		ocr, err := New(component.NewID("opencensus"), sink, componenttest.NewNopReceiverCreateSettings(), opts...)
+		require.NoError(t, err)
+
+		_, _, err = ocr.processReceivedMsg(context.Background(), nil, nil, &agenttracepb.ExportTraceServiceRequest{
+			Node:  node,
+			Spans: []*tracepb.Span{ocSpan},
+		})
+		require.NoError(t, err)
+
+		require.Len(t, sink.AllTraces(), 1)
+		attrs := sink.AllTraces()[0].ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Attributes()
+		host, ok := attrs.Get("http.host")
+		assert.Equal(t, translate, ok)
+		if translate {
+			assert.Equal(t, "example.com", host.Str())
+		}
+	}
+}
@@ -46,6 +46,7 @@ type ocReceiver struct {
 	gatewayMux         *gatewayruntime.ServeMux
 	corsOrigins        []string
 	grpcServerSettings configgrpc.GRPCServerSettings
+	traceOptions       []octrace.Option
 
 	traceReceiver   *octrace.Receiver
 	metricsReceiver *ocmetrics.Receiver
@@ -130,7 +131,7 @@ func (ocr *ocReceiver) registerTraceConsumer(host component.Host) error {
 	var err error
 
 	ocr.startTracesReceiverOnce.Do(func() {
-		ocr.traceReceiver, err = octrace.New(ocr.id, ocr.traceConsumer, ocr.settings)
+		ocr.traceReceiver, err = octrace.New(ocr.id, ocr.traceConsumer, ocr.settings, ocr.traceOptions...)
 		if err != nil {
 			return
 		}
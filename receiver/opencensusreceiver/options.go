@@ -16,6 +16,8 @@ package opencensusreceiver // import "github.com/open-telemetry/opentelemetry-co
 
 import (
 	"go.opentelemetry.io/collector/config/configgrpc"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/opencensusreceiver/internal/octrace"
 )
 
 // ocOption interface defines for configuration settings to be applied to receivers.
@@ -41,6 +43,20 @@ func withCorsOrigins(origins []string) ocOption {
 	return &corsOrigins{origins: origins}
 }
 
+type stackdriverAttributesTranslation struct{}
+
+var _ ocOption = (*stackdriverAttributesTranslation)(nil)
+
+func (stackdriverAttributesTranslation) withReceiver(ocr *ocReceiver) {
+	ocr.traceOptions = append(ocr.traceOptions, octrace.WithStackdriverAttributesTranslation())
+}
+
+// withStackdriverAttributesTranslation is an option to add the semantic conventions
+// equivalents of the Stackdriver-specific span attributes.
+func withStackdriverAttributesTranslation() ocOption {
+	return stackdriverAttributesTranslation{}
+}
+
 type grpcServerSettings configgrpc.GRPCServerSettings
 
 func withGRPCServerSettings(settings configgrpc.GRPCServerSettings) ocOption {
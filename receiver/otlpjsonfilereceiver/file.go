@@ -20,10 +20,12 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
 	"go.opentelemetry.io/collector/obsreport"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/multierr"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/adapter"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
@@ -59,9 +61,10 @@ func createDefaultConfig() component.ReceiverConfig {
 }
 
 type receiver struct {
-	input     *fileconsumer.Manager
-	id        component.ID
-	storageID *component.ID
+	input         *fileconsumer.Manager
+	id            component.ID
+	storageID     *component.ID
+	storageClient storage.Client
 }
 
 func (f *receiver) Start(ctx context.Context, host component.Host) error {
@@ -69,11 +72,18 @@ func (f *receiver) Start(ctx context.Context, host component.Host) error {
 	if err != nil {
 		return err
 	}
+	f.storageClient = storageClient
 	return f.input.Start(storageClient)
 }
 
+// Shutdown stops reading the files, then closes the storage client so that the
+// checkpointed offsets are persisted
 func (f *receiver) Shutdown(ctx context.Context) error {
-	return f.input.Stop()
+	err := f.input.Stop()
+	if f.storageClient != nil {
+		err = multierr.Append(err, f.storageClient.Close(ctx))
+	}
+	return err
 }
 
 func createLogsReceiver(_ context.Context, settings component.ReceiverCreateSettings, configuration component.ReceiverConfig, logs consumer.Logs) (component.LogsReceiver, error) {
@@ -89,8 +99,7 @@ func createLogsReceiver(_ context.Context, settings component.ReceiverCreateSett
 	cfg := configuration.(*Config)
 	input, err := cfg.Config.Build(settings.Logger.Sugar(), func(ctx context.Context, attrs *fileconsumer.FileAttributes, token []byte) {
 		ctx = obsrecv.StartLogsOp(ctx)
-		var l plog.Logs
-		l, err = logsUnmarshaler.UnmarshalLogs(token)
+		l, err := logsUnmarshaler.UnmarshalLogs(token)
 		if err != nil {
 			obsrecv.EndLogsOp(ctx, typeStr, 0, err)
 		} else {
@@ -118,8 +127,7 @@ func createMetricsReceiver(_ context.Context, settings component.ReceiverCreateS
 	cfg := configuration.(*Config)
 	input, err := cfg.Config.Build(settings.Logger.Sugar(), func(ctx context.Context, attrs *fileconsumer.FileAttributes, token []byte) {
 		ctx = obsrecv.StartMetricsOp(ctx)
-		var m pmetric.Metrics
-		m, err = metricsUnmarshaler.UnmarshalMetrics(token)
+		m, err := metricsUnmarshaler.UnmarshalMetrics(token)
 		if err != nil {
 			obsrecv.EndMetricsOp(ctx, typeStr, 0, err)
 		} else {
@@ -147,8 +155,7 @@ func createTracesReceiver(ctx context.Context, settings component.ReceiverCreate
 	cfg := configuration.(*Config)
 	input, err := cfg.Config.Build(settings.Logger.Sugar(), func(ctx context.Context, attrs *fileconsumer.FileAttributes, token []byte) {
 		ctx = obsrecv.StartTracesOp(ctx)
-		var t ptrace.Traces
-		t, err = tracesUnmarshaler.UnmarshalTraces(token)
+		t, err := tracesUnmarshaler.UnmarshalTraces(token)
 		if err != nil {
 			obsrecv.EndTracesOp(ctx, typeStr, 0, err)
 		} else {
@@ -15,6 +15,8 @@
 package otlpjsonfilereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/otlpjsonfilereceiver"
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"os"
 	"path/filepath"
@@ -32,6 +34,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/testdata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
@@ -156,3 +159,150 @@ func TestLoadConfig(t *testing.T) {
 
 	assert.Equal(t, testdataConfigYamlAsMap(), cfg)
 }
+
+func TestLoadConfigTail(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+
+	sub, err := cm.Sub(component.NewIDWithName(typeStr, "tail").String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalReceiverConfig(sub, cfg))
+
+	storageID := component.NewID("file_storage")
+	assert.Equal(t, &storageID, cfg.StorageID)
+	assert.Equal(t, []string{"/var/otlp/*.json", "/var/otlp/archive/*.json.gz"}, cfg.Include)
+	assert.Equal(t, "beginning", cfg.StartAt)
+	assert.Equal(t, "auto", cfg.Compression)
+	assert.Equal(t, time.Second, cfg.PollInterval)
+}
+
+// appendLine appends data, as a line, to the file at path
+func appendLine(t *testing.T, path string, data []byte) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = f.Write(append(data, '\n'))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+}
+
+func TestFileTracesReceiverTail(t *testing.T) {
+	tempFolder := t.TempDir()
+	factory := NewFactory()
+	cfg := createDefaultConfig().(*Config)
+	cfg.Config.Include = []string{filepath.Join(tempFolder, "*.json")}
+	cfg.Config.StartAt = "beginning"
+	cfg.Config.PollInterval = 10 * time.Millisecond
+	sink := new(consumertest.TracesSink)
+	receiver, err := factory.CreateTracesReceiver(context.Background(), componenttest.NewNopReceiverCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, receiver.Start(context.Background(), nil))
+
+	marshaler := &ptrace.JSONMarshaler{}
+	first := testdata.GenerateTracesOneSpan()
+	b, err := marshaler.MarshalTraces(first)
+	require.NoError(t, err)
+	appendLine(t, filepath.Join(tempFolder, "traces.json"), b)
+	require.Eventually(t, func() bool { return len(sink.AllTraces()) == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	// only the lines appended to the growing file, and the lines of new files, are read
+	second := testdata.GenerateTracesTwoSpansSameResource()
+	b, err = marshaler.MarshalTraces(second)
+	require.NoError(t, err)
+	appendLine(t, filepath.Join(tempFolder, "traces.json"), b)
+	appendLine(t, filepath.Join(tempFolder, "other.json"), b)
+	require.Eventually(t, func() bool { return len(sink.AllTraces()) == 3 }, 5*time.Second, 10*time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+	require.Len(t, sink.AllTraces(), 3)
+
+	assert.EqualValues(t, first, sink.AllTraces()[0])
+	assert.EqualValues(t, second, sink.AllTraces()[1])
+	assert.EqualValues(t, second, sink.AllTraces()[2])
+	require.NoError(t, receiver.Shutdown(context.Background()))
+}
+
+func TestFileLogsReceiverGzip(t *testing.T) {
+	tempFolder := t.TempDir()
+	factory := NewFactory()
+	cfg := createDefaultConfig().(*Config)
+	cfg.Config.Include = []string{filepath.Join(tempFolder, "*")}
+	cfg.Config.StartAt = "beginning"
+	cfg.Config.Compression = "auto"
+	cfg.Config.PollInterval = 10 * time.Millisecond
+	sink := new(consumertest.LogsSink)
+	receiver, err := factory.CreateLogsReceiver(context.Background(), componenttest.NewNopReceiverCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, receiver.Start(context.Background(), nil))
+
+	ld := testdata.GenerateLogsManyLogRecordsSameResource(5)
+	marshaler := &plog.JSONMarshaler{}
+	b, err := marshaler.MarshalLogs(ld)
+	require.NoError(t, err)
+	var compressed bytes.Buffer
+	gz := gzip.NewWriter(&compressed)
+	_, err = gz.Write(append(b, '\n'))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+	require.NoError(t, os.WriteFile(filepath.Join(tempFolder, "logs.json.gz"), compressed.Bytes(), 0600))
+
+	require.Eventually(t, func() bool { return len(sink.AllLogs()) == 1 }, 5*time.Second, 10*time.Millisecond)
+	assert.EqualValues(t, ld, sink.AllLogs()[0])
+	require.NoError(t, receiver.Shutdown(context.Background()))
+}
+
+func TestFileMetricsReceiverStorage(t *testing.T) {
+	ctx := context.Background()
+	tempFolder := t.TempDir()
+	storageDir := t.TempDir()
+	path := filepath.Join(tempFolder, "metrics.json")
+
+	factory := NewFactory()
+	cfg := createDefaultConfig().(*Config)
+	cfg.Config.Include = []string{filepath.Join(tempFolder, "*")}
+	cfg.Config.StartAt = "beginning"
+	cfg.Config.PollInterval = 10 * time.Millisecond
+	extID := storagetest.NewFileBackedStorageExtension("test", storageDir).ID()
+	cfg.StorageID = &extID
+	marshaler := &pmetric.JSONMarshaler{}
+
+	start := func(sink *consumertest.MetricsSink) (component.MetricsReceiver, *storagetest.StorageHost) {
+		ext := storagetest.NewFileBackedStorageExtension("test", storageDir)
+		host := storagetest.NewStorageHost().WithExtension(ext.ID(), ext)
+		receiver, err := factory.CreateMetricsReceiver(ctx, componenttest.NewNopReceiverCreateSettings(), cfg, sink)
+		require.NoError(t, err)
+		require.NoError(t, receiver.Start(ctx, host))
+		return receiver, host
+	}
+	stop := func(receiver component.MetricsReceiver, host *storagetest.StorageHost) {
+		require.NoError(t, receiver.Shutdown(ctx))
+		for _, e := range host.GetExtensions() {
+			require.NoError(t, e.Shutdown(ctx))
+		}
+	}
+
+	first := testdata.GenerateMetricsOneMetric()
+	b, err := marshaler.MarshalMetrics(first)
+	require.NoError(t, err)
+	appendLine(t, path, b)
+
+	sink := new(consumertest.MetricsSink)
+	receiver, host := start(sink)
+	require.Eventually(t, func() bool { return len(sink.AllMetrics()) == 1 }, 5*time.Second, 10*time.Millisecond)
+	assert.EqualValues(t, first, sink.AllMetrics()[0])
+	stop(receiver, host)
+
+	// the line appended while the receiver is not running is read from the checkpointed offset
+	second := testdata.GenerateMetricsManyMetricsSameResource(5)
+	b, err = marshaler.MarshalMetrics(second)
+	require.NoError(t, err)
+	appendLine(t, path, b)
+
+	sink = new(consumertest.MetricsSink)
+	receiver, host = start(sink)
+	require.Eventually(t, func() bool { return len(sink.AllMetrics()) == 1 }, 5*time.Second, 10*time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+	require.Len(t, sink.AllMetrics(), 1)
+	assert.EqualValues(t, second, sink.AllMetrics()[0])
+	stop(receiver, host)
+}
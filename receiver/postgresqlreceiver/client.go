@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"strconv"
 	"strings"
 	"time"
 
@@ -53,6 +54,8 @@ type client interface {
 	getDatabaseTableMetrics(ctx context.Context, db string) (map[tableIdentifier]tableStats, error)
 	getBlocksReadByTable(ctx context.Context, db string) (map[tableIdentifier]tableIOStats, error)
 	getReplicationStats(ctx context.Context) ([]replicationStats, error)
+	getReplicationSlotStats(ctx context.Context) ([]replicationSlotStats, error)
+	getQueryStats(ctx context.Context, limit int) ([]queryStats, error)
 	getLatestWalAgeSeconds(ctx context.Context) (int64, error)
 	getMaxConnections(ctx context.Context) (int64, error)
 	getIndexStats(ctx context.Context, database string) (map[indexIdentifer]indexStat, error)
@@ -490,6 +493,96 @@ func (c *postgreSQLClient) getReplicationStats(ctx context.Context) ([]replicati
 	return rs, errors
 }
 
+type replicationSlotStats struct {
+	slotName     string
+	pendingBytes int64
+}
+
+func (c *postgreSQLClient) getReplicationSlotStats(ctx context.Context) ([]replicationSlotStats, error) {
+	query := `SELECT
+	slot_name,
+	coalesce(pg_wal_lsn_diff(pg_current_wal_lsn(), confirmed_flush_lsn), -1) AS slot_bytes_pending
+	FROM pg_replication_slots
+	WHERE slot_type = 'logical';
+	`
+	rows, err := c.client.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query pg_replication_slots: %w", err)
+	}
+	defer rows.Close()
+	var ss []replicationSlotStats
+	var errors error
+	for rows.Next() {
+		var slot string
+		var pendingBytes int64
+		if err = rows.Scan(&slot, &pendingBytes); err != nil {
+			errors = multierr.Append(errors, err)
+			continue
+		}
+		ss = append(ss, replicationSlotStats{
+			slotName:     slot,
+			pendingBytes: pendingBytes,
+		})
+	}
+	return ss, errors
+}
+
+type queryStats struct {
+	database  string
+	queryID   string
+	calls     int64
+	rows      int64
+	totalTime float64
+}
+
+// pg_stat_statements renamed total_time to total_exec_time in PostgreSQL 13.
+const totalExecTimeMinVersion = 130000
+
+func (c *postgreSQLClient) getQueryStats(ctx context.Context, limit int) ([]queryStats, error) {
+	var version int
+	if err := c.client.QueryRowContext(ctx, `SELECT current_setting('server_version_num')::int;`).Scan(&version); err != nil {
+		return nil, fmt.Errorf("unable to determine server version: %w", err)
+	}
+	totalTime := "total_exec_time"
+	if version < totalExecTimeMinVersion {
+		totalTime = "total_time"
+	}
+
+	query := fmt.Sprintf(`SELECT
+	d.datname,
+	s.queryid,
+	s.calls,
+	s.rows,
+	s.%[1]s
+	FROM pg_stat_statements s
+	JOIN pg_database d ON d.oid = s.dbid
+	ORDER BY s.%[1]s DESC
+	LIMIT $1;
+	`, totalTime)
+	rows, err := c.client.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query pg_stat_statements: %w", err)
+	}
+	defer rows.Close()
+	var qs []queryStats
+	var errors error
+	for rows.Next() {
+		var s queryStats
+		var queryID sql.NullInt64
+		if err = rows.Scan(&s.database, &queryID, &s.calls, &s.rows, &s.totalTime); err != nil {
+			errors = multierr.Append(errors, err)
+			continue
+		}
+		if !queryID.Valid {
+			// the query id is hidden from users without the pg_read_all_stats role
+			continue
+		}
+		s.queryID = strconv.FormatInt(queryID.Int64, 10)
+		qs = append(qs, s)
+	}
+	return qs, errors
+}
+
 func (c *postgreSQLClient) getLatestWalAgeSeconds(ctx context.Context) (int64, error) {
 	query := `SELECT
 	coalesce(last_archived_time, CURRENT_TIMESTAMP) AS last_archived_wal,
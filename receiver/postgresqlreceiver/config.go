@@ -34,6 +34,7 @@ const (
 	ErrNotSupported        = "invalid config: field '%s' not supported"
 	ErrTransportsSupported = "invalid config: 'transport' must be 'tcp' or 'unix'"
 	ErrHostPort            = "invalid config: 'endpoint' must be in the form <host>:<port> no matter what 'transport' is configured"
+	ErrTopQueryCount       = "invalid config: 'top_query_count' must be positive"
 )
 
 type Config struct {
@@ -41,6 +42,7 @@ type Config struct {
 	Username                                string                         `mapstructure:"username"`
 	Password                                string                         `mapstructure:"password"`
 	Databases                               []string                       `mapstructure:"databases"`
+	TopQueryCount                           int                            `mapstructure:"top_query_count"`
 	confignet.NetAddr                       `mapstructure:",squash"`       // provides Endpoint and Transport
 	configtls.TLSClientSetting              `mapstructure:"tls,omitempty"` // provides SSL details
 	Metrics                                 metadata.MetricsSettings       `mapstructure:"metrics"`
@@ -66,6 +68,10 @@ func (cfg *Config) Validate() error {
 		err = multierr.Append(err, fmt.Errorf(ErrNotSupported, "MinVersion"))
 	}
 
+	if cfg.TopQueryCount <= 0 {
+		err = multierr.Append(err, errors.New(ErrTopQueryCount))
+	}
+
 	switch cfg.Transport {
 	case "tcp", "unix":
 		_, _, endpointErr := net.SplitHostPort(cfg.Endpoint)
@@ -97,6 +97,17 @@ func TestValidate(t *testing.T) {
 				fmt.Errorf(ErrNotSupported, "MinVersion"),
 			),
 		},
+		{
+			desc: "bad top query count",
+			defaultConfigModifier: func(cfg *Config) {
+				cfg.Username = "otel"
+				cfg.Password = "otel"
+				cfg.TopQueryCount = 0
+			},
+			expected: multierr.Combine(
+				errors.New(ErrTopQueryCount),
+			),
+		},
 		{
 			desc: "no error",
 			defaultConfigModifier: func(cfg *Config) {
@@ -148,6 +159,7 @@ func TestLoadConfig(t *testing.T) {
 		expected.Username = "otel"
 		expected.Password = "$POSTGRESQL_PASSWORD"
 		expected.Databases = []string{"otel"}
+		expected.TopQueryCount = 25
 		expected.CollectionInterval = 10 * time.Second
 		expected.TLSClientSetting = configtls.TLSClientSetting{
 			Insecure:           false,
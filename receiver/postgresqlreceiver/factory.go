@@ -54,7 +54,8 @@ func createDefaultConfig() component.ReceiverConfig {
 			Insecure:           false,
 			InsecureSkipVerify: true,
 		},
-		Metrics: metadata.DefaultMetricsSettings(),
+		TopQueryCount: 10,
+		Metrics:       metadata.DefaultMetricsSettings(),
 	}
 }
 
@@ -51,7 +51,11 @@ type MetricsSettings struct {
 	PostgresqlIndexScans               MetricSettings `mapstructure:"postgresql.index.scans"`
 	PostgresqlIndexSize                MetricSettings `mapstructure:"postgresql.index.size"`
 	PostgresqlOperations               MetricSettings `mapstructure:"postgresql.operations"`
+	PostgresqlQueryCalls               MetricSettings `mapstructure:"postgresql.query.calls"`
+	PostgresqlQueryRows                MetricSettings `mapstructure:"postgresql.query.rows"`
+	PostgresqlQueryTime                MetricSettings `mapstructure:"postgresql.query.time"`
 	PostgresqlReplicationDataDelay     MetricSettings `mapstructure:"postgresql.replication.data_delay"`
+	PostgresqlReplicationSlotLag       MetricSettings `mapstructure:"postgresql.replication.slot.lag"`
 	PostgresqlRollbacks                MetricSettings `mapstructure:"postgresql.rollbacks"`
 	PostgresqlRows                     MetricSettings `mapstructure:"postgresql.rows"`
 	PostgresqlTableCount               MetricSettings `mapstructure:"postgresql.table.count"`
@@ -105,9 +109,21 @@ func DefaultMetricsSettings() MetricsSettings {
 		PostgresqlOperations: MetricSettings{
 			Enabled: true,
 		},
+		PostgresqlQueryCalls: MetricSettings{
+			Enabled: false,
+		},
+		PostgresqlQueryRows: MetricSettings{
+			Enabled: false,
+		},
+		PostgresqlQueryTime: MetricSettings{
+			Enabled: false,
+		},
 		PostgresqlReplicationDataDelay: MetricSettings{
 			Enabled: true,
 		},
+		PostgresqlReplicationSlotLag: MetricSettings{
+			Enabled: false,
+		},
 		PostgresqlRollbacks: MetricSettings{
 			Enabled: true,
 		},
@@ -1088,6 +1104,168 @@ func newMetricPostgresqlOperations(settings MetricSettings) metricPostgresqlOper
 	return m
 }
 
+type metricPostgresqlQueryCalls struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills postgresql.query.calls metric with initial data.
+func (m *metricPostgresqlQueryCalls) init() {
+	m.data.SetName("postgresql.query.calls")
+	m.data.SetDescription("Number of times the query was executed.")
+	m.data.SetUnit("{calls}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricPostgresqlQueryCalls) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, databaseAttributeValue string, queryIDAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("database", databaseAttributeValue)
+	dp.Attributes().PutStr("query_id", queryIDAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricPostgresqlQueryCalls) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricPostgresqlQueryCalls) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricPostgresqlQueryCalls(settings MetricSettings) metricPostgresqlQueryCalls {
+	m := metricPostgresqlQueryCalls{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricPostgresqlQueryRows struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills postgresql.query.rows metric with initial data.
+func (m *metricPostgresqlQueryRows) init() {
+	m.data.SetName("postgresql.query.rows")
+	m.data.SetDescription("Number of rows retrieved or affected by the query.")
+	m.data.SetUnit("{rows}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricPostgresqlQueryRows) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, databaseAttributeValue string, queryIDAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("database", databaseAttributeValue)
+	dp.Attributes().PutStr("query_id", queryIDAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricPostgresqlQueryRows) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricPostgresqlQueryRows) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricPostgresqlQueryRows(settings MetricSettings) metricPostgresqlQueryRows {
+	m := metricPostgresqlQueryRows{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricPostgresqlQueryTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills postgresql.query.time metric with initial data.
+func (m *metricPostgresqlQueryTime) init() {
+	m.data.SetName("postgresql.query.time")
+	m.data.SetDescription("Total time spent executing the query.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricPostgresqlQueryTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, databaseAttributeValue string, queryIDAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("database", databaseAttributeValue)
+	dp.Attributes().PutStr("query_id", queryIDAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricPostgresqlQueryTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricPostgresqlQueryTime) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricPostgresqlQueryTime(settings MetricSettings) metricPostgresqlQueryTime {
+	m := metricPostgresqlQueryTime{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricPostgresqlReplicationDataDelay struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -1139,6 +1317,57 @@ func newMetricPostgresqlReplicationDataDelay(settings MetricSettings) metricPost
 	return m
 }
 
+type metricPostgresqlReplicationSlotLag struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills postgresql.replication.slot.lag metric with initial data.
+func (m *metricPostgresqlReplicationSlotLag) init() {
+	m.data.SetName("postgresql.replication.slot.lag")
+	m.data.SetDescription("The amount of WAL the consumer of a logical replication slot has not confirmed yet.")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricPostgresqlReplicationSlotLag) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, replicationSlotAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("replication_slot", replicationSlotAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricPostgresqlReplicationSlotLag) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricPostgresqlReplicationSlotLag) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricPostgresqlReplicationSlotLag(settings MetricSettings) metricPostgresqlReplicationSlotLag {
+	m := metricPostgresqlReplicationSlotLag{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricPostgresqlRollbacks struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -1523,7 +1752,11 @@ type MetricsBuilder struct {
 	metricPostgresqlIndexScans               metricPostgresqlIndexScans
 	metricPostgresqlIndexSize                metricPostgresqlIndexSize
 	metricPostgresqlOperations               metricPostgresqlOperations
+	metricPostgresqlQueryCalls               metricPostgresqlQueryCalls
+	metricPostgresqlQueryRows                metricPostgresqlQueryRows
+	metricPostgresqlQueryTime                metricPostgresqlQueryTime
 	metricPostgresqlReplicationDataDelay     metricPostgresqlReplicationDataDelay
+	metricPostgresqlReplicationSlotLag       metricPostgresqlReplicationSlotLag
 	metricPostgresqlRollbacks                metricPostgresqlRollbacks
 	metricPostgresqlRows                     metricPostgresqlRows
 	metricPostgresqlTableCount               metricPostgresqlTableCount
@@ -1562,7 +1795,11 @@ func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo,
 		metricPostgresqlIndexScans:               newMetricPostgresqlIndexScans(settings.PostgresqlIndexScans),
 		metricPostgresqlIndexSize:                newMetricPostgresqlIndexSize(settings.PostgresqlIndexSize),
 		metricPostgresqlOperations:               newMetricPostgresqlOperations(settings.PostgresqlOperations),
+		metricPostgresqlQueryCalls:               newMetricPostgresqlQueryCalls(settings.PostgresqlQueryCalls),
+		metricPostgresqlQueryRows:                newMetricPostgresqlQueryRows(settings.PostgresqlQueryRows),
+		metricPostgresqlQueryTime:                newMetricPostgresqlQueryTime(settings.PostgresqlQueryTime),
 		metricPostgresqlReplicationDataDelay:     newMetricPostgresqlReplicationDataDelay(settings.PostgresqlReplicationDataDelay),
+		metricPostgresqlReplicationSlotLag:       newMetricPostgresqlReplicationSlotLag(settings.PostgresqlReplicationSlotLag),
 		metricPostgresqlRollbacks:                newMetricPostgresqlRollbacks(settings.PostgresqlRollbacks),
 		metricPostgresqlRows:                     newMetricPostgresqlRows(settings.PostgresqlRows),
 		metricPostgresqlTableCount:               newMetricPostgresqlTableCount(settings.PostgresqlTableCount),
@@ -1657,7 +1894,11 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricPostgresqlIndexScans.emit(ils.Metrics())
 	mb.metricPostgresqlIndexSize.emit(ils.Metrics())
 	mb.metricPostgresqlOperations.emit(ils.Metrics())
+	mb.metricPostgresqlQueryCalls.emit(ils.Metrics())
+	mb.metricPostgresqlQueryRows.emit(ils.Metrics())
+	mb.metricPostgresqlQueryTime.emit(ils.Metrics())
 	mb.metricPostgresqlReplicationDataDelay.emit(ils.Metrics())
+	mb.metricPostgresqlReplicationSlotLag.emit(ils.Metrics())
 	mb.metricPostgresqlRollbacks.emit(ils.Metrics())
 	mb.metricPostgresqlRows.emit(ils.Metrics())
 	mb.metricPostgresqlTableCount.emit(ils.Metrics())
@@ -1754,11 +1995,31 @@ func (mb *MetricsBuilder) RecordPostgresqlOperationsDataPoint(ts pcommon.Timesta
 	mb.metricPostgresqlOperations.recordDataPoint(mb.startTime, ts, val, databaseAttributeValue, tableAttributeValue, operationAttributeValue.String())
 }
 
+// RecordPostgresqlQueryCallsDataPoint adds a data point to postgresql.query.calls metric.
+func (mb *MetricsBuilder) RecordPostgresqlQueryCallsDataPoint(ts pcommon.Timestamp, val int64, databaseAttributeValue string, queryIDAttributeValue string) {
+	mb.metricPostgresqlQueryCalls.recordDataPoint(mb.startTime, ts, val, databaseAttributeValue, queryIDAttributeValue)
+}
+
+// RecordPostgresqlQueryRowsDataPoint adds a data point to postgresql.query.rows metric.
+func (mb *MetricsBuilder) RecordPostgresqlQueryRowsDataPoint(ts pcommon.Timestamp, val int64, databaseAttributeValue string, queryIDAttributeValue string) {
+	mb.metricPostgresqlQueryRows.recordDataPoint(mb.startTime, ts, val, databaseAttributeValue, queryIDAttributeValue)
+}
+
+// RecordPostgresqlQueryTimeDataPoint adds a data point to postgresql.query.time metric.
+func (mb *MetricsBuilder) RecordPostgresqlQueryTimeDataPoint(ts pcommon.Timestamp, val float64, databaseAttributeValue string, queryIDAttributeValue string) {
+	mb.metricPostgresqlQueryTime.recordDataPoint(mb.startTime, ts, val, databaseAttributeValue, queryIDAttributeValue)
+}
+
 // RecordPostgresqlReplicationDataDelayDataPoint adds a data point to postgresql.replication.data_delay metric.
 func (mb *MetricsBuilder) RecordPostgresqlReplicationDataDelayDataPoint(ts pcommon.Timestamp, val int64, replicationClientAttributeValue string) {
 	mb.metricPostgresqlReplicationDataDelay.recordDataPoint(mb.startTime, ts, val, replicationClientAttributeValue)
 }
 
+// RecordPostgresqlReplicationSlotLagDataPoint adds a data point to postgresql.replication.slot.lag metric.
+func (mb *MetricsBuilder) RecordPostgresqlReplicationSlotLagDataPoint(ts pcommon.Timestamp, val int64, replicationSlotAttributeValue string) {
+	mb.metricPostgresqlReplicationSlotLag.recordDataPoint(mb.startTime, ts, val, replicationSlotAttributeValue)
+}
+
 // RecordPostgresqlRollbacksDataPoint adds a data point to postgresql.rollbacks metric.
 func (mb *MetricsBuilder) RecordPostgresqlRollbacksDataPoint(ts pcommon.Timestamp, val int64, databaseAttributeValue string) {
 	mb.metricPostgresqlRollbacks.recordDataPoint(mb.startTime, ts, val, databaseAttributeValue)
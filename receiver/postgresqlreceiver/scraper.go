@@ -154,6 +154,8 @@ func (p *postgreSQLScraper) scrape(ctx context.Context) (pmetric.Metrics, error)
 		p.collectWalAge(ctx, now, listClient, &errs)
 		p.collectReplicationStats(ctx, now, listClient, &errs)
 		p.collectMaxConnections(ctx, now, listClient, &errs)
+		p.collectReplicationSlotStats(ctx, now, listClient, &errs)
+		p.collectQueryStats(ctx, now, listClient, &errs)
 	}
 
 	return p.mb.Emit(), errs.Combine()
@@ -359,6 +361,54 @@ func (p *postgreSQLScraper) collectReplicationStats(
 	}
 }
 
+// collectReplicationSlotStats records the lag of the logical replication slots. The metric is disabled
+// by default, so pg_replication_slots is only queried when it is enabled.
+func (p *postgreSQLScraper) collectReplicationSlotStats(
+	ctx context.Context,
+	now pcommon.Timestamp,
+	client client,
+	errs *scrapererror.ScrapeErrors,
+) {
+	if !p.config.Metrics.PostgresqlReplicationSlotLag.Enabled {
+		return
+	}
+	slots, err := client.getReplicationSlotStats(ctx)
+	if err != nil {
+		errs.AddPartial(1, err)
+		return
+	}
+	for _, slot := range slots {
+		if slot.pendingBytes >= 0 {
+			p.mb.RecordPostgresqlReplicationSlotLagDataPoint(now, slot.pendingBytes, slot.slotName)
+		}
+	}
+}
+
+// collectQueryStats records the statistics of the top queries by total execution time. The metrics are
+// disabled by default as they require the pg_stat_statements extension.
+func (p *postgreSQLScraper) collectQueryStats(
+	ctx context.Context,
+	now pcommon.Timestamp,
+	client client,
+	errs *scrapererror.ScrapeErrors,
+) {
+	if !p.config.Metrics.PostgresqlQueryCalls.Enabled &&
+		!p.config.Metrics.PostgresqlQueryRows.Enabled &&
+		!p.config.Metrics.PostgresqlQueryTime.Enabled {
+		return
+	}
+	queries, err := client.getQueryStats(ctx, p.config.TopQueryCount)
+	if err != nil {
+		errs.AddPartial(3, err)
+		return
+	}
+	for _, q := range queries {
+		p.mb.RecordPostgresqlQueryCallsDataPoint(now, q.calls, q.database, q.queryID)
+		p.mb.RecordPostgresqlQueryRowsDataPoint(now, q.rows, q.database, q.queryID)
+		p.mb.RecordPostgresqlQueryTimeDataPoint(now, q.totalTime, q.database, q.queryID)
+	}
+}
+
 func (p *postgreSQLScraper) collectWalAge(
 	ctx context.Context,
 	now pcommon.Timestamp,
@@ -136,6 +136,28 @@ func TestScraperWithResourceAttributeFeatureGateSingle(t *testing.T) {
 	require.NoError(t, scrapertest.CompareMetrics(expectedMetrics, actualMetrics))
 }
 
+func TestScraperQueryAndReplicationSlotStats(t *testing.T) {
+	factory := mockClientFactory{}
+	factory.initMocks([]string{"otel"})
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.TopQueryCount = 2
+	cfg.Metrics.PostgresqlQueryCalls.Enabled = true
+	cfg.Metrics.PostgresqlQueryRows.Enabled = true
+	cfg.Metrics.PostgresqlQueryTime.Enabled = true
+	cfg.Metrics.PostgresqlReplicationSlotLag.Enabled = true
+	scraper := newPostgreSQLScraper(componenttest.NewNopReceiverCreateSettings(), cfg, &factory)
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	expectedFile := filepath.Join("testdata", "scraper", "otel", "expected_with_queries.json")
+	expectedMetrics, err := golden.ReadMetrics(expectedFile)
+	require.NoError(t, err)
+
+	require.NoError(t, scrapertest.CompareMetrics(expectedMetrics, actualMetrics))
+}
+
 type mockClientFactory struct{ mock.Mock }
 type mockClient struct{ mock.Mock }
 
@@ -196,6 +218,16 @@ func (m *mockClient) getReplicationStats(ctx context.Context) ([]replicationStat
 	return args.Get(0).([]replicationStats), args.Error(1)
 }
 
+func (m *mockClient) getReplicationSlotStats(ctx context.Context) ([]replicationSlotStats, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]replicationSlotStats), args.Error(1)
+}
+
+func (m *mockClient) getQueryStats(ctx context.Context, limit int) ([]queryStats, error) {
+	args := m.Called(ctx, limit)
+	return args.Get(0).([]queryStats), args.Error(1)
+}
+
 func (m *mockClient) listDatabases(_ context.Context) ([]string, error) {
 	args := m.Called()
 	return args.Get(0).([]string), args.Error(1)
@@ -271,6 +303,32 @@ func (m *mockClient) initMocks(database string, databases []string, index int) {
 				writeLag:     -1,
 			},
 		}, nil)
+		m.On("getReplicationSlotStats", mock.Anything).Return([]replicationSlotStats{
+			{
+				slotName:     "otel_slot",
+				pendingBytes: 2048,
+			},
+			{
+				slotName:     "unconfirmed_slot",
+				pendingBytes: -1,
+			},
+		}, nil)
+		m.On("getQueryStats", mock.Anything, 2).Return([]queryStats{
+			{
+				database:  "otel",
+				queryID:   "-6381422347962163424",
+				calls:     12,
+				rows:      120,
+				totalTime: 45.5,
+			},
+			{
+				database:  "otel",
+				queryID:   "2941232390212355718",
+				calls:     3,
+				rows:      1,
+				totalTime: 7.25,
+			},
+		}, nil)
 	} else {
 		table1 := "public.table1"
 		table2 := "public.table2"
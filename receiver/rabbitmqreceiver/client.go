@@ -27,12 +27,22 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/rabbitmqreceiver/internal/models"
 )
 
-// queuePath is the path to queues endpoint
-const queuePath = "/api/queues"
+const (
+	// queuePath is the path to queues endpoint
+	queuePath = "/api/queues"
+	// shovelPath is the path to the shovels endpoint of the shovel management plugin
+	shovelPath = "/api/shovels"
+	// federationLinkPath is the path to the federation links endpoint of the federation management plugin
+	federationLinkPath = "/api/federation-links"
+)
 
 type client interface {
 	// GetQueues calls "/api/queues" endpoint to get list of queues for the target node
 	GetQueues(ctx context.Context) ([]*models.Queue, error)
+	// GetShovels calls "/api/shovels" endpoint to get the status of the shovels
+	GetShovels(ctx context.Context) ([]*models.Shovel, error)
+	// GetFederationLinks calls "/api/federation-links" endpoint to get the status of the federation links
+	GetFederationLinks(ctx context.Context) ([]*models.FederationLink, error)
 }
 
 var _ client = (*rabbitmqClient)(nil)
@@ -77,6 +87,28 @@ func (c *rabbitmqClient) GetQueues(ctx context.Context) ([]*models.Queue, error)
 	return queues, nil
 }
 
+func (c *rabbitmqClient) GetShovels(ctx context.Context) ([]*models.Shovel, error) {
+	var shovels []*models.Shovel
+
+	if err := c.get(ctx, shovelPath, &shovels); err != nil {
+		c.logger.Debug("Failed to retrieve shovels", zap.Error(err))
+		return nil, err
+	}
+
+	return shovels, nil
+}
+
+func (c *rabbitmqClient) GetFederationLinks(ctx context.Context) ([]*models.FederationLink, error) {
+	var links []*models.FederationLink
+
+	if err := c.get(ctx, federationLinkPath, &links); err != nil {
+		c.logger.Debug("Failed to retrieve federation links", zap.Error(err))
+		return nil, err
+	}
+
+	return links, nil
+}
+
 func (c *rabbitmqClient) get(ctx context.Context, path string, respObj interface{}) error {
 	// Construct endpoint and create request
 	url := c.hostEndpoint + path
@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"regexp"
 
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
@@ -43,6 +44,66 @@ type Config struct {
 	Username                                string                   `mapstructure:"username"`
 	Password                                string                   `mapstructure:"password"`
 	Metrics                                 metadata.MetricsSettings `mapstructure:"metrics"`
+	// Queues filters the queues whose metrics are collected.
+	Queues QueuesConfig `mapstructure:"queues"`
+}
+
+// QueuesConfig filters queues by name with regular expressions.
+type QueuesConfig struct {
+	// Include restricts the queues to the ones matching any of the expressions, if not empty.
+	Include []string `mapstructure:"include"`
+	// Exclude removes the queues matching any of the expressions, after Include was applied.
+	Exclude []string `mapstructure:"exclude"`
+}
+
+// queueFilter is the compiled form of a QueuesConfig.
+type queueFilter struct {
+	include []*regexp.Regexp
+	exclude []*regexp.Regexp
+}
+
+func newQueueFilter(cfg QueuesConfig) (*queueFilter, error) {
+	var err error
+	f := &queueFilter{}
+	if f.include, err = compileExpressions(cfg.Include); err != nil {
+		return nil, fmt.Errorf("invalid queues include: %w", err)
+	}
+	if f.exclude, err = compileExpressions(cfg.Exclude); err != nil {
+		return nil, fmt.Errorf("invalid queues exclude: %w", err)
+	}
+	return f, nil
+}
+
+func compileExpressions(expressions []string) ([]*regexp.Regexp, error) {
+	compiled := make([]*regexp.Regexp, 0, len(expressions))
+	for _, expr := range expressions {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, err
+		}
+		compiled = append(compiled, re)
+	}
+	return compiled, nil
+}
+
+// matches returns whether the queue is scraped, a nil filter matches every queue.
+func (f *queueFilter) matches(queue string) bool {
+	if f == nil {
+		return true
+	}
+	if len(f.include) > 0 && !matchesAny(f.include, queue) {
+		return false
+	}
+	return !matchesAny(f.exclude, queue)
+}
+
+func matchesAny(expressions []*regexp.Regexp, s string) bool {
+	for _, re := range expressions {
+		if re.MatchString(s) {
+			return true
+		}
+	}
+	return false
 }
 
 // Validate validates the configuration by checking for missing or invalid fields
@@ -62,5 +123,9 @@ func (cfg *Config) Validate() error {
 		err = multierr.Append(err, wrappedErr)
 	}
 
+	if _, filterErr := newQueueFilter(cfg.Queues); filterErr != nil {
+		err = multierr.Append(err, filterErr)
+	}
+
 	return err
 }
@@ -86,6 +86,21 @@ func TestValidate(t *testing.T) {
 				fmt.Errorf("%s: %w", errInvalidEndpoint, errors.New(`parse "invalid://endpoint:  12efg": invalid port ":  12efg" after host`)),
 			),
 		},
+		{
+			desc: "invalid queue filters",
+			cfg: &Config{
+				Username: "otelu",
+				Password: "otelp",
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: defaultEndpoint,
+				},
+				Queues: QueuesConfig{
+					Include: []string{"^web"},
+					Exclude: []string{"("},
+				},
+			},
+			expectedErr: errors.New("invalid queues exclude: error parsing regexp: missing closing ): `(`"),
+		},
 		{
 			desc: "valid config",
 			cfg: &Config{
@@ -128,6 +143,10 @@ func TestLoadConfig(t *testing.T) {
 	expected.Username = "otelu"
 	expected.Password = "$RABBITMQ_PASSWORD"
 	expected.CollectionInterval = 10 * time.Second
+	expected.Queues = QueuesConfig{
+		Include: []string{"^orders\\."},
+		Exclude: []string{"\\.tmp$"},
+	}
 
 	require.Equal(t, expected, cfg)
 }
@@ -37,12 +37,16 @@ func (ms *MetricSettings) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsSettings provides settings for rabbitmqreceiver metrics.
 type MetricsSettings struct {
-	RabbitmqConsumerCount       MetricSettings `mapstructure:"rabbitmq.consumer.count"`
-	RabbitmqMessageAcknowledged MetricSettings `mapstructure:"rabbitmq.message.acknowledged"`
-	RabbitmqMessageCurrent      MetricSettings `mapstructure:"rabbitmq.message.current"`
-	RabbitmqMessageDelivered    MetricSettings `mapstructure:"rabbitmq.message.delivered"`
-	RabbitmqMessageDropped      MetricSettings `mapstructure:"rabbitmq.message.dropped"`
-	RabbitmqMessagePublished    MetricSettings `mapstructure:"rabbitmq.message.published"`
+	RabbitmqConsumerCount         MetricSettings `mapstructure:"rabbitmq.consumer.count"`
+	RabbitmqConsumerUtilization   MetricSettings `mapstructure:"rabbitmq.consumer.utilization"`
+	RabbitmqFederationLinkRunning MetricSettings `mapstructure:"rabbitmq.federation.link.running"`
+	RabbitmqMessageAcknowledged   MetricSettings `mapstructure:"rabbitmq.message.acknowledged"`
+	RabbitmqMessageCurrent        MetricSettings `mapstructure:"rabbitmq.message.current"`
+	RabbitmqMessageDelivered      MetricSettings `mapstructure:"rabbitmq.message.delivered"`
+	RabbitmqMessageDropped        MetricSettings `mapstructure:"rabbitmq.message.dropped"`
+	RabbitmqMessagePublished      MetricSettings `mapstructure:"rabbitmq.message.published"`
+	RabbitmqMessageRate           MetricSettings `mapstructure:"rabbitmq.message.rate"`
+	RabbitmqShovelRunning         MetricSettings `mapstructure:"rabbitmq.shovel.running"`
 }
 
 func DefaultMetricsSettings() MetricsSettings {
@@ -50,6 +54,12 @@ func DefaultMetricsSettings() MetricsSettings {
 		RabbitmqConsumerCount: MetricSettings{
 			Enabled: true,
 		},
+		RabbitmqConsumerUtilization: MetricSettings{
+			Enabled: false,
+		},
+		RabbitmqFederationLinkRunning: MetricSettings{
+			Enabled: false,
+		},
 		RabbitmqMessageAcknowledged: MetricSettings{
 			Enabled: true,
 		},
@@ -65,7 +75,47 @@ func DefaultMetricsSettings() MetricsSettings {
 		RabbitmqMessagePublished: MetricSettings{
 			Enabled: true,
 		},
+		RabbitmqMessageRate: MetricSettings{
+			Enabled: false,
+		},
+		RabbitmqShovelRunning: MetricSettings{
+			Enabled: false,
+		},
+	}
+}
+
+// AttributeMessageOperation specifies the a value message.operation attribute.
+type AttributeMessageOperation int
+
+const (
+	_ AttributeMessageOperation = iota
+	AttributeMessageOperationPublish
+	AttributeMessageOperationDeliver
+	AttributeMessageOperationAck
+	AttributeMessageOperationRedeliver
+)
+
+// String returns the string representation of the AttributeMessageOperation.
+func (av AttributeMessageOperation) String() string {
+	switch av {
+	case AttributeMessageOperationPublish:
+		return "publish"
+	case AttributeMessageOperationDeliver:
+		return "deliver"
+	case AttributeMessageOperationAck:
+		return "ack"
+	case AttributeMessageOperationRedeliver:
+		return "redeliver"
 	}
+	return ""
+}
+
+// MapAttributeMessageOperation is a helper map of string to AttributeMessageOperation attribute value.
+var MapAttributeMessageOperation = map[string]AttributeMessageOperation{
+	"publish":   AttributeMessageOperationPublish,
+	"deliver":   AttributeMessageOperationDeliver,
+	"ack":       AttributeMessageOperationAck,
+	"redeliver": AttributeMessageOperationRedeliver,
 }
 
 // AttributeMessageState specifies the a value message.state attribute.
@@ -145,6 +195,107 @@ func newMetricRabbitmqConsumerCount(settings MetricSettings) metricRabbitmqConsu
 	return m
 }
 
+type metricRabbitmqConsumerUtilization struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills rabbitmq.consumer.utilization metric with initial data.
+func (m *metricRabbitmqConsumerUtilization) init() {
+	m.data.SetName("rabbitmq.consumer.utilization")
+	m.data.SetDescription("The fraction of time the queue is able to immediately deliver messages to consumers.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricRabbitmqConsumerUtilization) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRabbitmqConsumerUtilization) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRabbitmqConsumerUtilization) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRabbitmqConsumerUtilization(settings MetricSettings) metricRabbitmqConsumerUtilization {
+	m := metricRabbitmqConsumerUtilization{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRabbitmqFederationLinkRunning struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills rabbitmq.federation.link.running metric with initial data.
+func (m *metricRabbitmqFederationLinkRunning) init() {
+	m.data.SetName("rabbitmq.federation.link.running")
+	m.data.SetDescription("Whether the federation link is running (1) or not (0).")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRabbitmqFederationLinkRunning) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, federationUpstreamAttributeValue string, federationTargetAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("upstream", federationUpstreamAttributeValue)
+	dp.Attributes().PutStr("target", federationTargetAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRabbitmqFederationLinkRunning) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRabbitmqFederationLinkRunning) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRabbitmqFederationLinkRunning(settings MetricSettings) metricRabbitmqFederationLinkRunning {
+	m := metricRabbitmqFederationLinkRunning{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricRabbitmqMessageAcknowledged struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -402,20 +553,126 @@ func newMetricRabbitmqMessagePublished(settings MetricSettings) metricRabbitmqMe
 	return m
 }
 
+type metricRabbitmqMessageRate struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills rabbitmq.message.rate metric with initial data.
+func (m *metricRabbitmqMessageRate) init() {
+	m.data.SetName("rabbitmq.message.rate")
+	m.data.SetDescription("The rate of the operations performed on the messages of the queue.")
+	m.data.SetUnit("{messages}/s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRabbitmqMessageRate) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, messageOperationAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("operation", messageOperationAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRabbitmqMessageRate) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRabbitmqMessageRate) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRabbitmqMessageRate(settings MetricSettings) metricRabbitmqMessageRate {
+	m := metricRabbitmqMessageRate{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRabbitmqShovelRunning struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills rabbitmq.shovel.running metric with initial data.
+func (m *metricRabbitmqShovelRunning) init() {
+	m.data.SetName("rabbitmq.shovel.running")
+	m.data.SetDescription("Whether the shovel is running (1) or not (0).")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRabbitmqShovelRunning) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, shovelNameAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("shovel", shovelNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRabbitmqShovelRunning) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRabbitmqShovelRunning) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRabbitmqShovelRunning(settings MetricSettings) metricRabbitmqShovelRunning {
+	m := metricRabbitmqShovelRunning{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user settings.
 type MetricsBuilder struct {
-	startTime                         pcommon.Timestamp   // start time that will be applied to all recorded data points.
-	metricsCapacity                   int                 // maximum observed number of metrics per resource.
-	resourceCapacity                  int                 // maximum observed number of resource attributes.
-	metricsBuffer                     pmetric.Metrics     // accumulates metrics data before emitting.
-	buildInfo                         component.BuildInfo // contains version information
-	metricRabbitmqConsumerCount       metricRabbitmqConsumerCount
-	metricRabbitmqMessageAcknowledged metricRabbitmqMessageAcknowledged
-	metricRabbitmqMessageCurrent      metricRabbitmqMessageCurrent
-	metricRabbitmqMessageDelivered    metricRabbitmqMessageDelivered
-	metricRabbitmqMessageDropped      metricRabbitmqMessageDropped
-	metricRabbitmqMessagePublished    metricRabbitmqMessagePublished
+	startTime                           pcommon.Timestamp   // start time that will be applied to all recorded data points.
+	metricsCapacity                     int                 // maximum observed number of metrics per resource.
+	resourceCapacity                    int                 // maximum observed number of resource attributes.
+	metricsBuffer                       pmetric.Metrics     // accumulates metrics data before emitting.
+	buildInfo                           component.BuildInfo // contains version information
+	metricRabbitmqConsumerCount         metricRabbitmqConsumerCount
+	metricRabbitmqConsumerUtilization   metricRabbitmqConsumerUtilization
+	metricRabbitmqFederationLinkRunning metricRabbitmqFederationLinkRunning
+	metricRabbitmqMessageAcknowledged   metricRabbitmqMessageAcknowledged
+	metricRabbitmqMessageCurrent        metricRabbitmqMessageCurrent
+	metricRabbitmqMessageDelivered      metricRabbitmqMessageDelivered
+	metricRabbitmqMessageDropped        metricRabbitmqMessageDropped
+	metricRabbitmqMessagePublished      metricRabbitmqMessagePublished
+	metricRabbitmqMessageRate           metricRabbitmqMessageRate
+	metricRabbitmqShovelRunning         metricRabbitmqShovelRunning
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -430,15 +687,19 @@ func WithStartTime(startTime pcommon.Timestamp) metricBuilderOption {
 
 func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo, options ...metricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		startTime:                         pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                     pmetric.NewMetrics(),
-		buildInfo:                         buildInfo,
-		metricRabbitmqConsumerCount:       newMetricRabbitmqConsumerCount(settings.RabbitmqConsumerCount),
-		metricRabbitmqMessageAcknowledged: newMetricRabbitmqMessageAcknowledged(settings.RabbitmqMessageAcknowledged),
-		metricRabbitmqMessageCurrent:      newMetricRabbitmqMessageCurrent(settings.RabbitmqMessageCurrent),
-		metricRabbitmqMessageDelivered:    newMetricRabbitmqMessageDelivered(settings.RabbitmqMessageDelivered),
-		metricRabbitmqMessageDropped:      newMetricRabbitmqMessageDropped(settings.RabbitmqMessageDropped),
-		metricRabbitmqMessagePublished:    newMetricRabbitmqMessagePublished(settings.RabbitmqMessagePublished),
+		startTime:                           pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                       pmetric.NewMetrics(),
+		buildInfo:                           buildInfo,
+		metricRabbitmqConsumerCount:         newMetricRabbitmqConsumerCount(settings.RabbitmqConsumerCount),
+		metricRabbitmqConsumerUtilization:   newMetricRabbitmqConsumerUtilization(settings.RabbitmqConsumerUtilization),
+		metricRabbitmqFederationLinkRunning: newMetricRabbitmqFederationLinkRunning(settings.RabbitmqFederationLinkRunning),
+		metricRabbitmqMessageAcknowledged:   newMetricRabbitmqMessageAcknowledged(settings.RabbitmqMessageAcknowledged),
+		metricRabbitmqMessageCurrent:        newMetricRabbitmqMessageCurrent(settings.RabbitmqMessageCurrent),
+		metricRabbitmqMessageDelivered:      newMetricRabbitmqMessageDelivered(settings.RabbitmqMessageDelivered),
+		metricRabbitmqMessageDropped:        newMetricRabbitmqMessageDropped(settings.RabbitmqMessageDropped),
+		metricRabbitmqMessagePublished:      newMetricRabbitmqMessagePublished(settings.RabbitmqMessagePublished),
+		metricRabbitmqMessageRate:           newMetricRabbitmqMessageRate(settings.RabbitmqMessageRate),
+		metricRabbitmqShovelRunning:         newMetricRabbitmqShovelRunning(settings.RabbitmqShovelRunning),
 	}
 	for _, op := range options {
 		op(mb)
@@ -513,11 +774,15 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
 	mb.metricRabbitmqConsumerCount.emit(ils.Metrics())
+	mb.metricRabbitmqConsumerUtilization.emit(ils.Metrics())
+	mb.metricRabbitmqFederationLinkRunning.emit(ils.Metrics())
 	mb.metricRabbitmqMessageAcknowledged.emit(ils.Metrics())
 	mb.metricRabbitmqMessageCurrent.emit(ils.Metrics())
 	mb.metricRabbitmqMessageDelivered.emit(ils.Metrics())
 	mb.metricRabbitmqMessageDropped.emit(ils.Metrics())
 	mb.metricRabbitmqMessagePublished.emit(ils.Metrics())
+	mb.metricRabbitmqMessageRate.emit(ils.Metrics())
+	mb.metricRabbitmqShovelRunning.emit(ils.Metrics())
 	for _, op := range rmo {
 		op(rm)
 	}
@@ -542,6 +807,16 @@ func (mb *MetricsBuilder) RecordRabbitmqConsumerCountDataPoint(ts pcommon.Timest
 	mb.metricRabbitmqConsumerCount.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRabbitmqConsumerUtilizationDataPoint adds a data point to rabbitmq.consumer.utilization metric.
+func (mb *MetricsBuilder) RecordRabbitmqConsumerUtilizationDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricRabbitmqConsumerUtilization.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordRabbitmqFederationLinkRunningDataPoint adds a data point to rabbitmq.federation.link.running metric.
+func (mb *MetricsBuilder) RecordRabbitmqFederationLinkRunningDataPoint(ts pcommon.Timestamp, val int64, federationUpstreamAttributeValue string, federationTargetAttributeValue string) {
+	mb.metricRabbitmqFederationLinkRunning.recordDataPoint(mb.startTime, ts, val, federationUpstreamAttributeValue, federationTargetAttributeValue)
+}
+
 // RecordRabbitmqMessageAcknowledgedDataPoint adds a data point to rabbitmq.message.acknowledged metric.
 func (mb *MetricsBuilder) RecordRabbitmqMessageAcknowledgedDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricRabbitmqMessageAcknowledged.recordDataPoint(mb.startTime, ts, val)
@@ -567,6 +842,16 @@ func (mb *MetricsBuilder) RecordRabbitmqMessagePublishedDataPoint(ts pcommon.Tim
 	mb.metricRabbitmqMessagePublished.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRabbitmqMessageRateDataPoint adds a data point to rabbitmq.message.rate metric.
+func (mb *MetricsBuilder) RecordRabbitmqMessageRateDataPoint(ts pcommon.Timestamp, val float64, messageOperationAttributeValue AttributeMessageOperation) {
+	mb.metricRabbitmqMessageRate.recordDataPoint(mb.startTime, ts, val, messageOperationAttributeValue.String())
+}
+
+// RecordRabbitmqShovelRunningDataPoint adds a data point to rabbitmq.shovel.running metric.
+func (mb *MetricsBuilder) RecordRabbitmqShovelRunningDataPoint(ts pcommon.Timestamp, val int64, shovelNameAttributeValue string) {
+	mb.metricRabbitmqShovelRunning.recordDataPoint(mb.startTime, ts, val, shovelNameAttributeValue)
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
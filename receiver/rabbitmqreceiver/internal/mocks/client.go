@@ -15,6 +15,29 @@ type MockClient struct {
 	mock.Mock
 }
 
+// GetFederationLinks provides a mock function with given fields: ctx
+func (_m *MockClient) GetFederationLinks(ctx context.Context) ([]*models.FederationLink, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*models.FederationLink
+	if rf, ok := ret.Get(0).(func(context.Context) []*models.FederationLink); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.FederationLink)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetQueues provides a mock function with given fields: ctx
 func (_m *MockClient) GetQueues(ctx context.Context) ([]*models.Queue, error) {
 	ret := _m.Called(ctx)
@@ -37,3 +60,26 @@ func (_m *MockClient) GetQueues(ctx context.Context) ([]*models.Queue, error) {
 
 	return r0, r1
 }
+
+// GetShovels provides a mock function with given fields: ctx
+func (_m *MockClient) GetShovels(ctx context.Context) ([]*models.Shovel, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*models.Shovel
+	if rf, ok := ret.Get(0).(func(context.Context) []*models.Shovel); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Shovel)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
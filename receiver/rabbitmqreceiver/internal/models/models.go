@@ -26,6 +26,30 @@ type Queue struct {
 	UnacknowledgedMessages int64 `json:"messages_unacknowledged"`
 	ReadyMessages          int64 `json:"messages_ready"`
 
+	// ConsumerUtilisation is null when the queue has no consumers
+	ConsumerUtilisation *float64 `json:"consumer_utilisation"`
+
 	// Embedded Metrics
 	MessageStats map[string]interface{} `json:"message_stats"`
 }
+
+// Shovel represents a shovel in the API response of the shovel management plugin
+type Shovel struct {
+	Name  string `json:"name"`
+	Node  string `json:"node"`
+	VHost string `json:"vhost"`
+	// State is one of starting, running or terminated
+	State string `json:"state"`
+}
+
+// FederationLink represents a federation link in the API response of the federation management plugin
+type FederationLink struct {
+	Upstream string `json:"upstream"`
+	Node     string `json:"node"`
+	VHost    string `json:"vhost"`
+	// Exchange is set for exchange federation links, Queue for queue federation links
+	Exchange string `json:"exchange"`
+	Queue    string `json:"queue"`
+	// Status is one of starting, running, shutdown or error
+	Status string `json:"status"`
+}
@@ -17,11 +17,13 @@ package rabbitmqreceiver // import "github.com/open-telemetry/opentelemetry-coll
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/rabbitmqreceiver/internal/metadata"
@@ -46,6 +48,14 @@ var messageStatMetrics = []string{
 	dropUnroutableStat,
 }
 
+// Rates to gather from the "<stat>_details" entries of the queue message_stats structure
+var messageRateOperations = map[string]metadata.AttributeMessageOperation{
+	publishStat: metadata.AttributeMessageOperationPublish,
+	deliverStat: metadata.AttributeMessageOperationDeliver,
+	ackStat:     metadata.AttributeMessageOperationAck,
+	"redeliver": metadata.AttributeMessageOperationRedeliver,
+}
+
 // rabbitmqScraper handles scraping of RabbitMQ metrics
 type rabbitmqScraper struct {
 	client   client
@@ -53,6 +63,8 @@ type rabbitmqScraper struct {
 	cfg      *Config
 	settings component.TelemetrySettings
 	mb       *metadata.MetricsBuilder
+	// queueFilter filters the queues whose metrics are collected.
+	queueFilter *queueFilter
 }
 
 // newScraper creates a new scraper
@@ -67,6 +79,9 @@ func newScraper(logger *zap.Logger, cfg *Config, settings component.ReceiverCrea
 
 // start starts the scraper by creating a new HTTP Client on the scraper
 func (r *rabbitmqScraper) start(ctx context.Context, host component.Host) (err error) {
+	if r.queueFilter, err = newQueueFilter(r.cfg.Queues); err != nil {
+		return err
+	}
 	r.client, err = newClient(r.cfg, host, r.settings, r.logger)
 	return
 }
@@ -88,11 +103,71 @@ func (r *rabbitmqScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 
 	// Collect metrics for each queue
 	for _, queue := range queues {
-
+		if !r.queueFilter.matches(queue.Name) {
+			continue
+		}
 		r.collectQueue(queue, now)
 	}
 
-	return r.mb.Emit(), nil
+	var errs scrapererror.ScrapeErrors
+	r.collectShovels(ctx, now, &errs)
+	r.collectFederationLinks(ctx, now, &errs)
+
+	return r.mb.Emit(), errs.Combine()
+}
+
+// collectShovels collects the status of the shovels, which requires the shovel management plugin
+func (r *rabbitmqScraper) collectShovels(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !r.cfg.Metrics.RabbitmqShovelRunning.Enabled {
+		return
+	}
+
+	shovels, err := r.client.GetShovels(ctx)
+	if err != nil {
+		errs.AddPartial(1, fmt.Errorf("failed to get shovels: %w", err))
+		return
+	}
+
+	for _, shovel := range shovels {
+		r.mb.RecordRabbitmqShovelRunningDataPoint(now, runningValue(shovel.State), shovel.Name)
+		r.mb.EmitForResource(
+			metadata.WithRabbitmqNodeName(shovel.Node),
+			metadata.WithRabbitmqVhostName(shovel.VHost),
+		)
+	}
+}
+
+// collectFederationLinks collects the status of the federation links, which requires the federation management plugin
+func (r *rabbitmqScraper) collectFederationLinks(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !r.cfg.Metrics.RabbitmqFederationLinkRunning.Enabled {
+		return
+	}
+
+	links, err := r.client.GetFederationLinks(ctx)
+	if err != nil {
+		errs.AddPartial(1, fmt.Errorf("failed to get federation links: %w", err))
+		return
+	}
+
+	for _, link := range links {
+		target := link.Exchange
+		if target == "" {
+			target = link.Queue
+		}
+		r.mb.RecordRabbitmqFederationLinkRunningDataPoint(now, runningValue(link.Status), link.Upstream, target)
+		r.mb.EmitForResource(
+			metadata.WithRabbitmqNodeName(link.Node),
+			metadata.WithRabbitmqVhostName(link.VHost),
+		)
+	}
+}
+
+// runningValue converts the state of a shovel or federation link to 1 if it is running.
+func runningValue(state string) int64 {
+	if state == "running" {
+		return 1
+	}
+	return 0
 }
 
 // collectQueue collects metrics
@@ -100,6 +175,20 @@ func (r *rabbitmqScraper) collectQueue(queue *models.Queue, now pcommon.Timestam
 	r.mb.RecordRabbitmqConsumerCountDataPoint(now, queue.Consumers)
 	r.mb.RecordRabbitmqMessageCurrentDataPoint(now, queue.UnacknowledgedMessages, metadata.AttributeMessageStateUnacknowledged)
 	r.mb.RecordRabbitmqMessageCurrentDataPoint(now, queue.ReadyMessages, metadata.AttributeMessageStateReady)
+	if queue.ConsumerUtilisation != nil {
+		r.mb.RecordRabbitmqConsumerUtilizationDataPoint(now, *queue.ConsumerUtilisation)
+	}
+
+	for stat, operation := range messageRateOperations {
+		// A rate is only reported once the operation occurred
+		details, ok := queue.MessageStats[stat+"_details"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if rate, ok := details["rate"].(float64); ok {
+			r.mb.RecordRabbitmqMessageRateDataPoint(now, rate, operation)
+		}
+	}
 
 	for _, messageStatMetric := range messageStatMetrics {
 		// Get metric value
@@ -27,6 +27,7 @@ import (
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/scrapertest"
@@ -156,3 +157,71 @@ func TestScaperScrape(t *testing.T) {
 		})
 	}
 }
+
+func TestScraperScrapeOptionalMetrics(t *testing.T) {
+	mockClient := mocks.MockClient{}
+	data := loadAPIResponseData(t, queuesAPIResponseFile)
+	var queues []*models.Queue
+	require.NoError(t, json.Unmarshal(data, &queues))
+	mockClient.On("GetQueues", mock.Anything).Return(queues, nil)
+	mockClient.On("GetShovels", mock.Anything).Return([]*models.Shovel{
+		{Name: "orders-shovel", Node: "rabbit@node1", VHost: "dev", State: "running"},
+		{Name: "audit-shovel", Node: "rabbit@node1", VHost: "dev", State: "terminated"},
+	}, nil)
+	mockClient.On("GetFederationLinks", mock.Anything).Return([]*models.FederationLink{
+		{Upstream: "dc2", Node: "rabbit@node1", VHost: "dev", Exchange: "events", Status: "running"},
+		{Upstream: "dc2", Node: "rabbit@node1", VHost: "dev", Queue: "jobs", Status: "error"},
+	}, nil)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Queues.Exclude = []string{"^test"}
+	cfg.Metrics.RabbitmqMessageRate.Enabled = true
+	cfg.Metrics.RabbitmqConsumerUtilization.Enabled = true
+	cfg.Metrics.RabbitmqShovelRunning.Enabled = true
+	cfg.Metrics.RabbitmqFederationLinkRunning.Enabled = true
+
+	scraper := newScraper(zap.NewNop(), cfg, componenttest.NewNopReceiverCreateSettings())
+	var err error
+	scraper.queueFilter, err = newQueueFilter(cfg.Queues)
+	require.NoError(t, err)
+	scraper.client = &mockClient
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	goldenPath := filepath.Join("testdata", "expected_metrics", "metrics_golden_optional.json")
+	expectedMetrics, err := golden.ReadMetrics(goldenPath)
+	require.NoError(t, err)
+
+	require.NoError(t, scrapertest.CompareMetrics(expectedMetrics, actualMetrics))
+}
+
+func TestScraperScrapePluginFailure(t *testing.T) {
+	mockClient := mocks.MockClient{}
+	mockClient.On("GetQueues", mock.Anything).Return([]*models.Queue{}, nil)
+	mockClient.On("GetShovels", mock.Anything).Return(nil, errors.New("non 200 code returned 404"))
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics.RabbitmqShovelRunning.Enabled = true
+
+	scraper := newScraper(zap.NewNop(), cfg, componenttest.NewNopReceiverCreateSettings())
+	scraper.client = &mockClient
+
+	_, err := scraper.scrape(context.Background())
+	require.EqualError(t, err, "failed to get shovels: non 200 code returned 404")
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+}
+
+func TestQueueFilter(t *testing.T) {
+	var filter *queueFilter
+	require.True(t, filter.matches("orders"))
+
+	filter, err := newQueueFilter(QueuesConfig{
+		Include: []string{"^orders", "^payments"},
+		Exclude: []string{"\\.tmp$"},
+	})
+	require.NoError(t, err)
+	require.True(t, filter.matches("orders.created"))
+	require.False(t, filter.matches("orders.tmp"))
+	require.False(t, filter.matches("audit"))
+}
@@ -0,0 +1,95 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package receivercreator // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/receivercreator"
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+)
+
+// annotationTemplate is the YAML value of the pod annotation named by receiverTemplate.Annotation.
+type annotationTemplate struct {
+	Config             map[string]interface{} `yaml:"config"`
+	ResourceAttributes map[string]interface{} `yaml:"resource_attributes"`
+}
+
+// podAnnotations returns the annotations of the pod of a pod or port endpoint.
+func podAnnotations(env observer.EndpointEnv) map[string]string {
+	switch env["type"] {
+	case string(observer.PodType):
+	case string(observer.PortType):
+		podEnv, ok := env["pod"].(observer.EndpointEnv)
+		if !ok {
+			return nil
+		}
+		env = podEnv
+	default:
+		return nil
+	}
+	annotations, _ := env["annotations"].(map[string]string)
+	return annotations
+}
+
+// applyAnnotation returns the config and resource attributes of the template overridden by
+// the ones of its annotation on the pod of the endpoint, if any.
+func (t receiverTemplate) applyAnnotation(env observer.EndpointEnv) (userConfigMap, map[string]interface{}, error) {
+	if t.Annotation == "" {
+		return t.config, t.ResourceAttributes, nil
+	}
+	value, ok := podAnnotations(env)[t.Annotation]
+	if !ok {
+		return t.config, t.ResourceAttributes, nil
+	}
+
+	var annotation annotationTemplate
+	if err := yaml.Unmarshal([]byte(value), &annotation); err != nil {
+		return nil, nil, fmt.Errorf("invalid annotation %q: %w", t.Annotation, err)
+	}
+	for k, v := range annotation.ResourceAttributes {
+		if _, ok := v.(string); !ok {
+			return nil, nil, fmt.Errorf("unsupported `resource_attributes` %q value %v in annotation %q", k, v, t.Annotation)
+		}
+	}
+
+	resourceAttributes := make(map[string]interface{}, len(t.ResourceAttributes)+len(annotation.ResourceAttributes))
+	for k, v := range t.ResourceAttributes {
+		resourceAttributes[k] = v
+	}
+	for k, v := range annotation.ResourceAttributes {
+		resourceAttributes[k] = v
+	}
+	return mergeConfig(t.config, annotation.Config), resourceAttributes, nil
+}
+
+// mergeConfig returns a copy of base with the values of override, merging nested maps.
+func mergeConfig(base, override map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(base)+len(override))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range override {
+		baseMap, baseIsMap := merged[k].(map[string]interface{})
+		overrideMap, overrideIsMap := v.(map[string]interface{})
+		if baseIsMap && overrideIsMap {
+			merged[k] = mergeConfig(baseMap, overrideMap)
+			continue
+		}
+		merged[k] = v
+	}
+	return merged
+}
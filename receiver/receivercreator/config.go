@@ -57,7 +57,11 @@ type receiverTemplate struct {
 	// ResourceAttributes is a map of resource attributes to add to just this receiver's resource metrics.
 	// It can contain expr expressions for endpoint env value expansion
 	ResourceAttributes map[string]interface{} `mapstructure:"resource_attributes"`
-	rule               rule
+	// Annotation is the name of a pod annotation whose YAML value overrides the `config` and
+	// `resource_attributes` of the receiver for the endpoints of that pod. Its values can also
+	// contain expr expressions for endpoint env value expansion.
+	Annotation string `mapstructure:"annotation"`
+	rule       rule
 }
 
 // resourceAttributes holds a map of default resource attributes for each Endpoint type.
@@ -105,7 +109,7 @@ func (cfg *Config) Unmarshal(componentParser *confmap.Conf) error {
 
 	for endpointType := range cfg.ResourceAttributes {
 		switch endpointType {
-		case observer.ContainerType, observer.HostPortType, observer.K8sNodeType, observer.PodType, observer.PortType:
+		case observer.ContainerType, observer.HostPortType, observer.K8sNodeType, observer.K8sServiceType, observer.K8sIngressType, observer.PodType, observer.PortType:
 		default:
 			return fmt.Errorf("resource attributes for unsupported endpoint type %q", endpointType)
 		}
@@ -85,6 +85,7 @@ func TestLoadConfig(t *testing.T) {
 						},
 						Rule:               `type == "port"`,
 						ResourceAttributes: map[string]interface{}{"one": "two"},
+						Annotation:         "io.opentelemetry/examplereceiver",
 						rule:               newRuleOrPanic(`type == "port"`),
 					},
 					"nop/1": {
@@ -105,11 +106,13 @@ func TestLoadConfig(t *testing.T) {
 					component.NewIDWithName("mock_observer", "with_name"),
 				},
 				ResourceAttributes: map[observer.EndpointType]map[string]string{
-					observer.ContainerType: {"container.key": "container.value"},
-					observer.PodType:       {"pod.key": "pod.value"},
-					observer.PortType:      {"port.key": "port.value"},
-					observer.HostPortType:  {"hostport.key": "hostport.value"},
-					observer.K8sNodeType:   {"k8s.node.key": "k8s.node.value"},
+					observer.ContainerType:  {"container.key": "container.value"},
+					observer.PodType:        {"pod.key": "pod.value"},
+					observer.PortType:       {"port.key": "port.value"},
+					observer.HostPortType:   {"hostport.key": "hostport.value"},
+					observer.K8sNodeType:    {"k8s.node.key": "k8s.node.value"},
+					observer.K8sServiceType: {"k8s.namespace.name": "`namespace`"},
+					observer.K8sIngressType: {"k8s.namespace.name": "`namespace`"},
 				},
 			},
 		},
@@ -103,6 +103,35 @@ var k8sNodeEndpoint = observer.Endpoint{
 	},
 }
 
+var k8sServiceEndpoint = observer.Endpoint{
+	ID:     "k8s.service-1",
+	Target: "10.0.0.1:6379",
+	Details: &observer.K8sService{
+		Name:        "redis",
+		UID:         "service-uid",
+		Namespace:   "default",
+		Labels:      map[string]string{"app": "redis"},
+		ServiceType: "ClusterIP",
+		ClusterIP:   "10.0.0.1",
+		PortName:    "redis",
+		Port:        6379,
+		Transport:   observer.ProtocolTCP,
+	},
+}
+
+var k8sIngressEndpoint = observer.Endpoint{
+	ID:     "k8s.ingress-1",
+	Target: "https://app.example.com/",
+	Details: &observer.K8sIngress{
+		Name:      "app",
+		UID:       "ingress-uid",
+		Namespace: "default",
+		Scheme:    "https",
+		Host:      "app.example.com",
+		Path:      "/",
+	},
+}
+
 var unsupportedEndpoint = observer.Endpoint{
 	ID:      "endpoint-1",
 	Target:  "localhost:1234",
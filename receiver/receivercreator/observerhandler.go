@@ -95,7 +95,13 @@ func (obs *observerHandler) OnAdd(added []observer.Endpoint) {
 				zap.String("endpoint", e.Target),
 				zap.String("endpoint_id", string(e.ID)))
 
-			resolvedConfig, err := expandMap(template.config, env)
+			templateConfig, templateResourceAttributes, err := template.applyAnnotation(env)
+			if err != nil {
+				obs.logger.Error("unable to apply template annotation", zap.String("receiver", template.id.String()), zap.Error(err))
+				continue
+			}
+
+			resolvedConfig, err := expandMap(templateConfig, env)
 			if err != nil {
 				obs.logger.Error("unable to resolve template config", zap.String("receiver", template.id.String()), zap.Error(err))
 				continue
@@ -116,7 +122,7 @@ func (obs *observerHandler) OnAdd(added []observer.Endpoint) {
 			}
 
 			resAttrs := map[string]string{}
-			for k, v := range template.ResourceAttributes {
+			for k, v := range templateResourceAttributes {
 				strVal, ok := v.(string)
 				if !ok {
 					obs.logger.Info(fmt.Sprintf("ignoring unsupported `resource_attributes` %q value %v", k, v))
@@ -19,6 +19,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
 	"go.uber.org/zap"
@@ -52,7 +53,7 @@ func TestOnAdd(t *testing.T) {
 	rcvrCfg := receiverConfig{id: component.NewIDWithName("name", "1"), config: userConfigMap{"foo": "bar"}, endpointID: portEndpoint.ID}
 	cfg := createDefaultConfig().(*Config)
 	cfg.receiverTemplates = map[string]receiverTemplate{
-		"name/1": {rcvrCfg, "", map[string]interface{}{}, newRuleOrPanic(`type == "port"`)},
+		"name/1": {rcvrCfg, "", map[string]interface{}{}, "", newRuleOrPanic(`type == "port"`)},
 	}
 	handler := &observerHandler{
 		config:                cfg,
@@ -104,7 +105,7 @@ func TestOnChange(t *testing.T) {
 	newRcvr := &nopWithEndpointReceiver{}
 	cfg := createDefaultConfig().(*Config)
 	cfg.receiverTemplates = map[string]receiverTemplate{
-		"name/1": {rcvrCfg, "", map[string]interface{}{}, newRuleOrPanic(`type == "port"`)},
+		"name/1": {rcvrCfg, "", map[string]interface{}{}, "", newRuleOrPanic(`type == "port"`)},
 	}
 	handler := &observerHandler{
 		config:                cfg,
@@ -162,3 +163,98 @@ func TestDynamicConfig(t *testing.T) {
 
 	runner.AssertExpectations(t)
 }
+
+func TestAnnotationConfig(t *testing.T) {
+	annotatedPod := pod
+	annotatedPod.Annotations = map[string]string{
+		"io.opentelemetry/name": "config:\n  metrics_path: /custom\n  collection_interval: 10s\n" +
+			"  tls:\n    insecure: true\nresource_attributes:\n  team: '`pod.labels[\"app\"]`'\n",
+	}
+	annotatedPortEndpoint := observer.Endpoint{
+		ID:     "port-1",
+		Target: "localhost:1234",
+		Details: &observer.Port{
+			Name:      "http",
+			Pod:       annotatedPod,
+			Port:      1234,
+			Transport: observer.ProtocolTCP,
+		},
+	}
+
+	runner := &mockRunner{}
+	cfg := createDefaultConfig().(*Config)
+	cfg.receiverTemplates = map[string]receiverTemplate{
+		"name/1": {
+			receiverConfig: receiverConfig{
+				id: component.NewIDWithName("name", "1"),
+				config: userConfigMap{
+					"metrics_path": "/metrics",
+					"tls":          map[string]interface{}{"ca_file": "/etc/ca.pem"},
+				},
+				endpointID: annotatedPortEndpoint.ID,
+			},
+			Rule:               `type == "port"`,
+			ResourceAttributes: map[string]interface{}{"team": "default", "one": "two"},
+			Annotation:         "io.opentelemetry/name",
+			rule:               newRuleOrPanic(`type == "port"`),
+		},
+	}
+	handler := &observerHandler{
+		config:                cfg,
+		logger:                zap.NewNop(),
+		receiversByEndpointID: receiverMap{},
+		runner:                runner,
+	}
+
+	var enhancer *resourceEnhancer
+	runner.On(
+		"start",
+		receiverConfig{
+			id: component.NewIDWithName("name", "1"),
+			config: userConfigMap{
+				"metrics_path":        "/custom",
+				"collection_interval": "10s",
+				"tls":                 map[string]interface{}{"ca_file": "/etc/ca.pem", "insecure": true},
+			},
+			endpointID: annotatedPortEndpoint.ID,
+		},
+		userConfigMap{endpointConfigKey: "localhost:1234"},
+		mock.IsType(&resourceEnhancer{}),
+	).Run(func(args mock.Arguments) {
+		enhancer = args.Get(2).(*resourceEnhancer)
+	}).Return(&nopWithEndpointReceiver{}, nil)
+
+	handler.OnAdd([]observer.Endpoint{annotatedPortEndpoint})
+
+	runner.AssertExpectations(t)
+	require.NotNil(t, enhancer)
+	assert.Equal(t, "redis", enhancer.attrs["team"])
+	assert.Equal(t, "two", enhancer.attrs["one"])
+}
+
+func TestInvalidAnnotationConfig(t *testing.T) {
+	annotatedPod := pod
+	annotatedPod.Annotations = map[string]string{"io.opentelemetry/name": "config: [unterminated"}
+
+	runner := &mockRunner{}
+	cfg := createDefaultConfig().(*Config)
+	cfg.receiverTemplates = map[string]receiverTemplate{
+		"name/1": {
+			receiverConfig: receiverConfig{id: component.NewIDWithName("name", "1"), config: userConfigMap{}, endpointID: podEndpoint.ID},
+			Rule:           `type == "pod"`,
+			Annotation:     "io.opentelemetry/name",
+			rule:           newRuleOrPanic(`type == "pod"`),
+		},
+	}
+	handler := &observerHandler{
+		config:                cfg,
+		logger:                zap.NewNop(),
+		receiversByEndpointID: receiverMap{},
+		runner:                runner,
+	}
+
+	handler.OnAdd([]observer.Endpoint{{ID: "pod-1", Target: "localhost", Details: &annotatedPod}})
+
+	runner.AssertNotCalled(t, "start", mock.Anything, mock.Anything, mock.Anything)
+	assert.Equal(t, 0, handler.receiversByEndpointID.Size())
+}
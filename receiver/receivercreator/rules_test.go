@@ -50,6 +50,8 @@ func Test_ruleEval(t *testing.T) {
 		{"annotations", args{`type == "pod" && annotations["scrape"] == "true"`, podEndpoint}, true, false},
 		{"basic container", args{`type == "container" && labels["region"] == "east-1"`, containerEndpoint}, true, false},
 		{"basic k8s.node", args{`type == "k8s.node" && kubelet_endpoint_port == 10250`, k8sNodeEndpoint}, true, false},
+		{"basic k8s.service", args{`type == "k8s.service" && port == 6379 && labels["app"] == "redis"`, k8sServiceEndpoint}, true, false},
+		{"basic k8s.ingress", args{`type == "k8s.ingress" && scheme == "https" && host == "app.example.com"`, k8sIngressEndpoint}, true, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -88,6 +90,8 @@ func Test_newRule(t *testing.T) {
 		{"valid pod", args{`type=="pod" && port_name == "http"`}, false},
 		{"valid hostport", args{`type == "hostport" && port_name == "http"`}, false},
 		{"valid container", args{`type == "container" && port == 8080`}, false},
+		{"valid k8s.service", args{`type == "k8s.service" && port == 8080`}, false},
+		{"valid k8s.ingress", args{`type == "k8s.ingress" && path == "/"`}, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
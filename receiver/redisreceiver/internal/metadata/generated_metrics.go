@@ -42,6 +42,7 @@ type MetricsSettings struct {
 	RedisClientsMaxInputBuffer             MetricSettings `mapstructure:"redis.clients.max_input_buffer"`
 	RedisClientsMaxOutputBuffer            MetricSettings `mapstructure:"redis.clients.max_output_buffer"`
 	RedisCmdCalls                          MetricSettings `mapstructure:"redis.cmd.calls"`
+	RedisCmdLatency                        MetricSettings `mapstructure:"redis.cmd.latency"`
 	RedisCmdUsec                           MetricSettings `mapstructure:"redis.cmd.usec"`
 	RedisCommands                          MetricSettings `mapstructure:"redis.commands"`
 	RedisCommandsProcessed                 MetricSettings `mapstructure:"redis.commands.processed"`
@@ -89,6 +90,9 @@ func DefaultMetricsSettings() MetricsSettings {
 		RedisCmdCalls: MetricSettings{
 			Enabled: false,
 		},
+		RedisCmdLatency: MetricSettings{
+			Enabled: false,
+		},
 		RedisCmdUsec: MetricSettings{
 			Enabled: false,
 		},
@@ -455,6 +459,58 @@ func newMetricRedisCmdCalls(settings MetricSettings) metricRedisCmdCalls {
 	return m
 }
 
+type metricRedisCmdLatency struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.cmd.latency metric with initial data.
+func (m *metricRedisCmdLatency) init() {
+	m.data.SetName("redis.cmd.latency")
+	m.data.SetDescription("Command execution latency percentiles, available from Redis 7.0")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisCmdLatency) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, cmdAttributeValue string, percentileAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("cmd", cmdAttributeValue)
+	dp.Attributes().PutStr("percentile", percentileAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisCmdLatency) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisCmdLatency) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisCmdLatency(settings MetricSettings) metricRedisCmdLatency {
+	m := metricRedisCmdLatency{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricRedisCmdUsec struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -1882,6 +1938,7 @@ type MetricsBuilder struct {
 	metricRedisClientsMaxInputBuffer             metricRedisClientsMaxInputBuffer
 	metricRedisClientsMaxOutputBuffer            metricRedisClientsMaxOutputBuffer
 	metricRedisCmdCalls                          metricRedisCmdCalls
+	metricRedisCmdLatency                        metricRedisCmdLatency
 	metricRedisCmdUsec                           metricRedisCmdUsec
 	metricRedisCommands                          metricRedisCommands
 	metricRedisCommandsProcessed                 metricRedisCommandsProcessed
@@ -1932,6 +1989,7 @@ func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo,
 		metricRedisClientsMaxInputBuffer:             newMetricRedisClientsMaxInputBuffer(settings.RedisClientsMaxInputBuffer),
 		metricRedisClientsMaxOutputBuffer:            newMetricRedisClientsMaxOutputBuffer(settings.RedisClientsMaxOutputBuffer),
 		metricRedisCmdCalls:                          newMetricRedisCmdCalls(settings.RedisCmdCalls),
+		metricRedisCmdLatency:                        newMetricRedisCmdLatency(settings.RedisCmdLatency),
 		metricRedisCmdUsec:                           newMetricRedisCmdUsec(settings.RedisCmdUsec),
 		metricRedisCommands:                          newMetricRedisCommands(settings.RedisCommands),
 		metricRedisCommandsProcessed:                 newMetricRedisCommandsProcessed(settings.RedisCommandsProcessed),
@@ -2024,6 +2082,7 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricRedisClientsMaxInputBuffer.emit(ils.Metrics())
 	mb.metricRedisClientsMaxOutputBuffer.emit(ils.Metrics())
 	mb.metricRedisCmdCalls.emit(ils.Metrics())
+	mb.metricRedisCmdLatency.emit(ils.Metrics())
 	mb.metricRedisCmdUsec.emit(ils.Metrics())
 	mb.metricRedisCommands.emit(ils.Metrics())
 	mb.metricRedisCommandsProcessed.emit(ils.Metrics())
@@ -2096,6 +2155,11 @@ func (mb *MetricsBuilder) RecordRedisCmdCallsDataPoint(ts pcommon.Timestamp, val
 	mb.metricRedisCmdCalls.recordDataPoint(mb.startTime, ts, val, cmdAttributeValue)
 }
 
+// RecordRedisCmdLatencyDataPoint adds a data point to redis.cmd.latency metric.
+func (mb *MetricsBuilder) RecordRedisCmdLatencyDataPoint(ts pcommon.Timestamp, val float64, cmdAttributeValue string, percentileAttributeValue string) {
+	mb.metricRedisCmdLatency.recordDataPoint(mb.startTime, ts, val, cmdAttributeValue, percentileAttributeValue)
+}
+
 // RecordRedisCmdUsecDataPoint adds a data point to redis.cmd.usec metric.
 func (mb *MetricsBuilder) RecordRedisCmdUsecDataPoint(ts pcommon.Timestamp, val int64, cmdAttributeValue string) {
 	mb.metricRedisCmdUsec.recordDataPoint(mb.startTime, ts, val, cmdAttributeValue)
@@ -16,6 +16,7 @@ package redisreceiver // import "github.com/open-telemetry/opentelemetry-collect
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"strings"
 	"time"
@@ -39,8 +40,6 @@ type redisScraper struct {
 	uptime   time.Duration
 }
 
-const redisMaxDbs = 16 // Maximum possible number of redis databases
-
 func newRedisScraper(cfg *Config, settings component.ReceiverCreateSettings) (scraperhelper.Scraper, error) {
 	opts := &redis.Options{
 		Addr:     cfg.Endpoint,
@@ -90,6 +89,7 @@ func (rs *redisScraper) Scrape(context.Context) (pmetric.Metrics, error) {
 	rs.recordKeyspaceMetrics(now, inf)
 	rs.recordRoleMetrics(now, inf)
 	rs.recordCmdStatsMetrics(now, inf)
+	rs.recordLatencyStatsMetrics(now, inf)
 	return rs.mb.Emit(metadata.WithRedisVersion(rs.getRedisVersion(inf))), nil
 }
 
@@ -122,13 +122,16 @@ func (rs *redisScraper) recordCommonMetrics(ts pcommon.Timestamp, inf info) {
 }
 
 // recordKeyspaceMetrics records metrics from 'keyspace' Redis info key-value pairs,
-// e.g. "db0: keys=1,expires=2,avg_ttl=3".
+// e.g. "db0: keys=1,expires=2,avg_ttl=3". Redis only reports the databases holding
+// keys, and the number of databases is configurable.
 func (rs *redisScraper) recordKeyspaceMetrics(ts pcommon.Timestamp, inf info) {
-	for db := 0; db < redisMaxDbs; db++ {
-		key := "db" + strconv.Itoa(db)
-		str, ok := inf[key]
-		if !ok {
-			break
+	for key, str := range inf {
+		if !strings.HasPrefix(key, "db") {
+			continue
+		}
+		db, err := strconv.Atoi(key[len("db"):])
+		if err != nil {
+			continue
 		}
 		keyspace, parsingError := parseKeyspaceString(db, str)
 		if parsingError != nil {
@@ -192,3 +195,43 @@ func (rs *redisScraper) recordCmdStatsMetrics(ts pcommon.Timestamp, inf info) {
 		}
 	}
 }
+
+// recordLatencyStatsMetrics records metrics from 'latencystats' Redis info key-value pairs,
+// reported by Redis 7.0 and later, e.g. "latency_percentiles_usec_get:p50=1.003,p99=3.007,p99.9=5.023".
+// The percentiles can be configured with the latency-tracking-info-percentiles directive.
+func (rs *redisScraper) recordLatencyStatsMetrics(ts pcommon.Timestamp, inf info) {
+	const latencyPrefix = "latency_percentiles_usec_"
+	for key, val := range inf {
+		if !strings.HasPrefix(key, latencyPrefix) {
+			continue
+		}
+
+		cmd := key[len(latencyPrefix):]
+		percentiles, err := parseLatencyStats(val)
+		if err != nil {
+			rs.settings.Logger.Warn("failed to parse latencystats", zap.String("key", key),
+				zap.String("val", val), zap.Error(err))
+			continue
+		}
+		for percentile, usec := range percentiles {
+			rs.mb.RecordRedisCmdLatencyDataPoint(ts, usec/1e6, cmd, percentile)
+		}
+	}
+}
+
+// parseLatencyStats parses the percentiles of a latencystats value, in microseconds.
+func parseLatencyStats(str string) (map[string]float64, error) {
+	percentiles := map[string]float64{}
+	for _, pairStr := range strings.Split(strings.TrimSpace(str), ",") {
+		pair := strings.Split(pairStr, "=")
+		if len(pair) != 2 {
+			return nil, fmt.Errorf("unexpected latencystats pair '%s'", pairStr)
+		}
+		usec, err := strconv.ParseFloat(pair[1], 64)
+		if err != nil {
+			return nil, err
+		}
+		percentiles[pair[0]] = usec
+	}
+	return percentiles, nil
+}
@@ -17,11 +17,13 @@ package redisreceiver
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver/internal/metadata"
@@ -58,3 +60,70 @@ func TestNewReceiver_invalid_auth_error(t *testing.T) {
 	assert.Contains(t, err.Error(), "failed to load TLS config")
 	assert.Nil(t, r)
 }
+
+func TestParseLatencyStats(t *testing.T) {
+	percentiles, err := parseLatencyStats("p50=1.003,p99=3.007,p99.9=5.023")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]float64{"p50": 1.003, "p99": 3.007, "p99.9": 5.023}, percentiles)
+
+	_, err = parseLatencyStats("p50")
+	assert.EqualError(t, err, "unexpected latencystats pair 'p50'")
+
+	_, err = parseLatencyStats("p50=abc")
+	assert.Error(t, err)
+}
+
+func TestRedisScraper_LatencyStats(t *testing.T) {
+	settings := componenttest.NewNopReceiverCreateSettings()
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics.RedisCmdLatency.Enabled = true
+	runner, err := newRedisScraperWithClient(newFakeClient(), settings, cfg)
+	require.NoError(t, err)
+	md, err := runner.Scrape(context.Background())
+	require.NoError(t, err)
+
+	latencies := map[string]float64{}
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		if metrics.At(i).Name() != "redis.cmd.latency" {
+			continue
+		}
+		dps := metrics.At(i).Gauge().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			cmd, _ := dps.At(j).Attributes().Get("cmd")
+			percentile, _ := dps.At(j).Attributes().Get("percentile")
+			latencies[cmd.Str()+" "+percentile.Str()] = dps.At(j).DoubleValue()
+		}
+	}
+	assert.Len(t, latencies, 6)
+	assert.InDelta(t, 1.003e-6, latencies["get p50"], 1e-12)
+	assert.InDelta(t, 27.007e-6, latencies["client|list p99.9"], 1e-12)
+}
+
+func TestRedisScraper_KeyspaceWithoutFirstDatabase(t *testing.T) {
+	settings := componenttest.NewNopReceiverCreateSettings()
+	cfg := createDefaultConfig().(*Config)
+	rs := &redisScraper{
+		settings: settings.TelemetrySettings,
+		mb:       metadata.NewMetricsBuilder(cfg.Metrics, settings.BuildInfo),
+	}
+	rs.recordKeyspaceMetrics(pcommon.NewTimestampFromTime(time.Now()), info{
+		"db3":  "keys=7,expires=1,avg_ttl=10",
+		"db17": "keys=2,expires=0,avg_ttl=0",
+	})
+	md := rs.mb.Emit()
+
+	keys := map[string]int64{}
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		if metrics.At(i).Name() != "redis.db.keys" {
+			continue
+		}
+		dps := metrics.At(i).Gauge().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			db, _ := dps.At(j).Attributes().Get("db")
+			keys[db.Str()] = dps.At(j).IntValue()
+		}
+	}
+	assert.Equal(t, map[string]int64{"3": 7, "17": 2}, keys)
+}
@@ -15,9 +15,14 @@
 package simpleprometheusreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/simpleprometheusreceiver"
 
 import (
+	"errors"
+	"fmt"
 	"net/url"
+	"strings"
 	"time"
 
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/relabel"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/config/confighttp"
 )
@@ -38,6 +43,110 @@ type Config struct {
 	Labels map[string]string `mapstructure:"labels,omitempty"`
 	// Whether or not to use pod service account to authenticate.
 	UseServiceAccount bool `mapstructure:"use_service_account"`
+	// Targets are the endpoints to scrape, each with its own labels. When set, Endpoint
+	// isn't scraped.
+	Targets []TargetConfig `mapstructure:"targets"`
+	// RelabelConfigs are applied to the labels of the targets before scraping them.
+	RelabelConfigs []RelabelConfig `mapstructure:"relabel_configs"`
+	// MetricRelabelConfigs are applied to the labels of the scraped metrics.
+	MetricRelabelConfigs []RelabelConfig `mapstructure:"metric_relabel_configs"`
+}
+
+// TargetConfig is an endpoint to scrape.
+type TargetConfig struct {
+	// Endpoint from which prometheus metrics are scraped.
+	Endpoint string `mapstructure:"endpoint"`
+	// Labels of the target, which take priority over the receiver labels.
+	Labels map[string]string `mapstructure:"labels,omitempty"`
+}
+
+// RelabelConfig is a subset of the Prometheus relabel_config, supporting the replace,
+// keep, drop, labeldrop and labelkeep actions.
+type RelabelConfig struct {
+	// SourceLabels are the labels whose values are concatenated with Separator and
+	// matched against Regex.
+	SourceLabels []string `mapstructure:"source_labels"`
+	// Separator between the concatenated source label values. Default is ";"
+	Separator string `mapstructure:"separator"`
+	// Regex, anchored at both ends, matched against the concatenated source label
+	// values, or the label names for labeldrop and labelkeep. Default is "(.*)"
+	Regex string `mapstructure:"regex"`
+	// TargetLabel is the label written by the replace action.
+	TargetLabel string `mapstructure:"target_label"`
+	// Replacement is the value written by the replace action, which may reference
+	// the Regex capture groups. Default is "$1"
+	Replacement string `mapstructure:"replacement"`
+	// Action is one of replace, keep, drop, labeldrop and labelkeep. Default is replace
+	Action string `mapstructure:"action"`
+}
+
+// supportedRelabelActions are the supported subset of the Prometheus relabel actions.
+var supportedRelabelActions = map[relabel.Action]bool{
+	relabel.Replace:   true,
+	relabel.Keep:      true,
+	relabel.Drop:      true,
+	relabel.LabelDrop: true,
+	relabel.LabelKeep: true,
+}
+
+// toPrometheus converts the relabel config to a Prometheus relabel config.
+func (rc RelabelConfig) toPrometheus() (*relabel.Config, error) {
+	out := relabel.DefaultRelabelConfig
+	if rc.Action != "" {
+		out.Action = relabel.Action(strings.ToLower(rc.Action))
+	}
+	if !supportedRelabelActions[out.Action] {
+		return nil, fmt.Errorf("unsupported action %q", rc.Action)
+	}
+	for _, name := range rc.SourceLabels {
+		out.SourceLabels = append(out.SourceLabels, model.LabelName(name))
+	}
+	if rc.Separator != "" {
+		out.Separator = rc.Separator
+	}
+	if rc.Regex != "" {
+		regex, err := relabel.NewRegexp(rc.Regex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regex %q: %w", rc.Regex, err)
+		}
+		out.Regex = regex
+	}
+	if rc.Replacement != "" {
+		out.Replacement = rc.Replacement
+	}
+	out.TargetLabel = rc.TargetLabel
+
+	switch out.Action {
+	case relabel.Replace:
+		if out.TargetLabel == "" {
+			return nil, errors.New("target_label is required for the replace action")
+		}
+	case relabel.LabelDrop, relabel.LabelKeep:
+		if len(out.SourceLabels) > 0 || out.TargetLabel != "" || rc.Separator != "" || rc.Replacement != "" {
+			return nil, fmt.Errorf("%s action requires only regex", out.Action)
+		}
+	}
+	return &out, nil
+}
+
+// Validate checks the receiver configuration is valid.
+func (cfg *Config) Validate() error {
+	for i, target := range cfg.Targets {
+		if target.Endpoint == "" {
+			return fmt.Errorf("targets[%d]: endpoint must be specified", i)
+		}
+	}
+	for i, rc := range cfg.RelabelConfigs {
+		if _, err := rc.toPrometheus(); err != nil {
+			return fmt.Errorf("relabel_configs[%d]: %w", i, err)
+		}
+	}
+	for i, rc := range cfg.MetricRelabelConfigs {
+		if _, err := rc.toPrometheus(); err != nil {
+			return fmt.Errorf("metric_relabel_configs[%d]: %w", i, err)
+		}
+	}
+	return nil
 }
 
 // TODO: Move to a common package for use by other receivers and also pull
@@ -89,6 +89,44 @@ func TestLoadConfig(t *testing.T) {
 				MetricsPath:        "/metrics",
 			},
 		},
+		{
+			id: component.NewIDWithName(typeStr, "targets"),
+			expected: &Config{
+				ReceiverSettings: config.NewReceiverSettings(component.NewID(typeStr)),
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "localhost:9090",
+					TLSSetting: configtls.TLSClientSetting{
+						Insecure: true,
+					},
+				},
+				CollectionInterval: 30 * time.Second,
+				MetricsPath:        "/metrics",
+				Labels:             map[string]string{"env": "production"},
+				Targets: []TargetConfig{
+					{
+						Endpoint: "localhost:9100",
+						Labels:   map[string]string{"sidecar": "node"},
+					},
+					{
+						Endpoint: "localhost:9121",
+						Labels:   map[string]string{"sidecar": "redis", "env": "staging"},
+					},
+				},
+				RelabelConfigs: []RelabelConfig{
+					{
+						SourceLabels: []string{"sidecar"},
+						TargetLabel:  "component",
+					},
+				},
+				MetricRelabelConfigs: []RelabelConfig{
+					{
+						SourceLabels: []string{"__name__"},
+						Regex:        "go_.*",
+						Action:       "drop",
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -105,3 +143,69 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		configure func(*Config)
+		wantErr   string
+	}{
+		{
+			name: "target without endpoint",
+			configure: func(cfg *Config) {
+				cfg.Targets = []TargetConfig{{Endpoint: "localhost:9100"}, {}}
+			},
+			wantErr: "targets[1]: endpoint must be specified",
+		},
+		{
+			name: "unsupported action",
+			configure: func(cfg *Config) {
+				cfg.RelabelConfigs = []RelabelConfig{{Action: "hashmod"}}
+			},
+			wantErr: `relabel_configs[0]: unsupported action "hashmod"`,
+		},
+		{
+			name: "invalid regex",
+			configure: func(cfg *Config) {
+				cfg.MetricRelabelConfigs = []RelabelConfig{{Regex: "(", Action: "keep"}}
+			},
+			wantErr: `metric_relabel_configs[0]: invalid regex "("`,
+		},
+		{
+			name: "replace without target label",
+			configure: func(cfg *Config) {
+				cfg.RelabelConfigs = []RelabelConfig{{SourceLabels: []string{"sidecar"}}}
+			},
+			wantErr: "relabel_configs[0]: target_label is required for the replace action",
+		},
+		{
+			name: "labeldrop with source labels",
+			configure: func(cfg *Config) {
+				cfg.MetricRelabelConfigs = []RelabelConfig{{SourceLabels: []string{"sidecar"}, Regex: "tmp_.*", Action: "labeldrop"}}
+			},
+			wantErr: "metric_relabel_configs[0]: labeldrop action requires only regex",
+		},
+		{
+			name: "valid relabel configs",
+			configure: func(cfg *Config) {
+				cfg.RelabelConfigs = []RelabelConfig{
+					{SourceLabels: []string{"__address__"}, Regex: "(.*):.*", TargetLabel: "host", Action: "Replace"},
+					{SourceLabels: []string{"sidecar"}, Regex: "node|redis", Action: "keep"},
+				}
+				cfg.MetricRelabelConfigs = []RelabelConfig{{Regex: "tmp_.*", Action: "labeldrop"}}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tt.configure(cfg)
+			err := cfg.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
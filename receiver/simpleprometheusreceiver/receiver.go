@@ -112,16 +112,20 @@ func getPrometheusConfig(cfg *Config) (*prometheusreceiver.Config, error) {
 
 	httpConfig.BearerToken = configutil.Secret(bearerToken)
 
-	labels := make(model.LabelSet, len(cfg.Labels)+1)
-	for k, v := range cfg.Labels {
-		labels[model.LabelName(k)] = model.LabelValue(v)
+	jobName := fmt.Sprintf("%s/%s", typeStr, cfg.Endpoint)
+	targets := []model.LabelSet{targetLabels(cfg.Labels, nil, cfg.Endpoint)}
+	if len(cfg.Targets) > 0 {
+		jobName = cfg.ID().String()
+		targets = make([]model.LabelSet, 0, len(cfg.Targets))
+		for _, target := range cfg.Targets {
+			targets = append(targets, targetLabels(cfg.Labels, target.Labels, target.Endpoint))
+		}
 	}
-	labels[model.AddressLabel] = model.LabelValue(cfg.Endpoint)
 
 	scrapeConfig := &config.ScrapeConfig{
 		ScrapeInterval:  model.Duration(cfg.CollectionInterval),
 		ScrapeTimeout:   model.Duration(cfg.CollectionInterval),
-		JobName:         fmt.Sprintf("%s/%s", typeStr, cfg.Endpoint),
+		JobName:         jobName,
 		HonorTimestamps: true,
 		Scheme:          scheme,
 		MetricsPath:     cfg.MetricsPath,
@@ -129,14 +133,27 @@ func getPrometheusConfig(cfg *Config) (*prometheusreceiver.Config, error) {
 		ServiceDiscoveryConfigs: discovery.Configs{
 			&discovery.StaticConfig{
 				{
-					Targets: []model.LabelSet{
-						labels,
-					},
+					Targets: targets,
 				},
 			},
 		},
 	}
 
+	for _, rc := range cfg.RelabelConfigs {
+		relabelConfig, err := rc.toPrometheus()
+		if err != nil {
+			return nil, err
+		}
+		scrapeConfig.RelabelConfigs = append(scrapeConfig.RelabelConfigs, relabelConfig)
+	}
+	for _, rc := range cfg.MetricRelabelConfigs {
+		relabelConfig, err := rc.toPrometheus()
+		if err != nil {
+			return nil, err
+		}
+		scrapeConfig.MetricRelabelConfigs = append(scrapeConfig.MetricRelabelConfigs, relabelConfig)
+	}
+
 	scrapeConfig.HTTPClientConfig = httpConfig
 	out.PrometheusConfig = &config.Config{ScrapeConfigs: []*config.ScrapeConfig{
 		scrapeConfig,
@@ -145,6 +162,20 @@ func getPrometheusConfig(cfg *Config) (*prometheusreceiver.Config, error) {
 	return out, nil
 }
 
+// targetLabels returns the labels of a target, the target labels taking priority over
+// the receiver ones.
+func targetLabels(receiverLabels, labels map[string]string, endpoint string) model.LabelSet {
+	out := make(model.LabelSet, len(receiverLabels)+len(labels)+1)
+	for k, v := range receiverLabels {
+		out[model.LabelName(k)] = model.LabelValue(v)
+	}
+	for k, v := range labels {
+		out[model.LabelName(k)] = model.LabelValue(v)
+	}
+	out[model.AddressLabel] = model.LabelValue(endpoint)
+	return out
+}
+
 // Shutdown stops the underlying Prometheus receiver.
 func (prw *prometheusReceiverWrapper) Shutdown(ctx context.Context) error {
 	return prw.prometheusRecever.Shutdown(ctx)
@@ -25,6 +25,8 @@ import (
 	"github.com/prometheus/common/model"
 	"github.com/prometheus/prometheus/config"
 	"github.com/prometheus/prometheus/discovery"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/model/relabel"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/confighttp"
@@ -422,3 +424,55 @@ func TestGetPrometheusConfigWrapper(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPrometheusConfigTargets(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.SetIDName("sidecars")
+	cfg.Labels = map[string]string{"env": "production"}
+	cfg.Targets = []TargetConfig{
+		{Endpoint: "localhost:9100", Labels: map[string]string{"sidecar": "node"}},
+		{Endpoint: "localhost:9121", Labels: map[string]string{"sidecar": "redis", "env": "staging"}},
+	}
+	cfg.RelabelConfigs = []RelabelConfig{
+		{SourceLabels: []string{"sidecar"}, TargetLabel: "component"},
+	}
+	cfg.MetricRelabelConfigs = []RelabelConfig{
+		{SourceLabels: []string{"__name__"}, Regex: "go_.*", Action: "drop"},
+	}
+
+	got, err := getPrometheusConfig(cfg)
+	require.NoError(t, err)
+	require.Len(t, got.PrometheusConfig.ScrapeConfigs, 1)
+
+	scrapeConfig := got.PrometheusConfig.ScrapeConfigs[0]
+	require.Equal(t, "prometheus_simple/sidecars", scrapeConfig.JobName)
+	require.Equal(t, discovery.Configs{
+		&discovery.StaticConfig{
+			{
+				Targets: []model.LabelSet{
+					{model.AddressLabel: "localhost:9100", "env": "production", "sidecar": "node"},
+					{model.AddressLabel: "localhost:9121", "env": "staging", "sidecar": "redis"},
+				},
+			},
+		},
+	}, scrapeConfig.ServiceDiscoveryConfigs)
+
+	require.Len(t, scrapeConfig.RelabelConfigs, 1)
+	relabelConfig := scrapeConfig.RelabelConfigs[0]
+	require.Equal(t, relabel.Replace, relabelConfig.Action)
+	require.Equal(t, model.LabelNames{"sidecar"}, relabelConfig.SourceLabels)
+	require.Equal(t, "component", relabelConfig.TargetLabel)
+	require.Equal(t, ";", relabelConfig.Separator)
+	require.Equal(t, "$1", relabelConfig.Replacement)
+
+	require.Len(t, scrapeConfig.MetricRelabelConfigs, 1)
+	metricRelabelConfig := scrapeConfig.MetricRelabelConfigs[0]
+	require.Equal(t, relabel.Drop, metricRelabelConfig.Action)
+	require.True(t, metricRelabelConfig.Regex.MatchString("go_goroutines"))
+	require.False(t, metricRelabelConfig.Regex.MatchString("http_go_requests"))
+
+	// the relabeled targets and metrics are those the prometheus receiver processes
+	lbls := relabel.Process(labels.FromStrings("sidecar", "node"), scrapeConfig.RelabelConfigs...)
+	require.Equal(t, "node", lbls.Get("component"))
+	require.Nil(t, relabel.Process(labels.FromStrings(model.MetricNameLabel, "go_goroutines"), scrapeConfig.MetricRelabelConfigs...))
+}
@@ -107,6 +107,11 @@ func newClient(cfg *Config, logger *zap.Logger) (client, error) {
 	// Set goSNMP target based on config
 	goSNMP.SetTarget(snmpURL.Hostname())
 
+	// Set goSNMP GETBULK max repetitions based on config, gosnmp has its own default otherwise
+	if cfg.MaxRepetitions > 0 {
+		goSNMP.SetMaxRepetitions(cfg.MaxRepetitions)
+	}
+
 	if goSNMP.GetVersion() == gosnmp.Version3 {
 		// Set goSNMP v3 configs
 		setV3ClientConfigs(goSNMP, cfg)
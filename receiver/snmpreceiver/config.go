@@ -58,6 +58,10 @@ var (
 	errMsgColumnAttributeBadValue          = `metric '%s' column_oid attribute '%s' value '%s' must match one of the possible enum values for the attribute config`
 	errMsgColumnResourceAttributeBadName   = `metric '%s' column_oid resource_attribute '%s' must match a resource_attribute config`
 	errMsgColumnIndexedAttributeRequired   = `metric '%s' column_oid must either have a resource_attribute or an indexed_value_prefix/oid attribute`
+	errMsgScalarOIDBadValueType            = `metric '%s' scalar_oid '%s' value_type must be either int or double`
+	errMsgColumnOIDBadValueType            = `metric '%s' column_oid '%s' value_type must be either int or double`
+	errMsgTargetBadAuthProfile             = `target '%s' auth_profile '%s' must match an auth_profiles config`
+	errMsgTarget                           = `target '%s': %w`
 
 	// Config errors
 	errEmptyEndpoint        = errors.New("endpoint must be specified")
@@ -126,6 +130,19 @@ type Config struct {
 	// Only valid for version “v3” and if "auth_priv" is selected for SecurityLevel
 	PrivacyPassword string `mapstructure:"privacy_password"`
 
+	// MaxRepetitions is the max-repetitions of the GETBULK requests used to walk column OIDs.
+	// Only valid for versions "v2c" and "v3". Some devices require a lower value.
+	// Default: 50
+	MaxRepetitions uint32 `mapstructure:"max_repetitions"`
+
+	// AuthProfiles defines named SNMP v3 user and security settings which can be shared by the Targets
+	AuthProfiles map[string]*AuthProfileConfig `mapstructure:"auth_profiles"`
+
+	// Targets defines the SNMP targets to request data from with the same attribute and metric configs.
+	// If defined, they are scraped instead of Endpoint and the resources of their metrics get a
+	// "snmp.target" resource attribute set to the target endpoint.
+	Targets []TargetConfig `mapstructure:"targets"`
+
 	// ResourceAttributes defines what resource attributes will be used for this receiver and is composed
 	// of resource attribute names along with their resource attribute configurations
 	ResourceAttributes map[string]*ResourceAttributeConfig `mapstructure:"resource_attributes"`
@@ -139,6 +156,32 @@ type Config struct {
 	Metrics map[string]*MetricConfig `mapstructure:"metrics"`
 }
 
+// TargetConfig contains the connection info of a SNMP target. The connection settings which are not
+// defined default to the ones of the receiver config.
+type TargetConfig struct {
+	// Endpoint is required and is the SNMP target to request data from, in the same format as the
+	// receiver config endpoint
+	Endpoint string `mapstructure:"endpoint"`
+	// Version is optional and is the version of SNMP to use for this target
+	Version string `mapstructure:"version"`
+	// Community is optional and is the SNMP community string to use for this target
+	Community string `mapstructure:"community"`
+	// AuthProfile is optional and is the name of the AuthProfileConfig to use for this target.
+	// Only valid for version "v3"
+	AuthProfile string `mapstructure:"auth_profile"`
+}
+
+// AuthProfileConfig contains SNMP v3 user and security settings. The fields have the same meaning
+// as the ones of the receiver config.
+type AuthProfileConfig struct {
+	User            string `mapstructure:"user"`
+	SecurityLevel   string `mapstructure:"security_level"`
+	AuthType        string `mapstructure:"auth_type"`
+	AuthPassword    string `mapstructure:"auth_password"`
+	PrivacyType     string `mapstructure:"privacy_type"`
+	PrivacyPassword string `mapstructure:"privacy_password"`
+}
+
 // ResourceAttributeConfig contains config info about all of the resource attributes that will be used by this receiver.
 type ResourceAttributeConfig struct {
 	// Description is optional and describes what the resource attribute represents
@@ -214,6 +257,10 @@ type ScalarOID struct {
 	// Attributes is optional and may contain names and values associated with enum
 	// AttributeConfigs to associate with the value of the scalar OID
 	Attributes []Attribute `mapstructure:"attributes"`
+	// ValueType is optional and overrides how the value of the OID is read, see ColumnOID
+	ValueType string `mapstructure:"value_type"`
+	// Scale is optional and multiplies the value of the OID, see ColumnOID
+	Scale float64 `mapstructure:"scale"`
 }
 
 // ColumnOID holds OID info for an indexed metric as well as any attributes
@@ -229,6 +276,13 @@ type ColumnOID struct {
 	// Valid values are non enum AttributeConfig names that will be used to differentiate the
 	// indexed values for the column OID
 	Attributes []Attribute `mapstructure:"attributes"`
+	// ValueType is optional and can be either int or double. If set, string values of the OID,
+	// such as the DisplayString load averages of the UCD-SNMP-MIB, are parsed as numbers of this type
+	// and numeric values are converted to it
+	ValueType string `mapstructure:"value_type"`
+	// Scale is optional and multiplies the values of the OID, to convert them to the unit of the
+	// metric. For example, 0.01 converts TimeTicks to seconds
+	Scale float64 `mapstructure:"scale"`
 }
 
 // Attribute is a connection between a metric configuration and an AttributeConfig
@@ -249,11 +303,57 @@ func (cfg *Config) Validate() error {
 	if strings.ToUpper(cfg.Version) == "V3" {
 		combinedErr = multierr.Append(combinedErr, validateSecurity(cfg))
 	}
+	combinedErr = multierr.Append(combinedErr, validateTargets(cfg))
 	combinedErr = multierr.Append(combinedErr, validateMetricConfigs(cfg))
 
 	return combinedErr
 }
 
+// targetConfig returns a copy of the config with the connection settings of the target
+func (cfg *Config) targetConfig(target TargetConfig) *Config {
+	targetCfg := *cfg
+	targetCfg.Endpoint = target.Endpoint
+	if target.Version != "" {
+		targetCfg.Version = target.Version
+	}
+	if target.Community != "" {
+		targetCfg.Community = target.Community
+	}
+	if profile, ok := cfg.AuthProfiles[target.AuthProfile]; ok && profile != nil {
+		targetCfg.User = profile.User
+		targetCfg.SecurityLevel = profile.SecurityLevel
+		targetCfg.AuthType = profile.AuthType
+		targetCfg.AuthPassword = profile.AuthPassword
+		targetCfg.PrivacyType = profile.PrivacyType
+		targetCfg.PrivacyPassword = profile.PrivacyPassword
+	}
+
+	return &targetCfg
+}
+
+// validateTargets validates the connection settings of each of the Targets
+func validateTargets(cfg *Config) error {
+	var combinedErr error
+
+	for _, target := range cfg.Targets {
+		if target.AuthProfile != "" && cfg.AuthProfiles[target.AuthProfile] == nil {
+			combinedErr = multierr.Append(combinedErr, fmt.Errorf(errMsgTargetBadAuthProfile, target.Endpoint, target.AuthProfile))
+			continue
+		}
+
+		targetCfg := cfg.targetConfig(target)
+		targetErr := multierr.Append(validateEndpoint(targetCfg), validateVersion(targetCfg))
+		if strings.ToUpper(targetCfg.Version) == "V3" {
+			targetErr = multierr.Append(targetErr, validateSecurity(targetCfg))
+		}
+		if targetErr != nil {
+			combinedErr = multierr.Append(combinedErr, fmt.Errorf(errMsgTarget, target.Endpoint, targetErr))
+		}
+	}
+
+	return combinedErr
+}
+
 // validateEndpoint validates the Endpoint
 func validateEndpoint(cfg *Config) error {
 	if cfg.Endpoint == "" {
@@ -427,6 +527,10 @@ func validateColumnOID(metricName string, columnOID ColumnOID, cfg *Config) erro
 		combinedErr = multierr.Append(combinedErr, fmt.Errorf(errMsgColumnOIDNoOID, metricName))
 	}
 
+	if !validOIDValueType(columnOID.ValueType) {
+		combinedErr = multierr.Append(combinedErr, fmt.Errorf(errMsgColumnOIDBadValueType, metricName, columnOID.OID))
+	}
+
 	// Keep track of whether the different indexed values can be differentiated by either attribute within the same metric
 	// or by different resource attributes (in different resources)
 	hasIndexedIdentifier := false
@@ -484,6 +588,10 @@ func validateScalarOID(metricName string, scalarOID ScalarOID, cfg *Config) erro
 		combinedErr = multierr.Append(combinedErr, fmt.Errorf(errMsgScalarOIDNoOID, metricName))
 	}
 
+	if !validOIDValueType(scalarOID.ValueType) {
+		combinedErr = multierr.Append(combinedErr, fmt.Errorf(errMsgScalarOIDBadValueType, metricName, scalarOID.OID))
+	}
+
 	if len(scalarOID.Attributes) == 0 {
 		return combinedErr
 	}
@@ -514,6 +622,16 @@ func validateScalarOID(metricName string, scalarOID ScalarOID, cfg *Config) erro
 	return combinedErr
 }
 
+// validOIDValueType checks if the optional value type override of an OID is valid
+func validOIDValueType(valueType string) bool {
+	switch strings.ToUpper(valueType) {
+	case "", "INT", "DOUBLE":
+		return true
+	default:
+		return false
+	}
+}
+
 // validateGauge validates a GaugeMetric
 func validateGauge(metricName string, gauge *GaugeMetric) error {
 	// Ensure valid values for ValueType
@@ -28,6 +28,13 @@ type configHelper struct {
 	metricNamesByOID            map[string]string
 	metricAttributesByOID       map[string][]Attribute
 	resourceAttributesByOID     map[string][]string
+	valueOverridesByOID         map[string]valueOverride
+}
+
+// valueOverride holds the optional value type and scale overrides of a metric scalar or column OID
+type valueOverride struct {
+	valueType string
+	scale     float64
 }
 
 // newConfigHelper returns a new configHelper with various pieces of static info saved for easy access
@@ -41,6 +48,7 @@ func newConfigHelper(cfg *Config) *configHelper {
 		metricNamesByOID:            map[string]string{},
 		metricAttributesByOID:       map[string][]Attribute{},
 		resourceAttributesByOID:     map[string][]string{},
+		valueOverridesByOID:         map[string]valueOverride{},
 	}
 
 	// Group all metric scalar OIDs and metric column OIDs
@@ -57,6 +65,7 @@ func newConfigHelper(cfg *Config) *configHelper {
 			ch.metricScalarOIDs = append(ch.metricScalarOIDs, oid.OID)
 			ch.metricNamesByOID[oid.OID] = name
 			ch.metricAttributesByOID[oid.OID] = oid.Attributes
+			ch.valueOverridesByOID[oid.OID] = valueOverride{valueType: oid.ValueType, scale: oid.Scale}
 		}
 
 		for i, oid := range metricCfg.ColumnOIDs {
@@ -70,6 +79,7 @@ func newConfigHelper(cfg *Config) *configHelper {
 			ch.metricNamesByOID[oid.OID] = name
 			ch.metricAttributesByOID[oid.OID] = oid.Attributes
 			ch.resourceAttributesByOID[oid.OID] = oid.ResourceAttributes
+			ch.valueOverridesByOID[oid.OID] = valueOverride{valueType: oid.ValueType, scale: oid.Scale}
 		}
 	}
 
@@ -195,3 +205,8 @@ func (h configHelper) getMetricConfigAttributes(oid string) []Attribute {
 func (h configHelper) getResourceAttributeNames(oid string) []string {
 	return h.resourceAttributesByOID[oid]
 }
+
+// getValueOverride returns the value type and scale overrides of the metric config for a given OID
+func (h configHelper) getValueOverride(oid string) valueOverride {
+	return h.valueOverridesByOID[oid]
+}
@@ -139,6 +139,58 @@ func TestLoadConfigConnectionConfigs(t *testing.T) {
 	expectedConfigV3NoPrivacyPassword.AuthPassword = "p"
 	expectedConfigV3NoPrivacyPassword.Metrics = metrics
 
+	expectedConfigTargetsGood := factory.CreateDefaultConfig().(*Config)
+	expectedConfigTargetsGood.MaxRepetitions = 10
+	expectedConfigTargetsGood.AuthProfiles = map[string]*AuthProfileConfig{
+		"core": {
+			User:            "u",
+			SecurityLevel:   "auth_priv",
+			AuthType:        "SHA",
+			AuthPassword:    "p",
+			PrivacyType:     "AES",
+			PrivacyPassword: "pp",
+		},
+	}
+	expectedConfigTargetsGood.Targets = []TargetConfig{
+		{
+			Endpoint:    "udp://switch1:161",
+			Version:     "v3",
+			AuthProfile: "core",
+		},
+		{
+			Endpoint:  "udp://switch2:161",
+			Community: "private",
+		},
+	}
+	expectedConfigTargetsGood.Metrics = metrics
+
+	expectedConfigTargetBadAuthProfile := factory.CreateDefaultConfig().(*Config)
+	expectedConfigTargetBadAuthProfile.Targets = []TargetConfig{
+		{
+			Endpoint:    "udp://switch1:161",
+			Version:     "v3",
+			AuthProfile: "edge",
+		},
+	}
+	expectedConfigTargetBadAuthProfile.Metrics = metrics
+
+	expectedConfigTargetAuthProfileNoAuthPassword := factory.CreateDefaultConfig().(*Config)
+	expectedConfigTargetAuthProfileNoAuthPassword.AuthProfiles = map[string]*AuthProfileConfig{
+		"core": {
+			User:          "u",
+			SecurityLevel: "auth_no_priv",
+			AuthType:      "SHA",
+		},
+	}
+	expectedConfigTargetAuthProfileNoAuthPassword.Targets = []TargetConfig{
+		{
+			Endpoint:    "udp://switch1:161",
+			Version:     "v3",
+			AuthProfile: "core",
+		},
+	}
+	expectedConfigTargetAuthProfileNoAuthPassword.Metrics = metrics
+
 	testCases := []testCase{
 		{
 			name:        "NoEndpointUsesDefault",
@@ -254,6 +306,24 @@ func TestLoadConfigConnectionConfigs(t *testing.T) {
 			expectedCfg: expectedConfigV3Simple,
 			expectedErr: "",
 		},
+		{
+			name:        "GoodTargetsNoErrors",
+			nameVal:     "targets_good",
+			expectedCfg: expectedConfigTargetsGood,
+			expectedErr: "",
+		},
+		{
+			name:        "TargetBadAuthProfileErrors",
+			nameVal:     "target_bad_auth_profile",
+			expectedCfg: expectedConfigTargetBadAuthProfile,
+			expectedErr: fmt.Sprintf(errMsgTargetBadAuthProfile, "udp://switch1:161", "edge"),
+		},
+		{
+			name:        "TargetAuthProfileNoAuthPasswordErrors",
+			nameVal:     "target_auth_profile_no_auth_password",
+			expectedCfg: expectedConfigTargetAuthProfileNoAuthPassword,
+			expectedErr: fmt.Errorf(errMsgTarget, "udp://switch1:161", errEmptyAuthPassword).Error(),
+		},
 	}
 
 	for _, test := range testCases {
@@ -400,6 +470,13 @@ func TestLoadConfigMetricConfigs(t *testing.T) {
 	expectedConfigBadMetricSumAggregation.Metrics = getBaseMetricConfig(false, true)
 	expectedConfigBadMetricSumAggregation.Metrics["m3"].Sum.Aggregation = "Counter"
 
+	expectedConfigBadColumnOIDValueType := factory.CreateDefaultConfig().(*Config)
+	expectedConfigBadColumnOIDValueType.Metrics = getBaseMetricConfig(true, false)
+	expectedConfigBadColumnOIDValueType.Metrics["m3"].ColumnOIDs[0].ResourceAttributes = []string{"ra1"}
+	expectedConfigBadColumnOIDValueType.Metrics["m3"].ColumnOIDs[0].ValueType = "string"
+	expectedConfigBadColumnOIDValueType.Metrics["m3"].ColumnOIDs[0].Scale = 0.01
+	expectedConfigBadColumnOIDValueType.ResourceAttributes = getBaseResourceAttrConfig("prefix")
+
 	expectedConfigNoScalarOIDOID := factory.CreateDefaultConfig().(*Config)
 	expectedConfigNoScalarOIDOID.Metrics = getBaseMetricConfig(true, true)
 	expectedConfigNoScalarOIDOID.Metrics["m3"].ScalarOIDs[0].OID = ""
@@ -832,6 +909,12 @@ func TestLoadConfigMetricConfigs(t *testing.T) {
 			expectedCfg: expectedConfigNoResourceAttributeOIDOrPrefix,
 			expectedErr: fmt.Sprintf(errMsgResourceAttributeNoOIDOrPrefix, "ra1"),
 		},
+		{
+			name:        "BadColumnOIDValueTypeErrors",
+			nameVal:     "bad_column_oid_value_type",
+			expectedCfg: expectedConfigBadColumnOIDValueType,
+			expectedErr: fmt.Sprintf(errMsgColumnOIDBadValueType, "m3", "1"),
+		},
 		{
 			name:        "ComplexConfigGood",
 			nameVal:     "complex_good",
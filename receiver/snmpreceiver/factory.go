@@ -83,21 +83,31 @@ func createMetricsReceiver(
 	return scraperhelper.NewScraperControllerReceiver(&snmpConfig.ScraperControllerSettings, params, consumer, scraperhelper.AddScraper(scraper))
 }
 
-// addMissingConfigDefaults adds any missing comfig parameters that have defaults
-func addMissingConfigDefaults(cfg *Config) error {
+// addMissingEndpointDefaults adds the default scheme and port to an endpoint if it doesn't contain them
+func addMissingEndpointDefaults(endpoint string) string {
 	// Add the schema prefix to the endpoint if it doesn't contain one
-	if !strings.Contains(cfg.Endpoint, "://") {
-		cfg.Endpoint = "udp://" + cfg.Endpoint
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "udp://" + endpoint
 	}
 
 	// Add default port to endpoint if it doesn't contain one
-	u, err := url.Parse(cfg.Endpoint)
+	u, err := url.Parse(endpoint)
 	if err == nil && u.Port() == "" {
 		portSuffix := "161"
-		if cfg.Endpoint[len(cfg.Endpoint)-1:] != ":" {
+		if endpoint[len(endpoint)-1:] != ":" {
 			portSuffix = ":" + portSuffix
 		}
-		cfg.Endpoint += portSuffix
+		endpoint += portSuffix
+	}
+
+	return endpoint
+}
+
+// addMissingConfigDefaults adds any missing comfig parameters that have defaults
+func addMissingConfigDefaults(cfg *Config) error {
+	cfg.Endpoint = addMissingEndpointDefaults(cfg.Endpoint)
+	for i := range cfg.Targets {
+		cfg.Targets[i].Endpoint = addMissingEndpointDefaults(cfg.Targets[i].Endpoint)
 	}
 
 	// Set defaults for metric configs
@@ -38,16 +38,28 @@ var (
 	errMsgScalarOIDProcessing            = `problem processing scalar metric data for OID '%s': %w`
 	errMsgIndexedMetricOIDProcessing     = `problem processing indexed metric data for OID '%s' from column OID '%s': %w`
 	errMsgIndexedAttributeOIDProcessing  = `problem processing indexed attribute data for OID '%s' from column OID '%s': %w`
+	errMsgValueOverrideParse             = `value '%s' of OID '%s' is not a valid %s`
+	errMsgTargetConnect                  = `problem connecting to SNMP target '%s': %w`
 )
 
+// targetResourceAttribute is the resource attribute set to the endpoint of the target when Targets are configured
+const targetResourceAttribute = "snmp.target"
+
 // snmpScraper handles scraping of SNMP metrics
 type snmpScraper struct {
 	client   client
+	targets  []snmpTarget
 	logger   *zap.Logger
 	cfg      *Config
 	settings component.ReceiverCreateSettings
 }
 
+// snmpTarget is the client of one of the configured Targets
+type snmpTarget struct {
+	endpoint string
+	client   client
+}
+
 type indexedAttributeValues map[string]string
 
 // newScraper creates an initialized snmpScraper
@@ -59,15 +71,30 @@ func newScraper(logger *zap.Logger, cfg *Config, settings component.ReceiverCrea
 	}
 }
 
-// start gets the client ready
+// start gets the client, or the clients of each of the targets, ready
 func (s *snmpScraper) start(_ context.Context, host component.Host) (err error) {
-	s.client, err = newClient(s.cfg, s.logger)
+	if len(s.cfg.Targets) == 0 {
+		s.client, err = newClient(s.cfg, s.logger)
+		return err
+	}
+
+	for _, target := range s.cfg.Targets {
+		targetClient, err := newClient(s.cfg.targetConfig(target), s.logger)
+		if err != nil {
+			return err
+		}
+		s.targets = append(s.targets, snmpTarget{endpoint: target.Endpoint, client: targetClient})
+	}
 
-	return err
+	return nil
 }
 
 // scrape collects and creates OTEL metrics from a SNMP environment
 func (s *snmpScraper) scrape(_ context.Context) (pmetric.Metrics, error) {
+	if len(s.targets) > 0 {
+		return s.scrapeTargets()
+	}
+
 	if err := s.client.Connect(); err != nil {
 		return pmetric.NewMetrics(), fmt.Errorf("problem connecting to SNMP host: %w", err)
 	}
@@ -79,18 +106,59 @@ func (s *snmpScraper) scrape(_ context.Context) (pmetric.Metrics, error) {
 	configHelper := newConfigHelper(s.cfg)
 
 	var scraperErrors scrapererror.ScrapeErrors
+	s.scrapeClient(s.client, metricHelper, configHelper, &scraperErrors)
+
+	return metricHelper.metrics, scraperErrors.Combine()
+}
+
+// scrapeTargets collects and creates OTEL metrics from each of the targets. A target which cannot
+// be connected to does not prevent the others from being scraped
+func (s *snmpScraper) scrapeTargets() (pmetric.Metrics, error) {
+	metrics := pmetric.NewMetrics()
+	configHelper := newConfigHelper(s.cfg)
+
+	var scraperErrors scrapererror.ScrapeErrors
+	for _, target := range s.targets {
+		if err := target.client.Connect(); err != nil {
+			scraperErrors.AddPartial(len(s.cfg.Metrics), fmt.Errorf(errMsgTargetConnect, target.endpoint, err))
+			continue
+		}
+
+		metricHelper := newOTELMetricHelper(s.settings)
+		s.scrapeClient(target.client, metricHelper, configHelper, &scraperErrors)
+		if err := target.client.Close(); err != nil {
+			s.logger.Warn("Problem with closing connection to SNMP target", zap.String("target", target.endpoint), zap.Error(err))
+		}
+
+		// Identify the target of the resources, as the same table indexes will be found on different targets
+		resourceMetrics := metricHelper.metrics.ResourceMetrics()
+		for i := 0; i < resourceMetrics.Len(); i++ {
+			resourceMetrics.At(i).Resource().Attributes().PutStr(targetResourceAttribute, target.endpoint)
+		}
+		resourceMetrics.MoveAndAppendTo(metrics.ResourceMetrics())
+	}
+
+	return metrics, scraperErrors.Combine()
+}
+
+// scrapeClient collects scalar and indexed data with the client and turns it into metrics
+func (s *snmpScraper) scrapeClient(
+	client client,
+	metricHelper *otelMetricHelper,
+	configHelper *configHelper,
+	scraperErrors *scrapererror.ScrapeErrors,
+) {
 	// Try to scrape scalar OID based metrics
-	s.scrapeScalarMetrics(metricHelper, configHelper, &scraperErrors)
+	s.scrapeScalarMetrics(client, metricHelper, configHelper, scraperErrors)
 
 	// Try to scrape column OID based metrics
-	s.scrapeIndexedMetrics(metricHelper, configHelper, &scraperErrors)
-
-	return metricHelper.metrics, scraperErrors.Combine()
+	s.scrapeIndexedMetrics(client, metricHelper, configHelper, scraperErrors)
 }
 
 // scrapeScalarMetrics retrieves all SNMP data from scalar OIDs and turns the returned scalar data
 // into metrics with optional enum attributes
 func (s *snmpScraper) scrapeScalarMetrics(
+	client client,
 	metricHelper *otelMetricHelper,
 	configHelper *configHelper,
 	scraperErrors *scrapererror.ScrapeErrors,
@@ -103,7 +171,7 @@ func (s *snmpScraper) scrapeScalarMetrics(
 	}
 
 	// Retrieve all SNMP data from scalar metric OIDs
-	scalarData := s.client.GetScalarData(metricScalarOIDs, scraperErrors)
+	scalarData := client.GetScalarData(metricScalarOIDs, scraperErrors)
 
 	// If no scalar data, nothing else to do
 	if len(scalarData) == 0 {
@@ -127,6 +195,7 @@ func (s *snmpScraper) scrapeScalarMetrics(
 // scrapeIndexedMetrics retrieves all SNMP data from column OIDs and turns the returned indexed data
 // into metrics with optional attribute and/or resource attributes
 func (s *snmpScraper) scrapeIndexedMetrics(
+	client client,
 	metricHelper *otelMetricHelper,
 	configHelper *configHelper,
 	scraperErrors *scrapererror.ScrapeErrors,
@@ -139,13 +208,13 @@ func (s *snmpScraper) scrapeIndexedMetrics(
 	}
 
 	// Retrieve column OID SNMP indexed data for attributes
-	columnOIDIndexedAttributeValues := s.scrapeIndexedAttributes(configHelper.getAttributeColumnOIDs(), scraperErrors)
+	columnOIDIndexedAttributeValues := s.scrapeIndexedAttributes(client, configHelper.getAttributeColumnOIDs(), scraperErrors)
 
 	// Retrieve column OID SNMP indexed data for resource attributes
-	columnOIDIndexedResourceAttributeValues := s.scrapeIndexedAttributes(configHelper.getResourceAttributeColumnOIDs(), scraperErrors)
+	columnOIDIndexedResourceAttributeValues := s.scrapeIndexedAttributes(client, configHelper.getResourceAttributeColumnOIDs(), scraperErrors)
 
 	// Retrieve all SNMP indexed data from column metric OIDs
-	indexedData := s.client.GetIndexedData(metricColumnOIDs, scraperErrors)
+	indexedData := client.GetIndexedData(metricColumnOIDs, scraperErrors)
 	// For each piece of SNMP data, attempt to create the necessary OTEL structures (resources/metrics/datapoints)
 	for _, data := range indexedData {
 		if err := s.indexedDataToMetric(data, metricHelper, configHelper, columnOIDIndexedAttributeValues, columnOIDIndexedResourceAttributeValues); err != nil {
@@ -164,6 +233,11 @@ func (s *snmpScraper) scalarDataToMetric(
 	// Get the related metric name for this SNMP indexed data
 	metricName := configHelper.getMetricName(data.oid)
 
+	data, err := overrideValue(data, configHelper.getValueOverride(data.oid))
+	if err != nil {
+		return err
+	}
+
 	// Keys will be determined from the related attribute config and enum values will come straight from
 	// the metric config's attribute values.
 	dataPointAttributes := getScalarDataPointAttributes(configHelper, data.oid)
@@ -184,6 +258,11 @@ func (s *snmpScraper) indexedDataToMetric(
 	// Get the related metric name for this SNMP indexed data
 	metricName := configHelper.getMetricName(data.columnOID)
 
+	data, err := overrideValue(data, configHelper.getValueOverride(data.columnOID))
+	if err != nil {
+		return err
+	}
+
 	indexString := strings.TrimPrefix(data.oid, data.columnOID)
 
 	// Get data point attributes
@@ -212,6 +291,47 @@ func (s *snmpScraper) indexedDataToMetric(
 	return addMetricDataPointToResource(data, metricHelper, configHelper, metricName, resourceKey, dataPointAttributes)
 }
 
+// overrideValue reads the value of the SNMP data as the value type of the override, if any, and
+// multiplies it by the scale of the override, if any
+func overrideValue(data SNMPData, override valueOverride) (SNMPData, error) {
+	// Not explicitly checking these casts as this should be made safe in the client
+	switch strings.ToUpper(override.valueType) {
+	case "INT":
+		switch data.valueType {
+		case stringVal:
+			value, err := strconv.ParseInt(strings.TrimSpace(data.value.(string)), 10, 64)
+			if err != nil {
+				return data, fmt.Errorf(errMsgValueOverrideParse, data.value, data.oid, override.valueType)
+			}
+			data.value, data.valueType = value, integerVal
+		case floatVal:
+			data.value, data.valueType = int64(data.value.(float64)), integerVal
+		}
+	case "DOUBLE":
+		switch data.valueType {
+		case stringVal:
+			value, err := strconv.ParseFloat(strings.TrimSpace(data.value.(string)), 64)
+			if err != nil {
+				return data, fmt.Errorf(errMsgValueOverrideParse, data.value, data.oid, override.valueType)
+			}
+			data.value, data.valueType = value, floatVal
+		case integerVal:
+			data.value, data.valueType = float64(data.value.(int64)), floatVal
+		}
+	}
+
+	if override.scale != 0 && override.scale != 1 {
+		switch data.valueType {
+		case integerVal:
+			data.value, data.valueType = float64(data.value.(int64))*override.scale, floatVal
+		case floatVal:
+			data.value = data.value.(float64) * override.scale
+		}
+	}
+
+	return data, nil
+}
+
 func addMetricDataPointToResource(
 	data SNMPData,
 	metricHelper *otelMetricHelper,
@@ -342,6 +462,7 @@ func getResourceAttributes(
 // scrapeIndexedAttributes retrieves all SNMP data from attribute (or resource attribute)
 // config column OIDs and stores the returned indexed data for later use by metrics
 func (s *snmpScraper) scrapeIndexedAttributes(
+	client client,
 	columnOIDs []string,
 	scraperErrors *scrapererror.ScrapeErrors,
 ) map[string]indexedAttributeValues {
@@ -353,7 +474,7 @@ func (s *snmpScraper) scrapeIndexedAttributes(
 	}
 
 	// Retrieve all SNMP indexed data from column resource attribute OIDs
-	indexedData := s.client.GetIndexedData(columnOIDs, scraperErrors)
+	indexedData := client.GetIndexedData(columnOIDs, scraperErrors)
 
 	// For each piece of SNMP data, store the necessary info to help create resources later if needed
 	for _, data := range indexedData {
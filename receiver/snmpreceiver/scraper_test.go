@@ -2196,3 +2196,168 @@ func TestScrape(t *testing.T) {
 		t.Run(tc.desc, tc.testFunc)
 	}
 }
+
+func TestStartTargets(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Targets = []TargetConfig{
+		{Endpoint: "udp://switch1:161"},
+		{Endpoint: "udp://switch2:161", Version: "v1"},
+	}
+	scraper := &snmpScraper{
+		cfg:      cfg,
+		settings: componenttest.NewNopReceiverCreateSettings(),
+	}
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+	require.Nil(t, scraper.client)
+	require.Len(t, scraper.targets, 2)
+	require.Equal(t, "udp://switch1:161", scraper.targets[0].endpoint)
+	require.Equal(t, "udp://switch2:161", scraper.targets[1].endpoint)
+}
+
+func TestScrapeTargets(t *testing.T) {
+	goodClient := new(MockClient)
+	goodClient.On("Connect").Return(nil)
+	goodClient.On("Close").Return(nil)
+	goodClient.On("GetScalarData", mock.Anything, mock.Anything).Return([]SNMPData{
+		{
+			oid:       ".1",
+			value:     int64(1),
+			valueType: integerVal,
+		},
+	})
+	badClient := new(MockClient)
+	connectErr := errors.New("connection refused")
+	badClient.On("Connect").Return(connectErr)
+
+	scraper := &snmpScraper{
+		cfg: &Config{
+			Metrics: map[string]*MetricConfig{
+				"metric1": {
+					Unit: "By",
+					Gauge: &GaugeMetric{
+						ValueType: "int",
+					},
+					ScalarOIDs: []ScalarOID{
+						{
+							OID: ".1",
+						},
+					},
+				},
+			},
+		},
+		settings: componenttest.NewNopReceiverCreateSettings(),
+		targets: []snmpTarget{
+			{endpoint: "udp://switch1:161", client: goodClient},
+			{endpoint: "udp://switch2:161", client: badClient},
+		},
+		logger: zap.NewNop(),
+	}
+
+	metrics, err := scraper.scrape(context.Background())
+	require.EqualError(t, err, fmt.Errorf(errMsgTargetConnect, "udp://switch2:161", connectErr).Error())
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	require.Equal(t, 1, metrics.ResourceMetrics().Len())
+	target, ok := metrics.ResourceMetrics().At(0).Resource().Attributes().Get(targetResourceAttribute)
+	require.True(t, ok)
+	require.Equal(t, "udp://switch1:161", target.Str())
+	require.Equal(t, 1, metrics.MetricCount())
+	goodClient.AssertExpectations(t)
+}
+
+func TestScrapeValueOverrides(t *testing.T) {
+	mockClient := new(MockClient)
+	mockClient.On("Connect").Return(nil)
+	mockClient.On("Close").Return(nil)
+	mockClient.On("GetScalarData", mock.Anything, mock.Anything).Return([]SNMPData{
+		{
+			oid:       ".1",
+			value:     " 0.15",
+			valueType: stringVal,
+		},
+		{
+			oid:       ".2",
+			value:     "n/a",
+			valueType: stringVal,
+		},
+	})
+	mockClient.On("GetIndexedData", []string{".3"}, mock.Anything).Return([]SNMPData{
+		{
+			columnOID: ".3",
+			oid:       ".3.1",
+			value:     int64(12345),
+			valueType: integerVal,
+		},
+	})
+
+	scraper := &snmpScraper{
+		cfg: &Config{
+			Attributes: map[string]*AttributeConfig{
+				"index": {
+					IndexedValuePrefix: "i",
+				},
+			},
+			Metrics: map[string]*MetricConfig{
+				"load": {
+					Unit: "1",
+					Gauge: &GaugeMetric{
+						ValueType: "double",
+					},
+					ScalarOIDs: []ScalarOID{
+						{
+							OID:       ".1",
+							ValueType: "double",
+						},
+					},
+				},
+				"invalid": {
+					Unit: "1",
+					Gauge: &GaugeMetric{
+						ValueType: "double",
+					},
+					ScalarOIDs: []ScalarOID{
+						{
+							OID:       ".2",
+							ValueType: "double",
+						},
+					},
+				},
+				"uptime": {
+					Unit: "s",
+					Sum: &SumMetric{
+						Aggregation: "cumulative",
+						Monotonic:   true,
+						ValueType:   "double",
+					},
+					ColumnOIDs: []ColumnOID{
+						{
+							OID:        ".3",
+							Attributes: []Attribute{{Name: "index"}},
+							Scale:      0.01,
+						},
+					},
+				},
+			},
+		},
+		settings: componenttest.NewNopReceiverCreateSettings(),
+		client:   mockClient,
+		logger:   zap.NewNop(),
+	}
+
+	metrics, err := scraper.scrape(context.Background())
+	expectedErr := fmt.Errorf(errMsgScalarOIDProcessing, ".2", fmt.Errorf(errMsgValueOverrideParse, "n/a", ".2", "double"))
+	require.EqualError(t, err, expectedErr.Error())
+
+	values := map[string]float64{}
+	rms := metrics.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		ms := rms.At(i).ScopeMetrics().At(0).Metrics()
+		for j := 0; j < ms.Len(); j++ {
+			if ms.At(j).Type() == pmetric.MetricTypeSum {
+				values[ms.At(j).Name()] = ms.At(j).Sum().DataPoints().At(0).DoubleValue()
+			} else {
+				values[ms.At(j).Name()] = ms.At(j).Gauge().DataPoints().At(0).DoubleValue()
+			}
+		}
+	}
+	require.Equal(t, map[string]float64{"load": 0.15, "uptime": 123.45}, values)
+}
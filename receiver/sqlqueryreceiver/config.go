@@ -27,9 +27,10 @@ import (
 
 type Config struct {
 	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
-	Driver                                  string  `mapstructure:"driver"`
-	DataSource                              string  `mapstructure:"datasource"`
-	Queries                                 []Query `mapstructure:"queries"`
+	Driver                                  string        `mapstructure:"driver"`
+	DataSource                              string        `mapstructure:"datasource"`
+	Queries                                 []Query       `mapstructure:"queries"`
+	StorageID                               *component.ID `mapstructure:"storage"`
 }
 
 func (c Config) Validate() error {
@@ -51,8 +52,11 @@ func (c Config) Validate() error {
 }
 
 type Query struct {
-	SQL     string      `mapstructure:"sql"`
-	Metrics []MetricCfg `mapstructure:"metrics"`
+	SQL                string      `mapstructure:"sql"`
+	Metrics            []MetricCfg `mapstructure:"metrics"`
+	Logs               []LogsCfg   `mapstructure:"logs"`
+	TrackingColumn     string      `mapstructure:"tracking_column"`
+	TrackingStartValue string      `mapstructure:"tracking_start_value"`
 }
 
 func (q Query) Validate() error {
@@ -60,17 +64,40 @@ func (q Query) Validate() error {
 	if q.SQL == "" {
 		errs = multierr.Append(errs, errors.New("'query.sql' cannot be empty"))
 	}
-	if len(q.Metrics) == 0 {
-		errs = multierr.Append(errs, errors.New("'query.metrics' cannot be empty"))
+	if len(q.Metrics) == 0 && len(q.Logs) == 0 {
+		errs = multierr.Append(errs, errors.New("'query.metrics' and 'query.logs' cannot both be empty"))
+	}
+	if q.TrackingColumn != "" && len(q.Metrics) > 0 {
+		errs = multierr.Append(errs, errors.New("'query.tracking_column' is only supported by queries without metrics"))
+	}
+	if q.TrackingStartValue != "" && q.TrackingColumn == "" {
+		errs = multierr.Append(errs, errors.New("'query.tracking_start_value' requires 'query.tracking_column'"))
 	}
 	for _, metric := range q.Metrics {
 		if err := metric.Validate(); err != nil {
 			errs = multierr.Append(errs, err)
 		}
 	}
+	for _, logs := range q.Logs {
+		if err := logs.Validate(); err != nil {
+			errs = multierr.Append(errs, err)
+		}
+	}
 	return errs
 }
 
+type LogsCfg struct {
+	BodyColumn       string   `mapstructure:"body_column"`
+	AttributeColumns []string `mapstructure:"attribute_columns"`
+}
+
+func (c LogsCfg) Validate() error {
+	if c.BodyColumn == "" {
+		return errors.New("'body_column' cannot be empty")
+	}
+	return nil
+}
+
 type MetricCfg struct {
 	MetricName       string            `mapstructure:"metric_name"`
 	ValueColumn      string            `mapstructure:"value_column"`
@@ -0,0 +1,38 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlqueryreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlqueryreceiver"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func rowToLog(row stringMap, cfg LogsCfg, lr plog.LogRecord) error {
+	body, found := row[cfg.BodyColumn]
+	if !found {
+		return fmt.Errorf("rowToLog: body_column '%s' not found in result set", cfg.BodyColumn)
+	}
+	lr.Body().SetStr(body)
+	attrs := lr.Attributes()
+	for _, name := range cfg.AttributeColumns {
+		value, found := row[name]
+		if !found {
+			return fmt.Errorf("rowToLog: attribute_column '%s' not found in result set", name)
+		}
+		attrs.PutStr(name, value)
+	}
+	return nil
+}
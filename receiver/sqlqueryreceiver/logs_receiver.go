@@ -0,0 +1,244 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlqueryreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlqueryreceiver"
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/obsreport"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+const trackingValueKeyPrefix = "tracking_value:"
+
+var errNoLogsQueries = errors.New("no queries with logs are configured")
+
+// logsReceiver runs the queries with logs at each collection interval and emits
+// a log record per row and logs config. The queries with a tracking column are
+// passed the tracking value of the last row consumed, which is persisted in the
+// storage extension when one is configured, so that only new rows are read.
+type logsReceiver struct {
+	id                 component.ID
+	config             *Config
+	settings           component.ReceiverCreateSettings
+	obsrecv            *obsreport.Receiver
+	consumer           consumer.Logs
+	sqlOpenerFunc      sqlOpenerFunc
+	clientProviderFunc clientProviderFunc
+
+	db            *sql.DB
+	queries       []*logsQuery
+	storageClient storage.Client
+	cancel        context.CancelFunc
+	wg            sync.WaitGroup
+}
+
+type logsQuery struct {
+	query         Query
+	client        dbClient
+	trackingValue string
+}
+
+func createLogsReceiverFunc(sqlOpenerFunc sqlOpenerFunc, clientProviderFunc clientProviderFunc) component.CreateLogsReceiverFunc {
+	return func(
+		ctx context.Context,
+		settings component.ReceiverCreateSettings,
+		cfg component.ReceiverConfig,
+		consumer consumer.Logs,
+	) (component.LogsReceiver, error) {
+		sqlCfg := cfg.(*Config)
+		hasLogs := false
+		for _, query := range sqlCfg.Queries {
+			hasLogs = hasLogs || len(query.Logs) > 0
+		}
+		if !hasLogs {
+			return nil, errNoLogsQueries
+		}
+		obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{
+			ReceiverID:             sqlCfg.ID(),
+			ReceiverCreateSettings: settings,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &logsReceiver{
+			id:                 sqlCfg.ID(),
+			config:             sqlCfg,
+			settings:           settings,
+			obsrecv:            obsrecv,
+			consumer:           consumer,
+			sqlOpenerFunc:      sqlOpenerFunc,
+			clientProviderFunc: clientProviderFunc,
+		}, nil
+	}
+}
+
+func (r *logsReceiver) Start(ctx context.Context, host component.Host) error {
+	var err error
+	r.storageClient, err = getStorageClient(ctx, host, r.config.StorageID, r.id)
+	if err != nil {
+		return fmt.Errorf("failed to get storage client: %w", err)
+	}
+	r.db, err = r.sqlOpenerFunc(r.config.Driver, r.config.DataSource)
+	if err != nil {
+		return fmt.Errorf("failed to open db connection: %w", err)
+	}
+	for _, query := range r.config.Queries {
+		if len(query.Logs) == 0 {
+			continue
+		}
+		q := &logsQuery{
+			query:         query,
+			client:        r.clientProviderFunc(r.db, query.SQL, r.settings.Logger),
+			trackingValue: query.TrackingStartValue,
+		}
+		if query.TrackingColumn != "" {
+			value, getErr := r.storageClient.Get(ctx, trackingValueKeyPrefix+query.SQL)
+			if getErr != nil {
+				return fmt.Errorf("failed to read the tracking value of query '%s': %w", query.SQL, getErr)
+			}
+			if value != nil {
+				q.trackingValue = string(value)
+			}
+		}
+		r.queries = append(r.queries, q)
+	}
+
+	collectCtx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.wg.Add(1)
+	go r.run(collectCtx)
+	return nil
+}
+
+func (r *logsReceiver) run(ctx context.Context) {
+	defer r.wg.Done()
+	ticker := time.NewTicker(r.config.CollectionInterval)
+	defer ticker.Stop()
+	for {
+		r.collect(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *logsReceiver) collect(ctx context.Context) {
+	for _, q := range r.queries {
+		if err := r.collectQuery(ctx, q); err != nil {
+			r.settings.Logger.Error("Failed to collect logs", zap.String("query", q.query.SQL), zap.Error(err))
+		}
+	}
+}
+
+func (r *logsReceiver) collectQuery(ctx context.Context, q *logsQuery) error {
+	var args []interface{}
+	if q.query.TrackingColumn != "" {
+		args = append(args, q.trackingValue)
+	}
+	rows, err := q.client.queryRows(ctx, args...)
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	ld := plog.NewLogs()
+	lrs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	observed := pcommon.NewTimestampFromTime(time.Now())
+	var errs error
+	for i, row := range rows {
+		for _, logsCfg := range q.query.Logs {
+			lr := lrs.AppendEmpty()
+			lr.SetObservedTimestamp(observed)
+			if err = rowToLog(row, logsCfg, lr); err != nil {
+				errs = multierr.Append(errs, fmt.Errorf("row %d: %w", i, err))
+			}
+		}
+	}
+	if errs != nil {
+		return fmt.Errorf("row conversion errors: %w", errs)
+	}
+
+	var trackingValue string
+	if q.query.TrackingColumn != "" {
+		var found bool
+		if trackingValue, found = rows[len(rows)-1][q.query.TrackingColumn]; !found {
+			return fmt.Errorf("tracking_column '%s' not found in result set", q.query.TrackingColumn)
+		}
+	}
+
+	obsCtx := r.obsrecv.StartLogsOp(ctx)
+	err = r.consumer.ConsumeLogs(obsCtx, ld)
+	r.obsrecv.EndLogsOp(obsCtx, typeStr, ld.LogRecordCount(), err)
+	if err != nil {
+		// the tracking value is left unchanged, so the rows are read again at the next interval.
+		return err
+	}
+
+	if q.query.TrackingColumn != "" {
+		q.trackingValue = trackingValue
+		if err = r.storageClient.Set(ctx, trackingValueKeyPrefix+q.query.SQL, []byte(trackingValue)); err != nil {
+			return fmt.Errorf("failed to persist the tracking value: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *logsReceiver) Shutdown(ctx context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	var errs error
+	if r.storageClient != nil {
+		errs = multierr.Append(errs, r.storageClient.Close(ctx))
+	}
+	if r.db != nil {
+		errs = multierr.Append(errs, r.db.Close())
+	}
+	return errs
+}
+
+// getStorageClient returns the client of the storage extension, or a nop client when
+// no extension is configured, in which case the tracking values are kept in memory.
+func getStorageClient(ctx context.Context, host component.Host, storageID *component.ID, receiverID component.ID) (storage.Client, error) {
+	if storageID == nil {
+		return storage.NewNopClient(), nil
+	}
+	extension, found := host.GetExtensions()[*storageID]
+	if !found {
+		return nil, fmt.Errorf("storage extension '%s' not found", storageID)
+	}
+	storageExtension, ok := extension.(storage.Extension)
+	if !ok {
+		return nil, fmt.Errorf("non-storage extension '%s' found", storageID)
+	}
+	return storageExtension.GetClient(ctx, component.KindReceiver, receiverID, "")
+}
@@ -0,0 +1,192 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlqueryreceiver
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/zap"
+)
+
+func TestCreateLogsReceiver_NoLogsQueries(t *testing.T) {
+	createReceiver := createLogsReceiverFunc(fakeDBConnect, mkFakeClient)
+	_, err := createReceiver(
+		context.Background(),
+		componenttest.NewNopReceiverCreateSettings(),
+		&Config{
+			Driver:     "mydriver",
+			DataSource: "my-datasource",
+			Queries: []Query{{
+				SQL:     "select * from foo",
+				Metrics: []MetricCfg{{MetricName: "my-metric", ValueColumn: "my-column"}},
+			}},
+		},
+		consumertest.NewNop(),
+	)
+	assert.ErrorIs(t, err, errNoLogsQueries)
+}
+
+func TestLogsReceiver_TrackingValue(t *testing.T) {
+	client := &fakeDBClient{responses: [][]stringMap{
+		{
+			{"id": "11", "body": "first"},
+			{"id": "12", "body": "second"},
+		},
+		{
+			{"id": "13", "body": "third"},
+		},
+	}}
+	sink := &consumertest.LogsSink{}
+	cfg := logsConfig()
+	cfg.StorageID = &testStorageID
+	r := createTestLogsReceiver(t, cfg, client, sink)
+
+	ext := &fakeStorageExtension{client: newFakeStorageClient()}
+	// The value persisted by a previous run takes precedence over the start value.
+	require.NoError(t, ext.client.Set(context.Background(), trackingValueKeyPrefix+cfg.Queries[0].SQL, []byte("10")))
+	require.NoError(t, r.Start(context.Background(), &fakeHost{Host: componenttest.NewNopHost(), ext: ext}))
+	require.Eventually(t, func() bool { return sink.LogRecordCount() == 2 }, time.Second, 10*time.Millisecond)
+	require.NoError(t, r.Shutdown(context.Background()))
+
+	logs := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	assert.Equal(t, "first", logs.At(0).Body().Str())
+	assert.Equal(t, "second", logs.At(1).Body().Str())
+	assert.Equal(t, []interface{}{"10"}, client.args[0])
+	value, err := ext.client.Get(context.Background(), trackingValueKeyPrefix+cfg.Queries[0].SQL)
+	require.NoError(t, err)
+	assert.Equal(t, "12", string(value))
+
+	require.NoError(t, r.collectQuery(context.Background(), r.queries[0]))
+	assert.Equal(t, []interface{}{"12"}, client.args[1])
+	assert.Equal(t, 3, sink.LogRecordCount())
+	assert.Equal(t, "13", r.queries[0].trackingValue)
+}
+
+func TestLogsReceiver_ConsumerError(t *testing.T) {
+	client := &fakeDBClient{responses: [][]stringMap{
+		{{"id": "11", "body": "first"}},
+	}}
+	r := createTestLogsReceiver(t, logsConfig(), client, consumertest.NewErr(errors.New("consumer error")))
+	r.storageClient = storage.NewNopClient()
+	r.queries = []*logsQuery{{query: r.config.Queries[0], client: client, trackingValue: "10"}}
+
+	assert.EqualError(t, r.collectQuery(context.Background(), r.queries[0]), "consumer error")
+	// The rows are read again at the next collection.
+	assert.Equal(t, "10", r.queries[0].trackingValue)
+}
+
+func TestLogsReceiver_MissingTrackingColumn(t *testing.T) {
+	client := &fakeDBClient{responses: [][]stringMap{
+		{{"body": "first"}},
+	}}
+	r := createTestLogsReceiver(t, logsConfig(), client, consumertest.NewNop())
+	r.storageClient = storage.NewNopClient()
+	r.queries = []*logsQuery{{query: r.config.Queries[0], client: client}}
+
+	assert.EqualError(t, r.collectQuery(context.Background(), r.queries[0]), "tracking_column 'id' not found in result set")
+}
+
+func TestGetStorageClient(t *testing.T) {
+	host := &fakeHost{Host: componenttest.NewNopHost(), ext: &fakeStorageExtension{client: newFakeStorageClient()}}
+	id := component.NewID(typeStr)
+
+	client, err := getStorageClient(context.Background(), host, nil, id)
+	require.NoError(t, err)
+	assert.NotNil(t, client)
+
+	client, err = getStorageClient(context.Background(), host, &testStorageID, id)
+	require.NoError(t, err)
+	assert.IsType(t, &fakeStorageClient{}, client)
+
+	missing := component.NewID("missing")
+	_, err = getStorageClient(context.Background(), host, &missing, id)
+	assert.EqualError(t, err, "storage extension 'missing' not found")
+}
+
+var testStorageID = component.NewID("file_storage")
+
+func logsConfig() *Config {
+	return &Config{
+		ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
+			CollectionInterval: time.Hour,
+		},
+		Driver:     "mydriver",
+		DataSource: "my-datasource",
+		Queries: []Query{{
+			SQL:                "select id, body from logs where id > ? order by id",
+			TrackingColumn:     "id",
+			TrackingStartValue: "0",
+			Logs:               []LogsCfg{{BodyColumn: "body"}},
+		}},
+	}
+}
+
+func createTestLogsReceiver(t *testing.T, cfg *Config, client dbClient, consumer consumer.Logs) *logsReceiver {
+	createReceiver := createLogsReceiverFunc(fakeDBConnect, func(*sql.DB, string, *zap.Logger) dbClient {
+		return client
+	})
+	r, err := createReceiver(context.Background(), componenttest.NewNopReceiverCreateSettings(), cfg, consumer)
+	require.NoError(t, err)
+	return r.(*logsReceiver)
+}
+
+type fakeHost struct {
+	component.Host
+	ext component.Extension
+}
+
+func (h *fakeHost) GetExtensions() map[component.ID]component.Extension {
+	return map[component.ID]component.Extension{testStorageID: h.ext}
+}
+
+type fakeStorageExtension struct {
+	component.StartFunc
+	component.ShutdownFunc
+	client *fakeStorageClient
+}
+
+func (e *fakeStorageExtension) GetClient(context.Context, component.Kind, component.ID, string) (storage.Client, error) {
+	return e.client, nil
+}
+
+type fakeStorageClient struct {
+	storage.Client
+	values map[string][]byte
+}
+
+func newFakeStorageClient() *fakeStorageClient {
+	return &fakeStorageClient{Client: storage.NewNopClient(), values: map[string][]byte{}}
+}
+
+func (c *fakeStorageClient) Get(_ context.Context, key string) ([]byte, error) {
+	return c.values[key], nil
+}
+
+func (c *fakeStorageClient) Set(_ context.Context, key string, value []byte) error {
+	c.values[key] = value
+	return nil
+}
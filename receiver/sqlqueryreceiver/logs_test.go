@@ -0,0 +1,43 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlqueryreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func TestRowToLog(t *testing.T) {
+	lr := plog.NewLogRecord()
+	err := rowToLog(
+		stringMap{"id": "1", "body": "user logged in", "user": "alice", "ignored": "x"},
+		LogsCfg{BodyColumn: "body", AttributeColumns: []string{"id", "user"}},
+		lr,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "user logged in", lr.Body().Str())
+	assert.Equal(t, map[string]interface{}{"id": "1", "user": "alice"}, lr.Attributes().AsRaw())
+}
+
+func TestRowToLog_MissingColumn(t *testing.T) {
+	err := rowToLog(stringMap{"id": "1"}, LogsCfg{BodyColumn: "body"}, plog.NewLogRecord())
+	assert.EqualError(t, err, "rowToLog: body_column 'body' not found in result set")
+
+	err = rowToLog(stringMap{"body": "foo"}, LogsCfg{BodyColumn: "body", AttributeColumns: []string{"id"}}, plog.NewLogRecord())
+	assert.EqualError(t, err, "rowToLog: attribute_column 'id' not found in result set")
+}
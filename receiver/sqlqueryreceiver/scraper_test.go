@@ -49,7 +49,7 @@ func TestScraper_ClientErrorOnScrape(t *testing.T) {
 
 func TestScraper_RowToMetricErrorOnScrape_Float(t *testing.T) {
 	client := &fakeDBClient{
-		responses: [][]metricRow{
+		responses: [][]stringMap{
 			{{"myfloat": "blah"}},
 		},
 	}
@@ -74,7 +74,7 @@ func TestScraper_RowToMetricErrorOnScrape_Float(t *testing.T) {
 
 func TestScraper_RowToMetricErrorOnScrape_Int(t *testing.T) {
 	client := &fakeDBClient{
-		responses: [][]metricRow{
+		responses: [][]stringMap{
 			{{"myint": "blah"}},
 		},
 	}
@@ -99,7 +99,7 @@ func TestScraper_RowToMetricErrorOnScrape_Int(t *testing.T) {
 
 func TestScraper_RowToMetricMultiErrorsOnScrape(t *testing.T) {
 	client := &fakeDBClient{
-		responses: [][]metricRow{{
+		responses: [][]stringMap{{
 			{"myint": "foo"},
 			{"myint": "bar"},
 		}},
@@ -126,7 +126,7 @@ func TestScraper_RowToMetricMultiErrorsOnScrape(t *testing.T) {
 func TestScraper_SingleRow_MultiMetrics(t *testing.T) {
 	scrpr := scraper{
 		client: &fakeDBClient{
-			responses: [][]metricRow{{{
+			responses: [][]stringMap{{{
 				"count":    "42",
 				"foo_name": "baz",
 				"bar_name": "quux",
@@ -196,7 +196,7 @@ func TestScraper_SingleRow_MultiMetrics(t *testing.T) {
 
 func TestScraper_MultiRow(t *testing.T) {
 	client := &fakeDBClient{
-		responses: [][]metricRow{{
+		responses: [][]stringMap{{
 			{
 				"count": "42",
 				"genre": "action",
@@ -242,7 +242,7 @@ func TestScraper_MultiRow(t *testing.T) {
 
 func TestScraper_MultiResults_CumulativeSum(t *testing.T) {
 	client := &fakeDBClient{
-		responses: [][]metricRow{
+		responses: [][]stringMap{
 			{{"count": "42"}},
 			{{"count": "43"}},
 		},
@@ -265,7 +265,7 @@ func TestScraper_MultiResults_CumulativeSum(t *testing.T) {
 
 func TestScraper_MultiResults_DeltaSum(t *testing.T) {
 	client := &fakeDBClient{
-		responses: [][]metricRow{
+		responses: [][]stringMap{
 			{{"count": "42"}},
 			{{"count": "43"}},
 		},
@@ -302,7 +302,7 @@ func assertTransactionCount(t *testing.T, scrpr scraper, expected int, agg pmetr
 
 func TestScraper_Float(t *testing.T) {
 	client := &fakeDBClient{
-		responses: [][]metricRow{
+		responses: [][]stringMap{
 			{{"myfloat": "123.4"}},
 		},
 	}
@@ -326,7 +326,7 @@ func TestScraper_Float(t *testing.T) {
 
 func TestScraper_DescriptionAndUnit(t *testing.T) {
 	client := &fakeDBClient{
-		responses: [][]metricRow{
+		responses: [][]stringMap{
 			{{"mycol": "123"}},
 		},
 	}
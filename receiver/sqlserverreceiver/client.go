@@ -0,0 +1,159 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlserverreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlserverreceiver"
+
+import (
+	"context"
+	"database/sql"
+
+	// register the sqlserver driver
+	_ "github.com/denisenkom/go-mssqldb"
+)
+
+const topQueriesQuery = `
+SELECT TOP (@p1)
+	CONVERT(varchar(64), qs.query_hash, 2) AS query_hash,
+	LEFT(MAX(st.text), 1024) AS query_text,
+	SUM(qs.execution_count) AS execution_count,
+	SUM(qs.total_worker_time) AS total_worker_time,
+	SUM(qs.total_elapsed_time) AS total_elapsed_time,
+	SUM(qs.total_logical_reads) AS total_logical_reads
+FROM sys.dm_exec_query_stats AS qs
+CROSS APPLY sys.dm_exec_sql_text(qs.sql_handle) AS st
+GROUP BY qs.query_hash
+ORDER BY SUM(qs.total_worker_time) DESC`
+
+// waitStatsQuery excludes the waits of idle background tasks, which do not reflect the load of the server.
+const waitStatsQuery = `
+SELECT wait_type, waiting_tasks_count, wait_time_ms
+FROM sys.dm_os_wait_stats
+WHERE waiting_tasks_count > 0 AND wait_type NOT IN (
+	'BROKER_EVENTHANDLER', 'BROKER_RECEIVE_WAITFOR', 'BROKER_TASK_STOP', 'BROKER_TO_FLUSH',
+	'CHECKPOINT_QUEUE', 'CLR_AUTO_EVENT', 'CLR_MANUAL_EVENT', 'DIRTY_PAGE_POLL',
+	'DISPATCHER_QUEUE_SEMAPHORE', 'FT_IFTS_SCHEDULER_IDLE_WAIT', 'HADR_FILESTREAM_IOMGR_IOCOMPLETION',
+	'LAZYWRITER_SLEEP', 'LOGMGR_QUEUE', 'REQUEST_FOR_DEADLOCK_SEARCH', 'SLEEP_TASK',
+	'SP_SERVER_DIAGNOSTICS_SLEEP', 'SQLTRACE_INCREMENTAL_FLUSH_SLEEP', 'WAITFOR',
+	'XE_DISPATCHER_WAIT', 'XE_TIMER_EVENT')`
+
+// indexFragmentationQuery uses the LIMITED mode, which only scans the pages above the leaf level.
+const indexFragmentationQuery = `
+SELECT
+	DB_NAME() AS database_name,
+	SCHEMA_NAME(o.schema_id) + '.' + o.name AS table_name,
+	i.name AS index_name,
+	ips.avg_fragmentation_in_percent
+FROM sys.dm_db_index_physical_stats(DB_ID(), NULL, NULL, NULL, 'LIMITED') AS ips
+JOIN sys.indexes AS i ON i.object_id = ips.object_id AND i.index_id = ips.index_id
+JOIN sys.objects AS o ON o.object_id = ips.object_id
+WHERE i.name IS NOT NULL AND o.is_ms_shipped = 0 AND ips.alloc_unit_type_desc = 'IN_ROW_DATA'`
+
+type queryStats struct {
+	queryHash         string
+	queryText         string
+	executionCount    int64
+	totalWorkerTime   int64
+	totalElapsedTime  int64
+	totalLogicalReads int64
+}
+
+type waitStats struct {
+	waitType          string
+	waitingTasksCount int64
+	waitTimeMs        int64
+}
+
+type indexFragmentation struct {
+	databaseName         string
+	tableName            string
+	indexName            string
+	fragmentationPercent float64
+}
+
+// client queries the dynamic management views of a SQL Server instance.
+type client interface {
+	topQueries(ctx context.Context, count uint) ([]queryStats, error)
+	waitStats(ctx context.Context) ([]waitStats, error)
+	indexFragmentation(ctx context.Context) ([]indexFragmentation, error)
+	Close() error
+}
+
+type dbClient struct {
+	db *sql.DB
+}
+
+var _ client = (*dbClient)(nil)
+
+func newDBClient(connectionString string) (client, error) {
+	db, err := sql.Open("sqlserver", connectionString)
+	if err != nil {
+		return nil, err
+	}
+	return &dbClient{db: db}, nil
+}
+
+func (c *dbClient) topQueries(ctx context.Context, count uint) ([]queryStats, error) {
+	rows, err := c.db.QueryContext(ctx, topQueriesQuery, int64(count))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var stats []queryStats
+	for rows.Next() {
+		var s queryStats
+		if err = rows.Scan(&s.queryHash, &s.queryText, &s.executionCount, &s.totalWorkerTime, &s.totalElapsedTime, &s.totalLogicalReads); err != nil {
+			return nil, err
+		}
+		stats = append(stats, s)
+	}
+	return stats, rows.Err()
+}
+
+func (c *dbClient) waitStats(ctx context.Context) ([]waitStats, error) {
+	rows, err := c.db.QueryContext(ctx, waitStatsQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var stats []waitStats
+	for rows.Next() {
+		var s waitStats
+		if err = rows.Scan(&s.waitType, &s.waitingTasksCount, &s.waitTimeMs); err != nil {
+			return nil, err
+		}
+		stats = append(stats, s)
+	}
+	return stats, rows.Err()
+}
+
+func (c *dbClient) indexFragmentation(ctx context.Context) ([]indexFragmentation, error) {
+	rows, err := c.db.QueryContext(ctx, indexFragmentationQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var stats []indexFragmentation
+	for rows.Next() {
+		var s indexFragmentation
+		if err = rows.Scan(&s.databaseName, &s.tableName, &s.indexName, &s.fragmentationPercent); err != nil {
+			return nil, err
+		}
+		stats = append(stats, s)
+	}
+	return stats, rows.Err()
+}
+
+func (c *dbClient) Close() error {
+	return c.db.Close()
+}
@@ -15,6 +15,11 @@
 package sqlserverreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlserverreceiver"
 
 import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlserverreceiver/internal/metadata"
@@ -24,4 +29,53 @@ import (
 type Config struct {
 	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
 	Metrics                                 metadata.MetricsSettings `mapstructure:"metrics"`
+
+	// Server enables the direct connection to the SQL Server instance, which scrapes the
+	// dynamic management views instead of the Windows performance counters.
+	Server   string `mapstructure:"server"`
+	Port     uint   `mapstructure:"port"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+	// Database is the database whose index fragmentation is scraped, defaults to the
+	// default database of the login.
+	Database string `mapstructure:"database"`
+	// TopQueryCount is the number of queries with the highest CPU time that are reported.
+	TopQueryCount uint `mapstructure:"top_query_count"`
+}
+
+// Validate validates the direct connection settings.
+func (cfg *Config) Validate() error {
+	if !cfg.directConnectionEnabled() {
+		if cfg.Port != 0 || cfg.Username != "" || cfg.Password != "" || cfg.Database != "" {
+			return errors.New("'server' must be set to connect directly to SQL Server")
+		}
+		return nil
+	}
+	if (cfg.Username == "") != (cfg.Password == "") {
+		return errors.New("'username' and 'password' must be set together")
+	}
+	if cfg.Port > 65535 {
+		return fmt.Errorf("invalid port %d", cfg.Port)
+	}
+	return nil
+}
+
+func (cfg *Config) directConnectionEnabled() bool {
+	return cfg.Server != ""
+}
+
+// connectionString returns the go-mssqldb connection string of the direct connection.
+func (cfg *Config) connectionString() string {
+	host := cfg.Server
+	if cfg.Port != 0 {
+		host += ":" + strconv.FormatUint(uint64(cfg.Port), 10)
+	}
+	u := &url.URL{Scheme: "sqlserver", Host: host}
+	if cfg.Username != "" {
+		u.User = url.UserPassword(cfg.Username, cfg.Password)
+	}
+	if cfg.Database != "" {
+		u.RawQuery = url.Values{"database": []string{cfg.Database}}.Encode()
+	}
+	return u.String()
 }
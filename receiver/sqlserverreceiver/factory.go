@@ -15,10 +15,12 @@
 package sqlserverreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlserverreceiver"
 
 import (
+	"errors"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlserverreceiver/internal/metadata"
@@ -29,6 +31,8 @@ const (
 	stability = component.StabilityLevelInDevelopment
 )
 
+var errConfigNotSqlServer = errors.New("config was not a sqlserver receiver config")
+
 // NewFactory creates a factory for SQL Server receiver.
 func NewFactory() component.ReceiverFactory {
 	return component.NewReceiverFactory(
@@ -43,6 +47,28 @@ func createDefaultConfig() component.ReceiverConfig {
 			ReceiverSettings:   config.NewReceiverSettings(component.NewID(typeStr)),
 			CollectionInterval: 10 * time.Second,
 		},
-		Metrics: metadata.DefaultMetricsSettings(),
+		Metrics:       metadata.DefaultMetricsSettings(),
+		TopQueryCount: 10,
+	}
+}
+
+// createDirectMetricsReceiver creates a receiver scraping the dynamic management views
+// through a direct connection, which is used on every platform when 'server' is set.
+func createDirectMetricsReceiver(
+	params component.ReceiverCreateSettings,
+	cfg *Config,
+	metricsConsumer consumer.Metrics,
+) (component.MetricsReceiver, error) {
+	directScraper := newDirectScraper(params, cfg)
+
+	scraper, err := scraperhelper.NewScraper(typeStr, directScraper.scrape,
+		scraperhelper.WithStart(directScraper.start),
+		scraperhelper.WithShutdown(directScraper.shutdown))
+	if err != nil {
+		return nil, err
 	}
+
+	return scraperhelper.NewScraperControllerReceiver(
+		&cfg.ScraperControllerSettings, params, metricsConsumer, scraperhelper.AddScraper(scraper),
+	)
 }
@@ -27,10 +27,17 @@ import (
 
 // createMetricsReceiver creates a metrics receiver based on provided config.
 func createMetricsReceiver(
-	ctx context.Context,
+	_ context.Context,
 	params component.ReceiverCreateSettings,
-	cfg component.ReceiverConfig,
-	consumer consumer.Metrics,
+	receiverCfg component.ReceiverConfig,
+	metricsConsumer consumer.Metrics,
 ) (component.MetricsReceiver, error) {
-	return nil, errors.New("the sqlserver receiver is only supported on Windows")
+	cfg, ok := receiverCfg.(*Config)
+	if !ok {
+		return nil, errConfigNotSqlServer
+	}
+	if !cfg.directConnectionEnabled() {
+		return nil, errors.New("the sqlserver receiver is only supported on Windows, unless 'server' is set to connect directly")
+	}
+	return createDirectMetricsReceiver(params, cfg, metricsConsumer)
 }
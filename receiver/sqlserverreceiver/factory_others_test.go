@@ -31,6 +31,18 @@ func TestCreateMetricsReceiver(t *testing.T) {
 	cfg := factory.CreateDefaultConfig()
 	mReceiver, err := factory.CreateMetricsReceiver(context.Background(), componenttest.NewNopReceiverCreateSettings(), cfg, consumertest.NewNop())
 
-	assert.EqualError(t, err, "the sqlserver receiver is only supported on Windows")
+	assert.EqualError(t, err, "the sqlserver receiver is only supported on Windows, unless 'server' is set to connect directly")
 	assert.Nil(t, mReceiver)
 }
+
+func TestCreateMetricsReceiver_DirectConnection(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.Server = "localhost"
+	cfg.Username = "sa"
+	cfg.Password = "s3cr3t"
+	mReceiver, err := factory.CreateMetricsReceiver(context.Background(), componenttest.NewNopReceiverCreateSettings(), cfg, consumertest.NewNop())
+
+	assert.NoError(t, err)
+	assert.NotNil(t, mReceiver)
+}
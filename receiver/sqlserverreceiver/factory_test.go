@@ -48,7 +48,8 @@ func TestNewFactory(t *testing.T) {
 						ReceiverSettings:   config.NewReceiverSettings(component.NewID(typeStr)),
 						CollectionInterval: 10 * time.Second,
 					},
-					Metrics: metadata.DefaultMetricsSettings(),
+					Metrics:       metadata.DefaultMetricsSettings(),
+					TopQueryCount: 10,
 				}
 
 				require.Equal(t, expectedCfg, factory.CreateDefaultConfig())
@@ -19,15 +19,12 @@ package sqlserverreceiver // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"context"
-	"errors"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 )
 
-var errConfigNotSqlServer = errors.New("config was not a sqlserver receiver config")
-
 // createMetricsReceiver creates a metrics receiver based on provided config.
 func createMetricsReceiver(
 	_ context.Context,
@@ -39,6 +36,9 @@ func createMetricsReceiver(
 	if !ok {
 		return nil, errConfigNotSqlServer
 	}
+	if cfg.directConnectionEnabled() {
+		return createDirectMetricsReceiver(params, cfg, metricsConsumer)
+	}
 	sqlServerScraper := newSqlServerScraper(params, cfg)
 
 	scraper, err := scraperhelper.NewScraper(typeStr, sqlServerScraper.scrape,
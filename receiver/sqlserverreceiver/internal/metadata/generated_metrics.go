@@ -40,6 +40,7 @@ type MetricsSettings struct {
 	SqlserverBatchRequestRate            MetricSettings `mapstructure:"sqlserver.batch.request.rate"`
 	SqlserverBatchSQLCompilationRate     MetricSettings `mapstructure:"sqlserver.batch.sql_compilation.rate"`
 	SqlserverBatchSQLRecompilationRate   MetricSettings `mapstructure:"sqlserver.batch.sql_recompilation.rate"`
+	SqlserverIndexFragmentation          MetricSettings `mapstructure:"sqlserver.index.fragmentation"`
 	SqlserverLockWaitRate                MetricSettings `mapstructure:"sqlserver.lock.wait.rate"`
 	SqlserverLockWaitTimeAvg             MetricSettings `mapstructure:"sqlserver.lock.wait_time.avg"`
 	SqlserverPageBufferCacheHitRatio     MetricSettings `mapstructure:"sqlserver.page.buffer_cache.hit_ratio"`
@@ -48,6 +49,10 @@ type MetricsSettings struct {
 	SqlserverPageLifeExpectancy          MetricSettings `mapstructure:"sqlserver.page.life_expectancy"`
 	SqlserverPageOperationRate           MetricSettings `mapstructure:"sqlserver.page.operation.rate"`
 	SqlserverPageSplitRate               MetricSettings `mapstructure:"sqlserver.page.split.rate"`
+	SqlserverQueryElapsedTime            MetricSettings `mapstructure:"sqlserver.query.elapsed_time"`
+	SqlserverQueryExecutionCount         MetricSettings `mapstructure:"sqlserver.query.execution.count"`
+	SqlserverQueryLogicalReads           MetricSettings `mapstructure:"sqlserver.query.logical_reads"`
+	SqlserverQueryWorkerTime             MetricSettings `mapstructure:"sqlserver.query.worker_time"`
 	SqlserverTransactionRate             MetricSettings `mapstructure:"sqlserver.transaction.rate"`
 	SqlserverTransactionWriteRate        MetricSettings `mapstructure:"sqlserver.transaction.write.rate"`
 	SqlserverTransactionLogFlushDataRate MetricSettings `mapstructure:"sqlserver.transaction_log.flush.data.rate"`
@@ -57,6 +62,8 @@ type MetricsSettings struct {
 	SqlserverTransactionLogShrinkCount   MetricSettings `mapstructure:"sqlserver.transaction_log.shrink.count"`
 	SqlserverTransactionLogUsage         MetricSettings `mapstructure:"sqlserver.transaction_log.usage"`
 	SqlserverUserConnectionCount         MetricSettings `mapstructure:"sqlserver.user.connection.count"`
+	SqlserverWaitCount                   MetricSettings `mapstructure:"sqlserver.wait.count"`
+	SqlserverWaitTime                    MetricSettings `mapstructure:"sqlserver.wait.time"`
 }
 
 func DefaultMetricsSettings() MetricsSettings {
@@ -70,6 +77,9 @@ func DefaultMetricsSettings() MetricsSettings {
 		SqlserverBatchSQLRecompilationRate: MetricSettings{
 			Enabled: true,
 		},
+		SqlserverIndexFragmentation: MetricSettings{
+			Enabled: true,
+		},
 		SqlserverLockWaitRate: MetricSettings{
 			Enabled: true,
 		},
@@ -94,6 +104,18 @@ func DefaultMetricsSettings() MetricsSettings {
 		SqlserverPageSplitRate: MetricSettings{
 			Enabled: true,
 		},
+		SqlserverQueryElapsedTime: MetricSettings{
+			Enabled: true,
+		},
+		SqlserverQueryExecutionCount: MetricSettings{
+			Enabled: true,
+		},
+		SqlserverQueryLogicalReads: MetricSettings{
+			Enabled: true,
+		},
+		SqlserverQueryWorkerTime: MetricSettings{
+			Enabled: true,
+		},
 		SqlserverTransactionRate: MetricSettings{
 			Enabled: true,
 		},
@@ -121,6 +143,12 @@ func DefaultMetricsSettings() MetricsSettings {
 		SqlserverUserConnectionCount: MetricSettings{
 			Enabled: true,
 		},
+		SqlserverWaitCount: MetricSettings{
+			Enabled: true,
+		},
+		SqlserverWaitTime: MetricSettings{
+			Enabled: true,
+		},
 	}
 }
 
@@ -297,6 +325,58 @@ func newMetricSqlserverBatchSQLRecompilationRate(settings MetricSettings) metric
 	return m
 }
 
+type metricSqlserverIndexFragmentation struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills sqlserver.index.fragmentation metric with initial data.
+func (m *metricSqlserverIndexFragmentation) init() {
+	m.data.SetName("sqlserver.index.fragmentation")
+	m.data.SetDescription("Logical fragmentation of the leaf level of the index. Only available with a direct connection.")
+	m.data.SetUnit("%")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSqlserverIndexFragmentation) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, tableNameAttributeValue string, indexNameAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("table.name", tableNameAttributeValue)
+	dp.Attributes().PutStr("index.name", indexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSqlserverIndexFragmentation) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSqlserverIndexFragmentation) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSqlserverIndexFragmentation(settings MetricSettings) metricSqlserverIndexFragmentation {
+	m := metricSqlserverIndexFragmentation{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricSqlserverLockWaitRate struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -691,6 +771,222 @@ func newMetricSqlserverPageSplitRate(settings MetricSettings) metricSqlserverPag
 	return m
 }
 
+type metricSqlserverQueryElapsedTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills sqlserver.query.elapsed_time metric with initial data.
+func (m *metricSqlserverQueryElapsedTime) init() {
+	m.data.SetName("sqlserver.query.elapsed_time")
+	m.data.SetDescription("Total elapsed time of the executions of the query since its plan was cached. Only available with a direct connection.")
+	m.data.SetUnit("us")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSqlserverQueryElapsedTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, queryHashAttributeValue string, queryTextAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("query.hash", queryHashAttributeValue)
+	dp.Attributes().PutStr("query.text", queryTextAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSqlserverQueryElapsedTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSqlserverQueryElapsedTime) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSqlserverQueryElapsedTime(settings MetricSettings) metricSqlserverQueryElapsedTime {
+	m := metricSqlserverQueryElapsedTime{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSqlserverQueryExecutionCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills sqlserver.query.execution.count metric with initial data.
+func (m *metricSqlserverQueryExecutionCount) init() {
+	m.data.SetName("sqlserver.query.execution.count")
+	m.data.SetDescription("Number of executions of the query since its plan was cached. Only available with a direct connection.")
+	m.data.SetUnit("{executions}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSqlserverQueryExecutionCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, queryHashAttributeValue string, queryTextAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("query.hash", queryHashAttributeValue)
+	dp.Attributes().PutStr("query.text", queryTextAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSqlserverQueryExecutionCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSqlserverQueryExecutionCount) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSqlserverQueryExecutionCount(settings MetricSettings) metricSqlserverQueryExecutionCount {
+	m := metricSqlserverQueryExecutionCount{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSqlserverQueryLogicalReads struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills sqlserver.query.logical_reads metric with initial data.
+func (m *metricSqlserverQueryLogicalReads) init() {
+	m.data.SetName("sqlserver.query.logical_reads")
+	m.data.SetDescription("Total number of logical reads performed by the executions of the query since its plan was cached. Only available with a direct connection.")
+	m.data.SetUnit("{reads}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSqlserverQueryLogicalReads) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, queryHashAttributeValue string, queryTextAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("query.hash", queryHashAttributeValue)
+	dp.Attributes().PutStr("query.text", queryTextAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSqlserverQueryLogicalReads) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSqlserverQueryLogicalReads) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSqlserverQueryLogicalReads(settings MetricSettings) metricSqlserverQueryLogicalReads {
+	m := metricSqlserverQueryLogicalReads{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSqlserverQueryWorkerTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills sqlserver.query.worker_time metric with initial data.
+func (m *metricSqlserverQueryWorkerTime) init() {
+	m.data.SetName("sqlserver.query.worker_time")
+	m.data.SetDescription("Total CPU time consumed by the executions of the query since its plan was cached. Only available with a direct connection.")
+	m.data.SetUnit("us")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSqlserverQueryWorkerTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, queryHashAttributeValue string, queryTextAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("query.hash", queryHashAttributeValue)
+	dp.Attributes().PutStr("query.text", queryTextAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSqlserverQueryWorkerTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSqlserverQueryWorkerTime) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSqlserverQueryWorkerTime(settings MetricSettings) metricSqlserverQueryWorkerTime {
+	m := metricSqlserverQueryWorkerTime{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricSqlserverTransactionRate struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -1136,6 +1432,112 @@ func newMetricSqlserverUserConnectionCount(settings MetricSettings) metricSqlser
 	return m
 }
 
+type metricSqlserverWaitCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills sqlserver.wait.count metric with initial data.
+func (m *metricSqlserverWaitCount) init() {
+	m.data.SetName("sqlserver.wait.count")
+	m.data.SetDescription("Number of waits of the type since the server started. Only available with a direct connection.")
+	m.data.SetUnit("{waits}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSqlserverWaitCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, waitTypeAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("wait.type", waitTypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSqlserverWaitCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSqlserverWaitCount) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSqlserverWaitCount(settings MetricSettings) metricSqlserverWaitCount {
+	m := metricSqlserverWaitCount{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSqlserverWaitTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills sqlserver.wait.time metric with initial data.
+func (m *metricSqlserverWaitTime) init() {
+	m.data.SetName("sqlserver.wait.time")
+	m.data.SetDescription("Total time spent waiting on the wait type since the server started. Only available with a direct connection.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSqlserverWaitTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, waitTypeAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("wait.type", waitTypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSqlserverWaitTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSqlserverWaitTime) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSqlserverWaitTime(settings MetricSettings) metricSqlserverWaitTime {
+	m := metricSqlserverWaitTime{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user settings.
 type MetricsBuilder struct {
@@ -1147,6 +1549,7 @@ type MetricsBuilder struct {
 	metricSqlserverBatchRequestRate            metricSqlserverBatchRequestRate
 	metricSqlserverBatchSQLCompilationRate     metricSqlserverBatchSQLCompilationRate
 	metricSqlserverBatchSQLRecompilationRate   metricSqlserverBatchSQLRecompilationRate
+	metricSqlserverIndexFragmentation          metricSqlserverIndexFragmentation
 	metricSqlserverLockWaitRate                metricSqlserverLockWaitRate
 	metricSqlserverLockWaitTimeAvg             metricSqlserverLockWaitTimeAvg
 	metricSqlserverPageBufferCacheHitRatio     metricSqlserverPageBufferCacheHitRatio
@@ -1155,6 +1558,10 @@ type MetricsBuilder struct {
 	metricSqlserverPageLifeExpectancy          metricSqlserverPageLifeExpectancy
 	metricSqlserverPageOperationRate           metricSqlserverPageOperationRate
 	metricSqlserverPageSplitRate               metricSqlserverPageSplitRate
+	metricSqlserverQueryElapsedTime            metricSqlserverQueryElapsedTime
+	metricSqlserverQueryExecutionCount         metricSqlserverQueryExecutionCount
+	metricSqlserverQueryLogicalReads           metricSqlserverQueryLogicalReads
+	metricSqlserverQueryWorkerTime             metricSqlserverQueryWorkerTime
 	metricSqlserverTransactionRate             metricSqlserverTransactionRate
 	metricSqlserverTransactionWriteRate        metricSqlserverTransactionWriteRate
 	metricSqlserverTransactionLogFlushDataRate metricSqlserverTransactionLogFlushDataRate
@@ -1164,6 +1571,8 @@ type MetricsBuilder struct {
 	metricSqlserverTransactionLogShrinkCount   metricSqlserverTransactionLogShrinkCount
 	metricSqlserverTransactionLogUsage         metricSqlserverTransactionLogUsage
 	metricSqlserverUserConnectionCount         metricSqlserverUserConnectionCount
+	metricSqlserverWaitCount                   metricSqlserverWaitCount
+	metricSqlserverWaitTime                    metricSqlserverWaitTime
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -1184,6 +1593,7 @@ func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo,
 		metricSqlserverBatchRequestRate:            newMetricSqlserverBatchRequestRate(settings.SqlserverBatchRequestRate),
 		metricSqlserverBatchSQLCompilationRate:     newMetricSqlserverBatchSQLCompilationRate(settings.SqlserverBatchSQLCompilationRate),
 		metricSqlserverBatchSQLRecompilationRate:   newMetricSqlserverBatchSQLRecompilationRate(settings.SqlserverBatchSQLRecompilationRate),
+		metricSqlserverIndexFragmentation:          newMetricSqlserverIndexFragmentation(settings.SqlserverIndexFragmentation),
 		metricSqlserverLockWaitRate:                newMetricSqlserverLockWaitRate(settings.SqlserverLockWaitRate),
 		metricSqlserverLockWaitTimeAvg:             newMetricSqlserverLockWaitTimeAvg(settings.SqlserverLockWaitTimeAvg),
 		metricSqlserverPageBufferCacheHitRatio:     newMetricSqlserverPageBufferCacheHitRatio(settings.SqlserverPageBufferCacheHitRatio),
@@ -1192,6 +1602,10 @@ func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo,
 		metricSqlserverPageLifeExpectancy:          newMetricSqlserverPageLifeExpectancy(settings.SqlserverPageLifeExpectancy),
 		metricSqlserverPageOperationRate:           newMetricSqlserverPageOperationRate(settings.SqlserverPageOperationRate),
 		metricSqlserverPageSplitRate:               newMetricSqlserverPageSplitRate(settings.SqlserverPageSplitRate),
+		metricSqlserverQueryElapsedTime:            newMetricSqlserverQueryElapsedTime(settings.SqlserverQueryElapsedTime),
+		metricSqlserverQueryExecutionCount:         newMetricSqlserverQueryExecutionCount(settings.SqlserverQueryExecutionCount),
+		metricSqlserverQueryLogicalReads:           newMetricSqlserverQueryLogicalReads(settings.SqlserverQueryLogicalReads),
+		metricSqlserverQueryWorkerTime:             newMetricSqlserverQueryWorkerTime(settings.SqlserverQueryWorkerTime),
 		metricSqlserverTransactionRate:             newMetricSqlserverTransactionRate(settings.SqlserverTransactionRate),
 		metricSqlserverTransactionWriteRate:        newMetricSqlserverTransactionWriteRate(settings.SqlserverTransactionWriteRate),
 		metricSqlserverTransactionLogFlushDataRate: newMetricSqlserverTransactionLogFlushDataRate(settings.SqlserverTransactionLogFlushDataRate),
@@ -1201,6 +1615,8 @@ func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo,
 		metricSqlserverTransactionLogShrinkCount:   newMetricSqlserverTransactionLogShrinkCount(settings.SqlserverTransactionLogShrinkCount),
 		metricSqlserverTransactionLogUsage:         newMetricSqlserverTransactionLogUsage(settings.SqlserverTransactionLogUsage),
 		metricSqlserverUserConnectionCount:         newMetricSqlserverUserConnectionCount(settings.SqlserverUserConnectionCount),
+		metricSqlserverWaitCount:                   newMetricSqlserverWaitCount(settings.SqlserverWaitCount),
+		metricSqlserverWaitTime:                    newMetricSqlserverWaitTime(settings.SqlserverWaitTime),
 	}
 	for _, op := range options {
 		op(mb)
@@ -1263,6 +1679,7 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricSqlserverBatchRequestRate.emit(ils.Metrics())
 	mb.metricSqlserverBatchSQLCompilationRate.emit(ils.Metrics())
 	mb.metricSqlserverBatchSQLRecompilationRate.emit(ils.Metrics())
+	mb.metricSqlserverIndexFragmentation.emit(ils.Metrics())
 	mb.metricSqlserverLockWaitRate.emit(ils.Metrics())
 	mb.metricSqlserverLockWaitTimeAvg.emit(ils.Metrics())
 	mb.metricSqlserverPageBufferCacheHitRatio.emit(ils.Metrics())
@@ -1271,6 +1688,10 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricSqlserverPageLifeExpectancy.emit(ils.Metrics())
 	mb.metricSqlserverPageOperationRate.emit(ils.Metrics())
 	mb.metricSqlserverPageSplitRate.emit(ils.Metrics())
+	mb.metricSqlserverQueryElapsedTime.emit(ils.Metrics())
+	mb.metricSqlserverQueryExecutionCount.emit(ils.Metrics())
+	mb.metricSqlserverQueryLogicalReads.emit(ils.Metrics())
+	mb.metricSqlserverQueryWorkerTime.emit(ils.Metrics())
 	mb.metricSqlserverTransactionRate.emit(ils.Metrics())
 	mb.metricSqlserverTransactionWriteRate.emit(ils.Metrics())
 	mb.metricSqlserverTransactionLogFlushDataRate.emit(ils.Metrics())
@@ -1280,6 +1701,8 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricSqlserverTransactionLogShrinkCount.emit(ils.Metrics())
 	mb.metricSqlserverTransactionLogUsage.emit(ils.Metrics())
 	mb.metricSqlserverUserConnectionCount.emit(ils.Metrics())
+	mb.metricSqlserverWaitCount.emit(ils.Metrics())
+	mb.metricSqlserverWaitTime.emit(ils.Metrics())
 	for _, op := range rmo {
 		op(rm)
 	}
@@ -1314,6 +1737,11 @@ func (mb *MetricsBuilder) RecordSqlserverBatchSQLRecompilationRateDataPoint(ts p
 	mb.metricSqlserverBatchSQLRecompilationRate.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordSqlserverIndexFragmentationDataPoint adds a data point to sqlserver.index.fragmentation metric.
+func (mb *MetricsBuilder) RecordSqlserverIndexFragmentationDataPoint(ts pcommon.Timestamp, val float64, tableNameAttributeValue string, indexNameAttributeValue string) {
+	mb.metricSqlserverIndexFragmentation.recordDataPoint(mb.startTime, ts, val, tableNameAttributeValue, indexNameAttributeValue)
+}
+
 // RecordSqlserverLockWaitRateDataPoint adds a data point to sqlserver.lock.wait.rate metric.
 func (mb *MetricsBuilder) RecordSqlserverLockWaitRateDataPoint(ts pcommon.Timestamp, val float64) {
 	mb.metricSqlserverLockWaitRate.recordDataPoint(mb.startTime, ts, val)
@@ -1354,6 +1782,26 @@ func (mb *MetricsBuilder) RecordSqlserverPageSplitRateDataPoint(ts pcommon.Times
 	mb.metricSqlserverPageSplitRate.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordSqlserverQueryElapsedTimeDataPoint adds a data point to sqlserver.query.elapsed_time metric.
+func (mb *MetricsBuilder) RecordSqlserverQueryElapsedTimeDataPoint(ts pcommon.Timestamp, val int64, queryHashAttributeValue string, queryTextAttributeValue string) {
+	mb.metricSqlserverQueryElapsedTime.recordDataPoint(mb.startTime, ts, val, queryHashAttributeValue, queryTextAttributeValue)
+}
+
+// RecordSqlserverQueryExecutionCountDataPoint adds a data point to sqlserver.query.execution.count metric.
+func (mb *MetricsBuilder) RecordSqlserverQueryExecutionCountDataPoint(ts pcommon.Timestamp, val int64, queryHashAttributeValue string, queryTextAttributeValue string) {
+	mb.metricSqlserverQueryExecutionCount.recordDataPoint(mb.startTime, ts, val, queryHashAttributeValue, queryTextAttributeValue)
+}
+
+// RecordSqlserverQueryLogicalReadsDataPoint adds a data point to sqlserver.query.logical_reads metric.
+func (mb *MetricsBuilder) RecordSqlserverQueryLogicalReadsDataPoint(ts pcommon.Timestamp, val int64, queryHashAttributeValue string, queryTextAttributeValue string) {
+	mb.metricSqlserverQueryLogicalReads.recordDataPoint(mb.startTime, ts, val, queryHashAttributeValue, queryTextAttributeValue)
+}
+
+// RecordSqlserverQueryWorkerTimeDataPoint adds a data point to sqlserver.query.worker_time metric.
+func (mb *MetricsBuilder) RecordSqlserverQueryWorkerTimeDataPoint(ts pcommon.Timestamp, val int64, queryHashAttributeValue string, queryTextAttributeValue string) {
+	mb.metricSqlserverQueryWorkerTime.recordDataPoint(mb.startTime, ts, val, queryHashAttributeValue, queryTextAttributeValue)
+}
+
 // RecordSqlserverTransactionRateDataPoint adds a data point to sqlserver.transaction.rate metric.
 func (mb *MetricsBuilder) RecordSqlserverTransactionRateDataPoint(ts pcommon.Timestamp, val float64) {
 	mb.metricSqlserverTransactionRate.recordDataPoint(mb.startTime, ts, val)
@@ -1399,6 +1847,16 @@ func (mb *MetricsBuilder) RecordSqlserverUserConnectionCountDataPoint(ts pcommon
 	mb.metricSqlserverUserConnectionCount.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordSqlserverWaitCountDataPoint adds a data point to sqlserver.wait.count metric.
+func (mb *MetricsBuilder) RecordSqlserverWaitCountDataPoint(ts pcommon.Timestamp, val int64, waitTypeAttributeValue string) {
+	mb.metricSqlserverWaitCount.recordDataPoint(mb.startTime, ts, val, waitTypeAttributeValue)
+}
+
+// RecordSqlserverWaitTimeDataPoint adds a data point to sqlserver.wait.time metric.
+func (mb *MetricsBuilder) RecordSqlserverWaitTimeDataPoint(ts pcommon.Timestamp, val int64, waitTypeAttributeValue string) {
+	mb.metricSqlserverWaitTime.recordDataPoint(mb.startTime, ts, val, waitTypeAttributeValue)
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
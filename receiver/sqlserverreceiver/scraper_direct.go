@@ -0,0 +1,106 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlserverreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlserverreceiver"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlserverreceiver/internal/metadata"
+)
+
+// directScraper scrapes the dynamic management views of a SQL Server instance through
+// a direct connection, which works on every platform.
+type directScraper struct {
+	logger         *zap.Logger
+	config         *Config
+	newClient      func(connectionString string) (client, error)
+	client         client
+	metricsBuilder *metadata.MetricsBuilder
+}
+
+func newDirectScraper(params component.ReceiverCreateSettings, cfg *Config) *directScraper {
+	return &directScraper{
+		logger:         params.Logger,
+		config:         cfg,
+		newClient:      newDBClient,
+		metricsBuilder: metadata.NewMetricsBuilder(cfg.Metrics, params.BuildInfo),
+	}
+}
+
+// start opens the connection pool, the connection itself is established by the first scrape.
+func (s *directScraper) start(context.Context, component.Host) error {
+	c, err := s.newClient(s.config.connectionString())
+	if err != nil {
+		return err
+	}
+	s.client = c
+	return nil
+}
+
+func (s *directScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	var errs error
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	if s.config.TopQueryCount > 0 {
+		queries, err := s.client.topQueries(ctx, s.config.TopQueryCount)
+		if err != nil {
+			errs = multierr.Append(errs, err)
+		}
+		for _, q := range queries {
+			s.metricsBuilder.RecordSqlserverQueryExecutionCountDataPoint(now, q.executionCount, q.queryHash, q.queryText)
+			s.metricsBuilder.RecordSqlserverQueryWorkerTimeDataPoint(now, q.totalWorkerTime, q.queryHash, q.queryText)
+			s.metricsBuilder.RecordSqlserverQueryElapsedTimeDataPoint(now, q.totalElapsedTime, q.queryHash, q.queryText)
+			s.metricsBuilder.RecordSqlserverQueryLogicalReadsDataPoint(now, q.totalLogicalReads, q.queryHash, q.queryText)
+		}
+	}
+
+	waits, err := s.client.waitStats(ctx)
+	if err != nil {
+		errs = multierr.Append(errs, err)
+	}
+	for _, w := range waits {
+		s.metricsBuilder.RecordSqlserverWaitCountDataPoint(now, w.waitingTasksCount, w.waitType)
+		s.metricsBuilder.RecordSqlserverWaitTimeDataPoint(now, w.waitTimeMs, w.waitType)
+	}
+	// the query and wait stats are server-wide
+	s.metricsBuilder.EmitForResource()
+
+	indexes, err := s.client.indexFragmentation(ctx)
+	if err != nil {
+		errs = multierr.Append(errs, err)
+	}
+	if len(indexes) > 0 {
+		for _, i := range indexes {
+			s.metricsBuilder.RecordSqlserverIndexFragmentationDataPoint(now, i.fragmentationPercent, i.tableName, i.indexName)
+		}
+		s.metricsBuilder.EmitForResource(metadata.WithSqlserverDatabaseName(indexes[0].databaseName))
+	}
+
+	return s.metricsBuilder.Emit(), errs
+}
+
+func (s *directScraper) shutdown(context.Context) error {
+	if s.client == nil {
+		return nil
+	}
+	return s.client.Close()
+}
@@ -0,0 +1,112 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlserverreceiver
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/scrapertest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/scrapertest/golden"
+)
+
+type fakeClient struct {
+	queries      []queryStats
+	waits        []waitStats
+	indexes      []indexFragmentation
+	err          error
+	queriesCount uint
+	closed       bool
+}
+
+func (c *fakeClient) topQueries(_ context.Context, count uint) ([]queryStats, error) {
+	c.queriesCount = count
+	return c.queries, c.err
+}
+
+func (c *fakeClient) waitStats(context.Context) ([]waitStats, error) {
+	return c.waits, nil
+}
+
+func (c *fakeClient) indexFragmentation(context.Context) ([]indexFragmentation, error) {
+	return c.indexes, nil
+}
+
+func (c *fakeClient) Close() error {
+	c.closed = true
+	return nil
+}
+
+func newTestDirectScraper(c *fakeClient) *directScraper {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Server = "localhost"
+	s := newDirectScraper(componenttest.NewNopReceiverCreateSettings(), cfg)
+	s.newClient = func(connectionString string) (client, error) {
+		return c, nil
+	}
+	return s
+}
+
+func TestDirectScraper(t *testing.T) {
+	c := &fakeClient{
+		queries: []queryStats{
+			{queryHash: "0A1B2C3D4E5F6071", queryText: "SELECT * FROM orders WHERE id = @id", executionCount: 120, totalWorkerTime: 53000, totalElapsedTime: 61000, totalLogicalReads: 960},
+		},
+		waits: []waitStats{
+			{waitType: "PAGEIOLATCH_SH", waitingTasksCount: 42, waitTimeMs: 1300},
+			{waitType: "LCK_M_X", waitingTasksCount: 3, waitTimeMs: 250},
+		},
+		indexes: []indexFragmentation{
+			{databaseName: "orders", tableName: "dbo.orders", indexName: "PK_orders", fragmentationPercent: 37.5},
+		},
+	}
+	s := newTestDirectScraper(c)
+	require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+
+	actualMetrics, err := s.scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint(10), c.queriesCount)
+
+	expectedMetrics, err := golden.ReadMetrics(filepath.Join("testdata", "golden_direct_scrape.json"))
+	require.NoError(t, err)
+	require.NoError(t, scrapertest.CompareMetrics(expectedMetrics, actualMetrics))
+
+	require.NoError(t, s.shutdown(context.Background()))
+	assert.True(t, c.closed)
+}
+
+func TestDirectScraper_PartialError(t *testing.T) {
+	c := &fakeClient{
+		err:   errors.New("permission denied"),
+		waits: []waitStats{{waitType: "LCK_M_X", waitingTasksCount: 3, waitTimeMs: 250}},
+	}
+	s := newTestDirectScraper(c)
+	require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+
+	metrics, err := s.scrape(context.Background())
+	assert.EqualError(t, err, "permission denied")
+	assert.Equal(t, 2, metrics.MetricCount())
+}
+
+func TestDirectScraper_ShutdownWithoutStart(t *testing.T) {
+	s := newTestDirectScraper(&fakeClient{})
+	require.NoError(t, s.shutdown(context.Background()))
+}
@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sshcheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sshcheckreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sshcheckreceiver/internal/metadata"
+)
+
+// Predefined error responses for configuration validation failures
+var (
+	errInvalidEndpoint = errors.New(`"endpoint" must be in the form of <hostname>:<port>`)
+	errMissingUsername = errors.New(`"username" must be specified`)
+	errMissingAuth     = errors.New(`either "password" or "key_file" must be specified`)
+	errMissingHostKey  = errors.New(`either "known_hosts" or "ignore_host_key" must be specified`)
+)
+
+const defaultEndpoint = "localhost:22"
+
+// SSHClientSettings defines how the receiver connects and authenticates to the SSH server.
+type SSHClientSettings struct {
+	// Endpoint is the SSH server to check, as <hostname>:<port>.
+	Endpoint string `mapstructure:"endpoint"`
+	// Timeout bounds establishing the connection and authenticating, and separately the SFTP check.
+	Timeout time.Duration `mapstructure:"timeout"`
+	// Username is the user to authenticate as.
+	Username string `mapstructure:"username"`
+	// Password is used for password authentication.
+	Password string `mapstructure:"password"`
+	// KeyFile is the path of a PEM encoded private key used for public key authentication.
+	KeyFile string `mapstructure:"key_file"`
+	// KnownHosts is the path of a known_hosts file the server host key is verified against.
+	KnownHosts string `mapstructure:"known_hosts"`
+	// IgnoreHostKey disables the verification of the server host key.
+	IgnoreHostKey bool `mapstructure:"ignore_host_key"`
+}
+
+// Config defines the configuration for the various elements of the receiver agent.
+type Config struct {
+	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
+	SSHClientSettings                       `mapstructure:",squash"`
+	// CheckSFTP additionally lists the user's directory over SFTP after authenticating.
+	CheckSFTP bool                     `mapstructure:"check_sftp"`
+	Metrics   metadata.MetricsSettings `mapstructure:"metrics"`
+}
+
+// Validate validates the configuration by checking for missing or invalid fields
+func (cfg *Config) Validate() error {
+	var err error
+
+	if _, _, splitErr := net.SplitHostPort(cfg.Endpoint); splitErr != nil {
+		wrappedErr := fmt.Errorf("%s: %w", errInvalidEndpoint.Error(), splitErr)
+		err = multierr.Append(err, wrappedErr)
+	}
+	if cfg.Username == "" {
+		err = multierr.Append(err, errMissingUsername)
+	}
+	if cfg.Password == "" && cfg.KeyFile == "" {
+		err = multierr.Append(err, errMissingAuth)
+	}
+	if cfg.KnownHosts == "" && !cfg.IgnoreHostKey {
+		err = multierr.Append(err, errMissingHostKey)
+	}
+
+	return err
+}
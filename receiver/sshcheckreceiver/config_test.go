@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sshcheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sshcheckreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/multierr"
+)
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		cfg         *Config
+		expectedErr error
+	}{
+		{
+			desc: "invalid endpoint",
+			cfg: &Config{
+				SSHClientSettings: SSHClientSettings{
+					Endpoint:      "localhost",
+					Username:      "otelu",
+					Password:      "otelp",
+					IgnoreHostKey: true,
+				},
+			},
+			expectedErr: multierr.Combine(
+				fmt.Errorf("%s: %w", errInvalidEndpoint, errors.New("address localhost: missing port in address")),
+			),
+		},
+		{
+			desc: "missing credentials",
+			cfg: &Config{
+				SSHClientSettings: SSHClientSettings{
+					Endpoint:   defaultEndpoint,
+					KnownHosts: "known_hosts",
+				},
+			},
+			expectedErr: multierr.Combine(
+				errMissingUsername,
+				errMissingAuth,
+			),
+		},
+		{
+			desc: "missing host key verification",
+			cfg: &Config{
+				SSHClientSettings: SSHClientSettings{
+					Endpoint: defaultEndpoint,
+					Username: "otelu",
+					KeyFile:  "id_ed25519",
+				},
+			},
+			expectedErr: errMissingHostKey,
+		},
+		{
+			desc: "valid config",
+			cfg: &Config{
+				SSHClientSettings: SSHClientSettings{
+					Endpoint:   defaultEndpoint,
+					Username:   "otelu",
+					Password:   "otelp",
+					KnownHosts: "known_hosts",
+				},
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			actualErr := tc.cfg.Validate()
+			if tc.expectedErr != nil {
+				require.EqualError(t, actualErr, tc.expectedErr.Error())
+			} else {
+				require.NoError(t, actualErr)
+			}
+		})
+	}
+}
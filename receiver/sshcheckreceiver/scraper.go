@@ -0,0 +1,159 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sshcheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sshcheckreceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"time"
+
+	"github.com/pkg/sftp"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sshcheckreceiver/internal/metadata"
+)
+
+var errClientNotInit = errors.New("client not initialized")
+
+type sshcheckScraper struct {
+	clientConfig *ssh.ClientConfig
+	cfg          *Config
+	settings     component.TelemetrySettings
+	mb           *metadata.MetricsBuilder
+}
+
+// start starts the scraper by building the SSH client configuration, so that an
+// unreadable key or known_hosts file is reported when the receiver starts
+func (s *sshcheckScraper) start(ctx context.Context, host component.Host) error {
+	var auth []ssh.AuthMethod
+	if s.cfg.KeyFile != "" {
+		key, err := os.ReadFile(s.cfg.KeyFile)
+		if err != nil {
+			return fmt.Errorf("failed to read key file: %w", err)
+		}
+		signer, err := ssh.ParsePrivateKey(key)
+		if err != nil {
+			return fmt.Errorf("failed to parse key file: %w", err)
+		}
+		auth = append(auth, ssh.PublicKeys(signer))
+	}
+	if s.cfg.Password != "" {
+		auth = append(auth, ssh.Password(s.cfg.Password))
+	}
+
+	hostKeyCallback := ssh.InsecureIgnoreHostKey() //nolint:gosec // explicitly requested by ignore_host_key
+	if !s.cfg.IgnoreHostKey {
+		var err error
+		if hostKeyCallback, err = knownhosts.New(s.cfg.KnownHosts); err != nil {
+			return fmt.Errorf("failed to read known_hosts file: %w", err)
+		}
+	}
+
+	s.clientConfig = &ssh.ClientConfig{
+		User:            s.cfg.Username,
+		Auth:            auth,
+		HostKeyCallback: hostKeyCallback,
+		Timeout:         s.cfg.Timeout,
+	}
+	return nil
+}
+
+// scrape connects and authenticates to the endpoint, optionally lists the user's
+// directory over SFTP, and produces metrics based on the outcome
+func (s *sshcheckScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	if s.clientConfig == nil {
+		return pmetric.NewMetrics(), errClientNotInit
+	}
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	start := time.Now()
+	client, err := s.dial(ctx)
+	s.mb.RecordSshcheckDurationDataPoint(now, time.Since(start).Milliseconds(), s.cfg.Endpoint)
+	if err != nil {
+		s.mb.RecordSshcheckStatusDataPoint(now, int64(0), s.cfg.Endpoint)
+		s.mb.RecordSshcheckErrorDataPoint(now, int64(1), s.cfg.Endpoint, err.Error())
+		if s.cfg.CheckSFTP {
+			s.mb.RecordSshcheckSftpStatusDataPoint(now, int64(0), s.cfg.Endpoint)
+		}
+		return s.mb.Emit(), nil
+	}
+	defer client.Close()
+	s.mb.RecordSshcheckStatusDataPoint(now, int64(1), s.cfg.Endpoint)
+
+	if s.cfg.CheckSFTP {
+		start = time.Now()
+		err = s.listSFTP(client)
+		s.mb.RecordSshcheckSftpDurationDataPoint(now, time.Since(start).Milliseconds(), s.cfg.Endpoint)
+		if err != nil {
+			s.mb.RecordSshcheckSftpStatusDataPoint(now, int64(0), s.cfg.Endpoint)
+			s.mb.RecordSshcheckSftpErrorDataPoint(now, int64(1), s.cfg.Endpoint, err.Error())
+		} else {
+			s.mb.RecordSshcheckSftpStatusDataPoint(now, int64(1), s.cfg.Endpoint)
+		}
+	}
+
+	return s.mb.Emit(), nil
+}
+
+// dial connects and authenticates to the endpoint within the configured timeout
+func (s *sshcheckScraper) dial(ctx context.Context) (*ssh.Client, error) {
+	dialer := net.Dialer{Timeout: s.cfg.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", s.cfg.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if s.cfg.Timeout > 0 {
+		_ = conn.SetDeadline(time.Now().Add(s.cfg.Timeout))
+	}
+
+	c, chans, reqs, err := ssh.NewClientConn(conn, s.cfg.Endpoint, s.clientConfig)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	if s.cfg.Timeout > 0 {
+		// the SFTP check gets a timeout of its own
+		_ = conn.SetDeadline(time.Now().Add(s.cfg.Timeout))
+	}
+	return ssh.NewClient(c, chans, reqs), nil
+}
+
+// listSFTP opens an SFTP session on client and lists the user's directory
+func (s *sshcheckScraper) listSFTP(client *ssh.Client) error {
+	sftpClient, err := sftp.NewClient(client)
+	if err != nil {
+		return err
+	}
+	defer sftpClient.Close()
+
+	_, err = sftpClient.ReadDir(".")
+	return err
+}
+
+func newScraper(conf *Config, settings component.ReceiverCreateSettings) *sshcheckScraper {
+	return &sshcheckScraper{
+		cfg:      conf,
+		settings: settings.TelemetrySettings,
+		mb:       metadata.NewMetricsBuilder(conf.Metrics, settings.BuildInfo),
+	}
+}
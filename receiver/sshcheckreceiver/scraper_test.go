@@ -0,0 +1,272 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sshcheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sshcheckreceiver"
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"io"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/sftp"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/scrapertest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/scrapertest/golden"
+)
+
+const (
+	testUsername = "otelu"
+	testPassword = "otelp"
+)
+
+// newMockServer starts an SSH server accepting the test credentials, which serves the
+// SFTP subsystem from the working directory when sftpEnabled is true
+func newMockServer(t *testing.T, sftpEnabled bool) string {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	signer, err := ssh.NewSignerFromKey(key)
+	require.NoError(t, err)
+
+	config := &ssh.ServerConfig{
+		PasswordCallback: func(c ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+			if c.User() == testUsername && string(password) == testPassword {
+				return nil, nil
+			}
+			return nil, errors.New("invalid credentials")
+		},
+	}
+	config.AddHostKey(signer)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serveConn(conn, config, sftpEnabled)
+		}
+	}()
+	return listener.Addr().String()
+}
+
+func serveConn(conn net.Conn, config *ssh.ServerConfig, sftpEnabled bool) {
+	defer conn.Close()
+	_, chans, reqs, err := ssh.NewServerConn(conn, config)
+	if err != nil {
+		return
+	}
+	go ssh.DiscardRequests(reqs)
+
+	for newChannel := range chans {
+		if newChannel.ChannelType() != "session" {
+			_ = newChannel.Reject(ssh.UnknownChannelType, "unknown channel type")
+			continue
+		}
+		channel, requests, err := newChannel.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			for req := range requests {
+				ok := sftpEnabled && req.Type == "subsystem" && len(req.Payload) > 4 && string(req.Payload[4:]) == "sftp"
+				_ = req.Reply(ok, nil)
+				if !ok {
+					continue
+				}
+				server, err := sftp.NewServer(channel)
+				if err != nil {
+					return
+				}
+				if err := server.Serve(); errors.Is(err, io.EOF) {
+					_ = server.Close()
+				}
+			}
+		}()
+	}
+}
+
+func TestScraperStart(t *testing.T) {
+	testcases := []struct {
+		desc        string
+		cfg         SSHClientSettings
+		expectError bool
+	}{
+		{
+			desc: "Missing key file",
+			cfg: SSHClientSettings{
+				Endpoint:      defaultEndpoint,
+				Username:      testUsername,
+				KeyFile:       "/non/existent",
+				IgnoreHostKey: true,
+			},
+			expectError: true,
+		},
+		{
+			desc: "Invalid key file",
+			cfg: SSHClientSettings{
+				Endpoint:      defaultEndpoint,
+				Username:      testUsername,
+				KeyFile:       "scraper.go",
+				IgnoreHostKey: true,
+			},
+			expectError: true,
+		},
+		{
+			desc: "Missing known_hosts file",
+			cfg: SSHClientSettings{
+				Endpoint:   defaultEndpoint,
+				Username:   testUsername,
+				Password:   testPassword,
+				KnownHosts: "/non/existent",
+			},
+			expectError: true,
+		},
+		{
+			desc: "Valid Config",
+			cfg: SSHClientSettings{
+				Endpoint:      defaultEndpoint,
+				Username:      testUsername,
+				Password:      testPassword,
+				IgnoreHostKey: true,
+			},
+			expectError: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.desc, func(t *testing.T) {
+			scraper := &sshcheckScraper{
+				cfg:      &Config{SSHClientSettings: tc.cfg},
+				settings: componenttest.NewNopTelemetrySettings(),
+			}
+			err := scraper.start(context.Background(), componenttest.NewNopHost())
+			if tc.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestScraperScrape(t *testing.T) {
+	testCases := []struct {
+		desc           string
+		golden         string
+		password       string
+		endpoint       string
+		checkSFTP      bool
+		sftpEnabled    bool
+		compareOptions []scrapertest.CompareOption
+	}{
+		{
+			desc:     "Successful Collection",
+			golden:   "metrics_golden.json",
+			password: testPassword,
+			compareOptions: []scrapertest.CompareOption{
+				scrapertest.IgnoreMetricAttributeValue("ssh.endpoint"),
+				scrapertest.IgnoreMetricValues("sshcheck.duration"),
+			},
+		},
+		{
+			desc:        "Successful Collection with SFTP",
+			golden:      "metrics_golden_sftp.json",
+			password:    testPassword,
+			checkSFTP:   true,
+			sftpEnabled: true,
+			compareOptions: []scrapertest.CompareOption{
+				scrapertest.IgnoreMetricAttributeValue("ssh.endpoint"),
+				scrapertest.IgnoreMetricValues("sshcheck.duration", "sshcheck.sftp_duration"),
+			},
+		},
+		{
+			desc:      "SFTP subsystem not available",
+			golden:    "sftp_unavailable.json",
+			password:  testPassword,
+			checkSFTP: true,
+			compareOptions: []scrapertest.CompareOption{
+				scrapertest.IgnoreMetricAttributeValue("ssh.endpoint"),
+				scrapertest.IgnoreMetricAttributeValue("error.message"),
+				scrapertest.IgnoreMetricValues("sshcheck.duration", "sshcheck.sftp_duration"),
+			},
+		},
+		{
+			desc:     "Invalid credentials",
+			golden:   "invalid_credentials.json",
+			password: "wrong",
+			compareOptions: []scrapertest.CompareOption{
+				scrapertest.IgnoreMetricAttributeValue("ssh.endpoint"),
+				scrapertest.IgnoreMetricAttributeValue("error.message"),
+				scrapertest.IgnoreMetricValues("sshcheck.duration"),
+			},
+		},
+		{
+			desc:      "Invalid endpoint",
+			golden:    "invalid_endpoint.json",
+			password:  testPassword,
+			endpoint:  "invalid-endpoint:22",
+			checkSFTP: true,
+			compareOptions: []scrapertest.CompareOption{
+				scrapertest.IgnoreMetricAttributeValue("error.message"),
+				scrapertest.IgnoreMetricValues("sshcheck.duration"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			if len(tc.endpoint) > 0 {
+				cfg.Endpoint = tc.endpoint
+			} else {
+				cfg.Endpoint = newMockServer(t, tc.sftpEnabled)
+			}
+			cfg.Username = testUsername
+			cfg.Password = tc.password
+			cfg.IgnoreHostKey = true
+			cfg.CheckSFTP = tc.checkSFTP
+
+			scraper := newScraper(cfg, componenttest.NewNopReceiverCreateSettings())
+			require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+			actualMetrics, err := scraper.scrape(context.Background())
+			require.NoError(t, err)
+
+			expectedMetrics, err := golden.ReadMetrics(filepath.Join("testdata", "expected_metrics", tc.golden))
+			require.NoError(t, err)
+
+			require.NoError(t, scrapertest.CompareMetrics(expectedMetrics, actualMetrics, tc.compareOptions...))
+		})
+	}
+}
+
+func TestNilClient(t *testing.T) {
+	scraper := newScraper(createDefaultConfig().(*Config), componenttest.NewNopReceiverCreateSettings())
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.EqualError(t, err, errClientNotInit.Error())
+	require.NoError(t, scrapertest.CompareMetrics(pmetric.NewMetrics(), actualMetrics))
+}
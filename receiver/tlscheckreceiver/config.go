@@ -0,0 +1,78 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlscheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/tlscheckreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/tlscheckreceiver/internal/metadata"
+)
+
+// Predefined error responses for configuration validation failures
+var (
+	errMissingTargets  = errors.New(`at least one target must be specified`)
+	errInvalidEndpoint = errors.New(`"endpoint" must be in the form of <hostname>:<port>`)
+	errInvalidTarget   = errors.New(`exactly one of "endpoint" or "file_path" must be specified`)
+)
+
+// TargetConfig is a certificate to check, either served by a TLS endpoint or stored in a local PEM file.
+type TargetConfig struct {
+	// Endpoint is the TLS server to connect to, as <hostname>:<port>.
+	Endpoint string `mapstructure:"endpoint"`
+	// FilePath is the path of a PEM file holding the certificate, optionally followed by its intermediates.
+	FilePath string `mapstructure:"file_path"`
+	// ServerName is sent as SNI and verified against the certificate. It defaults to the host of Endpoint.
+	ServerName string `mapstructure:"server_name"`
+	// CAFile is the path of a PEM file of the roots the chain is verified against, instead of the system roots.
+	CAFile string `mapstructure:"ca_file"`
+}
+
+// Config defines the configuration for the various elements of the receiver agent.
+type Config struct {
+	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
+	Targets                                 []TargetConfig `mapstructure:"targets"`
+	// Timeout bounds the connection and handshake to each endpoint.
+	Timeout time.Duration            `mapstructure:"timeout"`
+	Metrics metadata.MetricsSettings `mapstructure:"metrics"`
+}
+
+// Validate validates the configuration by checking for missing or invalid fields
+func (cfg *Config) Validate() error {
+	if len(cfg.Targets) == 0 {
+		return errMissingTargets
+	}
+
+	var err error
+	for i, target := range cfg.Targets {
+		if (target.Endpoint == "") == (target.FilePath == "") {
+			err = multierr.Append(err, fmt.Errorf("target %d: %w", i, errInvalidTarget))
+			continue
+		}
+		if target.Endpoint == "" {
+			continue
+		}
+		if _, _, splitErr := net.SplitHostPort(target.Endpoint); splitErr != nil {
+			err = multierr.Append(err, fmt.Errorf("target %d: %s: %w", i, errInvalidEndpoint.Error(), splitErr))
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlscheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/tlscheckreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/multierr"
+)
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		cfg         *Config
+		expectedErr error
+	}{
+		{
+			desc:        "missing targets",
+			cfg:         &Config{},
+			expectedErr: errMissingTargets,
+		},
+		{
+			desc: "invalid targets",
+			cfg: &Config{
+				Targets: []TargetConfig{
+					{},
+					{Endpoint: "example.com:443", FilePath: "/etc/ssl/cert.pem"},
+					{Endpoint: "example.com"},
+				},
+			},
+			expectedErr: multierr.Combine(
+				fmt.Errorf("target 0: %w", errInvalidTarget),
+				fmt.Errorf("target 1: %w", errInvalidTarget),
+				fmt.Errorf("target 2: %s: %w", errInvalidEndpoint, errors.New("address example.com: missing port in address")),
+			),
+		},
+		{
+			desc: "valid config",
+			cfg: &Config{
+				Targets: []TargetConfig{
+					{Endpoint: "example.com:443", ServerName: "www.example.com"},
+					{FilePath: "/etc/ssl/cert.pem", CAFile: "/etc/ssl/ca.pem"},
+				},
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			actualErr := tc.cfg.Validate()
+			if tc.expectedErr != nil {
+				require.EqualError(t, actualErr, tc.expectedErr.Error())
+			} else {
+				require.NoError(t, actualErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,238 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlscheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/tlscheckreceiver"
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/tlscheckreceiver/internal/metadata"
+)
+
+var (
+	errTargetsNotInit  = errors.New("targets not initialized")
+	errNoCertificate   = errors.New("no certificate found")
+	errInvalidCAFile   = errors.New("no certificate found in CA file")
+	fileExtKeyUsageAny = []x509.ExtKeyUsage{x509.ExtKeyUsageAny}
+)
+
+// checkTarget is a TargetConfig with its loaded roots.
+type checkTarget struct {
+	cfg TargetConfig
+	// roots is nil to verify against the system roots.
+	roots *x509.CertPool
+}
+
+// name is the value of the tlscheck.target attribute of the target.
+func (t *checkTarget) name() string {
+	if t.cfg.Endpoint != "" {
+		return t.cfg.Endpoint
+	}
+	return t.cfg.FilePath
+}
+
+type tlscheckScraper struct {
+	targets  []*checkTarget
+	cfg      *Config
+	settings component.TelemetrySettings
+	mb       *metadata.MetricsBuilder
+}
+
+// start starts the scraper by loading the CA files of the targets, so that an
+// unreadable CA file is reported when the receiver starts
+func (s *tlscheckScraper) start(ctx context.Context, host component.Host) error {
+	targets := make([]*checkTarget, 0, len(s.cfg.Targets))
+	for _, targetCfg := range s.cfg.Targets {
+		target := &checkTarget{cfg: targetCfg}
+		if targetCfg.CAFile != "" {
+			pemCerts, err := os.ReadFile(targetCfg.CAFile)
+			if err != nil {
+				return fmt.Errorf("failed to read CA file of target %q: %w", target.name(), err)
+			}
+			target.roots = x509.NewCertPool()
+			if !target.roots.AppendCertsFromPEM(pemCerts) {
+				return fmt.Errorf("failed to load CA file of target %q: %w", target.name(), errInvalidCAFile)
+			}
+		}
+		targets = append(targets, target)
+	}
+	s.targets = targets
+	return nil
+}
+
+// scrape checks the certificates of every target and produces metrics based on them
+func (s *tlscheckScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	if s.targets == nil {
+		return pmetric.NewMetrics(), errTargetsNotInit
+	}
+
+	nowTime := time.Now()
+	now := pcommon.NewTimestampFromTime(nowTime)
+	for _, target := range s.targets {
+		if target.cfg.Endpoint != "" {
+			s.checkEndpoint(ctx, now, nowTime, target)
+		} else {
+			s.checkFile(now, nowTime, target)
+		}
+	}
+
+	return s.mb.Emit(), nil
+}
+
+// checkEndpoint connects to the endpoint of the target and checks the certificates presented during the handshake
+func (s *tlscheckScraper) checkEndpoint(ctx context.Context, now pcommon.Timestamp, nowTime time.Time, target *checkTarget) {
+	serverName := target.cfg.ServerName
+	if serverName == "" {
+		serverName, _, _ = net.SplitHostPort(target.cfg.Endpoint)
+	}
+	dialer := &tls.Dialer{
+		NetDialer: &net.Dialer{Timeout: s.cfg.Timeout},
+		Config: &tls.Config{
+			ServerName: serverName,
+			// the chain is verified after the handshake, so that the certificates of an invalid chain are still checked
+			InsecureSkipVerify: true, //nolint:gosec
+		},
+	}
+
+	start := time.Now()
+	conn, err := dialer.DialContext(ctx, "tcp", target.cfg.Endpoint)
+	s.mb.RecordTlscheckHandshakeDurationDataPoint(now, time.Since(start).Milliseconds(), target.name())
+	if err != nil {
+		s.recordError(now, target, metadata.AttributeErrorTypeConnection, err)
+		return
+	}
+	certs := conn.(*tls.Conn).ConnectionState().PeerCertificates
+	_ = conn.Close()
+
+	s.recordCertificates(now, target, certs, x509.VerifyOptions{
+		DNSName:     serverName,
+		Roots:       target.roots,
+		CurrentTime: nowTime,
+	})
+}
+
+// checkFile reads the PEM file of the target and checks its certificates
+func (s *tlscheckScraper) checkFile(now pcommon.Timestamp, nowTime time.Time, target *checkTarget) {
+	certs, err := readCertificates(target.cfg.FilePath)
+	if err != nil {
+		s.recordError(now, target, metadata.AttributeErrorTypeFile, err)
+		return
+	}
+
+	s.recordCertificates(now, target, certs, x509.VerifyOptions{
+		DNSName:     target.cfg.ServerName,
+		Roots:       target.roots,
+		CurrentTime: nowTime,
+		// a file may hold any kind of certificate, not only a server one
+		KeyUsages: fileExtKeyUsageAny,
+	})
+}
+
+// recordCertificates records the time left of the leaf certificate and whether it is
+// verified with the other certificates as intermediates
+func (s *tlscheckScraper) recordCertificates(now pcommon.Timestamp, target *checkTarget, certs []*x509.Certificate, opts x509.VerifyOptions) {
+	if len(certs) == 0 {
+		s.recordError(now, target, metadata.AttributeErrorTypeNoCertificate, errNoCertificate)
+		return
+	}
+
+	leaf := certs[0]
+	timeLeft := leaf.NotAfter.Sub(opts.CurrentTime)
+	s.mb.RecordTlscheckTimeLeftDataPoint(now, int64(timeLeft.Seconds()), target.name(), leaf.Subject.CommonName, leaf.Issuer.String())
+
+	opts.Intermediates = x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	if _, err := leaf.Verify(opts); err != nil {
+		s.mb.RecordTlscheckChainValidDataPoint(now, int64(0), target.name())
+		s.recordError(now, target, verifyErrorType(err), err)
+		return
+	}
+	s.mb.RecordTlscheckChainValidDataPoint(now, int64(1), target.name())
+}
+
+// recordError records an error of the target by its type, as error messages may hold
+// unbounded values, and logs the full message
+func (s *tlscheckScraper) recordError(now pcommon.Timestamp, target *checkTarget, errType metadata.AttributeErrorType, err error) {
+	s.settings.Logger.Warn("TLS check failed",
+		zap.String("target", target.name()),
+		zap.String("error_type", errType.String()),
+		zap.Error(err))
+	s.mb.RecordTlscheckErrorDataPoint(now, int64(1), target.name(), errType)
+}
+
+// verifyErrorType returns the type of a certificate verification error
+func verifyErrorType(err error) metadata.AttributeErrorType {
+	var invalidErr x509.CertificateInvalidError
+	var hostnameErr x509.HostnameError
+	var authorityErr x509.UnknownAuthorityError
+	switch {
+	case errors.As(err, &invalidErr) && invalidErr.Reason == x509.Expired:
+		return metadata.AttributeErrorTypeExpired
+	case errors.As(err, &hostnameErr):
+		return metadata.AttributeErrorTypeHostnameMismatch
+	case errors.As(err, &authorityErr):
+		return metadata.AttributeErrorTypeUnknownAuthority
+	default:
+		return metadata.AttributeErrorTypeInvalidCertificate
+	}
+}
+
+// readCertificates parses the certificates of a PEM file, in order
+func readCertificates(path string) ([]*x509.Certificate, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	return certs, nil
+}
+
+func newScraper(conf *Config, settings component.ReceiverCreateSettings) *tlscheckScraper {
+	return &tlscheckScraper{
+		cfg:      conf,
+		settings: settings.TelemetrySettings,
+		mb:       metadata.NewMetricsBuilder(conf.Metrics, settings.BuildInfo),
+	}
+}
@@ -0,0 +1,280 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlscheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/tlscheckreceiver"
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/scrapertest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/scrapertest/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/tlscheckreceiver/internal/metadata"
+)
+
+// writePEM writes the certificates to a PEM file in a temporary directory and returns its path
+func writePEM(t *testing.T, certs ...*x509.Certificate) string {
+	path := filepath.Join(t.TempDir(), "cert.pem")
+	var data []byte
+	for _, cert := range certs {
+		data = append(data, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})...)
+	}
+	require.NoError(t, os.WriteFile(path, data, 0600))
+	return path
+}
+
+// newExpiredCertificate creates a self-signed certificate which expired a day ago
+func newExpiredCertificate(t *testing.T) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "expired.example.com", Organization: []string{"Example"}},
+		NotBefore:             time.Now().Add(-48 * time.Hour),
+		NotAfter:              time.Now().Add(-24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+// newClosedEndpoint returns an endpoint nothing listens on
+func newClosedEndpoint(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	endpoint := listener.Addr().String()
+	require.NoError(t, listener.Close())
+	return endpoint
+}
+
+func TestScraperStart(t *testing.T) {
+	testcases := []struct {
+		desc        string
+		caFile      func(t *testing.T) string
+		expectError bool
+	}{
+		{
+			desc: "Missing CA file",
+			caFile: func(t *testing.T) string {
+				return filepath.Join(t.TempDir(), "missing.pem")
+			},
+			expectError: true,
+		},
+		{
+			desc: "Invalid CA file",
+			caFile: func(t *testing.T) string {
+				path := filepath.Join(t.TempDir(), "invalid.pem")
+				require.NoError(t, os.WriteFile(path, []byte("not a certificate"), 0600))
+				return path
+			},
+			expectError: true,
+		},
+		{
+			desc: "Valid CA file",
+			caFile: func(t *testing.T) string {
+				return writePEM(t, newExpiredCertificate(t))
+			},
+			expectError: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Targets = []TargetConfig{{Endpoint: "localhost:443", CAFile: tc.caFile(t)}}
+			scraper := newScraper(cfg, componenttest.NewNopReceiverCreateSettings())
+			err := scraper.start(context.Background(), componenttest.NewNopHost())
+			if tc.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestScraperScrape(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {}))
+	defer server.Close()
+	serverEndpoint := server.Listener.Addr().String()
+
+	testCases := []struct {
+		desc           string
+		target         func(t *testing.T) TargetConfig
+		goldenFile     string
+		compareOptions []scrapertest.CompareOption
+	}{
+		{
+			desc: "Trusted endpoint",
+			target: func(t *testing.T) TargetConfig {
+				return TargetConfig{Endpoint: serverEndpoint, ServerName: "example.com", CAFile: writePEM(t, server.Certificate())}
+			},
+			goldenFile: "endpoint_trusted.json",
+			compareOptions: []scrapertest.CompareOption{
+				scrapertest.IgnoreMetricAttributeValue("tlscheck.target"),
+				scrapertest.IgnoreMetricValues("tlscheck.time_left", "tlscheck.handshake.duration"),
+			},
+		},
+		{
+			desc: "Untrusted endpoint",
+			target: func(t *testing.T) TargetConfig {
+				return TargetConfig{Endpoint: serverEndpoint}
+			},
+			goldenFile: "endpoint_untrusted.json",
+			compareOptions: []scrapertest.CompareOption{
+				scrapertest.IgnoreMetricAttributeValue("tlscheck.target"),
+				scrapertest.IgnoreMetricValues("tlscheck.time_left", "tlscheck.handshake.duration"),
+			},
+		},
+		{
+			desc: "Unreachable endpoint",
+			target: func(t *testing.T) TargetConfig {
+				return TargetConfig{Endpoint: newClosedEndpoint(t)}
+			},
+			goldenFile: "endpoint_unreachable.json",
+			compareOptions: []scrapertest.CompareOption{
+				scrapertest.IgnoreMetricAttributeValue("tlscheck.target"),
+				scrapertest.IgnoreMetricValues("tlscheck.handshake.duration"),
+			},
+		},
+		{
+			desc: "Expired file",
+			target: func(t *testing.T) TargetConfig {
+				cert := newExpiredCertificate(t)
+				return TargetConfig{FilePath: writePEM(t, cert), CAFile: writePEM(t, cert)}
+			},
+			goldenFile: "file_expired.json",
+			compareOptions: []scrapertest.CompareOption{
+				scrapertest.IgnoreMetricAttributeValue("tlscheck.target"),
+				scrapertest.IgnoreMetricValues("tlscheck.time_left"),
+			},
+		},
+		{
+			desc: "Missing file",
+			target: func(t *testing.T) TargetConfig {
+				return TargetConfig{FilePath: filepath.Join(t.TempDir(), "missing.pem")}
+			},
+			goldenFile: "file_missing.json",
+			compareOptions: []scrapertest.CompareOption{
+				scrapertest.IgnoreMetricAttributeValue("tlscheck.target"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Targets = []TargetConfig{tc.target(t)}
+			scraper := newScraper(cfg, componenttest.NewNopReceiverCreateSettings())
+			require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+			actualMetrics, err := scraper.scrape(context.Background())
+			require.NoError(t, err)
+
+			expectedMetrics, err := golden.ReadMetrics(filepath.Join("testdata", "expected_metrics", tc.goldenFile))
+			require.NoError(t, err)
+			require.NoError(t, scrapertest.CompareMetrics(expectedMetrics, actualMetrics, tc.compareOptions...))
+		})
+	}
+}
+
+func TestScrapeTimeLeft(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Targets = []TargetConfig{{FilePath: writePEM(t, newExpiredCertificate(t))}}
+	scraper := newScraper(cfg, componenttest.NewNopReceiverCreateSettings())
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	metrics := actualMetrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		if metrics.At(i).Name() == "tlscheck.time_left" {
+			timeLeft := metrics.At(i).Gauge().DataPoints().At(0).IntValue()
+			require.InDelta(t, -24*time.Hour.Seconds(), float64(timeLeft), 60)
+			return
+		}
+	}
+	t.Fatal("tlscheck.time_left not found")
+}
+
+func TestNilTargets(t *testing.T) {
+	scraper := newScraper(createDefaultConfig().(*Config), componenttest.NewNopReceiverCreateSettings())
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.EqualError(t, err, errTargetsNotInit.Error())
+	require.NoError(t, scrapertest.CompareMetrics(pmetric.NewMetrics(), actualMetrics))
+}
+
+func TestVerifyErrorType(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		err      error
+		expected metadata.AttributeErrorType
+	}{
+		{
+			desc:     "expired",
+			err:      x509.CertificateInvalidError{Reason: x509.Expired},
+			expected: metadata.AttributeErrorTypeExpired,
+		},
+		{
+			desc:     "hostname mismatch",
+			err:      x509.HostnameError{Host: "example.com"},
+			expected: metadata.AttributeErrorTypeHostnameMismatch,
+		},
+		{
+			desc:     "unknown authority",
+			err:      fmt.Errorf("wrapped: %w", x509.UnknownAuthorityError{}),
+			expected: metadata.AttributeErrorTypeUnknownAuthority,
+		},
+		{
+			desc:     "other invalid certificate",
+			err:      x509.CertificateInvalidError{Reason: x509.NotAuthorizedToSign},
+			expected: metadata.AttributeErrorTypeInvalidCertificate,
+		},
+		{
+			desc:     "other error",
+			err:      errors.New("unknown"),
+			expected: metadata.AttributeErrorTypeInvalidCertificate,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, tc.expected, verifyErrorType(tc.err))
+		})
+	}
+}
@@ -18,14 +18,18 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/event"
 	"github.com/vmware/govmomi/find"
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/performance"
 	"github.com/vmware/govmomi/property"
 	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
 	vt "github.com/vmware/govmomi/vim25/types"
+	"go.uber.org/multierr"
 )
 
 // vcenterClient is a client that
@@ -35,6 +39,7 @@ type vcenterClient struct {
 	finder    *find.Finder
 	pc        *property.Collector
 	pm        *performance.Manager
+	em        *event.Manager
 	cfg       *Config
 }
 
@@ -78,6 +83,7 @@ func (vc *vcenterClient) EnsureConnection(ctx context.Context) error {
 	vc.pc = property.DefaultCollector(vc.vimDriver)
 	vc.finder = find.NewFinder(vc.vimDriver)
 	vc.pm = performance.NewManager(vc.vimDriver)
+	vc.em = event.NewManager(vc.vimDriver)
 	return nil
 }
 
@@ -157,3 +163,48 @@ func (vc *vcenterClient) performanceQuery(
 		results:  result,
 	}, nil
 }
+
+// eventPageSize is the maximum number of events read at once from an event history collector
+const eventPageSize = 1000
+
+// Events returns the events created at or after since, restricted to eventTypes if any are given.
+// The events are read page by page from an event history collector, which is destroyed afterwards.
+func (vc *vcenterClient) Events(ctx context.Context, since time.Time, eventTypes []string) (events []vt.BaseEvent, err error) {
+	collector, err := vc.em.CreateCollectorForEvents(ctx, vt.EventFilterSpec{
+		Time:        &vt.EventFilterSpecByTime{BeginTime: &since},
+		EventTypeId: eventTypes,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create event collector: %w", err)
+	}
+	defer func() {
+		if destroyErr := collector.Destroy(ctx); destroyErr != nil {
+			err = multierr.Append(err, fmt.Errorf("unable to destroy event collector: %w", destroyErr))
+		}
+	}()
+
+	for {
+		page, err := collector.ReadNextEvents(ctx, eventPageSize)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read events: %w", err)
+		}
+		if len(page) == 0 {
+			return events, nil
+		}
+		events = append(events, page...)
+	}
+}
+
+// EventCategory returns the category of an event, such as "info", "warning" or "error"
+func (vc *vcenterClient) EventCategory(ctx context.Context, e vt.BaseEvent) (string, error) {
+	return vc.em.EventCategory(ctx, e)
+}
+
+// CurrentTime returns the current time of the vCenter server
+func (vc *vcenterClient) CurrentTime(ctx context.Context) (time.Time, error) {
+	t, err := methods.GetCurrentTime(ctx, vc.vimDriver)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unable to get the vCenter server time: %w", err)
+	}
+	return *t, nil
+}
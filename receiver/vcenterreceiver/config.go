@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"time"
 
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
@@ -34,6 +35,16 @@ type Config struct {
 	Endpoint                                string                   `mapstructure:"endpoint"`
 	Username                                string                   `mapstructure:"username"`
 	Password                                string                   `mapstructure:"password"`
+	Events                                  EventsConfig             `mapstructure:"events"`
+}
+
+// EventsConfig configures how vCenter events and alarms are collected by the logs receiver
+type EventsConfig struct {
+	// PollInterval is how often vCenter is queried for new events. Default is 1m.
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	// Types restricts collection to the listed event types, such as VmMigratedEvent or
+	// AlarmStatusChangedEvent. All events are collected when empty.
+	Types []string `mapstructure:"types"`
 }
 
 // Validate checks to see if the supplied config will work for the receiver
@@ -61,6 +72,10 @@ func (c *Config) Validate() error {
 		err = multierr.Append(err, errors.New("password not provided and is required"))
 	}
 
+	if c.Events.PollInterval <= 0 {
+		err = multierr.Append(err, errors.New("events poll_interval must be a positive duration"))
+	}
+
 	if _, tlsErr := c.LoadTLSConfig(); err != nil {
 		err = multierr.Append(err, fmt.Errorf("error loading tls configuration: %w", tlsErr))
 	}
@@ -82,6 +82,15 @@ func TestConfigValidation(t *testing.T) {
 			},
 			expectedErr: errors.New("password not provided"),
 		},
+		{
+			desc: "no events poll interval",
+			cfg: Config{
+				Endpoint: "https://vcsa.some-host",
+				Username: "otelu",
+				Password: "otelp",
+			},
+			expectedErr: errors.New("events poll_interval must be a positive duration"),
+		},
 	}
 
 	for _, tc := range cases {
@@ -112,6 +121,8 @@ func TestLoadConfig(t *testing.T) {
 	expected.Metrics = metadata.DefaultMetricsSettings()
 	expected.Metrics.VcenterHostCPUUtilization.Enabled = false
 	expected.CollectionInterval = 5 * time.Minute
+	expected.Events.PollInterval = 30 * time.Second
+	expected.Events.Types = []string{"VmMigratedEvent", "AlarmStatusChangedEvent"}
 
 	if diff := cmp.Diff(expected, cfg, cmpopts.IgnoreUnexported(config.ReceiverSettings{}, metadata.MetricSettings{})); diff != "" {
 		t.Errorf("Config mismatch (-expected +actual):\n%s", diff)
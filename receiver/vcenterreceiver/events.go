@@ -0,0 +1,252 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vcenterreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/vcenterreceiver"
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/vmware/govmomi/vim25/types"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+)
+
+// resource attributes identifying the entities an event relates to
+const (
+	datacenterNameAttribute = "vcenter.datacenter.name"
+	clusterNameAttribute    = "vcenter.cluster.name"
+	hostNameAttribute       = "vcenter.host.name"
+	vmNameAttribute         = "vcenter.vm.name"
+	datastoreNameAttribute  = "vcenter.datastore.name"
+)
+
+// log record attributes describing the event itself
+const (
+	eventTypeAttribute           = "vcenter.event.type"
+	eventKeyAttribute            = "vcenter.event.key"
+	eventChainIDAttribute        = "vcenter.event.chain_id"
+	eventUserAttribute           = "vcenter.event.user"
+	alarmNameAttribute           = "vcenter.alarm.name"
+	alarmEntityAttribute         = "vcenter.alarm.entity"
+	alarmStatusAttribute         = "vcenter.alarm.status"
+	alarmPreviousStatusAttribute = "vcenter.alarm.previous_status"
+)
+
+var _ component.LogsReceiver = (*vcenterEventsReceiver)(nil)
+
+// vcenterEventsReceiver polls the vCenter event manager and emits events and alarms as log records
+type vcenterEventsReceiver struct {
+	client   *vcenterClient
+	config   *Config
+	consumer consumer.Logs
+	logger   *zap.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	// lastTime and lastKey identify the newest event already emitted
+	lastTime time.Time
+	lastKey  int32
+}
+
+func newVcenterEventsReceiver(
+	logger *zap.Logger,
+	config *Config,
+	consumer consumer.Logs,
+) *vcenterEventsReceiver {
+	return &vcenterEventsReceiver{
+		client:   newVcenterClient(config),
+		config:   config,
+		consumer: consumer,
+		logger:   logger,
+	}
+}
+
+func (r *vcenterEventsReceiver) Start(_ context.Context, _ component.Host) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		ticker := time.NewTicker(r.config.Events.PollInterval)
+		defer ticker.Stop()
+		for {
+			if err := r.poll(ctx); err != nil {
+				r.logger.Error("unable to collect vCenter events", zap.Error(err))
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return nil
+}
+
+func (r *vcenterEventsReceiver) Shutdown(ctx context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	return r.client.Disconnect(ctx)
+}
+
+// poll emits the events created since the previous poll. The first poll only records the
+// vCenter server time, so that events created before the receiver started are not emitted.
+func (r *vcenterEventsReceiver) poll(ctx context.Context) error {
+	if err := r.client.EnsureConnection(ctx); err != nil {
+		return fmt.Errorf("unable to connect to vSphere SDK: %w", err)
+	}
+
+	if r.lastTime.IsZero() {
+		now, err := r.client.CurrentTime(ctx)
+		if err != nil {
+			return err
+		}
+		r.lastTime = now
+		return nil
+	}
+
+	events, err := r.client.Events(ctx, r.lastTime, r.config.Events.Types)
+	if err != nil {
+		return err
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].GetEvent().Key < events[j].GetEvent().Key
+	})
+
+	logs := plog.NewLogs()
+	observed := pcommon.NewTimestampFromTime(time.Now())
+	lastTime, lastKey := r.lastTime, r.lastKey
+	for _, e := range events {
+		ev := e.GetEvent()
+		// the time filter is inclusive, so events sharing the last emitted timestamp are returned again
+		if ev.Key <= r.lastKey && !ev.CreatedTime.After(r.lastTime) {
+			continue
+		}
+		r.appendEvent(ctx, logs, e, observed)
+		lastKey = ev.Key
+		if ev.CreatedTime.After(lastTime) {
+			lastTime = ev.CreatedTime
+		}
+	}
+
+	if logs.LogRecordCount() == 0 {
+		return nil
+	}
+	// the events are only marked as emitted once consumed, so that they are emitted again by the next poll
+	// when the consumer fails
+	if err := r.consumer.ConsumeLogs(ctx, logs); err != nil {
+		return err
+	}
+	r.lastTime, r.lastKey = lastTime, lastKey
+	return nil
+}
+
+func (r *vcenterEventsReceiver) appendEvent(ctx context.Context, logs plog.Logs, e types.BaseEvent, observed pcommon.Timestamp) {
+	ev := e.GetEvent()
+	rl := logs.ResourceLogs().AppendEmpty()
+	resourceAttrs := rl.Resource().Attributes()
+	if ev.Datacenter != nil {
+		resourceAttrs.PutStr(datacenterNameAttribute, ev.Datacenter.Name)
+	}
+	if ev.ComputeResource != nil {
+		resourceAttrs.PutStr(clusterNameAttribute, ev.ComputeResource.Name)
+	}
+	if ev.Host != nil {
+		resourceAttrs.PutStr(hostNameAttribute, ev.Host.Name)
+	}
+	if ev.Vm != nil {
+		resourceAttrs.PutStr(vmNameAttribute, ev.Vm.Name)
+	}
+	if ev.Ds != nil {
+		resourceAttrs.PutStr(datastoreNameAttribute, ev.Ds.Name)
+	}
+
+	lr := rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.SetTimestamp(pcommon.NewTimestampFromTime(ev.CreatedTime))
+	lr.SetObservedTimestamp(observed)
+	lr.Body().SetStr(ev.FullFormattedMessage)
+
+	attrs := lr.Attributes()
+	attrs.PutStr(eventTypeAttribute, eventType(e))
+	attrs.PutInt(eventKeyAttribute, int64(ev.Key))
+	attrs.PutInt(eventChainIDAttribute, int64(ev.ChainId))
+	if ev.UserName != "" {
+		attrs.PutStr(eventUserAttribute, ev.UserName)
+	}
+
+	if alarm, ok := e.(types.BaseAlarmEvent); ok {
+		attrs.PutStr(alarmNameAttribute, alarm.GetAlarmEvent().Alarm.Name)
+	}
+	if change, ok := e.(*types.AlarmStatusChangedEvent); ok {
+		attrs.PutStr(alarmEntityAttribute, change.Entity.Name)
+		attrs.PutStr(alarmStatusAttribute, change.To)
+		attrs.PutStr(alarmPreviousStatusAttribute, change.From)
+		lr.SetSeverityText(change.To)
+		lr.SetSeverityNumber(alarmStatusSeverity(change.To))
+		return
+	}
+
+	category, err := r.client.EventCategory(ctx, e)
+	if err != nil {
+		r.logger.Debug("unable to determine event category", zap.Int32("key", ev.Key), zap.Error(err))
+		return
+	}
+	lr.SetSeverityText(category)
+	lr.SetSeverityNumber(categorySeverity(category))
+}
+
+// eventType returns the vSphere type name of an event, or its event type ID for extended events
+func eventType(e types.BaseEvent) string {
+	switch ev := e.(type) {
+	case *types.EventEx:
+		return ev.EventTypeId
+	case *types.ExtendedEvent:
+		return ev.EventTypeId
+	}
+	return reflect.TypeOf(e).Elem().Name()
+}
+
+func categorySeverity(category string) plog.SeverityNumber {
+	switch category {
+	case "error":
+		return plog.SeverityNumberError
+	case "warning":
+		return plog.SeverityNumberWarn
+	default:
+		return plog.SeverityNumberInfo
+	}
+}
+
+func alarmStatusSeverity(status string) plog.SeverityNumber {
+	switch types.ManagedEntityStatus(status) {
+	case types.ManagedEntityStatusRed:
+		return plog.SeverityNumberError
+	case types.ManagedEntityStatusYellow:
+		return plog.SeverityNumberWarn
+	default:
+		return plog.SeverityNumberInfo
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vcenterreceiver // import github.com/open-telemetry/opentelemetry-collector-contrib/receiver/vcenterreceiver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/govmomi/event"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/types"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+)
+
+func TestEventsReceiverPoll(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		cfg := simulatorConfig(c)
+		sink := &consumertest.LogsSink{}
+		r := newVcenterEventsReceiver(zap.NewNop(), cfg, sink)
+		defer func() {
+			require.NoError(t, r.Shutdown(ctx))
+		}()
+
+		// the first poll only records the current time
+		require.NoError(t, r.poll(ctx))
+		require.Equal(t, 0, sink.LogRecordCount())
+
+		em := event.NewManager(c)
+		require.NoError(t, em.PostEvent(ctx, &types.VmMigratedEvent{
+			VmEvent: types.VmEvent{
+				Event: types.Event{
+					Datacenter:      &types.DatacenterEventArgument{EntityEventArgument: types.EntityEventArgument{Name: "DC0"}},
+					ComputeResource: &types.ComputeResourceEventArgument{EntityEventArgument: types.EntityEventArgument{Name: "DC0_C0"}},
+					Host:            &types.HostEventArgument{EntityEventArgument: types.EntityEventArgument{Name: "DC0_C0_H1"}},
+					Vm:              &types.VmEventArgument{EntityEventArgument: types.EntityEventArgument{Name: "DC0_C0_RP0_VM0"}},
+				},
+			},
+			SourceHost: types.HostEventArgument{EntityEventArgument: types.EntityEventArgument{Name: "DC0_C0_H0"}},
+		}))
+		require.NoError(t, em.PostEvent(ctx, &types.AlarmStatusChangedEvent{
+			AlarmEvent: types.AlarmEvent{
+				Event: types.Event{
+					Ds: &types.DatastoreEventArgument{EntityEventArgument: types.EntityEventArgument{Name: "LocalDS_0"}},
+				},
+				Alarm: types.AlarmEventArgument{EntityEventArgument: types.EntityEventArgument{Name: "Datastore usage on disk"}},
+			},
+			Entity: types.ManagedEntityEventArgument{EntityEventArgument: types.EntityEventArgument{Name: "LocalDS_0"}},
+			From:   string(types.ManagedEntityStatusGreen),
+			To:     string(types.ManagedEntityStatusRed),
+		}))
+
+		require.NoError(t, r.poll(ctx))
+		require.Equal(t, 2, sink.LogRecordCount())
+
+		logs := sink.AllLogs()[0]
+		migrated := logs.ResourceLogs().At(0)
+		require.Equal(t, map[string]interface{}{
+			datacenterNameAttribute: "DC0",
+			clusterNameAttribute:    "DC0_C0",
+			hostNameAttribute:       "DC0_C0_H1",
+			vmNameAttribute:         "DC0_C0_RP0_VM0",
+		}, migrated.Resource().Attributes().AsRaw())
+		lr := migrated.ScopeLogs().At(0).LogRecords().At(0)
+		eventType, _ := lr.Attributes().Get(eventTypeAttribute)
+		require.Equal(t, "VmMigratedEvent", eventType.Str())
+		require.Equal(t, plog.SeverityNumberInfo, lr.SeverityNumber())
+		require.NotZero(t, lr.Timestamp())
+
+		alarm := logs.ResourceLogs().At(1)
+		require.Equal(t, map[string]interface{}{
+			datastoreNameAttribute: "LocalDS_0",
+		}, alarm.Resource().Attributes().AsRaw())
+		lr = alarm.ScopeLogs().At(0).LogRecords().At(0)
+		attrs := lr.Attributes().AsRaw()
+		require.Equal(t, "AlarmStatusChangedEvent", attrs[eventTypeAttribute])
+		require.Equal(t, "Datastore usage on disk", attrs[alarmNameAttribute])
+		require.Equal(t, "LocalDS_0", attrs[alarmEntityAttribute])
+		require.Equal(t, "red", attrs[alarmStatusAttribute])
+		require.Equal(t, "green", attrs[alarmPreviousStatusAttribute])
+		require.Equal(t, plog.SeverityNumberError, lr.SeverityNumber())
+
+		// events are only emitted once
+		require.NoError(t, r.poll(ctx))
+		require.Equal(t, 2, sink.LogRecordCount())
+	})
+}
+
+func TestEventsReceiverPollPages(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		cfg := simulatorConfig(c)
+		sink := &consumertest.LogsSink{}
+		r := newVcenterEventsReceiver(zap.NewNop(), cfg, sink)
+		defer func() {
+			require.NoError(t, r.Shutdown(ctx))
+		}()
+		require.NoError(t, r.poll(ctx))
+
+		// more events than fit in a single page are created between polls
+		em := event.NewManager(c)
+		count := 2*eventPageSize + 10
+		for i := 0; i < count; i++ {
+			require.NoError(t, em.PostEvent(ctx, &types.VmMigratedEvent{}))
+		}
+
+		require.NoError(t, r.poll(ctx))
+		require.Equal(t, count, sink.LogRecordCount())
+	})
+}
+
+func TestEventsReceiverConsumerError(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		cfg := simulatorConfig(c)
+		sink := &consumertest.LogsSink{}
+		r := newVcenterEventsReceiver(zap.NewNop(), cfg, consumertest.NewErr(errors.New("consumer error")))
+		defer func() {
+			require.NoError(t, r.Shutdown(ctx))
+		}()
+		require.NoError(t, r.poll(ctx))
+
+		em := event.NewManager(c)
+		require.NoError(t, em.PostEvent(ctx, &types.VmMigratedEvent{}))
+		require.Error(t, r.poll(ctx))
+
+		// the events which failed to be consumed are emitted by the next poll
+		r.consumer = sink
+		require.NoError(t, r.poll(ctx))
+		require.Equal(t, 1, sink.LogRecordCount())
+		require.NoError(t, r.poll(ctx))
+		require.Equal(t, 1, sink.LogRecordCount())
+	})
+}
+
+func TestEventsReceiverTypes(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		cfg := simulatorConfig(c)
+		cfg.Events.Types = []string{"VmMigratedEvent"}
+		sink := &consumertest.LogsSink{}
+		r := newVcenterEventsReceiver(zap.NewNop(), cfg, sink)
+		defer func() {
+			require.NoError(t, r.Shutdown(ctx))
+		}()
+		require.NoError(t, r.poll(ctx))
+
+		em := event.NewManager(c)
+		require.NoError(t, em.PostEvent(ctx, &types.VmMigratedEvent{}))
+		require.NoError(t, em.PostEvent(ctx, &types.VmPoweredOffEvent{}))
+
+		require.NoError(t, r.poll(ctx))
+		require.Equal(t, 1, sink.LogRecordCount())
+		lr := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+		eventType, _ := lr.Attributes().Get(eventTypeAttribute)
+		require.Equal(t, "VmMigratedEvent", eventType.Str())
+	})
+}
+
+func TestEventsReceiverStartShutdown(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		cfg := simulatorConfig(c)
+		cfg.Events.PollInterval = 10 * time.Millisecond
+		sink := &consumertest.LogsSink{}
+		r := newVcenterEventsReceiver(zap.NewNop(), cfg, sink)
+		require.NoError(t, r.Start(ctx, nil))
+
+		em := event.NewManager(c)
+		require.Eventually(t, func() bool {
+			// events posted before the first poll are not collected, so keep posting
+			require.NoError(t, em.PostEvent(ctx, &types.VmMigratedEvent{}))
+			return sink.LogRecordCount() > 0
+		}, 5*time.Second, 20*time.Millisecond)
+		require.NoError(t, r.Shutdown(ctx))
+	})
+}
+
+func simulatorConfig(c *vim25.Client) *Config {
+	pw, _ := simulator.DefaultLogin.Password()
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = fmt.Sprintf("%s://%s", c.URL().Scheme, c.URL().Host)
+	cfg.Username = simulator.DefaultLogin.Username()
+	cfg.Password = pw
+	cfg.TLSClientSetting = configtls.TLSClientSetting{Insecure: true}
+	return cfg
+}
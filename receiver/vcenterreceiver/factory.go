@@ -39,6 +39,7 @@ func NewFactory() component.ReceiverFactory {
 		typeStr,
 		createDefaultConfig,
 		component.WithMetricsReceiver(createMetricsReceiver, stability),
+		component.WithLogsReceiver(createLogsReceiver, stability),
 	)
 }
 
@@ -50,6 +51,9 @@ func createDefaultConfig() component.ReceiverConfig {
 		},
 		TLSClientSetting: configtls.TLSClientSetting{},
 		Metrics:          metadata.DefaultMetricsSettings(),
+		Events: EventsConfig{
+			PollInterval: time.Minute,
+		},
 	}
 }
 
@@ -84,3 +88,19 @@ func createMetricsReceiver(
 		scraperhelper.AddScraper(scraper),
 	)
 }
+
+func createLogsReceiver(
+	_ context.Context,
+	params component.ReceiverCreateSettings,
+	rConf component.ReceiverConfig,
+	consumer consumer.Logs,
+) (component.LogsReceiver, error) {
+	cfg, ok := rConf.(*Config)
+	if !ok {
+		return nil, errConfigNotVcenter
+	}
+	if consumer == nil {
+		return nil, component.ErrNilNextConsumer
+	}
+	return newVcenterEventsReceiver(params.Logger, cfg, consumer), nil
+}
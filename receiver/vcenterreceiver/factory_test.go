@@ -74,3 +74,29 @@ func TestCreateMetricsReceiver(t *testing.T) {
 		t.Run(testCase.desc, testCase.testFn)
 	}
 }
+
+func TestCreateLogsReceiver(t *testing.T) {
+	_, err := createLogsReceiver(
+		context.Background(),
+		componenttest.NewNopReceiverCreateSettings(),
+		createDefaultConfig(),
+		consumertest.NewNop(),
+	)
+	require.NoError(t, err)
+
+	_, err = createLogsReceiver(
+		context.Background(),
+		componenttest.NewNopReceiverCreateSettings(),
+		nil,
+		consumertest.NewNop(),
+	)
+	require.ErrorIs(t, err, errConfigNotVcenter)
+
+	_, err = createLogsReceiver(
+		context.Background(),
+		componenttest.NewNopReceiverCreateSettings(),
+		createDefaultConfig(),
+		nil,
+	)
+	require.ErrorIs(t, err, component.ErrNilNextConsumer)
+}
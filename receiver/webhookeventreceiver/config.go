@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhookeventreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/webhookeventreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.uber.org/multierr"
+)
+
+const (
+	algorithmSHA1   = "sha1"
+	algorithmSHA256 = "sha256"
+)
+
+var (
+	errInvalidPath        = errors.New(`"path" must start with "/"`)
+	errInvalidMaxBodySize = errors.New(`"max_body_size" must be positive`)
+	errMissingHeader      = errors.New(`"signature.header" must be specified`)
+)
+
+// Config defines configuration for the webhook event receiver.
+type Config struct {
+	config.ReceiverSettings       `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
+	confighttp.HTTPServerSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
+
+	// Path is the path the webhooks are posted to.
+	Path string `mapstructure:"path"`
+	// MaxBodySize is the maximum size in bytes of the request bodies.
+	MaxBodySize int64 `mapstructure:"max_body_size"`
+	// ExcludeHeaders are the request headers not captured as attributes, in addition
+	// to the Authorization, Cookie and signature headers.
+	ExcludeHeaders []string `mapstructure:"exclude_headers"`
+	// Signature configures the validation of the HMAC signature of the requests.
+	Signature SignatureConfig `mapstructure:"signature"`
+}
+
+// SignatureConfig defines how the HMAC signature of the requests is validated.
+type SignatureConfig struct {
+	// Secret is the key shared with the sender of the webhooks. Signatures are
+	// only validated when it is set.
+	Secret string `mapstructure:"secret"`
+	// Header is the request header holding the hex encoded signature of the body,
+	// optionally prefixed with `<algorithm>=`, like GitHub does.
+	Header string `mapstructure:"header"`
+	// Algorithm is the hash function of the HMAC, either sha256 or sha1.
+	Algorithm string `mapstructure:"algorithm"`
+}
+
+// Validate checks if the receiver configuration is valid
+func (cfg *Config) Validate() error {
+	var err error
+	if !strings.HasPrefix(cfg.Path, "/") {
+		err = multierr.Append(err, errInvalidPath)
+	}
+	if cfg.MaxBodySize <= 0 {
+		err = multierr.Append(err, errInvalidMaxBodySize)
+	}
+	if cfg.Signature.enabled() {
+		err = multierr.Append(err, cfg.Signature.validate())
+	}
+	return err
+}
+
+func (cfg *SignatureConfig) enabled() bool {
+	return cfg.Secret != ""
+}
+
+func (cfg *SignatureConfig) validate() error {
+	var err error
+	if cfg.Header == "" {
+		err = multierr.Append(err, errMissingHeader)
+	}
+	switch cfg.Algorithm {
+	case algorithmSHA1, algorithmSHA256:
+	default:
+		err = multierr.Append(err, fmt.Errorf(`"signature.algorithm" must be either %q or %q, got %q`, algorithmSHA256, algorithmSHA1, cfg.Algorithm))
+	}
+	return err
+}
@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhookeventreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/webhookeventreceiver"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/consumer"
+)
+
+const (
+	// The value of "type" key in configuration.
+	typeStr = "webhookevent"
+	// The stability level of the receiver.
+	stability = component.StabilityLevelAlpha
+
+	// Default endpoint to bind to.
+	defaultEndpoint = ":8080"
+	// Default path the webhooks are posted to.
+	defaultPath = "/events"
+	// Default maximum size of the request bodies.
+	defaultMaxBodySize = 10 * 1024 * 1024
+	// Default header of the signatures, as sent by GitHub.
+	defaultSignatureHeader = "X-Hub-Signature-256"
+)
+
+// NewFactory creates a factory for the webhook event receiver.
+func NewFactory() component.ReceiverFactory {
+	return component.NewReceiverFactory(
+		typeStr,
+		createDefaultConfig,
+		component.WithLogsReceiver(createLogsReceiver, stability))
+}
+
+func createDefaultConfig() component.ReceiverConfig {
+	return &Config{
+		ReceiverSettings: config.NewReceiverSettings(component.NewID(typeStr)),
+		HTTPServerSettings: confighttp.HTTPServerSettings{
+			Endpoint: defaultEndpoint,
+		},
+		Path:        defaultPath,
+		MaxBodySize: defaultMaxBodySize,
+		Signature: SignatureConfig{
+			Header:    defaultSignatureHeader,
+			Algorithm: algorithmSHA256,
+		},
+	}
+}
+
+func createLogsReceiver(
+	_ context.Context,
+	params component.ReceiverCreateSettings,
+	cfg component.ReceiverConfig,
+	consumer consumer.Logs,
+) (component.LogsReceiver, error) {
+	return newLogsReceiver(params, cfg.(*Config), consumer)
+}
@@ -0,0 +1,342 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhookeventreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/webhookeventreceiver"
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/hmac"
+	"crypto/sha1" // #nosec G505 -- SHA1 is supported for the senders which only sign with it
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/obsreport"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/pdatautil"
+)
+
+const (
+	defaultServerTimeout = 20 * time.Second
+
+	// headerAttributePrefix is the prefix of the attributes of the captured request
+	// headers, following the semantic conventions of HTTP spans.
+	headerAttributePrefix = "http.request.header."
+)
+
+var (
+	errNilNextConsumer = errors.New("nil next consumer")
+	errEmptyEndpoint   = errors.New("empty endpoint")
+	errBodyTooLarge    = errors.New("request body too large")
+	errNoSignature     = errors.New("missing signature")
+	errWrongSignature  = errors.New("signature does not match the body")
+)
+
+// ndjsonContentTypes are the content types of bodies holding a JSON value per line.
+var ndjsonContentTypes = map[string]bool{
+	"application/x-ndjson":    true,
+	"application/ndjson":      true,
+	"application/jsonl":       true,
+	"application/x-jsonlines": true,
+}
+
+// sensitiveHeaders are the request headers never captured as attributes.
+var sensitiveHeaders = []string{"Authorization", "Cookie", "Proxy-Authorization"}
+
+// webhookReceiver implements component.LogsReceiver, converting the JSON payloads
+// posted to its endpoint to log records.
+type webhookReceiver struct {
+	settings       component.ReceiverCreateSettings
+	config         *Config
+	logsConsumer   consumer.Logs
+	server         *http.Server
+	shutdownWG     sync.WaitGroup
+	obsrecv        *obsreport.Receiver
+	excludeHeaders map[string]bool
+}
+
+var _ component.LogsReceiver = (*webhookReceiver)(nil)
+
+func newLogsReceiver(
+	settings component.ReceiverCreateSettings,
+	config *Config,
+	nextConsumer consumer.Logs,
+) (component.LogsReceiver, error) {
+	if nextConsumer == nil {
+		return nil, errNilNextConsumer
+	}
+	if config.Endpoint == "" {
+		return nil, errEmptyEndpoint
+	}
+
+	transport := "http"
+	if config.TLSSetting != nil {
+		transport = "https"
+	}
+	obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{
+		ReceiverID:             config.ID(),
+		Transport:              transport,
+		ReceiverCreateSettings: settings,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	excludeHeaders := map[string]bool{}
+	for _, header := range append(sensitiveHeaders, config.ExcludeHeaders...) {
+		excludeHeaders[http.CanonicalHeaderKey(header)] = true
+	}
+	if config.Signature.enabled() {
+		excludeHeaders[http.CanonicalHeaderKey(config.Signature.Header)] = true
+	}
+
+	return &webhookReceiver{
+		settings:       settings,
+		config:         config,
+		logsConsumer:   nextConsumer,
+		obsrecv:        obsrecv,
+		excludeHeaders: excludeHeaders,
+	}, nil
+}
+
+// Start tells the receiver to start its processing.
+func (r *webhookReceiver) Start(_ context.Context, host component.Host) error {
+	ln, err := r.config.HTTPServerSettings.ToListener()
+	if err != nil {
+		return fmt.Errorf("failed to bind to address %s: %w", r.config.Endpoint, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(r.config.Path, r.handleEvent)
+
+	r.server, err = r.config.HTTPServerSettings.ToServer(host, r.settings.TelemetrySettings, mux)
+	if err != nil {
+		return err
+	}
+	r.server.ReadHeaderTimeout = defaultServerTimeout
+	r.server.WriteTimeout = defaultServerTimeout
+
+	r.shutdownWG.Add(1)
+	go func() {
+		defer r.shutdownWG.Done()
+		if errHTTP := r.server.Serve(ln); !errors.Is(errHTTP, http.ErrServerClosed) && errHTTP != nil {
+			host.ReportFatalError(errHTTP)
+		}
+	}()
+	return nil
+}
+
+// Shutdown tells the receiver that should stop reception,
+// giving it a chance to perform any necessary clean-up.
+func (r *webhookReceiver) Shutdown(context.Context) error {
+	if r.server == nil {
+		return nil
+	}
+	err := r.server.Close()
+	r.shutdownWG.Wait()
+	return err
+}
+
+func (r *webhookReceiver) handleEvent(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx := r.obsrecv.StartLogsOp(req.Context())
+
+	body, err := r.readBody(req)
+	if err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, errBodyTooLarge) {
+			status = http.StatusRequestEntityTooLarge
+		}
+		r.failRequest(ctx, w, status, err)
+		return
+	}
+
+	if r.config.Signature.enabled() {
+		if err = r.verifySignature(req.Header.Get(r.config.Signature.Header), body); err != nil {
+			r.failRequest(ctx, w, http.StatusUnauthorized, err)
+			return
+		}
+	}
+
+	ld, err := r.toLogs(req, body)
+	if err != nil {
+		r.failRequest(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	numRecords := ld.LogRecordCount()
+	if numRecords > 0 {
+		err = r.logsConsumer.ConsumeLogs(ctx, ld)
+	}
+	r.obsrecv.EndLogsOp(ctx, typeStr, numRecords, err)
+	if err != nil {
+		status := http.StatusServiceUnavailable
+		if consumererror.IsPermanent(err) {
+			status = http.StatusInternalServerError
+		}
+		http.Error(w, "failed to process the event", status)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (r *webhookReceiver) failRequest(ctx context.Context, w http.ResponseWriter, status int, err error) {
+	r.obsrecv.EndLogsOp(ctx, typeStr, 0, err)
+	if r.settings.Logger.Core().Enabled(zap.DebugLevel) {
+		r.settings.Logger.Debug("Webhook event rejected", zap.Int("status", status), zap.Error(err))
+	}
+	http.Error(w, err.Error(), status)
+}
+
+// readBody reads the body, decompressing it if needed, up to the maximum body size.
+func (r *webhookReceiver) readBody(req *http.Request) ([]byte, error) {
+	var reader io.Reader = req.Body
+	switch encoding := req.Header.Get("Content-Encoding"); encoding {
+	case "":
+	case "gzip":
+		gz, err := gzip.NewReader(req.Body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid gzip body: %w", err)
+		}
+		defer gz.Close()
+		reader = gz
+	default:
+		return nil, fmt.Errorf("unsupported content encoding %q", encoding)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(reader, r.config.MaxBodySize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read body: %w", err)
+	}
+	if int64(len(body)) > r.config.MaxBodySize {
+		return nil, errBodyTooLarge
+	}
+	return body, nil
+}
+
+// verifySignature checks the hex encoded HMAC signature of the body, which may be
+// prefixed with the algorithm, e.g. `sha256=<signature>`.
+func (r *webhookReceiver) verifySignature(header string, body []byte) error {
+	if header == "" {
+		return errNoSignature
+	}
+	signature, err := hex.DecodeString(strings.TrimPrefix(header, r.config.Signature.Algorithm+"="))
+	if err != nil {
+		return errWrongSignature
+	}
+
+	var newHash func() hash.Hash
+	switch r.config.Signature.Algorithm {
+	case algorithmSHA1:
+		newHash = sha1.New
+	default:
+		newHash = sha256.New
+	}
+	mac := hmac.New(newHash, []byte(r.config.Signature.Secret))
+	mac.Write(body)
+	if !hmac.Equal(mac.Sum(nil), signature) {
+		return errWrongSignature
+	}
+	return nil
+}
+
+// toLogs converts the body to a log record, or to a log record per line for
+// newline delimited JSON.
+func (r *webhookReceiver) toLogs(req *http.Request, body []byte) (plog.Logs, error) {
+	ld := plog.NewLogs()
+	records := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	addRecord := func(payload []byte) error {
+		value, err := decodeJSON(payload)
+		if err != nil {
+			return err
+		}
+		record := records.AppendEmpty()
+		record.SetObservedTimestamp(now)
+		pdatautil.SetJSONValue(record.Body(), value)
+		r.addHeaderAttributes(record.Attributes(), req.Header)
+		return nil
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	if !ndjsonContentTypes[mediaType] {
+		if len(bytes.TrimSpace(body)) == 0 {
+			return ld, nil
+		}
+		return ld, addRecord(body)
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	scanner.Buffer(nil, len(body)+1)
+	for line := 1; scanner.Scan(); line++ {
+		payload := bytes.TrimSpace(scanner.Bytes())
+		if len(payload) == 0 {
+			continue
+		}
+		if err := addRecord(payload); err != nil {
+			return ld, fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+	return ld, scanner.Err()
+}
+
+func (r *webhookReceiver) addHeaderAttributes(attrs pcommon.Map, header http.Header) {
+	for name, values := range header {
+		if r.excludeHeaders[name] {
+			continue
+		}
+		slice := attrs.PutEmptySlice(headerAttributePrefix + strings.ToLower(name))
+		slice.EnsureCapacity(len(values))
+		for _, value := range values {
+			slice.AppendEmpty().SetStr(value)
+		}
+	}
+}
+
+func decodeJSON(payload []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+	if decoder.More() {
+		return nil, errors.New("invalid JSON: unexpected data after the JSON value")
+	}
+	return value, nil
+}
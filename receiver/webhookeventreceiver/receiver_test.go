@@ -0,0 +1,300 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhookeventreceiver
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
+)
+
+func newTestReceiver(t *testing.T, cfg *Config, sink *consumertest.LogsSink) *webhookReceiver {
+	r, err := newLogsReceiver(componenttest.NewNopReceiverCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	return r.(*webhookReceiver)
+}
+
+func post(r *webhookReceiver, contentType string, body []byte, header http.Header) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/events", bytes.NewReader(body))
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	rec := httptest.NewRecorder()
+	r.handleEvent(rec, req)
+	return rec
+}
+
+func TestHandleEventJSON(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	r := newTestReceiver(t, createDefaultConfig().(*Config), sink)
+
+	rec := post(r, "application/json", []byte(`{"action":"opened","number":42,"ratio":0.5,"labels":["bug"],"draft":false,"milestone":null}`), http.Header{
+		"X-Github-Event": {"pull_request"},
+		"Authorization":  {"Bearer token"},
+	})
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	require.Len(t, sink.AllLogs(), 1)
+	ld := sink.AllLogs()[0]
+	require.Equal(t, 1, ld.LogRecordCount())
+	record := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.NotZero(t, record.ObservedTimestamp())
+
+	assert.Equal(t, map[string]interface{}{
+		"action":    "opened",
+		"number":    int64(42),
+		"ratio":     0.5,
+		"labels":    []interface{}{"bug"},
+		"draft":     false,
+		"milestone": nil,
+	}, record.Body().Map().AsRaw())
+
+	event, ok := record.Attributes().Get("http.request.header.x-github-event")
+	require.True(t, ok)
+	assert.Equal(t, []interface{}{"pull_request"}, event.Slice().AsRaw())
+	_, ok = record.Attributes().Get("http.request.header.authorization")
+	assert.False(t, ok)
+	contentType, ok := record.Attributes().Get("http.request.header.content-type")
+	require.True(t, ok)
+	assert.Equal(t, []interface{}{"application/json"}, contentType.Slice().AsRaw())
+}
+
+func TestHandleEventNDJSON(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	r := newTestReceiver(t, createDefaultConfig().(*Config), sink)
+
+	rec := post(r, "application/x-ndjson", []byte("{\"status\":\"firing\"}\n\n\"resolved\"\n"), nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	require.Len(t, sink.AllLogs(), 1)
+	records := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 2, records.Len())
+	assert.Equal(t, map[string]interface{}{"status": "firing"}, records.At(0).Body().Map().AsRaw())
+	assert.Equal(t, pcommon.ValueTypeStr, records.At(1).Body().Type())
+	assert.Equal(t, "resolved", records.At(1).Body().Str())
+}
+
+func TestHandleEventGzip(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	r := newTestReceiver(t, createDefaultConfig().(*Config), sink)
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err := gz.Write([]byte(`{"alerts":[]}`))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	rec := post(r, "application/json", buf.Bytes(), http.Header{"Content-Encoding": {"gzip"}})
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, 1, sink.LogRecordCount())
+}
+
+func TestHandleEventErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		body       string
+		header     http.Header
+		wantStatus int
+	}{
+		{
+			name:       "invalid method",
+			method:     http.MethodGet,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:       "invalid JSON",
+			body:       `{"action":`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "several JSON values",
+			body:       `{} {}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid NDJSON line",
+			body:       "{}\nnot json\n",
+			header:     http.Header{"Content-Type": {"application/x-ndjson"}},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "body too large",
+			body:       `"` + strings.Repeat("a", 64) + `"`,
+			wantStatus: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:       "unsupported encoding",
+			body:       `{}`,
+			header:     http.Header{"Content-Encoding": {"br"}},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.MaxBodySize = 32
+			sink := new(consumertest.LogsSink)
+			r := newTestReceiver(t, cfg, sink)
+
+			method := tt.method
+			if method == "" {
+				method = http.MethodPost
+			}
+			req := httptest.NewRequest(method, "/events", strings.NewReader(tt.body))
+			for name, values := range tt.header {
+				req.Header[name] = values
+			}
+			rec := httptest.NewRecorder()
+			r.handleEvent(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Zero(t, sink.LogRecordCount())
+		})
+	}
+}
+
+func TestHandleEventSignature(t *testing.T) {
+	body := []byte(`{"zen":"Keep it logically awesome."}`)
+	mac := hmac.New(sha256.New, []byte("s3cr3t"))
+	mac.Write(body)
+	signature := hex.EncodeToString(mac.Sum(nil))
+
+	tests := []struct {
+		name       string
+		signature  string
+		wantStatus int
+	}{
+		{
+			name:       "valid",
+			signature:  "sha256=" + signature,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "valid without prefix",
+			signature:  signature,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "missing",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "wrong",
+			signature:  "sha256=" + strings.Repeat("0", len(signature)),
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "not hex",
+			signature:  "sha256=signature",
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Signature.Secret = "s3cr3t"
+			sink := new(consumertest.LogsSink)
+			r := newTestReceiver(t, cfg, sink)
+
+			header := http.Header{}
+			if tt.signature != "" {
+				header.Set(defaultSignatureHeader, tt.signature)
+			}
+			rec := post(r, "application/json", body, header)
+			require.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus != http.StatusOK {
+				assert.Zero(t, sink.LogRecordCount())
+				return
+			}
+
+			require.Equal(t, 1, sink.LogRecordCount())
+			attrs := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes()
+			_, ok := attrs.Get("http.request.header.x-hub-signature-256")
+			assert.False(t, ok, "the signature must not be captured")
+		})
+	}
+}
+
+func TestHandleEventConsumerError(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{
+			name:       "retryable",
+			err:        errors.New("queue is full"),
+			wantStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:       "permanent",
+			err:        consumererror.NewPermanent(errors.New("invalid data")),
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := newLogsReceiver(componenttest.NewNopReceiverCreateSettings(), createDefaultConfig().(*Config), consumertest.NewErr(tt.err))
+			require.NoError(t, err)
+
+			rec := post(r.(*webhookReceiver), "application/json", []byte(`{}`), nil)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+		})
+	}
+}
+
+func TestReceiverLifecycle(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = testutil.GetAvailableLocalAddress(t)
+	sink := new(consumertest.LogsSink)
+	r := newTestReceiver(t, cfg, sink)
+
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, r.Shutdown(context.Background()))
+	}()
+
+	resp, err := http.Post("http://"+cfg.Endpoint+defaultPath, "application/json", strings.NewReader(`{"event":"push"}`))
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 1, sink.LogRecordCount())
+
+	resp, err = http.Post("http://"+cfg.Endpoint+"/other", "application/json", strings.NewReader(`{}`))
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}